@@ -0,0 +1,111 @@
+package antivirus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// clamAVChunkSize размер части потока, передаваемой clamd в команде INSTREAM
+const clamAVChunkSize = 64 << 10
+
+// ClamAVScanner проверяет файлы демоном clamd по протоколу INSTREAM
+type ClamAVScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamAVScanner создает сканер clamd; address - host:port или unix:/path/to/clamd.sock
+func NewClamAVScanner(address string, timeout time.Duration) *ClamAVScanner {
+	network := "tcp"
+	if path, ok := strings.CutPrefix(address, "unix:"); ok {
+		network, address = "unix", path
+	}
+	return &ClamAVScanner{network: network, address: address, timeout: timeout}
+}
+
+// Name возвращает название сканера
+func (s *ClamAVScanner) Name() string {
+	return "clamav"
+}
+
+// Scan передает поток clamd частями и разбирает ответ: "stream: OK" или "stream: <сигнатура> FOUND"
+func (s *ClamAVScanner) Scan(ctx context.Context, r io.Reader) (*Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to clamd: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	// Отмена контекста прерывает ожидание ответа
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, fmt.Errorf("failed to send clamd command: %w", err)
+	}
+
+	writer := bufio.NewWriterSize(conn, clamAVChunkSize+4)
+	chunk := make([]byte, clamAVChunkSize)
+	var size [4]byte
+	for {
+		n, readErr := r.Read(chunk)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size[:], uint32(n))
+			if _, err := writer.Write(size[:]); err != nil {
+				return nil, fmt.Errorf("failed to stream data to clamd: %w", err)
+			}
+			if _, err := writer.Write(chunk[:n]); err != nil {
+				return nil, fmt.Errorf("failed to stream data to clamd: %w", err)
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read data for scanning: %w", readErr)
+		}
+	}
+	// Часть нулевой длины завершает поток
+	binary.BigEndian.PutUint32(size[:], 0)
+	if _, err := writer.Write(size[:]); err != nil {
+		return nil, fmt.Errorf("failed to stream data to clamd: %w", err)
+	}
+	if err := writer.Flush(); err != nil {
+		return nil, fmt.Errorf("failed to stream data to clamd: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadBytes(0)
+	if err != nil && len(reply) == 0 {
+		return nil, fmt.Errorf("failed to read clamd response: %w", err)
+	}
+	return parseClamAVReply(string(bytes.TrimRight(reply, "\x00")))
+}
+
+// parseClamAVReply разбирает ответ clamd на команду INSTREAM
+func parseClamAVReply(reply string) (*Result, error) {
+	reply = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(reply), "stream:"))
+	switch {
+	case reply == "OK":
+		return &Result{}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return &Result{Infected: true, Signature: strings.TrimSuffix(reply, " FOUND")}, nil
+	default:
+		// Например, "INSTREAM size limit exceeded. ERROR"
+		return nil, &ScanError{Scanner: "clamav", Response: reply}
+	}
+}
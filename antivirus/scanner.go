@@ -0,0 +1,83 @@
+package antivirus
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"main/utils"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// defaultScanTimeout максимальное время проверки одного файла по умолчанию
+const defaultScanTimeout = 2 * time.Minute
+
+// Result результат антивирусной проверки
+type Result struct {
+	// Infected файл содержит вредоносное содержимое
+	Infected bool
+	// Signature название обнаруженной сигнатуры (только для зараженных файлов)
+	Signature string
+}
+
+// Scanner проверяет содержимое файлов антивирусом (ClamAV, ICAP и т.д.)
+type Scanner interface {
+	// Name возвращает название сканера для логов и аудита
+	Name() string
+	// Scan проверяет поток целиком. Ошибка означает, что результат проверки неизвестен.
+	Scan(ctx context.Context, r io.Reader) (*Result, error)
+}
+
+var (
+	defaultScanner     Scanner
+	defaultScannerOnce sync.Once
+)
+
+// DefaultScanner возвращает сканер, настроенный переменными окружения, или nil, если проверка отключена:
+// ANTIVIRUS_SCANNER=clamav (по умолчанию проверка отключена), CLAMAV_ADDRESS (host:port или unix:/path,
+// по умолчанию localhost:3310), ANTIVIRUS_TIMEOUT (по умолчанию 2m)
+func DefaultScanner() Scanner {
+	defaultScannerOnce.Do(func() {
+		timeout := defaultScanTimeout
+		if value := os.Getenv("ANTIVIRUS_TIMEOUT"); value != "" {
+			if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+				timeout = parsed
+			} else {
+				utils.Logger.Warn("Invalid ANTIVIRUS_TIMEOUT, using default",
+					zap.String("value", value),
+					zap.Duration("default", defaultScanTimeout))
+			}
+		}
+
+		switch kind := strings.ToLower(os.Getenv("ANTIVIRUS_SCANNER")); kind {
+		case "", "none":
+			utils.Logger.Info("Antivirus scanning is disabled")
+		case "clamav":
+			address := os.Getenv("CLAMAV_ADDRESS")
+			if address == "" {
+				address = "localhost:3310"
+			}
+			defaultScanner = NewClamAVScanner(address, timeout)
+			utils.Logger.Info("Antivirus scanning is enabled",
+				zap.String("scanner", defaultScanner.Name()),
+				zap.String("address", address))
+		default:
+			utils.Logger.Error("Unknown ANTIVIRUS_SCANNER, scanning is disabled", zap.String("scanner", kind))
+		}
+	})
+	return defaultScanner
+}
+
+// ScanError ошибка сканера с ответом сервера проверки
+type ScanError struct {
+	Scanner  string
+	Response string
+}
+
+func (e *ScanError) Error() string {
+	return fmt.Sprintf("%s scan failed: %s", e.Scanner, e.Response)
+}
@@ -0,0 +1,218 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"main/utils"
+	"math"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultMaxUploadSize максимальный размер загружаемого файла по умолчанию (100MB)
+	DefaultMaxUploadSize int64 = 100 << 20
+	// DefaultMaxTenantUploadSize верхняя граница лимита размера файла, который может задать тенант (5GB)
+	DefaultMaxTenantUploadSize int64 = 5 << 30
+	// DefaultMaxBatchArchiveFiles максимальное количество файлов в архиве по умолчанию
+	DefaultMaxBatchArchiveFiles = 50
+	// DefaultMaxBatchDeleteFiles максимальное количество файлов для пакетного удаления по умолчанию
+	DefaultMaxBatchDeleteFiles = 100
+	// DefaultFilesOrder сортировка списков файлов, если клиент не передал orderBy (поле:направление)
+	DefaultFilesOrder = "CREATE_TIME:DESC"
+	// DefaultStorageCostCurrency валюта цен модели стоимости хранения
+	DefaultStorageCostCurrency = "USD"
+	// DefaultStorageCostStandardGBMonth цена хранения 1 ГБ в месяц для обычных файлов (S3 Standard)
+	DefaultStorageCostStandardGBMonth = 0.023
+	// DefaultStorageCostArchiveGBMonth цена хранения 1 ГБ в месяц для архивных файлов (S3 Glacier Instant Retrieval)
+	DefaultStorageCostArchiveGBMonth = 0.004
+	// DefaultStorageCostEgressGB цена исходящего трафика за 1 ГБ
+	DefaultStorageCostEgressGB = 0.09
+	// featureEnvPrefix префикс переменных окружения флагов функциональности (FEATURE_UPLOAD_SINGLE_FLIGHT=false)
+	featureEnvPrefix = "FEATURE_"
+)
+
+// Флаги функциональности
+const (
+	// FeatureUploadSingleFlight защита от одновременной загрузки одинаковых файлов
+	FeatureUploadSingleFlight = "upload_single_flight"
+)
+
+// defaultFeatures значения флагов, если они не заданы в окружении
+var defaultFeatures = map[string]bool{
+	FeatureUploadSingleFlight: true,
+}
+
+// Runtime содержит безопасные для горячей перезагрузки настройки сервиса.
+// Экземпляр неизменяем: перезагрузка подменяет его целиком, поэтому запросы в процессе
+// выполнения продолжают работать с настройками, прочитанными при их начале.
+type Runtime struct {
+	LogLevel             string           `json:"logLevel"`
+	MaxUploadSize        int64            `json:"maxUploadSize"`
+	MaxTenantUploadSize  int64            `json:"maxTenantUploadSize"`
+	MaxBatchArchiveFiles int              `json:"maxBatchArchiveFiles"`
+	MaxBatchDeleteFiles  int              `json:"maxBatchDeleteFiles"`
+	FilesDefaultOrder    string           `json:"filesDefaultOrder"`
+	Features             map[string]bool  `json:"features"`
+	StorageCost          StorageCostModel `json:"storageCost"`
+}
+
+// StorageCostModel цены для оценки стоимости хранения тенанта. Объем считается в ГБ по 2^30 байт, как в счетах S3.
+type StorageCostModel struct {
+	Currency string `json:"currency"`
+	// StandardGBMonth цена 1 ГБ в месяц для обычных файлов и файлов в корзине
+	StandardGBMonth float64 `json:"standardGbMonth"`
+	// ArchiveGBMonth цена 1 ГБ в месяц для файлов, переведенных в архив правилами жизненного цикла
+	ArchiveGBMonth float64 `json:"archiveGbMonth"`
+	// EgressGB цена 1 ГБ скачанных данных
+	EgressGB float64 `json:"egressGb"`
+}
+
+var (
+	current  atomic.Pointer[Runtime]
+	reloadMu sync.Mutex
+)
+
+// Get возвращает текущие настройки (загружает из окружения при первом обращении)
+func Get() *Runtime {
+	if cfg := current.Load(); cfg != nil {
+		return cfg
+	}
+	cfg := loadFromEnv()
+	if current.CompareAndSwap(nil, cfg) {
+		return cfg
+	}
+	return current.Load()
+}
+
+// FeatureEnabled возвращает значение флага функциональности
+func (r *Runtime) FeatureEnabled(name string) bool {
+	return r.Features[name]
+}
+
+// FeatureEnabled возвращает значение флага функциональности из текущих настроек
+func FeatureEnabled(name string) bool {
+	return Get().FeatureEnabled(name)
+}
+
+// Reload перечитывает .env и переменные окружения и атомарно применяет новые настройки.
+// Переменные из .env перезаписывают ранее загруженные значения.
+func Reload() (*Runtime, error) {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	if err := godotenv.Overload(".env"); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	previous := Get()
+	cfg := loadFromEnv()
+
+	if cfg.LogLevel != "" {
+		if err := utils.SetLogLevel(cfg.LogLevel); err != nil {
+			return nil, err
+		}
+	}
+
+	current.Store(cfg)
+
+	utils.Logger.Info("Runtime configuration reloaded",
+		zap.Any("previous", previous),
+		zap.Any("current", cfg))
+
+	return cfg, nil
+}
+
+// WatchSIGHUP перезагружает настройки по сигналу SIGHUP до отмены ctx
+func WatchSIGHUP(ctx context.Context) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(signals)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signals:
+				utils.Logger.Info("SIGHUP received, reloading runtime configuration")
+				if _, err := Reload(); err != nil {
+					utils.Logger.Error("Failed to reload runtime configuration", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// loadFromEnv читает настройки из переменных окружения; некорректные значения заменяются значениями по умолчанию
+func loadFromEnv() *Runtime {
+	cfg := &Runtime{
+		LogLevel:             os.Getenv("LOG_LEVEL"),
+		MaxUploadSize:        getEnvInt64("MAX_UPLOAD_SIZE", DefaultMaxUploadSize),
+		MaxTenantUploadSize:  getEnvInt64("MAX_TENANT_UPLOAD_SIZE", DefaultMaxTenantUploadSize),
+		MaxBatchArchiveFiles: int(getEnvInt64("MAX_BATCH_ARCHIVE_FILES", DefaultMaxBatchArchiveFiles)),
+		MaxBatchDeleteFiles:  int(getEnvInt64("MAX_BATCH_DELETE_FILES", DefaultMaxBatchDeleteFiles)),
+		FilesDefaultOrder:    getEnvString("FILES_DEFAULT_ORDER", DefaultFilesOrder),
+		Features:             make(map[string]bool, len(defaultFeatures)),
+		StorageCost: StorageCostModel{
+			Currency:        getEnvString("STORAGE_COST_CURRENCY", DefaultStorageCostCurrency),
+			StandardGBMonth: getEnvPrice("STORAGE_COST_STANDARD_GB_MONTH", DefaultStorageCostStandardGBMonth),
+			ArchiveGBMonth:  getEnvPrice("STORAGE_COST_ARCHIVE_GB_MONTH", DefaultStorageCostArchiveGBMonth),
+			EgressGB:        getEnvPrice("STORAGE_COST_EGRESS_GB", DefaultStorageCostEgressGB),
+		},
+	}
+
+	// Лимит по умолчанию всегда доступен тенанту
+	if cfg.MaxTenantUploadSize < cfg.MaxUploadSize {
+		cfg.MaxTenantUploadSize = cfg.MaxUploadSize
+	}
+
+	for name, enabled := range defaultFeatures {
+		cfg.Features[name] = enabled
+	}
+	for _, entry := range os.Environ() {
+		key, value, found := strings.Cut(entry, "=")
+		if !found || !strings.HasPrefix(key, featureEnvPrefix) {
+			continue
+		}
+		if enabled, err := strconv.ParseBool(value); err == nil {
+			cfg.Features[strings.ToLower(strings.TrimPrefix(key, featureEnvPrefix))] = enabled
+		}
+	}
+
+	return cfg
+}
+
+// getEnvInt64 возвращает положительное значение переменной окружения или значение по умолчанию
+func getEnvInt64(key string, defaultValue int64) int64 {
+	if value, err := strconv.ParseInt(os.Getenv(key), 10, 64); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
+// getEnvString возвращает непустое значение переменной окружения или значение по умолчанию
+func getEnvString(key, defaultValue string) string {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// getEnvPrice возвращает неотрицательную цену из переменной окружения или значение по умолчанию.
+// Ноль допустим: например, исходящий трафик без оплаты у S3-совместимого хранилища.
+func getEnvPrice(key string, defaultValue float64) float64 {
+	if value, err := strconv.ParseFloat(strings.TrimSpace(os.Getenv(key)), 64); err == nil && value >= 0 && !math.IsInf(value, 0) {
+		return value
+	}
+	return defaultValue
+}
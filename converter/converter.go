@@ -0,0 +1,92 @@
+package converter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"main/utils"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// defaultConvertTimeout максимальное время конвертации одного документа по умолчанию
+const defaultConvertTimeout = 2 * time.Minute
+
+// Converter конвертирует документы Office в PDF (LibreOffice, Gotenberg и т.д.)
+type Converter interface {
+	// Name возвращает название конвертера для логов
+	Name() string
+	// ConvertToPDF читает документ из r и записывает PDF в w. Формат документа определяется
+	// по расширению filename, поэтому передается исходное имя файла.
+	ConvertToPDF(ctx context.Context, r io.Reader, filename string, w io.Writer) error
+}
+
+var (
+	defaultConverter     Converter
+	defaultConverterOnce sync.Once
+)
+
+// DefaultConverter возвращает конвертер, настроенный переменными окружения, или nil, если конвертация отключена:
+// PDF_CONVERTER=libreoffice|gotenberg (по умолчанию отключена), LIBREOFFICE_COMMAND (по умолчанию soffice),
+// GOTENBERG_URL (по умолчанию http://localhost:3000), PDF_CONVERTER_TIMEOUT (по умолчанию 2m)
+func DefaultConverter() Converter {
+	defaultConverterOnce.Do(func() {
+		timeout := defaultConvertTimeout
+		if value := os.Getenv("PDF_CONVERTER_TIMEOUT"); value != "" {
+			if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+				timeout = parsed
+			} else {
+				utils.Logger.Warn("Invalid PDF_CONVERTER_TIMEOUT, using default",
+					zap.String("value", value),
+					zap.Duration("default", defaultConvertTimeout))
+			}
+		}
+
+		switch kind := strings.ToLower(os.Getenv("PDF_CONVERTER")); kind {
+		case "", "none":
+			utils.Logger.Info("PDF conversion is disabled")
+		case "libreoffice":
+			command := os.Getenv("LIBREOFFICE_COMMAND")
+			if command == "" {
+				command = "soffice"
+			}
+			libreOffice, err := NewLibreOfficeConverter(command, timeout)
+			if err != nil {
+				utils.Logger.Error("LibreOffice is not installed, PDF conversion is disabled",
+					zap.Error(err),
+					zap.String("command", command))
+				return
+			}
+			defaultConverter = libreOffice
+			utils.Logger.Info("PDF conversion is enabled", zap.String("converter", defaultConverter.Name()))
+		case "gotenberg":
+			url := os.Getenv("GOTENBERG_URL")
+			if url == "" {
+				url = "http://localhost:3000"
+			}
+			defaultConverter = NewGotenbergConverter(url, timeout)
+			utils.Logger.Info("PDF conversion is enabled",
+				zap.String("converter", defaultConverter.Name()),
+				zap.String("url", url))
+		default:
+			utils.Logger.Error("Unknown PDF_CONVERTER, conversion is disabled", zap.String("converter", kind))
+		}
+	})
+	return defaultConverter
+}
+
+// ConvertError ошибка конвертера с ответом сервера или выводом программы конвертации
+type ConvertError struct {
+	Converter string
+	Response  string
+	// Temporary ошибка на стороне сервера конвертации (перегрузка, 5xx): документ можно отправить повторно
+	Temporary bool
+}
+
+func (e *ConvertError) Error() string {
+	return fmt.Sprintf("%s conversion failed: %s", e.Converter, e.Response)
+}
@@ -0,0 +1,77 @@
+package converter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// gotenbergMaxErrorBody максимальный размер ответа Gotenberg с ошибкой, попадающего в лог
+const gotenbergMaxErrorBody = 4 << 10
+
+// GotenbergConverter конвертирует документы сервисом Gotenberg (маршрут /forms/libreoffice/convert)
+type GotenbergConverter struct {
+	url    string
+	client *http.Client
+}
+
+// NewGotenbergConverter создает конвертер Gotenberg; url - адрес сервиса без пути (например, http://gotenberg:3000)
+func NewGotenbergConverter(url string, timeout time.Duration) *GotenbergConverter {
+	return &GotenbergConverter{
+		url:    strings.TrimRight(url, "/"),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Name возвращает название конвертера
+func (c *GotenbergConverter) Name() string {
+	return "gotenberg"
+}
+
+// ConvertToPDF передает документ Gotenberg потоком multipart/form-data и записывает полученный PDF в w
+func (c *GotenbergConverter) ConvertToPDF(ctx context.Context, r io.Reader, filename string, w io.Writer) error {
+	body, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+	go func() {
+		// Gotenberg определяет формат по расширению имени части формы
+		part, err := form.CreateFormFile("files", "document"+sourceExtension(filename))
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		_ = writer.CloseWithError(err)
+	}()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url+"/forms/libreoffice/convert", body)
+	if err != nil {
+		_ = body.CloseWithError(err)
+		return fmt.Errorf("failed to create gotenberg request: %w", err)
+	}
+	request.Header.Set("Content-Type", form.FormDataContentType())
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		_ = body.CloseWithError(err)
+		return fmt.Errorf("failed to call gotenberg: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, gotenbergMaxErrorBody))
+		return &ConvertError{
+			Converter: c.Name(),
+			Response:  fmt.Sprintf("%s: %s", response.Status, strings.TrimSpace(string(message))),
+			Temporary: response.StatusCode >= http.StatusInternalServerError || response.StatusCode == http.StatusTooManyRequests,
+		}
+	}
+	if _, err := io.Copy(w, response.Body); err != nil {
+		return fmt.Errorf("failed to read converted document: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package converter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// LibreOfficeConverter конвертирует документы локальной программой LibreOffice (soffice --headless)
+type LibreOfficeConverter struct {
+	command string
+	timeout time.Duration
+}
+
+// NewLibreOfficeConverter создает конвертер LibreOffice; command - имя программы в PATH или путь к ней
+func NewLibreOfficeConverter(command string, timeout time.Duration) (*LibreOfficeConverter, error) {
+	path, err := exec.LookPath(command)
+	if err != nil {
+		return nil, err
+	}
+	return &LibreOfficeConverter{command: path, timeout: timeout}, nil
+}
+
+// Name возвращает название конвертера
+func (c *LibreOfficeConverter) Name() string {
+	return "libreoffice"
+}
+
+// ConvertToPDF сохраняет документ во временный каталог и конвертирует его командой soffice --convert-to pdf
+func (c *LibreOfficeConverter) ConvertToPDF(ctx context.Context, r io.Reader, filename string, w io.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	workDir, err := os.MkdirTemp("", "pdf-convert-")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	sourcePath := filepath.Join(workDir, "source"+sourceExtension(filename))
+	source, err := os.Create(sourcePath)
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	_, err = io.Copy(source, r)
+	if closeErr := source.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save document for conversion: %w", err)
+	}
+
+	// Отдельный профиль LibreOffice: параллельные экземпляры с общим профилем блокируют друг друга
+	profile := "-env:UserInstallation=file://" + filepath.Join(workDir, "profile")
+	output, err := exec.CommandContext(ctx, c.command, profile, "--headless", "--convert-to", "pdf", "--outdir", workDir, sourcePath).CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("libreoffice timed out: %w", ctx.Err())
+		}
+		return &ConvertError{Converter: c.Name(), Response: fmt.Sprintf("%v: %s", err, strings.TrimSpace(string(output)))}
+	}
+
+	result, err := os.Open(strings.TrimSuffix(sourcePath, filepath.Ext(sourcePath)) + ".pdf")
+	if err != nil {
+		// soffice завершается успешно и без результата, если формат документа не распознан
+		return &ConvertError{Converter: c.Name(), Response: strings.TrimSpace(string(output))}
+	}
+	defer result.Close()
+
+	if _, err := io.Copy(w, result); err != nil {
+		return fmt.Errorf("failed to copy converted document: %w", err)
+	}
+	return nil
+}
+
+// sourceExtension возвращает расширение имени файла для временной копии (по нему LibreOffice
+// определяет формат) или пустую строку, если расширение содержит недопустимые символы
+func sourceExtension(name string) string {
+	ext := strings.ToLower(filepath.Ext(name))
+	if len(ext) > 10 {
+		return ""
+	}
+	for _, r := range strings.TrimPrefix(ext, ".") {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return ""
+		}
+	}
+	return ext
+}
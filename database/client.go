@@ -155,6 +155,15 @@ func NewClient(ctx context.Context, config *Config) (*Client, error) {
 	return client, nil
 }
 
+// NewClientFromEnt wraps already opened ent clients (e.g. SQLite in contract tests) without caching
+func NewClientFromEnt(queryClient, mutationClient *ent.Client) *Client {
+	return &Client{
+		queryClient:    queryClient,
+		mutationClient: mutationClient,
+		config:         &Config{},
+	}
+}
+
 // createEntClient creates a single ent client using pgx driver with optional caching
 func createEntClient(ctx context.Context, dsn string, debug bool, clientType string, enableCache bool, cacheTTL time.Duration) (*ent.Client, error) {
 	// Parse connection config
@@ -198,6 +207,15 @@ func createEntClient(ctx context.Context, dsn string, debug bool, clientType str
 		if svc, err := redis.GetTenantCacheService(); err == nil {
 			if rc := svc.GetClient(); rc != nil {
 				cacheOpts = append(cacheOpts, entcache.Levels(NewTenantIsolatedRedis(rc)))
+				// Горячие запросы (DB_CACHE_HOT_QUERIES) получают single-flight и soft-TTL на уровне Redis
+				if hotQueries := getHotQueryConfig(); hotQueries.enabled() {
+					cacheOpts = append(cacheOpts, entcache.Hash(hotQueryHash))
+					utils.Logger.Info("Hot query stampede protection enabled",
+						zap.Int("signatures", len(hotQueries.signatures)),
+						zap.Duration("soft_ttl", hotQueries.softTTL),
+						zap.Duration("max_stale", hotQueries.maxStale),
+					)
+				}
 				serviceName := os.Getenv("APP_SERVICE_NAME")
 				if serviceName == "" {
 					serviceName = "default"
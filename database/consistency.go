@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// ConsistencyTokenHeader заголовок запроса, в котором клиент передает токен последней мутации
+	ConsistencyTokenHeader = "X-Consistency-Token"
+	// ConsistencyTokenExtension ключ extensions GraphQL: в ответе мутации - выданный токен,
+	// в запросе - альтернатива заголовку
+	ConsistencyTokenExtension = "consistencyToken"
+	// defaultReadYourWritesWindow время, в течение которого чтения с токеном идут на primary
+	defaultReadYourWritesWindow = 30 * time.Second
+	// maxConsistencyTokenSkew допустимое расхождение часов экземпляров сервиса для токенов "из будущего"
+	maxConsistencyTokenSkew = 5 * time.Second
+)
+
+type consistencyTokenKey struct{}
+
+// NewConsistencyToken возвращает логическую метку времени записи (unix ms).
+// Метка ставится в ответ после выполнения резолверов мутации, то есть не раньше коммита ее транзакций.
+func NewConsistencyToken() string {
+	return strconv.FormatInt(time.Now().UnixMilli(), 10)
+}
+
+// WithConsistencyToken сохраняет в контексте токен, переданный клиентом. Некорректные токены и токены
+// позже текущего времени больше чем на maxConsistencyTokenSkew игнорируются, чтобы клиент не мог
+// направить свои чтения на primary дольше окна; токены в пределах расхождения часов приводятся к текущему времени.
+func WithConsistencyToken(ctx context.Context, token string) context.Context {
+	writtenAtMs, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || writtenAtMs <= 0 {
+		return ctx
+	}
+	now := time.Now()
+	writtenAt := time.UnixMilli(writtenAtMs)
+	if writtenAt.After(now.Add(maxConsistencyTokenSkew)) {
+		return ctx
+	}
+	if writtenAt.After(now) {
+		writtenAt = now
+	}
+	return context.WithValue(ctx, consistencyTokenKey{}, writtenAt)
+}
+
+// RequiresPrimary возвращает true, если запрос должен читать свои записи:
+// токен клиента моложе окна READ_YOUR_WRITES_WINDOW, поэтому реплика и кэш могут быть устаревшими.
+// Такие запросы читают с primary (Mutation клиент) в обход entcache.
+func RequiresPrimary(ctx context.Context) bool {
+	writtenAt, ok := ctx.Value(consistencyTokenKey{}).(time.Time)
+	if !ok {
+		return false
+	}
+	return time.Since(writtenAt) < readYourWritesWindow()
+}
+
+// readYourWritesWindow возвращает окно read-your-writes из READ_YOUR_WRITES_WINDOW (например, "30s")
+func readYourWritesWindow() time.Duration {
+	if window, err := time.ParseDuration(os.Getenv("READ_YOUR_WRITES_WINDOW")); err == nil && window > 0 {
+		return window
+	}
+	return defaultReadYourWritesWindow
+}
@@ -0,0 +1,242 @@
+package database
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"main/redis"
+	"main/utils"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"ariga.io/entcache"
+	goredis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultHotQuerySoftTTL возраст записи, после которого один запрос обновляет ее, а остальные получают текущую
+	defaultHotQuerySoftTTL = 30 * time.Second
+	// defaultHotQueryMaxStale сколько после смены версии кэша тенанта можно отдавать предыдущий результат
+	defaultHotQueryMaxStale = 60 * time.Second
+	// hotQueryRefreshLockTTL время жизни блокировки обновления (если обновляющий запрос упал)
+	hotQueryRefreshLockTTL = 10 * time.Second
+	// hotQueryWaitTimeout максимальное ожидание результата обновления при отсутствии устаревшей копии
+	hotQueryWaitTimeout = 2 * time.Second
+	// hotQueryPollInterval интервал опроса результата обновления
+	hotQueryPollInterval = 50 * time.Millisecond
+)
+
+// hotQueryTablePattern извлекает основную таблицу из SELECT запроса ent
+var hotQueryTablePattern = regexp.MustCompile(`(?i)\bFROM\s+"?([a-zA-Z0-9_]+)"?`)
+
+// hotQueryKey ключ кэша горячего запроса: "hot:<сигнатура>:<хеш запроса>"
+type hotQueryKey string
+
+// hotQueryConfig настройки защиты горячих запросов от cache stampede
+type hotQueryConfig struct {
+	// signatures таблицы, запросы к которым считаются горячими (DB_CACHE_HOT_QUERIES=files,file_audit_events)
+	signatures map[string]bool
+	softTTL    time.Duration
+	maxStale   time.Duration
+}
+
+var (
+	hotQueryConfigOnce sync.Once
+	hotQueryCfg        *hotQueryConfig
+)
+
+// getHotQueryConfig читает настройки из окружения один раз.
+// DB_CACHE_HOT_SOFT_TTL и DB_CACHE_HOT_MAX_STALE задаются в секундах.
+func getHotQueryConfig() *hotQueryConfig {
+	hotQueryConfigOnce.Do(func() {
+		cfg := &hotQueryConfig{
+			signatures: make(map[string]bool),
+			softTTL:    defaultHotQuerySoftTTL,
+			maxStale:   defaultHotQueryMaxStale,
+		}
+		for _, signature := range strings.Split(os.Getenv("DB_CACHE_HOT_QUERIES"), ",") {
+			if signature = strings.TrimSpace(signature); signature != "" {
+				cfg.signatures[strings.ToLower(signature)] = true
+			}
+		}
+		if seconds, err := strconv.Atoi(os.Getenv("DB_CACHE_HOT_SOFT_TTL")); err == nil && seconds > 0 {
+			cfg.softTTL = time.Duration(seconds) * time.Second
+		}
+		if seconds, err := strconv.Atoi(os.Getenv("DB_CACHE_HOT_MAX_STALE")); err == nil && seconds >= 0 {
+			cfg.maxStale = time.Duration(seconds) * time.Second
+		}
+		hotQueryCfg = cfg
+	})
+	return hotQueryCfg
+}
+
+// enabled возвращает true, если настроена хотя бы одна сигнатура
+func (c *hotQueryConfig) enabled() bool {
+	return len(c.signatures) > 0
+}
+
+// hotQueryHash строит ключ кэша: для горячих сигнатур ключ помечается префиксом,
+// по которому Redis уровень включает single-flight и soft-TTL
+func hotQueryHash(query string, args []any) (entcache.Key, error) {
+	key, err := entcache.DefaultHash(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	match := hotQueryTablePattern.FindStringSubmatch(query)
+	if match == nil {
+		return key, nil
+	}
+	signature := strings.ToLower(match[1])
+	if !getHotQueryConfig().signatures[signature] {
+		return key, nil
+	}
+	return hotQueryKey(fmt.Sprintf("hot:%s:%v", signature, key)), nil
+}
+
+// encodeHotEntry добавляет к записи время ее сохранения
+func encodeHotEntry(entry *entcache.Entry, storedAt time.Time) ([]byte, error) {
+	data, err := entry.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 8+len(data))
+	binary.BigEndian.PutUint64(buf, uint64(storedAt.UnixMilli()))
+	copy(buf[8:], data)
+	return buf, nil
+}
+
+// decodeHotEntry возвращает запись и время ее сохранения
+func decodeHotEntry(data []byte) (*entcache.Entry, time.Time, error) {
+	if len(data) < 8 {
+		return nil, time.Time{}, fmt.Errorf("hot cache entry is too short")
+	}
+	storedAt := time.UnixMilli(int64(binary.BigEndian.Uint64(data[:8])))
+	entry := &entcache.Entry{}
+	if err := entry.UnmarshalBinary(data[8:]); err != nil {
+		return nil, time.Time{}, err
+	}
+	return entry, storedAt, nil
+}
+
+// staleKeyFor ключ последней копии результата без версии (переживает смену версии кэша тенанта)
+func (t *tenantAwareRedisLevel) staleKeyFor(ctx context.Context, key hotQueryKey) string {
+	return fmt.Sprintf("%stenant:%s:stale:%s", getCacheKeyPrefix(), t.tenantIDFromContext(ctx), key)
+}
+
+// refreshLockKey ключ блокировки обновления конкретной версии записи
+func refreshLockKey(versionedKey string) string {
+	return versionedKey + ":refresh"
+}
+
+// tryRefreshLock захватывает право обновить запись; ошибка Redis трактуется как захват (запрос идет в БД)
+func (t *tenantAwareRedisLevel) tryRefreshLock(ctx context.Context, versionedKey string) bool {
+	var acquired bool
+	err := redis.WithLatencyBudget(ctx, redis.HotPathCacheSet, func(ctx context.Context) (err error) {
+		acquired, err = t.client.SetNX(ctx, refreshLockKey(versionedKey), 1, hotQueryRefreshLockTTL).Result()
+		return err
+	})
+	return err != nil || acquired
+}
+
+// getHot читает горячую запись. Только один запрос (захвативший блокировку) получает промах и идет в БД:
+// - свежая запись отдается как есть;
+// - запись старше soft-TTL отдается всем, кроме одного запроса, который ее обновляет;
+// - при промахе после смены версии остальные запросы получают предыдущий результат (не старше max-stale)
+// или ждут результата обновления.
+func (t *tenantAwareRedisLevel) getHot(ctx context.Context, key hotQueryKey) (*entcache.Entry, error) {
+	cfg := getHotQueryConfig()
+
+	versionedKey, err := t.buildVersionedKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, storedAt, err := t.readHotEntry(ctx, versionedKey)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil {
+		if time.Since(storedAt) < cfg.softTTL || !t.tryRefreshLock(ctx, versionedKey) {
+			return entry, nil
+		}
+		return nil, entcache.ErrNotFound
+	}
+
+	if t.tryRefreshLock(ctx, versionedKey) {
+		return nil, entcache.ErrNotFound
+	}
+
+	// Обновление уже выполняет другой запрос: отдаем предыдущий результат, если он достаточно свежий
+	if stale, staleStoredAt, err := t.readHotEntry(ctx, t.staleKeyFor(ctx, key)); err == nil && stale != nil &&
+		time.Since(staleStoredAt) <= cfg.softTTL+cfg.maxStale {
+		return stale, nil
+	}
+
+	// Предыдущего результата нет - ждем результата обновляющего запроса
+	deadline := time.Now().Add(hotQueryWaitTimeout)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return nil, entcache.ErrNotFound
+		case <-time.After(hotQueryPollInterval):
+		}
+		if entry, _, err := t.readHotEntry(ctx, versionedKey); err == nil && entry != nil {
+			return entry, nil
+		}
+	}
+
+	utils.Logger.Debug("Timed out waiting for hot query refresh", zap.String("key", versionedKey))
+	return nil, entcache.ErrNotFound
+}
+
+// readHotEntry читает запись; отсутствие ключа возвращает (nil, zero, nil)
+func (t *tenantAwareRedisLevel) readHotEntry(ctx context.Context, redisKey string) (*entcache.Entry, time.Time, error) {
+	var data []byte
+	err := redis.WithLatencyBudget(ctx, redis.HotPathCacheGet, func(ctx context.Context) (err error) {
+		data, err = t.client.Get(ctx, redisKey).Bytes()
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, time.Time{}, nil
+		}
+		return nil, time.Time{}, err
+	}
+	entry, storedAt, err := decodeHotEntry(data)
+	if err != nil {
+		// Запись в другом формате считаем промахом, она будет перезаписана
+		return nil, time.Time{}, nil
+	}
+	return entry, storedAt, nil
+}
+
+// addHot сохраняет горячую запись, ее копию без версии и снимает блокировку обновления
+func (t *tenantAwareRedisLevel) addHot(ctx context.Context, key hotQueryKey, entry *entcache.Entry, ttl time.Duration) error {
+	cfg := getHotQueryConfig()
+
+	versionedKey, err := t.buildVersionedKey(ctx, key)
+	if err != nil {
+		return err
+	}
+	data, err := encodeHotEntry(entry, time.Now())
+	if err != nil {
+		return err
+	}
+
+	staleKey := t.staleKeyFor(ctx, key)
+	return redis.WithLatencyBudget(ctx, redis.HotPathCacheSet, func(ctx context.Context) error {
+		pipe := t.client.TxPipeline()
+		pipe.Set(ctx, versionedKey, data, ttl)
+		pipe.Set(ctx, staleKey, data, ttl+cfg.maxStale)
+		pipe.Del(ctx, refreshLockKey(versionedKey))
+		_, err := pipe.Exec(ctx)
+		return err
+	})
+}
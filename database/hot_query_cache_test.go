@@ -0,0 +1,191 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"main/utils"
+
+	"ariga.io/entcache"
+	"github.com/alicebob/miniredis/v2"
+	federation "github.com/esemashko/v2-federation"
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// withHotQueryConfig подменяет настройки горячих запросов на время теста
+func withHotQueryConfig(t *testing.T, cfg *hotQueryConfig) {
+	t.Helper()
+	hotQueryConfigOnce.Do(func() {})
+	previous := hotQueryCfg
+	hotQueryCfg = cfg
+	t.Cleanup(func() { hotQueryCfg = previous })
+}
+
+// newHotTestLevel Redis уровень кэша поверх miniredis и контекст тенанта
+func newHotTestLevel(t *testing.T) (*tenantAwareRedisLevel, *miniredis.Miniredis, context.Context) {
+	t.Helper()
+	utils.Logger = zap.NewNop()
+	withHotQueryConfig(t, &hotQueryConfig{
+		signatures: map[string]bool{"files": true},
+		softTTL:    time.Minute,
+		maxStale:   time.Minute,
+	})
+
+	server := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tenantID := uuid.New()
+	ctx := federation.WithContext(context.Background(), &federation.Context{TenantID: &tenantID})
+	return &tenantAwareRedisLevel{client: client}, server, ctx
+}
+
+func hotTestEntry(value string) *entcache.Entry {
+	return &entcache.Entry{Columns: []string{"name"}, Values: [][]driver.Value{{value}}}
+}
+
+// bumpTenantVersion имитирует инвалидацию кэша тенанта
+func bumpTenantVersion(t *testing.T, level *tenantAwareRedisLevel, server *miniredis.Miniredis, ctx context.Context) {
+	t.Helper()
+	_, err := server.Incr(level.versionKeyForTenant(level.tenantIDFromContext(ctx)), 1)
+	require.NoError(t, err)
+}
+
+func TestHotQueryHash(t *testing.T) {
+	withHotQueryConfig(t, &hotQueryConfig{signatures: map[string]bool{"files": true}})
+
+	key, err := hotQueryHash(`SELECT "files"."id" FROM "files" WHERE "files"."tenant_id" = $1`, []any{1})
+	require.NoError(t, err)
+	hotKey, ok := key.(hotQueryKey)
+	require.True(t, ok, "query to a hot table gets a hot key")
+	assert.Contains(t, string(hotKey), "hot:files:")
+
+	other, err := hotQueryHash(`SELECT "files"."id" FROM "files" WHERE "files"."tenant_id" = $1`, []any{2})
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other, "arguments are part of the key")
+
+	key, err = hotQueryHash(`SELECT "tags"."id" FROM "tags"`, nil)
+	require.NoError(t, err)
+	_, ok = key.(hotQueryKey)
+	assert.False(t, ok, "other tables use the regular key")
+}
+
+func TestHotQueryRefreshLock(t *testing.T) {
+	level, server, ctx := newHotTestLevel(t)
+
+	assert.True(t, level.tryRefreshLock(ctx, "key"))
+	assert.False(t, level.tryRefreshLock(ctx, "key"), "lock is held by the first request")
+	assert.True(t, level.tryRefreshLock(ctx, "other"))
+
+	// Упавший обновляющий запрос не держит блокировку дольше TTL
+	server.FastForward(hotQueryRefreshLockTTL)
+	assert.True(t, level.tryRefreshLock(ctx, "key"))
+
+	// Ошибка Redis считается захватом: запрос идет в БД
+	server.Close()
+	assert.True(t, level.tryRefreshLock(ctx, "key"))
+}
+
+func TestHotQueryLockContention(t *testing.T) {
+	level, server, ctx := newHotTestLevel(t)
+	key := hotQueryKey("hot:files:1")
+
+	require.NoError(t, level.addHot(ctx, key, hotTestEntry("previous"), time.Minute))
+	bumpTenantVersion(t, level, server, ctx)
+
+	// После смены версии только первый запрос идет в БД
+	_, err := level.getHot(ctx, key)
+	assert.ErrorIs(t, err, entcache.ErrNotFound)
+
+	// Остальные получают предыдущий результат, пока идет обновление
+	for i := 0; i < 3; i++ {
+		entry, err := level.getHot(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, "previous", entry.Values[0][0])
+	}
+
+	// Результат обновления снимает блокировку и отдается всем
+	require.NoError(t, level.addHot(ctx, key, hotTestEntry("current"), time.Minute))
+	versionedKey, err := level.buildVersionedKey(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, server.Exists(refreshLockKey(versionedKey)))
+
+	entry, err := level.getHot(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "current", entry.Values[0][0])
+}
+
+func TestHotQueryStaleThenRefresh(t *testing.T) {
+	level, server, ctx := newHotTestLevel(t)
+	key := hotQueryKey("hot:files:1")
+
+	versionedKey, err := level.buildVersionedKey(ctx, key)
+	require.NoError(t, err)
+	data, err := encodeHotEntry(hotTestEntry("old"), time.Now().Add(-2*time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, server.Set(versionedKey, string(data)))
+
+	// Запись старше soft-TTL: один запрос обновляет ее, остальные получают текущую
+	_, err = level.getHot(ctx, key)
+	assert.ErrorIs(t, err, entcache.ErrNotFound)
+
+	entry, err := level.getHot(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "old", entry.Values[0][0])
+
+	require.NoError(t, level.addHot(ctx, key, hotTestEntry("new"), time.Minute))
+	entry, err = level.getHot(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "new", entry.Values[0][0])
+}
+
+func TestHotQueryWaitsForRefresh(t *testing.T) {
+	level, server, ctx := newHotTestLevel(t)
+	key := hotQueryKey("hot:files:1")
+
+	// Предыдущий результат старше soft-TTL + max-stale не отдается
+	data, err := encodeHotEntry(hotTestEntry("expired"), time.Now().Add(-3*time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, server.Set(level.staleKeyFor(ctx, key), string(data)))
+
+	_, err = level.getHot(ctx, key)
+	require.ErrorIs(t, err, entcache.ErrNotFound)
+
+	go func() {
+		time.Sleep(3 * hotQueryPollInterval)
+		_ = level.addHot(ctx, key, hotTestEntry("refreshed"), time.Minute)
+	}()
+
+	entry, err := level.getHot(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, "refreshed", entry.Values[0][0])
+}
+
+func TestHotQueryWaitTimeout(t *testing.T) {
+	level, _, ctx := newHotTestLevel(t)
+	key := hotQueryKey("hot:files:1")
+
+	_, err := level.getHot(ctx, key)
+	require.ErrorIs(t, err, entcache.ErrNotFound)
+
+	// Обновляющий запрос не сохранил результат: ожидание ограничено, затем запрос идет в БД
+	started := time.Now()
+	_, err = level.getHot(ctx, key)
+	assert.ErrorIs(t, err, entcache.ErrNotFound)
+	assert.GreaterOrEqual(t, time.Since(started), hotQueryWaitTimeout)
+
+	t.Run("canceled context stops waiting", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, hotQueryPollInterval/2)
+		defer cancel()
+		started := time.Now()
+		_, err := level.getHot(ctx, key)
+		assert.ErrorIs(t, err, entcache.ErrNotFound)
+		assert.Less(t, time.Since(started), hotQueryWaitTimeout)
+	})
+}
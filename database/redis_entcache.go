@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"main/ent"
+	"main/redis"
 	"main/utils"
 	"os"
 	"time"
@@ -68,7 +69,11 @@ func (t *tenantAwareRedisLevel) versionKeyForTenant(tenantID string) string {
 
 func (t *tenantAwareRedisLevel) buildVersionedKey(ctx context.Context, key entcache.Key) (string, error) {
 	tenantID := t.tenantIDFromContext(ctx)
-	ver, err := t.client.Get(ctx, t.versionKeyForTenant(tenantID)).Result()
+	var ver string
+	err := redis.WithLatencyBudget(ctx, redis.HotPathCacheGet, func(ctx context.Context) (err error) {
+		ver, err = t.client.Get(ctx, t.versionKeyForTenant(tenantID)).Result()
+		return err
+	})
 	if err != nil && !errors.Is(err, goredis.Nil) {
 		return "", err
 	}
@@ -80,6 +85,14 @@ func (t *tenantAwareRedisLevel) buildVersionedKey(ctx context.Context, key entca
 
 // Add stores entry in Redis with TTL
 func (t *tenantAwareRedisLevel) Add(ctx context.Context, key entcache.Key, entry *entcache.Entry, ttl time.Duration) error {
+	if ttl <= 0 {
+		// Default TTL is handled by driver-level option; fall back to 5 minutes if not set
+		ttl = 5 * time.Minute
+	}
+	if hotKey, ok := key.(hotQueryKey); ok {
+		return t.addHot(ctx, hotKey, entry, ttl)
+	}
+
 	versionedKey, err := t.buildVersionedKey(ctx, key)
 	if err != nil {
 		return err
@@ -88,20 +101,26 @@ func (t *tenantAwareRedisLevel) Add(ctx context.Context, key entcache.Key, entry
 	if err != nil {
 		return err
 	}
-	if ttl <= 0 {
-		// Default TTL is handled by driver-level option; fall back to 5 minutes if not set
-		ttl = 5 * time.Minute
-	}
-	return t.client.Set(ctx, versionedKey, data, ttl).Err()
+	return redis.WithLatencyBudget(ctx, redis.HotPathCacheSet, func(ctx context.Context) error {
+		return t.client.Set(ctx, versionedKey, data, ttl).Err()
+	})
 }
 
 // Get retrieves entry from Redis
 func (t *tenantAwareRedisLevel) Get(ctx context.Context, key entcache.Key) (*entcache.Entry, error) {
+	if hotKey, ok := key.(hotQueryKey); ok {
+		return t.getHot(ctx, hotKey)
+	}
+
 	versionedKey, err := t.buildVersionedKey(ctx, key)
 	if err != nil {
 		return nil, err
 	}
-	data, err := t.client.Get(ctx, versionedKey).Bytes()
+	var data []byte
+	err = redis.WithLatencyBudget(ctx, redis.HotPathCacheGet, func(ctx context.Context) (err error) {
+		data, err = t.client.Get(ctx, versionedKey).Bytes()
+		return err
+	})
 	if err != nil {
 		if errors.Is(err, goredis.Nil) {
 			return nil, entcache.ErrNotFound
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+	"main/ent"
+)
+
+// OnCommit выполняет fn после успешного коммита транзакции из контекста (ent.NewTxContext).
+// Вне транзакции fn выполняется сразу. Используется сервисами для внешних побочных эффектов
+// (S3, Redis), которые нельзя откатить вместе с БД.
+func OnCommit(ctx context.Context, fn func(ctx context.Context)) {
+	tx := ent.TxFromContext(ctx)
+	if tx == nil {
+		fn(ctx)
+		return
+	}
+
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(txCtx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(txCtx, tx); err != nil {
+				return err
+			}
+			fn(ctx)
+			return nil
+		})
+	})
+}
+
+// OnRollback выполняет fn при откате транзакции из контекста.
+// Вне транзакции ничего не делает - компенсация остается на вызывающем коде.
+func OnRollback(ctx context.Context, fn func(ctx context.Context)) {
+	tx := ent.TxFromContext(ctx)
+	if tx == nil {
+		return
+	}
+
+	tx.OnRollback(func(next ent.Rollbacker) ent.Rollbacker {
+		return ent.RollbackFunc(func(txCtx context.Context, tx *ent.Tx) error {
+			err := next.Rollback(txCtx, tx)
+			fn(ctx)
+			return err
+		})
+	})
+}
+
+// InTx возвращает true, если контекст содержит транзакцию
+func InTx(ctx context.Context) bool {
+	return ent.TxFromContext(ctx) != nil
+}
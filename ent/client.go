@@ -11,11 +11,23 @@ import (
 
 	"main/ent/migrate"
 
+	"main/ent/departmentquota"
 	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/filefavorite"
+	"main/ent/fileset"
+	"main/ent/impersonationgrant"
+	"main/ent/lifecyclerule"
+	"main/ent/operationauditlog"
+	"main/ent/tag"
+	"main/ent/tenantsetting"
+	"main/ent/translationoverride"
+	"main/ent/userstoragelimit"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -24,8 +36,30 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// DepartmentQuota is the client for interacting with the DepartmentQuota builders.
+	DepartmentQuota *DepartmentQuotaClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// FileAuditEvent is the client for interacting with the FileAuditEvent builders.
+	FileAuditEvent *FileAuditEventClient
+	// FileFavorite is the client for interacting with the FileFavorite builders.
+	FileFavorite *FileFavoriteClient
+	// FileSet is the client for interacting with the FileSet builders.
+	FileSet *FileSetClient
+	// ImpersonationGrant is the client for interacting with the ImpersonationGrant builders.
+	ImpersonationGrant *ImpersonationGrantClient
+	// LifecycleRule is the client for interacting with the LifecycleRule builders.
+	LifecycleRule *LifecycleRuleClient
+	// OperationAuditLog is the client for interacting with the OperationAuditLog builders.
+	OperationAuditLog *OperationAuditLogClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TenantSetting is the client for interacting with the TenantSetting builders.
+	TenantSetting *TenantSettingClient
+	// TranslationOverride is the client for interacting with the TranslationOverride builders.
+	TranslationOverride *TranslationOverrideClient
+	// UserStorageLimit is the client for interacting with the UserStorageLimit builders.
+	UserStorageLimit *UserStorageLimitClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -37,7 +71,18 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.DepartmentQuota = NewDepartmentQuotaClient(c.config)
 	c.File = NewFileClient(c.config)
+	c.FileAuditEvent = NewFileAuditEventClient(c.config)
+	c.FileFavorite = NewFileFavoriteClient(c.config)
+	c.FileSet = NewFileSetClient(c.config)
+	c.ImpersonationGrant = NewImpersonationGrantClient(c.config)
+	c.LifecycleRule = NewLifecycleRuleClient(c.config)
+	c.OperationAuditLog = NewOperationAuditLogClient(c.config)
+	c.Tag = NewTagClient(c.config)
+	c.TenantSetting = NewTenantSettingClient(c.config)
+	c.TranslationOverride = NewTranslationOverrideClient(c.config)
+	c.UserStorageLimit = NewUserStorageLimitClient(c.config)
 }
 
 type (
@@ -128,9 +173,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		File:   NewFileClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		DepartmentQuota:     NewDepartmentQuotaClient(cfg),
+		File:                NewFileClient(cfg),
+		FileAuditEvent:      NewFileAuditEventClient(cfg),
+		FileFavorite:        NewFileFavoriteClient(cfg),
+		FileSet:             NewFileSetClient(cfg),
+		ImpersonationGrant:  NewImpersonationGrantClient(cfg),
+		LifecycleRule:       NewLifecycleRuleClient(cfg),
+		OperationAuditLog:   NewOperationAuditLogClient(cfg),
+		Tag:                 NewTagClient(cfg),
+		TenantSetting:       NewTenantSettingClient(cfg),
+		TranslationOverride: NewTranslationOverrideClient(cfg),
+		UserStorageLimit:    NewUserStorageLimitClient(cfg),
 	}, nil
 }
 
@@ -148,16 +204,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		File:   NewFileClient(cfg),
+		ctx:                 ctx,
+		config:              cfg,
+		DepartmentQuota:     NewDepartmentQuotaClient(cfg),
+		File:                NewFileClient(cfg),
+		FileAuditEvent:      NewFileAuditEventClient(cfg),
+		FileFavorite:        NewFileFavoriteClient(cfg),
+		FileSet:             NewFileSetClient(cfg),
+		ImpersonationGrant:  NewImpersonationGrantClient(cfg),
+		LifecycleRule:       NewLifecycleRuleClient(cfg),
+		OperationAuditLog:   NewOperationAuditLogClient(cfg),
+		Tag:                 NewTagClient(cfg),
+		TenantSetting:       NewTenantSettingClient(cfg),
+		TranslationOverride: NewTranslationOverrideClient(cfg),
+		UserStorageLimit:    NewUserStorageLimitClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		File.
+//		DepartmentQuota.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -179,25 +246,194 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.File.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.DepartmentQuota, c.File, c.FileAuditEvent, c.FileFavorite, c.FileSet,
+		c.ImpersonationGrant, c.LifecycleRule, c.OperationAuditLog, c.Tag,
+		c.TenantSetting, c.TranslationOverride, c.UserStorageLimit,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.File.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.DepartmentQuota, c.File, c.FileAuditEvent, c.FileFavorite, c.FileSet,
+		c.ImpersonationGrant, c.LifecycleRule, c.OperationAuditLog, c.Tag,
+		c.TenantSetting, c.TranslationOverride, c.UserStorageLimit,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *DepartmentQuotaMutation:
+		return c.DepartmentQuota.mutate(ctx, m)
 	case *FileMutation:
 		return c.File.mutate(ctx, m)
+	case *FileAuditEventMutation:
+		return c.FileAuditEvent.mutate(ctx, m)
+	case *FileFavoriteMutation:
+		return c.FileFavorite.mutate(ctx, m)
+	case *FileSetMutation:
+		return c.FileSet.mutate(ctx, m)
+	case *ImpersonationGrantMutation:
+		return c.ImpersonationGrant.mutate(ctx, m)
+	case *LifecycleRuleMutation:
+		return c.LifecycleRule.mutate(ctx, m)
+	case *OperationAuditLogMutation:
+		return c.OperationAuditLog.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
+	case *TenantSettingMutation:
+		return c.TenantSetting.mutate(ctx, m)
+	case *TranslationOverrideMutation:
+		return c.TranslationOverride.mutate(ctx, m)
+	case *UserStorageLimitMutation:
+		return c.UserStorageLimit.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// DepartmentQuotaClient is a client for the DepartmentQuota schema.
+type DepartmentQuotaClient struct {
+	config
+}
+
+// NewDepartmentQuotaClient returns a client for the DepartmentQuota from the given config.
+func NewDepartmentQuotaClient(c config) *DepartmentQuotaClient {
+	return &DepartmentQuotaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `departmentquota.Hooks(f(g(h())))`.
+func (c *DepartmentQuotaClient) Use(hooks ...Hook) {
+	c.hooks.DepartmentQuota = append(c.hooks.DepartmentQuota, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `departmentquota.Intercept(f(g(h())))`.
+func (c *DepartmentQuotaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DepartmentQuota = append(c.inters.DepartmentQuota, interceptors...)
+}
+
+// Create returns a builder for creating a DepartmentQuota entity.
+func (c *DepartmentQuotaClient) Create() *DepartmentQuotaCreate {
+	mutation := newDepartmentQuotaMutation(c.config, OpCreate)
+	return &DepartmentQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DepartmentQuota entities.
+func (c *DepartmentQuotaClient) CreateBulk(builders ...*DepartmentQuotaCreate) *DepartmentQuotaCreateBulk {
+	return &DepartmentQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DepartmentQuotaClient) MapCreateBulk(slice any, setFunc func(*DepartmentQuotaCreate, int)) *DepartmentQuotaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DepartmentQuotaCreateBulk{err: fmt.Errorf("calling to DepartmentQuotaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DepartmentQuotaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DepartmentQuotaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DepartmentQuota.
+func (c *DepartmentQuotaClient) Update() *DepartmentQuotaUpdate {
+	mutation := newDepartmentQuotaMutation(c.config, OpUpdate)
+	return &DepartmentQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DepartmentQuotaClient) UpdateOne(_m *DepartmentQuota) *DepartmentQuotaUpdateOne {
+	mutation := newDepartmentQuotaMutation(c.config, OpUpdateOne, withDepartmentQuota(_m))
+	return &DepartmentQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DepartmentQuotaClient) UpdateOneID(id uuid.UUID) *DepartmentQuotaUpdateOne {
+	mutation := newDepartmentQuotaMutation(c.config, OpUpdateOne, withDepartmentQuotaID(id))
+	return &DepartmentQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DepartmentQuota.
+func (c *DepartmentQuotaClient) Delete() *DepartmentQuotaDelete {
+	mutation := newDepartmentQuotaMutation(c.config, OpDelete)
+	return &DepartmentQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DepartmentQuotaClient) DeleteOne(_m *DepartmentQuota) *DepartmentQuotaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DepartmentQuotaClient) DeleteOneID(id uuid.UUID) *DepartmentQuotaDeleteOne {
+	builder := c.Delete().Where(departmentquota.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DepartmentQuotaDeleteOne{builder}
+}
+
+// Query returns a query builder for DepartmentQuota.
+func (c *DepartmentQuotaClient) Query() *DepartmentQuotaQuery {
+	return &DepartmentQuotaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDepartmentQuota},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DepartmentQuota entity by its id.
+func (c *DepartmentQuotaClient) Get(ctx context.Context, id uuid.UUID) (*DepartmentQuota, error) {
+	return c.Query().Where(departmentquota.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DepartmentQuotaClient) GetX(ctx context.Context, id uuid.UUID) *DepartmentQuota {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DepartmentQuotaClient) Hooks() []Hook {
+	hooks := c.hooks.DepartmentQuota
+	return append(hooks[:len(hooks):len(hooks)], departmentquota.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DepartmentQuotaClient) Interceptors() []Interceptor {
+	inters := c.inters.DepartmentQuota
+	return append(inters[:len(inters):len(inters)], departmentquota.Interceptors[:]...)
+}
+
+func (c *DepartmentQuotaClient) mutate(ctx context.Context, m *DepartmentQuotaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DepartmentQuotaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DepartmentQuotaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DepartmentQuotaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DepartmentQuotaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DepartmentQuota mutation op: %q", m.Op())
+	}
+}
+
 // FileClient is a client for the File schema.
 type FileClient struct {
 	config
@@ -306,6 +542,38 @@ func (c *FileClient) GetX(ctx context.Context, id uuid.UUID) *File {
 	return obj
 }
 
+// QueryTags queries the tags edge of a File.
+func (c *FileClient) QueryTags(_m *File) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, file.TagsTable, file.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFavorites queries the favorites edge of a File.
+func (c *FileClient) QueryFavorites(_m *File) *FileFavoriteQuery {
+	query := (&FileFavoriteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(filefavorite.Table, filefavorite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.FavoritesTable, file.FavoritesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *FileClient) Hooks() []Hook {
 	hooks := c.hooks.File
@@ -333,12 +601,1398 @@ func (c *FileClient) mutate(ctx context.Context, m *FileMutation) (Value, error)
 	}
 }
 
+// FileAuditEventClient is a client for the FileAuditEvent schema.
+type FileAuditEventClient struct {
+	config
+}
+
+// NewFileAuditEventClient returns a client for the FileAuditEvent from the given config.
+func NewFileAuditEventClient(c config) *FileAuditEventClient {
+	return &FileAuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fileauditevent.Hooks(f(g(h())))`.
+func (c *FileAuditEventClient) Use(hooks ...Hook) {
+	c.hooks.FileAuditEvent = append(c.hooks.FileAuditEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fileauditevent.Intercept(f(g(h())))`.
+func (c *FileAuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileAuditEvent = append(c.inters.FileAuditEvent, interceptors...)
+}
+
+// Create returns a builder for creating a FileAuditEvent entity.
+func (c *FileAuditEventClient) Create() *FileAuditEventCreate {
+	mutation := newFileAuditEventMutation(c.config, OpCreate)
+	return &FileAuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FileAuditEvent entities.
+func (c *FileAuditEventClient) CreateBulk(builders ...*FileAuditEventCreate) *FileAuditEventCreateBulk {
+	return &FileAuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileAuditEventClient) MapCreateBulk(slice any, setFunc func(*FileAuditEventCreate, int)) *FileAuditEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileAuditEventCreateBulk{err: fmt.Errorf("calling to FileAuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileAuditEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileAuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FileAuditEvent.
+func (c *FileAuditEventClient) Update() *FileAuditEventUpdate {
+	mutation := newFileAuditEventMutation(c.config, OpUpdate)
+	return &FileAuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileAuditEventClient) UpdateOne(_m *FileAuditEvent) *FileAuditEventUpdateOne {
+	mutation := newFileAuditEventMutation(c.config, OpUpdateOne, withFileAuditEvent(_m))
+	return &FileAuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileAuditEventClient) UpdateOneID(id uuid.UUID) *FileAuditEventUpdateOne {
+	mutation := newFileAuditEventMutation(c.config, OpUpdateOne, withFileAuditEventID(id))
+	return &FileAuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileAuditEvent.
+func (c *FileAuditEventClient) Delete() *FileAuditEventDelete {
+	mutation := newFileAuditEventMutation(c.config, OpDelete)
+	return &FileAuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileAuditEventClient) DeleteOne(_m *FileAuditEvent) *FileAuditEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileAuditEventClient) DeleteOneID(id uuid.UUID) *FileAuditEventDeleteOne {
+	builder := c.Delete().Where(fileauditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileAuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for FileAuditEvent.
+func (c *FileAuditEventClient) Query() *FileAuditEventQuery {
+	return &FileAuditEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileAuditEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileAuditEvent entity by its id.
+func (c *FileAuditEventClient) Get(ctx context.Context, id uuid.UUID) (*FileAuditEvent, error) {
+	return c.Query().Where(fileauditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileAuditEventClient) GetX(ctx context.Context, id uuid.UUID) *FileAuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FileAuditEventClient) Hooks() []Hook {
+	hooks := c.hooks.FileAuditEvent
+	return append(hooks[:len(hooks):len(hooks)], fileauditevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileAuditEventClient) Interceptors() []Interceptor {
+	inters := c.inters.FileAuditEvent
+	return append(inters[:len(inters):len(inters)], fileauditevent.Interceptors[:]...)
+}
+
+func (c *FileAuditEventClient) mutate(ctx context.Context, m *FileAuditEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileAuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileAuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileAuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileAuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileAuditEvent mutation op: %q", m.Op())
+	}
+}
+
+// FileFavoriteClient is a client for the FileFavorite schema.
+type FileFavoriteClient struct {
+	config
+}
+
+// NewFileFavoriteClient returns a client for the FileFavorite from the given config.
+func NewFileFavoriteClient(c config) *FileFavoriteClient {
+	return &FileFavoriteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `filefavorite.Hooks(f(g(h())))`.
+func (c *FileFavoriteClient) Use(hooks ...Hook) {
+	c.hooks.FileFavorite = append(c.hooks.FileFavorite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `filefavorite.Intercept(f(g(h())))`.
+func (c *FileFavoriteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileFavorite = append(c.inters.FileFavorite, interceptors...)
+}
+
+// Create returns a builder for creating a FileFavorite entity.
+func (c *FileFavoriteClient) Create() *FileFavoriteCreate {
+	mutation := newFileFavoriteMutation(c.config, OpCreate)
+	return &FileFavoriteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FileFavorite entities.
+func (c *FileFavoriteClient) CreateBulk(builders ...*FileFavoriteCreate) *FileFavoriteCreateBulk {
+	return &FileFavoriteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileFavoriteClient) MapCreateBulk(slice any, setFunc func(*FileFavoriteCreate, int)) *FileFavoriteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileFavoriteCreateBulk{err: fmt.Errorf("calling to FileFavoriteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileFavoriteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileFavoriteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FileFavorite.
+func (c *FileFavoriteClient) Update() *FileFavoriteUpdate {
+	mutation := newFileFavoriteMutation(c.config, OpUpdate)
+	return &FileFavoriteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileFavoriteClient) UpdateOne(_m *FileFavorite) *FileFavoriteUpdateOne {
+	mutation := newFileFavoriteMutation(c.config, OpUpdateOne, withFileFavorite(_m))
+	return &FileFavoriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileFavoriteClient) UpdateOneID(id uuid.UUID) *FileFavoriteUpdateOne {
+	mutation := newFileFavoriteMutation(c.config, OpUpdateOne, withFileFavoriteID(id))
+	return &FileFavoriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileFavorite.
+func (c *FileFavoriteClient) Delete() *FileFavoriteDelete {
+	mutation := newFileFavoriteMutation(c.config, OpDelete)
+	return &FileFavoriteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileFavoriteClient) DeleteOne(_m *FileFavorite) *FileFavoriteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileFavoriteClient) DeleteOneID(id uuid.UUID) *FileFavoriteDeleteOne {
+	builder := c.Delete().Where(filefavorite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileFavoriteDeleteOne{builder}
+}
+
+// Query returns a query builder for FileFavorite.
+func (c *FileFavoriteClient) Query() *FileFavoriteQuery {
+	return &FileFavoriteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileFavorite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileFavorite entity by its id.
+func (c *FileFavoriteClient) Get(ctx context.Context, id uuid.UUID) (*FileFavorite, error) {
+	return c.Query().Where(filefavorite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileFavoriteClient) GetX(ctx context.Context, id uuid.UUID) *FileFavorite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFile queries the file edge of a FileFavorite.
+func (c *FileFavoriteClient) QueryFile(_m *FileFavorite) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filefavorite.Table, filefavorite.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, filefavorite.FileTable, filefavorite.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FileFavoriteClient) Hooks() []Hook {
+	hooks := c.hooks.FileFavorite
+	return append(hooks[:len(hooks):len(hooks)], filefavorite.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileFavoriteClient) Interceptors() []Interceptor {
+	inters := c.inters.FileFavorite
+	return append(inters[:len(inters):len(inters)], filefavorite.Interceptors[:]...)
+}
+
+func (c *FileFavoriteClient) mutate(ctx context.Context, m *FileFavoriteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileFavoriteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileFavoriteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileFavoriteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileFavoriteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileFavorite mutation op: %q", m.Op())
+	}
+}
+
+// FileSetClient is a client for the FileSet schema.
+type FileSetClient struct {
+	config
+}
+
+// NewFileSetClient returns a client for the FileSet from the given config.
+func NewFileSetClient(c config) *FileSetClient {
+	return &FileSetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fileset.Hooks(f(g(h())))`.
+func (c *FileSetClient) Use(hooks ...Hook) {
+	c.hooks.FileSet = append(c.hooks.FileSet, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fileset.Intercept(f(g(h())))`.
+func (c *FileSetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileSet = append(c.inters.FileSet, interceptors...)
+}
+
+// Create returns a builder for creating a FileSet entity.
+func (c *FileSetClient) Create() *FileSetCreate {
+	mutation := newFileSetMutation(c.config, OpCreate)
+	return &FileSetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FileSet entities.
+func (c *FileSetClient) CreateBulk(builders ...*FileSetCreate) *FileSetCreateBulk {
+	return &FileSetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileSetClient) MapCreateBulk(slice any, setFunc func(*FileSetCreate, int)) *FileSetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileSetCreateBulk{err: fmt.Errorf("calling to FileSetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileSetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileSetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FileSet.
+func (c *FileSetClient) Update() *FileSetUpdate {
+	mutation := newFileSetMutation(c.config, OpUpdate)
+	return &FileSetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileSetClient) UpdateOne(_m *FileSet) *FileSetUpdateOne {
+	mutation := newFileSetMutation(c.config, OpUpdateOne, withFileSet(_m))
+	return &FileSetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileSetClient) UpdateOneID(id uuid.UUID) *FileSetUpdateOne {
+	mutation := newFileSetMutation(c.config, OpUpdateOne, withFileSetID(id))
+	return &FileSetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileSet.
+func (c *FileSetClient) Delete() *FileSetDelete {
+	mutation := newFileSetMutation(c.config, OpDelete)
+	return &FileSetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileSetClient) DeleteOne(_m *FileSet) *FileSetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileSetClient) DeleteOneID(id uuid.UUID) *FileSetDeleteOne {
+	builder := c.Delete().Where(fileset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileSetDeleteOne{builder}
+}
+
+// Query returns a query builder for FileSet.
+func (c *FileSetClient) Query() *FileSetQuery {
+	return &FileSetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileSet},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileSet entity by its id.
+func (c *FileSetClient) Get(ctx context.Context, id uuid.UUID) (*FileSet, error) {
+	return c.Query().Where(fileset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileSetClient) GetX(ctx context.Context, id uuid.UUID) *FileSet {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FileSetClient) Hooks() []Hook {
+	hooks := c.hooks.FileSet
+	return append(hooks[:len(hooks):len(hooks)], fileset.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileSetClient) Interceptors() []Interceptor {
+	inters := c.inters.FileSet
+	return append(inters[:len(inters):len(inters)], fileset.Interceptors[:]...)
+}
+
+func (c *FileSetClient) mutate(ctx context.Context, m *FileSetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileSetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileSetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileSetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileSetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileSet mutation op: %q", m.Op())
+	}
+}
+
+// ImpersonationGrantClient is a client for the ImpersonationGrant schema.
+type ImpersonationGrantClient struct {
+	config
+}
+
+// NewImpersonationGrantClient returns a client for the ImpersonationGrant from the given config.
+func NewImpersonationGrantClient(c config) *ImpersonationGrantClient {
+	return &ImpersonationGrantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `impersonationgrant.Hooks(f(g(h())))`.
+func (c *ImpersonationGrantClient) Use(hooks ...Hook) {
+	c.hooks.ImpersonationGrant = append(c.hooks.ImpersonationGrant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `impersonationgrant.Intercept(f(g(h())))`.
+func (c *ImpersonationGrantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ImpersonationGrant = append(c.inters.ImpersonationGrant, interceptors...)
+}
+
+// Create returns a builder for creating a ImpersonationGrant entity.
+func (c *ImpersonationGrantClient) Create() *ImpersonationGrantCreate {
+	mutation := newImpersonationGrantMutation(c.config, OpCreate)
+	return &ImpersonationGrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ImpersonationGrant entities.
+func (c *ImpersonationGrantClient) CreateBulk(builders ...*ImpersonationGrantCreate) *ImpersonationGrantCreateBulk {
+	return &ImpersonationGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImpersonationGrantClient) MapCreateBulk(slice any, setFunc func(*ImpersonationGrantCreate, int)) *ImpersonationGrantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImpersonationGrantCreateBulk{err: fmt.Errorf("calling to ImpersonationGrantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImpersonationGrantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImpersonationGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ImpersonationGrant.
+func (c *ImpersonationGrantClient) Update() *ImpersonationGrantUpdate {
+	mutation := newImpersonationGrantMutation(c.config, OpUpdate)
+	return &ImpersonationGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImpersonationGrantClient) UpdateOne(_m *ImpersonationGrant) *ImpersonationGrantUpdateOne {
+	mutation := newImpersonationGrantMutation(c.config, OpUpdateOne, withImpersonationGrant(_m))
+	return &ImpersonationGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImpersonationGrantClient) UpdateOneID(id uuid.UUID) *ImpersonationGrantUpdateOne {
+	mutation := newImpersonationGrantMutation(c.config, OpUpdateOne, withImpersonationGrantID(id))
+	return &ImpersonationGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ImpersonationGrant.
+func (c *ImpersonationGrantClient) Delete() *ImpersonationGrantDelete {
+	mutation := newImpersonationGrantMutation(c.config, OpDelete)
+	return &ImpersonationGrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImpersonationGrantClient) DeleteOne(_m *ImpersonationGrant) *ImpersonationGrantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImpersonationGrantClient) DeleteOneID(id uuid.UUID) *ImpersonationGrantDeleteOne {
+	builder := c.Delete().Where(impersonationgrant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImpersonationGrantDeleteOne{builder}
+}
+
+// Query returns a query builder for ImpersonationGrant.
+func (c *ImpersonationGrantClient) Query() *ImpersonationGrantQuery {
+	return &ImpersonationGrantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImpersonationGrant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ImpersonationGrant entity by its id.
+func (c *ImpersonationGrantClient) Get(ctx context.Context, id uuid.UUID) (*ImpersonationGrant, error) {
+	return c.Query().Where(impersonationgrant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImpersonationGrantClient) GetX(ctx context.Context, id uuid.UUID) *ImpersonationGrant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ImpersonationGrantClient) Hooks() []Hook {
+	hooks := c.hooks.ImpersonationGrant
+	return append(hooks[:len(hooks):len(hooks)], impersonationgrant.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImpersonationGrantClient) Interceptors() []Interceptor {
+	inters := c.inters.ImpersonationGrant
+	return append(inters[:len(inters):len(inters)], impersonationgrant.Interceptors[:]...)
+}
+
+func (c *ImpersonationGrantClient) mutate(ctx context.Context, m *ImpersonationGrantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImpersonationGrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImpersonationGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImpersonationGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImpersonationGrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ImpersonationGrant mutation op: %q", m.Op())
+	}
+}
+
+// LifecycleRuleClient is a client for the LifecycleRule schema.
+type LifecycleRuleClient struct {
+	config
+}
+
+// NewLifecycleRuleClient returns a client for the LifecycleRule from the given config.
+func NewLifecycleRuleClient(c config) *LifecycleRuleClient {
+	return &LifecycleRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lifecyclerule.Hooks(f(g(h())))`.
+func (c *LifecycleRuleClient) Use(hooks ...Hook) {
+	c.hooks.LifecycleRule = append(c.hooks.LifecycleRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lifecyclerule.Intercept(f(g(h())))`.
+func (c *LifecycleRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LifecycleRule = append(c.inters.LifecycleRule, interceptors...)
+}
+
+// Create returns a builder for creating a LifecycleRule entity.
+func (c *LifecycleRuleClient) Create() *LifecycleRuleCreate {
+	mutation := newLifecycleRuleMutation(c.config, OpCreate)
+	return &LifecycleRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LifecycleRule entities.
+func (c *LifecycleRuleClient) CreateBulk(builders ...*LifecycleRuleCreate) *LifecycleRuleCreateBulk {
+	return &LifecycleRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LifecycleRuleClient) MapCreateBulk(slice any, setFunc func(*LifecycleRuleCreate, int)) *LifecycleRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LifecycleRuleCreateBulk{err: fmt.Errorf("calling to LifecycleRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LifecycleRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LifecycleRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LifecycleRule.
+func (c *LifecycleRuleClient) Update() *LifecycleRuleUpdate {
+	mutation := newLifecycleRuleMutation(c.config, OpUpdate)
+	return &LifecycleRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LifecycleRuleClient) UpdateOne(_m *LifecycleRule) *LifecycleRuleUpdateOne {
+	mutation := newLifecycleRuleMutation(c.config, OpUpdateOne, withLifecycleRule(_m))
+	return &LifecycleRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LifecycleRuleClient) UpdateOneID(id uuid.UUID) *LifecycleRuleUpdateOne {
+	mutation := newLifecycleRuleMutation(c.config, OpUpdateOne, withLifecycleRuleID(id))
+	return &LifecycleRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LifecycleRule.
+func (c *LifecycleRuleClient) Delete() *LifecycleRuleDelete {
+	mutation := newLifecycleRuleMutation(c.config, OpDelete)
+	return &LifecycleRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LifecycleRuleClient) DeleteOne(_m *LifecycleRule) *LifecycleRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LifecycleRuleClient) DeleteOneID(id uuid.UUID) *LifecycleRuleDeleteOne {
+	builder := c.Delete().Where(lifecyclerule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LifecycleRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for LifecycleRule.
+func (c *LifecycleRuleClient) Query() *LifecycleRuleQuery {
+	return &LifecycleRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLifecycleRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LifecycleRule entity by its id.
+func (c *LifecycleRuleClient) Get(ctx context.Context, id uuid.UUID) (*LifecycleRule, error) {
+	return c.Query().Where(lifecyclerule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LifecycleRuleClient) GetX(ctx context.Context, id uuid.UUID) *LifecycleRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LifecycleRuleClient) Hooks() []Hook {
+	hooks := c.hooks.LifecycleRule
+	return append(hooks[:len(hooks):len(hooks)], lifecyclerule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LifecycleRuleClient) Interceptors() []Interceptor {
+	inters := c.inters.LifecycleRule
+	return append(inters[:len(inters):len(inters)], lifecyclerule.Interceptors[:]...)
+}
+
+func (c *LifecycleRuleClient) mutate(ctx context.Context, m *LifecycleRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LifecycleRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LifecycleRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LifecycleRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LifecycleRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LifecycleRule mutation op: %q", m.Op())
+	}
+}
+
+// OperationAuditLogClient is a client for the OperationAuditLog schema.
+type OperationAuditLogClient struct {
+	config
+}
+
+// NewOperationAuditLogClient returns a client for the OperationAuditLog from the given config.
+func NewOperationAuditLogClient(c config) *OperationAuditLogClient {
+	return &OperationAuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `operationauditlog.Hooks(f(g(h())))`.
+func (c *OperationAuditLogClient) Use(hooks ...Hook) {
+	c.hooks.OperationAuditLog = append(c.hooks.OperationAuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `operationauditlog.Intercept(f(g(h())))`.
+func (c *OperationAuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OperationAuditLog = append(c.inters.OperationAuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a OperationAuditLog entity.
+func (c *OperationAuditLogClient) Create() *OperationAuditLogCreate {
+	mutation := newOperationAuditLogMutation(c.config, OpCreate)
+	return &OperationAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OperationAuditLog entities.
+func (c *OperationAuditLogClient) CreateBulk(builders ...*OperationAuditLogCreate) *OperationAuditLogCreateBulk {
+	return &OperationAuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OperationAuditLogClient) MapCreateBulk(slice any, setFunc func(*OperationAuditLogCreate, int)) *OperationAuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OperationAuditLogCreateBulk{err: fmt.Errorf("calling to OperationAuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OperationAuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OperationAuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OperationAuditLog.
+func (c *OperationAuditLogClient) Update() *OperationAuditLogUpdate {
+	mutation := newOperationAuditLogMutation(c.config, OpUpdate)
+	return &OperationAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OperationAuditLogClient) UpdateOne(_m *OperationAuditLog) *OperationAuditLogUpdateOne {
+	mutation := newOperationAuditLogMutation(c.config, OpUpdateOne, withOperationAuditLog(_m))
+	return &OperationAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OperationAuditLogClient) UpdateOneID(id uuid.UUID) *OperationAuditLogUpdateOne {
+	mutation := newOperationAuditLogMutation(c.config, OpUpdateOne, withOperationAuditLogID(id))
+	return &OperationAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OperationAuditLog.
+func (c *OperationAuditLogClient) Delete() *OperationAuditLogDelete {
+	mutation := newOperationAuditLogMutation(c.config, OpDelete)
+	return &OperationAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OperationAuditLogClient) DeleteOne(_m *OperationAuditLog) *OperationAuditLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OperationAuditLogClient) DeleteOneID(id uuid.UUID) *OperationAuditLogDeleteOne {
+	builder := c.Delete().Where(operationauditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OperationAuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for OperationAuditLog.
+func (c *OperationAuditLogClient) Query() *OperationAuditLogQuery {
+	return &OperationAuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOperationAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OperationAuditLog entity by its id.
+func (c *OperationAuditLogClient) Get(ctx context.Context, id uuid.UUID) (*OperationAuditLog, error) {
+	return c.Query().Where(operationauditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OperationAuditLogClient) GetX(ctx context.Context, id uuid.UUID) *OperationAuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OperationAuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.OperationAuditLog
+	return append(hooks[:len(hooks):len(hooks)], operationauditlog.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OperationAuditLogClient) Interceptors() []Interceptor {
+	inters := c.inters.OperationAuditLog
+	return append(inters[:len(inters):len(inters)], operationauditlog.Interceptors[:]...)
+}
+
+func (c *OperationAuditLogClient) mutate(ctx context.Context, m *OperationAuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OperationAuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OperationAuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OperationAuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OperationAuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OperationAuditLog mutation op: %q", m.Op())
+	}
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
+}
+
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(_m *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(_m))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagClient) UpdateOneID(id uuid.UUID) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagClient) DeleteOne(_m *Tag) *TagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagClient) DeleteOneID(id uuid.UUID) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDeleteOne{builder}
+}
+
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id uuid.UUID) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagClient) GetX(ctx context.Context, id uuid.UUID) *Tag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFiles queries the files edge of a Tag.
+func (c *TagClient) QueryFiles(_m *Tag) *FileQuery {
+	query := (&FileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tag.FilesTable, tag.FilesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagClient) Hooks() []Hook {
+	hooks := c.hooks.Tag
+	return append(hooks[:len(hooks):len(hooks)], tag.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagClient) Interceptors() []Interceptor {
+	inters := c.inters.Tag
+	return append(inters[:len(inters):len(inters)], tag.Interceptors[:]...)
+}
+
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
+	}
+}
+
+// TenantSettingClient is a client for the TenantSetting schema.
+type TenantSettingClient struct {
+	config
+}
+
+// NewTenantSettingClient returns a client for the TenantSetting from the given config.
+func NewTenantSettingClient(c config) *TenantSettingClient {
+	return &TenantSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantsetting.Hooks(f(g(h())))`.
+func (c *TenantSettingClient) Use(hooks ...Hook) {
+	c.hooks.TenantSetting = append(c.hooks.TenantSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantsetting.Intercept(f(g(h())))`.
+func (c *TenantSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantSetting = append(c.inters.TenantSetting, interceptors...)
+}
+
+// Create returns a builder for creating a TenantSetting entity.
+func (c *TenantSettingClient) Create() *TenantSettingCreate {
+	mutation := newTenantSettingMutation(c.config, OpCreate)
+	return &TenantSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantSetting entities.
+func (c *TenantSettingClient) CreateBulk(builders ...*TenantSettingCreate) *TenantSettingCreateBulk {
+	return &TenantSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantSettingClient) MapCreateBulk(slice any, setFunc func(*TenantSettingCreate, int)) *TenantSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantSettingCreateBulk{err: fmt.Errorf("calling to TenantSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantSetting.
+func (c *TenantSettingClient) Update() *TenantSettingUpdate {
+	mutation := newTenantSettingMutation(c.config, OpUpdate)
+	return &TenantSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantSettingClient) UpdateOne(_m *TenantSetting) *TenantSettingUpdateOne {
+	mutation := newTenantSettingMutation(c.config, OpUpdateOne, withTenantSetting(_m))
+	return &TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantSettingClient) UpdateOneID(id uuid.UUID) *TenantSettingUpdateOne {
+	mutation := newTenantSettingMutation(c.config, OpUpdateOne, withTenantSettingID(id))
+	return &TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantSetting.
+func (c *TenantSettingClient) Delete() *TenantSettingDelete {
+	mutation := newTenantSettingMutation(c.config, OpDelete)
+	return &TenantSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantSettingClient) DeleteOne(_m *TenantSetting) *TenantSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantSettingClient) DeleteOneID(id uuid.UUID) *TenantSettingDeleteOne {
+	builder := c.Delete().Where(tenantsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantSetting.
+func (c *TenantSettingClient) Query() *TenantSettingQuery {
+	return &TenantSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantSetting entity by its id.
+func (c *TenantSettingClient) Get(ctx context.Context, id uuid.UUID) (*TenantSetting, error) {
+	return c.Query().Where(tenantsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantSettingClient) GetX(ctx context.Context, id uuid.UUID) *TenantSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TenantSettingClient) Hooks() []Hook {
+	hooks := c.hooks.TenantSetting
+	return append(hooks[:len(hooks):len(hooks)], tenantsetting.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantSettingClient) Interceptors() []Interceptor {
+	inters := c.inters.TenantSetting
+	return append(inters[:len(inters):len(inters)], tenantsetting.Interceptors[:]...)
+}
+
+func (c *TenantSettingClient) mutate(ctx context.Context, m *TenantSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TenantSetting mutation op: %q", m.Op())
+	}
+}
+
+// TranslationOverrideClient is a client for the TranslationOverride schema.
+type TranslationOverrideClient struct {
+	config
+}
+
+// NewTranslationOverrideClient returns a client for the TranslationOverride from the given config.
+func NewTranslationOverrideClient(c config) *TranslationOverrideClient {
+	return &TranslationOverrideClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `translationoverride.Hooks(f(g(h())))`.
+func (c *TranslationOverrideClient) Use(hooks ...Hook) {
+	c.hooks.TranslationOverride = append(c.hooks.TranslationOverride, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `translationoverride.Intercept(f(g(h())))`.
+func (c *TranslationOverrideClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TranslationOverride = append(c.inters.TranslationOverride, interceptors...)
+}
+
+// Create returns a builder for creating a TranslationOverride entity.
+func (c *TranslationOverrideClient) Create() *TranslationOverrideCreate {
+	mutation := newTranslationOverrideMutation(c.config, OpCreate)
+	return &TranslationOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TranslationOverride entities.
+func (c *TranslationOverrideClient) CreateBulk(builders ...*TranslationOverrideCreate) *TranslationOverrideCreateBulk {
+	return &TranslationOverrideCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TranslationOverrideClient) MapCreateBulk(slice any, setFunc func(*TranslationOverrideCreate, int)) *TranslationOverrideCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TranslationOverrideCreateBulk{err: fmt.Errorf("calling to TranslationOverrideClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TranslationOverrideCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TranslationOverrideCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TranslationOverride.
+func (c *TranslationOverrideClient) Update() *TranslationOverrideUpdate {
+	mutation := newTranslationOverrideMutation(c.config, OpUpdate)
+	return &TranslationOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TranslationOverrideClient) UpdateOne(_m *TranslationOverride) *TranslationOverrideUpdateOne {
+	mutation := newTranslationOverrideMutation(c.config, OpUpdateOne, withTranslationOverride(_m))
+	return &TranslationOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TranslationOverrideClient) UpdateOneID(id uuid.UUID) *TranslationOverrideUpdateOne {
+	mutation := newTranslationOverrideMutation(c.config, OpUpdateOne, withTranslationOverrideID(id))
+	return &TranslationOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TranslationOverride.
+func (c *TranslationOverrideClient) Delete() *TranslationOverrideDelete {
+	mutation := newTranslationOverrideMutation(c.config, OpDelete)
+	return &TranslationOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TranslationOverrideClient) DeleteOne(_m *TranslationOverride) *TranslationOverrideDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TranslationOverrideClient) DeleteOneID(id uuid.UUID) *TranslationOverrideDeleteOne {
+	builder := c.Delete().Where(translationoverride.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TranslationOverrideDeleteOne{builder}
+}
+
+// Query returns a query builder for TranslationOverride.
+func (c *TranslationOverrideClient) Query() *TranslationOverrideQuery {
+	return &TranslationOverrideQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTranslationOverride},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TranslationOverride entity by its id.
+func (c *TranslationOverrideClient) Get(ctx context.Context, id uuid.UUID) (*TranslationOverride, error) {
+	return c.Query().Where(translationoverride.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TranslationOverrideClient) GetX(ctx context.Context, id uuid.UUID) *TranslationOverride {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TranslationOverrideClient) Hooks() []Hook {
+	hooks := c.hooks.TranslationOverride
+	return append(hooks[:len(hooks):len(hooks)], translationoverride.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TranslationOverrideClient) Interceptors() []Interceptor {
+	inters := c.inters.TranslationOverride
+	return append(inters[:len(inters):len(inters)], translationoverride.Interceptors[:]...)
+}
+
+func (c *TranslationOverrideClient) mutate(ctx context.Context, m *TranslationOverrideMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TranslationOverrideCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TranslationOverrideUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TranslationOverrideUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TranslationOverrideDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TranslationOverride mutation op: %q", m.Op())
+	}
+}
+
+// UserStorageLimitClient is a client for the UserStorageLimit schema.
+type UserStorageLimitClient struct {
+	config
+}
+
+// NewUserStorageLimitClient returns a client for the UserStorageLimit from the given config.
+func NewUserStorageLimitClient(c config) *UserStorageLimitClient {
+	return &UserStorageLimitClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userstoragelimit.Hooks(f(g(h())))`.
+func (c *UserStorageLimitClient) Use(hooks ...Hook) {
+	c.hooks.UserStorageLimit = append(c.hooks.UserStorageLimit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userstoragelimit.Intercept(f(g(h())))`.
+func (c *UserStorageLimitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserStorageLimit = append(c.inters.UserStorageLimit, interceptors...)
+}
+
+// Create returns a builder for creating a UserStorageLimit entity.
+func (c *UserStorageLimitClient) Create() *UserStorageLimitCreate {
+	mutation := newUserStorageLimitMutation(c.config, OpCreate)
+	return &UserStorageLimitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserStorageLimit entities.
+func (c *UserStorageLimitClient) CreateBulk(builders ...*UserStorageLimitCreate) *UserStorageLimitCreateBulk {
+	return &UserStorageLimitCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserStorageLimitClient) MapCreateBulk(slice any, setFunc func(*UserStorageLimitCreate, int)) *UserStorageLimitCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserStorageLimitCreateBulk{err: fmt.Errorf("calling to UserStorageLimitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserStorageLimitCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserStorageLimitCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserStorageLimit.
+func (c *UserStorageLimitClient) Update() *UserStorageLimitUpdate {
+	mutation := newUserStorageLimitMutation(c.config, OpUpdate)
+	return &UserStorageLimitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserStorageLimitClient) UpdateOne(_m *UserStorageLimit) *UserStorageLimitUpdateOne {
+	mutation := newUserStorageLimitMutation(c.config, OpUpdateOne, withUserStorageLimit(_m))
+	return &UserStorageLimitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserStorageLimitClient) UpdateOneID(id uuid.UUID) *UserStorageLimitUpdateOne {
+	mutation := newUserStorageLimitMutation(c.config, OpUpdateOne, withUserStorageLimitID(id))
+	return &UserStorageLimitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserStorageLimit.
+func (c *UserStorageLimitClient) Delete() *UserStorageLimitDelete {
+	mutation := newUserStorageLimitMutation(c.config, OpDelete)
+	return &UserStorageLimitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserStorageLimitClient) DeleteOne(_m *UserStorageLimit) *UserStorageLimitDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserStorageLimitClient) DeleteOneID(id uuid.UUID) *UserStorageLimitDeleteOne {
+	builder := c.Delete().Where(userstoragelimit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserStorageLimitDeleteOne{builder}
+}
+
+// Query returns a query builder for UserStorageLimit.
+func (c *UserStorageLimitClient) Query() *UserStorageLimitQuery {
+	return &UserStorageLimitQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserStorageLimit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserStorageLimit entity by its id.
+func (c *UserStorageLimitClient) Get(ctx context.Context, id uuid.UUID) (*UserStorageLimit, error) {
+	return c.Query().Where(userstoragelimit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserStorageLimitClient) GetX(ctx context.Context, id uuid.UUID) *UserStorageLimit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserStorageLimitClient) Hooks() []Hook {
+	hooks := c.hooks.UserStorageLimit
+	return append(hooks[:len(hooks):len(hooks)], userstoragelimit.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserStorageLimitClient) Interceptors() []Interceptor {
+	inters := c.inters.UserStorageLimit
+	return append(inters[:len(inters):len(inters)], userstoragelimit.Interceptors[:]...)
+}
+
+func (c *UserStorageLimitClient) mutate(ctx context.Context, m *UserStorageLimitMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserStorageLimitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserStorageLimitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserStorageLimitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserStorageLimitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserStorageLimit mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		File []ent.Hook
+		DepartmentQuota, File, FileAuditEvent, FileFavorite, FileSet,
+		ImpersonationGrant, LifecycleRule, OperationAuditLog, Tag, TenantSetting,
+		TranslationOverride, UserStorageLimit []ent.Hook
 	}
 	inters struct {
-		File []ent.Interceptor
+		DepartmentQuota, File, FileAuditEvent, FileFavorite, FileSet,
+		ImpersonationGrant, LifecycleRule, OperationAuditLog, Tag, TenantSetting,
+		TranslationOverride, UserStorageLimit []ent.Interceptor
 	}
 )
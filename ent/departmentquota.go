@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"main/ent/departmentquota"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DepartmentQuota is the model entity for the DepartmentQuota schema.
+type DepartmentQuota struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Отдел из контекста федерации (DepartmentIDs)
+	DepartmentID uuid.UUID `json:"department_id,omitempty"`
+	// Лимит хранилища отдела в байтах
+	LimitBytes   int64 `json:"limit_bytes,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DepartmentQuota) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case departmentquota.FieldLimitBytes:
+			values[i] = new(sql.NullInt64)
+		case departmentquota.FieldCreateTime, departmentquota.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case departmentquota.FieldID, departmentquota.FieldTenantID, departmentquota.FieldDepartmentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DepartmentQuota fields.
+func (_m *DepartmentQuota) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case departmentquota.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case departmentquota.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case departmentquota.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case departmentquota.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case departmentquota.FieldDepartmentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field department_id", values[i])
+			} else if value != nil {
+				_m.DepartmentID = *value
+			}
+		case departmentquota.FieldLimitBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field limit_bytes", values[i])
+			} else if value.Valid {
+				_m.LimitBytes = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DepartmentQuota.
+// This includes values selected through modifiers, order, etc.
+func (_m *DepartmentQuota) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DepartmentQuota.
+// Note that you need to call DepartmentQuota.Unwrap() before calling this method if this DepartmentQuota
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DepartmentQuota) Update() *DepartmentQuotaUpdateOne {
+	return NewDepartmentQuotaClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DepartmentQuota entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DepartmentQuota) Unwrap() *DepartmentQuota {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DepartmentQuota is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DepartmentQuota) String() string {
+	var builder strings.Builder
+	builder.WriteString("DepartmentQuota(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("department_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DepartmentID))
+	builder.WriteString(", ")
+	builder.WriteString("limit_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LimitBytes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DepartmentQuotaSlice is a parsable slice of DepartmentQuota.
+type DepartmentQuotaSlice []*DepartmentQuota
@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package departmentquota
+
+import (
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DepartmentID applies equality check predicate on the "department_id" field. It's identical to DepartmentIDEQ.
+func DepartmentID(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// LimitBytes applies equality check predicate on the "limit_bytes" field. It's identical to LimitBytesEQ.
+func LimitBytes(v int64) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldLimitBytes, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// DepartmentIDEQ applies the EQ predicate on the "department_id" field.
+func DepartmentIDEQ(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDNEQ applies the NEQ predicate on the "department_id" field.
+func DepartmentIDNEQ(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDIn applies the In predicate on the "department_id" field.
+func DepartmentIDIn(vs ...uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDNotIn applies the NotIn predicate on the "department_id" field.
+func DepartmentIDNotIn(vs ...uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNotIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDGT applies the GT predicate on the "department_id" field.
+func DepartmentIDGT(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGT(FieldDepartmentID, v))
+}
+
+// DepartmentIDGTE applies the GTE predicate on the "department_id" field.
+func DepartmentIDGTE(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGTE(FieldDepartmentID, v))
+}
+
+// DepartmentIDLT applies the LT predicate on the "department_id" field.
+func DepartmentIDLT(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLT(FieldDepartmentID, v))
+}
+
+// DepartmentIDLTE applies the LTE predicate on the "department_id" field.
+func DepartmentIDLTE(v uuid.UUID) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLTE(FieldDepartmentID, v))
+}
+
+// LimitBytesEQ applies the EQ predicate on the "limit_bytes" field.
+func LimitBytesEQ(v int64) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldEQ(FieldLimitBytes, v))
+}
+
+// LimitBytesNEQ applies the NEQ predicate on the "limit_bytes" field.
+func LimitBytesNEQ(v int64) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNEQ(FieldLimitBytes, v))
+}
+
+// LimitBytesIn applies the In predicate on the "limit_bytes" field.
+func LimitBytesIn(vs ...int64) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldIn(FieldLimitBytes, vs...))
+}
+
+// LimitBytesNotIn applies the NotIn predicate on the "limit_bytes" field.
+func LimitBytesNotIn(vs ...int64) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldNotIn(FieldLimitBytes, vs...))
+}
+
+// LimitBytesGT applies the GT predicate on the "limit_bytes" field.
+func LimitBytesGT(v int64) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGT(FieldLimitBytes, v))
+}
+
+// LimitBytesGTE applies the GTE predicate on the "limit_bytes" field.
+func LimitBytesGTE(v int64) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldGTE(FieldLimitBytes, v))
+}
+
+// LimitBytesLT applies the LT predicate on the "limit_bytes" field.
+func LimitBytesLT(v int64) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLT(FieldLimitBytes, v))
+}
+
+// LimitBytesLTE applies the LTE predicate on the "limit_bytes" field.
+func LimitBytesLTE(v int64) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.FieldLTE(FieldLimitBytes, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DepartmentQuota) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DepartmentQuota) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DepartmentQuota) predicate.DepartmentQuota {
+	return predicate.DepartmentQuota(sql.NotPredicates(p))
+}
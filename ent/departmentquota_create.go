@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/departmentquota"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DepartmentQuotaCreate is the builder for creating a DepartmentQuota entity.
+type DepartmentQuotaCreate struct {
+	config
+	mutation *DepartmentQuotaMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DepartmentQuotaCreate) SetTenantID(v uuid.UUID) *DepartmentQuotaCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *DepartmentQuotaCreate) SetCreateTime(v time.Time) *DepartmentQuotaCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *DepartmentQuotaCreate) SetNillableCreateTime(v *time.Time) *DepartmentQuotaCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *DepartmentQuotaCreate) SetUpdateTime(v time.Time) *DepartmentQuotaCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *DepartmentQuotaCreate) SetNillableUpdateTime(v *time.Time) *DepartmentQuotaCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (_c *DepartmentQuotaCreate) SetDepartmentID(v uuid.UUID) *DepartmentQuotaCreate {
+	_c.mutation.SetDepartmentID(v)
+	return _c
+}
+
+// SetLimitBytes sets the "limit_bytes" field.
+func (_c *DepartmentQuotaCreate) SetLimitBytes(v int64) *DepartmentQuotaCreate {
+	_c.mutation.SetLimitBytes(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DepartmentQuotaCreate) SetID(v uuid.UUID) *DepartmentQuotaCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DepartmentQuotaCreate) SetNillableID(v *uuid.UUID) *DepartmentQuotaCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DepartmentQuotaMutation object of the builder.
+func (_c *DepartmentQuotaCreate) Mutation() *DepartmentQuotaMutation {
+	return _c.mutation
+}
+
+// Save creates the DepartmentQuota in the database.
+func (_c *DepartmentQuotaCreate) Save(ctx context.Context) (*DepartmentQuota, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DepartmentQuotaCreate) SaveX(ctx context.Context) *DepartmentQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DepartmentQuotaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DepartmentQuotaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DepartmentQuotaCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if departmentquota.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized departmentquota.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := departmentquota.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if departmentquota.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized departmentquota.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := departmentquota.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if departmentquota.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized departmentquota.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := departmentquota.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DepartmentQuotaCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "DepartmentQuota.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "DepartmentQuota.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "DepartmentQuota.update_time"`)}
+	}
+	if _, ok := _c.mutation.DepartmentID(); !ok {
+		return &ValidationError{Name: "department_id", err: errors.New(`ent: missing required field "DepartmentQuota.department_id"`)}
+	}
+	if _, ok := _c.mutation.LimitBytes(); !ok {
+		return &ValidationError{Name: "limit_bytes", err: errors.New(`ent: missing required field "DepartmentQuota.limit_bytes"`)}
+	}
+	if v, ok := _c.mutation.LimitBytes(); ok {
+		if err := departmentquota.LimitBytesValidator(v); err != nil {
+			return &ValidationError{Name: "limit_bytes", err: fmt.Errorf(`ent: validator failed for field "DepartmentQuota.limit_bytes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DepartmentQuotaCreate) sqlSave(ctx context.Context) (*DepartmentQuota, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DepartmentQuotaCreate) createSpec() (*DepartmentQuota, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DepartmentQuota{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(departmentquota.Table, sqlgraph.NewFieldSpec(departmentquota.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(departmentquota.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(departmentquota.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(departmentquota.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.DepartmentID(); ok {
+		_spec.SetField(departmentquota.FieldDepartmentID, field.TypeUUID, value)
+		_node.DepartmentID = value
+	}
+	if value, ok := _c.mutation.LimitBytes(); ok {
+		_spec.SetField(departmentquota.FieldLimitBytes, field.TypeInt64, value)
+		_node.LimitBytes = value
+	}
+	return _node, _spec
+}
+
+// DepartmentQuotaCreateBulk is the builder for creating many DepartmentQuota entities in bulk.
+type DepartmentQuotaCreateBulk struct {
+	config
+	err      error
+	builders []*DepartmentQuotaCreate
+}
+
+// Save creates the DepartmentQuota entities in the database.
+func (_c *DepartmentQuotaCreateBulk) Save(ctx context.Context) ([]*DepartmentQuota, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DepartmentQuota, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DepartmentQuotaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DepartmentQuotaCreateBulk) SaveX(ctx context.Context) []*DepartmentQuota {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DepartmentQuotaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DepartmentQuotaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/departmentquota"
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DepartmentQuotaUpdate is the builder for updating DepartmentQuota entities.
+type DepartmentQuotaUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DepartmentQuotaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DepartmentQuotaUpdate builder.
+func (_u *DepartmentQuotaUpdate) Where(ps ...predicate.DepartmentQuota) *DepartmentQuotaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *DepartmentQuotaUpdate) SetUpdateTime(v time.Time) *DepartmentQuotaUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (_u *DepartmentQuotaUpdate) SetDepartmentID(v uuid.UUID) *DepartmentQuotaUpdate {
+	_u.mutation.SetDepartmentID(v)
+	return _u
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (_u *DepartmentQuotaUpdate) SetNillableDepartmentID(v *uuid.UUID) *DepartmentQuotaUpdate {
+	if v != nil {
+		_u.SetDepartmentID(*v)
+	}
+	return _u
+}
+
+// SetLimitBytes sets the "limit_bytes" field.
+func (_u *DepartmentQuotaUpdate) SetLimitBytes(v int64) *DepartmentQuotaUpdate {
+	_u.mutation.ResetLimitBytes()
+	_u.mutation.SetLimitBytes(v)
+	return _u
+}
+
+// SetNillableLimitBytes sets the "limit_bytes" field if the given value is not nil.
+func (_u *DepartmentQuotaUpdate) SetNillableLimitBytes(v *int64) *DepartmentQuotaUpdate {
+	if v != nil {
+		_u.SetLimitBytes(*v)
+	}
+	return _u
+}
+
+// AddLimitBytes adds value to the "limit_bytes" field.
+func (_u *DepartmentQuotaUpdate) AddLimitBytes(v int64) *DepartmentQuotaUpdate {
+	_u.mutation.AddLimitBytes(v)
+	return _u
+}
+
+// Mutation returns the DepartmentQuotaMutation object of the builder.
+func (_u *DepartmentQuotaUpdate) Mutation() *DepartmentQuotaMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DepartmentQuotaUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DepartmentQuotaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DepartmentQuotaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DepartmentQuotaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DepartmentQuotaUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if departmentquota.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized departmentquota.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := departmentquota.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DepartmentQuotaUpdate) check() error {
+	if v, ok := _u.mutation.LimitBytes(); ok {
+		if err := departmentquota.LimitBytesValidator(v); err != nil {
+			return &ValidationError{Name: "limit_bytes", err: fmt.Errorf(`ent: validator failed for field "DepartmentQuota.limit_bytes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DepartmentQuotaUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DepartmentQuotaUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DepartmentQuotaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(departmentquota.Table, departmentquota.Columns, sqlgraph.NewFieldSpec(departmentquota.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(departmentquota.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DepartmentID(); ok {
+		_spec.SetField(departmentquota.FieldDepartmentID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LimitBytes(); ok {
+		_spec.SetField(departmentquota.FieldLimitBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLimitBytes(); ok {
+		_spec.AddField(departmentquota.FieldLimitBytes, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{departmentquota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DepartmentQuotaUpdateOne is the builder for updating a single DepartmentQuota entity.
+type DepartmentQuotaUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DepartmentQuotaMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *DepartmentQuotaUpdateOne) SetUpdateTime(v time.Time) *DepartmentQuotaUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (_u *DepartmentQuotaUpdateOne) SetDepartmentID(v uuid.UUID) *DepartmentQuotaUpdateOne {
+	_u.mutation.SetDepartmentID(v)
+	return _u
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (_u *DepartmentQuotaUpdateOne) SetNillableDepartmentID(v *uuid.UUID) *DepartmentQuotaUpdateOne {
+	if v != nil {
+		_u.SetDepartmentID(*v)
+	}
+	return _u
+}
+
+// SetLimitBytes sets the "limit_bytes" field.
+func (_u *DepartmentQuotaUpdateOne) SetLimitBytes(v int64) *DepartmentQuotaUpdateOne {
+	_u.mutation.ResetLimitBytes()
+	_u.mutation.SetLimitBytes(v)
+	return _u
+}
+
+// SetNillableLimitBytes sets the "limit_bytes" field if the given value is not nil.
+func (_u *DepartmentQuotaUpdateOne) SetNillableLimitBytes(v *int64) *DepartmentQuotaUpdateOne {
+	if v != nil {
+		_u.SetLimitBytes(*v)
+	}
+	return _u
+}
+
+// AddLimitBytes adds value to the "limit_bytes" field.
+func (_u *DepartmentQuotaUpdateOne) AddLimitBytes(v int64) *DepartmentQuotaUpdateOne {
+	_u.mutation.AddLimitBytes(v)
+	return _u
+}
+
+// Mutation returns the DepartmentQuotaMutation object of the builder.
+func (_u *DepartmentQuotaUpdateOne) Mutation() *DepartmentQuotaMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DepartmentQuotaUpdate builder.
+func (_u *DepartmentQuotaUpdateOne) Where(ps ...predicate.DepartmentQuota) *DepartmentQuotaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DepartmentQuotaUpdateOne) Select(field string, fields ...string) *DepartmentQuotaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DepartmentQuota entity.
+func (_u *DepartmentQuotaUpdateOne) Save(ctx context.Context) (*DepartmentQuota, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DepartmentQuotaUpdateOne) SaveX(ctx context.Context) *DepartmentQuota {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DepartmentQuotaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DepartmentQuotaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DepartmentQuotaUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if departmentquota.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized departmentquota.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := departmentquota.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DepartmentQuotaUpdateOne) check() error {
+	if v, ok := _u.mutation.LimitBytes(); ok {
+		if err := departmentquota.LimitBytesValidator(v); err != nil {
+			return &ValidationError{Name: "limit_bytes", err: fmt.Errorf(`ent: validator failed for field "DepartmentQuota.limit_bytes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DepartmentQuotaUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DepartmentQuotaUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DepartmentQuotaUpdateOne) sqlSave(ctx context.Context) (_node *DepartmentQuota, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(departmentquota.Table, departmentquota.Columns, sqlgraph.NewFieldSpec(departmentquota.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DepartmentQuota.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, departmentquota.FieldID)
+		for _, f := range fields {
+			if !departmentquota.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != departmentquota.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(departmentquota.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DepartmentID(); ok {
+		_spec.SetField(departmentquota.FieldDepartmentID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.LimitBytes(); ok {
+		_spec.SetField(departmentquota.FieldLimitBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLimitBytes(); ok {
+		_spec.AddField(departmentquota.FieldLimitBytes, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DepartmentQuota{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{departmentquota.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,7 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"main/ent/departmentquota"
 	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/filefavorite"
+	"main/ent/fileset"
+	"main/ent/impersonationgrant"
+	"main/ent/lifecyclerule"
+	"main/ent/operationauditlog"
+	"main/ent/tag"
+	"main/ent/tenantsetting"
+	"main/ent/translationoverride"
+	"main/ent/userstoragelimit"
 	"reflect"
 	"sync"
 
@@ -73,7 +84,18 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			file.Table: file.ValidColumn,
+			departmentquota.Table:     departmentquota.ValidColumn,
+			file.Table:                file.ValidColumn,
+			fileauditevent.Table:      fileauditevent.ValidColumn,
+			filefavorite.Table:        filefavorite.ValidColumn,
+			fileset.Table:             fileset.ValidColumn,
+			impersonationgrant.Table:  impersonationgrant.ValidColumn,
+			lifecyclerule.Table:       lifecyclerule.ValidColumn,
+			operationauditlog.Table:   operationauditlog.ValidColumn,
+			tag.Table:                 tag.ValidColumn,
+			tenantsetting.Table:       tenantsetting.ValidColumn,
+			translationoverride.Table: translationoverride.ValidColumn,
+			userstoragelimit.Table:    userstoragelimit.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -23,3 +23,45 @@ type UserDepartment struct {
 
 // IsEntity marks UserDepartment as a federation entity
 func (*UserDepartment) IsEntity() {}
+
+// Tenant is a federation stub for the Tenant entity owned by the account service.
+// This subgraph contributes storage usage fields computed from the files table.
+type Tenant struct {
+	ID uuid.UUID `json:"id"`
+	// StorageUsed суммарный размер файлов тенанта в байтах
+	StorageUsed int64 `json:"storageUsed"`
+	// StorageLimit лимит хранилища в байтах (nil - без ограничений)
+	StorageLimit *int64 `json:"storageLimit,omitempty"`
+	// FileCount количество файлов тенанта
+	FileCount int `json:"fileCount"`
+}
+
+// IsEntity marks Tenant as a federation entity
+func (*Tenant) IsEntity() {}
+
+// Ticket is a federation stub for the Ticket entity owned by the ticket service.
+// This subgraph contributes the number of attached files.
+type Ticket struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// IsEntity marks Ticket as a federation entity
+func (*Ticket) IsEntity() {}
+
+// TicketComment is a federation stub for the TicketComment entity owned by the ticket service.
+// This subgraph contributes the number of attached files.
+type TicketComment struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// IsEntity marks TicketComment as a federation entity
+func (*TicketComment) IsEntity() {}
+
+// Message is a federation stub for the chat Message entity owned by the chat service.
+// This subgraph contributes the number of attached files.
+type Message struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// IsEntity marks Message as a federation entity
+func (*Message) IsEntity() {}
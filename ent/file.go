@@ -25,37 +25,132 @@ type File struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// CreatedBy holds the value of the "created_by" field.
 	CreatedBy uuid.UUID `json:"created_by,omitempty"`
 	// Оригинальное имя загруженного файла
 	OriginalName string `json:"original_name,omitempty"`
 	// Уникальный ключ в хранилище S3
 	StorageKey string `json:"storage_key,omitempty"`
-	// MIME-тип файла
+	// MIME-тип файла, заявленный клиентом (или определенный по расширению)
 	MimeType string `json:"mime_type,omitempty"`
+	// MIME-тип, определенный по сигнатуре содержимого при загрузке
+	DetectedMimeType string `json:"detected_mime_type,omitempty"`
 	// Размер файла в байтах
 	Size int64 `json:"size,omitempty"`
-	// Путь к файлу в хранилище (deprecated, используется storage_key)
+	// Путь к файлу в хранилище (deprecated, используется storage_key); колонка удаляется, когда статистика /internal/deprecated-fields покажет, что File.path не запрашивают
 	Path string `json:"path,omitempty"`
 	// Описание файла
 	Description string `json:"description,omitempty"`
 	// Дополнительные метаданные файла
-	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// SHA-256 содержимого файла (hex), вычисляется при загрузке
+	ChecksumSha256 string `json:"checksum_sha256,omitempty"`
+	// Результат последней проверки целостности объекта в S3
+	IntegrityStatus file.IntegrityStatus `json:"integrity_status,omitempty"`
+	// Время последней проверки целостности
+	IntegrityCheckedAt *time.Time `json:"integrity_checked_at,omitempty"`
+	// Состояние превью изображения: NONE - превью не создается, PENDING - ожидает генерации
+	ThumbnailStatus file.ThumbnailStatus `json:"thumbnail_status,omitempty"`
+	// Состояние превью первой страницы документа (PDF, Office)
+	PreviewStatus file.PreviewStatus `json:"preview_status,omitempty"`
+	// Состояние PDF-версии документа Office для просмотра в браузере: NONE - конвертация не выполняется
+	PdfStatus file.PdfStatus `json:"pdf_status,omitempty"`
+	// Неудачные попытки конвертации в PDF; после временной ошибки документ остается в PENDING до исчерпания попыток
+	PdfAttempts int `json:"pdf_attempts,omitempty"`
+	// Время следующей попытки конвертации в PDF после временной ошибки конвертера или S3
+	PdfRetryAt *time.Time `json:"pdf_retry_at,omitempty"`
+	// Результат антивирусной проверки: NONE - проверка отключена, PENDING - ожидает проверки
+	ScanStatus file.ScanStatus `json:"scan_status,omitempty"`
+	// Срок хранения, заданный при загрузке: после него файл перемещается в корзину
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Отдел загрузившего пользователя (первый из DepartmentIDs федерации) для учета квоты отдела
+	DepartmentID *uuid.UUID `json:"department_id,omitempty"`
+	// Источник загрузки из заголовка gateway X-Upload-Source: пользовательский клиент или автоматизация
+	UploadSource file.UploadSource `json:"upload_source,omitempty"`
+	// Версия клиента из заголовка gateway X-Client-Version
+	ClientVersion string `json:"client_version,omitempty"`
+	// Количество скачиваний: выданных ссылок на файл и архивов, в которые он вошел
+	DownloadCount int64 `json:"download_count,omitempty"`
+	// Время последнего скачивания; используется условием простоя правил жизненного цикла
+	LastDownloadedAt *time.Time `json:"last_downloaded_at,omitempty"`
+	// Время перевода файла в архив правилом жизненного цикла; архивные файлы остаются доступными
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+	// Тип сущности другого сервиса, к которой прикреплен файл: тикет, комментарий или сообщение чата
+	EntityType *file.EntityType `json:"entity_type,omitempty"`
+	// ID сущности, к которой прикреплен файл; задается вместе с entity_type
+	EntityID *uuid.UUID `json:"entity_id,omitempty"`
+	// Пользователь, взявший файл на редактирование (lockFile); остальные не могут изменять файл до снятия блокировки
+	LockedBy *uuid.UUID `json:"locked_by,omitempty"`
+	// Время установки блокировки; задается вместе с locked_by
+	LockedAt *time.Time `json:"locked_at,omitempty"`
+	// Режим удержания объекта S3 Object Lock: GOVERNANCE снимается с особым правом, COMPLIANCE - никем до срока
+	ObjectLockMode *file.ObjectLockMode `json:"object_lock_mode,omitempty"`
+	// Срок удержания объекта в S3; задается вместе с object_lock_mode
+	ObjectLockRetainUntil *time.Time `json:"object_lock_retain_until,omitempty"`
+	// Legal hold объекта в S3: объект нельзя удалить, пока удержание не снято, независимо от срока
+	LegalHold bool `json:"legal_hold,omitempty"`
+	// Запланированное удаление (scheduleFileDeletion): в это время файл перемещается в корзину
+	ScheduledDeletionAt *time.Time `json:"scheduled_deletion_at,omitempty"`
+	// Пользователь, запланировавший удаление; задается вместе с scheduled_deletion_at
+	ScheduledDeletionBy *uuid.UUID `json:"scheduled_deletion_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FileQuery when eager-loading is set.
+	Edges        FileEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// FileEdges holds the relations/edges for other nodes in the graph.
+type FileEdges struct {
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Favorites holds the value of the favorites edge.
+	Favorites []*FileFavorite `json:"favorites,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedTags      map[string][]*Tag
+	namedFavorites map[string][]*FileFavorite
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[0] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// FavoritesOrErr returns the Favorites value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) FavoritesOrErr() ([]*FileFavorite, error) {
+	if e.loadedTypes[1] {
+		return e.Favorites, nil
+	}
+	return nil, &NotLoadedError{edge: "favorites"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*File) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case file.FieldDepartmentID, file.FieldEntityID, file.FieldLockedBy, file.FieldScheduledDeletionBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case file.FieldMetadata:
 			values[i] = new([]byte)
-		case file.FieldSize:
+		case file.FieldLegalHold:
+			values[i] = new(sql.NullBool)
+		case file.FieldSize, file.FieldPdfAttempts, file.FieldDownloadCount:
 			values[i] = new(sql.NullInt64)
-		case file.FieldOriginalName, file.FieldStorageKey, file.FieldMimeType, file.FieldPath, file.FieldDescription:
+		case file.FieldOriginalName, file.FieldStorageKey, file.FieldMimeType, file.FieldDetectedMimeType, file.FieldPath, file.FieldDescription, file.FieldChecksumSha256, file.FieldIntegrityStatus, file.FieldThumbnailStatus, file.FieldPreviewStatus, file.FieldPdfStatus, file.FieldScanStatus, file.FieldUploadSource, file.FieldClientVersion, file.FieldEntityType, file.FieldObjectLockMode:
 			values[i] = new(sql.NullString)
-		case file.FieldCreateTime, file.FieldUpdateTime:
+		case file.FieldCreateTime, file.FieldUpdateTime, file.FieldDeletedAt, file.FieldIntegrityCheckedAt, file.FieldPdfRetryAt, file.FieldExpiresAt, file.FieldLastDownloadedAt, file.FieldArchivedAt, file.FieldLockedAt, file.FieldObjectLockRetainUntil, file.FieldScheduledDeletionAt:
 			values[i] = new(sql.NullTime)
 		case file.FieldID, file.FieldTenantID, file.FieldCreatedBy:
 			values[i] = new(uuid.UUID)
@@ -98,6 +193,12 @@ func (_m *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdateTime = value.Time
 			}
+		case file.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Time
+			}
 		case file.FieldCreatedBy:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field created_by", values[i])
@@ -122,6 +223,12 @@ func (_m *File) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MimeType = value.String
 			}
+		case file.FieldDetectedMimeType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detected_mime_type", values[i])
+			} else if value.Valid {
+				_m.DetectedMimeType = value.String
+			}
 		case file.FieldSize:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field size", values[i])
@@ -148,6 +255,170 @@ func (_m *File) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field metadata: %w", err)
 				}
 			}
+		case file.FieldChecksumSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum_sha256", values[i])
+			} else if value.Valid {
+				_m.ChecksumSha256 = value.String
+			}
+		case file.FieldIntegrityStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field integrity_status", values[i])
+			} else if value.Valid {
+				_m.IntegrityStatus = file.IntegrityStatus(value.String)
+			}
+		case file.FieldIntegrityCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field integrity_checked_at", values[i])
+			} else if value.Valid {
+				_m.IntegrityCheckedAt = new(time.Time)
+				*_m.IntegrityCheckedAt = value.Time
+			}
+		case file.FieldThumbnailStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_status", values[i])
+			} else if value.Valid {
+				_m.ThumbnailStatus = file.ThumbnailStatus(value.String)
+			}
+		case file.FieldPreviewStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field preview_status", values[i])
+			} else if value.Valid {
+				_m.PreviewStatus = file.PreviewStatus(value.String)
+			}
+		case file.FieldPdfStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf_status", values[i])
+			} else if value.Valid {
+				_m.PdfStatus = file.PdfStatus(value.String)
+			}
+		case file.FieldPdfAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf_attempts", values[i])
+			} else if value.Valid {
+				_m.PdfAttempts = int(value.Int64)
+			}
+		case file.FieldPdfRetryAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf_retry_at", values[i])
+			} else if value.Valid {
+				_m.PdfRetryAt = new(time.Time)
+				*_m.PdfRetryAt = value.Time
+			}
+		case file.FieldScanStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scan_status", values[i])
+			} else if value.Valid {
+				_m.ScanStatus = file.ScanStatus(value.String)
+			}
+		case file.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case file.FieldDepartmentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field department_id", values[i])
+			} else if value.Valid {
+				_m.DepartmentID = new(uuid.UUID)
+				*_m.DepartmentID = *value.S.(*uuid.UUID)
+			}
+		case file.FieldUploadSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_source", values[i])
+			} else if value.Valid {
+				_m.UploadSource = file.UploadSource(value.String)
+			}
+		case file.FieldClientVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_version", values[i])
+			} else if value.Valid {
+				_m.ClientVersion = value.String
+			}
+		case file.FieldDownloadCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field download_count", values[i])
+			} else if value.Valid {
+				_m.DownloadCount = value.Int64
+			}
+		case file.FieldLastDownloadedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_downloaded_at", values[i])
+			} else if value.Valid {
+				_m.LastDownloadedAt = new(time.Time)
+				*_m.LastDownloadedAt = value.Time
+			}
+		case file.FieldArchivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field archived_at", values[i])
+			} else if value.Valid {
+				_m.ArchivedAt = new(time.Time)
+				*_m.ArchivedAt = value.Time
+			}
+		case file.FieldEntityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_type", values[i])
+			} else if value.Valid {
+				_m.EntityType = new(file.EntityType)
+				*_m.EntityType = file.EntityType(value.String)
+			}
+		case file.FieldEntityID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				_m.EntityID = new(uuid.UUID)
+				*_m.EntityID = *value.S.(*uuid.UUID)
+			}
+		case file.FieldLockedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_by", values[i])
+			} else if value.Valid {
+				_m.LockedBy = new(uuid.UUID)
+				*_m.LockedBy = *value.S.(*uuid.UUID)
+			}
+		case file.FieldLockedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_at", values[i])
+			} else if value.Valid {
+				_m.LockedAt = new(time.Time)
+				*_m.LockedAt = value.Time
+			}
+		case file.FieldObjectLockMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field object_lock_mode", values[i])
+			} else if value.Valid {
+				_m.ObjectLockMode = new(file.ObjectLockMode)
+				*_m.ObjectLockMode = file.ObjectLockMode(value.String)
+			}
+		case file.FieldObjectLockRetainUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field object_lock_retain_until", values[i])
+			} else if value.Valid {
+				_m.ObjectLockRetainUntil = new(time.Time)
+				*_m.ObjectLockRetainUntil = value.Time
+			}
+		case file.FieldLegalHold:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field legal_hold", values[i])
+			} else if value.Valid {
+				_m.LegalHold = value.Bool
+			}
+		case file.FieldScheduledDeletionAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_deletion_at", values[i])
+			} else if value.Valid {
+				_m.ScheduledDeletionAt = new(time.Time)
+				*_m.ScheduledDeletionAt = value.Time
+			}
+		case file.FieldScheduledDeletionBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled_deletion_by", values[i])
+			} else if value.Valid {
+				_m.ScheduledDeletionBy = new(uuid.UUID)
+				*_m.ScheduledDeletionBy = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -161,6 +432,16 @@ func (_m *File) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryTags queries the "tags" edge of the File entity.
+func (_m *File) QueryTags() *TagQuery {
+	return NewFileClient(_m.config).QueryTags(_m)
+}
+
+// QueryFavorites queries the "favorites" edge of the File entity.
+func (_m *File) QueryFavorites() *FileFavoriteQuery {
+	return NewFileClient(_m.config).QueryFavorites(_m)
+}
+
 // Update returns a builder for updating this File.
 // Note that you need to call File.Unwrap() before calling this method if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -193,6 +474,9 @@ func (_m *File) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(_m.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
 	builder.WriteString(", ")
@@ -205,6 +489,9 @@ func (_m *File) String() string {
 	builder.WriteString("mime_type=")
 	builder.WriteString(_m.MimeType)
 	builder.WriteString(", ")
+	builder.WriteString("detected_mime_type=")
+	builder.WriteString(_m.DetectedMimeType)
+	builder.WriteString(", ")
 	builder.WriteString("size=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Size))
 	builder.WriteString(", ")
@@ -216,9 +503,160 @@ func (_m *File) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("metadata=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	builder.WriteString("checksum_sha256=")
+	builder.WriteString(_m.ChecksumSha256)
+	builder.WriteString(", ")
+	builder.WriteString("integrity_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IntegrityStatus))
+	builder.WriteString(", ")
+	if v := _m.IntegrityCheckedAt; v != nil {
+		builder.WriteString("integrity_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ThumbnailStatus))
+	builder.WriteString(", ")
+	builder.WriteString("preview_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviewStatus))
+	builder.WriteString(", ")
+	builder.WriteString("pdf_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PdfStatus))
+	builder.WriteString(", ")
+	builder.WriteString("pdf_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PdfAttempts))
+	builder.WriteString(", ")
+	if v := _m.PdfRetryAt; v != nil {
+		builder.WriteString("pdf_retry_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scan_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScanStatus))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DepartmentID; v != nil {
+		builder.WriteString("department_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("upload_source=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadSource))
+	builder.WriteString(", ")
+	builder.WriteString("client_version=")
+	builder.WriteString(_m.ClientVersion)
+	builder.WriteString(", ")
+	builder.WriteString("download_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DownloadCount))
+	builder.WriteString(", ")
+	if v := _m.LastDownloadedAt; v != nil {
+		builder.WriteString("last_downloaded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ArchivedAt; v != nil {
+		builder.WriteString("archived_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EntityType; v != nil {
+		builder.WriteString("entity_type=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.EntityID; v != nil {
+		builder.WriteString("entity_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LockedBy; v != nil {
+		builder.WriteString("locked_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LockedAt; v != nil {
+		builder.WriteString("locked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ObjectLockMode; v != nil {
+		builder.WriteString("object_lock_mode=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ObjectLockRetainUntil; v != nil {
+		builder.WriteString("object_lock_retain_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("legal_hold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LegalHold))
+	builder.WriteString(", ")
+	if v := _m.ScheduledDeletionAt; v != nil {
+		builder.WriteString("scheduled_deletion_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ScheduledDeletionBy; v != nil {
+		builder.WriteString("scheduled_deletion_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// NamedTags returns the Tags named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *File) NamedTags(name string) ([]*Tag, error) {
+	if _m.Edges.namedTags == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedTags[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *File) appendNamedTags(name string, edges ...*Tag) {
+	if _m.Edges.namedTags == nil {
+		_m.Edges.namedTags = make(map[string][]*Tag)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedTags[name] = []*Tag{}
+	} else {
+		_m.Edges.namedTags[name] = append(_m.Edges.namedTags[name], edges...)
+	}
+}
+
+// NamedFavorites returns the Favorites named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *File) NamedFavorites(name string) ([]*FileFavorite, error) {
+	if _m.Edges.namedFavorites == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedFavorites[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *File) appendNamedFavorites(name string, edges ...*FileFavorite) {
+	if _m.Edges.namedFavorites == nil {
+		_m.Edges.namedFavorites = make(map[string][]*FileFavorite)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedFavorites[name] = []*FileFavorite{}
+	} else {
+		_m.Edges.namedFavorites[name] = append(_m.Edges.namedFavorites[name], edges...)
+	}
+}
+
 // Files is a parsable slice of File.
 type Files []*File
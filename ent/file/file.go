@@ -3,10 +3,14 @@
 package file
 
 import (
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -21,6 +25,8 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
 	// FieldOriginalName holds the string denoting the original_name field in the database.
@@ -29,6 +35,8 @@ const (
 	FieldStorageKey = "storage_key"
 	// FieldMimeType holds the string denoting the mime_type field in the database.
 	FieldMimeType = "mime_type"
+	// FieldDetectedMimeType holds the string denoting the detected_mime_type field in the database.
+	FieldDetectedMimeType = "detected_mime_type"
 	// FieldSize holds the string denoting the size field in the database.
 	FieldSize = "size"
 	// FieldPath holds the string denoting the path field in the database.
@@ -37,8 +45,74 @@ const (
 	FieldDescription = "description"
 	// FieldMetadata holds the string denoting the metadata field in the database.
 	FieldMetadata = "metadata"
+	// FieldChecksumSha256 holds the string denoting the checksum_sha256 field in the database.
+	FieldChecksumSha256 = "checksum_sha256"
+	// FieldIntegrityStatus holds the string denoting the integrity_status field in the database.
+	FieldIntegrityStatus = "integrity_status"
+	// FieldIntegrityCheckedAt holds the string denoting the integrity_checked_at field in the database.
+	FieldIntegrityCheckedAt = "integrity_checked_at"
+	// FieldThumbnailStatus holds the string denoting the thumbnail_status field in the database.
+	FieldThumbnailStatus = "thumbnail_status"
+	// FieldPreviewStatus holds the string denoting the preview_status field in the database.
+	FieldPreviewStatus = "preview_status"
+	// FieldPdfStatus holds the string denoting the pdf_status field in the database.
+	FieldPdfStatus = "pdf_status"
+	// FieldPdfAttempts holds the string denoting the pdf_attempts field in the database.
+	FieldPdfAttempts = "pdf_attempts"
+	// FieldPdfRetryAt holds the string denoting the pdf_retry_at field in the database.
+	FieldPdfRetryAt = "pdf_retry_at"
+	// FieldScanStatus holds the string denoting the scan_status field in the database.
+	FieldScanStatus = "scan_status"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldDepartmentID holds the string denoting the department_id field in the database.
+	FieldDepartmentID = "department_id"
+	// FieldUploadSource holds the string denoting the upload_source field in the database.
+	FieldUploadSource = "upload_source"
+	// FieldClientVersion holds the string denoting the client_version field in the database.
+	FieldClientVersion = "client_version"
+	// FieldDownloadCount holds the string denoting the download_count field in the database.
+	FieldDownloadCount = "download_count"
+	// FieldLastDownloadedAt holds the string denoting the last_downloaded_at field in the database.
+	FieldLastDownloadedAt = "last_downloaded_at"
+	// FieldArchivedAt holds the string denoting the archived_at field in the database.
+	FieldArchivedAt = "archived_at"
+	// FieldEntityType holds the string denoting the entity_type field in the database.
+	FieldEntityType = "entity_type"
+	// FieldEntityID holds the string denoting the entity_id field in the database.
+	FieldEntityID = "entity_id"
+	// FieldLockedBy holds the string denoting the locked_by field in the database.
+	FieldLockedBy = "locked_by"
+	// FieldLockedAt holds the string denoting the locked_at field in the database.
+	FieldLockedAt = "locked_at"
+	// FieldObjectLockMode holds the string denoting the object_lock_mode field in the database.
+	FieldObjectLockMode = "object_lock_mode"
+	// FieldObjectLockRetainUntil holds the string denoting the object_lock_retain_until field in the database.
+	FieldObjectLockRetainUntil = "object_lock_retain_until"
+	// FieldLegalHold holds the string denoting the legal_hold field in the database.
+	FieldLegalHold = "legal_hold"
+	// FieldScheduledDeletionAt holds the string denoting the scheduled_deletion_at field in the database.
+	FieldScheduledDeletionAt = "scheduled_deletion_at"
+	// FieldScheduledDeletionBy holds the string denoting the scheduled_deletion_by field in the database.
+	FieldScheduledDeletionBy = "scheduled_deletion_by"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeFavorites holds the string denoting the favorites edge name in mutations.
+	EdgeFavorites = "favorites"
 	// Table holds the table name of the file in the database.
 	Table = "files"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "file_tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// FavoritesTable is the table that holds the favorites relation/edge.
+	FavoritesTable = "file_favorites"
+	// FavoritesInverseTable is the table name for the FileFavorite entity.
+	// It exists in this package in order to avoid circular dependency with the "filefavorite" package.
+	FavoritesInverseTable = "file_favorites"
+	// FavoritesColumn is the table column denoting the favorites relation/edge.
+	FavoritesColumn = "file_id"
 )
 
 // Columns holds all SQL columns for file fields.
@@ -47,16 +121,49 @@ var Columns = []string{
 	FieldTenantID,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldDeletedAt,
 	FieldCreatedBy,
 	FieldOriginalName,
 	FieldStorageKey,
 	FieldMimeType,
+	FieldDetectedMimeType,
 	FieldSize,
 	FieldPath,
 	FieldDescription,
 	FieldMetadata,
+	FieldChecksumSha256,
+	FieldIntegrityStatus,
+	FieldIntegrityCheckedAt,
+	FieldThumbnailStatus,
+	FieldPreviewStatus,
+	FieldPdfStatus,
+	FieldPdfAttempts,
+	FieldPdfRetryAt,
+	FieldScanStatus,
+	FieldExpiresAt,
+	FieldDepartmentID,
+	FieldUploadSource,
+	FieldClientVersion,
+	FieldDownloadCount,
+	FieldLastDownloadedAt,
+	FieldArchivedAt,
+	FieldEntityType,
+	FieldEntityID,
+	FieldLockedBy,
+	FieldLockedAt,
+	FieldObjectLockMode,
+	FieldObjectLockRetainUntil,
+	FieldLegalHold,
+	FieldScheduledDeletionAt,
+	FieldScheduledDeletionBy,
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"file_id", "tag_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -73,8 +180,8 @@ func ValidColumn(column string) bool {
 //
 //	import _ "main/ent/runtime"
 var (
-	Hooks        [2]ent.Hook
-	Interceptors [2]ent.Interceptor
+	Hooks        [5]ent.Hook
+	Interceptors [3]ent.Interceptor
 	Policy       ent.Policy
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
@@ -90,10 +197,241 @@ var (
 	MimeTypeValidator func(string) error
 	// SizeValidator is a validator for the "size" field. It is called by the builders before save.
 	SizeValidator func(int64) error
+	// ChecksumSha256Validator is a validator for the "checksum_sha256" field. It is called by the builders before save.
+	ChecksumSha256Validator func(string) error
+	// DefaultPdfAttempts holds the default value on creation for the "pdf_attempts" field.
+	DefaultPdfAttempts int
+	// PdfAttemptsValidator is a validator for the "pdf_attempts" field. It is called by the builders before save.
+	PdfAttemptsValidator func(int) error
+	// ClientVersionValidator is a validator for the "client_version" field. It is called by the builders before save.
+	ClientVersionValidator func(string) error
+	// DefaultDownloadCount holds the default value on creation for the "download_count" field.
+	DefaultDownloadCount int64
+	// DownloadCountValidator is a validator for the "download_count" field. It is called by the builders before save.
+	DownloadCountValidator func(int64) error
+	// DefaultLegalHold holds the default value on creation for the "legal_hold" field.
+	DefaultLegalHold bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// IntegrityStatus defines the type for the "integrity_status" enum field.
+type IntegrityStatus string
+
+// IntegrityStatusUNKNOWN is the default value of the IntegrityStatus enum.
+const DefaultIntegrityStatus = IntegrityStatusUNKNOWN
+
+// IntegrityStatus values.
+const (
+	IntegrityStatusUNKNOWN   IntegrityStatus = "UNKNOWN"
+	IntegrityStatusOK        IntegrityStatus = "OK"
+	IntegrityStatusCORRUPTED IntegrityStatus = "CORRUPTED"
+	IntegrityStatusMISSING   IntegrityStatus = "MISSING"
+)
+
+func (is IntegrityStatus) String() string {
+	return string(is)
+}
+
+// IntegrityStatusValidator is a validator for the "integrity_status" field enum values. It is called by the builders before save.
+func IntegrityStatusValidator(is IntegrityStatus) error {
+	switch is {
+	case IntegrityStatusUNKNOWN, IntegrityStatusOK, IntegrityStatusCORRUPTED, IntegrityStatusMISSING:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for integrity_status field: %q", is)
+	}
+}
+
+// ThumbnailStatus defines the type for the "thumbnail_status" enum field.
+type ThumbnailStatus string
+
+// ThumbnailStatusNONE is the default value of the ThumbnailStatus enum.
+const DefaultThumbnailStatus = ThumbnailStatusNONE
+
+// ThumbnailStatus values.
+const (
+	ThumbnailStatusNONE    ThumbnailStatus = "NONE"
+	ThumbnailStatusPENDING ThumbnailStatus = "PENDING"
+	ThumbnailStatusREADY   ThumbnailStatus = "READY"
+	ThumbnailStatusFAILED  ThumbnailStatus = "FAILED"
+)
+
+func (ts ThumbnailStatus) String() string {
+	return string(ts)
+}
+
+// ThumbnailStatusValidator is a validator for the "thumbnail_status" field enum values. It is called by the builders before save.
+func ThumbnailStatusValidator(ts ThumbnailStatus) error {
+	switch ts {
+	case ThumbnailStatusNONE, ThumbnailStatusPENDING, ThumbnailStatusREADY, ThumbnailStatusFAILED:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for thumbnail_status field: %q", ts)
+	}
+}
+
+// PreviewStatus defines the type for the "preview_status" enum field.
+type PreviewStatus string
+
+// PreviewStatusNONE is the default value of the PreviewStatus enum.
+const DefaultPreviewStatus = PreviewStatusNONE
+
+// PreviewStatus values.
+const (
+	PreviewStatusNONE    PreviewStatus = "NONE"
+	PreviewStatusPENDING PreviewStatus = "PENDING"
+	PreviewStatusREADY   PreviewStatus = "READY"
+	PreviewStatusFAILED  PreviewStatus = "FAILED"
+)
+
+func (ps PreviewStatus) String() string {
+	return string(ps)
+}
+
+// PreviewStatusValidator is a validator for the "preview_status" field enum values. It is called by the builders before save.
+func PreviewStatusValidator(ps PreviewStatus) error {
+	switch ps {
+	case PreviewStatusNONE, PreviewStatusPENDING, PreviewStatusREADY, PreviewStatusFAILED:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for preview_status field: %q", ps)
+	}
+}
+
+// PdfStatus defines the type for the "pdf_status" enum field.
+type PdfStatus string
+
+// PdfStatusNONE is the default value of the PdfStatus enum.
+const DefaultPdfStatus = PdfStatusNONE
+
+// PdfStatus values.
+const (
+	PdfStatusNONE    PdfStatus = "NONE"
+	PdfStatusPENDING PdfStatus = "PENDING"
+	PdfStatusREADY   PdfStatus = "READY"
+	PdfStatusFAILED  PdfStatus = "FAILED"
+)
+
+func (ps PdfStatus) String() string {
+	return string(ps)
+}
+
+// PdfStatusValidator is a validator for the "pdf_status" field enum values. It is called by the builders before save.
+func PdfStatusValidator(ps PdfStatus) error {
+	switch ps {
+	case PdfStatusNONE, PdfStatusPENDING, PdfStatusREADY, PdfStatusFAILED:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for pdf_status field: %q", ps)
+	}
+}
+
+// ScanStatus defines the type for the "scan_status" enum field.
+type ScanStatus string
+
+// ScanStatusNONE is the default value of the ScanStatus enum.
+const DefaultScanStatus = ScanStatusNONE
+
+// ScanStatus values.
+const (
+	ScanStatusNONE     ScanStatus = "NONE"
+	ScanStatusPENDING  ScanStatus = "PENDING"
+	ScanStatusCLEAN    ScanStatus = "CLEAN"
+	ScanStatusINFECTED ScanStatus = "INFECTED"
+)
+
+func (ss ScanStatus) String() string {
+	return string(ss)
+}
+
+// ScanStatusValidator is a validator for the "scan_status" field enum values. It is called by the builders before save.
+func ScanStatusValidator(ss ScanStatus) error {
+	switch ss {
+	case ScanStatusNONE, ScanStatusPENDING, ScanStatusCLEAN, ScanStatusINFECTED:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for scan_status field: %q", ss)
+	}
+}
+
+// UploadSource defines the type for the "upload_source" enum field.
+type UploadSource string
+
+// UploadSourceUNKNOWN is the default value of the UploadSource enum.
+const DefaultUploadSource = UploadSourceUNKNOWN
+
+// UploadSource values.
+const (
+	UploadSourceUNKNOWN   UploadSource = "UNKNOWN"
+	UploadSourceWEB       UploadSource = "WEB"
+	UploadSourceMOBILE    UploadSource = "MOBILE"
+	UploadSourceAPI_TOKEN UploadSource = "API_TOKEN"
+	UploadSourceEMAIL_IN  UploadSource = "EMAIL_IN"
+	UploadSourceIMPORT    UploadSource = "IMPORT"
+)
+
+func (us UploadSource) String() string {
+	return string(us)
+}
+
+// UploadSourceValidator is a validator for the "upload_source" field enum values. It is called by the builders before save.
+func UploadSourceValidator(us UploadSource) error {
+	switch us {
+	case UploadSourceUNKNOWN, UploadSourceWEB, UploadSourceMOBILE, UploadSourceAPI_TOKEN, UploadSourceEMAIL_IN, UploadSourceIMPORT:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for upload_source field: %q", us)
+	}
+}
+
+// EntityType defines the type for the "entity_type" enum field.
+type EntityType string
+
+// EntityType values.
+const (
+	EntityTypeTICKET  EntityType = "TICKET"
+	EntityTypeCOMMENT EntityType = "COMMENT"
+	EntityTypeMESSAGE EntityType = "MESSAGE"
+)
+
+func (et EntityType) String() string {
+	return string(et)
+}
+
+// EntityTypeValidator is a validator for the "entity_type" field enum values. It is called by the builders before save.
+func EntityTypeValidator(et EntityType) error {
+	switch et {
+	case EntityTypeTICKET, EntityTypeCOMMENT, EntityTypeMESSAGE:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for entity_type field: %q", et)
+	}
+}
+
+// ObjectLockMode defines the type for the "object_lock_mode" enum field.
+type ObjectLockMode string
+
+// ObjectLockMode values.
+const (
+	ObjectLockModeGOVERNANCE ObjectLockMode = "GOVERNANCE"
+	ObjectLockModeCOMPLIANCE ObjectLockMode = "COMPLIANCE"
+)
+
+func (olm ObjectLockMode) String() string {
+	return string(olm)
+}
+
+// ObjectLockModeValidator is a validator for the "object_lock_mode" field enum values. It is called by the builders before save.
+func ObjectLockModeValidator(olm ObjectLockMode) error {
+	switch olm {
+	case ObjectLockModeGOVERNANCE, ObjectLockModeCOMPLIANCE:
+		return nil
+	default:
+		return fmt.Errorf("file: invalid enum value for object_lock_mode field: %q", olm)
+	}
+}
+
 // OrderOption defines the ordering options for the File queries.
 type OrderOption func(*sql.Selector)
 
@@ -117,6 +455,11 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByCreatedBy orders the results by the created_by field.
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
@@ -137,6 +480,11 @@ func ByMimeType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMimeType, opts...).ToFunc()
 }
 
+// ByDetectedMimeType orders the results by the detected_mime_type field.
+func ByDetectedMimeType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDetectedMimeType, opts...).ToFunc()
+}
+
 // BySize orders the results by the size field.
 func BySize(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSize, opts...).ToFunc()
@@ -151,3 +499,314 @@ func ByPath(opts ...sql.OrderTermOption) OrderOption {
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
+
+// ByChecksumSha256 orders the results by the checksum_sha256 field.
+func ByChecksumSha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksumSha256, opts...).ToFunc()
+}
+
+// ByIntegrityStatus orders the results by the integrity_status field.
+func ByIntegrityStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntegrityStatus, opts...).ToFunc()
+}
+
+// ByIntegrityCheckedAt orders the results by the integrity_checked_at field.
+func ByIntegrityCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntegrityCheckedAt, opts...).ToFunc()
+}
+
+// ByThumbnailStatus orders the results by the thumbnail_status field.
+func ByThumbnailStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThumbnailStatus, opts...).ToFunc()
+}
+
+// ByPreviewStatus orders the results by the preview_status field.
+func ByPreviewStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviewStatus, opts...).ToFunc()
+}
+
+// ByPdfStatus orders the results by the pdf_status field.
+func ByPdfStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPdfStatus, opts...).ToFunc()
+}
+
+// ByPdfAttempts orders the results by the pdf_attempts field.
+func ByPdfAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPdfAttempts, opts...).ToFunc()
+}
+
+// ByPdfRetryAt orders the results by the pdf_retry_at field.
+func ByPdfRetryAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPdfRetryAt, opts...).ToFunc()
+}
+
+// ByScanStatus orders the results by the scan_status field.
+func ByScanStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanStatus, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByDepartmentID orders the results by the department_id field.
+func ByDepartmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDepartmentID, opts...).ToFunc()
+}
+
+// ByUploadSource orders the results by the upload_source field.
+func ByUploadSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadSource, opts...).ToFunc()
+}
+
+// ByClientVersion orders the results by the client_version field.
+func ByClientVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientVersion, opts...).ToFunc()
+}
+
+// ByDownloadCount orders the results by the download_count field.
+func ByDownloadCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadCount, opts...).ToFunc()
+}
+
+// ByLastDownloadedAt orders the results by the last_downloaded_at field.
+func ByLastDownloadedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastDownloadedAt, opts...).ToFunc()
+}
+
+// ByArchivedAt orders the results by the archived_at field.
+func ByArchivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArchivedAt, opts...).ToFunc()
+}
+
+// ByEntityType orders the results by the entity_type field.
+func ByEntityType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityType, opts...).ToFunc()
+}
+
+// ByEntityID orders the results by the entity_id field.
+func ByEntityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntityID, opts...).ToFunc()
+}
+
+// ByLockedBy orders the results by the locked_by field.
+func ByLockedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedBy, opts...).ToFunc()
+}
+
+// ByLockedAt orders the results by the locked_at field.
+func ByLockedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedAt, opts...).ToFunc()
+}
+
+// ByObjectLockMode orders the results by the object_lock_mode field.
+func ByObjectLockMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectLockMode, opts...).ToFunc()
+}
+
+// ByObjectLockRetainUntil orders the results by the object_lock_retain_until field.
+func ByObjectLockRetainUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldObjectLockRetainUntil, opts...).ToFunc()
+}
+
+// ByLegalHold orders the results by the legal_hold field.
+func ByLegalHold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegalHold, opts...).ToFunc()
+}
+
+// ByScheduledDeletionAt orders the results by the scheduled_deletion_at field.
+func ByScheduledDeletionAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledDeletionAt, opts...).ToFunc()
+}
+
+// ByScheduledDeletionBy orders the results by the scheduled_deletion_by field.
+func ByScheduledDeletionBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScheduledDeletionBy, opts...).ToFunc()
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFavoritesCount orders the results by favorites count.
+func ByFavoritesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFavoritesStep(), opts...)
+	}
+}
+
+// ByFavorites orders the results by favorites terms.
+func ByFavorites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFavoritesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newFavoritesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FavoritesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FavoritesTable, FavoritesColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e IntegrityStatus) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *IntegrityStatus) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = IntegrityStatus(str)
+	if err := IntegrityStatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid IntegrityStatus", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e ThumbnailStatus) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *ThumbnailStatus) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = ThumbnailStatus(str)
+	if err := ThumbnailStatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid ThumbnailStatus", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e PreviewStatus) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *PreviewStatus) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = PreviewStatus(str)
+	if err := PreviewStatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid PreviewStatus", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e PdfStatus) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *PdfStatus) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = PdfStatus(str)
+	if err := PdfStatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid PdfStatus", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e ScanStatus) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *ScanStatus) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = ScanStatus(str)
+	if err := ScanStatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid ScanStatus", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e UploadSource) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *UploadSource) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = UploadSource(str)
+	if err := UploadSourceValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid UploadSource", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e EntityType) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *EntityType) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = EntityType(str)
+	if err := EntityTypeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid EntityType", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e ObjectLockMode) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *ObjectLockMode) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = ObjectLockMode(str)
+	if err := ObjectLockModeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid ObjectLockMode", str)
+	}
+	return nil
+}
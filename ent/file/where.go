@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -70,6 +71,11 @@ func UpdateTime(v time.Time) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
 func CreatedBy(v uuid.UUID) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldCreatedBy, v))
@@ -90,6 +96,11 @@ func MimeType(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldMimeType, v))
 }
 
+// DetectedMimeType applies equality check predicate on the "detected_mime_type" field. It's identical to DetectedMimeTypeEQ.
+func DetectedMimeType(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDetectedMimeType, v))
+}
+
 // Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
 func Size(v int64) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldSize, v))
@@ -105,6 +116,91 @@ func Description(v string) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldDescription, v))
 }
 
+// ChecksumSha256 applies equality check predicate on the "checksum_sha256" field. It's identical to ChecksumSha256EQ.
+func ChecksumSha256(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldChecksumSha256, v))
+}
+
+// IntegrityCheckedAt applies equality check predicate on the "integrity_checked_at" field. It's identical to IntegrityCheckedAtEQ.
+func IntegrityCheckedAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldIntegrityCheckedAt, v))
+}
+
+// PdfAttempts applies equality check predicate on the "pdf_attempts" field. It's identical to PdfAttemptsEQ.
+func PdfAttempts(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPdfAttempts, v))
+}
+
+// PdfRetryAt applies equality check predicate on the "pdf_retry_at" field. It's identical to PdfRetryAtEQ.
+func PdfRetryAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPdfRetryAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// DepartmentID applies equality check predicate on the "department_id" field. It's identical to DepartmentIDEQ.
+func DepartmentID(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// ClientVersion applies equality check predicate on the "client_version" field. It's identical to ClientVersionEQ.
+func ClientVersion(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldClientVersion, v))
+}
+
+// DownloadCount applies equality check predicate on the "download_count" field. It's identical to DownloadCountEQ.
+func DownloadCount(v int64) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// LastDownloadedAt applies equality check predicate on the "last_downloaded_at" field. It's identical to LastDownloadedAtEQ.
+func LastDownloadedAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLastDownloadedAt, v))
+}
+
+// ArchivedAt applies equality check predicate on the "archived_at" field. It's identical to ArchivedAtEQ.
+func ArchivedAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldEntityID, v))
+}
+
+// LockedBy applies equality check predicate on the "locked_by" field. It's identical to LockedByEQ.
+func LockedBy(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// LockedAt applies equality check predicate on the "locked_at" field. It's identical to LockedAtEQ.
+func LockedAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// ObjectLockRetainUntil applies equality check predicate on the "object_lock_retain_until" field. It's identical to ObjectLockRetainUntilEQ.
+func ObjectLockRetainUntil(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldObjectLockRetainUntil, v))
+}
+
+// LegalHold applies equality check predicate on the "legal_hold" field. It's identical to LegalHoldEQ.
+func LegalHold(v bool) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLegalHold, v))
+}
+
+// ScheduledDeletionAt applies equality check predicate on the "scheduled_deletion_at" field. It's identical to ScheduledDeletionAtEQ.
+func ScheduledDeletionAt(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScheduledDeletionAt, v))
+}
+
+// ScheduledDeletionBy applies equality check predicate on the "scheduled_deletion_by" field. It's identical to ScheduledDeletionByEQ.
+func ScheduledDeletionBy(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScheduledDeletionBy, v))
+}
+
 // TenantIDEQ applies the EQ predicate on the "tenant_id" field.
 func TenantIDEQ(v uuid.UUID) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldTenantID, v))
@@ -225,6 +321,56 @@ func UpdateTimeLTE(v time.Time) predicate.File {
 	return predicate.File(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // CreatedByEQ applies the EQ predicate on the "created_by" field.
 func CreatedByEQ(v uuid.UUID) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldCreatedBy, v))
@@ -460,6 +606,81 @@ func MimeTypeContainsFold(v string) predicate.File {
 	return predicate.File(sql.FieldContainsFold(FieldMimeType, v))
 }
 
+// DetectedMimeTypeEQ applies the EQ predicate on the "detected_mime_type" field.
+func DetectedMimeTypeEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeNEQ applies the NEQ predicate on the "detected_mime_type" field.
+func DetectedMimeTypeNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeIn applies the In predicate on the "detected_mime_type" field.
+func DetectedMimeTypeIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldDetectedMimeType, vs...))
+}
+
+// DetectedMimeTypeNotIn applies the NotIn predicate on the "detected_mime_type" field.
+func DetectedMimeTypeNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldDetectedMimeType, vs...))
+}
+
+// DetectedMimeTypeGT applies the GT predicate on the "detected_mime_type" field.
+func DetectedMimeTypeGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeGTE applies the GTE predicate on the "detected_mime_type" field.
+func DetectedMimeTypeGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeLT applies the LT predicate on the "detected_mime_type" field.
+func DetectedMimeTypeLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeLTE applies the LTE predicate on the "detected_mime_type" field.
+func DetectedMimeTypeLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeContains applies the Contains predicate on the "detected_mime_type" field.
+func DetectedMimeTypeContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeHasPrefix applies the HasPrefix predicate on the "detected_mime_type" field.
+func DetectedMimeTypeHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeHasSuffix applies the HasSuffix predicate on the "detected_mime_type" field.
+func DetectedMimeTypeHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeIsNil applies the IsNil predicate on the "detected_mime_type" field.
+func DetectedMimeTypeIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldDetectedMimeType))
+}
+
+// DetectedMimeTypeNotNil applies the NotNil predicate on the "detected_mime_type" field.
+func DetectedMimeTypeNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldDetectedMimeType))
+}
+
+// DetectedMimeTypeEqualFold applies the EqualFold predicate on the "detected_mime_type" field.
+func DetectedMimeTypeEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldDetectedMimeType, v))
+}
+
+// DetectedMimeTypeContainsFold applies the ContainsFold predicate on the "detected_mime_type" field.
+func DetectedMimeTypeContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldDetectedMimeType, v))
+}
+
 // SizeEQ applies the EQ predicate on the "size" field.
 func SizeEQ(v int64) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldSize, v))
@@ -660,6 +881,1072 @@ func MetadataNotNil() predicate.File {
 	return predicate.File(sql.FieldNotNull(FieldMetadata))
 }
 
+// ChecksumSha256EQ applies the EQ predicate on the "checksum_sha256" field.
+func ChecksumSha256EQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256NEQ applies the NEQ predicate on the "checksum_sha256" field.
+func ChecksumSha256NEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256In applies the In predicate on the "checksum_sha256" field.
+func ChecksumSha256In(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldChecksumSha256, vs...))
+}
+
+// ChecksumSha256NotIn applies the NotIn predicate on the "checksum_sha256" field.
+func ChecksumSha256NotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldChecksumSha256, vs...))
+}
+
+// ChecksumSha256GT applies the GT predicate on the "checksum_sha256" field.
+func ChecksumSha256GT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256GTE applies the GTE predicate on the "checksum_sha256" field.
+func ChecksumSha256GTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256LT applies the LT predicate on the "checksum_sha256" field.
+func ChecksumSha256LT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256LTE applies the LTE predicate on the "checksum_sha256" field.
+func ChecksumSha256LTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256Contains applies the Contains predicate on the "checksum_sha256" field.
+func ChecksumSha256Contains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256HasPrefix applies the HasPrefix predicate on the "checksum_sha256" field.
+func ChecksumSha256HasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256HasSuffix applies the HasSuffix predicate on the "checksum_sha256" field.
+func ChecksumSha256HasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256IsNil applies the IsNil predicate on the "checksum_sha256" field.
+func ChecksumSha256IsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldChecksumSha256))
+}
+
+// ChecksumSha256NotNil applies the NotNil predicate on the "checksum_sha256" field.
+func ChecksumSha256NotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldChecksumSha256))
+}
+
+// ChecksumSha256EqualFold applies the EqualFold predicate on the "checksum_sha256" field.
+func ChecksumSha256EqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldChecksumSha256, v))
+}
+
+// ChecksumSha256ContainsFold applies the ContainsFold predicate on the "checksum_sha256" field.
+func ChecksumSha256ContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldChecksumSha256, v))
+}
+
+// IntegrityStatusEQ applies the EQ predicate on the "integrity_status" field.
+func IntegrityStatusEQ(v IntegrityStatus) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldIntegrityStatus, v))
+}
+
+// IntegrityStatusNEQ applies the NEQ predicate on the "integrity_status" field.
+func IntegrityStatusNEQ(v IntegrityStatus) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldIntegrityStatus, v))
+}
+
+// IntegrityStatusIn applies the In predicate on the "integrity_status" field.
+func IntegrityStatusIn(vs ...IntegrityStatus) predicate.File {
+	return predicate.File(sql.FieldIn(FieldIntegrityStatus, vs...))
+}
+
+// IntegrityStatusNotIn applies the NotIn predicate on the "integrity_status" field.
+func IntegrityStatusNotIn(vs ...IntegrityStatus) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldIntegrityStatus, vs...))
+}
+
+// IntegrityCheckedAtEQ applies the EQ predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldIntegrityCheckedAt, v))
+}
+
+// IntegrityCheckedAtNEQ applies the NEQ predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldIntegrityCheckedAt, v))
+}
+
+// IntegrityCheckedAtIn applies the In predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldIntegrityCheckedAt, vs...))
+}
+
+// IntegrityCheckedAtNotIn applies the NotIn predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldIntegrityCheckedAt, vs...))
+}
+
+// IntegrityCheckedAtGT applies the GT predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldIntegrityCheckedAt, v))
+}
+
+// IntegrityCheckedAtGTE applies the GTE predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldIntegrityCheckedAt, v))
+}
+
+// IntegrityCheckedAtLT applies the LT predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldIntegrityCheckedAt, v))
+}
+
+// IntegrityCheckedAtLTE applies the LTE predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldIntegrityCheckedAt, v))
+}
+
+// IntegrityCheckedAtIsNil applies the IsNil predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldIntegrityCheckedAt))
+}
+
+// IntegrityCheckedAtNotNil applies the NotNil predicate on the "integrity_checked_at" field.
+func IntegrityCheckedAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldIntegrityCheckedAt))
+}
+
+// ThumbnailStatusEQ applies the EQ predicate on the "thumbnail_status" field.
+func ThumbnailStatusEQ(v ThumbnailStatus) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldThumbnailStatus, v))
+}
+
+// ThumbnailStatusNEQ applies the NEQ predicate on the "thumbnail_status" field.
+func ThumbnailStatusNEQ(v ThumbnailStatus) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldThumbnailStatus, v))
+}
+
+// ThumbnailStatusIn applies the In predicate on the "thumbnail_status" field.
+func ThumbnailStatusIn(vs ...ThumbnailStatus) predicate.File {
+	return predicate.File(sql.FieldIn(FieldThumbnailStatus, vs...))
+}
+
+// ThumbnailStatusNotIn applies the NotIn predicate on the "thumbnail_status" field.
+func ThumbnailStatusNotIn(vs ...ThumbnailStatus) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldThumbnailStatus, vs...))
+}
+
+// PreviewStatusEQ applies the EQ predicate on the "preview_status" field.
+func PreviewStatusEQ(v PreviewStatus) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPreviewStatus, v))
+}
+
+// PreviewStatusNEQ applies the NEQ predicate on the "preview_status" field.
+func PreviewStatusNEQ(v PreviewStatus) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldPreviewStatus, v))
+}
+
+// PreviewStatusIn applies the In predicate on the "preview_status" field.
+func PreviewStatusIn(vs ...PreviewStatus) predicate.File {
+	return predicate.File(sql.FieldIn(FieldPreviewStatus, vs...))
+}
+
+// PreviewStatusNotIn applies the NotIn predicate on the "preview_status" field.
+func PreviewStatusNotIn(vs ...PreviewStatus) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldPreviewStatus, vs...))
+}
+
+// PdfStatusEQ applies the EQ predicate on the "pdf_status" field.
+func PdfStatusEQ(v PdfStatus) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPdfStatus, v))
+}
+
+// PdfStatusNEQ applies the NEQ predicate on the "pdf_status" field.
+func PdfStatusNEQ(v PdfStatus) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldPdfStatus, v))
+}
+
+// PdfStatusIn applies the In predicate on the "pdf_status" field.
+func PdfStatusIn(vs ...PdfStatus) predicate.File {
+	return predicate.File(sql.FieldIn(FieldPdfStatus, vs...))
+}
+
+// PdfStatusNotIn applies the NotIn predicate on the "pdf_status" field.
+func PdfStatusNotIn(vs ...PdfStatus) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldPdfStatus, vs...))
+}
+
+// PdfAttemptsEQ applies the EQ predicate on the "pdf_attempts" field.
+func PdfAttemptsEQ(v int) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPdfAttempts, v))
+}
+
+// PdfAttemptsNEQ applies the NEQ predicate on the "pdf_attempts" field.
+func PdfAttemptsNEQ(v int) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldPdfAttempts, v))
+}
+
+// PdfAttemptsIn applies the In predicate on the "pdf_attempts" field.
+func PdfAttemptsIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldIn(FieldPdfAttempts, vs...))
+}
+
+// PdfAttemptsNotIn applies the NotIn predicate on the "pdf_attempts" field.
+func PdfAttemptsNotIn(vs ...int) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldPdfAttempts, vs...))
+}
+
+// PdfAttemptsGT applies the GT predicate on the "pdf_attempts" field.
+func PdfAttemptsGT(v int) predicate.File {
+	return predicate.File(sql.FieldGT(FieldPdfAttempts, v))
+}
+
+// PdfAttemptsGTE applies the GTE predicate on the "pdf_attempts" field.
+func PdfAttemptsGTE(v int) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldPdfAttempts, v))
+}
+
+// PdfAttemptsLT applies the LT predicate on the "pdf_attempts" field.
+func PdfAttemptsLT(v int) predicate.File {
+	return predicate.File(sql.FieldLT(FieldPdfAttempts, v))
+}
+
+// PdfAttemptsLTE applies the LTE predicate on the "pdf_attempts" field.
+func PdfAttemptsLTE(v int) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldPdfAttempts, v))
+}
+
+// PdfRetryAtEQ applies the EQ predicate on the "pdf_retry_at" field.
+func PdfRetryAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldPdfRetryAt, v))
+}
+
+// PdfRetryAtNEQ applies the NEQ predicate on the "pdf_retry_at" field.
+func PdfRetryAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldPdfRetryAt, v))
+}
+
+// PdfRetryAtIn applies the In predicate on the "pdf_retry_at" field.
+func PdfRetryAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldPdfRetryAt, vs...))
+}
+
+// PdfRetryAtNotIn applies the NotIn predicate on the "pdf_retry_at" field.
+func PdfRetryAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldPdfRetryAt, vs...))
+}
+
+// PdfRetryAtGT applies the GT predicate on the "pdf_retry_at" field.
+func PdfRetryAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldPdfRetryAt, v))
+}
+
+// PdfRetryAtGTE applies the GTE predicate on the "pdf_retry_at" field.
+func PdfRetryAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldPdfRetryAt, v))
+}
+
+// PdfRetryAtLT applies the LT predicate on the "pdf_retry_at" field.
+func PdfRetryAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldPdfRetryAt, v))
+}
+
+// PdfRetryAtLTE applies the LTE predicate on the "pdf_retry_at" field.
+func PdfRetryAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldPdfRetryAt, v))
+}
+
+// PdfRetryAtIsNil applies the IsNil predicate on the "pdf_retry_at" field.
+func PdfRetryAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldPdfRetryAt))
+}
+
+// PdfRetryAtNotNil applies the NotNil predicate on the "pdf_retry_at" field.
+func PdfRetryAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldPdfRetryAt))
+}
+
+// ScanStatusEQ applies the EQ predicate on the "scan_status" field.
+func ScanStatusEQ(v ScanStatus) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanStatusNEQ applies the NEQ predicate on the "scan_status" field.
+func ScanStatusNEQ(v ScanStatus) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanStatus, v))
+}
+
+// ScanStatusIn applies the In predicate on the "scan_status" field.
+func ScanStatusIn(vs ...ScanStatus) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusNotIn applies the NotIn predicate on the "scan_status" field.
+func ScanStatusNotIn(vs ...ScanStatus) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// DepartmentIDEQ applies the EQ predicate on the "department_id" field.
+func DepartmentIDEQ(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDNEQ applies the NEQ predicate on the "department_id" field.
+func DepartmentIDNEQ(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldDepartmentID, v))
+}
+
+// DepartmentIDIn applies the In predicate on the "department_id" field.
+func DepartmentIDIn(vs ...uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDNotIn applies the NotIn predicate on the "department_id" field.
+func DepartmentIDNotIn(vs ...uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldDepartmentID, vs...))
+}
+
+// DepartmentIDGT applies the GT predicate on the "department_id" field.
+func DepartmentIDGT(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldGT(FieldDepartmentID, v))
+}
+
+// DepartmentIDGTE applies the GTE predicate on the "department_id" field.
+func DepartmentIDGTE(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldDepartmentID, v))
+}
+
+// DepartmentIDLT applies the LT predicate on the "department_id" field.
+func DepartmentIDLT(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldLT(FieldDepartmentID, v))
+}
+
+// DepartmentIDLTE applies the LTE predicate on the "department_id" field.
+func DepartmentIDLTE(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldDepartmentID, v))
+}
+
+// DepartmentIDIsNil applies the IsNil predicate on the "department_id" field.
+func DepartmentIDIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldDepartmentID))
+}
+
+// DepartmentIDNotNil applies the NotNil predicate on the "department_id" field.
+func DepartmentIDNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldDepartmentID))
+}
+
+// UploadSourceEQ applies the EQ predicate on the "upload_source" field.
+func UploadSourceEQ(v UploadSource) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldUploadSource, v))
+}
+
+// UploadSourceNEQ applies the NEQ predicate on the "upload_source" field.
+func UploadSourceNEQ(v UploadSource) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldUploadSource, v))
+}
+
+// UploadSourceIn applies the In predicate on the "upload_source" field.
+func UploadSourceIn(vs ...UploadSource) predicate.File {
+	return predicate.File(sql.FieldIn(FieldUploadSource, vs...))
+}
+
+// UploadSourceNotIn applies the NotIn predicate on the "upload_source" field.
+func UploadSourceNotIn(vs ...UploadSource) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldUploadSource, vs...))
+}
+
+// ClientVersionEQ applies the EQ predicate on the "client_version" field.
+func ClientVersionEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldClientVersion, v))
+}
+
+// ClientVersionNEQ applies the NEQ predicate on the "client_version" field.
+func ClientVersionNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldClientVersion, v))
+}
+
+// ClientVersionIn applies the In predicate on the "client_version" field.
+func ClientVersionIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldClientVersion, vs...))
+}
+
+// ClientVersionNotIn applies the NotIn predicate on the "client_version" field.
+func ClientVersionNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldClientVersion, vs...))
+}
+
+// ClientVersionGT applies the GT predicate on the "client_version" field.
+func ClientVersionGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldClientVersion, v))
+}
+
+// ClientVersionGTE applies the GTE predicate on the "client_version" field.
+func ClientVersionGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldClientVersion, v))
+}
+
+// ClientVersionLT applies the LT predicate on the "client_version" field.
+func ClientVersionLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldClientVersion, v))
+}
+
+// ClientVersionLTE applies the LTE predicate on the "client_version" field.
+func ClientVersionLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldClientVersion, v))
+}
+
+// ClientVersionContains applies the Contains predicate on the "client_version" field.
+func ClientVersionContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldClientVersion, v))
+}
+
+// ClientVersionHasPrefix applies the HasPrefix predicate on the "client_version" field.
+func ClientVersionHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldClientVersion, v))
+}
+
+// ClientVersionHasSuffix applies the HasSuffix predicate on the "client_version" field.
+func ClientVersionHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldClientVersion, v))
+}
+
+// ClientVersionIsNil applies the IsNil predicate on the "client_version" field.
+func ClientVersionIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldClientVersion))
+}
+
+// ClientVersionNotNil applies the NotNil predicate on the "client_version" field.
+func ClientVersionNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldClientVersion))
+}
+
+// ClientVersionEqualFold applies the EqualFold predicate on the "client_version" field.
+func ClientVersionEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldClientVersion, v))
+}
+
+// ClientVersionContainsFold applies the ContainsFold predicate on the "client_version" field.
+func ClientVersionContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldClientVersion, v))
+}
+
+// DownloadCountEQ applies the EQ predicate on the "download_count" field.
+func DownloadCountEQ(v int64) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountNEQ applies the NEQ predicate on the "download_count" field.
+func DownloadCountNEQ(v int64) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldDownloadCount, v))
+}
+
+// DownloadCountIn applies the In predicate on the "download_count" field.
+func DownloadCountIn(vs ...int64) predicate.File {
+	return predicate.File(sql.FieldIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountNotIn applies the NotIn predicate on the "download_count" field.
+func DownloadCountNotIn(vs ...int64) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldDownloadCount, vs...))
+}
+
+// DownloadCountGT applies the GT predicate on the "download_count" field.
+func DownloadCountGT(v int64) predicate.File {
+	return predicate.File(sql.FieldGT(FieldDownloadCount, v))
+}
+
+// DownloadCountGTE applies the GTE predicate on the "download_count" field.
+func DownloadCountGTE(v int64) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldDownloadCount, v))
+}
+
+// DownloadCountLT applies the LT predicate on the "download_count" field.
+func DownloadCountLT(v int64) predicate.File {
+	return predicate.File(sql.FieldLT(FieldDownloadCount, v))
+}
+
+// DownloadCountLTE applies the LTE predicate on the "download_count" field.
+func DownloadCountLTE(v int64) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldDownloadCount, v))
+}
+
+// LastDownloadedAtEQ applies the EQ predicate on the "last_downloaded_at" field.
+func LastDownloadedAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtNEQ applies the NEQ predicate on the "last_downloaded_at" field.
+func LastDownloadedAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtIn applies the In predicate on the "last_downloaded_at" field.
+func LastDownloadedAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldLastDownloadedAt, vs...))
+}
+
+// LastDownloadedAtNotIn applies the NotIn predicate on the "last_downloaded_at" field.
+func LastDownloadedAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldLastDownloadedAt, vs...))
+}
+
+// LastDownloadedAtGT applies the GT predicate on the "last_downloaded_at" field.
+func LastDownloadedAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtGTE applies the GTE predicate on the "last_downloaded_at" field.
+func LastDownloadedAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtLT applies the LT predicate on the "last_downloaded_at" field.
+func LastDownloadedAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtLTE applies the LTE predicate on the "last_downloaded_at" field.
+func LastDownloadedAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldLastDownloadedAt, v))
+}
+
+// LastDownloadedAtIsNil applies the IsNil predicate on the "last_downloaded_at" field.
+func LastDownloadedAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldLastDownloadedAt))
+}
+
+// LastDownloadedAtNotNil applies the NotNil predicate on the "last_downloaded_at" field.
+func LastDownloadedAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldLastDownloadedAt))
+}
+
+// ArchivedAtEQ applies the EQ predicate on the "archived_at" field.
+func ArchivedAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtNEQ applies the NEQ predicate on the "archived_at" field.
+func ArchivedAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldArchivedAt, v))
+}
+
+// ArchivedAtIn applies the In predicate on the "archived_at" field.
+func ArchivedAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtNotIn applies the NotIn predicate on the "archived_at" field.
+func ArchivedAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldArchivedAt, vs...))
+}
+
+// ArchivedAtGT applies the GT predicate on the "archived_at" field.
+func ArchivedAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldArchivedAt, v))
+}
+
+// ArchivedAtGTE applies the GTE predicate on the "archived_at" field.
+func ArchivedAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtLT applies the LT predicate on the "archived_at" field.
+func ArchivedAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldArchivedAt, v))
+}
+
+// ArchivedAtLTE applies the LTE predicate on the "archived_at" field.
+func ArchivedAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldArchivedAt, v))
+}
+
+// ArchivedAtIsNil applies the IsNil predicate on the "archived_at" field.
+func ArchivedAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldArchivedAt))
+}
+
+// ArchivedAtNotNil applies the NotNil predicate on the "archived_at" field.
+func ArchivedAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldArchivedAt))
+}
+
+// EntityTypeEQ applies the EQ predicate on the "entity_type" field.
+func EntityTypeEQ(v EntityType) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldEntityType, v))
+}
+
+// EntityTypeNEQ applies the NEQ predicate on the "entity_type" field.
+func EntityTypeNEQ(v EntityType) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldEntityType, v))
+}
+
+// EntityTypeIn applies the In predicate on the "entity_type" field.
+func EntityTypeIn(vs ...EntityType) predicate.File {
+	return predicate.File(sql.FieldIn(FieldEntityType, vs...))
+}
+
+// EntityTypeNotIn applies the NotIn predicate on the "entity_type" field.
+func EntityTypeNotIn(vs ...EntityType) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldEntityType, vs...))
+}
+
+// EntityTypeIsNil applies the IsNil predicate on the "entity_type" field.
+func EntityTypeIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldEntityType))
+}
+
+// EntityTypeNotNil applies the NotNil predicate on the "entity_type" field.
+func EntityTypeNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldEntityType))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// EntityIDGT applies the GT predicate on the "entity_id" field.
+func EntityIDGT(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldGT(FieldEntityID, v))
+}
+
+// EntityIDGTE applies the GTE predicate on the "entity_id" field.
+func EntityIDGTE(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldEntityID, v))
+}
+
+// EntityIDLT applies the LT predicate on the "entity_id" field.
+func EntityIDLT(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldLT(FieldEntityID, v))
+}
+
+// EntityIDLTE applies the LTE predicate on the "entity_id" field.
+func EntityIDLTE(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldEntityID, v))
+}
+
+// EntityIDIsNil applies the IsNil predicate on the "entity_id" field.
+func EntityIDIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldEntityID))
+}
+
+// EntityIDNotNil applies the NotNil predicate on the "entity_id" field.
+func EntityIDNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldEntityID))
+}
+
+// LockedByEQ applies the EQ predicate on the "locked_by" field.
+func LockedByEQ(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLockedBy, v))
+}
+
+// LockedByNEQ applies the NEQ predicate on the "locked_by" field.
+func LockedByNEQ(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldLockedBy, v))
+}
+
+// LockedByIn applies the In predicate on the "locked_by" field.
+func LockedByIn(vs ...uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldIn(FieldLockedBy, vs...))
+}
+
+// LockedByNotIn applies the NotIn predicate on the "locked_by" field.
+func LockedByNotIn(vs ...uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldLockedBy, vs...))
+}
+
+// LockedByGT applies the GT predicate on the "locked_by" field.
+func LockedByGT(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldGT(FieldLockedBy, v))
+}
+
+// LockedByGTE applies the GTE predicate on the "locked_by" field.
+func LockedByGTE(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldLockedBy, v))
+}
+
+// LockedByLT applies the LT predicate on the "locked_by" field.
+func LockedByLT(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldLT(FieldLockedBy, v))
+}
+
+// LockedByLTE applies the LTE predicate on the "locked_by" field.
+func LockedByLTE(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldLockedBy, v))
+}
+
+// LockedByIsNil applies the IsNil predicate on the "locked_by" field.
+func LockedByIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldLockedBy))
+}
+
+// LockedByNotNil applies the NotNil predicate on the "locked_by" field.
+func LockedByNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldLockedBy))
+}
+
+// LockedAtEQ applies the EQ predicate on the "locked_at" field.
+func LockedAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLockedAt, v))
+}
+
+// LockedAtNEQ applies the NEQ predicate on the "locked_at" field.
+func LockedAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldLockedAt, v))
+}
+
+// LockedAtIn applies the In predicate on the "locked_at" field.
+func LockedAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldLockedAt, vs...))
+}
+
+// LockedAtNotIn applies the NotIn predicate on the "locked_at" field.
+func LockedAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldLockedAt, vs...))
+}
+
+// LockedAtGT applies the GT predicate on the "locked_at" field.
+func LockedAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldLockedAt, v))
+}
+
+// LockedAtGTE applies the GTE predicate on the "locked_at" field.
+func LockedAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldLockedAt, v))
+}
+
+// LockedAtLT applies the LT predicate on the "locked_at" field.
+func LockedAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldLockedAt, v))
+}
+
+// LockedAtLTE applies the LTE predicate on the "locked_at" field.
+func LockedAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldLockedAt, v))
+}
+
+// LockedAtIsNil applies the IsNil predicate on the "locked_at" field.
+func LockedAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldLockedAt))
+}
+
+// LockedAtNotNil applies the NotNil predicate on the "locked_at" field.
+func LockedAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldLockedAt))
+}
+
+// ObjectLockModeEQ applies the EQ predicate on the "object_lock_mode" field.
+func ObjectLockModeEQ(v ObjectLockMode) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldObjectLockMode, v))
+}
+
+// ObjectLockModeNEQ applies the NEQ predicate on the "object_lock_mode" field.
+func ObjectLockModeNEQ(v ObjectLockMode) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldObjectLockMode, v))
+}
+
+// ObjectLockModeIn applies the In predicate on the "object_lock_mode" field.
+func ObjectLockModeIn(vs ...ObjectLockMode) predicate.File {
+	return predicate.File(sql.FieldIn(FieldObjectLockMode, vs...))
+}
+
+// ObjectLockModeNotIn applies the NotIn predicate on the "object_lock_mode" field.
+func ObjectLockModeNotIn(vs ...ObjectLockMode) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldObjectLockMode, vs...))
+}
+
+// ObjectLockModeIsNil applies the IsNil predicate on the "object_lock_mode" field.
+func ObjectLockModeIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldObjectLockMode))
+}
+
+// ObjectLockModeNotNil applies the NotNil predicate on the "object_lock_mode" field.
+func ObjectLockModeNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldObjectLockMode))
+}
+
+// ObjectLockRetainUntilEQ applies the EQ predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldObjectLockRetainUntil, v))
+}
+
+// ObjectLockRetainUntilNEQ applies the NEQ predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldObjectLockRetainUntil, v))
+}
+
+// ObjectLockRetainUntilIn applies the In predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldObjectLockRetainUntil, vs...))
+}
+
+// ObjectLockRetainUntilNotIn applies the NotIn predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldObjectLockRetainUntil, vs...))
+}
+
+// ObjectLockRetainUntilGT applies the GT predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldObjectLockRetainUntil, v))
+}
+
+// ObjectLockRetainUntilGTE applies the GTE predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldObjectLockRetainUntil, v))
+}
+
+// ObjectLockRetainUntilLT applies the LT predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldObjectLockRetainUntil, v))
+}
+
+// ObjectLockRetainUntilLTE applies the LTE predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldObjectLockRetainUntil, v))
+}
+
+// ObjectLockRetainUntilIsNil applies the IsNil predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldObjectLockRetainUntil))
+}
+
+// ObjectLockRetainUntilNotNil applies the NotNil predicate on the "object_lock_retain_until" field.
+func ObjectLockRetainUntilNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldObjectLockRetainUntil))
+}
+
+// LegalHoldEQ applies the EQ predicate on the "legal_hold" field.
+func LegalHoldEQ(v bool) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldLegalHold, v))
+}
+
+// LegalHoldNEQ applies the NEQ predicate on the "legal_hold" field.
+func LegalHoldNEQ(v bool) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldLegalHold, v))
+}
+
+// ScheduledDeletionAtEQ applies the EQ predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScheduledDeletionAt, v))
+}
+
+// ScheduledDeletionAtNEQ applies the NEQ predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtNEQ(v time.Time) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScheduledDeletionAt, v))
+}
+
+// ScheduledDeletionAtIn applies the In predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScheduledDeletionAt, vs...))
+}
+
+// ScheduledDeletionAtNotIn applies the NotIn predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtNotIn(vs ...time.Time) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScheduledDeletionAt, vs...))
+}
+
+// ScheduledDeletionAtGT applies the GT predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtGT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScheduledDeletionAt, v))
+}
+
+// ScheduledDeletionAtGTE applies the GTE predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtGTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScheduledDeletionAt, v))
+}
+
+// ScheduledDeletionAtLT applies the LT predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtLT(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScheduledDeletionAt, v))
+}
+
+// ScheduledDeletionAtLTE applies the LTE predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtLTE(v time.Time) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScheduledDeletionAt, v))
+}
+
+// ScheduledDeletionAtIsNil applies the IsNil predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScheduledDeletionAt))
+}
+
+// ScheduledDeletionAtNotNil applies the NotNil predicate on the "scheduled_deletion_at" field.
+func ScheduledDeletionAtNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScheduledDeletionAt))
+}
+
+// ScheduledDeletionByEQ applies the EQ predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByEQ(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScheduledDeletionBy, v))
+}
+
+// ScheduledDeletionByNEQ applies the NEQ predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByNEQ(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScheduledDeletionBy, v))
+}
+
+// ScheduledDeletionByIn applies the In predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByIn(vs ...uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScheduledDeletionBy, vs...))
+}
+
+// ScheduledDeletionByNotIn applies the NotIn predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByNotIn(vs ...uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScheduledDeletionBy, vs...))
+}
+
+// ScheduledDeletionByGT applies the GT predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByGT(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScheduledDeletionBy, v))
+}
+
+// ScheduledDeletionByGTE applies the GTE predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByGTE(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScheduledDeletionBy, v))
+}
+
+// ScheduledDeletionByLT applies the LT predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByLT(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScheduledDeletionBy, v))
+}
+
+// ScheduledDeletionByLTE applies the LTE predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByLTE(v uuid.UUID) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScheduledDeletionBy, v))
+}
+
+// ScheduledDeletionByIsNil applies the IsNil predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScheduledDeletionBy))
+}
+
+// ScheduledDeletionByNotNil applies the NotNil predicate on the "scheduled_deletion_by" field.
+func ScheduledDeletionByNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScheduledDeletionBy))
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFavorites applies the HasEdge predicate on the "favorites" edge.
+func HasFavorites() predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FavoritesTable, FavoritesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFavoritesWith applies the HasEdge predicate on the "favorites" edge with a given conditions (other predicates).
+func HasFavoritesWith(preds ...predicate.FileFavorite) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		step := newFavoritesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.File) predicate.File {
 	return predicate.File(sql.AndPredicates(predicates...))
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"main/ent/file"
+	"main/ent/filefavorite"
+	"main/ent/tag"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -55,6 +57,20 @@ func (_c *FileCreate) SetNillableUpdateTime(v *time.Time) *FileCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FileCreate) SetDeletedAt(v time.Time) *FileCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FileCreate) SetNillableDeletedAt(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_c *FileCreate) SetCreatedBy(v uuid.UUID) *FileCreate {
 	_c.mutation.SetCreatedBy(v)
@@ -79,6 +95,20 @@ func (_c *FileCreate) SetMimeType(v string) *FileCreate {
 	return _c
 }
 
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (_c *FileCreate) SetDetectedMimeType(v string) *FileCreate {
+	_c.mutation.SetDetectedMimeType(v)
+	return _c
+}
+
+// SetNillableDetectedMimeType sets the "detected_mime_type" field if the given value is not nil.
+func (_c *FileCreate) SetNillableDetectedMimeType(v *string) *FileCreate {
+	if v != nil {
+		_c.SetDetectedMimeType(*v)
+	}
+	return _c
+}
+
 // SetSize sets the "size" field.
 func (_c *FileCreate) SetSize(v int64) *FileCreate {
 	_c.mutation.SetSize(v)
@@ -119,6 +149,356 @@ func (_c *FileCreate) SetMetadata(v map[string]interface{}) *FileCreate {
 	return _c
 }
 
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (_c *FileCreate) SetChecksumSha256(v string) *FileCreate {
+	_c.mutation.SetChecksumSha256(v)
+	return _c
+}
+
+// SetNillableChecksumSha256 sets the "checksum_sha256" field if the given value is not nil.
+func (_c *FileCreate) SetNillableChecksumSha256(v *string) *FileCreate {
+	if v != nil {
+		_c.SetChecksumSha256(*v)
+	}
+	return _c
+}
+
+// SetIntegrityStatus sets the "integrity_status" field.
+func (_c *FileCreate) SetIntegrityStatus(v file.IntegrityStatus) *FileCreate {
+	_c.mutation.SetIntegrityStatus(v)
+	return _c
+}
+
+// SetNillableIntegrityStatus sets the "integrity_status" field if the given value is not nil.
+func (_c *FileCreate) SetNillableIntegrityStatus(v *file.IntegrityStatus) *FileCreate {
+	if v != nil {
+		_c.SetIntegrityStatus(*v)
+	}
+	return _c
+}
+
+// SetIntegrityCheckedAt sets the "integrity_checked_at" field.
+func (_c *FileCreate) SetIntegrityCheckedAt(v time.Time) *FileCreate {
+	_c.mutation.SetIntegrityCheckedAt(v)
+	return _c
+}
+
+// SetNillableIntegrityCheckedAt sets the "integrity_checked_at" field if the given value is not nil.
+func (_c *FileCreate) SetNillableIntegrityCheckedAt(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetIntegrityCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetThumbnailStatus sets the "thumbnail_status" field.
+func (_c *FileCreate) SetThumbnailStatus(v file.ThumbnailStatus) *FileCreate {
+	_c.mutation.SetThumbnailStatus(v)
+	return _c
+}
+
+// SetNillableThumbnailStatus sets the "thumbnail_status" field if the given value is not nil.
+func (_c *FileCreate) SetNillableThumbnailStatus(v *file.ThumbnailStatus) *FileCreate {
+	if v != nil {
+		_c.SetThumbnailStatus(*v)
+	}
+	return _c
+}
+
+// SetPreviewStatus sets the "preview_status" field.
+func (_c *FileCreate) SetPreviewStatus(v file.PreviewStatus) *FileCreate {
+	_c.mutation.SetPreviewStatus(v)
+	return _c
+}
+
+// SetNillablePreviewStatus sets the "preview_status" field if the given value is not nil.
+func (_c *FileCreate) SetNillablePreviewStatus(v *file.PreviewStatus) *FileCreate {
+	if v != nil {
+		_c.SetPreviewStatus(*v)
+	}
+	return _c
+}
+
+// SetPdfStatus sets the "pdf_status" field.
+func (_c *FileCreate) SetPdfStatus(v file.PdfStatus) *FileCreate {
+	_c.mutation.SetPdfStatus(v)
+	return _c
+}
+
+// SetNillablePdfStatus sets the "pdf_status" field if the given value is not nil.
+func (_c *FileCreate) SetNillablePdfStatus(v *file.PdfStatus) *FileCreate {
+	if v != nil {
+		_c.SetPdfStatus(*v)
+	}
+	return _c
+}
+
+// SetPdfAttempts sets the "pdf_attempts" field.
+func (_c *FileCreate) SetPdfAttempts(v int) *FileCreate {
+	_c.mutation.SetPdfAttempts(v)
+	return _c
+}
+
+// SetNillablePdfAttempts sets the "pdf_attempts" field if the given value is not nil.
+func (_c *FileCreate) SetNillablePdfAttempts(v *int) *FileCreate {
+	if v != nil {
+		_c.SetPdfAttempts(*v)
+	}
+	return _c
+}
+
+// SetPdfRetryAt sets the "pdf_retry_at" field.
+func (_c *FileCreate) SetPdfRetryAt(v time.Time) *FileCreate {
+	_c.mutation.SetPdfRetryAt(v)
+	return _c
+}
+
+// SetNillablePdfRetryAt sets the "pdf_retry_at" field if the given value is not nil.
+func (_c *FileCreate) SetNillablePdfRetryAt(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetPdfRetryAt(*v)
+	}
+	return _c
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_c *FileCreate) SetScanStatus(v file.ScanStatus) *FileCreate {
+	_c.mutation.SetScanStatus(v)
+	return _c
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScanStatus(v *file.ScanStatus) *FileCreate {
+	if v != nil {
+		_c.SetScanStatus(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *FileCreate) SetExpiresAt(v time.Time) *FileCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *FileCreate) SetNillableExpiresAt(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (_c *FileCreate) SetDepartmentID(v uuid.UUID) *FileCreate {
+	_c.mutation.SetDepartmentID(v)
+	return _c
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (_c *FileCreate) SetNillableDepartmentID(v *uuid.UUID) *FileCreate {
+	if v != nil {
+		_c.SetDepartmentID(*v)
+	}
+	return _c
+}
+
+// SetUploadSource sets the "upload_source" field.
+func (_c *FileCreate) SetUploadSource(v file.UploadSource) *FileCreate {
+	_c.mutation.SetUploadSource(v)
+	return _c
+}
+
+// SetNillableUploadSource sets the "upload_source" field if the given value is not nil.
+func (_c *FileCreate) SetNillableUploadSource(v *file.UploadSource) *FileCreate {
+	if v != nil {
+		_c.SetUploadSource(*v)
+	}
+	return _c
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_c *FileCreate) SetClientVersion(v string) *FileCreate {
+	_c.mutation.SetClientVersion(v)
+	return _c
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_c *FileCreate) SetNillableClientVersion(v *string) *FileCreate {
+	if v != nil {
+		_c.SetClientVersion(*v)
+	}
+	return _c
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_c *FileCreate) SetDownloadCount(v int64) *FileCreate {
+	_c.mutation.SetDownloadCount(v)
+	return _c
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_c *FileCreate) SetNillableDownloadCount(v *int64) *FileCreate {
+	if v != nil {
+		_c.SetDownloadCount(*v)
+	}
+	return _c
+}
+
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (_c *FileCreate) SetLastDownloadedAt(v time.Time) *FileCreate {
+	_c.mutation.SetLastDownloadedAt(v)
+	return _c
+}
+
+// SetNillableLastDownloadedAt sets the "last_downloaded_at" field if the given value is not nil.
+func (_c *FileCreate) SetNillableLastDownloadedAt(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetLastDownloadedAt(*v)
+	}
+	return _c
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_c *FileCreate) SetArchivedAt(v time.Time) *FileCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *FileCreate) SetNillableArchivedAt(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_c *FileCreate) SetEntityType(v file.EntityType) *FileCreate {
+	_c.mutation.SetEntityType(v)
+	return _c
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_c *FileCreate) SetNillableEntityType(v *file.EntityType) *FileCreate {
+	if v != nil {
+		_c.SetEntityType(*v)
+	}
+	return _c
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_c *FileCreate) SetEntityID(v uuid.UUID) *FileCreate {
+	_c.mutation.SetEntityID(v)
+	return _c
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_c *FileCreate) SetNillableEntityID(v *uuid.UUID) *FileCreate {
+	if v != nil {
+		_c.SetEntityID(*v)
+	}
+	return _c
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_c *FileCreate) SetLockedBy(v uuid.UUID) *FileCreate {
+	_c.mutation.SetLockedBy(v)
+	return _c
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_c *FileCreate) SetNillableLockedBy(v *uuid.UUID) *FileCreate {
+	if v != nil {
+		_c.SetLockedBy(*v)
+	}
+	return _c
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_c *FileCreate) SetLockedAt(v time.Time) *FileCreate {
+	_c.mutation.SetLockedAt(v)
+	return _c
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_c *FileCreate) SetNillableLockedAt(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetLockedAt(*v)
+	}
+	return _c
+}
+
+// SetObjectLockMode sets the "object_lock_mode" field.
+func (_c *FileCreate) SetObjectLockMode(v file.ObjectLockMode) *FileCreate {
+	_c.mutation.SetObjectLockMode(v)
+	return _c
+}
+
+// SetNillableObjectLockMode sets the "object_lock_mode" field if the given value is not nil.
+func (_c *FileCreate) SetNillableObjectLockMode(v *file.ObjectLockMode) *FileCreate {
+	if v != nil {
+		_c.SetObjectLockMode(*v)
+	}
+	return _c
+}
+
+// SetObjectLockRetainUntil sets the "object_lock_retain_until" field.
+func (_c *FileCreate) SetObjectLockRetainUntil(v time.Time) *FileCreate {
+	_c.mutation.SetObjectLockRetainUntil(v)
+	return _c
+}
+
+// SetNillableObjectLockRetainUntil sets the "object_lock_retain_until" field if the given value is not nil.
+func (_c *FileCreate) SetNillableObjectLockRetainUntil(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetObjectLockRetainUntil(*v)
+	}
+	return _c
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (_c *FileCreate) SetLegalHold(v bool) *FileCreate {
+	_c.mutation.SetLegalHold(v)
+	return _c
+}
+
+// SetNillableLegalHold sets the "legal_hold" field if the given value is not nil.
+func (_c *FileCreate) SetNillableLegalHold(v *bool) *FileCreate {
+	if v != nil {
+		_c.SetLegalHold(*v)
+	}
+	return _c
+}
+
+// SetScheduledDeletionAt sets the "scheduled_deletion_at" field.
+func (_c *FileCreate) SetScheduledDeletionAt(v time.Time) *FileCreate {
+	_c.mutation.SetScheduledDeletionAt(v)
+	return _c
+}
+
+// SetNillableScheduledDeletionAt sets the "scheduled_deletion_at" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScheduledDeletionAt(v *time.Time) *FileCreate {
+	if v != nil {
+		_c.SetScheduledDeletionAt(*v)
+	}
+	return _c
+}
+
+// SetScheduledDeletionBy sets the "scheduled_deletion_by" field.
+func (_c *FileCreate) SetScheduledDeletionBy(v uuid.UUID) *FileCreate {
+	_c.mutation.SetScheduledDeletionBy(v)
+	return _c
+}
+
+// SetNillableScheduledDeletionBy sets the "scheduled_deletion_by" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScheduledDeletionBy(v *uuid.UUID) *FileCreate {
+	if v != nil {
+		_c.SetScheduledDeletionBy(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FileCreate) SetID(v uuid.UUID) *FileCreate {
 	_c.mutation.SetID(v)
@@ -133,6 +513,36 @@ func (_c *FileCreate) SetNillableID(v *uuid.UUID) *FileCreate {
 	return _c
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *FileCreate) AddTagIDs(ids ...uuid.UUID) *FileCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *FileCreate) AddTags(v ...*Tag) *FileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the FileFavorite entity by IDs.
+func (_c *FileCreate) AddFavoriteIDs(ids ...uuid.UUID) *FileCreate {
+	_c.mutation.AddFavoriteIDs(ids...)
+	return _c
+}
+
+// AddFavorites adds the "favorites" edges to the FileFavorite entity.
+func (_c *FileCreate) AddFavorites(v ...*FileFavorite) *FileCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFavoriteIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (_c *FileCreate) Mutation() *FileMutation {
 	return _c.mutation
@@ -184,6 +594,42 @@ func (_c *FileCreate) defaults() error {
 		v := file.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
+	if _, ok := _c.mutation.IntegrityStatus(); !ok {
+		v := file.DefaultIntegrityStatus
+		_c.mutation.SetIntegrityStatus(v)
+	}
+	if _, ok := _c.mutation.ThumbnailStatus(); !ok {
+		v := file.DefaultThumbnailStatus
+		_c.mutation.SetThumbnailStatus(v)
+	}
+	if _, ok := _c.mutation.PreviewStatus(); !ok {
+		v := file.DefaultPreviewStatus
+		_c.mutation.SetPreviewStatus(v)
+	}
+	if _, ok := _c.mutation.PdfStatus(); !ok {
+		v := file.DefaultPdfStatus
+		_c.mutation.SetPdfStatus(v)
+	}
+	if _, ok := _c.mutation.PdfAttempts(); !ok {
+		v := file.DefaultPdfAttempts
+		_c.mutation.SetPdfAttempts(v)
+	}
+	if _, ok := _c.mutation.ScanStatus(); !ok {
+		v := file.DefaultScanStatus
+		_c.mutation.SetScanStatus(v)
+	}
+	if _, ok := _c.mutation.UploadSource(); !ok {
+		v := file.DefaultUploadSource
+		_c.mutation.SetUploadSource(v)
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		v := file.DefaultDownloadCount
+		_c.mutation.SetDownloadCount(v)
+	}
+	if _, ok := _c.mutation.LegalHold(); !ok {
+		v := file.DefaultLegalHold
+		_c.mutation.SetLegalHold(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		if file.DefaultID == nil {
 			return fmt.Errorf("ent: uninitialized file.DefaultID (forgotten import ent/runtime?)")
@@ -240,6 +686,93 @@ func (_c *FileCreate) check() error {
 			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.ChecksumSha256(); ok {
+		if err := file.ChecksumSha256Validator(v); err != nil {
+			return &ValidationError{Name: "checksum_sha256", err: fmt.Errorf(`ent: validator failed for field "File.checksum_sha256": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IntegrityStatus(); !ok {
+		return &ValidationError{Name: "integrity_status", err: errors.New(`ent: missing required field "File.integrity_status"`)}
+	}
+	if v, ok := _c.mutation.IntegrityStatus(); ok {
+		if err := file.IntegrityStatusValidator(v); err != nil {
+			return &ValidationError{Name: "integrity_status", err: fmt.Errorf(`ent: validator failed for field "File.integrity_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ThumbnailStatus(); !ok {
+		return &ValidationError{Name: "thumbnail_status", err: errors.New(`ent: missing required field "File.thumbnail_status"`)}
+	}
+	if v, ok := _c.mutation.ThumbnailStatus(); ok {
+		if err := file.ThumbnailStatusValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_status", err: fmt.Errorf(`ent: validator failed for field "File.thumbnail_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PreviewStatus(); !ok {
+		return &ValidationError{Name: "preview_status", err: errors.New(`ent: missing required field "File.preview_status"`)}
+	}
+	if v, ok := _c.mutation.PreviewStatus(); ok {
+		if err := file.PreviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "preview_status", err: fmt.Errorf(`ent: validator failed for field "File.preview_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PdfStatus(); !ok {
+		return &ValidationError{Name: "pdf_status", err: errors.New(`ent: missing required field "File.pdf_status"`)}
+	}
+	if v, ok := _c.mutation.PdfStatus(); ok {
+		if err := file.PdfStatusValidator(v); err != nil {
+			return &ValidationError{Name: "pdf_status", err: fmt.Errorf(`ent: validator failed for field "File.pdf_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PdfAttempts(); !ok {
+		return &ValidationError{Name: "pdf_attempts", err: errors.New(`ent: missing required field "File.pdf_attempts"`)}
+	}
+	if v, ok := _c.mutation.PdfAttempts(); ok {
+		if err := file.PdfAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "pdf_attempts", err: fmt.Errorf(`ent: validator failed for field "File.pdf_attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ScanStatus(); !ok {
+		return &ValidationError{Name: "scan_status", err: errors.New(`ent: missing required field "File.scan_status"`)}
+	}
+	if v, ok := _c.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UploadSource(); !ok {
+		return &ValidationError{Name: "upload_source", err: errors.New(`ent: missing required field "File.upload_source"`)}
+	}
+	if v, ok := _c.mutation.UploadSource(); ok {
+		if err := file.UploadSourceValidator(v); err != nil {
+			return &ValidationError{Name: "upload_source", err: fmt.Errorf(`ent: validator failed for field "File.upload_source": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ClientVersion(); ok {
+		if err := file.ClientVersionValidator(v); err != nil {
+			return &ValidationError{Name: "client_version", err: fmt.Errorf(`ent: validator failed for field "File.client_version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DownloadCount(); !ok {
+		return &ValidationError{Name: "download_count", err: errors.New(`ent: missing required field "File.download_count"`)}
+	}
+	if v, ok := _c.mutation.DownloadCount(); ok {
+		if err := file.DownloadCountValidator(v); err != nil {
+			return &ValidationError{Name: "download_count", err: fmt.Errorf(`ent: validator failed for field "File.download_count": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EntityType(); ok {
+		if err := file.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "File.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ObjectLockMode(); ok {
+		if err := file.ObjectLockModeValidator(v); err != nil {
+			return &ValidationError{Name: "object_lock_mode", err: fmt.Errorf(`ent: validator failed for field "File.object_lock_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LegalHold(); !ok {
+		return &ValidationError{Name: "legal_hold", err: errors.New(`ent: missing required field "File.legal_hold"`)}
+	}
 	return nil
 }
 
@@ -287,6 +820,10 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(file.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
 	if value, ok := _c.mutation.CreatedBy(); ok {
 		_spec.SetField(file.FieldCreatedBy, field.TypeUUID, value)
 		_node.CreatedBy = value
@@ -303,6 +840,10 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldMimeType, field.TypeString, value)
 		_node.MimeType = value
 	}
+	if value, ok := _c.mutation.DetectedMimeType(); ok {
+		_spec.SetField(file.FieldDetectedMimeType, field.TypeString, value)
+		_node.DetectedMimeType = value
+	}
 	if value, ok := _c.mutation.Size(); ok {
 		_spec.SetField(file.FieldSize, field.TypeInt64, value)
 		_node.Size = value
@@ -319,6 +860,138 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldMetadata, field.TypeJSON, value)
 		_node.Metadata = value
 	}
+	if value, ok := _c.mutation.ChecksumSha256(); ok {
+		_spec.SetField(file.FieldChecksumSha256, field.TypeString, value)
+		_node.ChecksumSha256 = value
+	}
+	if value, ok := _c.mutation.IntegrityStatus(); ok {
+		_spec.SetField(file.FieldIntegrityStatus, field.TypeEnum, value)
+		_node.IntegrityStatus = value
+	}
+	if value, ok := _c.mutation.IntegrityCheckedAt(); ok {
+		_spec.SetField(file.FieldIntegrityCheckedAt, field.TypeTime, value)
+		_node.IntegrityCheckedAt = &value
+	}
+	if value, ok := _c.mutation.ThumbnailStatus(); ok {
+		_spec.SetField(file.FieldThumbnailStatus, field.TypeEnum, value)
+		_node.ThumbnailStatus = value
+	}
+	if value, ok := _c.mutation.PreviewStatus(); ok {
+		_spec.SetField(file.FieldPreviewStatus, field.TypeEnum, value)
+		_node.PreviewStatus = value
+	}
+	if value, ok := _c.mutation.PdfStatus(); ok {
+		_spec.SetField(file.FieldPdfStatus, field.TypeEnum, value)
+		_node.PdfStatus = value
+	}
+	if value, ok := _c.mutation.PdfAttempts(); ok {
+		_spec.SetField(file.FieldPdfAttempts, field.TypeInt, value)
+		_node.PdfAttempts = value
+	}
+	if value, ok := _c.mutation.PdfRetryAt(); ok {
+		_spec.SetField(file.FieldPdfRetryAt, field.TypeTime, value)
+		_node.PdfRetryAt = &value
+	}
+	if value, ok := _c.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+		_node.ScanStatus = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(file.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.DepartmentID(); ok {
+		_spec.SetField(file.FieldDepartmentID, field.TypeUUID, value)
+		_node.DepartmentID = &value
+	}
+	if value, ok := _c.mutation.UploadSource(); ok {
+		_spec.SetField(file.FieldUploadSource, field.TypeEnum, value)
+		_node.UploadSource = value
+	}
+	if value, ok := _c.mutation.ClientVersion(); ok {
+		_spec.SetField(file.FieldClientVersion, field.TypeString, value)
+		_node.ClientVersion = value
+	}
+	if value, ok := _c.mutation.DownloadCount(); ok {
+		_spec.SetField(file.FieldDownloadCount, field.TypeInt64, value)
+		_node.DownloadCount = value
+	}
+	if value, ok := _c.mutation.LastDownloadedAt(); ok {
+		_spec.SetField(file.FieldLastDownloadedAt, field.TypeTime, value)
+		_node.LastDownloadedAt = &value
+	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(file.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
+	if value, ok := _c.mutation.EntityType(); ok {
+		_spec.SetField(file.FieldEntityType, field.TypeEnum, value)
+		_node.EntityType = &value
+	}
+	if value, ok := _c.mutation.EntityID(); ok {
+		_spec.SetField(file.FieldEntityID, field.TypeUUID, value)
+		_node.EntityID = &value
+	}
+	if value, ok := _c.mutation.LockedBy(); ok {
+		_spec.SetField(file.FieldLockedBy, field.TypeUUID, value)
+		_node.LockedBy = &value
+	}
+	if value, ok := _c.mutation.LockedAt(); ok {
+		_spec.SetField(file.FieldLockedAt, field.TypeTime, value)
+		_node.LockedAt = &value
+	}
+	if value, ok := _c.mutation.ObjectLockMode(); ok {
+		_spec.SetField(file.FieldObjectLockMode, field.TypeEnum, value)
+		_node.ObjectLockMode = &value
+	}
+	if value, ok := _c.mutation.ObjectLockRetainUntil(); ok {
+		_spec.SetField(file.FieldObjectLockRetainUntil, field.TypeTime, value)
+		_node.ObjectLockRetainUntil = &value
+	}
+	if value, ok := _c.mutation.LegalHold(); ok {
+		_spec.SetField(file.FieldLegalHold, field.TypeBool, value)
+		_node.LegalHold = value
+	}
+	if value, ok := _c.mutation.ScheduledDeletionAt(); ok {
+		_spec.SetField(file.FieldScheduledDeletionAt, field.TypeTime, value)
+		_node.ScheduledDeletionAt = &value
+	}
+	if value, ok := _c.mutation.ScheduledDeletionBy(); ok {
+		_spec.SetField(file.FieldScheduledDeletionBy, field.TypeUUID, value)
+		_node.ScheduledDeletionBy = &value
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   file.TagsTable,
+			Columns: file.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.FavoritesTable,
+			Columns: []string{file.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
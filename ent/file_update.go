@@ -7,12 +7,15 @@ import (
 	"errors"
 	"fmt"
 	"main/ent/file"
+	"main/ent/filefavorite"
 	"main/ent/predicate"
+	"main/ent/tag"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // FileUpdate is the builder for updating File entities.
@@ -35,6 +38,26 @@ func (_u *FileUpdate) SetUpdateTime(v time.Time) *FileUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FileUpdate) SetDeletedAt(v time.Time) *FileUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableDeletedAt(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FileUpdate) ClearDeletedAt() *FileUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetOriginalName sets the "original_name" field.
 func (_u *FileUpdate) SetOriginalName(v string) *FileUpdate {
 	_u.mutation.SetOriginalName(v)
@@ -77,6 +100,26 @@ func (_u *FileUpdate) SetNillableMimeType(v *string) *FileUpdate {
 	return _u
 }
 
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (_u *FileUpdate) SetDetectedMimeType(v string) *FileUpdate {
+	_u.mutation.SetDetectedMimeType(v)
+	return _u
+}
+
+// SetNillableDetectedMimeType sets the "detected_mime_type" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableDetectedMimeType(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetDetectedMimeType(*v)
+	}
+	return _u
+}
+
+// ClearDetectedMimeType clears the value of the "detected_mime_type" field.
+func (_u *FileUpdate) ClearDetectedMimeType() *FileUpdate {
+	_u.mutation.ClearDetectedMimeType()
+	return _u
+}
+
 // SetSize sets the "size" field.
 func (_u *FileUpdate) SetSize(v int64) *FileUpdate {
 	_u.mutation.ResetSize()
@@ -150,280 +193,1675 @@ func (_u *FileUpdate) ClearMetadata() *FileUpdate {
 	return _u
 }
 
-// Mutation returns the FileMutation object of the builder.
-func (_u *FileUpdate) Mutation() *FileMutation {
-	return _u.mutation
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (_u *FileUpdate) SetChecksumSha256(v string) *FileUpdate {
+	_u.mutation.SetChecksumSha256(v)
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *FileUpdate) Save(ctx context.Context) (int, error) {
-	if err := _u.defaults(); err != nil {
-		return 0, err
+// SetNillableChecksumSha256 sets the "checksum_sha256" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableChecksumSha256(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetChecksumSha256(*v)
 	}
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *FileUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// ClearChecksumSha256 clears the value of the "checksum_sha256" field.
+func (_u *FileUpdate) ClearChecksumSha256() *FileUpdate {
+	_u.mutation.ClearChecksumSha256()
+	return _u
+}
+
+// SetIntegrityStatus sets the "integrity_status" field.
+func (_u *FileUpdate) SetIntegrityStatus(v file.IntegrityStatus) *FileUpdate {
+	_u.mutation.SetIntegrityStatus(v)
+	return _u
+}
+
+// SetNillableIntegrityStatus sets the "integrity_status" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableIntegrityStatus(v *file.IntegrityStatus) *FileUpdate {
+	if v != nil {
+		_u.SetIntegrityStatus(*v)
 	}
-	return affected
+	return _u
 }
 
-// Exec executes the query.
-func (_u *FileUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// SetIntegrityCheckedAt sets the "integrity_checked_at" field.
+func (_u *FileUpdate) SetIntegrityCheckedAt(v time.Time) *FileUpdate {
+	_u.mutation.SetIntegrityCheckedAt(v)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *FileUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// SetNillableIntegrityCheckedAt sets the "integrity_checked_at" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableIntegrityCheckedAt(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetIntegrityCheckedAt(*v)
 	}
+	return _u
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *FileUpdate) defaults() error {
-	if _, ok := _u.mutation.UpdateTime(); !ok {
-		if file.UpdateDefaultUpdateTime == nil {
-			return fmt.Errorf("ent: uninitialized file.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
-		}
-		v := file.UpdateDefaultUpdateTime()
-		_u.mutation.SetUpdateTime(v)
+// ClearIntegrityCheckedAt clears the value of the "integrity_checked_at" field.
+func (_u *FileUpdate) ClearIntegrityCheckedAt() *FileUpdate {
+	_u.mutation.ClearIntegrityCheckedAt()
+	return _u
+}
+
+// SetThumbnailStatus sets the "thumbnail_status" field.
+func (_u *FileUpdate) SetThumbnailStatus(v file.ThumbnailStatus) *FileUpdate {
+	_u.mutation.SetThumbnailStatus(v)
+	return _u
+}
+
+// SetNillableThumbnailStatus sets the "thumbnail_status" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableThumbnailStatus(v *file.ThumbnailStatus) *FileUpdate {
+	if v != nil {
+		_u.SetThumbnailStatus(*v)
 	}
-	return nil
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *FileUpdate) check() error {
-	if v, ok := _u.mutation.OriginalName(); ok {
-		if err := file.OriginalNameValidator(v); err != nil {
-			return &ValidationError{Name: "original_name", err: fmt.Errorf(`ent: validator failed for field "File.original_name": %w`, err)}
-		}
+// SetPreviewStatus sets the "preview_status" field.
+func (_u *FileUpdate) SetPreviewStatus(v file.PreviewStatus) *FileUpdate {
+	_u.mutation.SetPreviewStatus(v)
+	return _u
+}
+
+// SetNillablePreviewStatus sets the "preview_status" field if the given value is not nil.
+func (_u *FileUpdate) SetNillablePreviewStatus(v *file.PreviewStatus) *FileUpdate {
+	if v != nil {
+		_u.SetPreviewStatus(*v)
 	}
-	if v, ok := _u.mutation.StorageKey(); ok {
-		if err := file.StorageKeyValidator(v); err != nil {
-			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "File.storage_key": %w`, err)}
-		}
+	return _u
+}
+
+// SetPdfStatus sets the "pdf_status" field.
+func (_u *FileUpdate) SetPdfStatus(v file.PdfStatus) *FileUpdate {
+	_u.mutation.SetPdfStatus(v)
+	return _u
+}
+
+// SetNillablePdfStatus sets the "pdf_status" field if the given value is not nil.
+func (_u *FileUpdate) SetNillablePdfStatus(v *file.PdfStatus) *FileUpdate {
+	if v != nil {
+		_u.SetPdfStatus(*v)
 	}
-	if v, ok := _u.mutation.MimeType(); ok {
-		if err := file.MimeTypeValidator(v); err != nil {
-			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "File.mime_type": %w`, err)}
-		}
+	return _u
+}
+
+// SetPdfAttempts sets the "pdf_attempts" field.
+func (_u *FileUpdate) SetPdfAttempts(v int) *FileUpdate {
+	_u.mutation.ResetPdfAttempts()
+	_u.mutation.SetPdfAttempts(v)
+	return _u
+}
+
+// SetNillablePdfAttempts sets the "pdf_attempts" field if the given value is not nil.
+func (_u *FileUpdate) SetNillablePdfAttempts(v *int) *FileUpdate {
+	if v != nil {
+		_u.SetPdfAttempts(*v)
 	}
-	if v, ok := _u.mutation.Size(); ok {
-		if err := file.SizeValidator(v); err != nil {
-			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
-		}
+	return _u
+}
+
+// AddPdfAttempts adds value to the "pdf_attempts" field.
+func (_u *FileUpdate) AddPdfAttempts(v int) *FileUpdate {
+	_u.mutation.AddPdfAttempts(v)
+	return _u
+}
+
+// SetPdfRetryAt sets the "pdf_retry_at" field.
+func (_u *FileUpdate) SetPdfRetryAt(v time.Time) *FileUpdate {
+	_u.mutation.SetPdfRetryAt(v)
+	return _u
+}
+
+// SetNillablePdfRetryAt sets the "pdf_retry_at" field if the given value is not nil.
+func (_u *FileUpdate) SetNillablePdfRetryAt(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetPdfRetryAt(*v)
 	}
-	return nil
+	return _u
 }
 
-// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
-func (_u *FileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileUpdate {
-	_u.modifiers = append(_u.modifiers, modifiers...)
+// ClearPdfRetryAt clears the value of the "pdf_retry_at" field.
+func (_u *FileUpdate) ClearPdfRetryAt() *FileUpdate {
+	_u.mutation.ClearPdfRetryAt()
 	return _u
 }
 
-func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdate) SetScanStatus(v file.ScanStatus) *FileUpdate {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScanStatus(v *file.ScanStatus) *FileUpdate {
+	if v != nil {
+		_u.SetScanStatus(*v)
 	}
-	_spec := sqlgraph.NewUpdateSpec(file.Table, file.Columns, sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *FileUpdate) SetExpiresAt(v time.Time) *FileUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableExpiresAt(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
 	}
-	if value, ok := _u.mutation.UpdateTime(); ok {
-		_spec.SetField(file.FieldUpdateTime, field.TypeTime, value)
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *FileUpdate) ClearExpiresAt() *FileUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (_u *FileUpdate) SetDepartmentID(v uuid.UUID) *FileUpdate {
+	_u.mutation.SetDepartmentID(v)
+	return _u
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableDepartmentID(v *uuid.UUID) *FileUpdate {
+	if v != nil {
+		_u.SetDepartmentID(*v)
 	}
-	if value, ok := _u.mutation.OriginalName(); ok {
-		_spec.SetField(file.FieldOriginalName, field.TypeString, value)
+	return _u
+}
+
+// ClearDepartmentID clears the value of the "department_id" field.
+func (_u *FileUpdate) ClearDepartmentID() *FileUpdate {
+	_u.mutation.ClearDepartmentID()
+	return _u
+}
+
+// SetUploadSource sets the "upload_source" field.
+func (_u *FileUpdate) SetUploadSource(v file.UploadSource) *FileUpdate {
+	_u.mutation.SetUploadSource(v)
+	return _u
+}
+
+// SetNillableUploadSource sets the "upload_source" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableUploadSource(v *file.UploadSource) *FileUpdate {
+	if v != nil {
+		_u.SetUploadSource(*v)
 	}
-	if value, ok := _u.mutation.StorageKey(); ok {
-		_spec.SetField(file.FieldStorageKey, field.TypeString, value)
+	return _u
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_u *FileUpdate) SetClientVersion(v string) *FileUpdate {
+	_u.mutation.SetClientVersion(v)
+	return _u
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableClientVersion(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetClientVersion(*v)
 	}
-	if value, ok := _u.mutation.MimeType(); ok {
-		_spec.SetField(file.FieldMimeType, field.TypeString, value)
+	return _u
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (_u *FileUpdate) ClearClientVersion() *FileUpdate {
+	_u.mutation.ClearClientVersion()
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *FileUpdate) SetDownloadCount(v int64) *FileUpdate {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableDownloadCount(v *int64) *FileUpdate {
+	if v != nil {
+		_u.SetDownloadCount(*v)
 	}
-	if value, ok := _u.mutation.Size(); ok {
-		_spec.SetField(file.FieldSize, field.TypeInt64, value)
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *FileUpdate) AddDownloadCount(v int64) *FileUpdate {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (_u *FileUpdate) SetLastDownloadedAt(v time.Time) *FileUpdate {
+	_u.mutation.SetLastDownloadedAt(v)
+	return _u
+}
+
+// SetNillableLastDownloadedAt sets the "last_downloaded_at" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableLastDownloadedAt(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetLastDownloadedAt(*v)
 	}
-	if value, ok := _u.mutation.AddedSize(); ok {
-		_spec.AddField(file.FieldSize, field.TypeInt64, value)
+	return _u
+}
+
+// ClearLastDownloadedAt clears the value of the "last_downloaded_at" field.
+func (_u *FileUpdate) ClearLastDownloadedAt() *FileUpdate {
+	_u.mutation.ClearLastDownloadedAt()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *FileUpdate) SetArchivedAt(v time.Time) *FileUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableArchivedAt(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
 	}
-	if value, ok := _u.mutation.Path(); ok {
-		_spec.SetField(file.FieldPath, field.TypeString, value)
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *FileUpdate) ClearArchivedAt() *FileUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *FileUpdate) SetEntityType(v file.EntityType) *FileUpdate {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableEntityType(v *file.EntityType) *FileUpdate {
+	if v != nil {
+		_u.SetEntityType(*v)
 	}
-	if _u.mutation.PathCleared() {
-		_spec.ClearField(file.FieldPath, field.TypeString)
+	return _u
+}
+
+// ClearEntityType clears the value of the "entity_type" field.
+func (_u *FileUpdate) ClearEntityType() *FileUpdate {
+	_u.mutation.ClearEntityType()
+	return _u
+}
+
+// SetEntityID sets the "entity_id" field.
+func (_u *FileUpdate) SetEntityID(v uuid.UUID) *FileUpdate {
+	_u.mutation.SetEntityID(v)
+	return _u
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableEntityID(v *uuid.UUID) *FileUpdate {
+	if v != nil {
+		_u.SetEntityID(*v)
 	}
-	if value, ok := _u.mutation.Description(); ok {
-		_spec.SetField(file.FieldDescription, field.TypeString, value)
+	return _u
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (_u *FileUpdate) ClearEntityID() *FileUpdate {
+	_u.mutation.ClearEntityID()
+	return _u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_u *FileUpdate) SetLockedBy(v uuid.UUID) *FileUpdate {
+	_u.mutation.SetLockedBy(v)
+	return _u
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableLockedBy(v *uuid.UUID) *FileUpdate {
+	if v != nil {
+		_u.SetLockedBy(*v)
+	}
+	return _u
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (_u *FileUpdate) ClearLockedBy() *FileUpdate {
+	_u.mutation.ClearLockedBy()
+	return _u
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (_u *FileUpdate) SetLockedAt(v time.Time) *FileUpdate {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableLockedAt(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetLockedAt(*v)
+	}
+	return _u
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (_u *FileUpdate) ClearLockedAt() *FileUpdate {
+	_u.mutation.ClearLockedAt()
+	return _u
+}
+
+// SetObjectLockMode sets the "object_lock_mode" field.
+func (_u *FileUpdate) SetObjectLockMode(v file.ObjectLockMode) *FileUpdate {
+	_u.mutation.SetObjectLockMode(v)
+	return _u
+}
+
+// SetNillableObjectLockMode sets the "object_lock_mode" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableObjectLockMode(v *file.ObjectLockMode) *FileUpdate {
+	if v != nil {
+		_u.SetObjectLockMode(*v)
+	}
+	return _u
+}
+
+// ClearObjectLockMode clears the value of the "object_lock_mode" field.
+func (_u *FileUpdate) ClearObjectLockMode() *FileUpdate {
+	_u.mutation.ClearObjectLockMode()
+	return _u
+}
+
+// SetObjectLockRetainUntil sets the "object_lock_retain_until" field.
+func (_u *FileUpdate) SetObjectLockRetainUntil(v time.Time) *FileUpdate {
+	_u.mutation.SetObjectLockRetainUntil(v)
+	return _u
+}
+
+// SetNillableObjectLockRetainUntil sets the "object_lock_retain_until" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableObjectLockRetainUntil(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetObjectLockRetainUntil(*v)
+	}
+	return _u
+}
+
+// ClearObjectLockRetainUntil clears the value of the "object_lock_retain_until" field.
+func (_u *FileUpdate) ClearObjectLockRetainUntil() *FileUpdate {
+	_u.mutation.ClearObjectLockRetainUntil()
+	return _u
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (_u *FileUpdate) SetLegalHold(v bool) *FileUpdate {
+	_u.mutation.SetLegalHold(v)
+	return _u
+}
+
+// SetNillableLegalHold sets the "legal_hold" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableLegalHold(v *bool) *FileUpdate {
+	if v != nil {
+		_u.SetLegalHold(*v)
+	}
+	return _u
+}
+
+// SetScheduledDeletionAt sets the "scheduled_deletion_at" field.
+func (_u *FileUpdate) SetScheduledDeletionAt(v time.Time) *FileUpdate {
+	_u.mutation.SetScheduledDeletionAt(v)
+	return _u
+}
+
+// SetNillableScheduledDeletionAt sets the "scheduled_deletion_at" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScheduledDeletionAt(v *time.Time) *FileUpdate {
+	if v != nil {
+		_u.SetScheduledDeletionAt(*v)
+	}
+	return _u
+}
+
+// ClearScheduledDeletionAt clears the value of the "scheduled_deletion_at" field.
+func (_u *FileUpdate) ClearScheduledDeletionAt() *FileUpdate {
+	_u.mutation.ClearScheduledDeletionAt()
+	return _u
+}
+
+// SetScheduledDeletionBy sets the "scheduled_deletion_by" field.
+func (_u *FileUpdate) SetScheduledDeletionBy(v uuid.UUID) *FileUpdate {
+	_u.mutation.SetScheduledDeletionBy(v)
+	return _u
+}
+
+// SetNillableScheduledDeletionBy sets the "scheduled_deletion_by" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScheduledDeletionBy(v *uuid.UUID) *FileUpdate {
+	if v != nil {
+		_u.SetScheduledDeletionBy(*v)
+	}
+	return _u
+}
+
+// ClearScheduledDeletionBy clears the value of the "scheduled_deletion_by" field.
+func (_u *FileUpdate) ClearScheduledDeletionBy() *FileUpdate {
+	_u.mutation.ClearScheduledDeletionBy()
+	return _u
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *FileUpdate) AddTagIDs(ids ...uuid.UUID) *FileUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *FileUpdate) AddTags(v ...*Tag) *FileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the FileFavorite entity by IDs.
+func (_u *FileUpdate) AddFavoriteIDs(ids ...uuid.UUID) *FileUpdate {
+	_u.mutation.AddFavoriteIDs(ids...)
+	return _u
+}
+
+// AddFavorites adds the "favorites" edges to the FileFavorite entity.
+func (_u *FileUpdate) AddFavorites(v ...*FileFavorite) *FileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFavoriteIDs(ids...)
+}
+
+// Mutation returns the FileMutation object of the builder.
+func (_u *FileUpdate) Mutation() *FileMutation {
+	return _u.mutation
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *FileUpdate) ClearTags() *FileUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *FileUpdate) RemoveTagIDs(ids ...uuid.UUID) *FileUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *FileUpdate) RemoveTags(v ...*Tag) *FileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearFavorites clears all "favorites" edges to the FileFavorite entity.
+func (_u *FileUpdate) ClearFavorites() *FileUpdate {
+	_u.mutation.ClearFavorites()
+	return _u
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to FileFavorite entities by IDs.
+func (_u *FileUpdate) RemoveFavoriteIDs(ids ...uuid.UUID) *FileUpdate {
+	_u.mutation.RemoveFavoriteIDs(ids...)
+	return _u
+}
+
+// RemoveFavorites removes "favorites" edges to FileFavorite entities.
+func (_u *FileUpdate) RemoveFavorites(v ...*FileFavorite) *FileUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFavoriteIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FileUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FileUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if file.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized file.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := file.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileUpdate) check() error {
+	if v, ok := _u.mutation.OriginalName(); ok {
+		if err := file.OriginalNameValidator(v); err != nil {
+			return &ValidationError{Name: "original_name", err: fmt.Errorf(`ent: validator failed for field "File.original_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageKey(); ok {
+		if err := file.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "File.storage_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MimeType(); ok {
+		if err := file.MimeTypeValidator(v); err != nil {
+			return &ValidationError{Name: "mime_type", err: fmt.Errorf(`ent: validator failed for field "File.mime_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChecksumSha256(); ok {
+		if err := file.ChecksumSha256Validator(v); err != nil {
+			return &ValidationError{Name: "checksum_sha256", err: fmt.Errorf(`ent: validator failed for field "File.checksum_sha256": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IntegrityStatus(); ok {
+		if err := file.IntegrityStatusValidator(v); err != nil {
+			return &ValidationError{Name: "integrity_status", err: fmt.Errorf(`ent: validator failed for field "File.integrity_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThumbnailStatus(); ok {
+		if err := file.ThumbnailStatusValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_status", err: fmt.Errorf(`ent: validator failed for field "File.thumbnail_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PreviewStatus(); ok {
+		if err := file.PreviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "preview_status", err: fmt.Errorf(`ent: validator failed for field "File.preview_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PdfStatus(); ok {
+		if err := file.PdfStatusValidator(v); err != nil {
+			return &ValidationError{Name: "pdf_status", err: fmt.Errorf(`ent: validator failed for field "File.pdf_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PdfAttempts(); ok {
+		if err := file.PdfAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "pdf_attempts", err: fmt.Errorf(`ent: validator failed for field "File.pdf_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UploadSource(); ok {
+		if err := file.UploadSourceValidator(v); err != nil {
+			return &ValidationError{Name: "upload_source", err: fmt.Errorf(`ent: validator failed for field "File.upload_source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientVersion(); ok {
+		if err := file.ClientVersionValidator(v); err != nil {
+			return &ValidationError{Name: "client_version", err: fmt.Errorf(`ent: validator failed for field "File.client_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DownloadCount(); ok {
+		if err := file.DownloadCountValidator(v); err != nil {
+			return &ValidationError{Name: "download_count", err: fmt.Errorf(`ent: validator failed for field "File.download_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityType(); ok {
+		if err := file.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "File.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ObjectLockMode(); ok {
+		if err := file.ObjectLockModeValidator(v); err != nil {
+			return &ValidationError{Name: "object_lock_mode", err: fmt.Errorf(`ent: validator failed for field "File.object_lock_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(file.Table, file.Columns, sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(file.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(file.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(file.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OriginalName(); ok {
+		_spec.SetField(file.FieldOriginalName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StorageKey(); ok {
+		_spec.SetField(file.FieldStorageKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MimeType(); ok {
+		_spec.SetField(file.FieldMimeType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DetectedMimeType(); ok {
+		_spec.SetField(file.FieldDetectedMimeType, field.TypeString, value)
+	}
+	if _u.mutation.DetectedMimeTypeCleared() {
+		_spec.ClearField(file.FieldDetectedMimeType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(file.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(file.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(file.FieldPath, field.TypeString, value)
+	}
+	if _u.mutation.PathCleared() {
+		_spec.ClearField(file.FieldPath, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(file.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(file.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(file.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(file.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ChecksumSha256(); ok {
+		_spec.SetField(file.FieldChecksumSha256, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumSha256Cleared() {
+		_spec.ClearField(file.FieldChecksumSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.IntegrityStatus(); ok {
+		_spec.SetField(file.FieldIntegrityStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IntegrityCheckedAt(); ok {
+		_spec.SetField(file.FieldIntegrityCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.IntegrityCheckedAtCleared() {
+		_spec.ClearField(file.FieldIntegrityCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ThumbnailStatus(); ok {
+		_spec.SetField(file.FieldThumbnailStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviewStatus(); ok {
+		_spec.SetField(file.FieldPreviewStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PdfStatus(); ok {
+		_spec.SetField(file.FieldPdfStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PdfAttempts(); ok {
+		_spec.SetField(file.FieldPdfAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPdfAttempts(); ok {
+		_spec.AddField(file.FieldPdfAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PdfRetryAt(); ok {
+		_spec.SetField(file.FieldPdfRetryAt, field.TypeTime, value)
+	}
+	if _u.mutation.PdfRetryAtCleared() {
+		_spec.ClearField(file.FieldPdfRetryAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(file.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(file.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DepartmentID(); ok {
+		_spec.SetField(file.FieldDepartmentID, field.TypeUUID, value)
+	}
+	if _u.mutation.DepartmentIDCleared() {
+		_spec.ClearField(file.FieldDepartmentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UploadSource(); ok {
+		_spec.SetField(file.FieldUploadSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClientVersion(); ok {
+		_spec.SetField(file.FieldClientVersion, field.TypeString, value)
+	}
+	if _u.mutation.ClientVersionCleared() {
+		_spec.ClearField(file.FieldClientVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(file.FieldDownloadCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(file.FieldDownloadCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastDownloadedAt(); ok {
+		_spec.SetField(file.FieldLastDownloadedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDownloadedAtCleared() {
+		_spec.ClearField(file.FieldLastDownloadedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(file.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(file.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(file.FieldEntityType, field.TypeEnum, value)
+	}
+	if _u.mutation.EntityTypeCleared() {
+		_spec.ClearField(file.FieldEntityType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(file.FieldEntityID, field.TypeUUID, value)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(file.FieldEntityID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.LockedBy(); ok {
+		_spec.SetField(file.FieldLockedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.LockedByCleared() {
+		_spec.ClearField(file.FieldLockedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(file.FieldLockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LockedAtCleared() {
+		_spec.ClearField(file.FieldLockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ObjectLockMode(); ok {
+		_spec.SetField(file.FieldObjectLockMode, field.TypeEnum, value)
+	}
+	if _u.mutation.ObjectLockModeCleared() {
+		_spec.ClearField(file.FieldObjectLockMode, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ObjectLockRetainUntil(); ok {
+		_spec.SetField(file.FieldObjectLockRetainUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ObjectLockRetainUntilCleared() {
+		_spec.ClearField(file.FieldObjectLockRetainUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LegalHold(); ok {
+		_spec.SetField(file.FieldLegalHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScheduledDeletionAt(); ok {
+		_spec.SetField(file.FieldScheduledDeletionAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduledDeletionAtCleared() {
+		_spec.ClearField(file.FieldScheduledDeletionAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScheduledDeletionBy(); ok {
+		_spec.SetField(file.FieldScheduledDeletionBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ScheduledDeletionByCleared() {
+		_spec.ClearField(file.FieldScheduledDeletionBy, field.TypeUUID)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   file.TagsTable,
+			Columns: file.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   file.TagsTable,
+			Columns: file.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   file.TagsTable,
+			Columns: file.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.FavoritesTable,
+			Columns: []string{file.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFavoritesIDs(); len(nodes) > 0 && !_u.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.FavoritesTable,
+			Columns: []string{file.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.FavoritesTable,
+			Columns: []string{file.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{file.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FileUpdateOne is the builder for updating a single File entity.
+type FileUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FileUpdateOne) SetUpdateTime(v time.Time) *FileUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FileUpdateOne) SetDeletedAt(v time.Time) *FileUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableDeletedAt(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FileUpdateOne) ClearDeletedAt() *FileUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetOriginalName sets the "original_name" field.
+func (_u *FileUpdateOne) SetOriginalName(v string) *FileUpdateOne {
+	_u.mutation.SetOriginalName(v)
+	return _u
+}
+
+// SetNillableOriginalName sets the "original_name" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableOriginalName(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetOriginalName(*v)
+	}
+	return _u
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_u *FileUpdateOne) SetStorageKey(v string) *FileUpdateOne {
+	_u.mutation.SetStorageKey(v)
+	return _u
+}
+
+// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableStorageKey(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetStorageKey(*v)
+	}
+	return _u
+}
+
+// SetMimeType sets the "mime_type" field.
+func (_u *FileUpdateOne) SetMimeType(v string) *FileUpdateOne {
+	_u.mutation.SetMimeType(v)
+	return _u
+}
+
+// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableMimeType(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetMimeType(*v)
+	}
+	return _u
+}
+
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (_u *FileUpdateOne) SetDetectedMimeType(v string) *FileUpdateOne {
+	_u.mutation.SetDetectedMimeType(v)
+	return _u
+}
+
+// SetNillableDetectedMimeType sets the "detected_mime_type" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableDetectedMimeType(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetDetectedMimeType(*v)
+	}
+	return _u
+}
+
+// ClearDetectedMimeType clears the value of the "detected_mime_type" field.
+func (_u *FileUpdateOne) ClearDetectedMimeType() *FileUpdateOne {
+	_u.mutation.ClearDetectedMimeType()
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *FileUpdateOne) SetSize(v int64) *FileUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableSize(v *int64) *FileUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *FileUpdateOne) AddSize(v int64) *FileUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *FileUpdateOne) SetPath(v string) *FileUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillablePath(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// ClearPath clears the value of the "path" field.
+func (_u *FileUpdateOne) ClearPath() *FileUpdateOne {
+	_u.mutation.ClearPath()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *FileUpdateOne) SetDescription(v string) *FileUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableDescription(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *FileUpdateOne) ClearDescription() *FileUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *FileUpdateOne) SetMetadata(v map[string]interface{}) *FileUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *FileUpdateOne) ClearMetadata() *FileUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (_u *FileUpdateOne) SetChecksumSha256(v string) *FileUpdateOne {
+	_u.mutation.SetChecksumSha256(v)
+	return _u
+}
+
+// SetNillableChecksumSha256 sets the "checksum_sha256" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableChecksumSha256(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetChecksumSha256(*v)
+	}
+	return _u
+}
+
+// ClearChecksumSha256 clears the value of the "checksum_sha256" field.
+func (_u *FileUpdateOne) ClearChecksumSha256() *FileUpdateOne {
+	_u.mutation.ClearChecksumSha256()
+	return _u
+}
+
+// SetIntegrityStatus sets the "integrity_status" field.
+func (_u *FileUpdateOne) SetIntegrityStatus(v file.IntegrityStatus) *FileUpdateOne {
+	_u.mutation.SetIntegrityStatus(v)
+	return _u
+}
+
+// SetNillableIntegrityStatus sets the "integrity_status" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableIntegrityStatus(v *file.IntegrityStatus) *FileUpdateOne {
+	if v != nil {
+		_u.SetIntegrityStatus(*v)
+	}
+	return _u
+}
+
+// SetIntegrityCheckedAt sets the "integrity_checked_at" field.
+func (_u *FileUpdateOne) SetIntegrityCheckedAt(v time.Time) *FileUpdateOne {
+	_u.mutation.SetIntegrityCheckedAt(v)
+	return _u
+}
+
+// SetNillableIntegrityCheckedAt sets the "integrity_checked_at" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableIntegrityCheckedAt(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetIntegrityCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearIntegrityCheckedAt clears the value of the "integrity_checked_at" field.
+func (_u *FileUpdateOne) ClearIntegrityCheckedAt() *FileUpdateOne {
+	_u.mutation.ClearIntegrityCheckedAt()
+	return _u
+}
+
+// SetThumbnailStatus sets the "thumbnail_status" field.
+func (_u *FileUpdateOne) SetThumbnailStatus(v file.ThumbnailStatus) *FileUpdateOne {
+	_u.mutation.SetThumbnailStatus(v)
+	return _u
+}
+
+// SetNillableThumbnailStatus sets the "thumbnail_status" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableThumbnailStatus(v *file.ThumbnailStatus) *FileUpdateOne {
+	if v != nil {
+		_u.SetThumbnailStatus(*v)
+	}
+	return _u
+}
+
+// SetPreviewStatus sets the "preview_status" field.
+func (_u *FileUpdateOne) SetPreviewStatus(v file.PreviewStatus) *FileUpdateOne {
+	_u.mutation.SetPreviewStatus(v)
+	return _u
+}
+
+// SetNillablePreviewStatus sets the "preview_status" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillablePreviewStatus(v *file.PreviewStatus) *FileUpdateOne {
+	if v != nil {
+		_u.SetPreviewStatus(*v)
+	}
+	return _u
+}
+
+// SetPdfStatus sets the "pdf_status" field.
+func (_u *FileUpdateOne) SetPdfStatus(v file.PdfStatus) *FileUpdateOne {
+	_u.mutation.SetPdfStatus(v)
+	return _u
+}
+
+// SetNillablePdfStatus sets the "pdf_status" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillablePdfStatus(v *file.PdfStatus) *FileUpdateOne {
+	if v != nil {
+		_u.SetPdfStatus(*v)
+	}
+	return _u
+}
+
+// SetPdfAttempts sets the "pdf_attempts" field.
+func (_u *FileUpdateOne) SetPdfAttempts(v int) *FileUpdateOne {
+	_u.mutation.ResetPdfAttempts()
+	_u.mutation.SetPdfAttempts(v)
+	return _u
+}
+
+// SetNillablePdfAttempts sets the "pdf_attempts" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillablePdfAttempts(v *int) *FileUpdateOne {
+	if v != nil {
+		_u.SetPdfAttempts(*v)
+	}
+	return _u
+}
+
+// AddPdfAttempts adds value to the "pdf_attempts" field.
+func (_u *FileUpdateOne) AddPdfAttempts(v int) *FileUpdateOne {
+	_u.mutation.AddPdfAttempts(v)
+	return _u
+}
+
+// SetPdfRetryAt sets the "pdf_retry_at" field.
+func (_u *FileUpdateOne) SetPdfRetryAt(v time.Time) *FileUpdateOne {
+	_u.mutation.SetPdfRetryAt(v)
+	return _u
+}
+
+// SetNillablePdfRetryAt sets the "pdf_retry_at" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillablePdfRetryAt(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetPdfRetryAt(*v)
+	}
+	return _u
+}
+
+// ClearPdfRetryAt clears the value of the "pdf_retry_at" field.
+func (_u *FileUpdateOne) ClearPdfRetryAt() *FileUpdateOne {
+	_u.mutation.ClearPdfRetryAt()
+	return _u
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdateOne) SetScanStatus(v file.ScanStatus) *FileUpdateOne {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScanStatus(v *file.ScanStatus) *FileUpdateOne {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *FileUpdateOne) SetExpiresAt(v time.Time) *FileUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableExpiresAt(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *FileUpdateOne) ClearExpiresAt() *FileUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (_u *FileUpdateOne) SetDepartmentID(v uuid.UUID) *FileUpdateOne {
+	_u.mutation.SetDepartmentID(v)
+	return _u
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableDepartmentID(v *uuid.UUID) *FileUpdateOne {
+	if v != nil {
+		_u.SetDepartmentID(*v)
+	}
+	return _u
+}
+
+// ClearDepartmentID clears the value of the "department_id" field.
+func (_u *FileUpdateOne) ClearDepartmentID() *FileUpdateOne {
+	_u.mutation.ClearDepartmentID()
+	return _u
+}
+
+// SetUploadSource sets the "upload_source" field.
+func (_u *FileUpdateOne) SetUploadSource(v file.UploadSource) *FileUpdateOne {
+	_u.mutation.SetUploadSource(v)
+	return _u
+}
+
+// SetNillableUploadSource sets the "upload_source" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableUploadSource(v *file.UploadSource) *FileUpdateOne {
+	if v != nil {
+		_u.SetUploadSource(*v)
+	}
+	return _u
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_u *FileUpdateOne) SetClientVersion(v string) *FileUpdateOne {
+	_u.mutation.SetClientVersion(v)
+	return _u
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableClientVersion(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetClientVersion(*v)
 	}
-	if _u.mutation.DescriptionCleared() {
-		_spec.ClearField(file.FieldDescription, field.TypeString)
+	return _u
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (_u *FileUpdateOne) ClearClientVersion() *FileUpdateOne {
+	_u.mutation.ClearClientVersion()
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *FileUpdateOne) SetDownloadCount(v int64) *FileUpdateOne {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableDownloadCount(v *int64) *FileUpdateOne {
+	if v != nil {
+		_u.SetDownloadCount(*v)
 	}
-	if value, ok := _u.mutation.Metadata(); ok {
-		_spec.SetField(file.FieldMetadata, field.TypeJSON, value)
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *FileUpdateOne) AddDownloadCount(v int64) *FileUpdateOne {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (_u *FileUpdateOne) SetLastDownloadedAt(v time.Time) *FileUpdateOne {
+	_u.mutation.SetLastDownloadedAt(v)
+	return _u
+}
+
+// SetNillableLastDownloadedAt sets the "last_downloaded_at" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableLastDownloadedAt(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetLastDownloadedAt(*v)
 	}
-	if _u.mutation.MetadataCleared() {
-		_spec.ClearField(file.FieldMetadata, field.TypeJSON)
+	return _u
+}
+
+// ClearLastDownloadedAt clears the value of the "last_downloaded_at" field.
+func (_u *FileUpdateOne) ClearLastDownloadedAt() *FileUpdateOne {
+	_u.mutation.ClearLastDownloadedAt()
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *FileUpdateOne) SetArchivedAt(v time.Time) *FileUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableArchivedAt(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{file.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *FileUpdateOne) ClearArchivedAt() *FileUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
+// SetEntityType sets the "entity_type" field.
+func (_u *FileUpdateOne) SetEntityType(v file.EntityType) *FileUpdateOne {
+	_u.mutation.SetEntityType(v)
+	return _u
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableEntityType(v *file.EntityType) *FileUpdateOne {
+	if v != nil {
+		_u.SetEntityType(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// FileUpdateOne is the builder for updating a single File entity.
-type FileUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *FileMutation
-	modifiers []func(*sql.UpdateBuilder)
+// ClearEntityType clears the value of the "entity_type" field.
+func (_u *FileUpdateOne) ClearEntityType() *FileUpdateOne {
+	_u.mutation.ClearEntityType()
+	return _u
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (_u *FileUpdateOne) SetUpdateTime(v time.Time) *FileUpdateOne {
-	_u.mutation.SetUpdateTime(v)
+// SetEntityID sets the "entity_id" field.
+func (_u *FileUpdateOne) SetEntityID(v uuid.UUID) *FileUpdateOne {
+	_u.mutation.SetEntityID(v)
 	return _u
 }
 
-// SetOriginalName sets the "original_name" field.
-func (_u *FileUpdateOne) SetOriginalName(v string) *FileUpdateOne {
-	_u.mutation.SetOriginalName(v)
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableEntityID(v *uuid.UUID) *FileUpdateOne {
+	if v != nil {
+		_u.SetEntityID(*v)
+	}
 	return _u
 }
 
-// SetNillableOriginalName sets the "original_name" field if the given value is not nil.
-func (_u *FileUpdateOne) SetNillableOriginalName(v *string) *FileUpdateOne {
+// ClearEntityID clears the value of the "entity_id" field.
+func (_u *FileUpdateOne) ClearEntityID() *FileUpdateOne {
+	_u.mutation.ClearEntityID()
+	return _u
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (_u *FileUpdateOne) SetLockedBy(v uuid.UUID) *FileUpdateOne {
+	_u.mutation.SetLockedBy(v)
+	return _u
+}
+
+// SetNillableLockedBy sets the "locked_by" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableLockedBy(v *uuid.UUID) *FileUpdateOne {
 	if v != nil {
-		_u.SetOriginalName(*v)
+		_u.SetLockedBy(*v)
 	}
 	return _u
 }
 
-// SetStorageKey sets the "storage_key" field.
-func (_u *FileUpdateOne) SetStorageKey(v string) *FileUpdateOne {
-	_u.mutation.SetStorageKey(v)
+// ClearLockedBy clears the value of the "locked_by" field.
+func (_u *FileUpdateOne) ClearLockedBy() *FileUpdateOne {
+	_u.mutation.ClearLockedBy()
 	return _u
 }
 
-// SetNillableStorageKey sets the "storage_key" field if the given value is not nil.
-func (_u *FileUpdateOne) SetNillableStorageKey(v *string) *FileUpdateOne {
+// SetLockedAt sets the "locked_at" field.
+func (_u *FileUpdateOne) SetLockedAt(v time.Time) *FileUpdateOne {
+	_u.mutation.SetLockedAt(v)
+	return _u
+}
+
+// SetNillableLockedAt sets the "locked_at" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableLockedAt(v *time.Time) *FileUpdateOne {
 	if v != nil {
-		_u.SetStorageKey(*v)
+		_u.SetLockedAt(*v)
 	}
 	return _u
 }
 
-// SetMimeType sets the "mime_type" field.
-func (_u *FileUpdateOne) SetMimeType(v string) *FileUpdateOne {
-	_u.mutation.SetMimeType(v)
+// ClearLockedAt clears the value of the "locked_at" field.
+func (_u *FileUpdateOne) ClearLockedAt() *FileUpdateOne {
+	_u.mutation.ClearLockedAt()
 	return _u
 }
 
-// SetNillableMimeType sets the "mime_type" field if the given value is not nil.
-func (_u *FileUpdateOne) SetNillableMimeType(v *string) *FileUpdateOne {
+// SetObjectLockMode sets the "object_lock_mode" field.
+func (_u *FileUpdateOne) SetObjectLockMode(v file.ObjectLockMode) *FileUpdateOne {
+	_u.mutation.SetObjectLockMode(v)
+	return _u
+}
+
+// SetNillableObjectLockMode sets the "object_lock_mode" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableObjectLockMode(v *file.ObjectLockMode) *FileUpdateOne {
 	if v != nil {
-		_u.SetMimeType(*v)
+		_u.SetObjectLockMode(*v)
 	}
 	return _u
 }
 
-// SetSize sets the "size" field.
-func (_u *FileUpdateOne) SetSize(v int64) *FileUpdateOne {
-	_u.mutation.ResetSize()
-	_u.mutation.SetSize(v)
+// ClearObjectLockMode clears the value of the "object_lock_mode" field.
+func (_u *FileUpdateOne) ClearObjectLockMode() *FileUpdateOne {
+	_u.mutation.ClearObjectLockMode()
 	return _u
 }
 
-// SetNillableSize sets the "size" field if the given value is not nil.
-func (_u *FileUpdateOne) SetNillableSize(v *int64) *FileUpdateOne {
+// SetObjectLockRetainUntil sets the "object_lock_retain_until" field.
+func (_u *FileUpdateOne) SetObjectLockRetainUntil(v time.Time) *FileUpdateOne {
+	_u.mutation.SetObjectLockRetainUntil(v)
+	return _u
+}
+
+// SetNillableObjectLockRetainUntil sets the "object_lock_retain_until" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableObjectLockRetainUntil(v *time.Time) *FileUpdateOne {
 	if v != nil {
-		_u.SetSize(*v)
+		_u.SetObjectLockRetainUntil(*v)
 	}
 	return _u
 }
 
-// AddSize adds value to the "size" field.
-func (_u *FileUpdateOne) AddSize(v int64) *FileUpdateOne {
-	_u.mutation.AddSize(v)
+// ClearObjectLockRetainUntil clears the value of the "object_lock_retain_until" field.
+func (_u *FileUpdateOne) ClearObjectLockRetainUntil() *FileUpdateOne {
+	_u.mutation.ClearObjectLockRetainUntil()
 	return _u
 }
 
-// SetPath sets the "path" field.
-func (_u *FileUpdateOne) SetPath(v string) *FileUpdateOne {
-	_u.mutation.SetPath(v)
+// SetLegalHold sets the "legal_hold" field.
+func (_u *FileUpdateOne) SetLegalHold(v bool) *FileUpdateOne {
+	_u.mutation.SetLegalHold(v)
 	return _u
 }
 
-// SetNillablePath sets the "path" field if the given value is not nil.
-func (_u *FileUpdateOne) SetNillablePath(v *string) *FileUpdateOne {
+// SetNillableLegalHold sets the "legal_hold" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableLegalHold(v *bool) *FileUpdateOne {
 	if v != nil {
-		_u.SetPath(*v)
+		_u.SetLegalHold(*v)
 	}
 	return _u
 }
 
-// ClearPath clears the value of the "path" field.
-func (_u *FileUpdateOne) ClearPath() *FileUpdateOne {
-	_u.mutation.ClearPath()
+// SetScheduledDeletionAt sets the "scheduled_deletion_at" field.
+func (_u *FileUpdateOne) SetScheduledDeletionAt(v time.Time) *FileUpdateOne {
+	_u.mutation.SetScheduledDeletionAt(v)
 	return _u
 }
 
-// SetDescription sets the "description" field.
-func (_u *FileUpdateOne) SetDescription(v string) *FileUpdateOne {
-	_u.mutation.SetDescription(v)
+// SetNillableScheduledDeletionAt sets the "scheduled_deletion_at" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScheduledDeletionAt(v *time.Time) *FileUpdateOne {
+	if v != nil {
+		_u.SetScheduledDeletionAt(*v)
+	}
 	return _u
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (_u *FileUpdateOne) SetNillableDescription(v *string) *FileUpdateOne {
+// ClearScheduledDeletionAt clears the value of the "scheduled_deletion_at" field.
+func (_u *FileUpdateOne) ClearScheduledDeletionAt() *FileUpdateOne {
+	_u.mutation.ClearScheduledDeletionAt()
+	return _u
+}
+
+// SetScheduledDeletionBy sets the "scheduled_deletion_by" field.
+func (_u *FileUpdateOne) SetScheduledDeletionBy(v uuid.UUID) *FileUpdateOne {
+	_u.mutation.SetScheduledDeletionBy(v)
+	return _u
+}
+
+// SetNillableScheduledDeletionBy sets the "scheduled_deletion_by" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScheduledDeletionBy(v *uuid.UUID) *FileUpdateOne {
 	if v != nil {
-		_u.SetDescription(*v)
+		_u.SetScheduledDeletionBy(*v)
 	}
 	return _u
 }
 
-// ClearDescription clears the value of the "description" field.
-func (_u *FileUpdateOne) ClearDescription() *FileUpdateOne {
-	_u.mutation.ClearDescription()
+// ClearScheduledDeletionBy clears the value of the "scheduled_deletion_by" field.
+func (_u *FileUpdateOne) ClearScheduledDeletionBy() *FileUpdateOne {
+	_u.mutation.ClearScheduledDeletionBy()
 	return _u
 }
 
-// SetMetadata sets the "metadata" field.
-func (_u *FileUpdateOne) SetMetadata(v map[string]interface{}) *FileUpdateOne {
-	_u.mutation.SetMetadata(v)
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *FileUpdateOne) AddTagIDs(ids ...uuid.UUID) *FileUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
 	return _u
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (_u *FileUpdateOne) ClearMetadata() *FileUpdateOne {
-	_u.mutation.ClearMetadata()
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *FileUpdateOne) AddTags(v ...*Tag) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the FileFavorite entity by IDs.
+func (_u *FileUpdateOne) AddFavoriteIDs(ids ...uuid.UUID) *FileUpdateOne {
+	_u.mutation.AddFavoriteIDs(ids...)
 	return _u
 }
 
+// AddFavorites adds the "favorites" edges to the FileFavorite entity.
+func (_u *FileUpdateOne) AddFavorites(v ...*FileFavorite) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFavoriteIDs(ids...)
+}
+
 // Mutation returns the FileMutation object of the builder.
 func (_u *FileUpdateOne) Mutation() *FileMutation {
 	return _u.mutation
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *FileUpdateOne) ClearTags() *FileUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *FileUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *FileUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *FileUpdateOne) RemoveTags(v ...*Tag) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearFavorites clears all "favorites" edges to the FileFavorite entity.
+func (_u *FileUpdateOne) ClearFavorites() *FileUpdateOne {
+	_u.mutation.ClearFavorites()
+	return _u
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to FileFavorite entities by IDs.
+func (_u *FileUpdateOne) RemoveFavoriteIDs(ids ...uuid.UUID) *FileUpdateOne {
+	_u.mutation.RemoveFavoriteIDs(ids...)
+	return _u
+}
+
+// RemoveFavorites removes "favorites" edges to FileFavorite entities.
+func (_u *FileUpdateOne) RemoveFavorites(v ...*FileFavorite) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFavoriteIDs(ids...)
+}
+
 // Where appends a list predicates to the FileUpdate builder.
 func (_u *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
 	_u.mutation.Where(ps...)
@@ -501,6 +1939,66 @@ func (_u *FileUpdateOne) check() error {
 			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ChecksumSha256(); ok {
+		if err := file.ChecksumSha256Validator(v); err != nil {
+			return &ValidationError{Name: "checksum_sha256", err: fmt.Errorf(`ent: validator failed for field "File.checksum_sha256": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.IntegrityStatus(); ok {
+		if err := file.IntegrityStatusValidator(v); err != nil {
+			return &ValidationError{Name: "integrity_status", err: fmt.Errorf(`ent: validator failed for field "File.integrity_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ThumbnailStatus(); ok {
+		if err := file.ThumbnailStatusValidator(v); err != nil {
+			return &ValidationError{Name: "thumbnail_status", err: fmt.Errorf(`ent: validator failed for field "File.thumbnail_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PreviewStatus(); ok {
+		if err := file.PreviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "preview_status", err: fmt.Errorf(`ent: validator failed for field "File.preview_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PdfStatus(); ok {
+		if err := file.PdfStatusValidator(v); err != nil {
+			return &ValidationError{Name: "pdf_status", err: fmt.Errorf(`ent: validator failed for field "File.pdf_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PdfAttempts(); ok {
+		if err := file.PdfAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "pdf_attempts", err: fmt.Errorf(`ent: validator failed for field "File.pdf_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ScanStatus(); ok {
+		if err := file.ScanStatusValidator(v); err != nil {
+			return &ValidationError{Name: "scan_status", err: fmt.Errorf(`ent: validator failed for field "File.scan_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UploadSource(); ok {
+		if err := file.UploadSourceValidator(v); err != nil {
+			return &ValidationError{Name: "upload_source", err: fmt.Errorf(`ent: validator failed for field "File.upload_source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientVersion(); ok {
+		if err := file.ClientVersionValidator(v); err != nil {
+			return &ValidationError{Name: "client_version", err: fmt.Errorf(`ent: validator failed for field "File.client_version": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DownloadCount(); ok {
+		if err := file.DownloadCountValidator(v); err != nil {
+			return &ValidationError{Name: "download_count", err: fmt.Errorf(`ent: validator failed for field "File.download_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EntityType(); ok {
+		if err := file.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "File.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ObjectLockMode(); ok {
+		if err := file.ObjectLockModeValidator(v); err != nil {
+			return &ValidationError{Name: "object_lock_mode", err: fmt.Errorf(`ent: validator failed for field "File.object_lock_mode": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -542,6 +2040,12 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(file.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(file.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(file.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.OriginalName(); ok {
 		_spec.SetField(file.FieldOriginalName, field.TypeString, value)
 	}
@@ -551,6 +2055,12 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if value, ok := _u.mutation.MimeType(); ok {
 		_spec.SetField(file.FieldMimeType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.DetectedMimeType(); ok {
+		_spec.SetField(file.FieldDetectedMimeType, field.TypeString, value)
+	}
+	if _u.mutation.DetectedMimeTypeCleared() {
+		_spec.ClearField(file.FieldDetectedMimeType, field.TypeString)
+	}
 	if value, ok := _u.mutation.Size(); ok {
 		_spec.SetField(file.FieldSize, field.TypeInt64, value)
 	}
@@ -575,6 +2085,225 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if _u.mutation.MetadataCleared() {
 		_spec.ClearField(file.FieldMetadata, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.ChecksumSha256(); ok {
+		_spec.SetField(file.FieldChecksumSha256, field.TypeString, value)
+	}
+	if _u.mutation.ChecksumSha256Cleared() {
+		_spec.ClearField(file.FieldChecksumSha256, field.TypeString)
+	}
+	if value, ok := _u.mutation.IntegrityStatus(); ok {
+		_spec.SetField(file.FieldIntegrityStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IntegrityCheckedAt(); ok {
+		_spec.SetField(file.FieldIntegrityCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.IntegrityCheckedAtCleared() {
+		_spec.ClearField(file.FieldIntegrityCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ThumbnailStatus(); ok {
+		_spec.SetField(file.FieldThumbnailStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PreviewStatus(); ok {
+		_spec.SetField(file.FieldPreviewStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PdfStatus(); ok {
+		_spec.SetField(file.FieldPdfStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PdfAttempts(); ok {
+		_spec.SetField(file.FieldPdfAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPdfAttempts(); ok {
+		_spec.AddField(file.FieldPdfAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PdfRetryAt(); ok {
+		_spec.SetField(file.FieldPdfRetryAt, field.TypeTime, value)
+	}
+	if _u.mutation.PdfRetryAtCleared() {
+		_spec.ClearField(file.FieldPdfRetryAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(file.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(file.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DepartmentID(); ok {
+		_spec.SetField(file.FieldDepartmentID, field.TypeUUID, value)
+	}
+	if _u.mutation.DepartmentIDCleared() {
+		_spec.ClearField(file.FieldDepartmentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UploadSource(); ok {
+		_spec.SetField(file.FieldUploadSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ClientVersion(); ok {
+		_spec.SetField(file.FieldClientVersion, field.TypeString, value)
+	}
+	if _u.mutation.ClientVersionCleared() {
+		_spec.ClearField(file.FieldClientVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(file.FieldDownloadCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(file.FieldDownloadCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LastDownloadedAt(); ok {
+		_spec.SetField(file.FieldLastDownloadedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastDownloadedAtCleared() {
+		_spec.ClearField(file.FieldLastDownloadedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(file.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(file.FieldArchivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EntityType(); ok {
+		_spec.SetField(file.FieldEntityType, field.TypeEnum, value)
+	}
+	if _u.mutation.EntityTypeCleared() {
+		_spec.ClearField(file.FieldEntityType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.EntityID(); ok {
+		_spec.SetField(file.FieldEntityID, field.TypeUUID, value)
+	}
+	if _u.mutation.EntityIDCleared() {
+		_spec.ClearField(file.FieldEntityID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.LockedBy(); ok {
+		_spec.SetField(file.FieldLockedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.LockedByCleared() {
+		_spec.ClearField(file.FieldLockedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.LockedAt(); ok {
+		_spec.SetField(file.FieldLockedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LockedAtCleared() {
+		_spec.ClearField(file.FieldLockedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ObjectLockMode(); ok {
+		_spec.SetField(file.FieldObjectLockMode, field.TypeEnum, value)
+	}
+	if _u.mutation.ObjectLockModeCleared() {
+		_spec.ClearField(file.FieldObjectLockMode, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ObjectLockRetainUntil(); ok {
+		_spec.SetField(file.FieldObjectLockRetainUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ObjectLockRetainUntilCleared() {
+		_spec.ClearField(file.FieldObjectLockRetainUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LegalHold(); ok {
+		_spec.SetField(file.FieldLegalHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ScheduledDeletionAt(); ok {
+		_spec.SetField(file.FieldScheduledDeletionAt, field.TypeTime, value)
+	}
+	if _u.mutation.ScheduledDeletionAtCleared() {
+		_spec.ClearField(file.FieldScheduledDeletionAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ScheduledDeletionBy(); ok {
+		_spec.SetField(file.FieldScheduledDeletionBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ScheduledDeletionByCleared() {
+		_spec.ClearField(file.FieldScheduledDeletionBy, field.TypeUUID)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   file.TagsTable,
+			Columns: file.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   file.TagsTable,
+			Columns: file.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   file.TagsTable,
+			Columns: file.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.FavoritesTable,
+			Columns: []string{file.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFavoritesIDs(); len(nodes) > 0 && !_u.mutation.FavoritesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.FavoritesTable,
+			Columns: []string{file.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FavoritesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.FavoritesTable,
+			Columns: []string{file.FavoritesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &File{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fileauditevent
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the fileauditevent type in the database.
+	Label = "file_audit_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldFileID holds the string denoting the file_id field in the database.
+	FieldFileID = "file_id"
+	// FieldActorID holds the string denoting the actor_id field in the database.
+	FieldActorID = "actor_id"
+	// FieldImpersonatorID holds the string denoting the impersonator_id field in the database.
+	FieldImpersonatorID = "impersonator_id"
+	// FieldDetails holds the string denoting the details field in the database.
+	FieldDetails = "details"
+	// Table holds the table name of the fileauditevent in the database.
+	Table = "file_audit_events"
+)
+
+// Columns holds all SQL columns for fileauditevent fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldAction,
+	FieldFileID,
+	FieldActorID,
+	FieldImpersonatorID,
+	FieldDetails,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "main/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionUPLOAD                   Action = "UPLOAD"
+	ActionDELETE                   Action = "DELETE"
+	ActionRENAME                   Action = "RENAME"
+	ActionUPDATE                   Action = "UPDATE"
+	ActionURL_GENERATED            Action = "URL_GENERATED"
+	ActionBATCH_DOWNLOAD           Action = "BATCH_DOWNLOAD"
+	ActionSHARE_CREATED            Action = "SHARE_CREATED"
+	ActionLIMIT_VIOLATION          Action = "LIMIT_VIOLATION"
+	ActionINTEGRITY_FAILURE        Action = "INTEGRITY_FAILURE"
+	ActionQUOTA_EXCEEDED           Action = "QUOTA_EXCEEDED"
+	ActionRESTORE                  Action = "RESTORE"
+	ActionPURGE                    Action = "PURGE"
+	ActionRETENANT                 Action = "RETENANT"
+	ActionMALWARE_DETECTED         Action = "MALWARE_DETECTED"
+	ActionMALWARE_DOWNLOAD_BLOCKED Action = "MALWARE_DOWNLOAD_BLOCKED"
+	ActionLIFECYCLE_NOTICE         Action = "LIFECYCLE_NOTICE"
+	ActionCOPY                     Action = "COPY"
+	ActionMOVE                     Action = "MOVE"
+	ActionCONTENT_STREAMED         Action = "CONTENT_STREAMED"
+	ActionIMPERSONATION_GRANTED    Action = "IMPERSONATION_GRANTED"
+	ActionIMPERSONATION_REVOKED    Action = "IMPERSONATION_REVOKED"
+	ActionFILE_LOCKED              Action = "FILE_LOCKED"
+	ActionFILE_UNLOCKED            Action = "FILE_UNLOCKED"
+	ActionOBJECT_LOCK_UPDATED      Action = "OBJECT_LOCK_UPDATED"
+	ActionFILE_TYPE_BLOCKED        Action = "FILE_TYPE_BLOCKED"
+	ActionDELETION_SCHEDULED       Action = "DELETION_SCHEDULED"
+	ActionDELETION_CANCELED        Action = "DELETION_CANCELED"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionUPLOAD, ActionDELETE, ActionRENAME, ActionUPDATE, ActionURL_GENERATED, ActionBATCH_DOWNLOAD, ActionSHARE_CREATED, ActionLIMIT_VIOLATION, ActionINTEGRITY_FAILURE, ActionQUOTA_EXCEEDED, ActionRESTORE, ActionPURGE, ActionRETENANT, ActionMALWARE_DETECTED, ActionMALWARE_DOWNLOAD_BLOCKED, ActionLIFECYCLE_NOTICE, ActionCOPY, ActionMOVE, ActionCONTENT_STREAMED, ActionIMPERSONATION_GRANTED, ActionIMPERSONATION_REVOKED, ActionFILE_LOCKED, ActionFILE_UNLOCKED, ActionOBJECT_LOCK_UPDATED, ActionFILE_TYPE_BLOCKED, ActionDELETION_SCHEDULED, ActionDELETION_CANCELED:
+		return nil
+	default:
+		return fmt.Errorf("fileauditevent: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the FileAuditEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByFileID orders the results by the file_id field.
+func ByFileID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileID, opts...).ToFunc()
+}
+
+// ByActorID orders the results by the actor_id field.
+func ByActorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActorID, opts...).ToFunc()
+}
+
+// ByImpersonatorID orders the results by the impersonator_id field.
+func ByImpersonatorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonatorID, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Action) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Action) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Action(str)
+	if err := ActionValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Action", str)
+	}
+	return nil
+}
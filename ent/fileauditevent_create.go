@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/fileauditevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FileAuditEventCreate is the builder for creating a FileAuditEvent entity.
+type FileAuditEventCreate struct {
+	config
+	mutation *FileAuditEventMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *FileAuditEventCreate) SetTenantID(v uuid.UUID) *FileAuditEventCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *FileAuditEventCreate) SetCreateTime(v time.Time) *FileAuditEventCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *FileAuditEventCreate) SetNillableCreateTime(v *time.Time) *FileAuditEventCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *FileAuditEventCreate) SetUpdateTime(v time.Time) *FileAuditEventCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *FileAuditEventCreate) SetNillableUpdateTime(v *time.Time) *FileAuditEventCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *FileAuditEventCreate) SetAction(v fileauditevent.Action) *FileAuditEventCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetFileID sets the "file_id" field.
+func (_c *FileAuditEventCreate) SetFileID(v uuid.UUID) *FileAuditEventCreate {
+	_c.mutation.SetFileID(v)
+	return _c
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_c *FileAuditEventCreate) SetNillableFileID(v *uuid.UUID) *FileAuditEventCreate {
+	if v != nil {
+		_c.SetFileID(*v)
+	}
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *FileAuditEventCreate) SetActorID(v uuid.UUID) *FileAuditEventCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *FileAuditEventCreate) SetNillableActorID(v *uuid.UUID) *FileAuditEventCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_c *FileAuditEventCreate) SetImpersonatorID(v uuid.UUID) *FileAuditEventCreate {
+	_c.mutation.SetImpersonatorID(v)
+	return _c
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_c *FileAuditEventCreate) SetNillableImpersonatorID(v *uuid.UUID) *FileAuditEventCreate {
+	if v != nil {
+		_c.SetImpersonatorID(*v)
+	}
+	return _c
+}
+
+// SetDetails sets the "details" field.
+func (_c *FileAuditEventCreate) SetDetails(v map[string]interface{}) *FileAuditEventCreate {
+	_c.mutation.SetDetails(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FileAuditEventCreate) SetID(v uuid.UUID) *FileAuditEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FileAuditEventCreate) SetNillableID(v *uuid.UUID) *FileAuditEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the FileAuditEventMutation object of the builder.
+func (_c *FileAuditEventCreate) Mutation() *FileAuditEventMutation {
+	return _c.mutation
+}
+
+// Save creates the FileAuditEvent in the database.
+func (_c *FileAuditEventCreate) Save(ctx context.Context) (*FileAuditEvent, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FileAuditEventCreate) SaveX(ctx context.Context) *FileAuditEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileAuditEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileAuditEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FileAuditEventCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if fileauditevent.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileauditevent.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := fileauditevent.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if fileauditevent.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileauditevent.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := fileauditevent.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if fileauditevent.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized fileauditevent.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := fileauditevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FileAuditEventCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "FileAuditEvent.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "FileAuditEvent.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "FileAuditEvent.update_time"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "FileAuditEvent.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := fileauditevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "FileAuditEvent.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *FileAuditEventCreate) sqlSave(ctx context.Context) (*FileAuditEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FileAuditEventCreate) createSpec() (*FileAuditEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FileAuditEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(fileauditevent.Table, sqlgraph.NewFieldSpec(fileauditevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(fileauditevent.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(fileauditevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(fileauditevent.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(fileauditevent.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.FileID(); ok {
+		_spec.SetField(fileauditevent.FieldFileID, field.TypeUUID, value)
+		_node.FileID = &value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(fileauditevent.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = &value
+	}
+	if value, ok := _c.mutation.ImpersonatorID(); ok {
+		_spec.SetField(fileauditevent.FieldImpersonatorID, field.TypeUUID, value)
+		_node.ImpersonatorID = &value
+	}
+	if value, ok := _c.mutation.Details(); ok {
+		_spec.SetField(fileauditevent.FieldDetails, field.TypeJSON, value)
+		_node.Details = value
+	}
+	return _node, _spec
+}
+
+// FileAuditEventCreateBulk is the builder for creating many FileAuditEvent entities in bulk.
+type FileAuditEventCreateBulk struct {
+	config
+	err      error
+	builders []*FileAuditEventCreate
+}
+
+// Save creates the FileAuditEvent entities in the database.
+func (_c *FileAuditEventCreateBulk) Save(ctx context.Context) ([]*FileAuditEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FileAuditEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileAuditEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FileAuditEventCreateBulk) SaveX(ctx context.Context) []*FileAuditEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileAuditEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileAuditEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
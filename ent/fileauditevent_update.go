@@ -0,0 +1,493 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/fileauditevent"
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FileAuditEventUpdate is the builder for updating FileAuditEvent entities.
+type FileAuditEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FileAuditEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FileAuditEventUpdate builder.
+func (_u *FileAuditEventUpdate) Where(ps ...predicate.FileAuditEvent) *FileAuditEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FileAuditEventUpdate) SetUpdateTime(v time.Time) *FileAuditEventUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *FileAuditEventUpdate) SetAction(v fileauditevent.Action) *FileAuditEventUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *FileAuditEventUpdate) SetNillableAction(v *fileauditevent.Action) *FileAuditEventUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *FileAuditEventUpdate) SetFileID(v uuid.UUID) *FileAuditEventUpdate {
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *FileAuditEventUpdate) SetNillableFileID(v *uuid.UUID) *FileAuditEventUpdate {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (_u *FileAuditEventUpdate) ClearFileID() *FileAuditEventUpdate {
+	_u.mutation.ClearFileID()
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *FileAuditEventUpdate) SetActorID(v uuid.UUID) *FileAuditEventUpdate {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *FileAuditEventUpdate) SetNillableActorID(v *uuid.UUID) *FileAuditEventUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *FileAuditEventUpdate) ClearActorID() *FileAuditEventUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *FileAuditEventUpdate) SetImpersonatorID(v uuid.UUID) *FileAuditEventUpdate {
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *FileAuditEventUpdate) SetNillableImpersonatorID(v *uuid.UUID) *FileAuditEventUpdate {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *FileAuditEventUpdate) ClearImpersonatorID() *FileAuditEventUpdate {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *FileAuditEventUpdate) SetDetails(v map[string]interface{}) *FileAuditEventUpdate {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *FileAuditEventUpdate) ClearDetails() *FileAuditEventUpdate {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// Mutation returns the FileAuditEventMutation object of the builder.
+func (_u *FileAuditEventUpdate) Mutation() *FileAuditEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FileAuditEventUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileAuditEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FileAuditEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileAuditEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FileAuditEventUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if fileauditevent.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileauditevent.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := fileauditevent.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileAuditEventUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := fileauditevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "FileAuditEvent.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileAuditEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileAuditEventUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileAuditEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fileauditevent.Table, fileauditevent.Columns, sqlgraph.NewFieldSpec(fileauditevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(fileauditevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(fileauditevent.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(fileauditevent.FieldFileID, field.TypeUUID, value)
+	}
+	if _u.mutation.FileIDCleared() {
+		_spec.ClearField(fileauditevent.FieldFileID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(fileauditevent.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(fileauditevent.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(fileauditevent.FieldImpersonatorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(fileauditevent.FieldImpersonatorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(fileauditevent.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(fileauditevent.FieldDetails, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileauditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FileAuditEventUpdateOne is the builder for updating a single FileAuditEvent entity.
+type FileAuditEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FileAuditEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FileAuditEventUpdateOne) SetUpdateTime(v time.Time) *FileAuditEventUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *FileAuditEventUpdateOne) SetAction(v fileauditevent.Action) *FileAuditEventUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *FileAuditEventUpdateOne) SetNillableAction(v *fileauditevent.Action) *FileAuditEventUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetFileID sets the "file_id" field.
+func (_u *FileAuditEventUpdateOne) SetFileID(v uuid.UUID) *FileAuditEventUpdateOne {
+	_u.mutation.SetFileID(v)
+	return _u
+}
+
+// SetNillableFileID sets the "file_id" field if the given value is not nil.
+func (_u *FileAuditEventUpdateOne) SetNillableFileID(v *uuid.UUID) *FileAuditEventUpdateOne {
+	if v != nil {
+		_u.SetFileID(*v)
+	}
+	return _u
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (_u *FileAuditEventUpdateOne) ClearFileID() *FileAuditEventUpdateOne {
+	_u.mutation.ClearFileID()
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *FileAuditEventUpdateOne) SetActorID(v uuid.UUID) *FileAuditEventUpdateOne {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *FileAuditEventUpdateOne) SetNillableActorID(v *uuid.UUID) *FileAuditEventUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *FileAuditEventUpdateOne) ClearActorID() *FileAuditEventUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *FileAuditEventUpdateOne) SetImpersonatorID(v uuid.UUID) *FileAuditEventUpdateOne {
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *FileAuditEventUpdateOne) SetNillableImpersonatorID(v *uuid.UUID) *FileAuditEventUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *FileAuditEventUpdateOne) ClearImpersonatorID() *FileAuditEventUpdateOne {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *FileAuditEventUpdateOne) SetDetails(v map[string]interface{}) *FileAuditEventUpdateOne {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *FileAuditEventUpdateOne) ClearDetails() *FileAuditEventUpdateOne {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// Mutation returns the FileAuditEventMutation object of the builder.
+func (_u *FileAuditEventUpdateOne) Mutation() *FileAuditEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FileAuditEventUpdate builder.
+func (_u *FileAuditEventUpdateOne) Where(ps ...predicate.FileAuditEvent) *FileAuditEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FileAuditEventUpdateOne) Select(field string, fields ...string) *FileAuditEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FileAuditEvent entity.
+func (_u *FileAuditEventUpdateOne) Save(ctx context.Context) (*FileAuditEvent, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileAuditEventUpdateOne) SaveX(ctx context.Context) *FileAuditEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FileAuditEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileAuditEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FileAuditEventUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if fileauditevent.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileauditevent.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := fileauditevent.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileAuditEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := fileauditevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "FileAuditEvent.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileAuditEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileAuditEventUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileAuditEventUpdateOne) sqlSave(ctx context.Context) (_node *FileAuditEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fileauditevent.Table, fileauditevent.Columns, sqlgraph.NewFieldSpec(fileauditevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileAuditEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fileauditevent.FieldID)
+		for _, f := range fields {
+			if !fileauditevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fileauditevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(fileauditevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(fileauditevent.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FileID(); ok {
+		_spec.SetField(fileauditevent.FieldFileID, field.TypeUUID, value)
+	}
+	if _u.mutation.FileIDCleared() {
+		_spec.ClearField(fileauditevent.FieldFileID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(fileauditevent.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(fileauditevent.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(fileauditevent.FieldImpersonatorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(fileauditevent.FieldImpersonatorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(fileauditevent.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(fileauditevent.FieldDetails, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FileAuditEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileauditevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
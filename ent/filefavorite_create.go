@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/file"
+	"main/ent/filefavorite"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FileFavoriteCreate is the builder for creating a FileFavorite entity.
+type FileFavoriteCreate struct {
+	config
+	mutation *FileFavoriteMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *FileFavoriteCreate) SetTenantID(v uuid.UUID) *FileFavoriteCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *FileFavoriteCreate) SetCreateTime(v time.Time) *FileFavoriteCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *FileFavoriteCreate) SetNillableCreateTime(v *time.Time) *FileFavoriteCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *FileFavoriteCreate) SetUpdateTime(v time.Time) *FileFavoriteCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *FileFavoriteCreate) SetNillableUpdateTime(v *time.Time) *FileFavoriteCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetFileID sets the "file_id" field.
+func (_c *FileFavoriteCreate) SetFileID(v uuid.UUID) *FileFavoriteCreate {
+	_c.mutation.SetFileID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *FileFavoriteCreate) SetUserID(v uuid.UUID) *FileFavoriteCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FileFavoriteCreate) SetID(v uuid.UUID) *FileFavoriteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FileFavoriteCreate) SetNillableID(v *uuid.UUID) *FileFavoriteCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetFile sets the "file" edge to the File entity.
+func (_c *FileFavoriteCreate) SetFile(v *File) *FileFavoriteCreate {
+	return _c.SetFileID(v.ID)
+}
+
+// Mutation returns the FileFavoriteMutation object of the builder.
+func (_c *FileFavoriteCreate) Mutation() *FileFavoriteMutation {
+	return _c.mutation
+}
+
+// Save creates the FileFavorite in the database.
+func (_c *FileFavoriteCreate) Save(ctx context.Context) (*FileFavorite, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FileFavoriteCreate) SaveX(ctx context.Context) *FileFavorite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileFavoriteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileFavoriteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FileFavoriteCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if filefavorite.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized filefavorite.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := filefavorite.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if filefavorite.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized filefavorite.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := filefavorite.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if filefavorite.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized filefavorite.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := filefavorite.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FileFavoriteCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "FileFavorite.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "FileFavorite.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "FileFavorite.update_time"`)}
+	}
+	if _, ok := _c.mutation.FileID(); !ok {
+		return &ValidationError{Name: "file_id", err: errors.New(`ent: missing required field "FileFavorite.file_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "FileFavorite.user_id"`)}
+	}
+	if len(_c.mutation.FileIDs()) == 0 {
+		return &ValidationError{Name: "file", err: errors.New(`ent: missing required edge "FileFavorite.file"`)}
+	}
+	return nil
+}
+
+func (_c *FileFavoriteCreate) sqlSave(ctx context.Context) (*FileFavorite, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FileFavoriteCreate) createSpec() (*FileFavorite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FileFavorite{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(filefavorite.Table, sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(filefavorite.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(filefavorite.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(filefavorite.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(filefavorite.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if nodes := _c.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filefavorite.FileTable,
+			Columns: []string{filefavorite.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(file.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FileID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FileFavoriteCreateBulk is the builder for creating many FileFavorite entities in bulk.
+type FileFavoriteCreateBulk struct {
+	config
+	err      error
+	builders []*FileFavoriteCreate
+}
+
+// Save creates the FileFavorite entities in the database.
+func (_c *FileFavoriteCreateBulk) Save(ctx context.Context) ([]*FileFavorite, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FileFavorite, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileFavoriteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FileFavoriteCreateBulk) SaveX(ctx context.Context) []*FileFavorite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileFavoriteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileFavoriteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/filefavorite"
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// FileFavoriteUpdate is the builder for updating FileFavorite entities.
+type FileFavoriteUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FileFavoriteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FileFavoriteUpdate builder.
+func (_u *FileFavoriteUpdate) Where(ps ...predicate.FileFavorite) *FileFavoriteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FileFavoriteUpdate) SetUpdateTime(v time.Time) *FileFavoriteUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// Mutation returns the FileFavoriteMutation object of the builder.
+func (_u *FileFavoriteUpdate) Mutation() *FileFavoriteMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FileFavoriteUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileFavoriteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FileFavoriteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileFavoriteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FileFavoriteUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if filefavorite.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized filefavorite.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := filefavorite.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileFavoriteUpdate) check() error {
+	if _u.mutation.FileCleared() && len(_u.mutation.FileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FileFavorite.file"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileFavoriteUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileFavoriteUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileFavoriteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filefavorite.Table, filefavorite.Columns, sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(filefavorite.FieldUpdateTime, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filefavorite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FileFavoriteUpdateOne is the builder for updating a single FileFavorite entity.
+type FileFavoriteUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FileFavoriteMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FileFavoriteUpdateOne) SetUpdateTime(v time.Time) *FileFavoriteUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// Mutation returns the FileFavoriteMutation object of the builder.
+func (_u *FileFavoriteUpdateOne) Mutation() *FileFavoriteMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FileFavoriteUpdate builder.
+func (_u *FileFavoriteUpdateOne) Where(ps ...predicate.FileFavorite) *FileFavoriteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FileFavoriteUpdateOne) Select(field string, fields ...string) *FileFavoriteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FileFavorite entity.
+func (_u *FileFavoriteUpdateOne) Save(ctx context.Context) (*FileFavorite, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileFavoriteUpdateOne) SaveX(ctx context.Context) *FileFavorite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FileFavoriteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileFavoriteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FileFavoriteUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if filefavorite.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized filefavorite.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := filefavorite.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileFavoriteUpdateOne) check() error {
+	if _u.mutation.FileCleared() && len(_u.mutation.FileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FileFavorite.file"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileFavoriteUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileFavoriteUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileFavoriteUpdateOne) sqlSave(ctx context.Context) (_node *FileFavorite, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filefavorite.Table, filefavorite.Columns, sqlgraph.NewFieldSpec(filefavorite.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileFavorite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, filefavorite.FieldID)
+		for _, f := range fields {
+			if !filefavorite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != filefavorite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(filefavorite.FieldUpdateTime, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FileFavorite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filefavorite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
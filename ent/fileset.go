@@ -0,0 +1,217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/ent/fileset"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// FileSet is the model entity for the FileSet schema.
+type FileSet struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Владелец набора
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// Название набора
+	Name string `json:"name,omitempty"`
+	// Описание набора
+	Description string `json:"description,omitempty"`
+	// Файлы набора в порядке добавления
+	FileIds []uuid.UUID `json:"file_ids,omitempty"`
+	// Набор доступен всем пользователям тенанта
+	SharedWithTenant bool `json:"shared_with_tenant,omitempty"`
+	// Пользователи, которым открыт доступ к набору
+	SharedUserIds []uuid.UUID `json:"shared_user_ids,omitempty"`
+	// Файлы, доступ к которым открыт вместе с набором: файлы набора, которые мог скачивать открывший доступ при открытии доступа или изменении состава. Остальные файлы доступны по собственным правам пользователя
+	GrantedFileIds []uuid.UUID `json:"granted_file_ids,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FileSet) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fileset.FieldFileIds, fileset.FieldSharedUserIds, fileset.FieldGrantedFileIds:
+			values[i] = new([]byte)
+		case fileset.FieldSharedWithTenant:
+			values[i] = new(sql.NullBool)
+		case fileset.FieldName, fileset.FieldDescription:
+			values[i] = new(sql.NullString)
+		case fileset.FieldCreateTime, fileset.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case fileset.FieldID, fileset.FieldTenantID, fileset.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FileSet fields.
+func (_m *FileSet) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fileset.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case fileset.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case fileset.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case fileset.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case fileset.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case fileset.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case fileset.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case fileset.FieldFileIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field file_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FileIds); err != nil {
+					return fmt.Errorf("unmarshal field file_ids: %w", err)
+				}
+			}
+		case fileset.FieldSharedWithTenant:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field shared_with_tenant", values[i])
+			} else if value.Valid {
+				_m.SharedWithTenant = value.Bool
+			}
+		case fileset.FieldSharedUserIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field shared_user_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SharedUserIds); err != nil {
+					return fmt.Errorf("unmarshal field shared_user_ids: %w", err)
+				}
+			}
+		case fileset.FieldGrantedFileIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_file_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GrantedFileIds); err != nil {
+					return fmt.Errorf("unmarshal field granted_file_ids: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FileSet.
+// This includes values selected through modifiers, order, etc.
+func (_m *FileSet) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FileSet.
+// Note that you need to call FileSet.Unwrap() before calling this method if this FileSet
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FileSet) Update() *FileSetUpdateOne {
+	return NewFileSetClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FileSet entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FileSet) Unwrap() *FileSet {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileSet is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FileSet) String() string {
+	var builder strings.Builder
+	builder.WriteString("FileSet(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("file_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FileIds))
+	builder.WriteString(", ")
+	builder.WriteString("shared_with_tenant=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SharedWithTenant))
+	builder.WriteString(", ")
+	builder.WriteString("shared_user_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SharedUserIds))
+	builder.WriteString(", ")
+	builder.WriteString("granted_file_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrantedFileIds))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FileSets is a parsable slice of FileSet.
+type FileSets []*FileSet
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/fileset"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FileSetCreate is the builder for creating a FileSet entity.
+type FileSetCreate struct {
+	config
+	mutation *FileSetMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *FileSetCreate) SetTenantID(v uuid.UUID) *FileSetCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *FileSetCreate) SetCreateTime(v time.Time) *FileSetCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *FileSetCreate) SetNillableCreateTime(v *time.Time) *FileSetCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *FileSetCreate) SetUpdateTime(v time.Time) *FileSetCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *FileSetCreate) SetNillableUpdateTime(v *time.Time) *FileSetCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *FileSetCreate) SetCreatedBy(v uuid.UUID) *FileSetCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *FileSetCreate) SetName(v string) *FileSetCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *FileSetCreate) SetDescription(v string) *FileSetCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *FileSetCreate) SetNillableDescription(v *string) *FileSetCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetFileIds sets the "file_ids" field.
+func (_c *FileSetCreate) SetFileIds(v []uuid.UUID) *FileSetCreate {
+	_c.mutation.SetFileIds(v)
+	return _c
+}
+
+// SetSharedWithTenant sets the "shared_with_tenant" field.
+func (_c *FileSetCreate) SetSharedWithTenant(v bool) *FileSetCreate {
+	_c.mutation.SetSharedWithTenant(v)
+	return _c
+}
+
+// SetNillableSharedWithTenant sets the "shared_with_tenant" field if the given value is not nil.
+func (_c *FileSetCreate) SetNillableSharedWithTenant(v *bool) *FileSetCreate {
+	if v != nil {
+		_c.SetSharedWithTenant(*v)
+	}
+	return _c
+}
+
+// SetSharedUserIds sets the "shared_user_ids" field.
+func (_c *FileSetCreate) SetSharedUserIds(v []uuid.UUID) *FileSetCreate {
+	_c.mutation.SetSharedUserIds(v)
+	return _c
+}
+
+// SetGrantedFileIds sets the "granted_file_ids" field.
+func (_c *FileSetCreate) SetGrantedFileIds(v []uuid.UUID) *FileSetCreate {
+	_c.mutation.SetGrantedFileIds(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FileSetCreate) SetID(v uuid.UUID) *FileSetCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FileSetCreate) SetNillableID(v *uuid.UUID) *FileSetCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the FileSetMutation object of the builder.
+func (_c *FileSetCreate) Mutation() *FileSetMutation {
+	return _c.mutation
+}
+
+// Save creates the FileSet in the database.
+func (_c *FileSetCreate) Save(ctx context.Context) (*FileSet, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FileSetCreate) SaveX(ctx context.Context) *FileSet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileSetCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileSetCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FileSetCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if fileset.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileset.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := fileset.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if fileset.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileset.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := fileset.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.SharedWithTenant(); !ok {
+		v := fileset.DefaultSharedWithTenant
+		_c.mutation.SetSharedWithTenant(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if fileset.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized fileset.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := fileset.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FileSetCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "FileSet.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "FileSet.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "FileSet.update_time"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "FileSet.created_by"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FileSet.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := fileset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FileSet.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Description(); ok {
+		if err := fileset.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "FileSet.description": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FileIds(); !ok {
+		return &ValidationError{Name: "file_ids", err: errors.New(`ent: missing required field "FileSet.file_ids"`)}
+	}
+	if _, ok := _c.mutation.SharedWithTenant(); !ok {
+		return &ValidationError{Name: "shared_with_tenant", err: errors.New(`ent: missing required field "FileSet.shared_with_tenant"`)}
+	}
+	return nil
+}
+
+func (_c *FileSetCreate) sqlSave(ctx context.Context) (*FileSet, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FileSetCreate) createSpec() (*FileSet, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FileSet{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(fileset.Table, sqlgraph.NewFieldSpec(fileset.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(fileset.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(fileset.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(fileset.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(fileset.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(fileset.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(fileset.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.FileIds(); ok {
+		_spec.SetField(fileset.FieldFileIds, field.TypeJSON, value)
+		_node.FileIds = value
+	}
+	if value, ok := _c.mutation.SharedWithTenant(); ok {
+		_spec.SetField(fileset.FieldSharedWithTenant, field.TypeBool, value)
+		_node.SharedWithTenant = value
+	}
+	if value, ok := _c.mutation.SharedUserIds(); ok {
+		_spec.SetField(fileset.FieldSharedUserIds, field.TypeJSON, value)
+		_node.SharedUserIds = value
+	}
+	if value, ok := _c.mutation.GrantedFileIds(); ok {
+		_spec.SetField(fileset.FieldGrantedFileIds, field.TypeJSON, value)
+		_node.GrantedFileIds = value
+	}
+	return _node, _spec
+}
+
+// FileSetCreateBulk is the builder for creating many FileSet entities in bulk.
+type FileSetCreateBulk struct {
+	config
+	err      error
+	builders []*FileSetCreate
+}
+
+// Save creates the FileSet entities in the database.
+func (_c *FileSetCreateBulk) Save(ctx context.Context) ([]*FileSet, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FileSet, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileSetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FileSetCreateBulk) SaveX(ctx context.Context) []*FileSet {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileSetCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileSetCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
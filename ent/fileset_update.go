@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/fileset"
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FileSetUpdate is the builder for updating FileSet entities.
+type FileSetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FileSetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FileSetUpdate builder.
+func (_u *FileSetUpdate) Where(ps ...predicate.FileSet) *FileSetUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FileSetUpdate) SetUpdateTime(v time.Time) *FileSetUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *FileSetUpdate) SetName(v string) *FileSetUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *FileSetUpdate) SetNillableName(v *string) *FileSetUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *FileSetUpdate) SetDescription(v string) *FileSetUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *FileSetUpdate) SetNillableDescription(v *string) *FileSetUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *FileSetUpdate) ClearDescription() *FileSetUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFileIds sets the "file_ids" field.
+func (_u *FileSetUpdate) SetFileIds(v []uuid.UUID) *FileSetUpdate {
+	_u.mutation.SetFileIds(v)
+	return _u
+}
+
+// AppendFileIds appends value to the "file_ids" field.
+func (_u *FileSetUpdate) AppendFileIds(v []uuid.UUID) *FileSetUpdate {
+	_u.mutation.AppendFileIds(v)
+	return _u
+}
+
+// SetSharedWithTenant sets the "shared_with_tenant" field.
+func (_u *FileSetUpdate) SetSharedWithTenant(v bool) *FileSetUpdate {
+	_u.mutation.SetSharedWithTenant(v)
+	return _u
+}
+
+// SetNillableSharedWithTenant sets the "shared_with_tenant" field if the given value is not nil.
+func (_u *FileSetUpdate) SetNillableSharedWithTenant(v *bool) *FileSetUpdate {
+	if v != nil {
+		_u.SetSharedWithTenant(*v)
+	}
+	return _u
+}
+
+// SetSharedUserIds sets the "shared_user_ids" field.
+func (_u *FileSetUpdate) SetSharedUserIds(v []uuid.UUID) *FileSetUpdate {
+	_u.mutation.SetSharedUserIds(v)
+	return _u
+}
+
+// AppendSharedUserIds appends value to the "shared_user_ids" field.
+func (_u *FileSetUpdate) AppendSharedUserIds(v []uuid.UUID) *FileSetUpdate {
+	_u.mutation.AppendSharedUserIds(v)
+	return _u
+}
+
+// ClearSharedUserIds clears the value of the "shared_user_ids" field.
+func (_u *FileSetUpdate) ClearSharedUserIds() *FileSetUpdate {
+	_u.mutation.ClearSharedUserIds()
+	return _u
+}
+
+// SetGrantedFileIds sets the "granted_file_ids" field.
+func (_u *FileSetUpdate) SetGrantedFileIds(v []uuid.UUID) *FileSetUpdate {
+	_u.mutation.SetGrantedFileIds(v)
+	return _u
+}
+
+// AppendGrantedFileIds appends value to the "granted_file_ids" field.
+func (_u *FileSetUpdate) AppendGrantedFileIds(v []uuid.UUID) *FileSetUpdate {
+	_u.mutation.AppendGrantedFileIds(v)
+	return _u
+}
+
+// ClearGrantedFileIds clears the value of the "granted_file_ids" field.
+func (_u *FileSetUpdate) ClearGrantedFileIds() *FileSetUpdate {
+	_u.mutation.ClearGrantedFileIds()
+	return _u
+}
+
+// Mutation returns the FileSetMutation object of the builder.
+func (_u *FileSetUpdate) Mutation() *FileSetMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FileSetUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileSetUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FileSetUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileSetUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FileSetUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if fileset.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileset.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := fileset.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileSetUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := fileset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FileSet.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := fileset.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "FileSet.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileSetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileSetUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileSetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fileset.Table, fileset.Columns, sqlgraph.NewFieldSpec(fileset.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(fileset.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(fileset.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(fileset.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(fileset.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileIds(); ok {
+		_spec.SetField(fileset.FieldFileIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFileIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileset.FieldFileIds, value)
+		})
+	}
+	if value, ok := _u.mutation.SharedWithTenant(); ok {
+		_spec.SetField(fileset.FieldSharedWithTenant, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SharedUserIds(); ok {
+		_spec.SetField(fileset.FieldSharedUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSharedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileset.FieldSharedUserIds, value)
+		})
+	}
+	if _u.mutation.SharedUserIdsCleared() {
+		_spec.ClearField(fileset.FieldSharedUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantedFileIds(); ok {
+		_spec.SetField(fileset.FieldGrantedFileIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantedFileIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileset.FieldGrantedFileIds, value)
+		})
+	}
+	if _u.mutation.GrantedFileIdsCleared() {
+		_spec.ClearField(fileset.FieldGrantedFileIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FileSetUpdateOne is the builder for updating a single FileSet entity.
+type FileSetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FileSetMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *FileSetUpdateOne) SetUpdateTime(v time.Time) *FileSetUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *FileSetUpdateOne) SetName(v string) *FileSetUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *FileSetUpdateOne) SetNillableName(v *string) *FileSetUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *FileSetUpdateOne) SetDescription(v string) *FileSetUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *FileSetUpdateOne) SetNillableDescription(v *string) *FileSetUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *FileSetUpdateOne) ClearDescription() *FileSetUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFileIds sets the "file_ids" field.
+func (_u *FileSetUpdateOne) SetFileIds(v []uuid.UUID) *FileSetUpdateOne {
+	_u.mutation.SetFileIds(v)
+	return _u
+}
+
+// AppendFileIds appends value to the "file_ids" field.
+func (_u *FileSetUpdateOne) AppendFileIds(v []uuid.UUID) *FileSetUpdateOne {
+	_u.mutation.AppendFileIds(v)
+	return _u
+}
+
+// SetSharedWithTenant sets the "shared_with_tenant" field.
+func (_u *FileSetUpdateOne) SetSharedWithTenant(v bool) *FileSetUpdateOne {
+	_u.mutation.SetSharedWithTenant(v)
+	return _u
+}
+
+// SetNillableSharedWithTenant sets the "shared_with_tenant" field if the given value is not nil.
+func (_u *FileSetUpdateOne) SetNillableSharedWithTenant(v *bool) *FileSetUpdateOne {
+	if v != nil {
+		_u.SetSharedWithTenant(*v)
+	}
+	return _u
+}
+
+// SetSharedUserIds sets the "shared_user_ids" field.
+func (_u *FileSetUpdateOne) SetSharedUserIds(v []uuid.UUID) *FileSetUpdateOne {
+	_u.mutation.SetSharedUserIds(v)
+	return _u
+}
+
+// AppendSharedUserIds appends value to the "shared_user_ids" field.
+func (_u *FileSetUpdateOne) AppendSharedUserIds(v []uuid.UUID) *FileSetUpdateOne {
+	_u.mutation.AppendSharedUserIds(v)
+	return _u
+}
+
+// ClearSharedUserIds clears the value of the "shared_user_ids" field.
+func (_u *FileSetUpdateOne) ClearSharedUserIds() *FileSetUpdateOne {
+	_u.mutation.ClearSharedUserIds()
+	return _u
+}
+
+// SetGrantedFileIds sets the "granted_file_ids" field.
+func (_u *FileSetUpdateOne) SetGrantedFileIds(v []uuid.UUID) *FileSetUpdateOne {
+	_u.mutation.SetGrantedFileIds(v)
+	return _u
+}
+
+// AppendGrantedFileIds appends value to the "granted_file_ids" field.
+func (_u *FileSetUpdateOne) AppendGrantedFileIds(v []uuid.UUID) *FileSetUpdateOne {
+	_u.mutation.AppendGrantedFileIds(v)
+	return _u
+}
+
+// ClearGrantedFileIds clears the value of the "granted_file_ids" field.
+func (_u *FileSetUpdateOne) ClearGrantedFileIds() *FileSetUpdateOne {
+	_u.mutation.ClearGrantedFileIds()
+	return _u
+}
+
+// Mutation returns the FileSetMutation object of the builder.
+func (_u *FileSetUpdateOne) Mutation() *FileSetMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FileSetUpdate builder.
+func (_u *FileSetUpdateOne) Where(ps ...predicate.FileSet) *FileSetUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FileSetUpdateOne) Select(field string, fields ...string) *FileSetUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FileSet entity.
+func (_u *FileSetUpdateOne) Save(ctx context.Context) (*FileSet, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileSetUpdateOne) SaveX(ctx context.Context) *FileSet {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FileSetUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileSetUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FileSetUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if fileset.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized fileset.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := fileset.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileSetUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := fileset.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FileSet.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Description(); ok {
+		if err := fileset.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "FileSet.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FileSetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FileSetUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FileSetUpdateOne) sqlSave(ctx context.Context) (_node *FileSet, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fileset.Table, fileset.Columns, sqlgraph.NewFieldSpec(fileset.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileSet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fileset.FieldID)
+		for _, f := range fields {
+			if !fileset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != fileset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(fileset.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(fileset.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(fileset.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(fileset.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.FileIds(); ok {
+		_spec.SetField(fileset.FieldFileIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFileIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileset.FieldFileIds, value)
+		})
+	}
+	if value, ok := _u.mutation.SharedWithTenant(); ok {
+		_spec.SetField(fileset.FieldSharedWithTenant, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SharedUserIds(); ok {
+		_spec.SetField(fileset.FieldSharedUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSharedUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileset.FieldSharedUserIds, value)
+		})
+	}
+	if _u.mutation.SharedUserIdsCleared() {
+		_spec.ClearField(fileset.FieldSharedUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantedFileIds(); ok {
+		_spec.SetField(fileset.FieldGrantedFileIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGrantedFileIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fileset.FieldGrantedFileIds, value)
+		})
+	}
+	if _u.mutation.GrantedFileIdsCleared() {
+		_spec.ClearField(fileset.FieldGrantedFileIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FileSet{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"main/ent/file"
+	"main/ent/tag"
 
 	"entgo.io/contrib/entgql"
 	"github.com/99designs/gqlgen/graphql"
@@ -31,6 +32,19 @@ func (_q *FileQuery) collectField(ctx context.Context, oneNode bool, opCtx *grap
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
+
+		case "tags":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&TagClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, tagImplementors)...); err != nil {
+				return err
+			}
+			_q.WithNamedTags(alias, func(wq *TagQuery) {
+				*wq = *query
+			})
 		case "createTime":
 			if _, ok := fieldSeen[file.FieldCreateTime]; !ok {
 				selectedFields = append(selectedFields, file.FieldCreateTime)
@@ -41,6 +55,11 @@ func (_q *FileQuery) collectField(ctx context.Context, oneNode bool, opCtx *grap
 				selectedFields = append(selectedFields, file.FieldUpdateTime)
 				fieldSeen[file.FieldUpdateTime] = struct{}{}
 			}
+		case "deletedAt":
+			if _, ok := fieldSeen[file.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, file.FieldDeletedAt)
+				fieldSeen[file.FieldDeletedAt] = struct{}{}
+			}
 		case "originalName":
 			if _, ok := fieldSeen[file.FieldOriginalName]; !ok {
 				selectedFields = append(selectedFields, file.FieldOriginalName)
@@ -56,16 +75,16 @@ func (_q *FileQuery) collectField(ctx context.Context, oneNode bool, opCtx *grap
 				selectedFields = append(selectedFields, file.FieldMimeType)
 				fieldSeen[file.FieldMimeType] = struct{}{}
 			}
+		case "detectedMimeType":
+			if _, ok := fieldSeen[file.FieldDetectedMimeType]; !ok {
+				selectedFields = append(selectedFields, file.FieldDetectedMimeType)
+				fieldSeen[file.FieldDetectedMimeType] = struct{}{}
+			}
 		case "size":
 			if _, ok := fieldSeen[file.FieldSize]; !ok {
 				selectedFields = append(selectedFields, file.FieldSize)
 				fieldSeen[file.FieldSize] = struct{}{}
 			}
-		case "path":
-			if _, ok := fieldSeen[file.FieldPath]; !ok {
-				selectedFields = append(selectedFields, file.FieldPath)
-				fieldSeen[file.FieldPath] = struct{}{}
-			}
 		case "description":
 			if _, ok := fieldSeen[file.FieldDescription]; !ok {
 				selectedFields = append(selectedFields, file.FieldDescription)
@@ -76,6 +95,116 @@ func (_q *FileQuery) collectField(ctx context.Context, oneNode bool, opCtx *grap
 				selectedFields = append(selectedFields, file.FieldMetadata)
 				fieldSeen[file.FieldMetadata] = struct{}{}
 			}
+		case "checksumSha256":
+			if _, ok := fieldSeen[file.FieldChecksumSha256]; !ok {
+				selectedFields = append(selectedFields, file.FieldChecksumSha256)
+				fieldSeen[file.FieldChecksumSha256] = struct{}{}
+			}
+		case "integrityStatus":
+			if _, ok := fieldSeen[file.FieldIntegrityStatus]; !ok {
+				selectedFields = append(selectedFields, file.FieldIntegrityStatus)
+				fieldSeen[file.FieldIntegrityStatus] = struct{}{}
+			}
+		case "integrityCheckedAt":
+			if _, ok := fieldSeen[file.FieldIntegrityCheckedAt]; !ok {
+				selectedFields = append(selectedFields, file.FieldIntegrityCheckedAt)
+				fieldSeen[file.FieldIntegrityCheckedAt] = struct{}{}
+			}
+		case "thumbnailStatus":
+			if _, ok := fieldSeen[file.FieldThumbnailStatus]; !ok {
+				selectedFields = append(selectedFields, file.FieldThumbnailStatus)
+				fieldSeen[file.FieldThumbnailStatus] = struct{}{}
+			}
+		case "previewStatus":
+			if _, ok := fieldSeen[file.FieldPreviewStatus]; !ok {
+				selectedFields = append(selectedFields, file.FieldPreviewStatus)
+				fieldSeen[file.FieldPreviewStatus] = struct{}{}
+			}
+		case "pdfStatus":
+			if _, ok := fieldSeen[file.FieldPdfStatus]; !ok {
+				selectedFields = append(selectedFields, file.FieldPdfStatus)
+				fieldSeen[file.FieldPdfStatus] = struct{}{}
+			}
+		case "scanStatus":
+			if _, ok := fieldSeen[file.FieldScanStatus]; !ok {
+				selectedFields = append(selectedFields, file.FieldScanStatus)
+				fieldSeen[file.FieldScanStatus] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[file.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, file.FieldExpiresAt)
+				fieldSeen[file.FieldExpiresAt] = struct{}{}
+			}
+		case "uploadSource":
+			if _, ok := fieldSeen[file.FieldUploadSource]; !ok {
+				selectedFields = append(selectedFields, file.FieldUploadSource)
+				fieldSeen[file.FieldUploadSource] = struct{}{}
+			}
+		case "clientVersion":
+			if _, ok := fieldSeen[file.FieldClientVersion]; !ok {
+				selectedFields = append(selectedFields, file.FieldClientVersion)
+				fieldSeen[file.FieldClientVersion] = struct{}{}
+			}
+		case "downloadCount":
+			if _, ok := fieldSeen[file.FieldDownloadCount]; !ok {
+				selectedFields = append(selectedFields, file.FieldDownloadCount)
+				fieldSeen[file.FieldDownloadCount] = struct{}{}
+			}
+		case "lastDownloadedAt":
+			if _, ok := fieldSeen[file.FieldLastDownloadedAt]; !ok {
+				selectedFields = append(selectedFields, file.FieldLastDownloadedAt)
+				fieldSeen[file.FieldLastDownloadedAt] = struct{}{}
+			}
+		case "archivedAt":
+			if _, ok := fieldSeen[file.FieldArchivedAt]; !ok {
+				selectedFields = append(selectedFields, file.FieldArchivedAt)
+				fieldSeen[file.FieldArchivedAt] = struct{}{}
+			}
+		case "entityType":
+			if _, ok := fieldSeen[file.FieldEntityType]; !ok {
+				selectedFields = append(selectedFields, file.FieldEntityType)
+				fieldSeen[file.FieldEntityType] = struct{}{}
+			}
+		case "entityID":
+			if _, ok := fieldSeen[file.FieldEntityID]; !ok {
+				selectedFields = append(selectedFields, file.FieldEntityID)
+				fieldSeen[file.FieldEntityID] = struct{}{}
+			}
+		case "lockedBy":
+			if _, ok := fieldSeen[file.FieldLockedBy]; !ok {
+				selectedFields = append(selectedFields, file.FieldLockedBy)
+				fieldSeen[file.FieldLockedBy] = struct{}{}
+			}
+		case "lockedAt":
+			if _, ok := fieldSeen[file.FieldLockedAt]; !ok {
+				selectedFields = append(selectedFields, file.FieldLockedAt)
+				fieldSeen[file.FieldLockedAt] = struct{}{}
+			}
+		case "objectLockMode":
+			if _, ok := fieldSeen[file.FieldObjectLockMode]; !ok {
+				selectedFields = append(selectedFields, file.FieldObjectLockMode)
+				fieldSeen[file.FieldObjectLockMode] = struct{}{}
+			}
+		case "objectLockRetainUntil":
+			if _, ok := fieldSeen[file.FieldObjectLockRetainUntil]; !ok {
+				selectedFields = append(selectedFields, file.FieldObjectLockRetainUntil)
+				fieldSeen[file.FieldObjectLockRetainUntil] = struct{}{}
+			}
+		case "legalHold":
+			if _, ok := fieldSeen[file.FieldLegalHold]; !ok {
+				selectedFields = append(selectedFields, file.FieldLegalHold)
+				fieldSeen[file.FieldLegalHold] = struct{}{}
+			}
+		case "scheduledDeletionAt":
+			if _, ok := fieldSeen[file.FieldScheduledDeletionAt]; !ok {
+				selectedFields = append(selectedFields, file.FieldScheduledDeletionAt)
+				fieldSeen[file.FieldScheduledDeletionAt] = struct{}{}
+			}
+		case "scheduledDeletionBy":
+			if _, ok := fieldSeen[file.FieldScheduledDeletionBy]; !ok {
+				selectedFields = append(selectedFields, file.FieldScheduledDeletionBy)
+				fieldSeen[file.FieldScheduledDeletionBy] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -145,6 +274,110 @@ func newFilePaginateArgs(rv map[string]any) *filePaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *TagQuery) CollectFields(ctx context.Context, satisfies ...string) (*TagQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *TagQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(tag.Columns))
+		selectedFields = []string{tag.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createTime":
+			if _, ok := fieldSeen[tag.FieldCreateTime]; !ok {
+				selectedFields = append(selectedFields, tag.FieldCreateTime)
+				fieldSeen[tag.FieldCreateTime] = struct{}{}
+			}
+		case "updateTime":
+			if _, ok := fieldSeen[tag.FieldUpdateTime]; !ok {
+				selectedFields = append(selectedFields, tag.FieldUpdateTime)
+				fieldSeen[tag.FieldUpdateTime] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[tag.FieldName]; !ok {
+				selectedFields = append(selectedFields, tag.FieldName)
+				fieldSeen[tag.FieldName] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[tag.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, tag.FieldCreatedBy)
+				fieldSeen[tag.FieldCreatedBy] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type tagPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []TagPaginateOption
+}
+
+func newTagPaginateArgs(rv map[string]any) *tagPaginateArgs {
+	args := &tagPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &TagOrder{Field: &TagOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithTagOrder(order))
+			}
+		case *TagOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithTagOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*TagWhereInput); ok {
+		args.opts = append(args.opts, WithTagFilter(v.Filter))
+	}
+	return args
+}
+
 const (
 	afterField     = "after"
 	firstField     = "first"
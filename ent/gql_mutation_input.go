@@ -8,7 +8,6 @@ type CreateFileInput struct {
 	StorageKey   string
 	MimeType     string
 	Size         int64
-	Path         *string
 	Description  *string
 	Metadata     map[string]interface{}
 }
@@ -19,9 +18,6 @@ func (i *CreateFileInput) Mutate(m *FileMutation) {
 	m.SetStorageKey(i.StorageKey)
 	m.SetMimeType(i.MimeType)
 	m.SetSize(i.Size)
-	if v := i.Path; v != nil {
-		m.SetPath(*v)
-	}
 	if v := i.Description; v != nil {
 		m.SetDescription(*v)
 	}
@@ -42,8 +38,6 @@ type UpdateFileInput struct {
 	StorageKey       *string
 	MimeType         *string
 	Size             *int64
-	ClearPath        bool
-	Path             *string
 	ClearDescription bool
 	Description      *string
 	ClearMetadata    bool
@@ -64,12 +58,6 @@ func (i *UpdateFileInput) Mutate(m *FileMutation) {
 	if v := i.Size; v != nil {
 		m.SetSize(*v)
 	}
-	if i.ClearPath {
-		m.ClearPath()
-	}
-	if v := i.Path; v != nil {
-		m.SetPath(*v)
-	}
 	if i.ClearDescription {
 		m.ClearDescription()
 	}
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"encoding/json"
+	"main/ent/tag"
 
 	"github.com/google/uuid"
 )
@@ -36,8 +37,8 @@ func (_m *File) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     _m.ID,
 		Type:   "File",
-		Fields: make([]*Field, 9),
-		Edges:  make([]*Edge, 0),
+		Fields: make([]*Field, 32),
+		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(_m.CreateTime); err != nil {
@@ -56,10 +57,18 @@ func (_m *File) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "update_time",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.OriginalName); err != nil {
+	if buf, err = json.Marshal(_m.DeletedAt); err != nil {
 		return nil, err
 	}
 	node.Fields[2] = &Field{
+		Type:  "time.Time",
+		Name:  "deleted_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.OriginalName); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
 		Type:  "string",
 		Name:  "original_name",
 		Value: string(buf),
@@ -67,7 +76,7 @@ func (_m *File) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(_m.StorageKey); err != nil {
 		return nil, err
 	}
-	node.Fields[3] = &Field{
+	node.Fields[4] = &Field{
 		Type:  "string",
 		Name:  "storage_key",
 		Value: string(buf),
@@ -75,31 +84,31 @@ func (_m *File) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(_m.MimeType); err != nil {
 		return nil, err
 	}
-	node.Fields[4] = &Field{
+	node.Fields[5] = &Field{
 		Type:  "string",
 		Name:  "mime_type",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Size); err != nil {
+	if buf, err = json.Marshal(_m.DetectedMimeType); err != nil {
 		return nil, err
 	}
-	node.Fields[5] = &Field{
-		Type:  "int64",
-		Name:  "size",
+	node.Fields[6] = &Field{
+		Type:  "string",
+		Name:  "detected_mime_type",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(_m.Path); err != nil {
+	if buf, err = json.Marshal(_m.Size); err != nil {
 		return nil, err
 	}
-	node.Fields[6] = &Field{
-		Type:  "string",
-		Name:  "path",
+	node.Fields[7] = &Field{
+		Type:  "int64",
+		Name:  "size",
 		Value: string(buf),
 	}
 	if buf, err = json.Marshal(_m.Description); err != nil {
 		return nil, err
 	}
-	node.Fields[7] = &Field{
+	node.Fields[8] = &Field{
 		Type:  "string",
 		Name:  "description",
 		Value: string(buf),
@@ -107,11 +116,241 @@ func (_m *File) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(_m.Metadata); err != nil {
 		return nil, err
 	}
-	node.Fields[8] = &Field{
+	node.Fields[9] = &Field{
 		Type:  "map[string]interface {}",
 		Name:  "metadata",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(_m.ChecksumSha256); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "string",
+		Name:  "checksum_sha256",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.IntegrityStatus); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "file.IntegrityStatus",
+		Name:  "integrity_status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.IntegrityCheckedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[12] = &Field{
+		Type:  "time.Time",
+		Name:  "integrity_checked_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ThumbnailStatus); err != nil {
+		return nil, err
+	}
+	node.Fields[13] = &Field{
+		Type:  "file.ThumbnailStatus",
+		Name:  "thumbnail_status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.PreviewStatus); err != nil {
+		return nil, err
+	}
+	node.Fields[14] = &Field{
+		Type:  "file.PreviewStatus",
+		Name:  "preview_status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.PdfStatus); err != nil {
+		return nil, err
+	}
+	node.Fields[15] = &Field{
+		Type:  "file.PdfStatus",
+		Name:  "pdf_status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ScanStatus); err != nil {
+		return nil, err
+	}
+	node.Fields[16] = &Field{
+		Type:  "file.ScanStatus",
+		Name:  "scan_status",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ExpiresAt); err != nil {
+		return nil, err
+	}
+	node.Fields[17] = &Field{
+		Type:  "time.Time",
+		Name:  "expires_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UploadSource); err != nil {
+		return nil, err
+	}
+	node.Fields[18] = &Field{
+		Type:  "file.UploadSource",
+		Name:  "upload_source",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ClientVersion); err != nil {
+		return nil, err
+	}
+	node.Fields[19] = &Field{
+		Type:  "string",
+		Name:  "client_version",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.DownloadCount); err != nil {
+		return nil, err
+	}
+	node.Fields[20] = &Field{
+		Type:  "int64",
+		Name:  "download_count",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.LastDownloadedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[21] = &Field{
+		Type:  "time.Time",
+		Name:  "last_downloaded_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ArchivedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[22] = &Field{
+		Type:  "time.Time",
+		Name:  "archived_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.EntityType); err != nil {
+		return nil, err
+	}
+	node.Fields[23] = &Field{
+		Type:  "file.EntityType",
+		Name:  "entity_type",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.EntityID); err != nil {
+		return nil, err
+	}
+	node.Fields[24] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "entity_id",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.LockedBy); err != nil {
+		return nil, err
+	}
+	node.Fields[25] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "locked_by",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.LockedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[26] = &Field{
+		Type:  "time.Time",
+		Name:  "locked_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ObjectLockMode); err != nil {
+		return nil, err
+	}
+	node.Fields[27] = &Field{
+		Type:  "file.ObjectLockMode",
+		Name:  "object_lock_mode",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ObjectLockRetainUntil); err != nil {
+		return nil, err
+	}
+	node.Fields[28] = &Field{
+		Type:  "time.Time",
+		Name:  "object_lock_retain_until",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.LegalHold); err != nil {
+		return nil, err
+	}
+	node.Fields[29] = &Field{
+		Type:  "bool",
+		Name:  "legal_hold",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ScheduledDeletionAt); err != nil {
+		return nil, err
+	}
+	node.Fields[30] = &Field{
+		Type:  "time.Time",
+		Name:  "scheduled_deletion_at",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.ScheduledDeletionBy); err != nil {
+		return nil, err
+	}
+	node.Fields[31] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "scheduled_deletion_by",
+		Value: string(buf),
+	}
+	node.Edges[0] = &Edge{
+		Type: "Tag",
+		Name: "tags",
+	}
+	err = _m.QueryTags().
+		Select(tag.FieldID).
+		Scan(ctx, &node.Edges[0].IDs)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// Node implements Noder interface
+func (_m *Tag) Node(ctx context.Context) (node *Node, err error) {
+	node = &Node{
+		ID:     _m.ID,
+		Type:   "Tag",
+		Fields: make([]*Field, 4),
+		Edges:  make([]*Edge, 0),
+	}
+	var buf []byte
+	if buf, err = json.Marshal(_m.CreateTime); err != nil {
+		return nil, err
+	}
+	node.Fields[0] = &Field{
+		Type:  "time.Time",
+		Name:  "create_time",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.UpdateTime); err != nil {
+		return nil, err
+	}
+	node.Fields[1] = &Field{
+		Type:  "time.Time",
+		Name:  "update_time",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.Name); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "name",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(_m.CreatedBy); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "uuid.UUID",
+		Name:  "created_by",
+		Value: string(buf),
+	}
 	return node, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"main/ent/file"
 	"main/ent/predicate"
+	"main/ent/tag"
 	"time"
 
 	"github.com/google/uuid"
@@ -49,6 +50,18 @@ type FileWhereInput struct {
 	UpdateTimeLT    *time.Time  `json:"updateTimeLT,omitempty"`
 	UpdateTimeLTE   *time.Time  `json:"updateTimeLTE,omitempty"`
 
+	// "deleted_at" field predicates.
+	DeletedAt       *time.Time  `json:"deletedAt,omitempty"`
+	DeletedAtNEQ    *time.Time  `json:"deletedAtNEQ,omitempty"`
+	DeletedAtIn     []time.Time `json:"deletedAtIn,omitempty"`
+	DeletedAtNotIn  []time.Time `json:"deletedAtNotIn,omitempty"`
+	DeletedAtGT     *time.Time  `json:"deletedAtGT,omitempty"`
+	DeletedAtGTE    *time.Time  `json:"deletedAtGTE,omitempty"`
+	DeletedAtLT     *time.Time  `json:"deletedAtLT,omitempty"`
+	DeletedAtLTE    *time.Time  `json:"deletedAtLTE,omitempty"`
+	DeletedAtIsNil  bool        `json:"deletedAtIsNil,omitempty"`
+	DeletedAtNotNil bool        `json:"deletedAtNotNil,omitempty"`
+
 	// "original_name" field predicates.
 	OriginalName             *string  `json:"originalName,omitempty"`
 	OriginalNameNEQ          *string  `json:"originalNameNEQ,omitempty"`
@@ -94,6 +107,23 @@ type FileWhereInput struct {
 	MimeTypeEqualFold    *string  `json:"mimeTypeEqualFold,omitempty"`
 	MimeTypeContainsFold *string  `json:"mimeTypeContainsFold,omitempty"`
 
+	// "detected_mime_type" field predicates.
+	DetectedMimeType             *string  `json:"detectedMimeType,omitempty"`
+	DetectedMimeTypeNEQ          *string  `json:"detectedMimeTypeNEQ,omitempty"`
+	DetectedMimeTypeIn           []string `json:"detectedMimeTypeIn,omitempty"`
+	DetectedMimeTypeNotIn        []string `json:"detectedMimeTypeNotIn,omitempty"`
+	DetectedMimeTypeGT           *string  `json:"detectedMimeTypeGT,omitempty"`
+	DetectedMimeTypeGTE          *string  `json:"detectedMimeTypeGTE,omitempty"`
+	DetectedMimeTypeLT           *string  `json:"detectedMimeTypeLT,omitempty"`
+	DetectedMimeTypeLTE          *string  `json:"detectedMimeTypeLTE,omitempty"`
+	DetectedMimeTypeContains     *string  `json:"detectedMimeTypeContains,omitempty"`
+	DetectedMimeTypeHasPrefix    *string  `json:"detectedMimeTypeHasPrefix,omitempty"`
+	DetectedMimeTypeHasSuffix    *string  `json:"detectedMimeTypeHasSuffix,omitempty"`
+	DetectedMimeTypeIsNil        bool     `json:"detectedMimeTypeIsNil,omitempty"`
+	DetectedMimeTypeNotNil       bool     `json:"detectedMimeTypeNotNil,omitempty"`
+	DetectedMimeTypeEqualFold    *string  `json:"detectedMimeTypeEqualFold,omitempty"`
+	DetectedMimeTypeContainsFold *string  `json:"detectedMimeTypeContainsFold,omitempty"`
+
 	// "size" field predicates.
 	Size      *int64  `json:"size,omitempty"`
 	SizeNEQ   *int64  `json:"sizeNEQ,omitempty"`
@@ -104,23 +134,6 @@ type FileWhereInput struct {
 	SizeLT    *int64  `json:"sizeLT,omitempty"`
 	SizeLTE   *int64  `json:"sizeLTE,omitempty"`
 
-	// "path" field predicates.
-	Path             *string  `json:"path,omitempty"`
-	PathNEQ          *string  `json:"pathNEQ,omitempty"`
-	PathIn           []string `json:"pathIn,omitempty"`
-	PathNotIn        []string `json:"pathNotIn,omitempty"`
-	PathGT           *string  `json:"pathGT,omitempty"`
-	PathGTE          *string  `json:"pathGTE,omitempty"`
-	PathLT           *string  `json:"pathLT,omitempty"`
-	PathLTE          *string  `json:"pathLTE,omitempty"`
-	PathContains     *string  `json:"pathContains,omitempty"`
-	PathHasPrefix    *string  `json:"pathHasPrefix,omitempty"`
-	PathHasSuffix    *string  `json:"pathHasSuffix,omitempty"`
-	PathIsNil        bool     `json:"pathIsNil,omitempty"`
-	PathNotNil       bool     `json:"pathNotNil,omitempty"`
-	PathEqualFold    *string  `json:"pathEqualFold,omitempty"`
-	PathContainsFold *string  `json:"pathContainsFold,omitempty"`
-
 	// "description" field predicates.
 	Description             *string  `json:"description,omitempty"`
 	DescriptionNEQ          *string  `json:"descriptionNEQ,omitempty"`
@@ -137,6 +150,230 @@ type FileWhereInput struct {
 	DescriptionNotNil       bool     `json:"descriptionNotNil,omitempty"`
 	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
 	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+
+	// "checksum_sha256" field predicates.
+	ChecksumSha256             *string  `json:"checksumSha256,omitempty"`
+	ChecksumSha256NEQ          *string  `json:"checksumSha256NEQ,omitempty"`
+	ChecksumSha256In           []string `json:"checksumSha256In,omitempty"`
+	ChecksumSha256NotIn        []string `json:"checksumSha256NotIn,omitempty"`
+	ChecksumSha256GT           *string  `json:"checksumSha256GT,omitempty"`
+	ChecksumSha256GTE          *string  `json:"checksumSha256GTE,omitempty"`
+	ChecksumSha256LT           *string  `json:"checksumSha256LT,omitempty"`
+	ChecksumSha256LTE          *string  `json:"checksumSha256LTE,omitempty"`
+	ChecksumSha256Contains     *string  `json:"checksumSha256Contains,omitempty"`
+	ChecksumSha256HasPrefix    *string  `json:"checksumSha256HasPrefix,omitempty"`
+	ChecksumSha256HasSuffix    *string  `json:"checksumSha256HasSuffix,omitempty"`
+	ChecksumSha256IsNil        bool     `json:"checksumSha256IsNil,omitempty"`
+	ChecksumSha256NotNil       bool     `json:"checksumSha256NotNil,omitempty"`
+	ChecksumSha256EqualFold    *string  `json:"checksumSha256EqualFold,omitempty"`
+	ChecksumSha256ContainsFold *string  `json:"checksumSha256ContainsFold,omitempty"`
+
+	// "integrity_status" field predicates.
+	IntegrityStatus      *file.IntegrityStatus  `json:"integrityStatus,omitempty"`
+	IntegrityStatusNEQ   *file.IntegrityStatus  `json:"integrityStatusNEQ,omitempty"`
+	IntegrityStatusIn    []file.IntegrityStatus `json:"integrityStatusIn,omitempty"`
+	IntegrityStatusNotIn []file.IntegrityStatus `json:"integrityStatusNotIn,omitempty"`
+
+	// "integrity_checked_at" field predicates.
+	IntegrityCheckedAt       *time.Time  `json:"integrityCheckedAt,omitempty"`
+	IntegrityCheckedAtNEQ    *time.Time  `json:"integrityCheckedAtNEQ,omitempty"`
+	IntegrityCheckedAtIn     []time.Time `json:"integrityCheckedAtIn,omitempty"`
+	IntegrityCheckedAtNotIn  []time.Time `json:"integrityCheckedAtNotIn,omitempty"`
+	IntegrityCheckedAtGT     *time.Time  `json:"integrityCheckedAtGT,omitempty"`
+	IntegrityCheckedAtGTE    *time.Time  `json:"integrityCheckedAtGTE,omitempty"`
+	IntegrityCheckedAtLT     *time.Time  `json:"integrityCheckedAtLT,omitempty"`
+	IntegrityCheckedAtLTE    *time.Time  `json:"integrityCheckedAtLTE,omitempty"`
+	IntegrityCheckedAtIsNil  bool        `json:"integrityCheckedAtIsNil,omitempty"`
+	IntegrityCheckedAtNotNil bool        `json:"integrityCheckedAtNotNil,omitempty"`
+
+	// "thumbnail_status" field predicates.
+	ThumbnailStatus      *file.ThumbnailStatus  `json:"thumbnailStatus,omitempty"`
+	ThumbnailStatusNEQ   *file.ThumbnailStatus  `json:"thumbnailStatusNEQ,omitempty"`
+	ThumbnailStatusIn    []file.ThumbnailStatus `json:"thumbnailStatusIn,omitempty"`
+	ThumbnailStatusNotIn []file.ThumbnailStatus `json:"thumbnailStatusNotIn,omitempty"`
+
+	// "preview_status" field predicates.
+	PreviewStatus      *file.PreviewStatus  `json:"previewStatus,omitempty"`
+	PreviewStatusNEQ   *file.PreviewStatus  `json:"previewStatusNEQ,omitempty"`
+	PreviewStatusIn    []file.PreviewStatus `json:"previewStatusIn,omitempty"`
+	PreviewStatusNotIn []file.PreviewStatus `json:"previewStatusNotIn,omitempty"`
+
+	// "pdf_status" field predicates.
+	PdfStatus      *file.PdfStatus  `json:"pdfStatus,omitempty"`
+	PdfStatusNEQ   *file.PdfStatus  `json:"pdfStatusNEQ,omitempty"`
+	PdfStatusIn    []file.PdfStatus `json:"pdfStatusIn,omitempty"`
+	PdfStatusNotIn []file.PdfStatus `json:"pdfStatusNotIn,omitempty"`
+
+	// "scan_status" field predicates.
+	ScanStatus      *file.ScanStatus  `json:"scanStatus,omitempty"`
+	ScanStatusNEQ   *file.ScanStatus  `json:"scanStatusNEQ,omitempty"`
+	ScanStatusIn    []file.ScanStatus `json:"scanStatusIn,omitempty"`
+	ScanStatusNotIn []file.ScanStatus `json:"scanStatusNotIn,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt       *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ    *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn     []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn  []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT     *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE    *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT     *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE    *time.Time  `json:"expiresAtLTE,omitempty"`
+	ExpiresAtIsNil  bool        `json:"expiresAtIsNil,omitempty"`
+	ExpiresAtNotNil bool        `json:"expiresAtNotNil,omitempty"`
+
+	// "upload_source" field predicates.
+	UploadSource      *file.UploadSource  `json:"uploadSource,omitempty"`
+	UploadSourceNEQ   *file.UploadSource  `json:"uploadSourceNEQ,omitempty"`
+	UploadSourceIn    []file.UploadSource `json:"uploadSourceIn,omitempty"`
+	UploadSourceNotIn []file.UploadSource `json:"uploadSourceNotIn,omitempty"`
+
+	// "client_version" field predicates.
+	ClientVersion             *string  `json:"clientVersion,omitempty"`
+	ClientVersionNEQ          *string  `json:"clientVersionNEQ,omitempty"`
+	ClientVersionIn           []string `json:"clientVersionIn,omitempty"`
+	ClientVersionNotIn        []string `json:"clientVersionNotIn,omitempty"`
+	ClientVersionGT           *string  `json:"clientVersionGT,omitempty"`
+	ClientVersionGTE          *string  `json:"clientVersionGTE,omitempty"`
+	ClientVersionLT           *string  `json:"clientVersionLT,omitempty"`
+	ClientVersionLTE          *string  `json:"clientVersionLTE,omitempty"`
+	ClientVersionContains     *string  `json:"clientVersionContains,omitempty"`
+	ClientVersionHasPrefix    *string  `json:"clientVersionHasPrefix,omitempty"`
+	ClientVersionHasSuffix    *string  `json:"clientVersionHasSuffix,omitempty"`
+	ClientVersionIsNil        bool     `json:"clientVersionIsNil,omitempty"`
+	ClientVersionNotNil       bool     `json:"clientVersionNotNil,omitempty"`
+	ClientVersionEqualFold    *string  `json:"clientVersionEqualFold,omitempty"`
+	ClientVersionContainsFold *string  `json:"clientVersionContainsFold,omitempty"`
+
+	// "download_count" field predicates.
+	DownloadCount      *int64  `json:"downloadCount,omitempty"`
+	DownloadCountNEQ   *int64  `json:"downloadCountNEQ,omitempty"`
+	DownloadCountIn    []int64 `json:"downloadCountIn,omitempty"`
+	DownloadCountNotIn []int64 `json:"downloadCountNotIn,omitempty"`
+	DownloadCountGT    *int64  `json:"downloadCountGT,omitempty"`
+	DownloadCountGTE   *int64  `json:"downloadCountGTE,omitempty"`
+	DownloadCountLT    *int64  `json:"downloadCountLT,omitempty"`
+	DownloadCountLTE   *int64  `json:"downloadCountLTE,omitempty"`
+
+	// "last_downloaded_at" field predicates.
+	LastDownloadedAt       *time.Time  `json:"lastDownloadedAt,omitempty"`
+	LastDownloadedAtNEQ    *time.Time  `json:"lastDownloadedAtNEQ,omitempty"`
+	LastDownloadedAtIn     []time.Time `json:"lastDownloadedAtIn,omitempty"`
+	LastDownloadedAtNotIn  []time.Time `json:"lastDownloadedAtNotIn,omitempty"`
+	LastDownloadedAtGT     *time.Time  `json:"lastDownloadedAtGT,omitempty"`
+	LastDownloadedAtGTE    *time.Time  `json:"lastDownloadedAtGTE,omitempty"`
+	LastDownloadedAtLT     *time.Time  `json:"lastDownloadedAtLT,omitempty"`
+	LastDownloadedAtLTE    *time.Time  `json:"lastDownloadedAtLTE,omitempty"`
+	LastDownloadedAtIsNil  bool        `json:"lastDownloadedAtIsNil,omitempty"`
+	LastDownloadedAtNotNil bool        `json:"lastDownloadedAtNotNil,omitempty"`
+
+	// "archived_at" field predicates.
+	ArchivedAt       *time.Time  `json:"archivedAt,omitempty"`
+	ArchivedAtNEQ    *time.Time  `json:"archivedAtNEQ,omitempty"`
+	ArchivedAtIn     []time.Time `json:"archivedAtIn,omitempty"`
+	ArchivedAtNotIn  []time.Time `json:"archivedAtNotIn,omitempty"`
+	ArchivedAtGT     *time.Time  `json:"archivedAtGT,omitempty"`
+	ArchivedAtGTE    *time.Time  `json:"archivedAtGTE,omitempty"`
+	ArchivedAtLT     *time.Time  `json:"archivedAtLT,omitempty"`
+	ArchivedAtLTE    *time.Time  `json:"archivedAtLTE,omitempty"`
+	ArchivedAtIsNil  bool        `json:"archivedAtIsNil,omitempty"`
+	ArchivedAtNotNil bool        `json:"archivedAtNotNil,omitempty"`
+
+	// "entity_type" field predicates.
+	EntityType       *file.EntityType  `json:"entityType,omitempty"`
+	EntityTypeNEQ    *file.EntityType  `json:"entityTypeNEQ,omitempty"`
+	EntityTypeIn     []file.EntityType `json:"entityTypeIn,omitempty"`
+	EntityTypeNotIn  []file.EntityType `json:"entityTypeNotIn,omitempty"`
+	EntityTypeIsNil  bool              `json:"entityTypeIsNil,omitempty"`
+	EntityTypeNotNil bool              `json:"entityTypeNotNil,omitempty"`
+
+	// "entity_id" field predicates.
+	EntityID       *uuid.UUID  `json:"entityID,omitempty"`
+	EntityIDNEQ    *uuid.UUID  `json:"entityIDNEQ,omitempty"`
+	EntityIDIn     []uuid.UUID `json:"entityIDIn,omitempty"`
+	EntityIDNotIn  []uuid.UUID `json:"entityIDNotIn,omitempty"`
+	EntityIDGT     *uuid.UUID  `json:"entityIDGT,omitempty"`
+	EntityIDGTE    *uuid.UUID  `json:"entityIDGTE,omitempty"`
+	EntityIDLT     *uuid.UUID  `json:"entityIDLT,omitempty"`
+	EntityIDLTE    *uuid.UUID  `json:"entityIDLTE,omitempty"`
+	EntityIDIsNil  bool        `json:"entityIDIsNil,omitempty"`
+	EntityIDNotNil bool        `json:"entityIDNotNil,omitempty"`
+
+	// "locked_by" field predicates.
+	LockedBy       *uuid.UUID  `json:"lockedBy,omitempty"`
+	LockedByNEQ    *uuid.UUID  `json:"lockedByNEQ,omitempty"`
+	LockedByIn     []uuid.UUID `json:"lockedByIn,omitempty"`
+	LockedByNotIn  []uuid.UUID `json:"lockedByNotIn,omitempty"`
+	LockedByGT     *uuid.UUID  `json:"lockedByGT,omitempty"`
+	LockedByGTE    *uuid.UUID  `json:"lockedByGTE,omitempty"`
+	LockedByLT     *uuid.UUID  `json:"lockedByLT,omitempty"`
+	LockedByLTE    *uuid.UUID  `json:"lockedByLTE,omitempty"`
+	LockedByIsNil  bool        `json:"lockedByIsNil,omitempty"`
+	LockedByNotNil bool        `json:"lockedByNotNil,omitempty"`
+
+	// "locked_at" field predicates.
+	LockedAt       *time.Time  `json:"lockedAt,omitempty"`
+	LockedAtNEQ    *time.Time  `json:"lockedAtNEQ,omitempty"`
+	LockedAtIn     []time.Time `json:"lockedAtIn,omitempty"`
+	LockedAtNotIn  []time.Time `json:"lockedAtNotIn,omitempty"`
+	LockedAtGT     *time.Time  `json:"lockedAtGT,omitempty"`
+	LockedAtGTE    *time.Time  `json:"lockedAtGTE,omitempty"`
+	LockedAtLT     *time.Time  `json:"lockedAtLT,omitempty"`
+	LockedAtLTE    *time.Time  `json:"lockedAtLTE,omitempty"`
+	LockedAtIsNil  bool        `json:"lockedAtIsNil,omitempty"`
+	LockedAtNotNil bool        `json:"lockedAtNotNil,omitempty"`
+
+	// "object_lock_mode" field predicates.
+	ObjectLockMode       *file.ObjectLockMode  `json:"objectLockMode,omitempty"`
+	ObjectLockModeNEQ    *file.ObjectLockMode  `json:"objectLockModeNEQ,omitempty"`
+	ObjectLockModeIn     []file.ObjectLockMode `json:"objectLockModeIn,omitempty"`
+	ObjectLockModeNotIn  []file.ObjectLockMode `json:"objectLockModeNotIn,omitempty"`
+	ObjectLockModeIsNil  bool                  `json:"objectLockModeIsNil,omitempty"`
+	ObjectLockModeNotNil bool                  `json:"objectLockModeNotNil,omitempty"`
+
+	// "object_lock_retain_until" field predicates.
+	ObjectLockRetainUntil       *time.Time  `json:"objectLockRetainUntil,omitempty"`
+	ObjectLockRetainUntilNEQ    *time.Time  `json:"objectLockRetainUntilNEQ,omitempty"`
+	ObjectLockRetainUntilIn     []time.Time `json:"objectLockRetainUntilIn,omitempty"`
+	ObjectLockRetainUntilNotIn  []time.Time `json:"objectLockRetainUntilNotIn,omitempty"`
+	ObjectLockRetainUntilGT     *time.Time  `json:"objectLockRetainUntilGT,omitempty"`
+	ObjectLockRetainUntilGTE    *time.Time  `json:"objectLockRetainUntilGTE,omitempty"`
+	ObjectLockRetainUntilLT     *time.Time  `json:"objectLockRetainUntilLT,omitempty"`
+	ObjectLockRetainUntilLTE    *time.Time  `json:"objectLockRetainUntilLTE,omitempty"`
+	ObjectLockRetainUntilIsNil  bool        `json:"objectLockRetainUntilIsNil,omitempty"`
+	ObjectLockRetainUntilNotNil bool        `json:"objectLockRetainUntilNotNil,omitempty"`
+
+	// "legal_hold" field predicates.
+	LegalHold    *bool `json:"legalHold,omitempty"`
+	LegalHoldNEQ *bool `json:"legalHoldNEQ,omitempty"`
+
+	// "scheduled_deletion_at" field predicates.
+	ScheduledDeletionAt       *time.Time  `json:"scheduledDeletionAt,omitempty"`
+	ScheduledDeletionAtNEQ    *time.Time  `json:"scheduledDeletionAtNEQ,omitempty"`
+	ScheduledDeletionAtIn     []time.Time `json:"scheduledDeletionAtIn,omitempty"`
+	ScheduledDeletionAtNotIn  []time.Time `json:"scheduledDeletionAtNotIn,omitempty"`
+	ScheduledDeletionAtGT     *time.Time  `json:"scheduledDeletionAtGT,omitempty"`
+	ScheduledDeletionAtGTE    *time.Time  `json:"scheduledDeletionAtGTE,omitempty"`
+	ScheduledDeletionAtLT     *time.Time  `json:"scheduledDeletionAtLT,omitempty"`
+	ScheduledDeletionAtLTE    *time.Time  `json:"scheduledDeletionAtLTE,omitempty"`
+	ScheduledDeletionAtIsNil  bool        `json:"scheduledDeletionAtIsNil,omitempty"`
+	ScheduledDeletionAtNotNil bool        `json:"scheduledDeletionAtNotNil,omitempty"`
+
+	// "scheduled_deletion_by" field predicates.
+	ScheduledDeletionBy       *uuid.UUID  `json:"scheduledDeletionBy,omitempty"`
+	ScheduledDeletionByNEQ    *uuid.UUID  `json:"scheduledDeletionByNEQ,omitempty"`
+	ScheduledDeletionByIn     []uuid.UUID `json:"scheduledDeletionByIn,omitempty"`
+	ScheduledDeletionByNotIn  []uuid.UUID `json:"scheduledDeletionByNotIn,omitempty"`
+	ScheduledDeletionByGT     *uuid.UUID  `json:"scheduledDeletionByGT,omitempty"`
+	ScheduledDeletionByGTE    *uuid.UUID  `json:"scheduledDeletionByGTE,omitempty"`
+	ScheduledDeletionByLT     *uuid.UUID  `json:"scheduledDeletionByLT,omitempty"`
+	ScheduledDeletionByLTE    *uuid.UUID  `json:"scheduledDeletionByLTE,omitempty"`
+	ScheduledDeletionByIsNil  bool        `json:"scheduledDeletionByIsNil,omitempty"`
+	ScheduledDeletionByNotNil bool        `json:"scheduledDeletionByNotNil,omitempty"`
+
+	// "tags" edge predicates.
+	HasTags     *bool            `json:"hasTags,omitempty"`
+	HasTagsWith []*TagWhereInput `json:"hasTagsWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -282,6 +519,36 @@ func (i *FileWhereInput) P() (predicate.File, error) {
 	if i.UpdateTimeLTE != nil {
 		predicates = append(predicates, file.UpdateTimeLTE(*i.UpdateTimeLTE))
 	}
+	if i.DeletedAt != nil {
+		predicates = append(predicates, file.DeletedAtEQ(*i.DeletedAt))
+	}
+	if i.DeletedAtNEQ != nil {
+		predicates = append(predicates, file.DeletedAtNEQ(*i.DeletedAtNEQ))
+	}
+	if len(i.DeletedAtIn) > 0 {
+		predicates = append(predicates, file.DeletedAtIn(i.DeletedAtIn...))
+	}
+	if len(i.DeletedAtNotIn) > 0 {
+		predicates = append(predicates, file.DeletedAtNotIn(i.DeletedAtNotIn...))
+	}
+	if i.DeletedAtGT != nil {
+		predicates = append(predicates, file.DeletedAtGT(*i.DeletedAtGT))
+	}
+	if i.DeletedAtGTE != nil {
+		predicates = append(predicates, file.DeletedAtGTE(*i.DeletedAtGTE))
+	}
+	if i.DeletedAtLT != nil {
+		predicates = append(predicates, file.DeletedAtLT(*i.DeletedAtLT))
+	}
+	if i.DeletedAtLTE != nil {
+		predicates = append(predicates, file.DeletedAtLTE(*i.DeletedAtLTE))
+	}
+	if i.DeletedAtIsNil {
+		predicates = append(predicates, file.DeletedAtIsNil())
+	}
+	if i.DeletedAtNotNil {
+		predicates = append(predicates, file.DeletedAtNotNil())
+	}
 	if i.OriginalName != nil {
 		predicates = append(predicates, file.OriginalNameEQ(*i.OriginalName))
 	}
@@ -399,6 +666,51 @@ func (i *FileWhereInput) P() (predicate.File, error) {
 	if i.MimeTypeContainsFold != nil {
 		predicates = append(predicates, file.MimeTypeContainsFold(*i.MimeTypeContainsFold))
 	}
+	if i.DetectedMimeType != nil {
+		predicates = append(predicates, file.DetectedMimeTypeEQ(*i.DetectedMimeType))
+	}
+	if i.DetectedMimeTypeNEQ != nil {
+		predicates = append(predicates, file.DetectedMimeTypeNEQ(*i.DetectedMimeTypeNEQ))
+	}
+	if len(i.DetectedMimeTypeIn) > 0 {
+		predicates = append(predicates, file.DetectedMimeTypeIn(i.DetectedMimeTypeIn...))
+	}
+	if len(i.DetectedMimeTypeNotIn) > 0 {
+		predicates = append(predicates, file.DetectedMimeTypeNotIn(i.DetectedMimeTypeNotIn...))
+	}
+	if i.DetectedMimeTypeGT != nil {
+		predicates = append(predicates, file.DetectedMimeTypeGT(*i.DetectedMimeTypeGT))
+	}
+	if i.DetectedMimeTypeGTE != nil {
+		predicates = append(predicates, file.DetectedMimeTypeGTE(*i.DetectedMimeTypeGTE))
+	}
+	if i.DetectedMimeTypeLT != nil {
+		predicates = append(predicates, file.DetectedMimeTypeLT(*i.DetectedMimeTypeLT))
+	}
+	if i.DetectedMimeTypeLTE != nil {
+		predicates = append(predicates, file.DetectedMimeTypeLTE(*i.DetectedMimeTypeLTE))
+	}
+	if i.DetectedMimeTypeContains != nil {
+		predicates = append(predicates, file.DetectedMimeTypeContains(*i.DetectedMimeTypeContains))
+	}
+	if i.DetectedMimeTypeHasPrefix != nil {
+		predicates = append(predicates, file.DetectedMimeTypeHasPrefix(*i.DetectedMimeTypeHasPrefix))
+	}
+	if i.DetectedMimeTypeHasSuffix != nil {
+		predicates = append(predicates, file.DetectedMimeTypeHasSuffix(*i.DetectedMimeTypeHasSuffix))
+	}
+	if i.DetectedMimeTypeIsNil {
+		predicates = append(predicates, file.DetectedMimeTypeIsNil())
+	}
+	if i.DetectedMimeTypeNotNil {
+		predicates = append(predicates, file.DetectedMimeTypeNotNil())
+	}
+	if i.DetectedMimeTypeEqualFold != nil {
+		predicates = append(predicates, file.DetectedMimeTypeEqualFold(*i.DetectedMimeTypeEqualFold))
+	}
+	if i.DetectedMimeTypeContainsFold != nil {
+		predicates = append(predicates, file.DetectedMimeTypeContainsFold(*i.DetectedMimeTypeContainsFold))
+	}
 	if i.Size != nil {
 		predicates = append(predicates, file.SizeEQ(*i.Size))
 	}
@@ -423,51 +735,6 @@ func (i *FileWhereInput) P() (predicate.File, error) {
 	if i.SizeLTE != nil {
 		predicates = append(predicates, file.SizeLTE(*i.SizeLTE))
 	}
-	if i.Path != nil {
-		predicates = append(predicates, file.PathEQ(*i.Path))
-	}
-	if i.PathNEQ != nil {
-		predicates = append(predicates, file.PathNEQ(*i.PathNEQ))
-	}
-	if len(i.PathIn) > 0 {
-		predicates = append(predicates, file.PathIn(i.PathIn...))
-	}
-	if len(i.PathNotIn) > 0 {
-		predicates = append(predicates, file.PathNotIn(i.PathNotIn...))
-	}
-	if i.PathGT != nil {
-		predicates = append(predicates, file.PathGT(*i.PathGT))
-	}
-	if i.PathGTE != nil {
-		predicates = append(predicates, file.PathGTE(*i.PathGTE))
-	}
-	if i.PathLT != nil {
-		predicates = append(predicates, file.PathLT(*i.PathLT))
-	}
-	if i.PathLTE != nil {
-		predicates = append(predicates, file.PathLTE(*i.PathLTE))
-	}
-	if i.PathContains != nil {
-		predicates = append(predicates, file.PathContains(*i.PathContains))
-	}
-	if i.PathHasPrefix != nil {
-		predicates = append(predicates, file.PathHasPrefix(*i.PathHasPrefix))
-	}
-	if i.PathHasSuffix != nil {
-		predicates = append(predicates, file.PathHasSuffix(*i.PathHasSuffix))
-	}
-	if i.PathIsNil {
-		predicates = append(predicates, file.PathIsNil())
-	}
-	if i.PathNotNil {
-		predicates = append(predicates, file.PathNotNil())
-	}
-	if i.PathEqualFold != nil {
-		predicates = append(predicates, file.PathEqualFold(*i.PathEqualFold))
-	}
-	if i.PathContainsFold != nil {
-		predicates = append(predicates, file.PathContainsFold(*i.PathContainsFold))
-	}
 	if i.Description != nil {
 		predicates = append(predicates, file.DescriptionEQ(*i.Description))
 	}
@@ -513,7 +780,553 @@ func (i *FileWhereInput) P() (predicate.File, error) {
 	if i.DescriptionContainsFold != nil {
 		predicates = append(predicates, file.DescriptionContainsFold(*i.DescriptionContainsFold))
 	}
+	if i.ChecksumSha256 != nil {
+		predicates = append(predicates, file.ChecksumSha256EQ(*i.ChecksumSha256))
+	}
+	if i.ChecksumSha256NEQ != nil {
+		predicates = append(predicates, file.ChecksumSha256NEQ(*i.ChecksumSha256NEQ))
+	}
+	if len(i.ChecksumSha256In) > 0 {
+		predicates = append(predicates, file.ChecksumSha256In(i.ChecksumSha256In...))
+	}
+	if len(i.ChecksumSha256NotIn) > 0 {
+		predicates = append(predicates, file.ChecksumSha256NotIn(i.ChecksumSha256NotIn...))
+	}
+	if i.ChecksumSha256GT != nil {
+		predicates = append(predicates, file.ChecksumSha256GT(*i.ChecksumSha256GT))
+	}
+	if i.ChecksumSha256GTE != nil {
+		predicates = append(predicates, file.ChecksumSha256GTE(*i.ChecksumSha256GTE))
+	}
+	if i.ChecksumSha256LT != nil {
+		predicates = append(predicates, file.ChecksumSha256LT(*i.ChecksumSha256LT))
+	}
+	if i.ChecksumSha256LTE != nil {
+		predicates = append(predicates, file.ChecksumSha256LTE(*i.ChecksumSha256LTE))
+	}
+	if i.ChecksumSha256Contains != nil {
+		predicates = append(predicates, file.ChecksumSha256Contains(*i.ChecksumSha256Contains))
+	}
+	if i.ChecksumSha256HasPrefix != nil {
+		predicates = append(predicates, file.ChecksumSha256HasPrefix(*i.ChecksumSha256HasPrefix))
+	}
+	if i.ChecksumSha256HasSuffix != nil {
+		predicates = append(predicates, file.ChecksumSha256HasSuffix(*i.ChecksumSha256HasSuffix))
+	}
+	if i.ChecksumSha256IsNil {
+		predicates = append(predicates, file.ChecksumSha256IsNil())
+	}
+	if i.ChecksumSha256NotNil {
+		predicates = append(predicates, file.ChecksumSha256NotNil())
+	}
+	if i.ChecksumSha256EqualFold != nil {
+		predicates = append(predicates, file.ChecksumSha256EqualFold(*i.ChecksumSha256EqualFold))
+	}
+	if i.ChecksumSha256ContainsFold != nil {
+		predicates = append(predicates, file.ChecksumSha256ContainsFold(*i.ChecksumSha256ContainsFold))
+	}
+	if i.IntegrityStatus != nil {
+		predicates = append(predicates, file.IntegrityStatusEQ(*i.IntegrityStatus))
+	}
+	if i.IntegrityStatusNEQ != nil {
+		predicates = append(predicates, file.IntegrityStatusNEQ(*i.IntegrityStatusNEQ))
+	}
+	if len(i.IntegrityStatusIn) > 0 {
+		predicates = append(predicates, file.IntegrityStatusIn(i.IntegrityStatusIn...))
+	}
+	if len(i.IntegrityStatusNotIn) > 0 {
+		predicates = append(predicates, file.IntegrityStatusNotIn(i.IntegrityStatusNotIn...))
+	}
+	if i.IntegrityCheckedAt != nil {
+		predicates = append(predicates, file.IntegrityCheckedAtEQ(*i.IntegrityCheckedAt))
+	}
+	if i.IntegrityCheckedAtNEQ != nil {
+		predicates = append(predicates, file.IntegrityCheckedAtNEQ(*i.IntegrityCheckedAtNEQ))
+	}
+	if len(i.IntegrityCheckedAtIn) > 0 {
+		predicates = append(predicates, file.IntegrityCheckedAtIn(i.IntegrityCheckedAtIn...))
+	}
+	if len(i.IntegrityCheckedAtNotIn) > 0 {
+		predicates = append(predicates, file.IntegrityCheckedAtNotIn(i.IntegrityCheckedAtNotIn...))
+	}
+	if i.IntegrityCheckedAtGT != nil {
+		predicates = append(predicates, file.IntegrityCheckedAtGT(*i.IntegrityCheckedAtGT))
+	}
+	if i.IntegrityCheckedAtGTE != nil {
+		predicates = append(predicates, file.IntegrityCheckedAtGTE(*i.IntegrityCheckedAtGTE))
+	}
+	if i.IntegrityCheckedAtLT != nil {
+		predicates = append(predicates, file.IntegrityCheckedAtLT(*i.IntegrityCheckedAtLT))
+	}
+	if i.IntegrityCheckedAtLTE != nil {
+		predicates = append(predicates, file.IntegrityCheckedAtLTE(*i.IntegrityCheckedAtLTE))
+	}
+	if i.IntegrityCheckedAtIsNil {
+		predicates = append(predicates, file.IntegrityCheckedAtIsNil())
+	}
+	if i.IntegrityCheckedAtNotNil {
+		predicates = append(predicates, file.IntegrityCheckedAtNotNil())
+	}
+	if i.ThumbnailStatus != nil {
+		predicates = append(predicates, file.ThumbnailStatusEQ(*i.ThumbnailStatus))
+	}
+	if i.ThumbnailStatusNEQ != nil {
+		predicates = append(predicates, file.ThumbnailStatusNEQ(*i.ThumbnailStatusNEQ))
+	}
+	if len(i.ThumbnailStatusIn) > 0 {
+		predicates = append(predicates, file.ThumbnailStatusIn(i.ThumbnailStatusIn...))
+	}
+	if len(i.ThumbnailStatusNotIn) > 0 {
+		predicates = append(predicates, file.ThumbnailStatusNotIn(i.ThumbnailStatusNotIn...))
+	}
+	if i.PreviewStatus != nil {
+		predicates = append(predicates, file.PreviewStatusEQ(*i.PreviewStatus))
+	}
+	if i.PreviewStatusNEQ != nil {
+		predicates = append(predicates, file.PreviewStatusNEQ(*i.PreviewStatusNEQ))
+	}
+	if len(i.PreviewStatusIn) > 0 {
+		predicates = append(predicates, file.PreviewStatusIn(i.PreviewStatusIn...))
+	}
+	if len(i.PreviewStatusNotIn) > 0 {
+		predicates = append(predicates, file.PreviewStatusNotIn(i.PreviewStatusNotIn...))
+	}
+	if i.PdfStatus != nil {
+		predicates = append(predicates, file.PdfStatusEQ(*i.PdfStatus))
+	}
+	if i.PdfStatusNEQ != nil {
+		predicates = append(predicates, file.PdfStatusNEQ(*i.PdfStatusNEQ))
+	}
+	if len(i.PdfStatusIn) > 0 {
+		predicates = append(predicates, file.PdfStatusIn(i.PdfStatusIn...))
+	}
+	if len(i.PdfStatusNotIn) > 0 {
+		predicates = append(predicates, file.PdfStatusNotIn(i.PdfStatusNotIn...))
+	}
+	if i.ScanStatus != nil {
+		predicates = append(predicates, file.ScanStatusEQ(*i.ScanStatus))
+	}
+	if i.ScanStatusNEQ != nil {
+		predicates = append(predicates, file.ScanStatusNEQ(*i.ScanStatusNEQ))
+	}
+	if len(i.ScanStatusIn) > 0 {
+		predicates = append(predicates, file.ScanStatusIn(i.ScanStatusIn...))
+	}
+	if len(i.ScanStatusNotIn) > 0 {
+		predicates = append(predicates, file.ScanStatusNotIn(i.ScanStatusNotIn...))
+	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, file.ExpiresAtEQ(*i.ExpiresAt))
+	}
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, file.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	}
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, file.ExpiresAtIn(i.ExpiresAtIn...))
+	}
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, file.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	}
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, file.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, file.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, file.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, file.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.ExpiresAtIsNil {
+		predicates = append(predicates, file.ExpiresAtIsNil())
+	}
+	if i.ExpiresAtNotNil {
+		predicates = append(predicates, file.ExpiresAtNotNil())
+	}
+	if i.UploadSource != nil {
+		predicates = append(predicates, file.UploadSourceEQ(*i.UploadSource))
+	}
+	if i.UploadSourceNEQ != nil {
+		predicates = append(predicates, file.UploadSourceNEQ(*i.UploadSourceNEQ))
+	}
+	if len(i.UploadSourceIn) > 0 {
+		predicates = append(predicates, file.UploadSourceIn(i.UploadSourceIn...))
+	}
+	if len(i.UploadSourceNotIn) > 0 {
+		predicates = append(predicates, file.UploadSourceNotIn(i.UploadSourceNotIn...))
+	}
+	if i.ClientVersion != nil {
+		predicates = append(predicates, file.ClientVersionEQ(*i.ClientVersion))
+	}
+	if i.ClientVersionNEQ != nil {
+		predicates = append(predicates, file.ClientVersionNEQ(*i.ClientVersionNEQ))
+	}
+	if len(i.ClientVersionIn) > 0 {
+		predicates = append(predicates, file.ClientVersionIn(i.ClientVersionIn...))
+	}
+	if len(i.ClientVersionNotIn) > 0 {
+		predicates = append(predicates, file.ClientVersionNotIn(i.ClientVersionNotIn...))
+	}
+	if i.ClientVersionGT != nil {
+		predicates = append(predicates, file.ClientVersionGT(*i.ClientVersionGT))
+	}
+	if i.ClientVersionGTE != nil {
+		predicates = append(predicates, file.ClientVersionGTE(*i.ClientVersionGTE))
+	}
+	if i.ClientVersionLT != nil {
+		predicates = append(predicates, file.ClientVersionLT(*i.ClientVersionLT))
+	}
+	if i.ClientVersionLTE != nil {
+		predicates = append(predicates, file.ClientVersionLTE(*i.ClientVersionLTE))
+	}
+	if i.ClientVersionContains != nil {
+		predicates = append(predicates, file.ClientVersionContains(*i.ClientVersionContains))
+	}
+	if i.ClientVersionHasPrefix != nil {
+		predicates = append(predicates, file.ClientVersionHasPrefix(*i.ClientVersionHasPrefix))
+	}
+	if i.ClientVersionHasSuffix != nil {
+		predicates = append(predicates, file.ClientVersionHasSuffix(*i.ClientVersionHasSuffix))
+	}
+	if i.ClientVersionIsNil {
+		predicates = append(predicates, file.ClientVersionIsNil())
+	}
+	if i.ClientVersionNotNil {
+		predicates = append(predicates, file.ClientVersionNotNil())
+	}
+	if i.ClientVersionEqualFold != nil {
+		predicates = append(predicates, file.ClientVersionEqualFold(*i.ClientVersionEqualFold))
+	}
+	if i.ClientVersionContainsFold != nil {
+		predicates = append(predicates, file.ClientVersionContainsFold(*i.ClientVersionContainsFold))
+	}
+	if i.DownloadCount != nil {
+		predicates = append(predicates, file.DownloadCountEQ(*i.DownloadCount))
+	}
+	if i.DownloadCountNEQ != nil {
+		predicates = append(predicates, file.DownloadCountNEQ(*i.DownloadCountNEQ))
+	}
+	if len(i.DownloadCountIn) > 0 {
+		predicates = append(predicates, file.DownloadCountIn(i.DownloadCountIn...))
+	}
+	if len(i.DownloadCountNotIn) > 0 {
+		predicates = append(predicates, file.DownloadCountNotIn(i.DownloadCountNotIn...))
+	}
+	if i.DownloadCountGT != nil {
+		predicates = append(predicates, file.DownloadCountGT(*i.DownloadCountGT))
+	}
+	if i.DownloadCountGTE != nil {
+		predicates = append(predicates, file.DownloadCountGTE(*i.DownloadCountGTE))
+	}
+	if i.DownloadCountLT != nil {
+		predicates = append(predicates, file.DownloadCountLT(*i.DownloadCountLT))
+	}
+	if i.DownloadCountLTE != nil {
+		predicates = append(predicates, file.DownloadCountLTE(*i.DownloadCountLTE))
+	}
+	if i.LastDownloadedAt != nil {
+		predicates = append(predicates, file.LastDownloadedAtEQ(*i.LastDownloadedAt))
+	}
+	if i.LastDownloadedAtNEQ != nil {
+		predicates = append(predicates, file.LastDownloadedAtNEQ(*i.LastDownloadedAtNEQ))
+	}
+	if len(i.LastDownloadedAtIn) > 0 {
+		predicates = append(predicates, file.LastDownloadedAtIn(i.LastDownloadedAtIn...))
+	}
+	if len(i.LastDownloadedAtNotIn) > 0 {
+		predicates = append(predicates, file.LastDownloadedAtNotIn(i.LastDownloadedAtNotIn...))
+	}
+	if i.LastDownloadedAtGT != nil {
+		predicates = append(predicates, file.LastDownloadedAtGT(*i.LastDownloadedAtGT))
+	}
+	if i.LastDownloadedAtGTE != nil {
+		predicates = append(predicates, file.LastDownloadedAtGTE(*i.LastDownloadedAtGTE))
+	}
+	if i.LastDownloadedAtLT != nil {
+		predicates = append(predicates, file.LastDownloadedAtLT(*i.LastDownloadedAtLT))
+	}
+	if i.LastDownloadedAtLTE != nil {
+		predicates = append(predicates, file.LastDownloadedAtLTE(*i.LastDownloadedAtLTE))
+	}
+	if i.LastDownloadedAtIsNil {
+		predicates = append(predicates, file.LastDownloadedAtIsNil())
+	}
+	if i.LastDownloadedAtNotNil {
+		predicates = append(predicates, file.LastDownloadedAtNotNil())
+	}
+	if i.ArchivedAt != nil {
+		predicates = append(predicates, file.ArchivedAtEQ(*i.ArchivedAt))
+	}
+	if i.ArchivedAtNEQ != nil {
+		predicates = append(predicates, file.ArchivedAtNEQ(*i.ArchivedAtNEQ))
+	}
+	if len(i.ArchivedAtIn) > 0 {
+		predicates = append(predicates, file.ArchivedAtIn(i.ArchivedAtIn...))
+	}
+	if len(i.ArchivedAtNotIn) > 0 {
+		predicates = append(predicates, file.ArchivedAtNotIn(i.ArchivedAtNotIn...))
+	}
+	if i.ArchivedAtGT != nil {
+		predicates = append(predicates, file.ArchivedAtGT(*i.ArchivedAtGT))
+	}
+	if i.ArchivedAtGTE != nil {
+		predicates = append(predicates, file.ArchivedAtGTE(*i.ArchivedAtGTE))
+	}
+	if i.ArchivedAtLT != nil {
+		predicates = append(predicates, file.ArchivedAtLT(*i.ArchivedAtLT))
+	}
+	if i.ArchivedAtLTE != nil {
+		predicates = append(predicates, file.ArchivedAtLTE(*i.ArchivedAtLTE))
+	}
+	if i.ArchivedAtIsNil {
+		predicates = append(predicates, file.ArchivedAtIsNil())
+	}
+	if i.ArchivedAtNotNil {
+		predicates = append(predicates, file.ArchivedAtNotNil())
+	}
+	if i.EntityType != nil {
+		predicates = append(predicates, file.EntityTypeEQ(*i.EntityType))
+	}
+	if i.EntityTypeNEQ != nil {
+		predicates = append(predicates, file.EntityTypeNEQ(*i.EntityTypeNEQ))
+	}
+	if len(i.EntityTypeIn) > 0 {
+		predicates = append(predicates, file.EntityTypeIn(i.EntityTypeIn...))
+	}
+	if len(i.EntityTypeNotIn) > 0 {
+		predicates = append(predicates, file.EntityTypeNotIn(i.EntityTypeNotIn...))
+	}
+	if i.EntityTypeIsNil {
+		predicates = append(predicates, file.EntityTypeIsNil())
+	}
+	if i.EntityTypeNotNil {
+		predicates = append(predicates, file.EntityTypeNotNil())
+	}
+	if i.EntityID != nil {
+		predicates = append(predicates, file.EntityIDEQ(*i.EntityID))
+	}
+	if i.EntityIDNEQ != nil {
+		predicates = append(predicates, file.EntityIDNEQ(*i.EntityIDNEQ))
+	}
+	if len(i.EntityIDIn) > 0 {
+		predicates = append(predicates, file.EntityIDIn(i.EntityIDIn...))
+	}
+	if len(i.EntityIDNotIn) > 0 {
+		predicates = append(predicates, file.EntityIDNotIn(i.EntityIDNotIn...))
+	}
+	if i.EntityIDGT != nil {
+		predicates = append(predicates, file.EntityIDGT(*i.EntityIDGT))
+	}
+	if i.EntityIDGTE != nil {
+		predicates = append(predicates, file.EntityIDGTE(*i.EntityIDGTE))
+	}
+	if i.EntityIDLT != nil {
+		predicates = append(predicates, file.EntityIDLT(*i.EntityIDLT))
+	}
+	if i.EntityIDLTE != nil {
+		predicates = append(predicates, file.EntityIDLTE(*i.EntityIDLTE))
+	}
+	if i.EntityIDIsNil {
+		predicates = append(predicates, file.EntityIDIsNil())
+	}
+	if i.EntityIDNotNil {
+		predicates = append(predicates, file.EntityIDNotNil())
+	}
+	if i.LockedBy != nil {
+		predicates = append(predicates, file.LockedByEQ(*i.LockedBy))
+	}
+	if i.LockedByNEQ != nil {
+		predicates = append(predicates, file.LockedByNEQ(*i.LockedByNEQ))
+	}
+	if len(i.LockedByIn) > 0 {
+		predicates = append(predicates, file.LockedByIn(i.LockedByIn...))
+	}
+	if len(i.LockedByNotIn) > 0 {
+		predicates = append(predicates, file.LockedByNotIn(i.LockedByNotIn...))
+	}
+	if i.LockedByGT != nil {
+		predicates = append(predicates, file.LockedByGT(*i.LockedByGT))
+	}
+	if i.LockedByGTE != nil {
+		predicates = append(predicates, file.LockedByGTE(*i.LockedByGTE))
+	}
+	if i.LockedByLT != nil {
+		predicates = append(predicates, file.LockedByLT(*i.LockedByLT))
+	}
+	if i.LockedByLTE != nil {
+		predicates = append(predicates, file.LockedByLTE(*i.LockedByLTE))
+	}
+	if i.LockedByIsNil {
+		predicates = append(predicates, file.LockedByIsNil())
+	}
+	if i.LockedByNotNil {
+		predicates = append(predicates, file.LockedByNotNil())
+	}
+	if i.LockedAt != nil {
+		predicates = append(predicates, file.LockedAtEQ(*i.LockedAt))
+	}
+	if i.LockedAtNEQ != nil {
+		predicates = append(predicates, file.LockedAtNEQ(*i.LockedAtNEQ))
+	}
+	if len(i.LockedAtIn) > 0 {
+		predicates = append(predicates, file.LockedAtIn(i.LockedAtIn...))
+	}
+	if len(i.LockedAtNotIn) > 0 {
+		predicates = append(predicates, file.LockedAtNotIn(i.LockedAtNotIn...))
+	}
+	if i.LockedAtGT != nil {
+		predicates = append(predicates, file.LockedAtGT(*i.LockedAtGT))
+	}
+	if i.LockedAtGTE != nil {
+		predicates = append(predicates, file.LockedAtGTE(*i.LockedAtGTE))
+	}
+	if i.LockedAtLT != nil {
+		predicates = append(predicates, file.LockedAtLT(*i.LockedAtLT))
+	}
+	if i.LockedAtLTE != nil {
+		predicates = append(predicates, file.LockedAtLTE(*i.LockedAtLTE))
+	}
+	if i.LockedAtIsNil {
+		predicates = append(predicates, file.LockedAtIsNil())
+	}
+	if i.LockedAtNotNil {
+		predicates = append(predicates, file.LockedAtNotNil())
+	}
+	if i.ObjectLockMode != nil {
+		predicates = append(predicates, file.ObjectLockModeEQ(*i.ObjectLockMode))
+	}
+	if i.ObjectLockModeNEQ != nil {
+		predicates = append(predicates, file.ObjectLockModeNEQ(*i.ObjectLockModeNEQ))
+	}
+	if len(i.ObjectLockModeIn) > 0 {
+		predicates = append(predicates, file.ObjectLockModeIn(i.ObjectLockModeIn...))
+	}
+	if len(i.ObjectLockModeNotIn) > 0 {
+		predicates = append(predicates, file.ObjectLockModeNotIn(i.ObjectLockModeNotIn...))
+	}
+	if i.ObjectLockModeIsNil {
+		predicates = append(predicates, file.ObjectLockModeIsNil())
+	}
+	if i.ObjectLockModeNotNil {
+		predicates = append(predicates, file.ObjectLockModeNotNil())
+	}
+	if i.ObjectLockRetainUntil != nil {
+		predicates = append(predicates, file.ObjectLockRetainUntilEQ(*i.ObjectLockRetainUntil))
+	}
+	if i.ObjectLockRetainUntilNEQ != nil {
+		predicates = append(predicates, file.ObjectLockRetainUntilNEQ(*i.ObjectLockRetainUntilNEQ))
+	}
+	if len(i.ObjectLockRetainUntilIn) > 0 {
+		predicates = append(predicates, file.ObjectLockRetainUntilIn(i.ObjectLockRetainUntilIn...))
+	}
+	if len(i.ObjectLockRetainUntilNotIn) > 0 {
+		predicates = append(predicates, file.ObjectLockRetainUntilNotIn(i.ObjectLockRetainUntilNotIn...))
+	}
+	if i.ObjectLockRetainUntilGT != nil {
+		predicates = append(predicates, file.ObjectLockRetainUntilGT(*i.ObjectLockRetainUntilGT))
+	}
+	if i.ObjectLockRetainUntilGTE != nil {
+		predicates = append(predicates, file.ObjectLockRetainUntilGTE(*i.ObjectLockRetainUntilGTE))
+	}
+	if i.ObjectLockRetainUntilLT != nil {
+		predicates = append(predicates, file.ObjectLockRetainUntilLT(*i.ObjectLockRetainUntilLT))
+	}
+	if i.ObjectLockRetainUntilLTE != nil {
+		predicates = append(predicates, file.ObjectLockRetainUntilLTE(*i.ObjectLockRetainUntilLTE))
+	}
+	if i.ObjectLockRetainUntilIsNil {
+		predicates = append(predicates, file.ObjectLockRetainUntilIsNil())
+	}
+	if i.ObjectLockRetainUntilNotNil {
+		predicates = append(predicates, file.ObjectLockRetainUntilNotNil())
+	}
+	if i.LegalHold != nil {
+		predicates = append(predicates, file.LegalHoldEQ(*i.LegalHold))
+	}
+	if i.LegalHoldNEQ != nil {
+		predicates = append(predicates, file.LegalHoldNEQ(*i.LegalHoldNEQ))
+	}
+	if i.ScheduledDeletionAt != nil {
+		predicates = append(predicates, file.ScheduledDeletionAtEQ(*i.ScheduledDeletionAt))
+	}
+	if i.ScheduledDeletionAtNEQ != nil {
+		predicates = append(predicates, file.ScheduledDeletionAtNEQ(*i.ScheduledDeletionAtNEQ))
+	}
+	if len(i.ScheduledDeletionAtIn) > 0 {
+		predicates = append(predicates, file.ScheduledDeletionAtIn(i.ScheduledDeletionAtIn...))
+	}
+	if len(i.ScheduledDeletionAtNotIn) > 0 {
+		predicates = append(predicates, file.ScheduledDeletionAtNotIn(i.ScheduledDeletionAtNotIn...))
+	}
+	if i.ScheduledDeletionAtGT != nil {
+		predicates = append(predicates, file.ScheduledDeletionAtGT(*i.ScheduledDeletionAtGT))
+	}
+	if i.ScheduledDeletionAtGTE != nil {
+		predicates = append(predicates, file.ScheduledDeletionAtGTE(*i.ScheduledDeletionAtGTE))
+	}
+	if i.ScheduledDeletionAtLT != nil {
+		predicates = append(predicates, file.ScheduledDeletionAtLT(*i.ScheduledDeletionAtLT))
+	}
+	if i.ScheduledDeletionAtLTE != nil {
+		predicates = append(predicates, file.ScheduledDeletionAtLTE(*i.ScheduledDeletionAtLTE))
+	}
+	if i.ScheduledDeletionAtIsNil {
+		predicates = append(predicates, file.ScheduledDeletionAtIsNil())
+	}
+	if i.ScheduledDeletionAtNotNil {
+		predicates = append(predicates, file.ScheduledDeletionAtNotNil())
+	}
+	if i.ScheduledDeletionBy != nil {
+		predicates = append(predicates, file.ScheduledDeletionByEQ(*i.ScheduledDeletionBy))
+	}
+	if i.ScheduledDeletionByNEQ != nil {
+		predicates = append(predicates, file.ScheduledDeletionByNEQ(*i.ScheduledDeletionByNEQ))
+	}
+	if len(i.ScheduledDeletionByIn) > 0 {
+		predicates = append(predicates, file.ScheduledDeletionByIn(i.ScheduledDeletionByIn...))
+	}
+	if len(i.ScheduledDeletionByNotIn) > 0 {
+		predicates = append(predicates, file.ScheduledDeletionByNotIn(i.ScheduledDeletionByNotIn...))
+	}
+	if i.ScheduledDeletionByGT != nil {
+		predicates = append(predicates, file.ScheduledDeletionByGT(*i.ScheduledDeletionByGT))
+	}
+	if i.ScheduledDeletionByGTE != nil {
+		predicates = append(predicates, file.ScheduledDeletionByGTE(*i.ScheduledDeletionByGTE))
+	}
+	if i.ScheduledDeletionByLT != nil {
+		predicates = append(predicates, file.ScheduledDeletionByLT(*i.ScheduledDeletionByLT))
+	}
+	if i.ScheduledDeletionByLTE != nil {
+		predicates = append(predicates, file.ScheduledDeletionByLTE(*i.ScheduledDeletionByLTE))
+	}
+	if i.ScheduledDeletionByIsNil {
+		predicates = append(predicates, file.ScheduledDeletionByIsNil())
+	}
+	if i.ScheduledDeletionByNotNil {
+		predicates = append(predicates, file.ScheduledDeletionByNotNil())
+	}
 
+	if i.HasTags != nil {
+		p := file.HasTags()
+		if !*i.HasTags {
+			p = file.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasTagsWith) > 0 {
+		with := make([]predicate.Tag, 0, len(i.HasTagsWith))
+		for _, w := range i.HasTagsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasTagsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, file.HasTagsWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyFileWhereInput
@@ -523,3 +1336,305 @@ func (i *FileWhereInput) P() (predicate.File, error) {
 		return file.And(predicates...), nil
 	}
 }
+
+// TagWhereInput represents a where input for filtering Tag queries.
+type TagWhereInput struct {
+	Predicates []predicate.Tag  `json:"-"`
+	Not        *TagWhereInput   `json:"not,omitempty"`
+	Or         []*TagWhereInput `json:"or,omitempty"`
+	And        []*TagWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *uuid.UUID  `json:"id,omitempty"`
+	IDNEQ   *uuid.UUID  `json:"idNEQ,omitempty"`
+	IDIn    []uuid.UUID `json:"idIn,omitempty"`
+	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
+	IDGT    *uuid.UUID  `json:"idGT,omitempty"`
+	IDGTE   *uuid.UUID  `json:"idGTE,omitempty"`
+	IDLT    *uuid.UUID  `json:"idLT,omitempty"`
+	IDLTE   *uuid.UUID  `json:"idLTE,omitempty"`
+
+	// "create_time" field predicates.
+	CreateTime      *time.Time  `json:"createTime,omitempty"`
+	CreateTimeNEQ   *time.Time  `json:"createTimeNEQ,omitempty"`
+	CreateTimeIn    []time.Time `json:"createTimeIn,omitempty"`
+	CreateTimeNotIn []time.Time `json:"createTimeNotIn,omitempty"`
+	CreateTimeGT    *time.Time  `json:"createTimeGT,omitempty"`
+	CreateTimeGTE   *time.Time  `json:"createTimeGTE,omitempty"`
+	CreateTimeLT    *time.Time  `json:"createTimeLT,omitempty"`
+	CreateTimeLTE   *time.Time  `json:"createTimeLTE,omitempty"`
+
+	// "update_time" field predicates.
+	UpdateTime      *time.Time  `json:"updateTime,omitempty"`
+	UpdateTimeNEQ   *time.Time  `json:"updateTimeNEQ,omitempty"`
+	UpdateTimeIn    []time.Time `json:"updateTimeIn,omitempty"`
+	UpdateTimeNotIn []time.Time `json:"updateTimeNotIn,omitempty"`
+	UpdateTimeGT    *time.Time  `json:"updateTimeGT,omitempty"`
+	UpdateTimeGTE   *time.Time  `json:"updateTimeGTE,omitempty"`
+	UpdateTimeLT    *time.Time  `json:"updateTimeLT,omitempty"`
+	UpdateTimeLTE   *time.Time  `json:"updateTimeLTE,omitempty"`
+
+	// "name" field predicates.
+	Name             *string  `json:"name,omitempty"`
+	NameNEQ          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGT           *string  `json:"nameGT,omitempty"`
+	NameGTE          *string  `json:"nameGTE,omitempty"`
+	NameLT           *string  `json:"nameLT,omitempty"`
+	NameLTE          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "created_by" field predicates.
+	CreatedBy      *uuid.UUID  `json:"createdBy,omitempty"`
+	CreatedByNEQ   *uuid.UUID  `json:"createdByNEQ,omitempty"`
+	CreatedByIn    []uuid.UUID `json:"createdByIn,omitempty"`
+	CreatedByNotIn []uuid.UUID `json:"createdByNotIn,omitempty"`
+	CreatedByGT    *uuid.UUID  `json:"createdByGT,omitempty"`
+	CreatedByGTE   *uuid.UUID  `json:"createdByGTE,omitempty"`
+	CreatedByLT    *uuid.UUID  `json:"createdByLT,omitempty"`
+	CreatedByLTE   *uuid.UUID  `json:"createdByLTE,omitempty"`
+
+	// "files" edge predicates.
+	HasFiles     *bool             `json:"hasFiles,omitempty"`
+	HasFilesWith []*FileWhereInput `json:"hasFilesWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *TagWhereInput) AddPredicates(predicates ...predicate.Tag) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the TagWhereInput filter on the TagQuery builder.
+func (i *TagWhereInput) Filter(q *TagQuery) (*TagQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyTagWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyTagWhereInput is returned in case the TagWhereInput is empty.
+var ErrEmptyTagWhereInput = errors.New("ent: empty predicate TagWhereInput")
+
+// P returns a predicate for filtering tags.
+// An error is returned if the input is empty or invalid.
+func (i *TagWhereInput) P() (predicate.Tag, error) {
+	var predicates []predicate.Tag
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, tag.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Tag, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, tag.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Tag, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, tag.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, tag.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, tag.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, tag.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, tag.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, tag.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, tag.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, tag.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, tag.IDLTE(*i.IDLTE))
+	}
+	if i.CreateTime != nil {
+		predicates = append(predicates, tag.CreateTimeEQ(*i.CreateTime))
+	}
+	if i.CreateTimeNEQ != nil {
+		predicates = append(predicates, tag.CreateTimeNEQ(*i.CreateTimeNEQ))
+	}
+	if len(i.CreateTimeIn) > 0 {
+		predicates = append(predicates, tag.CreateTimeIn(i.CreateTimeIn...))
+	}
+	if len(i.CreateTimeNotIn) > 0 {
+		predicates = append(predicates, tag.CreateTimeNotIn(i.CreateTimeNotIn...))
+	}
+	if i.CreateTimeGT != nil {
+		predicates = append(predicates, tag.CreateTimeGT(*i.CreateTimeGT))
+	}
+	if i.CreateTimeGTE != nil {
+		predicates = append(predicates, tag.CreateTimeGTE(*i.CreateTimeGTE))
+	}
+	if i.CreateTimeLT != nil {
+		predicates = append(predicates, tag.CreateTimeLT(*i.CreateTimeLT))
+	}
+	if i.CreateTimeLTE != nil {
+		predicates = append(predicates, tag.CreateTimeLTE(*i.CreateTimeLTE))
+	}
+	if i.UpdateTime != nil {
+		predicates = append(predicates, tag.UpdateTimeEQ(*i.UpdateTime))
+	}
+	if i.UpdateTimeNEQ != nil {
+		predicates = append(predicates, tag.UpdateTimeNEQ(*i.UpdateTimeNEQ))
+	}
+	if len(i.UpdateTimeIn) > 0 {
+		predicates = append(predicates, tag.UpdateTimeIn(i.UpdateTimeIn...))
+	}
+	if len(i.UpdateTimeNotIn) > 0 {
+		predicates = append(predicates, tag.UpdateTimeNotIn(i.UpdateTimeNotIn...))
+	}
+	if i.UpdateTimeGT != nil {
+		predicates = append(predicates, tag.UpdateTimeGT(*i.UpdateTimeGT))
+	}
+	if i.UpdateTimeGTE != nil {
+		predicates = append(predicates, tag.UpdateTimeGTE(*i.UpdateTimeGTE))
+	}
+	if i.UpdateTimeLT != nil {
+		predicates = append(predicates, tag.UpdateTimeLT(*i.UpdateTimeLT))
+	}
+	if i.UpdateTimeLTE != nil {
+		predicates = append(predicates, tag.UpdateTimeLTE(*i.UpdateTimeLTE))
+	}
+	if i.Name != nil {
+		predicates = append(predicates, tag.NameEQ(*i.Name))
+	}
+	if i.NameNEQ != nil {
+		predicates = append(predicates, tag.NameNEQ(*i.NameNEQ))
+	}
+	if len(i.NameIn) > 0 {
+		predicates = append(predicates, tag.NameIn(i.NameIn...))
+	}
+	if len(i.NameNotIn) > 0 {
+		predicates = append(predicates, tag.NameNotIn(i.NameNotIn...))
+	}
+	if i.NameGT != nil {
+		predicates = append(predicates, tag.NameGT(*i.NameGT))
+	}
+	if i.NameGTE != nil {
+		predicates = append(predicates, tag.NameGTE(*i.NameGTE))
+	}
+	if i.NameLT != nil {
+		predicates = append(predicates, tag.NameLT(*i.NameLT))
+	}
+	if i.NameLTE != nil {
+		predicates = append(predicates, tag.NameLTE(*i.NameLTE))
+	}
+	if i.NameContains != nil {
+		predicates = append(predicates, tag.NameContains(*i.NameContains))
+	}
+	if i.NameHasPrefix != nil {
+		predicates = append(predicates, tag.NameHasPrefix(*i.NameHasPrefix))
+	}
+	if i.NameHasSuffix != nil {
+		predicates = append(predicates, tag.NameHasSuffix(*i.NameHasSuffix))
+	}
+	if i.NameEqualFold != nil {
+		predicates = append(predicates, tag.NameEqualFold(*i.NameEqualFold))
+	}
+	if i.NameContainsFold != nil {
+		predicates = append(predicates, tag.NameContainsFold(*i.NameContainsFold))
+	}
+	if i.CreatedBy != nil {
+		predicates = append(predicates, tag.CreatedByEQ(*i.CreatedBy))
+	}
+	if i.CreatedByNEQ != nil {
+		predicates = append(predicates, tag.CreatedByNEQ(*i.CreatedByNEQ))
+	}
+	if len(i.CreatedByIn) > 0 {
+		predicates = append(predicates, tag.CreatedByIn(i.CreatedByIn...))
+	}
+	if len(i.CreatedByNotIn) > 0 {
+		predicates = append(predicates, tag.CreatedByNotIn(i.CreatedByNotIn...))
+	}
+	if i.CreatedByGT != nil {
+		predicates = append(predicates, tag.CreatedByGT(*i.CreatedByGT))
+	}
+	if i.CreatedByGTE != nil {
+		predicates = append(predicates, tag.CreatedByGTE(*i.CreatedByGTE))
+	}
+	if i.CreatedByLT != nil {
+		predicates = append(predicates, tag.CreatedByLT(*i.CreatedByLT))
+	}
+	if i.CreatedByLTE != nil {
+		predicates = append(predicates, tag.CreatedByLTE(*i.CreatedByLTE))
+	}
+
+	if i.HasFiles != nil {
+		p := tag.HasFiles()
+		if !*i.HasFiles {
+			p = tag.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasFilesWith) > 0 {
+		with := make([]predicate.File, 0, len(i.HasFilesWith))
+		for _, w := range i.HasFilesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasFilesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, tag.HasFilesWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyTagWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return tag.And(predicates...), nil
+	}
+}
@@ -8,6 +8,18 @@ import (
 	"main/ent"
 )
 
+// The DepartmentQuotaFunc type is an adapter to allow the use of ordinary
+// function as DepartmentQuota mutator.
+type DepartmentQuotaFunc func(context.Context, *ent.DepartmentQuotaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DepartmentQuotaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DepartmentQuotaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DepartmentQuotaMutation", m)
+}
+
 // The FileFunc type is an adapter to allow the use of ordinary
 // function as File mutator.
 type FileFunc func(context.Context, *ent.FileMutation) (ent.Value, error)
@@ -20,6 +32,126 @@ func (f FileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileMutation", m)
 }
 
+// The FileAuditEventFunc type is an adapter to allow the use of ordinary
+// function as FileAuditEvent mutator.
+type FileAuditEventFunc func(context.Context, *ent.FileAuditEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileAuditEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FileAuditEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileAuditEventMutation", m)
+}
+
+// The FileFavoriteFunc type is an adapter to allow the use of ordinary
+// function as FileFavorite mutator.
+type FileFavoriteFunc func(context.Context, *ent.FileFavoriteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileFavoriteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FileFavoriteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileFavoriteMutation", m)
+}
+
+// The FileSetFunc type is an adapter to allow the use of ordinary
+// function as FileSet mutator.
+type FileSetFunc func(context.Context, *ent.FileSetMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileSetFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FileSetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileSetMutation", m)
+}
+
+// The ImpersonationGrantFunc type is an adapter to allow the use of ordinary
+// function as ImpersonationGrant mutator.
+type ImpersonationGrantFunc func(context.Context, *ent.ImpersonationGrantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ImpersonationGrantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ImpersonationGrantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ImpersonationGrantMutation", m)
+}
+
+// The LifecycleRuleFunc type is an adapter to allow the use of ordinary
+// function as LifecycleRule mutator.
+type LifecycleRuleFunc func(context.Context, *ent.LifecycleRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LifecycleRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LifecycleRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LifecycleRuleMutation", m)
+}
+
+// The OperationAuditLogFunc type is an adapter to allow the use of ordinary
+// function as OperationAuditLog mutator.
+type OperationAuditLogFunc func(context.Context, *ent.OperationAuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OperationAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OperationAuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OperationAuditLogMutation", m)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary
+// function as Tag mutator.
+type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
+}
+
+// The TenantSettingFunc type is an adapter to allow the use of ordinary
+// function as TenantSetting mutator.
+type TenantSettingFunc func(context.Context, *ent.TenantSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantSettingMutation", m)
+}
+
+// The TranslationOverrideFunc type is an adapter to allow the use of ordinary
+// function as TranslationOverride mutator.
+type TranslationOverrideFunc func(context.Context, *ent.TranslationOverrideMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TranslationOverrideFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TranslationOverrideMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TranslationOverrideMutation", m)
+}
+
+// The UserStorageLimitFunc type is an adapter to allow the use of ordinary
+// function as UserStorageLimit mutator.
+type UserStorageLimitFunc func(context.Context, *ent.UserStorageLimitMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserStorageLimitFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserStorageLimitMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserStorageLimitMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
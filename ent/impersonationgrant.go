@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/ent/impersonationgrant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ImpersonationGrant is the model entity for the ImpersonationGrant schema.
+type ImpersonationGrant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Администратор поддержки, которому выдан доступ
+	SupportUserID uuid.UUID `json:"support_user_id,omitempty"`
+	// Пользователь, от имени которого выполняются запросы
+	TargetUserID uuid.UUID `json:"target_user_id,omitempty"`
+	// Роль пользователя в сессии поддержки (не выше сотрудника)
+	TargetRole impersonationgrant.TargetRole `json:"target_role,omitempty"`
+	// Отделы пользователя в сессии поддержки
+	TargetDepartmentIds []uuid.UUID `json:"target_department_ids,omitempty"`
+	// Владелец, выдавший доступ
+	GrantedBy uuid.UUID `json:"granted_by,omitempty"`
+	// Причина выдачи доступа
+	Reason string `json:"reason,omitempty"`
+	// SHA-256 токена сессии; сам токен возвращается только при выдаче
+	TokenHash string `json:"-"`
+	// Окончание действия доступа
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Время досрочного отзыва доступа
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ImpersonationGrant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case impersonationgrant.FieldTargetDepartmentIds:
+			values[i] = new([]byte)
+		case impersonationgrant.FieldTargetRole, impersonationgrant.FieldReason, impersonationgrant.FieldTokenHash:
+			values[i] = new(sql.NullString)
+		case impersonationgrant.FieldCreateTime, impersonationgrant.FieldUpdateTime, impersonationgrant.FieldExpiresAt, impersonationgrant.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		case impersonationgrant.FieldID, impersonationgrant.FieldTenantID, impersonationgrant.FieldSupportUserID, impersonationgrant.FieldTargetUserID, impersonationgrant.FieldGrantedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ImpersonationGrant fields.
+func (_m *ImpersonationGrant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case impersonationgrant.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case impersonationgrant.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case impersonationgrant.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case impersonationgrant.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case impersonationgrant.FieldSupportUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field support_user_id", values[i])
+			} else if value != nil {
+				_m.SupportUserID = *value
+			}
+		case impersonationgrant.FieldTargetUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field target_user_id", values[i])
+			} else if value != nil {
+				_m.TargetUserID = *value
+			}
+		case impersonationgrant.FieldTargetRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_role", values[i])
+			} else if value.Valid {
+				_m.TargetRole = impersonationgrant.TargetRole(value.String)
+			}
+		case impersonationgrant.FieldTargetDepartmentIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field target_department_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TargetDepartmentIds); err != nil {
+					return fmt.Errorf("unmarshal field target_department_ids: %w", err)
+				}
+			}
+		case impersonationgrant.FieldGrantedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_by", values[i])
+			} else if value != nil {
+				_m.GrantedBy = *value
+			}
+		case impersonationgrant.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case impersonationgrant.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case impersonationgrant.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case impersonationgrant.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = new(time.Time)
+				*_m.RevokedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ImpersonationGrant.
+// This includes values selected through modifiers, order, etc.
+func (_m *ImpersonationGrant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ImpersonationGrant.
+// Note that you need to call ImpersonationGrant.Unwrap() before calling this method if this ImpersonationGrant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ImpersonationGrant) Update() *ImpersonationGrantUpdateOne {
+	return NewImpersonationGrantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ImpersonationGrant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ImpersonationGrant) Unwrap() *ImpersonationGrant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ImpersonationGrant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ImpersonationGrant) String() string {
+	var builder strings.Builder
+	builder.WriteString("ImpersonationGrant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("support_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SupportUserID))
+	builder.WriteString(", ")
+	builder.WriteString("target_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetUserID))
+	builder.WriteString(", ")
+	builder.WriteString("target_role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetRole))
+	builder.WriteString(", ")
+	builder.WriteString("target_department_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetDepartmentIds))
+	builder.WriteString(", ")
+	builder.WriteString("granted_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GrantedBy))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ImpersonationGrants is a parsable slice of ImpersonationGrant.
+type ImpersonationGrants []*ImpersonationGrant
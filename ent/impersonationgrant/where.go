@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package impersonationgrant
+
+import (
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// SupportUserID applies equality check predicate on the "support_user_id" field. It's identical to SupportUserIDEQ.
+func SupportUserID(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldSupportUserID, v))
+}
+
+// TargetUserID applies equality check predicate on the "target_user_id" field. It's identical to TargetUserIDEQ.
+func TargetUserID(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldTargetUserID, v))
+}
+
+// GrantedBy applies equality check predicate on the "granted_by" field. It's identical to GrantedByEQ.
+func GrantedBy(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldReason, v))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// SupportUserIDEQ applies the EQ predicate on the "support_user_id" field.
+func SupportUserIDEQ(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldSupportUserID, v))
+}
+
+// SupportUserIDNEQ applies the NEQ predicate on the "support_user_id" field.
+func SupportUserIDNEQ(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldSupportUserID, v))
+}
+
+// SupportUserIDIn applies the In predicate on the "support_user_id" field.
+func SupportUserIDIn(vs ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldSupportUserID, vs...))
+}
+
+// SupportUserIDNotIn applies the NotIn predicate on the "support_user_id" field.
+func SupportUserIDNotIn(vs ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldSupportUserID, vs...))
+}
+
+// SupportUserIDGT applies the GT predicate on the "support_user_id" field.
+func SupportUserIDGT(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldSupportUserID, v))
+}
+
+// SupportUserIDGTE applies the GTE predicate on the "support_user_id" field.
+func SupportUserIDGTE(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldSupportUserID, v))
+}
+
+// SupportUserIDLT applies the LT predicate on the "support_user_id" field.
+func SupportUserIDLT(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldSupportUserID, v))
+}
+
+// SupportUserIDLTE applies the LTE predicate on the "support_user_id" field.
+func SupportUserIDLTE(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldSupportUserID, v))
+}
+
+// TargetUserIDEQ applies the EQ predicate on the "target_user_id" field.
+func TargetUserIDEQ(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldTargetUserID, v))
+}
+
+// TargetUserIDNEQ applies the NEQ predicate on the "target_user_id" field.
+func TargetUserIDNEQ(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldTargetUserID, v))
+}
+
+// TargetUserIDIn applies the In predicate on the "target_user_id" field.
+func TargetUserIDIn(vs ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldTargetUserID, vs...))
+}
+
+// TargetUserIDNotIn applies the NotIn predicate on the "target_user_id" field.
+func TargetUserIDNotIn(vs ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldTargetUserID, vs...))
+}
+
+// TargetUserIDGT applies the GT predicate on the "target_user_id" field.
+func TargetUserIDGT(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldTargetUserID, v))
+}
+
+// TargetUserIDGTE applies the GTE predicate on the "target_user_id" field.
+func TargetUserIDGTE(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldTargetUserID, v))
+}
+
+// TargetUserIDLT applies the LT predicate on the "target_user_id" field.
+func TargetUserIDLT(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldTargetUserID, v))
+}
+
+// TargetUserIDLTE applies the LTE predicate on the "target_user_id" field.
+func TargetUserIDLTE(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldTargetUserID, v))
+}
+
+// TargetRoleEQ applies the EQ predicate on the "target_role" field.
+func TargetRoleEQ(v TargetRole) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldTargetRole, v))
+}
+
+// TargetRoleNEQ applies the NEQ predicate on the "target_role" field.
+func TargetRoleNEQ(v TargetRole) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldTargetRole, v))
+}
+
+// TargetRoleIn applies the In predicate on the "target_role" field.
+func TargetRoleIn(vs ...TargetRole) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldTargetRole, vs...))
+}
+
+// TargetRoleNotIn applies the NotIn predicate on the "target_role" field.
+func TargetRoleNotIn(vs ...TargetRole) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldTargetRole, vs...))
+}
+
+// TargetDepartmentIdsIsNil applies the IsNil predicate on the "target_department_ids" field.
+func TargetDepartmentIdsIsNil() predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIsNull(FieldTargetDepartmentIds))
+}
+
+// TargetDepartmentIdsNotNil applies the NotNil predicate on the "target_department_ids" field.
+func TargetDepartmentIdsNotNil() predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotNull(FieldTargetDepartmentIds))
+}
+
+// GrantedByEQ applies the EQ predicate on the "granted_by" field.
+func GrantedByEQ(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldGrantedBy, v))
+}
+
+// GrantedByNEQ applies the NEQ predicate on the "granted_by" field.
+func GrantedByNEQ(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldGrantedBy, v))
+}
+
+// GrantedByIn applies the In predicate on the "granted_by" field.
+func GrantedByIn(vs ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByNotIn applies the NotIn predicate on the "granted_by" field.
+func GrantedByNotIn(vs ...uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldGrantedBy, vs...))
+}
+
+// GrantedByGT applies the GT predicate on the "granted_by" field.
+func GrantedByGT(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldGrantedBy, v))
+}
+
+// GrantedByGTE applies the GTE predicate on the "granted_by" field.
+func GrantedByGTE(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldGrantedBy, v))
+}
+
+// GrantedByLT applies the LT predicate on the "granted_by" field.
+func GrantedByLT(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldGrantedBy, v))
+}
+
+// GrantedByLTE applies the LTE predicate on the "granted_by" field.
+func GrantedByLTE(v uuid.UUID) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldGrantedBy, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldContainsFold(FieldReason, v))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ImpersonationGrant) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ImpersonationGrant) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ImpersonationGrant) predicate.ImpersonationGrant {
+	return predicate.ImpersonationGrant(sql.NotPredicates(p))
+}
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/impersonationgrant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ImpersonationGrantCreate is the builder for creating a ImpersonationGrant entity.
+type ImpersonationGrantCreate struct {
+	config
+	mutation *ImpersonationGrantMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ImpersonationGrantCreate) SetTenantID(v uuid.UUID) *ImpersonationGrantCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *ImpersonationGrantCreate) SetCreateTime(v time.Time) *ImpersonationGrantCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *ImpersonationGrantCreate) SetNillableCreateTime(v *time.Time) *ImpersonationGrantCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *ImpersonationGrantCreate) SetUpdateTime(v time.Time) *ImpersonationGrantCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *ImpersonationGrantCreate) SetNillableUpdateTime(v *time.Time) *ImpersonationGrantCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetSupportUserID sets the "support_user_id" field.
+func (_c *ImpersonationGrantCreate) SetSupportUserID(v uuid.UUID) *ImpersonationGrantCreate {
+	_c.mutation.SetSupportUserID(v)
+	return _c
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (_c *ImpersonationGrantCreate) SetTargetUserID(v uuid.UUID) *ImpersonationGrantCreate {
+	_c.mutation.SetTargetUserID(v)
+	return _c
+}
+
+// SetTargetRole sets the "target_role" field.
+func (_c *ImpersonationGrantCreate) SetTargetRole(v impersonationgrant.TargetRole) *ImpersonationGrantCreate {
+	_c.mutation.SetTargetRole(v)
+	return _c
+}
+
+// SetTargetDepartmentIds sets the "target_department_ids" field.
+func (_c *ImpersonationGrantCreate) SetTargetDepartmentIds(v []uuid.UUID) *ImpersonationGrantCreate {
+	_c.mutation.SetTargetDepartmentIds(v)
+	return _c
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_c *ImpersonationGrantCreate) SetGrantedBy(v uuid.UUID) *ImpersonationGrantCreate {
+	_c.mutation.SetGrantedBy(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *ImpersonationGrantCreate) SetReason(v string) *ImpersonationGrantCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *ImpersonationGrantCreate) SetTokenHash(v string) *ImpersonationGrantCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ImpersonationGrantCreate) SetExpiresAt(v time.Time) *ImpersonationGrantCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *ImpersonationGrantCreate) SetRevokedAt(v time.Time) *ImpersonationGrantCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *ImpersonationGrantCreate) SetNillableRevokedAt(v *time.Time) *ImpersonationGrantCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ImpersonationGrantCreate) SetID(v uuid.UUID) *ImpersonationGrantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ImpersonationGrantCreate) SetNillableID(v *uuid.UUID) *ImpersonationGrantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ImpersonationGrantMutation object of the builder.
+func (_c *ImpersonationGrantCreate) Mutation() *ImpersonationGrantMutation {
+	return _c.mutation
+}
+
+// Save creates the ImpersonationGrant in the database.
+func (_c *ImpersonationGrantCreate) Save(ctx context.Context) (*ImpersonationGrant, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ImpersonationGrantCreate) SaveX(ctx context.Context) *ImpersonationGrant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImpersonationGrantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImpersonationGrantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ImpersonationGrantCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if impersonationgrant.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized impersonationgrant.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := impersonationgrant.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if impersonationgrant.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized impersonationgrant.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := impersonationgrant.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if impersonationgrant.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized impersonationgrant.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := impersonationgrant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ImpersonationGrantCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "ImpersonationGrant.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "ImpersonationGrant.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "ImpersonationGrant.update_time"`)}
+	}
+	if _, ok := _c.mutation.SupportUserID(); !ok {
+		return &ValidationError{Name: "support_user_id", err: errors.New(`ent: missing required field "ImpersonationGrant.support_user_id"`)}
+	}
+	if _, ok := _c.mutation.TargetUserID(); !ok {
+		return &ValidationError{Name: "target_user_id", err: errors.New(`ent: missing required field "ImpersonationGrant.target_user_id"`)}
+	}
+	if _, ok := _c.mutation.TargetRole(); !ok {
+		return &ValidationError{Name: "target_role", err: errors.New(`ent: missing required field "ImpersonationGrant.target_role"`)}
+	}
+	if v, ok := _c.mutation.TargetRole(); ok {
+		if err := impersonationgrant.TargetRoleValidator(v); err != nil {
+			return &ValidationError{Name: "target_role", err: fmt.Errorf(`ent: validator failed for field "ImpersonationGrant.target_role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GrantedBy(); !ok {
+		return &ValidationError{Name: "granted_by", err: errors.New(`ent: missing required field "ImpersonationGrant.granted_by"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ImpersonationGrant.reason"`)}
+	}
+	if v, ok := _c.mutation.Reason(); ok {
+		if err := impersonationgrant.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ImpersonationGrant.reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "ImpersonationGrant.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := impersonationgrant.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ImpersonationGrant.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ImpersonationGrant.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *ImpersonationGrantCreate) sqlSave(ctx context.Context) (*ImpersonationGrant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ImpersonationGrantCreate) createSpec() (*ImpersonationGrant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ImpersonationGrant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(impersonationgrant.Table, sqlgraph.NewFieldSpec(impersonationgrant.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(impersonationgrant.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(impersonationgrant.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(impersonationgrant.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.SupportUserID(); ok {
+		_spec.SetField(impersonationgrant.FieldSupportUserID, field.TypeUUID, value)
+		_node.SupportUserID = value
+	}
+	if value, ok := _c.mutation.TargetUserID(); ok {
+		_spec.SetField(impersonationgrant.FieldTargetUserID, field.TypeUUID, value)
+		_node.TargetUserID = value
+	}
+	if value, ok := _c.mutation.TargetRole(); ok {
+		_spec.SetField(impersonationgrant.FieldTargetRole, field.TypeEnum, value)
+		_node.TargetRole = value
+	}
+	if value, ok := _c.mutation.TargetDepartmentIds(); ok {
+		_spec.SetField(impersonationgrant.FieldTargetDepartmentIds, field.TypeJSON, value)
+		_node.TargetDepartmentIds = value
+	}
+	if value, ok := _c.mutation.GrantedBy(); ok {
+		_spec.SetField(impersonationgrant.FieldGrantedBy, field.TypeUUID, value)
+		_node.GrantedBy = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(impersonationgrant.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(impersonationgrant.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonationgrant.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(impersonationgrant.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	return _node, _spec
+}
+
+// ImpersonationGrantCreateBulk is the builder for creating many ImpersonationGrant entities in bulk.
+type ImpersonationGrantCreateBulk struct {
+	config
+	err      error
+	builders []*ImpersonationGrantCreate
+}
+
+// Save creates the ImpersonationGrant entities in the database.
+func (_c *ImpersonationGrantCreateBulk) Save(ctx context.Context) ([]*ImpersonationGrant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ImpersonationGrant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImpersonationGrantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ImpersonationGrantCreateBulk) SaveX(ctx context.Context) []*ImpersonationGrant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ImpersonationGrantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ImpersonationGrantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,636 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/impersonationgrant"
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ImpersonationGrantUpdate is the builder for updating ImpersonationGrant entities.
+type ImpersonationGrantUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ImpersonationGrantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ImpersonationGrantUpdate builder.
+func (_u *ImpersonationGrantUpdate) Where(ps ...predicate.ImpersonationGrant) *ImpersonationGrantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImpersonationGrantUpdate) SetUpdateTime(v time.Time) *ImpersonationGrantUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetSupportUserID sets the "support_user_id" field.
+func (_u *ImpersonationGrantUpdate) SetSupportUserID(v uuid.UUID) *ImpersonationGrantUpdate {
+	_u.mutation.SetSupportUserID(v)
+	return _u
+}
+
+// SetNillableSupportUserID sets the "support_user_id" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdate) SetNillableSupportUserID(v *uuid.UUID) *ImpersonationGrantUpdate {
+	if v != nil {
+		_u.SetSupportUserID(*v)
+	}
+	return _u
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (_u *ImpersonationGrantUpdate) SetTargetUserID(v uuid.UUID) *ImpersonationGrantUpdate {
+	_u.mutation.SetTargetUserID(v)
+	return _u
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdate) SetNillableTargetUserID(v *uuid.UUID) *ImpersonationGrantUpdate {
+	if v != nil {
+		_u.SetTargetUserID(*v)
+	}
+	return _u
+}
+
+// SetTargetRole sets the "target_role" field.
+func (_u *ImpersonationGrantUpdate) SetTargetRole(v impersonationgrant.TargetRole) *ImpersonationGrantUpdate {
+	_u.mutation.SetTargetRole(v)
+	return _u
+}
+
+// SetNillableTargetRole sets the "target_role" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdate) SetNillableTargetRole(v *impersonationgrant.TargetRole) *ImpersonationGrantUpdate {
+	if v != nil {
+		_u.SetTargetRole(*v)
+	}
+	return _u
+}
+
+// SetTargetDepartmentIds sets the "target_department_ids" field.
+func (_u *ImpersonationGrantUpdate) SetTargetDepartmentIds(v []uuid.UUID) *ImpersonationGrantUpdate {
+	_u.mutation.SetTargetDepartmentIds(v)
+	return _u
+}
+
+// AppendTargetDepartmentIds appends value to the "target_department_ids" field.
+func (_u *ImpersonationGrantUpdate) AppendTargetDepartmentIds(v []uuid.UUID) *ImpersonationGrantUpdate {
+	_u.mutation.AppendTargetDepartmentIds(v)
+	return _u
+}
+
+// ClearTargetDepartmentIds clears the value of the "target_department_ids" field.
+func (_u *ImpersonationGrantUpdate) ClearTargetDepartmentIds() *ImpersonationGrantUpdate {
+	_u.mutation.ClearTargetDepartmentIds()
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *ImpersonationGrantUpdate) SetGrantedBy(v uuid.UUID) *ImpersonationGrantUpdate {
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdate) SetNillableGrantedBy(v *uuid.UUID) *ImpersonationGrantUpdate {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ImpersonationGrantUpdate) SetReason(v string) *ImpersonationGrantUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdate) SetNillableReason(v *string) *ImpersonationGrantUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *ImpersonationGrantUpdate) SetTokenHash(v string) *ImpersonationGrantUpdate {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdate) SetNillableTokenHash(v *string) *ImpersonationGrantUpdate {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ImpersonationGrantUpdate) SetExpiresAt(v time.Time) *ImpersonationGrantUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdate) SetNillableExpiresAt(v *time.Time) *ImpersonationGrantUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *ImpersonationGrantUpdate) SetRevokedAt(v time.Time) *ImpersonationGrantUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdate) SetNillableRevokedAt(v *time.Time) *ImpersonationGrantUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *ImpersonationGrantUpdate) ClearRevokedAt() *ImpersonationGrantUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the ImpersonationGrantMutation object of the builder.
+func (_u *ImpersonationGrantUpdate) Mutation() *ImpersonationGrantMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ImpersonationGrantUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImpersonationGrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ImpersonationGrantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImpersonationGrantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImpersonationGrantUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if impersonationgrant.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized impersonationgrant.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := impersonationgrant.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImpersonationGrantUpdate) check() error {
+	if v, ok := _u.mutation.TargetRole(); ok {
+		if err := impersonationgrant.TargetRoleValidator(v); err != nil {
+			return &ValidationError{Name: "target_role", err: fmt.Errorf(`ent: validator failed for field "ImpersonationGrant.target_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := impersonationgrant.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ImpersonationGrant.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := impersonationgrant.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ImpersonationGrant.token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImpersonationGrantUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImpersonationGrantUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImpersonationGrantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(impersonationgrant.Table, impersonationgrant.Columns, sqlgraph.NewFieldSpec(impersonationgrant.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(impersonationgrant.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SupportUserID(); ok {
+		_spec.SetField(impersonationgrant.FieldSupportUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TargetUserID(); ok {
+		_spec.SetField(impersonationgrant.FieldTargetUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TargetRole(); ok {
+		_spec.SetField(impersonationgrant.FieldTargetRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetDepartmentIds(); ok {
+		_spec.SetField(impersonationgrant.FieldTargetDepartmentIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetDepartmentIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, impersonationgrant.FieldTargetDepartmentIds, value)
+		})
+	}
+	if _u.mutation.TargetDepartmentIdsCleared() {
+		_spec.ClearField(impersonationgrant.FieldTargetDepartmentIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(impersonationgrant.FieldGrantedBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(impersonationgrant.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(impersonationgrant.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonationgrant.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(impersonationgrant.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(impersonationgrant.FieldRevokedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{impersonationgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ImpersonationGrantUpdateOne is the builder for updating a single ImpersonationGrant entity.
+type ImpersonationGrantUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ImpersonationGrantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *ImpersonationGrantUpdateOne) SetUpdateTime(v time.Time) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetSupportUserID sets the "support_user_id" field.
+func (_u *ImpersonationGrantUpdateOne) SetSupportUserID(v uuid.UUID) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetSupportUserID(v)
+	return _u
+}
+
+// SetNillableSupportUserID sets the "support_user_id" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdateOne) SetNillableSupportUserID(v *uuid.UUID) *ImpersonationGrantUpdateOne {
+	if v != nil {
+		_u.SetSupportUserID(*v)
+	}
+	return _u
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (_u *ImpersonationGrantUpdateOne) SetTargetUserID(v uuid.UUID) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetTargetUserID(v)
+	return _u
+}
+
+// SetNillableTargetUserID sets the "target_user_id" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdateOne) SetNillableTargetUserID(v *uuid.UUID) *ImpersonationGrantUpdateOne {
+	if v != nil {
+		_u.SetTargetUserID(*v)
+	}
+	return _u
+}
+
+// SetTargetRole sets the "target_role" field.
+func (_u *ImpersonationGrantUpdateOne) SetTargetRole(v impersonationgrant.TargetRole) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetTargetRole(v)
+	return _u
+}
+
+// SetNillableTargetRole sets the "target_role" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdateOne) SetNillableTargetRole(v *impersonationgrant.TargetRole) *ImpersonationGrantUpdateOne {
+	if v != nil {
+		_u.SetTargetRole(*v)
+	}
+	return _u
+}
+
+// SetTargetDepartmentIds sets the "target_department_ids" field.
+func (_u *ImpersonationGrantUpdateOne) SetTargetDepartmentIds(v []uuid.UUID) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetTargetDepartmentIds(v)
+	return _u
+}
+
+// AppendTargetDepartmentIds appends value to the "target_department_ids" field.
+func (_u *ImpersonationGrantUpdateOne) AppendTargetDepartmentIds(v []uuid.UUID) *ImpersonationGrantUpdateOne {
+	_u.mutation.AppendTargetDepartmentIds(v)
+	return _u
+}
+
+// ClearTargetDepartmentIds clears the value of the "target_department_ids" field.
+func (_u *ImpersonationGrantUpdateOne) ClearTargetDepartmentIds() *ImpersonationGrantUpdateOne {
+	_u.mutation.ClearTargetDepartmentIds()
+	return _u
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (_u *ImpersonationGrantUpdateOne) SetGrantedBy(v uuid.UUID) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetGrantedBy(v)
+	return _u
+}
+
+// SetNillableGrantedBy sets the "granted_by" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdateOne) SetNillableGrantedBy(v *uuid.UUID) *ImpersonationGrantUpdateOne {
+	if v != nil {
+		_u.SetGrantedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *ImpersonationGrantUpdateOne) SetReason(v string) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdateOne) SetNillableReason(v *string) *ImpersonationGrantUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_u *ImpersonationGrantUpdateOne) SetTokenHash(v string) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetTokenHash(v)
+	return _u
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdateOne) SetNillableTokenHash(v *string) *ImpersonationGrantUpdateOne {
+	if v != nil {
+		_u.SetTokenHash(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ImpersonationGrantUpdateOne) SetExpiresAt(v time.Time) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdateOne) SetNillableExpiresAt(v *time.Time) *ImpersonationGrantUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *ImpersonationGrantUpdateOne) SetRevokedAt(v time.Time) *ImpersonationGrantUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *ImpersonationGrantUpdateOne) SetNillableRevokedAt(v *time.Time) *ImpersonationGrantUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *ImpersonationGrantUpdateOne) ClearRevokedAt() *ImpersonationGrantUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the ImpersonationGrantMutation object of the builder.
+func (_u *ImpersonationGrantUpdateOne) Mutation() *ImpersonationGrantMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ImpersonationGrantUpdate builder.
+func (_u *ImpersonationGrantUpdateOne) Where(ps ...predicate.ImpersonationGrant) *ImpersonationGrantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ImpersonationGrantUpdateOne) Select(field string, fields ...string) *ImpersonationGrantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ImpersonationGrant entity.
+func (_u *ImpersonationGrantUpdateOne) Save(ctx context.Context) (*ImpersonationGrant, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ImpersonationGrantUpdateOne) SaveX(ctx context.Context) *ImpersonationGrant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ImpersonationGrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ImpersonationGrantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ImpersonationGrantUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if impersonationgrant.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized impersonationgrant.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := impersonationgrant.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ImpersonationGrantUpdateOne) check() error {
+	if v, ok := _u.mutation.TargetRole(); ok {
+		if err := impersonationgrant.TargetRoleValidator(v); err != nil {
+			return &ValidationError{Name: "target_role", err: fmt.Errorf(`ent: validator failed for field "ImpersonationGrant.target_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := impersonationgrant.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ImpersonationGrant.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TokenHash(); ok {
+		if err := impersonationgrant.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "ImpersonationGrant.token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ImpersonationGrantUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ImpersonationGrantUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ImpersonationGrantUpdateOne) sqlSave(ctx context.Context) (_node *ImpersonationGrant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(impersonationgrant.Table, impersonationgrant.Columns, sqlgraph.NewFieldSpec(impersonationgrant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ImpersonationGrant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, impersonationgrant.FieldID)
+		for _, f := range fields {
+			if !impersonationgrant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != impersonationgrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(impersonationgrant.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SupportUserID(); ok {
+		_spec.SetField(impersonationgrant.FieldSupportUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TargetUserID(); ok {
+		_spec.SetField(impersonationgrant.FieldTargetUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TargetRole(); ok {
+		_spec.SetField(impersonationgrant.FieldTargetRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TargetDepartmentIds(); ok {
+		_spec.SetField(impersonationgrant.FieldTargetDepartmentIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetDepartmentIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, impersonationgrant.FieldTargetDepartmentIds, value)
+		})
+	}
+	if _u.mutation.TargetDepartmentIdsCleared() {
+		_spec.ClearField(impersonationgrant.FieldTargetDepartmentIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GrantedBy(); ok {
+		_spec.SetField(impersonationgrant.FieldGrantedBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(impersonationgrant.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TokenHash(); ok {
+		_spec.SetField(impersonationgrant.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(impersonationgrant.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(impersonationgrant.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(impersonationgrant.FieldRevokedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ImpersonationGrant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{impersonationgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,8 +7,19 @@ import (
 	"fmt"
 
 	"main/ent"
+	"main/ent/departmentquota"
 	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/filefavorite"
+	"main/ent/fileset"
+	"main/ent/impersonationgrant"
+	"main/ent/lifecyclerule"
+	"main/ent/operationauditlog"
 	"main/ent/predicate"
+	"main/ent/tag"
+	"main/ent/tenantsetting"
+	"main/ent/translationoverride"
+	"main/ent/userstoragelimit"
 
 	"entgo.io/ent/dialect/sql"
 )
@@ -69,6 +80,33 @@ func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
 	return f(ctx, query)
 }
 
+// The DepartmentQuotaFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DepartmentQuotaFunc func(context.Context, *ent.DepartmentQuotaQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f DepartmentQuotaFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.DepartmentQuotaQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.DepartmentQuotaQuery", q)
+}
+
+// The TraverseDepartmentQuota type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDepartmentQuota func(context.Context, *ent.DepartmentQuotaQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDepartmentQuota) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDepartmentQuota) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DepartmentQuotaQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.DepartmentQuotaQuery", q)
+}
+
 // The FileFunc type is an adapter to allow the use of ordinary function as a Querier.
 type FileFunc func(context.Context, *ent.FileQuery) (ent.Value, error)
 
@@ -96,11 +134,303 @@ func (f TraverseFile) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.FileQuery", q)
 }
 
+// The FileAuditEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FileAuditEventFunc func(context.Context, *ent.FileAuditEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f FileAuditEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.FileAuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.FileAuditEventQuery", q)
+}
+
+// The TraverseFileAuditEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFileAuditEvent func(context.Context, *ent.FileAuditEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFileAuditEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFileAuditEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileAuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.FileAuditEventQuery", q)
+}
+
+// The FileFavoriteFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FileFavoriteFunc func(context.Context, *ent.FileFavoriteQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f FileFavoriteFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.FileFavoriteQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.FileFavoriteQuery", q)
+}
+
+// The TraverseFileFavorite type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFileFavorite func(context.Context, *ent.FileFavoriteQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFileFavorite) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFileFavorite) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileFavoriteQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.FileFavoriteQuery", q)
+}
+
+// The FileSetFunc type is an adapter to allow the use of ordinary function as a Querier.
+type FileSetFunc func(context.Context, *ent.FileSetQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f FileSetFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.FileSetQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.FileSetQuery", q)
+}
+
+// The TraverseFileSet type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseFileSet func(context.Context, *ent.FileSetQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFileSet) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFileSet) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileSetQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.FileSetQuery", q)
+}
+
+// The ImpersonationGrantFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ImpersonationGrantFunc func(context.Context, *ent.ImpersonationGrantQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ImpersonationGrantFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ImpersonationGrantQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ImpersonationGrantQuery", q)
+}
+
+// The TraverseImpersonationGrant type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseImpersonationGrant func(context.Context, *ent.ImpersonationGrantQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseImpersonationGrant) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseImpersonationGrant) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ImpersonationGrantQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ImpersonationGrantQuery", q)
+}
+
+// The LifecycleRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LifecycleRuleFunc func(context.Context, *ent.LifecycleRuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LifecycleRuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LifecycleRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LifecycleRuleQuery", q)
+}
+
+// The TraverseLifecycleRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLifecycleRule func(context.Context, *ent.LifecycleRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLifecycleRule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLifecycleRule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LifecycleRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LifecycleRuleQuery", q)
+}
+
+// The OperationAuditLogFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OperationAuditLogFunc func(context.Context, *ent.OperationAuditLogQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OperationAuditLogFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OperationAuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OperationAuditLogQuery", q)
+}
+
+// The TraverseOperationAuditLog type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOperationAuditLog func(context.Context, *ent.OperationAuditLogQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOperationAuditLog) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOperationAuditLog) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OperationAuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OperationAuditLogQuery", q)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TagFunc func(context.Context, *ent.TagQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TagFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TagQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TagQuery", q)
+}
+
+// The TraverseTag type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTag func(context.Context, *ent.TagQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTag) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTag) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TagQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TagQuery", q)
+}
+
+// The TenantSettingFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TenantSettingFunc func(context.Context, *ent.TenantSettingQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TenantSettingFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TenantSettingQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TenantSettingQuery", q)
+}
+
+// The TraverseTenantSetting type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTenantSetting func(context.Context, *ent.TenantSettingQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTenantSetting) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTenantSetting) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantSettingQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TenantSettingQuery", q)
+}
+
+// The TranslationOverrideFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TranslationOverrideFunc func(context.Context, *ent.TranslationOverrideQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TranslationOverrideFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TranslationOverrideQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TranslationOverrideQuery", q)
+}
+
+// The TraverseTranslationOverride type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTranslationOverride func(context.Context, *ent.TranslationOverrideQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTranslationOverride) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTranslationOverride) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TranslationOverrideQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TranslationOverrideQuery", q)
+}
+
+// The UserStorageLimitFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserStorageLimitFunc func(context.Context, *ent.UserStorageLimitQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserStorageLimitFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserStorageLimitQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserStorageLimitQuery", q)
+}
+
+// The TraverseUserStorageLimit type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserStorageLimit func(context.Context, *ent.UserStorageLimitQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserStorageLimit) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserStorageLimit) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserStorageLimitQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserStorageLimitQuery", q)
+}
+
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q ent.Query) (Query, error) {
 	switch q := q.(type) {
+	case *ent.DepartmentQuotaQuery:
+		return &query[*ent.DepartmentQuotaQuery, predicate.DepartmentQuota, departmentquota.OrderOption]{typ: ent.TypeDepartmentQuota, tq: q}, nil
 	case *ent.FileQuery:
 		return &query[*ent.FileQuery, predicate.File, file.OrderOption]{typ: ent.TypeFile, tq: q}, nil
+	case *ent.FileAuditEventQuery:
+		return &query[*ent.FileAuditEventQuery, predicate.FileAuditEvent, fileauditevent.OrderOption]{typ: ent.TypeFileAuditEvent, tq: q}, nil
+	case *ent.FileFavoriteQuery:
+		return &query[*ent.FileFavoriteQuery, predicate.FileFavorite, filefavorite.OrderOption]{typ: ent.TypeFileFavorite, tq: q}, nil
+	case *ent.FileSetQuery:
+		return &query[*ent.FileSetQuery, predicate.FileSet, fileset.OrderOption]{typ: ent.TypeFileSet, tq: q}, nil
+	case *ent.ImpersonationGrantQuery:
+		return &query[*ent.ImpersonationGrantQuery, predicate.ImpersonationGrant, impersonationgrant.OrderOption]{typ: ent.TypeImpersonationGrant, tq: q}, nil
+	case *ent.LifecycleRuleQuery:
+		return &query[*ent.LifecycleRuleQuery, predicate.LifecycleRule, lifecyclerule.OrderOption]{typ: ent.TypeLifecycleRule, tq: q}, nil
+	case *ent.OperationAuditLogQuery:
+		return &query[*ent.OperationAuditLogQuery, predicate.OperationAuditLog, operationauditlog.OrderOption]{typ: ent.TypeOperationAuditLog, tq: q}, nil
+	case *ent.TagQuery:
+		return &query[*ent.TagQuery, predicate.Tag, tag.OrderOption]{typ: ent.TypeTag, tq: q}, nil
+	case *ent.TenantSettingQuery:
+		return &query[*ent.TenantSettingQuery, predicate.TenantSetting, tenantsetting.OrderOption]{typ: ent.TypeTenantSetting, tq: q}, nil
+	case *ent.TranslationOverrideQuery:
+		return &query[*ent.TranslationOverrideQuery, predicate.TranslationOverride, translationoverride.OrderOption]{typ: ent.TypeTranslationOverride, tq: q}, nil
+	case *ent.UserStorageLimitQuery:
+		return &query[*ent.UserStorageLimitQuery, predicate.UserStorageLimit, userstoragelimit.OrderOption]{typ: ent.TypeUserStorageLimit, tq: q}, nil
 	default:
 		return nil, fmt.Errorf("unknown query type %T", q)
 	}
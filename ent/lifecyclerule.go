@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"main/ent/lifecyclerule"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// LifecycleRule is the model entity for the LifecycleRule schema.
+type LifecycleRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Название правила (уникально в пределах тенанта)
+	Name string `json:"name,omitempty"`
+	// Выключенные правила не применяются, но доступны для пробного запуска
+	Enabled bool `json:"enabled,omitempty"`
+	// Действие с подходящими файлами: ARCHIVE - перевод в архив, EXPIRE - перемещение в корзину, NOTIFY - событие аудита для админов
+	Action lifecyclerule.Action `json:"action,omitempty"`
+	// Условие: файл загружен не менее указанного количества дней назад
+	MinAgeDays *int `json:"min_age_days,omitempty"`
+	// Условие: файл не скачивали указанное количество дней (без скачиваний считается от загрузки)
+	MinIdleDays *int `json:"min_idle_days,omitempty"`
+	// Условие: файл отмечен тегом
+	Tag *string `json:"tag,omitempty"`
+	// Условие: категория MIME типа файла (IMAGE, VIDEO, DOCUMENT и т.д.)
+	MimeCategory *string `json:"mime_category,omitempty"`
+	// Пользователь, создавший правило
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// Время последнего применения правила планировщиком
+	LastRunAt    *time.Time `json:"last_run_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LifecycleRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lifecyclerule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case lifecyclerule.FieldMinAgeDays, lifecyclerule.FieldMinIdleDays:
+			values[i] = new(sql.NullInt64)
+		case lifecyclerule.FieldName, lifecyclerule.FieldAction, lifecyclerule.FieldTag, lifecyclerule.FieldMimeCategory:
+			values[i] = new(sql.NullString)
+		case lifecyclerule.FieldCreateTime, lifecyclerule.FieldUpdateTime, lifecyclerule.FieldLastRunAt:
+			values[i] = new(sql.NullTime)
+		case lifecyclerule.FieldID, lifecyclerule.FieldTenantID, lifecyclerule.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LifecycleRule fields.
+func (_m *LifecycleRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lifecyclerule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case lifecyclerule.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case lifecyclerule.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case lifecyclerule.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case lifecyclerule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case lifecyclerule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case lifecyclerule.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = lifecyclerule.Action(value.String)
+			}
+		case lifecyclerule.FieldMinAgeDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_age_days", values[i])
+			} else if value.Valid {
+				_m.MinAgeDays = new(int)
+				*_m.MinAgeDays = int(value.Int64)
+			}
+		case lifecyclerule.FieldMinIdleDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_idle_days", values[i])
+			} else if value.Valid {
+				_m.MinIdleDays = new(int)
+				*_m.MinIdleDays = int(value.Int64)
+			}
+		case lifecyclerule.FieldTag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tag", values[i])
+			} else if value.Valid {
+				_m.Tag = new(string)
+				*_m.Tag = value.String
+			}
+		case lifecyclerule.FieldMimeCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mime_category", values[i])
+			} else if value.Valid {
+				_m.MimeCategory = new(string)
+				*_m.MimeCategory = value.String
+			}
+		case lifecyclerule.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case lifecyclerule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				_m.LastRunAt = new(time.Time)
+				*_m.LastRunAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LifecycleRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *LifecycleRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LifecycleRule.
+// Note that you need to call LifecycleRule.Unwrap() before calling this method if this LifecycleRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LifecycleRule) Update() *LifecycleRuleUpdateOne {
+	return NewLifecycleRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LifecycleRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LifecycleRule) Unwrap() *LifecycleRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LifecycleRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LifecycleRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("LifecycleRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	if v := _m.MinAgeDays; v != nil {
+		builder.WriteString("min_age_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MinIdleDays; v != nil {
+		builder.WriteString("min_idle_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Tag; v != nil {
+		builder.WriteString("tag=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.MimeCategory; v != nil {
+		builder.WriteString("mime_category=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	if v := _m.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LifecycleRules is a parsable slice of LifecycleRule.
+type LifecycleRules []*LifecycleRule
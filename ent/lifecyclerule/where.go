@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lifecyclerule
+
+import (
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// MinAgeDays applies equality check predicate on the "min_age_days" field. It's identical to MinAgeDaysEQ.
+func MinAgeDays(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldMinAgeDays, v))
+}
+
+// MinIdleDays applies equality check predicate on the "min_idle_days" field. It's identical to MinIdleDaysEQ.
+func MinIdleDays(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldMinIdleDays, v))
+}
+
+// Tag applies equality check predicate on the "tag" field. It's identical to TagEQ.
+func Tag(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldTag, v))
+}
+
+// MimeCategory applies equality check predicate on the "mime_category" field. It's identical to MimeCategoryEQ.
+func MimeCategory(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldMimeCategory, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// MinAgeDaysEQ applies the EQ predicate on the "min_age_days" field.
+func MinAgeDaysEQ(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldMinAgeDays, v))
+}
+
+// MinAgeDaysNEQ applies the NEQ predicate on the "min_age_days" field.
+func MinAgeDaysNEQ(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldMinAgeDays, v))
+}
+
+// MinAgeDaysIn applies the In predicate on the "min_age_days" field.
+func MinAgeDaysIn(vs ...int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldMinAgeDays, vs...))
+}
+
+// MinAgeDaysNotIn applies the NotIn predicate on the "min_age_days" field.
+func MinAgeDaysNotIn(vs ...int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldMinAgeDays, vs...))
+}
+
+// MinAgeDaysGT applies the GT predicate on the "min_age_days" field.
+func MinAgeDaysGT(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldMinAgeDays, v))
+}
+
+// MinAgeDaysGTE applies the GTE predicate on the "min_age_days" field.
+func MinAgeDaysGTE(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldMinAgeDays, v))
+}
+
+// MinAgeDaysLT applies the LT predicate on the "min_age_days" field.
+func MinAgeDaysLT(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldMinAgeDays, v))
+}
+
+// MinAgeDaysLTE applies the LTE predicate on the "min_age_days" field.
+func MinAgeDaysLTE(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldMinAgeDays, v))
+}
+
+// MinAgeDaysIsNil applies the IsNil predicate on the "min_age_days" field.
+func MinAgeDaysIsNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIsNull(FieldMinAgeDays))
+}
+
+// MinAgeDaysNotNil applies the NotNil predicate on the "min_age_days" field.
+func MinAgeDaysNotNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotNull(FieldMinAgeDays))
+}
+
+// MinIdleDaysEQ applies the EQ predicate on the "min_idle_days" field.
+func MinIdleDaysEQ(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldMinIdleDays, v))
+}
+
+// MinIdleDaysNEQ applies the NEQ predicate on the "min_idle_days" field.
+func MinIdleDaysNEQ(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldMinIdleDays, v))
+}
+
+// MinIdleDaysIn applies the In predicate on the "min_idle_days" field.
+func MinIdleDaysIn(vs ...int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldMinIdleDays, vs...))
+}
+
+// MinIdleDaysNotIn applies the NotIn predicate on the "min_idle_days" field.
+func MinIdleDaysNotIn(vs ...int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldMinIdleDays, vs...))
+}
+
+// MinIdleDaysGT applies the GT predicate on the "min_idle_days" field.
+func MinIdleDaysGT(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldMinIdleDays, v))
+}
+
+// MinIdleDaysGTE applies the GTE predicate on the "min_idle_days" field.
+func MinIdleDaysGTE(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldMinIdleDays, v))
+}
+
+// MinIdleDaysLT applies the LT predicate on the "min_idle_days" field.
+func MinIdleDaysLT(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldMinIdleDays, v))
+}
+
+// MinIdleDaysLTE applies the LTE predicate on the "min_idle_days" field.
+func MinIdleDaysLTE(v int) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldMinIdleDays, v))
+}
+
+// MinIdleDaysIsNil applies the IsNil predicate on the "min_idle_days" field.
+func MinIdleDaysIsNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIsNull(FieldMinIdleDays))
+}
+
+// MinIdleDaysNotNil applies the NotNil predicate on the "min_idle_days" field.
+func MinIdleDaysNotNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotNull(FieldMinIdleDays))
+}
+
+// TagEQ applies the EQ predicate on the "tag" field.
+func TagEQ(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldTag, v))
+}
+
+// TagNEQ applies the NEQ predicate on the "tag" field.
+func TagNEQ(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldTag, v))
+}
+
+// TagIn applies the In predicate on the "tag" field.
+func TagIn(vs ...string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldTag, vs...))
+}
+
+// TagNotIn applies the NotIn predicate on the "tag" field.
+func TagNotIn(vs ...string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldTag, vs...))
+}
+
+// TagGT applies the GT predicate on the "tag" field.
+func TagGT(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldTag, v))
+}
+
+// TagGTE applies the GTE predicate on the "tag" field.
+func TagGTE(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldTag, v))
+}
+
+// TagLT applies the LT predicate on the "tag" field.
+func TagLT(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldTag, v))
+}
+
+// TagLTE applies the LTE predicate on the "tag" field.
+func TagLTE(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldTag, v))
+}
+
+// TagContains applies the Contains predicate on the "tag" field.
+func TagContains(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldContains(FieldTag, v))
+}
+
+// TagHasPrefix applies the HasPrefix predicate on the "tag" field.
+func TagHasPrefix(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldHasPrefix(FieldTag, v))
+}
+
+// TagHasSuffix applies the HasSuffix predicate on the "tag" field.
+func TagHasSuffix(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldHasSuffix(FieldTag, v))
+}
+
+// TagIsNil applies the IsNil predicate on the "tag" field.
+func TagIsNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIsNull(FieldTag))
+}
+
+// TagNotNil applies the NotNil predicate on the "tag" field.
+func TagNotNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotNull(FieldTag))
+}
+
+// TagEqualFold applies the EqualFold predicate on the "tag" field.
+func TagEqualFold(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEqualFold(FieldTag, v))
+}
+
+// TagContainsFold applies the ContainsFold predicate on the "tag" field.
+func TagContainsFold(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldContainsFold(FieldTag, v))
+}
+
+// MimeCategoryEQ applies the EQ predicate on the "mime_category" field.
+func MimeCategoryEQ(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldMimeCategory, v))
+}
+
+// MimeCategoryNEQ applies the NEQ predicate on the "mime_category" field.
+func MimeCategoryNEQ(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldMimeCategory, v))
+}
+
+// MimeCategoryIn applies the In predicate on the "mime_category" field.
+func MimeCategoryIn(vs ...string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldMimeCategory, vs...))
+}
+
+// MimeCategoryNotIn applies the NotIn predicate on the "mime_category" field.
+func MimeCategoryNotIn(vs ...string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldMimeCategory, vs...))
+}
+
+// MimeCategoryGT applies the GT predicate on the "mime_category" field.
+func MimeCategoryGT(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldMimeCategory, v))
+}
+
+// MimeCategoryGTE applies the GTE predicate on the "mime_category" field.
+func MimeCategoryGTE(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldMimeCategory, v))
+}
+
+// MimeCategoryLT applies the LT predicate on the "mime_category" field.
+func MimeCategoryLT(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldMimeCategory, v))
+}
+
+// MimeCategoryLTE applies the LTE predicate on the "mime_category" field.
+func MimeCategoryLTE(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldMimeCategory, v))
+}
+
+// MimeCategoryContains applies the Contains predicate on the "mime_category" field.
+func MimeCategoryContains(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldContains(FieldMimeCategory, v))
+}
+
+// MimeCategoryHasPrefix applies the HasPrefix predicate on the "mime_category" field.
+func MimeCategoryHasPrefix(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldHasPrefix(FieldMimeCategory, v))
+}
+
+// MimeCategoryHasSuffix applies the HasSuffix predicate on the "mime_category" field.
+func MimeCategoryHasSuffix(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldHasSuffix(FieldMimeCategory, v))
+}
+
+// MimeCategoryIsNil applies the IsNil predicate on the "mime_category" field.
+func MimeCategoryIsNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIsNull(FieldMimeCategory))
+}
+
+// MimeCategoryNotNil applies the NotNil predicate on the "mime_category" field.
+func MimeCategoryNotNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotNull(FieldMimeCategory))
+}
+
+// MimeCategoryEqualFold applies the EqualFold predicate on the "mime_category" field.
+func MimeCategoryEqualFold(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEqualFold(FieldMimeCategory, v))
+}
+
+// MimeCategoryContainsFold applies the ContainsFold predicate on the "mime_category" field.
+func MimeCategoryContainsFold(v string) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldContainsFold(FieldMimeCategory, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LifecycleRule) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LifecycleRule) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LifecycleRule) predicate.LifecycleRule {
+	return predicate.LifecycleRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/lifecyclerule"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// LifecycleRuleCreate is the builder for creating a LifecycleRule entity.
+type LifecycleRuleCreate struct {
+	config
+	mutation *LifecycleRuleMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *LifecycleRuleCreate) SetTenantID(v uuid.UUID) *LifecycleRuleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *LifecycleRuleCreate) SetCreateTime(v time.Time) *LifecycleRuleCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *LifecycleRuleCreate) SetNillableCreateTime(v *time.Time) *LifecycleRuleCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *LifecycleRuleCreate) SetUpdateTime(v time.Time) *LifecycleRuleCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *LifecycleRuleCreate) SetNillableUpdateTime(v *time.Time) *LifecycleRuleCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LifecycleRuleCreate) SetName(v string) *LifecycleRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *LifecycleRuleCreate) SetEnabled(v bool) *LifecycleRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *LifecycleRuleCreate) SetNillableEnabled(v *bool) *LifecycleRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *LifecycleRuleCreate) SetAction(v lifecyclerule.Action) *LifecycleRuleCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetMinAgeDays sets the "min_age_days" field.
+func (_c *LifecycleRuleCreate) SetMinAgeDays(v int) *LifecycleRuleCreate {
+	_c.mutation.SetMinAgeDays(v)
+	return _c
+}
+
+// SetNillableMinAgeDays sets the "min_age_days" field if the given value is not nil.
+func (_c *LifecycleRuleCreate) SetNillableMinAgeDays(v *int) *LifecycleRuleCreate {
+	if v != nil {
+		_c.SetMinAgeDays(*v)
+	}
+	return _c
+}
+
+// SetMinIdleDays sets the "min_idle_days" field.
+func (_c *LifecycleRuleCreate) SetMinIdleDays(v int) *LifecycleRuleCreate {
+	_c.mutation.SetMinIdleDays(v)
+	return _c
+}
+
+// SetNillableMinIdleDays sets the "min_idle_days" field if the given value is not nil.
+func (_c *LifecycleRuleCreate) SetNillableMinIdleDays(v *int) *LifecycleRuleCreate {
+	if v != nil {
+		_c.SetMinIdleDays(*v)
+	}
+	return _c
+}
+
+// SetTag sets the "tag" field.
+func (_c *LifecycleRuleCreate) SetTag(v string) *LifecycleRuleCreate {
+	_c.mutation.SetTag(v)
+	return _c
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (_c *LifecycleRuleCreate) SetNillableTag(v *string) *LifecycleRuleCreate {
+	if v != nil {
+		_c.SetTag(*v)
+	}
+	return _c
+}
+
+// SetMimeCategory sets the "mime_category" field.
+func (_c *LifecycleRuleCreate) SetMimeCategory(v string) *LifecycleRuleCreate {
+	_c.mutation.SetMimeCategory(v)
+	return _c
+}
+
+// SetNillableMimeCategory sets the "mime_category" field if the given value is not nil.
+func (_c *LifecycleRuleCreate) SetNillableMimeCategory(v *string) *LifecycleRuleCreate {
+	if v != nil {
+		_c.SetMimeCategory(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *LifecycleRuleCreate) SetCreatedBy(v uuid.UUID) *LifecycleRuleCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *LifecycleRuleCreate) SetLastRunAt(v time.Time) *LifecycleRuleCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *LifecycleRuleCreate) SetNillableLastRunAt(v *time.Time) *LifecycleRuleCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LifecycleRuleCreate) SetID(v uuid.UUID) *LifecycleRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LifecycleRuleCreate) SetNillableID(v *uuid.UUID) *LifecycleRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LifecycleRuleMutation object of the builder.
+func (_c *LifecycleRuleCreate) Mutation() *LifecycleRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the LifecycleRule in the database.
+func (_c *LifecycleRuleCreate) Save(ctx context.Context) (*LifecycleRule, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LifecycleRuleCreate) SaveX(ctx context.Context) *LifecycleRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LifecycleRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LifecycleRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LifecycleRuleCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if lifecyclerule.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized lifecyclerule.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := lifecyclerule.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if lifecyclerule.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized lifecyclerule.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := lifecyclerule.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := lifecyclerule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if lifecyclerule.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized lifecyclerule.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := lifecyclerule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LifecycleRuleCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "LifecycleRule.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "LifecycleRule.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "LifecycleRule.update_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "LifecycleRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := lifecyclerule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "LifecycleRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "LifecycleRule.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := lifecyclerule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MinAgeDays(); ok {
+		if err := lifecyclerule.MinAgeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "min_age_days", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.min_age_days": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MinIdleDays(); ok {
+		if err := lifecyclerule.MinIdleDaysValidator(v); err != nil {
+			return &ValidationError{Name: "min_idle_days", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.min_idle_days": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Tag(); ok {
+		if err := lifecyclerule.TagValidator(v); err != nil {
+			return &ValidationError{Name: "tag", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.tag": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MimeCategory(); ok {
+		if err := lifecyclerule.MimeCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "mime_category", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.mime_category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "LifecycleRule.created_by"`)}
+	}
+	return nil
+}
+
+func (_c *LifecycleRuleCreate) sqlSave(ctx context.Context) (*LifecycleRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LifecycleRuleCreate) createSpec() (*LifecycleRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LifecycleRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(lifecyclerule.Table, sqlgraph.NewFieldSpec(lifecyclerule.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(lifecyclerule.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(lifecyclerule.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(lifecyclerule.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(lifecyclerule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(lifecyclerule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(lifecyclerule.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.MinAgeDays(); ok {
+		_spec.SetField(lifecyclerule.FieldMinAgeDays, field.TypeInt, value)
+		_node.MinAgeDays = &value
+	}
+	if value, ok := _c.mutation.MinIdleDays(); ok {
+		_spec.SetField(lifecyclerule.FieldMinIdleDays, field.TypeInt, value)
+		_node.MinIdleDays = &value
+	}
+	if value, ok := _c.mutation.Tag(); ok {
+		_spec.SetField(lifecyclerule.FieldTag, field.TypeString, value)
+		_node.Tag = &value
+	}
+	if value, ok := _c.mutation.MimeCategory(); ok {
+		_spec.SetField(lifecyclerule.FieldMimeCategory, field.TypeString, value)
+		_node.MimeCategory = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(lifecyclerule.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(lifecyclerule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	return _node, _spec
+}
+
+// LifecycleRuleCreateBulk is the builder for creating many LifecycleRule entities in bulk.
+type LifecycleRuleCreateBulk struct {
+	config
+	err      error
+	builders []*LifecycleRuleCreate
+}
+
+// Save creates the LifecycleRule entities in the database.
+func (_c *LifecycleRuleCreateBulk) Save(ctx context.Context) ([]*LifecycleRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LifecycleRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LifecycleRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LifecycleRuleCreateBulk) SaveX(ctx context.Context) []*LifecycleRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LifecycleRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LifecycleRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
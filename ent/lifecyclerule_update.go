@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/lifecyclerule"
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LifecycleRuleUpdate is the builder for updating LifecycleRule entities.
+type LifecycleRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LifecycleRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LifecycleRuleUpdate builder.
+func (_u *LifecycleRuleUpdate) Where(ps ...predicate.LifecycleRule) *LifecycleRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LifecycleRuleUpdate) SetUpdateTime(v time.Time) *LifecycleRuleUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LifecycleRuleUpdate) SetName(v string) *LifecycleRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LifecycleRuleUpdate) SetNillableName(v *string) *LifecycleRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LifecycleRuleUpdate) SetEnabled(v bool) *LifecycleRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LifecycleRuleUpdate) SetNillableEnabled(v *bool) *LifecycleRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *LifecycleRuleUpdate) SetAction(v lifecyclerule.Action) *LifecycleRuleUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *LifecycleRuleUpdate) SetNillableAction(v *lifecyclerule.Action) *LifecycleRuleUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetMinAgeDays sets the "min_age_days" field.
+func (_u *LifecycleRuleUpdate) SetMinAgeDays(v int) *LifecycleRuleUpdate {
+	_u.mutation.ResetMinAgeDays()
+	_u.mutation.SetMinAgeDays(v)
+	return _u
+}
+
+// SetNillableMinAgeDays sets the "min_age_days" field if the given value is not nil.
+func (_u *LifecycleRuleUpdate) SetNillableMinAgeDays(v *int) *LifecycleRuleUpdate {
+	if v != nil {
+		_u.SetMinAgeDays(*v)
+	}
+	return _u
+}
+
+// AddMinAgeDays adds value to the "min_age_days" field.
+func (_u *LifecycleRuleUpdate) AddMinAgeDays(v int) *LifecycleRuleUpdate {
+	_u.mutation.AddMinAgeDays(v)
+	return _u
+}
+
+// ClearMinAgeDays clears the value of the "min_age_days" field.
+func (_u *LifecycleRuleUpdate) ClearMinAgeDays() *LifecycleRuleUpdate {
+	_u.mutation.ClearMinAgeDays()
+	return _u
+}
+
+// SetMinIdleDays sets the "min_idle_days" field.
+func (_u *LifecycleRuleUpdate) SetMinIdleDays(v int) *LifecycleRuleUpdate {
+	_u.mutation.ResetMinIdleDays()
+	_u.mutation.SetMinIdleDays(v)
+	return _u
+}
+
+// SetNillableMinIdleDays sets the "min_idle_days" field if the given value is not nil.
+func (_u *LifecycleRuleUpdate) SetNillableMinIdleDays(v *int) *LifecycleRuleUpdate {
+	if v != nil {
+		_u.SetMinIdleDays(*v)
+	}
+	return _u
+}
+
+// AddMinIdleDays adds value to the "min_idle_days" field.
+func (_u *LifecycleRuleUpdate) AddMinIdleDays(v int) *LifecycleRuleUpdate {
+	_u.mutation.AddMinIdleDays(v)
+	return _u
+}
+
+// ClearMinIdleDays clears the value of the "min_idle_days" field.
+func (_u *LifecycleRuleUpdate) ClearMinIdleDays() *LifecycleRuleUpdate {
+	_u.mutation.ClearMinIdleDays()
+	return _u
+}
+
+// SetTag sets the "tag" field.
+func (_u *LifecycleRuleUpdate) SetTag(v string) *LifecycleRuleUpdate {
+	_u.mutation.SetTag(v)
+	return _u
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (_u *LifecycleRuleUpdate) SetNillableTag(v *string) *LifecycleRuleUpdate {
+	if v != nil {
+		_u.SetTag(*v)
+	}
+	return _u
+}
+
+// ClearTag clears the value of the "tag" field.
+func (_u *LifecycleRuleUpdate) ClearTag() *LifecycleRuleUpdate {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// SetMimeCategory sets the "mime_category" field.
+func (_u *LifecycleRuleUpdate) SetMimeCategory(v string) *LifecycleRuleUpdate {
+	_u.mutation.SetMimeCategory(v)
+	return _u
+}
+
+// SetNillableMimeCategory sets the "mime_category" field if the given value is not nil.
+func (_u *LifecycleRuleUpdate) SetNillableMimeCategory(v *string) *LifecycleRuleUpdate {
+	if v != nil {
+		_u.SetMimeCategory(*v)
+	}
+	return _u
+}
+
+// ClearMimeCategory clears the value of the "mime_category" field.
+func (_u *LifecycleRuleUpdate) ClearMimeCategory() *LifecycleRuleUpdate {
+	_u.mutation.ClearMimeCategory()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *LifecycleRuleUpdate) SetLastRunAt(v time.Time) *LifecycleRuleUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *LifecycleRuleUpdate) SetNillableLastRunAt(v *time.Time) *LifecycleRuleUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *LifecycleRuleUpdate) ClearLastRunAt() *LifecycleRuleUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// Mutation returns the LifecycleRuleMutation object of the builder.
+func (_u *LifecycleRuleUpdate) Mutation() *LifecycleRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LifecycleRuleUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LifecycleRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LifecycleRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LifecycleRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LifecycleRuleUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if lifecyclerule.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized lifecyclerule.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := lifecyclerule.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LifecycleRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := lifecyclerule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := lifecyclerule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinAgeDays(); ok {
+		if err := lifecyclerule.MinAgeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "min_age_days", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.min_age_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinIdleDays(); ok {
+		if err := lifecyclerule.MinIdleDaysValidator(v); err != nil {
+			return &ValidationError{Name: "min_idle_days", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.min_idle_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tag(); ok {
+		if err := lifecyclerule.TagValidator(v); err != nil {
+			return &ValidationError{Name: "tag", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.tag": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MimeCategory(); ok {
+		if err := lifecyclerule.MimeCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "mime_category", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.mime_category": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifecycleRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifecycleRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LifecycleRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lifecyclerule.Table, lifecyclerule.Columns, sqlgraph.NewFieldSpec(lifecyclerule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(lifecyclerule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(lifecyclerule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(lifecyclerule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(lifecyclerule.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MinAgeDays(); ok {
+		_spec.SetField(lifecyclerule.FieldMinAgeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinAgeDays(); ok {
+		_spec.AddField(lifecyclerule.FieldMinAgeDays, field.TypeInt, value)
+	}
+	if _u.mutation.MinAgeDaysCleared() {
+		_spec.ClearField(lifecyclerule.FieldMinAgeDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MinIdleDays(); ok {
+		_spec.SetField(lifecyclerule.FieldMinIdleDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinIdleDays(); ok {
+		_spec.AddField(lifecyclerule.FieldMinIdleDays, field.TypeInt, value)
+	}
+	if _u.mutation.MinIdleDaysCleared() {
+		_spec.ClearField(lifecyclerule.FieldMinIdleDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Tag(); ok {
+		_spec.SetField(lifecyclerule.FieldTag, field.TypeString, value)
+	}
+	if _u.mutation.TagCleared() {
+		_spec.ClearField(lifecyclerule.FieldTag, field.TypeString)
+	}
+	if value, ok := _u.mutation.MimeCategory(); ok {
+		_spec.SetField(lifecyclerule.FieldMimeCategory, field.TypeString, value)
+	}
+	if _u.mutation.MimeCategoryCleared() {
+		_spec.ClearField(lifecyclerule.FieldMimeCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(lifecyclerule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(lifecyclerule.FieldLastRunAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lifecyclerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LifecycleRuleUpdateOne is the builder for updating a single LifecycleRule entity.
+type LifecycleRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LifecycleRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LifecycleRuleUpdateOne) SetUpdateTime(v time.Time) *LifecycleRuleUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *LifecycleRuleUpdateOne) SetName(v string) *LifecycleRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *LifecycleRuleUpdateOne) SetNillableName(v *string) *LifecycleRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *LifecycleRuleUpdateOne) SetEnabled(v bool) *LifecycleRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *LifecycleRuleUpdateOne) SetNillableEnabled(v *bool) *LifecycleRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *LifecycleRuleUpdateOne) SetAction(v lifecyclerule.Action) *LifecycleRuleUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *LifecycleRuleUpdateOne) SetNillableAction(v *lifecyclerule.Action) *LifecycleRuleUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetMinAgeDays sets the "min_age_days" field.
+func (_u *LifecycleRuleUpdateOne) SetMinAgeDays(v int) *LifecycleRuleUpdateOne {
+	_u.mutation.ResetMinAgeDays()
+	_u.mutation.SetMinAgeDays(v)
+	return _u
+}
+
+// SetNillableMinAgeDays sets the "min_age_days" field if the given value is not nil.
+func (_u *LifecycleRuleUpdateOne) SetNillableMinAgeDays(v *int) *LifecycleRuleUpdateOne {
+	if v != nil {
+		_u.SetMinAgeDays(*v)
+	}
+	return _u
+}
+
+// AddMinAgeDays adds value to the "min_age_days" field.
+func (_u *LifecycleRuleUpdateOne) AddMinAgeDays(v int) *LifecycleRuleUpdateOne {
+	_u.mutation.AddMinAgeDays(v)
+	return _u
+}
+
+// ClearMinAgeDays clears the value of the "min_age_days" field.
+func (_u *LifecycleRuleUpdateOne) ClearMinAgeDays() *LifecycleRuleUpdateOne {
+	_u.mutation.ClearMinAgeDays()
+	return _u
+}
+
+// SetMinIdleDays sets the "min_idle_days" field.
+func (_u *LifecycleRuleUpdateOne) SetMinIdleDays(v int) *LifecycleRuleUpdateOne {
+	_u.mutation.ResetMinIdleDays()
+	_u.mutation.SetMinIdleDays(v)
+	return _u
+}
+
+// SetNillableMinIdleDays sets the "min_idle_days" field if the given value is not nil.
+func (_u *LifecycleRuleUpdateOne) SetNillableMinIdleDays(v *int) *LifecycleRuleUpdateOne {
+	if v != nil {
+		_u.SetMinIdleDays(*v)
+	}
+	return _u
+}
+
+// AddMinIdleDays adds value to the "min_idle_days" field.
+func (_u *LifecycleRuleUpdateOne) AddMinIdleDays(v int) *LifecycleRuleUpdateOne {
+	_u.mutation.AddMinIdleDays(v)
+	return _u
+}
+
+// ClearMinIdleDays clears the value of the "min_idle_days" field.
+func (_u *LifecycleRuleUpdateOne) ClearMinIdleDays() *LifecycleRuleUpdateOne {
+	_u.mutation.ClearMinIdleDays()
+	return _u
+}
+
+// SetTag sets the "tag" field.
+func (_u *LifecycleRuleUpdateOne) SetTag(v string) *LifecycleRuleUpdateOne {
+	_u.mutation.SetTag(v)
+	return _u
+}
+
+// SetNillableTag sets the "tag" field if the given value is not nil.
+func (_u *LifecycleRuleUpdateOne) SetNillableTag(v *string) *LifecycleRuleUpdateOne {
+	if v != nil {
+		_u.SetTag(*v)
+	}
+	return _u
+}
+
+// ClearTag clears the value of the "tag" field.
+func (_u *LifecycleRuleUpdateOne) ClearTag() *LifecycleRuleUpdateOne {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// SetMimeCategory sets the "mime_category" field.
+func (_u *LifecycleRuleUpdateOne) SetMimeCategory(v string) *LifecycleRuleUpdateOne {
+	_u.mutation.SetMimeCategory(v)
+	return _u
+}
+
+// SetNillableMimeCategory sets the "mime_category" field if the given value is not nil.
+func (_u *LifecycleRuleUpdateOne) SetNillableMimeCategory(v *string) *LifecycleRuleUpdateOne {
+	if v != nil {
+		_u.SetMimeCategory(*v)
+	}
+	return _u
+}
+
+// ClearMimeCategory clears the value of the "mime_category" field.
+func (_u *LifecycleRuleUpdateOne) ClearMimeCategory() *LifecycleRuleUpdateOne {
+	_u.mutation.ClearMimeCategory()
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *LifecycleRuleUpdateOne) SetLastRunAt(v time.Time) *LifecycleRuleUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *LifecycleRuleUpdateOne) SetNillableLastRunAt(v *time.Time) *LifecycleRuleUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *LifecycleRuleUpdateOne) ClearLastRunAt() *LifecycleRuleUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// Mutation returns the LifecycleRuleMutation object of the builder.
+func (_u *LifecycleRuleUpdateOne) Mutation() *LifecycleRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LifecycleRuleUpdate builder.
+func (_u *LifecycleRuleUpdateOne) Where(ps ...predicate.LifecycleRule) *LifecycleRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LifecycleRuleUpdateOne) Select(field string, fields ...string) *LifecycleRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LifecycleRule entity.
+func (_u *LifecycleRuleUpdateOne) Save(ctx context.Context) (*LifecycleRule, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LifecycleRuleUpdateOne) SaveX(ctx context.Context) *LifecycleRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LifecycleRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LifecycleRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LifecycleRuleUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if lifecyclerule.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized lifecyclerule.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := lifecyclerule.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LifecycleRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := lifecyclerule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := lifecyclerule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinAgeDays(); ok {
+		if err := lifecyclerule.MinAgeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "min_age_days", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.min_age_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MinIdleDays(); ok {
+		if err := lifecyclerule.MinIdleDaysValidator(v); err != nil {
+			return &ValidationError{Name: "min_idle_days", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.min_idle_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Tag(); ok {
+		if err := lifecyclerule.TagValidator(v); err != nil {
+			return &ValidationError{Name: "tag", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.tag": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MimeCategory(); ok {
+		if err := lifecyclerule.MimeCategoryValidator(v); err != nil {
+			return &ValidationError{Name: "mime_category", err: fmt.Errorf(`ent: validator failed for field "LifecycleRule.mime_category": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LifecycleRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LifecycleRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LifecycleRuleUpdateOne) sqlSave(ctx context.Context) (_node *LifecycleRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lifecyclerule.Table, lifecyclerule.Columns, sqlgraph.NewFieldSpec(lifecyclerule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LifecycleRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lifecyclerule.FieldID)
+		for _, f := range fields {
+			if !lifecyclerule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lifecyclerule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(lifecyclerule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(lifecyclerule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(lifecyclerule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(lifecyclerule.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.MinAgeDays(); ok {
+		_spec.SetField(lifecyclerule.FieldMinAgeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinAgeDays(); ok {
+		_spec.AddField(lifecyclerule.FieldMinAgeDays, field.TypeInt, value)
+	}
+	if _u.mutation.MinAgeDaysCleared() {
+		_spec.ClearField(lifecyclerule.FieldMinAgeDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MinIdleDays(); ok {
+		_spec.SetField(lifecyclerule.FieldMinIdleDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinIdleDays(); ok {
+		_spec.AddField(lifecyclerule.FieldMinIdleDays, field.TypeInt, value)
+	}
+	if _u.mutation.MinIdleDaysCleared() {
+		_spec.ClearField(lifecyclerule.FieldMinIdleDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Tag(); ok {
+		_spec.SetField(lifecyclerule.FieldTag, field.TypeString, value)
+	}
+	if _u.mutation.TagCleared() {
+		_spec.ClearField(lifecyclerule.FieldTag, field.TypeString)
+	}
+	if value, ok := _u.mutation.MimeCategory(); ok {
+		_spec.SetField(lifecyclerule.FieldMimeCategory, field.TypeString, value)
+	}
+	if _u.mutation.MimeCategoryCleared() {
+		_spec.ClearField(lifecyclerule.FieldMimeCategory, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(lifecyclerule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(lifecyclerule.FieldLastRunAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LifecycleRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lifecyclerule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,8 @@
+package migrate
+
+import "embed"
+
+// MigrationFiles versioned Atlas migrations, embedded to compare the applied revision with the build on startup
+//
+//go:embed migrations/*.sql
+var MigrationFiles embed.FS
@@ -0,0 +1,24 @@
+-- Create "tenant_settings" table
+CREATE TABLE "tenant_settings" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "default_language" character varying(10) NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "tenantsetting_tenant_id" to table: "tenant_settings"
+CREATE UNIQUE INDEX "tenantsetting_tenant_id" ON "tenant_settings" ("tenant_id");
+-- Create "translation_overrides" table
+CREATE TABLE "translation_overrides" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "message_id" character varying NOT NULL,
+  "language" character varying(10) NOT NULL,
+  "text" text NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "translationoverride_tenant_id_message_id_language" to table: "translation_overrides"
+CREATE UNIQUE INDEX "translationoverride_tenant_id_message_id_language" ON "translation_overrides" ("tenant_id", "message_id", "language");
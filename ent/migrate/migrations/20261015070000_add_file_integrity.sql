@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "checksum_sha256" character varying(64) NULL, ADD COLUMN "integrity_status" character varying NOT NULL DEFAULT 'UNKNOWN', ADD COLUMN "integrity_checked_at" timestamptz NULL;
+-- Create index "file_integrity_checked_at" to table: "files"
+CREATE INDEX "file_integrity_checked_at" ON "files" ("integrity_checked_at");
@@ -0,0 +1,18 @@
+-- Create "file_audit_events" table
+CREATE TABLE "file_audit_events" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "action" character varying NOT NULL,
+  "file_id" uuid NULL,
+  "actor_id" uuid NULL,
+  "details" jsonb NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "fileauditevent_tenant_id_action_create_time" to table: "file_audit_events"
+CREATE INDEX "fileauditevent_tenant_id_action_create_time" ON "file_audit_events" ("tenant_id", "action", "create_time");
+-- Create index "fileauditevent_tenant_id_actor_id" to table: "file_audit_events"
+CREATE INDEX "fileauditevent_tenant_id_actor_id" ON "file_audit_events" ("tenant_id", "actor_id");
+-- Create index "fileauditevent_tenant_id_file_id" to table: "file_audit_events"
+CREATE INDEX "fileauditevent_tenant_id_file_id" ON "file_audit_events" ("tenant_id", "file_id");
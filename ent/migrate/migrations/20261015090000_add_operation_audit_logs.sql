@@ -0,0 +1,21 @@
+-- Create "operation_audit_logs" table
+CREATE TABLE "operation_audit_logs" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "operation_name" character varying(255) NOT NULL,
+  "variables" jsonb NULL,
+  "actor_id" uuid NULL,
+  "actor_role" character varying(50) NULL,
+  "success" boolean NOT NULL DEFAULT false,
+  "error_message" character varying(1000) NULL,
+  "duration_ms" bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY ("id")
+);
+-- Create index "operationauditlog_tenant_id_actor_id" to table: "operation_audit_logs"
+CREATE INDEX "operationauditlog_tenant_id_actor_id" ON "operation_audit_logs" ("tenant_id", "actor_id");
+-- Create index "operationauditlog_tenant_id_create_time" to table: "operation_audit_logs"
+CREATE INDEX "operationauditlog_tenant_id_create_time" ON "operation_audit_logs" ("tenant_id", "create_time");
+-- Create index "operationauditlog_tenant_id_operation_name" to table: "operation_audit_logs"
+CREATE INDEX "operationauditlog_tenant_id_operation_name" ON "operation_audit_logs" ("tenant_id", "operation_name");
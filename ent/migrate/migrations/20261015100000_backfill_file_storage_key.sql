@@ -0,0 +1,2 @@
+-- Backfill "storage_key" for legacy rows that only have the deprecated "path" set
+UPDATE "files" SET "storage_key" = "path" WHERE ("storage_key" IS NULL OR "storage_key" = '') AND "path" IS NOT NULL AND "path" <> '';
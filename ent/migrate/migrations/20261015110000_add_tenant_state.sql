@@ -0,0 +1,2 @@
+-- Modify "tenant_settings" table
+ALTER TABLE "tenant_settings" ADD COLUMN "state" character varying NOT NULL DEFAULT 'ACTIVE', ADD COLUMN "state_reason" character varying(500) NULL, ADD COLUMN "state_changed_at" timestamptz NULL;
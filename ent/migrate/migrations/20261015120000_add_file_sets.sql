@@ -0,0 +1,18 @@
+-- Create "file_sets" table
+CREATE TABLE "file_sets" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "created_by" uuid NOT NULL,
+  "name" character varying(255) NOT NULL,
+  "description" character varying(1000) NULL,
+  "file_ids" jsonb NOT NULL,
+  "shared_with_tenant" boolean NOT NULL DEFAULT false,
+  "shared_user_ids" jsonb NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "fileset_tenant_id_created_by_name" to table: "file_sets"
+CREATE UNIQUE INDEX "fileset_tenant_id_created_by_name" ON "file_sets" ("tenant_id", "created_by", "name");
+-- Create index "fileset_tenant_id_shared_with_tenant" to table: "file_sets"
+CREATE INDEX "fileset_tenant_id_shared_with_tenant" ON "file_sets" ("tenant_id", "shared_with_tenant");
@@ -0,0 +1,2 @@
+-- Modify "tenant_settings" table
+ALTER TABLE "tenant_settings" ADD COLUMN "quota_mode" character varying NOT NULL DEFAULT 'HARD', ADD COLUMN "quota_grace_percent" bigint NOT NULL DEFAULT 0;
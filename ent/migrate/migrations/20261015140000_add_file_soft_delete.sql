@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "deleted_at" timestamptz NULL;
+-- Create index "file_deleted_at" to table: "files"
+CREATE INDEX "file_deleted_at" ON "files" ("deleted_at");
@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "thumbnail_status" character varying NOT NULL DEFAULT 'NONE';
+-- Create index "file_thumbnail_status" to table: "files"
+CREATE INDEX "file_thumbnail_status" ON "files" ("thumbnail_status");
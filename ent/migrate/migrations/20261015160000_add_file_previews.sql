@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "preview_status" character varying NOT NULL DEFAULT 'NONE';
+-- Create index "file_preview_status" to table: "files"
+CREATE INDEX "file_preview_status" ON "files" ("preview_status");
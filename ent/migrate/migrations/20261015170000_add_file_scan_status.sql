@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "scan_status" character varying NOT NULL DEFAULT 'NONE';
+-- Create index "file_scan_status" to table: "files"
+CREATE INDEX "file_scan_status" ON "files" ("scan_status");
@@ -0,0 +1,2 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "detected_mime_type" character varying NULL;
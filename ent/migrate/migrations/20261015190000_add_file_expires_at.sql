@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "expires_at" timestamptz NULL;
+-- Create index "file_expires_at" to table: "files"
+CREATE INDEX "file_expires_at" ON "files" ("expires_at");
@@ -0,0 +1,16 @@
+-- Create "department_quotas" table
+CREATE TABLE "department_quotas" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "department_id" uuid NOT NULL,
+  "limit_bytes" bigint NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "departmentquota_tenant_id_department_id" to table: "department_quotas"
+CREATE UNIQUE INDEX "departmentquota_tenant_id_department_id" ON "department_quotas" ("tenant_id", "department_id");
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "department_id" uuid NULL;
+-- Create index "file_department_id" to table: "files"
+CREATE INDEX "file_department_id" ON "files" ("department_id");
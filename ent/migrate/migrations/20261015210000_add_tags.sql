@@ -0,0 +1,20 @@
+-- Create "tags" table
+CREATE TABLE "tags" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "name" character varying(50) NOT NULL,
+  "created_by" uuid NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "tag_tenant_id_name" to table: "tags"
+CREATE UNIQUE INDEX "tag_tenant_id_name" ON "tags" ("tenant_id", "name");
+-- Create "file_tags" table
+CREATE TABLE "file_tags" (
+  "file_id" uuid NOT NULL,
+  "tag_id" uuid NOT NULL,
+  PRIMARY KEY ("file_id", "tag_id"),
+  CONSTRAINT "file_tags_file_id" FOREIGN KEY ("file_id") REFERENCES "files" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "file_tags_tag_id" FOREIGN KEY ("tag_id") REFERENCES "tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "upload_source" character varying NOT NULL DEFAULT 'UNKNOWN', ADD COLUMN "client_version" character varying(64) NULL;
+-- Create index "file_upload_source" to table: "files"
+CREATE INDEX "file_upload_source" ON "files" ("upload_source");
@@ -0,0 +1,25 @@
+-- Create "lifecycle_rules" table
+CREATE TABLE "lifecycle_rules" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "name" character varying(100) NOT NULL,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "action" character varying NOT NULL,
+  "min_age_days" bigint NULL,
+  "min_idle_days" bigint NULL,
+  "tag" character varying(50) NULL,
+  "mime_category" character varying(20) NULL,
+  "created_by" uuid NOT NULL,
+  "last_run_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "lifecyclerule_enabled" to table: "lifecycle_rules"
+CREATE INDEX "lifecyclerule_enabled" ON "lifecycle_rules" ("enabled");
+-- Create index "lifecyclerule_tenant_id_name" to table: "lifecycle_rules"
+CREATE UNIQUE INDEX "lifecyclerule_tenant_id_name" ON "lifecycle_rules" ("tenant_id", "name");
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "last_accessed_at" timestamptz NULL, ADD COLUMN "archived_at" timestamptz NULL;
+-- Create index "file_archived_at" to table: "files"
+CREATE INDEX "file_archived_at" ON "files" ("archived_at");
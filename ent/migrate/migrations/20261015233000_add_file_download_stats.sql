@@ -0,0 +1,6 @@
+-- Modify "files" table
+ALTER TABLE "files" RENAME COLUMN "last_accessed_at" TO "last_downloaded_at";
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "download_count" bigint NOT NULL DEFAULT 0;
+-- Create index "file_download_count" to table: "files"
+CREATE INDEX "file_download_count" ON "files" ("download_count");
@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "entity_type" character varying NULL, ADD COLUMN "entity_id" uuid NULL;
+-- Create index "file_entity_type_entity_id" to table: "files"
+CREATE INDEX "file_entity_type_entity_id" ON "files" ("entity_type", "entity_id");
@@ -0,0 +1,2 @@
+-- Modify "tenant_settings" table
+ALTER TABLE "tenant_settings" ADD COLUMN "default_file_retention_days" bigint NULL;
@@ -0,0 +1,2 @@
+-- Modify "tenant_settings" table
+ALTER TABLE "tenant_settings" ADD COLUMN "filename_conflict_mode" character varying NOT NULL DEFAULT 'ALLOW';
@@ -0,0 +1,2 @@
+-- Create index "fileauditevent_tenant_id_create_time" to table: "file_audit_events"
+CREATE INDEX "fileauditevent_tenant_id_create_time" ON "file_audit_events" ("tenant_id", "create_time");
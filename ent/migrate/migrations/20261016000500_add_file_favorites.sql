@@ -0,0 +1,15 @@
+-- Create "file_favorites" table
+CREATE TABLE "file_favorites" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  "file_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "file_favorites_files_favorites" FOREIGN KEY ("file_id") REFERENCES "files" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "filefavorite_file_id_user_id" to table: "file_favorites"
+CREATE UNIQUE INDEX "filefavorite_file_id_user_id" ON "file_favorites" ("file_id", "user_id");
+-- Create index "filefavorite_tenant_id_user_id" to table: "file_favorites"
+CREATE INDEX "filefavorite_tenant_id_user_id" ON "file_favorites" ("tenant_id", "user_id");
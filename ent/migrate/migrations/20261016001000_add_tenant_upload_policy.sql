@@ -0,0 +1,2 @@
+-- Modify "tenant_settings" table
+ALTER TABLE "tenant_settings" ADD COLUMN "require_upload_description" boolean NOT NULL DEFAULT false, ADD COLUMN "upload_description_template" character varying(1000) NULL, ADD COLUMN "upload_classification_tags" jsonb NULL;
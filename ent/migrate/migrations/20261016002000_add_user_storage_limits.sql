@@ -0,0 +1,13 @@
+-- Create "user_storage_limits" table
+CREATE TABLE "user_storage_limits" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  "max_files" bigint NULL,
+  "max_bytes" bigint NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "userstoragelimit_tenant_id_user_id" to table: "user_storage_limits"
+CREATE UNIQUE INDEX "userstoragelimit_tenant_id_user_id" ON "user_storage_limits" ("tenant_id", "user_id");
@@ -0,0 +1,25 @@
+-- Create "impersonation_grants" table
+CREATE TABLE "impersonation_grants" (
+  "id" uuid NOT NULL,
+  "tenant_id" uuid NOT NULL,
+  "create_time" timestamptz NOT NULL,
+  "update_time" timestamptz NOT NULL,
+  "support_user_id" uuid NOT NULL,
+  "target_user_id" uuid NOT NULL,
+  "target_role" character varying NOT NULL,
+  "target_department_ids" jsonb NULL,
+  "granted_by" uuid NOT NULL,
+  "reason" character varying(500) NOT NULL,
+  "token_hash" character varying NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "revoked_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "impersonationgrant_tenant_id_create_time" to table: "impersonation_grants"
+CREATE INDEX "impersonationgrant_tenant_id_create_time" ON "impersonation_grants" ("tenant_id", "create_time");
+-- Create index "impersonationgrant_token_hash" to table: "impersonation_grants"
+CREATE UNIQUE INDEX "impersonationgrant_token_hash" ON "impersonation_grants" ("token_hash");
+-- Modify "file_audit_events" table
+ALTER TABLE "file_audit_events" ADD COLUMN "impersonator_id" uuid NULL;
+-- Modify "operation_audit_logs" table
+ALTER TABLE "operation_audit_logs" ADD COLUMN "impersonator_id" uuid NULL;
@@ -0,0 +1,2 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "locked_by" uuid NULL, ADD COLUMN "locked_at" timestamptz NULL;
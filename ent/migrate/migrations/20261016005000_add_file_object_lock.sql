@@ -0,0 +1,2 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "object_lock_mode" character varying NULL, ADD COLUMN "object_lock_retain_until" timestamptz NULL, ADD COLUMN "legal_hold" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "tenant_settings" table
+ALTER TABLE "tenant_settings" ADD COLUMN "require_pdf_watermark" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "tenant_settings" table
+ALTER TABLE "tenant_settings" ADD COLUMN "upload_allowed_extensions" jsonb NULL, ADD COLUMN "upload_blocked_extensions" jsonb NULL, ADD COLUMN "upload_allowed_mime_types" jsonb NULL, ADD COLUMN "upload_blocked_mime_types" jsonb NULL;
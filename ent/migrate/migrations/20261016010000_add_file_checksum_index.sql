@@ -0,0 +1,2 @@
+-- Create index "file_checksum_sha256" to table: "files"
+CREATE INDEX "file_checksum_sha256" ON "files" ("checksum_sha256");
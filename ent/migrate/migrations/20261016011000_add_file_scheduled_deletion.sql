@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "scheduled_deletion_at" timestamptz NULL, ADD COLUMN "scheduled_deletion_by" uuid NULL;
+-- Create index "file_scheduled_deletion_at" to table: "files"
+CREATE INDEX "file_scheduled_deletion_at" ON "files" ("scheduled_deletion_at");
@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "pdf_status" character varying NOT NULL DEFAULT 'NONE';
+-- Create index "file_pdf_status" to table: "files"
+CREATE INDEX "file_pdf_status" ON "files" ("pdf_status");
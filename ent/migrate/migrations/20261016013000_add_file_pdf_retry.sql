@@ -0,0 +1,2 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "pdf_attempts" bigint NOT NULL DEFAULT 0, ADD COLUMN "pdf_retry_at" timestamptz NULL;
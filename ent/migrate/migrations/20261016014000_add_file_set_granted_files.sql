@@ -0,0 +1,2 @@
+-- Modify "file_sets" table
+ALTER TABLE "file_sets" ADD COLUMN "granted_file_ids" jsonb NULL;
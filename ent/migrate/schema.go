@@ -9,20 +9,69 @@ import (
 )
 
 var (
+	// DepartmentQuotasColumns holds the columns for the "department_quotas" table.
+	DepartmentQuotasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "department_id", Type: field.TypeUUID},
+		{Name: "limit_bytes", Type: field.TypeInt64},
+	}
+	// DepartmentQuotasTable holds the schema information for the "department_quotas" table.
+	DepartmentQuotasTable = &schema.Table{
+		Name:       "department_quotas",
+		Columns:    DepartmentQuotasColumns,
+		PrimaryKey: []*schema.Column{DepartmentQuotasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "departmentquota_tenant_id_department_id",
+				Unique:  true,
+				Columns: []*schema.Column{DepartmentQuotasColumns[1], DepartmentQuotasColumns[4]},
+			},
+		},
+	}
 	// FilesColumns holds the columns for the "files" table.
 	FilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "tenant_id", Type: field.TypeUUID},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_by", Type: field.TypeUUID},
 		{Name: "original_name", Type: field.TypeString},
 		{Name: "storage_key", Type: field.TypeString},
 		{Name: "mime_type", Type: field.TypeString},
+		{Name: "detected_mime_type", Type: field.TypeString, Nullable: true},
 		{Name: "size", Type: field.TypeInt64},
 		{Name: "path", Type: field.TypeString, Nullable: true},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "checksum_sha256", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "integrity_status", Type: field.TypeEnum, Enums: []string{"UNKNOWN", "OK", "CORRUPTED", "MISSING"}, Default: "UNKNOWN"},
+		{Name: "integrity_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "thumbnail_status", Type: field.TypeEnum, Enums: []string{"NONE", "PENDING", "READY", "FAILED"}, Default: "NONE"},
+		{Name: "preview_status", Type: field.TypeEnum, Enums: []string{"NONE", "PENDING", "READY", "FAILED"}, Default: "NONE"},
+		{Name: "pdf_status", Type: field.TypeEnum, Enums: []string{"NONE", "PENDING", "READY", "FAILED"}, Default: "NONE"},
+		{Name: "pdf_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "pdf_retry_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scan_status", Type: field.TypeEnum, Enums: []string{"NONE", "PENDING", "CLEAN", "INFECTED"}, Default: "NONE"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "department_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "upload_source", Type: field.TypeEnum, Enums: []string{"UNKNOWN", "WEB", "MOBILE", "API_TOKEN", "EMAIL_IN", "IMPORT"}, Default: "UNKNOWN"},
+		{Name: "client_version", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "download_count", Type: field.TypeInt64, Default: 0},
+		{Name: "last_downloaded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
+		{Name: "entity_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"TICKET", "COMMENT", "MESSAGE"}},
+		{Name: "entity_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "locked_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "locked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "object_lock_mode", Type: field.TypeEnum, Nullable: true, Enums: []string{"GOVERNANCE", "COMPLIANCE"}},
+		{Name: "object_lock_retain_until", Type: field.TypeTime, Nullable: true},
+		{Name: "legal_hold", Type: field.TypeBool, Default: false},
+		{Name: "scheduled_deletion_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scheduled_deletion_by", Type: field.TypeUUID, Nullable: true},
 	}
 	// FilesTable holds the schema information for the "files" table.
 	FilesTable = &schema.Table{
@@ -33,18 +82,482 @@ var (
 			{
 				Name:    "file_storage_key",
 				Unique:  true,
-				Columns: []*schema.Column{FilesColumns[6]},
+				Columns: []*schema.Column{FilesColumns[7]},
+			},
+			{
+				Name:    "file_integrity_checked_at",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[16]},
+			},
+			{
+				Name:    "file_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[4]},
+			},
+			{
+				Name:    "file_thumbnail_status",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[17]},
+			},
+			{
+				Name:    "file_preview_status",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[18]},
+			},
+			{
+				Name:    "file_pdf_status",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[19]},
+			},
+			{
+				Name:    "file_scan_status",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[22]},
+			},
+			{
+				Name:    "file_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[23]},
+			},
+			{
+				Name:    "file_department_id",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[24]},
+			},
+			{
+				Name:    "file_upload_source",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[25]},
+			},
+			{
+				Name:    "file_archived_at",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[29]},
+			},
+			{
+				Name:    "file_download_count",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[27]},
+			},
+			{
+				Name:    "file_checksum_sha256",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[14]},
+			},
+			{
+				Name:    "file_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[30], FilesColumns[31]},
+			},
+			{
+				Name:    "file_scheduled_deletion_at",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[37]},
+			},
+		},
+	}
+	// FileAuditEventsColumns holds the columns for the "file_audit_events" table.
+	FileAuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"UPLOAD", "DELETE", "RENAME", "UPDATE", "URL_GENERATED", "BATCH_DOWNLOAD", "SHARE_CREATED", "LIMIT_VIOLATION", "INTEGRITY_FAILURE", "QUOTA_EXCEEDED", "RESTORE", "PURGE", "RETENANT", "MALWARE_DETECTED", "MALWARE_DOWNLOAD_BLOCKED", "LIFECYCLE_NOTICE", "COPY", "MOVE", "CONTENT_STREAMED", "IMPERSONATION_GRANTED", "IMPERSONATION_REVOKED", "FILE_LOCKED", "FILE_UNLOCKED", "OBJECT_LOCK_UPDATED", "FILE_TYPE_BLOCKED", "DELETION_SCHEDULED", "DELETION_CANCELED"}},
+		{Name: "file_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "impersonator_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+	}
+	// FileAuditEventsTable holds the schema information for the "file_audit_events" table.
+	FileAuditEventsTable = &schema.Table{
+		Name:       "file_audit_events",
+		Columns:    FileAuditEventsColumns,
+		PrimaryKey: []*schema.Column{FileAuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fileauditevent_tenant_id_action_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{FileAuditEventsColumns[1], FileAuditEventsColumns[4], FileAuditEventsColumns[2]},
+			},
+			{
+				Name:    "fileauditevent_tenant_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{FileAuditEventsColumns[1], FileAuditEventsColumns[2]},
+			},
+			{
+				Name:    "fileauditevent_tenant_id_file_id",
+				Unique:  false,
+				Columns: []*schema.Column{FileAuditEventsColumns[1], FileAuditEventsColumns[5]},
+			},
+			{
+				Name:    "fileauditevent_tenant_id_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{FileAuditEventsColumns[1], FileAuditEventsColumns[6]},
+			},
+		},
+	}
+	// FileFavoritesColumns holds the columns for the "file_favorites" table.
+	FileFavoritesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "file_id", Type: field.TypeUUID},
+	}
+	// FileFavoritesTable holds the schema information for the "file_favorites" table.
+	FileFavoritesTable = &schema.Table{
+		Name:       "file_favorites",
+		Columns:    FileFavoritesColumns,
+		PrimaryKey: []*schema.Column{FileFavoritesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "file_favorites_files_favorites",
+				Columns:    []*schema.Column{FileFavoritesColumns[5]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "filefavorite_file_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{FileFavoritesColumns[5], FileFavoritesColumns[4]},
+			},
+			{
+				Name:    "filefavorite_tenant_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{FileFavoritesColumns[1], FileFavoritesColumns[4]},
+			},
+		},
+	}
+	// FileSetsColumns holds the columns for the "file_sets" table.
+	FileSetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "file_ids", Type: field.TypeJSON},
+		{Name: "shared_with_tenant", Type: field.TypeBool, Default: false},
+		{Name: "shared_user_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "granted_file_ids", Type: field.TypeJSON, Nullable: true},
+	}
+	// FileSetsTable holds the schema information for the "file_sets" table.
+	FileSetsTable = &schema.Table{
+		Name:       "file_sets",
+		Columns:    FileSetsColumns,
+		PrimaryKey: []*schema.Column{FileSetsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fileset_tenant_id_created_by_name",
+				Unique:  true,
+				Columns: []*schema.Column{FileSetsColumns[1], FileSetsColumns[4], FileSetsColumns[5]},
+			},
+			{
+				Name:    "fileset_tenant_id_shared_with_tenant",
+				Unique:  false,
+				Columns: []*schema.Column{FileSetsColumns[1], FileSetsColumns[8]},
+			},
+		},
+	}
+	// ImpersonationGrantsColumns holds the columns for the "impersonation_grants" table.
+	ImpersonationGrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "support_user_id", Type: field.TypeUUID},
+		{Name: "target_user_id", Type: field.TypeUUID},
+		{Name: "target_role", Type: field.TypeEnum, Enums: []string{"MEMBER", "CLIENT"}},
+		{Name: "target_department_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "granted_by", Type: field.TypeUUID},
+		{Name: "reason", Type: field.TypeString, Size: 500},
+		{Name: "token_hash", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ImpersonationGrantsTable holds the schema information for the "impersonation_grants" table.
+	ImpersonationGrantsTable = &schema.Table{
+		Name:       "impersonation_grants",
+		Columns:    ImpersonationGrantsColumns,
+		PrimaryKey: []*schema.Column{ImpersonationGrantsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "impersonationgrant_token_hash",
+				Unique:  true,
+				Columns: []*schema.Column{ImpersonationGrantsColumns[10]},
+			},
+			{
+				Name:    "impersonationgrant_tenant_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ImpersonationGrantsColumns[1], ImpersonationGrantsColumns[2]},
+			},
+		},
+	}
+	// LifecycleRulesColumns holds the columns for the "lifecycle_rules" table.
+	LifecycleRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"ARCHIVE", "EXPIRE", "NOTIFY"}},
+		{Name: "min_age_days", Type: field.TypeInt, Nullable: true},
+		{Name: "min_idle_days", Type: field.TypeInt, Nullable: true},
+		{Name: "tag", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "mime_category", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+	}
+	// LifecycleRulesTable holds the schema information for the "lifecycle_rules" table.
+	LifecycleRulesTable = &schema.Table{
+		Name:       "lifecycle_rules",
+		Columns:    LifecycleRulesColumns,
+		PrimaryKey: []*schema.Column{LifecycleRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lifecyclerule_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{LifecycleRulesColumns[1], LifecycleRulesColumns[4]},
+			},
+			{
+				Name:    "lifecyclerule_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{LifecycleRulesColumns[5]},
+			},
+		},
+	}
+	// OperationAuditLogsColumns holds the columns for the "operation_audit_logs" table.
+	OperationAuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "operation_name", Type: field.TypeString, Size: 255},
+		{Name: "variables", Type: field.TypeJSON, Nullable: true},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "impersonator_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "actor_role", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "success", Type: field.TypeBool, Default: false},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+	}
+	// OperationAuditLogsTable holds the schema information for the "operation_audit_logs" table.
+	OperationAuditLogsTable = &schema.Table{
+		Name:       "operation_audit_logs",
+		Columns:    OperationAuditLogsColumns,
+		PrimaryKey: []*schema.Column{OperationAuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "operationauditlog_tenant_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{OperationAuditLogsColumns[1], OperationAuditLogsColumns[2]},
+			},
+			{
+				Name:    "operationauditlog_tenant_id_operation_name",
+				Unique:  false,
+				Columns: []*schema.Column{OperationAuditLogsColumns[1], OperationAuditLogsColumns[4]},
+			},
+			{
+				Name:    "operationauditlog_tenant_id_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{OperationAuditLogsColumns[1], OperationAuditLogsColumns[6]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 50},
+		{Name: "created_by", Type: field.TypeUUID},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[1], TagsColumns[4]},
+			},
+		},
+	}
+	// TenantSettingsColumns holds the columns for the "tenant_settings" table.
+	TenantSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "default_language", Type: field.TypeString, Nullable: true, Size: 10},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"ACTIVE", "SUSPENDED", "OFFBOARDING"}, Default: "ACTIVE"},
+		{Name: "state_reason", Type: field.TypeString, Nullable: true, Size: 500},
+		{Name: "state_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "quota_mode", Type: field.TypeEnum, Enums: []string{"HARD", "SOFT", "GRACE"}, Default: "HARD"},
+		{Name: "quota_grace_percent", Type: field.TypeInt, Default: 0},
+		{Name: "storage_limit_bytes", Type: field.TypeInt64, Nullable: true},
+		{Name: "default_file_retention_days", Type: field.TypeInt, Nullable: true},
+		{Name: "filename_conflict_mode", Type: field.TypeEnum, Enums: []string{"ALLOW", "WARN", "RENAME"}, Default: "ALLOW"},
+		{Name: "require_upload_description", Type: field.TypeBool, Default: false},
+		{Name: "upload_description_template", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "upload_classification_tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "image_metadata_mode", Type: field.TypeEnum, Enums: []string{"KEEP", "STRIP", "STRIP_PRESERVE_ORIGINAL"}, Default: "KEEP"},
+		{Name: "require_pdf_watermark", Type: field.TypeBool, Default: false},
+		{Name: "max_upload_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "upload_allowed_extensions", Type: field.TypeJSON, Nullable: true},
+		{Name: "upload_blocked_extensions", Type: field.TypeJSON, Nullable: true},
+		{Name: "upload_allowed_mime_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "upload_blocked_mime_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "file_metadata_schemas", Type: field.TypeJSON, Nullable: true},
+	}
+	// TenantSettingsTable holds the schema information for the "tenant_settings" table.
+	TenantSettingsTable = &schema.Table{
+		Name:       "tenant_settings",
+		Columns:    TenantSettingsColumns,
+		PrimaryKey: []*schema.Column{TenantSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantsetting_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{TenantSettingsColumns[1]},
+			},
+		},
+	}
+	// TranslationOverridesColumns holds the columns for the "translation_overrides" table.
+	TranslationOverridesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "language", Type: field.TypeString, Size: 10},
+		{Name: "text", Type: field.TypeString, Size: 2147483647},
+	}
+	// TranslationOverridesTable holds the schema information for the "translation_overrides" table.
+	TranslationOverridesTable = &schema.Table{
+		Name:       "translation_overrides",
+		Columns:    TranslationOverridesColumns,
+		PrimaryKey: []*schema.Column{TranslationOverridesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "translationoverride_tenant_id_message_id_language",
+				Unique:  true,
+				Columns: []*schema.Column{TranslationOverridesColumns[1], TranslationOverridesColumns[4], TranslationOverridesColumns[5]},
+			},
+		},
+	}
+	// UserStorageLimitsColumns holds the columns for the "user_storage_limits" table.
+	UserStorageLimitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tenant_id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "max_files", Type: field.TypeInt, Nullable: true},
+		{Name: "max_bytes", Type: field.TypeInt64, Nullable: true},
+	}
+	// UserStorageLimitsTable holds the schema information for the "user_storage_limits" table.
+	UserStorageLimitsTable = &schema.Table{
+		Name:       "user_storage_limits",
+		Columns:    UserStorageLimitsColumns,
+		PrimaryKey: []*schema.Column{UserStorageLimitsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userstoragelimit_tenant_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{UserStorageLimitsColumns[1], UserStorageLimitsColumns[4]},
+			},
+		},
+	}
+	// FileTagsColumns holds the columns for the "file_tags" table.
+	FileTagsColumns = []*schema.Column{
+		{Name: "file_id", Type: field.TypeUUID},
+		{Name: "tag_id", Type: field.TypeUUID},
+	}
+	// FileTagsTable holds the schema information for the "file_tags" table.
+	FileTagsTable = &schema.Table{
+		Name:       "file_tags",
+		Columns:    FileTagsColumns,
+		PrimaryKey: []*schema.Column{FileTagsColumns[0], FileTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "file_tags_file_id",
+				Columns:    []*schema.Column{FileTagsColumns[0]},
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "file_tags_tag_id",
+				Columns:    []*schema.Column{FileTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		DepartmentQuotasTable,
 		FilesTable,
+		FileAuditEventsTable,
+		FileFavoritesTable,
+		FileSetsTable,
+		ImpersonationGrantsTable,
+		LifecycleRulesTable,
+		OperationAuditLogsTable,
+		TagsTable,
+		TenantSettingsTable,
+		TranslationOverridesTable,
+		UserStorageLimitsTable,
+		FileTagsTable,
 	}
 )
 
 func init() {
+	DepartmentQuotasTable.Annotation = &entsql.Annotation{
+		Table: "department_quotas",
+	}
 	FilesTable.Annotation = &entsql.Annotation{
 		Table: "files",
 	}
+	FileAuditEventsTable.Annotation = &entsql.Annotation{
+		Table: "file_audit_events",
+	}
+	FileFavoritesTable.ForeignKeys[0].RefTable = FilesTable
+	FileFavoritesTable.Annotation = &entsql.Annotation{
+		Table: "file_favorites",
+	}
+	FileSetsTable.Annotation = &entsql.Annotation{
+		Table: "file_sets",
+	}
+	ImpersonationGrantsTable.Annotation = &entsql.Annotation{
+		Table: "impersonation_grants",
+	}
+	LifecycleRulesTable.Annotation = &entsql.Annotation{
+		Table: "lifecycle_rules",
+	}
+	OperationAuditLogsTable.Annotation = &entsql.Annotation{
+		Table: "operation_audit_logs",
+	}
+	TagsTable.Annotation = &entsql.Annotation{
+		Table: "tags",
+	}
+	TenantSettingsTable.Annotation = &entsql.Annotation{
+		Table: "tenant_settings",
+	}
+	TranslationOverridesTable.Annotation = &entsql.Annotation{
+		Table: "translation_overrides",
+	}
+	UserStorageLimitsTable.Annotation = &entsql.Annotation{
+		Table: "user_storage_limits",
+	}
+	FileTagsTable.ForeignKeys[0].RefTable = FilesTable
+	FileTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
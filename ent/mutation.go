@@ -6,8 +6,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"main/ent/departmentquota"
 	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/filefavorite"
+	"main/ent/fileset"
+	"main/ent/impersonationgrant"
+	"main/ent/lifecyclerule"
+	"main/ent/operationauditlog"
 	"main/ent/predicate"
+	"main/ent/schema/filemeta"
+	"main/ent/tag"
+	"main/ent/tenantsetting"
+	"main/ent/translationoverride"
+	"main/ent/userstoragelimit"
 	"sync"
 	"time"
 
@@ -25,44 +37,49 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeFile = "File"
+	TypeDepartmentQuota     = "DepartmentQuota"
+	TypeFile                = "File"
+	TypeFileAuditEvent      = "FileAuditEvent"
+	TypeFileFavorite        = "FileFavorite"
+	TypeFileSet             = "FileSet"
+	TypeImpersonationGrant  = "ImpersonationGrant"
+	TypeLifecycleRule       = "LifecycleRule"
+	TypeOperationAuditLog   = "OperationAuditLog"
+	TypeTag                 = "Tag"
+	TypeTenantSetting       = "TenantSetting"
+	TypeTranslationOverride = "TranslationOverride"
+	TypeUserStorageLimit    = "UserStorageLimit"
 )
 
-// FileMutation represents an operation that mutates the File nodes in the graph.
-type FileMutation struct {
+// DepartmentQuotaMutation represents an operation that mutates the DepartmentQuota nodes in the graph.
+type DepartmentQuotaMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	tenant_id     *uuid.UUID
-	create_time   *time.Time
-	update_time   *time.Time
-	created_by    *uuid.UUID
-	original_name *string
-	storage_key   *string
-	mime_type     *string
-	size          *int64
-	addsize       *int64
-	_path         *string
-	description   *string
-	metadata      *map[string]interface{}
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*File, error)
-	predicates    []predicate.File
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	tenant_id      *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	department_id  *uuid.UUID
+	limit_bytes    *int64
+	addlimit_bytes *int64
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*DepartmentQuota, error)
+	predicates     []predicate.DepartmentQuota
 }
 
-var _ ent.Mutation = (*FileMutation)(nil)
+var _ ent.Mutation = (*DepartmentQuotaMutation)(nil)
 
-// fileOption allows management of the mutation configuration using functional options.
-type fileOption func(*FileMutation)
+// departmentquotaOption allows management of the mutation configuration using functional options.
+type departmentquotaOption func(*DepartmentQuotaMutation)
 
-// newFileMutation creates new mutation for the File entity.
-func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
-	m := &FileMutation{
+// newDepartmentQuotaMutation creates new mutation for the DepartmentQuota entity.
+func newDepartmentQuotaMutation(c config, op Op, opts ...departmentquotaOption) *DepartmentQuotaMutation {
+	m := &DepartmentQuotaMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFile,
+		typ:           TypeDepartmentQuota,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -71,20 +88,20 @@ func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
 	return m
 }
 
-// withFileID sets the ID field of the mutation.
-func withFileID(id uuid.UUID) fileOption {
-	return func(m *FileMutation) {
+// withDepartmentQuotaID sets the ID field of the mutation.
+func withDepartmentQuotaID(id uuid.UUID) departmentquotaOption {
+	return func(m *DepartmentQuotaMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *File
+			value *DepartmentQuota
 		)
-		m.oldValue = func(ctx context.Context) (*File, error) {
+		m.oldValue = func(ctx context.Context) (*DepartmentQuota, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().File.Get(ctx, id)
+					value, err = m.Client().DepartmentQuota.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -93,10 +110,10 @@ func withFileID(id uuid.UUID) fileOption {
 	}
 }
 
-// withFile sets the old File of the mutation.
-func withFile(node *File) fileOption {
-	return func(m *FileMutation) {
-		m.oldValue = func(context.Context) (*File, error) {
+// withDepartmentQuota sets the old DepartmentQuota of the mutation.
+func withDepartmentQuota(node *DepartmentQuota) departmentquotaOption {
+	return func(m *DepartmentQuotaMutation) {
+		m.oldValue = func(context.Context) (*DepartmentQuota, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -105,7 +122,7 @@ func withFile(node *File) fileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileMutation) Client() *Client {
+func (m DepartmentQuotaMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -113,7 +130,7 @@ func (m FileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FileMutation) Tx() (*Tx, error) {
+func (m DepartmentQuotaMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -123,14 +140,14 @@ func (m FileMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of File entities.
-func (m *FileMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of DepartmentQuota entities.
+func (m *DepartmentQuotaMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FileMutation) ID() (id uuid.UUID, exists bool) {
+func (m *DepartmentQuotaMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -141,7 +158,7 @@ func (m *FileMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *DepartmentQuotaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -150,19 +167,19 @@ func (m *FileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DepartmentQuota.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *FileMutation) SetTenantID(u uuid.UUID) {
+func (m *DepartmentQuotaMutation) SetTenantID(u uuid.UUID) {
 	m.tenant_id = &u
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *FileMutation) TenantID() (r uuid.UUID, exists bool) {
+func (m *DepartmentQuotaMutation) TenantID() (r uuid.UUID, exists bool) {
 	v := m.tenant_id
 	if v == nil {
 		return
@@ -170,10 +187,10 @@ func (m *FileMutation) TenantID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the DepartmentQuota entity.
+// If the DepartmentQuota object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *DepartmentQuotaMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -188,17 +205,17 @@ func (m *FileMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error)
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *FileMutation) ResetTenantID() {
+func (m *DepartmentQuotaMutation) ResetTenantID() {
 	m.tenant_id = nil
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *FileMutation) SetCreateTime(t time.Time) {
+func (m *DepartmentQuotaMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *FileMutation) CreateTime() (r time.Time, exists bool) {
+func (m *DepartmentQuotaMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -206,10 +223,10 @@ func (m *FileMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the DepartmentQuota entity.
+// If the DepartmentQuota object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *DepartmentQuotaMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -224,17 +241,17 @@ func (m *FileMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *FileMutation) ResetCreateTime() {
+func (m *DepartmentQuotaMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *FileMutation) SetUpdateTime(t time.Time) {
+func (m *DepartmentQuotaMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *FileMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *DepartmentQuotaMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -242,10 +259,10 @@ func (m *FileMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the DepartmentQuota entity.
+// If the DepartmentQuota object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *DepartmentQuotaMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -260,213 +277,936 @@ func (m *FileMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *FileMutation) ResetUpdateTime() {
+func (m *DepartmentQuotaMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *FileMutation) SetCreatedBy(u uuid.UUID) {
-	m.created_by = &u
+// SetDepartmentID sets the "department_id" field.
+func (m *DepartmentQuotaMutation) SetDepartmentID(u uuid.UUID) {
+	m.department_id = &u
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *FileMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.created_by
+// DepartmentID returns the value of the "department_id" field in the mutation.
+func (m *DepartmentQuotaMutation) DepartmentID() (r uuid.UUID, exists bool) {
+	v := m.department_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldDepartmentID returns the old "department_id" field's value of the DepartmentQuota entity.
+// If the DepartmentQuota object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
+func (m *DepartmentQuotaMutation) OldDepartmentID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldDepartmentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldDepartmentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldDepartmentID: %w", err)
 	}
-	return oldValue.CreatedBy, nil
+	return oldValue.DepartmentID, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *FileMutation) ResetCreatedBy() {
-	m.created_by = nil
+// ResetDepartmentID resets all changes to the "department_id" field.
+func (m *DepartmentQuotaMutation) ResetDepartmentID() {
+	m.department_id = nil
 }
 
-// SetOriginalName sets the "original_name" field.
-func (m *FileMutation) SetOriginalName(s string) {
-	m.original_name = &s
+// SetLimitBytes sets the "limit_bytes" field.
+func (m *DepartmentQuotaMutation) SetLimitBytes(i int64) {
+	m.limit_bytes = &i
+	m.addlimit_bytes = nil
 }
 
-// OriginalName returns the value of the "original_name" field in the mutation.
-func (m *FileMutation) OriginalName() (r string, exists bool) {
-	v := m.original_name
+// LimitBytes returns the value of the "limit_bytes" field in the mutation.
+func (m *DepartmentQuotaMutation) LimitBytes() (r int64, exists bool) {
+	v := m.limit_bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOriginalName returns the old "original_name" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldLimitBytes returns the old "limit_bytes" field's value of the DepartmentQuota entity.
+// If the DepartmentQuota object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldOriginalName(ctx context.Context) (v string, err error) {
+func (m *DepartmentQuotaMutation) OldLimitBytes(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOriginalName is only allowed on UpdateOne operations")
+		return v, errors.New("OldLimitBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOriginalName requires an ID field in the mutation")
+		return v, errors.New("OldLimitBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOriginalName: %w", err)
+		return v, fmt.Errorf("querying old value for OldLimitBytes: %w", err)
 	}
-	return oldValue.OriginalName, nil
-}
-
-// ResetOriginalName resets all changes to the "original_name" field.
-func (m *FileMutation) ResetOriginalName() {
-	m.original_name = nil
+	return oldValue.LimitBytes, nil
 }
 
-// SetStorageKey sets the "storage_key" field.
-func (m *FileMutation) SetStorageKey(s string) {
-	m.storage_key = &s
+// AddLimitBytes adds i to the "limit_bytes" field.
+func (m *DepartmentQuotaMutation) AddLimitBytes(i int64) {
+	if m.addlimit_bytes != nil {
+		*m.addlimit_bytes += i
+	} else {
+		m.addlimit_bytes = &i
+	}
 }
 
-// StorageKey returns the value of the "storage_key" field in the mutation.
-func (m *FileMutation) StorageKey() (r string, exists bool) {
-	v := m.storage_key
+// AddedLimitBytes returns the value that was added to the "limit_bytes" field in this mutation.
+func (m *DepartmentQuotaMutation) AddedLimitBytes() (r int64, exists bool) {
+	v := m.addlimit_bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStorageKey returns the old "storage_key" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldStorageKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStorageKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
-	}
-	return oldValue.StorageKey, nil
-}
-
-// ResetStorageKey resets all changes to the "storage_key" field.
-func (m *FileMutation) ResetStorageKey() {
-	m.storage_key = nil
+// ResetLimitBytes resets all changes to the "limit_bytes" field.
+func (m *DepartmentQuotaMutation) ResetLimitBytes() {
+	m.limit_bytes = nil
+	m.addlimit_bytes = nil
 }
 
-// SetMimeType sets the "mime_type" field.
-func (m *FileMutation) SetMimeType(s string) {
-	m.mime_type = &s
+// Where appends a list predicates to the DepartmentQuotaMutation builder.
+func (m *DepartmentQuotaMutation) Where(ps ...predicate.DepartmentQuota) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// MimeType returns the value of the "mime_type" field in the mutation.
-func (m *FileMutation) MimeType() (r string, exists bool) {
-	v := m.mime_type
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the DepartmentQuotaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DepartmentQuotaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DepartmentQuota, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldMimeType returns the old "mime_type" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldMimeType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMimeType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
-	}
-	return oldValue.MimeType, nil
+// Op returns the operation name.
+func (m *DepartmentQuotaMutation) Op() Op {
+	return m.op
 }
 
-// ResetMimeType resets all changes to the "mime_type" field.
-func (m *FileMutation) ResetMimeType() {
-	m.mime_type = nil
+// SetOp allows setting the mutation operation.
+func (m *DepartmentQuotaMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetSize sets the "size" field.
-func (m *FileMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// Type returns the node type of this mutation (DepartmentQuota).
+func (m *DepartmentQuotaMutation) Type() string {
+	return m.typ
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *FileMutation) Size() (r int64, exists bool) {
-	v := m.size
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DepartmentQuotaMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant_id != nil {
+		fields = append(fields, departmentquota.FieldTenantID)
 	}
-	return *v, true
-}
-
-// OldSize returns the old "size" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldSize(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	if m.create_time != nil {
+		fields = append(fields, departmentquota.FieldCreateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+	if m.update_time != nil {
+		fields = append(fields, departmentquota.FieldUpdateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	if m.department_id != nil {
+		fields = append(fields, departmentquota.FieldDepartmentID)
 	}
-	return oldValue.Size, nil
+	if m.limit_bytes != nil {
+		fields = append(fields, departmentquota.FieldLimitBytes)
+	}
+	return fields
 }
 
-// AddSize adds i to the "size" field.
-func (m *FileMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DepartmentQuotaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case departmentquota.FieldTenantID:
+		return m.TenantID()
+	case departmentquota.FieldCreateTime:
+		return m.CreateTime()
+	case departmentquota.FieldUpdateTime:
+		return m.UpdateTime()
+	case departmentquota.FieldDepartmentID:
+		return m.DepartmentID()
+	case departmentquota.FieldLimitBytes:
+		return m.LimitBytes()
 	}
+	return nil, false
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *FileMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DepartmentQuotaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case departmentquota.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case departmentquota.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case departmentquota.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case departmentquota.FieldDepartmentID:
+		return m.OldDepartmentID(ctx)
+	case departmentquota.FieldLimitBytes:
+		return m.OldLimitBytes(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown DepartmentQuota field %s", name)
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *FileMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DepartmentQuotaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case departmentquota.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case departmentquota.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case departmentquota.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case departmentquota.FieldDepartmentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepartmentID(v)
+		return nil
+	case departmentquota.FieldLimitBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLimitBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DepartmentQuota field %s", name)
 }
 
-// SetPath sets the "path" field.
-func (m *FileMutation) SetPath(s string) {
-	m._path = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DepartmentQuotaMutation) AddedFields() []string {
+	var fields []string
+	if m.addlimit_bytes != nil {
+		fields = append(fields, departmentquota.FieldLimitBytes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DepartmentQuotaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case departmentquota.FieldLimitBytes:
+		return m.AddedLimitBytes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DepartmentQuotaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case departmentquota.FieldLimitBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLimitBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DepartmentQuota numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DepartmentQuotaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DepartmentQuotaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DepartmentQuotaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DepartmentQuota nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DepartmentQuotaMutation) ResetField(name string) error {
+	switch name {
+	case departmentquota.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case departmentquota.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case departmentquota.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case departmentquota.FieldDepartmentID:
+		m.ResetDepartmentID()
+		return nil
+	case departmentquota.FieldLimitBytes:
+		m.ResetLimitBytes()
+		return nil
+	}
+	return fmt.Errorf("unknown DepartmentQuota field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DepartmentQuotaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DepartmentQuotaMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DepartmentQuotaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DepartmentQuotaMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DepartmentQuotaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DepartmentQuotaMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DepartmentQuotaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DepartmentQuota unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DepartmentQuotaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DepartmentQuota edge %s", name)
+}
+
+// FileMutation represents an operation that mutates the File nodes in the graph.
+type FileMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	tenant_id                *uuid.UUID
+	create_time              *time.Time
+	update_time              *time.Time
+	deleted_at               *time.Time
+	created_by               *uuid.UUID
+	original_name            *string
+	storage_key              *string
+	mime_type                *string
+	detected_mime_type       *string
+	size                     *int64
+	addsize                  *int64
+	_path                    *string
+	description              *string
+	metadata                 *map[string]interface{}
+	checksum_sha256          *string
+	integrity_status         *file.IntegrityStatus
+	integrity_checked_at     *time.Time
+	thumbnail_status         *file.ThumbnailStatus
+	preview_status           *file.PreviewStatus
+	pdf_status               *file.PdfStatus
+	pdf_attempts             *int
+	addpdf_attempts          *int
+	pdf_retry_at             *time.Time
+	scan_status              *file.ScanStatus
+	expires_at               *time.Time
+	department_id            *uuid.UUID
+	upload_source            *file.UploadSource
+	client_version           *string
+	download_count           *int64
+	adddownload_count        *int64
+	last_downloaded_at       *time.Time
+	archived_at              *time.Time
+	entity_type              *file.EntityType
+	entity_id                *uuid.UUID
+	locked_by                *uuid.UUID
+	locked_at                *time.Time
+	object_lock_mode         *file.ObjectLockMode
+	object_lock_retain_until *time.Time
+	legal_hold               *bool
+	scheduled_deletion_at    *time.Time
+	scheduled_deletion_by    *uuid.UUID
+	clearedFields            map[string]struct{}
+	tags                     map[uuid.UUID]struct{}
+	removedtags              map[uuid.UUID]struct{}
+	clearedtags              bool
+	favorites                map[uuid.UUID]struct{}
+	removedfavorites         map[uuid.UUID]struct{}
+	clearedfavorites         bool
+	done                     bool
+	oldValue                 func(context.Context) (*File, error)
+	predicates               []predicate.File
+}
+
+var _ ent.Mutation = (*FileMutation)(nil)
+
+// fileOption allows management of the mutation configuration using functional options.
+type fileOption func(*FileMutation)
+
+// newFileMutation creates new mutation for the File entity.
+func newFileMutation(c config, op Op, opts ...fileOption) *FileMutation {
+	m := &FileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileID sets the ID field of the mutation.
+func withFileID(id uuid.UUID) fileOption {
+	return func(m *FileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *File
+		)
+		m.oldValue = func(ctx context.Context) (*File, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().File.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFile sets the old File of the mutation.
+func withFile(node *File) fileOption {
+	return func(m *FileMutation) {
+		m.oldValue = func(context.Context) (*File, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of File entities.
+func (m *FileMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().File.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *FileMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *FileMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *FileMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FileMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FileMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FileMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FileMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FileMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FileMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FileMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FileMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FileMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[file.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FileMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FileMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, file.FieldDeletedAt)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *FileMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *FileMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *FileMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetOriginalName sets the "original_name" field.
+func (m *FileMutation) SetOriginalName(s string) {
+	m.original_name = &s
+}
+
+// OriginalName returns the value of the "original_name" field in the mutation.
+func (m *FileMutation) OriginalName() (r string, exists bool) {
+	v := m.original_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalName returns the old "original_name" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldOriginalName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalName: %w", err)
+	}
+	return oldValue.OriginalName, nil
+}
+
+// ResetOriginalName resets all changes to the "original_name" field.
+func (m *FileMutation) ResetOriginalName() {
+	m.original_name = nil
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (m *FileMutation) SetStorageKey(s string) {
+	m.storage_key = &s
+}
+
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *FileMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageKey returns the old "storage_key" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+	}
+	return oldValue.StorageKey, nil
+}
+
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *FileMutation) ResetStorageKey() {
+	m.storage_key = nil
+}
+
+// SetMimeType sets the "mime_type" field.
+func (m *FileMutation) SetMimeType(s string) {
+	m.mime_type = &s
+}
+
+// MimeType returns the value of the "mime_type" field in the mutation.
+func (m *FileMutation) MimeType() (r string, exists bool) {
+	v := m.mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeType returns the old "mime_type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeType: %w", err)
+	}
+	return oldValue.MimeType, nil
+}
+
+// ResetMimeType resets all changes to the "mime_type" field.
+func (m *FileMutation) ResetMimeType() {
+	m.mime_type = nil
+}
+
+// SetDetectedMimeType sets the "detected_mime_type" field.
+func (m *FileMutation) SetDetectedMimeType(s string) {
+	m.detected_mime_type = &s
+}
+
+// DetectedMimeType returns the value of the "detected_mime_type" field in the mutation.
+func (m *FileMutation) DetectedMimeType() (r string, exists bool) {
+	v := m.detected_mime_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetectedMimeType returns the old "detected_mime_type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDetectedMimeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetectedMimeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetectedMimeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetectedMimeType: %w", err)
+	}
+	return oldValue.DetectedMimeType, nil
+}
+
+// ClearDetectedMimeType clears the value of the "detected_mime_type" field.
+func (m *FileMutation) ClearDetectedMimeType() {
+	m.detected_mime_type = nil
+	m.clearedFields[file.FieldDetectedMimeType] = struct{}{}
+}
+
+// DetectedMimeTypeCleared returns if the "detected_mime_type" field was cleared in this mutation.
+func (m *FileMutation) DetectedMimeTypeCleared() bool {
+	_, ok := m.clearedFields[file.FieldDetectedMimeType]
+	return ok
+}
+
+// ResetDetectedMimeType resets all changes to the "detected_mime_type" field.
+func (m *FileMutation) ResetDetectedMimeType() {
+	m.detected_mime_type = nil
+	delete(m.clearedFields, file.FieldDetectedMimeType)
+}
+
+// SetSize sets the "size" field.
+func (m *FileMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *FileMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *FileMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *FileMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *FileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetPath sets the "path" field.
+func (m *FileMutation) SetPath(s string) {
+	m._path = &s
 }
 
 // Path returns the value of the "path" field in the mutation.
@@ -478,148 +1218,11507 @@ func (m *FileMutation) Path() (r string, exists bool) {
 	return *v, true
 }
 
-// OldPath returns the old "path" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ClearPath clears the value of the "path" field.
+func (m *FileMutation) ClearPath() {
+	m._path = nil
+	m.clearedFields[file.FieldPath] = struct{}{}
+}
+
+// PathCleared returns if the "path" field was cleared in this mutation.
+func (m *FileMutation) PathCleared() bool {
+	_, ok := m.clearedFields[file.FieldPath]
+	return ok
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *FileMutation) ResetPath() {
+	m._path = nil
+	delete(m.clearedFields, file.FieldPath)
+}
+
+// SetDescription sets the "description" field.
+func (m *FileMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *FileMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *FileMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[file.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *FileMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[file.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *FileMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, file.FieldDescription)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *FileMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *FileMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *FileMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[file.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *FileMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[file.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *FileMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, file.FieldMetadata)
+}
+
+// SetChecksumSha256 sets the "checksum_sha256" field.
+func (m *FileMutation) SetChecksumSha256(s string) {
+	m.checksum_sha256 = &s
+}
+
+// ChecksumSha256 returns the value of the "checksum_sha256" field in the mutation.
+func (m *FileMutation) ChecksumSha256() (r string, exists bool) {
+	v := m.checksum_sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksumSha256 returns the old "checksum_sha256" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldChecksumSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecksumSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecksumSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksumSha256: %w", err)
+	}
+	return oldValue.ChecksumSha256, nil
+}
+
+// ClearChecksumSha256 clears the value of the "checksum_sha256" field.
+func (m *FileMutation) ClearChecksumSha256() {
+	m.checksum_sha256 = nil
+	m.clearedFields[file.FieldChecksumSha256] = struct{}{}
+}
+
+// ChecksumSha256Cleared returns if the "checksum_sha256" field was cleared in this mutation.
+func (m *FileMutation) ChecksumSha256Cleared() bool {
+	_, ok := m.clearedFields[file.FieldChecksumSha256]
+	return ok
+}
+
+// ResetChecksumSha256 resets all changes to the "checksum_sha256" field.
+func (m *FileMutation) ResetChecksumSha256() {
+	m.checksum_sha256 = nil
+	delete(m.clearedFields, file.FieldChecksumSha256)
+}
+
+// SetIntegrityStatus sets the "integrity_status" field.
+func (m *FileMutation) SetIntegrityStatus(fs file.IntegrityStatus) {
+	m.integrity_status = &fs
+}
+
+// IntegrityStatus returns the value of the "integrity_status" field in the mutation.
+func (m *FileMutation) IntegrityStatus() (r file.IntegrityStatus, exists bool) {
+	v := m.integrity_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntegrityStatus returns the old "integrity_status" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldIntegrityStatus(ctx context.Context) (v file.IntegrityStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntegrityStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntegrityStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntegrityStatus: %w", err)
+	}
+	return oldValue.IntegrityStatus, nil
+}
+
+// ResetIntegrityStatus resets all changes to the "integrity_status" field.
+func (m *FileMutation) ResetIntegrityStatus() {
+	m.integrity_status = nil
+}
+
+// SetIntegrityCheckedAt sets the "integrity_checked_at" field.
+func (m *FileMutation) SetIntegrityCheckedAt(t time.Time) {
+	m.integrity_checked_at = &t
+}
+
+// IntegrityCheckedAt returns the value of the "integrity_checked_at" field in the mutation.
+func (m *FileMutation) IntegrityCheckedAt() (r time.Time, exists bool) {
+	v := m.integrity_checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntegrityCheckedAt returns the old "integrity_checked_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldIntegrityCheckedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntegrityCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntegrityCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntegrityCheckedAt: %w", err)
+	}
+	return oldValue.IntegrityCheckedAt, nil
+}
+
+// ClearIntegrityCheckedAt clears the value of the "integrity_checked_at" field.
+func (m *FileMutation) ClearIntegrityCheckedAt() {
+	m.integrity_checked_at = nil
+	m.clearedFields[file.FieldIntegrityCheckedAt] = struct{}{}
+}
+
+// IntegrityCheckedAtCleared returns if the "integrity_checked_at" field was cleared in this mutation.
+func (m *FileMutation) IntegrityCheckedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldIntegrityCheckedAt]
+	return ok
+}
+
+// ResetIntegrityCheckedAt resets all changes to the "integrity_checked_at" field.
+func (m *FileMutation) ResetIntegrityCheckedAt() {
+	m.integrity_checked_at = nil
+	delete(m.clearedFields, file.FieldIntegrityCheckedAt)
+}
+
+// SetThumbnailStatus sets the "thumbnail_status" field.
+func (m *FileMutation) SetThumbnailStatus(fs file.ThumbnailStatus) {
+	m.thumbnail_status = &fs
+}
+
+// ThumbnailStatus returns the value of the "thumbnail_status" field in the mutation.
+func (m *FileMutation) ThumbnailStatus() (r file.ThumbnailStatus, exists bool) {
+	v := m.thumbnail_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailStatus returns the old "thumbnail_status" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldThumbnailStatus(ctx context.Context) (v file.ThumbnailStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailStatus: %w", err)
+	}
+	return oldValue.ThumbnailStatus, nil
+}
+
+// ResetThumbnailStatus resets all changes to the "thumbnail_status" field.
+func (m *FileMutation) ResetThumbnailStatus() {
+	m.thumbnail_status = nil
+}
+
+// SetPreviewStatus sets the "preview_status" field.
+func (m *FileMutation) SetPreviewStatus(fs file.PreviewStatus) {
+	m.preview_status = &fs
+}
+
+// PreviewStatus returns the value of the "preview_status" field in the mutation.
+func (m *FileMutation) PreviewStatus() (r file.PreviewStatus, exists bool) {
+	v := m.preview_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviewStatus returns the old "preview_status" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldPreviewStatus(ctx context.Context) (v file.PreviewStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviewStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviewStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviewStatus: %w", err)
+	}
+	return oldValue.PreviewStatus, nil
+}
+
+// ResetPreviewStatus resets all changes to the "preview_status" field.
+func (m *FileMutation) ResetPreviewStatus() {
+	m.preview_status = nil
+}
+
+// SetPdfStatus sets the "pdf_status" field.
+func (m *FileMutation) SetPdfStatus(fs file.PdfStatus) {
+	m.pdf_status = &fs
+}
+
+// PdfStatus returns the value of the "pdf_status" field in the mutation.
+func (m *FileMutation) PdfStatus() (r file.PdfStatus, exists bool) {
+	v := m.pdf_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPdfStatus returns the old "pdf_status" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldPdfStatus(ctx context.Context) (v file.PdfStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPdfStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPdfStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPdfStatus: %w", err)
+	}
+	return oldValue.PdfStatus, nil
+}
+
+// ResetPdfStatus resets all changes to the "pdf_status" field.
+func (m *FileMutation) ResetPdfStatus() {
+	m.pdf_status = nil
+}
+
+// SetPdfAttempts sets the "pdf_attempts" field.
+func (m *FileMutation) SetPdfAttempts(i int) {
+	m.pdf_attempts = &i
+	m.addpdf_attempts = nil
+}
+
+// PdfAttempts returns the value of the "pdf_attempts" field in the mutation.
+func (m *FileMutation) PdfAttempts() (r int, exists bool) {
+	v := m.pdf_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPdfAttempts returns the old "pdf_attempts" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldPdfAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPdfAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPdfAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPdfAttempts: %w", err)
+	}
+	return oldValue.PdfAttempts, nil
+}
+
+// AddPdfAttempts adds i to the "pdf_attempts" field.
+func (m *FileMutation) AddPdfAttempts(i int) {
+	if m.addpdf_attempts != nil {
+		*m.addpdf_attempts += i
+	} else {
+		m.addpdf_attempts = &i
+	}
+}
+
+// AddedPdfAttempts returns the value that was added to the "pdf_attempts" field in this mutation.
+func (m *FileMutation) AddedPdfAttempts() (r int, exists bool) {
+	v := m.addpdf_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPdfAttempts resets all changes to the "pdf_attempts" field.
+func (m *FileMutation) ResetPdfAttempts() {
+	m.pdf_attempts = nil
+	m.addpdf_attempts = nil
+}
+
+// SetPdfRetryAt sets the "pdf_retry_at" field.
+func (m *FileMutation) SetPdfRetryAt(t time.Time) {
+	m.pdf_retry_at = &t
+}
+
+// PdfRetryAt returns the value of the "pdf_retry_at" field in the mutation.
+func (m *FileMutation) PdfRetryAt() (r time.Time, exists bool) {
+	v := m.pdf_retry_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPdfRetryAt returns the old "pdf_retry_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldPdfRetryAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPdfRetryAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPdfRetryAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPdfRetryAt: %w", err)
+	}
+	return oldValue.PdfRetryAt, nil
+}
+
+// ClearPdfRetryAt clears the value of the "pdf_retry_at" field.
+func (m *FileMutation) ClearPdfRetryAt() {
+	m.pdf_retry_at = nil
+	m.clearedFields[file.FieldPdfRetryAt] = struct{}{}
+}
+
+// PdfRetryAtCleared returns if the "pdf_retry_at" field was cleared in this mutation.
+func (m *FileMutation) PdfRetryAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldPdfRetryAt]
+	return ok
+}
+
+// ResetPdfRetryAt resets all changes to the "pdf_retry_at" field.
+func (m *FileMutation) ResetPdfRetryAt() {
+	m.pdf_retry_at = nil
+	delete(m.clearedFields, file.FieldPdfRetryAt)
+}
+
+// SetScanStatus sets the "scan_status" field.
+func (m *FileMutation) SetScanStatus(fs file.ScanStatus) {
+	m.scan_status = &fs
+}
+
+// ScanStatus returns the value of the "scan_status" field in the mutation.
+func (m *FileMutation) ScanStatus() (r file.ScanStatus, exists bool) {
+	v := m.scan_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScanStatus returns the old "scan_status" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldScanStatus(ctx context.Context) (v file.ScanStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScanStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScanStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScanStatus: %w", err)
+	}
+	return oldValue.ScanStatus, nil
+}
+
+// ResetScanStatus resets all changes to the "scan_status" field.
+func (m *FileMutation) ResetScanStatus() {
+	m.scan_status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *FileMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *FileMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *FileMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[file.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *FileMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *FileMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, file.FieldExpiresAt)
+}
+
+// SetDepartmentID sets the "department_id" field.
+func (m *FileMutation) SetDepartmentID(u uuid.UUID) {
+	m.department_id = &u
+}
+
+// DepartmentID returns the value of the "department_id" field in the mutation.
+func (m *FileMutation) DepartmentID() (r uuid.UUID, exists bool) {
+	v := m.department_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDepartmentID returns the old "department_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDepartmentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDepartmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDepartmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDepartmentID: %w", err)
+	}
+	return oldValue.DepartmentID, nil
+}
+
+// ClearDepartmentID clears the value of the "department_id" field.
+func (m *FileMutation) ClearDepartmentID() {
+	m.department_id = nil
+	m.clearedFields[file.FieldDepartmentID] = struct{}{}
+}
+
+// DepartmentIDCleared returns if the "department_id" field was cleared in this mutation.
+func (m *FileMutation) DepartmentIDCleared() bool {
+	_, ok := m.clearedFields[file.FieldDepartmentID]
+	return ok
+}
+
+// ResetDepartmentID resets all changes to the "department_id" field.
+func (m *FileMutation) ResetDepartmentID() {
+	m.department_id = nil
+	delete(m.clearedFields, file.FieldDepartmentID)
+}
+
+// SetUploadSource sets the "upload_source" field.
+func (m *FileMutation) SetUploadSource(fs file.UploadSource) {
+	m.upload_source = &fs
+}
+
+// UploadSource returns the value of the "upload_source" field in the mutation.
+func (m *FileMutation) UploadSource() (r file.UploadSource, exists bool) {
+	v := m.upload_source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadSource returns the old "upload_source" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldUploadSource(ctx context.Context) (v file.UploadSource, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadSource: %w", err)
+	}
+	return oldValue.UploadSource, nil
+}
+
+// ResetUploadSource resets all changes to the "upload_source" field.
+func (m *FileMutation) ResetUploadSource() {
+	m.upload_source = nil
+}
+
+// SetClientVersion sets the "client_version" field.
+func (m *FileMutation) SetClientVersion(s string) {
+	m.client_version = &s
+}
+
+// ClientVersion returns the value of the "client_version" field in the mutation.
+func (m *FileMutation) ClientVersion() (r string, exists bool) {
+	v := m.client_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientVersion returns the old "client_version" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldClientVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientVersion: %w", err)
+	}
+	return oldValue.ClientVersion, nil
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (m *FileMutation) ClearClientVersion() {
+	m.client_version = nil
+	m.clearedFields[file.FieldClientVersion] = struct{}{}
+}
+
+// ClientVersionCleared returns if the "client_version" field was cleared in this mutation.
+func (m *FileMutation) ClientVersionCleared() bool {
+	_, ok := m.clearedFields[file.FieldClientVersion]
+	return ok
+}
+
+// ResetClientVersion resets all changes to the "client_version" field.
+func (m *FileMutation) ResetClientVersion() {
+	m.client_version = nil
+	delete(m.clearedFields, file.FieldClientVersion)
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (m *FileMutation) SetDownloadCount(i int64) {
+	m.download_count = &i
+	m.adddownload_count = nil
+}
+
+// DownloadCount returns the value of the "download_count" field in the mutation.
+func (m *FileMutation) DownloadCount() (r int64, exists bool) {
+	v := m.download_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadCount returns the old "download_count" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldDownloadCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadCount: %w", err)
+	}
+	return oldValue.DownloadCount, nil
+}
+
+// AddDownloadCount adds i to the "download_count" field.
+func (m *FileMutation) AddDownloadCount(i int64) {
+	if m.adddownload_count != nil {
+		*m.adddownload_count += i
+	} else {
+		m.adddownload_count = &i
+	}
+}
+
+// AddedDownloadCount returns the value that was added to the "download_count" field in this mutation.
+func (m *FileMutation) AddedDownloadCount() (r int64, exists bool) {
+	v := m.adddownload_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDownloadCount resets all changes to the "download_count" field.
+func (m *FileMutation) ResetDownloadCount() {
+	m.download_count = nil
+	m.adddownload_count = nil
+}
+
+// SetLastDownloadedAt sets the "last_downloaded_at" field.
+func (m *FileMutation) SetLastDownloadedAt(t time.Time) {
+	m.last_downloaded_at = &t
+}
+
+// LastDownloadedAt returns the value of the "last_downloaded_at" field in the mutation.
+func (m *FileMutation) LastDownloadedAt() (r time.Time, exists bool) {
+	v := m.last_downloaded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastDownloadedAt returns the old "last_downloaded_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldLastDownloadedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastDownloadedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastDownloadedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastDownloadedAt: %w", err)
+	}
+	return oldValue.LastDownloadedAt, nil
+}
+
+// ClearLastDownloadedAt clears the value of the "last_downloaded_at" field.
+func (m *FileMutation) ClearLastDownloadedAt() {
+	m.last_downloaded_at = nil
+	m.clearedFields[file.FieldLastDownloadedAt] = struct{}{}
+}
+
+// LastDownloadedAtCleared returns if the "last_downloaded_at" field was cleared in this mutation.
+func (m *FileMutation) LastDownloadedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldLastDownloadedAt]
+	return ok
+}
+
+// ResetLastDownloadedAt resets all changes to the "last_downloaded_at" field.
+func (m *FileMutation) ResetLastDownloadedAt() {
+	m.last_downloaded_at = nil
+	delete(m.clearedFields, file.FieldLastDownloadedAt)
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (m *FileMutation) SetArchivedAt(t time.Time) {
+	m.archived_at = &t
+}
+
+// ArchivedAt returns the value of the "archived_at" field in the mutation.
+func (m *FileMutation) ArchivedAt() (r time.Time, exists bool) {
+	v := m.archived_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArchivedAt returns the old "archived_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldArchivedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArchivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArchivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArchivedAt: %w", err)
+	}
+	return oldValue.ArchivedAt, nil
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (m *FileMutation) ClearArchivedAt() {
+	m.archived_at = nil
+	m.clearedFields[file.FieldArchivedAt] = struct{}{}
+}
+
+// ArchivedAtCleared returns if the "archived_at" field was cleared in this mutation.
+func (m *FileMutation) ArchivedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldArchivedAt]
+	return ok
+}
+
+// ResetArchivedAt resets all changes to the "archived_at" field.
+func (m *FileMutation) ResetArchivedAt() {
+	m.archived_at = nil
+	delete(m.clearedFields, file.FieldArchivedAt)
+}
+
+// SetEntityType sets the "entity_type" field.
+func (m *FileMutation) SetEntityType(ft file.EntityType) {
+	m.entity_type = &ft
+}
+
+// EntityType returns the value of the "entity_type" field in the mutation.
+func (m *FileMutation) EntityType() (r file.EntityType, exists bool) {
+	v := m.entity_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityType returns the old "entity_type" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldEntityType(ctx context.Context) (v *file.EntityType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityType: %w", err)
+	}
+	return oldValue.EntityType, nil
+}
+
+// ClearEntityType clears the value of the "entity_type" field.
+func (m *FileMutation) ClearEntityType() {
+	m.entity_type = nil
+	m.clearedFields[file.FieldEntityType] = struct{}{}
+}
+
+// EntityTypeCleared returns if the "entity_type" field was cleared in this mutation.
+func (m *FileMutation) EntityTypeCleared() bool {
+	_, ok := m.clearedFields[file.FieldEntityType]
+	return ok
+}
+
+// ResetEntityType resets all changes to the "entity_type" field.
+func (m *FileMutation) ResetEntityType() {
+	m.entity_type = nil
+	delete(m.clearedFields, file.FieldEntityType)
+}
+
+// SetEntityID sets the "entity_id" field.
+func (m *FileMutation) SetEntityID(u uuid.UUID) {
+	m.entity_id = &u
+}
+
+// EntityID returns the value of the "entity_id" field in the mutation.
+func (m *FileMutation) EntityID() (r uuid.UUID, exists bool) {
+	v := m.entity_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntityID returns the old "entity_id" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldEntityID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntityID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntityID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntityID: %w", err)
+	}
+	return oldValue.EntityID, nil
+}
+
+// ClearEntityID clears the value of the "entity_id" field.
+func (m *FileMutation) ClearEntityID() {
+	m.entity_id = nil
+	m.clearedFields[file.FieldEntityID] = struct{}{}
+}
+
+// EntityIDCleared returns if the "entity_id" field was cleared in this mutation.
+func (m *FileMutation) EntityIDCleared() bool {
+	_, ok := m.clearedFields[file.FieldEntityID]
+	return ok
+}
+
+// ResetEntityID resets all changes to the "entity_id" field.
+func (m *FileMutation) ResetEntityID() {
+	m.entity_id = nil
+	delete(m.clearedFields, file.FieldEntityID)
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (m *FileMutation) SetLockedBy(u uuid.UUID) {
+	m.locked_by = &u
+}
+
+// LockedBy returns the value of the "locked_by" field in the mutation.
+func (m *FileMutation) LockedBy() (r uuid.UUID, exists bool) {
+	v := m.locked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedBy returns the old "locked_by" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldLockedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedBy: %w", err)
+	}
+	return oldValue.LockedBy, nil
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (m *FileMutation) ClearLockedBy() {
+	m.locked_by = nil
+	m.clearedFields[file.FieldLockedBy] = struct{}{}
+}
+
+// LockedByCleared returns if the "locked_by" field was cleared in this mutation.
+func (m *FileMutation) LockedByCleared() bool {
+	_, ok := m.clearedFields[file.FieldLockedBy]
+	return ok
+}
+
+// ResetLockedBy resets all changes to the "locked_by" field.
+func (m *FileMutation) ResetLockedBy() {
+	m.locked_by = nil
+	delete(m.clearedFields, file.FieldLockedBy)
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (m *FileMutation) SetLockedAt(t time.Time) {
+	m.locked_at = &t
+}
+
+// LockedAt returns the value of the "locked_at" field in the mutation.
+func (m *FileMutation) LockedAt() (r time.Time, exists bool) {
+	v := m.locked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedAt returns the old "locked_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldLockedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedAt: %w", err)
+	}
+	return oldValue.LockedAt, nil
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (m *FileMutation) ClearLockedAt() {
+	m.locked_at = nil
+	m.clearedFields[file.FieldLockedAt] = struct{}{}
+}
+
+// LockedAtCleared returns if the "locked_at" field was cleared in this mutation.
+func (m *FileMutation) LockedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldLockedAt]
+	return ok
+}
+
+// ResetLockedAt resets all changes to the "locked_at" field.
+func (m *FileMutation) ResetLockedAt() {
+	m.locked_at = nil
+	delete(m.clearedFields, file.FieldLockedAt)
+}
+
+// SetObjectLockMode sets the "object_lock_mode" field.
+func (m *FileMutation) SetObjectLockMode(flm file.ObjectLockMode) {
+	m.object_lock_mode = &flm
+}
+
+// ObjectLockMode returns the value of the "object_lock_mode" field in the mutation.
+func (m *FileMutation) ObjectLockMode() (r file.ObjectLockMode, exists bool) {
+	v := m.object_lock_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectLockMode returns the old "object_lock_mode" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldObjectLockMode(ctx context.Context) (v *file.ObjectLockMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectLockMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectLockMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectLockMode: %w", err)
+	}
+	return oldValue.ObjectLockMode, nil
+}
+
+// ClearObjectLockMode clears the value of the "object_lock_mode" field.
+func (m *FileMutation) ClearObjectLockMode() {
+	m.object_lock_mode = nil
+	m.clearedFields[file.FieldObjectLockMode] = struct{}{}
+}
+
+// ObjectLockModeCleared returns if the "object_lock_mode" field was cleared in this mutation.
+func (m *FileMutation) ObjectLockModeCleared() bool {
+	_, ok := m.clearedFields[file.FieldObjectLockMode]
+	return ok
+}
+
+// ResetObjectLockMode resets all changes to the "object_lock_mode" field.
+func (m *FileMutation) ResetObjectLockMode() {
+	m.object_lock_mode = nil
+	delete(m.clearedFields, file.FieldObjectLockMode)
+}
+
+// SetObjectLockRetainUntil sets the "object_lock_retain_until" field.
+func (m *FileMutation) SetObjectLockRetainUntil(t time.Time) {
+	m.object_lock_retain_until = &t
+}
+
+// ObjectLockRetainUntil returns the value of the "object_lock_retain_until" field in the mutation.
+func (m *FileMutation) ObjectLockRetainUntil() (r time.Time, exists bool) {
+	v := m.object_lock_retain_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObjectLockRetainUntil returns the old "object_lock_retain_until" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldObjectLockRetainUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObjectLockRetainUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObjectLockRetainUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObjectLockRetainUntil: %w", err)
+	}
+	return oldValue.ObjectLockRetainUntil, nil
+}
+
+// ClearObjectLockRetainUntil clears the value of the "object_lock_retain_until" field.
+func (m *FileMutation) ClearObjectLockRetainUntil() {
+	m.object_lock_retain_until = nil
+	m.clearedFields[file.FieldObjectLockRetainUntil] = struct{}{}
+}
+
+// ObjectLockRetainUntilCleared returns if the "object_lock_retain_until" field was cleared in this mutation.
+func (m *FileMutation) ObjectLockRetainUntilCleared() bool {
+	_, ok := m.clearedFields[file.FieldObjectLockRetainUntil]
+	return ok
+}
+
+// ResetObjectLockRetainUntil resets all changes to the "object_lock_retain_until" field.
+func (m *FileMutation) ResetObjectLockRetainUntil() {
+	m.object_lock_retain_until = nil
+	delete(m.clearedFields, file.FieldObjectLockRetainUntil)
+}
+
+// SetLegalHold sets the "legal_hold" field.
+func (m *FileMutation) SetLegalHold(b bool) {
+	m.legal_hold = &b
+}
+
+// LegalHold returns the value of the "legal_hold" field in the mutation.
+func (m *FileMutation) LegalHold() (r bool, exists bool) {
+	v := m.legal_hold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegalHold returns the old "legal_hold" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldLegalHold(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegalHold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegalHold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegalHold: %w", err)
+	}
+	return oldValue.LegalHold, nil
+}
+
+// ResetLegalHold resets all changes to the "legal_hold" field.
+func (m *FileMutation) ResetLegalHold() {
+	m.legal_hold = nil
+}
+
+// SetScheduledDeletionAt sets the "scheduled_deletion_at" field.
+func (m *FileMutation) SetScheduledDeletionAt(t time.Time) {
+	m.scheduled_deletion_at = &t
+}
+
+// ScheduledDeletionAt returns the value of the "scheduled_deletion_at" field in the mutation.
+func (m *FileMutation) ScheduledDeletionAt() (r time.Time, exists bool) {
+	v := m.scheduled_deletion_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledDeletionAt returns the old "scheduled_deletion_at" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldScheduledDeletionAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledDeletionAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledDeletionAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledDeletionAt: %w", err)
+	}
+	return oldValue.ScheduledDeletionAt, nil
+}
+
+// ClearScheduledDeletionAt clears the value of the "scheduled_deletion_at" field.
+func (m *FileMutation) ClearScheduledDeletionAt() {
+	m.scheduled_deletion_at = nil
+	m.clearedFields[file.FieldScheduledDeletionAt] = struct{}{}
+}
+
+// ScheduledDeletionAtCleared returns if the "scheduled_deletion_at" field was cleared in this mutation.
+func (m *FileMutation) ScheduledDeletionAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldScheduledDeletionAt]
+	return ok
+}
+
+// ResetScheduledDeletionAt resets all changes to the "scheduled_deletion_at" field.
+func (m *FileMutation) ResetScheduledDeletionAt() {
+	m.scheduled_deletion_at = nil
+	delete(m.clearedFields, file.FieldScheduledDeletionAt)
+}
+
+// SetScheduledDeletionBy sets the "scheduled_deletion_by" field.
+func (m *FileMutation) SetScheduledDeletionBy(u uuid.UUID) {
+	m.scheduled_deletion_by = &u
+}
+
+// ScheduledDeletionBy returns the value of the "scheduled_deletion_by" field in the mutation.
+func (m *FileMutation) ScheduledDeletionBy() (r uuid.UUID, exists bool) {
+	v := m.scheduled_deletion_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduledDeletionBy returns the old "scheduled_deletion_by" field's value of the File entity.
+// If the File object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileMutation) OldScheduledDeletionBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduledDeletionBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduledDeletionBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduledDeletionBy: %w", err)
+	}
+	return oldValue.ScheduledDeletionBy, nil
+}
+
+// ClearScheduledDeletionBy clears the value of the "scheduled_deletion_by" field.
+func (m *FileMutation) ClearScheduledDeletionBy() {
+	m.scheduled_deletion_by = nil
+	m.clearedFields[file.FieldScheduledDeletionBy] = struct{}{}
+}
+
+// ScheduledDeletionByCleared returns if the "scheduled_deletion_by" field was cleared in this mutation.
+func (m *FileMutation) ScheduledDeletionByCleared() bool {
+	_, ok := m.clearedFields[file.FieldScheduledDeletionBy]
+	return ok
+}
+
+// ResetScheduledDeletionBy resets all changes to the "scheduled_deletion_by" field.
+func (m *FileMutation) ResetScheduledDeletionBy() {
+	m.scheduled_deletion_by = nil
+	delete(m.clearedFields, file.FieldScheduledDeletionBy)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *FileMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *FileMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *FileMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *FileMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *FileMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *FileMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *FileMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddFavoriteIDs adds the "favorites" edge to the FileFavorite entity by ids.
+func (m *FileMutation) AddFavoriteIDs(ids ...uuid.UUID) {
+	if m.favorites == nil {
+		m.favorites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.favorites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFavorites clears the "favorites" edge to the FileFavorite entity.
+func (m *FileMutation) ClearFavorites() {
+	m.clearedfavorites = true
+}
+
+// FavoritesCleared reports if the "favorites" edge to the FileFavorite entity was cleared.
+func (m *FileMutation) FavoritesCleared() bool {
+	return m.clearedfavorites
+}
+
+// RemoveFavoriteIDs removes the "favorites" edge to the FileFavorite entity by IDs.
+func (m *FileMutation) RemoveFavoriteIDs(ids ...uuid.UUID) {
+	if m.removedfavorites == nil {
+		m.removedfavorites = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.favorites, ids[i])
+		m.removedfavorites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFavorites returns the removed IDs of the "favorites" edge to the FileFavorite entity.
+func (m *FileMutation) RemovedFavoritesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfavorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FavoritesIDs returns the "favorites" edge IDs in the mutation.
+func (m *FileMutation) FavoritesIDs() (ids []uuid.UUID) {
+	for id := range m.favorites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFavorites resets all changes to the "favorites" edge.
+func (m *FileMutation) ResetFavorites() {
+	m.favorites = nil
+	m.clearedfavorites = false
+	m.removedfavorites = nil
+}
+
+// Where appends a list predicates to the FileMutation builder.
+func (m *FileMutation) Where(ps ...predicate.File) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.File, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (File).
+func (m *FileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileMutation) Fields() []string {
+	fields := make([]string, 0, 38)
+	if m.tenant_id != nil {
+		fields = append(fields, file.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, file.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, file.FieldUpdateTime)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, file.FieldDeletedAt)
+	}
+	if m.created_by != nil {
+		fields = append(fields, file.FieldCreatedBy)
+	}
+	if m.original_name != nil {
+		fields = append(fields, file.FieldOriginalName)
+	}
+	if m.storage_key != nil {
+		fields = append(fields, file.FieldStorageKey)
+	}
+	if m.mime_type != nil {
+		fields = append(fields, file.FieldMimeType)
+	}
+	if m.detected_mime_type != nil {
+		fields = append(fields, file.FieldDetectedMimeType)
+	}
+	if m.size != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m._path != nil {
+		fields = append(fields, file.FieldPath)
+	}
+	if m.description != nil {
+		fields = append(fields, file.FieldDescription)
+	}
+	if m.metadata != nil {
+		fields = append(fields, file.FieldMetadata)
+	}
+	if m.checksum_sha256 != nil {
+		fields = append(fields, file.FieldChecksumSha256)
+	}
+	if m.integrity_status != nil {
+		fields = append(fields, file.FieldIntegrityStatus)
+	}
+	if m.integrity_checked_at != nil {
+		fields = append(fields, file.FieldIntegrityCheckedAt)
+	}
+	if m.thumbnail_status != nil {
+		fields = append(fields, file.FieldThumbnailStatus)
+	}
+	if m.preview_status != nil {
+		fields = append(fields, file.FieldPreviewStatus)
+	}
+	if m.pdf_status != nil {
+		fields = append(fields, file.FieldPdfStatus)
+	}
+	if m.pdf_attempts != nil {
+		fields = append(fields, file.FieldPdfAttempts)
+	}
+	if m.pdf_retry_at != nil {
+		fields = append(fields, file.FieldPdfRetryAt)
+	}
+	if m.scan_status != nil {
+		fields = append(fields, file.FieldScanStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, file.FieldExpiresAt)
+	}
+	if m.department_id != nil {
+		fields = append(fields, file.FieldDepartmentID)
+	}
+	if m.upload_source != nil {
+		fields = append(fields, file.FieldUploadSource)
+	}
+	if m.client_version != nil {
+		fields = append(fields, file.FieldClientVersion)
+	}
+	if m.download_count != nil {
+		fields = append(fields, file.FieldDownloadCount)
+	}
+	if m.last_downloaded_at != nil {
+		fields = append(fields, file.FieldLastDownloadedAt)
+	}
+	if m.archived_at != nil {
+		fields = append(fields, file.FieldArchivedAt)
+	}
+	if m.entity_type != nil {
+		fields = append(fields, file.FieldEntityType)
+	}
+	if m.entity_id != nil {
+		fields = append(fields, file.FieldEntityID)
+	}
+	if m.locked_by != nil {
+		fields = append(fields, file.FieldLockedBy)
+	}
+	if m.locked_at != nil {
+		fields = append(fields, file.FieldLockedAt)
+	}
+	if m.object_lock_mode != nil {
+		fields = append(fields, file.FieldObjectLockMode)
+	}
+	if m.object_lock_retain_until != nil {
+		fields = append(fields, file.FieldObjectLockRetainUntil)
+	}
+	if m.legal_hold != nil {
+		fields = append(fields, file.FieldLegalHold)
+	}
+	if m.scheduled_deletion_at != nil {
+		fields = append(fields, file.FieldScheduledDeletionAt)
+	}
+	if m.scheduled_deletion_by != nil {
+		fields = append(fields, file.FieldScheduledDeletionBy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldTenantID:
+		return m.TenantID()
+	case file.FieldCreateTime:
+		return m.CreateTime()
+	case file.FieldUpdateTime:
+		return m.UpdateTime()
+	case file.FieldDeletedAt:
+		return m.DeletedAt()
+	case file.FieldCreatedBy:
+		return m.CreatedBy()
+	case file.FieldOriginalName:
+		return m.OriginalName()
+	case file.FieldStorageKey:
+		return m.StorageKey()
+	case file.FieldMimeType:
+		return m.MimeType()
+	case file.FieldDetectedMimeType:
+		return m.DetectedMimeType()
+	case file.FieldSize:
+		return m.Size()
+	case file.FieldPath:
+		return m.Path()
+	case file.FieldDescription:
+		return m.Description()
+	case file.FieldMetadata:
+		return m.Metadata()
+	case file.FieldChecksumSha256:
+		return m.ChecksumSha256()
+	case file.FieldIntegrityStatus:
+		return m.IntegrityStatus()
+	case file.FieldIntegrityCheckedAt:
+		return m.IntegrityCheckedAt()
+	case file.FieldThumbnailStatus:
+		return m.ThumbnailStatus()
+	case file.FieldPreviewStatus:
+		return m.PreviewStatus()
+	case file.FieldPdfStatus:
+		return m.PdfStatus()
+	case file.FieldPdfAttempts:
+		return m.PdfAttempts()
+	case file.FieldPdfRetryAt:
+		return m.PdfRetryAt()
+	case file.FieldScanStatus:
+		return m.ScanStatus()
+	case file.FieldExpiresAt:
+		return m.ExpiresAt()
+	case file.FieldDepartmentID:
+		return m.DepartmentID()
+	case file.FieldUploadSource:
+		return m.UploadSource()
+	case file.FieldClientVersion:
+		return m.ClientVersion()
+	case file.FieldDownloadCount:
+		return m.DownloadCount()
+	case file.FieldLastDownloadedAt:
+		return m.LastDownloadedAt()
+	case file.FieldArchivedAt:
+		return m.ArchivedAt()
+	case file.FieldEntityType:
+		return m.EntityType()
+	case file.FieldEntityID:
+		return m.EntityID()
+	case file.FieldLockedBy:
+		return m.LockedBy()
+	case file.FieldLockedAt:
+		return m.LockedAt()
+	case file.FieldObjectLockMode:
+		return m.ObjectLockMode()
+	case file.FieldObjectLockRetainUntil:
+		return m.ObjectLockRetainUntil()
+	case file.FieldLegalHold:
+		return m.LegalHold()
+	case file.FieldScheduledDeletionAt:
+		return m.ScheduledDeletionAt()
+	case file.FieldScheduledDeletionBy:
+		return m.ScheduledDeletionBy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case file.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case file.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case file.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case file.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case file.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case file.FieldOriginalName:
+		return m.OldOriginalName(ctx)
+	case file.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case file.FieldMimeType:
+		return m.OldMimeType(ctx)
+	case file.FieldDetectedMimeType:
+		return m.OldDetectedMimeType(ctx)
+	case file.FieldSize:
+		return m.OldSize(ctx)
+	case file.FieldPath:
+		return m.OldPath(ctx)
+	case file.FieldDescription:
+		return m.OldDescription(ctx)
+	case file.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case file.FieldChecksumSha256:
+		return m.OldChecksumSha256(ctx)
+	case file.FieldIntegrityStatus:
+		return m.OldIntegrityStatus(ctx)
+	case file.FieldIntegrityCheckedAt:
+		return m.OldIntegrityCheckedAt(ctx)
+	case file.FieldThumbnailStatus:
+		return m.OldThumbnailStatus(ctx)
+	case file.FieldPreviewStatus:
+		return m.OldPreviewStatus(ctx)
+	case file.FieldPdfStatus:
+		return m.OldPdfStatus(ctx)
+	case file.FieldPdfAttempts:
+		return m.OldPdfAttempts(ctx)
+	case file.FieldPdfRetryAt:
+		return m.OldPdfRetryAt(ctx)
+	case file.FieldScanStatus:
+		return m.OldScanStatus(ctx)
+	case file.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case file.FieldDepartmentID:
+		return m.OldDepartmentID(ctx)
+	case file.FieldUploadSource:
+		return m.OldUploadSource(ctx)
+	case file.FieldClientVersion:
+		return m.OldClientVersion(ctx)
+	case file.FieldDownloadCount:
+		return m.OldDownloadCount(ctx)
+	case file.FieldLastDownloadedAt:
+		return m.OldLastDownloadedAt(ctx)
+	case file.FieldArchivedAt:
+		return m.OldArchivedAt(ctx)
+	case file.FieldEntityType:
+		return m.OldEntityType(ctx)
+	case file.FieldEntityID:
+		return m.OldEntityID(ctx)
+	case file.FieldLockedBy:
+		return m.OldLockedBy(ctx)
+	case file.FieldLockedAt:
+		return m.OldLockedAt(ctx)
+	case file.FieldObjectLockMode:
+		return m.OldObjectLockMode(ctx)
+	case file.FieldObjectLockRetainUntil:
+		return m.OldObjectLockRetainUntil(ctx)
+	case file.FieldLegalHold:
+		return m.OldLegalHold(ctx)
+	case file.FieldScheduledDeletionAt:
+		return m.OldScheduledDeletionAt(ctx)
+	case file.FieldScheduledDeletionBy:
+		return m.OldScheduledDeletionBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown File field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case file.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case file.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case file.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case file.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case file.FieldOriginalName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalName(v)
+		return nil
+	case file.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case file.FieldMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeType(v)
+		return nil
+	case file.FieldDetectedMimeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetectedMimeType(v)
+		return nil
+	case file.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case file.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case file.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case file.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case file.FieldChecksumSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksumSha256(v)
+		return nil
+	case file.FieldIntegrityStatus:
+		v, ok := value.(file.IntegrityStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntegrityStatus(v)
+		return nil
+	case file.FieldIntegrityCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntegrityCheckedAt(v)
+		return nil
+	case file.FieldThumbnailStatus:
+		v, ok := value.(file.ThumbnailStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailStatus(v)
+		return nil
+	case file.FieldPreviewStatus:
+		v, ok := value.(file.PreviewStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviewStatus(v)
+		return nil
+	case file.FieldPdfStatus:
+		v, ok := value.(file.PdfStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPdfStatus(v)
+		return nil
+	case file.FieldPdfAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPdfAttempts(v)
+		return nil
+	case file.FieldPdfRetryAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPdfRetryAt(v)
+		return nil
+	case file.FieldScanStatus:
+		v, ok := value.(file.ScanStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScanStatus(v)
+		return nil
+	case file.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case file.FieldDepartmentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDepartmentID(v)
+		return nil
+	case file.FieldUploadSource:
+		v, ok := value.(file.UploadSource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadSource(v)
+		return nil
+	case file.FieldClientVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientVersion(v)
+		return nil
+	case file.FieldDownloadCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloadCount(v)
+		return nil
+	case file.FieldLastDownloadedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastDownloadedAt(v)
+		return nil
+	case file.FieldArchivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArchivedAt(v)
+		return nil
+	case file.FieldEntityType:
+		v, ok := value.(file.EntityType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityType(v)
+		return nil
+	case file.FieldEntityID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntityID(v)
+		return nil
+	case file.FieldLockedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedBy(v)
+		return nil
+	case file.FieldLockedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedAt(v)
+		return nil
+	case file.FieldObjectLockMode:
+		v, ok := value.(file.ObjectLockMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectLockMode(v)
+		return nil
+	case file.FieldObjectLockRetainUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObjectLockRetainUntil(v)
+		return nil
+	case file.FieldLegalHold:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegalHold(v)
+		return nil
+	case file.FieldScheduledDeletionAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledDeletionAt(v)
+		return nil
+	case file.FieldScheduledDeletionBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduledDeletionBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m.addpdf_attempts != nil {
+		fields = append(fields, file.FieldPdfAttempts)
+	}
+	if m.adddownload_count != nil {
+		fields = append(fields, file.FieldDownloadCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldSize:
+		return m.AddedSize()
+	case file.FieldPdfAttempts:
+		return m.AddedPdfAttempts()
+	case file.FieldDownloadCount:
+		return m.AddedDownloadCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case file.FieldPdfAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPdfAttempts(v)
+		return nil
+	case file.FieldDownloadCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloadCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(file.FieldDeletedAt) {
+		fields = append(fields, file.FieldDeletedAt)
+	}
+	if m.FieldCleared(file.FieldDetectedMimeType) {
+		fields = append(fields, file.FieldDetectedMimeType)
+	}
+	if m.FieldCleared(file.FieldPath) {
+		fields = append(fields, file.FieldPath)
+	}
+	if m.FieldCleared(file.FieldDescription) {
+		fields = append(fields, file.FieldDescription)
+	}
+	if m.FieldCleared(file.FieldMetadata) {
+		fields = append(fields, file.FieldMetadata)
+	}
+	if m.FieldCleared(file.FieldChecksumSha256) {
+		fields = append(fields, file.FieldChecksumSha256)
+	}
+	if m.FieldCleared(file.FieldIntegrityCheckedAt) {
+		fields = append(fields, file.FieldIntegrityCheckedAt)
+	}
+	if m.FieldCleared(file.FieldPdfRetryAt) {
+		fields = append(fields, file.FieldPdfRetryAt)
+	}
+	if m.FieldCleared(file.FieldExpiresAt) {
+		fields = append(fields, file.FieldExpiresAt)
+	}
+	if m.FieldCleared(file.FieldDepartmentID) {
+		fields = append(fields, file.FieldDepartmentID)
+	}
+	if m.FieldCleared(file.FieldClientVersion) {
+		fields = append(fields, file.FieldClientVersion)
+	}
+	if m.FieldCleared(file.FieldLastDownloadedAt) {
+		fields = append(fields, file.FieldLastDownloadedAt)
+	}
+	if m.FieldCleared(file.FieldArchivedAt) {
+		fields = append(fields, file.FieldArchivedAt)
+	}
+	if m.FieldCleared(file.FieldEntityType) {
+		fields = append(fields, file.FieldEntityType)
+	}
+	if m.FieldCleared(file.FieldEntityID) {
+		fields = append(fields, file.FieldEntityID)
+	}
+	if m.FieldCleared(file.FieldLockedBy) {
+		fields = append(fields, file.FieldLockedBy)
+	}
+	if m.FieldCleared(file.FieldLockedAt) {
+		fields = append(fields, file.FieldLockedAt)
+	}
+	if m.FieldCleared(file.FieldObjectLockMode) {
+		fields = append(fields, file.FieldObjectLockMode)
+	}
+	if m.FieldCleared(file.FieldObjectLockRetainUntil) {
+		fields = append(fields, file.FieldObjectLockRetainUntil)
+	}
+	if m.FieldCleared(file.FieldScheduledDeletionAt) {
+		fields = append(fields, file.FieldScheduledDeletionAt)
+	}
+	if m.FieldCleared(file.FieldScheduledDeletionBy) {
+		fields = append(fields, file.FieldScheduledDeletionBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileMutation) ClearField(name string) error {
+	switch name {
+	case file.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case file.FieldDetectedMimeType:
+		m.ClearDetectedMimeType()
+		return nil
+	case file.FieldPath:
+		m.ClearPath()
+		return nil
+	case file.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case file.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case file.FieldChecksumSha256:
+		m.ClearChecksumSha256()
+		return nil
+	case file.FieldIntegrityCheckedAt:
+		m.ClearIntegrityCheckedAt()
+		return nil
+	case file.FieldPdfRetryAt:
+		m.ClearPdfRetryAt()
+		return nil
+	case file.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case file.FieldDepartmentID:
+		m.ClearDepartmentID()
+		return nil
+	case file.FieldClientVersion:
+		m.ClearClientVersion()
+		return nil
+	case file.FieldLastDownloadedAt:
+		m.ClearLastDownloadedAt()
+		return nil
+	case file.FieldArchivedAt:
+		m.ClearArchivedAt()
+		return nil
+	case file.FieldEntityType:
+		m.ClearEntityType()
+		return nil
+	case file.FieldEntityID:
+		m.ClearEntityID()
+		return nil
+	case file.FieldLockedBy:
+		m.ClearLockedBy()
+		return nil
+	case file.FieldLockedAt:
+		m.ClearLockedAt()
+		return nil
+	case file.FieldObjectLockMode:
+		m.ClearObjectLockMode()
+		return nil
+	case file.FieldObjectLockRetainUntil:
+		m.ClearObjectLockRetainUntil()
+		return nil
+	case file.FieldScheduledDeletionAt:
+		m.ClearScheduledDeletionAt()
+		return nil
+	case file.FieldScheduledDeletionBy:
+		m.ClearScheduledDeletionBy()
+		return nil
+	}
+	return fmt.Errorf("unknown File nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileMutation) ResetField(name string) error {
+	switch name {
+	case file.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case file.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case file.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case file.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case file.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case file.FieldOriginalName:
+		m.ResetOriginalName()
+		return nil
+	case file.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case file.FieldMimeType:
+		m.ResetMimeType()
+		return nil
+	case file.FieldDetectedMimeType:
+		m.ResetDetectedMimeType()
+		return nil
+	case file.FieldSize:
+		m.ResetSize()
+		return nil
+	case file.FieldPath:
+		m.ResetPath()
+		return nil
+	case file.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case file.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case file.FieldChecksumSha256:
+		m.ResetChecksumSha256()
+		return nil
+	case file.FieldIntegrityStatus:
+		m.ResetIntegrityStatus()
+		return nil
+	case file.FieldIntegrityCheckedAt:
+		m.ResetIntegrityCheckedAt()
+		return nil
+	case file.FieldThumbnailStatus:
+		m.ResetThumbnailStatus()
+		return nil
+	case file.FieldPreviewStatus:
+		m.ResetPreviewStatus()
+		return nil
+	case file.FieldPdfStatus:
+		m.ResetPdfStatus()
+		return nil
+	case file.FieldPdfAttempts:
+		m.ResetPdfAttempts()
+		return nil
+	case file.FieldPdfRetryAt:
+		m.ResetPdfRetryAt()
+		return nil
+	case file.FieldScanStatus:
+		m.ResetScanStatus()
+		return nil
+	case file.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case file.FieldDepartmentID:
+		m.ResetDepartmentID()
+		return nil
+	case file.FieldUploadSource:
+		m.ResetUploadSource()
+		return nil
+	case file.FieldClientVersion:
+		m.ResetClientVersion()
+		return nil
+	case file.FieldDownloadCount:
+		m.ResetDownloadCount()
+		return nil
+	case file.FieldLastDownloadedAt:
+		m.ResetLastDownloadedAt()
+		return nil
+	case file.FieldArchivedAt:
+		m.ResetArchivedAt()
+		return nil
+	case file.FieldEntityType:
+		m.ResetEntityType()
+		return nil
+	case file.FieldEntityID:
+		m.ResetEntityID()
+		return nil
+	case file.FieldLockedBy:
+		m.ResetLockedBy()
+		return nil
+	case file.FieldLockedAt:
+		m.ResetLockedAt()
+		return nil
+	case file.FieldObjectLockMode:
+		m.ResetObjectLockMode()
+		return nil
+	case file.FieldObjectLockRetainUntil:
+		m.ResetObjectLockRetainUntil()
+		return nil
+	case file.FieldLegalHold:
+		m.ResetLegalHold()
+		return nil
+	case file.FieldScheduledDeletionAt:
+		m.ResetScheduledDeletionAt()
+		return nil
+	case file.FieldScheduledDeletionBy:
+		m.ResetScheduledDeletionBy()
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tags != nil {
+		edges = append(edges, file.EdgeTags)
+	}
+	if m.favorites != nil {
+		edges = append(edges, file.EdgeFavorites)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.favorites))
+		for id := range m.favorites {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtags != nil {
+		edges = append(edges, file.EdgeTags)
+	}
+	if m.removedfavorites != nil {
+		edges = append(edges, file.EdgeFavorites)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeFavorites:
+		ids := make([]ent.Value, 0, len(m.removedfavorites))
+		for id := range m.removedfavorites {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtags {
+		edges = append(edges, file.EdgeTags)
+	}
+	if m.clearedfavorites {
+		edges = append(edges, file.EdgeFavorites)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case file.EdgeTags:
+		return m.clearedtags
+	case file.EdgeFavorites:
+		return m.clearedfavorites
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown File unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileMutation) ResetEdge(name string) error {
+	switch name {
+	case file.EdgeTags:
+		m.ResetTags()
+		return nil
+	case file.EdgeFavorites:
+		m.ResetFavorites()
+		return nil
+	}
+	return fmt.Errorf("unknown File edge %s", name)
+}
+
+// FileAuditEventMutation represents an operation that mutates the FileAuditEvent nodes in the graph.
+type FileAuditEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	tenant_id       *uuid.UUID
+	create_time     *time.Time
+	update_time     *time.Time
+	action          *fileauditevent.Action
+	file_id         *uuid.UUID
+	actor_id        *uuid.UUID
+	impersonator_id *uuid.UUID
+	details         *map[string]interface{}
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*FileAuditEvent, error)
+	predicates      []predicate.FileAuditEvent
+}
+
+var _ ent.Mutation = (*FileAuditEventMutation)(nil)
+
+// fileauditeventOption allows management of the mutation configuration using functional options.
+type fileauditeventOption func(*FileAuditEventMutation)
+
+// newFileAuditEventMutation creates new mutation for the FileAuditEvent entity.
+func newFileAuditEventMutation(c config, op Op, opts ...fileauditeventOption) *FileAuditEventMutation {
+	m := &FileAuditEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileAuditEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileAuditEventID sets the ID field of the mutation.
+func withFileAuditEventID(id uuid.UUID) fileauditeventOption {
+	return func(m *FileAuditEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FileAuditEvent
+		)
+		m.oldValue = func(ctx context.Context) (*FileAuditEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FileAuditEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFileAuditEvent sets the old FileAuditEvent of the mutation.
+func withFileAuditEvent(node *FileAuditEvent) fileauditeventOption {
+	return func(m *FileAuditEventMutation) {
+		m.oldValue = func(context.Context) (*FileAuditEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileAuditEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileAuditEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FileAuditEvent entities.
+func (m *FileAuditEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileAuditEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileAuditEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FileAuditEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *FileAuditEventMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *FileAuditEventMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the FileAuditEvent entity.
+// If the FileAuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileAuditEventMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *FileAuditEventMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FileAuditEventMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FileAuditEventMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the FileAuditEvent entity.
+// If the FileAuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileAuditEventMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FileAuditEventMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FileAuditEventMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FileAuditEventMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the FileAuditEvent entity.
+// If the FileAuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileAuditEventMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FileAuditEventMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetAction sets the "action" field.
+func (m *FileAuditEventMutation) SetAction(f fileauditevent.Action) {
+	m.action = &f
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *FileAuditEventMutation) Action() (r fileauditevent.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the FileAuditEvent entity.
+// If the FileAuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileAuditEventMutation) OldAction(ctx context.Context) (v fileauditevent.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *FileAuditEventMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetFileID sets the "file_id" field.
+func (m *FileAuditEventMutation) SetFileID(u uuid.UUID) {
+	m.file_id = &u
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *FileAuditEventMutation) FileID() (r uuid.UUID, exists bool) {
+	v := m.file_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the FileAuditEvent entity.
+// If the FileAuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileAuditEventMutation) OldFileID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// ClearFileID clears the value of the "file_id" field.
+func (m *FileAuditEventMutation) ClearFileID() {
+	m.file_id = nil
+	m.clearedFields[fileauditevent.FieldFileID] = struct{}{}
+}
+
+// FileIDCleared returns if the "file_id" field was cleared in this mutation.
+func (m *FileAuditEventMutation) FileIDCleared() bool {
+	_, ok := m.clearedFields[fileauditevent.FieldFileID]
+	return ok
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *FileAuditEventMutation) ResetFileID() {
+	m.file_id = nil
+	delete(m.clearedFields, fileauditevent.FieldFileID)
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *FileAuditEventMutation) SetActorID(u uuid.UUID) {
+	m.actor_id = &u
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *FileAuditEventMutation) ActorID() (r uuid.UUID, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the FileAuditEvent entity.
+// If the FileAuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileAuditEventMutation) OldActorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *FileAuditEventMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[fileauditevent.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *FileAuditEventMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[fileauditevent.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *FileAuditEventMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, fileauditevent.FieldActorID)
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (m *FileAuditEventMutation) SetImpersonatorID(u uuid.UUID) {
+	m.impersonator_id = &u
+}
+
+// ImpersonatorID returns the value of the "impersonator_id" field in the mutation.
+func (m *FileAuditEventMutation) ImpersonatorID() (r uuid.UUID, exists bool) {
+	v := m.impersonator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImpersonatorID returns the old "impersonator_id" field's value of the FileAuditEvent entity.
+// If the FileAuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileAuditEventMutation) OldImpersonatorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImpersonatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImpersonatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImpersonatorID: %w", err)
+	}
+	return oldValue.ImpersonatorID, nil
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (m *FileAuditEventMutation) ClearImpersonatorID() {
+	m.impersonator_id = nil
+	m.clearedFields[fileauditevent.FieldImpersonatorID] = struct{}{}
+}
+
+// ImpersonatorIDCleared returns if the "impersonator_id" field was cleared in this mutation.
+func (m *FileAuditEventMutation) ImpersonatorIDCleared() bool {
+	_, ok := m.clearedFields[fileauditevent.FieldImpersonatorID]
+	return ok
+}
+
+// ResetImpersonatorID resets all changes to the "impersonator_id" field.
+func (m *FileAuditEventMutation) ResetImpersonatorID() {
+	m.impersonator_id = nil
+	delete(m.clearedFields, fileauditevent.FieldImpersonatorID)
+}
+
+// SetDetails sets the "details" field.
+func (m *FileAuditEventMutation) SetDetails(value map[string]interface{}) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *FileAuditEventMutation) Details() (r map[string]interface{}, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the FileAuditEvent entity.
+// If the FileAuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileAuditEventMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *FileAuditEventMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[fileauditevent.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *FileAuditEventMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[fileauditevent.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *FileAuditEventMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, fileauditevent.FieldDetails)
+}
+
+// Where appends a list predicates to the FileAuditEventMutation builder.
+func (m *FileAuditEventMutation) Where(ps ...predicate.FileAuditEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileAuditEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileAuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileAuditEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileAuditEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileAuditEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FileAuditEvent).
+func (m *FileAuditEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileAuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.tenant_id != nil {
+		fields = append(fields, fileauditevent.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, fileauditevent.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, fileauditevent.FieldUpdateTime)
+	}
+	if m.action != nil {
+		fields = append(fields, fileauditevent.FieldAction)
+	}
+	if m.file_id != nil {
+		fields = append(fields, fileauditevent.FieldFileID)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, fileauditevent.FieldActorID)
+	}
+	if m.impersonator_id != nil {
+		fields = append(fields, fileauditevent.FieldImpersonatorID)
+	}
+	if m.details != nil {
+		fields = append(fields, fileauditevent.FieldDetails)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileAuditEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fileauditevent.FieldTenantID:
+		return m.TenantID()
+	case fileauditevent.FieldCreateTime:
+		return m.CreateTime()
+	case fileauditevent.FieldUpdateTime:
+		return m.UpdateTime()
+	case fileauditevent.FieldAction:
+		return m.Action()
+	case fileauditevent.FieldFileID:
+		return m.FileID()
+	case fileauditevent.FieldActorID:
+		return m.ActorID()
+	case fileauditevent.FieldImpersonatorID:
+		return m.ImpersonatorID()
+	case fileauditevent.FieldDetails:
+		return m.Details()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileAuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fileauditevent.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case fileauditevent.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case fileauditevent.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case fileauditevent.FieldAction:
+		return m.OldAction(ctx)
+	case fileauditevent.FieldFileID:
+		return m.OldFileID(ctx)
+	case fileauditevent.FieldActorID:
+		return m.OldActorID(ctx)
+	case fileauditevent.FieldImpersonatorID:
+		return m.OldImpersonatorID(ctx)
+	case fileauditevent.FieldDetails:
+		return m.OldDetails(ctx)
+	}
+	return nil, fmt.Errorf("unknown FileAuditEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileAuditEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fileauditevent.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case fileauditevent.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case fileauditevent.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case fileauditevent.FieldAction:
+		v, ok := value.(fileauditevent.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case fileauditevent.FieldFileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case fileauditevent.FieldActorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case fileauditevent.FieldImpersonatorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImpersonatorID(v)
+		return nil
+	case fileauditevent.FieldDetails:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileAuditEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileAuditEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileAuditEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileAuditEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FileAuditEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileAuditEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fileauditevent.FieldFileID) {
+		fields = append(fields, fileauditevent.FieldFileID)
+	}
+	if m.FieldCleared(fileauditevent.FieldActorID) {
+		fields = append(fields, fileauditevent.FieldActorID)
+	}
+	if m.FieldCleared(fileauditevent.FieldImpersonatorID) {
+		fields = append(fields, fileauditevent.FieldImpersonatorID)
+	}
+	if m.FieldCleared(fileauditevent.FieldDetails) {
+		fields = append(fields, fileauditevent.FieldDetails)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileAuditEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileAuditEventMutation) ClearField(name string) error {
+	switch name {
+	case fileauditevent.FieldFileID:
+		m.ClearFileID()
+		return nil
+	case fileauditevent.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case fileauditevent.FieldImpersonatorID:
+		m.ClearImpersonatorID()
+		return nil
+	case fileauditevent.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown FileAuditEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileAuditEventMutation) ResetField(name string) error {
+	switch name {
+	case fileauditevent.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case fileauditevent.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case fileauditevent.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case fileauditevent.FieldAction:
+		m.ResetAction()
+		return nil
+	case fileauditevent.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case fileauditevent.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case fileauditevent.FieldImpersonatorID:
+		m.ResetImpersonatorID()
+		return nil
+	case fileauditevent.FieldDetails:
+		m.ResetDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown FileAuditEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileAuditEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileAuditEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileAuditEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileAuditEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileAuditEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileAuditEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileAuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FileAuditEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileAuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FileAuditEvent edge %s", name)
+}
+
+// FileFavoriteMutation represents an operation that mutates the FileFavorite nodes in the graph.
+type FileFavoriteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	tenant_id     *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	user_id       *uuid.UUID
+	clearedFields map[string]struct{}
+	file          *uuid.UUID
+	clearedfile   bool
+	done          bool
+	oldValue      func(context.Context) (*FileFavorite, error)
+	predicates    []predicate.FileFavorite
+}
+
+var _ ent.Mutation = (*FileFavoriteMutation)(nil)
+
+// filefavoriteOption allows management of the mutation configuration using functional options.
+type filefavoriteOption func(*FileFavoriteMutation)
+
+// newFileFavoriteMutation creates new mutation for the FileFavorite entity.
+func newFileFavoriteMutation(c config, op Op, opts ...filefavoriteOption) *FileFavoriteMutation {
+	m := &FileFavoriteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileFavorite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileFavoriteID sets the ID field of the mutation.
+func withFileFavoriteID(id uuid.UUID) filefavoriteOption {
+	return func(m *FileFavoriteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FileFavorite
+		)
+		m.oldValue = func(ctx context.Context) (*FileFavorite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FileFavorite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFileFavorite sets the old FileFavorite of the mutation.
+func withFileFavorite(node *FileFavorite) filefavoriteOption {
+	return func(m *FileFavoriteMutation) {
+		m.oldValue = func(context.Context) (*FileFavorite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileFavoriteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileFavoriteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FileFavorite entities.
+func (m *FileFavoriteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileFavoriteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileFavoriteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FileFavorite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *FileFavoriteMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *FileFavoriteMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the FileFavorite entity.
+// If the FileFavorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileFavoriteMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *FileFavoriteMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FileFavoriteMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FileFavoriteMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the FileFavorite entity.
+// If the FileFavorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileFavoriteMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FileFavoriteMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FileFavoriteMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FileFavoriteMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the FileFavorite entity.
+// If the FileFavorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileFavoriteMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FileFavoriteMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetFileID sets the "file_id" field.
+func (m *FileFavoriteMutation) SetFileID(u uuid.UUID) {
+	m.file = &u
+}
+
+// FileID returns the value of the "file_id" field in the mutation.
+func (m *FileFavoriteMutation) FileID() (r uuid.UUID, exists bool) {
+	v := m.file
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileID returns the old "file_id" field's value of the FileFavorite entity.
+// If the FileFavorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileFavoriteMutation) OldFileID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileID: %w", err)
+	}
+	return oldValue.FileID, nil
+}
+
+// ResetFileID resets all changes to the "file_id" field.
+func (m *FileFavoriteMutation) ResetFileID() {
+	m.file = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FileFavoriteMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FileFavoriteMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the FileFavorite entity.
+// If the FileFavorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileFavoriteMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FileFavoriteMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// ClearFile clears the "file" edge to the File entity.
+func (m *FileFavoriteMutation) ClearFile() {
+	m.clearedfile = true
+	m.clearedFields[filefavorite.FieldFileID] = struct{}{}
+}
+
+// FileCleared reports if the "file" edge to the File entity was cleared.
+func (m *FileFavoriteMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileIDs returns the "file" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *FileFavoriteMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile resets all changes to the "file" edge.
+func (m *FileFavoriteMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Where appends a list predicates to the FileFavoriteMutation builder.
+func (m *FileFavoriteMutation) Where(ps ...predicate.FileFavorite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileFavoriteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileFavoriteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileFavorite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileFavoriteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileFavoriteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FileFavorite).
+func (m *FileFavoriteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileFavoriteMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant_id != nil {
+		fields = append(fields, filefavorite.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, filefavorite.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, filefavorite.FieldUpdateTime)
+	}
+	if m.file != nil {
+		fields = append(fields, filefavorite.FieldFileID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, filefavorite.FieldUserID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileFavoriteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filefavorite.FieldTenantID:
+		return m.TenantID()
+	case filefavorite.FieldCreateTime:
+		return m.CreateTime()
+	case filefavorite.FieldUpdateTime:
+		return m.UpdateTime()
+	case filefavorite.FieldFileID:
+		return m.FileID()
+	case filefavorite.FieldUserID:
+		return m.UserID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileFavoriteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case filefavorite.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case filefavorite.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case filefavorite.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case filefavorite.FieldFileID:
+		return m.OldFileID(ctx)
+	case filefavorite.FieldUserID:
+		return m.OldUserID(ctx)
+	}
+	return nil, fmt.Errorf("unknown FileFavorite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileFavoriteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filefavorite.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case filefavorite.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case filefavorite.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case filefavorite.FieldFileID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileID(v)
+		return nil
+	case filefavorite.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileFavorite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileFavoriteMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileFavoriteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileFavoriteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FileFavorite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileFavoriteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileFavoriteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileFavoriteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FileFavorite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileFavoriteMutation) ResetField(name string) error {
+	switch name {
+	case filefavorite.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case filefavorite.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case filefavorite.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case filefavorite.FieldFileID:
+		m.ResetFileID()
+		return nil
+	case filefavorite.FieldUserID:
+		m.ResetUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown FileFavorite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileFavoriteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, filefavorite.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileFavoriteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case filefavorite.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileFavoriteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileFavoriteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileFavoriteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, filefavorite.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileFavoriteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case filefavorite.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileFavoriteMutation) ClearEdge(name string) error {
+	switch name {
+	case filefavorite.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileFavorite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileFavoriteMutation) ResetEdge(name string) error {
+	switch name {
+	case filefavorite.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileFavorite edge %s", name)
+}
+
+// FileSetMutation represents an operation that mutates the FileSet nodes in the graph.
+type FileSetMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	tenant_id              *uuid.UUID
+	create_time            *time.Time
+	update_time            *time.Time
+	created_by             *uuid.UUID
+	name                   *string
+	description            *string
+	file_ids               *[]uuid.UUID
+	appendfile_ids         []uuid.UUID
+	shared_with_tenant     *bool
+	shared_user_ids        *[]uuid.UUID
+	appendshared_user_ids  []uuid.UUID
+	granted_file_ids       *[]uuid.UUID
+	appendgranted_file_ids []uuid.UUID
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*FileSet, error)
+	predicates             []predicate.FileSet
+}
+
+var _ ent.Mutation = (*FileSetMutation)(nil)
+
+// filesetOption allows management of the mutation configuration using functional options.
+type filesetOption func(*FileSetMutation)
+
+// newFileSetMutation creates new mutation for the FileSet entity.
+func newFileSetMutation(c config, op Op, opts ...filesetOption) *FileSetMutation {
+	m := &FileSetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileSet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileSetID sets the ID field of the mutation.
+func withFileSetID(id uuid.UUID) filesetOption {
+	return func(m *FileSetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FileSet
+		)
+		m.oldValue = func(ctx context.Context) (*FileSet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FileSet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFileSet sets the old FileSet of the mutation.
+func withFileSet(node *FileSet) filesetOption {
+	return func(m *FileSetMutation) {
+		m.oldValue = func(context.Context) (*FileSet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileSetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileSetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FileSet entities.
+func (m *FileSetMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileSetMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileSetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FileSet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *FileSetMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *FileSetMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *FileSetMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *FileSetMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *FileSetMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *FileSetMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *FileSetMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *FileSetMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *FileSetMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *FileSetMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *FileSetMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *FileSetMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetName sets the "name" field.
+func (m *FileSetMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FileSetMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FileSetMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *FileSetMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *FileSetMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *FileSetMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[fileset.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *FileSetMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[fileset.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *FileSetMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, fileset.FieldDescription)
+}
+
+// SetFileIds sets the "file_ids" field.
+func (m *FileSetMutation) SetFileIds(u []uuid.UUID) {
+	m.file_ids = &u
+	m.appendfile_ids = nil
+}
+
+// FileIds returns the value of the "file_ids" field in the mutation.
+func (m *FileSetMutation) FileIds() (r []uuid.UUID, exists bool) {
+	v := m.file_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileIds returns the old "file_ids" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldFileIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileIds: %w", err)
+	}
+	return oldValue.FileIds, nil
+}
+
+// AppendFileIds adds u to the "file_ids" field.
+func (m *FileSetMutation) AppendFileIds(u []uuid.UUID) {
+	m.appendfile_ids = append(m.appendfile_ids, u...)
+}
+
+// AppendedFileIds returns the list of values that were appended to the "file_ids" field in this mutation.
+func (m *FileSetMutation) AppendedFileIds() ([]uuid.UUID, bool) {
+	if len(m.appendfile_ids) == 0 {
+		return nil, false
+	}
+	return m.appendfile_ids, true
+}
+
+// ResetFileIds resets all changes to the "file_ids" field.
+func (m *FileSetMutation) ResetFileIds() {
+	m.file_ids = nil
+	m.appendfile_ids = nil
+}
+
+// SetSharedWithTenant sets the "shared_with_tenant" field.
+func (m *FileSetMutation) SetSharedWithTenant(b bool) {
+	m.shared_with_tenant = &b
+}
+
+// SharedWithTenant returns the value of the "shared_with_tenant" field in the mutation.
+func (m *FileSetMutation) SharedWithTenant() (r bool, exists bool) {
+	v := m.shared_with_tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSharedWithTenant returns the old "shared_with_tenant" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldSharedWithTenant(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSharedWithTenant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSharedWithTenant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSharedWithTenant: %w", err)
+	}
+	return oldValue.SharedWithTenant, nil
+}
+
+// ResetSharedWithTenant resets all changes to the "shared_with_tenant" field.
+func (m *FileSetMutation) ResetSharedWithTenant() {
+	m.shared_with_tenant = nil
+}
+
+// SetSharedUserIds sets the "shared_user_ids" field.
+func (m *FileSetMutation) SetSharedUserIds(u []uuid.UUID) {
+	m.shared_user_ids = &u
+	m.appendshared_user_ids = nil
+}
+
+// SharedUserIds returns the value of the "shared_user_ids" field in the mutation.
+func (m *FileSetMutation) SharedUserIds() (r []uuid.UUID, exists bool) {
+	v := m.shared_user_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSharedUserIds returns the old "shared_user_ids" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldSharedUserIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSharedUserIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSharedUserIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSharedUserIds: %w", err)
+	}
+	return oldValue.SharedUserIds, nil
+}
+
+// AppendSharedUserIds adds u to the "shared_user_ids" field.
+func (m *FileSetMutation) AppendSharedUserIds(u []uuid.UUID) {
+	m.appendshared_user_ids = append(m.appendshared_user_ids, u...)
+}
+
+// AppendedSharedUserIds returns the list of values that were appended to the "shared_user_ids" field in this mutation.
+func (m *FileSetMutation) AppendedSharedUserIds() ([]uuid.UUID, bool) {
+	if len(m.appendshared_user_ids) == 0 {
+		return nil, false
+	}
+	return m.appendshared_user_ids, true
+}
+
+// ClearSharedUserIds clears the value of the "shared_user_ids" field.
+func (m *FileSetMutation) ClearSharedUserIds() {
+	m.shared_user_ids = nil
+	m.appendshared_user_ids = nil
+	m.clearedFields[fileset.FieldSharedUserIds] = struct{}{}
+}
+
+// SharedUserIdsCleared returns if the "shared_user_ids" field was cleared in this mutation.
+func (m *FileSetMutation) SharedUserIdsCleared() bool {
+	_, ok := m.clearedFields[fileset.FieldSharedUserIds]
+	return ok
+}
+
+// ResetSharedUserIds resets all changes to the "shared_user_ids" field.
+func (m *FileSetMutation) ResetSharedUserIds() {
+	m.shared_user_ids = nil
+	m.appendshared_user_ids = nil
+	delete(m.clearedFields, fileset.FieldSharedUserIds)
+}
+
+// SetGrantedFileIds sets the "granted_file_ids" field.
+func (m *FileSetMutation) SetGrantedFileIds(u []uuid.UUID) {
+	m.granted_file_ids = &u
+	m.appendgranted_file_ids = nil
+}
+
+// GrantedFileIds returns the value of the "granted_file_ids" field in the mutation.
+func (m *FileSetMutation) GrantedFileIds() (r []uuid.UUID, exists bool) {
+	v := m.granted_file_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantedFileIds returns the old "granted_file_ids" field's value of the FileSet entity.
+// If the FileSet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSetMutation) OldGrantedFileIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedFileIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedFileIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedFileIds: %w", err)
+	}
+	return oldValue.GrantedFileIds, nil
+}
+
+// AppendGrantedFileIds adds u to the "granted_file_ids" field.
+func (m *FileSetMutation) AppendGrantedFileIds(u []uuid.UUID) {
+	m.appendgranted_file_ids = append(m.appendgranted_file_ids, u...)
+}
+
+// AppendedGrantedFileIds returns the list of values that were appended to the "granted_file_ids" field in this mutation.
+func (m *FileSetMutation) AppendedGrantedFileIds() ([]uuid.UUID, bool) {
+	if len(m.appendgranted_file_ids) == 0 {
+		return nil, false
+	}
+	return m.appendgranted_file_ids, true
+}
+
+// ClearGrantedFileIds clears the value of the "granted_file_ids" field.
+func (m *FileSetMutation) ClearGrantedFileIds() {
+	m.granted_file_ids = nil
+	m.appendgranted_file_ids = nil
+	m.clearedFields[fileset.FieldGrantedFileIds] = struct{}{}
+}
+
+// GrantedFileIdsCleared returns if the "granted_file_ids" field was cleared in this mutation.
+func (m *FileSetMutation) GrantedFileIdsCleared() bool {
+	_, ok := m.clearedFields[fileset.FieldGrantedFileIds]
+	return ok
+}
+
+// ResetGrantedFileIds resets all changes to the "granted_file_ids" field.
+func (m *FileSetMutation) ResetGrantedFileIds() {
+	m.granted_file_ids = nil
+	m.appendgranted_file_ids = nil
+	delete(m.clearedFields, fileset.FieldGrantedFileIds)
+}
+
+// Where appends a list predicates to the FileSetMutation builder.
+func (m *FileSetMutation) Where(ps ...predicate.FileSet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileSetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileSetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileSet, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileSetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileSetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FileSet).
+func (m *FileSetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileSetMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.tenant_id != nil {
+		fields = append(fields, fileset.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, fileset.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, fileset.FieldUpdateTime)
+	}
+	if m.created_by != nil {
+		fields = append(fields, fileset.FieldCreatedBy)
+	}
+	if m.name != nil {
+		fields = append(fields, fileset.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, fileset.FieldDescription)
+	}
+	if m.file_ids != nil {
+		fields = append(fields, fileset.FieldFileIds)
+	}
+	if m.shared_with_tenant != nil {
+		fields = append(fields, fileset.FieldSharedWithTenant)
+	}
+	if m.shared_user_ids != nil {
+		fields = append(fields, fileset.FieldSharedUserIds)
+	}
+	if m.granted_file_ids != nil {
+		fields = append(fields, fileset.FieldGrantedFileIds)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileSetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fileset.FieldTenantID:
+		return m.TenantID()
+	case fileset.FieldCreateTime:
+		return m.CreateTime()
+	case fileset.FieldUpdateTime:
+		return m.UpdateTime()
+	case fileset.FieldCreatedBy:
+		return m.CreatedBy()
+	case fileset.FieldName:
+		return m.Name()
+	case fileset.FieldDescription:
+		return m.Description()
+	case fileset.FieldFileIds:
+		return m.FileIds()
+	case fileset.FieldSharedWithTenant:
+		return m.SharedWithTenant()
+	case fileset.FieldSharedUserIds:
+		return m.SharedUserIds()
+	case fileset.FieldGrantedFileIds:
+		return m.GrantedFileIds()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileSetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fileset.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case fileset.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case fileset.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case fileset.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case fileset.FieldName:
+		return m.OldName(ctx)
+	case fileset.FieldDescription:
+		return m.OldDescription(ctx)
+	case fileset.FieldFileIds:
+		return m.OldFileIds(ctx)
+	case fileset.FieldSharedWithTenant:
+		return m.OldSharedWithTenant(ctx)
+	case fileset.FieldSharedUserIds:
+		return m.OldSharedUserIds(ctx)
+	case fileset.FieldGrantedFileIds:
+		return m.OldGrantedFileIds(ctx)
+	}
+	return nil, fmt.Errorf("unknown FileSet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileSetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fileset.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case fileset.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case fileset.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case fileset.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case fileset.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case fileset.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case fileset.FieldFileIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileIds(v)
+		return nil
+	case fileset.FieldSharedWithTenant:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSharedWithTenant(v)
+		return nil
+	case fileset.FieldSharedUserIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSharedUserIds(v)
+		return nil
+	case fileset.FieldGrantedFileIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantedFileIds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileSet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileSetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileSetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileSetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FileSet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileSetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fileset.FieldDescription) {
+		fields = append(fields, fileset.FieldDescription)
+	}
+	if m.FieldCleared(fileset.FieldSharedUserIds) {
+		fields = append(fields, fileset.FieldSharedUserIds)
+	}
+	if m.FieldCleared(fileset.FieldGrantedFileIds) {
+		fields = append(fields, fileset.FieldGrantedFileIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileSetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileSetMutation) ClearField(name string) error {
+	switch name {
+	case fileset.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case fileset.FieldSharedUserIds:
+		m.ClearSharedUserIds()
+		return nil
+	case fileset.FieldGrantedFileIds:
+		m.ClearGrantedFileIds()
+		return nil
+	}
+	return fmt.Errorf("unknown FileSet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileSetMutation) ResetField(name string) error {
+	switch name {
+	case fileset.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case fileset.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case fileset.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case fileset.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case fileset.FieldName:
+		m.ResetName()
+		return nil
+	case fileset.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case fileset.FieldFileIds:
+		m.ResetFileIds()
+		return nil
+	case fileset.FieldSharedWithTenant:
+		m.ResetSharedWithTenant()
+		return nil
+	case fileset.FieldSharedUserIds:
+		m.ResetSharedUserIds()
+		return nil
+	case fileset.FieldGrantedFileIds:
+		m.ResetGrantedFileIds()
+		return nil
+	}
+	return fmt.Errorf("unknown FileSet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileSetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileSetMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileSetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileSetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileSetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileSetMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileSetMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FileSet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileSetMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FileSet edge %s", name)
+}
+
+// ImpersonationGrantMutation represents an operation that mutates the ImpersonationGrant nodes in the graph.
+type ImpersonationGrantMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uuid.UUID
+	tenant_id                   *uuid.UUID
+	create_time                 *time.Time
+	update_time                 *time.Time
+	support_user_id             *uuid.UUID
+	target_user_id              *uuid.UUID
+	target_role                 *impersonationgrant.TargetRole
+	target_department_ids       *[]uuid.UUID
+	appendtarget_department_ids []uuid.UUID
+	granted_by                  *uuid.UUID
+	reason                      *string
+	token_hash                  *string
+	expires_at                  *time.Time
+	revoked_at                  *time.Time
+	clearedFields               map[string]struct{}
+	done                        bool
+	oldValue                    func(context.Context) (*ImpersonationGrant, error)
+	predicates                  []predicate.ImpersonationGrant
+}
+
+var _ ent.Mutation = (*ImpersonationGrantMutation)(nil)
+
+// impersonationgrantOption allows management of the mutation configuration using functional options.
+type impersonationgrantOption func(*ImpersonationGrantMutation)
+
+// newImpersonationGrantMutation creates new mutation for the ImpersonationGrant entity.
+func newImpersonationGrantMutation(c config, op Op, opts ...impersonationgrantOption) *ImpersonationGrantMutation {
+	m := &ImpersonationGrantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImpersonationGrant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImpersonationGrantID sets the ID field of the mutation.
+func withImpersonationGrantID(id uuid.UUID) impersonationgrantOption {
+	return func(m *ImpersonationGrantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ImpersonationGrant
+		)
+		m.oldValue = func(ctx context.Context) (*ImpersonationGrant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ImpersonationGrant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImpersonationGrant sets the old ImpersonationGrant of the mutation.
+func withImpersonationGrant(node *ImpersonationGrant) impersonationgrantOption {
+	return func(m *ImpersonationGrantMutation) {
+		m.oldValue = func(context.Context) (*ImpersonationGrant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImpersonationGrantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImpersonationGrantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ImpersonationGrant entities.
+func (m *ImpersonationGrantMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImpersonationGrantMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImpersonationGrantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ImpersonationGrant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ImpersonationGrantMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ImpersonationGrantMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ImpersonationGrantMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ImpersonationGrantMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ImpersonationGrantMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ImpersonationGrantMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ImpersonationGrantMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ImpersonationGrantMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ImpersonationGrantMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetSupportUserID sets the "support_user_id" field.
+func (m *ImpersonationGrantMutation) SetSupportUserID(u uuid.UUID) {
+	m.support_user_id = &u
+}
+
+// SupportUserID returns the value of the "support_user_id" field in the mutation.
+func (m *ImpersonationGrantMutation) SupportUserID() (r uuid.UUID, exists bool) {
+	v := m.support_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSupportUserID returns the old "support_user_id" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldSupportUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSupportUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSupportUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSupportUserID: %w", err)
+	}
+	return oldValue.SupportUserID, nil
+}
+
+// ResetSupportUserID resets all changes to the "support_user_id" field.
+func (m *ImpersonationGrantMutation) ResetSupportUserID() {
+	m.support_user_id = nil
+}
+
+// SetTargetUserID sets the "target_user_id" field.
+func (m *ImpersonationGrantMutation) SetTargetUserID(u uuid.UUID) {
+	m.target_user_id = &u
+}
+
+// TargetUserID returns the value of the "target_user_id" field in the mutation.
+func (m *ImpersonationGrantMutation) TargetUserID() (r uuid.UUID, exists bool) {
+	v := m.target_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetUserID returns the old "target_user_id" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldTargetUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetUserID: %w", err)
+	}
+	return oldValue.TargetUserID, nil
+}
+
+// ResetTargetUserID resets all changes to the "target_user_id" field.
+func (m *ImpersonationGrantMutation) ResetTargetUserID() {
+	m.target_user_id = nil
+}
+
+// SetTargetRole sets the "target_role" field.
+func (m *ImpersonationGrantMutation) SetTargetRole(ir impersonationgrant.TargetRole) {
+	m.target_role = &ir
+}
+
+// TargetRole returns the value of the "target_role" field in the mutation.
+func (m *ImpersonationGrantMutation) TargetRole() (r impersonationgrant.TargetRole, exists bool) {
+	v := m.target_role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetRole returns the old "target_role" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldTargetRole(ctx context.Context) (v impersonationgrant.TargetRole, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetRole: %w", err)
+	}
+	return oldValue.TargetRole, nil
+}
+
+// ResetTargetRole resets all changes to the "target_role" field.
+func (m *ImpersonationGrantMutation) ResetTargetRole() {
+	m.target_role = nil
+}
+
+// SetTargetDepartmentIds sets the "target_department_ids" field.
+func (m *ImpersonationGrantMutation) SetTargetDepartmentIds(u []uuid.UUID) {
+	m.target_department_ids = &u
+	m.appendtarget_department_ids = nil
+}
+
+// TargetDepartmentIds returns the value of the "target_department_ids" field in the mutation.
+func (m *ImpersonationGrantMutation) TargetDepartmentIds() (r []uuid.UUID, exists bool) {
+	v := m.target_department_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetDepartmentIds returns the old "target_department_ids" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldTargetDepartmentIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetDepartmentIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetDepartmentIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetDepartmentIds: %w", err)
+	}
+	return oldValue.TargetDepartmentIds, nil
+}
+
+// AppendTargetDepartmentIds adds u to the "target_department_ids" field.
+func (m *ImpersonationGrantMutation) AppendTargetDepartmentIds(u []uuid.UUID) {
+	m.appendtarget_department_ids = append(m.appendtarget_department_ids, u...)
+}
+
+// AppendedTargetDepartmentIds returns the list of values that were appended to the "target_department_ids" field in this mutation.
+func (m *ImpersonationGrantMutation) AppendedTargetDepartmentIds() ([]uuid.UUID, bool) {
+	if len(m.appendtarget_department_ids) == 0 {
+		return nil, false
+	}
+	return m.appendtarget_department_ids, true
+}
+
+// ClearTargetDepartmentIds clears the value of the "target_department_ids" field.
+func (m *ImpersonationGrantMutation) ClearTargetDepartmentIds() {
+	m.target_department_ids = nil
+	m.appendtarget_department_ids = nil
+	m.clearedFields[impersonationgrant.FieldTargetDepartmentIds] = struct{}{}
+}
+
+// TargetDepartmentIdsCleared returns if the "target_department_ids" field was cleared in this mutation.
+func (m *ImpersonationGrantMutation) TargetDepartmentIdsCleared() bool {
+	_, ok := m.clearedFields[impersonationgrant.FieldTargetDepartmentIds]
+	return ok
+}
+
+// ResetTargetDepartmentIds resets all changes to the "target_department_ids" field.
+func (m *ImpersonationGrantMutation) ResetTargetDepartmentIds() {
+	m.target_department_ids = nil
+	m.appendtarget_department_ids = nil
+	delete(m.clearedFields, impersonationgrant.FieldTargetDepartmentIds)
+}
+
+// SetGrantedBy sets the "granted_by" field.
+func (m *ImpersonationGrantMutation) SetGrantedBy(u uuid.UUID) {
+	m.granted_by = &u
+}
+
+// GrantedBy returns the value of the "granted_by" field in the mutation.
+func (m *ImpersonationGrantMutation) GrantedBy() (r uuid.UUID, exists bool) {
+	v := m.granted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantedBy returns the old "granted_by" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldGrantedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedBy: %w", err)
+	}
+	return oldValue.GrantedBy, nil
+}
+
+// ResetGrantedBy resets all changes to the "granted_by" field.
+func (m *ImpersonationGrantMutation) ResetGrantedBy() {
+	m.granted_by = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ImpersonationGrantMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ImpersonationGrantMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ImpersonationGrantMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *ImpersonationGrantMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *ImpersonationGrantMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *ImpersonationGrantMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ImpersonationGrantMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ImpersonationGrantMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ImpersonationGrantMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *ImpersonationGrantMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *ImpersonationGrantMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the ImpersonationGrant entity.
+// If the ImpersonationGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImpersonationGrantMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *ImpersonationGrantMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[impersonationgrant.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *ImpersonationGrantMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[impersonationgrant.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *ImpersonationGrantMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, impersonationgrant.FieldRevokedAt)
+}
+
+// Where appends a list predicates to the ImpersonationGrantMutation builder.
+func (m *ImpersonationGrantMutation) Where(ps ...predicate.ImpersonationGrant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImpersonationGrantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImpersonationGrantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ImpersonationGrant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImpersonationGrantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImpersonationGrantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ImpersonationGrant).
+func (m *ImpersonationGrantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImpersonationGrantMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.tenant_id != nil {
+		fields = append(fields, impersonationgrant.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, impersonationgrant.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, impersonationgrant.FieldUpdateTime)
+	}
+	if m.support_user_id != nil {
+		fields = append(fields, impersonationgrant.FieldSupportUserID)
+	}
+	if m.target_user_id != nil {
+		fields = append(fields, impersonationgrant.FieldTargetUserID)
+	}
+	if m.target_role != nil {
+		fields = append(fields, impersonationgrant.FieldTargetRole)
+	}
+	if m.target_department_ids != nil {
+		fields = append(fields, impersonationgrant.FieldTargetDepartmentIds)
+	}
+	if m.granted_by != nil {
+		fields = append(fields, impersonationgrant.FieldGrantedBy)
+	}
+	if m.reason != nil {
+		fields = append(fields, impersonationgrant.FieldReason)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, impersonationgrant.FieldTokenHash)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, impersonationgrant.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, impersonationgrant.FieldRevokedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImpersonationGrantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case impersonationgrant.FieldTenantID:
+		return m.TenantID()
+	case impersonationgrant.FieldCreateTime:
+		return m.CreateTime()
+	case impersonationgrant.FieldUpdateTime:
+		return m.UpdateTime()
+	case impersonationgrant.FieldSupportUserID:
+		return m.SupportUserID()
+	case impersonationgrant.FieldTargetUserID:
+		return m.TargetUserID()
+	case impersonationgrant.FieldTargetRole:
+		return m.TargetRole()
+	case impersonationgrant.FieldTargetDepartmentIds:
+		return m.TargetDepartmentIds()
+	case impersonationgrant.FieldGrantedBy:
+		return m.GrantedBy()
+	case impersonationgrant.FieldReason:
+		return m.Reason()
+	case impersonationgrant.FieldTokenHash:
+		return m.TokenHash()
+	case impersonationgrant.FieldExpiresAt:
+		return m.ExpiresAt()
+	case impersonationgrant.FieldRevokedAt:
+		return m.RevokedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImpersonationGrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case impersonationgrant.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case impersonationgrant.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case impersonationgrant.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case impersonationgrant.FieldSupportUserID:
+		return m.OldSupportUserID(ctx)
+	case impersonationgrant.FieldTargetUserID:
+		return m.OldTargetUserID(ctx)
+	case impersonationgrant.FieldTargetRole:
+		return m.OldTargetRole(ctx)
+	case impersonationgrant.FieldTargetDepartmentIds:
+		return m.OldTargetDepartmentIds(ctx)
+	case impersonationgrant.FieldGrantedBy:
+		return m.OldGrantedBy(ctx)
+	case impersonationgrant.FieldReason:
+		return m.OldReason(ctx)
+	case impersonationgrant.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case impersonationgrant.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case impersonationgrant.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ImpersonationGrant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImpersonationGrantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case impersonationgrant.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case impersonationgrant.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case impersonationgrant.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case impersonationgrant.FieldSupportUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSupportUserID(v)
+		return nil
+	case impersonationgrant.FieldTargetUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetUserID(v)
+		return nil
+	case impersonationgrant.FieldTargetRole:
+		v, ok := value.(impersonationgrant.TargetRole)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetRole(v)
+		return nil
+	case impersonationgrant.FieldTargetDepartmentIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetDepartmentIds(v)
+		return nil
+	case impersonationgrant.FieldGrantedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantedBy(v)
+		return nil
+	case impersonationgrant.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case impersonationgrant.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case impersonationgrant.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case impersonationgrant.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ImpersonationGrant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImpersonationGrantMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImpersonationGrantMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImpersonationGrantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ImpersonationGrant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImpersonationGrantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(impersonationgrant.FieldTargetDepartmentIds) {
+		fields = append(fields, impersonationgrant.FieldTargetDepartmentIds)
+	}
+	if m.FieldCleared(impersonationgrant.FieldRevokedAt) {
+		fields = append(fields, impersonationgrant.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImpersonationGrantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImpersonationGrantMutation) ClearField(name string) error {
+	switch name {
+	case impersonationgrant.FieldTargetDepartmentIds:
+		m.ClearTargetDepartmentIds()
+		return nil
+	case impersonationgrant.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImpersonationGrant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImpersonationGrantMutation) ResetField(name string) error {
+	switch name {
+	case impersonationgrant.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case impersonationgrant.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case impersonationgrant.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case impersonationgrant.FieldSupportUserID:
+		m.ResetSupportUserID()
+		return nil
+	case impersonationgrant.FieldTargetUserID:
+		m.ResetTargetUserID()
+		return nil
+	case impersonationgrant.FieldTargetRole:
+		m.ResetTargetRole()
+		return nil
+	case impersonationgrant.FieldTargetDepartmentIds:
+		m.ResetTargetDepartmentIds()
+		return nil
+	case impersonationgrant.FieldGrantedBy:
+		m.ResetGrantedBy()
+		return nil
+	case impersonationgrant.FieldReason:
+		m.ResetReason()
+		return nil
+	case impersonationgrant.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case impersonationgrant.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case impersonationgrant.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ImpersonationGrant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImpersonationGrantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImpersonationGrantMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImpersonationGrantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImpersonationGrantMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImpersonationGrantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImpersonationGrantMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImpersonationGrantMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ImpersonationGrant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImpersonationGrantMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ImpersonationGrant edge %s", name)
+}
+
+// LifecycleRuleMutation represents an operation that mutates the LifecycleRule nodes in the graph.
+type LifecycleRuleMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	tenant_id        *uuid.UUID
+	create_time      *time.Time
+	update_time      *time.Time
+	name             *string
+	enabled          *bool
+	action           *lifecyclerule.Action
+	min_age_days     *int
+	addmin_age_days  *int
+	min_idle_days    *int
+	addmin_idle_days *int
+	tag              *string
+	mime_category    *string
+	created_by       *uuid.UUID
+	last_run_at      *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*LifecycleRule, error)
+	predicates       []predicate.LifecycleRule
+}
+
+var _ ent.Mutation = (*LifecycleRuleMutation)(nil)
+
+// lifecycleruleOption allows management of the mutation configuration using functional options.
+type lifecycleruleOption func(*LifecycleRuleMutation)
+
+// newLifecycleRuleMutation creates new mutation for the LifecycleRule entity.
+func newLifecycleRuleMutation(c config, op Op, opts ...lifecycleruleOption) *LifecycleRuleMutation {
+	m := &LifecycleRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLifecycleRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLifecycleRuleID sets the ID field of the mutation.
+func withLifecycleRuleID(id uuid.UUID) lifecycleruleOption {
+	return func(m *LifecycleRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LifecycleRule
+		)
+		m.oldValue = func(ctx context.Context) (*LifecycleRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LifecycleRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLifecycleRule sets the old LifecycleRule of the mutation.
+func withLifecycleRule(node *LifecycleRule) lifecycleruleOption {
+	return func(m *LifecycleRuleMutation) {
+		m.oldValue = func(context.Context) (*LifecycleRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LifecycleRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LifecycleRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LifecycleRule entities.
+func (m *LifecycleRuleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LifecycleRuleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LifecycleRuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LifecycleRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *LifecycleRuleMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *LifecycleRuleMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *LifecycleRuleMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *LifecycleRuleMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *LifecycleRuleMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *LifecycleRuleMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *LifecycleRuleMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *LifecycleRuleMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *LifecycleRuleMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *LifecycleRuleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LifecycleRuleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LifecycleRuleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *LifecycleRuleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *LifecycleRuleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *LifecycleRuleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetAction sets the "action" field.
+func (m *LifecycleRuleMutation) SetAction(l lifecyclerule.Action) {
+	m.action = &l
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *LifecycleRuleMutation) Action() (r lifecyclerule.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldAction(ctx context.Context) (v lifecyclerule.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *LifecycleRuleMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetMinAgeDays sets the "min_age_days" field.
+func (m *LifecycleRuleMutation) SetMinAgeDays(i int) {
+	m.min_age_days = &i
+	m.addmin_age_days = nil
+}
+
+// MinAgeDays returns the value of the "min_age_days" field in the mutation.
+func (m *LifecycleRuleMutation) MinAgeDays() (r int, exists bool) {
+	v := m.min_age_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinAgeDays returns the old "min_age_days" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldMinAgeDays(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinAgeDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinAgeDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinAgeDays: %w", err)
+	}
+	return oldValue.MinAgeDays, nil
+}
+
+// AddMinAgeDays adds i to the "min_age_days" field.
+func (m *LifecycleRuleMutation) AddMinAgeDays(i int) {
+	if m.addmin_age_days != nil {
+		*m.addmin_age_days += i
+	} else {
+		m.addmin_age_days = &i
+	}
+}
+
+// AddedMinAgeDays returns the value that was added to the "min_age_days" field in this mutation.
+func (m *LifecycleRuleMutation) AddedMinAgeDays() (r int, exists bool) {
+	v := m.addmin_age_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMinAgeDays clears the value of the "min_age_days" field.
+func (m *LifecycleRuleMutation) ClearMinAgeDays() {
+	m.min_age_days = nil
+	m.addmin_age_days = nil
+	m.clearedFields[lifecyclerule.FieldMinAgeDays] = struct{}{}
+}
+
+// MinAgeDaysCleared returns if the "min_age_days" field was cleared in this mutation.
+func (m *LifecycleRuleMutation) MinAgeDaysCleared() bool {
+	_, ok := m.clearedFields[lifecyclerule.FieldMinAgeDays]
+	return ok
+}
+
+// ResetMinAgeDays resets all changes to the "min_age_days" field.
+func (m *LifecycleRuleMutation) ResetMinAgeDays() {
+	m.min_age_days = nil
+	m.addmin_age_days = nil
+	delete(m.clearedFields, lifecyclerule.FieldMinAgeDays)
+}
+
+// SetMinIdleDays sets the "min_idle_days" field.
+func (m *LifecycleRuleMutation) SetMinIdleDays(i int) {
+	m.min_idle_days = &i
+	m.addmin_idle_days = nil
+}
+
+// MinIdleDays returns the value of the "min_idle_days" field in the mutation.
+func (m *LifecycleRuleMutation) MinIdleDays() (r int, exists bool) {
+	v := m.min_idle_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinIdleDays returns the old "min_idle_days" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldMinIdleDays(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinIdleDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinIdleDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinIdleDays: %w", err)
+	}
+	return oldValue.MinIdleDays, nil
+}
+
+// AddMinIdleDays adds i to the "min_idle_days" field.
+func (m *LifecycleRuleMutation) AddMinIdleDays(i int) {
+	if m.addmin_idle_days != nil {
+		*m.addmin_idle_days += i
+	} else {
+		m.addmin_idle_days = &i
+	}
+}
+
+// AddedMinIdleDays returns the value that was added to the "min_idle_days" field in this mutation.
+func (m *LifecycleRuleMutation) AddedMinIdleDays() (r int, exists bool) {
+	v := m.addmin_idle_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMinIdleDays clears the value of the "min_idle_days" field.
+func (m *LifecycleRuleMutation) ClearMinIdleDays() {
+	m.min_idle_days = nil
+	m.addmin_idle_days = nil
+	m.clearedFields[lifecyclerule.FieldMinIdleDays] = struct{}{}
+}
+
+// MinIdleDaysCleared returns if the "min_idle_days" field was cleared in this mutation.
+func (m *LifecycleRuleMutation) MinIdleDaysCleared() bool {
+	_, ok := m.clearedFields[lifecyclerule.FieldMinIdleDays]
+	return ok
+}
+
+// ResetMinIdleDays resets all changes to the "min_idle_days" field.
+func (m *LifecycleRuleMutation) ResetMinIdleDays() {
+	m.min_idle_days = nil
+	m.addmin_idle_days = nil
+	delete(m.clearedFields, lifecyclerule.FieldMinIdleDays)
+}
+
+// SetTag sets the "tag" field.
+func (m *LifecycleRuleMutation) SetTag(s string) {
+	m.tag = &s
+}
+
+// Tag returns the value of the "tag" field in the mutation.
+func (m *LifecycleRuleMutation) Tag() (r string, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTag returns the old "tag" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldTag(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTag: %w", err)
+	}
+	return oldValue.Tag, nil
+}
+
+// ClearTag clears the value of the "tag" field.
+func (m *LifecycleRuleMutation) ClearTag() {
+	m.tag = nil
+	m.clearedFields[lifecyclerule.FieldTag] = struct{}{}
+}
+
+// TagCleared returns if the "tag" field was cleared in this mutation.
+func (m *LifecycleRuleMutation) TagCleared() bool {
+	_, ok := m.clearedFields[lifecyclerule.FieldTag]
+	return ok
+}
+
+// ResetTag resets all changes to the "tag" field.
+func (m *LifecycleRuleMutation) ResetTag() {
+	m.tag = nil
+	delete(m.clearedFields, lifecyclerule.FieldTag)
+}
+
+// SetMimeCategory sets the "mime_category" field.
+func (m *LifecycleRuleMutation) SetMimeCategory(s string) {
+	m.mime_category = &s
+}
+
+// MimeCategory returns the value of the "mime_category" field in the mutation.
+func (m *LifecycleRuleMutation) MimeCategory() (r string, exists bool) {
+	v := m.mime_category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMimeCategory returns the old "mime_category" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldMimeCategory(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMimeCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMimeCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMimeCategory: %w", err)
+	}
+	return oldValue.MimeCategory, nil
+}
+
+// ClearMimeCategory clears the value of the "mime_category" field.
+func (m *LifecycleRuleMutation) ClearMimeCategory() {
+	m.mime_category = nil
+	m.clearedFields[lifecyclerule.FieldMimeCategory] = struct{}{}
+}
+
+// MimeCategoryCleared returns if the "mime_category" field was cleared in this mutation.
+func (m *LifecycleRuleMutation) MimeCategoryCleared() bool {
+	_, ok := m.clearedFields[lifecyclerule.FieldMimeCategory]
+	return ok
+}
+
+// ResetMimeCategory resets all changes to the "mime_category" field.
+func (m *LifecycleRuleMutation) ResetMimeCategory() {
+	m.mime_category = nil
+	delete(m.clearedFields, lifecyclerule.FieldMimeCategory)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *LifecycleRuleMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *LifecycleRuleMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *LifecycleRuleMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (m *LifecycleRuleMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
+}
+
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *LifecycleRuleMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunAt returns the old "last_run_at" field's value of the LifecycleRule entity.
+// If the LifecycleRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LifecycleRuleMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *LifecycleRuleMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[lifecyclerule.FieldLastRunAt] = struct{}{}
+}
+
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *LifecycleRuleMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[lifecyclerule.FieldLastRunAt]
+	return ok
+}
+
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *LifecycleRuleMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, lifecyclerule.FieldLastRunAt)
+}
+
+// Where appends a list predicates to the LifecycleRuleMutation builder.
+func (m *LifecycleRuleMutation) Where(ps ...predicate.LifecycleRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LifecycleRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LifecycleRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LifecycleRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LifecycleRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LifecycleRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LifecycleRule).
+func (m *LifecycleRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LifecycleRuleMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.tenant_id != nil {
+		fields = append(fields, lifecyclerule.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, lifecyclerule.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, lifecyclerule.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, lifecyclerule.FieldName)
+	}
+	if m.enabled != nil {
+		fields = append(fields, lifecyclerule.FieldEnabled)
+	}
+	if m.action != nil {
+		fields = append(fields, lifecyclerule.FieldAction)
+	}
+	if m.min_age_days != nil {
+		fields = append(fields, lifecyclerule.FieldMinAgeDays)
+	}
+	if m.min_idle_days != nil {
+		fields = append(fields, lifecyclerule.FieldMinIdleDays)
+	}
+	if m.tag != nil {
+		fields = append(fields, lifecyclerule.FieldTag)
+	}
+	if m.mime_category != nil {
+		fields = append(fields, lifecyclerule.FieldMimeCategory)
+	}
+	if m.created_by != nil {
+		fields = append(fields, lifecyclerule.FieldCreatedBy)
+	}
+	if m.last_run_at != nil {
+		fields = append(fields, lifecyclerule.FieldLastRunAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LifecycleRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lifecyclerule.FieldTenantID:
+		return m.TenantID()
+	case lifecyclerule.FieldCreateTime:
+		return m.CreateTime()
+	case lifecyclerule.FieldUpdateTime:
+		return m.UpdateTime()
+	case lifecyclerule.FieldName:
+		return m.Name()
+	case lifecyclerule.FieldEnabled:
+		return m.Enabled()
+	case lifecyclerule.FieldAction:
+		return m.Action()
+	case lifecyclerule.FieldMinAgeDays:
+		return m.MinAgeDays()
+	case lifecyclerule.FieldMinIdleDays:
+		return m.MinIdleDays()
+	case lifecyclerule.FieldTag:
+		return m.Tag()
+	case lifecyclerule.FieldMimeCategory:
+		return m.MimeCategory()
+	case lifecyclerule.FieldCreatedBy:
+		return m.CreatedBy()
+	case lifecyclerule.FieldLastRunAt:
+		return m.LastRunAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LifecycleRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lifecyclerule.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case lifecyclerule.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case lifecyclerule.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case lifecyclerule.FieldName:
+		return m.OldName(ctx)
+	case lifecyclerule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case lifecyclerule.FieldAction:
+		return m.OldAction(ctx)
+	case lifecyclerule.FieldMinAgeDays:
+		return m.OldMinAgeDays(ctx)
+	case lifecyclerule.FieldMinIdleDays:
+		return m.OldMinIdleDays(ctx)
+	case lifecyclerule.FieldTag:
+		return m.OldTag(ctx)
+	case lifecyclerule.FieldMimeCategory:
+		return m.OldMimeCategory(ctx)
+	case lifecyclerule.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case lifecyclerule.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LifecycleRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LifecycleRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lifecyclerule.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case lifecyclerule.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case lifecyclerule.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case lifecyclerule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case lifecyclerule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case lifecyclerule.FieldAction:
+		v, ok := value.(lifecyclerule.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case lifecyclerule.FieldMinAgeDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinAgeDays(v)
+		return nil
+	case lifecyclerule.FieldMinIdleDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinIdleDays(v)
+		return nil
+	case lifecyclerule.FieldTag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTag(v)
+		return nil
+	case lifecyclerule.FieldMimeCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMimeCategory(v)
+		return nil
+	case lifecyclerule.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case lifecyclerule.FieldLastRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LifecycleRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LifecycleRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin_age_days != nil {
+		fields = append(fields, lifecyclerule.FieldMinAgeDays)
+	}
+	if m.addmin_idle_days != nil {
+		fields = append(fields, lifecyclerule.FieldMinIdleDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LifecycleRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case lifecyclerule.FieldMinAgeDays:
+		return m.AddedMinAgeDays()
+	case lifecyclerule.FieldMinIdleDays:
+		return m.AddedMinIdleDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LifecycleRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case lifecyclerule.FieldMinAgeDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinAgeDays(v)
+		return nil
+	case lifecyclerule.FieldMinIdleDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinIdleDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LifecycleRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LifecycleRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(lifecyclerule.FieldMinAgeDays) {
+		fields = append(fields, lifecyclerule.FieldMinAgeDays)
+	}
+	if m.FieldCleared(lifecyclerule.FieldMinIdleDays) {
+		fields = append(fields, lifecyclerule.FieldMinIdleDays)
+	}
+	if m.FieldCleared(lifecyclerule.FieldTag) {
+		fields = append(fields, lifecyclerule.FieldTag)
+	}
+	if m.FieldCleared(lifecyclerule.FieldMimeCategory) {
+		fields = append(fields, lifecyclerule.FieldMimeCategory)
+	}
+	if m.FieldCleared(lifecyclerule.FieldLastRunAt) {
+		fields = append(fields, lifecyclerule.FieldLastRunAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LifecycleRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LifecycleRuleMutation) ClearField(name string) error {
+	switch name {
+	case lifecyclerule.FieldMinAgeDays:
+		m.ClearMinAgeDays()
+		return nil
+	case lifecyclerule.FieldMinIdleDays:
+		m.ClearMinIdleDays()
+		return nil
+	case lifecyclerule.FieldTag:
+		m.ClearTag()
+		return nil
+	case lifecyclerule.FieldMimeCategory:
+		m.ClearMimeCategory()
+		return nil
+	case lifecyclerule.FieldLastRunAt:
+		m.ClearLastRunAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LifecycleRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LifecycleRuleMutation) ResetField(name string) error {
+	switch name {
+	case lifecyclerule.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case lifecyclerule.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case lifecyclerule.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case lifecyclerule.FieldName:
+		m.ResetName()
+		return nil
+	case lifecyclerule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case lifecyclerule.FieldAction:
+		m.ResetAction()
+		return nil
+	case lifecyclerule.FieldMinAgeDays:
+		m.ResetMinAgeDays()
+		return nil
+	case lifecyclerule.FieldMinIdleDays:
+		m.ResetMinIdleDays()
+		return nil
+	case lifecyclerule.FieldTag:
+		m.ResetTag()
+		return nil
+	case lifecyclerule.FieldMimeCategory:
+		m.ResetMimeCategory()
+		return nil
+	case lifecyclerule.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case lifecyclerule.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LifecycleRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LifecycleRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LifecycleRuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LifecycleRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LifecycleRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LifecycleRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LifecycleRuleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LifecycleRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LifecycleRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LifecycleRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LifecycleRule edge %s", name)
+}
+
+// OperationAuditLogMutation represents an operation that mutates the OperationAuditLog nodes in the graph.
+type OperationAuditLogMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	tenant_id       *uuid.UUID
+	create_time     *time.Time
+	update_time     *time.Time
+	operation_name  *string
+	variables       *map[string]interface{}
+	actor_id        *uuid.UUID
+	impersonator_id *uuid.UUID
+	actor_role      *string
+	success         *bool
+	error_message   *string
+	duration_ms     *int64
+	addduration_ms  *int64
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*OperationAuditLog, error)
+	predicates      []predicate.OperationAuditLog
+}
+
+var _ ent.Mutation = (*OperationAuditLogMutation)(nil)
+
+// operationauditlogOption allows management of the mutation configuration using functional options.
+type operationauditlogOption func(*OperationAuditLogMutation)
+
+// newOperationAuditLogMutation creates new mutation for the OperationAuditLog entity.
+func newOperationAuditLogMutation(c config, op Op, opts ...operationauditlogOption) *OperationAuditLogMutation {
+	m := &OperationAuditLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOperationAuditLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOperationAuditLogID sets the ID field of the mutation.
+func withOperationAuditLogID(id uuid.UUID) operationauditlogOption {
+	return func(m *OperationAuditLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OperationAuditLog
+		)
+		m.oldValue = func(ctx context.Context) (*OperationAuditLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OperationAuditLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOperationAuditLog sets the old OperationAuditLog of the mutation.
+func withOperationAuditLog(node *OperationAuditLog) operationauditlogOption {
+	return func(m *OperationAuditLogMutation) {
+		m.oldValue = func(context.Context) (*OperationAuditLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OperationAuditLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OperationAuditLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OperationAuditLog entities.
+func (m *OperationAuditLogMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OperationAuditLogMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OperationAuditLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OperationAuditLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *OperationAuditLogMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *OperationAuditLogMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *OperationAuditLogMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *OperationAuditLogMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OperationAuditLogMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OperationAuditLogMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *OperationAuditLogMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OperationAuditLogMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OperationAuditLogMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOperationName sets the "operation_name" field.
+func (m *OperationAuditLogMutation) SetOperationName(s string) {
+	m.operation_name = &s
+}
+
+// OperationName returns the value of the "operation_name" field in the mutation.
+func (m *OperationAuditLogMutation) OperationName() (r string, exists bool) {
+	v := m.operation_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperationName returns the old "operation_name" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldOperationName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperationName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperationName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperationName: %w", err)
+	}
+	return oldValue.OperationName, nil
+}
+
+// ResetOperationName resets all changes to the "operation_name" field.
+func (m *OperationAuditLogMutation) ResetOperationName() {
+	m.operation_name = nil
+}
+
+// SetVariables sets the "variables" field.
+func (m *OperationAuditLogMutation) SetVariables(value map[string]interface{}) {
+	m.variables = &value
+}
+
+// Variables returns the value of the "variables" field in the mutation.
+func (m *OperationAuditLogMutation) Variables() (r map[string]interface{}, exists bool) {
+	v := m.variables
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariables returns the old "variables" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldVariables(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariables is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariables requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariables: %w", err)
+	}
+	return oldValue.Variables, nil
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (m *OperationAuditLogMutation) ClearVariables() {
+	m.variables = nil
+	m.clearedFields[operationauditlog.FieldVariables] = struct{}{}
+}
+
+// VariablesCleared returns if the "variables" field was cleared in this mutation.
+func (m *OperationAuditLogMutation) VariablesCleared() bool {
+	_, ok := m.clearedFields[operationauditlog.FieldVariables]
+	return ok
+}
+
+// ResetVariables resets all changes to the "variables" field.
+func (m *OperationAuditLogMutation) ResetVariables() {
+	m.variables = nil
+	delete(m.clearedFields, operationauditlog.FieldVariables)
+}
+
+// SetActorID sets the "actor_id" field.
+func (m *OperationAuditLogMutation) SetActorID(u uuid.UUID) {
+	m.actor_id = &u
+}
+
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *OperationAuditLogMutation) ActorID() (r uuid.UUID, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorID returns the old "actor_id" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldActorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (m *OperationAuditLogMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[operationauditlog.FieldActorID] = struct{}{}
+}
+
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *OperationAuditLogMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[operationauditlog.FieldActorID]
+	return ok
+}
+
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *OperationAuditLogMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, operationauditlog.FieldActorID)
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (m *OperationAuditLogMutation) SetImpersonatorID(u uuid.UUID) {
+	m.impersonator_id = &u
+}
+
+// ImpersonatorID returns the value of the "impersonator_id" field in the mutation.
+func (m *OperationAuditLogMutation) ImpersonatorID() (r uuid.UUID, exists bool) {
+	v := m.impersonator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImpersonatorID returns the old "impersonator_id" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldImpersonatorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImpersonatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImpersonatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImpersonatorID: %w", err)
+	}
+	return oldValue.ImpersonatorID, nil
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (m *OperationAuditLogMutation) ClearImpersonatorID() {
+	m.impersonator_id = nil
+	m.clearedFields[operationauditlog.FieldImpersonatorID] = struct{}{}
+}
+
+// ImpersonatorIDCleared returns if the "impersonator_id" field was cleared in this mutation.
+func (m *OperationAuditLogMutation) ImpersonatorIDCleared() bool {
+	_, ok := m.clearedFields[operationauditlog.FieldImpersonatorID]
+	return ok
+}
+
+// ResetImpersonatorID resets all changes to the "impersonator_id" field.
+func (m *OperationAuditLogMutation) ResetImpersonatorID() {
+	m.impersonator_id = nil
+	delete(m.clearedFields, operationauditlog.FieldImpersonatorID)
+}
+
+// SetActorRole sets the "actor_role" field.
+func (m *OperationAuditLogMutation) SetActorRole(s string) {
+	m.actor_role = &s
+}
+
+// ActorRole returns the value of the "actor_role" field in the mutation.
+func (m *OperationAuditLogMutation) ActorRole() (r string, exists bool) {
+	v := m.actor_role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActorRole returns the old "actor_role" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldActorRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorRole: %w", err)
+	}
+	return oldValue.ActorRole, nil
+}
+
+// ClearActorRole clears the value of the "actor_role" field.
+func (m *OperationAuditLogMutation) ClearActorRole() {
+	m.actor_role = nil
+	m.clearedFields[operationauditlog.FieldActorRole] = struct{}{}
+}
+
+// ActorRoleCleared returns if the "actor_role" field was cleared in this mutation.
+func (m *OperationAuditLogMutation) ActorRoleCleared() bool {
+	_, ok := m.clearedFields[operationauditlog.FieldActorRole]
+	return ok
+}
+
+// ResetActorRole resets all changes to the "actor_role" field.
+func (m *OperationAuditLogMutation) ResetActorRole() {
+	m.actor_role = nil
+	delete(m.clearedFields, operationauditlog.FieldActorRole)
+}
+
+// SetSuccess sets the "success" field.
+func (m *OperationAuditLogMutation) SetSuccess(b bool) {
+	m.success = &b
+}
+
+// Success returns the value of the "success" field in the mutation.
+func (m *OperationAuditLogMutation) Success() (r bool, exists bool) {
+	v := m.success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuccess returns the old "success" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
+	}
+	return oldValue.Success, nil
+}
+
+// ResetSuccess resets all changes to the "success" field.
+func (m *OperationAuditLogMutation) ResetSuccess() {
+	m.success = nil
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *OperationAuditLogMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *OperationAuditLogMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *OperationAuditLogMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[operationauditlog.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *OperationAuditLogMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[operationauditlog.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *OperationAuditLogMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, operationauditlog.FieldErrorMessage)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *OperationAuditLogMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *OperationAuditLogMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the OperationAuditLog entity.
+// If the OperationAuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OperationAuditLogMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *OperationAuditLogMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *OperationAuditLogMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *OperationAuditLogMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// Where appends a list predicates to the OperationAuditLogMutation builder.
+func (m *OperationAuditLogMutation) Where(ps ...predicate.OperationAuditLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OperationAuditLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OperationAuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OperationAuditLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OperationAuditLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OperationAuditLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OperationAuditLog).
+func (m *OperationAuditLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OperationAuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.tenant_id != nil {
+		fields = append(fields, operationauditlog.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, operationauditlog.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, operationauditlog.FieldUpdateTime)
+	}
+	if m.operation_name != nil {
+		fields = append(fields, operationauditlog.FieldOperationName)
+	}
+	if m.variables != nil {
+		fields = append(fields, operationauditlog.FieldVariables)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, operationauditlog.FieldActorID)
+	}
+	if m.impersonator_id != nil {
+		fields = append(fields, operationauditlog.FieldImpersonatorID)
+	}
+	if m.actor_role != nil {
+		fields = append(fields, operationauditlog.FieldActorRole)
+	}
+	if m.success != nil {
+		fields = append(fields, operationauditlog.FieldSuccess)
+	}
+	if m.error_message != nil {
+		fields = append(fields, operationauditlog.FieldErrorMessage)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, operationauditlog.FieldDurationMs)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OperationAuditLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case operationauditlog.FieldTenantID:
+		return m.TenantID()
+	case operationauditlog.FieldCreateTime:
+		return m.CreateTime()
+	case operationauditlog.FieldUpdateTime:
+		return m.UpdateTime()
+	case operationauditlog.FieldOperationName:
+		return m.OperationName()
+	case operationauditlog.FieldVariables:
+		return m.Variables()
+	case operationauditlog.FieldActorID:
+		return m.ActorID()
+	case operationauditlog.FieldImpersonatorID:
+		return m.ImpersonatorID()
+	case operationauditlog.FieldActorRole:
+		return m.ActorRole()
+	case operationauditlog.FieldSuccess:
+		return m.Success()
+	case operationauditlog.FieldErrorMessage:
+		return m.ErrorMessage()
+	case operationauditlog.FieldDurationMs:
+		return m.DurationMs()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OperationAuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case operationauditlog.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case operationauditlog.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case operationauditlog.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case operationauditlog.FieldOperationName:
+		return m.OldOperationName(ctx)
+	case operationauditlog.FieldVariables:
+		return m.OldVariables(ctx)
+	case operationauditlog.FieldActorID:
+		return m.OldActorID(ctx)
+	case operationauditlog.FieldImpersonatorID:
+		return m.OldImpersonatorID(ctx)
+	case operationauditlog.FieldActorRole:
+		return m.OldActorRole(ctx)
+	case operationauditlog.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case operationauditlog.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case operationauditlog.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	}
+	return nil, fmt.Errorf("unknown OperationAuditLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OperationAuditLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case operationauditlog.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case operationauditlog.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case operationauditlog.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case operationauditlog.FieldOperationName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperationName(v)
+		return nil
+	case operationauditlog.FieldVariables:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariables(v)
+		return nil
+	case operationauditlog.FieldActorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorID(v)
+		return nil
+	case operationauditlog.FieldImpersonatorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImpersonatorID(v)
+		return nil
+	case operationauditlog.FieldActorRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActorRole(v)
+		return nil
+	case operationauditlog.FieldSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccess(v)
+		return nil
+	case operationauditlog.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case operationauditlog.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OperationAuditLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OperationAuditLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, operationauditlog.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OperationAuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case operationauditlog.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OperationAuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case operationauditlog.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OperationAuditLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OperationAuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(operationauditlog.FieldVariables) {
+		fields = append(fields, operationauditlog.FieldVariables)
+	}
+	if m.FieldCleared(operationauditlog.FieldActorID) {
+		fields = append(fields, operationauditlog.FieldActorID)
+	}
+	if m.FieldCleared(operationauditlog.FieldImpersonatorID) {
+		fields = append(fields, operationauditlog.FieldImpersonatorID)
+	}
+	if m.FieldCleared(operationauditlog.FieldActorRole) {
+		fields = append(fields, operationauditlog.FieldActorRole)
+	}
+	if m.FieldCleared(operationauditlog.FieldErrorMessage) {
+		fields = append(fields, operationauditlog.FieldErrorMessage)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OperationAuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OperationAuditLogMutation) ClearField(name string) error {
+	switch name {
+	case operationauditlog.FieldVariables:
+		m.ClearVariables()
+		return nil
+	case operationauditlog.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case operationauditlog.FieldImpersonatorID:
+		m.ClearImpersonatorID()
+		return nil
+	case operationauditlog.FieldActorRole:
+		m.ClearActorRole()
+		return nil
+	case operationauditlog.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown OperationAuditLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OperationAuditLogMutation) ResetField(name string) error {
+	switch name {
+	case operationauditlog.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case operationauditlog.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case operationauditlog.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case operationauditlog.FieldOperationName:
+		m.ResetOperationName()
+		return nil
+	case operationauditlog.FieldVariables:
+		m.ResetVariables()
+		return nil
+	case operationauditlog.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case operationauditlog.FieldImpersonatorID:
+		m.ResetImpersonatorID()
+		return nil
+	case operationauditlog.FieldActorRole:
+		m.ResetActorRole()
+		return nil
+	case operationauditlog.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case operationauditlog.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case operationauditlog.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	}
+	return fmt.Errorf("unknown OperationAuditLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OperationAuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OperationAuditLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OperationAuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OperationAuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OperationAuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OperationAuditLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OperationAuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OperationAuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OperationAuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OperationAuditLog edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	tenant_id     *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	created_by    *uuid.UUID
+	clearedFields map[string]struct{}
+	files         map[uuid.UUID]struct{}
+	removedfiles  map[uuid.UUID]struct{}
+	clearedfiles  bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id uuid.UUID) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *TagMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TagMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TagMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TagMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TagMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TagMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TagMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TagMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TagMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *TagMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *TagMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *TagMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// AddFileIDs adds the "files" edge to the File entity by ids.
+func (m *TagMutation) AddFileIDs(ids ...uuid.UUID) {
+	if m.files == nil {
+		m.files = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the File entity.
+func (m *TagMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the File entity was cleared.
+func (m *TagMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the File entity by IDs.
+func (m *TagMutation) RemoveFileIDs(ids ...uuid.UUID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the File entity.
+func (m *TagMutation) RemovedFilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *TagMutation) FilesIDs() (ids []uuid.UUID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *TagMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant_id != nil {
+		fields = append(fields, tag.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, tag.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, tag.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.created_by != nil {
+		fields = append(fields, tag.FieldCreatedBy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldTenantID:
+		return m.TenantID()
+	case tag.FieldCreateTime:
+		return m.CreateTime()
+	case tag.FieldUpdateTime:
+		return m.UpdateTime()
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldCreatedBy:
+		return m.CreatedBy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case tag.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case tag.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case tag.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case tag.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case tag.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tag.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.files != nil {
+		edges = append(edges, tag.EdgeFiles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedfiles != nil {
+		edges = append(edges, tag.EdgeFiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfiles {
+		edges = append(edges, tag.EdgeFiles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeFiles:
+		return m.clearedfiles
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// TenantSettingMutation represents an operation that mutates the TenantSetting nodes in the graph.
+type TenantSettingMutation struct {
+	config
+	op                               Op
+	typ                              string
+	id                               *uuid.UUID
+	tenant_id                        *uuid.UUID
+	create_time                      *time.Time
+	update_time                      *time.Time
+	default_language                 *string
+	state                            *tenantsetting.State
+	state_reason                     *string
+	state_changed_at                 *time.Time
+	quota_mode                       *tenantsetting.QuotaMode
+	quota_grace_percent              *int
+	addquota_grace_percent           *int
+	storage_limit_bytes              *int64
+	addstorage_limit_bytes           *int64
+	default_file_retention_days      *int
+	adddefault_file_retention_days   *int
+	filename_conflict_mode           *tenantsetting.FilenameConflictMode
+	require_upload_description       *bool
+	upload_description_template      *string
+	upload_classification_tags       *[]string
+	appendupload_classification_tags []string
+	image_metadata_mode              *tenantsetting.ImageMetadataMode
+	require_pdf_watermark            *bool
+	max_upload_size                  *int64
+	addmax_upload_size               *int64
+	upload_allowed_extensions        *[]string
+	appendupload_allowed_extensions  []string
+	upload_blocked_extensions        *[]string
+	appendupload_blocked_extensions  []string
+	upload_allowed_mime_types        *[]string
+	appendupload_allowed_mime_types  []string
+	upload_blocked_mime_types        *[]string
+	appendupload_blocked_mime_types  []string
+	file_metadata_schemas            *[]filemeta.Schema
+	appendfile_metadata_schemas      []filemeta.Schema
+	clearedFields                    map[string]struct{}
+	done                             bool
+	oldValue                         func(context.Context) (*TenantSetting, error)
+	predicates                       []predicate.TenantSetting
+}
+
+var _ ent.Mutation = (*TenantSettingMutation)(nil)
+
+// tenantsettingOption allows management of the mutation configuration using functional options.
+type tenantsettingOption func(*TenantSettingMutation)
+
+// newTenantSettingMutation creates new mutation for the TenantSetting entity.
+func newTenantSettingMutation(c config, op Op, opts ...tenantsettingOption) *TenantSettingMutation {
+	m := &TenantSettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTenantSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTenantSettingID sets the ID field of the mutation.
+func withTenantSettingID(id uuid.UUID) tenantsettingOption {
+	return func(m *TenantSettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TenantSetting
+		)
+		m.oldValue = func(ctx context.Context) (*TenantSetting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TenantSetting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTenantSetting sets the old TenantSetting of the mutation.
+func withTenantSetting(node *TenantSetting) tenantsettingOption {
+	return func(m *TenantSettingMutation) {
+		m.oldValue = func(context.Context) (*TenantSetting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TenantSettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TenantSetting entities.
+func (m *TenantSettingMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantSettingMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantSettingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TenantSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *TenantSettingMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TenantSettingMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TenantSettingMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TenantSettingMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TenantSettingMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TenantSettingMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TenantSettingMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TenantSettingMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TenantSettingMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (m *TenantSettingMutation) SetDefaultLanguage(s string) {
+	m.default_language = &s
+}
+
+// DefaultLanguage returns the value of the "default_language" field in the mutation.
+func (m *TenantSettingMutation) DefaultLanguage() (r string, exists bool) {
+	v := m.default_language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultLanguage returns the old "default_language" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldDefaultLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultLanguage: %w", err)
+	}
+	return oldValue.DefaultLanguage, nil
+}
+
+// ClearDefaultLanguage clears the value of the "default_language" field.
+func (m *TenantSettingMutation) ClearDefaultLanguage() {
+	m.default_language = nil
+	m.clearedFields[tenantsetting.FieldDefaultLanguage] = struct{}{}
+}
+
+// DefaultLanguageCleared returns if the "default_language" field was cleared in this mutation.
+func (m *TenantSettingMutation) DefaultLanguageCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldDefaultLanguage]
+	return ok
+}
+
+// ResetDefaultLanguage resets all changes to the "default_language" field.
+func (m *TenantSettingMutation) ResetDefaultLanguage() {
+	m.default_language = nil
+	delete(m.clearedFields, tenantsetting.FieldDefaultLanguage)
+}
+
+// SetState sets the "state" field.
+func (m *TenantSettingMutation) SetState(t tenantsetting.State) {
+	m.state = &t
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *TenantSettingMutation) State() (r tenantsetting.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldState(ctx context.Context) (v tenantsetting.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *TenantSettingMutation) ResetState() {
+	m.state = nil
+}
+
+// SetStateReason sets the "state_reason" field.
+func (m *TenantSettingMutation) SetStateReason(s string) {
+	m.state_reason = &s
+}
+
+// StateReason returns the value of the "state_reason" field in the mutation.
+func (m *TenantSettingMutation) StateReason() (r string, exists bool) {
+	v := m.state_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStateReason returns the old "state_reason" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldStateReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStateReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStateReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStateReason: %w", err)
+	}
+	return oldValue.StateReason, nil
+}
+
+// ClearStateReason clears the value of the "state_reason" field.
+func (m *TenantSettingMutation) ClearStateReason() {
+	m.state_reason = nil
+	m.clearedFields[tenantsetting.FieldStateReason] = struct{}{}
+}
+
+// StateReasonCleared returns if the "state_reason" field was cleared in this mutation.
+func (m *TenantSettingMutation) StateReasonCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldStateReason]
+	return ok
+}
+
+// ResetStateReason resets all changes to the "state_reason" field.
+func (m *TenantSettingMutation) ResetStateReason() {
+	m.state_reason = nil
+	delete(m.clearedFields, tenantsetting.FieldStateReason)
+}
+
+// SetStateChangedAt sets the "state_changed_at" field.
+func (m *TenantSettingMutation) SetStateChangedAt(t time.Time) {
+	m.state_changed_at = &t
+}
+
+// StateChangedAt returns the value of the "state_changed_at" field in the mutation.
+func (m *TenantSettingMutation) StateChangedAt() (r time.Time, exists bool) {
+	v := m.state_changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStateChangedAt returns the old "state_changed_at" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldStateChangedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStateChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStateChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStateChangedAt: %w", err)
+	}
+	return oldValue.StateChangedAt, nil
+}
+
+// ClearStateChangedAt clears the value of the "state_changed_at" field.
+func (m *TenantSettingMutation) ClearStateChangedAt() {
+	m.state_changed_at = nil
+	m.clearedFields[tenantsetting.FieldStateChangedAt] = struct{}{}
+}
+
+// StateChangedAtCleared returns if the "state_changed_at" field was cleared in this mutation.
+func (m *TenantSettingMutation) StateChangedAtCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldStateChangedAt]
+	return ok
+}
+
+// ResetStateChangedAt resets all changes to the "state_changed_at" field.
+func (m *TenantSettingMutation) ResetStateChangedAt() {
+	m.state_changed_at = nil
+	delete(m.clearedFields, tenantsetting.FieldStateChangedAt)
+}
+
+// SetQuotaMode sets the "quota_mode" field.
+func (m *TenantSettingMutation) SetQuotaMode(tm tenantsetting.QuotaMode) {
+	m.quota_mode = &tm
+}
+
+// QuotaMode returns the value of the "quota_mode" field in the mutation.
+func (m *TenantSettingMutation) QuotaMode() (r tenantsetting.QuotaMode, exists bool) {
+	v := m.quota_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuotaMode returns the old "quota_mode" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldQuotaMode(ctx context.Context) (v tenantsetting.QuotaMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaMode: %w", err)
+	}
+	return oldValue.QuotaMode, nil
+}
+
+// ResetQuotaMode resets all changes to the "quota_mode" field.
+func (m *TenantSettingMutation) ResetQuotaMode() {
+	m.quota_mode = nil
+}
+
+// SetQuotaGracePercent sets the "quota_grace_percent" field.
+func (m *TenantSettingMutation) SetQuotaGracePercent(i int) {
+	m.quota_grace_percent = &i
+	m.addquota_grace_percent = nil
+}
+
+// QuotaGracePercent returns the value of the "quota_grace_percent" field in the mutation.
+func (m *TenantSettingMutation) QuotaGracePercent() (r int, exists bool) {
+	v := m.quota_grace_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuotaGracePercent returns the old "quota_grace_percent" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldQuotaGracePercent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuotaGracePercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuotaGracePercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuotaGracePercent: %w", err)
+	}
+	return oldValue.QuotaGracePercent, nil
+}
+
+// AddQuotaGracePercent adds i to the "quota_grace_percent" field.
+func (m *TenantSettingMutation) AddQuotaGracePercent(i int) {
+	if m.addquota_grace_percent != nil {
+		*m.addquota_grace_percent += i
+	} else {
+		m.addquota_grace_percent = &i
+	}
+}
+
+// AddedQuotaGracePercent returns the value that was added to the "quota_grace_percent" field in this mutation.
+func (m *TenantSettingMutation) AddedQuotaGracePercent() (r int, exists bool) {
+	v := m.addquota_grace_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuotaGracePercent resets all changes to the "quota_grace_percent" field.
+func (m *TenantSettingMutation) ResetQuotaGracePercent() {
+	m.quota_grace_percent = nil
+	m.addquota_grace_percent = nil
+}
+
+// SetStorageLimitBytes sets the "storage_limit_bytes" field.
+func (m *TenantSettingMutation) SetStorageLimitBytes(i int64) {
+	m.storage_limit_bytes = &i
+	m.addstorage_limit_bytes = nil
+}
+
+// StorageLimitBytes returns the value of the "storage_limit_bytes" field in the mutation.
+func (m *TenantSettingMutation) StorageLimitBytes() (r int64, exists bool) {
+	v := m.storage_limit_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageLimitBytes returns the old "storage_limit_bytes" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldStorageLimitBytes(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageLimitBytes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageLimitBytes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageLimitBytes: %w", err)
+	}
+	return oldValue.StorageLimitBytes, nil
+}
+
+// AddStorageLimitBytes adds i to the "storage_limit_bytes" field.
+func (m *TenantSettingMutation) AddStorageLimitBytes(i int64) {
+	if m.addstorage_limit_bytes != nil {
+		*m.addstorage_limit_bytes += i
+	} else {
+		m.addstorage_limit_bytes = &i
+	}
+}
+
+// AddedStorageLimitBytes returns the value that was added to the "storage_limit_bytes" field in this mutation.
+func (m *TenantSettingMutation) AddedStorageLimitBytes() (r int64, exists bool) {
+	v := m.addstorage_limit_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStorageLimitBytes clears the value of the "storage_limit_bytes" field.
+func (m *TenantSettingMutation) ClearStorageLimitBytes() {
+	m.storage_limit_bytes = nil
+	m.addstorage_limit_bytes = nil
+	m.clearedFields[tenantsetting.FieldStorageLimitBytes] = struct{}{}
+}
+
+// StorageLimitBytesCleared returns if the "storage_limit_bytes" field was cleared in this mutation.
+func (m *TenantSettingMutation) StorageLimitBytesCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldStorageLimitBytes]
+	return ok
+}
+
+// ResetStorageLimitBytes resets all changes to the "storage_limit_bytes" field.
+func (m *TenantSettingMutation) ResetStorageLimitBytes() {
+	m.storage_limit_bytes = nil
+	m.addstorage_limit_bytes = nil
+	delete(m.clearedFields, tenantsetting.FieldStorageLimitBytes)
+}
+
+// SetDefaultFileRetentionDays sets the "default_file_retention_days" field.
+func (m *TenantSettingMutation) SetDefaultFileRetentionDays(i int) {
+	m.default_file_retention_days = &i
+	m.adddefault_file_retention_days = nil
+}
+
+// DefaultFileRetentionDays returns the value of the "default_file_retention_days" field in the mutation.
+func (m *TenantSettingMutation) DefaultFileRetentionDays() (r int, exists bool) {
+	v := m.default_file_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultFileRetentionDays returns the old "default_file_retention_days" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldDefaultFileRetentionDays(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultFileRetentionDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultFileRetentionDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultFileRetentionDays: %w", err)
+	}
+	return oldValue.DefaultFileRetentionDays, nil
+}
+
+// AddDefaultFileRetentionDays adds i to the "default_file_retention_days" field.
+func (m *TenantSettingMutation) AddDefaultFileRetentionDays(i int) {
+	if m.adddefault_file_retention_days != nil {
+		*m.adddefault_file_retention_days += i
+	} else {
+		m.adddefault_file_retention_days = &i
+	}
+}
+
+// AddedDefaultFileRetentionDays returns the value that was added to the "default_file_retention_days" field in this mutation.
+func (m *TenantSettingMutation) AddedDefaultFileRetentionDays() (r int, exists bool) {
+	v := m.adddefault_file_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDefaultFileRetentionDays clears the value of the "default_file_retention_days" field.
+func (m *TenantSettingMutation) ClearDefaultFileRetentionDays() {
+	m.default_file_retention_days = nil
+	m.adddefault_file_retention_days = nil
+	m.clearedFields[tenantsetting.FieldDefaultFileRetentionDays] = struct{}{}
+}
+
+// DefaultFileRetentionDaysCleared returns if the "default_file_retention_days" field was cleared in this mutation.
+func (m *TenantSettingMutation) DefaultFileRetentionDaysCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldDefaultFileRetentionDays]
+	return ok
+}
+
+// ResetDefaultFileRetentionDays resets all changes to the "default_file_retention_days" field.
+func (m *TenantSettingMutation) ResetDefaultFileRetentionDays() {
+	m.default_file_retention_days = nil
+	m.adddefault_file_retention_days = nil
+	delete(m.clearedFields, tenantsetting.FieldDefaultFileRetentionDays)
+}
+
+// SetFilenameConflictMode sets the "filename_conflict_mode" field.
+func (m *TenantSettingMutation) SetFilenameConflictMode(tcm tenantsetting.FilenameConflictMode) {
+	m.filename_conflict_mode = &tcm
+}
+
+// FilenameConflictMode returns the value of the "filename_conflict_mode" field in the mutation.
+func (m *TenantSettingMutation) FilenameConflictMode() (r tenantsetting.FilenameConflictMode, exists bool) {
+	v := m.filename_conflict_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilenameConflictMode returns the old "filename_conflict_mode" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldFilenameConflictMode(ctx context.Context) (v tenantsetting.FilenameConflictMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilenameConflictMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilenameConflictMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilenameConflictMode: %w", err)
+	}
+	return oldValue.FilenameConflictMode, nil
+}
+
+// ResetFilenameConflictMode resets all changes to the "filename_conflict_mode" field.
+func (m *TenantSettingMutation) ResetFilenameConflictMode() {
+	m.filename_conflict_mode = nil
+}
+
+// SetRequireUploadDescription sets the "require_upload_description" field.
+func (m *TenantSettingMutation) SetRequireUploadDescription(b bool) {
+	m.require_upload_description = &b
+}
+
+// RequireUploadDescription returns the value of the "require_upload_description" field in the mutation.
+func (m *TenantSettingMutation) RequireUploadDescription() (r bool, exists bool) {
+	v := m.require_upload_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequireUploadDescription returns the old "require_upload_description" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldRequireUploadDescription(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequireUploadDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequireUploadDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequireUploadDescription: %w", err)
+	}
+	return oldValue.RequireUploadDescription, nil
+}
+
+// ResetRequireUploadDescription resets all changes to the "require_upload_description" field.
+func (m *TenantSettingMutation) ResetRequireUploadDescription() {
+	m.require_upload_description = nil
+}
+
+// SetUploadDescriptionTemplate sets the "upload_description_template" field.
+func (m *TenantSettingMutation) SetUploadDescriptionTemplate(s string) {
+	m.upload_description_template = &s
+}
+
+// UploadDescriptionTemplate returns the value of the "upload_description_template" field in the mutation.
+func (m *TenantSettingMutation) UploadDescriptionTemplate() (r string, exists bool) {
+	v := m.upload_description_template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadDescriptionTemplate returns the old "upload_description_template" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldUploadDescriptionTemplate(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadDescriptionTemplate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadDescriptionTemplate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadDescriptionTemplate: %w", err)
+	}
+	return oldValue.UploadDescriptionTemplate, nil
+}
+
+// ClearUploadDescriptionTemplate clears the value of the "upload_description_template" field.
+func (m *TenantSettingMutation) ClearUploadDescriptionTemplate() {
+	m.upload_description_template = nil
+	m.clearedFields[tenantsetting.FieldUploadDescriptionTemplate] = struct{}{}
+}
+
+// UploadDescriptionTemplateCleared returns if the "upload_description_template" field was cleared in this mutation.
+func (m *TenantSettingMutation) UploadDescriptionTemplateCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUploadDescriptionTemplate]
+	return ok
+}
+
+// ResetUploadDescriptionTemplate resets all changes to the "upload_description_template" field.
+func (m *TenantSettingMutation) ResetUploadDescriptionTemplate() {
+	m.upload_description_template = nil
+	delete(m.clearedFields, tenantsetting.FieldUploadDescriptionTemplate)
+}
+
+// SetUploadClassificationTags sets the "upload_classification_tags" field.
+func (m *TenantSettingMutation) SetUploadClassificationTags(s []string) {
+	m.upload_classification_tags = &s
+	m.appendupload_classification_tags = nil
+}
+
+// UploadClassificationTags returns the value of the "upload_classification_tags" field in the mutation.
+func (m *TenantSettingMutation) UploadClassificationTags() (r []string, exists bool) {
+	v := m.upload_classification_tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadClassificationTags returns the old "upload_classification_tags" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldUploadClassificationTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadClassificationTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadClassificationTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadClassificationTags: %w", err)
+	}
+	return oldValue.UploadClassificationTags, nil
+}
+
+// AppendUploadClassificationTags adds s to the "upload_classification_tags" field.
+func (m *TenantSettingMutation) AppendUploadClassificationTags(s []string) {
+	m.appendupload_classification_tags = append(m.appendupload_classification_tags, s...)
+}
+
+// AppendedUploadClassificationTags returns the list of values that were appended to the "upload_classification_tags" field in this mutation.
+func (m *TenantSettingMutation) AppendedUploadClassificationTags() ([]string, bool) {
+	if len(m.appendupload_classification_tags) == 0 {
+		return nil, false
+	}
+	return m.appendupload_classification_tags, true
+}
+
+// ClearUploadClassificationTags clears the value of the "upload_classification_tags" field.
+func (m *TenantSettingMutation) ClearUploadClassificationTags() {
+	m.upload_classification_tags = nil
+	m.appendupload_classification_tags = nil
+	m.clearedFields[tenantsetting.FieldUploadClassificationTags] = struct{}{}
+}
+
+// UploadClassificationTagsCleared returns if the "upload_classification_tags" field was cleared in this mutation.
+func (m *TenantSettingMutation) UploadClassificationTagsCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUploadClassificationTags]
+	return ok
+}
+
+// ResetUploadClassificationTags resets all changes to the "upload_classification_tags" field.
+func (m *TenantSettingMutation) ResetUploadClassificationTags() {
+	m.upload_classification_tags = nil
+	m.appendupload_classification_tags = nil
+	delete(m.clearedFields, tenantsetting.FieldUploadClassificationTags)
+}
+
+// SetImageMetadataMode sets the "image_metadata_mode" field.
+func (m *TenantSettingMutation) SetImageMetadataMode(tmm tenantsetting.ImageMetadataMode) {
+	m.image_metadata_mode = &tmm
+}
+
+// ImageMetadataMode returns the value of the "image_metadata_mode" field in the mutation.
+func (m *TenantSettingMutation) ImageMetadataMode() (r tenantsetting.ImageMetadataMode, exists bool) {
+	v := m.image_metadata_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageMetadataMode returns the old "image_metadata_mode" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldImageMetadataMode(ctx context.Context) (v tenantsetting.ImageMetadataMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageMetadataMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageMetadataMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageMetadataMode: %w", err)
+	}
+	return oldValue.ImageMetadataMode, nil
+}
+
+// ResetImageMetadataMode resets all changes to the "image_metadata_mode" field.
+func (m *TenantSettingMutation) ResetImageMetadataMode() {
+	m.image_metadata_mode = nil
+}
+
+// SetRequirePdfWatermark sets the "require_pdf_watermark" field.
+func (m *TenantSettingMutation) SetRequirePdfWatermark(b bool) {
+	m.require_pdf_watermark = &b
+}
+
+// RequirePdfWatermark returns the value of the "require_pdf_watermark" field in the mutation.
+func (m *TenantSettingMutation) RequirePdfWatermark() (r bool, exists bool) {
+	v := m.require_pdf_watermark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequirePdfWatermark returns the old "require_pdf_watermark" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldRequirePdfWatermark(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequirePdfWatermark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequirePdfWatermark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequirePdfWatermark: %w", err)
+	}
+	return oldValue.RequirePdfWatermark, nil
+}
+
+// ResetRequirePdfWatermark resets all changes to the "require_pdf_watermark" field.
+func (m *TenantSettingMutation) ResetRequirePdfWatermark() {
+	m.require_pdf_watermark = nil
+}
+
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (m *TenantSettingMutation) SetMaxUploadSize(i int64) {
+	m.max_upload_size = &i
+	m.addmax_upload_size = nil
+}
+
+// MaxUploadSize returns the value of the "max_upload_size" field in the mutation.
+func (m *TenantSettingMutation) MaxUploadSize() (r int64, exists bool) {
+	v := m.max_upload_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUploadSize returns the old "max_upload_size" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldMaxUploadSize(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUploadSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUploadSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUploadSize: %w", err)
+	}
+	return oldValue.MaxUploadSize, nil
+}
+
+// AddMaxUploadSize adds i to the "max_upload_size" field.
+func (m *TenantSettingMutation) AddMaxUploadSize(i int64) {
+	if m.addmax_upload_size != nil {
+		*m.addmax_upload_size += i
+	} else {
+		m.addmax_upload_size = &i
+	}
+}
+
+// AddedMaxUploadSize returns the value that was added to the "max_upload_size" field in this mutation.
+func (m *TenantSettingMutation) AddedMaxUploadSize() (r int64, exists bool) {
+	v := m.addmax_upload_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxUploadSize clears the value of the "max_upload_size" field.
+func (m *TenantSettingMutation) ClearMaxUploadSize() {
+	m.max_upload_size = nil
+	m.addmax_upload_size = nil
+	m.clearedFields[tenantsetting.FieldMaxUploadSize] = struct{}{}
+}
+
+// MaxUploadSizeCleared returns if the "max_upload_size" field was cleared in this mutation.
+func (m *TenantSettingMutation) MaxUploadSizeCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldMaxUploadSize]
+	return ok
+}
+
+// ResetMaxUploadSize resets all changes to the "max_upload_size" field.
+func (m *TenantSettingMutation) ResetMaxUploadSize() {
+	m.max_upload_size = nil
+	m.addmax_upload_size = nil
+	delete(m.clearedFields, tenantsetting.FieldMaxUploadSize)
+}
+
+// SetUploadAllowedExtensions sets the "upload_allowed_extensions" field.
+func (m *TenantSettingMutation) SetUploadAllowedExtensions(s []string) {
+	m.upload_allowed_extensions = &s
+	m.appendupload_allowed_extensions = nil
+}
+
+// UploadAllowedExtensions returns the value of the "upload_allowed_extensions" field in the mutation.
+func (m *TenantSettingMutation) UploadAllowedExtensions() (r []string, exists bool) {
+	v := m.upload_allowed_extensions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadAllowedExtensions returns the old "upload_allowed_extensions" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldUploadAllowedExtensions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadAllowedExtensions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadAllowedExtensions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadAllowedExtensions: %w", err)
+	}
+	return oldValue.UploadAllowedExtensions, nil
+}
+
+// AppendUploadAllowedExtensions adds s to the "upload_allowed_extensions" field.
+func (m *TenantSettingMutation) AppendUploadAllowedExtensions(s []string) {
+	m.appendupload_allowed_extensions = append(m.appendupload_allowed_extensions, s...)
+}
+
+// AppendedUploadAllowedExtensions returns the list of values that were appended to the "upload_allowed_extensions" field in this mutation.
+func (m *TenantSettingMutation) AppendedUploadAllowedExtensions() ([]string, bool) {
+	if len(m.appendupload_allowed_extensions) == 0 {
+		return nil, false
+	}
+	return m.appendupload_allowed_extensions, true
+}
+
+// ClearUploadAllowedExtensions clears the value of the "upload_allowed_extensions" field.
+func (m *TenantSettingMutation) ClearUploadAllowedExtensions() {
+	m.upload_allowed_extensions = nil
+	m.appendupload_allowed_extensions = nil
+	m.clearedFields[tenantsetting.FieldUploadAllowedExtensions] = struct{}{}
+}
+
+// UploadAllowedExtensionsCleared returns if the "upload_allowed_extensions" field was cleared in this mutation.
+func (m *TenantSettingMutation) UploadAllowedExtensionsCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUploadAllowedExtensions]
+	return ok
+}
+
+// ResetUploadAllowedExtensions resets all changes to the "upload_allowed_extensions" field.
+func (m *TenantSettingMutation) ResetUploadAllowedExtensions() {
+	m.upload_allowed_extensions = nil
+	m.appendupload_allowed_extensions = nil
+	delete(m.clearedFields, tenantsetting.FieldUploadAllowedExtensions)
+}
+
+// SetUploadBlockedExtensions sets the "upload_blocked_extensions" field.
+func (m *TenantSettingMutation) SetUploadBlockedExtensions(s []string) {
+	m.upload_blocked_extensions = &s
+	m.appendupload_blocked_extensions = nil
+}
+
+// UploadBlockedExtensions returns the value of the "upload_blocked_extensions" field in the mutation.
+func (m *TenantSettingMutation) UploadBlockedExtensions() (r []string, exists bool) {
+	v := m.upload_blocked_extensions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadBlockedExtensions returns the old "upload_blocked_extensions" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldUploadBlockedExtensions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadBlockedExtensions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadBlockedExtensions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadBlockedExtensions: %w", err)
+	}
+	return oldValue.UploadBlockedExtensions, nil
+}
+
+// AppendUploadBlockedExtensions adds s to the "upload_blocked_extensions" field.
+func (m *TenantSettingMutation) AppendUploadBlockedExtensions(s []string) {
+	m.appendupload_blocked_extensions = append(m.appendupload_blocked_extensions, s...)
+}
+
+// AppendedUploadBlockedExtensions returns the list of values that were appended to the "upload_blocked_extensions" field in this mutation.
+func (m *TenantSettingMutation) AppendedUploadBlockedExtensions() ([]string, bool) {
+	if len(m.appendupload_blocked_extensions) == 0 {
+		return nil, false
+	}
+	return m.appendupload_blocked_extensions, true
+}
+
+// ClearUploadBlockedExtensions clears the value of the "upload_blocked_extensions" field.
+func (m *TenantSettingMutation) ClearUploadBlockedExtensions() {
+	m.upload_blocked_extensions = nil
+	m.appendupload_blocked_extensions = nil
+	m.clearedFields[tenantsetting.FieldUploadBlockedExtensions] = struct{}{}
+}
+
+// UploadBlockedExtensionsCleared returns if the "upload_blocked_extensions" field was cleared in this mutation.
+func (m *TenantSettingMutation) UploadBlockedExtensionsCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUploadBlockedExtensions]
+	return ok
+}
+
+// ResetUploadBlockedExtensions resets all changes to the "upload_blocked_extensions" field.
+func (m *TenantSettingMutation) ResetUploadBlockedExtensions() {
+	m.upload_blocked_extensions = nil
+	m.appendupload_blocked_extensions = nil
+	delete(m.clearedFields, tenantsetting.FieldUploadBlockedExtensions)
+}
+
+// SetUploadAllowedMimeTypes sets the "upload_allowed_mime_types" field.
+func (m *TenantSettingMutation) SetUploadAllowedMimeTypes(s []string) {
+	m.upload_allowed_mime_types = &s
+	m.appendupload_allowed_mime_types = nil
+}
+
+// UploadAllowedMimeTypes returns the value of the "upload_allowed_mime_types" field in the mutation.
+func (m *TenantSettingMutation) UploadAllowedMimeTypes() (r []string, exists bool) {
+	v := m.upload_allowed_mime_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadAllowedMimeTypes returns the old "upload_allowed_mime_types" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldUploadAllowedMimeTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadAllowedMimeTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadAllowedMimeTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadAllowedMimeTypes: %w", err)
+	}
+	return oldValue.UploadAllowedMimeTypes, nil
+}
+
+// AppendUploadAllowedMimeTypes adds s to the "upload_allowed_mime_types" field.
+func (m *TenantSettingMutation) AppendUploadAllowedMimeTypes(s []string) {
+	m.appendupload_allowed_mime_types = append(m.appendupload_allowed_mime_types, s...)
+}
+
+// AppendedUploadAllowedMimeTypes returns the list of values that were appended to the "upload_allowed_mime_types" field in this mutation.
+func (m *TenantSettingMutation) AppendedUploadAllowedMimeTypes() ([]string, bool) {
+	if len(m.appendupload_allowed_mime_types) == 0 {
+		return nil, false
+	}
+	return m.appendupload_allowed_mime_types, true
+}
+
+// ClearUploadAllowedMimeTypes clears the value of the "upload_allowed_mime_types" field.
+func (m *TenantSettingMutation) ClearUploadAllowedMimeTypes() {
+	m.upload_allowed_mime_types = nil
+	m.appendupload_allowed_mime_types = nil
+	m.clearedFields[tenantsetting.FieldUploadAllowedMimeTypes] = struct{}{}
+}
+
+// UploadAllowedMimeTypesCleared returns if the "upload_allowed_mime_types" field was cleared in this mutation.
+func (m *TenantSettingMutation) UploadAllowedMimeTypesCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUploadAllowedMimeTypes]
+	return ok
+}
+
+// ResetUploadAllowedMimeTypes resets all changes to the "upload_allowed_mime_types" field.
+func (m *TenantSettingMutation) ResetUploadAllowedMimeTypes() {
+	m.upload_allowed_mime_types = nil
+	m.appendupload_allowed_mime_types = nil
+	delete(m.clearedFields, tenantsetting.FieldUploadAllowedMimeTypes)
+}
+
+// SetUploadBlockedMimeTypes sets the "upload_blocked_mime_types" field.
+func (m *TenantSettingMutation) SetUploadBlockedMimeTypes(s []string) {
+	m.upload_blocked_mime_types = &s
+	m.appendupload_blocked_mime_types = nil
+}
+
+// UploadBlockedMimeTypes returns the value of the "upload_blocked_mime_types" field in the mutation.
+func (m *TenantSettingMutation) UploadBlockedMimeTypes() (r []string, exists bool) {
+	v := m.upload_blocked_mime_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadBlockedMimeTypes returns the old "upload_blocked_mime_types" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldUploadBlockedMimeTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadBlockedMimeTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadBlockedMimeTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadBlockedMimeTypes: %w", err)
+	}
+	return oldValue.UploadBlockedMimeTypes, nil
+}
+
+// AppendUploadBlockedMimeTypes adds s to the "upload_blocked_mime_types" field.
+func (m *TenantSettingMutation) AppendUploadBlockedMimeTypes(s []string) {
+	m.appendupload_blocked_mime_types = append(m.appendupload_blocked_mime_types, s...)
+}
+
+// AppendedUploadBlockedMimeTypes returns the list of values that were appended to the "upload_blocked_mime_types" field in this mutation.
+func (m *TenantSettingMutation) AppendedUploadBlockedMimeTypes() ([]string, bool) {
+	if len(m.appendupload_blocked_mime_types) == 0 {
+		return nil, false
+	}
+	return m.appendupload_blocked_mime_types, true
+}
+
+// ClearUploadBlockedMimeTypes clears the value of the "upload_blocked_mime_types" field.
+func (m *TenantSettingMutation) ClearUploadBlockedMimeTypes() {
+	m.upload_blocked_mime_types = nil
+	m.appendupload_blocked_mime_types = nil
+	m.clearedFields[tenantsetting.FieldUploadBlockedMimeTypes] = struct{}{}
+}
+
+// UploadBlockedMimeTypesCleared returns if the "upload_blocked_mime_types" field was cleared in this mutation.
+func (m *TenantSettingMutation) UploadBlockedMimeTypesCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUploadBlockedMimeTypes]
+	return ok
+}
+
+// ResetUploadBlockedMimeTypes resets all changes to the "upload_blocked_mime_types" field.
+func (m *TenantSettingMutation) ResetUploadBlockedMimeTypes() {
+	m.upload_blocked_mime_types = nil
+	m.appendupload_blocked_mime_types = nil
+	delete(m.clearedFields, tenantsetting.FieldUploadBlockedMimeTypes)
+}
+
+// SetFileMetadataSchemas sets the "file_metadata_schemas" field.
+func (m *TenantSettingMutation) SetFileMetadataSchemas(f []filemeta.Schema) {
+	m.file_metadata_schemas = &f
+	m.appendfile_metadata_schemas = nil
+}
+
+// FileMetadataSchemas returns the value of the "file_metadata_schemas" field in the mutation.
+func (m *TenantSettingMutation) FileMetadataSchemas() (r []filemeta.Schema, exists bool) {
+	v := m.file_metadata_schemas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileMetadataSchemas returns the old "file_metadata_schemas" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantSettingMutation) OldFileMetadataSchemas(ctx context.Context) (v []filemeta.Schema, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileMetadataSchemas is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileMetadataSchemas requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileMetadataSchemas: %w", err)
+	}
+	return oldValue.FileMetadataSchemas, nil
+}
+
+// AppendFileMetadataSchemas adds f to the "file_metadata_schemas" field.
+func (m *TenantSettingMutation) AppendFileMetadataSchemas(f []filemeta.Schema) {
+	m.appendfile_metadata_schemas = append(m.appendfile_metadata_schemas, f...)
+}
+
+// AppendedFileMetadataSchemas returns the list of values that were appended to the "file_metadata_schemas" field in this mutation.
+func (m *TenantSettingMutation) AppendedFileMetadataSchemas() ([]filemeta.Schema, bool) {
+	if len(m.appendfile_metadata_schemas) == 0 {
+		return nil, false
+	}
+	return m.appendfile_metadata_schemas, true
+}
+
+// ClearFileMetadataSchemas clears the value of the "file_metadata_schemas" field.
+func (m *TenantSettingMutation) ClearFileMetadataSchemas() {
+	m.file_metadata_schemas = nil
+	m.appendfile_metadata_schemas = nil
+	m.clearedFields[tenantsetting.FieldFileMetadataSchemas] = struct{}{}
+}
+
+// FileMetadataSchemasCleared returns if the "file_metadata_schemas" field was cleared in this mutation.
+func (m *TenantSettingMutation) FileMetadataSchemasCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldFileMetadataSchemas]
+	return ok
+}
+
+// ResetFileMetadataSchemas resets all changes to the "file_metadata_schemas" field.
+func (m *TenantSettingMutation) ResetFileMetadataSchemas() {
+	m.file_metadata_schemas = nil
+	m.appendfile_metadata_schemas = nil
+	delete(m.clearedFields, tenantsetting.FieldFileMetadataSchemas)
+}
+
+// Where appends a list predicates to the TenantSettingMutation builder.
+func (m *TenantSettingMutation) Where(ps ...predicate.TenantSetting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TenantSettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TenantSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TenantSetting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TenantSettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TenantSettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TenantSetting).
+func (m *TenantSettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TenantSettingMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.tenant_id != nil {
+		fields = append(fields, tenantsetting.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, tenantsetting.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, tenantsetting.FieldUpdateTime)
+	}
+	if m.default_language != nil {
+		fields = append(fields, tenantsetting.FieldDefaultLanguage)
+	}
+	if m.state != nil {
+		fields = append(fields, tenantsetting.FieldState)
+	}
+	if m.state_reason != nil {
+		fields = append(fields, tenantsetting.FieldStateReason)
+	}
+	if m.state_changed_at != nil {
+		fields = append(fields, tenantsetting.FieldStateChangedAt)
+	}
+	if m.quota_mode != nil {
+		fields = append(fields, tenantsetting.FieldQuotaMode)
+	}
+	if m.quota_grace_percent != nil {
+		fields = append(fields, tenantsetting.FieldQuotaGracePercent)
+	}
+	if m.storage_limit_bytes != nil {
+		fields = append(fields, tenantsetting.FieldStorageLimitBytes)
+	}
+	if m.default_file_retention_days != nil {
+		fields = append(fields, tenantsetting.FieldDefaultFileRetentionDays)
+	}
+	if m.filename_conflict_mode != nil {
+		fields = append(fields, tenantsetting.FieldFilenameConflictMode)
+	}
+	if m.require_upload_description != nil {
+		fields = append(fields, tenantsetting.FieldRequireUploadDescription)
+	}
+	if m.upload_description_template != nil {
+		fields = append(fields, tenantsetting.FieldUploadDescriptionTemplate)
+	}
+	if m.upload_classification_tags != nil {
+		fields = append(fields, tenantsetting.FieldUploadClassificationTags)
+	}
+	if m.image_metadata_mode != nil {
+		fields = append(fields, tenantsetting.FieldImageMetadataMode)
+	}
+	if m.require_pdf_watermark != nil {
+		fields = append(fields, tenantsetting.FieldRequirePdfWatermark)
+	}
+	if m.max_upload_size != nil {
+		fields = append(fields, tenantsetting.FieldMaxUploadSize)
+	}
+	if m.upload_allowed_extensions != nil {
+		fields = append(fields, tenantsetting.FieldUploadAllowedExtensions)
+	}
+	if m.upload_blocked_extensions != nil {
+		fields = append(fields, tenantsetting.FieldUploadBlockedExtensions)
+	}
+	if m.upload_allowed_mime_types != nil {
+		fields = append(fields, tenantsetting.FieldUploadAllowedMimeTypes)
+	}
+	if m.upload_blocked_mime_types != nil {
+		fields = append(fields, tenantsetting.FieldUploadBlockedMimeTypes)
+	}
+	if m.file_metadata_schemas != nil {
+		fields = append(fields, tenantsetting.FieldFileMetadataSchemas)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TenantSettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tenantsetting.FieldTenantID:
+		return m.TenantID()
+	case tenantsetting.FieldCreateTime:
+		return m.CreateTime()
+	case tenantsetting.FieldUpdateTime:
+		return m.UpdateTime()
+	case tenantsetting.FieldDefaultLanguage:
+		return m.DefaultLanguage()
+	case tenantsetting.FieldState:
+		return m.State()
+	case tenantsetting.FieldStateReason:
+		return m.StateReason()
+	case tenantsetting.FieldStateChangedAt:
+		return m.StateChangedAt()
+	case tenantsetting.FieldQuotaMode:
+		return m.QuotaMode()
+	case tenantsetting.FieldQuotaGracePercent:
+		return m.QuotaGracePercent()
+	case tenantsetting.FieldStorageLimitBytes:
+		return m.StorageLimitBytes()
+	case tenantsetting.FieldDefaultFileRetentionDays:
+		return m.DefaultFileRetentionDays()
+	case tenantsetting.FieldFilenameConflictMode:
+		return m.FilenameConflictMode()
+	case tenantsetting.FieldRequireUploadDescription:
+		return m.RequireUploadDescription()
+	case tenantsetting.FieldUploadDescriptionTemplate:
+		return m.UploadDescriptionTemplate()
+	case tenantsetting.FieldUploadClassificationTags:
+		return m.UploadClassificationTags()
+	case tenantsetting.FieldImageMetadataMode:
+		return m.ImageMetadataMode()
+	case tenantsetting.FieldRequirePdfWatermark:
+		return m.RequirePdfWatermark()
+	case tenantsetting.FieldMaxUploadSize:
+		return m.MaxUploadSize()
+	case tenantsetting.FieldUploadAllowedExtensions:
+		return m.UploadAllowedExtensions()
+	case tenantsetting.FieldUploadBlockedExtensions:
+		return m.UploadBlockedExtensions()
+	case tenantsetting.FieldUploadAllowedMimeTypes:
+		return m.UploadAllowedMimeTypes()
+	case tenantsetting.FieldUploadBlockedMimeTypes:
+		return m.UploadBlockedMimeTypes()
+	case tenantsetting.FieldFileMetadataSchemas:
+		return m.FileMetadataSchemas()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TenantSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tenantsetting.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case tenantsetting.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case tenantsetting.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case tenantsetting.FieldDefaultLanguage:
+		return m.OldDefaultLanguage(ctx)
+	case tenantsetting.FieldState:
+		return m.OldState(ctx)
+	case tenantsetting.FieldStateReason:
+		return m.OldStateReason(ctx)
+	case tenantsetting.FieldStateChangedAt:
+		return m.OldStateChangedAt(ctx)
+	case tenantsetting.FieldQuotaMode:
+		return m.OldQuotaMode(ctx)
+	case tenantsetting.FieldQuotaGracePercent:
+		return m.OldQuotaGracePercent(ctx)
+	case tenantsetting.FieldStorageLimitBytes:
+		return m.OldStorageLimitBytes(ctx)
+	case tenantsetting.FieldDefaultFileRetentionDays:
+		return m.OldDefaultFileRetentionDays(ctx)
+	case tenantsetting.FieldFilenameConflictMode:
+		return m.OldFilenameConflictMode(ctx)
+	case tenantsetting.FieldRequireUploadDescription:
+		return m.OldRequireUploadDescription(ctx)
+	case tenantsetting.FieldUploadDescriptionTemplate:
+		return m.OldUploadDescriptionTemplate(ctx)
+	case tenantsetting.FieldUploadClassificationTags:
+		return m.OldUploadClassificationTags(ctx)
+	case tenantsetting.FieldImageMetadataMode:
+		return m.OldImageMetadataMode(ctx)
+	case tenantsetting.FieldRequirePdfWatermark:
+		return m.OldRequirePdfWatermark(ctx)
+	case tenantsetting.FieldMaxUploadSize:
+		return m.OldMaxUploadSize(ctx)
+	case tenantsetting.FieldUploadAllowedExtensions:
+		return m.OldUploadAllowedExtensions(ctx)
+	case tenantsetting.FieldUploadBlockedExtensions:
+		return m.OldUploadBlockedExtensions(ctx)
+	case tenantsetting.FieldUploadAllowedMimeTypes:
+		return m.OldUploadAllowedMimeTypes(ctx)
+	case tenantsetting.FieldUploadBlockedMimeTypes:
+		return m.OldUploadBlockedMimeTypes(ctx)
+	case tenantsetting.FieldFileMetadataSchemas:
+		return m.OldFileMetadataSchemas(ctx)
+	}
+	return nil, fmt.Errorf("unknown TenantSetting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantSettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tenantsetting.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case tenantsetting.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case tenantsetting.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tenantsetting.FieldDefaultLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultLanguage(v)
+		return nil
+	case tenantsetting.FieldState:
+		v, ok := value.(tenantsetting.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case tenantsetting.FieldStateReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStateReason(v)
+		return nil
+	case tenantsetting.FieldStateChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStateChangedAt(v)
+		return nil
+	case tenantsetting.FieldQuotaMode:
+		v, ok := value.(tenantsetting.QuotaMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuotaMode(v)
+		return nil
+	case tenantsetting.FieldQuotaGracePercent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuotaGracePercent(v)
+		return nil
+	case tenantsetting.FieldStorageLimitBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageLimitBytes(v)
+		return nil
+	case tenantsetting.FieldDefaultFileRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultFileRetentionDays(v)
+		return nil
+	case tenantsetting.FieldFilenameConflictMode:
+		v, ok := value.(tenantsetting.FilenameConflictMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilenameConflictMode(v)
+		return nil
+	case tenantsetting.FieldRequireUploadDescription:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequireUploadDescription(v)
+		return nil
+	case tenantsetting.FieldUploadDescriptionTemplate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadDescriptionTemplate(v)
+		return nil
+	case tenantsetting.FieldUploadClassificationTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadClassificationTags(v)
+		return nil
+	case tenantsetting.FieldImageMetadataMode:
+		v, ok := value.(tenantsetting.ImageMetadataMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageMetadataMode(v)
+		return nil
+	case tenantsetting.FieldRequirePdfWatermark:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequirePdfWatermark(v)
+		return nil
+	case tenantsetting.FieldMaxUploadSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxUploadSize(v)
+		return nil
+	case tenantsetting.FieldUploadAllowedExtensions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadAllowedExtensions(v)
+		return nil
+	case tenantsetting.FieldUploadBlockedExtensions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadBlockedExtensions(v)
+		return nil
+	case tenantsetting.FieldUploadAllowedMimeTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadAllowedMimeTypes(v)
+		return nil
+	case tenantsetting.FieldUploadBlockedMimeTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadBlockedMimeTypes(v)
+		return nil
+	case tenantsetting.FieldFileMetadataSchemas:
+		v, ok := value.([]filemeta.Schema)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileMetadataSchemas(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TenantSetting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TenantSettingMutation) AddedFields() []string {
+	var fields []string
+	if m.addquota_grace_percent != nil {
+		fields = append(fields, tenantsetting.FieldQuotaGracePercent)
+	}
+	if m.addstorage_limit_bytes != nil {
+		fields = append(fields, tenantsetting.FieldStorageLimitBytes)
+	}
+	if m.adddefault_file_retention_days != nil {
+		fields = append(fields, tenantsetting.FieldDefaultFileRetentionDays)
+	}
+	if m.addmax_upload_size != nil {
+		fields = append(fields, tenantsetting.FieldMaxUploadSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TenantSettingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tenantsetting.FieldQuotaGracePercent:
+		return m.AddedQuotaGracePercent()
+	case tenantsetting.FieldStorageLimitBytes:
+		return m.AddedStorageLimitBytes()
+	case tenantsetting.FieldDefaultFileRetentionDays:
+		return m.AddedDefaultFileRetentionDays()
+	case tenantsetting.FieldMaxUploadSize:
+		return m.AddedMaxUploadSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantSettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tenantsetting.FieldQuotaGracePercent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuotaGracePercent(v)
+		return nil
+	case tenantsetting.FieldStorageLimitBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageLimitBytes(v)
+		return nil
+	case tenantsetting.FieldDefaultFileRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefaultFileRetentionDays(v)
+		return nil
+	case tenantsetting.FieldMaxUploadSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUploadSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TenantSetting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TenantSettingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tenantsetting.FieldDefaultLanguage) {
+		fields = append(fields, tenantsetting.FieldDefaultLanguage)
+	}
+	if m.FieldCleared(tenantsetting.FieldStateReason) {
+		fields = append(fields, tenantsetting.FieldStateReason)
+	}
+	if m.FieldCleared(tenantsetting.FieldStateChangedAt) {
+		fields = append(fields, tenantsetting.FieldStateChangedAt)
+	}
+	if m.FieldCleared(tenantsetting.FieldStorageLimitBytes) {
+		fields = append(fields, tenantsetting.FieldStorageLimitBytes)
+	}
+	if m.FieldCleared(tenantsetting.FieldDefaultFileRetentionDays) {
+		fields = append(fields, tenantsetting.FieldDefaultFileRetentionDays)
+	}
+	if m.FieldCleared(tenantsetting.FieldUploadDescriptionTemplate) {
+		fields = append(fields, tenantsetting.FieldUploadDescriptionTemplate)
+	}
+	if m.FieldCleared(tenantsetting.FieldUploadClassificationTags) {
+		fields = append(fields, tenantsetting.FieldUploadClassificationTags)
+	}
+	if m.FieldCleared(tenantsetting.FieldMaxUploadSize) {
+		fields = append(fields, tenantsetting.FieldMaxUploadSize)
+	}
+	if m.FieldCleared(tenantsetting.FieldUploadAllowedExtensions) {
+		fields = append(fields, tenantsetting.FieldUploadAllowedExtensions)
+	}
+	if m.FieldCleared(tenantsetting.FieldUploadBlockedExtensions) {
+		fields = append(fields, tenantsetting.FieldUploadBlockedExtensions)
+	}
+	if m.FieldCleared(tenantsetting.FieldUploadAllowedMimeTypes) {
+		fields = append(fields, tenantsetting.FieldUploadAllowedMimeTypes)
+	}
+	if m.FieldCleared(tenantsetting.FieldUploadBlockedMimeTypes) {
+		fields = append(fields, tenantsetting.FieldUploadBlockedMimeTypes)
+	}
+	if m.FieldCleared(tenantsetting.FieldFileMetadataSchemas) {
+		fields = append(fields, tenantsetting.FieldFileMetadataSchemas)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TenantSettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TenantSettingMutation) ClearField(name string) error {
+	switch name {
+	case tenantsetting.FieldDefaultLanguage:
+		m.ClearDefaultLanguage()
+		return nil
+	case tenantsetting.FieldStateReason:
+		m.ClearStateReason()
+		return nil
+	case tenantsetting.FieldStateChangedAt:
+		m.ClearStateChangedAt()
+		return nil
+	case tenantsetting.FieldStorageLimitBytes:
+		m.ClearStorageLimitBytes()
+		return nil
+	case tenantsetting.FieldDefaultFileRetentionDays:
+		m.ClearDefaultFileRetentionDays()
+		return nil
+	case tenantsetting.FieldUploadDescriptionTemplate:
+		m.ClearUploadDescriptionTemplate()
+		return nil
+	case tenantsetting.FieldUploadClassificationTags:
+		m.ClearUploadClassificationTags()
+		return nil
+	case tenantsetting.FieldMaxUploadSize:
+		m.ClearMaxUploadSize()
+		return nil
+	case tenantsetting.FieldUploadAllowedExtensions:
+		m.ClearUploadAllowedExtensions()
+		return nil
+	case tenantsetting.FieldUploadBlockedExtensions:
+		m.ClearUploadBlockedExtensions()
+		return nil
+	case tenantsetting.FieldUploadAllowedMimeTypes:
+		m.ClearUploadAllowedMimeTypes()
+		return nil
+	case tenantsetting.FieldUploadBlockedMimeTypes:
+		m.ClearUploadBlockedMimeTypes()
+		return nil
+	case tenantsetting.FieldFileMetadataSchemas:
+		m.ClearFileMetadataSchemas()
+		return nil
+	}
+	return fmt.Errorf("unknown TenantSetting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TenantSettingMutation) ResetField(name string) error {
+	switch name {
+	case tenantsetting.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case tenantsetting.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tenantsetting.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tenantsetting.FieldDefaultLanguage:
+		m.ResetDefaultLanguage()
+		return nil
+	case tenantsetting.FieldState:
+		m.ResetState()
+		return nil
+	case tenantsetting.FieldStateReason:
+		m.ResetStateReason()
+		return nil
+	case tenantsetting.FieldStateChangedAt:
+		m.ResetStateChangedAt()
+		return nil
+	case tenantsetting.FieldQuotaMode:
+		m.ResetQuotaMode()
+		return nil
+	case tenantsetting.FieldQuotaGracePercent:
+		m.ResetQuotaGracePercent()
+		return nil
+	case tenantsetting.FieldStorageLimitBytes:
+		m.ResetStorageLimitBytes()
+		return nil
+	case tenantsetting.FieldDefaultFileRetentionDays:
+		m.ResetDefaultFileRetentionDays()
+		return nil
+	case tenantsetting.FieldFilenameConflictMode:
+		m.ResetFilenameConflictMode()
+		return nil
+	case tenantsetting.FieldRequireUploadDescription:
+		m.ResetRequireUploadDescription()
+		return nil
+	case tenantsetting.FieldUploadDescriptionTemplate:
+		m.ResetUploadDescriptionTemplate()
+		return nil
+	case tenantsetting.FieldUploadClassificationTags:
+		m.ResetUploadClassificationTags()
+		return nil
+	case tenantsetting.FieldImageMetadataMode:
+		m.ResetImageMetadataMode()
+		return nil
+	case tenantsetting.FieldRequirePdfWatermark:
+		m.ResetRequirePdfWatermark()
+		return nil
+	case tenantsetting.FieldMaxUploadSize:
+		m.ResetMaxUploadSize()
+		return nil
+	case tenantsetting.FieldUploadAllowedExtensions:
+		m.ResetUploadAllowedExtensions()
+		return nil
+	case tenantsetting.FieldUploadBlockedExtensions:
+		m.ResetUploadBlockedExtensions()
+		return nil
+	case tenantsetting.FieldUploadAllowedMimeTypes:
+		m.ResetUploadAllowedMimeTypes()
+		return nil
+	case tenantsetting.FieldUploadBlockedMimeTypes:
+		m.ResetUploadBlockedMimeTypes()
+		return nil
+	case tenantsetting.FieldFileMetadataSchemas:
+		m.ResetFileMetadataSchemas()
+		return nil
+	}
+	return fmt.Errorf("unknown TenantSetting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TenantSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TenantSettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TenantSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TenantSettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TenantSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TenantSettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TenantSettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TenantSetting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TenantSettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TenantSetting edge %s", name)
+}
+
+// TranslationOverrideMutation represents an operation that mutates the TranslationOverride nodes in the graph.
+type TranslationOverrideMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	tenant_id     *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	message_id    *string
+	language      *string
+	text          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TranslationOverride, error)
+	predicates    []predicate.TranslationOverride
+}
+
+var _ ent.Mutation = (*TranslationOverrideMutation)(nil)
+
+// translationoverrideOption allows management of the mutation configuration using functional options.
+type translationoverrideOption func(*TranslationOverrideMutation)
+
+// newTranslationOverrideMutation creates new mutation for the TranslationOverride entity.
+func newTranslationOverrideMutation(c config, op Op, opts ...translationoverrideOption) *TranslationOverrideMutation {
+	m := &TranslationOverrideMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTranslationOverride,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTranslationOverrideID sets the ID field of the mutation.
+func withTranslationOverrideID(id uuid.UUID) translationoverrideOption {
+	return func(m *TranslationOverrideMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TranslationOverride
+		)
+		m.oldValue = func(ctx context.Context) (*TranslationOverride, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TranslationOverride.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTranslationOverride sets the old TranslationOverride of the mutation.
+func withTranslationOverride(node *TranslationOverride) translationoverrideOption {
+	return func(m *TranslationOverrideMutation) {
+		m.oldValue = func(context.Context) (*TranslationOverride, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TranslationOverrideMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TranslationOverrideMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TranslationOverride entities.
+func (m *TranslationOverrideMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TranslationOverrideMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TranslationOverrideMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TranslationOverride.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *TranslationOverrideMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TranslationOverrideMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the TranslationOverride entity.
+// If the TranslationOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranslationOverrideMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TranslationOverrideMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TranslationOverrideMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TranslationOverrideMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the TranslationOverride entity.
+// If the TranslationOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranslationOverrideMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TranslationOverrideMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TranslationOverrideMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TranslationOverrideMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the TranslationOverride entity.
+// If the TranslationOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranslationOverrideMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TranslationOverrideMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *TranslationOverrideMutation) SetMessageID(s string) {
+	m.message_id = &s
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *TranslationOverrideMutation) MessageID() (r string, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the TranslationOverride entity.
+// If the TranslationOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranslationOverrideMutation) OldMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *TranslationOverrideMutation) ResetMessageID() {
+	m.message_id = nil
+}
+
+// SetLanguage sets the "language" field.
+func (m *TranslationOverrideMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *TranslationOverrideMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the TranslationOverride entity.
+// If the TranslationOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranslationOverrideMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *TranslationOverrideMutation) ResetLanguage() {
+	m.language = nil
+}
+
+// SetText sets the "text" field.
+func (m *TranslationOverrideMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *TranslationOverrideMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the TranslationOverride entity.
+// If the TranslationOverride object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TranslationOverrideMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *TranslationOverrideMutation) ResetText() {
+	m.text = nil
+}
+
+// Where appends a list predicates to the TranslationOverrideMutation builder.
+func (m *TranslationOverrideMutation) Where(ps ...predicate.TranslationOverride) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TranslationOverrideMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TranslationOverrideMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TranslationOverride, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TranslationOverrideMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TranslationOverrideMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TranslationOverride).
+func (m *TranslationOverrideMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TranslationOverrideMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.tenant_id != nil {
+		fields = append(fields, translationoverride.FieldTenantID)
+	}
+	if m.create_time != nil {
+		fields = append(fields, translationoverride.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, translationoverride.FieldUpdateTime)
+	}
+	if m.message_id != nil {
+		fields = append(fields, translationoverride.FieldMessageID)
+	}
+	if m.language != nil {
+		fields = append(fields, translationoverride.FieldLanguage)
+	}
+	if m.text != nil {
+		fields = append(fields, translationoverride.FieldText)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TranslationOverrideMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case translationoverride.FieldTenantID:
+		return m.TenantID()
+	case translationoverride.FieldCreateTime:
+		return m.CreateTime()
+	case translationoverride.FieldUpdateTime:
+		return m.UpdateTime()
+	case translationoverride.FieldMessageID:
+		return m.MessageID()
+	case translationoverride.FieldLanguage:
+		return m.Language()
+	case translationoverride.FieldText:
+		return m.Text()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TranslationOverrideMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case translationoverride.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case translationoverride.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case translationoverride.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case translationoverride.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case translationoverride.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case translationoverride.FieldText:
+		return m.OldText(ctx)
+	}
+	return nil, fmt.Errorf("unknown TranslationOverride field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TranslationOverrideMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case translationoverride.FieldTenantID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case translationoverride.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case translationoverride.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case translationoverride.FieldMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case translationoverride.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case translationoverride.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TranslationOverride field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TranslationOverrideMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TranslationOverrideMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TranslationOverrideMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TranslationOverride numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TranslationOverrideMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TranslationOverrideMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TranslationOverrideMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TranslationOverride nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TranslationOverrideMutation) ResetField(name string) error {
+	switch name {
+	case translationoverride.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case translationoverride.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case translationoverride.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case translationoverride.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case translationoverride.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case translationoverride.FieldText:
+		m.ResetText()
+		return nil
+	}
+	return fmt.Errorf("unknown TranslationOverride field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TranslationOverrideMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TranslationOverrideMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TranslationOverrideMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TranslationOverrideMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TranslationOverrideMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TranslationOverrideMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TranslationOverrideMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TranslationOverride unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TranslationOverrideMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TranslationOverride edge %s", name)
+}
+
+// UserStorageLimitMutation represents an operation that mutates the UserStorageLimit nodes in the graph.
+type UserStorageLimitMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	tenant_id     *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	user_id       *uuid.UUID
+	max_files     *int
+	addmax_files  *int
+	max_bytes     *int64
+	addmax_bytes  *int64
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*UserStorageLimit, error)
+	predicates    []predicate.UserStorageLimit
+}
+
+var _ ent.Mutation = (*UserStorageLimitMutation)(nil)
+
+// userstoragelimitOption allows management of the mutation configuration using functional options.
+type userstoragelimitOption func(*UserStorageLimitMutation)
+
+// newUserStorageLimitMutation creates new mutation for the UserStorageLimit entity.
+func newUserStorageLimitMutation(c config, op Op, opts ...userstoragelimitOption) *UserStorageLimitMutation {
+	m := &UserStorageLimitMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserStorageLimit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserStorageLimitID sets the ID field of the mutation.
+func withUserStorageLimitID(id uuid.UUID) userstoragelimitOption {
+	return func(m *UserStorageLimitMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserStorageLimit
+		)
+		m.oldValue = func(ctx context.Context) (*UserStorageLimit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserStorageLimit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserStorageLimit sets the old UserStorageLimit of the mutation.
+func withUserStorageLimit(node *UserStorageLimit) userstoragelimitOption {
+	return func(m *UserStorageLimitMutation) {
+		m.oldValue = func(context.Context) (*UserStorageLimit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserStorageLimitMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserStorageLimitMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserStorageLimit entities.
+func (m *UserStorageLimitMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserStorageLimitMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserStorageLimitMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserStorageLimit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *UserStorageLimitMutation) SetTenantID(u uuid.UUID) {
+	m.tenant_id = &u
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *UserStorageLimitMutation) TenantID() (r uuid.UUID, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the UserStorageLimit entity.
+// If the UserStorageLimit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldPath(ctx context.Context) (v string, err error) {
+func (m *UserStorageLimitMutation) OldTenantID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPath requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Path, nil
+	return oldValue.TenantID, nil
 }
 
-// ClearPath clears the value of the "path" field.
-func (m *FileMutation) ClearPath() {
-	m._path = nil
-	m.clearedFields[file.FieldPath] = struct{}{}
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *UserStorageLimitMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// PathCleared returns if the "path" field was cleared in this mutation.
-func (m *FileMutation) PathCleared() bool {
-	_, ok := m.clearedFields[file.FieldPath]
-	return ok
+// SetCreateTime sets the "create_time" field.
+func (m *UserStorageLimitMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// ResetPath resets all changes to the "path" field.
-func (m *FileMutation) ResetPath() {
-	m._path = nil
-	delete(m.clearedFields, file.FieldPath)
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *UserStorageLimitMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetDescription sets the "description" field.
-func (m *FileMutation) SetDescription(s string) {
-	m.description = &s
+// OldCreateTime returns the old "create_time" field's value of the UserStorageLimit entity.
+// If the UserStorageLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserStorageLimitMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *FileMutation) Description() (r string, exists bool) {
-	v := m.description
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *UserStorageLimitMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *UserStorageLimitMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *UserStorageLimitMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the UserStorageLimit entity.
+// If the UserStorageLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserStorageLimitMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *UserStorageLimitMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *UserStorageLimitMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserStorageLimitMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UserStorageLimit entity.
+// If the UserStorageLimit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserStorageLimitMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserStorageLimitMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (m *UserStorageLimitMutation) SetMaxFiles(i int) {
+	m.max_files = &i
+	m.addmax_files = nil
+}
+
+// MaxFiles returns the value of the "max_files" field in the mutation.
+func (m *UserStorageLimitMutation) MaxFiles() (r int, exists bool) {
+	v := m.max_files
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxFiles returns the old "max_files" field's value of the UserStorageLimit entity.
+// If the UserStorageLimit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *UserStorageLimitMutation) OldMaxFiles(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxFiles is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldMaxFiles requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxFiles: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.MaxFiles, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *FileMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[file.FieldDescription] = struct{}{}
+// AddMaxFiles adds i to the "max_files" field.
+func (m *UserStorageLimitMutation) AddMaxFiles(i int) {
+	if m.addmax_files != nil {
+		*m.addmax_files += i
+	} else {
+		m.addmax_files = &i
+	}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *FileMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[file.FieldDescription]
+// AddedMaxFiles returns the value that was added to the "max_files" field in this mutation.
+func (m *UserStorageLimitMutation) AddedMaxFiles() (r int, exists bool) {
+	v := m.addmax_files
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxFiles clears the value of the "max_files" field.
+func (m *UserStorageLimitMutation) ClearMaxFiles() {
+	m.max_files = nil
+	m.addmax_files = nil
+	m.clearedFields[userstoragelimit.FieldMaxFiles] = struct{}{}
+}
+
+// MaxFilesCleared returns if the "max_files" field was cleared in this mutation.
+func (m *UserStorageLimitMutation) MaxFilesCleared() bool {
+	_, ok := m.clearedFields[userstoragelimit.FieldMaxFiles]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *FileMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, file.FieldDescription)
+// ResetMaxFiles resets all changes to the "max_files" field.
+func (m *UserStorageLimitMutation) ResetMaxFiles() {
+	m.max_files = nil
+	m.addmax_files = nil
+	delete(m.clearedFields, userstoragelimit.FieldMaxFiles)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *FileMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetMaxBytes sets the "max_bytes" field.
+func (m *UserStorageLimitMutation) SetMaxBytes(i int64) {
+	m.max_bytes = &i
+	m.addmax_bytes = nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *FileMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// MaxBytes returns the value of the "max_bytes" field in the mutation.
+func (m *UserStorageLimitMutation) MaxBytes() (r int64, exists bool) {
+	v := m.max_bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the File entity.
-// If the File object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxBytes returns the old "max_bytes" field's value of the UserStorageLimit entity.
+// If the UserStorageLimit object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FileMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *UserStorageLimitMutation) OldMaxBytes(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldMaxBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxBytes: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.MaxBytes, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *FileMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[file.FieldMetadata] = struct{}{}
+// AddMaxBytes adds i to the "max_bytes" field.
+func (m *UserStorageLimitMutation) AddMaxBytes(i int64) {
+	if m.addmax_bytes != nil {
+		*m.addmax_bytes += i
+	} else {
+		m.addmax_bytes = &i
+	}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *FileMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[file.FieldMetadata]
+// AddedMaxBytes returns the value that was added to the "max_bytes" field in this mutation.
+func (m *UserStorageLimitMutation) AddedMaxBytes() (r int64, exists bool) {
+	v := m.addmax_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (m *UserStorageLimitMutation) ClearMaxBytes() {
+	m.max_bytes = nil
+	m.addmax_bytes = nil
+	m.clearedFields[userstoragelimit.FieldMaxBytes] = struct{}{}
+}
+
+// MaxBytesCleared returns if the "max_bytes" field was cleared in this mutation.
+func (m *UserStorageLimitMutation) MaxBytesCleared() bool {
+	_, ok := m.clearedFields[userstoragelimit.FieldMaxBytes]
 	return ok
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *FileMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, file.FieldMetadata)
+// ResetMaxBytes resets all changes to the "max_bytes" field.
+func (m *UserStorageLimitMutation) ResetMaxBytes() {
+	m.max_bytes = nil
+	m.addmax_bytes = nil
+	delete(m.clearedFields, userstoragelimit.FieldMaxBytes)
 }
 
-// Where appends a list predicates to the FileMutation builder.
-func (m *FileMutation) Where(ps ...predicate.File) {
+// Where appends a list predicates to the UserStorageLimitMutation builder.
+func (m *UserStorageLimitMutation) Where(ps ...predicate.UserStorageLimit) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserStorageLimitMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.File, len(ps))
+func (m *UserStorageLimitMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserStorageLimit, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -627,57 +12726,42 @@ func (m *FileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FileMutation) Op() Op {
+func (m *UserStorageLimitMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FileMutation) SetOp(op Op) {
+func (m *UserStorageLimitMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (File).
-func (m *FileMutation) Type() string {
+// Type returns the node type of this mutation (UserStorageLimit).
+func (m *UserStorageLimitMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FileMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *UserStorageLimitMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.tenant_id != nil {
-		fields = append(fields, file.FieldTenantID)
+		fields = append(fields, userstoragelimit.FieldTenantID)
 	}
 	if m.create_time != nil {
-		fields = append(fields, file.FieldCreateTime)
+		fields = append(fields, userstoragelimit.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, file.FieldUpdateTime)
-	}
-	if m.created_by != nil {
-		fields = append(fields, file.FieldCreatedBy)
-	}
-	if m.original_name != nil {
-		fields = append(fields, file.FieldOriginalName)
-	}
-	if m.storage_key != nil {
-		fields = append(fields, file.FieldStorageKey)
-	}
-	if m.mime_type != nil {
-		fields = append(fields, file.FieldMimeType)
-	}
-	if m.size != nil {
-		fields = append(fields, file.FieldSize)
+		fields = append(fields, userstoragelimit.FieldUpdateTime)
 	}
-	if m._path != nil {
-		fields = append(fields, file.FieldPath)
+	if m.user_id != nil {
+		fields = append(fields, userstoragelimit.FieldUserID)
 	}
-	if m.description != nil {
-		fields = append(fields, file.FieldDescription)
+	if m.max_files != nil {
+		fields = append(fields, userstoragelimit.FieldMaxFiles)
 	}
-	if m.metadata != nil {
-		fields = append(fields, file.FieldMetadata)
+	if m.max_bytes != nil {
+		fields = append(fields, userstoragelimit.FieldMaxBytes)
 	}
 	return fields
 }
@@ -685,30 +12769,20 @@ func (m *FileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FileMutation) Field(name string) (ent.Value, bool) {
+func (m *UserStorageLimitMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldTenantID:
+	case userstoragelimit.FieldTenantID:
 		return m.TenantID()
-	case file.FieldCreateTime:
+	case userstoragelimit.FieldCreateTime:
 		return m.CreateTime()
-	case file.FieldUpdateTime:
+	case userstoragelimit.FieldUpdateTime:
 		return m.UpdateTime()
-	case file.FieldCreatedBy:
-		return m.CreatedBy()
-	case file.FieldOriginalName:
-		return m.OriginalName()
-	case file.FieldStorageKey:
-		return m.StorageKey()
-	case file.FieldMimeType:
-		return m.MimeType()
-	case file.FieldSize:
-		return m.Size()
-	case file.FieldPath:
-		return m.Path()
-	case file.FieldDescription:
-		return m.Description()
-	case file.FieldMetadata:
-		return m.Metadata()
+	case userstoragelimit.FieldUserID:
+		return m.UserID()
+	case userstoragelimit.FieldMaxFiles:
+		return m.MaxFiles()
+	case userstoragelimit.FieldMaxBytes:
+		return m.MaxBytes()
 	}
 	return nil, false
 }
@@ -716,126 +12790,84 @@ func (m *FileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserStorageLimitMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case file.FieldTenantID:
+	case userstoragelimit.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case file.FieldCreateTime:
+	case userstoragelimit.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case file.FieldUpdateTime:
+	case userstoragelimit.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case file.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case file.FieldOriginalName:
-		return m.OldOriginalName(ctx)
-	case file.FieldStorageKey:
-		return m.OldStorageKey(ctx)
-	case file.FieldMimeType:
-		return m.OldMimeType(ctx)
-	case file.FieldSize:
-		return m.OldSize(ctx)
-	case file.FieldPath:
-		return m.OldPath(ctx)
-	case file.FieldDescription:
-		return m.OldDescription(ctx)
-	case file.FieldMetadata:
-		return m.OldMetadata(ctx)
+	case userstoragelimit.FieldUserID:
+		return m.OldUserID(ctx)
+	case userstoragelimit.FieldMaxFiles:
+		return m.OldMaxFiles(ctx)
+	case userstoragelimit.FieldMaxBytes:
+		return m.OldMaxBytes(ctx)
 	}
-	return nil, fmt.Errorf("unknown File field %s", name)
+	return nil, fmt.Errorf("unknown UserStorageLimit field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) SetField(name string, value ent.Value) error {
+func (m *UserStorageLimitMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldTenantID:
+	case userstoragelimit.FieldTenantID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case file.FieldCreateTime:
+	case userstoragelimit.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case file.FieldUpdateTime:
+	case userstoragelimit.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case file.FieldCreatedBy:
+	case userstoragelimit.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
-		return nil
-	case file.FieldOriginalName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOriginalName(v)
-		return nil
-	case file.FieldStorageKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStorageKey(v)
+		m.SetUserID(v)
 		return nil
-	case file.FieldMimeType:
-		v, ok := value.(string)
+	case userstoragelimit.FieldMaxFiles:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMimeType(v)
+		m.SetMaxFiles(v)
 		return nil
-	case file.FieldSize:
+	case userstoragelimit.FieldMaxBytes:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
-		return nil
-	case file.FieldPath:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPath(v)
-		return nil
-	case file.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case file.FieldMetadata:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMetadata(v)
+		m.SetMaxBytes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown UserStorageLimit field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FileMutation) AddedFields() []string {
+func (m *UserStorageLimitMutation) AddedFields() []string {
 	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, file.FieldSize)
+	if m.addmax_files != nil {
+		fields = append(fields, userstoragelimit.FieldMaxFiles)
+	}
+	if m.addmax_bytes != nil {
+		fields = append(fields, userstoragelimit.FieldMaxBytes)
 	}
 	return fields
 }
@@ -843,10 +12875,12 @@ func (m *FileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserStorageLimitMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldSize:
-		return m.AddedSize()
+	case userstoragelimit.FieldMaxFiles:
+		return m.AddedMaxFiles()
+	case userstoragelimit.FieldMaxBytes:
+		return m.AddedMaxBytes()
 	}
 	return nil, false
 }
@@ -854,144 +12888,130 @@ func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FileMutation) AddField(name string, value ent.Value) error {
+func (m *UserStorageLimitMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldSize:
+	case userstoragelimit.FieldMaxFiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxFiles(v)
+		return nil
+	case userstoragelimit.FieldMaxBytes:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSize(v)
+		m.AddMaxBytes(v)
 		return nil
 	}
-	return fmt.Errorf("unknown File numeric field %s", name)
+	return fmt.Errorf("unknown UserStorageLimit numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FileMutation) ClearedFields() []string {
+func (m *UserStorageLimitMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(file.FieldPath) {
-		fields = append(fields, file.FieldPath)
-	}
-	if m.FieldCleared(file.FieldDescription) {
-		fields = append(fields, file.FieldDescription)
+	if m.FieldCleared(userstoragelimit.FieldMaxFiles) {
+		fields = append(fields, userstoragelimit.FieldMaxFiles)
 	}
-	if m.FieldCleared(file.FieldMetadata) {
-		fields = append(fields, file.FieldMetadata)
+	if m.FieldCleared(userstoragelimit.FieldMaxBytes) {
+		fields = append(fields, userstoragelimit.FieldMaxBytes)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FileMutation) FieldCleared(name string) bool {
+func (m *UserStorageLimitMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileMutation) ClearField(name string) error {
+func (m *UserStorageLimitMutation) ClearField(name string) error {
 	switch name {
-	case file.FieldPath:
-		m.ClearPath()
-		return nil
-	case file.FieldDescription:
-		m.ClearDescription()
+	case userstoragelimit.FieldMaxFiles:
+		m.ClearMaxFiles()
 		return nil
-	case file.FieldMetadata:
-		m.ClearMetadata()
+	case userstoragelimit.FieldMaxBytes:
+		m.ClearMaxBytes()
 		return nil
 	}
-	return fmt.Errorf("unknown File nullable field %s", name)
+	return fmt.Errorf("unknown UserStorageLimit nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FileMutation) ResetField(name string) error {
+func (m *UserStorageLimitMutation) ResetField(name string) error {
 	switch name {
-	case file.FieldTenantID:
+	case userstoragelimit.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case file.FieldCreateTime:
+	case userstoragelimit.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case file.FieldUpdateTime:
+	case userstoragelimit.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case file.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case file.FieldOriginalName:
-		m.ResetOriginalName()
-		return nil
-	case file.FieldStorageKey:
-		m.ResetStorageKey()
-		return nil
-	case file.FieldMimeType:
-		m.ResetMimeType()
-		return nil
-	case file.FieldSize:
-		m.ResetSize()
-		return nil
-	case file.FieldPath:
-		m.ResetPath()
+	case userstoragelimit.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case file.FieldDescription:
-		m.ResetDescription()
+	case userstoragelimit.FieldMaxFiles:
+		m.ResetMaxFiles()
 		return nil
-	case file.FieldMetadata:
-		m.ResetMetadata()
+	case userstoragelimit.FieldMaxBytes:
+		m.ResetMaxBytes()
 		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown UserStorageLimit field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FileMutation) AddedEdges() []string {
+func (m *UserStorageLimitMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FileMutation) AddedIDs(name string) []ent.Value {
+func (m *UserStorageLimitMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FileMutation) RemovedEdges() []string {
+func (m *UserStorageLimitMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserStorageLimitMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FileMutation) ClearedEdges() []string {
+func (m *UserStorageLimitMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FileMutation) EdgeCleared(name string) bool {
+func (m *UserStorageLimitMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FileMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown File unique edge %s", name)
+func (m *UserStorageLimitMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserStorageLimit unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FileMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown File edge %s", name)
+func (m *UserStorageLimitMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserStorageLimit edge %s", name)
 }
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/ent/operationauditlog"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// OperationAuditLog is the model entity for the OperationAuditLog schema.
+type OperationAuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Имя мутации (поле Mutation)
+	OperationName string `json:"operation_name,omitempty"`
+	// Аргументы мутации со скрытыми секретами
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	// Пользователь, выполнивший мутацию
+	ActorID *uuid.UUID `json:"actor_id,omitempty"`
+	// Администратор поддержки, действовавший от имени пользователя (пусто вне сессии поддержки)
+	ImpersonatorID *uuid.UUID `json:"impersonator_id,omitempty"`
+	// Роль пользователя на момент выполнения
+	ActorRole string `json:"actor_role,omitempty"`
+	// Результат выполнения
+	Success bool `json:"success,omitempty"`
+	// Сообщение об ошибке для неуспешных операций
+	ErrorMessage string `json:"error_message,omitempty"`
+	// Длительность выполнения в миллисекундах
+	DurationMs   int64 `json:"duration_ms,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OperationAuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case operationauditlog.FieldActorID, operationauditlog.FieldImpersonatorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case operationauditlog.FieldVariables:
+			values[i] = new([]byte)
+		case operationauditlog.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case operationauditlog.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case operationauditlog.FieldOperationName, operationauditlog.FieldActorRole, operationauditlog.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case operationauditlog.FieldCreateTime, operationauditlog.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case operationauditlog.FieldID, operationauditlog.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OperationAuditLog fields.
+func (_m *OperationAuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case operationauditlog.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case operationauditlog.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case operationauditlog.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case operationauditlog.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case operationauditlog.FieldOperationName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation_name", values[i])
+			} else if value.Valid {
+				_m.OperationName = value.String
+			}
+		case operationauditlog.FieldVariables:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variables", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Variables); err != nil {
+					return fmt.Errorf("unmarshal field variables: %w", err)
+				}
+			}
+		case operationauditlog.FieldActorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				_m.ActorID = new(uuid.UUID)
+				*_m.ActorID = *value.S.(*uuid.UUID)
+			}
+		case operationauditlog.FieldImpersonatorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonator_id", values[i])
+			} else if value.Valid {
+				_m.ImpersonatorID = new(uuid.UUID)
+				*_m.ImpersonatorID = *value.S.(*uuid.UUID)
+			}
+		case operationauditlog.FieldActorRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_role", values[i])
+			} else if value.Valid {
+				_m.ActorRole = value.String
+			}
+		case operationauditlog.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				_m.Success = value.Bool
+			}
+		case operationauditlog.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				_m.ErrorMessage = value.String
+			}
+		case operationauditlog.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OperationAuditLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *OperationAuditLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OperationAuditLog.
+// Note that you need to call OperationAuditLog.Unwrap() before calling this method if this OperationAuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OperationAuditLog) Update() *OperationAuditLogUpdateOne {
+	return NewOperationAuditLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OperationAuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OperationAuditLog) Unwrap() *OperationAuditLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OperationAuditLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OperationAuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("OperationAuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("operation_name=")
+	builder.WriteString(_m.OperationName)
+	builder.WriteString(", ")
+	builder.WriteString("variables=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Variables))
+	builder.WriteString(", ")
+	if v := _m.ActorID; v != nil {
+		builder.WriteString("actor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ImpersonatorID; v != nil {
+		builder.WriteString("impersonator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("actor_role=")
+	builder.WriteString(_m.ActorRole)
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Success))
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(_m.ErrorMessage)
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMs))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OperationAuditLogs is a parsable slice of OperationAuditLog.
+type OperationAuditLogs []*OperationAuditLog
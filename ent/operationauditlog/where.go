@@ -0,0 +1,616 @@
+// Code generated by ent, DO NOT EDIT.
+
+package operationauditlog
+
+import (
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// OperationName applies equality check predicate on the "operation_name" field. It's identical to OperationNameEQ.
+func OperationName(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldOperationName, v))
+}
+
+// ActorID applies equality check predicate on the "actor_id" field. It's identical to ActorIDEQ.
+func ActorID(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldActorID, v))
+}
+
+// ImpersonatorID applies equality check predicate on the "impersonator_id" field. It's identical to ImpersonatorIDEQ.
+func ImpersonatorID(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldImpersonatorID, v))
+}
+
+// ActorRole applies equality check predicate on the "actor_role" field. It's identical to ActorRoleEQ.
+func ActorRole(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldActorRole, v))
+}
+
+// Success applies equality check predicate on the "success" field. It's identical to SuccessEQ.
+func Success(v bool) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldSuccess, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// OperationNameEQ applies the EQ predicate on the "operation_name" field.
+func OperationNameEQ(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldOperationName, v))
+}
+
+// OperationNameNEQ applies the NEQ predicate on the "operation_name" field.
+func OperationNameNEQ(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldOperationName, v))
+}
+
+// OperationNameIn applies the In predicate on the "operation_name" field.
+func OperationNameIn(vs ...string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldOperationName, vs...))
+}
+
+// OperationNameNotIn applies the NotIn predicate on the "operation_name" field.
+func OperationNameNotIn(vs ...string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldOperationName, vs...))
+}
+
+// OperationNameGT applies the GT predicate on the "operation_name" field.
+func OperationNameGT(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldOperationName, v))
+}
+
+// OperationNameGTE applies the GTE predicate on the "operation_name" field.
+func OperationNameGTE(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldOperationName, v))
+}
+
+// OperationNameLT applies the LT predicate on the "operation_name" field.
+func OperationNameLT(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldOperationName, v))
+}
+
+// OperationNameLTE applies the LTE predicate on the "operation_name" field.
+func OperationNameLTE(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldOperationName, v))
+}
+
+// OperationNameContains applies the Contains predicate on the "operation_name" field.
+func OperationNameContains(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldContains(FieldOperationName, v))
+}
+
+// OperationNameHasPrefix applies the HasPrefix predicate on the "operation_name" field.
+func OperationNameHasPrefix(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldHasPrefix(FieldOperationName, v))
+}
+
+// OperationNameHasSuffix applies the HasSuffix predicate on the "operation_name" field.
+func OperationNameHasSuffix(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldHasSuffix(FieldOperationName, v))
+}
+
+// OperationNameEqualFold applies the EqualFold predicate on the "operation_name" field.
+func OperationNameEqualFold(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEqualFold(FieldOperationName, v))
+}
+
+// OperationNameContainsFold applies the ContainsFold predicate on the "operation_name" field.
+func OperationNameContainsFold(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldContainsFold(FieldOperationName, v))
+}
+
+// VariablesIsNil applies the IsNil predicate on the "variables" field.
+func VariablesIsNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIsNull(FieldVariables))
+}
+
+// VariablesNotNil applies the NotNil predicate on the "variables" field.
+func VariablesNotNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotNull(FieldVariables))
+}
+
+// ActorIDEQ applies the EQ predicate on the "actor_id" field.
+func ActorIDEQ(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldActorID, v))
+}
+
+// ActorIDNEQ applies the NEQ predicate on the "actor_id" field.
+func ActorIDNEQ(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldActorID, v))
+}
+
+// ActorIDIn applies the In predicate on the "actor_id" field.
+func ActorIDIn(vs ...uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldActorID, vs...))
+}
+
+// ActorIDNotIn applies the NotIn predicate on the "actor_id" field.
+func ActorIDNotIn(vs ...uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldActorID, vs...))
+}
+
+// ActorIDGT applies the GT predicate on the "actor_id" field.
+func ActorIDGT(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldActorID, v))
+}
+
+// ActorIDGTE applies the GTE predicate on the "actor_id" field.
+func ActorIDGTE(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldActorID, v))
+}
+
+// ActorIDLT applies the LT predicate on the "actor_id" field.
+func ActorIDLT(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldActorID, v))
+}
+
+// ActorIDLTE applies the LTE predicate on the "actor_id" field.
+func ActorIDLTE(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldActorID, v))
+}
+
+// ActorIDIsNil applies the IsNil predicate on the "actor_id" field.
+func ActorIDIsNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIsNull(FieldActorID))
+}
+
+// ActorIDNotNil applies the NotNil predicate on the "actor_id" field.
+func ActorIDNotNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotNull(FieldActorID))
+}
+
+// ImpersonatorIDEQ applies the EQ predicate on the "impersonator_id" field.
+func ImpersonatorIDEQ(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDNEQ applies the NEQ predicate on the "impersonator_id" field.
+func ImpersonatorIDNEQ(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDIn applies the In predicate on the "impersonator_id" field.
+func ImpersonatorIDIn(vs ...uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldImpersonatorID, vs...))
+}
+
+// ImpersonatorIDNotIn applies the NotIn predicate on the "impersonator_id" field.
+func ImpersonatorIDNotIn(vs ...uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldImpersonatorID, vs...))
+}
+
+// ImpersonatorIDGT applies the GT predicate on the "impersonator_id" field.
+func ImpersonatorIDGT(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDGTE applies the GTE predicate on the "impersonator_id" field.
+func ImpersonatorIDGTE(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDLT applies the LT predicate on the "impersonator_id" field.
+func ImpersonatorIDLT(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDLTE applies the LTE predicate on the "impersonator_id" field.
+func ImpersonatorIDLTE(v uuid.UUID) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDIsNil applies the IsNil predicate on the "impersonator_id" field.
+func ImpersonatorIDIsNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIsNull(FieldImpersonatorID))
+}
+
+// ImpersonatorIDNotNil applies the NotNil predicate on the "impersonator_id" field.
+func ImpersonatorIDNotNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotNull(FieldImpersonatorID))
+}
+
+// ActorRoleEQ applies the EQ predicate on the "actor_role" field.
+func ActorRoleEQ(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldActorRole, v))
+}
+
+// ActorRoleNEQ applies the NEQ predicate on the "actor_role" field.
+func ActorRoleNEQ(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldActorRole, v))
+}
+
+// ActorRoleIn applies the In predicate on the "actor_role" field.
+func ActorRoleIn(vs ...string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldActorRole, vs...))
+}
+
+// ActorRoleNotIn applies the NotIn predicate on the "actor_role" field.
+func ActorRoleNotIn(vs ...string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldActorRole, vs...))
+}
+
+// ActorRoleGT applies the GT predicate on the "actor_role" field.
+func ActorRoleGT(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldActorRole, v))
+}
+
+// ActorRoleGTE applies the GTE predicate on the "actor_role" field.
+func ActorRoleGTE(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldActorRole, v))
+}
+
+// ActorRoleLT applies the LT predicate on the "actor_role" field.
+func ActorRoleLT(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldActorRole, v))
+}
+
+// ActorRoleLTE applies the LTE predicate on the "actor_role" field.
+func ActorRoleLTE(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldActorRole, v))
+}
+
+// ActorRoleContains applies the Contains predicate on the "actor_role" field.
+func ActorRoleContains(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldContains(FieldActorRole, v))
+}
+
+// ActorRoleHasPrefix applies the HasPrefix predicate on the "actor_role" field.
+func ActorRoleHasPrefix(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldHasPrefix(FieldActorRole, v))
+}
+
+// ActorRoleHasSuffix applies the HasSuffix predicate on the "actor_role" field.
+func ActorRoleHasSuffix(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldHasSuffix(FieldActorRole, v))
+}
+
+// ActorRoleIsNil applies the IsNil predicate on the "actor_role" field.
+func ActorRoleIsNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIsNull(FieldActorRole))
+}
+
+// ActorRoleNotNil applies the NotNil predicate on the "actor_role" field.
+func ActorRoleNotNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotNull(FieldActorRole))
+}
+
+// ActorRoleEqualFold applies the EqualFold predicate on the "actor_role" field.
+func ActorRoleEqualFold(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEqualFold(FieldActorRole, v))
+}
+
+// ActorRoleContainsFold applies the ContainsFold predicate on the "actor_role" field.
+func ActorRoleContainsFold(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldContainsFold(FieldActorRole, v))
+}
+
+// SuccessEQ applies the EQ predicate on the "success" field.
+func SuccessEQ(v bool) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldSuccess, v))
+}
+
+// SuccessNEQ applies the NEQ predicate on the "success" field.
+func SuccessNEQ(v bool) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldSuccess, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OperationAuditLog) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OperationAuditLog) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OperationAuditLog) predicate.OperationAuditLog {
+	return predicate.OperationAuditLog(sql.NotPredicates(p))
+}
@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/operationauditlog"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OperationAuditLogCreate is the builder for creating a OperationAuditLog entity.
+type OperationAuditLogCreate struct {
+	config
+	mutation *OperationAuditLogMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OperationAuditLogCreate) SetTenantID(v uuid.UUID) *OperationAuditLogCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *OperationAuditLogCreate) SetCreateTime(v time.Time) *OperationAuditLogCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableCreateTime(v *time.Time) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *OperationAuditLogCreate) SetUpdateTime(v time.Time) *OperationAuditLogCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableUpdateTime(v *time.Time) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetOperationName sets the "operation_name" field.
+func (_c *OperationAuditLogCreate) SetOperationName(v string) *OperationAuditLogCreate {
+	_c.mutation.SetOperationName(v)
+	return _c
+}
+
+// SetVariables sets the "variables" field.
+func (_c *OperationAuditLogCreate) SetVariables(v map[string]interface{}) *OperationAuditLogCreate {
+	_c.mutation.SetVariables(v)
+	return _c
+}
+
+// SetActorID sets the "actor_id" field.
+func (_c *OperationAuditLogCreate) SetActorID(v uuid.UUID) *OperationAuditLogCreate {
+	_c.mutation.SetActorID(v)
+	return _c
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableActorID(v *uuid.UUID) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetActorID(*v)
+	}
+	return _c
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_c *OperationAuditLogCreate) SetImpersonatorID(v uuid.UUID) *OperationAuditLogCreate {
+	_c.mutation.SetImpersonatorID(v)
+	return _c
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableImpersonatorID(v *uuid.UUID) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetImpersonatorID(*v)
+	}
+	return _c
+}
+
+// SetActorRole sets the "actor_role" field.
+func (_c *OperationAuditLogCreate) SetActorRole(v string) *OperationAuditLogCreate {
+	_c.mutation.SetActorRole(v)
+	return _c
+}
+
+// SetNillableActorRole sets the "actor_role" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableActorRole(v *string) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetActorRole(*v)
+	}
+	return _c
+}
+
+// SetSuccess sets the "success" field.
+func (_c *OperationAuditLogCreate) SetSuccess(v bool) *OperationAuditLogCreate {
+	_c.mutation.SetSuccess(v)
+	return _c
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableSuccess(v *bool) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetSuccess(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *OperationAuditLogCreate) SetErrorMessage(v string) *OperationAuditLogCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableErrorMessage(v *string) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *OperationAuditLogCreate) SetDurationMs(v int64) *OperationAuditLogCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableDurationMs(v *int64) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OperationAuditLogCreate) SetID(v uuid.UUID) *OperationAuditLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OperationAuditLogCreate) SetNillableID(v *uuid.UUID) *OperationAuditLogCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the OperationAuditLogMutation object of the builder.
+func (_c *OperationAuditLogCreate) Mutation() *OperationAuditLogMutation {
+	return _c.mutation
+}
+
+// Save creates the OperationAuditLog in the database.
+func (_c *OperationAuditLogCreate) Save(ctx context.Context) (*OperationAuditLog, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OperationAuditLogCreate) SaveX(ctx context.Context) *OperationAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OperationAuditLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OperationAuditLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OperationAuditLogCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if operationauditlog.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized operationauditlog.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := operationauditlog.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if operationauditlog.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized operationauditlog.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := operationauditlog.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		v := operationauditlog.DefaultSuccess
+		_c.mutation.SetSuccess(v)
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		v := operationauditlog.DefaultDurationMs
+		_c.mutation.SetDurationMs(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if operationauditlog.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized operationauditlog.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := operationauditlog.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OperationAuditLogCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "OperationAuditLog.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "OperationAuditLog.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "OperationAuditLog.update_time"`)}
+	}
+	if _, ok := _c.mutation.OperationName(); !ok {
+		return &ValidationError{Name: "operation_name", err: errors.New(`ent: missing required field "OperationAuditLog.operation_name"`)}
+	}
+	if v, ok := _c.mutation.OperationName(); ok {
+		if err := operationauditlog.OperationNameValidator(v); err != nil {
+			return &ValidationError{Name: "operation_name", err: fmt.Errorf(`ent: validator failed for field "OperationAuditLog.operation_name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ActorRole(); ok {
+		if err := operationauditlog.ActorRoleValidator(v); err != nil {
+			return &ValidationError{Name: "actor_role", err: fmt.Errorf(`ent: validator failed for field "OperationAuditLog.actor_role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`ent: missing required field "OperationAuditLog.success"`)}
+	}
+	if v, ok := _c.mutation.ErrorMessage(); ok {
+		if err := operationauditlog.ErrorMessageValidator(v); err != nil {
+			return &ValidationError{Name: "error_message", err: fmt.Errorf(`ent: validator failed for field "OperationAuditLog.error_message": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "OperationAuditLog.duration_ms"`)}
+	}
+	return nil
+}
+
+func (_c *OperationAuditLogCreate) sqlSave(ctx context.Context) (*OperationAuditLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OperationAuditLogCreate) createSpec() (*OperationAuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OperationAuditLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(operationauditlog.Table, sqlgraph.NewFieldSpec(operationauditlog.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(operationauditlog.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(operationauditlog.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(operationauditlog.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.OperationName(); ok {
+		_spec.SetField(operationauditlog.FieldOperationName, field.TypeString, value)
+		_node.OperationName = value
+	}
+	if value, ok := _c.mutation.Variables(); ok {
+		_spec.SetField(operationauditlog.FieldVariables, field.TypeJSON, value)
+		_node.Variables = value
+	}
+	if value, ok := _c.mutation.ActorID(); ok {
+		_spec.SetField(operationauditlog.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = &value
+	}
+	if value, ok := _c.mutation.ImpersonatorID(); ok {
+		_spec.SetField(operationauditlog.FieldImpersonatorID, field.TypeUUID, value)
+		_node.ImpersonatorID = &value
+	}
+	if value, ok := _c.mutation.ActorRole(); ok {
+		_spec.SetField(operationauditlog.FieldActorRole, field.TypeString, value)
+		_node.ActorRole = value
+	}
+	if value, ok := _c.mutation.Success(); ok {
+		_spec.SetField(operationauditlog.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(operationauditlog.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(operationauditlog.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	return _node, _spec
+}
+
+// OperationAuditLogCreateBulk is the builder for creating many OperationAuditLog entities in bulk.
+type OperationAuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*OperationAuditLogCreate
+}
+
+// Save creates the OperationAuditLog entities in the database.
+func (_c *OperationAuditLogCreateBulk) Save(ctx context.Context) ([]*OperationAuditLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OperationAuditLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OperationAuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OperationAuditLogCreateBulk) SaveX(ctx context.Context) []*OperationAuditLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OperationAuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OperationAuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,653 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/operationauditlog"
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OperationAuditLogUpdate is the builder for updating OperationAuditLog entities.
+type OperationAuditLogUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *OperationAuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the OperationAuditLogUpdate builder.
+func (_u *OperationAuditLogUpdate) Where(ps ...predicate.OperationAuditLog) *OperationAuditLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OperationAuditLogUpdate) SetUpdateTime(v time.Time) *OperationAuditLogUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetOperationName sets the "operation_name" field.
+func (_u *OperationAuditLogUpdate) SetOperationName(v string) *OperationAuditLogUpdate {
+	_u.mutation.SetOperationName(v)
+	return _u
+}
+
+// SetNillableOperationName sets the "operation_name" field if the given value is not nil.
+func (_u *OperationAuditLogUpdate) SetNillableOperationName(v *string) *OperationAuditLogUpdate {
+	if v != nil {
+		_u.SetOperationName(*v)
+	}
+	return _u
+}
+
+// SetVariables sets the "variables" field.
+func (_u *OperationAuditLogUpdate) SetVariables(v map[string]interface{}) *OperationAuditLogUpdate {
+	_u.mutation.SetVariables(v)
+	return _u
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (_u *OperationAuditLogUpdate) ClearVariables() *OperationAuditLogUpdate {
+	_u.mutation.ClearVariables()
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *OperationAuditLogUpdate) SetActorID(v uuid.UUID) *OperationAuditLogUpdate {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *OperationAuditLogUpdate) SetNillableActorID(v *uuid.UUID) *OperationAuditLogUpdate {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *OperationAuditLogUpdate) ClearActorID() *OperationAuditLogUpdate {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *OperationAuditLogUpdate) SetImpersonatorID(v uuid.UUID) *OperationAuditLogUpdate {
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *OperationAuditLogUpdate) SetNillableImpersonatorID(v *uuid.UUID) *OperationAuditLogUpdate {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *OperationAuditLogUpdate) ClearImpersonatorID() *OperationAuditLogUpdate {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetActorRole sets the "actor_role" field.
+func (_u *OperationAuditLogUpdate) SetActorRole(v string) *OperationAuditLogUpdate {
+	_u.mutation.SetActorRole(v)
+	return _u
+}
+
+// SetNillableActorRole sets the "actor_role" field if the given value is not nil.
+func (_u *OperationAuditLogUpdate) SetNillableActorRole(v *string) *OperationAuditLogUpdate {
+	if v != nil {
+		_u.SetActorRole(*v)
+	}
+	return _u
+}
+
+// ClearActorRole clears the value of the "actor_role" field.
+func (_u *OperationAuditLogUpdate) ClearActorRole() *OperationAuditLogUpdate {
+	_u.mutation.ClearActorRole()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *OperationAuditLogUpdate) SetSuccess(v bool) *OperationAuditLogUpdate {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *OperationAuditLogUpdate) SetNillableSuccess(v *bool) *OperationAuditLogUpdate {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *OperationAuditLogUpdate) SetErrorMessage(v string) *OperationAuditLogUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *OperationAuditLogUpdate) SetNillableErrorMessage(v *string) *OperationAuditLogUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *OperationAuditLogUpdate) ClearErrorMessage() *OperationAuditLogUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *OperationAuditLogUpdate) SetDurationMs(v int64) *OperationAuditLogUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *OperationAuditLogUpdate) SetNillableDurationMs(v *int64) *OperationAuditLogUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *OperationAuditLogUpdate) AddDurationMs(v int64) *OperationAuditLogUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// Mutation returns the OperationAuditLogMutation object of the builder.
+func (_u *OperationAuditLogUpdate) Mutation() *OperationAuditLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OperationAuditLogUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OperationAuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OperationAuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OperationAuditLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OperationAuditLogUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if operationauditlog.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized operationauditlog.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := operationauditlog.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OperationAuditLogUpdate) check() error {
+	if v, ok := _u.mutation.OperationName(); ok {
+		if err := operationauditlog.OperationNameValidator(v); err != nil {
+			return &ValidationError{Name: "operation_name", err: fmt.Errorf(`ent: validator failed for field "OperationAuditLog.operation_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActorRole(); ok {
+		if err := operationauditlog.ActorRoleValidator(v); err != nil {
+			return &ValidationError{Name: "actor_role", err: fmt.Errorf(`ent: validator failed for field "OperationAuditLog.actor_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ErrorMessage(); ok {
+		if err := operationauditlog.ErrorMessageValidator(v); err != nil {
+			return &ValidationError{Name: "error_message", err: fmt.Errorf(`ent: validator failed for field "OperationAuditLog.error_message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OperationAuditLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OperationAuditLogUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OperationAuditLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(operationauditlog.Table, operationauditlog.Columns, sqlgraph.NewFieldSpec(operationauditlog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(operationauditlog.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OperationName(); ok {
+		_spec.SetField(operationauditlog.FieldOperationName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Variables(); ok {
+		_spec.SetField(operationauditlog.FieldVariables, field.TypeJSON, value)
+	}
+	if _u.mutation.VariablesCleared() {
+		_spec.ClearField(operationauditlog.FieldVariables, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(operationauditlog.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(operationauditlog.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(operationauditlog.FieldImpersonatorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(operationauditlog.FieldImpersonatorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ActorRole(); ok {
+		_spec.SetField(operationauditlog.FieldActorRole, field.TypeString, value)
+	}
+	if _u.mutation.ActorRoleCleared() {
+		_spec.ClearField(operationauditlog.FieldActorRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(operationauditlog.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(operationauditlog.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(operationauditlog.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(operationauditlog.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(operationauditlog.FieldDurationMs, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operationauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OperationAuditLogUpdateOne is the builder for updating a single OperationAuditLog entity.
+type OperationAuditLogUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *OperationAuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OperationAuditLogUpdateOne) SetUpdateTime(v time.Time) *OperationAuditLogUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetOperationName sets the "operation_name" field.
+func (_u *OperationAuditLogUpdateOne) SetOperationName(v string) *OperationAuditLogUpdateOne {
+	_u.mutation.SetOperationName(v)
+	return _u
+}
+
+// SetNillableOperationName sets the "operation_name" field if the given value is not nil.
+func (_u *OperationAuditLogUpdateOne) SetNillableOperationName(v *string) *OperationAuditLogUpdateOne {
+	if v != nil {
+		_u.SetOperationName(*v)
+	}
+	return _u
+}
+
+// SetVariables sets the "variables" field.
+func (_u *OperationAuditLogUpdateOne) SetVariables(v map[string]interface{}) *OperationAuditLogUpdateOne {
+	_u.mutation.SetVariables(v)
+	return _u
+}
+
+// ClearVariables clears the value of the "variables" field.
+func (_u *OperationAuditLogUpdateOne) ClearVariables() *OperationAuditLogUpdateOne {
+	_u.mutation.ClearVariables()
+	return _u
+}
+
+// SetActorID sets the "actor_id" field.
+func (_u *OperationAuditLogUpdateOne) SetActorID(v uuid.UUID) *OperationAuditLogUpdateOne {
+	_u.mutation.SetActorID(v)
+	return _u
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (_u *OperationAuditLogUpdateOne) SetNillableActorID(v *uuid.UUID) *OperationAuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorID(*v)
+	}
+	return _u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (_u *OperationAuditLogUpdateOne) ClearActorID() *OperationAuditLogUpdateOne {
+	_u.mutation.ClearActorID()
+	return _u
+}
+
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *OperationAuditLogUpdateOne) SetImpersonatorID(v uuid.UUID) *OperationAuditLogUpdateOne {
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *OperationAuditLogUpdateOne) SetNillableImpersonatorID(v *uuid.UUID) *OperationAuditLogUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *OperationAuditLogUpdateOne) ClearImpersonatorID() *OperationAuditLogUpdateOne {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetActorRole sets the "actor_role" field.
+func (_u *OperationAuditLogUpdateOne) SetActorRole(v string) *OperationAuditLogUpdateOne {
+	_u.mutation.SetActorRole(v)
+	return _u
+}
+
+// SetNillableActorRole sets the "actor_role" field if the given value is not nil.
+func (_u *OperationAuditLogUpdateOne) SetNillableActorRole(v *string) *OperationAuditLogUpdateOne {
+	if v != nil {
+		_u.SetActorRole(*v)
+	}
+	return _u
+}
+
+// ClearActorRole clears the value of the "actor_role" field.
+func (_u *OperationAuditLogUpdateOne) ClearActorRole() *OperationAuditLogUpdateOne {
+	_u.mutation.ClearActorRole()
+	return _u
+}
+
+// SetSuccess sets the "success" field.
+func (_u *OperationAuditLogUpdateOne) SetSuccess(v bool) *OperationAuditLogUpdateOne {
+	_u.mutation.SetSuccess(v)
+	return _u
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (_u *OperationAuditLogUpdateOne) SetNillableSuccess(v *bool) *OperationAuditLogUpdateOne {
+	if v != nil {
+		_u.SetSuccess(*v)
+	}
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *OperationAuditLogUpdateOne) SetErrorMessage(v string) *OperationAuditLogUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *OperationAuditLogUpdateOne) SetNillableErrorMessage(v *string) *OperationAuditLogUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *OperationAuditLogUpdateOne) ClearErrorMessage() *OperationAuditLogUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *OperationAuditLogUpdateOne) SetDurationMs(v int64) *OperationAuditLogUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *OperationAuditLogUpdateOne) SetNillableDurationMs(v *int64) *OperationAuditLogUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *OperationAuditLogUpdateOne) AddDurationMs(v int64) *OperationAuditLogUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// Mutation returns the OperationAuditLogMutation object of the builder.
+func (_u *OperationAuditLogUpdateOne) Mutation() *OperationAuditLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OperationAuditLogUpdate builder.
+func (_u *OperationAuditLogUpdateOne) Where(ps ...predicate.OperationAuditLog) *OperationAuditLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OperationAuditLogUpdateOne) Select(field string, fields ...string) *OperationAuditLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OperationAuditLog entity.
+func (_u *OperationAuditLogUpdateOne) Save(ctx context.Context) (*OperationAuditLog, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OperationAuditLogUpdateOne) SaveX(ctx context.Context) *OperationAuditLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OperationAuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OperationAuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OperationAuditLogUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if operationauditlog.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized operationauditlog.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := operationauditlog.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OperationAuditLogUpdateOne) check() error {
+	if v, ok := _u.mutation.OperationName(); ok {
+		if err := operationauditlog.OperationNameValidator(v); err != nil {
+			return &ValidationError{Name: "operation_name", err: fmt.Errorf(`ent: validator failed for field "OperationAuditLog.operation_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ActorRole(); ok {
+		if err := operationauditlog.ActorRoleValidator(v); err != nil {
+			return &ValidationError{Name: "actor_role", err: fmt.Errorf(`ent: validator failed for field "OperationAuditLog.actor_role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ErrorMessage(); ok {
+		if err := operationauditlog.ErrorMessageValidator(v); err != nil {
+			return &ValidationError{Name: "error_message", err: fmt.Errorf(`ent: validator failed for field "OperationAuditLog.error_message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *OperationAuditLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *OperationAuditLogUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *OperationAuditLogUpdateOne) sqlSave(ctx context.Context) (_node *OperationAuditLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(operationauditlog.Table, operationauditlog.Columns, sqlgraph.NewFieldSpec(operationauditlog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OperationAuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, operationauditlog.FieldID)
+		for _, f := range fields {
+			if !operationauditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != operationauditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(operationauditlog.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.OperationName(); ok {
+		_spec.SetField(operationauditlog.FieldOperationName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Variables(); ok {
+		_spec.SetField(operationauditlog.FieldVariables, field.TypeJSON, value)
+	}
+	if _u.mutation.VariablesCleared() {
+		_spec.ClearField(operationauditlog.FieldVariables, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ActorID(); ok {
+		_spec.SetField(operationauditlog.FieldActorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ActorIDCleared() {
+		_spec.ClearField(operationauditlog.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(operationauditlog.FieldImpersonatorID, field.TypeUUID, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(operationauditlog.FieldImpersonatorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ActorRole(); ok {
+		_spec.SetField(operationauditlog.FieldActorRole, field.TypeString, value)
+	}
+	if _u.mutation.ActorRoleCleared() {
+		_spec.ClearField(operationauditlog.FieldActorRole, field.TypeString)
+	}
+	if value, ok := _u.mutation.Success(); ok {
+		_spec.SetField(operationauditlog.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(operationauditlog.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(operationauditlog.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(operationauditlog.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(operationauditlog.FieldDurationMs, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &OperationAuditLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{operationauditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
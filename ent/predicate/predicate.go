@@ -6,5 +6,38 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// DepartmentQuota is the predicate function for departmentquota builders.
+type DepartmentQuota func(*sql.Selector)
+
 // File is the predicate function for file builders.
 type File func(*sql.Selector)
+
+// FileAuditEvent is the predicate function for fileauditevent builders.
+type FileAuditEvent func(*sql.Selector)
+
+// FileFavorite is the predicate function for filefavorite builders.
+type FileFavorite func(*sql.Selector)
+
+// FileSet is the predicate function for fileset builders.
+type FileSet func(*sql.Selector)
+
+// ImpersonationGrant is the predicate function for impersonationgrant builders.
+type ImpersonationGrant func(*sql.Selector)
+
+// LifecycleRule is the predicate function for lifecyclerule builders.
+type LifecycleRule func(*sql.Selector)
+
+// OperationAuditLog is the predicate function for operationauditlog builders.
+type OperationAuditLog func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
+// TenantSetting is the predicate function for tenantsetting builders.
+type TenantSetting func(*sql.Selector)
+
+// TranslationOverride is the predicate function for translationoverride builders.
+type TranslationOverride func(*sql.Selector)
+
+// UserStorageLimit is the predicate function for userstoragelimit builders.
+type UserStorageLimit func(*sql.Selector)
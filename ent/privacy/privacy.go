@@ -110,6 +110,30 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The DepartmentQuotaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DepartmentQuotaQueryRuleFunc func(context.Context, *ent.DepartmentQuotaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DepartmentQuotaQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DepartmentQuotaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DepartmentQuotaQuery", q)
+}
+
+// The DepartmentQuotaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DepartmentQuotaMutationRuleFunc func(context.Context, *ent.DepartmentQuotaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DepartmentQuotaMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DepartmentQuotaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DepartmentQuotaMutation", m)
+}
+
 // The FileQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type FileQueryRuleFunc func(context.Context, *ent.FileQuery) error
@@ -133,3 +157,243 @@ func (f FileMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	}
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileMutation", m)
 }
+
+// The FileAuditEventQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FileAuditEventQueryRuleFunc func(context.Context, *ent.FileAuditEventQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FileAuditEventQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileAuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FileAuditEventQuery", q)
+}
+
+// The FileAuditEventMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FileAuditEventMutationRuleFunc func(context.Context, *ent.FileAuditEventMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FileAuditEventMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FileAuditEventMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileAuditEventMutation", m)
+}
+
+// The FileFavoriteQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FileFavoriteQueryRuleFunc func(context.Context, *ent.FileFavoriteQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FileFavoriteQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileFavoriteQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FileFavoriteQuery", q)
+}
+
+// The FileFavoriteMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FileFavoriteMutationRuleFunc func(context.Context, *ent.FileFavoriteMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FileFavoriteMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FileFavoriteMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileFavoriteMutation", m)
+}
+
+// The FileSetQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FileSetQueryRuleFunc func(context.Context, *ent.FileSetQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FileSetQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileSetQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FileSetQuery", q)
+}
+
+// The FileSetMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FileSetMutationRuleFunc func(context.Context, *ent.FileSetMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FileSetMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FileSetMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileSetMutation", m)
+}
+
+// The ImpersonationGrantQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ImpersonationGrantQueryRuleFunc func(context.Context, *ent.ImpersonationGrantQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ImpersonationGrantQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ImpersonationGrantQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ImpersonationGrantQuery", q)
+}
+
+// The ImpersonationGrantMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ImpersonationGrantMutationRuleFunc func(context.Context, *ent.ImpersonationGrantMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ImpersonationGrantMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ImpersonationGrantMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ImpersonationGrantMutation", m)
+}
+
+// The LifecycleRuleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LifecycleRuleQueryRuleFunc func(context.Context, *ent.LifecycleRuleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LifecycleRuleQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LifecycleRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LifecycleRuleQuery", q)
+}
+
+// The LifecycleRuleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LifecycleRuleMutationRuleFunc func(context.Context, *ent.LifecycleRuleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LifecycleRuleMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LifecycleRuleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LifecycleRuleMutation", m)
+}
+
+// The OperationAuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OperationAuditLogQueryRuleFunc func(context.Context, *ent.OperationAuditLogQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OperationAuditLogQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OperationAuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OperationAuditLogQuery", q)
+}
+
+// The OperationAuditLogMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OperationAuditLogMutationRuleFunc func(context.Context, *ent.OperationAuditLogMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OperationAuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OperationAuditLogMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OperationAuditLogMutation", m)
+}
+
+// The TagQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TagQueryRuleFunc func(context.Context, *ent.TagQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TagQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TagQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TagQuery", q)
+}
+
+// The TagMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TagMutationRuleFunc func(context.Context, *ent.TagMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TagMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TagMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TagMutation", m)
+}
+
+// The TenantSettingQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TenantSettingQueryRuleFunc func(context.Context, *ent.TenantSettingQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TenantSettingQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantSettingQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TenantSettingQuery", q)
+}
+
+// The TenantSettingMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TenantSettingMutationRuleFunc func(context.Context, *ent.TenantSettingMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TenantSettingMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TenantSettingMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TenantSettingMutation", m)
+}
+
+// The TranslationOverrideQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TranslationOverrideQueryRuleFunc func(context.Context, *ent.TranslationOverrideQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TranslationOverrideQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TranslationOverrideQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TranslationOverrideQuery", q)
+}
+
+// The TranslationOverrideMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TranslationOverrideMutationRuleFunc func(context.Context, *ent.TranslationOverrideMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TranslationOverrideMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TranslationOverrideMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TranslationOverrideMutation", m)
+}
+
+// The UserStorageLimitQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserStorageLimitQueryRuleFunc func(context.Context, *ent.UserStorageLimitQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserStorageLimitQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserStorageLimitQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserStorageLimitQuery", q)
+}
+
+// The UserStorageLimitMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserStorageLimitMutationRuleFunc func(context.Context, *ent.UserStorageLimitMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserStorageLimitMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserStorageLimitMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserStorageLimitMutation", m)
+}
@@ -4,8 +4,19 @@ package runtime
 
 import (
 	"context"
+	"main/ent/departmentquota"
 	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/filefavorite"
+	"main/ent/fileset"
+	"main/ent/impersonationgrant"
+	"main/ent/lifecyclerule"
+	"main/ent/operationauditlog"
 	"main/ent/schema"
+	"main/ent/tag"
+	"main/ent/tenantsetting"
+	"main/ent/translationoverride"
+	"main/ent/userstoragelimit"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +29,35 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	departmentquotaMixin := schema.DepartmentQuota{}.Mixin()
+	departmentquotaMixinHooks0 := departmentquotaMixin[0].Hooks()
+	departmentquotaMixinHooks1 := departmentquotaMixin[1].Hooks()
+	departmentquota.Hooks[0] = departmentquotaMixinHooks0[0]
+	departmentquota.Hooks[1] = departmentquotaMixinHooks1[0]
+	departmentquotaMixinInters0 := departmentquotaMixin[0].Interceptors()
+	departmentquota.Interceptors[0] = departmentquotaMixinInters0[0]
+	departmentquotaMixinFields1 := departmentquotaMixin[1].Fields()
+	_ = departmentquotaMixinFields1
+	departmentquotaFields := schema.DepartmentQuota{}.Fields()
+	_ = departmentquotaFields
+	// departmentquotaDescCreateTime is the schema descriptor for create_time field.
+	departmentquotaDescCreateTime := departmentquotaMixinFields1[0].Descriptor()
+	// departmentquota.DefaultCreateTime holds the default value on creation for the create_time field.
+	departmentquota.DefaultCreateTime = departmentquotaDescCreateTime.Default.(func() time.Time)
+	// departmentquotaDescUpdateTime is the schema descriptor for update_time field.
+	departmentquotaDescUpdateTime := departmentquotaMixinFields1[1].Descriptor()
+	// departmentquota.DefaultUpdateTime holds the default value on creation for the update_time field.
+	departmentquota.DefaultUpdateTime = departmentquotaDescUpdateTime.Default.(func() time.Time)
+	// departmentquota.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	departmentquota.UpdateDefaultUpdateTime = departmentquotaDescUpdateTime.UpdateDefault.(func() time.Time)
+	// departmentquotaDescLimitBytes is the schema descriptor for limit_bytes field.
+	departmentquotaDescLimitBytes := departmentquotaFields[2].Descriptor()
+	// departmentquota.LimitBytesValidator is a validator for the "limit_bytes" field. It is called by the builders before save.
+	departmentquota.LimitBytesValidator = departmentquotaDescLimitBytes.Validators[0].(func(int64) error)
+	// departmentquotaDescID is the schema descriptor for id field.
+	departmentquotaDescID := departmentquotaFields[0].Descriptor()
+	// departmentquota.DefaultID holds the default value on creation for the id field.
+	departmentquota.DefaultID = departmentquotaDescID.Default.(func() uuid.UUID)
 	fileMixin := schema.File{}.Mixin()
 	file.Policy = privacy.NewPolicies(schema.File{})
 	file.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -29,12 +69,22 @@ func init() {
 		})
 	}
 	fileMixinHooks0 := fileMixin[0].Hooks()
+	fileMixinHooks1 := fileMixin[1].Hooks()
+	fileHooks := schema.File{}.Hooks()
 
 	file.Hooks[1] = fileMixinHooks0[0]
+
+	file.Hooks[2] = fileMixinHooks1[0]
+
+	file.Hooks[3] = fileHooks[0]
+
+	file.Hooks[4] = fileHooks[1]
 	fileMixinInters0 := fileMixin[0].Interceptors()
 	fileMixinInters2 := fileMixin[2].Interceptors()
+	fileMixinInters3 := fileMixin[3].Interceptors()
 	file.Interceptors[0] = fileMixinInters0[0]
 	file.Interceptors[1] = fileMixinInters2[0]
+	file.Interceptors[2] = fileMixinInters3[0]
 	fileMixinFields1 := fileMixin[1].Fields()
 	_ = fileMixinFields1
 	fileFields := schema.File{}.Fields()
@@ -62,13 +112,493 @@ func init() {
 	// file.MimeTypeValidator is a validator for the "mime_type" field. It is called by the builders before save.
 	file.MimeTypeValidator = fileDescMimeType.Validators[0].(func(string) error)
 	// fileDescSize is the schema descriptor for size field.
-	fileDescSize := fileFields[5].Descriptor()
+	fileDescSize := fileFields[6].Descriptor()
 	// file.SizeValidator is a validator for the "size" field. It is called by the builders before save.
 	file.SizeValidator = fileDescSize.Validators[0].(func(int64) error)
+	// fileDescChecksumSha256 is the schema descriptor for checksum_sha256 field.
+	fileDescChecksumSha256 := fileFields[10].Descriptor()
+	// file.ChecksumSha256Validator is a validator for the "checksum_sha256" field. It is called by the builders before save.
+	file.ChecksumSha256Validator = fileDescChecksumSha256.Validators[0].(func(string) error)
+	// fileDescPdfAttempts is the schema descriptor for pdf_attempts field.
+	fileDescPdfAttempts := fileFields[16].Descriptor()
+	// file.DefaultPdfAttempts holds the default value on creation for the pdf_attempts field.
+	file.DefaultPdfAttempts = fileDescPdfAttempts.Default.(int)
+	// file.PdfAttemptsValidator is a validator for the "pdf_attempts" field. It is called by the builders before save.
+	file.PdfAttemptsValidator = fileDescPdfAttempts.Validators[0].(func(int) error)
+	// fileDescClientVersion is the schema descriptor for client_version field.
+	fileDescClientVersion := fileFields[22].Descriptor()
+	// file.ClientVersionValidator is a validator for the "client_version" field. It is called by the builders before save.
+	file.ClientVersionValidator = fileDescClientVersion.Validators[0].(func(string) error)
+	// fileDescDownloadCount is the schema descriptor for download_count field.
+	fileDescDownloadCount := fileFields[23].Descriptor()
+	// file.DefaultDownloadCount holds the default value on creation for the download_count field.
+	file.DefaultDownloadCount = fileDescDownloadCount.Default.(int64)
+	// file.DownloadCountValidator is a validator for the "download_count" field. It is called by the builders before save.
+	file.DownloadCountValidator = fileDescDownloadCount.Validators[0].(func(int64) error)
+	// fileDescLegalHold is the schema descriptor for legal_hold field.
+	fileDescLegalHold := fileFields[32].Descriptor()
+	// file.DefaultLegalHold holds the default value on creation for the legal_hold field.
+	file.DefaultLegalHold = fileDescLegalHold.Default.(bool)
 	// fileDescID is the schema descriptor for id field.
 	fileDescID := fileFields[0].Descriptor()
 	// file.DefaultID holds the default value on creation for the id field.
 	file.DefaultID = fileDescID.Default.(func() uuid.UUID)
+	fileauditeventMixin := schema.FileAuditEvent{}.Mixin()
+	fileauditeventMixinHooks0 := fileauditeventMixin[0].Hooks()
+	fileauditeventMixinHooks1 := fileauditeventMixin[1].Hooks()
+	fileauditevent.Hooks[0] = fileauditeventMixinHooks0[0]
+	fileauditevent.Hooks[1] = fileauditeventMixinHooks1[0]
+	fileauditeventMixinInters0 := fileauditeventMixin[0].Interceptors()
+	fileauditevent.Interceptors[0] = fileauditeventMixinInters0[0]
+	fileauditeventMixinFields1 := fileauditeventMixin[1].Fields()
+	_ = fileauditeventMixinFields1
+	fileauditeventFields := schema.FileAuditEvent{}.Fields()
+	_ = fileauditeventFields
+	// fileauditeventDescCreateTime is the schema descriptor for create_time field.
+	fileauditeventDescCreateTime := fileauditeventMixinFields1[0].Descriptor()
+	// fileauditevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	fileauditevent.DefaultCreateTime = fileauditeventDescCreateTime.Default.(func() time.Time)
+	// fileauditeventDescUpdateTime is the schema descriptor for update_time field.
+	fileauditeventDescUpdateTime := fileauditeventMixinFields1[1].Descriptor()
+	// fileauditevent.DefaultUpdateTime holds the default value on creation for the update_time field.
+	fileauditevent.DefaultUpdateTime = fileauditeventDescUpdateTime.Default.(func() time.Time)
+	// fileauditevent.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	fileauditevent.UpdateDefaultUpdateTime = fileauditeventDescUpdateTime.UpdateDefault.(func() time.Time)
+	// fileauditeventDescID is the schema descriptor for id field.
+	fileauditeventDescID := fileauditeventFields[0].Descriptor()
+	// fileauditevent.DefaultID holds the default value on creation for the id field.
+	fileauditevent.DefaultID = fileauditeventDescID.Default.(func() uuid.UUID)
+	filefavoriteMixin := schema.FileFavorite{}.Mixin()
+	filefavoriteMixinHooks0 := filefavoriteMixin[0].Hooks()
+	filefavoriteMixinHooks1 := filefavoriteMixin[1].Hooks()
+	filefavorite.Hooks[0] = filefavoriteMixinHooks0[0]
+	filefavorite.Hooks[1] = filefavoriteMixinHooks1[0]
+	filefavoriteMixinInters0 := filefavoriteMixin[0].Interceptors()
+	filefavorite.Interceptors[0] = filefavoriteMixinInters0[0]
+	filefavoriteMixinFields1 := filefavoriteMixin[1].Fields()
+	_ = filefavoriteMixinFields1
+	filefavoriteFields := schema.FileFavorite{}.Fields()
+	_ = filefavoriteFields
+	// filefavoriteDescCreateTime is the schema descriptor for create_time field.
+	filefavoriteDescCreateTime := filefavoriteMixinFields1[0].Descriptor()
+	// filefavorite.DefaultCreateTime holds the default value on creation for the create_time field.
+	filefavorite.DefaultCreateTime = filefavoriteDescCreateTime.Default.(func() time.Time)
+	// filefavoriteDescUpdateTime is the schema descriptor for update_time field.
+	filefavoriteDescUpdateTime := filefavoriteMixinFields1[1].Descriptor()
+	// filefavorite.DefaultUpdateTime holds the default value on creation for the update_time field.
+	filefavorite.DefaultUpdateTime = filefavoriteDescUpdateTime.Default.(func() time.Time)
+	// filefavorite.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	filefavorite.UpdateDefaultUpdateTime = filefavoriteDescUpdateTime.UpdateDefault.(func() time.Time)
+	// filefavoriteDescID is the schema descriptor for id field.
+	filefavoriteDescID := filefavoriteFields[0].Descriptor()
+	// filefavorite.DefaultID holds the default value on creation for the id field.
+	filefavorite.DefaultID = filefavoriteDescID.Default.(func() uuid.UUID)
+	filesetMixin := schema.FileSet{}.Mixin()
+	filesetMixinHooks0 := filesetMixin[0].Hooks()
+	filesetMixinHooks1 := filesetMixin[1].Hooks()
+	fileset.Hooks[0] = filesetMixinHooks0[0]
+	fileset.Hooks[1] = filesetMixinHooks1[0]
+	filesetMixinInters0 := filesetMixin[0].Interceptors()
+	fileset.Interceptors[0] = filesetMixinInters0[0]
+	filesetMixinFields1 := filesetMixin[1].Fields()
+	_ = filesetMixinFields1
+	filesetFields := schema.FileSet{}.Fields()
+	_ = filesetFields
+	// filesetDescCreateTime is the schema descriptor for create_time field.
+	filesetDescCreateTime := filesetMixinFields1[0].Descriptor()
+	// fileset.DefaultCreateTime holds the default value on creation for the create_time field.
+	fileset.DefaultCreateTime = filesetDescCreateTime.Default.(func() time.Time)
+	// filesetDescUpdateTime is the schema descriptor for update_time field.
+	filesetDescUpdateTime := filesetMixinFields1[1].Descriptor()
+	// fileset.DefaultUpdateTime holds the default value on creation for the update_time field.
+	fileset.DefaultUpdateTime = filesetDescUpdateTime.Default.(func() time.Time)
+	// fileset.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	fileset.UpdateDefaultUpdateTime = filesetDescUpdateTime.UpdateDefault.(func() time.Time)
+	// filesetDescName is the schema descriptor for name field.
+	filesetDescName := filesetFields[2].Descriptor()
+	// fileset.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	fileset.NameValidator = func() func(string) error {
+		validators := filesetDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// filesetDescDescription is the schema descriptor for description field.
+	filesetDescDescription := filesetFields[3].Descriptor()
+	// fileset.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	fileset.DescriptionValidator = filesetDescDescription.Validators[0].(func(string) error)
+	// filesetDescSharedWithTenant is the schema descriptor for shared_with_tenant field.
+	filesetDescSharedWithTenant := filesetFields[5].Descriptor()
+	// fileset.DefaultSharedWithTenant holds the default value on creation for the shared_with_tenant field.
+	fileset.DefaultSharedWithTenant = filesetDescSharedWithTenant.Default.(bool)
+	// filesetDescID is the schema descriptor for id field.
+	filesetDescID := filesetFields[0].Descriptor()
+	// fileset.DefaultID holds the default value on creation for the id field.
+	fileset.DefaultID = filesetDescID.Default.(func() uuid.UUID)
+	impersonationgrantMixin := schema.ImpersonationGrant{}.Mixin()
+	impersonationgrantMixinHooks0 := impersonationgrantMixin[0].Hooks()
+	impersonationgrantMixinHooks1 := impersonationgrantMixin[1].Hooks()
+	impersonationgrant.Hooks[0] = impersonationgrantMixinHooks0[0]
+	impersonationgrant.Hooks[1] = impersonationgrantMixinHooks1[0]
+	impersonationgrantMixinInters0 := impersonationgrantMixin[0].Interceptors()
+	impersonationgrant.Interceptors[0] = impersonationgrantMixinInters0[0]
+	impersonationgrantMixinFields1 := impersonationgrantMixin[1].Fields()
+	_ = impersonationgrantMixinFields1
+	impersonationgrantFields := schema.ImpersonationGrant{}.Fields()
+	_ = impersonationgrantFields
+	// impersonationgrantDescCreateTime is the schema descriptor for create_time field.
+	impersonationgrantDescCreateTime := impersonationgrantMixinFields1[0].Descriptor()
+	// impersonationgrant.DefaultCreateTime holds the default value on creation for the create_time field.
+	impersonationgrant.DefaultCreateTime = impersonationgrantDescCreateTime.Default.(func() time.Time)
+	// impersonationgrantDescUpdateTime is the schema descriptor for update_time field.
+	impersonationgrantDescUpdateTime := impersonationgrantMixinFields1[1].Descriptor()
+	// impersonationgrant.DefaultUpdateTime holds the default value on creation for the update_time field.
+	impersonationgrant.DefaultUpdateTime = impersonationgrantDescUpdateTime.Default.(func() time.Time)
+	// impersonationgrant.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	impersonationgrant.UpdateDefaultUpdateTime = impersonationgrantDescUpdateTime.UpdateDefault.(func() time.Time)
+	// impersonationgrantDescReason is the schema descriptor for reason field.
+	impersonationgrantDescReason := impersonationgrantFields[6].Descriptor()
+	// impersonationgrant.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	impersonationgrant.ReasonValidator = func() func(string) error {
+		validators := impersonationgrantDescReason.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(reason string) error {
+			for _, fn := range fns {
+				if err := fn(reason); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// impersonationgrantDescTokenHash is the schema descriptor for token_hash field.
+	impersonationgrantDescTokenHash := impersonationgrantFields[7].Descriptor()
+	// impersonationgrant.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	impersonationgrant.TokenHashValidator = impersonationgrantDescTokenHash.Validators[0].(func(string) error)
+	// impersonationgrantDescID is the schema descriptor for id field.
+	impersonationgrantDescID := impersonationgrantFields[0].Descriptor()
+	// impersonationgrant.DefaultID holds the default value on creation for the id field.
+	impersonationgrant.DefaultID = impersonationgrantDescID.Default.(func() uuid.UUID)
+	lifecycleruleMixin := schema.LifecycleRule{}.Mixin()
+	lifecycleruleMixinHooks0 := lifecycleruleMixin[0].Hooks()
+	lifecycleruleMixinHooks1 := lifecycleruleMixin[1].Hooks()
+	lifecyclerule.Hooks[0] = lifecycleruleMixinHooks0[0]
+	lifecyclerule.Hooks[1] = lifecycleruleMixinHooks1[0]
+	lifecycleruleMixinInters0 := lifecycleruleMixin[0].Interceptors()
+	lifecyclerule.Interceptors[0] = lifecycleruleMixinInters0[0]
+	lifecycleruleMixinFields1 := lifecycleruleMixin[1].Fields()
+	_ = lifecycleruleMixinFields1
+	lifecycleruleFields := schema.LifecycleRule{}.Fields()
+	_ = lifecycleruleFields
+	// lifecycleruleDescCreateTime is the schema descriptor for create_time field.
+	lifecycleruleDescCreateTime := lifecycleruleMixinFields1[0].Descriptor()
+	// lifecyclerule.DefaultCreateTime holds the default value on creation for the create_time field.
+	lifecyclerule.DefaultCreateTime = lifecycleruleDescCreateTime.Default.(func() time.Time)
+	// lifecycleruleDescUpdateTime is the schema descriptor for update_time field.
+	lifecycleruleDescUpdateTime := lifecycleruleMixinFields1[1].Descriptor()
+	// lifecyclerule.DefaultUpdateTime holds the default value on creation for the update_time field.
+	lifecyclerule.DefaultUpdateTime = lifecycleruleDescUpdateTime.Default.(func() time.Time)
+	// lifecyclerule.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	lifecyclerule.UpdateDefaultUpdateTime = lifecycleruleDescUpdateTime.UpdateDefault.(func() time.Time)
+	// lifecycleruleDescName is the schema descriptor for name field.
+	lifecycleruleDescName := lifecycleruleFields[1].Descriptor()
+	// lifecyclerule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	lifecyclerule.NameValidator = func() func(string) error {
+		validators := lifecycleruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// lifecycleruleDescEnabled is the schema descriptor for enabled field.
+	lifecycleruleDescEnabled := lifecycleruleFields[2].Descriptor()
+	// lifecyclerule.DefaultEnabled holds the default value on creation for the enabled field.
+	lifecyclerule.DefaultEnabled = lifecycleruleDescEnabled.Default.(bool)
+	// lifecycleruleDescMinAgeDays is the schema descriptor for min_age_days field.
+	lifecycleruleDescMinAgeDays := lifecycleruleFields[4].Descriptor()
+	// lifecyclerule.MinAgeDaysValidator is a validator for the "min_age_days" field. It is called by the builders before save.
+	lifecyclerule.MinAgeDaysValidator = lifecycleruleDescMinAgeDays.Validators[0].(func(int) error)
+	// lifecycleruleDescMinIdleDays is the schema descriptor for min_idle_days field.
+	lifecycleruleDescMinIdleDays := lifecycleruleFields[5].Descriptor()
+	// lifecyclerule.MinIdleDaysValidator is a validator for the "min_idle_days" field. It is called by the builders before save.
+	lifecyclerule.MinIdleDaysValidator = lifecycleruleDescMinIdleDays.Validators[0].(func(int) error)
+	// lifecycleruleDescTag is the schema descriptor for tag field.
+	lifecycleruleDescTag := lifecycleruleFields[6].Descriptor()
+	// lifecyclerule.TagValidator is a validator for the "tag" field. It is called by the builders before save.
+	lifecyclerule.TagValidator = lifecycleruleDescTag.Validators[0].(func(string) error)
+	// lifecycleruleDescMimeCategory is the schema descriptor for mime_category field.
+	lifecycleruleDescMimeCategory := lifecycleruleFields[7].Descriptor()
+	// lifecyclerule.MimeCategoryValidator is a validator for the "mime_category" field. It is called by the builders before save.
+	lifecyclerule.MimeCategoryValidator = lifecycleruleDescMimeCategory.Validators[0].(func(string) error)
+	// lifecycleruleDescID is the schema descriptor for id field.
+	lifecycleruleDescID := lifecycleruleFields[0].Descriptor()
+	// lifecyclerule.DefaultID holds the default value on creation for the id field.
+	lifecyclerule.DefaultID = lifecycleruleDescID.Default.(func() uuid.UUID)
+	operationauditlogMixin := schema.OperationAuditLog{}.Mixin()
+	operationauditlogMixinHooks0 := operationauditlogMixin[0].Hooks()
+	operationauditlogMixinHooks1 := operationauditlogMixin[1].Hooks()
+	operationauditlog.Hooks[0] = operationauditlogMixinHooks0[0]
+	operationauditlog.Hooks[1] = operationauditlogMixinHooks1[0]
+	operationauditlogMixinInters0 := operationauditlogMixin[0].Interceptors()
+	operationauditlog.Interceptors[0] = operationauditlogMixinInters0[0]
+	operationauditlogMixinFields1 := operationauditlogMixin[1].Fields()
+	_ = operationauditlogMixinFields1
+	operationauditlogFields := schema.OperationAuditLog{}.Fields()
+	_ = operationauditlogFields
+	// operationauditlogDescCreateTime is the schema descriptor for create_time field.
+	operationauditlogDescCreateTime := operationauditlogMixinFields1[0].Descriptor()
+	// operationauditlog.DefaultCreateTime holds the default value on creation for the create_time field.
+	operationauditlog.DefaultCreateTime = operationauditlogDescCreateTime.Default.(func() time.Time)
+	// operationauditlogDescUpdateTime is the schema descriptor for update_time field.
+	operationauditlogDescUpdateTime := operationauditlogMixinFields1[1].Descriptor()
+	// operationauditlog.DefaultUpdateTime holds the default value on creation for the update_time field.
+	operationauditlog.DefaultUpdateTime = operationauditlogDescUpdateTime.Default.(func() time.Time)
+	// operationauditlog.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	operationauditlog.UpdateDefaultUpdateTime = operationauditlogDescUpdateTime.UpdateDefault.(func() time.Time)
+	// operationauditlogDescOperationName is the schema descriptor for operation_name field.
+	operationauditlogDescOperationName := operationauditlogFields[1].Descriptor()
+	// operationauditlog.OperationNameValidator is a validator for the "operation_name" field. It is called by the builders before save.
+	operationauditlog.OperationNameValidator = func() func(string) error {
+		validators := operationauditlogDescOperationName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(operation_name string) error {
+			for _, fn := range fns {
+				if err := fn(operation_name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// operationauditlogDescActorRole is the schema descriptor for actor_role field.
+	operationauditlogDescActorRole := operationauditlogFields[5].Descriptor()
+	// operationauditlog.ActorRoleValidator is a validator for the "actor_role" field. It is called by the builders before save.
+	operationauditlog.ActorRoleValidator = operationauditlogDescActorRole.Validators[0].(func(string) error)
+	// operationauditlogDescSuccess is the schema descriptor for success field.
+	operationauditlogDescSuccess := operationauditlogFields[6].Descriptor()
+	// operationauditlog.DefaultSuccess holds the default value on creation for the success field.
+	operationauditlog.DefaultSuccess = operationauditlogDescSuccess.Default.(bool)
+	// operationauditlogDescErrorMessage is the schema descriptor for error_message field.
+	operationauditlogDescErrorMessage := operationauditlogFields[7].Descriptor()
+	// operationauditlog.ErrorMessageValidator is a validator for the "error_message" field. It is called by the builders before save.
+	operationauditlog.ErrorMessageValidator = operationauditlogDescErrorMessage.Validators[0].(func(string) error)
+	// operationauditlogDescDurationMs is the schema descriptor for duration_ms field.
+	operationauditlogDescDurationMs := operationauditlogFields[8].Descriptor()
+	// operationauditlog.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	operationauditlog.DefaultDurationMs = operationauditlogDescDurationMs.Default.(int64)
+	// operationauditlogDescID is the schema descriptor for id field.
+	operationauditlogDescID := operationauditlogFields[0].Descriptor()
+	// operationauditlog.DefaultID holds the default value on creation for the id field.
+	operationauditlog.DefaultID = operationauditlogDescID.Default.(func() uuid.UUID)
+	tagMixin := schema.Tag{}.Mixin()
+	tagMixinHooks0 := tagMixin[0].Hooks()
+	tagMixinHooks1 := tagMixin[1].Hooks()
+	tag.Hooks[0] = tagMixinHooks0[0]
+	tag.Hooks[1] = tagMixinHooks1[0]
+	tagMixinInters0 := tagMixin[0].Interceptors()
+	tag.Interceptors[0] = tagMixinInters0[0]
+	tagMixinFields1 := tagMixin[1].Fields()
+	_ = tagMixinFields1
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescCreateTime is the schema descriptor for create_time field.
+	tagDescCreateTime := tagMixinFields1[0].Descriptor()
+	// tag.DefaultCreateTime holds the default value on creation for the create_time field.
+	tag.DefaultCreateTime = tagDescCreateTime.Default.(func() time.Time)
+	// tagDescUpdateTime is the schema descriptor for update_time field.
+	tagDescUpdateTime := tagMixinFields1[1].Descriptor()
+	// tag.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tag.DefaultUpdateTime = tagDescUpdateTime.Default.(func() time.Time)
+	// tag.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tag.UpdateDefaultUpdateTime = tagDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = func() func(string) error {
+		validators := tagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
+	tenantsettingMixin := schema.TenantSetting{}.Mixin()
+	tenantsettingMixinHooks0 := tenantsettingMixin[0].Hooks()
+	tenantsettingMixinHooks1 := tenantsettingMixin[1].Hooks()
+	tenantsetting.Hooks[0] = tenantsettingMixinHooks0[0]
+	tenantsetting.Hooks[1] = tenantsettingMixinHooks1[0]
+	tenantsettingMixinInters0 := tenantsettingMixin[0].Interceptors()
+	tenantsetting.Interceptors[0] = tenantsettingMixinInters0[0]
+	tenantsettingMixinFields1 := tenantsettingMixin[1].Fields()
+	_ = tenantsettingMixinFields1
+	tenantsettingFields := schema.TenantSetting{}.Fields()
+	_ = tenantsettingFields
+	// tenantsettingDescCreateTime is the schema descriptor for create_time field.
+	tenantsettingDescCreateTime := tenantsettingMixinFields1[0].Descriptor()
+	// tenantsetting.DefaultCreateTime holds the default value on creation for the create_time field.
+	tenantsetting.DefaultCreateTime = tenantsettingDescCreateTime.Default.(func() time.Time)
+	// tenantsettingDescUpdateTime is the schema descriptor for update_time field.
+	tenantsettingDescUpdateTime := tenantsettingMixinFields1[1].Descriptor()
+	// tenantsetting.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tenantsetting.DefaultUpdateTime = tenantsettingDescUpdateTime.Default.(func() time.Time)
+	// tenantsetting.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tenantsetting.UpdateDefaultUpdateTime = tenantsettingDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tenantsettingDescDefaultLanguage is the schema descriptor for default_language field.
+	tenantsettingDescDefaultLanguage := tenantsettingFields[1].Descriptor()
+	// tenantsetting.DefaultLanguageValidator is a validator for the "default_language" field. It is called by the builders before save.
+	tenantsetting.DefaultLanguageValidator = tenantsettingDescDefaultLanguage.Validators[0].(func(string) error)
+	// tenantsettingDescStateReason is the schema descriptor for state_reason field.
+	tenantsettingDescStateReason := tenantsettingFields[3].Descriptor()
+	// tenantsetting.StateReasonValidator is a validator for the "state_reason" field. It is called by the builders before save.
+	tenantsetting.StateReasonValidator = tenantsettingDescStateReason.Validators[0].(func(string) error)
+	// tenantsettingDescQuotaGracePercent is the schema descriptor for quota_grace_percent field.
+	tenantsettingDescQuotaGracePercent := tenantsettingFields[6].Descriptor()
+	// tenantsetting.DefaultQuotaGracePercent holds the default value on creation for the quota_grace_percent field.
+	tenantsetting.DefaultQuotaGracePercent = tenantsettingDescQuotaGracePercent.Default.(int)
+	// tenantsetting.QuotaGracePercentValidator is a validator for the "quota_grace_percent" field. It is called by the builders before save.
+	tenantsetting.QuotaGracePercentValidator = tenantsettingDescQuotaGracePercent.Validators[0].(func(int) error)
+	// tenantsettingDescStorageLimitBytes is the schema descriptor for storage_limit_bytes field.
+	tenantsettingDescStorageLimitBytes := tenantsettingFields[7].Descriptor()
+	// tenantsetting.StorageLimitBytesValidator is a validator for the "storage_limit_bytes" field. It is called by the builders before save.
+	tenantsetting.StorageLimitBytesValidator = tenantsettingDescStorageLimitBytes.Validators[0].(func(int64) error)
+	// tenantsettingDescDefaultFileRetentionDays is the schema descriptor for default_file_retention_days field.
+	tenantsettingDescDefaultFileRetentionDays := tenantsettingFields[8].Descriptor()
+	// tenantsetting.DefaultFileRetentionDaysValidator is a validator for the "default_file_retention_days" field. It is called by the builders before save.
+	tenantsetting.DefaultFileRetentionDaysValidator = tenantsettingDescDefaultFileRetentionDays.Validators[0].(func(int) error)
+	// tenantsettingDescRequireUploadDescription is the schema descriptor for require_upload_description field.
+	tenantsettingDescRequireUploadDescription := tenantsettingFields[10].Descriptor()
+	// tenantsetting.DefaultRequireUploadDescription holds the default value on creation for the require_upload_description field.
+	tenantsetting.DefaultRequireUploadDescription = tenantsettingDescRequireUploadDescription.Default.(bool)
+	// tenantsettingDescUploadDescriptionTemplate is the schema descriptor for upload_description_template field.
+	tenantsettingDescUploadDescriptionTemplate := tenantsettingFields[11].Descriptor()
+	// tenantsetting.UploadDescriptionTemplateValidator is a validator for the "upload_description_template" field. It is called by the builders before save.
+	tenantsetting.UploadDescriptionTemplateValidator = tenantsettingDescUploadDescriptionTemplate.Validators[0].(func(string) error)
+	// tenantsettingDescRequirePdfWatermark is the schema descriptor for require_pdf_watermark field.
+	tenantsettingDescRequirePdfWatermark := tenantsettingFields[14].Descriptor()
+	// tenantsetting.DefaultRequirePdfWatermark holds the default value on creation for the require_pdf_watermark field.
+	tenantsetting.DefaultRequirePdfWatermark = tenantsettingDescRequirePdfWatermark.Default.(bool)
+	// tenantsettingDescID is the schema descriptor for id field.
+	tenantsettingDescID := tenantsettingFields[0].Descriptor()
+	// tenantsetting.DefaultID holds the default value on creation for the id field.
+	tenantsetting.DefaultID = tenantsettingDescID.Default.(func() uuid.UUID)
+	translationoverrideMixin := schema.TranslationOverride{}.Mixin()
+	translationoverrideMixinHooks0 := translationoverrideMixin[0].Hooks()
+	translationoverrideMixinHooks1 := translationoverrideMixin[1].Hooks()
+	translationoverride.Hooks[0] = translationoverrideMixinHooks0[0]
+	translationoverride.Hooks[1] = translationoverrideMixinHooks1[0]
+	translationoverrideMixinInters0 := translationoverrideMixin[0].Interceptors()
+	translationoverride.Interceptors[0] = translationoverrideMixinInters0[0]
+	translationoverrideMixinFields1 := translationoverrideMixin[1].Fields()
+	_ = translationoverrideMixinFields1
+	translationoverrideFields := schema.TranslationOverride{}.Fields()
+	_ = translationoverrideFields
+	// translationoverrideDescCreateTime is the schema descriptor for create_time field.
+	translationoverrideDescCreateTime := translationoverrideMixinFields1[0].Descriptor()
+	// translationoverride.DefaultCreateTime holds the default value on creation for the create_time field.
+	translationoverride.DefaultCreateTime = translationoverrideDescCreateTime.Default.(func() time.Time)
+	// translationoverrideDescUpdateTime is the schema descriptor for update_time field.
+	translationoverrideDescUpdateTime := translationoverrideMixinFields1[1].Descriptor()
+	// translationoverride.DefaultUpdateTime holds the default value on creation for the update_time field.
+	translationoverride.DefaultUpdateTime = translationoverrideDescUpdateTime.Default.(func() time.Time)
+	// translationoverride.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	translationoverride.UpdateDefaultUpdateTime = translationoverrideDescUpdateTime.UpdateDefault.(func() time.Time)
+	// translationoverrideDescMessageID is the schema descriptor for message_id field.
+	translationoverrideDescMessageID := translationoverrideFields[1].Descriptor()
+	// translationoverride.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	translationoverride.MessageIDValidator = translationoverrideDescMessageID.Validators[0].(func(string) error)
+	// translationoverrideDescLanguage is the schema descriptor for language field.
+	translationoverrideDescLanguage := translationoverrideFields[2].Descriptor()
+	// translationoverride.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	translationoverride.LanguageValidator = func() func(string) error {
+		validators := translationoverrideDescLanguage.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(language string) error {
+			for _, fn := range fns {
+				if err := fn(language); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// translationoverrideDescText is the schema descriptor for text field.
+	translationoverrideDescText := translationoverrideFields[3].Descriptor()
+	// translationoverride.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	translationoverride.TextValidator = translationoverrideDescText.Validators[0].(func(string) error)
+	// translationoverrideDescID is the schema descriptor for id field.
+	translationoverrideDescID := translationoverrideFields[0].Descriptor()
+	// translationoverride.DefaultID holds the default value on creation for the id field.
+	translationoverride.DefaultID = translationoverrideDescID.Default.(func() uuid.UUID)
+	userstoragelimitMixin := schema.UserStorageLimit{}.Mixin()
+	userstoragelimitMixinHooks0 := userstoragelimitMixin[0].Hooks()
+	userstoragelimitMixinHooks1 := userstoragelimitMixin[1].Hooks()
+	userstoragelimit.Hooks[0] = userstoragelimitMixinHooks0[0]
+	userstoragelimit.Hooks[1] = userstoragelimitMixinHooks1[0]
+	userstoragelimitMixinInters0 := userstoragelimitMixin[0].Interceptors()
+	userstoragelimit.Interceptors[0] = userstoragelimitMixinInters0[0]
+	userstoragelimitMixinFields1 := userstoragelimitMixin[1].Fields()
+	_ = userstoragelimitMixinFields1
+	userstoragelimitFields := schema.UserStorageLimit{}.Fields()
+	_ = userstoragelimitFields
+	// userstoragelimitDescCreateTime is the schema descriptor for create_time field.
+	userstoragelimitDescCreateTime := userstoragelimitMixinFields1[0].Descriptor()
+	// userstoragelimit.DefaultCreateTime holds the default value on creation for the create_time field.
+	userstoragelimit.DefaultCreateTime = userstoragelimitDescCreateTime.Default.(func() time.Time)
+	// userstoragelimitDescUpdateTime is the schema descriptor for update_time field.
+	userstoragelimitDescUpdateTime := userstoragelimitMixinFields1[1].Descriptor()
+	// userstoragelimit.DefaultUpdateTime holds the default value on creation for the update_time field.
+	userstoragelimit.DefaultUpdateTime = userstoragelimitDescUpdateTime.Default.(func() time.Time)
+	// userstoragelimit.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	userstoragelimit.UpdateDefaultUpdateTime = userstoragelimitDescUpdateTime.UpdateDefault.(func() time.Time)
+	// userstoragelimitDescMaxFiles is the schema descriptor for max_files field.
+	userstoragelimitDescMaxFiles := userstoragelimitFields[2].Descriptor()
+	// userstoragelimit.MaxFilesValidator is a validator for the "max_files" field. It is called by the builders before save.
+	userstoragelimit.MaxFilesValidator = userstoragelimitDescMaxFiles.Validators[0].(func(int) error)
+	// userstoragelimitDescMaxBytes is the schema descriptor for max_bytes field.
+	userstoragelimitDescMaxBytes := userstoragelimitFields[3].Descriptor()
+	// userstoragelimit.MaxBytesValidator is a validator for the "max_bytes" field. It is called by the builders before save.
+	userstoragelimit.MaxBytesValidator = userstoragelimitDescMaxBytes.Validators[0].(func(int64) error)
+	// userstoragelimitDescID is the schema descriptor for id field.
+	userstoragelimitDescID := userstoragelimitFields[0].Descriptor()
+	// userstoragelimit.DefaultID holds the default value on creation for the id field.
+	userstoragelimit.DefaultID = userstoragelimitDescID.Default.(func() uuid.UUID)
 }
 
 const (
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DepartmentQuota holds the schema definition for per-department storage quotas
+type DepartmentQuota struct {
+	ent.Schema
+}
+
+// Mixin of the DepartmentQuota
+func (DepartmentQuota) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (DepartmentQuota) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("department_id", uuid.UUID{}).
+			Comment("Отдел из контекста федерации (DepartmentIDs)"),
+		field.Int64("limit_bytes").
+			Positive().
+			Comment("Лимит хранилища отдела в байтах"),
+	}
+}
+
+func (DepartmentQuota) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (DepartmentQuota) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "department_id").
+			Unique(),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (DepartmentQuota) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "department_quotas"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -2,12 +2,14 @@ package schema
 
 import (
 	localmixin "main/ent/schema/mixin"
+	"main/hooks"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/privacy"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
@@ -24,6 +26,7 @@ func (File) Mixin() []ent.Mixin {
 		localmixin.TenantMixin{},
 		localmixin.TimeMixin{},
 		localmixin.LimitMixin{},
+		localmixin.SoftDeleteMixin{},
 	}
 }
 
@@ -44,6 +47,10 @@ func (File) Hooks() []ent.Hook {
 	return []ent.Hook{
 		// Автоматически удаляет файл из S3 при удалении записи из БД
 		//hooks.WithFileS3Deletion(),
+		// Счетчик использования хранилища тенанта в Redis
+		hooks.WithFileStorageUsage(),
+		// Проверка metadata по схеме тенанта
+		hooks.WithFileMetadataSchema(),
 	}
 }
 
@@ -58,36 +65,252 @@ func (File) Fields() []ent.Field {
 			),
 		field.String("original_name").
 			NotEmpty().
+			Annotations(
+				entgql.OrderField("NAME"),
+			).
 			Comment("Оригинальное имя загруженного файла"),
 		field.String("storage_key").
 			NotEmpty().
 			Comment("Уникальный ключ в хранилище S3"),
 		field.String("mime_type").
 			NotEmpty().
-			Comment("MIME-тип файла"),
+			Comment("MIME-тип файла, заявленный клиентом (или определенный по расширению)"),
+		field.String("detected_mime_type").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("MIME-тип, определенный по сигнатуре содержимого при загрузке"),
 		field.Int64("size").
 			Positive().
+			Annotations(
+				entgql.OrderField("SIZE"),
+			).
 			Comment("Размер файла в байтах"),
 		field.String("path").
 			Optional().
-			Comment("Путь к файлу в хранилище (deprecated, используется storage_key)"),
+			Annotations(entgql.Skip(entgql.SkipAll)).
+			Comment("Путь к файлу в хранилище (deprecated, используется storage_key); колонка удаляется, когда статистика /internal/deprecated-fields покажет, что File.path не запрашивают"),
 		field.String("description").
 			Optional().
 			Comment("Описание файла"),
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional().
 			Comment("Дополнительные метаданные файла"),
+		field.String("checksum_sha256").
+			Optional().
+			MaxLen(64).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("SHA-256 содержимого файла (hex), вычисляется при загрузке"),
+		field.Enum("integrity_status").
+			Values("UNKNOWN", "OK", "CORRUPTED", "MISSING").
+			Default("UNKNOWN").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Результат последней проверки целостности объекта в S3"),
+		field.Time("integrity_checked_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Время последней проверки целостности"),
+		field.Enum("thumbnail_status").
+			Values("NONE", "PENDING", "READY", "FAILED").
+			Default("NONE").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Состояние превью изображения: NONE - превью не создается, PENDING - ожидает генерации"),
+		field.Enum("preview_status").
+			Values("NONE", "PENDING", "READY", "FAILED").
+			Default("NONE").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Состояние превью первой страницы документа (PDF, Office)"),
+		field.Enum("pdf_status").
+			Values("NONE", "PENDING", "READY", "FAILED").
+			Default("NONE").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Состояние PDF-версии документа Office для просмотра в браузере: NONE - конвертация не выполняется"),
+		field.Int("pdf_attempts").
+			Default(0).
+			NonNegative().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			).
+			Comment("Неудачные попытки конвертации в PDF; после временной ошибки документ остается в PENDING до исчерпания попыток"),
+		field.Time("pdf_retry_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			).
+			Comment("Время следующей попытки конвертации в PDF после временной ошибки конвертера или S3"),
+		field.Enum("scan_status").
+			Values("NONE", "PENDING", "CLEAN", "INFECTED").
+			Default("NONE").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Результат антивирусной проверки: NONE - проверка отключена, PENDING - ожидает проверки"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Срок хранения, заданный при загрузке: после него файл перемещается в корзину"),
+		field.UUID("department_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			).
+			Comment("Отдел загрузившего пользователя (первый из DepartmentIDs федерации) для учета квоты отдела"),
+		field.Enum("upload_source").
+			Values("UNKNOWN", "WEB", "MOBILE", "API_TOKEN", "EMAIL_IN", "IMPORT").
+			Default("UNKNOWN").
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Источник загрузки из заголовка gateway X-Upload-Source: пользовательский клиент или автоматизация"),
+		field.String("client_version").
+			Optional().
+			MaxLen(64).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Версия клиента из заголовка gateway X-Client-Version"),
+		field.Int64("download_count").
+			Default(0).
+			NonNegative().
+			Annotations(
+				entgql.OrderField("DOWNLOAD_COUNT"),
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Количество скачиваний: выданных ссылок на файл и архивов, в которые он вошел"),
+		field.Time("last_downloaded_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Время последнего скачивания; используется условием простоя правил жизненного цикла"),
+		field.Time("archived_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Время перевода файла в архив правилом жизненного цикла; архивные файлы остаются доступными"),
+		field.Enum("entity_type").
+			Values("TICKET", "COMMENT", "MESSAGE").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Тип сущности другого сервиса, к которой прикреплен файл: тикет, комментарий или сообщение чата"),
+		field.UUID("entity_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("ID сущности, к которой прикреплен файл; задается вместе с entity_type"),
+		field.UUID("locked_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Пользователь, взявший файл на редактирование (lockFile); остальные не могут изменять файл до снятия блокировки"),
+		field.Time("locked_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Время установки блокировки; задается вместе с locked_by"),
+		field.Enum("object_lock_mode").
+			Values("GOVERNANCE", "COMPLIANCE").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Режим удержания объекта S3 Object Lock: GOVERNANCE снимается с особым правом, COMPLIANCE - никем до срока"),
+		field.Time("object_lock_retain_until").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Срок удержания объекта в S3; задается вместе с object_lock_mode"),
+		field.Bool("legal_hold").
+			Default(false).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Legal hold объекта в S3: объект нельзя удалить, пока удержание не снято, независимо от срока"),
+		field.Time("scheduled_deletion_at").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Запланированное удаление (scheduleFileDeletion): в это время файл перемещается в корзину"),
+		field.UUID("scheduled_deletion_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			).
+			Comment("Пользователь, запланировавший удаление; задается вместе с scheduled_deletion_at"),
 	}
 }
 
 func (File) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		// Теги изменяются мутациями tagFiles/untagFiles и при загрузке
+		edge.To("tags", Tag.Type).
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		// Избранное пользователей изменяется мутациями starFile/unstarFile, фильтр - FileWhereInput.onlyFavorites
+		edge.To("favorites", FileFavorite.Type).
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entgql.Skip(entgql.SkipAll),
+			),
+	}
 }
 
 func (File) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("storage_key").
 			Unique(),
+		index.Fields("integrity_checked_at"),
+		index.Fields("deleted_at"),
+		index.Fields("thumbnail_status"),
+		index.Fields("preview_status"),
+		index.Fields("pdf_status"),
+		index.Fields("scan_status"),
+		index.Fields("expires_at"),
+		index.Fields("department_id"),
+		index.Fields("upload_source"),
+		index.Fields("archived_at"),
+		index.Fields("download_count"),
+		index.Fields("checksum_sha256"),
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("scheduled_deletion_at"),
 	}
 }
 
@@ -0,0 +1,101 @@
+package schema
+
+import (
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FileAuditEvent holds the schema definition for file audit events
+type FileAuditEvent struct {
+	ent.Schema
+}
+
+// Mixin of the FileAuditEvent
+func (FileAuditEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (FileAuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Enum("action").
+			Values(
+				"UPLOAD",
+				"DELETE",
+				"RENAME",
+				"UPDATE",
+				"URL_GENERATED",
+				"BATCH_DOWNLOAD",
+				"SHARE_CREATED",
+				"LIMIT_VIOLATION",
+				"INTEGRITY_FAILURE",
+				"QUOTA_EXCEEDED",
+				"RESTORE",
+				"PURGE",
+				"RETENANT",
+				"MALWARE_DETECTED",
+				"MALWARE_DOWNLOAD_BLOCKED",
+				"LIFECYCLE_NOTICE",
+				"COPY",
+				"MOVE",
+				"CONTENT_STREAMED",
+				"IMPERSONATION_GRANTED",
+				"IMPERSONATION_REVOKED",
+				"FILE_LOCKED",
+				"FILE_UNLOCKED",
+				"OBJECT_LOCK_UPDATED",
+				"FILE_TYPE_BLOCKED",
+				"DELETION_SCHEDULED",
+				"DELETION_CANCELED",
+			).
+			Comment("Тип действия"),
+		field.UUID("file_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Файл, к которому относится событие (пусто для событий без файла)"),
+		field.UUID("actor_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Пользователь, выполнивший действие (пусто для системных операций)"),
+		field.UUID("impersonator_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Администратор поддержки, действовавший от имени пользователя (пусто вне сессии поддержки)"),
+		field.JSON("details", map[string]interface{}{}).
+			Optional().
+			Comment("Дополнительные данные события"),
+	}
+}
+
+func (FileAuditEvent) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (FileAuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "action", "create_time"),
+		// Агрегаты аудита по периоду без фильтра по действию
+		index.Fields("tenant_id", "create_time"),
+		index.Fields("tenant_id", "file_id"),
+		index.Fields("tenant_id", "actor_id"),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (FileAuditEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "file_audit_events"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FileFavorite holds the schema definition for a file starred by a user
+type FileFavorite struct {
+	ent.Schema
+}
+
+// Mixin of the FileFavorite
+func (FileFavorite) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (FileFavorite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("file_id", uuid.UUID{}).
+			Immutable().
+			Comment("Избранный файл"),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("Пользователь, добавивший файл в избранное"),
+	}
+}
+
+func (FileFavorite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("file", File.Type).
+			Ref("favorites").
+			Field("file_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (FileFavorite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("file_id", "user_id").
+			Unique(),
+		index.Fields("tenant_id", "user_id"),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (FileFavorite) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "file_favorites"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FileSet holds the schema definition for a saved named selection of files
+type FileSet struct {
+	ent.Schema
+}
+
+// Mixin of the FileSet
+func (FileSet) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (FileSet) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("created_by", uuid.UUID{}).
+			Immutable().
+			Comment("Владелец набора"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Название набора"),
+		field.String("description").
+			Optional().
+			MaxLen(1000).
+			Comment("Описание набора"),
+		field.JSON("file_ids", []uuid.UUID{}).
+			Comment("Файлы набора в порядке добавления"),
+		field.Bool("shared_with_tenant").
+			Default(false).
+			Comment("Набор доступен всем пользователям тенанта"),
+		field.JSON("shared_user_ids", []uuid.UUID{}).
+			Optional().
+			Comment("Пользователи, которым открыт доступ к набору"),
+		field.JSON("granted_file_ids", []uuid.UUID{}).
+			Optional().
+			Comment("Файлы, доступ к которым открыт вместе с набором: файлы набора, которые мог скачивать открывший доступ " +
+				"при открытии доступа или изменении состава. Остальные файлы доступны по собственным правам пользователя"),
+	}
+}
+
+func (FileSet) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (FileSet) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_by", "name").
+			Unique(),
+		index.Fields("tenant_id", "shared_with_tenant"),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (FileSet) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "file_sets"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,160 @@
+package filemeta
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"sort"
+)
+
+// FieldType тип значения поля метаданных
+type FieldType string
+
+const (
+	FieldTypeString  FieldType = "STRING"
+	FieldTypeNumber  FieldType = "NUMBER"
+	FieldTypeInteger FieldType = "INTEGER"
+	FieldTypeBoolean FieldType = "BOOLEAN"
+	FieldTypeObject  FieldType = "OBJECT"
+	FieldTypeArray   FieldType = "ARRAY"
+)
+
+// Ключи metadata, которые заполняет сервис. Схема не считает их лишними, а клиент не может задать их
+// через произвольные атрибуты (папка задается отдельным полем загрузки).
+const (
+	KeyFolder                = "folder"
+	KeyImageMetadataStripped = "image_metadata_stripped"
+	KeyOriginalExif          = "original_exif"
+)
+
+// ServiceKeys служебные ключи metadata
+var ServiceKeys = []string{KeyFolder, KeyImageMetadataStripped, KeyOriginalExif}
+
+// Причины несоответствия метаданных схеме
+const (
+	ViolationRequired   = "required"
+	ViolationType       = "type"
+	ViolationUnknownKey = "unknown_key"
+)
+
+// FieldTypes допустимые типы полей
+var FieldTypes = []FieldType{
+	FieldTypeString, FieldTypeNumber, FieldTypeInteger, FieldTypeBoolean, FieldTypeObject, FieldTypeArray,
+}
+
+// Field описание поля метаданных файла
+type Field struct {
+	Key      string    `json:"key"`
+	Type     FieldType `json:"type"`
+	Required bool      `json:"required,omitempty"`
+}
+
+// Schema схема метаданных файлов тенанта. Схема с EntityType применяется к файлам этой сущности,
+// схема без EntityType - к остальным файлам.
+type Schema struct {
+	EntityType string  `json:"entity_type,omitempty"`
+	Fields     []Field `json:"fields"`
+	// AllowAdditional разрешает ключи, не описанные в схеме
+	AllowAdditional bool `json:"allow_additional,omitempty"`
+}
+
+// Violation первое найденное несоответствие метаданных схеме
+type Violation struct {
+	Key    string
+	Reason string
+	// Type ожидаемый тип поля (для ViolationType)
+	Type FieldType
+}
+
+// Find возвращает схему для сущности файла: схему этой сущности или общую схему
+func Find(schemas []Schema, entityType string) *Schema {
+	var fallback *Schema
+	for i := range schemas {
+		if schemas[i].EntityType == "" {
+			fallback = &schemas[i]
+		} else if schemas[i].EntityType == entityType {
+			return &schemas[i]
+		}
+	}
+	return fallback
+}
+
+// Validate проверяет метаданные по схеме и возвращает первое несоответствие (nil - метаданные корректны).
+// Ключи из ignoredKeys (служебные ключи сервиса) не считаются лишними. Поля проверяются в порядке схемы,
+// лишние ключи - в алфавитном порядке, чтобы ошибка была одинаковой при повторе запроса.
+func (s *Schema) Validate(metadata map[string]interface{}, ignoredKeys ...string) *Violation {
+	for _, field := range s.Fields {
+		value, ok := metadata[field.Key]
+		if !ok || value == nil {
+			if field.Required {
+				return &Violation{Key: field.Key, Reason: ViolationRequired}
+			}
+			continue
+		}
+		if !matchesType(value, field.Type) {
+			return &Violation{Key: field.Key, Reason: ViolationType, Type: field.Type}
+		}
+	}
+
+	if s.AllowAdditional {
+		return nil
+	}
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if slices.Contains(ignoredKeys, key) || slices.ContainsFunc(s.Fields, func(field Field) bool { return field.Key == key }) {
+			continue
+		}
+		return &Violation{Key: key, Reason: ViolationUnknownKey}
+	}
+	return nil
+}
+
+// matchesType проверяет тип значения. Числа приходят как float64 (JSON из БД), json.Number
+// (переменные GraphQL) или целые типы Go (литералы запроса и код сервиса).
+func matchesType(value interface{}, fieldType FieldType) bool {
+	switch fieldType {
+	case FieldTypeString:
+		_, ok := value.(string)
+		return ok
+	case FieldTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case FieldTypeObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case FieldTypeArray:
+		_, ok := value.([]interface{})
+		return ok
+	case FieldTypeNumber, FieldTypeInteger:
+		number, ok := toFloat(value)
+		if !ok {
+			return false
+		}
+		return fieldType == FieldTypeNumber || number == math.Trunc(number)
+	}
+	return false
+}
+
+// toFloat приводит числовое значение к float64
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		number, err := v.Float64()
+		return number, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ImpersonationGrant holds the schema definition for owner-granted support impersonation sessions
+type ImpersonationGrant struct {
+	ent.Schema
+}
+
+// Mixin of the ImpersonationGrant
+func (ImpersonationGrant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (ImpersonationGrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("support_user_id", uuid.UUID{}).
+			Comment("Администратор поддержки, которому выдан доступ"),
+		field.UUID("target_user_id", uuid.UUID{}).
+			Comment("Пользователь, от имени которого выполняются запросы"),
+		field.Enum("target_role").
+			Values("MEMBER", "CLIENT").
+			Comment("Роль пользователя в сессии поддержки (не выше сотрудника)"),
+		field.JSON("target_department_ids", []uuid.UUID{}).
+			Optional().
+			Comment("Отделы пользователя в сессии поддержки"),
+		field.UUID("granted_by", uuid.UUID{}).
+			Comment("Владелец, выдавший доступ"),
+		field.String("reason").
+			NotEmpty().
+			MaxLen(500).
+			Comment("Причина выдачи доступа"),
+		field.String("token_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("SHA-256 токена сессии; сам токен возвращается только при выдаче"),
+		field.Time("expires_at").
+			Comment("Окончание действия доступа"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("Время досрочного отзыва доступа"),
+	}
+}
+
+func (ImpersonationGrant) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (ImpersonationGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token_hash").
+			Unique(),
+		index.Fields("tenant_id", "create_time"),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (ImpersonationGrant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "impersonation_grants"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LifecycleRule holds the schema definition for per-tenant file lifecycle rules
+type LifecycleRule struct {
+	ent.Schema
+}
+
+// Mixin of the LifecycleRule
+func (LifecycleRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (LifecycleRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100).
+			Comment("Название правила (уникально в пределах тенанта)"),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Выключенные правила не применяются, но доступны для пробного запуска"),
+		field.Enum("action").
+			Values("ARCHIVE", "EXPIRE", "NOTIFY").
+			Comment("Действие с подходящими файлами: ARCHIVE - перевод в архив, EXPIRE - перемещение в корзину, NOTIFY - событие аудита для админов"),
+		field.Int("min_age_days").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Условие: файл загружен не менее указанного количества дней назад"),
+		field.Int("min_idle_days").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Условие: файл не скачивали указанное количество дней (без скачиваний считается от загрузки)"),
+		field.String("tag").
+			Optional().
+			Nillable().
+			MaxLen(50).
+			Comment("Условие: файл отмечен тегом"),
+		field.String("mime_category").
+			Optional().
+			Nillable().
+			MaxLen(20).
+			Comment("Условие: категория MIME типа файла (IMAGE, VIDEO, DOCUMENT и т.д.)"),
+		field.UUID("created_by", uuid.UUID{}).
+			Immutable().
+			Comment("Пользователь, создавший правило"),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			Comment("Время последнего применения правила планировщиком"),
+	}
+}
+
+func (LifecycleRule) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (LifecycleRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+		index.Fields("enabled"),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (LifecycleRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "lifecycle_rules"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -33,7 +33,10 @@ func (TenantMixin) Fields() []ent.Field {
 // TenantFilterKey is used to skip tenant filtering in specific contexts
 type TenantFilterKey struct{}
 
-// SkipTenantFilter returns a new context that skips the tenant filter interceptor
+// SkipTenantFilter returns a new context that skips the tenant filter interceptor.
+//
+// Deprecated: use SkipTenantFilterFor, which records the reason and sets a deadline.
+// Outside production operations with a context from SkipTenantFilter are rejected.
 func SkipTenantFilter(parent context.Context) context.Context {
 	return context.WithValue(parent, TenantFilterKey{}, true)
 }
@@ -45,7 +48,7 @@ func (TenantMixin) Interceptors() []ent.Interceptor {
 		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
 			// Skip tenant filter if explicitly requested
 			if skip, _ := ctx.Value(TenantFilterKey{}).(bool); skip {
-				return nil
+				return checkTenantFilterSkip(ctx)
 			}
 
 			// Get tenant from context
@@ -72,6 +75,9 @@ func (TenantMixin) Hooks() []ent.Hook {
 			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
 				// Skip if explicitly requested
 				if skip, _ := ctx.Value(TenantFilterKey{}).(bool); skip {
+					if err := checkTenantFilterSkip(ctx); err != nil {
+						return nil, err
+					}
 					return next.Mutate(ctx, m)
 				}
 
@@ -0,0 +1,153 @@
+package mixin
+
+import (
+	"context"
+	"fmt"
+	"main/utils"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// TenantFilterSkip описывает операцию, выполняемую без фильтра по тенанту
+type TenantFilterSkip struct {
+	Reason string
+	Caller string
+}
+
+// TenantFilterSkipUsage содержит статистику отключений фильтра по тенанту для места вызова
+type TenantFilterSkipUsage struct {
+	Caller     string    `json:"caller"`
+	Reason     string    `json:"reason"`
+	Count      int64     `json:"count"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+}
+
+type tenantFilterSkipKey struct{}
+
+var (
+	tenantFilterSkips   = make(map[string]*TenantFilterSkipUsage)
+	tenantFilterSkipsMu sync.Mutex
+)
+
+// SkipTenantFilterFor отключает фильтр по тенанту для операций с контекстом с указанием причины.
+// Место вызова и причина логируются и учитываются в статистике (TenantFilterSkipUsages).
+// Операции без фильтра обязаны иметь срок выполнения: если у parent его нет или он позже timeout,
+// устанавливается timeout. Возвращаемую функцию отмены нужно вызвать по завершении операций.
+func SkipTenantFilterFor(parent context.Context, reason string, timeout time.Duration) (context.Context, context.CancelFunc) {
+	skip := &TenantFilterSkip{Reason: reason, Caller: callerName(2)}
+	recordTenantFilterSkip(skip)
+
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	ctx = context.WithValue(ctx, tenantFilterSkipKey{}, skip)
+	return context.WithValue(ctx, TenantFilterKey{}, true), cancel
+}
+
+// TenantFilterSkipFromContext возвращает описание отключения фильтра по тенанту, если оно выполнено через SkipTenantFilterFor
+func TenantFilterSkipFromContext(ctx context.Context) *TenantFilterSkip {
+	skip, _ := ctx.Value(tenantFilterSkipKey{}).(*TenantFilterSkip)
+	return skip
+}
+
+// TenantFilterSkipUsages возвращает статистику отключений фильтра по тенанту с момента запуска
+func TenantFilterSkipUsages() []TenantFilterSkipUsage {
+	tenantFilterSkipsMu.Lock()
+	defer tenantFilterSkipsMu.Unlock()
+
+	usages := make([]TenantFilterSkipUsage, 0, len(tenantFilterSkips))
+	for _, usage := range tenantFilterSkips {
+		usages = append(usages, *usage)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].Caller < usages[j].Caller
+	})
+	return usages
+}
+
+// checkTenantFilterSkip проверяет операцию, выполняемую без фильтра по тенанту:
+// срок выполнения обязателен всегда, а отключение без причины (SkipTenantFilter)
+// вне production отклоняется, чтобы такие вызовы находились до выкладки
+func checkTenantFilterSkip(ctx context.Context) error {
+	skip := TenantFilterSkipFromContext(ctx)
+	if skip == nil {
+		caller := callerOutsideEnt()
+		if !utils.IsProduction() {
+			return fmt.Errorf("tenant filter skipped without reason at %s: use mixin.SkipTenantFilterFor", caller)
+		}
+		utils.Logger.Warn("Tenant filter skipped without reason", zap.String("caller", caller))
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		return fmt.Errorf("operation without tenant filter requires a context deadline")
+	}
+	return nil
+}
+
+// recordTenantFilterSkip увеличивает счетчик места вызова; первое отключение для места вызова логируется
+// с уровнем Info, повторные - с уровнем Debug
+func recordTenantFilterSkip(skip *TenantFilterSkip) {
+	tenantFilterSkipsMu.Lock()
+	usage, exists := tenantFilterSkips[skip.Caller]
+	if !exists {
+		usage = &TenantFilterSkipUsage{Caller: skip.Caller}
+		tenantFilterSkips[skip.Caller] = usage
+	}
+	usage.Reason = skip.Reason
+	usage.Count++
+	usage.LastSeenAt = time.Now()
+	tenantFilterSkipsMu.Unlock()
+
+	if utils.Logger == nil {
+		return
+	}
+	fields := []zap.Field{zap.String("caller", skip.Caller), zap.String("reason", skip.Reason)}
+	if !exists {
+		utils.Logger.Info("Tenant filter skipped", fields...)
+	} else {
+		utils.Logger.Debug("Tenant filter skipped", fields...)
+	}
+}
+
+// callerName возвращает функцию и строку вызова на глубине skip
+func callerName(skip int) string {
+	pc, path, line, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown"
+	}
+	name := "unknown"
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		name = fn.Name()
+	}
+	return fmt.Sprintf("%s (%s:%d)", name, shortPath(path), line)
+}
+
+// callerOutsideEnt возвращает первое место вызова за пределами ent и этого пакета
+// (интерцепторы и хуки вызываются глубоко внутри сгенерированного кода)
+func callerOutsideEnt() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "main/ent") && !strings.HasPrefix(frame.Function, "entgo.io/") &&
+			!strings.HasPrefix(frame.Function, "runtime.") {
+			return fmt.Sprintf("%s (%s:%d)", frame.Function, shortPath(frame.File), frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// shortPath оставляет в пути файла каталог и имя
+func shortPath(path string) string {
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		if j := strings.LastIndex(path[:i], "/"); j >= 0 {
+			return path[j+1:]
+		}
+	}
+	return path
+}
@@ -1,6 +1,7 @@
 package mixin
 
 import (
+	"context"
 	"time"
 
 	"entgo.io/contrib/entgql"
@@ -14,22 +15,50 @@ type TimeMixin struct {
 	mixin.Schema
 }
 
+// nowUTC возвращает текущее время в UTC независимо от часового пояса сервера
+func nowUTC() time.Time {
+	return time.Now().UTC()
+}
+
 // Fields of the TimeMixin.
 func (TimeMixin) Fields() []ent.Field {
 	return []ent.Field{
 		field.Time("create_time").
-			Default(time.Now).
+			Default(nowUTC).
 			Immutable().
 			Annotations(
 				entgql.OrderField("CREATE_TIME"),
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 			),
 		field.Time("update_time").
-			Default(time.Now).
-			UpdateDefault(time.Now).
+			Default(nowUTC).
+			UpdateDefault(nowUTC).
 			Annotations(
 				entgql.OrderField("UPDATE_TIME"),
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
 			),
 	}
 }
+
+// Hooks of the TimeMixin: все значения времени мутации сохраняются в UTC,
+// поэтому сортировка и курсоры не зависят от часового пояса сервера и клиента
+func (TimeMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				for _, name := range m.Fields() {
+					value, ok := m.Field(name)
+					if !ok {
+						continue
+					}
+					if t, isTime := value.(time.Time); isTime && t.Location() != time.UTC {
+						if err := m.SetField(name, t.UTC()); err != nil {
+							return nil, err
+						}
+					}
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
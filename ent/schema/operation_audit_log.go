@@ -0,0 +1,82 @@
+package schema
+
+import (
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OperationAuditLog holds the schema definition for admin/owner GraphQL mutation audit
+type OperationAuditLog struct {
+	ent.Schema
+}
+
+// Mixin of the OperationAuditLog
+func (OperationAuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (OperationAuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("operation_name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Имя мутации (поле Mutation)"),
+		field.JSON("variables", map[string]interface{}{}).
+			Optional().
+			Comment("Аргументы мутации со скрытыми секретами"),
+		field.UUID("actor_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Пользователь, выполнивший мутацию"),
+		field.UUID("impersonator_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Администратор поддержки, действовавший от имени пользователя (пусто вне сессии поддержки)"),
+		field.String("actor_role").
+			Optional().
+			MaxLen(50).
+			Comment("Роль пользователя на момент выполнения"),
+		field.Bool("success").
+			Default(false).
+			Comment("Результат выполнения"),
+		field.String("error_message").
+			Optional().
+			MaxLen(1000).
+			Comment("Сообщение об ошибке для неуспешных операций"),
+		field.Int64("duration_ms").
+			Default(0).
+			Comment("Длительность выполнения в миллисекундах"),
+	}
+}
+
+func (OperationAuditLog) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (OperationAuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "create_time"),
+		index.Fields("tenant_id", "operation_name"),
+		index.Fields("tenant_id", "actor_id"),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (OperationAuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "operation_audit_logs"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
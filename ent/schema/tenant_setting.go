@@ -0,0 +1,128 @@
+package schema
+
+import (
+	"main/ent/schema/filemeta"
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TenantSetting holds the schema definition for per-tenant service settings
+type TenantSetting struct {
+	ent.Schema
+}
+
+// Mixin of the TenantSetting
+func (TenantSetting) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (TenantSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("default_language").
+			Optional().
+			MaxLen(10).
+			Comment("Язык по умолчанию для пользователей тенанта без явного языка"),
+		field.Enum("state").
+			Values("ACTIVE", "SUSPENDED", "OFFBOARDING").
+			Default("ACTIVE").
+			Comment("Состояние тенанта: SUSPENDED и OFFBOARDING переводят файлы в режим только для чтения"),
+		field.String("state_reason").
+			Optional().
+			MaxLen(500).
+			Comment("Причина изменения состояния (например, задолженность)"),
+		field.Time("state_changed_at").
+			Optional().
+			Nillable().
+			Comment("Время последнего изменения состояния"),
+		field.Enum("quota_mode").
+			Values("HARD", "SOFT", "GRACE").
+			Default("HARD").
+			Comment("Режим квоты хранилища: HARD блокирует загрузку, SOFT только предупреждает, GRACE допускает превышение на quota_grace_percent"),
+		field.Int("quota_grace_percent").
+			Default(0).
+			Range(0, 100).
+			Comment("Допустимое превышение лимита хранилища в процентах для режима GRACE"),
+		field.Int64("storage_limit_bytes").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("Лимит хранилища тенанта в байтах, заданный оператором (null - S3_STORAGE_LIMIT_BYTES, больше него не действует)"),
+		field.Int("default_file_retention_days").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Срок хранения новых файлов в днях, если при загрузке не задан expires_at (null - без срока)"),
+		field.Enum("filename_conflict_mode").
+			Values("ALLOW", "WARN", "RENAME").
+			Default("ALLOW").
+			Comment("Обработка одинаковых имен файлов одной сущности или папки: ALLOW разрешает дубли, WARN предупреждает, RENAME переименовывает"),
+		field.Bool("require_upload_description").
+			Default(false).
+			Comment("Загрузка файла без описания отклоняется"),
+		field.String("upload_description_template").
+			Optional().
+			Nillable().
+			MaxLen(1000).
+			Comment("Шаблон описания, которым клиент заполняет форму загрузки"),
+		field.Strings("upload_classification_tags").
+			Optional().
+			Comment("Справочник тегов классификации: если задан, каждый загружаемый файл должен иметь хотя бы один тег из него"),
+		field.Enum("image_metadata_mode").
+			Values("KEEP", "STRIP", "STRIP_PRESERVE_ORIGINAL").
+			Default("KEEP").
+			Comment("Метаданные загружаемых изображений: KEEP сохраняет, STRIP удаляет EXIF и XMP (GPS, камера), STRIP_PRESERVE_ORIGINAL удаляет и сохраняет исходный EXIF в metadata файла"),
+		field.Bool("require_pdf_watermark").
+			Default(false).
+			Comment("Ссылки на скачивание PDF всегда выдаются на копию с водяным знаком (email пользователя и время)"),
+		field.Int64("max_upload_size").
+			Optional().
+			Nillable().
+			Comment("Максимальный размер загружаемого файла в байтах (null - MAX_UPLOAD_SIZE сервиса, больше него не действует)"),
+		field.Strings("upload_allowed_extensions").
+			Optional().
+			Comment("Разрешенные расширения файлов без точки; если заданы, загрузка файлов с другими расширениями отклоняется"),
+		field.Strings("upload_blocked_extensions").
+			Optional().
+			Comment("Запрещенные расширения файлов без точки; имеют приоритет над разрешенными"),
+		field.Strings("upload_allowed_mime_types").
+			Optional().
+			Comment("Разрешенные типы содержимого (type/subtype или семейство type/*); если заданы, загрузка других типов отклоняется"),
+		field.Strings("upload_blocked_mime_types").
+			Optional().
+			Comment("Запрещенные типы содержимого (type/subtype или семейство type/*); имеют приоритет над разрешенными"),
+		field.JSON("file_metadata_schemas", []filemeta.Schema{}).
+			Optional().
+			Comment("Схемы metadata файлов (обязательные ключи и типы значений) по типам сущностей; проверяются при создании и изменении файла"),
+	}
+}
+
+func (TenantSetting) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (TenantSetting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id").
+			Unique(),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (TenantSetting) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "tenant_settings"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
@@ -0,0 +1,21 @@
+package timegql
+
+import (
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MarshalTime marshals a time to RFC3339 in UTC, so clients in every region see the same value
+func MarshalTime(t time.Time) graphql.Marshaler {
+	return graphql.MarshalTime(t.UTC())
+}
+
+// UnmarshalTime unmarshals an RFC3339 string to a time normalized to UTC
+func UnmarshalTime(v interface{}) (time.Time, error) {
+	t, err := graphql.UnmarshalTime(v)
+	if err != nil {
+		return t, err
+	}
+	return t.UTC(), nil
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TranslationOverride holds the schema definition for tenant-wide terminology overrides
+type TranslationOverride struct {
+	ent.Schema
+}
+
+// Mixin of the TranslationOverride
+func (TranslationOverride) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (TranslationOverride) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("message_id").
+			NotEmpty().
+			Comment("Ключ сообщения локализации (например, error.file.not_found)"),
+		field.String("language").
+			NotEmpty().
+			MaxLen(10).
+			Comment("Язык переопределения"),
+		field.Text("text").
+			NotEmpty().
+			Comment("Текст, заменяющий базовый перевод"),
+	}
+}
+
+func (TranslationOverride) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (TranslationOverride) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "message_id", "language").
+			Unique(),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (TranslationOverride) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "translation_overrides"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
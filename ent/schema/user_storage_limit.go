@@ -0,0 +1,64 @@
+package schema
+
+import (
+	localmixin "main/ent/schema/mixin"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// UserStorageLimit holds the schema definition for per-user upload limits
+type UserStorageLimit struct {
+	ent.Schema
+}
+
+// Mixin of the UserStorageLimit
+func (UserStorageLimit) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		localmixin.TenantMixin{},
+		localmixin.TimeMixin{},
+	}
+}
+
+func (UserStorageLimit) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("Пользователь, загрузки которого ограничиваются"),
+		field.Int("max_files").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Максимальное количество файлов пользователя (NULL - без ограничения)"),
+		field.Int64("max_bytes").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Максимальный суммарный размер файлов пользователя в байтах (NULL - без ограничения)"),
+	}
+}
+
+func (UserStorageLimit) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (UserStorageLimit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id").
+			Unique(),
+	}
+}
+
+// Annotations defines GraphQL and database annotations
+func (UserStorageLimit) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "user_storage_limits"},
+		entgql.Skip(entgql.SkipAll),
+	}
+}
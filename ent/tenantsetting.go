@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"main/ent/schema/filemeta"
+	"main/ent/tenantsetting"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// TenantSetting is the model entity for the TenantSetting schema.
+type TenantSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID uuid.UUID `json:"tenant_id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Язык по умолчанию для пользователей тенанта без явного языка
+	DefaultLanguage string `json:"default_language,omitempty"`
+	// Состояние тенанта: SUSPENDED и OFFBOARDING переводят файлы в режим только для чтения
+	State tenantsetting.State `json:"state,omitempty"`
+	// Причина изменения состояния (например, задолженность)
+	StateReason string `json:"state_reason,omitempty"`
+	// Время последнего изменения состояния
+	StateChangedAt *time.Time `json:"state_changed_at,omitempty"`
+	// Режим квоты хранилища: HARD блокирует загрузку, SOFT только предупреждает, GRACE допускает превышение на quota_grace_percent
+	QuotaMode tenantsetting.QuotaMode `json:"quota_mode,omitempty"`
+	// Допустимое превышение лимита хранилища в процентах для режима GRACE
+	QuotaGracePercent int `json:"quota_grace_percent,omitempty"`
+	// Лимит хранилища тенанта в байтах, заданный оператором (null - S3_STORAGE_LIMIT_BYTES, больше него не действует)
+	StorageLimitBytes *int64 `json:"storage_limit_bytes,omitempty"`
+	// Срок хранения новых файлов в днях, если при загрузке не задан expires_at (null - без срока)
+	DefaultFileRetentionDays *int `json:"default_file_retention_days,omitempty"`
+	// Обработка одинаковых имен файлов одной сущности или папки: ALLOW разрешает дубли, WARN предупреждает, RENAME переименовывает
+	FilenameConflictMode tenantsetting.FilenameConflictMode `json:"filename_conflict_mode,omitempty"`
+	// Загрузка файла без описания отклоняется
+	RequireUploadDescription bool `json:"require_upload_description,omitempty"`
+	// Шаблон описания, которым клиент заполняет форму загрузки
+	UploadDescriptionTemplate *string `json:"upload_description_template,omitempty"`
+	// Справочник тегов классификации: если задан, каждый загружаемый файл должен иметь хотя бы один тег из него
+	UploadClassificationTags []string `json:"upload_classification_tags,omitempty"`
+	// Метаданные загружаемых изображений: KEEP сохраняет, STRIP удаляет EXIF и XMP (GPS, камера), STRIP_PRESERVE_ORIGINAL удаляет и сохраняет исходный EXIF в metadata файла
+	ImageMetadataMode tenantsetting.ImageMetadataMode `json:"image_metadata_mode,omitempty"`
+	// Ссылки на скачивание PDF всегда выдаются на копию с водяным знаком (email пользователя и время)
+	RequirePdfWatermark bool `json:"require_pdf_watermark,omitempty"`
+	// Максимальный размер загружаемого файла в байтах (null - MAX_UPLOAD_SIZE сервиса, больше него не действует)
+	MaxUploadSize *int64 `json:"max_upload_size,omitempty"`
+	// Разрешенные расширения файлов без точки; если заданы, загрузка файлов с другими расширениями отклоняется
+	UploadAllowedExtensions []string `json:"upload_allowed_extensions,omitempty"`
+	// Запрещенные расширения файлов без точки; имеют приоритет над разрешенными
+	UploadBlockedExtensions []string `json:"upload_blocked_extensions,omitempty"`
+	// Разрешенные типы содержимого (type/subtype или семейство type/*); если заданы, загрузка других типов отклоняется
+	UploadAllowedMimeTypes []string `json:"upload_allowed_mime_types,omitempty"`
+	// Запрещенные типы содержимого (type/subtype или семейство type/*); имеют приоритет над разрешенными
+	UploadBlockedMimeTypes []string `json:"upload_blocked_mime_types,omitempty"`
+	// Схемы metadata файлов (обязательные ключи и типы значений) по типам сущностей; проверяются при создании и изменении файла
+	FileMetadataSchemas []filemeta.Schema `json:"file_metadata_schemas,omitempty"`
+	selectValues        sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantsetting.FieldUploadClassificationTags, tenantsetting.FieldUploadAllowedExtensions, tenantsetting.FieldUploadBlockedExtensions, tenantsetting.FieldUploadAllowedMimeTypes, tenantsetting.FieldUploadBlockedMimeTypes, tenantsetting.FieldFileMetadataSchemas:
+			values[i] = new([]byte)
+		case tenantsetting.FieldRequireUploadDescription, tenantsetting.FieldRequirePdfWatermark:
+			values[i] = new(sql.NullBool)
+		case tenantsetting.FieldQuotaGracePercent, tenantsetting.FieldStorageLimitBytes, tenantsetting.FieldDefaultFileRetentionDays, tenantsetting.FieldMaxUploadSize:
+			values[i] = new(sql.NullInt64)
+		case tenantsetting.FieldDefaultLanguage, tenantsetting.FieldState, tenantsetting.FieldStateReason, tenantsetting.FieldQuotaMode, tenantsetting.FieldFilenameConflictMode, tenantsetting.FieldUploadDescriptionTemplate, tenantsetting.FieldImageMetadataMode:
+			values[i] = new(sql.NullString)
+		case tenantsetting.FieldCreateTime, tenantsetting.FieldUpdateTime, tenantsetting.FieldStateChangedAt:
+			values[i] = new(sql.NullTime)
+		case tenantsetting.FieldID, tenantsetting.FieldTenantID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantSetting fields.
+func (_m *TenantSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantsetting.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case tenantsetting.FieldTenantID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value != nil {
+				_m.TenantID = *value
+			}
+		case tenantsetting.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case tenantsetting.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case tenantsetting.FieldDefaultLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_language", values[i])
+			} else if value.Valid {
+				_m.DefaultLanguage = value.String
+			}
+		case tenantsetting.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = tenantsetting.State(value.String)
+			}
+		case tenantsetting.FieldStateReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state_reason", values[i])
+			} else if value.Valid {
+				_m.StateReason = value.String
+			}
+		case tenantsetting.FieldStateChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field state_changed_at", values[i])
+			} else if value.Valid {
+				_m.StateChangedAt = new(time.Time)
+				*_m.StateChangedAt = value.Time
+			}
+		case tenantsetting.FieldQuotaMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quota_mode", values[i])
+			} else if value.Valid {
+				_m.QuotaMode = tenantsetting.QuotaMode(value.String)
+			}
+		case tenantsetting.FieldQuotaGracePercent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quota_grace_percent", values[i])
+			} else if value.Valid {
+				_m.QuotaGracePercent = int(value.Int64)
+			}
+		case tenantsetting.FieldStorageLimitBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_limit_bytes", values[i])
+			} else if value.Valid {
+				_m.StorageLimitBytes = new(int64)
+				*_m.StorageLimitBytes = value.Int64
+			}
+		case tenantsetting.FieldDefaultFileRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_file_retention_days", values[i])
+			} else if value.Valid {
+				_m.DefaultFileRetentionDays = new(int)
+				*_m.DefaultFileRetentionDays = int(value.Int64)
+			}
+		case tenantsetting.FieldFilenameConflictMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field filename_conflict_mode", values[i])
+			} else if value.Valid {
+				_m.FilenameConflictMode = tenantsetting.FilenameConflictMode(value.String)
+			}
+		case tenantsetting.FieldRequireUploadDescription:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_upload_description", values[i])
+			} else if value.Valid {
+				_m.RequireUploadDescription = value.Bool
+			}
+		case tenantsetting.FieldUploadDescriptionTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_description_template", values[i])
+			} else if value.Valid {
+				_m.UploadDescriptionTemplate = new(string)
+				*_m.UploadDescriptionTemplate = value.String
+			}
+		case tenantsetting.FieldUploadClassificationTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_classification_tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UploadClassificationTags); err != nil {
+					return fmt.Errorf("unmarshal field upload_classification_tags: %w", err)
+				}
+			}
+		case tenantsetting.FieldImageMetadataMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image_metadata_mode", values[i])
+			} else if value.Valid {
+				_m.ImageMetadataMode = tenantsetting.ImageMetadataMode(value.String)
+			}
+		case tenantsetting.FieldRequirePdfWatermark:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_pdf_watermark", values[i])
+			} else if value.Valid {
+				_m.RequirePdfWatermark = value.Bool
+			}
+		case tenantsetting.FieldMaxUploadSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_upload_size", values[i])
+			} else if value.Valid {
+				_m.MaxUploadSize = new(int64)
+				*_m.MaxUploadSize = value.Int64
+			}
+		case tenantsetting.FieldUploadAllowedExtensions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_allowed_extensions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UploadAllowedExtensions); err != nil {
+					return fmt.Errorf("unmarshal field upload_allowed_extensions: %w", err)
+				}
+			}
+		case tenantsetting.FieldUploadBlockedExtensions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_blocked_extensions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UploadBlockedExtensions); err != nil {
+					return fmt.Errorf("unmarshal field upload_blocked_extensions: %w", err)
+				}
+			}
+		case tenantsetting.FieldUploadAllowedMimeTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_allowed_mime_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UploadAllowedMimeTypes); err != nil {
+					return fmt.Errorf("unmarshal field upload_allowed_mime_types: %w", err)
+				}
+			}
+		case tenantsetting.FieldUploadBlockedMimeTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field upload_blocked_mime_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.UploadBlockedMimeTypes); err != nil {
+					return fmt.Errorf("unmarshal field upload_blocked_mime_types: %w", err)
+				}
+			}
+		case tenantsetting.FieldFileMetadataSchemas:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field file_metadata_schemas", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FileMetadataSchemas); err != nil {
+					return fmt.Errorf("unmarshal field file_metadata_schemas: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantSetting.
+// Note that you need to call TenantSetting.Unwrap() before calling this method if this TenantSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantSetting) Update() *TenantSettingUpdateOne {
+	return NewTenantSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantSetting) Unwrap() *TenantSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("default_language=")
+	builder.WriteString(_m.DefaultLanguage)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", _m.State))
+	builder.WriteString(", ")
+	builder.WriteString("state_reason=")
+	builder.WriteString(_m.StateReason)
+	builder.WriteString(", ")
+	if v := _m.StateChangedAt; v != nil {
+		builder.WriteString("state_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("quota_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuotaMode))
+	builder.WriteString(", ")
+	builder.WriteString("quota_grace_percent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuotaGracePercent))
+	builder.WriteString(", ")
+	if v := _m.StorageLimitBytes; v != nil {
+		builder.WriteString("storage_limit_bytes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DefaultFileRetentionDays; v != nil {
+		builder.WriteString("default_file_retention_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("filename_conflict_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FilenameConflictMode))
+	builder.WriteString(", ")
+	builder.WriteString("require_upload_description=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireUploadDescription))
+	builder.WriteString(", ")
+	if v := _m.UploadDescriptionTemplate; v != nil {
+		builder.WriteString("upload_description_template=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("upload_classification_tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadClassificationTags))
+	builder.WriteString(", ")
+	builder.WriteString("image_metadata_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImageMetadataMode))
+	builder.WriteString(", ")
+	builder.WriteString("require_pdf_watermark=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequirePdfWatermark))
+	builder.WriteString(", ")
+	if v := _m.MaxUploadSize; v != nil {
+		builder.WriteString("max_upload_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("upload_allowed_extensions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadAllowedExtensions))
+	builder.WriteString(", ")
+	builder.WriteString("upload_blocked_extensions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadBlockedExtensions))
+	builder.WriteString(", ")
+	builder.WriteString("upload_allowed_mime_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadAllowedMimeTypes))
+	builder.WriteString(", ")
+	builder.WriteString("upload_blocked_mime_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UploadBlockedMimeTypes))
+	builder.WriteString(", ")
+	builder.WriteString("file_metadata_schemas=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FileMetadataSchemas))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantSettings is a parsable slice of TenantSetting.
+type TenantSettings []*TenantSetting
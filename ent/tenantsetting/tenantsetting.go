@@ -0,0 +1,416 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantsetting
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the tenantsetting type in the database.
+	Label = "tenant_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDefaultLanguage holds the string denoting the default_language field in the database.
+	FieldDefaultLanguage = "default_language"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldStateReason holds the string denoting the state_reason field in the database.
+	FieldStateReason = "state_reason"
+	// FieldStateChangedAt holds the string denoting the state_changed_at field in the database.
+	FieldStateChangedAt = "state_changed_at"
+	// FieldQuotaMode holds the string denoting the quota_mode field in the database.
+	FieldQuotaMode = "quota_mode"
+	// FieldQuotaGracePercent holds the string denoting the quota_grace_percent field in the database.
+	FieldQuotaGracePercent = "quota_grace_percent"
+	// FieldStorageLimitBytes holds the string denoting the storage_limit_bytes field in the database.
+	FieldStorageLimitBytes = "storage_limit_bytes"
+	// FieldDefaultFileRetentionDays holds the string denoting the default_file_retention_days field in the database.
+	FieldDefaultFileRetentionDays = "default_file_retention_days"
+	// FieldFilenameConflictMode holds the string denoting the filename_conflict_mode field in the database.
+	FieldFilenameConflictMode = "filename_conflict_mode"
+	// FieldRequireUploadDescription holds the string denoting the require_upload_description field in the database.
+	FieldRequireUploadDescription = "require_upload_description"
+	// FieldUploadDescriptionTemplate holds the string denoting the upload_description_template field in the database.
+	FieldUploadDescriptionTemplate = "upload_description_template"
+	// FieldUploadClassificationTags holds the string denoting the upload_classification_tags field in the database.
+	FieldUploadClassificationTags = "upload_classification_tags"
+	// FieldImageMetadataMode holds the string denoting the image_metadata_mode field in the database.
+	FieldImageMetadataMode = "image_metadata_mode"
+	// FieldRequirePdfWatermark holds the string denoting the require_pdf_watermark field in the database.
+	FieldRequirePdfWatermark = "require_pdf_watermark"
+	// FieldMaxUploadSize holds the string denoting the max_upload_size field in the database.
+	FieldMaxUploadSize = "max_upload_size"
+	// FieldUploadAllowedExtensions holds the string denoting the upload_allowed_extensions field in the database.
+	FieldUploadAllowedExtensions = "upload_allowed_extensions"
+	// FieldUploadBlockedExtensions holds the string denoting the upload_blocked_extensions field in the database.
+	FieldUploadBlockedExtensions = "upload_blocked_extensions"
+	// FieldUploadAllowedMimeTypes holds the string denoting the upload_allowed_mime_types field in the database.
+	FieldUploadAllowedMimeTypes = "upload_allowed_mime_types"
+	// FieldUploadBlockedMimeTypes holds the string denoting the upload_blocked_mime_types field in the database.
+	FieldUploadBlockedMimeTypes = "upload_blocked_mime_types"
+	// FieldFileMetadataSchemas holds the string denoting the file_metadata_schemas field in the database.
+	FieldFileMetadataSchemas = "file_metadata_schemas"
+	// Table holds the table name of the tenantsetting in the database.
+	Table = "tenant_settings"
+)
+
+// Columns holds all SQL columns for tenantsetting fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDefaultLanguage,
+	FieldState,
+	FieldStateReason,
+	FieldStateChangedAt,
+	FieldQuotaMode,
+	FieldQuotaGracePercent,
+	FieldStorageLimitBytes,
+	FieldDefaultFileRetentionDays,
+	FieldFilenameConflictMode,
+	FieldRequireUploadDescription,
+	FieldUploadDescriptionTemplate,
+	FieldUploadClassificationTags,
+	FieldImageMetadataMode,
+	FieldRequirePdfWatermark,
+	FieldMaxUploadSize,
+	FieldUploadAllowedExtensions,
+	FieldUploadBlockedExtensions,
+	FieldUploadAllowedMimeTypes,
+	FieldUploadBlockedMimeTypes,
+	FieldFileMetadataSchemas,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "main/ent/runtime"
+var (
+	Hooks        [2]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DefaultLanguageValidator is a validator for the "default_language" field. It is called by the builders before save.
+	DefaultLanguageValidator func(string) error
+	// StateReasonValidator is a validator for the "state_reason" field. It is called by the builders before save.
+	StateReasonValidator func(string) error
+	// DefaultQuotaGracePercent holds the default value on creation for the "quota_grace_percent" field.
+	DefaultQuotaGracePercent int
+	// QuotaGracePercentValidator is a validator for the "quota_grace_percent" field. It is called by the builders before save.
+	QuotaGracePercentValidator func(int) error
+	// StorageLimitBytesValidator is a validator for the "storage_limit_bytes" field. It is called by the builders before save.
+	StorageLimitBytesValidator func(int64) error
+	// DefaultFileRetentionDaysValidator is a validator for the "default_file_retention_days" field. It is called by the builders before save.
+	DefaultFileRetentionDaysValidator func(int) error
+	// DefaultRequireUploadDescription holds the default value on creation for the "require_upload_description" field.
+	DefaultRequireUploadDescription bool
+	// UploadDescriptionTemplateValidator is a validator for the "upload_description_template" field. It is called by the builders before save.
+	UploadDescriptionTemplateValidator func(string) error
+	// DefaultRequirePdfWatermark holds the default value on creation for the "require_pdf_watermark" field.
+	DefaultRequirePdfWatermark bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// State defines the type for the "state" enum field.
+type State string
+
+// StateACTIVE is the default value of the State enum.
+const DefaultState = StateACTIVE
+
+// State values.
+const (
+	StateACTIVE      State = "ACTIVE"
+	StateSUSPENDED   State = "SUSPENDED"
+	StateOFFBOARDING State = "OFFBOARDING"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StateACTIVE, StateSUSPENDED, StateOFFBOARDING:
+		return nil
+	default:
+		return fmt.Errorf("tenantsetting: invalid enum value for state field: %q", s)
+	}
+}
+
+// QuotaMode defines the type for the "quota_mode" enum field.
+type QuotaMode string
+
+// QuotaModeHARD is the default value of the QuotaMode enum.
+const DefaultQuotaMode = QuotaModeHARD
+
+// QuotaMode values.
+const (
+	QuotaModeHARD  QuotaMode = "HARD"
+	QuotaModeSOFT  QuotaMode = "SOFT"
+	QuotaModeGRACE QuotaMode = "GRACE"
+)
+
+func (qm QuotaMode) String() string {
+	return string(qm)
+}
+
+// QuotaModeValidator is a validator for the "quota_mode" field enum values. It is called by the builders before save.
+func QuotaModeValidator(qm QuotaMode) error {
+	switch qm {
+	case QuotaModeHARD, QuotaModeSOFT, QuotaModeGRACE:
+		return nil
+	default:
+		return fmt.Errorf("tenantsetting: invalid enum value for quota_mode field: %q", qm)
+	}
+}
+
+// FilenameConflictMode defines the type for the "filename_conflict_mode" enum field.
+type FilenameConflictMode string
+
+// FilenameConflictModeALLOW is the default value of the FilenameConflictMode enum.
+const DefaultFilenameConflictMode = FilenameConflictModeALLOW
+
+// FilenameConflictMode values.
+const (
+	FilenameConflictModeALLOW  FilenameConflictMode = "ALLOW"
+	FilenameConflictModeWARN   FilenameConflictMode = "WARN"
+	FilenameConflictModeRENAME FilenameConflictMode = "RENAME"
+)
+
+func (fcm FilenameConflictMode) String() string {
+	return string(fcm)
+}
+
+// FilenameConflictModeValidator is a validator for the "filename_conflict_mode" field enum values. It is called by the builders before save.
+func FilenameConflictModeValidator(fcm FilenameConflictMode) error {
+	switch fcm {
+	case FilenameConflictModeALLOW, FilenameConflictModeWARN, FilenameConflictModeRENAME:
+		return nil
+	default:
+		return fmt.Errorf("tenantsetting: invalid enum value for filename_conflict_mode field: %q", fcm)
+	}
+}
+
+// ImageMetadataMode defines the type for the "image_metadata_mode" enum field.
+type ImageMetadataMode string
+
+// ImageMetadataModeKEEP is the default value of the ImageMetadataMode enum.
+const DefaultImageMetadataMode = ImageMetadataModeKEEP
+
+// ImageMetadataMode values.
+const (
+	ImageMetadataModeKEEP                    ImageMetadataMode = "KEEP"
+	ImageMetadataModeSTRIP                   ImageMetadataMode = "STRIP"
+	ImageMetadataModeSTRIP_PRESERVE_ORIGINAL ImageMetadataMode = "STRIP_PRESERVE_ORIGINAL"
+)
+
+func (imm ImageMetadataMode) String() string {
+	return string(imm)
+}
+
+// ImageMetadataModeValidator is a validator for the "image_metadata_mode" field enum values. It is called by the builders before save.
+func ImageMetadataModeValidator(imm ImageMetadataMode) error {
+	switch imm {
+	case ImageMetadataModeKEEP, ImageMetadataModeSTRIP, ImageMetadataModeSTRIP_PRESERVE_ORIGINAL:
+		return nil
+	default:
+		return fmt.Errorf("tenantsetting: invalid enum value for image_metadata_mode field: %q", imm)
+	}
+}
+
+// OrderOption defines the ordering options for the TenantSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDefaultLanguage orders the results by the default_language field.
+func ByDefaultLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultLanguage, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByStateReason orders the results by the state_reason field.
+func ByStateReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStateReason, opts...).ToFunc()
+}
+
+// ByStateChangedAt orders the results by the state_changed_at field.
+func ByStateChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStateChangedAt, opts...).ToFunc()
+}
+
+// ByQuotaMode orders the results by the quota_mode field.
+func ByQuotaMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuotaMode, opts...).ToFunc()
+}
+
+// ByQuotaGracePercent orders the results by the quota_grace_percent field.
+func ByQuotaGracePercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuotaGracePercent, opts...).ToFunc()
+}
+
+// ByStorageLimitBytes orders the results by the storage_limit_bytes field.
+func ByStorageLimitBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageLimitBytes, opts...).ToFunc()
+}
+
+// ByDefaultFileRetentionDays orders the results by the default_file_retention_days field.
+func ByDefaultFileRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultFileRetentionDays, opts...).ToFunc()
+}
+
+// ByFilenameConflictMode orders the results by the filename_conflict_mode field.
+func ByFilenameConflictMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilenameConflictMode, opts...).ToFunc()
+}
+
+// ByRequireUploadDescription orders the results by the require_upload_description field.
+func ByRequireUploadDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireUploadDescription, opts...).ToFunc()
+}
+
+// ByUploadDescriptionTemplate orders the results by the upload_description_template field.
+func ByUploadDescriptionTemplate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadDescriptionTemplate, opts...).ToFunc()
+}
+
+// ByImageMetadataMode orders the results by the image_metadata_mode field.
+func ByImageMetadataMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageMetadataMode, opts...).ToFunc()
+}
+
+// ByRequirePdfWatermark orders the results by the require_pdf_watermark field.
+func ByRequirePdfWatermark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequirePdfWatermark, opts...).ToFunc()
+}
+
+// ByMaxUploadSize orders the results by the max_upload_size field.
+func ByMaxUploadSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxUploadSize, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e State) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *State) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = State(str)
+	if err := StateValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid State", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e QuotaMode) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *QuotaMode) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = QuotaMode(str)
+	if err := QuotaModeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid QuotaMode", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e FilenameConflictMode) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *FilenameConflictMode) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = FilenameConflictMode(str)
+	if err := FilenameConflictModeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid FilenameConflictMode", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e ImageMetadataMode) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *ImageMetadataMode) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = ImageMetadataMode(str)
+	if err := ImageMetadataModeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid ImageMetadataMode", str)
+	}
+	return nil
+}
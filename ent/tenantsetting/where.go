@@ -0,0 +1,881 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantsetting
+
+import (
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DefaultLanguage applies equality check predicate on the "default_language" field. It's identical to DefaultLanguageEQ.
+func DefaultLanguage(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldDefaultLanguage, v))
+}
+
+// StateReason applies equality check predicate on the "state_reason" field. It's identical to StateReasonEQ.
+func StateReason(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldStateReason, v))
+}
+
+// StateChangedAt applies equality check predicate on the "state_changed_at" field. It's identical to StateChangedAtEQ.
+func StateChangedAt(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldStateChangedAt, v))
+}
+
+// QuotaGracePercent applies equality check predicate on the "quota_grace_percent" field. It's identical to QuotaGracePercentEQ.
+func QuotaGracePercent(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldQuotaGracePercent, v))
+}
+
+// StorageLimitBytes applies equality check predicate on the "storage_limit_bytes" field. It's identical to StorageLimitBytesEQ.
+func StorageLimitBytes(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldStorageLimitBytes, v))
+}
+
+// DefaultFileRetentionDays applies equality check predicate on the "default_file_retention_days" field. It's identical to DefaultFileRetentionDaysEQ.
+func DefaultFileRetentionDays(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldDefaultFileRetentionDays, v))
+}
+
+// RequireUploadDescription applies equality check predicate on the "require_upload_description" field. It's identical to RequireUploadDescriptionEQ.
+func RequireUploadDescription(v bool) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldRequireUploadDescription, v))
+}
+
+// UploadDescriptionTemplate applies equality check predicate on the "upload_description_template" field. It's identical to UploadDescriptionTemplateEQ.
+func UploadDescriptionTemplate(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldUploadDescriptionTemplate, v))
+}
+
+// RequirePdfWatermark applies equality check predicate on the "require_pdf_watermark" field. It's identical to RequirePdfWatermarkEQ.
+func RequirePdfWatermark(v bool) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldRequirePdfWatermark, v))
+}
+
+// MaxUploadSize applies equality check predicate on the "max_upload_size" field. It's identical to MaxUploadSizeEQ.
+func MaxUploadSize(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldMaxUploadSize, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// DefaultLanguageEQ applies the EQ predicate on the "default_language" field.
+func DefaultLanguageEQ(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageNEQ applies the NEQ predicate on the "default_language" field.
+func DefaultLanguageNEQ(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageIn applies the In predicate on the "default_language" field.
+func DefaultLanguageIn(vs ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldDefaultLanguage, vs...))
+}
+
+// DefaultLanguageNotIn applies the NotIn predicate on the "default_language" field.
+func DefaultLanguageNotIn(vs ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldDefaultLanguage, vs...))
+}
+
+// DefaultLanguageGT applies the GT predicate on the "default_language" field.
+func DefaultLanguageGT(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageGTE applies the GTE predicate on the "default_language" field.
+func DefaultLanguageGTE(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageLT applies the LT predicate on the "default_language" field.
+func DefaultLanguageLT(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageLTE applies the LTE predicate on the "default_language" field.
+func DefaultLanguageLTE(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageContains applies the Contains predicate on the "default_language" field.
+func DefaultLanguageContains(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldContains(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageHasPrefix applies the HasPrefix predicate on the "default_language" field.
+func DefaultLanguageHasPrefix(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldHasPrefix(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageHasSuffix applies the HasSuffix predicate on the "default_language" field.
+func DefaultLanguageHasSuffix(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldHasSuffix(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageIsNil applies the IsNil predicate on the "default_language" field.
+func DefaultLanguageIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldDefaultLanguage))
+}
+
+// DefaultLanguageNotNil applies the NotNil predicate on the "default_language" field.
+func DefaultLanguageNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldDefaultLanguage))
+}
+
+// DefaultLanguageEqualFold applies the EqualFold predicate on the "default_language" field.
+func DefaultLanguageEqualFold(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEqualFold(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageContainsFold applies the ContainsFold predicate on the "default_language" field.
+func DefaultLanguageContainsFold(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldContainsFold(FieldDefaultLanguage, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateReasonEQ applies the EQ predicate on the "state_reason" field.
+func StateReasonEQ(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldStateReason, v))
+}
+
+// StateReasonNEQ applies the NEQ predicate on the "state_reason" field.
+func StateReasonNEQ(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldStateReason, v))
+}
+
+// StateReasonIn applies the In predicate on the "state_reason" field.
+func StateReasonIn(vs ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldStateReason, vs...))
+}
+
+// StateReasonNotIn applies the NotIn predicate on the "state_reason" field.
+func StateReasonNotIn(vs ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldStateReason, vs...))
+}
+
+// StateReasonGT applies the GT predicate on the "state_reason" field.
+func StateReasonGT(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldStateReason, v))
+}
+
+// StateReasonGTE applies the GTE predicate on the "state_reason" field.
+func StateReasonGTE(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldStateReason, v))
+}
+
+// StateReasonLT applies the LT predicate on the "state_reason" field.
+func StateReasonLT(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldStateReason, v))
+}
+
+// StateReasonLTE applies the LTE predicate on the "state_reason" field.
+func StateReasonLTE(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldStateReason, v))
+}
+
+// StateReasonContains applies the Contains predicate on the "state_reason" field.
+func StateReasonContains(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldContains(FieldStateReason, v))
+}
+
+// StateReasonHasPrefix applies the HasPrefix predicate on the "state_reason" field.
+func StateReasonHasPrefix(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldHasPrefix(FieldStateReason, v))
+}
+
+// StateReasonHasSuffix applies the HasSuffix predicate on the "state_reason" field.
+func StateReasonHasSuffix(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldHasSuffix(FieldStateReason, v))
+}
+
+// StateReasonIsNil applies the IsNil predicate on the "state_reason" field.
+func StateReasonIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldStateReason))
+}
+
+// StateReasonNotNil applies the NotNil predicate on the "state_reason" field.
+func StateReasonNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldStateReason))
+}
+
+// StateReasonEqualFold applies the EqualFold predicate on the "state_reason" field.
+func StateReasonEqualFold(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEqualFold(FieldStateReason, v))
+}
+
+// StateReasonContainsFold applies the ContainsFold predicate on the "state_reason" field.
+func StateReasonContainsFold(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldContainsFold(FieldStateReason, v))
+}
+
+// StateChangedAtEQ applies the EQ predicate on the "state_changed_at" field.
+func StateChangedAtEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldStateChangedAt, v))
+}
+
+// StateChangedAtNEQ applies the NEQ predicate on the "state_changed_at" field.
+func StateChangedAtNEQ(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldStateChangedAt, v))
+}
+
+// StateChangedAtIn applies the In predicate on the "state_changed_at" field.
+func StateChangedAtIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldStateChangedAt, vs...))
+}
+
+// StateChangedAtNotIn applies the NotIn predicate on the "state_changed_at" field.
+func StateChangedAtNotIn(vs ...time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldStateChangedAt, vs...))
+}
+
+// StateChangedAtGT applies the GT predicate on the "state_changed_at" field.
+func StateChangedAtGT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldStateChangedAt, v))
+}
+
+// StateChangedAtGTE applies the GTE predicate on the "state_changed_at" field.
+func StateChangedAtGTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldStateChangedAt, v))
+}
+
+// StateChangedAtLT applies the LT predicate on the "state_changed_at" field.
+func StateChangedAtLT(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldStateChangedAt, v))
+}
+
+// StateChangedAtLTE applies the LTE predicate on the "state_changed_at" field.
+func StateChangedAtLTE(v time.Time) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldStateChangedAt, v))
+}
+
+// StateChangedAtIsNil applies the IsNil predicate on the "state_changed_at" field.
+func StateChangedAtIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldStateChangedAt))
+}
+
+// StateChangedAtNotNil applies the NotNil predicate on the "state_changed_at" field.
+func StateChangedAtNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldStateChangedAt))
+}
+
+// QuotaModeEQ applies the EQ predicate on the "quota_mode" field.
+func QuotaModeEQ(v QuotaMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldQuotaMode, v))
+}
+
+// QuotaModeNEQ applies the NEQ predicate on the "quota_mode" field.
+func QuotaModeNEQ(v QuotaMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldQuotaMode, v))
+}
+
+// QuotaModeIn applies the In predicate on the "quota_mode" field.
+func QuotaModeIn(vs ...QuotaMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldQuotaMode, vs...))
+}
+
+// QuotaModeNotIn applies the NotIn predicate on the "quota_mode" field.
+func QuotaModeNotIn(vs ...QuotaMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldQuotaMode, vs...))
+}
+
+// QuotaGracePercentEQ applies the EQ predicate on the "quota_grace_percent" field.
+func QuotaGracePercentEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldQuotaGracePercent, v))
+}
+
+// QuotaGracePercentNEQ applies the NEQ predicate on the "quota_grace_percent" field.
+func QuotaGracePercentNEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldQuotaGracePercent, v))
+}
+
+// QuotaGracePercentIn applies the In predicate on the "quota_grace_percent" field.
+func QuotaGracePercentIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldQuotaGracePercent, vs...))
+}
+
+// QuotaGracePercentNotIn applies the NotIn predicate on the "quota_grace_percent" field.
+func QuotaGracePercentNotIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldQuotaGracePercent, vs...))
+}
+
+// QuotaGracePercentGT applies the GT predicate on the "quota_grace_percent" field.
+func QuotaGracePercentGT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldQuotaGracePercent, v))
+}
+
+// QuotaGracePercentGTE applies the GTE predicate on the "quota_grace_percent" field.
+func QuotaGracePercentGTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldQuotaGracePercent, v))
+}
+
+// QuotaGracePercentLT applies the LT predicate on the "quota_grace_percent" field.
+func QuotaGracePercentLT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldQuotaGracePercent, v))
+}
+
+// QuotaGracePercentLTE applies the LTE predicate on the "quota_grace_percent" field.
+func QuotaGracePercentLTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldQuotaGracePercent, v))
+}
+
+// StorageLimitBytesEQ applies the EQ predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesEQ(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldStorageLimitBytes, v))
+}
+
+// StorageLimitBytesNEQ applies the NEQ predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesNEQ(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldStorageLimitBytes, v))
+}
+
+// StorageLimitBytesIn applies the In predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesIn(vs ...int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldStorageLimitBytes, vs...))
+}
+
+// StorageLimitBytesNotIn applies the NotIn predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesNotIn(vs ...int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldStorageLimitBytes, vs...))
+}
+
+// StorageLimitBytesGT applies the GT predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesGT(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldStorageLimitBytes, v))
+}
+
+// StorageLimitBytesGTE applies the GTE predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesGTE(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldStorageLimitBytes, v))
+}
+
+// StorageLimitBytesLT applies the LT predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesLT(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldStorageLimitBytes, v))
+}
+
+// StorageLimitBytesLTE applies the LTE predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesLTE(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldStorageLimitBytes, v))
+}
+
+// StorageLimitBytesIsNil applies the IsNil predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldStorageLimitBytes))
+}
+
+// StorageLimitBytesNotNil applies the NotNil predicate on the "storage_limit_bytes" field.
+func StorageLimitBytesNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldStorageLimitBytes))
+}
+
+// DefaultFileRetentionDaysEQ applies the EQ predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldDefaultFileRetentionDays, v))
+}
+
+// DefaultFileRetentionDaysNEQ applies the NEQ predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysNEQ(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldDefaultFileRetentionDays, v))
+}
+
+// DefaultFileRetentionDaysIn applies the In predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldDefaultFileRetentionDays, vs...))
+}
+
+// DefaultFileRetentionDaysNotIn applies the NotIn predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysNotIn(vs ...int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldDefaultFileRetentionDays, vs...))
+}
+
+// DefaultFileRetentionDaysGT applies the GT predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysGT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldDefaultFileRetentionDays, v))
+}
+
+// DefaultFileRetentionDaysGTE applies the GTE predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysGTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldDefaultFileRetentionDays, v))
+}
+
+// DefaultFileRetentionDaysLT applies the LT predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysLT(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldDefaultFileRetentionDays, v))
+}
+
+// DefaultFileRetentionDaysLTE applies the LTE predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysLTE(v int) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldDefaultFileRetentionDays, v))
+}
+
+// DefaultFileRetentionDaysIsNil applies the IsNil predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldDefaultFileRetentionDays))
+}
+
+// DefaultFileRetentionDaysNotNil applies the NotNil predicate on the "default_file_retention_days" field.
+func DefaultFileRetentionDaysNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldDefaultFileRetentionDays))
+}
+
+// FilenameConflictModeEQ applies the EQ predicate on the "filename_conflict_mode" field.
+func FilenameConflictModeEQ(v FilenameConflictMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldFilenameConflictMode, v))
+}
+
+// FilenameConflictModeNEQ applies the NEQ predicate on the "filename_conflict_mode" field.
+func FilenameConflictModeNEQ(v FilenameConflictMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldFilenameConflictMode, v))
+}
+
+// FilenameConflictModeIn applies the In predicate on the "filename_conflict_mode" field.
+func FilenameConflictModeIn(vs ...FilenameConflictMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldFilenameConflictMode, vs...))
+}
+
+// FilenameConflictModeNotIn applies the NotIn predicate on the "filename_conflict_mode" field.
+func FilenameConflictModeNotIn(vs ...FilenameConflictMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldFilenameConflictMode, vs...))
+}
+
+// RequireUploadDescriptionEQ applies the EQ predicate on the "require_upload_description" field.
+func RequireUploadDescriptionEQ(v bool) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldRequireUploadDescription, v))
+}
+
+// RequireUploadDescriptionNEQ applies the NEQ predicate on the "require_upload_description" field.
+func RequireUploadDescriptionNEQ(v bool) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldRequireUploadDescription, v))
+}
+
+// UploadDescriptionTemplateEQ applies the EQ predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateEQ(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateNEQ applies the NEQ predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateNEQ(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateIn applies the In predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateIn(vs ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldUploadDescriptionTemplate, vs...))
+}
+
+// UploadDescriptionTemplateNotIn applies the NotIn predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateNotIn(vs ...string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldUploadDescriptionTemplate, vs...))
+}
+
+// UploadDescriptionTemplateGT applies the GT predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateGT(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateGTE applies the GTE predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateGTE(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateLT applies the LT predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateLT(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateLTE applies the LTE predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateLTE(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateContains applies the Contains predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateContains(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldContains(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateHasPrefix applies the HasPrefix predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateHasPrefix(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldHasPrefix(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateHasSuffix applies the HasSuffix predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateHasSuffix(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldHasSuffix(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateIsNil applies the IsNil predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldUploadDescriptionTemplate))
+}
+
+// UploadDescriptionTemplateNotNil applies the NotNil predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldUploadDescriptionTemplate))
+}
+
+// UploadDescriptionTemplateEqualFold applies the EqualFold predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateEqualFold(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEqualFold(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadDescriptionTemplateContainsFold applies the ContainsFold predicate on the "upload_description_template" field.
+func UploadDescriptionTemplateContainsFold(v string) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldContainsFold(FieldUploadDescriptionTemplate, v))
+}
+
+// UploadClassificationTagsIsNil applies the IsNil predicate on the "upload_classification_tags" field.
+func UploadClassificationTagsIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldUploadClassificationTags))
+}
+
+// UploadClassificationTagsNotNil applies the NotNil predicate on the "upload_classification_tags" field.
+func UploadClassificationTagsNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldUploadClassificationTags))
+}
+
+// ImageMetadataModeEQ applies the EQ predicate on the "image_metadata_mode" field.
+func ImageMetadataModeEQ(v ImageMetadataMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldImageMetadataMode, v))
+}
+
+// ImageMetadataModeNEQ applies the NEQ predicate on the "image_metadata_mode" field.
+func ImageMetadataModeNEQ(v ImageMetadataMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldImageMetadataMode, v))
+}
+
+// ImageMetadataModeIn applies the In predicate on the "image_metadata_mode" field.
+func ImageMetadataModeIn(vs ...ImageMetadataMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldImageMetadataMode, vs...))
+}
+
+// ImageMetadataModeNotIn applies the NotIn predicate on the "image_metadata_mode" field.
+func ImageMetadataModeNotIn(vs ...ImageMetadataMode) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldImageMetadataMode, vs...))
+}
+
+// RequirePdfWatermarkEQ applies the EQ predicate on the "require_pdf_watermark" field.
+func RequirePdfWatermarkEQ(v bool) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldRequirePdfWatermark, v))
+}
+
+// RequirePdfWatermarkNEQ applies the NEQ predicate on the "require_pdf_watermark" field.
+func RequirePdfWatermarkNEQ(v bool) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldRequirePdfWatermark, v))
+}
+
+// MaxUploadSizeEQ applies the EQ predicate on the "max_upload_size" field.
+func MaxUploadSizeEQ(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldEQ(FieldMaxUploadSize, v))
+}
+
+// MaxUploadSizeNEQ applies the NEQ predicate on the "max_upload_size" field.
+func MaxUploadSizeNEQ(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNEQ(FieldMaxUploadSize, v))
+}
+
+// MaxUploadSizeIn applies the In predicate on the "max_upload_size" field.
+func MaxUploadSizeIn(vs ...int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIn(FieldMaxUploadSize, vs...))
+}
+
+// MaxUploadSizeNotIn applies the NotIn predicate on the "max_upload_size" field.
+func MaxUploadSizeNotIn(vs ...int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotIn(FieldMaxUploadSize, vs...))
+}
+
+// MaxUploadSizeGT applies the GT predicate on the "max_upload_size" field.
+func MaxUploadSizeGT(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGT(FieldMaxUploadSize, v))
+}
+
+// MaxUploadSizeGTE applies the GTE predicate on the "max_upload_size" field.
+func MaxUploadSizeGTE(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldGTE(FieldMaxUploadSize, v))
+}
+
+// MaxUploadSizeLT applies the LT predicate on the "max_upload_size" field.
+func MaxUploadSizeLT(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLT(FieldMaxUploadSize, v))
+}
+
+// MaxUploadSizeLTE applies the LTE predicate on the "max_upload_size" field.
+func MaxUploadSizeLTE(v int64) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldLTE(FieldMaxUploadSize, v))
+}
+
+// MaxUploadSizeIsNil applies the IsNil predicate on the "max_upload_size" field.
+func MaxUploadSizeIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldMaxUploadSize))
+}
+
+// MaxUploadSizeNotNil applies the NotNil predicate on the "max_upload_size" field.
+func MaxUploadSizeNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldMaxUploadSize))
+}
+
+// UploadAllowedExtensionsIsNil applies the IsNil predicate on the "upload_allowed_extensions" field.
+func UploadAllowedExtensionsIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldUploadAllowedExtensions))
+}
+
+// UploadAllowedExtensionsNotNil applies the NotNil predicate on the "upload_allowed_extensions" field.
+func UploadAllowedExtensionsNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldUploadAllowedExtensions))
+}
+
+// UploadBlockedExtensionsIsNil applies the IsNil predicate on the "upload_blocked_extensions" field.
+func UploadBlockedExtensionsIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldUploadBlockedExtensions))
+}
+
+// UploadBlockedExtensionsNotNil applies the NotNil predicate on the "upload_blocked_extensions" field.
+func UploadBlockedExtensionsNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldUploadBlockedExtensions))
+}
+
+// UploadAllowedMimeTypesIsNil applies the IsNil predicate on the "upload_allowed_mime_types" field.
+func UploadAllowedMimeTypesIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldUploadAllowedMimeTypes))
+}
+
+// UploadAllowedMimeTypesNotNil applies the NotNil predicate on the "upload_allowed_mime_types" field.
+func UploadAllowedMimeTypesNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldUploadAllowedMimeTypes))
+}
+
+// UploadBlockedMimeTypesIsNil applies the IsNil predicate on the "upload_blocked_mime_types" field.
+func UploadBlockedMimeTypesIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldUploadBlockedMimeTypes))
+}
+
+// UploadBlockedMimeTypesNotNil applies the NotNil predicate on the "upload_blocked_mime_types" field.
+func UploadBlockedMimeTypesNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldUploadBlockedMimeTypes))
+}
+
+// FileMetadataSchemasIsNil applies the IsNil predicate on the "file_metadata_schemas" field.
+func FileMetadataSchemasIsNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldIsNull(FieldFileMetadataSchemas))
+}
+
+// FileMetadataSchemasNotNil applies the NotNil predicate on the "file_metadata_schemas" field.
+func FileMetadataSchemasNotNil() predicate.TenantSetting {
+	return predicate.TenantSetting(sql.FieldNotNull(FieldFileMetadataSchemas))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantSetting) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantSetting) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantSetting) predicate.TenantSetting {
+	return predicate.TenantSetting(sql.NotPredicates(p))
+}
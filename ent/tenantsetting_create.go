@@ -0,0 +1,688 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/schema/filemeta"
+	"main/ent/tenantsetting"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TenantSettingCreate is the builder for creating a TenantSetting entity.
+type TenantSettingCreate struct {
+	config
+	mutation *TenantSettingMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantSettingCreate) SetTenantID(v uuid.UUID) *TenantSettingCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TenantSettingCreate) SetCreateTime(v time.Time) *TenantSettingCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableCreateTime(v *time.Time) *TenantSettingCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TenantSettingCreate) SetUpdateTime(v time.Time) *TenantSettingCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableUpdateTime(v *time.Time) *TenantSettingCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (_c *TenantSettingCreate) SetDefaultLanguage(v string) *TenantSettingCreate {
+	_c.mutation.SetDefaultLanguage(v)
+	return _c
+}
+
+// SetNillableDefaultLanguage sets the "default_language" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableDefaultLanguage(v *string) *TenantSettingCreate {
+	if v != nil {
+		_c.SetDefaultLanguage(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *TenantSettingCreate) SetState(v tenantsetting.State) *TenantSettingCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableState(v *tenantsetting.State) *TenantSettingCreate {
+	if v != nil {
+		_c.SetState(*v)
+	}
+	return _c
+}
+
+// SetStateReason sets the "state_reason" field.
+func (_c *TenantSettingCreate) SetStateReason(v string) *TenantSettingCreate {
+	_c.mutation.SetStateReason(v)
+	return _c
+}
+
+// SetNillableStateReason sets the "state_reason" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableStateReason(v *string) *TenantSettingCreate {
+	if v != nil {
+		_c.SetStateReason(*v)
+	}
+	return _c
+}
+
+// SetStateChangedAt sets the "state_changed_at" field.
+func (_c *TenantSettingCreate) SetStateChangedAt(v time.Time) *TenantSettingCreate {
+	_c.mutation.SetStateChangedAt(v)
+	return _c
+}
+
+// SetNillableStateChangedAt sets the "state_changed_at" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableStateChangedAt(v *time.Time) *TenantSettingCreate {
+	if v != nil {
+		_c.SetStateChangedAt(*v)
+	}
+	return _c
+}
+
+// SetQuotaMode sets the "quota_mode" field.
+func (_c *TenantSettingCreate) SetQuotaMode(v tenantsetting.QuotaMode) *TenantSettingCreate {
+	_c.mutation.SetQuotaMode(v)
+	return _c
+}
+
+// SetNillableQuotaMode sets the "quota_mode" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableQuotaMode(v *tenantsetting.QuotaMode) *TenantSettingCreate {
+	if v != nil {
+		_c.SetQuotaMode(*v)
+	}
+	return _c
+}
+
+// SetQuotaGracePercent sets the "quota_grace_percent" field.
+func (_c *TenantSettingCreate) SetQuotaGracePercent(v int) *TenantSettingCreate {
+	_c.mutation.SetQuotaGracePercent(v)
+	return _c
+}
+
+// SetNillableQuotaGracePercent sets the "quota_grace_percent" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableQuotaGracePercent(v *int) *TenantSettingCreate {
+	if v != nil {
+		_c.SetQuotaGracePercent(*v)
+	}
+	return _c
+}
+
+// SetStorageLimitBytes sets the "storage_limit_bytes" field.
+func (_c *TenantSettingCreate) SetStorageLimitBytes(v int64) *TenantSettingCreate {
+	_c.mutation.SetStorageLimitBytes(v)
+	return _c
+}
+
+// SetNillableStorageLimitBytes sets the "storage_limit_bytes" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableStorageLimitBytes(v *int64) *TenantSettingCreate {
+	if v != nil {
+		_c.SetStorageLimitBytes(*v)
+	}
+	return _c
+}
+
+// SetDefaultFileRetentionDays sets the "default_file_retention_days" field.
+func (_c *TenantSettingCreate) SetDefaultFileRetentionDays(v int) *TenantSettingCreate {
+	_c.mutation.SetDefaultFileRetentionDays(v)
+	return _c
+}
+
+// SetNillableDefaultFileRetentionDays sets the "default_file_retention_days" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableDefaultFileRetentionDays(v *int) *TenantSettingCreate {
+	if v != nil {
+		_c.SetDefaultFileRetentionDays(*v)
+	}
+	return _c
+}
+
+// SetFilenameConflictMode sets the "filename_conflict_mode" field.
+func (_c *TenantSettingCreate) SetFilenameConflictMode(v tenantsetting.FilenameConflictMode) *TenantSettingCreate {
+	_c.mutation.SetFilenameConflictMode(v)
+	return _c
+}
+
+// SetNillableFilenameConflictMode sets the "filename_conflict_mode" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableFilenameConflictMode(v *tenantsetting.FilenameConflictMode) *TenantSettingCreate {
+	if v != nil {
+		_c.SetFilenameConflictMode(*v)
+	}
+	return _c
+}
+
+// SetRequireUploadDescription sets the "require_upload_description" field.
+func (_c *TenantSettingCreate) SetRequireUploadDescription(v bool) *TenantSettingCreate {
+	_c.mutation.SetRequireUploadDescription(v)
+	return _c
+}
+
+// SetNillableRequireUploadDescription sets the "require_upload_description" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableRequireUploadDescription(v *bool) *TenantSettingCreate {
+	if v != nil {
+		_c.SetRequireUploadDescription(*v)
+	}
+	return _c
+}
+
+// SetUploadDescriptionTemplate sets the "upload_description_template" field.
+func (_c *TenantSettingCreate) SetUploadDescriptionTemplate(v string) *TenantSettingCreate {
+	_c.mutation.SetUploadDescriptionTemplate(v)
+	return _c
+}
+
+// SetNillableUploadDescriptionTemplate sets the "upload_description_template" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableUploadDescriptionTemplate(v *string) *TenantSettingCreate {
+	if v != nil {
+		_c.SetUploadDescriptionTemplate(*v)
+	}
+	return _c
+}
+
+// SetUploadClassificationTags sets the "upload_classification_tags" field.
+func (_c *TenantSettingCreate) SetUploadClassificationTags(v []string) *TenantSettingCreate {
+	_c.mutation.SetUploadClassificationTags(v)
+	return _c
+}
+
+// SetImageMetadataMode sets the "image_metadata_mode" field.
+func (_c *TenantSettingCreate) SetImageMetadataMode(v tenantsetting.ImageMetadataMode) *TenantSettingCreate {
+	_c.mutation.SetImageMetadataMode(v)
+	return _c
+}
+
+// SetNillableImageMetadataMode sets the "image_metadata_mode" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableImageMetadataMode(v *tenantsetting.ImageMetadataMode) *TenantSettingCreate {
+	if v != nil {
+		_c.SetImageMetadataMode(*v)
+	}
+	return _c
+}
+
+// SetRequirePdfWatermark sets the "require_pdf_watermark" field.
+func (_c *TenantSettingCreate) SetRequirePdfWatermark(v bool) *TenantSettingCreate {
+	_c.mutation.SetRequirePdfWatermark(v)
+	return _c
+}
+
+// SetNillableRequirePdfWatermark sets the "require_pdf_watermark" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableRequirePdfWatermark(v *bool) *TenantSettingCreate {
+	if v != nil {
+		_c.SetRequirePdfWatermark(*v)
+	}
+	return _c
+}
+
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (_c *TenantSettingCreate) SetMaxUploadSize(v int64) *TenantSettingCreate {
+	_c.mutation.SetMaxUploadSize(v)
+	return _c
+}
+
+// SetNillableMaxUploadSize sets the "max_upload_size" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableMaxUploadSize(v *int64) *TenantSettingCreate {
+	if v != nil {
+		_c.SetMaxUploadSize(*v)
+	}
+	return _c
+}
+
+// SetUploadAllowedExtensions sets the "upload_allowed_extensions" field.
+func (_c *TenantSettingCreate) SetUploadAllowedExtensions(v []string) *TenantSettingCreate {
+	_c.mutation.SetUploadAllowedExtensions(v)
+	return _c
+}
+
+// SetUploadBlockedExtensions sets the "upload_blocked_extensions" field.
+func (_c *TenantSettingCreate) SetUploadBlockedExtensions(v []string) *TenantSettingCreate {
+	_c.mutation.SetUploadBlockedExtensions(v)
+	return _c
+}
+
+// SetUploadAllowedMimeTypes sets the "upload_allowed_mime_types" field.
+func (_c *TenantSettingCreate) SetUploadAllowedMimeTypes(v []string) *TenantSettingCreate {
+	_c.mutation.SetUploadAllowedMimeTypes(v)
+	return _c
+}
+
+// SetUploadBlockedMimeTypes sets the "upload_blocked_mime_types" field.
+func (_c *TenantSettingCreate) SetUploadBlockedMimeTypes(v []string) *TenantSettingCreate {
+	_c.mutation.SetUploadBlockedMimeTypes(v)
+	return _c
+}
+
+// SetFileMetadataSchemas sets the "file_metadata_schemas" field.
+func (_c *TenantSettingCreate) SetFileMetadataSchemas(v []filemeta.Schema) *TenantSettingCreate {
+	_c.mutation.SetFileMetadataSchemas(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantSettingCreate) SetID(v uuid.UUID) *TenantSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TenantSettingCreate) SetNillableID(v *uuid.UUID) *TenantSettingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (_c *TenantSettingCreate) Mutation() *TenantSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantSetting in the database.
+func (_c *TenantSettingCreate) Save(ctx context.Context) (*TenantSetting, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantSettingCreate) SaveX(ctx context.Context) *TenantSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantSettingCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if tenantsetting.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenantsetting.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := tenantsetting.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if tenantsetting.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenantsetting.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenantsetting.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		v := tenantsetting.DefaultState
+		_c.mutation.SetState(v)
+	}
+	if _, ok := _c.mutation.QuotaMode(); !ok {
+		v := tenantsetting.DefaultQuotaMode
+		_c.mutation.SetQuotaMode(v)
+	}
+	if _, ok := _c.mutation.QuotaGracePercent(); !ok {
+		v := tenantsetting.DefaultQuotaGracePercent
+		_c.mutation.SetQuotaGracePercent(v)
+	}
+	if _, ok := _c.mutation.FilenameConflictMode(); !ok {
+		v := tenantsetting.DefaultFilenameConflictMode
+		_c.mutation.SetFilenameConflictMode(v)
+	}
+	if _, ok := _c.mutation.RequireUploadDescription(); !ok {
+		v := tenantsetting.DefaultRequireUploadDescription
+		_c.mutation.SetRequireUploadDescription(v)
+	}
+	if _, ok := _c.mutation.ImageMetadataMode(); !ok {
+		v := tenantsetting.DefaultImageMetadataMode
+		_c.mutation.SetImageMetadataMode(v)
+	}
+	if _, ok := _c.mutation.RequirePdfWatermark(); !ok {
+		v := tenantsetting.DefaultRequirePdfWatermark
+		_c.mutation.SetRequirePdfWatermark(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if tenantsetting.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized tenantsetting.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := tenantsetting.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantSettingCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TenantSetting.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TenantSetting.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "TenantSetting.update_time"`)}
+	}
+	if v, ok := _c.mutation.DefaultLanguage(); ok {
+		if err := tenantsetting.DefaultLanguageValidator(v); err != nil {
+			return &ValidationError{Name: "default_language", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.default_language": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "TenantSetting.state"`)}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := tenantsetting.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.state": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.StateReason(); ok {
+		if err := tenantsetting.StateReasonValidator(v); err != nil {
+			return &ValidationError{Name: "state_reason", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.state_reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.QuotaMode(); !ok {
+		return &ValidationError{Name: "quota_mode", err: errors.New(`ent: missing required field "TenantSetting.quota_mode"`)}
+	}
+	if v, ok := _c.mutation.QuotaMode(); ok {
+		if err := tenantsetting.QuotaModeValidator(v); err != nil {
+			return &ValidationError{Name: "quota_mode", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.quota_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.QuotaGracePercent(); !ok {
+		return &ValidationError{Name: "quota_grace_percent", err: errors.New(`ent: missing required field "TenantSetting.quota_grace_percent"`)}
+	}
+	if v, ok := _c.mutation.QuotaGracePercent(); ok {
+		if err := tenantsetting.QuotaGracePercentValidator(v); err != nil {
+			return &ValidationError{Name: "quota_grace_percent", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.quota_grace_percent": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.StorageLimitBytes(); ok {
+		if err := tenantsetting.StorageLimitBytesValidator(v); err != nil {
+			return &ValidationError{Name: "storage_limit_bytes", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.storage_limit_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DefaultFileRetentionDays(); ok {
+		if err := tenantsetting.DefaultFileRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "default_file_retention_days", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.default_file_retention_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FilenameConflictMode(); !ok {
+		return &ValidationError{Name: "filename_conflict_mode", err: errors.New(`ent: missing required field "TenantSetting.filename_conflict_mode"`)}
+	}
+	if v, ok := _c.mutation.FilenameConflictMode(); ok {
+		if err := tenantsetting.FilenameConflictModeValidator(v); err != nil {
+			return &ValidationError{Name: "filename_conflict_mode", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.filename_conflict_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequireUploadDescription(); !ok {
+		return &ValidationError{Name: "require_upload_description", err: errors.New(`ent: missing required field "TenantSetting.require_upload_description"`)}
+	}
+	if v, ok := _c.mutation.UploadDescriptionTemplate(); ok {
+		if err := tenantsetting.UploadDescriptionTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "upload_description_template", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.upload_description_template": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ImageMetadataMode(); !ok {
+		return &ValidationError{Name: "image_metadata_mode", err: errors.New(`ent: missing required field "TenantSetting.image_metadata_mode"`)}
+	}
+	if v, ok := _c.mutation.ImageMetadataMode(); ok {
+		if err := tenantsetting.ImageMetadataModeValidator(v); err != nil {
+			return &ValidationError{Name: "image_metadata_mode", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.image_metadata_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequirePdfWatermark(); !ok {
+		return &ValidationError{Name: "require_pdf_watermark", err: errors.New(`ent: missing required field "TenantSetting.require_pdf_watermark"`)}
+	}
+	return nil
+}
+
+func (_c *TenantSettingCreate) sqlSave(ctx context.Context) (*TenantSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantSettingCreate) createSpec() (*TenantSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantsetting.Table, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(tenantsetting.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(tenantsetting.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsetting.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.DefaultLanguage(); ok {
+		_spec.SetField(tenantsetting.FieldDefaultLanguage, field.TypeString, value)
+		_node.DefaultLanguage = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(tenantsetting.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.StateReason(); ok {
+		_spec.SetField(tenantsetting.FieldStateReason, field.TypeString, value)
+		_node.StateReason = value
+	}
+	if value, ok := _c.mutation.StateChangedAt(); ok {
+		_spec.SetField(tenantsetting.FieldStateChangedAt, field.TypeTime, value)
+		_node.StateChangedAt = &value
+	}
+	if value, ok := _c.mutation.QuotaMode(); ok {
+		_spec.SetField(tenantsetting.FieldQuotaMode, field.TypeEnum, value)
+		_node.QuotaMode = value
+	}
+	if value, ok := _c.mutation.QuotaGracePercent(); ok {
+		_spec.SetField(tenantsetting.FieldQuotaGracePercent, field.TypeInt, value)
+		_node.QuotaGracePercent = value
+	}
+	if value, ok := _c.mutation.StorageLimitBytes(); ok {
+		_spec.SetField(tenantsetting.FieldStorageLimitBytes, field.TypeInt64, value)
+		_node.StorageLimitBytes = &value
+	}
+	if value, ok := _c.mutation.DefaultFileRetentionDays(); ok {
+		_spec.SetField(tenantsetting.FieldDefaultFileRetentionDays, field.TypeInt, value)
+		_node.DefaultFileRetentionDays = &value
+	}
+	if value, ok := _c.mutation.FilenameConflictMode(); ok {
+		_spec.SetField(tenantsetting.FieldFilenameConflictMode, field.TypeEnum, value)
+		_node.FilenameConflictMode = value
+	}
+	if value, ok := _c.mutation.RequireUploadDescription(); ok {
+		_spec.SetField(tenantsetting.FieldRequireUploadDescription, field.TypeBool, value)
+		_node.RequireUploadDescription = value
+	}
+	if value, ok := _c.mutation.UploadDescriptionTemplate(); ok {
+		_spec.SetField(tenantsetting.FieldUploadDescriptionTemplate, field.TypeString, value)
+		_node.UploadDescriptionTemplate = &value
+	}
+	if value, ok := _c.mutation.UploadClassificationTags(); ok {
+		_spec.SetField(tenantsetting.FieldUploadClassificationTags, field.TypeJSON, value)
+		_node.UploadClassificationTags = value
+	}
+	if value, ok := _c.mutation.ImageMetadataMode(); ok {
+		_spec.SetField(tenantsetting.FieldImageMetadataMode, field.TypeEnum, value)
+		_node.ImageMetadataMode = value
+	}
+	if value, ok := _c.mutation.RequirePdfWatermark(); ok {
+		_spec.SetField(tenantsetting.FieldRequirePdfWatermark, field.TypeBool, value)
+		_node.RequirePdfWatermark = value
+	}
+	if value, ok := _c.mutation.MaxUploadSize(); ok {
+		_spec.SetField(tenantsetting.FieldMaxUploadSize, field.TypeInt64, value)
+		_node.MaxUploadSize = &value
+	}
+	if value, ok := _c.mutation.UploadAllowedExtensions(); ok {
+		_spec.SetField(tenantsetting.FieldUploadAllowedExtensions, field.TypeJSON, value)
+		_node.UploadAllowedExtensions = value
+	}
+	if value, ok := _c.mutation.UploadBlockedExtensions(); ok {
+		_spec.SetField(tenantsetting.FieldUploadBlockedExtensions, field.TypeJSON, value)
+		_node.UploadBlockedExtensions = value
+	}
+	if value, ok := _c.mutation.UploadAllowedMimeTypes(); ok {
+		_spec.SetField(tenantsetting.FieldUploadAllowedMimeTypes, field.TypeJSON, value)
+		_node.UploadAllowedMimeTypes = value
+	}
+	if value, ok := _c.mutation.UploadBlockedMimeTypes(); ok {
+		_spec.SetField(tenantsetting.FieldUploadBlockedMimeTypes, field.TypeJSON, value)
+		_node.UploadBlockedMimeTypes = value
+	}
+	if value, ok := _c.mutation.FileMetadataSchemas(); ok {
+		_spec.SetField(tenantsetting.FieldFileMetadataSchemas, field.TypeJSON, value)
+		_node.FileMetadataSchemas = value
+	}
+	return _node, _spec
+}
+
+// TenantSettingCreateBulk is the builder for creating many TenantSetting entities in bulk.
+type TenantSettingCreateBulk struct {
+	config
+	err      error
+	builders []*TenantSettingCreate
+}
+
+// Save creates the TenantSetting entities in the database.
+func (_c *TenantSettingCreateBulk) Save(ctx context.Context) ([]*TenantSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantSettingCreateBulk) SaveX(ctx context.Context) []*TenantSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/predicate"
+	"main/ent/schema/filemeta"
+	"main/ent/tenantsetting"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantSettingUpdate is the builder for updating TenantSetting entities.
+type TenantSettingUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantSettingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantSettingUpdate builder.
+func (_u *TenantSettingUpdate) Where(ps ...predicate.TenantSetting) *TenantSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantSettingUpdate) SetUpdateTime(v time.Time) *TenantSettingUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (_u *TenantSettingUpdate) SetDefaultLanguage(v string) *TenantSettingUpdate {
+	_u.mutation.SetDefaultLanguage(v)
+	return _u
+}
+
+// SetNillableDefaultLanguage sets the "default_language" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableDefaultLanguage(v *string) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetDefaultLanguage(*v)
+	}
+	return _u
+}
+
+// ClearDefaultLanguage clears the value of the "default_language" field.
+func (_u *TenantSettingUpdate) ClearDefaultLanguage() *TenantSettingUpdate {
+	_u.mutation.ClearDefaultLanguage()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *TenantSettingUpdate) SetState(v tenantsetting.State) *TenantSettingUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableState(v *tenantsetting.State) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetStateReason sets the "state_reason" field.
+func (_u *TenantSettingUpdate) SetStateReason(v string) *TenantSettingUpdate {
+	_u.mutation.SetStateReason(v)
+	return _u
+}
+
+// SetNillableStateReason sets the "state_reason" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableStateReason(v *string) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetStateReason(*v)
+	}
+	return _u
+}
+
+// ClearStateReason clears the value of the "state_reason" field.
+func (_u *TenantSettingUpdate) ClearStateReason() *TenantSettingUpdate {
+	_u.mutation.ClearStateReason()
+	return _u
+}
+
+// SetStateChangedAt sets the "state_changed_at" field.
+func (_u *TenantSettingUpdate) SetStateChangedAt(v time.Time) *TenantSettingUpdate {
+	_u.mutation.SetStateChangedAt(v)
+	return _u
+}
+
+// SetNillableStateChangedAt sets the "state_changed_at" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableStateChangedAt(v *time.Time) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetStateChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearStateChangedAt clears the value of the "state_changed_at" field.
+func (_u *TenantSettingUpdate) ClearStateChangedAt() *TenantSettingUpdate {
+	_u.mutation.ClearStateChangedAt()
+	return _u
+}
+
+// SetQuotaMode sets the "quota_mode" field.
+func (_u *TenantSettingUpdate) SetQuotaMode(v tenantsetting.QuotaMode) *TenantSettingUpdate {
+	_u.mutation.SetQuotaMode(v)
+	return _u
+}
+
+// SetNillableQuotaMode sets the "quota_mode" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableQuotaMode(v *tenantsetting.QuotaMode) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetQuotaMode(*v)
+	}
+	return _u
+}
+
+// SetQuotaGracePercent sets the "quota_grace_percent" field.
+func (_u *TenantSettingUpdate) SetQuotaGracePercent(v int) *TenantSettingUpdate {
+	_u.mutation.ResetQuotaGracePercent()
+	_u.mutation.SetQuotaGracePercent(v)
+	return _u
+}
+
+// SetNillableQuotaGracePercent sets the "quota_grace_percent" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableQuotaGracePercent(v *int) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetQuotaGracePercent(*v)
+	}
+	return _u
+}
+
+// AddQuotaGracePercent adds value to the "quota_grace_percent" field.
+func (_u *TenantSettingUpdate) AddQuotaGracePercent(v int) *TenantSettingUpdate {
+	_u.mutation.AddQuotaGracePercent(v)
+	return _u
+}
+
+// SetStorageLimitBytes sets the "storage_limit_bytes" field.
+func (_u *TenantSettingUpdate) SetStorageLimitBytes(v int64) *TenantSettingUpdate {
+	_u.mutation.ResetStorageLimitBytes()
+	_u.mutation.SetStorageLimitBytes(v)
+	return _u
+}
+
+// SetNillableStorageLimitBytes sets the "storage_limit_bytes" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableStorageLimitBytes(v *int64) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetStorageLimitBytes(*v)
+	}
+	return _u
+}
+
+// AddStorageLimitBytes adds value to the "storage_limit_bytes" field.
+func (_u *TenantSettingUpdate) AddStorageLimitBytes(v int64) *TenantSettingUpdate {
+	_u.mutation.AddStorageLimitBytes(v)
+	return _u
+}
+
+// ClearStorageLimitBytes clears the value of the "storage_limit_bytes" field.
+func (_u *TenantSettingUpdate) ClearStorageLimitBytes() *TenantSettingUpdate {
+	_u.mutation.ClearStorageLimitBytes()
+	return _u
+}
+
+// SetDefaultFileRetentionDays sets the "default_file_retention_days" field.
+func (_u *TenantSettingUpdate) SetDefaultFileRetentionDays(v int) *TenantSettingUpdate {
+	_u.mutation.ResetDefaultFileRetentionDays()
+	_u.mutation.SetDefaultFileRetentionDays(v)
+	return _u
+}
+
+// SetNillableDefaultFileRetentionDays sets the "default_file_retention_days" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableDefaultFileRetentionDays(v *int) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetDefaultFileRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddDefaultFileRetentionDays adds value to the "default_file_retention_days" field.
+func (_u *TenantSettingUpdate) AddDefaultFileRetentionDays(v int) *TenantSettingUpdate {
+	_u.mutation.AddDefaultFileRetentionDays(v)
+	return _u
+}
+
+// ClearDefaultFileRetentionDays clears the value of the "default_file_retention_days" field.
+func (_u *TenantSettingUpdate) ClearDefaultFileRetentionDays() *TenantSettingUpdate {
+	_u.mutation.ClearDefaultFileRetentionDays()
+	return _u
+}
+
+// SetFilenameConflictMode sets the "filename_conflict_mode" field.
+func (_u *TenantSettingUpdate) SetFilenameConflictMode(v tenantsetting.FilenameConflictMode) *TenantSettingUpdate {
+	_u.mutation.SetFilenameConflictMode(v)
+	return _u
+}
+
+// SetNillableFilenameConflictMode sets the "filename_conflict_mode" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableFilenameConflictMode(v *tenantsetting.FilenameConflictMode) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetFilenameConflictMode(*v)
+	}
+	return _u
+}
+
+// SetRequireUploadDescription sets the "require_upload_description" field.
+func (_u *TenantSettingUpdate) SetRequireUploadDescription(v bool) *TenantSettingUpdate {
+	_u.mutation.SetRequireUploadDescription(v)
+	return _u
+}
+
+// SetNillableRequireUploadDescription sets the "require_upload_description" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableRequireUploadDescription(v *bool) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetRequireUploadDescription(*v)
+	}
+	return _u
+}
+
+// SetUploadDescriptionTemplate sets the "upload_description_template" field.
+func (_u *TenantSettingUpdate) SetUploadDescriptionTemplate(v string) *TenantSettingUpdate {
+	_u.mutation.SetUploadDescriptionTemplate(v)
+	return _u
+}
+
+// SetNillableUploadDescriptionTemplate sets the "upload_description_template" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableUploadDescriptionTemplate(v *string) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetUploadDescriptionTemplate(*v)
+	}
+	return _u
+}
+
+// ClearUploadDescriptionTemplate clears the value of the "upload_description_template" field.
+func (_u *TenantSettingUpdate) ClearUploadDescriptionTemplate() *TenantSettingUpdate {
+	_u.mutation.ClearUploadDescriptionTemplate()
+	return _u
+}
+
+// SetUploadClassificationTags sets the "upload_classification_tags" field.
+func (_u *TenantSettingUpdate) SetUploadClassificationTags(v []string) *TenantSettingUpdate {
+	_u.mutation.SetUploadClassificationTags(v)
+	return _u
+}
+
+// AppendUploadClassificationTags appends value to the "upload_classification_tags" field.
+func (_u *TenantSettingUpdate) AppendUploadClassificationTags(v []string) *TenantSettingUpdate {
+	_u.mutation.AppendUploadClassificationTags(v)
+	return _u
+}
+
+// ClearUploadClassificationTags clears the value of the "upload_classification_tags" field.
+func (_u *TenantSettingUpdate) ClearUploadClassificationTags() *TenantSettingUpdate {
+	_u.mutation.ClearUploadClassificationTags()
+	return _u
+}
+
+// SetImageMetadataMode sets the "image_metadata_mode" field.
+func (_u *TenantSettingUpdate) SetImageMetadataMode(v tenantsetting.ImageMetadataMode) *TenantSettingUpdate {
+	_u.mutation.SetImageMetadataMode(v)
+	return _u
+}
+
+// SetNillableImageMetadataMode sets the "image_metadata_mode" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableImageMetadataMode(v *tenantsetting.ImageMetadataMode) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetImageMetadataMode(*v)
+	}
+	return _u
+}
+
+// SetRequirePdfWatermark sets the "require_pdf_watermark" field.
+func (_u *TenantSettingUpdate) SetRequirePdfWatermark(v bool) *TenantSettingUpdate {
+	_u.mutation.SetRequirePdfWatermark(v)
+	return _u
+}
+
+// SetNillableRequirePdfWatermark sets the "require_pdf_watermark" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableRequirePdfWatermark(v *bool) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetRequirePdfWatermark(*v)
+	}
+	return _u
+}
+
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (_u *TenantSettingUpdate) SetMaxUploadSize(v int64) *TenantSettingUpdate {
+	_u.mutation.ResetMaxUploadSize()
+	_u.mutation.SetMaxUploadSize(v)
+	return _u
+}
+
+// SetNillableMaxUploadSize sets the "max_upload_size" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableMaxUploadSize(v *int64) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetMaxUploadSize(*v)
+	}
+	return _u
+}
+
+// AddMaxUploadSize adds value to the "max_upload_size" field.
+func (_u *TenantSettingUpdate) AddMaxUploadSize(v int64) *TenantSettingUpdate {
+	_u.mutation.AddMaxUploadSize(v)
+	return _u
+}
+
+// ClearMaxUploadSize clears the value of the "max_upload_size" field.
+func (_u *TenantSettingUpdate) ClearMaxUploadSize() *TenantSettingUpdate {
+	_u.mutation.ClearMaxUploadSize()
+	return _u
+}
+
+// SetUploadAllowedExtensions sets the "upload_allowed_extensions" field.
+func (_u *TenantSettingUpdate) SetUploadAllowedExtensions(v []string) *TenantSettingUpdate {
+	_u.mutation.SetUploadAllowedExtensions(v)
+	return _u
+}
+
+// AppendUploadAllowedExtensions appends value to the "upload_allowed_extensions" field.
+func (_u *TenantSettingUpdate) AppendUploadAllowedExtensions(v []string) *TenantSettingUpdate {
+	_u.mutation.AppendUploadAllowedExtensions(v)
+	return _u
+}
+
+// ClearUploadAllowedExtensions clears the value of the "upload_allowed_extensions" field.
+func (_u *TenantSettingUpdate) ClearUploadAllowedExtensions() *TenantSettingUpdate {
+	_u.mutation.ClearUploadAllowedExtensions()
+	return _u
+}
+
+// SetUploadBlockedExtensions sets the "upload_blocked_extensions" field.
+func (_u *TenantSettingUpdate) SetUploadBlockedExtensions(v []string) *TenantSettingUpdate {
+	_u.mutation.SetUploadBlockedExtensions(v)
+	return _u
+}
+
+// AppendUploadBlockedExtensions appends value to the "upload_blocked_extensions" field.
+func (_u *TenantSettingUpdate) AppendUploadBlockedExtensions(v []string) *TenantSettingUpdate {
+	_u.mutation.AppendUploadBlockedExtensions(v)
+	return _u
+}
+
+// ClearUploadBlockedExtensions clears the value of the "upload_blocked_extensions" field.
+func (_u *TenantSettingUpdate) ClearUploadBlockedExtensions() *TenantSettingUpdate {
+	_u.mutation.ClearUploadBlockedExtensions()
+	return _u
+}
+
+// SetUploadAllowedMimeTypes sets the "upload_allowed_mime_types" field.
+func (_u *TenantSettingUpdate) SetUploadAllowedMimeTypes(v []string) *TenantSettingUpdate {
+	_u.mutation.SetUploadAllowedMimeTypes(v)
+	return _u
+}
+
+// AppendUploadAllowedMimeTypes appends value to the "upload_allowed_mime_types" field.
+func (_u *TenantSettingUpdate) AppendUploadAllowedMimeTypes(v []string) *TenantSettingUpdate {
+	_u.mutation.AppendUploadAllowedMimeTypes(v)
+	return _u
+}
+
+// ClearUploadAllowedMimeTypes clears the value of the "upload_allowed_mime_types" field.
+func (_u *TenantSettingUpdate) ClearUploadAllowedMimeTypes() *TenantSettingUpdate {
+	_u.mutation.ClearUploadAllowedMimeTypes()
+	return _u
+}
+
+// SetUploadBlockedMimeTypes sets the "upload_blocked_mime_types" field.
+func (_u *TenantSettingUpdate) SetUploadBlockedMimeTypes(v []string) *TenantSettingUpdate {
+	_u.mutation.SetUploadBlockedMimeTypes(v)
+	return _u
+}
+
+// AppendUploadBlockedMimeTypes appends value to the "upload_blocked_mime_types" field.
+func (_u *TenantSettingUpdate) AppendUploadBlockedMimeTypes(v []string) *TenantSettingUpdate {
+	_u.mutation.AppendUploadBlockedMimeTypes(v)
+	return _u
+}
+
+// ClearUploadBlockedMimeTypes clears the value of the "upload_blocked_mime_types" field.
+func (_u *TenantSettingUpdate) ClearUploadBlockedMimeTypes() *TenantSettingUpdate {
+	_u.mutation.ClearUploadBlockedMimeTypes()
+	return _u
+}
+
+// SetFileMetadataSchemas sets the "file_metadata_schemas" field.
+func (_u *TenantSettingUpdate) SetFileMetadataSchemas(v []filemeta.Schema) *TenantSettingUpdate {
+	_u.mutation.SetFileMetadataSchemas(v)
+	return _u
+}
+
+// AppendFileMetadataSchemas appends value to the "file_metadata_schemas" field.
+func (_u *TenantSettingUpdate) AppendFileMetadataSchemas(v []filemeta.Schema) *TenantSettingUpdate {
+	_u.mutation.AppendFileMetadataSchemas(v)
+	return _u
+}
+
+// ClearFileMetadataSchemas clears the value of the "file_metadata_schemas" field.
+func (_u *TenantSettingUpdate) ClearFileMetadataSchemas() *TenantSettingUpdate {
+	_u.mutation.ClearFileMetadataSchemas()
+	return _u
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (_u *TenantSettingUpdate) Mutation() *TenantSettingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantSettingUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantSettingUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if tenantsetting.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenantsetting.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenantsetting.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSettingUpdate) check() error {
+	if v, ok := _u.mutation.DefaultLanguage(); ok {
+		if err := tenantsetting.DefaultLanguageValidator(v); err != nil {
+			return &ValidationError{Name: "default_language", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.default_language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := tenantsetting.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StateReason(); ok {
+		if err := tenantsetting.StateReasonValidator(v); err != nil {
+			return &ValidationError{Name: "state_reason", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.state_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuotaMode(); ok {
+		if err := tenantsetting.QuotaModeValidator(v); err != nil {
+			return &ValidationError{Name: "quota_mode", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.quota_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuotaGracePercent(); ok {
+		if err := tenantsetting.QuotaGracePercentValidator(v); err != nil {
+			return &ValidationError{Name: "quota_grace_percent", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.quota_grace_percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageLimitBytes(); ok {
+		if err := tenantsetting.StorageLimitBytesValidator(v); err != nil {
+			return &ValidationError{Name: "storage_limit_bytes", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.storage_limit_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DefaultFileRetentionDays(); ok {
+		if err := tenantsetting.DefaultFileRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "default_file_retention_days", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.default_file_retention_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FilenameConflictMode(); ok {
+		if err := tenantsetting.FilenameConflictModeValidator(v); err != nil {
+			return &ValidationError{Name: "filename_conflict_mode", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.filename_conflict_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UploadDescriptionTemplate(); ok {
+		if err := tenantsetting.UploadDescriptionTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "upload_description_template", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.upload_description_template": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ImageMetadataMode(); ok {
+		if err := tenantsetting.ImageMetadataModeValidator(v); err != nil {
+			return &ValidationError{Name: "image_metadata_mode", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.image_metadata_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantSettingUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantSettingUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsetting.Table, tenantsetting.Columns, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsetting.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DefaultLanguage(); ok {
+		_spec.SetField(tenantsetting.FieldDefaultLanguage, field.TypeString, value)
+	}
+	if _u.mutation.DefaultLanguageCleared() {
+		_spec.ClearField(tenantsetting.FieldDefaultLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(tenantsetting.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StateReason(); ok {
+		_spec.SetField(tenantsetting.FieldStateReason, field.TypeString, value)
+	}
+	if _u.mutation.StateReasonCleared() {
+		_spec.ClearField(tenantsetting.FieldStateReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StateChangedAt(); ok {
+		_spec.SetField(tenantsetting.FieldStateChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StateChangedAtCleared() {
+		_spec.ClearField(tenantsetting.FieldStateChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QuotaMode(); ok {
+		_spec.SetField(tenantsetting.FieldQuotaMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.QuotaGracePercent(); ok {
+		_spec.SetField(tenantsetting.FieldQuotaGracePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaGracePercent(); ok {
+		_spec.AddField(tenantsetting.FieldQuotaGracePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StorageLimitBytes(); ok {
+		_spec.SetField(tenantsetting.FieldStorageLimitBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStorageLimitBytes(); ok {
+		_spec.AddField(tenantsetting.FieldStorageLimitBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.StorageLimitBytesCleared() {
+		_spec.ClearField(tenantsetting.FieldStorageLimitBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DefaultFileRetentionDays(); ok {
+		_spec.SetField(tenantsetting.FieldDefaultFileRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultFileRetentionDays(); ok {
+		_spec.AddField(tenantsetting.FieldDefaultFileRetentionDays, field.TypeInt, value)
+	}
+	if _u.mutation.DefaultFileRetentionDaysCleared() {
+		_spec.ClearField(tenantsetting.FieldDefaultFileRetentionDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FilenameConflictMode(); ok {
+		_spec.SetField(tenantsetting.FieldFilenameConflictMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RequireUploadDescription(); ok {
+		_spec.SetField(tenantsetting.FieldRequireUploadDescription, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UploadDescriptionTemplate(); ok {
+		_spec.SetField(tenantsetting.FieldUploadDescriptionTemplate, field.TypeString, value)
+	}
+	if _u.mutation.UploadDescriptionTemplateCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadDescriptionTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.UploadClassificationTags(); ok {
+		_spec.SetField(tenantsetting.FieldUploadClassificationTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadClassificationTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadClassificationTags, value)
+		})
+	}
+	if _u.mutation.UploadClassificationTagsCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadClassificationTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ImageMetadataMode(); ok {
+		_spec.SetField(tenantsetting.FieldImageMetadataMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RequirePdfWatermark(); ok {
+		_spec.SetField(tenantsetting.FieldRequirePdfWatermark, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaxUploadSize(); ok {
+		_spec.SetField(tenantsetting.FieldMaxUploadSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUploadSize(); ok {
+		_spec.AddField(tenantsetting.FieldMaxUploadSize, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxUploadSizeCleared() {
+		_spec.ClearField(tenantsetting.FieldMaxUploadSize, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.UploadAllowedExtensions(); ok {
+		_spec.SetField(tenantsetting.FieldUploadAllowedExtensions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadAllowedExtensions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadAllowedExtensions, value)
+		})
+	}
+	if _u.mutation.UploadAllowedExtensionsCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadAllowedExtensions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UploadBlockedExtensions(); ok {
+		_spec.SetField(tenantsetting.FieldUploadBlockedExtensions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadBlockedExtensions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadBlockedExtensions, value)
+		})
+	}
+	if _u.mutation.UploadBlockedExtensionsCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadBlockedExtensions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UploadAllowedMimeTypes(); ok {
+		_spec.SetField(tenantsetting.FieldUploadAllowedMimeTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadAllowedMimeTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadAllowedMimeTypes, value)
+		})
+	}
+	if _u.mutation.UploadAllowedMimeTypesCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadAllowedMimeTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UploadBlockedMimeTypes(); ok {
+		_spec.SetField(tenantsetting.FieldUploadBlockedMimeTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadBlockedMimeTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadBlockedMimeTypes, value)
+		})
+	}
+	if _u.mutation.UploadBlockedMimeTypesCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadBlockedMimeTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FileMetadataSchemas(); ok {
+		_spec.SetField(tenantsetting.FieldFileMetadataSchemas, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFileMetadataSchemas(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldFileMetadataSchemas, value)
+		})
+	}
+	if _u.mutation.FileMetadataSchemasCleared() {
+		_spec.ClearField(tenantsetting.FieldFileMetadataSchemas, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantSettingUpdateOne is the builder for updating a single TenantSetting entity.
+type TenantSettingUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantSettingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantSettingUpdateOne) SetUpdateTime(v time.Time) *TenantSettingUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (_u *TenantSettingUpdateOne) SetDefaultLanguage(v string) *TenantSettingUpdateOne {
+	_u.mutation.SetDefaultLanguage(v)
+	return _u
+}
+
+// SetNillableDefaultLanguage sets the "default_language" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableDefaultLanguage(v *string) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetDefaultLanguage(*v)
+	}
+	return _u
+}
+
+// ClearDefaultLanguage clears the value of the "default_language" field.
+func (_u *TenantSettingUpdateOne) ClearDefaultLanguage() *TenantSettingUpdateOne {
+	_u.mutation.ClearDefaultLanguage()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *TenantSettingUpdateOne) SetState(v tenantsetting.State) *TenantSettingUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableState(v *tenantsetting.State) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetStateReason sets the "state_reason" field.
+func (_u *TenantSettingUpdateOne) SetStateReason(v string) *TenantSettingUpdateOne {
+	_u.mutation.SetStateReason(v)
+	return _u
+}
+
+// SetNillableStateReason sets the "state_reason" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableStateReason(v *string) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetStateReason(*v)
+	}
+	return _u
+}
+
+// ClearStateReason clears the value of the "state_reason" field.
+func (_u *TenantSettingUpdateOne) ClearStateReason() *TenantSettingUpdateOne {
+	_u.mutation.ClearStateReason()
+	return _u
+}
+
+// SetStateChangedAt sets the "state_changed_at" field.
+func (_u *TenantSettingUpdateOne) SetStateChangedAt(v time.Time) *TenantSettingUpdateOne {
+	_u.mutation.SetStateChangedAt(v)
+	return _u
+}
+
+// SetNillableStateChangedAt sets the "state_changed_at" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableStateChangedAt(v *time.Time) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetStateChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearStateChangedAt clears the value of the "state_changed_at" field.
+func (_u *TenantSettingUpdateOne) ClearStateChangedAt() *TenantSettingUpdateOne {
+	_u.mutation.ClearStateChangedAt()
+	return _u
+}
+
+// SetQuotaMode sets the "quota_mode" field.
+func (_u *TenantSettingUpdateOne) SetQuotaMode(v tenantsetting.QuotaMode) *TenantSettingUpdateOne {
+	_u.mutation.SetQuotaMode(v)
+	return _u
+}
+
+// SetNillableQuotaMode sets the "quota_mode" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableQuotaMode(v *tenantsetting.QuotaMode) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetQuotaMode(*v)
+	}
+	return _u
+}
+
+// SetQuotaGracePercent sets the "quota_grace_percent" field.
+func (_u *TenantSettingUpdateOne) SetQuotaGracePercent(v int) *TenantSettingUpdateOne {
+	_u.mutation.ResetQuotaGracePercent()
+	_u.mutation.SetQuotaGracePercent(v)
+	return _u
+}
+
+// SetNillableQuotaGracePercent sets the "quota_grace_percent" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableQuotaGracePercent(v *int) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetQuotaGracePercent(*v)
+	}
+	return _u
+}
+
+// AddQuotaGracePercent adds value to the "quota_grace_percent" field.
+func (_u *TenantSettingUpdateOne) AddQuotaGracePercent(v int) *TenantSettingUpdateOne {
+	_u.mutation.AddQuotaGracePercent(v)
+	return _u
+}
+
+// SetStorageLimitBytes sets the "storage_limit_bytes" field.
+func (_u *TenantSettingUpdateOne) SetStorageLimitBytes(v int64) *TenantSettingUpdateOne {
+	_u.mutation.ResetStorageLimitBytes()
+	_u.mutation.SetStorageLimitBytes(v)
+	return _u
+}
+
+// SetNillableStorageLimitBytes sets the "storage_limit_bytes" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableStorageLimitBytes(v *int64) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetStorageLimitBytes(*v)
+	}
+	return _u
+}
+
+// AddStorageLimitBytes adds value to the "storage_limit_bytes" field.
+func (_u *TenantSettingUpdateOne) AddStorageLimitBytes(v int64) *TenantSettingUpdateOne {
+	_u.mutation.AddStorageLimitBytes(v)
+	return _u
+}
+
+// ClearStorageLimitBytes clears the value of the "storage_limit_bytes" field.
+func (_u *TenantSettingUpdateOne) ClearStorageLimitBytes() *TenantSettingUpdateOne {
+	_u.mutation.ClearStorageLimitBytes()
+	return _u
+}
+
+// SetDefaultFileRetentionDays sets the "default_file_retention_days" field.
+func (_u *TenantSettingUpdateOne) SetDefaultFileRetentionDays(v int) *TenantSettingUpdateOne {
+	_u.mutation.ResetDefaultFileRetentionDays()
+	_u.mutation.SetDefaultFileRetentionDays(v)
+	return _u
+}
+
+// SetNillableDefaultFileRetentionDays sets the "default_file_retention_days" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableDefaultFileRetentionDays(v *int) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetDefaultFileRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddDefaultFileRetentionDays adds value to the "default_file_retention_days" field.
+func (_u *TenantSettingUpdateOne) AddDefaultFileRetentionDays(v int) *TenantSettingUpdateOne {
+	_u.mutation.AddDefaultFileRetentionDays(v)
+	return _u
+}
+
+// ClearDefaultFileRetentionDays clears the value of the "default_file_retention_days" field.
+func (_u *TenantSettingUpdateOne) ClearDefaultFileRetentionDays() *TenantSettingUpdateOne {
+	_u.mutation.ClearDefaultFileRetentionDays()
+	return _u
+}
+
+// SetFilenameConflictMode sets the "filename_conflict_mode" field.
+func (_u *TenantSettingUpdateOne) SetFilenameConflictMode(v tenantsetting.FilenameConflictMode) *TenantSettingUpdateOne {
+	_u.mutation.SetFilenameConflictMode(v)
+	return _u
+}
+
+// SetNillableFilenameConflictMode sets the "filename_conflict_mode" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableFilenameConflictMode(v *tenantsetting.FilenameConflictMode) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetFilenameConflictMode(*v)
+	}
+	return _u
+}
+
+// SetRequireUploadDescription sets the "require_upload_description" field.
+func (_u *TenantSettingUpdateOne) SetRequireUploadDescription(v bool) *TenantSettingUpdateOne {
+	_u.mutation.SetRequireUploadDescription(v)
+	return _u
+}
+
+// SetNillableRequireUploadDescription sets the "require_upload_description" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableRequireUploadDescription(v *bool) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetRequireUploadDescription(*v)
+	}
+	return _u
+}
+
+// SetUploadDescriptionTemplate sets the "upload_description_template" field.
+func (_u *TenantSettingUpdateOne) SetUploadDescriptionTemplate(v string) *TenantSettingUpdateOne {
+	_u.mutation.SetUploadDescriptionTemplate(v)
+	return _u
+}
+
+// SetNillableUploadDescriptionTemplate sets the "upload_description_template" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableUploadDescriptionTemplate(v *string) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetUploadDescriptionTemplate(*v)
+	}
+	return _u
+}
+
+// ClearUploadDescriptionTemplate clears the value of the "upload_description_template" field.
+func (_u *TenantSettingUpdateOne) ClearUploadDescriptionTemplate() *TenantSettingUpdateOne {
+	_u.mutation.ClearUploadDescriptionTemplate()
+	return _u
+}
+
+// SetUploadClassificationTags sets the "upload_classification_tags" field.
+func (_u *TenantSettingUpdateOne) SetUploadClassificationTags(v []string) *TenantSettingUpdateOne {
+	_u.mutation.SetUploadClassificationTags(v)
+	return _u
+}
+
+// AppendUploadClassificationTags appends value to the "upload_classification_tags" field.
+func (_u *TenantSettingUpdateOne) AppendUploadClassificationTags(v []string) *TenantSettingUpdateOne {
+	_u.mutation.AppendUploadClassificationTags(v)
+	return _u
+}
+
+// ClearUploadClassificationTags clears the value of the "upload_classification_tags" field.
+func (_u *TenantSettingUpdateOne) ClearUploadClassificationTags() *TenantSettingUpdateOne {
+	_u.mutation.ClearUploadClassificationTags()
+	return _u
+}
+
+// SetImageMetadataMode sets the "image_metadata_mode" field.
+func (_u *TenantSettingUpdateOne) SetImageMetadataMode(v tenantsetting.ImageMetadataMode) *TenantSettingUpdateOne {
+	_u.mutation.SetImageMetadataMode(v)
+	return _u
+}
+
+// SetNillableImageMetadataMode sets the "image_metadata_mode" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableImageMetadataMode(v *tenantsetting.ImageMetadataMode) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetImageMetadataMode(*v)
+	}
+	return _u
+}
+
+// SetRequirePdfWatermark sets the "require_pdf_watermark" field.
+func (_u *TenantSettingUpdateOne) SetRequirePdfWatermark(v bool) *TenantSettingUpdateOne {
+	_u.mutation.SetRequirePdfWatermark(v)
+	return _u
+}
+
+// SetNillableRequirePdfWatermark sets the "require_pdf_watermark" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableRequirePdfWatermark(v *bool) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetRequirePdfWatermark(*v)
+	}
+	return _u
+}
+
+// SetMaxUploadSize sets the "max_upload_size" field.
+func (_u *TenantSettingUpdateOne) SetMaxUploadSize(v int64) *TenantSettingUpdateOne {
+	_u.mutation.ResetMaxUploadSize()
+	_u.mutation.SetMaxUploadSize(v)
+	return _u
+}
+
+// SetNillableMaxUploadSize sets the "max_upload_size" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableMaxUploadSize(v *int64) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetMaxUploadSize(*v)
+	}
+	return _u
+}
+
+// AddMaxUploadSize adds value to the "max_upload_size" field.
+func (_u *TenantSettingUpdateOne) AddMaxUploadSize(v int64) *TenantSettingUpdateOne {
+	_u.mutation.AddMaxUploadSize(v)
+	return _u
+}
+
+// ClearMaxUploadSize clears the value of the "max_upload_size" field.
+func (_u *TenantSettingUpdateOne) ClearMaxUploadSize() *TenantSettingUpdateOne {
+	_u.mutation.ClearMaxUploadSize()
+	return _u
+}
+
+// SetUploadAllowedExtensions sets the "upload_allowed_extensions" field.
+func (_u *TenantSettingUpdateOne) SetUploadAllowedExtensions(v []string) *TenantSettingUpdateOne {
+	_u.mutation.SetUploadAllowedExtensions(v)
+	return _u
+}
+
+// AppendUploadAllowedExtensions appends value to the "upload_allowed_extensions" field.
+func (_u *TenantSettingUpdateOne) AppendUploadAllowedExtensions(v []string) *TenantSettingUpdateOne {
+	_u.mutation.AppendUploadAllowedExtensions(v)
+	return _u
+}
+
+// ClearUploadAllowedExtensions clears the value of the "upload_allowed_extensions" field.
+func (_u *TenantSettingUpdateOne) ClearUploadAllowedExtensions() *TenantSettingUpdateOne {
+	_u.mutation.ClearUploadAllowedExtensions()
+	return _u
+}
+
+// SetUploadBlockedExtensions sets the "upload_blocked_extensions" field.
+func (_u *TenantSettingUpdateOne) SetUploadBlockedExtensions(v []string) *TenantSettingUpdateOne {
+	_u.mutation.SetUploadBlockedExtensions(v)
+	return _u
+}
+
+// AppendUploadBlockedExtensions appends value to the "upload_blocked_extensions" field.
+func (_u *TenantSettingUpdateOne) AppendUploadBlockedExtensions(v []string) *TenantSettingUpdateOne {
+	_u.mutation.AppendUploadBlockedExtensions(v)
+	return _u
+}
+
+// ClearUploadBlockedExtensions clears the value of the "upload_blocked_extensions" field.
+func (_u *TenantSettingUpdateOne) ClearUploadBlockedExtensions() *TenantSettingUpdateOne {
+	_u.mutation.ClearUploadBlockedExtensions()
+	return _u
+}
+
+// SetUploadAllowedMimeTypes sets the "upload_allowed_mime_types" field.
+func (_u *TenantSettingUpdateOne) SetUploadAllowedMimeTypes(v []string) *TenantSettingUpdateOne {
+	_u.mutation.SetUploadAllowedMimeTypes(v)
+	return _u
+}
+
+// AppendUploadAllowedMimeTypes appends value to the "upload_allowed_mime_types" field.
+func (_u *TenantSettingUpdateOne) AppendUploadAllowedMimeTypes(v []string) *TenantSettingUpdateOne {
+	_u.mutation.AppendUploadAllowedMimeTypes(v)
+	return _u
+}
+
+// ClearUploadAllowedMimeTypes clears the value of the "upload_allowed_mime_types" field.
+func (_u *TenantSettingUpdateOne) ClearUploadAllowedMimeTypes() *TenantSettingUpdateOne {
+	_u.mutation.ClearUploadAllowedMimeTypes()
+	return _u
+}
+
+// SetUploadBlockedMimeTypes sets the "upload_blocked_mime_types" field.
+func (_u *TenantSettingUpdateOne) SetUploadBlockedMimeTypes(v []string) *TenantSettingUpdateOne {
+	_u.mutation.SetUploadBlockedMimeTypes(v)
+	return _u
+}
+
+// AppendUploadBlockedMimeTypes appends value to the "upload_blocked_mime_types" field.
+func (_u *TenantSettingUpdateOne) AppendUploadBlockedMimeTypes(v []string) *TenantSettingUpdateOne {
+	_u.mutation.AppendUploadBlockedMimeTypes(v)
+	return _u
+}
+
+// ClearUploadBlockedMimeTypes clears the value of the "upload_blocked_mime_types" field.
+func (_u *TenantSettingUpdateOne) ClearUploadBlockedMimeTypes() *TenantSettingUpdateOne {
+	_u.mutation.ClearUploadBlockedMimeTypes()
+	return _u
+}
+
+// SetFileMetadataSchemas sets the "file_metadata_schemas" field.
+func (_u *TenantSettingUpdateOne) SetFileMetadataSchemas(v []filemeta.Schema) *TenantSettingUpdateOne {
+	_u.mutation.SetFileMetadataSchemas(v)
+	return _u
+}
+
+// AppendFileMetadataSchemas appends value to the "file_metadata_schemas" field.
+func (_u *TenantSettingUpdateOne) AppendFileMetadataSchemas(v []filemeta.Schema) *TenantSettingUpdateOne {
+	_u.mutation.AppendFileMetadataSchemas(v)
+	return _u
+}
+
+// ClearFileMetadataSchemas clears the value of the "file_metadata_schemas" field.
+func (_u *TenantSettingUpdateOne) ClearFileMetadataSchemas() *TenantSettingUpdateOne {
+	_u.mutation.ClearFileMetadataSchemas()
+	return _u
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (_u *TenantSettingUpdateOne) Mutation() *TenantSettingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantSettingUpdate builder.
+func (_u *TenantSettingUpdateOne) Where(ps ...predicate.TenantSetting) *TenantSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantSettingUpdateOne) Select(field string, fields ...string) *TenantSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantSetting entity.
+func (_u *TenantSettingUpdateOne) Save(ctx context.Context) (*TenantSetting, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSettingUpdateOne) SaveX(ctx context.Context) *TenantSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantSettingUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if tenantsetting.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenantsetting.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenantsetting.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSettingUpdateOne) check() error {
+	if v, ok := _u.mutation.DefaultLanguage(); ok {
+		if err := tenantsetting.DefaultLanguageValidator(v); err != nil {
+			return &ValidationError{Name: "default_language", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.default_language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := tenantsetting.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.state": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StateReason(); ok {
+		if err := tenantsetting.StateReasonValidator(v); err != nil {
+			return &ValidationError{Name: "state_reason", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.state_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuotaMode(); ok {
+		if err := tenantsetting.QuotaModeValidator(v); err != nil {
+			return &ValidationError{Name: "quota_mode", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.quota_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuotaGracePercent(); ok {
+		if err := tenantsetting.QuotaGracePercentValidator(v); err != nil {
+			return &ValidationError{Name: "quota_grace_percent", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.quota_grace_percent": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.StorageLimitBytes(); ok {
+		if err := tenantsetting.StorageLimitBytesValidator(v); err != nil {
+			return &ValidationError{Name: "storage_limit_bytes", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.storage_limit_bytes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DefaultFileRetentionDays(); ok {
+		if err := tenantsetting.DefaultFileRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "default_file_retention_days", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.default_file_retention_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FilenameConflictMode(); ok {
+		if err := tenantsetting.FilenameConflictModeValidator(v); err != nil {
+			return &ValidationError{Name: "filename_conflict_mode", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.filename_conflict_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UploadDescriptionTemplate(); ok {
+		if err := tenantsetting.UploadDescriptionTemplateValidator(v); err != nil {
+			return &ValidationError{Name: "upload_description_template", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.upload_description_template": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ImageMetadataMode(); ok {
+		if err := tenantsetting.ImageMetadataModeValidator(v); err != nil {
+			return &ValidationError{Name: "image_metadata_mode", err: fmt.Errorf(`ent: validator failed for field "TenantSetting.image_metadata_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantSettingUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantSettingUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantSettingUpdateOne) sqlSave(ctx context.Context) (_node *TenantSetting, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsetting.Table, tenantsetting.Columns, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantsetting.FieldID)
+		for _, f := range fields {
+			if !tenantsetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenantsetting.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DefaultLanguage(); ok {
+		_spec.SetField(tenantsetting.FieldDefaultLanguage, field.TypeString, value)
+	}
+	if _u.mutation.DefaultLanguageCleared() {
+		_spec.ClearField(tenantsetting.FieldDefaultLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(tenantsetting.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StateReason(); ok {
+		_spec.SetField(tenantsetting.FieldStateReason, field.TypeString, value)
+	}
+	if _u.mutation.StateReasonCleared() {
+		_spec.ClearField(tenantsetting.FieldStateReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StateChangedAt(); ok {
+		_spec.SetField(tenantsetting.FieldStateChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StateChangedAtCleared() {
+		_spec.ClearField(tenantsetting.FieldStateChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.QuotaMode(); ok {
+		_spec.SetField(tenantsetting.FieldQuotaMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.QuotaGracePercent(); ok {
+		_spec.SetField(tenantsetting.FieldQuotaGracePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuotaGracePercent(); ok {
+		_spec.AddField(tenantsetting.FieldQuotaGracePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StorageLimitBytes(); ok {
+		_spec.SetField(tenantsetting.FieldStorageLimitBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedStorageLimitBytes(); ok {
+		_spec.AddField(tenantsetting.FieldStorageLimitBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.StorageLimitBytesCleared() {
+		_spec.ClearField(tenantsetting.FieldStorageLimitBytes, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.DefaultFileRetentionDays(); ok {
+		_spec.SetField(tenantsetting.FieldDefaultFileRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultFileRetentionDays(); ok {
+		_spec.AddField(tenantsetting.FieldDefaultFileRetentionDays, field.TypeInt, value)
+	}
+	if _u.mutation.DefaultFileRetentionDaysCleared() {
+		_spec.ClearField(tenantsetting.FieldDefaultFileRetentionDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FilenameConflictMode(); ok {
+		_spec.SetField(tenantsetting.FieldFilenameConflictMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RequireUploadDescription(); ok {
+		_spec.SetField(tenantsetting.FieldRequireUploadDescription, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UploadDescriptionTemplate(); ok {
+		_spec.SetField(tenantsetting.FieldUploadDescriptionTemplate, field.TypeString, value)
+	}
+	if _u.mutation.UploadDescriptionTemplateCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadDescriptionTemplate, field.TypeString)
+	}
+	if value, ok := _u.mutation.UploadClassificationTags(); ok {
+		_spec.SetField(tenantsetting.FieldUploadClassificationTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadClassificationTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadClassificationTags, value)
+		})
+	}
+	if _u.mutation.UploadClassificationTagsCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadClassificationTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ImageMetadataMode(); ok {
+		_spec.SetField(tenantsetting.FieldImageMetadataMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RequirePdfWatermark(); ok {
+		_spec.SetField(tenantsetting.FieldRequirePdfWatermark, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaxUploadSize(); ok {
+		_spec.SetField(tenantsetting.FieldMaxUploadSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUploadSize(); ok {
+		_spec.AddField(tenantsetting.FieldMaxUploadSize, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxUploadSizeCleared() {
+		_spec.ClearField(tenantsetting.FieldMaxUploadSize, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.UploadAllowedExtensions(); ok {
+		_spec.SetField(tenantsetting.FieldUploadAllowedExtensions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadAllowedExtensions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadAllowedExtensions, value)
+		})
+	}
+	if _u.mutation.UploadAllowedExtensionsCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadAllowedExtensions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UploadBlockedExtensions(); ok {
+		_spec.SetField(tenantsetting.FieldUploadBlockedExtensions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadBlockedExtensions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadBlockedExtensions, value)
+		})
+	}
+	if _u.mutation.UploadBlockedExtensionsCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadBlockedExtensions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UploadAllowedMimeTypes(); ok {
+		_spec.SetField(tenantsetting.FieldUploadAllowedMimeTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadAllowedMimeTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadAllowedMimeTypes, value)
+		})
+	}
+	if _u.mutation.UploadAllowedMimeTypesCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadAllowedMimeTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UploadBlockedMimeTypes(); ok {
+		_spec.SetField(tenantsetting.FieldUploadBlockedMimeTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedUploadBlockedMimeTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldUploadBlockedMimeTypes, value)
+		})
+	}
+	if _u.mutation.UploadBlockedMimeTypesCleared() {
+		_spec.ClearField(tenantsetting.FieldUploadBlockedMimeTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FileMetadataSchemas(); ok {
+		_spec.SetField(tenantsetting.FieldFileMetadataSchemas, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFileMetadataSchemas(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldFileMetadataSchemas, value)
+		})
+	}
+	if _u.mutation.FileMetadataSchemasCleared() {
+		_spec.ClearField(tenantsetting.FieldFileMetadataSchemas, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/translationoverride"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TranslationOverrideCreate is the builder for creating a TranslationOverride entity.
+type TranslationOverrideCreate struct {
+	config
+	mutation *TranslationOverrideMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TranslationOverrideCreate) SetTenantID(v uuid.UUID) *TranslationOverrideCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *TranslationOverrideCreate) SetCreateTime(v time.Time) *TranslationOverrideCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *TranslationOverrideCreate) SetNillableCreateTime(v *time.Time) *TranslationOverrideCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *TranslationOverrideCreate) SetUpdateTime(v time.Time) *TranslationOverrideCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *TranslationOverrideCreate) SetNillableUpdateTime(v *time.Time) *TranslationOverrideCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *TranslationOverrideCreate) SetMessageID(v string) *TranslationOverrideCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *TranslationOverrideCreate) SetLanguage(v string) *TranslationOverrideCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *TranslationOverrideCreate) SetText(v string) *TranslationOverrideCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TranslationOverrideCreate) SetID(v uuid.UUID) *TranslationOverrideCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TranslationOverrideCreate) SetNillableID(v *uuid.UUID) *TranslationOverrideCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TranslationOverrideMutation object of the builder.
+func (_c *TranslationOverrideCreate) Mutation() *TranslationOverrideMutation {
+	return _c.mutation
+}
+
+// Save creates the TranslationOverride in the database.
+func (_c *TranslationOverrideCreate) Save(ctx context.Context) (*TranslationOverride, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TranslationOverrideCreate) SaveX(ctx context.Context) *TranslationOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TranslationOverrideCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TranslationOverrideCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TranslationOverrideCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if translationoverride.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized translationoverride.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := translationoverride.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if translationoverride.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized translationoverride.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := translationoverride.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if translationoverride.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized translationoverride.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := translationoverride.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TranslationOverrideCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "TranslationOverride.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TranslationOverride.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "TranslationOverride.update_time"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "TranslationOverride.message_id"`)}
+	}
+	if v, ok := _c.mutation.MessageID(); ok {
+		if err := translationoverride.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "TranslationOverride.message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`ent: missing required field "TranslationOverride.language"`)}
+	}
+	if v, ok := _c.mutation.Language(); ok {
+		if err := translationoverride.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "TranslationOverride.language": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "TranslationOverride.text"`)}
+	}
+	if v, ok := _c.mutation.Text(); ok {
+		if err := translationoverride.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "TranslationOverride.text": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TranslationOverrideCreate) sqlSave(ctx context.Context) (*TranslationOverride, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TranslationOverrideCreate) createSpec() (*TranslationOverride, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TranslationOverride{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(translationoverride.Table, sqlgraph.NewFieldSpec(translationoverride.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(translationoverride.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(translationoverride.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(translationoverride.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(translationoverride.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(translationoverride.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(translationoverride.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	return _node, _spec
+}
+
+// TranslationOverrideCreateBulk is the builder for creating many TranslationOverride entities in bulk.
+type TranslationOverrideCreateBulk struct {
+	config
+	err      error
+	builders []*TranslationOverrideCreate
+}
+
+// Save creates the TranslationOverride entities in the database.
+func (_c *TranslationOverrideCreateBulk) Save(ctx context.Context) ([]*TranslationOverride, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TranslationOverride, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TranslationOverrideMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TranslationOverrideCreateBulk) SaveX(ctx context.Context) []*TranslationOverride {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TranslationOverrideCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TranslationOverrideCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/predicate"
+	"main/ent/translationoverride"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TranslationOverrideUpdate is the builder for updating TranslationOverride entities.
+type TranslationOverrideUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TranslationOverrideMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TranslationOverrideUpdate builder.
+func (_u *TranslationOverrideUpdate) Where(ps ...predicate.TranslationOverride) *TranslationOverrideUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TranslationOverrideUpdate) SetUpdateTime(v time.Time) *TranslationOverrideUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *TranslationOverrideUpdate) SetMessageID(v string) *TranslationOverrideUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *TranslationOverrideUpdate) SetNillableMessageID(v *string) *TranslationOverrideUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *TranslationOverrideUpdate) SetLanguage(v string) *TranslationOverrideUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *TranslationOverrideUpdate) SetNillableLanguage(v *string) *TranslationOverrideUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *TranslationOverrideUpdate) SetText(v string) *TranslationOverrideUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *TranslationOverrideUpdate) SetNillableText(v *string) *TranslationOverrideUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TranslationOverrideMutation object of the builder.
+func (_u *TranslationOverrideUpdate) Mutation() *TranslationOverrideMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TranslationOverrideUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TranslationOverrideUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TranslationOverrideUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TranslationOverrideUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TranslationOverrideUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if translationoverride.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized translationoverride.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := translationoverride.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TranslationOverrideUpdate) check() error {
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := translationoverride.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "TranslationOverride.message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := translationoverride.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "TranslationOverride.language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Text(); ok {
+		if err := translationoverride.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "TranslationOverride.text": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TranslationOverrideUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TranslationOverrideUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TranslationOverrideUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(translationoverride.Table, translationoverride.Columns, sqlgraph.NewFieldSpec(translationoverride.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(translationoverride.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(translationoverride.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(translationoverride.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(translationoverride.FieldText, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{translationoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TranslationOverrideUpdateOne is the builder for updating a single TranslationOverride entity.
+type TranslationOverrideUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TranslationOverrideMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TranslationOverrideUpdateOne) SetUpdateTime(v time.Time) *TranslationOverrideUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *TranslationOverrideUpdateOne) SetMessageID(v string) *TranslationOverrideUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *TranslationOverrideUpdateOne) SetNillableMessageID(v *string) *TranslationOverrideUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *TranslationOverrideUpdateOne) SetLanguage(v string) *TranslationOverrideUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *TranslationOverrideUpdateOne) SetNillableLanguage(v *string) *TranslationOverrideUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *TranslationOverrideUpdateOne) SetText(v string) *TranslationOverrideUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *TranslationOverrideUpdateOne) SetNillableText(v *string) *TranslationOverrideUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TranslationOverrideMutation object of the builder.
+func (_u *TranslationOverrideUpdateOne) Mutation() *TranslationOverrideMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TranslationOverrideUpdate builder.
+func (_u *TranslationOverrideUpdateOne) Where(ps ...predicate.TranslationOverride) *TranslationOverrideUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TranslationOverrideUpdateOne) Select(field string, fields ...string) *TranslationOverrideUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TranslationOverride entity.
+func (_u *TranslationOverrideUpdateOne) Save(ctx context.Context) (*TranslationOverride, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TranslationOverrideUpdateOne) SaveX(ctx context.Context) *TranslationOverride {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TranslationOverrideUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TranslationOverrideUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TranslationOverrideUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if translationoverride.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized translationoverride.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := translationoverride.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TranslationOverrideUpdateOne) check() error {
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := translationoverride.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`ent: validator failed for field "TranslationOverride.message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Language(); ok {
+		if err := translationoverride.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "TranslationOverride.language": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Text(); ok {
+		if err := translationoverride.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "TranslationOverride.text": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TranslationOverrideUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TranslationOverrideUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TranslationOverrideUpdateOne) sqlSave(ctx context.Context) (_node *TranslationOverride, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(translationoverride.Table, translationoverride.Columns, sqlgraph.NewFieldSpec(translationoverride.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TranslationOverride.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, translationoverride.FieldID)
+		for _, f := range fields {
+			if !translationoverride.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != translationoverride.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(translationoverride.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(translationoverride.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(translationoverride.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(translationoverride.FieldText, field.TypeString, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TranslationOverride{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{translationoverride.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,30 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// DepartmentQuota is the client for interacting with the DepartmentQuota builders.
+	DepartmentQuota *DepartmentQuotaClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// FileAuditEvent is the client for interacting with the FileAuditEvent builders.
+	FileAuditEvent *FileAuditEventClient
+	// FileFavorite is the client for interacting with the FileFavorite builders.
+	FileFavorite *FileFavoriteClient
+	// FileSet is the client for interacting with the FileSet builders.
+	FileSet *FileSetClient
+	// ImpersonationGrant is the client for interacting with the ImpersonationGrant builders.
+	ImpersonationGrant *ImpersonationGrantClient
+	// LifecycleRule is the client for interacting with the LifecycleRule builders.
+	LifecycleRule *LifecycleRuleClient
+	// OperationAuditLog is the client for interacting with the OperationAuditLog builders.
+	OperationAuditLog *OperationAuditLogClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
+	// TenantSetting is the client for interacting with the TenantSetting builders.
+	TenantSetting *TenantSettingClient
+	// TranslationOverride is the client for interacting with the TranslationOverride builders.
+	TranslationOverride *TranslationOverrideClient
+	// UserStorageLimit is the client for interacting with the UserStorageLimit builders.
+	UserStorageLimit *UserStorageLimitClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +167,18 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.DepartmentQuota = NewDepartmentQuotaClient(tx.config)
 	tx.File = NewFileClient(tx.config)
+	tx.FileAuditEvent = NewFileAuditEventClient(tx.config)
+	tx.FileFavorite = NewFileFavoriteClient(tx.config)
+	tx.FileSet = NewFileSetClient(tx.config)
+	tx.ImpersonationGrant = NewImpersonationGrantClient(tx.config)
+	tx.LifecycleRule = NewLifecycleRuleClient(tx.config)
+	tx.OperationAuditLog = NewOperationAuditLogClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
+	tx.TenantSetting = NewTenantSettingClient(tx.config)
+	tx.TranslationOverride = NewTranslationOverrideClient(tx.config)
+	tx.UserStorageLimit = NewUserStorageLimitClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +188,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: File.QueryXXX(), the query will be executed
+// applies a query, for example: DepartmentQuota.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userstoragelimit
+
+import (
+	"main/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLTE(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldUserID, v))
+}
+
+// MaxFiles applies equality check predicate on the "max_files" field. It's identical to MaxFilesEQ.
+func MaxFiles(v int) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldMaxFiles, v))
+}
+
+// MaxBytes applies equality check predicate on the "max_bytes" field. It's identical to MaxBytesEQ.
+func MaxBytes(v int64) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldMaxBytes, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLTE(FieldUserID, v))
+}
+
+// MaxFilesEQ applies the EQ predicate on the "max_files" field.
+func MaxFilesEQ(v int) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldMaxFiles, v))
+}
+
+// MaxFilesNEQ applies the NEQ predicate on the "max_files" field.
+func MaxFilesNEQ(v int) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNEQ(FieldMaxFiles, v))
+}
+
+// MaxFilesIn applies the In predicate on the "max_files" field.
+func MaxFilesIn(vs ...int) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldIn(FieldMaxFiles, vs...))
+}
+
+// MaxFilesNotIn applies the NotIn predicate on the "max_files" field.
+func MaxFilesNotIn(vs ...int) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNotIn(FieldMaxFiles, vs...))
+}
+
+// MaxFilesGT applies the GT predicate on the "max_files" field.
+func MaxFilesGT(v int) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGT(FieldMaxFiles, v))
+}
+
+// MaxFilesGTE applies the GTE predicate on the "max_files" field.
+func MaxFilesGTE(v int) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGTE(FieldMaxFiles, v))
+}
+
+// MaxFilesLT applies the LT predicate on the "max_files" field.
+func MaxFilesLT(v int) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLT(FieldMaxFiles, v))
+}
+
+// MaxFilesLTE applies the LTE predicate on the "max_files" field.
+func MaxFilesLTE(v int) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLTE(FieldMaxFiles, v))
+}
+
+// MaxFilesIsNil applies the IsNil predicate on the "max_files" field.
+func MaxFilesIsNil() predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldIsNull(FieldMaxFiles))
+}
+
+// MaxFilesNotNil applies the NotNil predicate on the "max_files" field.
+func MaxFilesNotNil() predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNotNull(FieldMaxFiles))
+}
+
+// MaxBytesEQ applies the EQ predicate on the "max_bytes" field.
+func MaxBytesEQ(v int64) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldEQ(FieldMaxBytes, v))
+}
+
+// MaxBytesNEQ applies the NEQ predicate on the "max_bytes" field.
+func MaxBytesNEQ(v int64) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNEQ(FieldMaxBytes, v))
+}
+
+// MaxBytesIn applies the In predicate on the "max_bytes" field.
+func MaxBytesIn(vs ...int64) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldIn(FieldMaxBytes, vs...))
+}
+
+// MaxBytesNotIn applies the NotIn predicate on the "max_bytes" field.
+func MaxBytesNotIn(vs ...int64) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNotIn(FieldMaxBytes, vs...))
+}
+
+// MaxBytesGT applies the GT predicate on the "max_bytes" field.
+func MaxBytesGT(v int64) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGT(FieldMaxBytes, v))
+}
+
+// MaxBytesGTE applies the GTE predicate on the "max_bytes" field.
+func MaxBytesGTE(v int64) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldGTE(FieldMaxBytes, v))
+}
+
+// MaxBytesLT applies the LT predicate on the "max_bytes" field.
+func MaxBytesLT(v int64) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLT(FieldMaxBytes, v))
+}
+
+// MaxBytesLTE applies the LTE predicate on the "max_bytes" field.
+func MaxBytesLTE(v int64) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldLTE(FieldMaxBytes, v))
+}
+
+// MaxBytesIsNil applies the IsNil predicate on the "max_bytes" field.
+func MaxBytesIsNil() predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldIsNull(FieldMaxBytes))
+}
+
+// MaxBytesNotNil applies the NotNil predicate on the "max_bytes" field.
+func MaxBytesNotNil() predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.FieldNotNull(FieldMaxBytes))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserStorageLimit) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserStorageLimit) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserStorageLimit) predicate.UserStorageLimit {
+	return predicate.UserStorageLimit(sql.NotPredicates(p))
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/userstoragelimit"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserStorageLimitCreate is the builder for creating a UserStorageLimit entity.
+type UserStorageLimitCreate struct {
+	config
+	mutation *UserStorageLimitMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *UserStorageLimitCreate) SetTenantID(v uuid.UUID) *UserStorageLimitCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *UserStorageLimitCreate) SetCreateTime(v time.Time) *UserStorageLimitCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *UserStorageLimitCreate) SetNillableCreateTime(v *time.Time) *UserStorageLimitCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *UserStorageLimitCreate) SetUpdateTime(v time.Time) *UserStorageLimitCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *UserStorageLimitCreate) SetNillableUpdateTime(v *time.Time) *UserStorageLimitCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserStorageLimitCreate) SetUserID(v uuid.UUID) *UserStorageLimitCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (_c *UserStorageLimitCreate) SetMaxFiles(v int) *UserStorageLimitCreate {
+	_c.mutation.SetMaxFiles(v)
+	return _c
+}
+
+// SetNillableMaxFiles sets the "max_files" field if the given value is not nil.
+func (_c *UserStorageLimitCreate) SetNillableMaxFiles(v *int) *UserStorageLimitCreate {
+	if v != nil {
+		_c.SetMaxFiles(*v)
+	}
+	return _c
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_c *UserStorageLimitCreate) SetMaxBytes(v int64) *UserStorageLimitCreate {
+	_c.mutation.SetMaxBytes(v)
+	return _c
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_c *UserStorageLimitCreate) SetNillableMaxBytes(v *int64) *UserStorageLimitCreate {
+	if v != nil {
+		_c.SetMaxBytes(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserStorageLimitCreate) SetID(v uuid.UUID) *UserStorageLimitCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *UserStorageLimitCreate) SetNillableID(v *uuid.UUID) *UserStorageLimitCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the UserStorageLimitMutation object of the builder.
+func (_c *UserStorageLimitCreate) Mutation() *UserStorageLimitMutation {
+	return _c.mutation
+}
+
+// Save creates the UserStorageLimit in the database.
+func (_c *UserStorageLimitCreate) Save(ctx context.Context) (*UserStorageLimit, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserStorageLimitCreate) SaveX(ctx context.Context) *UserStorageLimit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserStorageLimitCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserStorageLimitCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UserStorageLimitCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if userstoragelimit.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized userstoragelimit.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := userstoragelimit.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if userstoragelimit.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized userstoragelimit.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := userstoragelimit.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if userstoragelimit.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized userstoragelimit.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := userstoragelimit.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserStorageLimitCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "UserStorageLimit.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "UserStorageLimit.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "UserStorageLimit.update_time"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserStorageLimit.user_id"`)}
+	}
+	if v, ok := _c.mutation.MaxFiles(); ok {
+		if err := userstoragelimit.MaxFilesValidator(v); err != nil {
+			return &ValidationError{Name: "max_files", err: fmt.Errorf(`ent: validator failed for field "UserStorageLimit.max_files": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxBytes(); ok {
+		if err := userstoragelimit.MaxBytesValidator(v); err != nil {
+			return &ValidationError{Name: "max_bytes", err: fmt.Errorf(`ent: validator failed for field "UserStorageLimit.max_bytes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UserStorageLimitCreate) sqlSave(ctx context.Context) (*UserStorageLimit, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserStorageLimitCreate) createSpec() (*UserStorageLimit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserStorageLimit{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(userstoragelimit.Table, sqlgraph.NewFieldSpec(userstoragelimit.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(userstoragelimit.FieldTenantID, field.TypeUUID, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(userstoragelimit.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(userstoragelimit.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(userstoragelimit.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.MaxFiles(); ok {
+		_spec.SetField(userstoragelimit.FieldMaxFiles, field.TypeInt, value)
+		_node.MaxFiles = &value
+	}
+	if value, ok := _c.mutation.MaxBytes(); ok {
+		_spec.SetField(userstoragelimit.FieldMaxBytes, field.TypeInt64, value)
+		_node.MaxBytes = &value
+	}
+	return _node, _spec
+}
+
+// UserStorageLimitCreateBulk is the builder for creating many UserStorageLimit entities in bulk.
+type UserStorageLimitCreateBulk struct {
+	config
+	err      error
+	builders []*UserStorageLimitCreate
+}
+
+// Save creates the UserStorageLimit entities in the database.
+func (_c *UserStorageLimitCreateBulk) Save(ctx context.Context) ([]*UserStorageLimit, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserStorageLimit, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserStorageLimitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserStorageLimitCreateBulk) SaveX(ctx context.Context) []*UserStorageLimit {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserStorageLimitCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserStorageLimitCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
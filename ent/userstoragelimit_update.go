@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/ent/predicate"
+	"main/ent/userstoragelimit"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// UserStorageLimitUpdate is the builder for updating UserStorageLimit entities.
+type UserStorageLimitUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserStorageLimitMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserStorageLimitUpdate builder.
+func (_u *UserStorageLimitUpdate) Where(ps ...predicate.UserStorageLimit) *UserStorageLimitUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *UserStorageLimitUpdate) SetUpdateTime(v time.Time) *UserStorageLimitUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserStorageLimitUpdate) SetUserID(v uuid.UUID) *UserStorageLimitUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserStorageLimitUpdate) SetNillableUserID(v *uuid.UUID) *UserStorageLimitUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (_u *UserStorageLimitUpdate) SetMaxFiles(v int) *UserStorageLimitUpdate {
+	_u.mutation.ResetMaxFiles()
+	_u.mutation.SetMaxFiles(v)
+	return _u
+}
+
+// SetNillableMaxFiles sets the "max_files" field if the given value is not nil.
+func (_u *UserStorageLimitUpdate) SetNillableMaxFiles(v *int) *UserStorageLimitUpdate {
+	if v != nil {
+		_u.SetMaxFiles(*v)
+	}
+	return _u
+}
+
+// AddMaxFiles adds value to the "max_files" field.
+func (_u *UserStorageLimitUpdate) AddMaxFiles(v int) *UserStorageLimitUpdate {
+	_u.mutation.AddMaxFiles(v)
+	return _u
+}
+
+// ClearMaxFiles clears the value of the "max_files" field.
+func (_u *UserStorageLimitUpdate) ClearMaxFiles() *UserStorageLimitUpdate {
+	_u.mutation.ClearMaxFiles()
+	return _u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_u *UserStorageLimitUpdate) SetMaxBytes(v int64) *UserStorageLimitUpdate {
+	_u.mutation.ResetMaxBytes()
+	_u.mutation.SetMaxBytes(v)
+	return _u
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_u *UserStorageLimitUpdate) SetNillableMaxBytes(v *int64) *UserStorageLimitUpdate {
+	if v != nil {
+		_u.SetMaxBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBytes adds value to the "max_bytes" field.
+func (_u *UserStorageLimitUpdate) AddMaxBytes(v int64) *UserStorageLimitUpdate {
+	_u.mutation.AddMaxBytes(v)
+	return _u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (_u *UserStorageLimitUpdate) ClearMaxBytes() *UserStorageLimitUpdate {
+	_u.mutation.ClearMaxBytes()
+	return _u
+}
+
+// Mutation returns the UserStorageLimitMutation object of the builder.
+func (_u *UserStorageLimitUpdate) Mutation() *UserStorageLimitMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserStorageLimitUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserStorageLimitUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserStorageLimitUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserStorageLimitUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserStorageLimitUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if userstoragelimit.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized userstoragelimit.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := userstoragelimit.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserStorageLimitUpdate) check() error {
+	if v, ok := _u.mutation.MaxFiles(); ok {
+		if err := userstoragelimit.MaxFilesValidator(v); err != nil {
+			return &ValidationError{Name: "max_files", err: fmt.Errorf(`ent: validator failed for field "UserStorageLimit.max_files": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxBytes(); ok {
+		if err := userstoragelimit.MaxBytesValidator(v); err != nil {
+			return &ValidationError{Name: "max_bytes", err: fmt.Errorf(`ent: validator failed for field "UserStorageLimit.max_bytes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserStorageLimitUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserStorageLimitUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UserStorageLimitUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userstoragelimit.Table, userstoragelimit.Columns, sqlgraph.NewFieldSpec(userstoragelimit.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(userstoragelimit.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userstoragelimit.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.MaxFiles(); ok {
+		_spec.SetField(userstoragelimit.FieldMaxFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFiles(); ok {
+		_spec.AddField(userstoragelimit.FieldMaxFiles, field.TypeInt, value)
+	}
+	if _u.mutation.MaxFilesCleared() {
+		_spec.ClearField(userstoragelimit.FieldMaxFiles, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxBytes(); ok {
+		_spec.SetField(userstoragelimit.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBytes(); ok {
+		_spec.AddField(userstoragelimit.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxBytesCleared() {
+		_spec.ClearField(userstoragelimit.FieldMaxBytes, field.TypeInt64)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userstoragelimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserStorageLimitUpdateOne is the builder for updating a single UserStorageLimit entity.
+type UserStorageLimitUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserStorageLimitMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *UserStorageLimitUpdateOne) SetUpdateTime(v time.Time) *UserStorageLimitUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserStorageLimitUpdateOne) SetUserID(v uuid.UUID) *UserStorageLimitUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserStorageLimitUpdateOne) SetNillableUserID(v *uuid.UUID) *UserStorageLimitUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetMaxFiles sets the "max_files" field.
+func (_u *UserStorageLimitUpdateOne) SetMaxFiles(v int) *UserStorageLimitUpdateOne {
+	_u.mutation.ResetMaxFiles()
+	_u.mutation.SetMaxFiles(v)
+	return _u
+}
+
+// SetNillableMaxFiles sets the "max_files" field if the given value is not nil.
+func (_u *UserStorageLimitUpdateOne) SetNillableMaxFiles(v *int) *UserStorageLimitUpdateOne {
+	if v != nil {
+		_u.SetMaxFiles(*v)
+	}
+	return _u
+}
+
+// AddMaxFiles adds value to the "max_files" field.
+func (_u *UserStorageLimitUpdateOne) AddMaxFiles(v int) *UserStorageLimitUpdateOne {
+	_u.mutation.AddMaxFiles(v)
+	return _u
+}
+
+// ClearMaxFiles clears the value of the "max_files" field.
+func (_u *UserStorageLimitUpdateOne) ClearMaxFiles() *UserStorageLimitUpdateOne {
+	_u.mutation.ClearMaxFiles()
+	return _u
+}
+
+// SetMaxBytes sets the "max_bytes" field.
+func (_u *UserStorageLimitUpdateOne) SetMaxBytes(v int64) *UserStorageLimitUpdateOne {
+	_u.mutation.ResetMaxBytes()
+	_u.mutation.SetMaxBytes(v)
+	return _u
+}
+
+// SetNillableMaxBytes sets the "max_bytes" field if the given value is not nil.
+func (_u *UserStorageLimitUpdateOne) SetNillableMaxBytes(v *int64) *UserStorageLimitUpdateOne {
+	if v != nil {
+		_u.SetMaxBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxBytes adds value to the "max_bytes" field.
+func (_u *UserStorageLimitUpdateOne) AddMaxBytes(v int64) *UserStorageLimitUpdateOne {
+	_u.mutation.AddMaxBytes(v)
+	return _u
+}
+
+// ClearMaxBytes clears the value of the "max_bytes" field.
+func (_u *UserStorageLimitUpdateOne) ClearMaxBytes() *UserStorageLimitUpdateOne {
+	_u.mutation.ClearMaxBytes()
+	return _u
+}
+
+// Mutation returns the UserStorageLimitMutation object of the builder.
+func (_u *UserStorageLimitUpdateOne) Mutation() *UserStorageLimitMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserStorageLimitUpdate builder.
+func (_u *UserStorageLimitUpdateOne) Where(ps ...predicate.UserStorageLimit) *UserStorageLimitUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserStorageLimitUpdateOne) Select(field string, fields ...string) *UserStorageLimitUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserStorageLimit entity.
+func (_u *UserStorageLimitUpdateOne) Save(ctx context.Context) (*UserStorageLimit, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserStorageLimitUpdateOne) SaveX(ctx context.Context) *UserStorageLimit {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserStorageLimitUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserStorageLimitUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *UserStorageLimitUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if userstoragelimit.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized userstoragelimit.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := userstoragelimit.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserStorageLimitUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxFiles(); ok {
+		if err := userstoragelimit.MaxFilesValidator(v); err != nil {
+			return &ValidationError{Name: "max_files", err: fmt.Errorf(`ent: validator failed for field "UserStorageLimit.max_files": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxBytes(); ok {
+		if err := userstoragelimit.MaxBytesValidator(v); err != nil {
+			return &ValidationError{Name: "max_bytes", err: fmt.Errorf(`ent: validator failed for field "UserStorageLimit.max_bytes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserStorageLimitUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserStorageLimitUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UserStorageLimitUpdateOne) sqlSave(ctx context.Context) (_node *UserStorageLimit, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userstoragelimit.Table, userstoragelimit.Columns, sqlgraph.NewFieldSpec(userstoragelimit.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserStorageLimit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userstoragelimit.FieldID)
+		for _, f := range fields {
+			if !userstoragelimit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userstoragelimit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(userstoragelimit.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(userstoragelimit.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.MaxFiles(); ok {
+		_spec.SetField(userstoragelimit.FieldMaxFiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxFiles(); ok {
+		_spec.AddField(userstoragelimit.FieldMaxFiles, field.TypeInt, value)
+	}
+	if _u.mutation.MaxFilesCleared() {
+		_spec.ClearField(userstoragelimit.FieldMaxFiles, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MaxBytes(); ok {
+		_spec.SetField(userstoragelimit.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxBytes(); ok {
+		_spec.AddField(userstoragelimit.FieldMaxBytes, field.TypeInt64, value)
+	}
+	if _u.mutation.MaxBytesCleared() {
+		_spec.ClearField(userstoragelimit.FieldMaxBytes, field.TypeInt64)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &UserStorageLimit{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userstoragelimit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,44 @@
+// Package errcatalog содержит типизированные конструкторы локализованных ошибок.
+// Константы и функции для каждого ключа error.* генерируются tools/build_errors из locales/build
+// (go generate), поэтому опечатка в ключе или пропущенный параметр шаблона - ошибка компиляции.
+package errcatalog
+
+import (
+	"context"
+	"errors"
+	"main/utils"
+)
+
+// Error локализованная ошибка: Error() возвращает текст на языке запроса
+type Error struct {
+	// Key ключ сообщения в locales
+	Key     string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// newError переводит сообщение на язык запроса
+func newError(ctx context.Context, key string, data utils.TemplateData) error {
+	if data == nil {
+		return &Error{Key: key, Message: utils.T(ctx, key)}
+	}
+	return &Error{Key: key, Message: utils.T(ctx, key, data)}
+}
+
+// Is проверяет, что ошибка создана каталогом по указанному ключу
+func Is(err error, key string) bool {
+	var catalogErr *Error
+	return errors.As(err, &catalogErr) && catalogErr.Key == key
+}
+
+// KeyOf возвращает ключ ошибки каталога (пустая строка для остальных ошибок)
+func KeyOf(err error) string {
+	var catalogErr *Error
+	if errors.As(err, &catalogErr) {
+		return catalogErr.Key
+	}
+	return ""
+}
@@ -0,0 +1,1440 @@
+// Code generated by tools/build_errors from locales/build. DO NOT EDIT.
+
+package errcatalog
+
+import (
+	"context"
+	"main/utils"
+)
+
+// Message keys of localized errors
+const (
+	// KeyAuditAggregateFailed "Failed to aggregate audit events"
+	KeyAuditAggregateFailed = "error.audit.aggregate_failed"
+	// KeyAuditExportFailed "Failed to export audit events"
+	KeyAuditExportFailed = "error.audit.export_failed"
+	// KeyAuditGetFailed "Failed to retrieve audit events"
+	KeyAuditGetFailed = "error.audit.get_failed"
+	// KeyAuditInvalidCursor "Invalid pagination cursor"
+	KeyAuditInvalidCursor = "error.audit.invalid_cursor"
+	// KeyAuditInvalidPeriod "Invalid period: start must be before end and the period must not exceed 366 days"
+	KeyAuditInvalidPeriod = "error.audit.invalid_period"
+	// KeyBatchAllFailed "None of the selected items could be processed"
+	KeyBatchAllFailed = "error.batch.all_failed"
+	// KeyBatchItemFailed "The item could not be processed"
+	KeyBatchItemFailed = "error.batch.item_failed"
+	// KeyConfigInvalidLogLevel "Invalid log level"
+	KeyConfigInvalidLogLevel = "error.config.invalid_log_level"
+	// KeyConfigReloadFailed "Failed to reload service configuration"
+	KeyConfigReloadFailed = "error.config.reload_failed"
+	// KeyFileAccessDeniedForBatchUpdate "Access denied for batch update"
+	KeyFileAccessDeniedForBatchUpdate = "error.file.access_denied_for_batch_update"
+	// KeyFileAlreadyAttached "File is already attached to this entity"
+	KeyFileAlreadyAttached = "error.file.already_attached"
+	// KeyFileArchiveCreationFailed "Failed to create archive"
+	KeyFileArchiveCreationFailed = "error.file.archive_creation_failed"
+	// KeyFileArchiveJobNotFound "Archive job not found"
+	KeyFileArchiveJobNotFound = "error.file.archive_job_not_found"
+	// KeyFileArchiveJobUnavailable "Archive jobs are temporarily unavailable"
+	KeyFileArchiveJobUnavailable = "error.file.archive_job_unavailable"
+	// KeyFileArchiveUploadFailed "Failed to upload archive"
+	KeyFileArchiveUploadFailed = "error.file.archive_upload_failed"
+	// KeyFileChecksumInvalid "Invalid checksum: expected a SHA-256 digest as 64 hexadecimal characters"
+	KeyFileChecksumInvalid = "error.file.checksum_invalid"
+	// KeyFileChecksumMismatch "File content does not match the provided checksum: the file was corrupted in transit, upload it again"
+	KeyFileChecksumMismatch = "error.file.checksum_mismatch"
+	// KeyFileClassificationTagRequired "Add at least one classification tag: {{.tags}}"
+	KeyFileClassificationTagRequired = "error.file.classification_tag_required"
+	// KeyFileContentTypeMismatch "The file content does not match its type or the file type is not allowed"
+	KeyFileContentTypeMismatch = "error.file.content_type_mismatch"
+	// KeyFileCopyFailed "Failed to copy file"
+	KeyFileCopyFailed = "error.file.copy_failed"
+	// KeyFileCreateFailed "Failed to create file"
+	KeyFileCreateFailed = "error.file.create_failed"
+	// KeyFileDeleteFailed "Failed to delete file"
+	KeyFileDeleteFailed = "error.file.delete_failed"
+	// KeyFileDeletePermissionDenied "Permission denied to delete file"
+	KeyFileDeletePermissionDenied = "error.file.delete_permission_denied"
+	// KeyFileDeletionScheduleFailed "Failed to schedule file deletion"
+	KeyFileDeletionScheduleFailed = "error.file.deletion_schedule_failed"
+	// KeyFileDeletionTimeInvalid "Scheduled deletion time must be in the future"
+	KeyFileDeletionTimeInvalid = "error.file.deletion_time_invalid"
+	// KeyFileDepartmentQuotaExceeded "Your department has exceeded its storage quota"
+	KeyFileDepartmentQuotaExceeded = "error.file.department_quota_exceeded"
+	// KeyFileDepartmentQuotaUpdateFailed "Failed to update department quota"
+	KeyFileDepartmentQuotaUpdateFailed = "error.file.department_quota_update_failed"
+	// KeyFileDescriptionRequired "A description is required for every uploaded file"
+	KeyFileDescriptionRequired = "error.file.description_required"
+	// KeyFileDownloadFailed "Failed to read file content"
+	KeyFileDownloadFailed = "error.file.download_failed"
+	// KeyFileDuplicateReportFailed "Failed to build the duplicate files report"
+	KeyFileDuplicateReportFailed = "error.file.duplicate_report_failed"
+	// KeyFileFavoriteFailed "Failed to update favorites"
+	KeyFileFavoriteFailed = "error.file.favorite_failed"
+	// KeyFileFileTooLargeForStorage "File is too large for storage: {{.file_size}} {{.file_unit}} with a limit of {{.limit}} {{.limit_unit}}"
+	KeyFileFileTooLargeForStorage = "error.file.file_too_large_for_storage"
+	// KeyFileFilenameTooLong "Filename is too long"
+	KeyFileFilenameTooLong = "error.file.filename_too_long"
+	// KeyFileGetFailed "Failed to retrieve file"
+	KeyFileGetFailed = "error.file.get_failed"
+	// KeyFileGetFilesFailed "Failed to retrieve files"
+	KeyFileGetFilesFailed = "error.file.get_files_failed"
+	// KeyFileGetUpdatedFilesFailed "Failed to retrieve updated files"
+	KeyFileGetUpdatedFilesFailed = "error.file.get_updated_files_failed"
+	// KeyFileImageMetadataStripFailed "Failed to remove metadata from the image: the file is damaged or has an unsupported structure"
+	KeyFileImageMetadataStripFailed = "error.file.image_metadata_strip_failed"
+	// KeyFileImageMetadataStripTooLarge "Image is too large to remove metadata (maximum {{.max}} MB)"
+	KeyFileImageMetadataStripTooLarge = "error.file.image_metadata_strip_too_large"
+	// KeyFileImageTransformFailed "Failed to resize the image"
+	KeyFileImageTransformFailed = "error.file.image_transform_failed"
+	// KeyFileImageTransformInvalid "Invalid image size: w and h must be between 1 and {{.max}}, fit must be contain or cover"
+	KeyFileImageTransformInvalid = "error.file.image_transform_invalid"
+	// KeyFileImageTransformUnsupported "Resizing is not supported for this file type"
+	KeyFileImageTransformUnsupported = "error.file.image_transform_unsupported"
+	// KeyFileIntegrityCheckFailed "Failed to verify file integrity"
+	KeyFileIntegrityCheckFailed = "error.file.integrity_check_failed"
+	// KeyFileIntegrityMismatch "File content does not match the stored checksum"
+	KeyFileIntegrityMismatch = "error.file.integrity_mismatch"
+	// KeyFileInvalidDepartmentQuota "Department quota must be greater than zero"
+	KeyFileInvalidDepartmentQuota = "error.file.invalid_department_quota"
+	// KeyFileInvalidResponseContentType "Invalid response content type: expected a MIME type such as image/png"
+	KeyFileInvalidResponseContentType = "error.file.invalid_response_content_type"
+	// KeyFileInvalidURLExpiration "Invalid link lifetime: expected a positive number of seconds"
+	KeyFileInvalidURLExpiration = "error.file.invalid_url_expiration"
+	// KeyFileInvalidUserStorageLimit "User limits must be greater than zero"
+	KeyFileInvalidUserStorageLimit = "error.file.invalid_user_storage_limit"
+	// KeyFileLifecycleConditionRequired "A lifecycle rule must have at least one condition"
+	KeyFileLifecycleConditionRequired = "error.file.lifecycle.condition_required"
+	// KeyFileLifecycleDryRunFailed "Failed to evaluate lifecycle rules"
+	KeyFileLifecycleDryRunFailed = "error.file.lifecycle.dry_run_failed"
+	// KeyFileLifecycleInvalidAction "Invalid lifecycle rule action"
+	KeyFileLifecycleInvalidAction = "error.file.lifecycle.invalid_action"
+	// KeyFileLifecycleInvalidDays "Number of days must be between 1 and 36500"
+	KeyFileLifecycleInvalidDays = "error.file.lifecycle.invalid_days"
+	// KeyFileLifecycleInvalidMIMECategory "Unsupported file category for a lifecycle rule"
+	KeyFileLifecycleInvalidMIMECategory = "error.file.lifecycle.invalid_mime_category"
+	// KeyFileLifecycleInvalidName "Rule name must be between 1 and 100 characters"
+	KeyFileLifecycleInvalidName = "error.file.lifecycle.invalid_name"
+	// KeyFileLifecycleListFailed "Failed to get lifecycle rules"
+	KeyFileLifecycleListFailed = "error.file.lifecycle.list_failed"
+	// KeyFileLifecycleNameTaken "A lifecycle rule with this name already exists"
+	KeyFileLifecycleNameTaken = "error.file.lifecycle.name_taken"
+	// KeyFileLifecycleNotFound "Lifecycle rule not found"
+	KeyFileLifecycleNotFound = "error.file.lifecycle.not_found"
+	// KeyFileLifecycleSaveFailed "Failed to save lifecycle rule"
+	KeyFileLifecycleSaveFailed = "error.file.lifecycle.save_failed"
+	// KeyFileLifecycleTooMany "Too many lifecycle rules"
+	KeyFileLifecycleTooMany = "error.file.lifecycle.too_many"
+	// KeyFileLockFailed "Failed to lock file"
+	KeyFileLockFailed = "error.file.lock_failed"
+	// KeyFileLockedByOther "File is locked for editing by another user"
+	KeyFileLockedByOther = "error.file.locked_by_other"
+	// KeyFileMalwareBlocked "Download is blocked: the file contains malware"
+	KeyFileMalwareBlocked = "error.file.malware_blocked"
+	// KeyFileMalwareDetected "The file was rejected: malware detected"
+	KeyFileMalwareDetected = "error.file.malware_detected"
+	// KeyFileMetadataExpiresInPast "Expiration time must be in the future"
+	KeyFileMetadataExpiresInPast = "error.file.metadata.expires_in_past"
+	// KeyFileMetadataInvalidEntity "Entity type and entity ID must be specified together"
+	KeyFileMetadataInvalidEntity = "error.file.metadata.invalid_entity"
+	// KeyFileMetadataInvalidFolder "Invalid folder: up to 255 characters, \"..\" is not allowed"
+	KeyFileMetadataInvalidFolder = "error.file.metadata.invalid_folder"
+	// KeyFileMetadataInvalidType "Metadata field \"{{.key}}\" must be of type {{.type}}"
+	KeyFileMetadataInvalidType = "error.file.metadata_invalid_type"
+	// KeyFileMetadataRequiredKey "Metadata field \"{{.key}}\" is required"
+	KeyFileMetadataRequiredKey = "error.file.metadata_required_key"
+	// KeyFileMetadataReservedKey "Metadata field \"{{.key}}\" is reserved by the service"
+	KeyFileMetadataReservedKey = "error.file.metadata_reserved_key"
+	// KeyFileMetadataSchemaCheckFailed "Failed to check file metadata against the metadata schema"
+	KeyFileMetadataSchemaCheckFailed = "error.file.metadata_schema_check_failed"
+	// KeyFileMetadataUnknownKey "Metadata field \"{{.key}}\" is not allowed by the metadata schema"
+	KeyFileMetadataUnknownKey = "error.file.metadata_unknown_key"
+	// KeyFileMoveFailed "Failed to move file"
+	KeyFileMoveFailed = "error.file.move_failed"
+	// KeyFileNoAccessibleFiles "No accessible files"
+	KeyFileNoAccessibleFiles = "error.file.no_accessible_files"
+	// KeyFileNoFile "No file provided"
+	KeyFileNoFile = "error.file.no_file"
+	// KeyFileNoFilesSelected "No files selected"
+	KeyFileNoFilesSelected = "error.file.no_files_selected"
+	// KeyFileNotFound "File not found"
+	KeyFileNotFound = "error.file.not_found"
+	// KeyFileNotInTrash "File not found in trash"
+	KeyFileNotInTrash = "error.file.not_in_trash"
+	// KeyFileObjectLockComplianceActive "Compliance retention cannot be shortened or changed to governance"
+	KeyFileObjectLockComplianceActive = "error.file.object_lock_compliance_active"
+	// KeyFileObjectLockFailed "Failed to set object lock"
+	KeyFileObjectLockFailed = "error.file.object_lock_failed"
+	// KeyFileObjectLockInvalid "Invalid object lock: mode and retainUntil are set together, retainUntil must be in the future and within {{.max}} years"
+	KeyFileObjectLockInvalid = "error.file.object_lock_invalid"
+	// KeyFileObjectLockNotSupported "Object lock is not enabled for the storage bucket"
+	KeyFileObjectLockNotSupported = "error.file.object_lock_not_supported"
+	// KeyFileObjectLockPermissionDenied "Only administrators can set object lock"
+	KeyFileObjectLockPermissionDenied = "error.file.object_lock_permission_denied"
+	// KeyFileObjectLocked "File is under retention or legal hold and cannot be deleted"
+	KeyFileObjectLocked = "error.file.object_locked"
+	// KeyFilePDFURLFailed "Failed to generate PDF link"
+	KeyFilePDFURLFailed = "error.file.pdf_url_failed"
+	// KeyFilePreviewURLFailed "Failed to generate preview link"
+	KeyFilePreviewURLFailed = "error.file.preview_url_failed"
+	// KeyFileRemoteFetchFailed "Failed to download the file from the URL"
+	KeyFileRemoteFetchFailed = "error.file.remote_fetch_failed"
+	// KeyFileRemoteURLForbidden "The file URL points to a local or private network address"
+	KeyFileRemoteURLForbidden = "error.file.remote_url_forbidden"
+	// KeyFileRemoteURLInvalid "Invalid file URL: expected an http or https link without credentials"
+	KeyFileRemoteURLInvalid = "error.file.remote_url_invalid"
+	// KeyFileRestoreAccessDenied "You can only restore your own files"
+	KeyFileRestoreAccessDenied = "error.file.restore_access_denied"
+	// KeyFileRestoreFailed "Failed to restore file"
+	KeyFileRestoreFailed = "error.file.restore_failed"
+	// KeyFileResumableAbortFailed "Failed to abort upload"
+	KeyFileResumableAbortFailed = "error.file.resumable_abort_failed"
+	// KeyFileResumableExpired "Upload has expired, please start it again"
+	KeyFileResumableExpired = "error.file.resumable_expired"
+	// KeyFileResumableIncomplete "Upload is incomplete: {{.missing}} part(s) missing"
+	KeyFileResumableIncomplete = "error.file.resumable_incomplete"
+	// KeyFileResumableInvalidChecksum "Invalid part checksum: expected base64-encoded MD5 (16 bytes) or CRC32 (4 bytes)"
+	KeyFileResumableInvalidChecksum = "error.file.resumable_invalid_checksum"
+	// KeyFileResumableInvalidPart "Invalid part number"
+	KeyFileResumableInvalidPart = "error.file.resumable_invalid_part"
+	// KeyFileResumableInvalidPartSize "Invalid part size, expected {{.expected}} bytes"
+	KeyFileResumableInvalidPartSize = "error.file.resumable_invalid_part_size"
+	// KeyFileResumableInvalidSize "File size must be greater than zero"
+	KeyFileResumableInvalidSize = "error.file.resumable_invalid_size"
+	// KeyFileResumableNotFound "Upload not found"
+	KeyFileResumableNotFound = "error.file.resumable_not_found"
+	// KeyFileResumablePartChecksumMismatch "Part {{.algorithm}} checksum mismatch: the part was corrupted in transit, upload it again"
+	KeyFileResumablePartChecksumMismatch = "error.file.resumable_part_checksum_mismatch"
+	// KeyFileResumableUnavailable "Resumable uploads are temporarily unavailable. Please try again later"
+	KeyFileResumableUnavailable = "error.file.resumable_unavailable"
+	// KeyFileS3ConnectionFailed "Failed to connect to S3"
+	KeyFileS3ConnectionFailed = "error.file.s3_connection_failed"
+	// KeyFileS3NotConfigured "S3 storage is not configured"
+	KeyFileS3NotConfigured = "error.file.s3_not_configured"
+	// KeyFileScanPending "The file is being checked by antivirus, try again later"
+	KeyFileScanPending = "error.file.scan_pending"
+	// KeyFileServiceShuttingDown "The service is restarting, please retry the upload in a few seconds"
+	KeyFileServiceShuttingDown = "error.file.service_shutting_down"
+	// KeyFileSizeTooLarge "File size is too large"
+	KeyFileSizeTooLarge = "error.file.size_too_large"
+	// KeyFileSomeFilesNotFound "Some files were not found"
+	KeyFileSomeFilesNotFound = "error.file.some_files_not_found"
+	// KeyFileStorageLimitExceeded "Storage limit exceeded: {{.current_usage}} {{.current_unit}} used of {{.limit}} {{.limit_unit}}"
+	KeyFileStorageLimitExceeded = "error.file.storage_limit_exceeded"
+	// KeyFileStorageNotConfigured "Storage is not configured"
+	KeyFileStorageNotConfigured = "error.file.storage_not_configured"
+	// KeyFileStorageStatsFailed "Failed to calculate storage usage"
+	KeyFileStorageStatsFailed = "error.file.storage_stats_failed"
+	// KeyFileTagListFailed "Failed to retrieve tags"
+	KeyFileTagListFailed = "error.file.tag.list_failed"
+	// KeyFileTagRequired "Specify at least one tag"
+	KeyFileTagRequired = "error.file.tag.required"
+	// KeyFileTagSaveFailed "Failed to save tags"
+	KeyFileTagSaveFailed = "error.file.tag.save_failed"
+	// KeyFileTagTooLong "Tag must not exceed 50 characters"
+	KeyFileTagTooLong = "error.file.tag.too_long"
+	// KeyFileTagTooMany "A file can have at most 20 tags"
+	KeyFileTagTooMany = "error.file.tag.too_many"
+	// KeyFileThumbnailURLFailed "Failed to generate thumbnail link"
+	KeyFileThumbnailURLFailed = "error.file.thumbnail_url_failed"
+	// KeyFileTooManyFilesForBatchDelete "Too many files for batch delete"
+	KeyFileTooManyFilesForBatchDelete = "error.file.too_many_files_for_batch_delete"
+	// KeyFileTooManyFilesForBatchUpdate "Too many files for batch update"
+	KeyFileTooManyFilesForBatchUpdate = "error.file.too_many_files_for_batch_update"
+	// KeyFileTooManyFilesForDownloadUrls "Too many files: download links can be requested for up to {{.max}} files at once"
+	KeyFileTooManyFilesForDownloadUrls = "error.file.too_many_files_for_download_urls"
+	// KeyFileTooManyFilesForPermissions "Too many files: permissions can be requested for up to {{.max}} files at once"
+	KeyFileTooManyFilesForPermissions = "error.file.too_many_files_for_permissions"
+	// KeyFileTooManyFilesSelected "Too many files selected"
+	KeyFileTooManyFilesSelected = "error.file.too_many_files_selected"
+	// KeyFileTypeNotAllowed "Files of this type are not allowed"
+	KeyFileTypeNotAllowed = "error.file.type_not_allowed"
+	// KeyFileUnlockFailed "Failed to unlock file"
+	KeyFileUnlockFailed = "error.file.unlock_failed"
+	// KeyFileUpdateFailed "Failed to update file"
+	KeyFileUpdateFailed = "error.file.update_failed"
+	// KeyFileUpdatePermissionDenied "Permission denied to update file"
+	KeyFileUpdatePermissionDenied = "error.file.update_permission_denied"
+	// KeyFileUploadFailed "Failed to upload file"
+	KeyFileUploadFailed = "error.file.upload_failed"
+	// KeyFileUploadPermissionDenied "Permission denied to upload file"
+	KeyFileUploadPermissionDenied = "error.file.upload_permission_denied"
+	// KeyFileUploadTimeout "File upload timed out"
+	KeyFileUploadTimeout = "error.file.upload_timeout"
+	// KeyFileURLGenerationFailed "Failed to generate URL"
+	KeyFileURLGenerationFailed = "error.file.url_generation_failed"
+	// KeyFileUserFileLimitExceeded "You have reached your limit of {{.max}} files"
+	KeyFileUserFileLimitExceeded = "error.file.user_file_limit_exceeded"
+	// KeyFileUserStorageLimitExceeded "Your personal storage limit has been exceeded"
+	KeyFileUserStorageLimitExceeded = "error.file.user_storage_limit_exceeded"
+	// KeyFileUserStorageLimitUpdateFailed "Failed to update user storage limit"
+	KeyFileUserStorageLimitUpdateFailed = "error.file.user_storage_limit_update_failed"
+	// KeyFileViewPermissionDenied "Permission denied to view file"
+	KeyFileViewPermissionDenied = "error.file.view_permission_denied"
+	// KeyFileWatermarkArchiveExcluded "PDF documents must be downloaded individually with a watermark"
+	KeyFileWatermarkArchiveExcluded = "error.file.watermark_archive_excluded"
+	// KeyFileWatermarkFailed "Failed to apply watermark to the document"
+	KeyFileWatermarkFailed = "error.file.watermark_failed"
+	// KeyFileWatermarkUnavailable "PDF watermarking is not available on the server"
+	KeyFileWatermarkUnavailable = "error.file.watermark_unavailable"
+	// KeyFileWatermarkUnsupported "Watermarks are only applied to PDF documents"
+	KeyFileWatermarkUnsupported = "error.file.watermark_unsupported"
+	// KeyFilesetDeleteFailed "Failed to delete file set"
+	KeyFilesetDeleteFailed = "error.fileset.delete_failed"
+	// KeyFilesetDescriptionTooLong "File set description is too long (max 1000 characters)"
+	KeyFilesetDescriptionTooLong = "error.fileset.description_too_long"
+	// KeyFilesetEditPermissionDenied "Only the owner or an administrator can modify this file set"
+	KeyFilesetEditPermissionDenied = "error.fileset.edit_permission_denied"
+	// KeyFilesetGetFailed "Failed to retrieve file sets"
+	KeyFilesetGetFailed = "error.fileset.get_failed"
+	// KeyFilesetNameRequired "File set name is required"
+	KeyFilesetNameRequired = "error.fileset.name_required"
+	// KeyFilesetNameTaken "You already have a file set with this name"
+	KeyFilesetNameTaken = "error.fileset.name_taken"
+	// KeyFilesetNameTooLong "File set name is too long (max 255 characters)"
+	KeyFilesetNameTooLong = "error.fileset.name_too_long"
+	// KeyFilesetNotFound "File set not found"
+	KeyFilesetNotFound = "error.fileset.not_found"
+	// KeyFilesetSaveFailed "Failed to save file set"
+	KeyFilesetSaveFailed = "error.fileset.save_failed"
+	// KeyFilesetTooManySharedUsers "Too many users to share the file set with (max 100)"
+	KeyFilesetTooManySharedUsers = "error.fileset.too_many_shared_users"
+	// KeyImpersonationGetFailed "Failed to retrieve support access grants"
+	KeyImpersonationGetFailed = "error.impersonation.get_failed"
+	// KeyImpersonationGrantFailed "Failed to grant support access"
+	KeyImpersonationGrantFailed = "error.impersonation.grant_failed"
+	// KeyImpersonationInvalidDuration "Access duration must be between 1 and {{.max}} minutes"
+	KeyImpersonationInvalidDuration = "error.impersonation.invalid_duration"
+	// KeyImpersonationInvalidReason "Specify a reason of up to 500 characters"
+	KeyImpersonationInvalidReason = "error.impersonation.invalid_reason"
+	// KeyImpersonationInvalidRole "Support sessions can act only with the member or client role"
+	KeyImpersonationInvalidRole = "error.impersonation.invalid_role"
+	// KeyImpersonationInvalidTarget "Cannot grant access on behalf of yourself or the support administrator"
+	KeyImpersonationInvalidTarget = "error.impersonation.invalid_target"
+	// KeyImpersonationInvalidToken "Support access token is invalid, expired or revoked"
+	KeyImpersonationInvalidToken = "error.impersonation.invalid_token"
+	// KeyImpersonationNotFound "Support access grant not found"
+	KeyImpersonationNotFound = "error.impersonation.not_found"
+	// KeyImpersonationReadOnly "Support sessions on behalf of a user are read-only"
+	KeyImpersonationReadOnly = "error.impersonation.read_only"
+	// KeyImpersonationRevokeFailed "Failed to revoke support access"
+	KeyImpersonationRevokeFailed = "error.impersonation.revoke_failed"
+	// KeyInternalRedisSubscriptionFailed "Failed to subscribe to Redis channel"
+	KeyInternalRedisSubscriptionFailed = "error.internal.redis_subscription_failed"
+	// KeyInternalRedisUnavailable "Redis service is unavailable"
+	KeyInternalRedisUnavailable = "error.internal.redis_unavailable"
+	// KeyLocalizationGetFailed "Failed to retrieve localization settings"
+	KeyLocalizationGetFailed = "error.localization.get_failed"
+	// KeyLocalizationInvalidOverride "Message key and text are required"
+	KeyLocalizationInvalidOverride = "error.localization.invalid_override"
+	// KeyLocalizationOverrideNotFound "Translation override not found"
+	KeyLocalizationOverrideNotFound = "error.localization.override_not_found"
+	// KeyLocalizationOverrideTooLong "Override text is too long"
+	KeyLocalizationOverrideTooLong = "error.localization.override_too_long"
+	// KeyLocalizationUnsupportedLanguage "Language is not supported"
+	KeyLocalizationUnsupportedLanguage = "error.localization.unsupported_language"
+	// KeyLocalizationUpdateFailed "Failed to update localization settings"
+	KeyLocalizationUpdateFailed = "error.localization.update_failed"
+	// KeySubscriptionConnectionLimitExceeded "Too many active subscriptions on this connection"
+	KeySubscriptionConnectionLimitExceeded = "error.subscription.connection_limit_exceeded"
+	// KeySubscriptionUserLimitExceeded "Too many active subscriptions for this user"
+	KeySubscriptionUserLimitExceeded = "error.subscription.user_limit_exceeded"
+	// KeySystemNotImplemented "Feature not implemented"
+	KeySystemNotImplemented = "error.system.not_implemented"
+	// KeyTenantFileMetadataSchemaGetFailed "Failed to get file metadata schemas"
+	KeyTenantFileMetadataSchemaGetFailed = "error.tenant.file_metadata_schema_get_failed"
+	// KeyTenantFileMetadataSchemaUpdateFailed "Failed to update file metadata schemas"
+	KeyTenantFileMetadataSchemaUpdateFailed = "error.tenant.file_metadata_schema_update_failed"
+	// KeyTenantFileTypePolicyGetFailed "Failed to get file type policy"
+	KeyTenantFileTypePolicyGetFailed = "error.tenant.file_type_policy_get_failed"
+	// KeyTenantFileTypePolicyUpdateFailed "Failed to update file type policy"
+	KeyTenantFileTypePolicyUpdateFailed = "error.tenant.file_type_policy_update_failed"
+	// KeyTenantFilenameConflictGetFailed "Failed to get filename conflict mode"
+	KeyTenantFilenameConflictGetFailed = "error.tenant.filename_conflict_get_failed"
+	// KeyTenantFilenameConflictUpdateFailed "Failed to update filename conflict mode"
+	KeyTenantFilenameConflictUpdateFailed = "error.tenant.filename_conflict_update_failed"
+	// KeyTenantImageMetadataGetFailed "Failed to get image metadata mode"
+	KeyTenantImageMetadataGetFailed = "error.tenant.image_metadata_get_failed"
+	// KeyTenantImageMetadataUpdateFailed "Failed to update image metadata mode"
+	KeyTenantImageMetadataUpdateFailed = "error.tenant.image_metadata_update_failed"
+	// KeyTenantInvalidClassificationTags "Classification tags: up to 100 tags of up to 50 characters each"
+	KeyTenantInvalidClassificationTags = "error.tenant.invalid_classification_tags"
+	// KeyTenantInvalidFileMetadataSchema "Invalid metadata schemas: one schema per entity type, up to {{.max}} fields with unique keys (a letter followed by up to 63 letters, digits, '_', '-' or '.')"
+	KeyTenantInvalidFileMetadataSchema = "error.tenant.invalid_file_metadata_schema"
+	// KeyTenantInvalidFileTypePolicy "File type policy contains an invalid extension or MIME type, or more than 200 entries in a list"
+	KeyTenantInvalidFileTypePolicy = "error.tenant.invalid_file_type_policy"
+	// KeyTenantInvalidFilenameConflictMode "Invalid filename conflict mode"
+	KeyTenantInvalidFilenameConflictMode = "error.tenant.invalid_filename_conflict_mode"
+	// KeyTenantInvalidImageMetadataMode "Invalid image metadata mode"
+	KeyTenantInvalidImageMetadataMode = "error.tenant.invalid_image_metadata_mode"
+	// KeyTenantInvalidMaxUploadSize "Maximum upload size must be between 1 and {{.max}} bytes"
+	KeyTenantInvalidMaxUploadSize = "error.tenant.invalid_max_upload_size"
+	// KeyTenantInvalidQuotaGracePercent "Grace percentage must be between 1 and 100"
+	KeyTenantInvalidQuotaGracePercent = "error.tenant.invalid_quota_grace_percent"
+	// KeyTenantInvalidQuotaMode "Invalid storage quota mode"
+	KeyTenantInvalidQuotaMode = "error.tenant.invalid_quota_mode"
+	// KeyTenantInvalidRetentionDays "Retention period must be between 1 and 3650 days"
+	KeyTenantInvalidRetentionDays = "error.tenant.invalid_retention_days"
+	// KeyTenantInvalidState "Invalid tenant state"
+	KeyTenantInvalidState = "error.tenant.invalid_state"
+	// KeyTenantInvalidStorageLimit "Storage limit must not be negative"
+	KeyTenantInvalidStorageLimit = "error.tenant.invalid_storage_limit"
+	// KeyTenantInvalidUploadDescriptionTemplate "Description template must not exceed 1000 characters"
+	KeyTenantInvalidUploadDescriptionTemplate = "error.tenant.invalid_upload_description_template"
+	// KeyTenantNotFound "Tenant not found in request context"
+	KeyTenantNotFound = "error.tenant.not_found"
+	// KeyTenantOffboarding "The organization is being offboarded: files are available in read-only mode"
+	KeyTenantOffboarding = "error.tenant.offboarding"
+	// KeyTenantQuotaGetFailed "Failed to get storage quota mode"
+	KeyTenantQuotaGetFailed = "error.tenant.quota_get_failed"
+	// KeyTenantQuotaUpdateFailed "Failed to update storage quota mode"
+	KeyTenantQuotaUpdateFailed = "error.tenant.quota_update_failed"
+	// KeyTenantRetentionGetFailed "Failed to get default file retention"
+	KeyTenantRetentionGetFailed = "error.tenant.retention_get_failed"
+	// KeyTenantRetentionUpdateFailed "Failed to update default file retention"
+	KeyTenantRetentionUpdateFailed = "error.tenant.retention_update_failed"
+	// KeyTenantStateGetFailed "Failed to retrieve tenant state"
+	KeyTenantStateGetFailed = "error.tenant.state_get_failed"
+	// KeyTenantStateReasonTooLong "State reason is too long"
+	KeyTenantStateReasonTooLong = "error.tenant.state_reason_too_long"
+	// KeyTenantStateUpdateFailed "Failed to update tenant state"
+	KeyTenantStateUpdateFailed = "error.tenant.state_update_failed"
+	// KeyTenantSuspended "The organization is suspended: files are available in read-only mode"
+	KeyTenantSuspended = "error.tenant.suspended"
+	// KeyTenantUploadPolicyGetFailed "Failed to get upload policy"
+	KeyTenantUploadPolicyGetFailed = "error.tenant.upload_policy_get_failed"
+	// KeyTenantUploadPolicyUpdateFailed "Failed to update upload policy"
+	KeyTenantUploadPolicyUpdateFailed = "error.tenant.upload_policy_update_failed"
+	// KeyTenantWatermarkGetFailed "Failed to get watermark policy"
+	KeyTenantWatermarkGetFailed = "error.tenant.watermark_get_failed"
+	// KeyTenantWatermarkUpdateFailed "Failed to update watermark policy"
+	KeyTenantWatermarkUpdateFailed = "error.tenant.watermark_update_failed"
+	// KeyTransactionCommitFailed "Failed to commit transaction"
+	KeyTransactionCommitFailed = "error.transaction.commit_failed"
+	// KeyTransactionFailed "Transaction failed"
+	KeyTransactionFailed = "error.transaction.failed"
+	// KeyUnauthorized "Unauthorized access"
+	KeyUnauthorized = "error.unauthorized"
+	// KeyUserNotAuthenticated "User not authenticated"
+	KeyUserNotAuthenticated = "error.user.not_authenticated"
+)
+
+// AuditAggregateFailed "Failed to aggregate audit events"
+func AuditAggregateFailed(ctx context.Context) error {
+	return newError(ctx, KeyAuditAggregateFailed, nil)
+}
+
+// AuditExportFailed "Failed to export audit events"
+func AuditExportFailed(ctx context.Context) error {
+	return newError(ctx, KeyAuditExportFailed, nil)
+}
+
+// AuditGetFailed "Failed to retrieve audit events"
+func AuditGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyAuditGetFailed, nil)
+}
+
+// AuditInvalidCursor "Invalid pagination cursor"
+func AuditInvalidCursor(ctx context.Context) error {
+	return newError(ctx, KeyAuditInvalidCursor, nil)
+}
+
+// AuditInvalidPeriod "Invalid period: start must be before end and the period must not exceed 366 days"
+func AuditInvalidPeriod(ctx context.Context) error {
+	return newError(ctx, KeyAuditInvalidPeriod, nil)
+}
+
+// BatchAllFailed "None of the selected items could be processed"
+func BatchAllFailed(ctx context.Context) error {
+	return newError(ctx, KeyBatchAllFailed, nil)
+}
+
+// BatchItemFailed "The item could not be processed"
+func BatchItemFailed(ctx context.Context) error {
+	return newError(ctx, KeyBatchItemFailed, nil)
+}
+
+// ConfigInvalidLogLevel "Invalid log level"
+func ConfigInvalidLogLevel(ctx context.Context) error {
+	return newError(ctx, KeyConfigInvalidLogLevel, nil)
+}
+
+// ConfigReloadFailed "Failed to reload service configuration"
+func ConfigReloadFailed(ctx context.Context) error {
+	return newError(ctx, KeyConfigReloadFailed, nil)
+}
+
+// FileAccessDeniedForBatchUpdate "Access denied for batch update"
+func FileAccessDeniedForBatchUpdate(ctx context.Context) error {
+	return newError(ctx, KeyFileAccessDeniedForBatchUpdate, nil)
+}
+
+// FileAlreadyAttached "File is already attached to this entity"
+func FileAlreadyAttached(ctx context.Context) error {
+	return newError(ctx, KeyFileAlreadyAttached, nil)
+}
+
+// FileArchiveCreationFailed "Failed to create archive"
+func FileArchiveCreationFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileArchiveCreationFailed, nil)
+}
+
+// FileArchiveJobNotFound "Archive job not found"
+func FileArchiveJobNotFound(ctx context.Context) error {
+	return newError(ctx, KeyFileArchiveJobNotFound, nil)
+}
+
+// FileArchiveJobUnavailable "Archive jobs are temporarily unavailable"
+func FileArchiveJobUnavailable(ctx context.Context) error {
+	return newError(ctx, KeyFileArchiveJobUnavailable, nil)
+}
+
+// FileArchiveUploadFailed "Failed to upload archive"
+func FileArchiveUploadFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileArchiveUploadFailed, nil)
+}
+
+// FileChecksumInvalid "Invalid checksum: expected a SHA-256 digest as 64 hexadecimal characters"
+func FileChecksumInvalid(ctx context.Context) error {
+	return newError(ctx, KeyFileChecksumInvalid, nil)
+}
+
+// FileChecksumMismatch "File content does not match the provided checksum: the file was corrupted in transit, upload it again"
+func FileChecksumMismatch(ctx context.Context) error {
+	return newError(ctx, KeyFileChecksumMismatch, nil)
+}
+
+// FileClassificationTagRequired "Add at least one classification tag: {{.tags}}"
+func FileClassificationTagRequired(ctx context.Context, tags string) error {
+	return newError(ctx, KeyFileClassificationTagRequired, utils.TemplateData{"tags": tags})
+}
+
+// FileContentTypeMismatch "The file content does not match its type or the file type is not allowed"
+func FileContentTypeMismatch(ctx context.Context) error {
+	return newError(ctx, KeyFileContentTypeMismatch, nil)
+}
+
+// FileCopyFailed "Failed to copy file"
+func FileCopyFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileCopyFailed, nil)
+}
+
+// FileCreateFailed "Failed to create file"
+func FileCreateFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileCreateFailed, nil)
+}
+
+// FileDeleteFailed "Failed to delete file"
+func FileDeleteFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileDeleteFailed, nil)
+}
+
+// FileDeletePermissionDenied "Permission denied to delete file"
+func FileDeletePermissionDenied(ctx context.Context) error {
+	return newError(ctx, KeyFileDeletePermissionDenied, nil)
+}
+
+// FileDeletionScheduleFailed "Failed to schedule file deletion"
+func FileDeletionScheduleFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileDeletionScheduleFailed, nil)
+}
+
+// FileDeletionTimeInvalid "Scheduled deletion time must be in the future"
+func FileDeletionTimeInvalid(ctx context.Context) error {
+	return newError(ctx, KeyFileDeletionTimeInvalid, nil)
+}
+
+// FileDepartmentQuotaExceeded "Your department has exceeded its storage quota"
+func FileDepartmentQuotaExceeded(ctx context.Context) error {
+	return newError(ctx, KeyFileDepartmentQuotaExceeded, nil)
+}
+
+// FileDepartmentQuotaUpdateFailed "Failed to update department quota"
+func FileDepartmentQuotaUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileDepartmentQuotaUpdateFailed, nil)
+}
+
+// FileDescriptionRequired "A description is required for every uploaded file"
+func FileDescriptionRequired(ctx context.Context) error {
+	return newError(ctx, KeyFileDescriptionRequired, nil)
+}
+
+// FileDownloadFailed "Failed to read file content"
+func FileDownloadFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileDownloadFailed, nil)
+}
+
+// FileDuplicateReportFailed "Failed to build the duplicate files report"
+func FileDuplicateReportFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileDuplicateReportFailed, nil)
+}
+
+// FileFavoriteFailed "Failed to update favorites"
+func FileFavoriteFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileFavoriteFailed, nil)
+}
+
+// FileFileTooLargeForStorage "File is too large for storage: {{.file_size}} {{.file_unit}} with a limit of {{.limit}} {{.limit_unit}}"
+func FileFileTooLargeForStorage(ctx context.Context, fileSize string, fileUnit string, limit string, limitUnit string) error {
+	return newError(ctx, KeyFileFileTooLargeForStorage, utils.TemplateData{"file_size": fileSize, "file_unit": fileUnit, "limit": limit, "limit_unit": limitUnit})
+}
+
+// FileFilenameTooLong "Filename is too long"
+func FileFilenameTooLong(ctx context.Context) error {
+	return newError(ctx, KeyFileFilenameTooLong, nil)
+}
+
+// FileGetFailed "Failed to retrieve file"
+func FileGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileGetFailed, nil)
+}
+
+// FileGetFilesFailed "Failed to retrieve files"
+func FileGetFilesFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileGetFilesFailed, nil)
+}
+
+// FileGetUpdatedFilesFailed "Failed to retrieve updated files"
+func FileGetUpdatedFilesFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileGetUpdatedFilesFailed, nil)
+}
+
+// FileImageMetadataStripFailed "Failed to remove metadata from the image: the file is damaged or has an unsupported structure"
+func FileImageMetadataStripFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileImageMetadataStripFailed, nil)
+}
+
+// FileImageMetadataStripTooLarge "Image is too large to remove metadata (maximum {{.max}} MB)"
+func FileImageMetadataStripTooLarge(ctx context.Context, max int) error {
+	return newError(ctx, KeyFileImageMetadataStripTooLarge, utils.TemplateData{"max": max})
+}
+
+// FileImageTransformFailed "Failed to resize the image"
+func FileImageTransformFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileImageTransformFailed, nil)
+}
+
+// FileImageTransformInvalid "Invalid image size: w and h must be between 1 and {{.max}}, fit must be contain or cover"
+func FileImageTransformInvalid(ctx context.Context, max int) error {
+	return newError(ctx, KeyFileImageTransformInvalid, utils.TemplateData{"max": max})
+}
+
+// FileImageTransformUnsupported "Resizing is not supported for this file type"
+func FileImageTransformUnsupported(ctx context.Context) error {
+	return newError(ctx, KeyFileImageTransformUnsupported, nil)
+}
+
+// FileIntegrityCheckFailed "Failed to verify file integrity"
+func FileIntegrityCheckFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileIntegrityCheckFailed, nil)
+}
+
+// FileIntegrityMismatch "File content does not match the stored checksum"
+func FileIntegrityMismatch(ctx context.Context) error {
+	return newError(ctx, KeyFileIntegrityMismatch, nil)
+}
+
+// FileInvalidDepartmentQuota "Department quota must be greater than zero"
+func FileInvalidDepartmentQuota(ctx context.Context) error {
+	return newError(ctx, KeyFileInvalidDepartmentQuota, nil)
+}
+
+// FileInvalidResponseContentType "Invalid response content type: expected a MIME type such as image/png"
+func FileInvalidResponseContentType(ctx context.Context) error {
+	return newError(ctx, KeyFileInvalidResponseContentType, nil)
+}
+
+// FileInvalidURLExpiration "Invalid link lifetime: expected a positive number of seconds"
+func FileInvalidURLExpiration(ctx context.Context) error {
+	return newError(ctx, KeyFileInvalidURLExpiration, nil)
+}
+
+// FileInvalidUserStorageLimit "User limits must be greater than zero"
+func FileInvalidUserStorageLimit(ctx context.Context) error {
+	return newError(ctx, KeyFileInvalidUserStorageLimit, nil)
+}
+
+// FileLifecycleConditionRequired "A lifecycle rule must have at least one condition"
+func FileLifecycleConditionRequired(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleConditionRequired, nil)
+}
+
+// FileLifecycleDryRunFailed "Failed to evaluate lifecycle rules"
+func FileLifecycleDryRunFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleDryRunFailed, nil)
+}
+
+// FileLifecycleInvalidAction "Invalid lifecycle rule action"
+func FileLifecycleInvalidAction(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleInvalidAction, nil)
+}
+
+// FileLifecycleInvalidDays "Number of days must be between 1 and 36500"
+func FileLifecycleInvalidDays(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleInvalidDays, nil)
+}
+
+// FileLifecycleInvalidMIMECategory "Unsupported file category for a lifecycle rule"
+func FileLifecycleInvalidMIMECategory(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleInvalidMIMECategory, nil)
+}
+
+// FileLifecycleInvalidName "Rule name must be between 1 and 100 characters"
+func FileLifecycleInvalidName(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleInvalidName, nil)
+}
+
+// FileLifecycleListFailed "Failed to get lifecycle rules"
+func FileLifecycleListFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleListFailed, nil)
+}
+
+// FileLifecycleNameTaken "A lifecycle rule with this name already exists"
+func FileLifecycleNameTaken(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleNameTaken, nil)
+}
+
+// FileLifecycleNotFound "Lifecycle rule not found"
+func FileLifecycleNotFound(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleNotFound, nil)
+}
+
+// FileLifecycleSaveFailed "Failed to save lifecycle rule"
+func FileLifecycleSaveFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleSaveFailed, nil)
+}
+
+// FileLifecycleTooMany "Too many lifecycle rules"
+func FileLifecycleTooMany(ctx context.Context) error {
+	return newError(ctx, KeyFileLifecycleTooMany, nil)
+}
+
+// FileLockFailed "Failed to lock file"
+func FileLockFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileLockFailed, nil)
+}
+
+// FileLockedByOther "File is locked for editing by another user"
+func FileLockedByOther(ctx context.Context) error {
+	return newError(ctx, KeyFileLockedByOther, nil)
+}
+
+// FileMalwareBlocked "Download is blocked: the file contains malware"
+func FileMalwareBlocked(ctx context.Context) error {
+	return newError(ctx, KeyFileMalwareBlocked, nil)
+}
+
+// FileMalwareDetected "The file was rejected: malware detected"
+func FileMalwareDetected(ctx context.Context) error {
+	return newError(ctx, KeyFileMalwareDetected, nil)
+}
+
+// FileMetadataExpiresInPast "Expiration time must be in the future"
+func FileMetadataExpiresInPast(ctx context.Context) error {
+	return newError(ctx, KeyFileMetadataExpiresInPast, nil)
+}
+
+// FileMetadataInvalidEntity "Entity type and entity ID must be specified together"
+func FileMetadataInvalidEntity(ctx context.Context) error {
+	return newError(ctx, KeyFileMetadataInvalidEntity, nil)
+}
+
+// FileMetadataInvalidFolder "Invalid folder: up to 255 characters, \"..\" is not allowed"
+func FileMetadataInvalidFolder(ctx context.Context) error {
+	return newError(ctx, KeyFileMetadataInvalidFolder, nil)
+}
+
+// FileMetadataInvalidType "Metadata field \"{{.key}}\" must be of type {{.type}}"
+func FileMetadataInvalidType(ctx context.Context, key string, typeValue string) error {
+	return newError(ctx, KeyFileMetadataInvalidType, utils.TemplateData{"key": key, "type": typeValue})
+}
+
+// FileMetadataRequiredKey "Metadata field \"{{.key}}\" is required"
+func FileMetadataRequiredKey(ctx context.Context, key string) error {
+	return newError(ctx, KeyFileMetadataRequiredKey, utils.TemplateData{"key": key})
+}
+
+// FileMetadataReservedKey "Metadata field \"{{.key}}\" is reserved by the service"
+func FileMetadataReservedKey(ctx context.Context, key string) error {
+	return newError(ctx, KeyFileMetadataReservedKey, utils.TemplateData{"key": key})
+}
+
+// FileMetadataSchemaCheckFailed "Failed to check file metadata against the metadata schema"
+func FileMetadataSchemaCheckFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileMetadataSchemaCheckFailed, nil)
+}
+
+// FileMetadataUnknownKey "Metadata field \"{{.key}}\" is not allowed by the metadata schema"
+func FileMetadataUnknownKey(ctx context.Context, key string) error {
+	return newError(ctx, KeyFileMetadataUnknownKey, utils.TemplateData{"key": key})
+}
+
+// FileMoveFailed "Failed to move file"
+func FileMoveFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileMoveFailed, nil)
+}
+
+// FileNoAccessibleFiles "No accessible files"
+func FileNoAccessibleFiles(ctx context.Context) error {
+	return newError(ctx, KeyFileNoAccessibleFiles, nil)
+}
+
+// FileNoFile "No file provided"
+func FileNoFile(ctx context.Context) error {
+	return newError(ctx, KeyFileNoFile, nil)
+}
+
+// FileNoFilesSelected "No files selected"
+func FileNoFilesSelected(ctx context.Context) error {
+	return newError(ctx, KeyFileNoFilesSelected, nil)
+}
+
+// FileNotFound "File not found"
+func FileNotFound(ctx context.Context) error {
+	return newError(ctx, KeyFileNotFound, nil)
+}
+
+// FileNotInTrash "File not found in trash"
+func FileNotInTrash(ctx context.Context) error {
+	return newError(ctx, KeyFileNotInTrash, nil)
+}
+
+// FileObjectLockComplianceActive "Compliance retention cannot be shortened or changed to governance"
+func FileObjectLockComplianceActive(ctx context.Context) error {
+	return newError(ctx, KeyFileObjectLockComplianceActive, nil)
+}
+
+// FileObjectLockFailed "Failed to set object lock"
+func FileObjectLockFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileObjectLockFailed, nil)
+}
+
+// FileObjectLockInvalid "Invalid object lock: mode and retainUntil are set together, retainUntil must be in the future and within {{.max}} years"
+func FileObjectLockInvalid(ctx context.Context, max int) error {
+	return newError(ctx, KeyFileObjectLockInvalid, utils.TemplateData{"max": max})
+}
+
+// FileObjectLockNotSupported "Object lock is not enabled for the storage bucket"
+func FileObjectLockNotSupported(ctx context.Context) error {
+	return newError(ctx, KeyFileObjectLockNotSupported, nil)
+}
+
+// FileObjectLockPermissionDenied "Only administrators can set object lock"
+func FileObjectLockPermissionDenied(ctx context.Context) error {
+	return newError(ctx, KeyFileObjectLockPermissionDenied, nil)
+}
+
+// FileObjectLocked "File is under retention or legal hold and cannot be deleted"
+func FileObjectLocked(ctx context.Context) error {
+	return newError(ctx, KeyFileObjectLocked, nil)
+}
+
+// FilePDFURLFailed "Failed to generate PDF link"
+func FilePDFURLFailed(ctx context.Context) error {
+	return newError(ctx, KeyFilePDFURLFailed, nil)
+}
+
+// FilePreviewURLFailed "Failed to generate preview link"
+func FilePreviewURLFailed(ctx context.Context) error {
+	return newError(ctx, KeyFilePreviewURLFailed, nil)
+}
+
+// FileRemoteFetchFailed "Failed to download the file from the URL"
+func FileRemoteFetchFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileRemoteFetchFailed, nil)
+}
+
+// FileRemoteURLForbidden "The file URL points to a local or private network address"
+func FileRemoteURLForbidden(ctx context.Context) error {
+	return newError(ctx, KeyFileRemoteURLForbidden, nil)
+}
+
+// FileRemoteURLInvalid "Invalid file URL: expected an http or https link without credentials"
+func FileRemoteURLInvalid(ctx context.Context) error {
+	return newError(ctx, KeyFileRemoteURLInvalid, nil)
+}
+
+// FileRestoreAccessDenied "You can only restore your own files"
+func FileRestoreAccessDenied(ctx context.Context) error {
+	return newError(ctx, KeyFileRestoreAccessDenied, nil)
+}
+
+// FileRestoreFailed "Failed to restore file"
+func FileRestoreFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileRestoreFailed, nil)
+}
+
+// FileResumableAbortFailed "Failed to abort upload"
+func FileResumableAbortFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileResumableAbortFailed, nil)
+}
+
+// FileResumableExpired "Upload has expired, please start it again"
+func FileResumableExpired(ctx context.Context) error {
+	return newError(ctx, KeyFileResumableExpired, nil)
+}
+
+// FileResumableIncomplete "Upload is incomplete: {{.missing}} part(s) missing"
+func FileResumableIncomplete(ctx context.Context, missing int) error {
+	return newError(ctx, KeyFileResumableIncomplete, utils.TemplateData{"missing": missing})
+}
+
+// FileResumableInvalidChecksum "Invalid part checksum: expected base64-encoded MD5 (16 bytes) or CRC32 (4 bytes)"
+func FileResumableInvalidChecksum(ctx context.Context) error {
+	return newError(ctx, KeyFileResumableInvalidChecksum, nil)
+}
+
+// FileResumableInvalidPart "Invalid part number"
+func FileResumableInvalidPart(ctx context.Context) error {
+	return newError(ctx, KeyFileResumableInvalidPart, nil)
+}
+
+// FileResumableInvalidPartSize "Invalid part size, expected {{.expected}} bytes"
+func FileResumableInvalidPartSize(ctx context.Context, expected int64) error {
+	return newError(ctx, KeyFileResumableInvalidPartSize, utils.TemplateData{"expected": expected})
+}
+
+// FileResumableInvalidSize "File size must be greater than zero"
+func FileResumableInvalidSize(ctx context.Context) error {
+	return newError(ctx, KeyFileResumableInvalidSize, nil)
+}
+
+// FileResumableNotFound "Upload not found"
+func FileResumableNotFound(ctx context.Context) error {
+	return newError(ctx, KeyFileResumableNotFound, nil)
+}
+
+// FileResumablePartChecksumMismatch "Part {{.algorithm}} checksum mismatch: the part was corrupted in transit, upload it again"
+func FileResumablePartChecksumMismatch(ctx context.Context, algorithm string) error {
+	return newError(ctx, KeyFileResumablePartChecksumMismatch, utils.TemplateData{"algorithm": algorithm})
+}
+
+// FileResumableUnavailable "Resumable uploads are temporarily unavailable. Please try again later"
+func FileResumableUnavailable(ctx context.Context) error {
+	return newError(ctx, KeyFileResumableUnavailable, nil)
+}
+
+// FileS3ConnectionFailed "Failed to connect to S3"
+func FileS3ConnectionFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileS3ConnectionFailed, nil)
+}
+
+// FileS3NotConfigured "S3 storage is not configured"
+func FileS3NotConfigured(ctx context.Context) error {
+	return newError(ctx, KeyFileS3NotConfigured, nil)
+}
+
+// FileScanPending "The file is being checked by antivirus, try again later"
+func FileScanPending(ctx context.Context) error {
+	return newError(ctx, KeyFileScanPending, nil)
+}
+
+// FileServiceShuttingDown "The service is restarting, please retry the upload in a few seconds"
+func FileServiceShuttingDown(ctx context.Context) error {
+	return newError(ctx, KeyFileServiceShuttingDown, nil)
+}
+
+// FileSizeTooLarge "File size is too large"
+func FileSizeTooLarge(ctx context.Context) error {
+	return newError(ctx, KeyFileSizeTooLarge, nil)
+}
+
+// FileSomeFilesNotFound "Some files were not found"
+func FileSomeFilesNotFound(ctx context.Context) error {
+	return newError(ctx, KeyFileSomeFilesNotFound, nil)
+}
+
+// FileStorageLimitExceeded "Storage limit exceeded: {{.current_usage}} {{.current_unit}} used of {{.limit}} {{.limit_unit}}"
+func FileStorageLimitExceeded(ctx context.Context, currentUnit string, currentUsage string, limit string, limitUnit string) error {
+	return newError(ctx, KeyFileStorageLimitExceeded, utils.TemplateData{"current_unit": currentUnit, "current_usage": currentUsage, "limit": limit, "limit_unit": limitUnit})
+}
+
+// FileStorageNotConfigured "Storage is not configured"
+func FileStorageNotConfigured(ctx context.Context) error {
+	return newError(ctx, KeyFileStorageNotConfigured, nil)
+}
+
+// FileStorageStatsFailed "Failed to calculate storage usage"
+func FileStorageStatsFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileStorageStatsFailed, nil)
+}
+
+// FileTagListFailed "Failed to retrieve tags"
+func FileTagListFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileTagListFailed, nil)
+}
+
+// FileTagRequired "Specify at least one tag"
+func FileTagRequired(ctx context.Context) error {
+	return newError(ctx, KeyFileTagRequired, nil)
+}
+
+// FileTagSaveFailed "Failed to save tags"
+func FileTagSaveFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileTagSaveFailed, nil)
+}
+
+// FileTagTooLong "Tag must not exceed 50 characters"
+func FileTagTooLong(ctx context.Context) error {
+	return newError(ctx, KeyFileTagTooLong, nil)
+}
+
+// FileTagTooMany "A file can have at most 20 tags"
+func FileTagTooMany(ctx context.Context) error {
+	return newError(ctx, KeyFileTagTooMany, nil)
+}
+
+// FileThumbnailURLFailed "Failed to generate thumbnail link"
+func FileThumbnailURLFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileThumbnailURLFailed, nil)
+}
+
+// FileTooManyFilesForBatchDelete "Too many files for batch delete"
+func FileTooManyFilesForBatchDelete(ctx context.Context) error {
+	return newError(ctx, KeyFileTooManyFilesForBatchDelete, nil)
+}
+
+// FileTooManyFilesForBatchUpdate "Too many files for batch update"
+func FileTooManyFilesForBatchUpdate(ctx context.Context) error {
+	return newError(ctx, KeyFileTooManyFilesForBatchUpdate, nil)
+}
+
+// FileTooManyFilesForDownloadUrls "Too many files: download links can be requested for up to {{.max}} files at once"
+func FileTooManyFilesForDownloadUrls(ctx context.Context, max int) error {
+	return newError(ctx, KeyFileTooManyFilesForDownloadUrls, utils.TemplateData{"max": max})
+}
+
+// FileTooManyFilesForPermissions "Too many files: permissions can be requested for up to {{.max}} files at once"
+func FileTooManyFilesForPermissions(ctx context.Context, max int) error {
+	return newError(ctx, KeyFileTooManyFilesForPermissions, utils.TemplateData{"max": max})
+}
+
+// FileTooManyFilesSelected "Too many files selected"
+func FileTooManyFilesSelected(ctx context.Context) error {
+	return newError(ctx, KeyFileTooManyFilesSelected, nil)
+}
+
+// FileTypeNotAllowed "Files of this type are not allowed"
+func FileTypeNotAllowed(ctx context.Context) error {
+	return newError(ctx, KeyFileTypeNotAllowed, nil)
+}
+
+// FileUnlockFailed "Failed to unlock file"
+func FileUnlockFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileUnlockFailed, nil)
+}
+
+// FileUpdateFailed "Failed to update file"
+func FileUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileUpdateFailed, nil)
+}
+
+// FileUpdatePermissionDenied "Permission denied to update file"
+func FileUpdatePermissionDenied(ctx context.Context) error {
+	return newError(ctx, KeyFileUpdatePermissionDenied, nil)
+}
+
+// FileUploadFailed "Failed to upload file"
+func FileUploadFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileUploadFailed, nil)
+}
+
+// FileUploadPermissionDenied "Permission denied to upload file"
+func FileUploadPermissionDenied(ctx context.Context) error {
+	return newError(ctx, KeyFileUploadPermissionDenied, nil)
+}
+
+// FileUploadTimeout "File upload timed out"
+func FileUploadTimeout(ctx context.Context) error {
+	return newError(ctx, KeyFileUploadTimeout, nil)
+}
+
+// FileURLGenerationFailed "Failed to generate URL"
+func FileURLGenerationFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileURLGenerationFailed, nil)
+}
+
+// FileUserFileLimitExceeded "You have reached your limit of {{.max}} files"
+func FileUserFileLimitExceeded(ctx context.Context, max int) error {
+	return newError(ctx, KeyFileUserFileLimitExceeded, utils.TemplateData{"max": max})
+}
+
+// FileUserStorageLimitExceeded "Your personal storage limit has been exceeded"
+func FileUserStorageLimitExceeded(ctx context.Context) error {
+	return newError(ctx, KeyFileUserStorageLimitExceeded, nil)
+}
+
+// FileUserStorageLimitUpdateFailed "Failed to update user storage limit"
+func FileUserStorageLimitUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileUserStorageLimitUpdateFailed, nil)
+}
+
+// FileViewPermissionDenied "Permission denied to view file"
+func FileViewPermissionDenied(ctx context.Context) error {
+	return newError(ctx, KeyFileViewPermissionDenied, nil)
+}
+
+// FileWatermarkArchiveExcluded "PDF documents must be downloaded individually with a watermark"
+func FileWatermarkArchiveExcluded(ctx context.Context) error {
+	return newError(ctx, KeyFileWatermarkArchiveExcluded, nil)
+}
+
+// FileWatermarkFailed "Failed to apply watermark to the document"
+func FileWatermarkFailed(ctx context.Context) error {
+	return newError(ctx, KeyFileWatermarkFailed, nil)
+}
+
+// FileWatermarkUnavailable "PDF watermarking is not available on the server"
+func FileWatermarkUnavailable(ctx context.Context) error {
+	return newError(ctx, KeyFileWatermarkUnavailable, nil)
+}
+
+// FileWatermarkUnsupported "Watermarks are only applied to PDF documents"
+func FileWatermarkUnsupported(ctx context.Context) error {
+	return newError(ctx, KeyFileWatermarkUnsupported, nil)
+}
+
+// FilesetDeleteFailed "Failed to delete file set"
+func FilesetDeleteFailed(ctx context.Context) error {
+	return newError(ctx, KeyFilesetDeleteFailed, nil)
+}
+
+// FilesetDescriptionTooLong "File set description is too long (max 1000 characters)"
+func FilesetDescriptionTooLong(ctx context.Context) error {
+	return newError(ctx, KeyFilesetDescriptionTooLong, nil)
+}
+
+// FilesetEditPermissionDenied "Only the owner or an administrator can modify this file set"
+func FilesetEditPermissionDenied(ctx context.Context) error {
+	return newError(ctx, KeyFilesetEditPermissionDenied, nil)
+}
+
+// FilesetGetFailed "Failed to retrieve file sets"
+func FilesetGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyFilesetGetFailed, nil)
+}
+
+// FilesetNameRequired "File set name is required"
+func FilesetNameRequired(ctx context.Context) error {
+	return newError(ctx, KeyFilesetNameRequired, nil)
+}
+
+// FilesetNameTaken "You already have a file set with this name"
+func FilesetNameTaken(ctx context.Context) error {
+	return newError(ctx, KeyFilesetNameTaken, nil)
+}
+
+// FilesetNameTooLong "File set name is too long (max 255 characters)"
+func FilesetNameTooLong(ctx context.Context) error {
+	return newError(ctx, KeyFilesetNameTooLong, nil)
+}
+
+// FilesetNotFound "File set not found"
+func FilesetNotFound(ctx context.Context) error {
+	return newError(ctx, KeyFilesetNotFound, nil)
+}
+
+// FilesetSaveFailed "Failed to save file set"
+func FilesetSaveFailed(ctx context.Context) error {
+	return newError(ctx, KeyFilesetSaveFailed, nil)
+}
+
+// FilesetTooManySharedUsers "Too many users to share the file set with (max 100)"
+func FilesetTooManySharedUsers(ctx context.Context) error {
+	return newError(ctx, KeyFilesetTooManySharedUsers, nil)
+}
+
+// ImpersonationGetFailed "Failed to retrieve support access grants"
+func ImpersonationGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyImpersonationGetFailed, nil)
+}
+
+// ImpersonationGrantFailed "Failed to grant support access"
+func ImpersonationGrantFailed(ctx context.Context) error {
+	return newError(ctx, KeyImpersonationGrantFailed, nil)
+}
+
+// ImpersonationInvalidDuration "Access duration must be between 1 and {{.max}} minutes"
+func ImpersonationInvalidDuration(ctx context.Context, max int) error {
+	return newError(ctx, KeyImpersonationInvalidDuration, utils.TemplateData{"max": max})
+}
+
+// ImpersonationInvalidReason "Specify a reason of up to 500 characters"
+func ImpersonationInvalidReason(ctx context.Context) error {
+	return newError(ctx, KeyImpersonationInvalidReason, nil)
+}
+
+// ImpersonationInvalidRole "Support sessions can act only with the member or client role"
+func ImpersonationInvalidRole(ctx context.Context) error {
+	return newError(ctx, KeyImpersonationInvalidRole, nil)
+}
+
+// ImpersonationInvalidTarget "Cannot grant access on behalf of yourself or the support administrator"
+func ImpersonationInvalidTarget(ctx context.Context) error {
+	return newError(ctx, KeyImpersonationInvalidTarget, nil)
+}
+
+// ImpersonationInvalidToken "Support access token is invalid, expired or revoked"
+func ImpersonationInvalidToken(ctx context.Context) error {
+	return newError(ctx, KeyImpersonationInvalidToken, nil)
+}
+
+// ImpersonationNotFound "Support access grant not found"
+func ImpersonationNotFound(ctx context.Context) error {
+	return newError(ctx, KeyImpersonationNotFound, nil)
+}
+
+// ImpersonationReadOnly "Support sessions on behalf of a user are read-only"
+func ImpersonationReadOnly(ctx context.Context) error {
+	return newError(ctx, KeyImpersonationReadOnly, nil)
+}
+
+// ImpersonationRevokeFailed "Failed to revoke support access"
+func ImpersonationRevokeFailed(ctx context.Context) error {
+	return newError(ctx, KeyImpersonationRevokeFailed, nil)
+}
+
+// InternalRedisSubscriptionFailed "Failed to subscribe to Redis channel"
+func InternalRedisSubscriptionFailed(ctx context.Context) error {
+	return newError(ctx, KeyInternalRedisSubscriptionFailed, nil)
+}
+
+// InternalRedisUnavailable "Redis service is unavailable"
+func InternalRedisUnavailable(ctx context.Context) error {
+	return newError(ctx, KeyInternalRedisUnavailable, nil)
+}
+
+// LocalizationGetFailed "Failed to retrieve localization settings"
+func LocalizationGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyLocalizationGetFailed, nil)
+}
+
+// LocalizationInvalidOverride "Message key and text are required"
+func LocalizationInvalidOverride(ctx context.Context) error {
+	return newError(ctx, KeyLocalizationInvalidOverride, nil)
+}
+
+// LocalizationOverrideNotFound "Translation override not found"
+func LocalizationOverrideNotFound(ctx context.Context) error {
+	return newError(ctx, KeyLocalizationOverrideNotFound, nil)
+}
+
+// LocalizationOverrideTooLong "Override text is too long"
+func LocalizationOverrideTooLong(ctx context.Context) error {
+	return newError(ctx, KeyLocalizationOverrideTooLong, nil)
+}
+
+// LocalizationUnsupportedLanguage "Language is not supported"
+func LocalizationUnsupportedLanguage(ctx context.Context) error {
+	return newError(ctx, KeyLocalizationUnsupportedLanguage, nil)
+}
+
+// LocalizationUpdateFailed "Failed to update localization settings"
+func LocalizationUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyLocalizationUpdateFailed, nil)
+}
+
+// SubscriptionConnectionLimitExceeded "Too many active subscriptions on this connection"
+func SubscriptionConnectionLimitExceeded(ctx context.Context) error {
+	return newError(ctx, KeySubscriptionConnectionLimitExceeded, nil)
+}
+
+// SubscriptionUserLimitExceeded "Too many active subscriptions for this user"
+func SubscriptionUserLimitExceeded(ctx context.Context) error {
+	return newError(ctx, KeySubscriptionUserLimitExceeded, nil)
+}
+
+// SystemNotImplemented "Feature not implemented"
+func SystemNotImplemented(ctx context.Context) error {
+	return newError(ctx, KeySystemNotImplemented, nil)
+}
+
+// TenantFileMetadataSchemaGetFailed "Failed to get file metadata schemas"
+func TenantFileMetadataSchemaGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantFileMetadataSchemaGetFailed, nil)
+}
+
+// TenantFileMetadataSchemaUpdateFailed "Failed to update file metadata schemas"
+func TenantFileMetadataSchemaUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantFileMetadataSchemaUpdateFailed, nil)
+}
+
+// TenantFileTypePolicyGetFailed "Failed to get file type policy"
+func TenantFileTypePolicyGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantFileTypePolicyGetFailed, nil)
+}
+
+// TenantFileTypePolicyUpdateFailed "Failed to update file type policy"
+func TenantFileTypePolicyUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantFileTypePolicyUpdateFailed, nil)
+}
+
+// TenantFilenameConflictGetFailed "Failed to get filename conflict mode"
+func TenantFilenameConflictGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantFilenameConflictGetFailed, nil)
+}
+
+// TenantFilenameConflictUpdateFailed "Failed to update filename conflict mode"
+func TenantFilenameConflictUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantFilenameConflictUpdateFailed, nil)
+}
+
+// TenantImageMetadataGetFailed "Failed to get image metadata mode"
+func TenantImageMetadataGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantImageMetadataGetFailed, nil)
+}
+
+// TenantImageMetadataUpdateFailed "Failed to update image metadata mode"
+func TenantImageMetadataUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantImageMetadataUpdateFailed, nil)
+}
+
+// TenantInvalidClassificationTags "Classification tags: up to 100 tags of up to 50 characters each"
+func TenantInvalidClassificationTags(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidClassificationTags, nil)
+}
+
+// TenantInvalidFileMetadataSchema "Invalid metadata schemas: one schema per entity type, up to {{.max}} fields with unique keys (a letter followed by up to 63 letters, digits, '_', '-' or '.')"
+func TenantInvalidFileMetadataSchema(ctx context.Context, max int) error {
+	return newError(ctx, KeyTenantInvalidFileMetadataSchema, utils.TemplateData{"max": max})
+}
+
+// TenantInvalidFileTypePolicy "File type policy contains an invalid extension or MIME type, or more than 200 entries in a list"
+func TenantInvalidFileTypePolicy(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidFileTypePolicy, nil)
+}
+
+// TenantInvalidFilenameConflictMode "Invalid filename conflict mode"
+func TenantInvalidFilenameConflictMode(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidFilenameConflictMode, nil)
+}
+
+// TenantInvalidImageMetadataMode "Invalid image metadata mode"
+func TenantInvalidImageMetadataMode(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidImageMetadataMode, nil)
+}
+
+// TenantInvalidMaxUploadSize "Maximum upload size must be between 1 and {{.max}} bytes"
+func TenantInvalidMaxUploadSize(ctx context.Context, max int) error {
+	return newError(ctx, KeyTenantInvalidMaxUploadSize, utils.TemplateData{"max": max})
+}
+
+// TenantInvalidQuotaGracePercent "Grace percentage must be between 1 and 100"
+func TenantInvalidQuotaGracePercent(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidQuotaGracePercent, nil)
+}
+
+// TenantInvalidQuotaMode "Invalid storage quota mode"
+func TenantInvalidQuotaMode(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidQuotaMode, nil)
+}
+
+// TenantInvalidRetentionDays "Retention period must be between 1 and 3650 days"
+func TenantInvalidRetentionDays(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidRetentionDays, nil)
+}
+
+// TenantInvalidState "Invalid tenant state"
+func TenantInvalidState(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidState, nil)
+}
+
+// TenantInvalidStorageLimit "Storage limit must not be negative"
+func TenantInvalidStorageLimit(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidStorageLimit, nil)
+}
+
+// TenantInvalidUploadDescriptionTemplate "Description template must not exceed 1000 characters"
+func TenantInvalidUploadDescriptionTemplate(ctx context.Context) error {
+	return newError(ctx, KeyTenantInvalidUploadDescriptionTemplate, nil)
+}
+
+// TenantNotFound "Tenant not found in request context"
+func TenantNotFound(ctx context.Context) error {
+	return newError(ctx, KeyTenantNotFound, nil)
+}
+
+// TenantOffboarding "The organization is being offboarded: files are available in read-only mode"
+func TenantOffboarding(ctx context.Context) error {
+	return newError(ctx, KeyTenantOffboarding, nil)
+}
+
+// TenantQuotaGetFailed "Failed to get storage quota mode"
+func TenantQuotaGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantQuotaGetFailed, nil)
+}
+
+// TenantQuotaUpdateFailed "Failed to update storage quota mode"
+func TenantQuotaUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantQuotaUpdateFailed, nil)
+}
+
+// TenantRetentionGetFailed "Failed to get default file retention"
+func TenantRetentionGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantRetentionGetFailed, nil)
+}
+
+// TenantRetentionUpdateFailed "Failed to update default file retention"
+func TenantRetentionUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantRetentionUpdateFailed, nil)
+}
+
+// TenantStateGetFailed "Failed to retrieve tenant state"
+func TenantStateGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantStateGetFailed, nil)
+}
+
+// TenantStateReasonTooLong "State reason is too long"
+func TenantStateReasonTooLong(ctx context.Context) error {
+	return newError(ctx, KeyTenantStateReasonTooLong, nil)
+}
+
+// TenantStateUpdateFailed "Failed to update tenant state"
+func TenantStateUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantStateUpdateFailed, nil)
+}
+
+// TenantSuspended "The organization is suspended: files are available in read-only mode"
+func TenantSuspended(ctx context.Context) error {
+	return newError(ctx, KeyTenantSuspended, nil)
+}
+
+// TenantUploadPolicyGetFailed "Failed to get upload policy"
+func TenantUploadPolicyGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantUploadPolicyGetFailed, nil)
+}
+
+// TenantUploadPolicyUpdateFailed "Failed to update upload policy"
+func TenantUploadPolicyUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantUploadPolicyUpdateFailed, nil)
+}
+
+// TenantWatermarkGetFailed "Failed to get watermark policy"
+func TenantWatermarkGetFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantWatermarkGetFailed, nil)
+}
+
+// TenantWatermarkUpdateFailed "Failed to update watermark policy"
+func TenantWatermarkUpdateFailed(ctx context.Context) error {
+	return newError(ctx, KeyTenantWatermarkUpdateFailed, nil)
+}
+
+// TransactionCommitFailed "Failed to commit transaction"
+func TransactionCommitFailed(ctx context.Context) error {
+	return newError(ctx, KeyTransactionCommitFailed, nil)
+}
+
+// TransactionFailed "Transaction failed"
+func TransactionFailed(ctx context.Context) error {
+	return newError(ctx, KeyTransactionFailed, nil)
+}
+
+// Unauthorized "Unauthorized access"
+func Unauthorized(ctx context.Context) error {
+	return newError(ctx, KeyUnauthorized, nil)
+}
+
+// UserNotAuthenticated "User not authenticated"
+func UserNotAuthenticated(ctx context.Context) error {
+	return newError(ctx, KeyUserNotAuthenticated, nil)
+}
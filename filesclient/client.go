@@ -0,0 +1,203 @@
+// Package filesclient - типизированный клиент сервиса файлов для внутренних Go сервисов.
+// Заменяет самописные GraphQL запросы: документы операций и типы ответов поддерживаются
+// вместе со схемой сервиса. Пакет вынесен в отдельный модуль без внешних зависимостей.
+package filesclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+)
+
+// HeaderFunc добавляет заголовки к запросу: обычно это заголовки федерации (тенант, пользователь),
+// пробрасываемые из входящего запроса вызывающего сервиса
+type HeaderFunc func(ctx context.Context, header http.Header)
+
+// Option настраивает клиент
+type Option func(*Client)
+
+// WithHTTPClient задает HTTP клиент (таймауты, транспорт)
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithHeaders задает функцию заголовков, вызываемую для каждого запроса
+func WithHeaders(headers HeaderFunc) Option {
+	return func(c *Client) {
+		c.headers = headers
+	}
+}
+
+// Client клиент GraphQL API сервиса файлов
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+	headers    HeaderFunc
+}
+
+// New создает клиент; endpoint - адрес GraphQL сервиса файлов, например http://files:8080/query
+func New(endpoint string, opts ...Option) *Client {
+	c := &Client{
+		endpoint:   endpoint,
+		httpClient: http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// GraphQLError ошибка уровня GraphQL (авторизация, валидация запроса)
+type GraphQLError struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// Errors список ошибок GraphQL ответа
+type Errors []GraphQLError
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Message)
+	}
+	return "graphql: " + strings.Join(messages, "; ")
+}
+
+// OperationError неуспешный результат операции (success: false) с локализованным сообщением сервиса
+type OperationError struct {
+	Operation string
+	Message   string
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Operation, e.Message)
+}
+
+// request тело GraphQL запроса
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// response тело GraphQL ответа
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors Errors          `json:"errors"`
+}
+
+// upload файл для передачи по спецификации GraphQL multipart request
+type upload struct {
+	// variable путь переменной с файлом, например variables.input.file
+	variable    string
+	filename    string
+	contentType string
+	content     io.Reader
+}
+
+// do выполняет операцию и декодирует data в result
+func (c *Client) do(ctx context.Context, req request, result interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("filesclient: encode request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("filesclient: create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	return c.send(httpReq, req.OperationName, result)
+}
+
+// doUpload выполняет операцию с файлом (multipart/form-data); содержимое передается потоком
+func (c *Client) doUpload(ctx context.Context, req request, file upload, result interface{}) error {
+	operations, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("filesclient: encode request: %w", err)
+	}
+	fileMap, err := json.Marshal(map[string][]string{"0": {file.variable}})
+	if err != nil {
+		return fmt.Errorf("filesclient: encode file map: %w", err)
+	}
+
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+	go func() {
+		writer.CloseWithError(writeUploadForm(form, operations, fileMap, file))
+	}()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, reader)
+	if err != nil {
+		_ = reader.Close()
+		return fmt.Errorf("filesclient: create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", form.FormDataContentType())
+
+	return c.send(httpReq, req.OperationName, result)
+}
+
+// writeUploadForm пишет части operations, map и файл в порядке, требуемом спецификацией
+func writeUploadForm(form *multipart.Writer, operations, fileMap []byte, file upload) error {
+	if err := form.WriteField("operations", string(operations)); err != nil {
+		return err
+	}
+	if err := form.WriteField("map", string(fileMap)); err != nil {
+		return err
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", multipart.FileContentDisposition("0", file.filename))
+	if file.contentType != "" {
+		header.Set("Content-Type", file.contentType)
+	} else {
+		header.Set("Content-Type", "application/octet-stream")
+	}
+	part, err := form.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, file.content); err != nil {
+		return err
+	}
+	return form.Close()
+}
+
+// send отправляет запрос и разбирает GraphQL ответ
+func (c *Client) send(httpReq *http.Request, operation string, result interface{}) error {
+	if c.headers != nil {
+		c.headers(httpReq.Context(), httpReq.Header)
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("filesclient: %s: %w", operation, err)
+	}
+	defer httpResp.Body.Close()
+
+	var resp response
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return fmt.Errorf("filesclient: %s: unexpected response (HTTP %d): %w", operation, httpResp.StatusCode, err)
+	}
+	if len(resp.Errors) > 0 {
+		return resp.Errors
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("filesclient: %s: HTTP %d", operation, httpResp.StatusCode)
+	}
+
+	if err := json.Unmarshal(resp.Data, result); err != nil {
+		return fmt.Errorf("filesclient: %s: decode data: %w", operation, err)
+	}
+	return nil
+}
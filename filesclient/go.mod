@@ -0,0 +1,3 @@
+module github.com/esemashko/v2-service-files/filesclient
+
+go 1.25
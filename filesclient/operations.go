@@ -0,0 +1,209 @@
+package filesclient
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// fileFields поля файла, возвращаемые операциями клиента
+const fileFields = `
+fragment FileFields on File {
+    id
+    originalName
+    mimeType
+    size
+    description
+    checksumSha256
+    createTime
+    updateTime
+}`
+
+const uploadFileMutation = `
+mutation UploadFile($input: UploadFileInput!) {
+    uploadFile(input: $input) {
+        success
+        message
+        file { ...FileFields }
+    }
+}` + fileFields
+
+const getDownloadURLMutation = `
+mutation GetFileDownloadURL($id: ID!) {
+    getFileDownloadURL(id: $id) {
+        success
+        message
+        url
+        expiresAt
+    }
+}`
+
+const listFilesQuery = `
+query ListFiles($first: Int, $after: Cursor, $orderBy: [FileOrder!], $where: FileWhereInput) {
+    files(first: $first, after: $after, orderBy: $orderBy, where: $where) {
+        totalCount
+        pageInfo { hasNextPage endCursor }
+        edges { node { ...FileFields } }
+    }
+}` + fileFields
+
+// File файл сервиса
+type File struct {
+	ID             string    `json:"id"`
+	OriginalName   string    `json:"originalName"`
+	MimeType       string    `json:"mimeType"`
+	Size           int64     `json:"size"`
+	Description    *string   `json:"description"`
+	ChecksumSha256 *string   `json:"checksumSha256"`
+	CreateTime     time.Time `json:"createTime"`
+	UpdateTime     time.Time `json:"updateTime"`
+}
+
+// UploadFileInput параметры загрузки файла
+type UploadFileInput struct {
+	Filename    string
+	ContentType string
+	Content     io.Reader
+	Description *string
+}
+
+// DownloadURL временная ссылка на скачивание файла
+type DownloadURL struct {
+	URL       string
+	ExpiresAt *time.Time
+}
+
+// ListFilesOptions параметры выборки файлов; по умолчанию - первые 20 файлов, новые первыми
+type ListFilesOptions struct {
+	First int
+	After string
+	// NameContains фильтр по вхождению в имя файла без учета регистра
+	NameContains string
+	// MimeTypePrefix фильтр по началу MIME типа, например image/
+	MimeTypePrefix string
+}
+
+// FilePage страница файлов
+type FilePage struct {
+	Files       []File
+	TotalCount  int
+	HasNextPage bool
+	EndCursor   string
+}
+
+// defaultListFilesFirst размер страницы ListFiles по умолчанию
+const defaultListFilesFirst = 20
+
+// UploadFile загружает файл (GraphQL multipart request). Содержимое передается потоком без буферизации.
+func (c *Client) UploadFile(ctx context.Context, input UploadFileInput) (*File, error) {
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"file":        nil,
+			"description": input.Description,
+		},
+	}
+
+	var data struct {
+		UploadFile struct {
+			Success bool   `json:"success"`
+			Message string `json:"message"`
+			File    *File  `json:"file"`
+		} `json:"uploadFile"`
+	}
+	err := c.doUpload(ctx, request{Query: uploadFileMutation, OperationName: "UploadFile", Variables: variables}, upload{
+		variable:    "variables.input.file",
+		filename:    input.Filename,
+		contentType: input.ContentType,
+		content:     input.Content,
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+	if !data.UploadFile.Success || data.UploadFile.File == nil {
+		return nil, &OperationError{Operation: "uploadFile", Message: data.UploadFile.Message}
+	}
+	return data.UploadFile.File, nil
+}
+
+// GetDownloadURL возвращает временную ссылку на скачивание файла
+func (c *Client) GetDownloadURL(ctx context.Context, fileID string) (*DownloadURL, error) {
+	var data struct {
+		GetFileDownloadURL struct {
+			Success   bool       `json:"success"`
+			Message   string     `json:"message"`
+			URL       *string    `json:"url"`
+			ExpiresAt *time.Time `json:"expiresAt"`
+		} `json:"getFileDownloadURL"`
+	}
+	err := c.do(ctx, request{
+		Query:         getDownloadURLMutation,
+		OperationName: "GetFileDownloadURL",
+		Variables:     map[string]interface{}{"id": fileID},
+	}, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := data.GetFileDownloadURL
+	if !result.Success || result.URL == nil {
+		return nil, &OperationError{Operation: "getFileDownloadURL", Message: result.Message}
+	}
+	return &DownloadURL{URL: *result.URL, ExpiresAt: result.ExpiresAt}, nil
+}
+
+// ListFiles возвращает страницу файлов, доступных пользователю из заголовков запроса
+func (c *Client) ListFiles(ctx context.Context, opts ListFilesOptions) (*FilePage, error) {
+	first := opts.First
+	if first <= 0 {
+		first = defaultListFilesFirst
+	}
+
+	variables := map[string]interface{}{
+		"first":   first,
+		"orderBy": []map[string]string{{"field": "CREATE_TIME", "direction": "DESC"}},
+	}
+	if opts.After != "" {
+		variables["after"] = opts.After
+	}
+	where := map[string]interface{}{}
+	if opts.NameContains != "" {
+		where["originalNameContainsFold"] = opts.NameContains
+	}
+	if opts.MimeTypePrefix != "" {
+		where["mimeTypeHasPrefix"] = opts.MimeTypePrefix
+	}
+	if len(where) > 0 {
+		variables["where"] = where
+	}
+
+	var data struct {
+		Files struct {
+			TotalCount int `json:"totalCount"`
+			PageInfo   struct {
+				HasNextPage bool    `json:"hasNextPage"`
+				EndCursor   *string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Edges []struct {
+				Node *File `json:"node"`
+			} `json:"edges"`
+		} `json:"files"`
+	}
+	if err := c.do(ctx, request{Query: listFilesQuery, OperationName: "ListFiles", Variables: variables}, &data); err != nil {
+		return nil, err
+	}
+
+	page := &FilePage{
+		Files:       make([]File, 0, len(data.Files.Edges)),
+		TotalCount:  data.Files.TotalCount,
+		HasNextPage: data.Files.PageInfo.HasNextPage,
+	}
+	if data.Files.PageInfo.EndCursor != nil {
+		page.EndCursor = *data.Files.PageInfo.EndCursor
+	}
+	for _, edge := range data.Files.Edges {
+		if edge.Node != nil {
+			page.Files = append(page.Files, *edge.Node)
+		}
+	}
+	return page, nil
+}
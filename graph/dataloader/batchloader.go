@@ -101,10 +101,26 @@ func (l *BatchLoader[K, V]) Load(ctx context.Context, key K) (V, error) {
 	}
 }
 
-// LoadAll loads multiple values
+// LoadAll loads multiple values and fails if any key fails.
+// Use LoadAllPartial when one broken key must not hide the others.
 func (l *BatchLoader[K, V]) LoadAll(ctx context.Context, keys []K) ([]V, error) {
+	results, errs := l.LoadAllPartial(ctx, keys)
+
+	// Check if any errors occurred
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// LoadAllPartial loads multiple values in parallel and returns per-key errors
+// preserving input order: values[i] is the zero value when errs[i] != nil
+func (l *BatchLoader[K, V]) LoadAllPartial(ctx context.Context, keys []K) ([]V, []error) {
 	results := make([]V, len(keys))
-	errors := make([]error, len(keys))
+	errs := make([]error, len(keys))
 
 	var wg sync.WaitGroup
 	wg.Add(len(keys))
@@ -112,20 +128,18 @@ func (l *BatchLoader[K, V]) LoadAll(ctx context.Context, keys []K) ([]V, error)
 	for i, key := range keys {
 		go func(idx int, k K) {
 			defer wg.Done()
-			results[idx], errors[idx] = l.Load(ctx, k)
+			value, err := l.Load(ctx, k)
+			if err != nil {
+				errs[idx] = err
+				return
+			}
+			results[idx] = value
 		}(i, key)
 	}
 
 	wg.Wait()
 
-	// Check if any errors occurred
-	for _, err := range errors {
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return results, nil
+	return results, errs
 }
 
 func (l *BatchLoader[K, V]) executeBatch(ctx context.Context, batch []batchRequest[K, V]) {
@@ -0,0 +1,71 @@
+package dataloader
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+
+	"github.com/google/uuid"
+)
+
+// EntityKey identifies an entity of another service that files are attached to
+type EntityKey struct {
+	Type file.EntityType
+	ID   uuid.UUID
+}
+
+// EntityFilesCountReader batches attached file counts for tickets, comments and messages
+type EntityFilesCountReader struct {
+	client *ent.Client
+}
+
+func NewEntityFilesCountReader(client *ent.Client) *EntityFilesCountReader {
+	return &EntityFilesCountReader{client: client}
+}
+
+// GetFilesCounts returns attached file counts for the given entities preserving input order.
+// All entity types are counted with a single GROUP BY query.
+func (r *EntityFilesCountReader) GetFilesCounts(ctx context.Context, keys []EntityKey) ([]int, []error) {
+	results := make([]int, len(keys))
+	errors := make([]error, len(keys))
+
+	if len(keys) == 0 {
+		return results, errors
+	}
+
+	entityIDs := make([]uuid.UUID, 0, len(keys))
+	for _, key := range keys {
+		entityIDs = append(entityIDs, key.ID)
+	}
+
+	// Wrap context with client for hooks/privacies per project rules
+	ctxWithClient := ent.NewContext(ctx, r.client)
+
+	var rows []struct {
+		EntityType file.EntityType `json:"entity_type"`
+		EntityID   uuid.UUID       `json:"entity_id"`
+		Count      int             `json:"count"`
+	}
+	err := r.client.File.Query().
+		Where(file.EntityIDIn(entityIDs...)).
+		GroupBy(file.FieldEntityType, file.FieldEntityID).
+		Aggregate(ent.Count()).
+		Scan(ctxWithClient, &rows)
+	if err != nil {
+		for i := range errors {
+			errors[i] = err
+		}
+		return results, errors
+	}
+
+	counts := make(map[EntityKey]int, len(rows))
+	for _, row := range rows {
+		counts[EntityKey{Type: row.EntityType, ID: row.EntityID}] = row.Count
+	}
+
+	for i, key := range keys {
+		results[i] = counts[key]
+	}
+
+	return results, errors
+}
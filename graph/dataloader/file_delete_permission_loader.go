@@ -4,6 +4,9 @@ import (
 	"context"
 	"main/ent"
 	"main/ent/file"
+	"main/ent/predicate"
+	fileservice "main/services/file"
+	"time"
 
 	federation "github.com/esemashko/v2-federation"
 
@@ -40,23 +43,21 @@ func (r *FileDeletePermissionReader) GetCanDeleteFlags(ctx context.Context, file
 
 	userRole := federation.GetUserRole(ctx)
 
-	// Admin can delete any file
-	if userRole == "admin" || userRole == "owner" {
-		for i := range results {
-			results[i] = true
-		}
-		return results, errors
-	}
-
 	// Wrap context with client for hooks/privacies per project rules
 	ctxWithClient := ent.NewContext(ctx, r.client)
 
-	// Find files created by the current user among requested IDs
+	// Find files the current user can delete among requested IDs: admins can delete any file,
+	// files locked by another user or under S3 object lock can't be deleted by anyone
+	predicates := []predicate.File{
+		file.IDIn(fileIDs...),
+		file.Or(file.LockedByIsNil(), file.LockedBy(*userID)),
+		fileservice.ObjectLockReleased(time.Now()),
+	}
+	if userRole != "admin" && userRole != "owner" {
+		predicates = append(predicates, file.CreatedBy(*userID))
+	}
 	ownedIDs, err := r.client.File.Query().
-		Where(
-			file.IDIn(fileIDs...),
-			file.CreatedBy(*userID),
-		).
+		Where(predicates...).
 		IDs(ctxWithClient)
 	if err != nil {
 		for i := range errors {
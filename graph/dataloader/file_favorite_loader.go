@@ -0,0 +1,61 @@
+package dataloader
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/filefavorite"
+
+	federation "github.com/esemashko/v2-federation"
+
+	"github.com/google/uuid"
+)
+
+// FileFavoriteReader batches isFavorite checks for File entities
+type FileFavoriteReader struct {
+	client *ent.Client
+}
+
+func NewFileFavoriteReader(client *ent.Client) *FileFavoriteReader {
+	return &FileFavoriteReader{client: client}
+}
+
+// GetFavoriteFlags returns whether the current user starred the given files, preserving input order
+func (r *FileFavoriteReader) GetFavoriteFlags(ctx context.Context, fileIDs []uuid.UUID) ([]bool, []error) {
+	results := make([]bool, len(fileIDs))
+	errors := make([]error, len(fileIDs))
+
+	userID := federation.GetUserID(ctx)
+	if len(fileIDs) == 0 || userID == nil {
+		return results, errors
+	}
+
+	// Wrap context with client for hooks/privacies per project rules
+	ctxWithClient := ent.NewContext(ctx, r.client)
+
+	var starredIDs []uuid.UUID
+	err := r.client.FileFavorite.Query().
+		Where(
+			filefavorite.FileIDIn(fileIDs...),
+			filefavorite.UserID(*userID),
+		).
+		Select(filefavorite.FieldFileID).
+		Scan(ctxWithClient, &starredIDs)
+	if err != nil {
+		for i := range errors {
+			errors[i] = err
+		}
+		return results, errors
+	}
+
+	starredSet := make(map[uuid.UUID]struct{}, len(starredIDs))
+	for _, id := range starredIDs {
+		starredSet[id] = struct{}{}
+	}
+
+	for i, id := range fileIDs {
+		_, ok := starredSet[id]
+		results[i] = ok
+	}
+
+	return results, errors
+}
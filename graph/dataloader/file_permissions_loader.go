@@ -0,0 +1,33 @@
+package dataloader
+
+import (
+	"context"
+	"main/ent"
+	fileservice "main/services/file"
+
+	"github.com/google/uuid"
+)
+
+// FilePermissionsReader batches view/download/update/delete permission checks for File entities
+type FilePermissionsReader struct {
+	client *ent.Client
+}
+
+func NewFilePermissionsReader(client *ent.Client) *FilePermissionsReader {
+	return &FilePermissionsReader{client: client}
+}
+
+// GetPermissions returns permissions of the current user for the given file IDs preserving input order
+func (r *FilePermissionsReader) GetPermissions(ctx context.Context, fileIDs []uuid.UUID) ([]fileservice.FilePermissions, []error) {
+	errors := make([]error, len(fileIDs))
+
+	permissions, err := fileservice.NewFileService().GetFilePermissionsBatch(ctx, r.client, fileIDs)
+	if err != nil {
+		for i := range errors {
+			errors[i] = err
+		}
+		return make([]fileservice.FilePermissions, len(fileIDs)), errors
+	}
+
+	return permissions, errors
+}
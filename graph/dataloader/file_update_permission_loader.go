@@ -0,0 +1,74 @@
+package dataloader
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/predicate"
+
+	federation "github.com/esemashko/v2-federation"
+
+	"github.com/google/uuid"
+)
+
+// FileUpdatePermissionReader batches canUpdate checks for File entities
+type FileUpdatePermissionReader struct {
+	client *ent.Client
+}
+
+func NewFileUpdatePermissionReader(client *ent.Client) *FileUpdatePermissionReader {
+	return &FileUpdatePermissionReader{client: client}
+}
+
+// GetCanUpdateFlags returns canUpdate flags for the given file IDs preserving input order
+func (r *FileUpdatePermissionReader) GetCanUpdateFlags(ctx context.Context, fileIDs []uuid.UUID) ([]bool, []error) {
+	results := make([]bool, len(fileIDs))
+	errors := make([]error, len(fileIDs))
+
+	if len(fileIDs) == 0 {
+		return results, errors
+	}
+
+	// Get current user from federation context
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		// No user in context - can't update
+		return results, errors
+	}
+
+	userRole := federation.GetUserRole(ctx)
+
+	// Wrap context with client for hooks/privacies per project rules
+	ctxWithClient := ent.NewContext(ctx, r.client)
+
+	// Find files the current user can update among requested IDs (same rules as CanUpdateFile):
+	// admins can update any file, files locked by another user can't be updated by anyone
+	predicates := []predicate.File{
+		file.IDIn(fileIDs...),
+		file.Or(file.LockedByIsNil(), file.LockedBy(*userID)),
+	}
+	if userRole != "admin" && userRole != "owner" {
+		predicates = append(predicates, file.CreatedBy(*userID))
+	}
+	updatableIDs, err := r.client.File.Query().
+		Where(predicates...).
+		IDs(ctxWithClient)
+	if err != nil {
+		for i := range errors {
+			errors[i] = err
+		}
+		return results, errors
+	}
+
+	updatableSet := make(map[uuid.UUID]struct{}, len(updatableIDs))
+	for _, id := range updatableIDs {
+		updatableSet[id] = struct{}{}
+	}
+
+	for i, id := range fileIDs {
+		_, ok := updatableSet[id]
+		results[i] = ok
+	}
+
+	return results, errors
+}
@@ -0,0 +1,71 @@
+package dataloader
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/predicate"
+
+	federation "github.com/esemashko/v2-federation"
+
+	"github.com/google/uuid"
+)
+
+// FileViewPermissionReader batches canView checks for File entities
+type FileViewPermissionReader struct {
+	client *ent.Client
+}
+
+func NewFileViewPermissionReader(client *ent.Client) *FileViewPermissionReader {
+	return &FileViewPermissionReader{client: client}
+}
+
+// GetCanViewFlags returns canView flags for the given file IDs preserving input order
+func (r *FileViewPermissionReader) GetCanViewFlags(ctx context.Context, fileIDs []uuid.UUID) ([]bool, []error) {
+	results := make([]bool, len(fileIDs))
+	errors := make([]error, len(fileIDs))
+
+	if len(fileIDs) == 0 {
+		return results, errors
+	}
+
+	// Get current user from federation context
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		// No user in context - can't view
+		return results, errors
+	}
+
+	userRole := federation.GetUserRole(ctx)
+
+	// Wrap context with client for hooks/privacies per project rules
+	ctxWithClient := ent.NewContext(ctx, r.client)
+
+	// Find files the current user can view among requested IDs (same rules as CanViewFile):
+	// admins can view any file, other users only their own files
+	predicates := []predicate.File{file.IDIn(fileIDs...)}
+	if userRole != "admin" && userRole != "owner" {
+		predicates = append(predicates, file.CreatedBy(*userID))
+	}
+	viewableIDs, err := r.client.File.Query().
+		Where(predicates...).
+		IDs(ctxWithClient)
+	if err != nil {
+		for i := range errors {
+			errors[i] = err
+		}
+		return results, errors
+	}
+
+	viewableSet := make(map[uuid.UUID]struct{}, len(viewableIDs))
+	for _, id := range viewableIDs {
+		viewableSet[id] = struct{}{}
+	}
+
+	for i, id := range fileIDs {
+		_, ok := viewableSet[id]
+		results[i] = ok
+	}
+
+	return results, errors
+}
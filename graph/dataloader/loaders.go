@@ -3,6 +3,8 @@ package dataloader
 import (
 	"context"
 	"main/ent"
+	"main/ent/file"
+	fileservice "main/services/file"
 	"time"
 
 	"github.com/google/uuid"
@@ -20,7 +22,16 @@ type Loaders struct {
 	//FederationTenantLoader *BatchLoader[uuid.UUID, *ent.Tenant]
 
 	// File permission loaders
-	FileCanDeleteLoader *BatchLoader[uuid.UUID, bool]
+	FileCanDeleteLoader   *BatchLoader[uuid.UUID, bool]
+	FileCanUpdateLoader   *BatchLoader[uuid.UUID, bool]
+	FileCanViewLoader     *BatchLoader[uuid.UUID, bool]
+	FilePermissionsLoader *BatchLoader[uuid.UUID, fileservice.FilePermissions]
+
+	// Favorite flags of the current user
+	FileIsFavoriteLoader *BatchLoader[uuid.UUID, bool]
+
+	// Attached file counts of tickets, comments and messages
+	EntityFilesCountLoader *BatchLoader[EntityKey, int]
 }
 
 // NewLoaders creates new data loaders
@@ -30,13 +41,31 @@ func NewLoaders(client *ent.Client) *Loaders {
 
 	// File permission readers
 	fileDeletePermissionReader := NewFileDeletePermissionReader(client)
+	fileUpdatePermissionReader := NewFileUpdatePermissionReader(client)
+	fileViewPermissionReader := NewFileViewPermissionReader(client)
+	filePermissionsReader := NewFilePermissionsReader(client)
+
+	// File favorite readers
+	fileFavoriteReader := NewFileFavoriteReader(client)
+
+	// File count readers
+	entityFilesCountReader := NewEntityFilesCountReader(client)
 
 	return &Loaders{
 		// Federation loaders
 		//FederationTenantLoader: NewBatchLoader(federationTenantReader.GetTenantsByID, 2*time.Millisecond, 100),
 
 		// File permission loaders
-		FileCanDeleteLoader: NewBatchLoader(fileDeletePermissionReader.GetCanDeleteFlags, 2*time.Millisecond, 100),
+		FileCanDeleteLoader:   NewBatchLoader(fileDeletePermissionReader.GetCanDeleteFlags, 2*time.Millisecond, 100),
+		FileCanUpdateLoader:   NewBatchLoader(fileUpdatePermissionReader.GetCanUpdateFlags, 2*time.Millisecond, 100),
+		FileCanViewLoader:     NewBatchLoader(fileViewPermissionReader.GetCanViewFlags, 2*time.Millisecond, 100),
+		FilePermissionsLoader: NewBatchLoader(filePermissionsReader.GetPermissions, 2*time.Millisecond, 100),
+
+		// File favorite loaders
+		FileIsFavoriteLoader: NewBatchLoader(fileFavoriteReader.GetFavoriteFlags, 2*time.Millisecond, 100),
+
+		// File count loaders
+		EntityFilesCountLoader: NewBatchLoader(entityFilesCountReader.GetFilesCounts, 2*time.Millisecond, 100),
 	}
 }
 
@@ -56,6 +85,37 @@ func GetFileCanDelete(ctx context.Context, fileID uuid.UUID) (bool, error) {
 	return loaders.FileCanDeleteLoader.Load(ctx, fileID)
 }
 
+// GetFileCanUpdate returns canUpdate flag for a single file
+func GetFileCanUpdate(ctx context.Context, fileID uuid.UUID) (bool, error) {
+	loaders := For(ctx)
+	return loaders.FileCanUpdateLoader.Load(ctx, fileID)
+}
+
+// GetFileCanView returns canView flag for a single file
+func GetFileCanView(ctx context.Context, fileID uuid.UUID) (bool, error) {
+	loaders := For(ctx)
+	return loaders.FileCanViewLoader.Load(ctx, fileID)
+}
+
+// GetFilePermissions returns permissions of the current user for the given files preserving input order
+// with per-file errors: a failed file does not hide the permissions of the others
+func GetFilePermissions(ctx context.Context, fileIDs []uuid.UUID) ([]fileservice.FilePermissions, []error) {
+	loaders := For(ctx)
+	return loaders.FilePermissionsLoader.LoadAllPartial(ctx, fileIDs)
+}
+
+// GetFileIsFavorite returns whether the current user starred a single file
+func GetFileIsFavorite(ctx context.Context, fileID uuid.UUID) (bool, error) {
+	loaders := For(ctx)
+	return loaders.FileIsFavoriteLoader.Load(ctx, fileID)
+}
+
+// GetEntityFilesCount returns the number of files attached to a ticket, comment or message
+func GetEntityFilesCount(ctx context.Context, entityType file.EntityType, entityID uuid.UUID) (int, error) {
+	loaders := For(ctx)
+	return loaders.EntityFilesCountLoader.Load(ctx, EntityKey{Type: entityType, ID: entityID})
+}
+
 // GetFederationTenant gets a Tenant entity for federation resolution
 // This is used by entity resolvers when other services request Tenant entities
 /*func GetFederationTenant(ctx context.Context, userID uuid.UUID) (*ent.Tenant, error) {
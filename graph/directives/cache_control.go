@@ -0,0 +1,29 @@
+package directives
+
+import (
+	"context"
+	"main/graph/model"
+	"main/middleware"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CacheControl директива подсказки кэширования: после успешного резолва поля
+// добавляет подсказку в extensions.cacheControl ответа (scope по умолчанию PUBLIC, maxAge - 0)
+func CacheControl(ctx context.Context, obj interface{}, next graphql.Resolver, maxAge *int, scope *model.CacheControlScope) (interface{}, error) {
+	result, err := next(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	hintMaxAge, hintScope := 0, model.CacheControlScopePublic
+	if maxAge != nil && *maxAge > 0 {
+		hintMaxAge = *maxAge
+	}
+	if scope != nil {
+		hintScope = *scope
+	}
+	middleware.RecordCacheHint(ctx, hintMaxAge, hintScope.String())
+
+	return result, nil
+}
@@ -0,0 +1,18 @@
+package directives
+
+import (
+	"context"
+	"main/security"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Owner директива для проверки на владельца организации
+func Owner(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	errMsg := security.ValidateOwnerAccess(ctx)
+	if errMsg != nil {
+		return nil, errMsg
+	}
+
+	return next(ctx)
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"main/graph/model"
 	"strings"
 	"sync"
 
@@ -134,6 +135,8 @@ func (ec *executionContext) resolveEntityGroup(
 
 func isMulti(typeName string) bool {
 	switch typeName {
+	case "Tenant":
+		return true
 	default:
 		return false
 	}
@@ -170,6 +173,63 @@ func (ec *executionContext) resolveEntity(
 				return nil, fmt.Errorf(`resolving Entity "File": %w`, err)
 			}
 
+			return entity, nil
+		}
+	case "Message":
+		resolverName, err := entityResolverNameForMessage(ctx, rep)
+		if err != nil {
+			return nil, fmt.Errorf(`finding resolver for Entity "Message": %w`, err)
+		}
+		switch resolverName {
+
+		case "findMessageByID":
+			id0, err := ec.unmarshalNID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, rep["id"])
+			if err != nil {
+				return nil, fmt.Errorf(`unmarshalling param 0 for findMessageByID(): %w`, err)
+			}
+			entity, err := ec.resolvers.Entity().FindMessageByID(ctx, id0)
+			if err != nil {
+				return nil, fmt.Errorf(`resolving Entity "Message": %w`, err)
+			}
+
+			return entity, nil
+		}
+	case "Ticket":
+		resolverName, err := entityResolverNameForTicket(ctx, rep)
+		if err != nil {
+			return nil, fmt.Errorf(`finding resolver for Entity "Ticket": %w`, err)
+		}
+		switch resolverName {
+
+		case "findTicketByID":
+			id0, err := ec.unmarshalNID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, rep["id"])
+			if err != nil {
+				return nil, fmt.Errorf(`unmarshalling param 0 for findTicketByID(): %w`, err)
+			}
+			entity, err := ec.resolvers.Entity().FindTicketByID(ctx, id0)
+			if err != nil {
+				return nil, fmt.Errorf(`resolving Entity "Ticket": %w`, err)
+			}
+
+			return entity, nil
+		}
+	case "TicketComment":
+		resolverName, err := entityResolverNameForTicketComment(ctx, rep)
+		if err != nil {
+			return nil, fmt.Errorf(`finding resolver for Entity "TicketComment": %w`, err)
+		}
+		switch resolverName {
+
+		case "findTicketCommentByID":
+			id0, err := ec.unmarshalNID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, rep["id"])
+			if err != nil {
+				return nil, fmt.Errorf(`unmarshalling param 0 for findTicketCommentByID(): %w`, err)
+			}
+			entity, err := ec.resolvers.Entity().FindTicketCommentByID(ctx, id0)
+			if err != nil {
+				return nil, fmt.Errorf(`resolving Entity "TicketComment": %w`, err)
+			}
+
 			return entity, nil
 		}
 	case "User":
@@ -212,6 +272,41 @@ func (ec *executionContext) resolveManyEntities(
 
 	switch typeName {
 
+	case "Tenant":
+		resolverName, err := entityResolverNameForTenant(ctx, reps[0].entity)
+		if err != nil {
+			return fmt.Errorf(`finding resolver for Entity "Tenant": %w`, err)
+		}
+		switch resolverName {
+
+		case "findManyTenantByIDs":
+			typedReps := make([]*model.TenantByIDsInput, len(reps))
+
+			for i, rep := range reps {
+				id0, err := ec.unmarshalNID2githubᚗcomᚋgoogleᚋuuidᚐUUID(ctx, rep.entity["id"])
+				if err != nil {
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
+				}
+
+				typedReps[i] = &model.TenantByIDsInput{
+					ID: id0,
+				}
+			}
+
+			entities, err := ec.resolvers.Entity().FindManyTenantByIDs(ctx, typedReps)
+			if err != nil {
+				return err
+			}
+
+			for i, entity := range entities {
+				list[reps[i].index] = entity
+			}
+			return nil
+
+		default:
+			return fmt.Errorf("unknown resolver: %s", resolverName)
+		}
+
 	default:
 		return errors.New("unknown type: " + typeName)
 	}
@@ -252,6 +347,146 @@ func entityResolverNameForFile(ctx context.Context, rep EntityRepresentation) (s
 		errors.Join(entityResolverErrs...).Error())
 }
 
+func entityResolverNameForMessage(ctx context.Context, rep EntityRepresentation) (string, error) {
+	// we collect errors because a later entity resolver may work fine
+	// when an entity has multiple keys
+	entityResolverErrs := []error{}
+	for {
+		var (
+			m   EntityRepresentation
+			val any
+			ok  bool
+		)
+		_ = val
+		// if all of the KeyFields values for this resolver are null,
+		// we shouldn't use use it
+		allNull := true
+		m = rep
+		val, ok = m["id"]
+		if !ok {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to missing Key Field \"id\" for Message", ErrTypeNotFound))
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		if allNull {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to all null value KeyFields for Message", ErrTypeNotFound))
+			break
+		}
+		return "findMessageByID", nil
+	}
+	return "", fmt.Errorf("%w for Message due to %v", ErrTypeNotFound,
+		errors.Join(entityResolverErrs...).Error())
+}
+
+func entityResolverNameForTenant(ctx context.Context, rep EntityRepresentation) (string, error) {
+	// we collect errors because a later entity resolver may work fine
+	// when an entity has multiple keys
+	entityResolverErrs := []error{}
+	for {
+		var (
+			m   EntityRepresentation
+			val any
+			ok  bool
+		)
+		_ = val
+		// if all of the KeyFields values for this resolver are null,
+		// we shouldn't use use it
+		allNull := true
+		m = rep
+		val, ok = m["id"]
+		if !ok {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to missing Key Field \"id\" for Tenant", ErrTypeNotFound))
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		if allNull {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to all null value KeyFields for Tenant", ErrTypeNotFound))
+			break
+		}
+		return "findManyTenantByIDs", nil
+	}
+	return "", fmt.Errorf("%w for Tenant due to %v", ErrTypeNotFound,
+		errors.Join(entityResolverErrs...).Error())
+}
+
+func entityResolverNameForTicket(ctx context.Context, rep EntityRepresentation) (string, error) {
+	// we collect errors because a later entity resolver may work fine
+	// when an entity has multiple keys
+	entityResolverErrs := []error{}
+	for {
+		var (
+			m   EntityRepresentation
+			val any
+			ok  bool
+		)
+		_ = val
+		// if all of the KeyFields values for this resolver are null,
+		// we shouldn't use use it
+		allNull := true
+		m = rep
+		val, ok = m["id"]
+		if !ok {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to missing Key Field \"id\" for Ticket", ErrTypeNotFound))
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		if allNull {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to all null value KeyFields for Ticket", ErrTypeNotFound))
+			break
+		}
+		return "findTicketByID", nil
+	}
+	return "", fmt.Errorf("%w for Ticket due to %v", ErrTypeNotFound,
+		errors.Join(entityResolverErrs...).Error())
+}
+
+func entityResolverNameForTicketComment(ctx context.Context, rep EntityRepresentation) (string, error) {
+	// we collect errors because a later entity resolver may work fine
+	// when an entity has multiple keys
+	entityResolverErrs := []error{}
+	for {
+		var (
+			m   EntityRepresentation
+			val any
+			ok  bool
+		)
+		_ = val
+		// if all of the KeyFields values for this resolver are null,
+		// we shouldn't use use it
+		allNull := true
+		m = rep
+		val, ok = m["id"]
+		if !ok {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to missing Key Field \"id\" for TicketComment", ErrTypeNotFound))
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		if allNull {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to all null value KeyFields for TicketComment", ErrTypeNotFound))
+			break
+		}
+		return "findTicketCommentByID", nil
+	}
+	return "", fmt.Errorf("%w for TicketComment due to %v", ErrTypeNotFound,
+		errors.Join(entityResolverErrs...).Error())
+}
+
 func entityResolverNameForUser(ctx context.Context, rep EntityRepresentation) (string, error) {
 	// we collect errors because a later entity resolver may work fine
 	// when an entity has multiple keys
@@ -3,26 +3,247 @@
 package model
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"main/ent"
+	file1 "main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/impersonationgrant"
+	"main/ent/lifecyclerule"
+	"main/ent/tenantsetting"
+	"main/services/batch"
+	"main/services/file"
+	"strconv"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/google/uuid"
 )
 
+// Итог пакетной операции: каждый уникальный ID запроса попадает либо в succeeded, либо в failed.
+// Операция успешна (success), если обработан хотя бы один элемент
+type BatchResult interface {
+	IsBatchResult()
+	GetSucceeded() []uuid.UUID
+	GetFailed() []*batch.Failure
+}
+
+type ActiveRequest struct {
+	Kind      string    `json:"kind"`
+	Operation string    `json:"operation"`
+	StartedAt time.Time `json:"startedAt"`
+	ElapsedMs int       `json:"elapsedMs"`
+}
+
+// Фоновая сборка ZIP архива для пакетного скачивания
+type ArchiveJob struct {
+	ID             uuid.UUID        `json:"id"`
+	Status         ArchiveJobStatus `json:"status"`
+	ArchiveName    string           `json:"archiveName"`
+	TotalFiles     int              `json:"totalFiles"`
+	ProcessedFiles int              `json:"processedFiles"`
+	Percent        int              `json:"percent"`
+	URL            *string          `json:"url,omitempty"`
+	ExpiresAt      *time.Time       `json:"expiresAt,omitempty"`
+	Error          *string          `json:"error,omitempty"`
+	Succeeded      []uuid.UUID      `json:"succeeded"`
+	Failed         []*batch.Failure `json:"failed"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+}
+
+func (ArchiveJob) IsBatchResult() {}
+func (this ArchiveJob) GetSucceeded() []uuid.UUID {
+	if this.Succeeded == nil {
+		return nil
+	}
+	interfaceSlice := make([]uuid.UUID, 0, len(this.Succeeded))
+	for _, concrete := range this.Succeeded {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+func (this ArchiveJob) GetFailed() []*batch.Failure {
+	if this.Failed == nil {
+		return nil
+	}
+	interfaceSlice := make([]*batch.Failure, 0, len(this.Failed))
+	for _, concrete := range this.Failed {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+type ArchiveJobResponse struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message"`
+	Job     *ArchiveJob `json:"job,omitempty"`
+}
+
+type AuditLogExportResponse struct {
+	Success   bool       `json:"success"`
+	Message   string     `json:"message"`
+	URL       *string    `json:"url,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	FileName  *string    `json:"fileName,omitempty"`
+	RowCount  int        `json:"rowCount"`
+}
+
 // visibility removed; batch input no longer needed
 type BatchDownloadInput struct {
-	FileIds     []uuid.UUID `json:"fileIds"`
-	ArchiveName *string     `json:"archiveName,omitempty"`
+	FileIds     []uuid.UUID    `json:"fileIds"`
+	ArchiveName *string        `json:"archiveName,omitempty"`
+	Layout      *ArchiveLayout `json:"layout,omitempty"`
 }
 
 type BatchDownloadURLResponse struct {
-	Success     bool       `json:"success"`
-	Message     string     `json:"message"`
-	URL         *string    `json:"url,omitempty"`
-	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
-	ArchiveName *string    `json:"archiveName,omitempty"`
-	TotalFiles  int        `json:"totalFiles"`
+	Success     bool             `json:"success"`
+	Message     string           `json:"message"`
+	URL         *string          `json:"url,omitempty"`
+	ExpiresAt   *time.Time       `json:"expiresAt,omitempty"`
+	ArchiveName *string          `json:"archiveName,omitempty"`
+	TotalFiles  int              `json:"totalFiles"`
+	Succeeded   []uuid.UUID      `json:"succeeded"`
+	Failed      []*batch.Failure `json:"failed"`
+}
+
+func (BatchDownloadURLResponse) IsBatchResult() {}
+func (this BatchDownloadURLResponse) GetSucceeded() []uuid.UUID {
+	if this.Succeeded == nil {
+		return nil
+	}
+	interfaceSlice := make([]uuid.UUID, 0, len(this.Succeeded))
+	for _, concrete := range this.Succeeded {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+func (this BatchDownloadURLResponse) GetFailed() []*batch.Failure {
+	if this.Failed == nil {
+		return nil
+	}
+	interfaceSlice := make([]*batch.Failure, 0, len(this.Failed))
+	for _, concrete := range this.Failed {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+type CategoryStorageUsage struct {
+	Category  FileCategory `json:"category"`
+	Label     string       `json:"label"`
+	FileCount int          `json:"fileCount"`
+	Bytes     int          `json:"bytes"`
+}
+
+type CreateFileSetInput struct {
+	Name        string      `json:"name"`
+	Description *string     `json:"description,omitempty"`
+	FileIds     []uuid.UUID `json:"fileIds"`
+}
+
+type DepartmentStorageUsageListResponse struct {
+	Success     bool                           `json:"success"`
+	Message     string                         `json:"message"`
+	Departments []*file.DepartmentStorageUsage `json:"departments"`
+}
+
+type DepartmentStorageUsageResponse struct {
+	Success bool                         `json:"success"`
+	Message string                       `json:"message"`
+	Usage   *file.DepartmentStorageUsage `json:"usage,omitempty"`
+}
+
+type DuplicateFileGroup struct {
+	MatchedBy      DuplicateFileMatch `json:"matchedBy"`
+	ChecksumSha256 *string            `json:"checksumSha256,omitempty"`
+	Name           *string            `json:"name,omitempty"`
+	Size           int                `json:"size"`
+	FileCount      int                `json:"fileCount"`
+	WastedBytes    int                `json:"wastedBytes"`
+	Files          []*ent.File        `json:"files"`
+}
+
+type DuplicateFilesResponse struct {
+	Success          bool                  `json:"success"`
+	Message          string                `json:"message"`
+	Groups           []*DuplicateFileGroup `json:"groups"`
+	TotalGroups      int                   `json:"totalGroups"`
+	TotalWastedBytes int                   `json:"totalWastedBytes"`
+}
+
+type FileAttachResponse struct {
+	Success      bool              `json:"success"`
+	Message      string            `json:"message"`
+	File         *ent.File         `json:"file,omitempty"`
+	NameConflict *FileNameConflict `json:"nameConflict,omitempty"`
+}
+
+type FileAuditActionBucket struct {
+	Action fileauditevent.Action `json:"action"`
+	Count  int                   `json:"count"`
+}
+
+type FileAuditActorBucket struct {
+	ActorID uuid.UUID `json:"actorId"`
+	Count   int       `json:"count"`
+}
+
+// Агрегаты аудита файлов, посчитанные в БД. Фильтр actions применяется только к actionsByType:
+// скачивания считаются по URL_GENERATED, BATCH_DOWNLOAD и CONTENT_STREAMED
+type FileAuditAggregations struct {
+	From            time.Time                `json:"from"`
+	To              time.Time                `json:"to"`
+	DownloadsPerDay []*FileAuditDayBucket    `json:"downloadsPerDay"`
+	TopDownloaders  []*FileAuditActorBucket  `json:"topDownloaders"`
+	ActionsByType   []*FileAuditActionBucket `json:"actionsByType"`
+}
+
+type FileAuditAggregationsResponse struct {
+	Success      bool                   `json:"success"`
+	Message      string                 `json:"message"`
+	Aggregations *FileAuditAggregations `json:"aggregations,omitempty"`
+}
+
+// Количество событий за день (дата в часовом поясе пользователя, YYYY-MM-DD)
+type FileAuditDayBucket struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+}
+
+type FileAuditEventFilter struct {
+	Actions []fileauditevent.Action `json:"actions,omitempty"`
+	FileID  *uuid.UUID              `json:"fileId,omitempty"`
+	ActorID *uuid.UUID              `json:"actorId,omitempty"`
+	From    *time.Time              `json:"from,omitempty"`
+	To      *time.Time              `json:"to,omitempty"`
+	Search  *string                 `json:"search,omitempty"`
+}
+
+type FileAuditEventItem struct {
+	ID             uuid.UUID             `json:"id"`
+	Action         fileauditevent.Action `json:"action"`
+	FileID         *uuid.UUID            `json:"fileId,omitempty"`
+	ActorID        *uuid.UUID            `json:"actorId,omitempty"`
+	ImpersonatorID *uuid.UUID            `json:"impersonatorId,omitempty"`
+	Details        map[string]any        `json:"details,omitempty"`
+	CreatedAt      time.Time             `json:"createdAt"`
+}
+
+type FileAuditEventListResponse struct {
+	Success     bool                  `json:"success"`
+	Message     string                `json:"message"`
+	Events      []*FileAuditEventItem `json:"events"`
+	TotalCount  int                   `json:"totalCount"`
+	EndCursor   *string               `json:"endCursor,omitempty"`
+	HasNextPage bool                  `json:"hasNextPage"`
+}
+
+// Категория файла с локализованным названием
+type FileCategoryInfo struct {
+	Category FileCategory `json:"category"`
+	Label    string       `json:"label"`
 }
 
 type FileDeleteResponse struct {
@@ -30,11 +251,72 @@ type FileDeleteResponse struct {
 	Message string `json:"message"`
 }
 
+type FileDeleteResult struct {
+	FileID  uuid.UUID `json:"fileId"`
+	Success bool      `json:"success"`
+	Message *string   `json:"message,omitempty"`
+}
+
+type FileDownloadURL struct {
+	FileID      uuid.UUID       `json:"fileId"`
+	URL         string          `json:"url"`
+	ExpiresAt   time.Time       `json:"expiresAt"`
+	Disposition FileDisposition `json:"disposition"`
+	Watermarked bool            `json:"watermarked"`
+}
+
 type FileDownloadURLResponse struct {
-	Success   bool       `json:"success"`
-	Message   string     `json:"message"`
-	URL       *string    `json:"url,omitempty"`
-	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Success     bool             `json:"success"`
+	Message     string           `json:"message"`
+	URL         *string          `json:"url,omitempty"`
+	ExpiresAt   *time.Time       `json:"expiresAt,omitempty"`
+	Disposition *FileDisposition `json:"disposition,omitempty"`
+	Watermarked *bool            `json:"watermarked,omitempty"`
+}
+
+type FileDownloadURLsResponse struct {
+	Success   bool               `json:"success"`
+	Message   string             `json:"message"`
+	Urls      []*FileDownloadURL `json:"urls"`
+	Succeeded []uuid.UUID        `json:"succeeded"`
+	Failed    []*batch.Failure   `json:"failed"`
+}
+
+func (FileDownloadURLsResponse) IsBatchResult() {}
+func (this FileDownloadURLsResponse) GetSucceeded() []uuid.UUID {
+	if this.Succeeded == nil {
+		return nil
+	}
+	interfaceSlice := make([]uuid.UUID, 0, len(this.Succeeded))
+	for _, concrete := range this.Succeeded {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+func (this FileDownloadURLsResponse) GetFailed() []*batch.Failure {
+	if this.Failed == nil {
+		return nil
+	}
+	interfaceSlice := make([]*batch.Failure, 0, len(this.Failed))
+	for _, concrete := range this.Failed {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+type FileEntityInput struct {
+	EntityType file1.EntityType `json:"entityType"`
+	EntityID   uuid.UUID        `json:"entityId"`
+}
+
+type FileIntegrityResponse struct {
+	Success          bool                   `json:"success"`
+	Message          string                 `json:"message"`
+	File             *ent.File              `json:"file,omitempty"`
+	Status           *file1.IntegrityStatus `json:"status,omitempty"`
+	ExpectedChecksum *string                `json:"expectedChecksum,omitempty"`
+	ActualChecksum   *string                `json:"actualChecksum,omitempty"`
+	CheckedAt        *time.Time             `json:"checkedAt,omitempty"`
 }
 
 type FileListResponse struct {
@@ -44,16 +326,132 @@ type FileListResponse struct {
 	TotalCount int         `json:"totalCount"`
 }
 
+type FileMetadataField struct {
+	Key      string                `json:"key"`
+	Type     FileMetadataFieldType `json:"type"`
+	Required bool                  `json:"required"`
+}
+
+type FileMetadataFieldInput struct {
+	Key      string                `json:"key"`
+	Type     FileMetadataFieldType `json:"type"`
+	Required *bool                 `json:"required,omitempty"`
+}
+
+// Схема metadata файлов: схема с entityType применяется к файлам этой сущности, схема без entityType - к остальным.
+// Проверяется при создании файла и изменении metadata или сущности файла; служебные ключи сервиса (folder и др.) не считаются лишними
+type FileMetadataSchema struct {
+	EntityType      *file1.EntityType    `json:"entityType,omitempty"`
+	Fields          []*FileMetadataField `json:"fields"`
+	AllowAdditional bool                 `json:"allowAdditional"`
+}
+
+type FileMetadataSchemaInput struct {
+	EntityType      *file1.EntityType         `json:"entityType,omitempty"`
+	Fields          []*FileMetadataFieldInput `json:"fields"`
+	AllowAdditional *bool                     `json:"allowAdditional,omitempty"`
+}
+
+// Файл с таким же именем (без учета регистра) уже прикреплен к той же сущности (entityType/entityId)
+// или папке (metadata.folder); обработка задается режимом тенанта TenantFilenameConflictMode
+type FileNameConflict struct {
+	OriginalName     string      `json:"originalName"`
+	ResolvedName     string      `json:"resolvedName"`
+	Renamed          bool        `json:"renamed"`
+	ConflictingFiles []*ent.File `json:"conflictingFiles"`
+}
+
+type FileObjectLockInput struct {
+	Mode        *file1.ObjectLockMode `json:"mode,omitempty"`
+	RetainUntil *time.Time            `json:"retainUntil,omitempty"`
+	LegalHold   *bool                 `json:"legalHold,omitempty"`
+}
+
+// Права текущего пользователя на файл; ненайденный или недоступный по тенанту файл возвращается с found = false
+type FilePermissions struct {
+	FileID      uuid.UUID `json:"fileId"`
+	Found       bool      `json:"found"`
+	CanView     bool      `json:"canView"`
+	CanDownload bool      `json:"canDownload"`
+	CanUpdate   bool      `json:"canUpdate"`
+	CanDelete   bool      `json:"canDelete"`
+}
+
+// Права на файлы пакета: файлы, права на которые не удалось получить, перечисляются в failed
+// и не попадают в permissions; success ложен, только если не удалось получить права ни на один файл
+type FilePermissionsBatchResponse struct {
+	Success     bool               `json:"success"`
+	Message     string             `json:"message"`
+	Permissions []*FilePermissions `json:"permissions"`
+	Failed      []*batch.Failure   `json:"failed"`
+}
+
+// Обязательные поля и лимит размера загрузки файлов тенанта. Проверяются в uploadFile и startResumableUpload;
+// при справочнике классификации возобновляемая загрузка недоступна, так как не принимает теги
+type FilePolicy struct {
+	RequireDescription     bool     `json:"requireDescription"`
+	DescriptionTemplate    *string  `json:"descriptionTemplate,omitempty"`
+	ClassificationTags     []string `json:"classificationTags"`
+	MaxUploadSize          *int     `json:"maxUploadSize,omitempty"`
+	EffectiveMaxUploadSize int      `json:"effectiveMaxUploadSize"`
+}
+
+type FilePolicyInput struct {
+	RequireDescription  bool     `json:"requireDescription"`
+	DescriptionTemplate *string  `json:"descriptionTemplate,omitempty"`
+	ClassificationTags  []string `json:"classificationTags,omitempty"`
+	MaxUploadSize       *int     `json:"maxUploadSize,omitempty"`
+}
+
+type FilePolicyResponse struct {
+	Success    bool        `json:"success"`
+	Message    string      `json:"message"`
+	FilePolicy *FilePolicy `json:"filePolicy,omitempty"`
+}
+
 type FileResponse struct {
 	Success bool      `json:"success"`
 	Message string    `json:"message"`
 	File    *ent.File `json:"file,omitempty"`
 }
 
+type FileSetDeleteResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// Сохраненный набор файлов (например, комплект документов для выгрузки)
+type FileSetItem struct {
+	ID               uuid.UUID   `json:"id"`
+	Name             string      `json:"name"`
+	Description      *string     `json:"description,omitempty"`
+	FileIds          []uuid.UUID `json:"fileIds"`
+	Files            []*ent.File `json:"files"`
+	SharedWithTenant bool        `json:"sharedWithTenant"`
+	SharedUserIds    []uuid.UUID `json:"sharedUserIds"`
+	CreatedBy        uuid.UUID   `json:"createdBy"`
+	CreateTime       time.Time   `json:"createTime"`
+	UpdateTime       time.Time   `json:"updateTime"`
+	CanEdit          bool        `json:"canEdit"`
+}
+
+type FileSetListResponse struct {
+	Success  bool           `json:"success"`
+	Message  string         `json:"message"`
+	FileSets []*FileSetItem `json:"fileSets"`
+}
+
+type FileSetResponse struct {
+	Success bool         `json:"success"`
+	Message string       `json:"message"`
+	FileSet *FileSetItem `json:"fileSet,omitempty"`
+}
+
 type FileUploadResponse struct {
-	Success bool      `json:"success"`
-	Message string    `json:"message"`
-	File    *ent.File `json:"file,omitempty"`
+	Success      bool              `json:"success"`
+	Message      string            `json:"message"`
+	File         *ent.File         `json:"file,omitempty"`
+	NameConflict *FileNameConflict `json:"nameConflict,omitempty"`
 }
 
 type FilesBatchResponse struct {
@@ -63,12 +461,1053 @@ type FilesBatchResponse struct {
 	TotalUpdated int         `json:"totalUpdated"`
 }
 
+type FilesDeleteResponse struct {
+	Success      bool                `json:"success"`
+	Message      string              `json:"message"`
+	Succeeded    []uuid.UUID         `json:"succeeded"`
+	Failed       []*batch.Failure    `json:"failed"`
+	TotalDeleted int                 `json:"totalDeleted"`
+	TotalFailed  int                 `json:"totalFailed"`
+	Results      []*FileDeleteResult `json:"results"`
+}
+
+func (FilesDeleteResponse) IsBatchResult() {}
+func (this FilesDeleteResponse) GetSucceeded() []uuid.UUID {
+	if this.Succeeded == nil {
+		return nil
+	}
+	interfaceSlice := make([]uuid.UUID, 0, len(this.Succeeded))
+	for _, concrete := range this.Succeeded {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+func (this FilesDeleteResponse) GetFailed() []*batch.Failure {
+	if this.Failed == nil {
+		return nil
+	}
+	interfaceSlice := make([]*batch.Failure, 0, len(this.Failed))
+	for _, concrete := range this.Failed {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+type FilesTagResponse struct {
+	Success   bool             `json:"success"`
+	Message   string           `json:"message"`
+	Files     []*ent.File      `json:"files"`
+	Succeeded []uuid.UUID      `json:"succeeded"`
+	Failed    []*batch.Failure `json:"failed"`
+}
+
+func (FilesTagResponse) IsBatchResult() {}
+func (this FilesTagResponse) GetSucceeded() []uuid.UUID {
+	if this.Succeeded == nil {
+		return nil
+	}
+	interfaceSlice := make([]uuid.UUID, 0, len(this.Succeeded))
+	for _, concrete := range this.Succeeded {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+func (this FilesTagResponse) GetFailed() []*batch.Failure {
+	if this.Failed == nil {
+		return nil
+	}
+	interfaceSlice := make([]*batch.Failure, 0, len(this.Failed))
+	for _, concrete := range this.Failed {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+type GrantImpersonationInput struct {
+	SupportUserID       uuid.UUID                     `json:"supportUserId"`
+	TargetUserID        uuid.UUID                     `json:"targetUserId"`
+	TargetRole          impersonationgrant.TargetRole `json:"targetRole"`
+	TargetDepartmentIds []uuid.UUID                   `json:"targetDepartmentIds,omitempty"`
+	DurationMinutes     int                           `json:"durationMinutes"`
+	Reason              string                        `json:"reason"`
+}
+
+type ImpersonationGrantListResponse struct {
+	Success bool                      `json:"success"`
+	Message string                    `json:"message"`
+	Grants  []*ent.ImpersonationGrant `json:"grants"`
+}
+
+type ImpersonationGrantResponse struct {
+	Success bool                    `json:"success"`
+	Message string                  `json:"message"`
+	Grant   *ent.ImpersonationGrant `json:"grant,omitempty"`
+	Token   *string                 `json:"token,omitempty"`
+}
+
+type LifecycleDryRunResponse struct {
+	Success bool                        `json:"success"`
+	Message string                      `json:"message"`
+	Results []*file.LifecycleRuleDryRun `json:"results"`
+}
+
+type LifecycleRuleDeleteResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+type LifecycleRuleInput struct {
+	Name         string               `json:"name"`
+	Enabled      *bool                `json:"enabled,omitempty"`
+	Action       lifecyclerule.Action `json:"action"`
+	MinAgeDays   *int                 `json:"minAgeDays,omitempty"`
+	MinIdleDays  *int                 `json:"minIdleDays,omitempty"`
+	Tag          *string              `json:"tag,omitempty"`
+	MimeCategory *FileCategory        `json:"mimeCategory,omitempty"`
+}
+
+// Правило жизненного цикла тенанта; условия объединяются через И и применяются планировщиком
+type LifecycleRuleItem struct {
+	ID           uuid.UUID            `json:"id"`
+	Name         string               `json:"name"`
+	Enabled      bool                 `json:"enabled"`
+	Action       lifecyclerule.Action `json:"action"`
+	MinAgeDays   *int                 `json:"minAgeDays,omitempty"`
+	MinIdleDays  *int                 `json:"minIdleDays,omitempty"`
+	Tag          *string              `json:"tag,omitempty"`
+	MimeCategory *FileCategory        `json:"mimeCategory,omitempty"`
+	CreatedBy    uuid.UUID            `json:"createdBy"`
+	LastRunAt    *time.Time           `json:"lastRunAt,omitempty"`
+	CreateTime   time.Time            `json:"createTime"`
+	UpdateTime   time.Time            `json:"updateTime"`
+}
+
+type LifecycleRuleListResponse struct {
+	Success bool                 `json:"success"`
+	Message string               `json:"message"`
+	Rules   []*LifecycleRuleItem `json:"rules"`
+}
+
+type LifecycleRuleResponse struct {
+	Success bool               `json:"success"`
+	Message string             `json:"message"`
+	Rule    *LifecycleRuleItem `json:"rule,omitempty"`
+}
+
+type LogLevelResponse struct {
+	Success bool           `json:"success"`
+	Message string         `json:"message"`
+	State   *LogLevelState `json:"state,omitempty"`
+}
+
+// Уровень логирования сервиса
+type LogLevelState struct {
+	Level     string     `json:"level"`
+	BaseLevel string     `json:"baseLevel"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type MonthStorageUsage struct {
+	Month     string `json:"month"`
+	FileCount int    `json:"fileCount"`
+	Bytes     int    `json:"bytes"`
+}
+
+type MyStorageUsageResponse struct {
+	Success bool                   `json:"success"`
+	Message string                 `json:"message"`
+	Usage   *file.UserStorageUsage `json:"usage,omitempty"`
+}
+
+type OperationAuditLogFilter struct {
+	OperationName *string    `json:"operationName,omitempty"`
+	ActorID       *uuid.UUID `json:"actorId,omitempty"`
+	Success       *bool      `json:"success,omitempty"`
+	From          *time.Time `json:"from,omitempty"`
+	To            *time.Time `json:"to,omitempty"`
+}
+
+// Запись журнала мутаций администраторов и владельцев
+type OperationAuditLogItem struct {
+	ID             uuid.UUID      `json:"id"`
+	OperationName  string         `json:"operationName"`
+	Variables      map[string]any `json:"variables,omitempty"`
+	ActorID        *uuid.UUID     `json:"actorId,omitempty"`
+	ActorRole      *string        `json:"actorRole,omitempty"`
+	ImpersonatorID *uuid.UUID     `json:"impersonatorId,omitempty"`
+	Success        bool           `json:"success"`
+	ErrorMessage   *string        `json:"errorMessage,omitempty"`
+	DurationMs     int            `json:"durationMs"`
+	CreatedAt      time.Time      `json:"createdAt"`
+}
+
+type OperationAuditLogListResponse struct {
+	Success    bool                     `json:"success"`
+	Message    string                   `json:"message"`
+	Logs       []*OperationAuditLogItem `json:"logs"`
+	TotalCount int                      `json:"totalCount"`
+}
+
+// Состояние загрузки по частям; позволяет продолжить загрузку после обрыва соединения или перезапуска
+type ResumableUpload struct {
+	ID            uuid.UUID              `json:"id"`
+	Filename      string                 `json:"filename"`
+	ContentType   string                 `json:"contentType"`
+	Description   *string                `json:"description,omitempty"`
+	Size          int                    `json:"size"`
+	PartSize      int                    `json:"partSize"`
+	TotalParts    int                    `json:"totalParts"`
+	UploadedParts []*ResumableUploadPart `json:"uploadedParts"`
+	MissingParts  []int                  `json:"missingParts"`
+	UploadedBy    uuid.UUID              `json:"uploadedBy"`
+	CreatedAt     time.Time              `json:"createdAt"`
+	ExpiresAt     time.Time              `json:"expiresAt"`
+}
+
+type ResumableUploadAbortResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+type ResumableUploadListResponse struct {
+	Success bool               `json:"success"`
+	Message string             `json:"message"`
+	Uploads []*ResumableUpload `json:"uploads"`
+}
+
+type ResumableUploadPart struct {
+	PartNumber int       `json:"partNumber"`
+	Size       int       `json:"size"`
+	Etag       string    `json:"etag"`
+	UploadedAt time.Time `json:"uploadedAt"`
+	Md5        *string   `json:"md5,omitempty"`
+}
+
+type ResumableUploadResponse struct {
+	Success bool             `json:"success"`
+	Message string           `json:"message"`
+	Upload  *ResumableUpload `json:"upload,omitempty"`
+}
+
+// Выполняющиеся запросы тенанта на экземпляре сервиса, обработавшем запрос
+type ServiceActivity struct {
+	HTTPRequests    int                         `json:"httpRequests"`
+	GraphqlRequests int                         `json:"graphqlRequests"`
+	WebSockets      int                         `json:"webSockets"`
+	Operations      []*ServiceActivityOperation `json:"operations"`
+	Longest         []*ActiveRequest            `json:"longest"`
+}
+
+type ServiceActivityOperation struct {
+	Operation string `json:"operation"`
+	Count     int    `json:"count"`
+}
+
+type ServiceActivityResponse struct {
+	Success  bool             `json:"success"`
+	Message  string           `json:"message"`
+	Activity *ServiceActivity `json:"activity,omitempty"`
+}
+
+// Настройки сервиса, применяемые без перезапуска
+type ServiceConfig struct {
+	LogLevel             *string        `json:"logLevel,omitempty"`
+	MaxUploadSize        int            `json:"maxUploadSize"`
+	MaxBatchArchiveFiles int            `json:"maxBatchArchiveFiles"`
+	MaxBatchDeleteFiles  int            `json:"maxBatchDeleteFiles"`
+	FilesDefaultOrder    string         `json:"filesDefaultOrder"`
+	Features             map[string]any `json:"features"`
+}
+
+type ServiceConfigResponse struct {
+	Success bool           `json:"success"`
+	Message string         `json:"message"`
+	Config  *ServiceConfig `json:"config,omitempty"`
+}
+
+type ShareFileSetInput struct {
+	SharedWithTenant bool        `json:"sharedWithTenant"`
+	UserIds          []uuid.UUID `json:"userIds,omitempty"`
+}
+
+type StartResumableUploadInput struct {
+	Filename    string  `json:"filename"`
+	Size        int     `json:"size"`
+	ContentType *string `json:"contentType,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Объем и месячная стоимость хранения одного класса
+type StorageClassCost struct {
+	StorageClass    StorageClass `json:"storageClass"`
+	FileCount       int          `json:"fileCount"`
+	Bytes           int          `json:"bytes"`
+	PricePerGbMonth float64      `json:"pricePerGbMonth"`
+	MonthlyCost     float64      `json:"monthlyCost"`
+}
+
+// Оценка месячной стоимости вложений тенанта
+type StorageCostEstimate struct {
+	Currency         string              `json:"currency"`
+	From             time.Time           `json:"from"`
+	To               time.Time           `json:"to"`
+	StorageClasses   []*StorageClassCost `json:"storageClasses"`
+	StorageCost      float64             `json:"storageCost"`
+	EgressDownloads  int                 `json:"egressDownloads"`
+	EgressBytes      int                 `json:"egressBytes"`
+	EgressPricePerGb float64             `json:"egressPricePerGb"`
+	EgressCost       float64             `json:"egressCost"`
+	TotalMonthlyCost float64             `json:"totalMonthlyCost"`
+}
+
+type StorageCostEstimateResponse struct {
+	Success  bool                 `json:"success"`
+	Message  string               `json:"message"`
+	Estimate *StorageCostEstimate `json:"estimate,omitempty"`
+}
+
+// Разбивка использования хранилища тенанта; учитывает файлы в корзине, как и квота
+type StorageUsageBreakdown struct {
+	TotalBytes   int                     `json:"totalBytes"`
+	FileCount    int                     `json:"fileCount"`
+	TrashedBytes int                     `json:"trashedBytes"`
+	ByUploader   []*UploaderStorageUsage `json:"byUploader"`
+	ByCategory   []*CategoryStorageUsage `json:"byCategory"`
+	ByMonth      []*MonthStorageUsage    `json:"byMonth"`
+	ComputedAt   time.Time               `json:"computedAt"`
+}
+
+type StorageUsageResponse struct {
+	Success bool                   `json:"success"`
+	Message string                 `json:"message"`
+	Usage   *StorageUsageBreakdown `json:"usage,omitempty"`
+}
+
+type TagListResponse struct {
+	Success bool       `json:"success"`
+	Message string     `json:"message"`
+	Tags    []*ent.Tag `json:"tags"`
+}
+
+type TenantByIDsInput struct {
+	ID uuid.UUID `json:"ID"`
+}
+
+type TenantFileMetadataSchemasResponse struct {
+	Success bool                  `json:"success"`
+	Message string                `json:"message"`
+	Schemas []*FileMetadataSchema `json:"schemas,omitempty"`
+}
+
+// Разрешенные и запрещенные типы загружаемых файлов. Проверяются в uploadFile и возобновляемой загрузке
+// по расширению имени файла и по типу содержимого (заявленному и обнаруженному по сигнатуре).
+// Запреты имеют приоритет; пустой список разрешенных не ограничивает загрузку. Отклоненные загрузки
+// фиксируются в аудите (FILE_TYPE_BLOCKED)
+type TenantFileTypePolicy struct {
+	AllowedExtensions []string `json:"allowedExtensions"`
+	BlockedExtensions []string `json:"blockedExtensions"`
+	AllowedMimeTypes  []string `json:"allowedMimeTypes"`
+	BlockedMimeTypes  []string `json:"blockedMimeTypes"`
+}
+
+type TenantFileTypePolicyInput struct {
+	AllowedExtensions []string `json:"allowedExtensions,omitempty"`
+	BlockedExtensions []string `json:"blockedExtensions,omitempty"`
+	AllowedMimeTypes  []string `json:"allowedMimeTypes,omitempty"`
+	BlockedMimeTypes  []string `json:"blockedMimeTypes,omitempty"`
+}
+
+type TenantFileTypePolicyResponse struct {
+	Success        bool                  `json:"success"`
+	Message        string                `json:"message"`
+	FileTypePolicy *TenantFileTypePolicy `json:"fileTypePolicy,omitempty"`
+}
+
+type TenantFilenameConflictPolicy struct {
+	Mode tenantsetting.FilenameConflictMode `json:"mode"`
+}
+
+type TenantFilenameConflictPolicyResponse struct {
+	Success                bool                          `json:"success"`
+	Message                string                        `json:"message"`
+	FilenameConflictPolicy *TenantFilenameConflictPolicy `json:"filenameConflictPolicy,omitempty"`
+}
+
+type TenantImageMetadataPolicy struct {
+	Mode tenantsetting.ImageMetadataMode `json:"mode"`
+}
+
+type TenantImageMetadataPolicyResponse struct {
+	Success             bool                       `json:"success"`
+	Message             string                     `json:"message"`
+	ImageMetadataPolicy *TenantImageMetadataPolicy `json:"imageMetadataPolicy,omitempty"`
+}
+
+type TenantLocaleSettings struct {
+	DefaultLanguage    *string                    `json:"defaultLanguage,omitempty"`
+	SupportedLanguages []string                   `json:"supportedLanguages"`
+	Overrides          []*TranslationOverrideItem `json:"overrides"`
+}
+
+type TenantLocaleSettingsResponse struct {
+	Success  bool                  `json:"success"`
+	Message  string                `json:"message"`
+	Settings *TenantLocaleSettings `json:"settings,omitempty"`
+}
+
+type TenantQuotaPolicy struct {
+	Mode              tenantsetting.QuotaMode `json:"mode"`
+	GracePercent      int                     `json:"gracePercent"`
+	StorageLimitBytes *int                    `json:"storageLimitBytes,omitempty"`
+}
+
+type TenantQuotaPolicyResponse struct {
+	Success     bool               `json:"success"`
+	Message     string             `json:"message"`
+	QuotaPolicy *TenantQuotaPolicy `json:"quotaPolicy,omitempty"`
+}
+
+// Срок хранения файлов тенанта по умолчанию: по истечении файл перемещается в корзину
+// и удаляется из БД и S3 очисткой корзины
+type TenantRetentionPolicy struct {
+	DefaultRetentionDays *int `json:"defaultRetentionDays,omitempty"`
+}
+
+type TenantRetentionPolicyResponse struct {
+	Success         bool                   `json:"success"`
+	Message         string                 `json:"message"`
+	RetentionPolicy *TenantRetentionPolicy `json:"retentionPolicy,omitempty"`
+}
+
+type TenantStateInfo struct {
+	State     tenantsetting.State `json:"state"`
+	Reason    *string             `json:"reason,omitempty"`
+	ChangedAt *time.Time          `json:"changedAt,omitempty"`
+	Source    string              `json:"source"`
+}
+
+type TenantStateResponse struct {
+	Success     bool             `json:"success"`
+	Message     string           `json:"message"`
+	TenantState *TenantStateInfo `json:"tenantState,omitempty"`
+}
+
+// Водяной знак на скачиваемых PDF: при requirePdfWatermark getFileDownloadURL и /files/{id}/content отдают
+// копию с email пользователя и временем на каждой странице. PDF документы в архивы
+// не включаются и скачиваются по одному
+type TenantWatermarkPolicy struct {
+	RequirePDFWatermark bool `json:"requirePdfWatermark"`
+}
+
+type TenantWatermarkPolicyResponse struct {
+	Success         bool                   `json:"success"`
+	Message         string                 `json:"message"`
+	WatermarkPolicy *TenantWatermarkPolicy `json:"watermarkPolicy,omitempty"`
+}
+
+type TranslationOverrideInput struct {
+	MessageID string `json:"messageId"`
+	Language  string `json:"language"`
+	Text      string `json:"text"`
+}
+
+type TranslationOverrideItem struct {
+	MessageID string `json:"messageId"`
+	Language  string `json:"language"`
+	Text      string `json:"text"`
+}
+
 type UpdateFileInfoInput struct {
-	OriginalName *string `json:"originalName,omitempty"`
-	Description  *string `json:"description,omitempty"`
+	OriginalName *string        `json:"originalName,omitempty"`
+	Description  *string        `json:"description,omitempty"`
+	Attributes   map[string]any `json:"attributes,omitempty"`
+}
+
+type UpdateFileSetInput struct {
+	Name        *string     `json:"name,omitempty"`
+	Description *string     `json:"description,omitempty"`
+	FileIds     []uuid.UUID `json:"fileIds,omitempty"`
 }
 
 type UploadFileInput struct {
-	File        graphql.Upload `json:"file"`
-	Description *string        `json:"description,omitempty"`
+	File           graphql.Upload       `json:"file"`
+	Description    *string              `json:"description,omitempty"`
+	Metadata       *UploadMetadataInput `json:"metadata,omitempty"`
+	ChecksumSha256 *string              `json:"checksumSha256,omitempty"`
+	ObjectLock     *FileObjectLockInput `json:"objectLock,omitempty"`
+}
+
+type UploadMetadataInput struct {
+	Tags       []string          `json:"tags,omitempty"`
+	Folder     *string           `json:"folder,omitempty"`
+	ExpiresAt  *time.Time        `json:"expiresAt,omitempty"`
+	EntityType *file1.EntityType `json:"entityType,omitempty"`
+	EntityID   *uuid.UUID        `json:"entityId,omitempty"`
+	Attributes map[string]any    `json:"attributes,omitempty"`
+}
+
+type UploaderStorageUsage struct {
+	UserID    uuid.UUID `json:"userId"`
+	FileCount int       `json:"fileCount"`
+	Bytes     int       `json:"bytes"`
+}
+
+type UserStorageLimitListResponse struct {
+	Success bool                         `json:"success"`
+	Message string                       `json:"message"`
+	Users   []*file.UserStorageLimitInfo `json:"users"`
+}
+
+type UserStorageLimitResponse struct {
+	Success bool                       `json:"success"`
+	Message string                     `json:"message"`
+	Limit   *file.UserStorageLimitInfo `json:"limit,omitempty"`
+}
+
+type ArchiveJobStatus string
+
+const (
+	// Задание ожидает свободного обработчика
+	ArchiveJobStatusPending ArchiveJobStatus = "PENDING"
+	// Архив собирается
+	ArchiveJobStatusRunning ArchiveJobStatus = "RUNNING"
+	// Архив готов, url доступен
+	ArchiveJobStatusCompleted ArchiveJobStatus = "COMPLETED"
+	// Архив собрать не удалось
+	ArchiveJobStatusFailed ArchiveJobStatus = "FAILED"
+)
+
+var AllArchiveJobStatus = []ArchiveJobStatus{
+	ArchiveJobStatusPending,
+	ArchiveJobStatusRunning,
+	ArchiveJobStatusCompleted,
+	ArchiveJobStatusFailed,
+}
+
+func (e ArchiveJobStatus) IsValid() bool {
+	switch e {
+	case ArchiveJobStatusPending, ArchiveJobStatusRunning, ArchiveJobStatusCompleted, ArchiveJobStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e ArchiveJobStatus) String() string {
+	return string(e)
+}
+
+func (e *ArchiveJobStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ArchiveJobStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ArchiveJobStatus", str)
+	}
+	return nil
+}
+
+func (e ArchiveJobStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ArchiveJobStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ArchiveJobStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Раскладка файлов внутри ZIP-архива пакетного скачивания
+type ArchiveLayout string
+
+const (
+	// Все файлы в корне архива
+	ArchiveLayoutFlat ArchiveLayout = "FLAT"
+	// По папке из metadata.folder (например, TICKET-123/filename.pdf)
+	ArchiveLayoutByFolder ArchiveLayout = "BY_FOLDER"
+	// По месяцу загрузки (YYYY-MM/filename.pdf)
+	ArchiveLayoutByDate ArchiveLayout = "BY_DATE"
+	// По сущности, к которой прикреплен файл (ticket-<id>/filename.pdf, comment-<id>/, chat-<id>/); файлы без сущности - в корне
+	ArchiveLayoutByEntity ArchiveLayout = "BY_ENTITY"
+)
+
+var AllArchiveLayout = []ArchiveLayout{
+	ArchiveLayoutFlat,
+	ArchiveLayoutByFolder,
+	ArchiveLayoutByDate,
+	ArchiveLayoutByEntity,
+}
+
+func (e ArchiveLayout) IsValid() bool {
+	switch e {
+	case ArchiveLayoutFlat, ArchiveLayoutByFolder, ArchiveLayoutByDate, ArchiveLayoutByEntity:
+		return true
+	}
+	return false
+}
+
+func (e ArchiveLayout) String() string {
+	return string(e)
+}
+
+func (e *ArchiveLayout) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ArchiveLayout(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ArchiveLayout", str)
+	}
+	return nil
+}
+
+func (e ArchiveLayout) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ArchiveLayout) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ArchiveLayout) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type CacheControlScope string
+
+const (
+	CacheControlScopePublic  CacheControlScope = "PUBLIC"
+	CacheControlScopePrivate CacheControlScope = "PRIVATE"
+)
+
+var AllCacheControlScope = []CacheControlScope{
+	CacheControlScopePublic,
+	CacheControlScopePrivate,
+}
+
+func (e CacheControlScope) IsValid() bool {
+	switch e {
+	case CacheControlScopePublic, CacheControlScopePrivate:
+		return true
+	}
+	return false
+}
+
+func (e CacheControlScope) String() string {
+	return string(e)
+}
+
+func (e *CacheControlScope) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CacheControlScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CacheControlScope", str)
+	}
+	return nil
+}
+
+func (e CacheControlScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CacheControlScope) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CacheControlScope) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Признак, по которому файлы считаются одинаковыми
+type DuplicateFileMatch string
+
+const (
+	DuplicateFileMatchChecksum DuplicateFileMatch = "CHECKSUM"
+	DuplicateFileMatchNameSize DuplicateFileMatch = "NAME_SIZE"
+)
+
+var AllDuplicateFileMatch = []DuplicateFileMatch{
+	DuplicateFileMatchChecksum,
+	DuplicateFileMatchNameSize,
+}
+
+func (e DuplicateFileMatch) IsValid() bool {
+	switch e {
+	case DuplicateFileMatchChecksum, DuplicateFileMatchNameSize:
+		return true
+	}
+	return false
+}
+
+func (e DuplicateFileMatch) String() string {
+	return string(e)
+}
+
+func (e *DuplicateFileMatch) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DuplicateFileMatch(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DuplicateFileMatch", str)
+	}
+	return nil
+}
+
+func (e DuplicateFileMatch) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DuplicateFileMatch) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DuplicateFileMatch) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Категория файла для отображения
+type FileCategory string
+
+const (
+	FileCategoryImage        FileCategory = "IMAGE"
+	FileCategoryVideo        FileCategory = "VIDEO"
+	FileCategoryAudio        FileCategory = "AUDIO"
+	FileCategoryPDF          FileCategory = "PDF"
+	FileCategoryDocument     FileCategory = "DOCUMENT"
+	FileCategorySpreadsheet  FileCategory = "SPREADSHEET"
+	FileCategoryPresentation FileCategory = "PRESENTATION"
+	FileCategoryArchive      FileCategory = "ARCHIVE"
+	FileCategoryCode         FileCategory = "CODE"
+	FileCategoryText         FileCategory = "TEXT"
+	FileCategoryOther        FileCategory = "OTHER"
+)
+
+var AllFileCategory = []FileCategory{
+	FileCategoryImage,
+	FileCategoryVideo,
+	FileCategoryAudio,
+	FileCategoryPDF,
+	FileCategoryDocument,
+	FileCategorySpreadsheet,
+	FileCategoryPresentation,
+	FileCategoryArchive,
+	FileCategoryCode,
+	FileCategoryText,
+	FileCategoryOther,
+}
+
+func (e FileCategory) IsValid() bool {
+	switch e {
+	case FileCategoryImage, FileCategoryVideo, FileCategoryAudio, FileCategoryPDF, FileCategoryDocument, FileCategorySpreadsheet, FileCategoryPresentation, FileCategoryArchive, FileCategoryCode, FileCategoryText, FileCategoryOther:
+		return true
+	}
+	return false
+}
+
+func (e FileCategory) String() string {
+	return string(e)
+}
+
+func (e *FileCategory) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FileCategory(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FileCategory", str)
+	}
+	return nil
+}
+
+func (e FileCategory) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FileCategory) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FileCategory) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Способ открытия файла по ссылке (Content-Disposition)
+type FileDisposition string
+
+const (
+	// Открыть в браузере
+	FileDispositionInline FileDisposition = "INLINE"
+	// Сохранить на диск
+	FileDispositionAttachment FileDisposition = "ATTACHMENT"
+)
+
+var AllFileDisposition = []FileDisposition{
+	FileDispositionInline,
+	FileDispositionAttachment,
+}
+
+func (e FileDisposition) IsValid() bool {
+	switch e {
+	case FileDispositionInline, FileDispositionAttachment:
+		return true
+	}
+	return false
+}
+
+func (e FileDisposition) String() string {
+	return string(e)
+}
+
+func (e *FileDisposition) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FileDisposition(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FileDisposition", str)
+	}
+	return nil
+}
+
+func (e FileDisposition) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FileDisposition) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FileDisposition) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Тип значения поля metadata: INTEGER - число без дробной части, OBJECT и ARRAY - JSON объект и массив
+type FileMetadataFieldType string
+
+const (
+	FileMetadataFieldTypeString  FileMetadataFieldType = "STRING"
+	FileMetadataFieldTypeNumber  FileMetadataFieldType = "NUMBER"
+	FileMetadataFieldTypeInteger FileMetadataFieldType = "INTEGER"
+	FileMetadataFieldTypeBoolean FileMetadataFieldType = "BOOLEAN"
+	FileMetadataFieldTypeObject  FileMetadataFieldType = "OBJECT"
+	FileMetadataFieldTypeArray   FileMetadataFieldType = "ARRAY"
+)
+
+var AllFileMetadataFieldType = []FileMetadataFieldType{
+	FileMetadataFieldTypeString,
+	FileMetadataFieldTypeNumber,
+	FileMetadataFieldTypeInteger,
+	FileMetadataFieldTypeBoolean,
+	FileMetadataFieldTypeObject,
+	FileMetadataFieldTypeArray,
+}
+
+func (e FileMetadataFieldType) IsValid() bool {
+	switch e {
+	case FileMetadataFieldTypeString, FileMetadataFieldTypeNumber, FileMetadataFieldTypeInteger, FileMetadataFieldTypeBoolean, FileMetadataFieldTypeObject, FileMetadataFieldTypeArray:
+		return true
+	}
+	return false
+}
+
+func (e FileMetadataFieldType) String() string {
+	return string(e)
+}
+
+func (e *FileMetadataFieldType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FileMetadataFieldType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FileMetadataFieldType", str)
+	}
+	return nil
+}
+
+func (e FileMetadataFieldType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *FileMetadataFieldType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e FileMetadataFieldType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Класс хранения в оценке стоимости: TRASH - файлы в корзине по цене STANDARD до окончательного удаления
+type StorageClass string
+
+const (
+	StorageClassStandard StorageClass = "STANDARD"
+	StorageClassArchive  StorageClass = "ARCHIVE"
+	StorageClassTrash    StorageClass = "TRASH"
+)
+
+var AllStorageClass = []StorageClass{
+	StorageClassStandard,
+	StorageClassArchive,
+	StorageClassTrash,
+}
+
+func (e StorageClass) IsValid() bool {
+	switch e {
+	case StorageClassStandard, StorageClassArchive, StorageClassTrash:
+		return true
+	}
+	return false
+}
+
+func (e StorageClass) String() string {
+	return string(e)
+}
+
+func (e *StorageClass) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StorageClass(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StorageClass", str)
+	}
+	return nil
+}
+
+func (e StorageClass) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StorageClass) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StorageClass) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Размер превью изображения: SMALL - до 160px, MEDIUM - до 640px по большей стороне
+type ThumbnailSize string
+
+const (
+	ThumbnailSizeSmall  ThumbnailSize = "SMALL"
+	ThumbnailSizeMedium ThumbnailSize = "MEDIUM"
+)
+
+var AllThumbnailSize = []ThumbnailSize{
+	ThumbnailSizeSmall,
+	ThumbnailSizeMedium,
+}
+
+func (e ThumbnailSize) IsValid() bool {
+	switch e {
+	case ThumbnailSizeSmall, ThumbnailSizeMedium:
+		return true
+	}
+	return false
+}
+
+func (e ThumbnailSize) String() string {
+	return string(e)
+}
+
+func (e *ThumbnailSize) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ThumbnailSize(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ThumbnailSize", str)
+	}
+	return nil
+}
+
+func (e ThumbnailSize) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ThumbnailSize) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ThumbnailSize) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
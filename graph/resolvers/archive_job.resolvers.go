@@ -0,0 +1,54 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"main/graph/model"
+	fileservice "main/services/file"
+	"main/utils"
+
+	"github.com/google/uuid"
+)
+
+// CreateArchiveJob is the resolver for the createArchiveJob field.
+func (r *mutationResolver) CreateArchiveJob(ctx context.Context, input model.BatchDownloadInput) (*model.ArchiveJobResponse, error) {
+	client := r.getClient(ctx)
+
+	var archiveName string
+	if input.ArchiveName != nil {
+		archiveName = *input.ArchiveName
+	}
+
+	layout := fileservice.ArchiveLayoutFlat
+	if input.Layout != nil {
+		layout = fileservice.ArchiveLayout(*input.Layout)
+	}
+
+	job, err := fileservice.NewFileService().CreateArchiveJob(ctx, client, input.FileIds, archiveName, layout)
+	if err != nil {
+		return &model.ArchiveJobResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.ArchiveJobResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.archive_job_created"),
+		Job:     buildArchiveJob(job),
+	}, nil
+}
+
+// ArchiveJob is the resolver for the archiveJob field.
+func (r *queryResolver) ArchiveJob(ctx context.Context, id uuid.UUID) (*model.ArchiveJobResponse, error) {
+	job, err := fileservice.NewFileService().GetArchiveJob(ctx, id)
+	if err != nil {
+		return &model.ArchiveJobResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.ArchiveJobResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.archive_job_state"),
+		Job:     buildArchiveJob(job),
+	}, nil
+}
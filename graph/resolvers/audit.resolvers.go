@@ -0,0 +1,157 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"main/graph/model"
+	auditservice "main/services/audit"
+	fileservice "main/services/file"
+	"main/utils"
+)
+
+// ExportAuditLog is the resolver for the exportAuditLog field.
+func (r *mutationResolver) ExportAuditLog(ctx context.Context, filter *model.FileAuditEventFilter) (*model.AuditLogExportResponse, error) {
+	export, err := fileservice.NewFileService().ExportAuditLog(ctx, r.getClient(ctx), buildAuditListFilter(filter))
+	if err != nil {
+		return &model.AuditLogExportResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.AuditLogExportResponse{
+		Success:   true,
+		Message:   utils.T(ctx, "success.audit.exported"),
+		URL:       &export.URL,
+		ExpiresAt: &export.ExpiresAt,
+		FileName:  &export.FileName,
+		RowCount:  export.RowCount,
+	}, nil
+}
+
+// FileAuditEvents is the resolver for the fileAuditEvents field.
+func (r *queryResolver) FileAuditEvents(ctx context.Context, filter *model.FileAuditEventFilter, limit *int, offset *int, after *string) (*model.FileAuditEventListResponse, error) {
+	client := r.getClient(ctx)
+
+	listFilter := buildAuditListFilter(filter)
+	if limit != nil {
+		listFilter.Limit = *limit
+	}
+	if offset != nil {
+		listFilter.Offset = *offset
+	}
+	listFilter.After = after
+
+	page, err := auditservice.NewAuditService().List(ctx, client, listFilter)
+	if err != nil {
+		return &model.FileAuditEventListResponse{
+			Success: false,
+			Message: err.Error(),
+			Events:  []*model.FileAuditEventItem{},
+		}, nil
+	}
+
+	items := make([]*model.FileAuditEventItem, 0, len(page.Events))
+	for _, event := range page.Events {
+		items = append(items, &model.FileAuditEventItem{
+			ID:             event.ID,
+			Action:         event.Action,
+			FileID:         event.FileID,
+			ActorID:        event.ActorID,
+			ImpersonatorID: event.ImpersonatorID,
+			Details:        event.Details,
+			CreatedAt:      event.CreateTime,
+		})
+	}
+
+	response := &model.FileAuditEventListResponse{
+		Success:     true,
+		Message:     utils.T(ctx, "success.audit.found"),
+		Events:      items,
+		TotalCount:  page.TotalCount,
+		HasNextPage: page.HasNextPage,
+	}
+	if len(page.Events) > 0 {
+		cursor := auditservice.EncodeCursor(page.Events[len(page.Events)-1])
+		response.EndCursor = &cursor
+	}
+	return response, nil
+}
+
+// OperationAuditLogs is the resolver for the operationAuditLogs field.
+func (r *queryResolver) OperationAuditLogs(ctx context.Context, filter *model.OperationAuditLogFilter, limit *int, offset *int) (*model.OperationAuditLogListResponse, error) {
+	client := r.getClient(ctx)
+
+	listFilter := auditservice.OperationListFilter{}
+	if filter != nil {
+		listFilter.OperationName = filter.OperationName
+		listFilter.ActorID = filter.ActorID
+		listFilter.Success = filter.Success
+		listFilter.From = filter.From
+		listFilter.To = filter.To
+	}
+	if limit != nil {
+		listFilter.Limit = *limit
+	}
+	if offset != nil {
+		listFilter.Offset = *offset
+	}
+
+	logs, totalCount, err := auditservice.NewAuditService().ListOperations(ctx, client, listFilter)
+	if err != nil {
+		return &model.OperationAuditLogListResponse{
+			Success: false,
+			Message: err.Error(),
+			Logs:    []*model.OperationAuditLogItem{},
+		}, nil
+	}
+
+	items := make([]*model.OperationAuditLogItem, 0, len(logs))
+	for _, log := range logs {
+		item := &model.OperationAuditLogItem{
+			ID:             log.ID,
+			OperationName:  log.OperationName,
+			Variables:      log.Variables,
+			ActorID:        log.ActorID,
+			ImpersonatorID: log.ImpersonatorID,
+			Success:        log.Success,
+			DurationMs:     int(log.DurationMs),
+			CreatedAt:      log.CreateTime,
+		}
+		if log.ActorRole != "" {
+			item.ActorRole = &log.ActorRole
+		}
+		if log.ErrorMessage != "" {
+			item.ErrorMessage = &log.ErrorMessage
+		}
+		items = append(items, item)
+	}
+
+	return &model.OperationAuditLogListResponse{
+		Success:    true,
+		Message:    utils.T(ctx, "success.audit.found"),
+		Logs:       items,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// FileAuditAggregations is the resolver for the fileAuditAggregations field.
+func (r *queryResolver) FileAuditAggregations(ctx context.Context, filter *model.FileAuditEventFilter, topLimit *int) (*model.FileAuditAggregationsResponse, error) {
+	client := r.getClient(ctx)
+
+	limit := 0
+	if topLimit != nil {
+		limit = *topLimit
+	}
+
+	aggregations, err := auditservice.NewAuditService().Aggregate(ctx, client, buildAuditListFilter(filter), limit)
+	if err != nil {
+		return &model.FileAuditAggregationsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileAuditAggregationsResponse{
+		Success:      true,
+		Message:      utils.T(ctx, "success.audit.aggregated"),
+		Aggregations: buildFileAuditAggregations(aggregations),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"main/config"
+	"main/errcatalog"
+	"main/graph/model"
+	"main/middleware"
+	"main/utils"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"go.uber.org/zap"
+)
+
+// ReloadServiceConfig is the resolver for the reloadServiceConfig field.
+func (r *mutationResolver) ReloadServiceConfig(ctx context.Context) (*model.ServiceConfigResponse, error) {
+	cfg, err := config.Reload()
+	if err != nil {
+		utils.Logger.Error("Failed to reload runtime configuration", zap.Error(err))
+		return &model.ServiceConfigResponse{
+			Success: false,
+			Message: utils.T(ctx, "error.config.reload_failed"),
+		}, nil
+	}
+
+	return &model.ServiceConfigResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.config.reloaded"),
+		Config:  buildServiceConfig(cfg),
+	}, nil
+}
+
+// SetLogLevel is the resolver for the setLogLevel field.
+func (r *mutationResolver) SetLogLevel(ctx context.Context, level string, durationMinutes *int) (*model.LogLevelResponse, error) {
+	var duration time.Duration
+	if durationMinutes != nil {
+		duration = time.Duration(*durationMinutes) * time.Minute
+	}
+
+	state, err := utils.SetTemporaryLogLevel(level, duration)
+	if err != nil {
+		return &model.LogLevelResponse{
+			Success: false,
+			Message: utils.T(ctx, "error.config.invalid_log_level"),
+		}, nil
+	}
+
+	return &model.LogLevelResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.config.log_level_changed"),
+		State:   buildLogLevelState(state),
+	}, nil
+}
+
+// ResetLogLevel is the resolver for the resetLogLevel field.
+func (r *mutationResolver) ResetLogLevel(ctx context.Context) (*model.LogLevelResponse, error) {
+	return &model.LogLevelResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.config.log_level_reset"),
+		State:   buildLogLevelState(utils.ResetLogLevel()),
+	}, nil
+}
+
+// ServiceActivity is the resolver for the serviceActivity field.
+func (r *queryResolver) ServiceActivity(ctx context.Context) (*model.ServiceActivityResponse, error) {
+	tenantID := federation.GetTenantID(ctx)
+	if tenantID == nil {
+		return &model.ServiceActivityResponse{
+			Success: false,
+			Message: errcatalog.UserNotAuthenticated(ctx).Error(),
+		}, nil
+	}
+
+	return &model.ServiceActivityResponse{
+		Success:  true,
+		Message:  utils.T(ctx, "success.config.activity_found"),
+		Activity: buildServiceActivity(middleware.GetInflightStats(tenantID.String())),
+	}, nil
+}
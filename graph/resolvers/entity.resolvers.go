@@ -3,6 +3,8 @@ package resolvers
 import (
 	"context"
 	"main/ent"
+	"main/graph/model"
+	fileservice "main/services/file"
 
 	"github.com/google/uuid"
 )
@@ -24,6 +26,51 @@ func (r *entityResolver) FindFileByID(ctx context.Context, id uuid.UUID) (*ent.F
 	return client.File.Get(ctx, id)
 }
 
+// FindTicketByID returns a stub Ticket entity; filesCount is resolved through the dataloader.
+func (r *entityResolver) FindTicketByID(ctx context.Context, id uuid.UUID) (*ent.Ticket, error) {
+	return &ent.Ticket{ID: id}, nil
+}
+
+// FindTicketCommentByID returns a stub TicketComment entity; filesCount is resolved through the dataloader.
+func (r *entityResolver) FindTicketCommentByID(ctx context.Context, id uuid.UUID) (*ent.TicketComment, error) {
+	return &ent.TicketComment{ID: id}, nil
+}
+
+// FindMessageByID returns a stub Message entity; filesCount is resolved through the dataloader.
+func (r *entityResolver) FindMessageByID(ctx context.Context, id uuid.UUID) (*ent.Message, error) {
+	return &ent.Message{ID: id}, nil
+}
+
+// FindManyTenantByIDs returns Tenant entities with storage usage contributed by this service.
+// Aggregates for all representations are computed in a single query; tenants other than
+// the one from the federation context resolve to null.
+func (r *entityResolver) FindManyTenantByIDs(ctx context.Context, reps []*model.TenantByIDsInput) ([]*ent.Tenant, error) {
+	tenantIDs := make([]uuid.UUID, 0, len(reps))
+	for _, rep := range reps {
+		tenantIDs = append(tenantIDs, rep.ID)
+	}
+
+	stats, err := fileservice.NewFileService().GetTenantStorageStats(ctx, r.getClient(ctx), tenantIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tenants := make([]*ent.Tenant, len(reps))
+	for i, rep := range reps {
+		stat, ok := stats[rep.ID]
+		if !ok {
+			continue
+		}
+		tenants[i] = &ent.Tenant{
+			ID:           rep.ID,
+			StorageUsed:  stat.StorageUsed,
+			StorageLimit: stat.StorageLimit,
+			FileCount:    stat.FileCount,
+		}
+	}
+	return tenants, nil
+}
+
 // CreatedBy is the resolver for the createdBy field.
 func (r *fileResolver) CreatedBy(ctx context.Context, obj *ent.File) (*ent.User, error) {
 	// Return a stub User entity with the user ID from File
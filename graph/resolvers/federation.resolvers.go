@@ -7,6 +7,8 @@ package resolvers
 import (
 	"context"
 	"main/ent"
+	"main/ent/file"
+	"main/graph/dataloader"
 )
 
 // User is the resolver for the user field.
@@ -17,3 +19,18 @@ func (r *fileResolver) User(ctx context.Context, obj *ent.File) (*ent.User, erro
 		ID: obj.CreatedBy,
 	}, nil
 }
+
+// FilesCount is the resolver for the filesCount field.
+func (r *ticketResolver) FilesCount(ctx context.Context, obj *ent.Ticket) (int, error) {
+	return dataloader.GetEntityFilesCount(ctx, file.EntityTypeTICKET, obj.ID)
+}
+
+// FilesCount is the resolver for the filesCount field.
+func (r *ticketCommentResolver) FilesCount(ctx context.Context, obj *ent.TicketComment) (int, error) {
+	return dataloader.GetEntityFilesCount(ctx, file.EntityTypeCOMMENT, obj.ID)
+}
+
+// FilesCount is the resolver for the filesCount field.
+func (r *messageResolver) FilesCount(ctx context.Context, obj *ent.Message) (int, error) {
+	return dataloader.GetEntityFilesCount(ctx, file.EntityTypeMESSAGE, obj.ID)
+}
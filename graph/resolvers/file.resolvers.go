@@ -8,20 +8,22 @@ import (
 	"context"
 	"main/ent"
 	entfile "main/ent/file"
+	"main/errcatalog"
 	"main/graph/dataloader"
 	"main/graph/model"
+	"main/services/batch"
 	fileservice "main/services/file"
 	"main/utils"
+	"time"
 
 	"entgo.io/contrib/entgql"
+	federation "github.com/esemashko/v2-federation"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 // UploadFile is the resolver for the uploadFile field.
 func (r *mutationResolver) UploadFile(ctx context.Context, input model.UploadFileInput) (*model.FileUploadResponse, error) {
-	client := r.getClient(ctx)
-
 	// 🔒 [PERMISSION CHECK] Проверяем права на загрузку файлов
 	fileService := fileservice.NewFileService()
 	if err := fileService.CanUploadFile(ctx); err != nil {
@@ -47,32 +49,100 @@ func (r *mutationResolver) UploadFile(ctx context.Context, input model.UploadFil
 		zap.Int64("size", input.File.Size),
 		zap.String("content_type", input.File.ContentType))
 
-	// Дополнительная проверка размера файла
-	const maxFileSize = 100 * 1024 * 1024 // 100MB
-	if input.File.Size > maxFileSize {
-		utils.Logger.Warn("File too large",
-			zap.String("filename", input.File.Filename),
-			zap.Int64("size", input.File.Size),
-			zap.Int64("max_size", maxFileSize))
+	// Создаем input для файлового сервиса
+	fileInput := fileservice.UploadFileInput{
+		Upload:         &input.File,
+		Description:    input.Description,
+		ChecksumSha256: input.ChecksumSha256,
+	}
+	if input.Metadata != nil {
+		fileInput.Metadata = &fileservice.UploadMetadataInput{
+			Tags:       input.Metadata.Tags,
+			Folder:     input.Metadata.Folder,
+			ExpiresAt:  input.Metadata.ExpiresAt,
+			EntityType: input.Metadata.EntityType,
+			EntityID:   input.Metadata.EntityID,
+			Attributes: input.Metadata.Attributes,
+		}
+	}
+	if input.ObjectLock != nil {
+		fileInput.ObjectLock = buildObjectLockInput(*input.ObjectLock)
+	}
+
+	// 🔄 [TRANSACTION] Запись файла с метаданными и аудит выполняются атомарно,
+	// при откате загруженный объект удаляется из S3
+	var fileResult *ent.File
+	var conflict *fileservice.FilenameConflict
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		fileResult, conflict, err = fileService.UploadFile(txCtx, txClient, fileInput)
+		return err
+	})
+	if err != nil {
+		utils.Logger.Error("Failed to upload file",
+			zap.Error(err),
+			zap.String("filename", input.File.Filename))
 		return &model.FileUploadResponse{
 			Success: false,
-			Message: utils.T(ctx, "error.file.too_large"),
+			Message: err.Error(),
 			File:    nil,
 		}, nil
 	}
 
-	// Создаем input для файлового сервиса
-	fileInput := fileservice.UploadFileInput{
-		Upload:      &input.File,
-		Description: input.Description,
+	return &model.FileUploadResponse{
+		Success:      true,
+		Message:      uploadSuccessMessage(ctx, conflict),
+		File:         fileResult,
+		NameConflict: buildFileNameConflict(ctx, r.getClient(ctx), conflict),
+	}, nil
+}
+
+// UploadFileFromURL is the resolver for the uploadFileFromURL field.
+func (r *mutationResolver) UploadFileFromURL(ctx context.Context, url string, description *string) (*model.FileUploadResponse, error) {
+	// 🔒 [PERMISSION CHECK] Проверяем права на загрузку файлов до обращения к внешнему адресу
+	fileService := fileservice.NewFileService()
+	if err := fileService.CanUploadFile(ctx); err != nil {
+		return &model.FileUploadResponse{
+			Success: false,
+			Message: err.Error(),
+			File:    nil,
+		}, nil
 	}
 
-	// Используем сервис для загрузки файла
-	fileResult, err := fileService.UploadFile(ctx, client, fileInput)
+	// Скачивание выполняется вне транзакции, чтобы не держать ее открытой во время сетевого запроса
+	remote, err := fileService.FetchRemoteFile(ctx, r.getClient(ctx), url)
 	if err != nil {
-		utils.Logger.Error("Failed to upload file",
+		return &model.FileUploadResponse{
+			Success: false,
+			Message: err.Error(),
+			File:    nil,
+		}, nil
+	}
+	defer remote.Close()
+
+	utils.Logger.Info("File upload from URL attempt",
+		zap.String("source_url", remote.SourceURL),
+		zap.String("filename", remote.Upload.Filename),
+		zap.Int64("size", remote.Upload.Size),
+		zap.String("content_type", remote.Upload.ContentType))
+
+	// 🔄 [TRANSACTION] Запись файла с метаданными и аудит выполняются атомарно,
+	// при откате загруженный объект удаляется из S3
+	var fileResult *ent.File
+	var conflict *fileservice.FilenameConflict
+	err = r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		fileResult, conflict, err = fileService.UploadFile(txCtx, txClient, fileservice.UploadFileInput{
+			Upload:      remote.Upload,
+			Description: description,
+			SourceURL:   remote.SourceURL,
+		})
+		return err
+	})
+	if err != nil {
+		utils.Logger.Error("Failed to upload file from URL",
 			zap.Error(err),
-			zap.String("filename", input.File.Filename))
+			zap.String("source_url", remote.SourceURL))
 		return &model.FileUploadResponse{
 			Success: false,
 			Message: err.Error(),
@@ -81,9 +151,10 @@ func (r *mutationResolver) UploadFile(ctx context.Context, input model.UploadFil
 	}
 
 	return &model.FileUploadResponse{
-		Success: true,
-		Message: utils.T(ctx, "success.file.uploaded"),
-		File:    fileResult,
+		Success:      true,
+		Message:      uploadSuccessMessage(ctx, conflict),
+		File:         fileResult,
+		NameConflict: buildFileNameConflict(ctx, r.getClient(ctx), conflict),
 	}, nil
 }
 
@@ -101,32 +172,21 @@ func (r *mutationResolver) UpdateFileInfo(ctx context.Context, id uuid.UUID, inp
 		}, nil
 	}
 
-	// Создаем updater
-	updater := client.File.UpdateOneID(id)
-
-	// Обновляем только переданные поля
-	if input.Description != nil {
-		updater = updater.SetDescription(*input.Description)
-	}
-	if input.OriginalName != nil {
-		updater = updater.SetOriginalName(*input.OriginalName)
-	}
-
-	// Выполняем обновление
-	ctxWithClient := ent.NewContext(ctx, client)
-	updatedFile, err := updater.Save(ctxWithClient)
+	// 🔄 [TRANSACTION] Обновление и запись аудита выполняются атомарно
+	var updatedFile *ent.File
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		updatedFile, err = fileService.UpdateFileInfo(txCtx, txClient, id, fileservice.UpdateFileInfoInput{
+			OriginalName: input.OriginalName,
+			Description:  input.Description,
+			Attributes:   input.Attributes,
+		})
+		return err
+	})
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return &model.FileResponse{
-				Success: false,
-				Message: utils.T(ctx, "error.file.not_found"),
-				File:    nil,
-			}, nil
-		}
-		utils.Logger.Error("Failed to update file", zap.Error(err), zap.String("file_id", id.String()))
 		return &model.FileResponse{
 			Success: false,
-			Message: utils.T(ctx, "error.file.update_failed"),
+			Message: err.Error(),
 			File:    nil,
 		}, nil
 	}
@@ -148,30 +208,41 @@ func (r *mutationResolver) DeleteFile(ctx context.Context, id uuid.UUID) (*model
 		return &model.FileDeleteResponse{Success: false, Message: err.Error()}, nil
 	}
 
-	// 🔄 [TRANSACTION]
-	tx, err := client.Tx(ctx)
+	// 🔄 [TRANSACTION] Перемещаем файл в корзину через сервис
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		return fileService.DeleteFile(txCtx, txClient, id)
+	})
 	if err != nil {
-		return &model.FileDeleteResponse{Success: false, Message: utils.T(ctx, "error.transaction.failed")}, nil
-	}
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
-		}
-	}()
-
-	txCtx := ent.NewTxContext(ctx, tx)
-
-	// Удаляем файл через сервис (включает удаление из S3 и БД)
-	if err = fileService.DeleteFile(txCtx, tx.Client(), id); err != nil {
 		utils.Logger.Error("Failed to delete file", zap.Error(err), zap.String("file_id", id.String()))
 		return &model.FileDeleteResponse{Success: false, Message: err.Error()}, nil
 	}
 
-	if err = tx.Commit(); err != nil {
-		return &model.FileDeleteResponse{Success: false, Message: utils.T(ctx, "error.transaction.commit_failed")}, nil
+	return &model.FileDeleteResponse{Success: true, Message: utils.T(ctx, "success.file.deleted")}, nil
+}
+
+// DeleteFiles is the resolver for the deleteFiles field.
+func (r *mutationResolver) DeleteFiles(ctx context.Context, ids []uuid.UUID) (*model.FilesDeleteResponse, error) {
+	fileService := fileservice.NewFileService()
+
+	// 🔄 [TRANSACTION] Доступные файлы удаляются в одной транзакции, недоступные возвращаются с причиной
+	var items *batch.Result
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		items, err = fileService.DeleteFiles(txCtx, txClient, ids)
+		return err
+	})
+	if err != nil {
+		utils.Logger.Error("Failed to delete files", zap.Error(err), zap.Int("file_count", len(ids)))
+		return &model.FilesDeleteResponse{
+			Success:   false,
+			Message:   err.Error(),
+			Succeeded: []uuid.UUID{},
+			Failed:    []*batch.Failure{},
+			Results:   []*model.FileDeleteResult{},
+		}, nil
 	}
 
-	return &model.FileDeleteResponse{Success: true, Message: utils.T(ctx, "success.file.deleted")}, nil
+	return buildFilesDeleteResponse(ctx, items), nil
 }
 
 // File is the resolver for the file field.
@@ -231,10 +302,10 @@ func (r *queryResolver) Files(ctx context.Context, after *entgql.Cursor[uuid.UUI
 		return nil, err
 	}
 
-	// Возвращаем пагинированный результат
+	// Возвращаем пагинированный результат (без orderBy - сортировка по умолчанию, ID - последний ключ)
 	return query.Paginate(ctx, after, first, before, last,
 		ent.WithFileFilter(where.Filter),
-		ent.WithFileOrder(orderBy),
+		ent.WithFileOrder(fileservice.FileConnectionOrder(orderBy)),
 	)
 }
 
@@ -254,10 +325,10 @@ func (r *queryResolver) FileList(ctx context.Context, after *entgql.Cursor[uuid.
 		return nil, err
 	}
 
-	// Возвращаем пагинированный результат
+	// Возвращаем пагинированный результат (без orderBy - сортировка по умолчанию, ID - последний ключ)
 	return query.Paginate(ctx, after, first, before, last,
 		ent.WithFileFilter(where.Filter),
-		ent.WithFileOrder(orderBy),
+		ent.WithFileOrder(fileservice.FileConnectionOrder(orderBy)),
 	)
 }
 
@@ -304,12 +375,21 @@ func (r *queryResolver) FilesByUser(ctx context.Context, userID uuid.UUID, limit
 }
 
 // GetFileDownloadURL is the resolver for the getFileDownloadURL field.
-func (r *mutationResolver) GetFileDownloadURL(ctx context.Context, id uuid.UUID) (*model.FileDownloadURLResponse, error) {
+func (r *mutationResolver) GetFileDownloadURL(ctx context.Context, id uuid.UUID, disposition *model.FileDisposition, responseContentType *string, watermark *bool, expiresIn *int) (*model.FileDownloadURLResponse, error) {
 	client := r.getClient(ctx)
 
+	options := fileservice.DownloadURLOptions{
+		ContentType: responseContentType,
+		Watermark:   watermark != nil && *watermark,
+		ExpiresIn:   expiresIn,
+	}
+	if disposition != nil {
+		options.Disposition = fileservice.DownloadDisposition(*disposition)
+	}
+
 	// Получаем pre-signed URL для файла через сервис
 	fileService := fileservice.NewFileService()
-	result, err := fileService.GetFileDownloadURL(ctx, client, id)
+	result, err := fileService.GetFileDownloadURL(ctx, client, id, options)
 	if err != nil {
 		utils.Logger.Error("Failed to get file download URL",
 			zap.Error(err),
@@ -321,11 +401,57 @@ func (r *mutationResolver) GetFileDownloadURL(ctx context.Context, id uuid.UUID)
 		}, nil
 	}
 
+	resultDisposition := model.FileDisposition(result.Disposition)
 	return &model.FileDownloadURLResponse{
-		Success:   true,
-		Message:   utils.T(ctx, "success.file.download_url_generated"),
-		URL:       &result.URL,
-		ExpiresAt: &result.ExpiresAt,
+		Success:     true,
+		Message:     utils.T(ctx, "success.file.download_url_generated"),
+		URL:         &result.URL,
+		ExpiresAt:   &result.ExpiresAt,
+		Disposition: &resultDisposition,
+		Watermarked: &result.Watermarked,
+	}, nil
+}
+
+// GetFileDownloadURLs is the resolver for the getFileDownloadURLs field.
+func (r *mutationResolver) GetFileDownloadURLs(ctx context.Context, ids []uuid.UUID, disposition *model.FileDisposition, watermark *bool, expiresIn *int) (*model.FileDownloadURLsResponse, error) {
+	client := r.getClient(ctx)
+
+	options := fileservice.DownloadURLOptions{
+		Watermark: watermark != nil && *watermark,
+		ExpiresIn: expiresIn,
+	}
+	if disposition != nil {
+		options.Disposition = fileservice.DownloadDisposition(*disposition)
+	}
+
+	results, items, err := fileservice.NewFileService().GetFileDownloadURLs(ctx, client, ids, options)
+	if err != nil {
+		return &model.FileDownloadURLsResponse{
+			Success:   false,
+			Message:   err.Error(),
+			Urls:      []*model.FileDownloadURL{},
+			Succeeded: []uuid.UUID{},
+			Failed:    []*batch.Failure{},
+		}, nil
+	}
+
+	urls := make([]*model.FileDownloadURL, 0, len(results))
+	for _, result := range results {
+		urls = append(urls, &model.FileDownloadURL{
+			FileID:      result.FileID,
+			URL:         result.URL,
+			ExpiresAt:   result.ExpiresAt,
+			Disposition: model.FileDisposition(result.Disposition),
+			Watermarked: result.Watermarked,
+		})
+	}
+
+	return &model.FileDownloadURLsResponse{
+		Success:   len(items.Succeeded) > 0,
+		Message:   batchMessage(ctx, items, utils.T(ctx, "success.file.download_urls_generated")),
+		Urls:      urls,
+		Succeeded: items.Succeeded,
+		Failed:    items.Failed,
 	}, nil
 }
 
@@ -342,9 +468,15 @@ func (r *mutationResolver) GetBatchDownloadURL(ctx context.Context, input model.
 		archiveName = *input.ArchiveName
 	}
 
+	// Раскладка файлов внутри архива (по умолчанию - все файлы в корне)
+	layout := fileservice.ArchiveLayoutFlat
+	if input.Layout != nil {
+		layout = fileservice.ArchiveLayout(*input.Layout)
+	}
+
 	// Получаем pre-signed URL для архива через сервис
 	fileService := fileservice.NewFileService()
-	result, err := fileService.GetBatchDownloadURL(ctx, client, fileIDs, archiveName)
+	result, err := fileService.GetBatchDownloadURL(ctx, client, fileIDs, archiveName, layout)
 	if err != nil {
 		utils.Logger.Error("Failed to get batch download URL",
 			zap.Error(err),
@@ -353,16 +485,45 @@ func (r *mutationResolver) GetBatchDownloadURL(ctx context.Context, input model.
 			Success:    false,
 			Message:    err.Error(),
 			TotalFiles: 0,
+			Succeeded:  []uuid.UUID{},
+			Failed:     []*batch.Failure{},
 		}, nil
 	}
 
-	return &model.BatchDownloadURLResponse{
-		Success:     true,
-		Message:     utils.T(ctx, "success.file.batch_download_url_generated"),
-		URL:         &result.URL,
-		ExpiresAt:   &result.ExpiresAt,
-		ArchiveName: &result.ArchiveName,
-		TotalFiles:  result.TotalFiles,
+	return buildBatchDownloadURLResponse(ctx, result), nil
+}
+
+// VerifyFileIntegrity is the resolver for the verifyFileIntegrity field.
+func (r *mutationResolver) VerifyFileIntegrity(ctx context.Context, id uuid.UUID) (*model.FileIntegrityResponse, error) {
+	fileService := fileservice.NewFileService()
+
+	// 🔄 [TRANSACTION] Сравниваем сохраненный SHA-256 с текущим объектом в S3 и сохраняем результат
+	var result *fileservice.FileIntegrityResult
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		result, err = fileService.VerifyFileIntegrity(txCtx, txClient, id)
+		return err
+	})
+	if err != nil {
+		utils.Logger.Error("Failed to verify file integrity",
+			zap.Error(err),
+			zap.String("file_id", id.String()))
+		return &model.FileIntegrityResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	message := utils.T(ctx, "success.file.integrity_verified")
+	if result.Status != entfile.IntegrityStatusOK {
+		message = utils.T(ctx, "error.file.integrity_mismatch")
+	}
+
+	return &model.FileIntegrityResponse{
+		Success:          true,
+		Message:          message,
+		File:             result.File,
+		Status:           &result.Status,
+		ExpectedChecksum: &result.ExpectedChecksum,
+		ActualChecksum:   &result.ActualChecksum,
+		CheckedAt:        &result.CheckedAt,
 	}, nil
 }
 
@@ -370,3 +531,633 @@ func (r *mutationResolver) GetBatchDownloadURL(ctx context.Context, input model.
 func (r *fileResolver) CanDelete(ctx context.Context, obj *ent.File) (bool, error) {
 	return dataloader.GetFileCanDelete(ctx, obj.ID)
 }
+
+// CanUpdate is the resolver for the canUpdate field.
+func (r *fileResolver) CanUpdate(ctx context.Context, obj *ent.File) (bool, error) {
+	return dataloader.GetFileCanUpdate(ctx, obj.ID)
+}
+
+// CanView is the resolver for the canView field.
+func (r *fileResolver) CanView(ctx context.Context, obj *ent.File) (bool, error) {
+	return dataloader.GetFileCanView(ctx, obj.ID)
+}
+
+// IsFavorite is the resolver for the isFavorite field.
+func (r *fileResolver) IsFavorite(ctx context.Context, obj *ent.File) (bool, error) {
+	return dataloader.GetFileIsFavorite(ctx, obj.ID)
+}
+
+// Category is the resolver for the category field.
+func (r *fileResolver) Category(ctx context.Context, obj *ent.File) (model.FileCategory, error) {
+	return model.FileCategory(fileservice.ResolveFileType(obj.MimeType, obj.OriginalName).Category), nil
+}
+
+// CategoryLabel is the resolver for the categoryLabel field.
+func (r *fileResolver) CategoryLabel(ctx context.Context, obj *ent.File) (string, error) {
+	return fileservice.FileCategoryLabel(ctx, fileservice.ResolveFileType(obj.MimeType, obj.OriginalName).Category), nil
+}
+
+// IconKey is the resolver for the iconKey field.
+func (r *fileResolver) IconKey(ctx context.Context, obj *ent.File) (string, error) {
+	return fileservice.ResolveFileType(obj.MimeType, obj.OriginalName).IconKey, nil
+}
+
+// ThumbnailURL is the resolver for the thumbnailUrl field.
+func (r *fileResolver) ThumbnailURL(ctx context.Context, obj *ent.File, size model.ThumbnailSize) (*string, error) {
+	return fileservice.NewFileService().GetThumbnailURL(ctx, obj, fileservice.ThumbnailSize(size))
+}
+
+// PreviewURL is the resolver for the previewUrl field.
+func (r *fileResolver) PreviewURL(ctx context.Context, obj *ent.File) (*string, error) {
+	return fileservice.NewFileService().GetPreviewURL(ctx, obj)
+}
+
+// PDFURL is the resolver for the pdfUrl field.
+func (r *fileResolver) PDFURL(ctx context.Context, obj *ent.File) (*string, error) {
+	return fileservice.NewFileService().GetPDFURL(ctx, obj)
+}
+
+// CreateTimeLocal is the resolver for the createTimeLocal field.
+func (r *fileResolver) CreateTimeLocal(ctx context.Context, obj *ent.File) (string, error) {
+	return utils.FormatInUserTimezone(ctx, obj.CreateTime), nil
+}
+
+// Path is the resolver for the path field.
+func (r *fileResolver) Path(ctx context.Context, obj *ent.File) (*string, error) {
+	return &obj.Path, nil
+}
+
+// RestoreFile is the resolver for the restoreFile field.
+func (r *mutationResolver) RestoreFile(ctx context.Context, id uuid.UUID) (*model.FileResponse, error) {
+	fileService := fileservice.NewFileService()
+
+	// 🔄 [TRANSACTION]
+	var restored *ent.File
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		restored, err = fileService.RestoreFile(txCtx, txClient, id)
+		return err
+	})
+	if err != nil {
+		return &model.FileResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.restored"),
+		File:    restored,
+	}, nil
+}
+
+// CopyFile is the resolver for the copyFile field.
+func (r *mutationResolver) CopyFile(ctx context.Context, id uuid.UUID, target model.FileEntityInput) (*model.FileAttachResponse, error) {
+	fileService := fileservice.NewFileService()
+
+	// 🔄 [TRANSACTION] При откате копия объекта удаляется из S3
+	var copied *ent.File
+	var conflict *fileservice.FilenameConflict
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		copied, conflict, err = fileService.CopyFile(txCtx, txClient, id, fileservice.FileEntityTarget{
+			EntityType: target.EntityType,
+			EntityID:   target.EntityID,
+		})
+		return err
+	})
+	if err != nil {
+		return &model.FileAttachResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileAttachResponse{
+		Success:      true,
+		Message:      utils.T(ctx, "success.file.copied"),
+		File:         copied,
+		NameConflict: buildFileNameConflict(ctx, r.getClient(ctx), conflict),
+	}, nil
+}
+
+// MoveFile is the resolver for the moveFile field.
+func (r *mutationResolver) MoveFile(ctx context.Context, id uuid.UUID, target model.FileEntityInput) (*model.FileAttachResponse, error) {
+	fileService := fileservice.NewFileService()
+
+	// 🔄 [TRANSACTION]
+	var moved *ent.File
+	var conflict *fileservice.FilenameConflict
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		moved, conflict, err = fileService.MoveFile(txCtx, txClient, id, fileservice.FileEntityTarget{
+			EntityType: target.EntityType,
+			EntityID:   target.EntityID,
+		})
+		return err
+	})
+	if err != nil {
+		return &model.FileAttachResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileAttachResponse{
+		Success:      true,
+		Message:      utils.T(ctx, "success.file.moved"),
+		File:         moved,
+		NameConflict: buildFileNameConflict(ctx, r.getClient(ctx), conflict),
+	}, nil
+}
+
+// SetDepartmentQuota is the resolver for the setDepartmentQuota field.
+func (r *mutationResolver) SetDepartmentQuota(ctx context.Context, departmentID uuid.UUID, limitBytes *int) (*model.DepartmentStorageUsageResponse, error) {
+	var limit *int64
+	if limitBytes != nil {
+		value := int64(*limitBytes)
+		limit = &value
+	}
+
+	// 🔄 [TRANSACTION]
+	var usage *fileservice.DepartmentStorageUsage
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		usage, err = fileservice.NewFileService().SetDepartmentQuota(txCtx, txClient, departmentID, limit)
+		return err
+	})
+	if err != nil {
+		return &model.DepartmentStorageUsageResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.DepartmentStorageUsageResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.department_quota_updated"),
+		Usage:   usage,
+	}, nil
+}
+
+// SetUserStorageLimit is the resolver for the setUserStorageLimit field.
+func (r *mutationResolver) SetUserStorageLimit(ctx context.Context, userID uuid.UUID, maxFiles *int, maxBytes *int) (*model.UserStorageLimitResponse, error) {
+	var bytesLimit *int64
+	if maxBytes != nil {
+		value := int64(*maxBytes)
+		bytesLimit = &value
+	}
+
+	// 🔄 [TRANSACTION]
+	var limit *fileservice.UserStorageLimitInfo
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		limit, err = fileservice.NewFileService().SetUserStorageLimit(txCtx, txClient, userID, maxFiles, bytesLimit)
+		return err
+	})
+	if err != nil {
+		return &model.UserStorageLimitResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.UserStorageLimitResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.user_storage_limit_updated"),
+		Limit:   limit,
+	}, nil
+}
+
+// TagFiles is the resolver for the tagFiles field.
+func (r *mutationResolver) TagFiles(ctx context.Context, fileIds []uuid.UUID, tags []string) (*model.FilesTagResponse, error) {
+	// 🔄 [TRANSACTION] Теги всех файлов изменяются атомарно
+	var files []*ent.File
+	var items *batch.Result
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		files, items, err = fileservice.NewFileService().TagFiles(txCtx, txClient, fileIds, tags)
+		return err
+	})
+	if err != nil {
+		return &model.FilesTagResponse{
+			Success:   false,
+			Message:   err.Error(),
+			Files:     []*ent.File{},
+			Succeeded: []uuid.UUID{},
+			Failed:    []*batch.Failure{},
+		}, nil
+	}
+
+	return &model.FilesTagResponse{
+		Success:   len(items.Succeeded) > 0,
+		Message:   batchMessage(ctx, items, utils.T(ctx, "success.file.tagged")),
+		Files:     unwrapFiles(files),
+		Succeeded: items.Succeeded,
+		Failed:    items.Failed,
+	}, nil
+}
+
+// UntagFiles is the resolver for the untagFiles field.
+func (r *mutationResolver) UntagFiles(ctx context.Context, fileIds []uuid.UUID, tags []string) (*model.FilesTagResponse, error) {
+	// 🔄 [TRANSACTION]
+	var files []*ent.File
+	var items *batch.Result
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		files, items, err = fileservice.NewFileService().UntagFiles(txCtx, txClient, fileIds, tags)
+		return err
+	})
+	if err != nil {
+		return &model.FilesTagResponse{
+			Success:   false,
+			Message:   err.Error(),
+			Files:     []*ent.File{},
+			Succeeded: []uuid.UUID{},
+			Failed:    []*batch.Failure{},
+		}, nil
+	}
+
+	return &model.FilesTagResponse{
+		Success:   len(items.Succeeded) > 0,
+		Message:   batchMessage(ctx, items, utils.T(ctx, "success.file.untagged")),
+		Files:     unwrapFiles(files),
+		Succeeded: items.Succeeded,
+		Failed:    items.Failed,
+	}, nil
+}
+
+// StarFile is the resolver for the starFile field.
+func (r *mutationResolver) StarFile(ctx context.Context, id uuid.UUID) (*model.FileResponse, error) {
+	// 🔄 [TRANSACTION]
+	var starred *ent.File
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		starred, err = fileservice.NewFileService().StarFile(txCtx, txClient, id)
+		return err
+	})
+	if err != nil {
+		return &model.FileResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.starred"),
+		File:    starred,
+	}, nil
+}
+
+// UnstarFile is the resolver for the unstarFile field.
+func (r *mutationResolver) UnstarFile(ctx context.Context, id uuid.UUID) (*model.FileResponse, error) {
+	// 🔄 [TRANSACTION]
+	var unstarred *ent.File
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		unstarred, err = fileservice.NewFileService().UnstarFile(txCtx, txClient, id)
+		return err
+	})
+	if err != nil {
+		return &model.FileResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.unstarred"),
+		File:    unstarred,
+	}, nil
+}
+
+// LockFile is the resolver for the lockFile field.
+func (r *mutationResolver) LockFile(ctx context.Context, id uuid.UUID) (*model.FileResponse, error) {
+	// 🔄 [TRANSACTION] Блокировка и запись аудита выполняются атомарно
+	var locked *ent.File
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		locked, err = fileservice.NewFileService().LockFile(txCtx, txClient, id)
+		return err
+	})
+	if err != nil {
+		return &model.FileResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.locked"),
+		File:    locked,
+	}, nil
+}
+
+// UnlockFile is the resolver for the unlockFile field.
+func (r *mutationResolver) UnlockFile(ctx context.Context, id uuid.UUID) (*model.FileResponse, error) {
+	// 🔄 [TRANSACTION] Снятие блокировки и запись аудита выполняются атомарно
+	var unlocked *ent.File
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		unlocked, err = fileservice.NewFileService().UnlockFile(txCtx, txClient, id)
+		return err
+	})
+	if err != nil {
+		return &model.FileResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.unlocked"),
+		File:    unlocked,
+	}, nil
+}
+
+// SetFileObjectLock is the resolver for the setFileObjectLock field.
+func (r *mutationResolver) SetFileObjectLock(ctx context.Context, id uuid.UUID, input model.FileObjectLockInput) (*model.FileResponse, error) {
+	// 🔄 [TRANSACTION] Удержание задается в S3 до записи файла и аудита
+	var updated *ent.File
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		updated, err = fileservice.NewFileService().SetFileObjectLock(txCtx, txClient, id, *buildObjectLockInput(input))
+		return err
+	})
+	if err != nil {
+		return &model.FileResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.object_lock_updated"),
+		File:    updated,
+	}, nil
+}
+
+// ScheduleFileDeletion is the resolver for the scheduleFileDeletion field.
+func (r *mutationResolver) ScheduleFileDeletion(ctx context.Context, id uuid.UUID, at *time.Time) (*model.FileResponse, error) {
+	// 🔄 [TRANSACTION] Срок удаления и запись аудита сохраняются атомарно
+	var updated *ent.File
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		updated, err = fileservice.NewFileService().ScheduleFileDeletion(txCtx, txClient, id, at)
+		return err
+	})
+	if err != nil {
+		return &model.FileResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	message := utils.T(ctx, "success.file.deletion_scheduled")
+	if at == nil {
+		message = utils.T(ctx, "success.file.deletion_canceled")
+	}
+	return &model.FileResponse{
+		Success: true,
+		Message: message,
+		File:    updated,
+	}, nil
+}
+
+// TrashedFiles is the resolver for the trashedFiles field.
+func (r *queryResolver) TrashedFiles(ctx context.Context, limit *int, offset *int) (*model.FileListResponse, error) {
+	client := r.getClient(ctx)
+
+	listLimit, listOffset := 0, 0
+	if limit != nil {
+		listLimit = *limit
+	}
+	if offset != nil {
+		listOffset = *offset
+	}
+
+	files, totalCount, err := fileservice.NewFileService().ListTrashedFiles(ctx, client, listLimit, listOffset)
+	if err != nil {
+		return &model.FileListResponse{Success: false, Message: err.Error(), Files: []*ent.File{}}, nil
+	}
+
+	return &model.FileListResponse{
+		Success:    true,
+		Message:    utils.T(ctx, "success.file.trash_list"),
+		Files:      files,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// MyStorageUsage is the resolver for the myStorageUsage field.
+func (r *queryResolver) MyStorageUsage(ctx context.Context, largestLimit *int) (*model.MyStorageUsageResponse, error) {
+	limit := 0
+	if largestLimit != nil {
+		limit = *largestLimit
+	}
+
+	usage, err := fileservice.NewFileService().GetMyStorageUsage(ctx, r.getClient(ctx), limit)
+	if err != nil {
+		return &model.MyStorageUsageResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.MyStorageUsageResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.storage_usage"),
+		Usage:   usage,
+	}, nil
+}
+
+// DepartmentStorageUsage is the resolver for the departmentStorageUsage field.
+func (r *queryResolver) DepartmentStorageUsage(ctx context.Context) (*model.DepartmentStorageUsageListResponse, error) {
+	departments, err := fileservice.NewFileService().GetDepartmentStorageUsage(ctx, r.getClient(ctx))
+	if err != nil {
+		return &model.DepartmentStorageUsageListResponse{
+			Success:     false,
+			Message:     err.Error(),
+			Departments: []*fileservice.DepartmentStorageUsage{},
+		}, nil
+	}
+
+	return &model.DepartmentStorageUsageListResponse{
+		Success:     true,
+		Message:     utils.T(ctx, "success.file.department_usage"),
+		Departments: departments,
+	}, nil
+}
+
+// UserStorageLimits is the resolver for the userStorageLimits field.
+func (r *queryResolver) UserStorageLimits(ctx context.Context) (*model.UserStorageLimitListResponse, error) {
+	users, err := fileservice.NewFileService().GetUserStorageLimits(ctx, r.getClient(ctx))
+	if err != nil {
+		return &model.UserStorageLimitListResponse{
+			Success: false,
+			Message: err.Error(),
+			Users:   []*fileservice.UserStorageLimitInfo{},
+		}, nil
+	}
+
+	return &model.UserStorageLimitListResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.user_storage_limits"),
+		Users:   users,
+	}, nil
+}
+
+// StorageCostEstimate is the resolver for the storageCostEstimate field.
+func (r *queryResolver) StorageCostEstimate(ctx context.Context) (*model.StorageCostEstimateResponse, error) {
+	estimate, err := fileservice.NewFileService().EstimateStorageCost(ctx, r.getClient(ctx))
+	if err != nil {
+		return &model.StorageCostEstimateResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	classes := make([]*model.StorageClassCost, 0, len(estimate.StorageClasses))
+	for _, class := range estimate.StorageClasses {
+		classes = append(classes, &model.StorageClassCost{
+			StorageClass:    model.StorageClass(class.StorageClass),
+			FileCount:       class.FileCount,
+			Bytes:           int(class.Bytes),
+			PricePerGbMonth: class.PricePerGBMonth,
+			MonthlyCost:     class.MonthlyCost,
+		})
+	}
+
+	return &model.StorageCostEstimateResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.storage_cost_estimate"),
+		Estimate: &model.StorageCostEstimate{
+			Currency:         estimate.Currency,
+			From:             estimate.From,
+			To:               estimate.To,
+			StorageClasses:   classes,
+			StorageCost:      estimate.StorageCost,
+			EgressDownloads:  estimate.EgressDownloads,
+			EgressBytes:      int(estimate.EgressBytes),
+			EgressPricePerGb: estimate.EgressPricePerGB,
+			EgressCost:       estimate.EgressCost,
+			TotalMonthlyCost: estimate.TotalMonthlyCost,
+		},
+	}, nil
+}
+
+// StorageUsage is the resolver for the storageUsage field.
+func (r *queryResolver) StorageUsage(ctx context.Context, refresh *bool) (*model.StorageUsageResponse, error) {
+	breakdown, err := fileservice.NewFileService().GetStorageUsageBreakdown(ctx, r.getClient(ctx), refresh != nil && *refresh)
+	if err != nil {
+		return &model.StorageUsageResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.StorageUsageResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.storage_usage"),
+		Usage:   buildStorageUsageBreakdown(ctx, breakdown),
+	}, nil
+}
+
+// FileTags is the resolver for the fileTags field.
+func (r *queryResolver) FileTags(ctx context.Context, search *string, limit *int) (*model.TagListResponse, error) {
+	searchValue, limitValue := "", 0
+	if search != nil {
+		searchValue = *search
+	}
+	if limit != nil {
+		limitValue = *limit
+	}
+
+	tags, err := fileservice.NewFileService().ListTags(ctx, r.getClient(ctx), searchValue, limitValue)
+	if err != nil {
+		return &model.TagListResponse{Success: false, Message: err.Error(), Tags: []*ent.Tag{}}, nil
+	}
+
+	return &model.TagListResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.tags_found"),
+		Tags:    tags,
+	}, nil
+}
+
+// TopDownloadedFiles is the resolver for the topDownloadedFiles field.
+func (r *queryResolver) TopDownloadedFiles(ctx context.Context, limit *int) (*model.FileListResponse, error) {
+	listLimit := 0
+	if limit != nil {
+		listLimit = *limit
+	}
+
+	files, totalCount, err := fileservice.NewFileService().TopDownloadedFiles(ctx, r.getClient(ctx), listLimit)
+	if err != nil {
+		return &model.FileListResponse{Success: false, Message: err.Error(), Files: []*ent.File{}}, nil
+	}
+
+	return &model.FileListResponse{
+		Success:    true,
+		Message:    utils.T(ctx, "success.file.top_downloaded"),
+		Files:      files,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// DuplicateFiles is the resolver for the duplicateFiles field.
+func (r *queryResolver) DuplicateFiles(ctx context.Context, limit *int, offset *int) (*model.DuplicateFilesResponse, error) {
+	reportLimit, reportOffset := 0, 0
+	if limit != nil {
+		reportLimit = *limit
+	}
+	if offset != nil {
+		reportOffset = *offset
+	}
+
+	report, err := fileservice.NewFileService().FindDuplicateFiles(ctx, r.getClient(ctx), reportLimit, reportOffset)
+	if err != nil {
+		return &model.DuplicateFilesResponse{Success: false, Message: err.Error(), Groups: []*model.DuplicateFileGroup{}}, nil
+	}
+
+	return &model.DuplicateFilesResponse{
+		Success:          true,
+		Message:          utils.T(ctx, "success.file.duplicates_found"),
+		Groups:           buildDuplicateFileGroups(report.Groups),
+		TotalGroups:      report.TotalGroups,
+		TotalWastedBytes: int(report.TotalWastedBytes),
+	}, nil
+}
+
+// FilePermissionsBatch is the resolver for the filePermissionsBatch field.
+func (r *queryResolver) FilePermissionsBatch(ctx context.Context, ids []uuid.UUID) (*model.FilePermissionsBatchResponse, error) {
+	if len(ids) > fileservice.MaxFilePermissionsBatch {
+		return &model.FilePermissionsBatchResponse{
+			Success:     false,
+			Message:     errcatalog.FileTooManyFilesForPermissions(ctx, fileservice.MaxFilePermissionsBatch).Error(),
+			Permissions: []*model.FilePermissions{},
+			Failed:      []*batch.Failure{},
+		}, nil
+	}
+
+	// Ошибка по одному файлу не скрывает права на остальные: такие файлы перечисляются в failed
+	loaded, errs := dataloader.GetFilePermissions(ctx, ids)
+	permissions := make([]fileservice.FilePermissions, 0, len(loaded))
+	failures := batch.NewResult()
+	for i, err := range errs {
+		if err != nil {
+			utils.Logger.Error("Failed to get file permissions", zap.Error(err), zap.String("file_id", ids[i].String()))
+			failures.Fail(ctx, ids[i], errcatalog.FileGetFilesFailed(ctx))
+			continue
+		}
+		permissions = append(permissions, loaded[i])
+	}
+
+	if len(ids) > 0 && len(permissions) == 0 {
+		return &model.FilePermissionsBatchResponse{
+			Success:     false,
+			Message:     errcatalog.FileGetFilesFailed(ctx).Error(),
+			Permissions: []*model.FilePermissions{},
+			Failed:      failures.Failed,
+		}, nil
+	}
+
+	return &model.FilePermissionsBatchResponse{
+		Success:     true,
+		Message:     utils.T(ctx, "success.file.permissions_found"),
+		Permissions: buildFilePermissions(permissions),
+		Failed:      failures.Failed,
+	}, nil
+}
+
+// FileCategories is the resolver for the fileCategories field.
+func (r *queryResolver) FileCategories(ctx context.Context) ([]*model.FileCategoryInfo, error) {
+	categories := make([]*model.FileCategoryInfo, 0, len(fileservice.FileCategories))
+	for _, category := range fileservice.FileCategories {
+		categories = append(categories, &model.FileCategoryInfo{
+			Category: model.FileCategory(category),
+			Label:    fileservice.FileCategoryLabel(ctx, category),
+		})
+	}
+	return categories, nil
+}
+
+// OnlyFavorites is the resolver for the onlyFavorites field.
+func (r *fileWhereInputResolver) OnlyFavorites(ctx context.Context, obj *ent.FileWhereInput, data *bool) error {
+	if data == nil || !*data {
+		return nil
+	}
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return errcatalog.UserNotAuthenticated(ctx)
+	}
+	obj.AddPredicates(fileservice.FavoriteOf(*userID))
+	return nil
+}
@@ -0,0 +1,182 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"main/graph/model"
+	"main/services/batch"
+	fileservice "main/services/file"
+	"main/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// CreateFileSet is the resolver for the createFileSet field.
+func (r *mutationResolver) CreateFileSet(ctx context.Context, input model.CreateFileSetInput) (*model.FileSetResponse, error) {
+	client := r.getClient(ctx)
+	service := fileservice.NewFileSetService()
+
+	set, err := service.CreateFileSet(ctx, client, fileservice.CreateFileSetInput{
+		Name:        input.Name,
+		Description: input.Description,
+		FileIDs:     input.FileIds,
+	})
+	if err != nil {
+		return &model.FileSetResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	item, err := buildFileSet(ctx, client, service, set)
+	if err != nil {
+		return &model.FileSetResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileSetResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.fileset.created"),
+		FileSet: item,
+	}, nil
+}
+
+// UpdateFileSet is the resolver for the updateFileSet field.
+func (r *mutationResolver) UpdateFileSet(ctx context.Context, id uuid.UUID, input model.UpdateFileSetInput) (*model.FileSetResponse, error) {
+	client := r.getClient(ctx)
+	service := fileservice.NewFileSetService()
+
+	set, err := service.UpdateFileSet(ctx, client, id, fileservice.UpdateFileSetInput{
+		Name:        input.Name,
+		Description: input.Description,
+		FileIDs:     input.FileIds,
+	})
+	if err != nil {
+		return &model.FileSetResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	item, err := buildFileSet(ctx, client, service, set)
+	if err != nil {
+		return &model.FileSetResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileSetResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.fileset.updated"),
+		FileSet: item,
+	}, nil
+}
+
+// DeleteFileSet is the resolver for the deleteFileSet field.
+func (r *mutationResolver) DeleteFileSet(ctx context.Context, id uuid.UUID) (*model.FileSetDeleteResponse, error) {
+	if err := fileservice.NewFileSetService().DeleteFileSet(ctx, r.getClient(ctx), id); err != nil {
+		return &model.FileSetDeleteResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileSetDeleteResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.fileset.deleted"),
+	}, nil
+}
+
+// ShareFileSet is the resolver for the shareFileSet field.
+func (r *mutationResolver) ShareFileSet(ctx context.Context, id uuid.UUID, input model.ShareFileSetInput) (*model.FileSetResponse, error) {
+	client := r.getClient(ctx)
+	service := fileservice.NewFileSetService()
+
+	set, err := service.ShareFileSet(ctx, client, id, input.SharedWithTenant, input.UserIds)
+	if err != nil {
+		return &model.FileSetResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	item, err := buildFileSet(ctx, client, service, set)
+	if err != nil {
+		return &model.FileSetResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileSetResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.fileset.shared"),
+		FileSet: item,
+	}, nil
+}
+
+// GetFileSetDownloadURL is the resolver for the getFileSetDownloadURL field.
+func (r *mutationResolver) GetFileSetDownloadURL(ctx context.Context, id uuid.UUID, archiveName *string, layout *model.ArchiveLayout) (*model.BatchDownloadURLResponse, error) {
+	var name string
+	if archiveName != nil {
+		name = *archiveName
+	}
+
+	// Раскладка файлов внутри архива (по умолчанию - все файлы в корне)
+	archiveLayout := fileservice.ArchiveLayoutFlat
+	if layout != nil {
+		archiveLayout = fileservice.ArchiveLayout(*layout)
+	}
+
+	result, err := fileservice.NewFileSetService().GetFileSetDownloadURL(ctx, r.getClient(ctx), id, name, archiveLayout)
+	if err != nil {
+		utils.Logger.Error("Failed to get file set download URL",
+			zap.Error(err),
+			zap.String("file_set_id", id.String()))
+		return &model.BatchDownloadURLResponse{
+			Success:    false,
+			Message:    err.Error(),
+			TotalFiles: 0,
+			Succeeded:  []uuid.UUID{},
+			Failed:     []*batch.Failure{},
+		}, nil
+	}
+
+	return buildBatchDownloadURLResponse(ctx, result), nil
+}
+
+// FileSets is the resolver for the fileSets field.
+func (r *queryResolver) FileSets(ctx context.Context) (*model.FileSetListResponse, error) {
+	client := r.getClient(ctx)
+	service := fileservice.NewFileSetService()
+
+	sets, err := service.ListFileSets(ctx, client)
+	if err != nil {
+		return &model.FileSetListResponse{Success: false, Message: err.Error(), FileSets: []*model.FileSetItem{}}, nil
+	}
+
+	// Файлы всех наборов загружаются одним запросом
+	files, err := service.GetFileSetsFiles(ctx, client, sets)
+	if err != nil {
+		return &model.FileSetListResponse{Success: false, Message: err.Error(), FileSets: []*model.FileSetItem{}}, nil
+	}
+
+	items := make([]*model.FileSetItem, 0, len(sets))
+	for _, set := range sets {
+		items = append(items, buildFileSetItem(ctx, service, set, files[set.ID]))
+	}
+
+	return &model.FileSetListResponse{
+		Success:  true,
+		Message:  utils.T(ctx, "success.fileset.list_retrieved"),
+		FileSets: items,
+	}, nil
+}
+
+// FileSet is the resolver for the fileSet field.
+func (r *queryResolver) FileSet(ctx context.Context, id uuid.UUID) (*model.FileSetResponse, error) {
+	client := r.getClient(ctx)
+	service := fileservice.NewFileSetService()
+
+	set, err := service.GetFileSet(ctx, client, id)
+	if err != nil {
+		return &model.FileSetResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	item, err := buildFileSet(ctx, client, service, set)
+	if err != nil {
+		return &model.FileSetResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileSetResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.fileset.retrieved"),
+		FileSet: item,
+	}, nil
+}
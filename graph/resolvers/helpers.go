@@ -0,0 +1,603 @@
+package resolvers
+
+// This file will not be regenerated automatically.
+//
+// It contains helpers shared by resolvers.
+
+import (
+	"context"
+	"main/config"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/schema/filemeta"
+	"main/errcatalog"
+	"main/graph/model"
+	"main/middleware"
+	auditservice "main/services/audit"
+	"main/services/batch"
+	fileservice "main/services/file"
+	localizationservice "main/services/localization"
+	tenantservice "main/services/tenant"
+	"main/utils"
+	"sort"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// withTx выполняет fn в транзакции. Резолвер владеет границами транзакции, а сервисы получают
+// tx-клиент и контекст с транзакцией, поэтому несколько вызовов сервисов выполняются атомарно.
+// Ошибка fn возвращается как есть (сервисы возвращают уже локализованные сообщения).
+func (r *Resolver) withTx(ctx context.Context, fn func(txCtx context.Context, client *ent.Client) error) error {
+	tx, err := r.getClient(ctx).Tx(ctx)
+	if err != nil {
+		utils.Logger.Error("Failed to start transaction", zap.Error(err))
+		return errcatalog.TransactionFailed(ctx)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	txCtx := ent.NewTxContext(ctx, tx)
+	if err := fn(txCtx, tx.Client()); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			utils.Logger.Error("Failed to rollback transaction", zap.Error(rollbackErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		utils.Logger.Error("Failed to commit transaction", zap.Error(err))
+		return errcatalog.TransactionCommitFailed(ctx)
+	}
+
+	return nil
+}
+
+// unwrapFiles отвязывает файлы от завершенной транзакции withTx, чтобы связи, запрошенные в ответе
+// (например, tags), загружались через основной клиент, а не через закрытую транзакцию
+func unwrapFiles(files []*ent.File) []*ent.File {
+	for i, fileRecord := range files {
+		files[i] = fileRecord.Unwrap()
+	}
+	return files
+}
+
+// buildTenantLocaleSettings собирает настройки локализации тенанта для ответа GraphQL
+func buildTenantLocaleSettings(ctx context.Context, client *ent.Client, service *localizationservice.LocalizationService) (*model.TenantLocaleSettings, error) {
+	defaultLanguage, err := service.GetDefaultLanguage(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := service.ListOverrides(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &model.TenantLocaleSettings{
+		SupportedLanguages: localizationservice.SupportedLanguages,
+		Overrides:          make([]*model.TranslationOverrideItem, 0, len(overrides)),
+	}
+	if defaultLanguage != "" {
+		settings.DefaultLanguage = &defaultLanguage
+	}
+	for _, override := range overrides {
+		settings.Overrides = append(settings.Overrides, &model.TranslationOverrideItem{
+			MessageID: override.MessageID,
+			Language:  override.Language,
+			Text:      override.Text,
+		})
+	}
+
+	return settings, nil
+}
+
+// buildServiceConfig конвертирует настройки сервиса в GraphQL модель
+func buildServiceConfig(cfg *config.Runtime) *model.ServiceConfig {
+	features := make(map[string]any, len(cfg.Features))
+	for name, enabled := range cfg.Features {
+		features[name] = enabled
+	}
+
+	result := &model.ServiceConfig{
+		MaxUploadSize:        int(cfg.MaxUploadSize),
+		MaxBatchArchiveFiles: cfg.MaxBatchArchiveFiles,
+		MaxBatchDeleteFiles:  cfg.MaxBatchDeleteFiles,
+		FilesDefaultOrder:    cfg.FilesDefaultOrder,
+		Features:             features,
+	}
+	if cfg.LogLevel != "" {
+		result.LogLevel = &cfg.LogLevel
+	}
+	return result
+}
+
+// buildLogLevelState конвертирует состояние уровня логирования в GraphQL модель
+func buildLogLevelState(state utils.LogLevelState) *model.LogLevelState {
+	return &model.LogLevelState{
+		Level:     state.Level,
+		BaseLevel: state.BaseLevel,
+		ExpiresAt: state.ExpiresAt,
+	}
+}
+
+// buildServiceActivity конвертирует сводку выполняющихся запросов в GraphQL модель
+func buildServiceActivity(stats middleware.InflightStats) *model.ServiceActivity {
+	operations := make([]*model.ServiceActivityOperation, 0, len(stats.ByOperation))
+	for operation, count := range stats.ByOperation {
+		operations = append(operations, &model.ServiceActivityOperation{Operation: operation, Count: count})
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].Count != operations[j].Count {
+			return operations[i].Count > operations[j].Count
+		}
+		return operations[i].Operation < operations[j].Operation
+	})
+
+	longest := make([]*model.ActiveRequest, 0, len(stats.Longest))
+	for _, request := range stats.Longest {
+		longest = append(longest, &model.ActiveRequest{
+			Kind:      request.Kind,
+			Operation: request.Operation,
+			StartedAt: request.StartedAt,
+			ElapsedMs: int(request.ElapsedMs),
+		})
+	}
+
+	return &model.ServiceActivity{
+		HTTPRequests:    stats.HTTPRequests,
+		GraphqlRequests: stats.GraphQLRequests,
+		WebSockets:      stats.WebSockets,
+		Operations:      operations,
+		Longest:         longest,
+	}
+}
+
+// buildTenantStateInfo конвертирует состояние тенанта в GraphQL модель
+func buildTenantStateInfo(state *tenantservice.TenantState) *model.TenantStateInfo {
+	info := &model.TenantStateInfo{
+		State:     state.State,
+		ChangedAt: state.ChangedAt,
+		Source:    string(state.Source),
+	}
+	if state.Reason != "" {
+		info.Reason = &state.Reason
+	}
+	return info
+}
+
+// buildTenantQuotaPolicy конвертирует режим квоты тенанта в GraphQL модель
+func buildTenantQuotaPolicy(policy *tenantservice.QuotaPolicy) *model.TenantQuotaPolicy {
+	var storageLimitBytes *int
+	if policy.StorageLimitBytes != nil {
+		value := int(*policy.StorageLimitBytes)
+		storageLimitBytes = &value
+	}
+	return &model.TenantQuotaPolicy{
+		Mode:              policy.Mode,
+		GracePercent:      policy.GracePercent,
+		StorageLimitBytes: storageLimitBytes,
+	}
+}
+
+// buildFilePermissions конвертирует права на файлы в GraphQL модель
+func buildFilePermissions(permissions []fileservice.FilePermissions) []*model.FilePermissions {
+	result := make([]*model.FilePermissions, 0, len(permissions))
+	for _, permission := range permissions {
+		result = append(result, &model.FilePermissions{
+			FileID:      permission.FileID,
+			Found:       permission.Found,
+			CanView:     permission.CanView,
+			CanDownload: permission.CanDownload,
+			CanUpdate:   permission.CanUpdate,
+			CanDelete:   permission.CanDelete,
+		})
+	}
+	return result
+}
+
+// buildFilePolicy конвертирует политику загрузки тенанта в GraphQL модель
+func buildFilePolicy(policy *tenantservice.UploadPolicy) *model.FilePolicy {
+	classificationTags := policy.ClassificationTags
+	if classificationTags == nil {
+		classificationTags = []string{}
+	}
+	var maxUploadSize *int
+	if policy.MaxUploadSize != nil {
+		value := int(*policy.MaxUploadSize)
+		maxUploadSize = &value
+	}
+	return &model.FilePolicy{
+		RequireDescription:     policy.RequireDescription,
+		DescriptionTemplate:    policy.DescriptionTemplate,
+		ClassificationTags:     classificationTags,
+		MaxUploadSize:          maxUploadSize,
+		EffectiveMaxUploadSize: int(policy.EffectiveMaxUploadSize()),
+	}
+}
+
+// buildTenantFileTypePolicy конвертирует политику типов файлов тенанта в GraphQL модель
+func buildTenantFileTypePolicy(policy *tenantservice.FileTypePolicy) *model.TenantFileTypePolicy {
+	orEmpty := func(values []string) []string {
+		if values == nil {
+			return []string{}
+		}
+		return values
+	}
+	return &model.TenantFileTypePolicy{
+		AllowedExtensions: orEmpty(policy.AllowedExtensions),
+		BlockedExtensions: orEmpty(policy.BlockedExtensions),
+		AllowedMimeTypes:  orEmpty(policy.AllowedMimeTypes),
+		BlockedMimeTypes:  orEmpty(policy.BlockedMimeTypes),
+	}
+}
+
+// buildFileMetadataSchemas конвертирует схемы metadata файлов тенанта в GraphQL модель
+func buildFileMetadataSchemas(schemas []filemeta.Schema) []*model.FileMetadataSchema {
+	result := make([]*model.FileMetadataSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		modelSchema := &model.FileMetadataSchema{
+			Fields:          make([]*model.FileMetadataField, 0, len(schema.Fields)),
+			AllowAdditional: schema.AllowAdditional,
+		}
+		if schema.EntityType != "" {
+			entityType := file.EntityType(schema.EntityType)
+			modelSchema.EntityType = &entityType
+		}
+		for _, field := range schema.Fields {
+			modelSchema.Fields = append(modelSchema.Fields, &model.FileMetadataField{
+				Key:      field.Key,
+				Type:     model.FileMetadataFieldType(field.Type),
+				Required: field.Required,
+			})
+		}
+		result = append(result, modelSchema)
+	}
+	return result
+}
+
+// fileMetadataSchemasFromInput конвертирует схемы metadata из GraphQL input
+func fileMetadataSchemasFromInput(input []*model.FileMetadataSchemaInput) []filemeta.Schema {
+	schemas := make([]filemeta.Schema, 0, len(input))
+	for _, schemaInput := range input {
+		schema := filemeta.Schema{
+			Fields:          make([]filemeta.Field, 0, len(schemaInput.Fields)),
+			AllowAdditional: schemaInput.AllowAdditional != nil && *schemaInput.AllowAdditional,
+		}
+		if schemaInput.EntityType != nil {
+			schema.EntityType = schemaInput.EntityType.String()
+		}
+		for _, fieldInput := range schemaInput.Fields {
+			schema.Fields = append(schema.Fields, filemeta.Field{
+				Key:      fieldInput.Key,
+				Type:     filemeta.FieldType(fieldInput.Type),
+				Required: fieldInput.Required != nil && *fieldInput.Required,
+			})
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas
+}
+
+// buildStorageUsageBreakdown конвертирует разбивку использования хранилища в GraphQL модель
+func buildStorageUsageBreakdown(ctx context.Context, breakdown *fileservice.StorageUsageBreakdown) *model.StorageUsageBreakdown {
+	usage := &model.StorageUsageBreakdown{
+		TotalBytes:   int(breakdown.TotalBytes),
+		FileCount:    breakdown.FileCount,
+		TrashedBytes: int(breakdown.TrashedBytes),
+		ByUploader:   make([]*model.UploaderStorageUsage, 0, len(breakdown.ByUploader)),
+		ByCategory:   make([]*model.CategoryStorageUsage, 0, len(breakdown.ByCategory)),
+		ByMonth:      make([]*model.MonthStorageUsage, 0, len(breakdown.ByMonth)),
+		ComputedAt:   breakdown.ComputedAt,
+	}
+	for _, uploader := range breakdown.ByUploader {
+		usage.ByUploader = append(usage.ByUploader, &model.UploaderStorageUsage{
+			UserID:    uploader.UserID,
+			FileCount: uploader.FileCount,
+			Bytes:     int(uploader.Bytes),
+		})
+	}
+	for _, category := range breakdown.ByCategory {
+		usage.ByCategory = append(usage.ByCategory, &model.CategoryStorageUsage{
+			Category:  model.FileCategory(category.Category),
+			Label:     fileservice.FileCategoryLabel(ctx, category.Category),
+			FileCount: category.FileCount,
+			Bytes:     int(category.Bytes),
+		})
+	}
+	for _, month := range breakdown.ByMonth {
+		usage.ByMonth = append(usage.ByMonth, &model.MonthStorageUsage{
+			Month:     month.Month,
+			FileCount: month.FileCount,
+			Bytes:     int(month.Bytes),
+		})
+	}
+	return usage
+}
+
+// buildDuplicateFileGroups конвертирует группы дублей файлов в GraphQL модель
+func buildDuplicateFileGroups(groups []*fileservice.DuplicateFileGroup) []*model.DuplicateFileGroup {
+	result := make([]*model.DuplicateFileGroup, 0, len(groups))
+	for _, group := range groups {
+		modelGroup := &model.DuplicateFileGroup{
+			MatchedBy:   model.DuplicateFileMatch(group.MatchedBy),
+			Size:        int(group.Size),
+			FileCount:   group.FileCount,
+			WastedBytes: int(group.WastedBytes),
+			Files:       group.Files,
+		}
+		if group.MatchedBy == fileservice.DuplicateMatchChecksum {
+			modelGroup.ChecksumSha256 = &group.ChecksumSha256
+		} else {
+			modelGroup.Name = &group.Name
+		}
+		result = append(result, modelGroup)
+	}
+	return result
+}
+
+// buildResumableUpload конвертирует состояние возобновляемой загрузки в GraphQL модель
+func buildResumableUpload(upload *fileservice.ResumableUpload) *model.ResumableUpload {
+	parts := make([]*model.ResumableUploadPart, 0, len(upload.Parts))
+	for _, part := range upload.Parts {
+		modelPart := &model.ResumableUploadPart{
+			PartNumber: part.PartNumber,
+			Size:       int(part.Size),
+			Etag:       part.ETag,
+			UploadedAt: part.UploadedAt,
+		}
+		if part.MD5 != "" {
+			md5 := part.MD5
+			modelPart.Md5 = &md5
+		}
+		parts = append(parts, modelPart)
+	}
+
+	return &model.ResumableUpload{
+		ID:            upload.ID,
+		Filename:      upload.Filename,
+		ContentType:   upload.ContentType,
+		Description:   upload.Description,
+		Size:          int(upload.Size),
+		PartSize:      int(upload.PartSize),
+		TotalParts:    upload.TotalParts(),
+		UploadedParts: parts,
+		MissingParts:  upload.MissingParts(),
+		UploadedBy:    upload.UploadedBy,
+		CreatedAt:     upload.CreatedAt,
+		ExpiresAt:     upload.ExpiresAt,
+	}
+}
+
+// buildAuditListFilter конвертирует GraphQL фильтр аудита файлов в фильтр сервиса
+func buildAuditListFilter(filter *model.FileAuditEventFilter) auditservice.ListFilter {
+	listFilter := auditservice.ListFilter{}
+	if filter != nil {
+		listFilter.Actions = filter.Actions
+		listFilter.FileID = filter.FileID
+		listFilter.ActorID = filter.ActorID
+		listFilter.From = filter.From
+		listFilter.To = filter.To
+		listFilter.Search = filter.Search
+	}
+	return listFilter
+}
+
+// buildFileAuditAggregations конвертирует агрегаты аудита в GraphQL модель
+func buildFileAuditAggregations(aggregations *auditservice.Aggregations) *model.FileAuditAggregations {
+	result := &model.FileAuditAggregations{
+		From:            aggregations.From,
+		To:              aggregations.To,
+		DownloadsPerDay: make([]*model.FileAuditDayBucket, 0, len(aggregations.DownloadsPerDay)),
+		TopDownloaders:  make([]*model.FileAuditActorBucket, 0, len(aggregations.TopDownloaders)),
+		ActionsByType:   make([]*model.FileAuditActionBucket, 0, len(aggregations.ActionsByType)),
+	}
+	for _, bucket := range aggregations.DownloadsPerDay {
+		result.DownloadsPerDay = append(result.DownloadsPerDay, &model.FileAuditDayBucket{Date: bucket.Date, Count: bucket.Count})
+	}
+	for _, bucket := range aggregations.TopDownloaders {
+		result.TopDownloaders = append(result.TopDownloaders, &model.FileAuditActorBucket{ActorID: bucket.ActorID, Count: bucket.Count})
+	}
+	for _, bucket := range aggregations.ActionsByType {
+		result.ActionsByType = append(result.ActionsByType, &model.FileAuditActionBucket{Action: bucket.Action, Count: bucket.Count})
+	}
+	return result
+}
+
+// buildArchiveJob конвертирует задание сборки архива в GraphQL модель
+func buildArchiveJob(job *fileservice.ArchiveJob) *model.ArchiveJob {
+	items := job.Result
+	if items == nil {
+		// Задание сохранено до появления итога по файлам
+		items = batch.NewResult()
+	}
+	return &model.ArchiveJob{
+		ID:             job.ID,
+		Status:         model.ArchiveJobStatus(job.Status),
+		ArchiveName:    job.ArchiveName,
+		TotalFiles:     job.TotalFiles,
+		ProcessedFiles: job.ProcessedFiles,
+		Percent:        job.Percent(),
+		URL:            job.URL,
+		ExpiresAt:      job.URLExpiresAt,
+		Error:          job.Error,
+		Succeeded:      items.Succeeded,
+		Failed:         items.Failed,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+}
+
+// buildFilesDeleteResponse собирает ответ пакетного удаления. Устаревшие totalDeleted, totalFailed и results
+// заполняются из итога для клиентов, еще не перешедших на succeeded и failed.
+func buildFilesDeleteResponse(ctx context.Context, items *batch.Result) *model.FilesDeleteResponse {
+	response := &model.FilesDeleteResponse{
+		Success:      len(items.Succeeded) > 0,
+		Message:      batchMessage(ctx, items, utils.T(ctx, "success.file.batch_deleted")),
+		Succeeded:    items.Succeeded,
+		Failed:       items.Failed,
+		TotalDeleted: len(items.Succeeded),
+		TotalFailed:  len(items.Failed),
+		Results:      make([]*model.FileDeleteResult, 0, len(items.Succeeded)+len(items.Failed)),
+	}
+	for _, fileID := range items.Succeeded {
+		response.Results = append(response.Results, &model.FileDeleteResult{FileID: fileID, Success: true})
+	}
+	for _, failure := range items.Failed {
+		message := failure.Message
+		response.Results = append(response.Results, &model.FileDeleteResult{FileID: failure.ID, Message: &message})
+	}
+	return response
+}
+
+// buildBatchDownloadURLResponse собирает ответ со ссылкой на архив; ссылки нет, если в архив не вошел ни один файл
+func buildBatchDownloadURLResponse(ctx context.Context, result *fileservice.BatchDownloadUrlResult) *model.BatchDownloadURLResponse {
+	response := &model.BatchDownloadURLResponse{
+		Success:    len(result.Result.Succeeded) > 0,
+		Message:    batchMessage(ctx, result.Result, utils.T(ctx, "success.file.batch_download_url_generated")),
+		TotalFiles: result.TotalFiles,
+		Succeeded:  result.Result.Succeeded,
+		Failed:     result.Result.Failed,
+	}
+	if response.Success {
+		response.URL = &result.URL
+		response.ExpiresAt = &result.ExpiresAt
+		response.ArchiveName = &result.ArchiveName
+	}
+	return response
+}
+
+// batchMessage возвращает сообщение пакетной операции: successMessage, если обработаны все элементы,
+// количество обработанных и отклоненных при частичном успехе и общую ошибку, если не обработан ни один
+func batchMessage(ctx context.Context, items *batch.Result, successMessage string) string {
+	switch {
+	case len(items.Succeeded) == 0:
+		return errcatalog.BatchAllFailed(ctx).Error()
+	case len(items.Failed) > 0:
+		return utils.T(ctx, "success.batch.partial", utils.TemplateData{
+			"succeeded": len(items.Succeeded),
+			"failed":    len(items.Failed),
+		})
+	default:
+		return successMessage
+	}
+}
+
+// uploadSuccessMessage возвращает сообщение об успешной загрузке. Конфликт имен не мешает загрузке:
+// клиент получает предупреждение или новое имя файла.
+func uploadSuccessMessage(ctx context.Context, conflict *fileservice.FilenameConflict) string {
+	if conflict == nil {
+		return utils.T(ctx, "success.file.uploaded")
+	}
+	if conflict.Renamed {
+		return utils.T(ctx, "success.file.uploaded_renamed", utils.TemplateData{"name": conflict.ResolvedName})
+	}
+	return utils.T(ctx, "success.file.uploaded_name_conflict", utils.TemplateData{"name": conflict.OriginalName})
+}
+
+// buildFileNameConflict конвертирует конфликт имен загруженного файла в GraphQL модель.
+// Файлы с тем же именем, которые не удалось загрузить, не включаются в ответ.
+func buildFileNameConflict(ctx context.Context, client *ent.Client, conflict *fileservice.FilenameConflict) *model.FileNameConflict {
+	if conflict == nil {
+		return nil
+	}
+	conflictingFiles, err := client.File.Query().
+		Where(file.IDIn(conflict.ConflictingFileIDs...)).
+		All(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Warn("Failed to load conflicting files", zap.Error(err))
+		conflictingFiles = []*ent.File{}
+	}
+	return &model.FileNameConflict{
+		OriginalName:     conflict.OriginalName,
+		ResolvedName:     conflict.ResolvedName,
+		Renamed:          conflict.Renamed,
+		ConflictingFiles: conflictingFiles,
+	}
+}
+
+// buildFileSet конвертирует набор файлов в GraphQL модель вместе с доступными файлами набора
+func buildFileSet(ctx context.Context, client *ent.Client, service *fileservice.FileSetService, set *ent.FileSet) (*model.FileSetItem, error) {
+	files, err := service.GetFileSetFiles(ctx, client, set)
+	if err != nil {
+		return nil, err
+	}
+	return buildFileSetItem(ctx, service, set, files), nil
+}
+
+// buildFileSetItem конвертирует набор с уже загруженными доступными файлами в GraphQL модель
+func buildFileSetItem(ctx context.Context, service *fileservice.FileSetService, set *ent.FileSet, files []*ent.File) *model.FileSetItem {
+	sharedUserIDs := set.SharedUserIds
+	if sharedUserIDs == nil {
+		sharedUserIDs = []uuid.UUID{}
+	}
+
+	item := &model.FileSetItem{
+		ID:               set.ID,
+		Name:             set.Name,
+		FileIds:          set.FileIds,
+		Files:            files,
+		SharedWithTenant: set.SharedWithTenant,
+		SharedUserIds:    sharedUserIDs,
+		CreatedBy:        set.CreatedBy,
+		CreateTime:       set.CreateTime,
+		UpdateTime:       set.UpdateTime,
+		CanEdit:          service.CanEditFileSet(ctx, set),
+	}
+	if set.Description != "" {
+		item.Description = &set.Description
+	}
+	return item
+}
+
+// buildLifecycleRule конвертирует правило жизненного цикла в GraphQL модель
+func buildLifecycleRule(rule *ent.LifecycleRule) *model.LifecycleRuleItem {
+	item := &model.LifecycleRuleItem{
+		ID:          rule.ID,
+		Name:        rule.Name,
+		Enabled:     rule.Enabled,
+		Action:      rule.Action,
+		MinAgeDays:  rule.MinAgeDays,
+		MinIdleDays: rule.MinIdleDays,
+		Tag:         rule.Tag,
+		CreatedBy:   rule.CreatedBy,
+		LastRunAt:   rule.LastRunAt,
+		CreateTime:  rule.CreateTime,
+		UpdateTime:  rule.UpdateTime,
+	}
+	if rule.MimeCategory != nil {
+		category := model.FileCategory(*rule.MimeCategory)
+		item.MimeCategory = &category
+	}
+	return item
+}
+
+// buildLifecycleRuleInput конвертирует GraphQL параметры правила жизненного цикла во входные данные сервиса
+func buildLifecycleRuleInput(input *model.LifecycleRuleInput) *fileservice.LifecycleRuleInput {
+	result := &fileservice.LifecycleRuleInput{
+		Name:        input.Name,
+		Enabled:     input.Enabled == nil || *input.Enabled,
+		Action:      input.Action,
+		MinAgeDays:  input.MinAgeDays,
+		MinIdleDays: input.MinIdleDays,
+		Tag:         input.Tag,
+	}
+	if input.MimeCategory != nil {
+		category := fileservice.FileCategory(*input.MimeCategory)
+		result.MimeCategory = &category
+	}
+	return result
+}
+
+// buildObjectLockInput конвертирует GraphQL удержание объекта во входные данные сервиса
+func buildObjectLockInput(input model.FileObjectLockInput) *fileservice.ObjectLockInput {
+	return &fileservice.ObjectLockInput{
+		Mode:        input.Mode,
+		RetainUntil: input.RetainUntil,
+		LegalHold:   input.LegalHold,
+	}
+}
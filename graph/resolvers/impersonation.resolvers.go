@@ -0,0 +1,82 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"main/ent"
+	"main/graph/model"
+	impersonationservice "main/services/impersonation"
+	"main/utils"
+
+	"github.com/google/uuid"
+)
+
+// GrantImpersonation is the resolver for the grantImpersonation field.
+func (r *mutationResolver) GrantImpersonation(ctx context.Context, input model.GrantImpersonationInput) (*model.ImpersonationGrantResponse, error) {
+	// 🔄 [TRANSACTION]
+	var grant *ent.ImpersonationGrant
+	var token string
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		grant, token, err = impersonationservice.NewImpersonationService().Grant(txCtx, txClient, impersonationservice.GrantInput{
+			SupportUserID:       input.SupportUserID,
+			TargetUserID:        input.TargetUserID,
+			TargetRole:          input.TargetRole,
+			TargetDepartmentIDs: input.TargetDepartmentIds,
+			DurationMinutes:     input.DurationMinutes,
+			Reason:              input.Reason,
+		})
+		return err
+	})
+	if err != nil {
+		return &model.ImpersonationGrantResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.ImpersonationGrantResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.impersonation.granted"),
+		Grant:   grant,
+		Token:   &token,
+	}, nil
+}
+
+// RevokeImpersonation is the resolver for the revokeImpersonation field.
+func (r *mutationResolver) RevokeImpersonation(ctx context.Context, id uuid.UUID) (*model.ImpersonationGrantResponse, error) {
+	// 🔄 [TRANSACTION]
+	var grant *ent.ImpersonationGrant
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		grant, err = impersonationservice.NewImpersonationService().Revoke(txCtx, txClient, id)
+		return err
+	})
+	if err != nil {
+		return &model.ImpersonationGrantResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.ImpersonationGrantResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.impersonation.revoked"),
+		Grant:   grant,
+	}, nil
+}
+
+// ImpersonationGrants is the resolver for the impersonationGrants field.
+func (r *queryResolver) ImpersonationGrants(ctx context.Context, activeOnly *bool) (*model.ImpersonationGrantListResponse, error) {
+	grants, err := impersonationservice.NewImpersonationService().List(ctx, r.getClient(ctx), activeOnly != nil && *activeOnly)
+	if err != nil {
+		return &model.ImpersonationGrantListResponse{
+			Success: false,
+			Message: err.Error(),
+			Grants:  []*ent.ImpersonationGrant{},
+		}, nil
+	}
+
+	return &model.ImpersonationGrantListResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.impersonation.found"),
+		Grants:  grants,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"main/ent"
+	"main/graph/model"
+	fileservice "main/services/file"
+	"main/utils"
+
+	"github.com/google/uuid"
+)
+
+// CreateLifecycleRule is the resolver for the createLifecycleRule field.
+func (r *mutationResolver) CreateLifecycleRule(ctx context.Context, input model.LifecycleRuleInput) (*model.LifecycleRuleResponse, error) {
+	// 🔄 [TRANSACTION]
+	var rule *ent.LifecycleRule
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		rule, err = fileservice.NewFileService().CreateLifecycleRule(txCtx, txClient, *buildLifecycleRuleInput(&input))
+		return err
+	})
+	if err != nil {
+		return &model.LifecycleRuleResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.LifecycleRuleResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.lifecycle.rule_created"),
+		Rule:    buildLifecycleRule(rule),
+	}, nil
+}
+
+// UpdateLifecycleRule is the resolver for the updateLifecycleRule field.
+func (r *mutationResolver) UpdateLifecycleRule(ctx context.Context, id uuid.UUID, input model.LifecycleRuleInput) (*model.LifecycleRuleResponse, error) {
+	// 🔄 [TRANSACTION]
+	var rule *ent.LifecycleRule
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		rule, err = fileservice.NewFileService().UpdateLifecycleRule(txCtx, txClient, id, *buildLifecycleRuleInput(&input))
+		return err
+	})
+	if err != nil {
+		return &model.LifecycleRuleResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.LifecycleRuleResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.lifecycle.rule_updated"),
+		Rule:    buildLifecycleRule(rule),
+	}, nil
+}
+
+// DeleteLifecycleRule is the resolver for the deleteLifecycleRule field.
+func (r *mutationResolver) DeleteLifecycleRule(ctx context.Context, id uuid.UUID) (*model.LifecycleRuleDeleteResponse, error) {
+	if err := fileservice.NewFileService().DeleteLifecycleRule(ctx, r.getClient(ctx), id); err != nil {
+		return &model.LifecycleRuleDeleteResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.LifecycleRuleDeleteResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.lifecycle.rule_deleted"),
+	}, nil
+}
+
+// DryRunLifecycleRules is the resolver for the dryRunLifecycleRules field.
+func (r *mutationResolver) DryRunLifecycleRules(ctx context.Context, rules []*model.LifecycleRuleInput, sampleLimit *int) (*model.LifecycleDryRunResponse, error) {
+	var inputs []*fileservice.LifecycleRuleInput
+	if rules != nil {
+		inputs = make([]*fileservice.LifecycleRuleInput, 0, len(rules))
+		for _, rule := range rules {
+			inputs = append(inputs, buildLifecycleRuleInput(rule))
+		}
+	}
+	limit := 0
+	if sampleLimit != nil {
+		limit = *sampleLimit
+	}
+
+	results, err := fileservice.NewFileService().DryRunLifecycleRules(ctx, r.getClient(ctx), inputs, limit)
+	if err != nil {
+		return &model.LifecycleDryRunResponse{
+			Success: false,
+			Message: err.Error(),
+			Results: []*fileservice.LifecycleRuleDryRun{},
+		}, nil
+	}
+
+	return &model.LifecycleDryRunResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.lifecycle.dry_run"),
+		Results: results,
+	}, nil
+}
+
+// LifecycleRules is the resolver for the lifecycleRules field.
+func (r *queryResolver) LifecycleRules(ctx context.Context) (*model.LifecycleRuleListResponse, error) {
+	rules, err := fileservice.NewFileService().ListLifecycleRules(ctx, r.getClient(ctx))
+	if err != nil {
+		return &model.LifecycleRuleListResponse{
+			Success: false,
+			Message: err.Error(),
+			Rules:   []*model.LifecycleRuleItem{},
+		}, nil
+	}
+
+	items := make([]*model.LifecycleRuleItem, 0, len(rules))
+	for _, rule := range rules {
+		items = append(items, buildLifecycleRule(rule))
+	}
+
+	return &model.LifecycleRuleListResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.lifecycle.rules_found"),
+		Rules:   items,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"main/ent"
+	"main/graph/model"
+	localizationservice "main/services/localization"
+	"main/utils"
+
+	"go.uber.org/zap"
+)
+
+// SetTenantDefaultLanguage is the resolver for the setTenantDefaultLanguage field.
+func (r *mutationResolver) SetTenantDefaultLanguage(ctx context.Context, language string) (*model.TenantLocaleSettingsResponse, error) {
+	client := r.getClient(ctx)
+	localizationService := localizationservice.NewLocalizationService()
+
+	// 🔄 [TRANSACTION]
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		return localizationService.SetDefaultLanguage(txCtx, txClient, language)
+	})
+	if err != nil {
+		return &model.TenantLocaleSettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	// Инвалидируем кэш после коммита
+	localizationService.InvalidateTenantLocaleCache(ctx)
+
+	settings, err := buildTenantLocaleSettings(ctx, client, localizationService)
+	if err != nil {
+		return &model.TenantLocaleSettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantLocaleSettingsResponse{
+		Success:  true,
+		Message:  utils.T(ctx, "success.localization.updated"),
+		Settings: settings,
+	}, nil
+}
+
+// SetTranslationOverride is the resolver for the setTranslationOverride field.
+func (r *mutationResolver) SetTranslationOverride(ctx context.Context, input model.TranslationOverrideInput) (*model.TenantLocaleSettingsResponse, error) {
+	client := r.getClient(ctx)
+	localizationService := localizationservice.NewLocalizationService()
+
+	// 🔄 [TRANSACTION]
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		return localizationService.SetOverride(txCtx, txClient, localizationservice.OverrideInput{
+			MessageID: input.MessageID,
+			Language:  input.Language,
+			Text:      input.Text,
+		})
+	})
+	if err != nil {
+		utils.Logger.Warn("Failed to set translation override",
+			zap.Error(err),
+			zap.String("message_id", input.MessageID))
+		return &model.TenantLocaleSettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	// Инвалидируем кэш после коммита
+	localizationService.InvalidateTenantLocaleCache(ctx)
+
+	settings, err := buildTenantLocaleSettings(ctx, client, localizationService)
+	if err != nil {
+		return &model.TenantLocaleSettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantLocaleSettingsResponse{
+		Success:  true,
+		Message:  utils.T(ctx, "success.localization.updated"),
+		Settings: settings,
+	}, nil
+}
+
+// DeleteTranslationOverride is the resolver for the deleteTranslationOverride field.
+func (r *mutationResolver) DeleteTranslationOverride(ctx context.Context, messageID string, language string) (*model.TenantLocaleSettingsResponse, error) {
+	client := r.getClient(ctx)
+	localizationService := localizationservice.NewLocalizationService()
+
+	if err := localizationService.DeleteOverride(ctx, client, messageID, language); err != nil {
+		return &model.TenantLocaleSettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	// Инвалидируем кэш после удаления
+	localizationService.InvalidateTenantLocaleCache(ctx)
+
+	settings, err := buildTenantLocaleSettings(ctx, client, localizationService)
+	if err != nil {
+		return &model.TenantLocaleSettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantLocaleSettingsResponse{
+		Success:  true,
+		Message:  utils.T(ctx, "success.localization.updated"),
+		Settings: settings,
+	}, nil
+}
+
+// TenantLocaleSettings is the resolver for the tenantLocaleSettings field.
+func (r *queryResolver) TenantLocaleSettings(ctx context.Context) (*model.TenantLocaleSettingsResponse, error) {
+	client := r.getClient(ctx)
+	localizationService := localizationservice.NewLocalizationService()
+
+	settings, err := buildTenantLocaleSettings(ctx, client, localizationService)
+	if err != nil {
+		return &model.TenantLocaleSettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantLocaleSettingsResponse{
+		Success:  true,
+		Message:  utils.T(ctx, "success.localization.found"),
+		Settings: settings,
+	}, nil
+}
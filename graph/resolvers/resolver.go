@@ -39,9 +39,11 @@ func NewSchema(client *ent.Client) graphql.ExecutableSchema {
 			client: client,
 		},
 		Directives: generated.DirectiveRoot{
-			Auth:   directives.Auth,
-			Admin:  directives.Admin,
-			Member: directives.Member,
+			Auth:         directives.Auth,
+			Admin:        directives.Admin,
+			Owner:        directives.Owner,
+			Member:       directives.Member,
+			CacheControl: directives.CacheControl,
 		},
 	})
 }
@@ -63,8 +65,26 @@ func (r *Resolver) File() generated.FileResolver {
 	return &fileResolver{r}
 }
 
+// FileWhereInput returns generated.FileWhereInputResolver implementation
+func (r *Resolver) FileWhereInput() generated.FileWhereInputResolver {
+	return &fileWhereInputResolver{r}
+}
+
+// Ticket returns generated.TicketResolver implementation
+func (r *Resolver) Ticket() generated.TicketResolver { return &ticketResolver{r} }
+
+// TicketComment returns generated.TicketCommentResolver implementation
+func (r *Resolver) TicketComment() generated.TicketCommentResolver { return &ticketCommentResolver{r} }
+
+// Message returns generated.MessageResolver implementation
+func (r *Resolver) Message() generated.MessageResolver { return &messageResolver{r} }
+
 type queryResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
 type entityResolver struct{ *Resolver }
 type fileResolver struct{ *Resolver }
+type fileWhereInputResolver struct{ *Resolver }
+type ticketResolver struct{ *Resolver }
+type ticketCommentResolver struct{ *Resolver }
+type messageResolver struct{ *Resolver }
@@ -0,0 +1,132 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"main/ent"
+	"main/graph/model"
+	fileservice "main/services/file"
+	"main/utils"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+)
+
+// StartResumableUpload is the resolver for the startResumableUpload field.
+func (r *mutationResolver) StartResumableUpload(ctx context.Context, input model.StartResumableUploadInput) (*model.ResumableUploadResponse, error) {
+	client := r.getClient(ctx)
+
+	serviceInput := fileservice.StartResumableUploadInput{
+		Filename:    input.Filename,
+		Size:        int64(input.Size),
+		Description: input.Description,
+	}
+	if input.ContentType != nil {
+		serviceInput.ContentType = *input.ContentType
+	}
+
+	upload, err := fileservice.NewFileService().StartResumableUpload(ctx, client, serviceInput)
+	if err != nil {
+		return &model.ResumableUploadResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.ResumableUploadResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.resumable_started"),
+		Upload:  buildResumableUpload(upload),
+	}, nil
+}
+
+// UploadResumablePart is the resolver for the uploadResumablePart field.
+func (r *mutationResolver) UploadResumablePart(ctx context.Context, uploadID uuid.UUID, partNumber int, chunk graphql.Upload, contentMd5 *string, crc32 *string) (*model.ResumableUploadResponse, error) {
+	upload, err := fileservice.NewFileService().UploadResumablePart(ctx, uploadID, partNumber, &chunk, fileservice.PartChecksum{
+		ContentMD5: contentMd5,
+		CRC32:      crc32,
+	})
+	if err != nil {
+		return &model.ResumableUploadResponse{Success: false, Message: err.Error()}, nil
+	}
+	if upload == nil {
+		return &model.ResumableUploadResponse{Success: false, Message: utils.T(ctx, "error.file.resumable_not_found")}, nil
+	}
+
+	return &model.ResumableUploadResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.resumable_part_uploaded"),
+		Upload:  buildResumableUpload(upload),
+	}, nil
+}
+
+// CompleteResumableUpload is the resolver for the completeResumableUpload field.
+func (r *mutationResolver) CompleteResumableUpload(ctx context.Context, uploadID uuid.UUID) (*model.FileUploadResponse, error) {
+	fileService := fileservice.NewFileService()
+
+	// 🔄 [TRANSACTION] Состояние загрузки удаляется только после коммита записи файла
+	var fileResult *ent.File
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		fileResult, err = fileService.CompleteResumableUpload(txCtx, txClient, uploadID)
+		return err
+	})
+	if err != nil {
+		return &model.FileUploadResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FileUploadResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.uploaded"),
+		File:    fileResult,
+	}, nil
+}
+
+// AbortResumableUpload is the resolver for the abortResumableUpload field.
+func (r *mutationResolver) AbortResumableUpload(ctx context.Context, uploadID uuid.UUID) (*model.ResumableUploadAbortResponse, error) {
+	if err := fileservice.NewFileService().AbortResumableUpload(ctx, uploadID); err != nil {
+		return &model.ResumableUploadAbortResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.ResumableUploadAbortResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.resumable_aborted"),
+	}, nil
+}
+
+// ListResumableUploads is the resolver for the listResumableUploads field.
+func (r *queryResolver) ListResumableUploads(ctx context.Context) (*model.ResumableUploadListResponse, error) {
+	uploads, err := fileservice.NewFileService().ListResumableUploads(ctx)
+	if err != nil {
+		return &model.ResumableUploadListResponse{
+			Success: false,
+			Message: err.Error(),
+			Uploads: []*model.ResumableUpload{},
+		}, nil
+	}
+
+	result := make([]*model.ResumableUpload, 0, len(uploads))
+	for _, upload := range uploads {
+		result = append(result, buildResumableUpload(upload))
+	}
+
+	return &model.ResumableUploadListResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.resumable_list"),
+		Uploads: result,
+	}, nil
+}
+
+// ResumableUpload is the resolver for the resumableUpload field.
+func (r *queryResolver) ResumableUpload(ctx context.Context, uploadID uuid.UUID) (*model.ResumableUploadResponse, error) {
+	upload, err := fileservice.NewFileService().GetResumableUpload(ctx, uploadID)
+	if err != nil {
+		return &model.ResumableUploadResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.ResumableUploadResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.file.resumable_state"),
+		Upload:  buildResumableUpload(upload),
+	}, nil
+}
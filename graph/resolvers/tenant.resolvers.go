@@ -0,0 +1,352 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.78
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/schema/filemeta"
+	"main/ent/tenantsetting"
+	"main/graph/model"
+	tenantservice "main/services/tenant"
+	"main/utils"
+)
+
+// SetTenantState is the resolver for the setTenantState field.
+func (r *mutationResolver) SetTenantState(ctx context.Context, state tenantsetting.State, reason *string) (*model.TenantStateResponse, error) {
+	client := r.getClient(ctx)
+	tenantStateService := tenantservice.NewTenantStateService()
+
+	stateReason := ""
+	if reason != nil {
+		stateReason = *reason
+	}
+
+	// 🔄 [TRANSACTION]
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		return tenantStateService.SetState(txCtx, txClient, state, stateReason)
+	})
+	if err != nil {
+		return &model.TenantStateResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	tenantState, err := tenantStateService.GetState(ctx, client)
+	if err != nil {
+		return &model.TenantStateResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantStateResponse{
+		Success:     true,
+		Message:     utils.T(ctx, "success.tenant.state_updated"),
+		TenantState: buildTenantStateInfo(tenantState),
+	}, nil
+}
+
+// SetTenantRetentionPolicy is the resolver for the setTenantRetentionPolicy field.
+func (r *mutationResolver) SetTenantRetentionPolicy(ctx context.Context, defaultRetentionDays *int) (*model.TenantRetentionPolicyResponse, error) {
+	client := r.getClient(ctx)
+	retentionService := tenantservice.NewTenantRetentionService()
+
+	// 🔄 [TRANSACTION]
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		return retentionService.SetDefaultRetentionDays(txCtx, txClient, defaultRetentionDays)
+	})
+	if err != nil {
+		return &model.TenantRetentionPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	days, err := retentionService.GetDefaultRetentionDays(ctx, client)
+	if err != nil {
+		return &model.TenantRetentionPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantRetentionPolicyResponse{
+		Success:         true,
+		Message:         utils.T(ctx, "success.tenant.retention_updated"),
+		RetentionPolicy: &model.TenantRetentionPolicy{DefaultRetentionDays: days},
+	}, nil
+}
+
+// SetTenantFilenameConflictPolicy is the resolver for the setTenantFilenameConflictPolicy field.
+func (r *mutationResolver) SetTenantFilenameConflictPolicy(ctx context.Context, mode tenantsetting.FilenameConflictMode) (*model.TenantFilenameConflictPolicyResponse, error) {
+	conflictService := tenantservice.NewTenantFilenameConflictService()
+
+	// 🔄 [TRANSACTION]
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		return conflictService.SetMode(txCtx, txClient, mode)
+	})
+	if err != nil {
+		return &model.TenantFilenameConflictPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantFilenameConflictPolicyResponse{
+		Success:                true,
+		Message:                utils.T(ctx, "success.tenant.filename_conflict_updated"),
+		FilenameConflictPolicy: &model.TenantFilenameConflictPolicy{Mode: mode},
+	}, nil
+}
+
+// SetTenantImageMetadataPolicy is the resolver for the setTenantImageMetadataPolicy field.
+func (r *mutationResolver) SetTenantImageMetadataPolicy(ctx context.Context, mode tenantsetting.ImageMetadataMode) (*model.TenantImageMetadataPolicyResponse, error) {
+	imageMetadataService := tenantservice.NewTenantImageMetadataService()
+
+	// 🔄 [TRANSACTION]
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		return imageMetadataService.SetMode(txCtx, txClient, mode)
+	})
+	if err != nil {
+		return &model.TenantImageMetadataPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantImageMetadataPolicyResponse{
+		Success:             true,
+		Message:             utils.T(ctx, "success.tenant.image_metadata_updated"),
+		ImageMetadataPolicy: &model.TenantImageMetadataPolicy{Mode: mode},
+	}, nil
+}
+
+// SetTenantWatermarkPolicy is the resolver for the setTenantWatermarkPolicy field.
+func (r *mutationResolver) SetTenantWatermarkPolicy(ctx context.Context, requirePdfWatermark bool) (*model.TenantWatermarkPolicyResponse, error) {
+	watermarkService := tenantservice.NewTenantWatermarkService()
+
+	// 🔄 [TRANSACTION]
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		return watermarkService.SetPDFWatermarkRequired(txCtx, txClient, requirePdfWatermark)
+	})
+	if err != nil {
+		return &model.TenantWatermarkPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantWatermarkPolicyResponse{
+		Success:         true,
+		Message:         utils.T(ctx, "success.tenant.watermark_updated"),
+		WatermarkPolicy: &model.TenantWatermarkPolicy{RequirePDFWatermark: requirePdfWatermark},
+	}, nil
+}
+
+// SetFilePolicy is the resolver for the setFilePolicy field.
+func (r *mutationResolver) SetFilePolicy(ctx context.Context, input model.FilePolicyInput) (*model.FilePolicyResponse, error) {
+	policyService := tenantservice.NewTenantUploadPolicyService()
+	var maxUploadSize *int64
+	if input.MaxUploadSize != nil {
+		value := int64(*input.MaxUploadSize)
+		maxUploadSize = &value
+	}
+
+	// 🔄 [TRANSACTION]
+	var policy *tenantservice.UploadPolicy
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		policy, err = policyService.SetPolicy(txCtx, txClient, tenantservice.UploadPolicy{
+			RequireDescription:  input.RequireDescription,
+			DescriptionTemplate: input.DescriptionTemplate,
+			ClassificationTags:  input.ClassificationTags,
+			MaxUploadSize:       maxUploadSize,
+		})
+		return err
+	})
+	if err != nil {
+		return &model.FilePolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FilePolicyResponse{
+		Success:    true,
+		Message:    utils.T(ctx, "success.tenant.file_policy_updated"),
+		FilePolicy: buildFilePolicy(policy),
+	}, nil
+}
+
+// SetTenantFileTypePolicy is the resolver for the setTenantFileTypePolicy field.
+func (r *mutationResolver) SetTenantFileTypePolicy(ctx context.Context, input model.TenantFileTypePolicyInput) (*model.TenantFileTypePolicyResponse, error) {
+	fileTypeService := tenantservice.NewTenantFileTypeService()
+
+	// 🔄 [TRANSACTION]
+	var policy *tenantservice.FileTypePolicy
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		policy, err = fileTypeService.SetPolicy(txCtx, txClient, tenantservice.FileTypePolicy{
+			AllowedExtensions: input.AllowedExtensions,
+			BlockedExtensions: input.BlockedExtensions,
+			AllowedMimeTypes:  input.AllowedMimeTypes,
+			BlockedMimeTypes:  input.BlockedMimeTypes,
+		})
+		return err
+	})
+	if err != nil {
+		return &model.TenantFileTypePolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantFileTypePolicyResponse{
+		Success:        true,
+		Message:        utils.T(ctx, "success.tenant.file_type_policy_updated"),
+		FileTypePolicy: buildTenantFileTypePolicy(policy),
+	}, nil
+}
+
+// SetTenantFileMetadataSchemas is the resolver for the setTenantFileMetadataSchemas field.
+func (r *mutationResolver) SetTenantFileMetadataSchemas(ctx context.Context, schemas []*model.FileMetadataSchemaInput) (*model.TenantFileMetadataSchemasResponse, error) {
+	metadataSchemaService := tenantservice.NewTenantMetadataSchemaService()
+
+	// 🔄 [TRANSACTION]
+	var saved []filemeta.Schema
+	err := r.withTx(ctx, func(txCtx context.Context, txClient *ent.Client) error {
+		var err error
+		saved, err = metadataSchemaService.SetSchemas(txCtx, txClient, fileMetadataSchemasFromInput(schemas))
+		return err
+	})
+	if err != nil {
+		return &model.TenantFileMetadataSchemasResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantFileMetadataSchemasResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.tenant.file_metadata_schema_updated"),
+		Schemas: buildFileMetadataSchemas(saved),
+	}, nil
+}
+
+// TenantState is the resolver for the tenantState field.
+func (r *queryResolver) TenantState(ctx context.Context) (*model.TenantStateResponse, error) {
+	client := r.getClient(ctx)
+
+	tenantState, err := tenantservice.NewTenantStateService().GetState(ctx, client)
+	if err != nil {
+		return &model.TenantStateResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantStateResponse{
+		Success:     true,
+		Message:     utils.T(ctx, "success.tenant.state_found"),
+		TenantState: buildTenantStateInfo(tenantState),
+	}, nil
+}
+
+// TenantQuotaPolicy is the resolver for the tenantQuotaPolicy field.
+func (r *queryResolver) TenantQuotaPolicy(ctx context.Context) (*model.TenantQuotaPolicyResponse, error) {
+	client := r.getClient(ctx)
+
+	policy, err := tenantservice.NewTenantQuotaService().GetPolicy(ctx, client)
+	if err != nil {
+		return &model.TenantQuotaPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantQuotaPolicyResponse{
+		Success:     true,
+		Message:     utils.T(ctx, "success.tenant.quota_found"),
+		QuotaPolicy: buildTenantQuotaPolicy(policy),
+	}, nil
+}
+
+// TenantRetentionPolicy is the resolver for the tenantRetentionPolicy field.
+func (r *queryResolver) TenantRetentionPolicy(ctx context.Context) (*model.TenantRetentionPolicyResponse, error) {
+	client := r.getClient(ctx)
+
+	days, err := tenantservice.NewTenantRetentionService().GetDefaultRetentionDays(ctx, client)
+	if err != nil {
+		return &model.TenantRetentionPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantRetentionPolicyResponse{
+		Success:         true,
+		Message:         utils.T(ctx, "success.tenant.retention_found"),
+		RetentionPolicy: &model.TenantRetentionPolicy{DefaultRetentionDays: days},
+	}, nil
+}
+
+// TenantFilenameConflictPolicy is the resolver for the tenantFilenameConflictPolicy field.
+func (r *queryResolver) TenantFilenameConflictPolicy(ctx context.Context) (*model.TenantFilenameConflictPolicyResponse, error) {
+	client := r.getClient(ctx)
+
+	mode, err := tenantservice.NewTenantFilenameConflictService().GetMode(ctx, client)
+	if err != nil {
+		return &model.TenantFilenameConflictPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantFilenameConflictPolicyResponse{
+		Success:                true,
+		Message:                utils.T(ctx, "success.tenant.filename_conflict_found"),
+		FilenameConflictPolicy: &model.TenantFilenameConflictPolicy{Mode: mode},
+	}, nil
+}
+
+// TenantImageMetadataPolicy is the resolver for the tenantImageMetadataPolicy field.
+func (r *queryResolver) TenantImageMetadataPolicy(ctx context.Context) (*model.TenantImageMetadataPolicyResponse, error) {
+	client := r.getClient(ctx)
+
+	mode, err := tenantservice.NewTenantImageMetadataService().GetMode(ctx, client)
+	if err != nil {
+		return &model.TenantImageMetadataPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantImageMetadataPolicyResponse{
+		Success:             true,
+		Message:             utils.T(ctx, "success.tenant.image_metadata_found"),
+		ImageMetadataPolicy: &model.TenantImageMetadataPolicy{Mode: mode},
+	}, nil
+}
+
+// TenantWatermarkPolicy is the resolver for the tenantWatermarkPolicy field.
+func (r *queryResolver) TenantWatermarkPolicy(ctx context.Context) (*model.TenantWatermarkPolicyResponse, error) {
+	client := r.getClient(ctx)
+
+	required, err := tenantservice.NewTenantWatermarkService().IsPDFWatermarkRequired(ctx, client)
+	if err != nil {
+		return &model.TenantWatermarkPolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantWatermarkPolicyResponse{
+		Success:         true,
+		Message:         utils.T(ctx, "success.tenant.watermark_found"),
+		WatermarkPolicy: &model.TenantWatermarkPolicy{RequirePDFWatermark: required},
+	}, nil
+}
+
+// FilePolicy is the resolver for the filePolicy field.
+func (r *queryResolver) FilePolicy(ctx context.Context) (*model.FilePolicyResponse, error) {
+	client := r.getClient(ctx)
+
+	policy, err := tenantservice.NewTenantUploadPolicyService().GetPolicy(ctx, client)
+	if err != nil {
+		return &model.FilePolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.FilePolicyResponse{
+		Success:    true,
+		Message:    utils.T(ctx, "success.tenant.file_policy_found"),
+		FilePolicy: buildFilePolicy(policy),
+	}, nil
+}
+
+// TenantFileTypePolicy is the resolver for the tenantFileTypePolicy field.
+func (r *queryResolver) TenantFileTypePolicy(ctx context.Context) (*model.TenantFileTypePolicyResponse, error) {
+	client := r.getClient(ctx)
+
+	policy, err := tenantservice.NewTenantFileTypeService().GetPolicy(ctx, client)
+	if err != nil {
+		return &model.TenantFileTypePolicyResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantFileTypePolicyResponse{
+		Success:        true,
+		Message:        utils.T(ctx, "success.tenant.file_type_policy_found"),
+		FileTypePolicy: buildTenantFileTypePolicy(policy),
+	}, nil
+}
+
+// TenantFileMetadataSchemas is the resolver for the tenantFileMetadataSchemas field.
+func (r *queryResolver) TenantFileMetadataSchemas(ctx context.Context) (*model.TenantFileMetadataSchemasResponse, error) {
+	client := r.getClient(ctx)
+
+	schemas, err := tenantservice.NewTenantMetadataSchemaService().GetSchemas(ctx, client)
+	if err != nil {
+		return &model.TenantFileMetadataSchemasResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	return &model.TenantFileMetadataSchemasResponse{
+		Success: true,
+		Message: utils.T(ctx, "success.tenant.file_metadata_schema_found"),
+		Schemas: buildFileMetadataSchemas(schemas),
+	}, nil
+}
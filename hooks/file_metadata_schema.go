@@ -0,0 +1,153 @@
+package hooks
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/hook"
+	"main/ent/schema/filemeta"
+	"main/ent/schema/mixin"
+	"main/ent/tenantsetting"
+	"main/errcatalog"
+	"main/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// WithFileMetadataSchema проверяет metadata файла по схеме тенанта (TenantSetting.file_metadata_schemas)
+// при создании файла и при изменении metadata или сущности файла. Схема выбирается по типу сущности файла,
+// без подходящей схемы metadata не проверяется.
+func WithFileMetadataSchema() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.FileFunc(func(ctx context.Context, m *ent.FileMutation) (ent.Value, error) {
+			var err error
+			if m.Op().Is(ent.OpCreate) {
+				err = validateCreatedFileMetadata(ctx, m)
+			} else if fileMetadataChanged(m) {
+				err = validateUpdatedFileMetadata(ctx, m)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// fileMetadataChanged проверяет, меняет ли мутация metadata или тип сущности файла
+func fileMetadataChanged(m *ent.FileMutation) bool {
+	_, metadataSet := m.Metadata()
+	_, entityTypeSet := m.EntityType()
+	return metadataSet || entityTypeSet || m.MetadataCleared() || m.EntityTypeCleared()
+}
+
+// validateCreatedFileMetadata проверяет metadata создаваемого файла
+func validateCreatedFileMetadata(ctx context.Context, m *ent.FileMutation) error {
+	tenantID, ok := m.TenantID()
+	if !ok {
+		return nil
+	}
+	schemas, err := tenantMetadataSchemas(ctx, m.Client(), tenantID)
+	if err != nil {
+		return err
+	}
+	metadata, _ := m.Metadata()
+	entityType, _ := m.EntityType()
+	return checkFileMetadata(ctx, schemas, entityType, metadata)
+}
+
+// validateUpdatedFileMetadata проверяет итоговые metadata изменяемых файлов
+func validateUpdatedFileMetadata(ctx context.Context, m *ent.FileMutation) error {
+	// Файлы в корзине тоже могут меняться (например, при восстановлении)
+	ctx = mixin.SkipSoftDelete(ctx)
+
+	ids, err := m.IDs(ctx)
+	if err != nil {
+		utils.Logger.Error("Failed to get updated file IDs for metadata schema check", zap.Error(err))
+		return errcatalog.FileMetadataSchemaCheckFailed(ctx)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	files, err := m.Client().File.Query().
+		Where(file.IDIn(ids...)).
+		Select(file.FieldTenantID, file.FieldMetadata, file.FieldEntityType).
+		All(ctx)
+	if err != nil {
+		utils.Logger.Error("Failed to load updated files for metadata schema check", zap.Error(err))
+		return errcatalog.FileMetadataSchemaCheckFailed(ctx)
+	}
+
+	newMetadata, metadataSet := m.Metadata()
+	newEntityType, entityTypeSet := m.EntityType()
+	schemasByTenant := make(map[uuid.UUID][]filemeta.Schema)
+	for _, fileRecord := range files {
+		schemas, ok := schemasByTenant[fileRecord.TenantID]
+		if !ok {
+			if schemas, err = tenantMetadataSchemas(ctx, m.Client(), fileRecord.TenantID); err != nil {
+				return err
+			}
+			schemasByTenant[fileRecord.TenantID] = schemas
+		}
+
+		metadata := fileRecord.Metadata
+		switch {
+		case metadataSet:
+			metadata = newMetadata
+		case m.MetadataCleared():
+			metadata = nil
+		}
+		var entityType file.EntityType
+		switch {
+		case entityTypeSet:
+			entityType = newEntityType
+		case !m.EntityTypeCleared() && fileRecord.EntityType != nil:
+			entityType = *fileRecord.EntityType
+		}
+
+		if err := checkFileMetadata(ctx, schemas, entityType, metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tenantMetadataSchemas загружает схемы metadata тенанта файла; тенант задается явно,
+// так как хук выполняется и в системных операциях без тенанта в контексте
+func tenantMetadataSchemas(ctx context.Context, client *ent.Client, tenantID uuid.UUID) ([]filemeta.Schema, error) {
+	setting, err := client.TenantSetting.Query().
+		Where(tenantsetting.TenantID(tenantID)).
+		Select(tenantsetting.FieldFileMetadataSchemas).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		utils.Logger.Error("Failed to load tenant metadata schemas",
+			zap.Error(err),
+			zap.String("tenant_id", tenantID.String()))
+		return nil, errcatalog.FileMetadataSchemaCheckFailed(ctx)
+	}
+	return setting.FileMetadataSchemas, nil
+}
+
+// checkFileMetadata проверяет metadata по схеме сущности и возвращает локализованную ошибку несоответствия
+func checkFileMetadata(ctx context.Context, schemas []filemeta.Schema, entityType file.EntityType, metadata map[string]interface{}) error {
+	schema := filemeta.Find(schemas, string(entityType))
+	if schema == nil {
+		return nil
+	}
+	violation := schema.Validate(metadata, filemeta.ServiceKeys...)
+	if violation == nil {
+		return nil
+	}
+	switch violation.Reason {
+	case filemeta.ViolationRequired:
+		return errcatalog.FileMetadataRequiredKey(ctx, violation.Key)
+	case filemeta.ViolationType:
+		return errcatalog.FileMetadataInvalidType(ctx, violation.Key, string(violation.Type))
+	default:
+		return errcatalog.FileMetadataUnknownKey(ctx, violation.Key)
+	}
+}
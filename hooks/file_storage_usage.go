@@ -0,0 +1,112 @@
+package hooks
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/hook"
+	"main/ent/schema/mixin"
+	"main/redis"
+	"main/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// WithFileStorageUsage поддерживает счетчик использования хранилища тенанта в Redis:
+// создание файла увеличивает его на размер файла, удаление уменьшает.
+// В транзакции счетчик меняется только после коммита; расхождения (откат, сбой Redis)
+// исправляет периодическая сверка с БД.
+func WithFileStorageUsage() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.FileFunc(func(ctx context.Context, m *ent.FileMutation) (ent.Value, error) {
+			// Размеры удаляемых файлов известны только до удаления
+			var deltas map[uuid.UUID]int64
+			if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				deltas = deletedFileSizes(ctx, m)
+			}
+
+			value, err := next.Mutate(ctx, m)
+			if err != nil {
+				return value, err
+			}
+
+			if created, ok := value.(*ent.File); ok && m.Op().Is(ent.OpCreate) {
+				deltas = map[uuid.UUID]int64{created.TenantID: created.Size}
+			}
+			if len(deltas) == 0 {
+				return value, nil
+			}
+
+			if tx, txErr := m.Tx(); txErr == nil {
+				tx.OnCommit(func(commit ent.Committer) ent.Committer {
+					return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+						if err := commit.Commit(ctx, tx); err != nil {
+							return err
+						}
+						applyStorageUsageDeltas(context.WithoutCancel(ctx), deltas)
+						return nil
+					})
+				})
+				return value, nil
+			}
+
+			applyStorageUsageDeltas(context.WithoutCancel(ctx), deltas)
+			return value, nil
+		})
+	}, ent.OpCreate|ent.OpDelete|ent.OpDeleteOne)
+}
+
+// deletedFileSizes возвращает суммарный размер удаляемых файлов по тенантам
+func deletedFileSizes(ctx context.Context, m *ent.FileMutation) map[uuid.UUID]int64 {
+	// Окончательно удаляются и файлы из корзины, скрытые фильтром мягкого удаления
+	ctx = mixin.SkipSoftDelete(ctx)
+
+	ids, err := m.IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		if err != nil {
+			utils.Logger.Warn("Failed to get deleted file IDs for storage usage", zap.Error(err))
+		}
+		return nil
+	}
+
+	var rows []struct {
+		TenantID uuid.UUID `json:"tenant_id"`
+		Sum      int64     `json:"sum"`
+	}
+	err = m.Client().File.Query().
+		Where(file.IDIn(ids...)).
+		GroupBy(file.FieldTenantID).
+		Aggregate(ent.Sum(file.FieldSize)).
+		Scan(ctx, &rows)
+	if err != nil {
+		utils.Logger.Warn("Failed to get deleted file sizes for storage usage", zap.Error(err))
+		return nil
+	}
+
+	deltas := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		deltas[row.TenantID] = -row.Sum
+	}
+	return deltas
+}
+
+// applyStorageUsageDeltas применяет изменения к инициализированным счетчикам; ошибки только логируются
+func applyStorageUsageDeltas(ctx context.Context, deltas map[uuid.UUID]int64) {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil || cacheService.GetClient() == nil {
+		return
+	}
+
+	for tenantID, delta := range deltas {
+		if delta == 0 {
+			continue
+		}
+		if err := cacheService.IncrTenantStorageUsage(ctx, tenantID.String(), delta); err != nil {
+			utils.Logger.Warn("Failed to update tenant storage usage counter",
+				zap.Error(err),
+				zap.String("tenant_id", tenantID.String()),
+				zap.Int64("delta", delta))
+		}
+	}
+}
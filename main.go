@@ -3,9 +3,12 @@ package main
 import (
 	"context"
 	"flag"
+	"main/config"
+	"main/ent"
 	_ "main/ent/runtime"
 	"main/middleware"
 	"main/server"
+	fileservice "main/services/file"
 	"main/utils"
 	"os"
 	"os/signal"
@@ -24,12 +27,19 @@ import (
 
 func main() {
 	exportSchema := flag.Bool("schema", false, "Export GraphQL schema to schema.graphql")
+	selfTest := flag.Bool("selftest", false, "Run non-destructive self-test of dependencies and print JSON report")
+	tenantAudit := flag.Bool("tenant-audit", false, "Check schema and data for tenant isolation violations and print JSON report")
 	flag.Parse()
 
 	// Load environment variables BEFORE initializing logger
 	if err := godotenv.Load(".env"); err != nil {
 		// Use fmt for initial logging since logger is not initialized yet
-		fmt.Printf("No .env file found, using environment variables: %v\n", err)
+		fmt.Fprintf(os.Stderr, "No .env file found, using environment variables: %v\n", err)
+	}
+
+	// Отчеты самотестирования и аудита изоляции занимают stdout, логи уходят в stderr
+	if *selfTest || *tenantAudit {
+		_ = os.Setenv("LOG_OUTPUT", "stderr")
 	}
 
 	// Initialize logger AFTER loading environment variables
@@ -51,6 +61,117 @@ func main() {
 		return
 	}
 
+	// Самотестирование для пайплайнов деплоя: отчет в stdout, код выхода 1 при ошибке
+	if *selfTest {
+		report := server.RunSelfTest(context.Background())
+		if err := server.WriteSelfTestReport(os.Stdout, report); err != nil {
+			utils.Logger.Error("Failed to write self-test report", zap.Error(err))
+		}
+		_ = middleware.CloseDatabaseClient()
+		utils.Logger.Sync()
+		if !report.OK() {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Аудит изоляции тенантов: отчет в stdout, код выхода 1 при нарушениях
+	if *tenantAudit {
+		report := server.RunTenantIsolationAudit(context.Background())
+		if err := server.WriteTenantAuditReport(os.Stdout, report); err != nil {
+			utils.Logger.Error("Failed to write tenant audit report", zap.Error(err))
+		}
+		utils.Logger.Sync()
+		if !report.OK() {
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Фаза запуска: прогреваем тяжеловесные синглтоны (БД, Redis, i18n) до приема трафика
+	if server.IsEagerStartup() {
+		if err := server.Warmup(context.Background()); err != nil {
+			// Не падаем: /readyz вернет 503, а БД будет переинициализирована при первом запросе
+			utils.Logger.Error("Startup warm-up completed with errors",
+				zap.Error(err),
+			)
+		}
+	} else {
+		server.RegisterLazyComponents()
+	}
+
+	// Сводка запуска для разбора инцидентов: версия, конфигурация без секретов, миграции, хеш схемы
+	server.LogStartupBanner(context.Background())
+
+	// Фоновые задачи останавливаются при завершении сервиса
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+
+	// Горячая перезагрузка безопасных настроек по SIGHUP
+	config.WatchSIGHUP(backgroundCtx)
+
+	// Выборочная проверка целостности файлов (FILE_INTEGRITY_SAMPLE_INTERVAL)
+	fileservice.StartIntegritySampler(backgroundCtx, func() *ent.Client {
+		if db := middleware.GetDatabaseClient(); db != nil {
+			return db.Mutation()
+		}
+		return nil
+	})
+
+	// Отмена истекших возобновляемых загрузок в S3 (RESUMABLE_UPLOAD_JANITOR_INTERVAL)
+	fileservice.StartResumableUploadJanitor(backgroundCtx)
+
+	// Удаление временных архивов пакетного скачивания по расписанию в Redis (ARCHIVE_CLEANUP_INTERVAL)
+	fileservice.StartArchiveCleanup(backgroundCtx)
+
+	// Генерация превью загруженных изображений (THUMBNAIL_INTERVAL)
+	fileservice.StartThumbnailGenerator(backgroundCtx, func() *ent.Client {
+		if db := middleware.GetDatabaseClient(); db != nil {
+			return db.Mutation()
+		}
+		return nil
+	})
+
+	// Рендеринг превью первой страницы документов (PREVIEW_INTERVAL, нужен pdftoppm)
+	fileservice.StartPreviewRenderer(backgroundCtx, func() *ent.Client {
+		if db := middleware.GetDatabaseClient(); db != nil {
+			return db.Mutation()
+		}
+		return nil
+	})
+
+	// Конвертация документов Office в PDF для просмотра в браузере (PDF_CONVERTER, PDF_CONVERSION_INTERVAL)
+	fileservice.StartPDFRenditionConverter(backgroundCtx, func() *ent.Client {
+		if db := middleware.GetDatabaseClient(); db != nil {
+			return db.Mutation()
+		}
+		return nil
+	})
+
+	// Антивирусная проверка файлов, не проверенных при загрузке (ANTIVIRUS_SCANNER, ANTIVIRUS_SCAN_INTERVAL)
+	fileservice.StartMalwareScanner(backgroundCtx, func() *ent.Client {
+		if db := middleware.GetDatabaseClient(); db != nil {
+			return db.Mutation()
+		}
+		return nil
+	})
+
+	// Правила жизненного цикла тенантов и окончательное удаление файлов из корзины (FILE_TRASH_PURGE_INTERVAL, FILE_TRASH_RETENTION)
+	fileservice.StartTrashPurger(backgroundCtx, func() *ent.Client {
+		if db := middleware.GetDatabaseClient(); db != nil {
+			return db.Mutation()
+		}
+		return nil
+	})
+
+	// Сверка счетчиков использования хранилища в Redis с БД (STORAGE_USAGE_RECONCILE_INTERVAL)
+	fileservice.StartStorageUsageReconciler(backgroundCtx, func() *ent.Client {
+		if db := middleware.GetDatabaseClient(); db != nil {
+			return db.Mutation()
+		}
+		return nil
+	})
+
 	// Run web server with graceful shutdown
 	runWebServerWithGracefulShutdown(shutdown)
 }
@@ -69,10 +190,7 @@ func runWebServerWithGracefulShutdown(shutdown chan os.Signal) {
 	}
 
 	// Создаем HTTP-сервер
-	srv := &http.Server{
-		Addr:    fmt.Sprintf(":%s", port),
-		Handler: router,
-	}
+	srv := server.NewHTTPServer(fmt.Sprintf(":%s", port), router, server.LoadHTTPLimits())
 
 	// Запускаем сервер в отдельной горутине
 	go func() {
@@ -88,8 +206,8 @@ func runWebServerWithGracefulShutdown(shutdown chan os.Signal) {
 	<-shutdown
 	utils.Logger.Info("Shutdown signal received, gracefully shutting down...")
 
-	// Создаем единый контекст с таймаутом для всего процесса shutdоwn
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	// Создаем единый контекст с таймаутом для всего процесса shutdоwn (с учетом ожидания загрузок)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second+fileservice.UploadDrainGracePeriod())
 	defer cancel()
 
 	// Подготавливаем блок для сброса логов
@@ -99,16 +217,32 @@ func runWebServerWithGracefulShutdown(shutdown chan os.Signal) {
 		}
 	}
 
+	// 0. Прекращаем прием загрузок и ждем выполняющиеся (UPLOAD_DRAIN_GRACE_PERIOD)
+	fileservice.DrainUploads(ctx)
+
 	// 1. Сначала останавливаем HTTP-сервер
 	serverCtx, serverCancel := context.WithTimeout(ctx, 15*time.Second)
 	defer serverCancel()
 
+	inflight := middleware.GetInflightStats("")
+	utils.Logger.Info("Waiting for in-flight requests",
+		zap.Int("http_requests", inflight.HTTPRequests),
+		zap.Int("graphql_requests", inflight.GraphQLRequests),
+		zap.Int("websockets", inflight.WebSockets))
+
 	if err := srv.Shutdown(serverCtx); err != nil {
 		utils.Logger.Error("Server shutdown error",
 			zap.Error(err),
 		)
+		// Бюджет остановки исчерпан: фиксируем, какие запросы не успели завершиться
+		middleware.LogInflightRequests(err.Error())
 	} else {
 		utils.Logger.Info("Server shutdown complete")
+		// Shutdown не ждет WebSocket соединения: они закрываются вместе с процессом
+		if remaining := middleware.GetInflightStats(""); remaining.WebSockets > 0 {
+			utils.Logger.Info("Closing WebSocket connections on shutdown",
+				zap.Int("websockets", remaining.WebSockets))
+		}
 	}
 
 	// Сбрасываем логи после остановки сервера
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// CacheControlExtension ключ extensions ответа с подсказками кэширования (формат Apollo Cache Control)
+const CacheControlExtension = "cacheControl"
+
+// CacheHint подсказка кэширования для поля ответа
+type CacheHint struct {
+	Path   []interface{} `json:"path"`
+	MaxAge int           `json:"maxAge"`
+	Scope  string        `json:"scope,omitempty"`
+}
+
+// cacheHintsKey ключ контекста со сборщиком подсказок операции
+type cacheHintsKey struct{}
+
+// cacheHints собирает подсказки полей одной операции (поля резолвятся параллельно)
+type cacheHints struct {
+	mu    sync.Mutex
+	hints []CacheHint
+}
+
+// RecordCacheHint сохраняет подсказку кэширования текущего поля (вызывается директивой @cacheControl).
+// Вне запросов на чтение подсказки не собираются.
+func RecordCacheHint(ctx context.Context, maxAge int, scope string) {
+	collector, ok := ctx.Value(cacheHintsKey{}).(*cacheHints)
+	if !ok {
+		return
+	}
+	fieldCtx := graphql.GetFieldContext(ctx)
+	if fieldCtx == nil {
+		return
+	}
+
+	path := make([]interface{}, 0)
+	for _, element := range fieldCtx.Path() {
+		switch element := element.(type) {
+		case ast.PathName:
+			path = append(path, string(element))
+		case ast.PathIndex:
+			path = append(path, int(element))
+		}
+	}
+
+	collector.mu.Lock()
+	collector.hints = append(collector.hints, CacheHint{Path: path, MaxAge: maxAge, Scope: scope})
+	collector.mu.Unlock()
+}
+
+// CacheControlMiddleware добавляет в ответ запроса extensions.cacheControl с подсказками полей,
+// отмеченных @cacheControl, чтобы шлюз/CDN могли кэшировать статические данные.
+// Ответы с ошибками и мутации подсказок не получают.
+func CacheControlMiddleware() graphql.ResponseMiddleware {
+	return func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		opCtx := graphql.GetOperationContext(ctx)
+		if opCtx == nil || opCtx.Operation == nil || opCtx.Operation.Operation != ast.Query {
+			return next(ctx)
+		}
+
+		collector := &cacheHints{}
+		response := next(context.WithValue(ctx, cacheHintsKey{}, collector))
+		if response == nil || len(response.Errors) > 0 {
+			return response
+		}
+
+		collector.mu.Lock()
+		hints := collector.hints
+		collector.mu.Unlock()
+		if len(hints) == 0 {
+			return response
+		}
+
+		if response.Extensions == nil {
+			response.Extensions = make(map[string]interface{})
+		}
+		response.Extensions[CacheControlExtension] = map[string]interface{}{
+			"version": 1,
+			"hints":   hints,
+		}
+		return response
+	}
+}
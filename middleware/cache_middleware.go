@@ -21,8 +21,9 @@ func GraphQLCacheMiddleware() graphql.OperationMiddleware {
 			// Устанавливаем режим кеширования в зависимости от типа операции
 			switch op {
 			case ast.Query:
-				// Кеш разрешен только при наличии тенанта, иначе полностью отключаем
-				if federation.GetTenantID(ctx) != nil {
+				// Кеш разрешен только при наличии тенанта, иначе полностью отключаем.
+				// Запросы с токеном недавней мутации (read-your-writes) читают в обход кэша.
+				if federation.GetTenantID(ctx) != nil && !database.RequiresPrimary(ctx) {
 					ctx = database.EnableContextCache(ctx)
 				} else {
 					ctx = database.SkipCache(ctx)
@@ -39,7 +40,7 @@ func GraphQLCacheMiddleware() graphql.OperationMiddleware {
 					zap.String("operation_name", opCtx.OperationName),
 					zap.String("operation_type", string(op)),
 					zap.Bool("tenant_present", federation.GetTenantID(ctx) != nil),
-					zap.Bool("cache_enabled", op == ast.Query && federation.GetTenantID(ctx) != nil),
+					zap.Bool("cache_enabled", op == ast.Query && federation.GetTenantID(ctx) != nil && !database.RequiresPrimary(ctx)),
 				)
 			}
 		}
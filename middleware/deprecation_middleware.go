@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"context"
+	"main/utils"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	federation "github.com/esemashko/v2-federation"
+	"go.uber.org/zap"
+)
+
+// clientNameHeader заголовок Apollo с именем клиента, по которому определяется потребитель устаревших полей
+const clientNameHeader = "apollographql-client-name"
+
+// DeprecatedFieldUsage содержит статистику использования устаревшего поля конкретным клиентом
+type DeprecatedFieldUsage struct {
+	Field      string    `json:"field"`
+	Client     string    `json:"client"`
+	Operation  string    `json:"operation"`
+	Count      int64     `json:"count"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+}
+
+var (
+	deprecatedUsage   = make(map[string]*DeprecatedFieldUsage)
+	deprecatedUsageMu sync.Mutex
+)
+
+// DeprecatedFieldMiddleware логирует обращения к полям с @deprecated, чтобы найти оставшихся потребителей
+// перед удалением полей (например, File.path). Статистика доступна через DeprecatedFieldUsages.
+func DeprecatedFieldMiddleware() graphql.FieldMiddleware {
+	return func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+		fieldCtx := graphql.GetFieldContext(ctx)
+		if fieldCtx == nil || fieldCtx.Field.Definition == nil ||
+			fieldCtx.Field.Definition.Directives.ForName("deprecated") == nil {
+			return next(ctx)
+		}
+
+		field := fieldCtx.Object + "." + fieldCtx.Field.Name
+		client, operation := "unknown", ""
+		if opCtx := graphql.GetOperationContext(ctx); opCtx != nil {
+			operation = opCtx.OperationName
+			if name := opCtx.Headers.Get(clientNameHeader); name != "" {
+				client = name
+			}
+		}
+
+		if recordDeprecatedUsage(field, client, operation) {
+			fields := []zap.Field{
+				zap.String("field", field),
+				zap.String("client", client),
+				zap.String("operation", operation),
+			}
+			if tenantID := federation.GetTenantID(ctx); tenantID != nil {
+				fields = append(fields, zap.String("tenant_id", tenantID.String()))
+			}
+			utils.Logger.Warn("Deprecated GraphQL field used", fields...)
+		}
+
+		return next(ctx)
+	}
+}
+
+// recordDeprecatedUsage увеличивает счетчик; возвращает true для первого обращения в рамках комбинации
+// поле/клиент/операция, чтобы не засорять лог повторами
+func recordDeprecatedUsage(field, client, operation string) bool {
+	key := field + "|" + client + "|" + operation
+
+	deprecatedUsageMu.Lock()
+	defer deprecatedUsageMu.Unlock()
+
+	usage, exists := deprecatedUsage[key]
+	if !exists {
+		usage = &DeprecatedFieldUsage{Field: field, Client: client, Operation: operation}
+		deprecatedUsage[key] = usage
+	}
+	usage.Count++
+	usage.LastSeenAt = time.Now()
+	return !exists
+}
+
+// DeprecatedFieldUsages возвращает статистику использования устаревших полей с момента запуска
+func DeprecatedFieldUsages() []DeprecatedFieldUsage {
+	deprecatedUsageMu.Lock()
+	defer deprecatedUsageMu.Unlock()
+
+	usages := make([]DeprecatedFieldUsage, 0, len(deprecatedUsage))
+	for _, usage := range deprecatedUsage {
+		usages = append(usages, *usage)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Field != usages[j].Field {
+			return usages[i].Field < usages[j].Field
+		}
+		return usages[i].Client < usages[j].Client
+	})
+	return usages
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"main/types"
+	"main/utils"
+	"net/http"
+	"os"
+	"strings"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DevFakeFederationEnv включает подстановку контекста федерации для локальной разработки без gateway
+	DevFakeFederationEnv = "DEV_FAKE_FEDERATION"
+	// defaultDevTenantID тенант разработки по умолчанию (DEV_FAKE_TENANT_ID)
+	defaultDevTenantID = "00000000-0000-0000-0000-000000000001"
+	// defaultDevUserID пользователь разработки по умолчанию (DEV_FAKE_USER_ID)
+	defaultDevUserID = "00000000-0000-0000-0000-000000000002"
+	// defaultDevLanguage язык пользователя разработки по умолчанию (DEV_FAKE_LANGUAGE)
+	defaultDevLanguage = "ru"
+)
+
+// loadDevFederation читает контекст федерации разработки из окружения:
+// DEV_FAKE_TENANT_ID, DEV_FAKE_USER_ID, DEV_FAKE_USER_ROLE (по умолчанию owner), DEV_FAKE_LANGUAGE
+// и DEV_FAKE_DEPARTMENT_IDS (через запятую). Возвращает nil, если режим выключен или окружение production.
+func loadDevFederation() *federation.Context {
+	if os.Getenv(DevFakeFederationEnv) != "true" {
+		return nil
+	}
+	if utils.IsProduction() {
+		utils.Logger.Warn("DEV_FAKE_FEDERATION is ignored in production")
+		return nil
+	}
+
+	tenantID, err := uuid.Parse(envOrDefault("DEV_FAKE_TENANT_ID", defaultDevTenantID))
+	if err != nil {
+		utils.Logger.Error("Invalid DEV_FAKE_TENANT_ID, fake federation disabled", zap.Error(err))
+		return nil
+	}
+	userID, err := uuid.Parse(envOrDefault("DEV_FAKE_USER_ID", defaultDevUserID))
+	if err != nil {
+		utils.Logger.Error("Invalid DEV_FAKE_USER_ID, fake federation disabled", zap.Error(err))
+		return nil
+	}
+
+	fake := &federation.Context{
+		RequestID: "dev",
+		TenantID:  &tenantID,
+		UserID:    &userID,
+		UserRole:  envOrDefault("DEV_FAKE_USER_ROLE", types.RoleOwner),
+		Language:  envOrDefault("DEV_FAKE_LANGUAGE", defaultDevLanguage),
+	}
+	for _, value := range strings.Split(os.Getenv("DEV_FAKE_DEPARTMENT_IDS"), ",") {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		departmentID, err := uuid.Parse(value)
+		if err != nil {
+			utils.Logger.Warn("Invalid department ID in DEV_FAKE_DEPARTMENT_IDS, skipping", zap.String("value", value))
+			continue
+		}
+		fake.DepartmentIDs = append(fake.DepartmentIDs, departmentID)
+	}
+
+	utils.Logger.Warn("Fake federation context enabled: requests without gateway context run as the development user",
+		zap.String("tenant_id", tenantID.String()),
+		zap.String("user_id", userID.String()),
+		zap.String("user_role", fake.UserRole),
+		zap.String("language", fake.Language))
+
+	return fake
+}
+
+// envOrDefault возвращает значение переменной окружения или значение по умолчанию
+func envOrDefault(name, defaultValue string) string {
+	if value := strings.TrimSpace(os.Getenv(name)); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// devFederationHandler подставляет контекст федерации разработки в запросы без контекста от gateway,
+// чтобы GraphQL playground работал локально. Контекст, переданный gateway, не заменяется.
+func devFederationHandler(fake *federation.Context, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if federation.GetContext(r.Context()) == nil {
+			// Копия на запрос: обработчики не должны менять общий контекст разработки
+			fedCtx := *fake
+			r = r.WithContext(federation.WithContext(r.Context(), &fedCtx))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
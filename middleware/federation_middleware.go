@@ -1,6 +1,10 @@
 package middleware
 
 import (
+	"main/s3"
+	fileservice "main/services/file"
+	"main/services/tenant"
+	"main/utils"
 	"net/http"
 
 	federation "github.com/esemashko/v2-federation"
@@ -8,6 +12,11 @@ import (
 
 // FederationMiddleware applies federation middleware and logs federation context
 func FederationMiddleware(next http.Handler) http.Handler {
+	// Без gateway (локальная разработка) контекст федерации подставляется из DEV_FAKE_* переменных окружения
+	if fake := loadDevFederation(); fake != nil {
+		next = devFederationHandler(fake, next)
+	}
+
 	// First apply federation middleware
 	handler := federation.Middleware(next)
 
@@ -36,6 +45,32 @@ func FederationMiddleware(next http.Handler) http.Handler {
 			)*/
 		}
 
+		// Состояние тенанта из биллинга, переданное gateway (приоритетнее сохраненного в настройках)
+		if state := r.Header.Get(tenant.TenantStateHeader); state != "" {
+			r = r.WithContext(tenant.WithFederatedState(ctx, state))
+		}
+
+		// Регион клиента по геолокации gateway: выбор ближайшего endpoint для pre-signed URL
+		if region := r.Header.Get(s3.ClientRegionHeader); region != "" {
+			r = r.WithContext(s3.WithClientRegion(r.Context(), region))
+		}
+
+		// Источник загрузки и версия клиента: отличают пользовательские загрузки от автоматизации
+		source, clientVersion := r.Header.Get(fileservice.UploadSourceHeader), r.Header.Get(fileservice.ClientVersionHeader)
+		if source != "" || clientVersion != "" {
+			r = r.WithContext(fileservice.WithUploadSource(r.Context(), source, clientVersion))
+		}
+
+		// Email пользователя: текст водяного знака на скачиваемых PDF
+		if email := r.Header.Get(fileservice.UserEmailHeader); email != "" {
+			r = r.WithContext(fileservice.WithUserEmail(r.Context(), email))
+		}
+
+		// Часовой пояс пользователя: время отдается в UTC, локальное представление форматируется по нему
+		if timezone := r.Header.Get(utils.UserTimezoneHeader); timezone != "" {
+			r = r.WithContext(utils.WithUserTimezone(r.Context(), timezone))
+		}
+
 		// Call the federation-wrapped handler
 		handler.ServeHTTP(w, r)
 	})
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"main/errcatalog"
+	"main/security"
+	"main/services/impersonation"
+	"main/utils"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.uber.org/zap"
+)
+
+// ImpersonationMiddleware переключает запрос администратора поддержки с токеном X-Impersonation-Token
+// на пользователя, от имени которого выдан доступ. Должен стоять после DatabaseMiddleware и
+// FederationMiddleware. Недействительный токен отклоняется, а не игнорируется, чтобы поддержка не
+// приняла собственные права за права пользователя. Сессия только на чтение: разрешены GET и HEAD,
+// а также POST GraphQL запросов (мутации отклоняет ImpersonationScopeMiddleware).
+func ImpersonationMiddleware(next http.Handler) http.Handler {
+	service := impersonation.NewImpersonationService()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimSpace(r.Header.Get(impersonation.TokenHeader))
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		db := GetDBFromContext(r.Context())
+		if db == nil {
+			http.Error(w, "Database not available", http.StatusServiceUnavailable)
+			return
+		}
+
+		// Доступ читается с primary: отозванный доступ перестает действовать сразу
+		ctx, err := service.Authorize(r.Context(), db.Mutation(), token)
+		if err != nil {
+			utils.Logger.Warn("Rejected impersonation token", zap.String("path", r.URL.Path))
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+		case http.MethodPost:
+			if r.URL.Path != "/query" {
+				http.Error(w, errcatalog.ImpersonationReadOnly(ctx).Error(), http.StatusForbidden)
+				return
+			}
+		default:
+			http.Error(w, errcatalog.ImpersonationReadOnly(ctx).Error(), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ImpersonationScopeMiddleware отклоняет мутации в сессии поддержки от имени пользователя
+func ImpersonationScopeMiddleware() graphql.OperationMiddleware {
+	return func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		opCtx := graphql.GetOperationContext(ctx)
+		if security.GetImpersonation(ctx) != nil && opCtx != nil && opCtx.Operation != nil &&
+			opCtx.Operation.Operation == ast.Mutation {
+			return graphql.OneShot(graphql.ErrorResponse(ctx, "%s", errcatalog.ImpersonationReadOnly(ctx).Error()))
+		}
+		return next(ctx)
+	}
+}
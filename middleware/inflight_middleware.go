@@ -0,0 +1,193 @@
+package middleware
+
+import (
+	"context"
+	"main/utils"
+	"net/http"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	federation "github.com/esemashko/v2-federation"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// Виды выполняющихся запросов
+const (
+	InflightKindHTTP      = "http"
+	InflightKindGraphQL   = "graphql"
+	InflightKindWebSocket = "websocket"
+)
+
+// inflightLongestLimit сколько самых долгих запросов попадает в сводку
+const inflightLongestLimit = 10
+
+// inflightIDSegment идентификаторы в пути заменяются на {id}, чтобы операции HTTP группировались по маршруту
+var inflightIDSegment = regexp.MustCompile(`/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+
+// inflightRequest выполняющийся запрос или открытое WebSocket соединение
+type inflightRequest struct {
+	kind      string
+	operation string
+	tenantID  string
+	startedAt time.Time
+}
+
+// InflightRequest выполняющийся запрос в сводке
+type InflightRequest struct {
+	Kind      string    `json:"kind"`
+	Operation string    `json:"operation"`
+	TenantID  string    `json:"tenantId,omitempty"`
+	StartedAt time.Time `json:"startedAt"`
+	ElapsedMs int64     `json:"elapsedMs"`
+}
+
+// InflightStats сводка выполняющихся запросов: количество по видам, операциям и тенантам
+// и самые долгие запросы
+type InflightStats struct {
+	HTTPRequests    int               `json:"httpRequests"`
+	GraphQLRequests int               `json:"graphqlRequests"`
+	WebSockets      int               `json:"webSockets"`
+	ByOperation     map[string]int    `json:"byOperation"`
+	ByTenant        map[string]int    `json:"byTenant"`
+	Longest         []InflightRequest `json:"longest"`
+}
+
+var (
+	inflightRequests   = make(map[uint64]*inflightRequest)
+	inflightRequestsMu sync.Mutex
+	inflightNextID     uint64
+)
+
+// inflightRequestKey ключ контекста с учетной записью запроса
+type inflightRequestKey struct{}
+
+// InflightRequestsMiddleware учитывает выполняющиеся HTTP запросы и открытые WebSocket соединения.
+// Подключается после FederationMiddleware, чтобы запрос относился к тенанту; операция GraphQL
+// уточняется в InflightOperationMiddleware.
+func InflightRequestsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &inflightRequest{
+			kind:      InflightKindHTTP,
+			operation: r.Method + " " + inflightIDSegment.ReplaceAllString(r.URL.Path, "/{id}"),
+			startedAt: time.Now(),
+		}
+		// Обработчик WebSocket выполняется, пока открыто соединение
+		if websocket.IsWebSocketUpgrade(r) {
+			request.kind = InflightKindWebSocket
+			request.operation = "subscriptions"
+		}
+		if tenantID := federation.GetTenantID(r.Context()); tenantID != nil {
+			request.tenantID = tenantID.String()
+		}
+
+		inflightRequestsMu.Lock()
+		inflightNextID++
+		id := inflightNextID
+		inflightRequests[id] = request
+		inflightRequestsMu.Unlock()
+
+		defer func() {
+			inflightRequestsMu.Lock()
+			delete(inflightRequests, id)
+			inflightRequestsMu.Unlock()
+		}()
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), inflightRequestKey{}, request)))
+	})
+}
+
+// InflightOperationMiddleware отмечает HTTP запрос как операцию GraphQL с ее типом и именем
+func InflightOperationMiddleware() graphql.OperationMiddleware {
+	return func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		request, _ := ctx.Value(inflightRequestKey{}).(*inflightRequest)
+		opCtx := graphql.GetOperationContext(ctx)
+		if request != nil && request.kind == InflightKindHTTP && opCtx != nil && opCtx.Operation != nil {
+			name := opCtx.OperationName
+			if name == "" {
+				name = "anonymous"
+			}
+			inflightRequestsMu.Lock()
+			request.kind = InflightKindGraphQL
+			request.operation = string(opCtx.Operation.Operation) + " " + name
+			inflightRequestsMu.Unlock()
+		}
+		return next(ctx)
+	}
+}
+
+// GetInflightStats возвращает сводку выполняющихся запросов; tenantID (если не пустой) отбирает запросы тенанта
+func GetInflightStats(tenantID string) InflightStats {
+	now := time.Now()
+	stats := InflightStats{
+		ByOperation: make(map[string]int),
+		ByTenant:    make(map[string]int),
+		Longest:     make([]InflightRequest, 0),
+	}
+
+	inflightRequestsMu.Lock()
+	defer inflightRequestsMu.Unlock()
+
+	for _, request := range inflightRequests {
+		if tenantID != "" && request.tenantID != tenantID {
+			continue
+		}
+		switch request.kind {
+		case InflightKindGraphQL:
+			stats.GraphQLRequests++
+		case InflightKindWebSocket:
+			stats.WebSockets++
+		default:
+			stats.HTTPRequests++
+		}
+		stats.ByOperation[request.operation]++
+		if request.tenantID != "" {
+			stats.ByTenant[request.tenantID]++
+		}
+		stats.Longest = append(stats.Longest, InflightRequest{
+			Kind:      request.kind,
+			Operation: request.operation,
+			TenantID:  request.tenantID,
+			StartedAt: request.startedAt,
+			ElapsedMs: now.Sub(request.startedAt).Milliseconds(),
+		})
+	}
+
+	sort.Slice(stats.Longest, func(i, j int) bool {
+		return stats.Longest[i].StartedAt.Before(stats.Longest[j].StartedAt)
+	})
+	if len(stats.Longest) > inflightLongestLimit {
+		stats.Longest = stats.Longest[:inflightLongestLimit]
+	}
+	return stats
+}
+
+// LogInflightRequests логирует каждый выполняющийся запрос и WebSocket соединение; вызывается при остановке,
+// когда HTTP сервер не дождался их завершения
+func LogInflightRequests(reason string) {
+	now := time.Now()
+
+	inflightRequestsMu.Lock()
+	requests := make([]inflightRequest, 0, len(inflightRequests))
+	for _, request := range inflightRequests {
+		requests = append(requests, *request)
+	}
+	inflightRequestsMu.Unlock()
+
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].startedAt.Before(requests[j].startedAt)
+	})
+	utils.Logger.Warn("In-flight requests at shutdown",
+		zap.String("reason", reason),
+		zap.Int("count", len(requests)))
+	for _, request := range requests {
+		utils.Logger.Warn("In-flight request",
+			zap.String("kind", request.kind),
+			zap.String("operation", request.operation),
+			zap.String("tenant_id", request.tenantID),
+			zap.Duration("elapsed", now.Sub(request.startedAt)))
+	}
+}
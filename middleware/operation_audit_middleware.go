@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"main/ent"
+	"main/security"
+	auditservice "main/services/audit"
+	"main/types"
+	"reflect"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	federation "github.com/esemashko/v2-federation"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// OperationAuditMiddleware записывает в журнал операций каждую мутацию, выполненную администратором или владельцем.
+// Фиксируются корневые поля Mutation: имя, аргументы со скрытыми секретами, актор, тенант и результат.
+// В сессии поддержки от имени пользователя фиксируются все корневые поля, включая запросы.
+func OperationAuditMiddleware() graphql.FieldMiddleware {
+	auditService := auditservice.NewAuditService()
+
+	return func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+		fieldCtx := graphql.GetFieldContext(ctx)
+		opCtx := graphql.GetOperationContext(ctx)
+		if fieldCtx == nil || opCtx == nil || opCtx.Operation == nil || !isAuditedField(ctx, opCtx, fieldCtx) {
+			return next(ctx)
+		}
+
+		started := time.Now()
+		result, err := next(ctx)
+
+		operation := auditservice.Operation{
+			Name:      fieldCtx.Field.Name,
+			Variables: fieldCtx.Field.ArgumentMap(opCtx.Variables),
+			Duration:  time.Since(started),
+		}
+		if err != nil {
+			operation.Error = err.Error()
+		} else {
+			operation.Success, operation.Error = responseOutcome(result)
+		}
+
+		// Запись идет через клиент операции вне транзакции резолвера, поэтому откат мутации не удаляет запись аудита
+		auditService.RecordOperation(ctx, ent.FromContext(ctx), operation)
+
+		return result, err
+	}
+}
+
+// isAuditedField проверяет, записывается ли поле в журнал операций
+func isAuditedField(ctx context.Context, opCtx *graphql.OperationContext, fieldCtx *graphql.FieldContext) bool {
+	if security.GetImpersonation(ctx) != nil {
+		return fieldCtx.Object == "Query" || fieldCtx.Object == "Mutation"
+	}
+	return opCtx.Operation.Operation == ast.Mutation && fieldCtx.Object == "Mutation" && isAuditedActor(ctx)
+}
+
+// isAuditedActor возвращает true для администраторов и владельцев тенанта
+func isAuditedActor(ctx context.Context) bool {
+	role := federation.GetUserRole(ctx)
+	return role != "" && types.IsRoleHigherOrEqual(role, types.RoleAdmin)
+}
+
+// responseOutcome извлекает результат из ответа мутации вида {success, message}.
+// Ответы без поля Success считаются успешными.
+func responseOutcome(result interface{}) (bool, string) {
+	value := reflect.ValueOf(result)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return true, ""
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return true, ""
+	}
+
+	success := value.FieldByName("Success")
+	if !success.IsValid() || success.Kind() != reflect.Bool {
+		return true, ""
+	}
+	if success.Bool() {
+		return true, ""
+	}
+
+	message := value.FieldByName("Message")
+	if message.IsValid() && message.Kind() == reflect.String {
+		return false, message.String()
+	}
+	return false, ""
+}
@@ -0,0 +1,111 @@
+package redis
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	// encryptedPayloadPrefix помечает зашифрованные значения кэша (версия формата для будущей ротации)
+	encryptedPayloadPrefix = "enc:v1:"
+	// cacheEncryptionKeySize размер ключа AES-256
+	cacheEncryptionKeySize = 32
+)
+
+// cacheEncryption шифрует данные тенантов в Redis (AES-GCM).
+// Ключ сервиса задается REDIS_CACHE_ENCRYPTION_KEY (base64, 32 байта); без ключа данные хранятся как есть.
+type cacheEncryption struct {
+	aead cipher.AEAD
+	// err - ошибка конфигурации ключа: кэширование отключается, чтобы не писать данные в открытом виде
+	err error
+}
+
+// newCacheEncryptionFromEnv создает шифрование кэша из переменных окружения
+func newCacheEncryptionFromEnv() *cacheEncryption {
+	encodedKey := os.Getenv("REDIS_CACHE_ENCRYPTION_KEY")
+	if encodedKey == "" {
+		return &cacheEncryption{}
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return &cacheEncryption{err: fmt.Errorf("invalid REDIS_CACHE_ENCRYPTION_KEY: %w", err)}
+	}
+	if len(key) != cacheEncryptionKeySize {
+		return &cacheEncryption{err: fmt.Errorf("invalid REDIS_CACHE_ENCRYPTION_KEY: expected %d bytes, got %d", cacheEncryptionKeySize, len(key))}
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return &cacheEncryption{err: fmt.Errorf("failed to create cache cipher: %w", err)}
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return &cacheEncryption{err: fmt.Errorf("failed to create cache cipher: %w", err)}
+	}
+
+	return &cacheEncryption{aead: aead}
+}
+
+// enabled возвращает true, если ключ шифрования задан
+func (e *cacheEncryption) enabled() bool {
+	return e.aead != nil || e.err != nil
+}
+
+// seal шифрует данные. Ключ кэша используется как associated data,
+// поэтому значение нельзя подставить под ключ другого тенанта.
+func (e *cacheEncryption) seal(cacheKey string, data []byte) ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	if e.aead == nil {
+		return data, nil
+	}
+
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	payload := make([]byte, 0, len(encryptedPayloadPrefix)+len(nonce)+len(data)+e.aead.Overhead())
+	payload = append(payload, encryptedPayloadPrefix...)
+	payload = append(payload, nonce...)
+	return e.aead.Seal(payload, nonce, data, []byte(cacheKey)), nil
+}
+
+// open расшифровывает данные. Незашифрованные значения (записанные до включения шифрования)
+// и значения, которые не удалось расшифровать, считаются промахом кэша.
+func (e *cacheEncryption) open(cacheKey string, payload []byte) ([]byte, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	encrypted := bytes.HasPrefix(payload, []byte(encryptedPayloadPrefix))
+	if e.aead == nil {
+		if encrypted {
+			return nil, fmt.Errorf("cache miss: encrypted payload without encryption key")
+		}
+		return payload, nil
+	}
+	if !encrypted {
+		return nil, fmt.Errorf("cache miss: unencrypted payload")
+	}
+
+	payload = payload[len(encryptedPayloadPrefix):]
+	nonceSize := e.aead.NonceSize()
+	if len(payload) < nonceSize {
+		return nil, fmt.Errorf("cache miss: malformed encrypted payload")
+	}
+
+	data, err := e.aead.Open(nil, payload[:nonceSize], payload[nonceSize:], []byte(cacheKey))
+	if err != nil {
+		return nil, fmt.Errorf("cache miss: failed to decrypt payload")
+	}
+	return data, nil
+}
@@ -0,0 +1,184 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/utils"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Операции горячего пути для статистики бюджета задержки
+const (
+	HotPathCacheGet = "cache_get"
+	HotPathCacheSet = "cache_set"
+	HotPathPublish  = "publish"
+)
+
+// errRedisDegraded возвращается без обращения к Redis, пока действует деградированный режим
+var errRedisDegraded = errors.New("redis is degraded: latency budget exceeded")
+
+// latencyBudgetConfig настройки бюджета задержки команд горячего пути
+type latencyBudgetConfig struct {
+	// budget максимальное время одной команды (REDIS_HOT_PATH_BUDGET)
+	budget time.Duration
+	// threshold количество таймаутов подряд, после которого включается деградированный режим (REDIS_DEGRADED_THRESHOLD)
+	threshold int
+	// cooldown время, в течение которого команды не отправляются в Redis (REDIS_DEGRADED_COOLDOWN)
+	cooldown time.Duration
+}
+
+var (
+	latencyBudget     *latencyBudgetConfig
+	latencyBudgetOnce sync.Once
+
+	// consecutiveTimeouts количество таймаутов подряд по всем операциям горячего пути
+	consecutiveTimeouts atomic.Int64
+	// degradedUntil время (UnixNano) окончания деградированного режима
+	degradedUntil atomic.Int64
+
+	hotPathStats   = make(map[string]*hotPathCounters)
+	hotPathStatsMu sync.Mutex
+)
+
+// hotPathCounters счетчики операции горячего пути
+type hotPathCounters struct {
+	calls         atomic.Int64
+	timeouts      atomic.Int64
+	shortCircuits atomic.Int64
+}
+
+// HotPathStat содержит статистику команд горячего пути с момента запуска
+type HotPathStat struct {
+	Operation string `json:"operation"`
+	Calls     int64  `json:"calls"`
+	Timeouts  int64  `json:"timeouts"`
+	// ShortCircuits команды, пропущенные без обращения к Redis в деградированном режиме
+	ShortCircuits int64 `json:"shortCircuits"`
+}
+
+// HotPathStatus состояние деградированного режима и статистика операций
+type HotPathStatus struct {
+	Degraded      bool          `json:"degraded"`
+	DegradedUntil *time.Time    `json:"degradedUntil,omitempty"`
+	Budget        string        `json:"budget"`
+	Operations    []HotPathStat `json:"operations"`
+}
+
+// getLatencyBudgetConfig читает настройки из окружения один раз
+func getLatencyBudgetConfig() *latencyBudgetConfig {
+	latencyBudgetOnce.Do(func() {
+		latencyBudget = &latencyBudgetConfig{
+			budget:    getEnvDuration("REDIS_HOT_PATH_BUDGET", 50*time.Millisecond),
+			threshold: getEnvInt("REDIS_DEGRADED_THRESHOLD", 5),
+			cooldown:  getEnvDuration("REDIS_DEGRADED_COOLDOWN", 10*time.Second),
+		}
+		if latencyBudget.threshold < 1 {
+			latencyBudget.threshold = 1
+		}
+	})
+	return latencyBudget
+}
+
+// WithLatencyBudget выполняет команду горячего пути (чтение/запись кэша, публикация) с жестким ограничением
+// времени REDIS_HOT_PATH_BUDGET вместо полного ReadTimeout. После REDIS_DEGRADED_THRESHOLD таймаутов подряд
+// Redis считается деградированным: в течение REDIS_DEGRADED_COOLDOWN команды сразу возвращают
+// RedisUnavailableError, и вызывающий код переходит на путь без кэша. Первая команда после паузы
+// проверяет, восстановился ли Redis.
+func WithLatencyBudget(ctx context.Context, operation string, command func(ctx context.Context) error) error {
+	cfg := getLatencyBudgetConfig()
+	stat := hotPathStat(operation)
+
+	if IsDegraded() {
+		stat.shortCircuits.Add(1)
+		return &RedisUnavailableError{Err: errRedisDegraded}
+	}
+
+	stat.calls.Add(1)
+	budgetCtx, cancel := context.WithTimeout(ctx, cfg.budget)
+	defer cancel()
+
+	err := command(budgetCtx)
+	switch {
+	case err == nil:
+		if consecutiveTimeouts.Swap(0) >= int64(cfg.threshold) {
+			utils.Logger.Info("Redis latency recovered, leaving degraded mode")
+		}
+	case ctx.Err() == nil && isTimeout(err):
+		// Таймаут по бюджету (а не отмена запроса вызывающей стороной)
+		stat.timeouts.Add(1)
+		if consecutiveTimeouts.Add(1) >= int64(cfg.threshold) {
+			until := time.Now().Add(cfg.cooldown)
+			if previous := degradedUntil.Swap(until.UnixNano()); previous < time.Now().UnixNano() {
+				utils.Logger.Warn("Redis exceeds latency budget, entering degraded mode",
+					zap.String("operation", operation),
+					zap.Duration("budget", cfg.budget),
+					zap.Duration("cooldown", cfg.cooldown))
+			}
+		}
+		return &RedisUnavailableError{Err: fmt.Errorf("%s exceeded latency budget %s: %w", operation, cfg.budget, err)}
+	}
+	return err
+}
+
+// IsDegraded возвращает true, пока команды горячего пути не отправляются в Redis из-за превышения бюджета задержки
+func IsDegraded() bool {
+	return time.Now().UnixNano() < degradedUntil.Load()
+}
+
+// GetHotPathStatus возвращает состояние деградированного режима и статистику команд горячего пути
+func GetHotPathStatus() HotPathStatus {
+	status := HotPathStatus{
+		Degraded: IsDegraded(),
+		Budget:   getLatencyBudgetConfig().budget.String(),
+	}
+	if status.Degraded {
+		until := time.Unix(0, degradedUntil.Load())
+		status.DegradedUntil = &until
+	}
+
+	hotPathStatsMu.Lock()
+	defer hotPathStatsMu.Unlock()
+
+	status.Operations = make([]HotPathStat, 0, len(hotPathStats))
+	for operation, stat := range hotPathStats {
+		status.Operations = append(status.Operations, HotPathStat{
+			Operation:     operation,
+			Calls:         stat.calls.Load(),
+			Timeouts:      stat.timeouts.Load(),
+			ShortCircuits: stat.shortCircuits.Load(),
+		})
+	}
+	sort.Slice(status.Operations, func(i, j int) bool {
+		return status.Operations[i].Operation < status.Operations[j].Operation
+	})
+	return status
+}
+
+// hotPathStat возвращает счетчики операции, создавая их при первом обращении
+func hotPathStat(operation string) *hotPathCounters {
+	hotPathStatsMu.Lock()
+	defer hotPathStatsMu.Unlock()
+
+	stat, ok := hotPathStats[operation]
+	if !ok {
+		stat = &hotPathCounters{}
+		hotPathStats[operation] = stat
+	}
+	return stat
+}
+
+// isTimeout проверяет, что команда прервана по времени (контекст или таймаут сетевого соединения)
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
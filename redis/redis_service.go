@@ -16,6 +16,7 @@ import (
 
 const (
 	prefixTenantBySubdomain  = "tenant:subdomain:"
+	prefixTenantLocale       = "tenant:locale:"
 	defaultTTL               = 24 * time.Hour
 	initialReconnectInterval = 5 * time.Second // Начальный интервал для переподключения
 	maxReconnectInterval     = 5 * time.Minute // Максимальный интервал для переподключения
@@ -81,7 +82,8 @@ type TenantCacheService struct {
 	mu           sync.RWMutex // Мьютекс для безопасного доступа к client
 	healthCtx    context.Context
 	healthCancel context.CancelFunc
-	wg           sync.WaitGroup // WaitGroup для ожидания завершения горутин
+	wg           sync.WaitGroup   // WaitGroup для ожидания завершения горутин
+	encryption   *cacheEncryption // Шифрование данных тенантов (REDIS_CACHE_ENCRYPTION_KEY)
 }
 
 var (
@@ -94,8 +96,16 @@ func GetTenantCacheService() (*TenantCacheService, error) {
 	once.Do(func() {
 		config := NewRedisConfigFromEnv()
 		instance = &TenantCacheService{
-			client: nil,
-			config: config,
+			client:     nil,
+			config:     config,
+			encryption: newCacheEncryptionFromEnv(),
+		}
+
+		if instance.encryption.err != nil {
+			utils.Logger.Error("Tenant cache encryption is misconfigured, tenant cache is disabled",
+				zap.Error(instance.encryption.err))
+		} else if instance.encryption.enabled() {
+			utils.Logger.Info("Tenant cache encryption is enabled")
 		}
 
 		// Запуск горутины мониторинга здоровья соединения
@@ -298,15 +308,42 @@ func GetTenantSubdomainKey(subdomain string) string {
 	return prefixTenantBySubdomain + subdomain
 }
 
+// GetTenantLocaleKey returns Redis key for tenant localization settings
+func GetTenantLocaleKey(tenantID string) string {
+	return prefixTenantLocale + tenantID
+}
+
 // SetTenantCache stores tenant data in Redis cache
 func (s *TenantCacheService) SetTenantCache(ctx context.Context, tenantID, cacheKey string, data []byte) error {
+	return s.SetTenantCacheWithTTL(ctx, tenantID, cacheKey, data, defaultTTL)
+}
+
+// SetTenantCacheWithTTL stores tenant data in Redis cache with explicit TTL
+func (s *TenantCacheService) SetTenantCacheWithTTL(ctx context.Context, tenantID, cacheKey string, data []byte, ttl time.Duration) error {
 	client := s.getClient()
 	if client == nil {
 		return &RedisUnavailableError{Err: fmt.Errorf("redis client is nil")}
 	}
 
+	// Шифруем данные тенанта перед записью (если задан ключ)
+	payload, err := s.encryption.seal(cacheKey, data)
+	if err != nil {
+		utils.Logger.Warn("Failed to encrypt tenant data for Redis",
+			zap.Error(err),
+			zap.String("tenant_id", tenantID),
+			zap.String("cache_key", cacheKey),
+		)
+		return err
+	}
+
 	key := cacheKey
-	if err := client.Set(ctx, key, data, defaultTTL).Err(); err != nil {
+	if err := WithLatencyBudget(ctx, HotPathCacheSet, func(ctx context.Context) error {
+		return client.Set(ctx, key, payload, ttl).Err()
+	}); err != nil {
+		// Превышение бюджета задержки уже учтено в статистике горячего пути
+		if IsRedisUnavailable(err) {
+			return err
+		}
 		utils.Logger.Warn("Failed to set tenant data in Redis",
 			zap.Error(err),
 			zap.String("tenant_id", tenantID),
@@ -318,7 +355,7 @@ func (s *TenantCacheService) SetTenantCache(ctx context.Context, tenantID, cache
 	utils.Logger.Debug("Successfully cached tenant data in Redis",
 		zap.String("tenant_id", tenantID),
 		zap.String("cache_key", cacheKey),
-		zap.Duration("ttl", defaultTTL),
+		zap.Duration("ttl", ttl),
 	)
 
 	return nil
@@ -331,14 +368,31 @@ func (s *TenantCacheService) GetTenantCache(ctx context.Context, cacheKey string
 		return nil, &RedisUnavailableError{Err: fmt.Errorf("redis client is nil")}
 	}
 
-	data, err := client.Get(ctx, cacheKey).Bytes()
+	var payload []byte
+	err := WithLatencyBudget(ctx, HotPathCacheGet, func(ctx context.Context) (err error) {
+		payload, err = client.Get(ctx, cacheKey).Bytes()
+		return err
+	})
 	if err != nil {
 		if err == redis.Nil {
 			return nil, fmt.Errorf("cache miss")
 		}
+		if IsRedisUnavailable(err) {
+			return nil, err
+		}
 		return nil, &RedisUnavailableError{Err: err}
 	}
 
+	// Расшифровываем данные; значения в чужом формате считаются промахом и будут перезаписаны
+	data, err := s.encryption.open(cacheKey, payload)
+	if err != nil {
+		utils.Logger.Debug("Failed to decode tenant data from Redis",
+			zap.Error(err),
+			zap.String("cache_key", cacheKey),
+		)
+		return nil, err
+	}
+
 	return data, nil
 }
 
@@ -368,6 +422,30 @@ func (s *TenantCacheService) RefreshTenantCache(ctx context.Context, tenantID, c
 	return nil
 }
 
+// DeleteTenantCache removes tenant data from Redis cache
+func (s *TenantCacheService) DeleteTenantCache(ctx context.Context, tenantID, cacheKey string) error {
+	client := s.getClient()
+	if client == nil {
+		return &RedisUnavailableError{Err: fmt.Errorf("redis client is nil")}
+	}
+
+	if err := client.Del(ctx, cacheKey).Err(); err != nil {
+		utils.Logger.Warn("Failed to delete tenant data from Redis",
+			zap.Error(err),
+			zap.String("tenant_id", tenantID),
+			zap.String("cache_key", cacheKey),
+		)
+		return &RedisUnavailableError{Err: err}
+	}
+
+	utils.Logger.Debug("Successfully deleted tenant data from Redis",
+		zap.String("tenant_id", tenantID),
+		zap.String("cache_key", cacheKey),
+	)
+
+	return nil
+}
+
 // Close closes Redis connection and stops the health check
 func (s *TenantCacheService) Close() error {
 	// Останавливаем health check loop
@@ -0,0 +1,87 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	prefixTenantStorageUsage = "tenant:storage_usage:"
+	// StorageUsageTTL время жизни счетчика использования хранилища; сверка пересчитывает его раньше
+	StorageUsageTTL = 24 * time.Hour
+
+	prefixTenantStorageBreakdown = "tenant:storage_breakdown:"
+	// StorageBreakdownTTL время жизни кэша разбивки использования хранилища по загрузившим, категориям и месяцам
+	StorageBreakdownTTL = 5 * time.Minute
+)
+
+// incrStorageUsageScript увеличивает счетчик, только если он уже инициализирован из БД:
+// иначе отсутствующий ключ превратился бы в счетчик, равный одному изменению
+var incrStorageUsageScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("INCRBY", KEYS[1], ARGV[1])
+end
+return nil
+`)
+
+// GetTenantStorageUsageKey returns Redis key for tenant storage usage counter
+func GetTenantStorageUsageKey(tenantID string) string {
+	return prefixTenantStorageUsage + tenantID
+}
+
+// GetTenantStorageBreakdownKey returns Redis key for cached tenant storage usage breakdown
+func GetTenantStorageBreakdownKey(tenantID string) string {
+	return prefixTenantStorageBreakdown + tenantID
+}
+
+// GetTenantStorageUsage возвращает использование хранилища тенанта в байтах; found=false, если счетчик не инициализирован
+func (s *TenantCacheService) GetTenantStorageUsage(ctx context.Context, tenantID string) (usage int64, found bool, err error) {
+	client := s.getClient()
+	if client == nil {
+		return 0, false, &RedisUnavailableError{Err: fmt.Errorf("redis client is nil")}
+	}
+
+	usage, err = client.Get(ctx, GetTenantStorageUsageKey(tenantID)).Int64()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return usage, true, nil
+}
+
+// SetTenantStorageUsage инициализирует (или пересчитывает) счетчик использования хранилища тенанта
+func (s *TenantCacheService) SetTenantStorageUsage(ctx context.Context, tenantID string, usage int64) error {
+	client := s.getClient()
+	if client == nil {
+		return &RedisUnavailableError{Err: fmt.Errorf("redis client is nil")}
+	}
+	return client.Set(ctx, GetTenantStorageUsageKey(tenantID), usage, StorageUsageTTL).Err()
+}
+
+// IncrTenantStorageUsage изменяет счетчик на delta байт, если он инициализирован
+func (s *TenantCacheService) IncrTenantStorageUsage(ctx context.Context, tenantID string, delta int64) error {
+	client := s.getClient()
+	if client == nil {
+		return &RedisUnavailableError{Err: fmt.Errorf("redis client is nil")}
+	}
+
+	err := incrStorageUsageScript.Run(ctx, client, []string{GetTenantStorageUsageKey(tenantID)}, delta).Err()
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
+
+// DeleteTenantStorageUsage сбрасывает счетчик: следующее чтение пересчитает использование по БД
+func (s *TenantCacheService) DeleteTenantStorageUsage(ctx context.Context, tenantID string) error {
+	client := s.getClient()
+	if client == nil {
+		return &RedisUnavailableError{Err: fmt.Errorf("redis client is nil")}
+	}
+	return client.Del(ctx, GetTenantStorageUsageKey(tenantID)).Err()
+}
@@ -0,0 +1,141 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/google/uuid"
+)
+
+const (
+	// maxSingleCopySize максимальный размер объекта для CopyObject (ограничение S3 - 5 GiB)
+	maxSingleCopySize int64 = 5 * 1024 * 1024 * 1024
+	// copyPartSize размер части при multipart копировании больших объектов
+	copyPartSize int64 = 512 * 1024 * 1024
+)
+
+// TenantStorageKey переносит ключ хранилища под префикс другого тенанта:
+// tenants/{source}/path -> tenants/{target}/path. Ключи вне префикса тенанта целиком
+// помещаются под префикс целевого тенанта.
+func TenantStorageKey(storageKey string, sourceTenantID, targetTenantID uuid.UUID) string {
+	sourcePrefix := fmt.Sprintf("tenants/%s/", sourceTenantID.String())
+	targetPrefix := fmt.Sprintf("tenants/%s/", targetTenantID.String())
+	return targetPrefix + strings.TrimPrefix(storageKey, sourcePrefix)
+}
+
+// NewStorageKey генерирует уникальный ключ хранилища тенанта для нового объекта (например, копии файла)
+func (s *S3Service) NewStorageKey(ctx context.Context, originalName string) (string, error) {
+	tenantPrefix, err := s.getTenantPrefix(ctx)
+	if err != nil {
+		return "", err
+	}
+	return tenantPrefix + s.generateStorageKey(originalName), nil
+}
+
+// CopyFile копирует объект внутри бакета на стороне S3 без передачи данных через сервис.
+// Объекты больше 5 GiB копируются по частям (UploadPartCopy). Метаданные шифрования копируются вместе
+// с объектом, поэтому копия расшифровывается ключом источника.
+func (s *S3Service) CopyFile(ctx context.Context, sourceKey, targetKey string, size int64) error {
+	config, err := s.getS3Config(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	client, err := s.getS3Client(config)
+	if err != nil {
+		return fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	copySource := config.Bucket + "/" + escapeCopySourceKey(sourceKey)
+	// Зашифрованный объект в хранилище больше файла на теги чанков
+	if s.encryption != nil {
+		size = encryptedSize(size)
+	}
+
+	if size <= maxSingleCopySize {
+		if _, err := client.CopyObjectWithContext(ctx, &s3.CopyObjectInput{
+			Bucket:     aws.String(config.Bucket),
+			Key:        aws.String(targetKey),
+			CopySource: aws.String(copySource),
+		}); err != nil {
+			return fmt.Errorf("failed to copy file: %w", err)
+		}
+		return nil
+	}
+
+	return s.multipartCopy(ctx, client, config.Bucket, copySource, sourceKey, targetKey)
+}
+
+// multipartCopy копирует большой объект по частям с сохранением типа содержимого и метаданных
+func (s *S3Service) multipartCopy(ctx context.Context, client *s3.S3, bucket, copySource, sourceKey, targetKey string) error {
+	head, err := client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(sourceKey),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get source file info: %w", err)
+	}
+	size := aws.Int64Value(head.ContentLength)
+
+	created, err := client.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(targetKey),
+		ContentType: head.ContentType,
+		Metadata:    head.Metadata,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create multipart copy: %w", err)
+	}
+	uploadID := aws.StringValue(created.UploadId)
+
+	completed := make([]*s3.CompletedPart, 0, size/copyPartSize+1)
+	for partNumber, offset := int64(1), int64(0); offset < size; partNumber, offset = partNumber+1, offset+copyPartSize {
+		end := offset + copyPartSize - 1
+		if end >= size {
+			end = size - 1
+		}
+
+		part, err := client.UploadPartCopyWithContext(ctx, &s3.UploadPartCopyInput{
+			Bucket:          aws.String(bucket),
+			Key:             aws.String(targetKey),
+			UploadId:        aws.String(uploadID),
+			PartNumber:      aws.Int64(partNumber),
+			CopySource:      aws.String(copySource),
+			CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", offset, end)),
+		})
+		if err != nil {
+			s.abortFailedUpload(client, bucket, targetKey, uploadID)
+			return fmt.Errorf("failed to copy part %d: %w", partNumber, err)
+		}
+
+		completed = append(completed, &s3.CompletedPart{
+			PartNumber: aws.Int64(partNumber),
+			ETag:       part.CopyPartResult.ETag,
+		})
+	}
+
+	if _, err := client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(targetKey),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	}); err != nil {
+		s.abortFailedUpload(client, bucket, targetKey, uploadID)
+		return fmt.Errorf("failed to complete multipart copy: %w", err)
+	}
+
+	return nil
+}
+
+// escapeCopySourceKey кодирует ключ для заголовка x-amz-copy-source, сохраняя разделители пути
+func escapeCopySourceKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
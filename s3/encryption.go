@@ -0,0 +1,737 @@
+package s3
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"main/utils"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/s3"
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Client-side envelope encryption of tenant objects.
+//
+// Every tenant has a data key generated by the key provider (KMS or the S3_ENCRYPTION_MASTER_KEY env key)
+// and stored wrapped under tenants/{id}/.encryption/data-key. Each object gets its own AES-256 key derived
+// from the tenant data key with HKDF and a random salt; the content is split into 64 KiB chunks sealed with
+// AES-GCM, so byte ranges can be decrypted without reading the whole object. The last chunk of the object is
+// sealed with a final-chunk marker in the associated data, so an object truncated at a chunk boundary fails
+// to decrypt instead of returning a shorter plaintext. The wrapped data key, the salt and the part size are
+// stored in the object metadata, so an object stays readable after the tenant key object is replaced and
+// after a server-side copy to another key or tenant.
+const (
+	// EncryptionChunkSize plaintext bytes sealed in one AES-GCM chunk; multipart part sizes must be its multiple
+	EncryptionChunkSize = 64 << 10
+	// encryptionTagSize AES-GCM authentication tag appended to every chunk
+	encryptionTagSize = 16
+	// encryptionScheme value of the file-encryption metadata for new encrypted objects; it is also the HKDF
+	// info of the object key, so a rewritten scheme value does not downgrade the object to the legacy scheme
+	encryptionScheme = "aes-256-gcm-chunked-v2"
+	// legacyEncryptionScheme objects stored before the final-chunk marker; they are read without the truncation check
+	legacyEncryptionScheme = "aes-256-gcm-chunked-v1"
+	// encryptionSaltSize random salt for the per-object key derivation
+	encryptionSaltSize = 16
+	// dataKeySize tenant data key length (AES-256)
+	dataKeySize = 32
+	// dataKeyObjectName wrapped tenant data key, relative to the tenant prefix
+	dataKeyObjectName = ".encryption/data-key"
+)
+
+// Object metadata of encrypted objects (x-amz-meta-*)
+const (
+	metaEncryption = "File-Encryption"
+	metaDataKey    = "File-Data-Key"
+	metaKeyTenant  = "File-Key-Tenant"
+	metaSalt       = "File-Salt"
+	metaPartSize   = "File-Part-Size"
+)
+
+// keyProvider generates and unwraps tenant data keys; the tenant ID is bound to the wrapped key
+type keyProvider interface {
+	name() string
+	generateDataKey(ctx context.Context, tenantID string) (plaintext, wrapped []byte, err error)
+	decryptDataKey(ctx context.Context, tenantID string, wrapped []byte) ([]byte, error)
+}
+
+// envKeyProvider wraps data keys with AES-256-GCM under the master key from the environment
+type envKeyProvider struct {
+	aead cipher.AEAD
+}
+
+func newEnvKeyProvider(encodedKey string) (*envKeyProvider, error) {
+	masterKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil || len(masterKey) != dataKeySize {
+		return nil, fmt.Errorf("S3_ENCRYPTION_MASTER_KEY must be %d bytes encoded in base64", dataKeySize)
+	}
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	return &envKeyProvider{aead: aead}, nil
+}
+
+func (p *envKeyProvider) name() string { return "env" }
+
+func (p *envKeyProvider) generateDataKey(_ context.Context, tenantID string) ([]byte, []byte, error) {
+	plaintext := make([]byte, dataKeySize)
+	nonce := make([]byte, p.aead.NonceSize())
+	if _, err := rand.Read(plaintext); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate data key nonce: %w", err)
+	}
+	return plaintext, p.aead.Seal(nonce, nonce, plaintext, []byte("tenant:"+tenantID)), nil
+}
+
+func (p *envKeyProvider) decryptDataKey(_ context.Context, tenantID string, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < p.aead.NonceSize() {
+		return nil, errors.New("wrapped data key is too short")
+	}
+	nonce, sealed := wrapped[:p.aead.NonceSize()], wrapped[p.aead.NonceSize():]
+	plaintext, err := p.aead.Open(nil, nonce, sealed, []byte("tenant:"+tenantID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	return plaintext, nil
+}
+
+// kmsKeyProvider generates data keys with AWS KMS; the tenant ID is passed as the encryption context
+type kmsKeyProvider struct {
+	client *kms.KMS
+	keyID  string
+}
+
+func newKMSKeyProvider(keyID, region string) (*kmsKeyProvider, error) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(region)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create KMS session: %w", err)
+	}
+	return &kmsKeyProvider{client: kms.New(sess), keyID: keyID}, nil
+}
+
+func (p *kmsKeyProvider) name() string { return "kms" }
+
+func (p *kmsKeyProvider) generateDataKey(ctx context.Context, tenantID string) ([]byte, []byte, error) {
+	result, err := p.client.GenerateDataKeyWithContext(ctx, &kms.GenerateDataKeyInput{
+		KeyId:             aws.String(p.keyID),
+		KeySpec:           aws.String(kms.DataKeySpecAes256),
+		EncryptionContext: map[string]*string{"tenant_id": aws.String(tenantID)},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate KMS data key: %w", err)
+	}
+	return result.Plaintext, result.CiphertextBlob, nil
+}
+
+func (p *kmsKeyProvider) decryptDataKey(ctx context.Context, tenantID string, wrapped []byte) ([]byte, error) {
+	result, err := p.client.DecryptWithContext(ctx, &kms.DecryptInput{
+		KeyId:             aws.String(p.keyID),
+		CiphertextBlob:    wrapped,
+		EncryptionContext: map[string]*string{"tenant_id": aws.String(tenantID)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt KMS data key: %w", err)
+	}
+	return result.Plaintext, nil
+}
+
+// unavailableKeyProvider keeps encryption enabled when its configuration is invalid:
+// objects are neither written in plaintext nor read until the configuration is fixed
+type unavailableKeyProvider struct {
+	err error
+}
+
+func (p *unavailableKeyProvider) name() string { return "unavailable" }
+
+func (p *unavailableKeyProvider) generateDataKey(context.Context, string) ([]byte, []byte, error) {
+	return nil, nil, p.err
+}
+
+func (p *unavailableKeyProvider) decryptDataKey(context.Context, string, []byte) ([]byte, error) {
+	return nil, p.err
+}
+
+// tenantDataKey data key used for new objects of a tenant
+type tenantDataKey struct {
+	plaintext []byte
+	wrapped   []byte
+}
+
+// envelopeEncryption caches unwrapped data keys for the process lifetime: one key per tenant
+// (and one per replaced key still referenced by older objects)
+type envelopeEncryption struct {
+	provider   keyProvider
+	mu         sync.Mutex
+	tenantKeys map[string]*tenantDataKey
+	unwrapped  map[string][]byte
+}
+
+var (
+	encryptionOnce    sync.Once
+	processEncryption *envelopeEncryption
+)
+
+// getEncryption returns the process-wide encryption, or nil when neither S3_ENCRYPTION_KMS_KEY_ID
+// nor S3_ENCRYPTION_MASTER_KEY is set
+func getEncryption() *envelopeEncryption {
+	encryptionOnce.Do(func() {
+		var provider keyProvider
+		var err error
+		if keyID := strings.TrimSpace(os.Getenv("S3_ENCRYPTION_KMS_KEY_ID")); keyID != "" {
+			provider, err = newKMSKeyProvider(keyID, getEnv("S3_ENCRYPTION_KMS_REGION", getEnv("S3_REGION", "us-east-1")))
+		} else if masterKey := os.Getenv("S3_ENCRYPTION_MASTER_KEY"); masterKey != "" {
+			provider, err = newEnvKeyProvider(masterKey)
+		} else {
+			return
+		}
+		if err != nil {
+			utils.Logger.Error("Invalid S3 encryption configuration, file storage is unavailable", zap.Error(err))
+			provider = &unavailableKeyProvider{err: fmt.Errorf("S3 encryption is misconfigured: %w", err)}
+		}
+		processEncryption = &envelopeEncryption{
+			provider:   provider,
+			tenantKeys: make(map[string]*tenantDataKey),
+			unwrapped:  make(map[string][]byte),
+		}
+	})
+	return processEncryption
+}
+
+// EncryptionEnabled reports whether tenant objects are encrypted on the client side.
+// Presigned URLs of encrypted objects return ciphertext, so their content is served through the service.
+func (s *S3Service) EncryptionEnabled() bool {
+	return s.encryption != nil
+}
+
+// objectEncryption parameters of one encrypted object
+type objectEncryption struct {
+	aead cipher.AEAD
+	// partSize plaintext bytes per multipart part (a multiple of EncryptionChunkSize); 0 - a single part
+	partSize int64
+	// size plaintext size of a multipart upload: parts are sealed separately, so the final chunk is found by the size
+	size int64
+	// finalMarker the final chunk is sealed with the final-chunk marker (false for the legacy scheme)
+	finalMarker bool
+}
+
+// encryptionParams parameters stored in the object metadata (and in the multipart upload state)
+type encryptionParams struct {
+	// Scheme encryption scheme; empty in the state of multipart uploads started with the legacy scheme
+	Scheme    string `json:"scheme,omitempty"`
+	KeyTenant string `json:"keyTenant"`
+	DataKey   []byte `json:"dataKey"`
+	Salt      []byte `json:"salt"`
+	PartSize  int64  `json:"partSize"`
+	// Size plaintext size of a multipart upload (0 for single objects)
+	Size int64 `json:"size,omitempty"`
+}
+
+// scheme returns the encryption scheme of the parameters
+func (p *encryptionParams) scheme() string {
+	if p.Scheme == "" {
+		return legacyEncryptionScheme
+	}
+	return p.Scheme
+}
+
+// metadata returns the object metadata for the parameters
+func (p *encryptionParams) metadata() map[string]*string {
+	return map[string]*string{
+		metaEncryption: aws.String(p.scheme()),
+		metaDataKey:    aws.String(base64.StdEncoding.EncodeToString(p.DataKey)),
+		metaKeyTenant:  aws.String(p.KeyTenant),
+		metaSalt:       aws.String(base64.StdEncoding.EncodeToString(p.Salt)),
+		metaPartSize:   aws.String(strconv.FormatInt(p.PartSize, 10)),
+	}
+}
+
+// newObjectParams prepares the encryption of a new object with the current data key of the object tenant.
+// size is the plaintext size of a multipart upload (0 for single objects).
+func (e *envelopeEncryption) newObjectParams(ctx context.Context, client *s3.S3, bucket, storageKey string, partSize, size int64) (*encryptionParams, error) {
+	if partSize%EncryptionChunkSize != 0 {
+		return nil, fmt.Errorf("part size %d is not a multiple of the encryption chunk size", partSize)
+	}
+	tenantID, err := objectTenantID(ctx, storageKey)
+	if err != nil {
+		return nil, err
+	}
+	key, err := e.tenantKey(ctx, client, bucket, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, encryptionSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate encryption salt: %w", err)
+	}
+	return &encryptionParams{
+		Scheme:    encryptionScheme,
+		KeyTenant: tenantID,
+		DataKey:   key.wrapped,
+		Salt:      salt,
+		PartSize:  partSize,
+		Size:      size,
+	}, nil
+}
+
+// encryptObject prepares the encryption of a new object and returns it with the object metadata
+func (e *envelopeEncryption) encryptObject(ctx context.Context, client *s3.S3, bucket, storageKey string, partSize int64) (*objectEncryption, map[string]*string, error) {
+	params, err := e.newObjectParams(ctx, client, bucket, storageKey, partSize, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	object, err := e.object(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	return object, params.metadata(), nil
+}
+
+// objectFromMetadata returns the encryption of a stored object, or nil for objects stored in plaintext
+func (e *envelopeEncryption) objectFromMetadata(ctx context.Context, metadata map[string]*string) (*objectEncryption, error) {
+	if metadataValue(metadata, metaEncryption) == "" {
+		return nil, nil
+	}
+	scheme := metadataValue(metadata, metaEncryption)
+	if scheme != encryptionScheme && scheme != legacyEncryptionScheme {
+		return nil, fmt.Errorf("unsupported object encryption %q", scheme)
+	}
+	dataKey, err := base64.StdEncoding.DecodeString(metadataValue(metadata, metaDataKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid object data key: %w", err)
+	}
+	salt, err := base64.StdEncoding.DecodeString(metadataValue(metadata, metaSalt))
+	if err != nil {
+		return nil, fmt.Errorf("invalid object salt: %w", err)
+	}
+	partSize, err := strconv.ParseInt(metadataValue(metadata, metaPartSize), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object part size: %w", err)
+	}
+	return e.object(ctx, &encryptionParams{
+		Scheme:    scheme,
+		KeyTenant: metadataValue(metadata, metaKeyTenant),
+		DataKey:   dataKey,
+		Salt:      salt,
+		PartSize:  partSize,
+	})
+}
+
+// object derives the object key from the tenant data key and the object salt
+func (e *envelopeEncryption) object(ctx context.Context, params *encryptionParams) (*objectEncryption, error) {
+	if params.PartSize < 0 || params.PartSize%EncryptionChunkSize != 0 || len(params.Salt) != encryptionSaltSize {
+		return nil, errors.New("invalid object encryption parameters")
+	}
+	dataKey, err := e.unwrap(ctx, params.KeyTenant, params.DataKey)
+	if err != nil {
+		return nil, err
+	}
+	objectKey, err := hkdf.Key(sha256.New, dataKey, params.Salt, params.scheme(), dataKeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive object key: %w", err)
+	}
+	aead, err := newAEAD(objectKey)
+	if err != nil {
+		return nil, err
+	}
+	return &objectEncryption{
+		aead:        aead,
+		partSize:    params.PartSize,
+		size:        params.Size,
+		finalMarker: params.scheme() != legacyEncryptionScheme,
+	}, nil
+}
+
+// tenantKey returns the data key for new objects of the tenant: from the cache, from the key object,
+// or a new key. Concurrent instances may each store a new key; the last one is used afterwards,
+// objects encrypted with the other stay readable by their own metadata.
+func (e *envelopeEncryption) tenantKey(ctx context.Context, client *s3.S3, bucket, tenantID string) (*tenantDataKey, error) {
+	e.mu.Lock()
+	key := e.tenantKeys[tenantID]
+	e.mu.Unlock()
+	if key != nil {
+		return key, nil
+	}
+
+	objectKey := fmt.Sprintf("tenants/%s/%s", tenantID, dataKeyObjectName)
+	result, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(objectKey),
+	})
+	switch {
+	case err == nil:
+		wrapped, readErr := io.ReadAll(result.Body)
+		result.Body.Close()
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read tenant data key: %w", readErr)
+		}
+		plaintext, err := e.unwrap(ctx, tenantID, wrapped)
+		if err != nil {
+			return nil, err
+		}
+		key = &tenantDataKey{plaintext: plaintext, wrapped: wrapped}
+	case IsNotFoundError(err):
+		plaintext, wrapped, err := e.provider.generateDataKey(ctx, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+			Bucket:      aws.String(bucket),
+			Key:         aws.String(objectKey),
+			Body:        bytes.NewReader(wrapped),
+			ContentType: aws.String("application/octet-stream"),
+		}); err != nil {
+			return nil, fmt.Errorf("failed to store tenant data key: %w", err)
+		}
+		utils.Logger.Info("Tenant data key created",
+			zap.String("tenant_id", tenantID),
+			zap.String("key_provider", e.provider.name()))
+		key = &tenantDataKey{plaintext: plaintext, wrapped: wrapped}
+	default:
+		return nil, fmt.Errorf("failed to get tenant data key: %w", err)
+	}
+
+	e.mu.Lock()
+	e.tenantKeys[tenantID] = key
+	e.unwrapped[string(key.wrapped)] = key.plaintext
+	e.mu.Unlock()
+	return key, nil
+}
+
+// unwrap returns the plaintext of a wrapped data key of the tenant
+func (e *envelopeEncryption) unwrap(ctx context.Context, tenantID string, wrapped []byte) ([]byte, error) {
+	e.mu.Lock()
+	plaintext, ok := e.unwrapped[string(wrapped)]
+	e.mu.Unlock()
+	if ok {
+		return plaintext, nil
+	}
+
+	plaintext, err := e.provider.decryptDataKey(ctx, tenantID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if len(plaintext) != dataKeySize {
+		return nil, errors.New("unexpected data key length")
+	}
+	e.mu.Lock()
+	e.unwrapped[string(wrapped)] = plaintext
+	e.mu.Unlock()
+	return plaintext, nil
+}
+
+// objectTenantID returns the tenant of the object by its key (tenants/{id}/...), falling back to the context tenant
+func objectTenantID(ctx context.Context, storageKey string) (string, error) {
+	if rest, ok := strings.CutPrefix(storageKey, "tenants/"); ok {
+		if id, _, found := strings.Cut(rest, "/"); found {
+			if tenantID, err := uuid.Parse(id); err == nil {
+				return tenantID.String(), nil
+			}
+		}
+	}
+	if tenantID := federation.GetTenantID(ctx); tenantID != nil {
+		return tenantID.String(), nil
+	}
+	return "", fmt.Errorf("tenant ID not found for object %s", storageKey)
+}
+
+// metadataValue returns an object metadata value; the SDK and S3-compatible storages differ in key case
+func metadataValue(metadata map[string]*string, name string) string {
+	for key, value := range metadata {
+		if strings.EqualFold(key, name) {
+			return aws.StringValue(value)
+		}
+	}
+	return ""
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns the nonce of a chunk: the part number and the chunk index within the part
+func (o *objectEncryption) chunkNonce(part uint32, chunk uint64) []byte {
+	nonce := make([]byte, o.aead.NonceSize())
+	binary.BigEndian.PutUint32(nonce[:4], part)
+	binary.BigEndian.PutUint64(nonce[4:], chunk)
+	return nonce
+}
+
+// chunkAAD returns the associated data of a chunk: the final chunk of the object carries the final-chunk marker,
+// so dropping trailing chunks (or appending chunks after the final one) breaks the authentication
+func (o *objectEncryption) chunkAAD(final bool) []byte {
+	switch {
+	case !o.finalMarker:
+		return nil
+	case final:
+		return []byte{1}
+	default:
+		return []byte{0}
+	}
+}
+
+// chunkPosition returns the part and the index within the part of the chunk with the global index
+func (o *objectEncryption) chunkPosition(index int64) (uint32, uint64) {
+	if o.partSize == 0 {
+		return 0, uint64(index)
+	}
+	chunksPerPart := o.partSize / EncryptionChunkSize
+	return uint32(index/chunksPerPart + 1), uint64(index % chunksPerPart)
+}
+
+// chunkIndex returns the global index of the chunk of the part
+func (o *objectEncryption) chunkIndex(part uint32, chunk uint64) int64 {
+	if o.partSize == 0 {
+		return int64(chunk)
+	}
+	return int64(part-1)*(o.partSize/EncryptionChunkSize) + int64(chunk)
+}
+
+// encryptedSize returns the stored size of plaintext of the given size (empty plaintext is one empty final chunk)
+func encryptedSize(size int64) int64 {
+	chunks := max((size+EncryptionChunkSize-1)/EncryptionChunkSize, 1)
+	return size + chunks*encryptionTagSize
+}
+
+// decryptedSize returns the plaintext size of a stored object of the given size
+func decryptedSize(size int64) int64 {
+	chunks := (size + EncryptionChunkSize + encryptionTagSize - 1) / (EncryptionChunkSize + encryptionTagSize)
+	return size - chunks*encryptionTagSize
+}
+
+// encryptingReader seals the source stream chunk by chunk, starting from the first chunk of the part.
+// The final chunk is the end of the stream, or for parts of a multipart upload the chunk at the end of the
+// declared upload size.
+type encryptingReader struct {
+	source *bufio.Reader
+	object *objectEncryption
+	part   uint32
+	chunk  uint64
+	plain  []byte
+	sealed []byte
+	err    error
+}
+
+func newEncryptingReader(source io.Reader, object *objectEncryption, part uint32) *encryptingReader {
+	return &encryptingReader{
+		source: bufio.NewReader(source),
+		object: object,
+		part:   part,
+		plain:  make([]byte, EncryptionChunkSize),
+	}
+}
+
+// final reports whether the chunk just read is the final chunk of the object
+func (r *encryptingReader) final(n int, eof bool) bool {
+	if r.part > 0 {
+		lastIndex := max((r.object.size-1)/EncryptionChunkSize, 0)
+		return r.object.chunkIndex(r.part, r.chunk) == lastIndex
+	}
+	if eof || n < EncryptionChunkSize {
+		return true
+	}
+	// A full chunk is final when nothing follows it
+	_, err := r.source.Peek(1)
+	return err == io.EOF
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.sealed) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		n, err := io.ReadFull(r.source, r.plain)
+		eof := err == io.EOF || err == io.ErrUnexpectedEOF
+		if eof {
+			r.err = io.EOF
+		} else if err != nil {
+			r.err = err
+			continue
+		}
+		// Empty plaintext is sealed as one empty final chunk, so that it cannot be confused with a truncated object
+		if n > 0 || (r.chunk == 0 && r.object.finalMarker) {
+			nonce := r.object.chunkNonce(r.part, r.chunk)
+			r.sealed = r.object.aead.Seal(r.sealed[:0], nonce, r.plain[:n], r.object.chunkAAD(r.final(n, eof)))
+			r.chunk++
+		}
+		if eof {
+			break
+		}
+	}
+	n := copy(p, r.sealed)
+	r.sealed = r.sealed[n:]
+	if n == 0 {
+		return 0, r.err
+	}
+	return n, nil
+}
+
+// decryptingReader opens chunks of the stored stream starting from the chunk with the global index first;
+// skip plaintext bytes are dropped at the beginning and at most limit bytes are returned (negative - all).
+// A stream that ends before the final chunk is rejected as truncated.
+type decryptingReader struct {
+	source io.ReadCloser
+	object *objectEncryption
+	index  int64
+	skip   int64
+	limit  int64
+	sealed []byte
+	plain  []byte
+	final  bool
+	err    error
+}
+
+func newDecryptingReader(source io.ReadCloser, object *objectEncryption, first, skip, limit int64) *decryptingReader {
+	return &decryptingReader{
+		source: source,
+		object: object,
+		index:  first,
+		skip:   skip,
+		limit:  limit,
+		sealed: make([]byte, EncryptionChunkSize+encryptionTagSize),
+	}
+}
+
+// open opens a sealed chunk: a short chunk can only be the final one, a full chunk is tried as a regular chunk first
+func (r *decryptingReader) open(sealed []byte, short bool) ([]byte, bool, error) {
+	part, chunk := r.object.chunkPosition(r.index)
+	nonce := r.object.chunkNonce(part, chunk)
+	if !short || !r.object.finalMarker {
+		plain, err := r.object.aead.Open(nil, nonce, sealed, r.object.chunkAAD(false))
+		if err == nil || !r.object.finalMarker {
+			return plain, false, err
+		}
+	}
+	plain, err := r.object.aead.Open(nil, nonce, sealed, r.object.chunkAAD(true))
+	return plain, true, err
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.limit == 0 {
+			r.err = io.EOF
+			return 0, r.err
+		}
+		n, err := io.ReadFull(r.source, r.sealed)
+		if err == io.EOF {
+			r.err = io.EOF
+			if r.object.finalMarker && !r.final {
+				r.err = fmt.Errorf("encrypted object is truncated before chunk %d", r.index)
+			}
+			continue
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			r.err = err
+			continue
+		}
+		if r.final {
+			r.err = fmt.Errorf("encrypted object has data after the final chunk %d", r.index-1)
+			continue
+		}
+		plain, final, openErr := r.open(r.sealed[:n], err == io.ErrUnexpectedEOF)
+		if openErr != nil {
+			r.err = fmt.Errorf("failed to decrypt object chunk %d: %w", r.index, openErr)
+			continue
+		}
+		r.index++
+		r.final = final
+		if err == io.ErrUnexpectedEOF {
+			r.err = io.EOF
+		}
+		if r.skip > 0 {
+			drop := min(r.skip, int64(len(plain)))
+			plain, r.skip = plain[drop:], r.skip-drop
+		}
+		if r.limit >= 0 && int64(len(plain)) > r.limit {
+			plain = plain[:r.limit]
+		}
+		r.plain = plain
+	}
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	if r.limit > 0 {
+		r.limit -= int64(n)
+	}
+	return n, nil
+}
+
+func (r *decryptingReader) Close() error {
+	return r.source.Close()
+}
+
+// getEncryptedObjectRange streams plaintext bytes start..end of an object: the chunks covering the range are
+// requested and decrypted. Objects stored before encryption was enabled are read by the plaintext range again.
+func (s *S3Service) getEncryptedObjectRange(ctx context.Context, client *s3.S3, bucket, storageKey string, start, end int64) (io.ReadCloser, error) {
+	firstChunk, lastChunk := start/EncryptionChunkSize, end/EncryptionChunkSize
+	sealedChunkSize := int64(EncryptionChunkSize + encryptionTagSize)
+
+	result, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(storageKey),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", firstChunk*sealedChunkSize, (lastChunk+1)*sealedChunkSize-1)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file object range: %w", err)
+	}
+
+	object, err := s.encryption.objectFromMetadata(ctx, result.Metadata)
+	if err != nil {
+		result.Body.Close()
+		return nil, fmt.Errorf("failed to open encrypted file object: %w", err)
+	}
+	if object != nil {
+		return newDecryptingReader(result.Body, object, firstChunk, start-firstChunk*EncryptionChunkSize, end-start+1), nil
+	}
+
+	result.Body.Close()
+	result, err = client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(storageKey),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", start, end)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file object range: %w", err)
+	}
+	return result.Body, nil
+}
+
+// encryptPart seals one part of a multipart upload with the parameters saved when the upload was created
+func (e *envelopeEncryption) encryptPart(ctx context.Context, encoded string, partNumber int64, body io.Reader) ([]byte, error) {
+	var params encryptionParams
+	if err := json.Unmarshal([]byte(encoded), &params); err != nil {
+		return nil, fmt.Errorf("invalid upload encryption parameters: %w", err)
+	}
+	object, err := e.object(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(newEncryptingReader(body, object, uint32(partNumber)))
+}
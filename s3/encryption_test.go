@@ -0,0 +1,197 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sealedChunkSize = EncryptionChunkSize + encryptionTagSize
+
+// testObject возвращает параметры шифрования объекта со случайным ключом
+func testObject(t *testing.T, partSize, size int64, finalMarker bool) *objectEncryption {
+	t.Helper()
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	aead, err := newAEAD(key)
+	require.NoError(t, err)
+	return &objectEncryption{aead: aead, partSize: partSize, size: size, finalMarker: finalMarker}
+}
+
+func testPlaintext(t *testing.T, size int) []byte {
+	t.Helper()
+	data := make([]byte, size)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	return data
+}
+
+func seal(t *testing.T, object *objectEncryption, plain []byte, part uint32) []byte {
+	t.Helper()
+	sealed, err := io.ReadAll(newEncryptingReader(bytes.NewReader(plain), object, part))
+	require.NoError(t, err)
+	return sealed
+}
+
+func open(object *objectEncryption, sealed []byte, first, skip, limit int64) ([]byte, error) {
+	return io.ReadAll(newDecryptingReader(io.NopCloser(bytes.NewReader(sealed)), object, first, skip, limit))
+}
+
+func TestEncryptionRoundTrip(t *testing.T) {
+	sizes := []int{0, 1, EncryptionChunkSize - 1, EncryptionChunkSize, EncryptionChunkSize + 1, 3 * EncryptionChunkSize, 3*EncryptionChunkSize + 5}
+	for _, finalMarker := range []bool{true, false} {
+		for _, size := range sizes {
+			object := testObject(t, 0, 0, finalMarker)
+			plain := testPlaintext(t, size)
+
+			sealed := seal(t, object, plain, 0)
+			if finalMarker {
+				assert.Equal(t, encryptedSize(int64(size)), int64(len(sealed)), "size %d", size)
+			}
+			assert.Equal(t, int64(size), decryptedSize(int64(len(sealed))), "size %d", size)
+
+			opened, err := open(object, sealed, 0, 0, -1)
+			require.NoError(t, err, "size %d", size)
+			assert.True(t, bytes.Equal(plain, opened), "size %d", size)
+		}
+	}
+}
+
+func TestEncryptionTruncation(t *testing.T) {
+	object := testObject(t, 0, 0, true)
+	plain := testPlaintext(t, 3*EncryptionChunkSize+5)
+	sealed := seal(t, object, plain, 0)
+
+	tests := []struct {
+		name   string
+		sealed []byte
+	}{
+		{name: "empty stream", sealed: nil},
+		{name: "at first chunk boundary", sealed: sealed[:sealedChunkSize]},
+		{name: "at last chunk boundary", sealed: sealed[:3*sealedChunkSize]},
+		{name: "inside a chunk", sealed: sealed[:2*sealedChunkSize+100]},
+		{name: "without the tag of the final chunk", sealed: sealed[:len(sealed)-encryptionTagSize]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := open(object, tt.sealed, 0, 0, -1)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("exact multiple of the chunk size", func(t *testing.T) {
+		plain := testPlaintext(t, 2*EncryptionChunkSize)
+		sealed := seal(t, object, plain, 0)
+		_, err := open(object, sealed[:sealedChunkSize], 0, 0, -1)
+		assert.Error(t, err)
+	})
+
+	t.Run("legacy objects are read without the final chunk check", func(t *testing.T) {
+		legacy := testObject(t, 0, 0, false)
+		sealed := seal(t, legacy, plain, 0)
+		opened, err := open(legacy, sealed[:sealedChunkSize], 0, 0, -1)
+		require.NoError(t, err)
+		assert.Equal(t, plain[:EncryptionChunkSize], opened)
+	})
+}
+
+func TestEncryptionTamper(t *testing.T) {
+	object := testObject(t, 0, 0, true)
+	plain := testPlaintext(t, 2*EncryptionChunkSize+10)
+	sealed := seal(t, object, plain, 0)
+
+	tampered := bytes.Clone(sealed)
+	tampered[EncryptionChunkSize+3] ^= 0x01
+	_, err := open(object, tampered, 0, 0, -1)
+	assert.Error(t, err, "flipped byte")
+
+	swapped := append(bytes.Clone(sealed[sealedChunkSize:2*sealedChunkSize]), sealed[:sealedChunkSize]...)
+	swapped = append(swapped, sealed[2*sealedChunkSize:]...)
+	_, err = open(object, swapped, 0, 0, -1)
+	assert.Error(t, err, "reordered chunks")
+
+	extended := append(bytes.Clone(sealed), sealed[:sealedChunkSize]...)
+	_, err = open(object, extended, 0, 0, -1)
+	assert.Error(t, err, "data after the final chunk")
+
+	other := testObject(t, 0, 0, true)
+	_, err = open(other, sealed, 0, 0, -1)
+	assert.Error(t, err, "wrong key")
+}
+
+func TestEncryptionRangeRead(t *testing.T) {
+	object := testObject(t, 0, 0, true)
+	size := 4*EncryptionChunkSize + 100
+	plain := testPlaintext(t, size)
+	sealed := seal(t, object, plain, 0)
+
+	ranges := []struct {
+		name       string
+		start, end int64
+	}{
+		{name: "first byte", start: 0, end: 0},
+		{name: "inside one chunk", start: 10, end: 200},
+		{name: "across chunks", start: EncryptionChunkSize - 5, end: 2*EncryptionChunkSize + 7},
+		{name: "full chunk", start: EncryptionChunkSize, end: 2*EncryptionChunkSize - 1},
+		{name: "tail of the object", start: 4*EncryptionChunkSize - 3, end: int64(size) - 1},
+	}
+	for _, tt := range ranges {
+		t.Run(tt.name, func(t *testing.T) {
+			// Как в getEncryptedObjectRange: запрашиваются только блоки, покрывающие диапазон
+			firstChunk, lastChunk := tt.start/EncryptionChunkSize, tt.end/EncryptionChunkSize
+			stored := sealed[firstChunk*sealedChunkSize : min((lastChunk+1)*sealedChunkSize, int64(len(sealed)))]
+			opened, err := open(object, stored, firstChunk, tt.start-firstChunk*EncryptionChunkSize, tt.end-tt.start+1)
+			require.NoError(t, err)
+			assert.Equal(t, plain[tt.start:tt.end+1], opened)
+		})
+	}
+
+	t.Run("range cut short by a truncated object", func(t *testing.T) {
+		stored := sealed[2*sealedChunkSize : 4*sealedChunkSize]
+		_, err := open(object, stored, 2, 0, int64(size)-2*EncryptionChunkSize)
+		assert.Error(t, err)
+	})
+}
+
+func TestEncryptionMultipart(t *testing.T) {
+	partSize := int64(2 * EncryptionChunkSize)
+	size := 2*partSize + 300
+	plain := testPlaintext(t, int(size))
+	object := testObject(t, partSize, size, true)
+
+	var sealed []byte
+	var parts [][]byte
+	for part := uint32(1); int64(part-1)*partSize < size; part++ {
+		from := int64(part-1) * partSize
+		parts = append(parts, seal(t, object, plain[from:min(from+partSize, size)], part))
+		sealed = append(sealed, parts[len(parts)-1]...)
+	}
+	require.Len(t, parts, 3)
+
+	opened, err := open(object, sealed, 0, 0, -1)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(plain, opened))
+
+	// Без последней части объект обрывается на границе части
+	_, err = open(object, sealed[:len(parts[0])+len(parts[1])], 0, 0, -1)
+	assert.Error(t, err)
+
+	t.Run("size at a part boundary", func(t *testing.T) {
+		size := 2 * partSize
+		plain := testPlaintext(t, int(size))
+		object := testObject(t, partSize, size, true)
+		sealed := append(seal(t, object, plain[:partSize], 1), seal(t, object, plain[partSize:], 2)...)
+
+		opened, err := open(object, sealed, 0, 0, -1)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(plain, opened))
+
+		_, err = open(object, sealed[:2*sealedChunkSize], 0, 0, -1)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,129 @@
+package s3
+
+import (
+	"context"
+	"main/utils"
+	"os"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// ClientRegionHeader заголовок, которым gateway передает регион клиента по геолокации (например, APAC, EU, US)
+	ClientRegionHeader = "X-Client-Region"
+	// endpointAccelerate значение в S3_PRESIGN_REGION_ENDPOINTS: использовать S3 Transfer Acceleration
+	endpointAccelerate = "accelerate"
+	// endpointDefault значение в S3_PRESIGN_REGION_ENDPOINTS: использовать основной endpoint бакета
+	endpointDefault = "default"
+)
+
+// clientRegionKey ключ региона клиента в контексте
+type clientRegionKey struct{}
+
+// WithClientRegion сохраняет в контексте регион клиента, переданный gateway
+func WithClientRegion(ctx context.Context, region string) context.Context {
+	region = strings.ToUpper(strings.TrimSpace(region))
+	if region == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, clientRegionKey{}, region)
+}
+
+// GetClientRegion возвращает регион клиента из контекста (пустая строка, если gateway его не передал)
+func GetClientRegion(ctx context.Context) string {
+	region, _ := ctx.Value(clientRegionKey{}).(string)
+	return region
+}
+
+// regionalEndpoint endpoint для pre-signed URL клиентов из региона
+type regionalEndpoint struct {
+	// Accelerate использовать endpoint S3 Transfer Acceleration (<bucket>.s3-accelerate.amazonaws.com)
+	Accelerate bool
+	// Endpoint собственный endpoint (прокси или CDN перед бакетом); пустой - основной endpoint бакета
+	Endpoint string
+}
+
+var (
+	regionalEndpointsOnce sync.Once
+	regionalEndpoints     map[string]regionalEndpoint
+)
+
+// getRegionalEndpoints читает S3_PRESIGN_REGION_ENDPOINTS один раз.
+// Формат: "APAC=accelerate,US=https://files-us.example.com,EU=default".
+func getRegionalEndpoints() map[string]regionalEndpoint {
+	regionalEndpointsOnce.Do(func() {
+		regionalEndpoints = parseRegionalEndpoints(os.Getenv("S3_PRESIGN_REGION_ENDPOINTS"))
+	})
+	return regionalEndpoints
+}
+
+// parseRegionalEndpoints разбирает список "регион=endpoint"; некорректные элементы пропускаются с предупреждением
+func parseRegionalEndpoints(value string) map[string]regionalEndpoint {
+	endpoints := make(map[string]regionalEndpoint)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		region, target, ok := strings.Cut(item, "=")
+		region = strings.ToUpper(strings.TrimSpace(region))
+		target = strings.TrimSpace(target)
+		if !ok || region == "" || target == "" {
+			utils.Logger.Warn("Invalid S3_PRESIGN_REGION_ENDPOINTS entry, skipping", zap.String("entry", item))
+			continue
+		}
+
+		switch strings.ToLower(target) {
+		case endpointAccelerate:
+			endpoints[region] = regionalEndpoint{Accelerate: true}
+		case endpointDefault:
+			endpoints[region] = regionalEndpoint{}
+		default:
+			if !strings.HasPrefix(target, "http://") && !strings.HasPrefix(target, "https://") {
+				utils.Logger.Warn("S3 regional endpoint must be an http(s) URL, skipping",
+					zap.String("region", region),
+					zap.String("endpoint", target))
+				continue
+			}
+			endpoints[region] = regionalEndpoint{Endpoint: target}
+		}
+	}
+	return endpoints
+}
+
+// presignConfig возвращает конфигурацию для pre-signed URL с учетом региона клиента.
+// Бакет остается тем же, меняется только endpoint, через который клиент обращается к объекту.
+// Переопределенная для запроса или sandbox тенанта конфигурация используется без изменений.
+func (s *S3Service) presignConfig(ctx context.Context, config *S3Config) *S3Config {
+	region := GetClientRegion(ctx)
+	if region == "" || s.hasConfigOverride(ctx) {
+		return config
+	}
+
+	endpoint, ok := getRegionalEndpoints()[region]
+	if !ok {
+		return config
+	}
+
+	presign := *config
+	switch {
+	case endpoint.Accelerate:
+		// Transfer Acceleration работает только с AWS S3 и virtual-hosted адресацией
+		if config.Endpoint != "" {
+			utils.Logger.Warn("S3 Transfer Acceleration is not available for custom endpoints, using default endpoint",
+				zap.String("client_region", region))
+			return config
+		}
+		presign.UseAccelerate = true
+	case endpoint.Endpoint != "":
+		presign.Endpoint = endpoint.Endpoint
+		presign.UseSSL = strings.HasPrefix(endpoint.Endpoint, "https://")
+		presign.UseAccelerate = false
+	default:
+		presign.UseAccelerate = false
+	}
+	return &presign
+}
@@ -0,0 +1,183 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// CompletedPart описывает загруженную часть multipart загрузки
+type CompletedPart struct {
+	PartNumber int64
+	ETag       string
+}
+
+// MultipartUpload начатая multipart загрузка
+type MultipartUpload struct {
+	StorageKey string
+	UploadID   string
+	// Encryption параметры шифрования частей (пусто без шифрования). S3 не отдает метаданные
+	// незавершенной загрузки, поэтому они хранятся вместе с состоянием загрузки
+	Encryption string
+}
+
+// CreateMultipartUpload начинает multipart загрузку. partSize - размер всех частей, кроме последней;
+// при включенном шифровании он должен быть кратен EncryptionChunkSize. size - размер всего файла,
+// по нему шифрование находит последний блок объекта
+func (s *S3Service) CreateMultipartUpload(ctx context.Context, originalName, contentType string, partSize, size int64) (*MultipartUpload, error) {
+	config, err := s.getS3Config(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	tenantPrefix, err := s.getTenantPrefix(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant prefix: %w", err)
+	}
+
+	client, err := s.getS3Client(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	upload := &MultipartUpload{StorageKey: tenantPrefix + s.generateStorageKey(originalName)}
+	input := &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(config.Bucket),
+		Key:         aws.String(upload.StorageKey),
+		ContentType: aws.String(contentType),
+	}
+	if s.encryption != nil {
+		params, err := s.encryption.newObjectParams(ctx, client, config.Bucket, upload.StorageKey, partSize, size)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare encryption: %w", err)
+		}
+		encoded, err := json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode encryption parameters: %w", err)
+		}
+		upload.Encryption = string(encoded)
+		input.Metadata = params.metadata()
+	}
+
+	result, err := client.CreateMultipartUploadWithContext(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+
+	upload.UploadID = aws.StringValue(result.UploadId)
+	return upload, nil
+}
+
+// UploadPart загружает часть multipart загрузки и возвращает ее ETag.
+// contentMD5 (base64, может быть пустым) передается как Content-MD5: S3 отклонит часть, поврежденную при передаче.
+// Зашифрованная часть шифруется в памяти, Content-MD5 в этом случае считается по шифротексту.
+func (s *S3Service) UploadPart(ctx context.Context, upload *MultipartUpload, partNumber int64, body io.ReadSeeker, contentMD5 string) (string, error) {
+	config, err := s.getS3Config(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	client, err := s.getS3Client(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	input := &s3.UploadPartInput{
+		Bucket:     aws.String(config.Bucket),
+		Key:        aws.String(upload.StorageKey),
+		UploadId:   aws.String(upload.UploadID),
+		PartNumber: aws.Int64(partNumber),
+		Body:       body,
+	}
+	if contentMD5 != "" {
+		input.ContentMD5 = aws.String(contentMD5)
+	}
+	if upload.Encryption != "" {
+		if s.encryption == nil {
+			return "", fmt.Errorf("upload is encrypted, but S3 encryption is not configured")
+		}
+		sealed, err := s.encryption.encryptPart(ctx, upload.Encryption, partNumber, body)
+		if err != nil {
+			return "", fmt.Errorf("failed to encrypt part: %w", err)
+		}
+		input.Body = bytes.NewReader(sealed)
+		if contentMD5 != "" {
+			sum := md5.Sum(sealed)
+			input.ContentMD5 = aws.String(base64.StdEncoding.EncodeToString(sum[:]))
+		}
+	}
+
+	result, err := client.UploadPartWithContext(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload part: %w", err)
+	}
+
+	return aws.StringValue(result.ETag), nil
+}
+
+// CompleteMultipartUpload собирает объект из загруженных частей
+func (s *S3Service) CompleteMultipartUpload(ctx context.Context, storageKey, uploadID string, parts []CompletedPart) error {
+	config, err := s.getS3Config(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	client, err := s.getS3Client(config)
+	if err != nil {
+		return fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	sorted := make([]CompletedPart, len(parts))
+	copy(sorted, parts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].PartNumber < sorted[j].PartNumber })
+
+	completed := make([]*s3.CompletedPart, 0, len(sorted))
+	for _, part := range sorted {
+		completed = append(completed, &s3.CompletedPart{
+			PartNumber: aws.Int64(part.PartNumber),
+			ETag:       aws.String(part.ETag),
+		})
+	}
+
+	if _, err := client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(config.Bucket),
+		Key:             aws.String(storageKey),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	}); err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+
+	return nil
+}
+
+// AbortMultipartUpload отменяет multipart загрузку и освобождает загруженные части
+func (s *S3Service) AbortMultipartUpload(ctx context.Context, storageKey, uploadID string) error {
+	config, err := s.getS3Config(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	client, err := s.getS3Client(config)
+	if err != nil {
+		return fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	if _, err := client.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(config.Bucket),
+		Key:      aws.String(storageKey),
+		UploadId: aws.String(uploadID),
+	}); err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+
+	return nil
+}
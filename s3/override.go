@@ -0,0 +1,166 @@
+package s3
+
+import (
+	"context"
+	"main/utils"
+	"os"
+	"strings"
+	"sync"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ConfigOverride переопределение конфигурации S3 для одного запроса: интеграционные тесты и sandbox тенанты
+// работают с MinIO, пока основная конфигурация процесса указывает на production S3.
+// Пустые поля (и nil) берутся из основной конфигурации.
+type ConfigOverride struct {
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	Endpoint  string
+	UseSSL    *bool
+	PathStyle string
+}
+
+// configOverrideKey ключ переопределения конфигурации в контексте
+type configOverrideKey struct{}
+
+// WithConfigOverride сохраняет в контексте переопределение конфигурации S3 (интеграционные тесты).
+// В production (ENV или GO_ENV=production) переопределение не применяется.
+func WithConfigOverride(ctx context.Context, override ConfigOverride) context.Context {
+	return context.WithValue(ctx, configOverrideKey{}, override)
+}
+
+// sandboxOverride переопределение конфигурации для sandbox тенантов из окружения
+type sandboxOverride struct {
+	tenantIDs map[uuid.UUID]struct{}
+	override  ConfigOverride
+}
+
+var (
+	sandboxOnce    sync.Once
+	processSandbox *sandboxOverride
+)
+
+// getSandboxOverride читает один раз на процесс S3_SANDBOX_TENANT_IDS (через запятую) и S3_SANDBOX_REGION,
+// S3_SANDBOX_BUCKET, S3_SANDBOX_ACCESS_KEY, S3_SANDBOX_SECRET_KEY, S3_SANDBOX_ENDPOINT, S3_SANDBOX_USE_SSL,
+// S3_SANDBOX_PATH_STYLE. Возвращает nil, если sandbox тенанты не заданы или сервис запущен в production.
+func getSandboxOverride() *sandboxOverride {
+	sandboxOnce.Do(func() {
+		tenantIDs := os.Getenv("S3_SANDBOX_TENANT_IDS")
+		if strings.TrimSpace(tenantIDs) == "" {
+			return
+		}
+		if utils.IsProduction() {
+			utils.Logger.Warn("S3_SANDBOX_TENANT_IDS is ignored in production")
+			return
+		}
+
+		override := ConfigOverride{
+			Region:    os.Getenv("S3_SANDBOX_REGION"),
+			Bucket:    os.Getenv("S3_SANDBOX_BUCKET"),
+			AccessKey: os.Getenv("S3_SANDBOX_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SANDBOX_SECRET_KEY"),
+			Endpoint:  os.Getenv("S3_SANDBOX_ENDPOINT"),
+			PathStyle: os.Getenv("S3_SANDBOX_PATH_STYLE"),
+		}
+		if value := os.Getenv("S3_SANDBOX_USE_SSL"); value != "" {
+			useSSL := value == "true" || value == "1"
+			override.UseSSL = &useSSL
+		}
+		processSandbox = newSandboxOverride(tenantIDs, override)
+	})
+	return processSandbox
+}
+
+// newSandboxOverride разбирает список sandbox тенантов; некорректные ID пропускаются с предупреждением
+func newSandboxOverride(tenantIDs string, override ConfigOverride) *sandboxOverride {
+	sandbox := &sandboxOverride{tenantIDs: make(map[uuid.UUID]struct{}), override: override}
+	for _, value := range strings.Split(tenantIDs, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		tenantID, err := uuid.Parse(value)
+		if err != nil {
+			utils.Logger.Warn("Invalid tenant ID in S3_SANDBOX_TENANT_IDS, skipping", zap.String("tenant_id", value))
+			continue
+		}
+		sandbox.tenantIDs[tenantID] = struct{}{}
+	}
+	if len(sandbox.tenantIDs) == 0 {
+		return nil
+	}
+	return sandbox
+}
+
+// configOverride возвращает переопределение для контекста: явное из WithConfigOverride, иначе sandbox
+// тенанта из контекста федерации. Фоновые задачи без тенанта в контексте работают с основной конфигурацией.
+func (s *S3Service) configOverride(ctx context.Context) (ConfigOverride, bool) {
+	override, ok := ctx.Value(configOverrideKey{}).(ConfigOverride)
+	if ok {
+		if utils.IsProduction() {
+			utils.Logger.Warn("S3 config override is not allowed in production, ignoring",
+				zap.String("endpoint", override.Endpoint),
+				zap.String("bucket", override.Bucket))
+			return ConfigOverride{}, false
+		}
+		return override, true
+	}
+
+	if s.sandbox == nil {
+		return ConfigOverride{}, false
+	}
+	tenantID := federation.GetTenantID(ctx)
+	if tenantID == nil {
+		return ConfigOverride{}, false
+	}
+	if _, sandbox := s.sandbox.tenantIDs[*tenantID]; !sandbox {
+		return ConfigOverride{}, false
+	}
+	return s.sandbox.override, true
+}
+
+// hasConfigOverride сообщает, действует ли в контексте переопределение конфигурации
+func (s *S3Service) hasConfigOverride(ctx context.Context) bool {
+	_, ok := s.configOverride(ctx)
+	return ok
+}
+
+// applyConfigOverride применяет к копии конфигурации переопределение для контекста
+func (s *S3Service) applyConfigOverride(ctx context.Context, config *S3Config) *S3Config {
+	override, ok := s.configOverride(ctx)
+	if !ok {
+		return config
+	}
+
+	if override.Region != "" {
+		config.Region = override.Region
+	}
+	if override.Bucket != "" {
+		config.Bucket = override.Bucket
+		// S3_OBJECT_LOCK_ENABLED описывает основной бакет
+		config.ObjectLockEnabled = false
+	}
+	if override.AccessKey != "" {
+		config.AccessKey = override.AccessKey
+	}
+	if override.SecretKey != "" {
+		config.SecretKey = override.SecretKey
+	}
+	if override.Endpoint != "" {
+		config.Endpoint = override.Endpoint
+		// Transfer Acceleration недоступна для собственного endpoint
+		config.UseAccelerate = false
+	}
+	if override.UseSSL != nil {
+		config.UseSSL = *override.UseSSL
+	}
+	if override.PathStyle != "" {
+		config.PathStyle = override.PathStyle
+	}
+	return config
+}
@@ -0,0 +1,138 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"main/utils"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testOverrideService сервис с основной конфигурацией production S3 и sandbox конфигурацией MinIO
+func testOverrideService(t *testing.T, sandbox *sandboxOverride) *S3Service {
+	t.Helper()
+	utils.Logger = zap.NewNop()
+	t.Setenv("ENV", "")
+	t.Setenv("GO_ENV", "")
+	return &S3Service{
+		config: &S3Config{
+			Region:            "eu-central-1",
+			Bucket:            "prod-files",
+			AccessKey:         "prod-key",
+			SecretKey:         "prod-secret",
+			UseSSL:            true,
+			PathStyle:         "auto",
+			StorageLimitBytes: 100,
+			UseAccelerate:     true,
+			ObjectLockEnabled: true,
+		},
+		sandbox: sandbox,
+	}
+}
+
+func minioOverride() ConfigOverride {
+	useSSL := false
+	return ConfigOverride{
+		Endpoint:  "http://localhost:9000",
+		Bucket:    "test-files",
+		AccessKey: "minioadmin",
+		SecretKey: "minioadmin",
+		UseSSL:    &useSSL,
+		PathStyle: "path",
+	}
+}
+
+func tenantContext(tenantID uuid.UUID) context.Context {
+	return federation.WithContext(context.Background(), &federation.Context{TenantID: &tenantID})
+}
+
+func TestConfigOverride(t *testing.T) {
+	service := testOverrideService(t, nil)
+
+	t.Run("without override", func(t *testing.T) {
+		config, err := service.getS3Config(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, *service.config, *config)
+		assert.False(t, service.hasConfigOverride(context.Background()))
+	})
+
+	t.Run("merges set fields over the main config", func(t *testing.T) {
+		ctx := WithConfigOverride(context.Background(), minioOverride())
+		config, err := service.getS3Config(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, "http://localhost:9000", config.Endpoint)
+		assert.Equal(t, "test-files", config.Bucket)
+		assert.Equal(t, "minioadmin", config.AccessKey)
+		assert.Equal(t, "minioadmin", config.SecretKey)
+		assert.False(t, config.UseSSL)
+		assert.Equal(t, "path", config.PathStyle)
+		assert.False(t, config.UseAccelerate, "acceleration is not available for a custom endpoint")
+		assert.False(t, config.ObjectLockEnabled, "object lock describes the main bucket")
+		// Незаданные поля берутся из основной конфигурации
+		assert.Equal(t, "eu-central-1", config.Region)
+		assert.Equal(t, int64(100), config.StorageLimitBytes)
+		assert.True(t, service.hasConfigOverride(ctx))
+
+		// Основная конфигурация не меняется
+		assert.Equal(t, "prod-files", service.config.Bucket)
+	})
+
+	t.Run("empty override keeps the main config", func(t *testing.T) {
+		config, err := service.getS3Config(WithConfigOverride(context.Background(), ConfigOverride{}))
+		require.NoError(t, err)
+		assert.Equal(t, *service.config, *config)
+	})
+
+	for _, env := range []string{"ENV", "GO_ENV"} {
+		t.Run(fmt.Sprintf("ignored with %s=production", env), func(t *testing.T) {
+			t.Setenv(env, "production")
+			ctx := WithConfigOverride(context.Background(), minioOverride())
+			config, err := service.getS3Config(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, *service.config, *config)
+			assert.False(t, service.hasConfigOverride(ctx))
+		})
+	}
+}
+
+func TestSandboxConfigOverride(t *testing.T) {
+	sandboxTenant, otherTenant := uuid.New(), uuid.New()
+	sandbox := newSandboxOverride(fmt.Sprintf(" %s, not-a-uuid,", sandboxTenant), minioOverride())
+	require.NotNil(t, sandbox)
+	require.Len(t, sandbox.tenantIDs, 1)
+	service := testOverrideService(t, sandbox)
+
+	t.Run("sandbox tenant uses the sandbox storage", func(t *testing.T) {
+		config, err := service.getS3Config(tenantContext(sandboxTenant))
+		require.NoError(t, err)
+		assert.Equal(t, "test-files", config.Bucket)
+		assert.Equal(t, "http://localhost:9000", config.Endpoint)
+	})
+
+	t.Run("other tenants and contexts without tenant use the main storage", func(t *testing.T) {
+		for _, ctx := range []context.Context{tenantContext(otherTenant), context.Background()} {
+			config, err := service.getS3Config(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, *service.config, *config)
+		}
+	})
+
+	t.Run("explicit override takes precedence", func(t *testing.T) {
+		ctx := WithConfigOverride(tenantContext(sandboxTenant), ConfigOverride{Bucket: "integration-files"})
+		config, err := service.getS3Config(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "integration-files", config.Bucket)
+		assert.Empty(t, config.Endpoint)
+	})
+
+	t.Run("no valid tenant IDs disables sandbox", func(t *testing.T) {
+		assert.Nil(t, newSandboxOverride("not-a-uuid, ", minioOverride()))
+	})
+}
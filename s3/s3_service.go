@@ -1,9 +1,12 @@
 package s3
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"main/errcatalog"
 	"main/utils"
 	"os"
 	"path/filepath"
@@ -12,6 +15,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -24,6 +28,10 @@ import (
 // S3Service handles S3 operations for tenant files
 type S3Service struct {
 	config *S3Config
+	// encryption client-side encryption of tenant objects (nil when disabled, see encryption.go)
+	encryption *envelopeEncryption
+	// sandbox S3 configuration override for sandbox tenants (nil when disabled, see override.go)
+	sandbox *sandboxOverride
 }
 
 // S3Config contains S3 configuration from environment variables
@@ -36,6 +44,10 @@ type S3Config struct {
 	UseSSL            bool
 	PathStyle         string
 	StorageLimitBytes int64
+	// UseAccelerate включает S3 Transfer Acceleration (только AWS S3 без собственного endpoint)
+	UseAccelerate bool
+	// ObjectLockEnabled бакет создан с S3 Object Lock (WORM): разрешены удержание и legal hold объектов
+	ObjectLockEnabled bool
 }
 
 // getEnv returns environment variable or default value
@@ -75,10 +87,34 @@ func NewS3Service() *S3Service {
 		UseSSL:            getEnvBool("S3_USE_SSL", true),
 		PathStyle:         getEnv("S3_PATH_STYLE", "auto"),
 		StorageLimitBytes: getEnvInt64("S3_STORAGE_LIMIT_BYTES", -1),
+		UseAccelerate:     getEnvBool("S3_USE_ACCELERATE", false),
+		ObjectLockEnabled: getEnvBool("S3_OBJECT_LOCK_ENABLED", false),
 	}
 
 	return &S3Service{
-		config: config,
+		config:     config,
+		encryption: getEncryption(),
+		sandbox:    getSandboxOverride(),
+	}
+}
+
+// StorageLimitBytes returns the per-tenant storage limit in bytes (negative means unlimited)
+func (s *S3Service) StorageLimitBytes() int64 {
+	return s.config.StorageLimitBytes
+}
+
+// ConfigSummary returns the effective S3 configuration without credentials, for startup logging
+func (s *S3Service) ConfigSummary() map[string]interface{} {
+	return map[string]interface{}{
+		"region":          s.config.Region,
+		"bucket":          s.config.Bucket,
+		"endpoint":        s.config.Endpoint,
+		"use_ssl":         s.config.UseSSL,
+		"path_style":      s.config.PathStyle,
+		"use_accelerate":  s.config.UseAccelerate,
+		"object_lock":     s.config.ObjectLockEnabled,
+		"storage_limit":   s.config.StorageLimitBytes,
+		"credentials_set": s.config.AccessKey != "" && s.config.SecretKey != "",
 	}
 }
 
@@ -102,6 +138,8 @@ func (s *S3Service) getS3Client(config *S3Config) (*s3.S3, error) {
 		if config.PathStyle == "path" || config.PathStyle == "auto" {
 			awsConfig.S3ForcePathStyle = aws.Bool(true)
 		}
+	} else if config.UseAccelerate {
+		awsConfig.S3UseAccelerate = aws.Bool(true)
 	}
 
 	sess, err := session.NewSession(awsConfig)
@@ -112,13 +150,8 @@ func (s *S3Service) getS3Client(config *S3Config) (*s3.S3, error) {
 	return s3.New(sess), nil
 }
 
-// getS3Config returns S3 configuration from service config
+// getS3Config returns S3 configuration from service config with the context override applied
 func (s *S3Service) getS3Config(ctx context.Context) (*S3Config, error) {
-	// Validate configuration
-	if s.config.AccessKey == "" || s.config.SecretKey == "" || s.config.Bucket == "" {
-		return nil, fmt.Errorf("S3 credentials are not configured")
-	}
-
 	// Copy config for this context
 	config := &S3Config{
 		Region:            s.config.Region,
@@ -129,6 +162,14 @@ func (s *S3Service) getS3Config(ctx context.Context) (*S3Config, error) {
 		UseSSL:            s.config.UseSSL,
 		PathStyle:         s.config.PathStyle,
 		StorageLimitBytes: s.config.StorageLimitBytes,
+		UseAccelerate:     s.config.UseAccelerate,
+		ObjectLockEnabled: s.config.ObjectLockEnabled,
+	}
+	config = s.applyConfigOverride(ctx, config)
+
+	// Validate configuration
+	if config.AccessKey == "" || config.SecretKey == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("S3 credentials are not configured")
 	}
 
 	return config, nil
@@ -171,6 +212,7 @@ func (s *S3Service) UploadFile(ctx context.Context, fileContent io.Reader, origi
 		zap.String("endpoint", config.Endpoint),
 		zap.Bool("use_ssl", config.UseSSL),
 		zap.String("path_style", config.PathStyle),
+		zap.Bool("use_accelerate", config.UseAccelerate),
 		zap.String("tenant_prefix", tenantPrefix),
 		zap.Bool("has_access_key", config.AccessKey != ""),
 		zap.Bool("has_secret_key", config.SecretKey != ""))
@@ -188,22 +230,42 @@ func (s *S3Service) UploadFile(ctx context.Context, fileContent io.Reader, origi
 	// Generate unique storage key with tenant prefix
 	storageKey := tenantPrefix + s.generateStorageKey(originalName)
 
-	// Create uploader
-	uploader := s3manager.NewUploaderWithClient(client)
+	// Create uploader. Незавершенная multipart загрузка прерывается ниже отдельным контекстом:
+	// при отмене ctx (остановка сервиса) встроенная очистка s3manager не выполнилась бы
+	uploader := s3manager.NewUploaderWithClient(client, func(u *s3manager.Uploader) {
+		u.LeavePartsOnError = true
+	})
 
 	utils.Logger.Info("Starting S3 upload",
 		zap.String("filename", originalName),
 		zap.String("storage_key", storageKey),
 		zap.String("content_type", contentType))
 
-	// Upload file
-	result, err := uploader.Upload(&s3manager.UploadInput{
+	input := &s3manager.UploadInput{
 		Bucket:      aws.String(config.Bucket),
 		Key:         aws.String(storageKey),
 		Body:        fileContent,
 		ContentType: aws.String(contentType),
-	})
+	}
+	if s.encryption != nil {
+		object, metadata, err := s.encryption.encryptObject(ctx, client, config.Bucket, storageKey, 0)
+		if err != nil {
+			utils.Logger.Error("Failed to prepare S3 object encryption",
+				zap.Error(err),
+				zap.String("storage_key", storageKey))
+			return "", fmt.Errorf("failed to prepare encryption: %w", err)
+		}
+		input.Body = newEncryptingReader(fileContent, object, 0)
+		input.Metadata = metadata
+	}
+
+	// Upload file
+	result, err := uploader.UploadWithContext(ctx, input)
 	if err != nil {
+		var multipartErr s3manager.MultiUploadFailure
+		if errors.As(err, &multipartErr) {
+			s.abortFailedUpload(client, config.Bucket, storageKey, multipartErr.UploadID())
+		}
 		utils.Logger.Error("S3 upload operation failed",
 			zap.Error(err),
 			zap.String("filename", originalName),
@@ -221,6 +283,29 @@ func (s *S3Service) UploadFile(ctx context.Context, fileContent io.Reader, origi
 	return storageKey, nil
 }
 
+// abortFailedUpload прерывает multipart загрузку, оставшуюся после ошибки или отмены, чтобы части не занимали место
+func (s *S3Service) abortFailedUpload(client *s3.S3, bucket, storageKey, uploadID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := client.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(storageKey),
+		UploadId: aws.String(uploadID),
+	})
+	if err != nil {
+		utils.Logger.Error("Failed to abort multipart upload",
+			zap.Error(err),
+			zap.String("storage_key", storageKey),
+			zap.String("upload_id", uploadID))
+		return
+	}
+
+	utils.Logger.Info("Multipart upload aborted",
+		zap.String("storage_key", storageKey),
+		zap.String("upload_id", uploadID))
+}
+
 // DeleteFile deletes a file from S3
 func (s *S3Service) DeleteFile(ctx context.Context, storageKey string) error {
 	config, err := s.getS3Config(ctx)
@@ -244,22 +329,129 @@ func (s *S3Service) DeleteFile(ctx context.Context, storageKey string) error {
 	return nil
 }
 
-// GetPresignedURL generates a presigned URL for file access
+// DeleteObjectsWithPrefix deletes every object whose key starts with prefix (derived files of one source object)
+// and returns the number of deleted objects
+func (s *S3Service) DeleteObjectsWithPrefix(ctx context.Context, prefix string) (int, error) {
+	config, err := s.getS3Config(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	client, err := s.getS3Client(config)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	deleted := 0
+	var deleteErr error
+	err = client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(config.Bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if len(page.Contents) == 0 {
+			return true
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, len(page.Contents))
+		for _, object := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+		}
+		if _, deleteErr = client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(config.Bucket),
+			Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		}); deleteErr != nil {
+			return false
+		}
+		deleted += len(objects)
+		return true
+	})
+	if deleteErr != nil {
+		return deleted, fmt.Errorf("failed to delete objects: %w", deleteErr)
+	}
+	if err != nil {
+		return deleted, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// PutObject uploads a small object under an explicit storage key (derived files such as thumbnails)
+func (s *S3Service) PutObject(ctx context.Context, storageKey string, body io.ReadSeeker, contentType string) error {
+	config, err := s.getS3Config(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	client, err := s.getS3Client(config)
+	if err != nil {
+		return fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(config.Bucket),
+		Key:         aws.String(storageKey),
+		Body:        body,
+		ContentType: aws.String(contentType),
+	}
+	if s.encryption != nil {
+		object, metadata, err := s.encryption.encryptObject(ctx, client, config.Bucket, storageKey, 0)
+		if err != nil {
+			return fmt.Errorf("failed to prepare encryption: %w", err)
+		}
+		sealed, err := io.ReadAll(newEncryptingReader(body, object, 0))
+		if err != nil {
+			return fmt.Errorf("failed to encrypt object: %w", err)
+		}
+		input.Body = bytes.NewReader(sealed)
+		input.Metadata = metadata
+	}
+
+	if _, err := client.PutObjectWithContext(ctx, input); err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+
+	return nil
+}
+
+// PresignOptions overrides response headers of a presigned GET request
+type PresignOptions struct {
+	// ResponseContentDisposition is returned as Content-Disposition (empty keeps the stored object metadata)
+	ResponseContentDisposition string
+	// ResponseContentType is returned as Content-Type (empty keeps the stored object metadata)
+	ResponseContentType string
+}
+
+// GetPresignedURL generates a presigned URL for file access.
+// The URL host is selected by the client region (see S3_PRESIGN_REGION_ENDPOINTS).
 func (s *S3Service) GetPresignedURL(ctx context.Context, storageKey string, expiration time.Duration) (string, error) {
+	return s.GetPresignedURLWithOptions(ctx, storageKey, expiration, PresignOptions{})
+}
+
+// GetPresignedURLWithOptions generates a presigned URL for file access with response header overrides.
+// The overrides are part of the signature, so the client cannot change them.
+// With encryption enabled the URL returns the stored ciphertext of tenant objects (see EncryptionEnabled).
+func (s *S3Service) GetPresignedURLWithOptions(ctx context.Context, storageKey string, expiration time.Duration, options PresignOptions) (string, error) {
 	config, err := s.getS3Config(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get S3 config: %w", err)
 	}
 
-	client, err := s.getS3Client(config)
+	client, err := s.getS3Client(s.presignConfig(ctx, config))
 	if err != nil {
 		return "", fmt.Errorf("failed to create S3 client: %w", err)
 	}
 
-	req, _ := client.GetObjectRequest(&s3.GetObjectInput{
+	input := &s3.GetObjectInput{
 		Bucket: aws.String(config.Bucket),
 		Key:    aws.String(storageKey),
-	})
+	}
+	if options.ResponseContentDisposition != "" {
+		input.ResponseContentDisposition = aws.String(options.ResponseContentDisposition)
+	}
+	if options.ResponseContentType != "" {
+		input.ResponseContentType = aws.String(options.ResponseContentType)
+	}
+
+	req, _ := client.GetObjectRequest(input)
 
 	url, err := req.Presign(expiration)
 	if err != nil {
@@ -364,10 +556,35 @@ func (s *S3Service) GetFileInfo(ctx context.Context, storageKey string) (*s3.Hea
 	if err != nil {
 		return nil, fmt.Errorf("failed to get file info: %w", err)
 	}
+	// ContentLength of encrypted objects is reported for the plaintext
+	if metadataValue(result.Metadata, metaEncryption) != "" && result.ContentLength != nil {
+		result.ContentLength = aws.Int64(decryptedSize(*result.ContentLength))
+	}
 
 	return result, nil
 }
 
+// HeadBucket проверяет доступность бакета с текущими учетными данными (без изменения данных)
+func (s *S3Service) HeadBucket(ctx context.Context) error {
+	config, err := s.getS3Config(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	client, err := s.getS3Client(config)
+	if err != nil {
+		return fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	if _, err := client.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(config.Bucket),
+	}); err != nil {
+		return fmt.Errorf("failed to head bucket: %w", err)
+	}
+
+	return nil
+}
+
 // GetFileObject получает файл из S3 как поток для чтения
 func (s *S3Service) GetFileObject(ctx context.Context, storageKey string) (io.ReadCloser, error) {
 	config, err := s.getS3Config(ctx)
@@ -387,6 +604,45 @@ func (s *S3Service) GetFileObject(ctx context.Context, storageKey string) (io.Re
 	if err != nil {
 		return nil, fmt.Errorf("failed to get file object: %w", err)
 	}
+	if s.encryption == nil {
+		return result.Body, nil
+	}
+
+	object, err := s.encryption.objectFromMetadata(ctx, result.Metadata)
+	if err != nil {
+		result.Body.Close()
+		return nil, fmt.Errorf("failed to open encrypted file object: %w", err)
+	}
+	if object == nil {
+		return result.Body, nil
+	}
+	return newDecryptingReader(result.Body, object, 0, 0, -1), nil
+}
+
+// GetFileObjectRange streams bytes start..end (inclusive, as in the HTTP Range header) of the object
+func (s *S3Service) GetFileObjectRange(ctx context.Context, storageKey string, start, end int64) (io.ReadCloser, error) {
+	config, err := s.getS3Config(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get S3 config: %w", err)
+	}
+
+	client, err := s.getS3Client(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	if s.encryption != nil {
+		return s.getEncryptedObjectRange(ctx, client, config.Bucket, storageKey, start, end)
+	}
+
+	result, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(config.Bucket),
+		Key:    aws.String(storageKey),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", start, end)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file object range: %w", err)
+	}
 
 	return result.Body, nil
 }
@@ -413,7 +669,7 @@ func (s *S3Service) CheckStorageLimit(ctx context.Context, fileSize int64, curre
 			zap.Int64("file_size", fileSize),
 		)
 
-		return fmt.Errorf("%s", utils.T(ctx, "error.file.storage_not_configured"))
+		return errcatalog.FileStorageNotConfigured(ctx)
 	}
 
 	// Добавляем буфер 10%
@@ -434,17 +690,32 @@ func (s *S3Service) CheckStorageLimit(ctx context.Context, fileSize int64, curre
 			zap.Int64("buffer_limit_bytes", bufferLimit),
 		)
 
-		return fmt.Errorf("%s", utils.T(ctx, "error.file.storage_limit_exceeded", map[string]interface{}{
-			"current_usage_gb": currentUsageGB,
-			"limit_gb":         storageLimitGB,
-		}))
+		unit := utils.T(ctx, "units.storage.gb")
+		return errcatalog.FileStorageLimitExceeded(ctx,
+			unit, strconv.FormatInt(currentUsageGB, 10),
+			strconv.FormatInt(storageLimitGB, 10), unit)
 	}
 
 	return nil
 }
 
-// CheckStorageLimitWithFilename проверяет лимит хранилища с возможностью аудита (для использования в FileService)
-func (s *S3Service) CheckStorageLimitWithFilename(ctx context.Context, fileName string, fileSize int64, currentUsage int64) error {
+// CheckStorageLimitWithFilename проверяет лимит хранилища тенанта storageLimit с возможностью аудита
+// (для использования в FileService; отрицательный лимит - без ограничений)
+func (s *S3Service) CheckStorageLimitWithFilename(ctx context.Context, fileName string, fileSize int64, currentUsage int64, storageLimit int64) error {
+	return s.checkStorageLimit(ctx, fileName, fileSize, currentUsage, storageLimit, -1)
+}
+
+// CheckStorageLimitWithGrace проверяет лимит хранилища для режима квоты с допустимым превышением:
+// использование после загрузки (и размер одного файла) может превышать лимит не более чем на gracePercent процентов
+func (s *S3Service) CheckStorageLimitWithGrace(ctx context.Context, fileName string, fileSize int64, currentUsage int64, storageLimit int64, gracePercent int) error {
+	if gracePercent < 0 {
+		gracePercent = 0
+	}
+	return s.checkStorageLimit(ctx, fileName, fileSize, currentUsage, storageLimit, gracePercent)
+}
+
+// checkStorageLimit проверяет лимит; при отрицательном gracePercent действуют проверки жесткой квоты (буфер 10%)
+func (s *S3Service) checkStorageLimit(ctx context.Context, fileName string, fileSize int64, currentUsage int64, storageLimit int64, gracePercent int) error {
 	// Получаем tenant ID для логирования
 	tenantID := federation.GetTenantID(ctx)
 	if tenantID == nil {
@@ -458,11 +729,9 @@ func (s *S3Service) CheckStorageLimitWithFilename(ctx context.Context, fileName
 		zap.String("tenant_id", tenantID.String()),
 		zap.String("file_name", fileName),
 		zap.Int64("file_size", fileSize),
-		zap.Int64("storage_limit", s.config.StorageLimitBytes),
+		zap.Int64("storage_limit", storageLimit),
 		zap.Int64("current_usage", currentUsage))
 
-	// Получаем лимит хранилища из конфигурации
-	storageLimit := s.config.StorageLimitBytes
 	if storageLimit < 0 {
 		utils.Logger.Info("Storage limit is negative - skipping check",
 			zap.String("tenant_id", tenantID.String()),
@@ -496,8 +765,16 @@ func (s *S3Service) CheckStorageLimitWithFilename(ctx context.Context, fileName
 		limitUnit = utils.T(ctx, "units.storage.mb")
 	}
 
+	// Добавляем буфер 10%, в режиме с допустимым превышением - заданный процент
+	bufferLimit := int64(float64(storageLimit) * 1.1)
+	fileLimit := storageLimit
+	if gracePercent >= 0 {
+		bufferLimit = storageLimit + storageLimit*int64(gracePercent)/100
+		fileLimit = bufferLimit
+	}
+
 	// Сначала проверяем, не больше ли файл сам по себе лимита (когда ничего не загружено)
-	if currentUsage == 0 && fileSize > storageLimit {
+	if currentUsage == 0 && fileSize > fileLimit {
 		// Определяем единицы для размера файла
 		var fileSize64, fileUnit string
 		if fileSize >= 1024*1024*1024 {
@@ -525,9 +802,6 @@ func (s *S3Service) CheckStorageLimitWithFilename(ctx context.Context, fileName
 		}
 	}
 
-	// Добавляем буфер 10%
-	bufferLimit := int64(float64(storageLimit) * 1.1)
-
 	// Проверяем, не превысит ли новый файл лимит с буфером
 	if currentUsage+fileSize > bufferLimit {
 		// Определяем подходящие единицы для текущего использования
@@ -582,6 +856,17 @@ func (e *StorageLimitError) Error() string {
 		e.CurrentUsage64, e.CurrentUnit, e.Limit64, e.LimitUnit)
 }
 
+// IsQuotaExceeded сообщает, что ошибка проверки лимита вызвана превышением квоты
+// (а не ненастроенным хранилищем или отсутствием тенанта)
+func IsQuotaExceeded(err error) bool {
+	switch err.(type) {
+	case *StorageLimitError, *FileTooLargeError:
+		return true
+	default:
+		return false
+	}
+}
+
 // StorageNotConfiguredError представляет ошибку для незастроенного хранилища
 type StorageNotConfiguredError struct {
 	FileName string
@@ -608,7 +893,18 @@ func (e *FileTooLargeError) Error() string {
 		e.FileSize64, e.FileUnit, e.Limit64, e.LimitUnit)
 }
 
-// UploadTemporaryFile uploads a temporary file to S3 with a custom storage key
+// IsNotFoundError проверяет, что ошибка S3 означает отсутствие объекта
+func IsNotFoundError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == s3.ErrCodeNoSuchKey || awsErr.Code() == "NotFound"
+	}
+	return false
+}
+
+// UploadTemporaryFile uploads a temporary file to S3 under the given storage key as is
+// (without tenant prefix), so presigned URLs and scheduled deletion use the same key.
+// Temporary files are never encrypted: they are only served by presigned URLs.
 func (s *S3Service) UploadTemporaryFile(ctx context.Context, fileContent io.Reader, storageKey, contentType string) error {
 	config, err := s.getS3Config(ctx)
 	if err != nil {
@@ -620,23 +916,25 @@ func (s *S3Service) UploadTemporaryFile(ctx context.Context, fileContent io.Read
 		return fmt.Errorf("failed to create S3 client: %w", err)
 	}
 
-	// Get tenant prefix
-	tenantPrefix, err := s.getTenantPrefix(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get tenant prefix: %w", err)
-	}
-
-	// Create uploader
-	uploader := s3manager.NewUploaderWithClient(client)
+	// Create uploader. Незавершенная multipart загрузка прерывается ниже отдельным контекстом:
+	// при отмене ctx (остановка сервиса) встроенная очистка s3manager не выполнилась бы
+	uploader := s3manager.NewUploaderWithClient(client, func(u *s3manager.Uploader) {
+		u.LeavePartsOnError = true
+	})
 
-	// Upload file with tenant prefix
-	_, err = uploader.Upload(&s3manager.UploadInput{
+	// Upload file. Поток без известного размера (например, архив из io.Pipe)
+	// загружается частями, поэтому в памяти держатся только текущие части
+	_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
 		Bucket:      aws.String(config.Bucket),
-		Key:         aws.String(tenantPrefix + storageKey),
+		Key:         aws.String(storageKey),
 		Body:        fileContent,
 		ContentType: aws.String(contentType),
 	})
 	if err != nil {
+		var multipartErr s3manager.MultiUploadFailure
+		if errors.As(err, &multipartErr) {
+			s.abortFailedUpload(client, config.Bucket, storageKey, multipartErr.UploadID())
+		}
 		return fmt.Errorf("failed to upload temporary file: %w", err)
 	}
 
@@ -0,0 +1,37 @@
+package security
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Impersonation сведения о сессии поддержки, выполняемой от имени пользователя тенанта.
+// Контекст федерации в такой сессии содержит пользователя, от имени которого выполняются запросы.
+type Impersonation struct {
+	GrantID uuid.UUID
+	// ImpersonatorID администратор поддержки, фактически выполняющий запросы
+	ImpersonatorID   uuid.UUID
+	ImpersonatorRole string
+}
+
+type impersonationKey struct{}
+
+// WithImpersonation помечает контекст как сессию поддержки от имени пользователя
+func WithImpersonation(ctx context.Context, impersonation *Impersonation) context.Context {
+	return context.WithValue(ctx, impersonationKey{}, impersonation)
+}
+
+// GetImpersonation возвращает сведения о сессии поддержки (nil вне такой сессии)
+func GetImpersonation(ctx context.Context) *Impersonation {
+	impersonation, _ := ctx.Value(impersonationKey{}).(*Impersonation)
+	return impersonation
+}
+
+// GetImpersonatorID возвращает администратора поддержки для записи в аудит (nil вне сессии поддержки)
+func GetImpersonatorID(ctx context.Context) *uuid.UUID {
+	if impersonation := GetImpersonation(ctx); impersonation != nil {
+		return &impersonation.ImpersonatorID
+	}
+	return nil
+}
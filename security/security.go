@@ -42,6 +42,25 @@ func ValidateAdminAccess(ctx context.Context) error {
 	return errors.New("you are not authenticated")
 }
 
+// ValidateOwnerAccess проверяет пользователя на владельца организации.
+// В сессии поддержки от имени пользователя права владельца не действуют.
+func ValidateOwnerAccess(ctx context.Context) error {
+	err := ValidateAuthAccess(ctx)
+	if err != nil {
+		return err
+	}
+
+	if GetImpersonation(ctx) != nil {
+		return errors.New("you are not authenticated")
+	}
+
+	if federation.GetUserRole(ctx) == types.RoleOwner {
+		return nil
+	}
+
+	return errors.New("you are not authenticated")
+}
+
 // ValidateMemberAccess проверяет пользователя на роль сотрудника
 func ValidateMemberAccess(ctx context.Context) error {
 	err := ValidateAuthAccess(ctx)
@@ -0,0 +1,485 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"main/database"
+	"main/ent"
+	"main/ent/enttest"
+	_ "main/ent/runtime"
+	"main/ent/schema/mixin"
+	"main/types"
+	"main/utils"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	contractTestdata = "testdata/contract"
+	contractBucket   = "contract-files"
+)
+
+// Фиксированные ID тестовых данных: в эталонах они остаются как есть, остальные UUID заменяются на <uuid-N>
+var (
+	contractTenantID   = uuid.MustParse("00000000-0000-4000-8000-000000000001")
+	contractUserID     = uuid.MustParse("00000000-0000-4000-8000-000000000002")
+	contractOtherID    = uuid.MustParse("00000000-0000-4000-8000-000000000003")
+	contractReportID   = uuid.MustParse("00000000-0000-4000-8000-000000000101")
+	contractContractID = uuid.MustParse("00000000-0000-4000-8000-000000000102")
+	contractPhotoID    = uuid.MustParse("00000000-0000-4000-8000-000000000103")
+	contractNotesID    = uuid.MustParse("00000000-0000-4000-8000-000000000104")
+	contractMissingID  = uuid.MustParse("00000000-0000-4000-8000-000000000199")
+)
+
+// contractCase операция testdata/contract/<name>.graphql, ответ на которую сравнивается с <name>.golden.json
+type contractCase struct {
+	name      string
+	variables map[string]interface{}
+	// upload - содержимое файла для переменной input.file (GraphQL multipart request)
+	upload *contractUpload
+}
+
+type contractUpload struct {
+	filename    string
+	contentType string
+	content     string
+}
+
+// TestGraphQLContract выполняет типовые операции клиентов на сервере с тестовыми данными и сравнивает
+// ответы с эталонами: рефакторинг резолверов и схемы не должен незаметно менять форму ответов.
+// Эталоны обновляются так: go test ./server -run TestGraphQLContract -update
+func TestGraphQLContract(t *testing.T) {
+	cases := []contractCase{
+		{
+			name: "upload_file",
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{"file": nil, "description": "Contract upload"},
+			},
+			upload: &contractUpload{filename: "hello.txt", contentType: "text/plain", content: "hello contract\n"},
+		},
+		{
+			name: "files_connection",
+			variables: map[string]interface{}{
+				"where":   map[string]interface{}{"mimeTypeHasPrefix": "application/"},
+				"first":   2,
+				"orderBy": []map[string]interface{}{{"field": "CREATE_TIME", "direction": "DESC"}},
+			},
+		},
+		{
+			name:      "get_file_download_url",
+			variables: map[string]interface{}{"id": contractReportID, "disposition": "INLINE"},
+		},
+		{
+			name: "delete_files",
+			variables: map[string]interface{}{
+				"ids": []uuid.UUID{contractReportID, contractNotesID, contractMissingID},
+			},
+		},
+		{
+			name: "tag_files",
+			variables: map[string]interface{}{
+				"fileIds": []uuid.UUID{contractReportID, contractPhotoID, contractMissingID},
+				"tags":    []string{"finance", "q1"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, storage, _ := newContractServer(t)
+
+			query, err := os.ReadFile(filepath.Join(contractTestdata, tc.name+".graphql"))
+			require.NoError(t, err)
+
+			body := executeContractOperation(t, handler, string(query), tc)
+			actual := normalizeContractResponse(t, body, storage.URL)
+
+			goldenPath := filepath.Join(contractTestdata, tc.name+".golden.json")
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(goldenPath, actual, 0o644))
+			}
+
+			expected, err := os.ReadFile(goldenPath)
+			require.NoError(t, err, "golden file is missing, run with -update to create it")
+			assert.Equal(t, string(expected), string(actual))
+		})
+	}
+}
+
+// newContractServer поднимает GraphQL сервер на SQLite в памяти с тестовыми файлами и S3 в памяти процесса.
+// Запросы выполняются от участника (member) тестового тенанта, как будто контекст передал gateway.
+func newContractServer(t *testing.T) (http.Handler, *httptest.Server, *ent.Client) {
+	t.Helper()
+
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	if utils.GetI18nBundle() == nil {
+		bundle, err := InitI18n()
+		require.NoError(t, err)
+		utils.SetI18nBundle(bundle)
+	}
+
+	storage := newContractStorage(t)
+	t.Setenv("S3_ENDPOINT", storage.URL)
+	t.Setenv("S3_BUCKET", contractBucket)
+	t.Setenv("S3_ACCESS_KEY", "contract")
+	t.Setenv("S3_SECRET_KEY", "contract")
+	t.Setenv("S3_USE_SSL", "false")
+	t.Setenv("S3_PATH_STYLE", "path")
+
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { _ = client.Close() })
+	seedContractFiles(t, client, storage)
+
+	graphqlServer := NewGraphQLServer(database.NewClientFromEnt(client, client))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenantID, userID := contractTenantID, contractUserID
+		r = r.WithContext(federation.WithContext(r.Context(), &federation.Context{
+			RequestID: "contract",
+			TenantID:  &tenantID,
+			UserID:    &userID,
+			UserRole:  types.RoleMember,
+			Language:  "en",
+		}))
+		graphqlServer.ServeHTTP(w, r)
+	}), storage.Server, client
+}
+
+// seedContractFiles создает файлы текущего пользователя и чужой файл вместе с объектами в S3
+func seedContractFiles(t *testing.T, client *ent.Client, storage *contractStorage) {
+	t.Helper()
+
+	ctx, cancel := mixin.SkipTenantFilterFor(context.Background(), "contract test fixtures", time.Minute)
+	defer cancel()
+
+	createdAt := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	fixtures := []struct {
+		id        uuid.UUID
+		createdBy uuid.UUID
+		name      string
+		mimeType  string
+		content   string
+	}{
+		{contractReportID, contractUserID, "report.pdf", "application/pdf", "%PDF-1.4 report"},
+		{contractContractID, contractUserID, "contract.pdf", "application/pdf", "%PDF-1.4 contract"},
+		{contractPhotoID, contractUserID, "photo.png", "image/png", "\x89PNG photo"},
+		{contractNotesID, contractOtherID, "notes.txt", "text/plain", "notes of another user"},
+	}
+	for i, fixture := range fixtures {
+		storageKey := fmt.Sprintf("tenants/%s/2026/01/01/%s", contractTenantID, fixture.name)
+		storage.put(storageKey, fixture.mimeType, []byte(fixture.content))
+		require.NoError(t, client.File.Create().
+			SetID(fixture.id).
+			SetTenantID(contractTenantID).
+			SetCreatedBy(fixture.createdBy).
+			SetOriginalName(fixture.name).
+			SetStorageKey(storageKey).
+			SetMimeType(fixture.mimeType).
+			SetSize(int64(len(fixture.content))).
+			SetCreateTime(createdAt.Add(time.Duration(i)*time.Hour)).
+			SetUpdateTime(createdAt.Add(time.Duration(i)*time.Hour)).
+			Exec(ctx))
+	}
+}
+
+// executeContractOperation отправляет операцию JSON запросом или, если есть файл, GraphQL multipart запросом
+func executeContractOperation(t *testing.T, handler http.Handler, query string, tc contractCase) []byte {
+	t.Helper()
+
+	operation, err := json.Marshal(map[string]interface{}{"query": query, "variables": tc.variables})
+	require.NoError(t, err)
+
+	var request *http.Request
+	if tc.upload == nil {
+		request = httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(operation))
+		request.Header.Set("Content-Type", "application/json")
+	} else {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		require.NoError(t, writer.WriteField("operations", string(operation)))
+		require.NoError(t, writer.WriteField("map", `{"0": ["variables.input.file"]}`))
+		part, err := writer.CreatePart(map[string][]string{
+			"Content-Disposition": {fmt.Sprintf(`form-data; name="0"; filename=%q`, tc.upload.filename)},
+			"Content-Type":        {tc.upload.contentType},
+		})
+		require.NoError(t, err)
+		_, err = io.WriteString(part, tc.upload.content)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		request = httptest.NewRequest(http.MethodPost, "/query", &body)
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+	return recorder.Body.Bytes()
+}
+
+var (
+	contractUUIDPattern = regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+	contractTimePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`)
+)
+
+// normalizeContractResponse убирает из ответа значения, которые меняются от запуска к запуску: случайные UUID
+// заменяются на <uuid-N> в порядке появления, время - на <time>, адрес и подпись pre-signed URL - на <s3>
+// и имена параметров. Extensions (токен согласованности, подсказки кэширования) в контракт не входят.
+func normalizeContractResponse(t *testing.T, body []byte, storageURL string) []byte {
+	t.Helper()
+
+	var response map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &response))
+	delete(response, "extensions")
+
+	fixed := map[string]bool{}
+	for _, id := range []uuid.UUID{contractTenantID, contractUserID, contractOtherID, contractReportID,
+		contractContractID, contractPhotoID, contractNotesID, contractMissingID} {
+		fixed[id.String()] = true
+	}
+	placeholders := map[string]string{}
+
+	// Ключи объектов обходятся по алфавиту, как при сериализации, поэтому номера <uuid-N> стабильны
+	var normalize func(value interface{}) interface{}
+	normalize = func(value interface{}) interface{} {
+		switch typed := value.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(typed))
+			for key := range typed {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				typed[key] = normalize(typed[key])
+			}
+			return typed
+		case []interface{}:
+			for i, item := range typed {
+				typed[i] = normalize(item)
+			}
+			return typed
+		case string:
+			if contractTimePattern.MatchString(typed) {
+				return "<time>"
+			}
+			if strings.HasPrefix(typed, storageURL) {
+				typed = normalizePresignedURL(typed)
+			}
+			return contractUUIDPattern.ReplaceAllStringFunc(typed, func(id string) string {
+				if fixed[id] {
+					return id
+				}
+				if _, ok := placeholders[id]; !ok {
+					placeholders[id] = fmt.Sprintf("<uuid-%d>", len(placeholders)+1)
+				}
+				return placeholders[id]
+			})
+		default:
+			return value
+		}
+	}
+
+	var normalized bytes.Buffer
+	encoder := json.NewEncoder(&normalized)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	require.NoError(t, encoder.Encode(normalize(response)))
+	return normalized.Bytes()
+}
+
+// normalizePresignedURL оставляет путь объекта и параметры ответа; параметры подписи X-Amz-* сохраняются только по имени
+func normalizePresignedURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	query := parsed.Query()
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params := make([]string, 0, len(names))
+	for _, name := range names {
+		if strings.HasPrefix(name, "X-Amz-") {
+			params = append(params, name)
+			continue
+		}
+		params = append(params, name+"="+query.Get(name))
+	}
+	return "<s3>" + parsed.Path + "?" + strings.Join(params, "&")
+}
+
+// contractStorage S3-совместимое хранилище в памяти: PUT, GET, HEAD, DELETE объектов, пакетное удаление
+// и ListObjectsV2 по префиксу в path-style адресации (S3_PATH_STYLE=path)
+type contractStorage struct {
+	*httptest.Server
+	mu      sync.Mutex
+	objects map[string]contractObject
+}
+
+type contractObject struct {
+	contentType string
+	data        []byte
+}
+
+func newContractStorage(t *testing.T) *contractStorage {
+	t.Helper()
+
+	storage := &contractStorage{objects: map[string]contractObject{}}
+	storage.Server = httptest.NewServer(http.HandlerFunc(storage.serve))
+	t.Cleanup(storage.Close)
+	return storage
+}
+
+func (s *contractStorage) put(key, contentType string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = contractObject{contentType: contentType, data: data}
+}
+
+func (s *contractStorage) serve(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/"+contractBucket), "/")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Query().Has("delete"):
+		var request struct {
+			Objects []struct {
+				Key string `xml:"Key"`
+			} `xml:"Object"`
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var result strings.Builder
+		result.WriteString("<DeleteResult>")
+		for _, object := range request.Objects {
+			delete(s.objects, object.Key)
+			fmt.Fprintf(&result, "<Deleted><Key>%s</Key></Deleted>", object.Key)
+		}
+		result.WriteString("</DeleteResult>")
+		_, _ = io.WriteString(w, result.String())
+	case r.Method == http.MethodGet && key == "":
+		prefix := r.URL.Query().Get("prefix")
+		keys := make([]string, 0)
+		for objectKey := range s.objects {
+			if strings.HasPrefix(objectKey, prefix) {
+				keys = append(keys, objectKey)
+			}
+		}
+		sort.Strings(keys)
+		var result strings.Builder
+		fmt.Fprintf(&result, "<ListBucketResult><Name>%s</Name><KeyCount>%d</KeyCount><IsTruncated>false</IsTruncated>",
+			contractBucket, len(keys))
+		for _, objectKey := range keys {
+			fmt.Fprintf(&result, "<Contents><Key>%s</Key><Size>%d</Size></Contents>", objectKey, len(s.objects[objectKey].data))
+		}
+		result.WriteString("</ListBucketResult>")
+		_, _ = io.WriteString(w, result.String())
+	case r.Method == http.MethodPut:
+		if source := r.Header.Get("X-Amz-Copy-Source"); source != "" {
+			sourceKey := strings.TrimPrefix(strings.TrimPrefix(source, "/"), contractBucket+"/")
+			if sourceKey, err := url.PathUnescape(sourceKey); err == nil {
+				s.objects[key] = s.objects[sourceKey]
+			}
+			_, _ = io.WriteString(w, `<CopyObjectResult><ETag>"copy"</ETag></CopyObjectResult>`)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.objects[key] = contractObject{contentType: r.Header.Get("Content-Type"), data: data}
+		w.Header().Set("ETag", fmt.Sprintf(`"%x"`, md5.Sum(data)))
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		object, ok := s.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				_, _ = io.WriteString(w, "<Error><Code>NoSuchKey</Code></Error>")
+			}
+			return
+		}
+		w.Header().Set("Content-Type", object.contentType)
+		w.Header().Set("Content-Length", fmt.Sprint(len(object.data)))
+		w.Header().Set("ETag", fmt.Sprintf(`"%x"`, md5.Sum(object.data)))
+		w.Header().Set("Last-Modified", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Format(http.TimeFormat))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(object.data)
+		}
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// TestMutationConsistencyTokenAfterCommit токен согласованности мутации ставится после выполнения резолверов:
+// метка не раньше последней записи мутации, иначе read-your-writes окно начиналось бы до коммита
+func TestMutationConsistencyTokenAfterCommit(t *testing.T) {
+	handler, _, client := newContractServer(t)
+
+	var mu sync.Mutex
+	var lastWrite time.Time
+	client.File.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			value, err := next.Mutate(ctx, m)
+			// Длительная мутация: метка в начале запроса была бы раньше записи
+			time.Sleep(20 * time.Millisecond)
+			mu.Lock()
+			lastWrite = time.Now()
+			mu.Unlock()
+			return value, err
+		})
+	})
+
+	query, err := os.ReadFile(filepath.Join(contractTestdata, "delete_files.graphql"))
+	require.NoError(t, err)
+	body := executeContractOperation(t, handler, string(query), contractCase{
+		variables: map[string]interface{}{"ids": []uuid.UUID{contractReportID}},
+	})
+
+	var response struct {
+		Extensions map[string]interface{} `json:"extensions"`
+	}
+	require.NoError(t, json.Unmarshal(body, &response))
+	token, ok := response.Extensions[database.ConsistencyTokenExtension].(string)
+	require.True(t, ok, string(body))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.False(t, lastWrite.IsZero(), "mutation did not write files")
+	writtenAtMs, err := strconv.ParseInt(token, 10, 64)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, writtenAtMs, lastWrite.UnixMilli())
+}
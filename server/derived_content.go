@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"io"
+	"main/ent"
+	"main/middleware"
+	fileservice "main/services/file"
+	localizationservice "main/services/localization"
+	"main/utils"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ThumbnailHandler отдает готовое превью изображения (GET /files/{id}/thumbnail/{size}, size - small или medium).
+// Используется в ссылках thumbnailUrl, когда хранилище зашифровано и pre-signed ссылка отдала бы шифротекст.
+func ThumbnailHandler() http.HandlerFunc {
+	return derivedContentHandler(func(ctx context.Context, client *ent.Client, fileID uuid.UUID, r *http.Request) (*fileservice.FileContent, error) {
+		size := fileservice.ThumbnailSize(strings.ToUpper(chi.URLParam(r, "size")))
+		return fileservice.NewFileService().OpenThumbnailContent(ctx, client, fileID, size)
+	})
+}
+
+// PreviewHandler отдает готовое превью первой страницы документа (GET /files/{id}/preview)
+func PreviewHandler() http.HandlerFunc {
+	return derivedContentHandler(func(ctx context.Context, client *ent.Client, fileID uuid.UUID, _ *http.Request) (*fileservice.FileContent, error) {
+		return fileservice.NewFileService().OpenPreviewContent(ctx, client, fileID)
+	})
+}
+
+// PDFRenditionHandler отдает готовую PDF-версию документа Office (GET /files/{id}/pdf)
+func PDFRenditionHandler() http.HandlerFunc {
+	return derivedContentHandler(func(ctx context.Context, client *ent.Client, fileID uuid.UUID, _ *http.Request) (*fileservice.FileContent, error) {
+		return fileservice.NewFileService().OpenPDFRenditionContent(ctx, client, fileID)
+	})
+}
+
+// derivedContentHandler отдает производную копию файла, открытую open, с кэшированием по ETag как ImageHandler
+func derivedContentHandler(open func(ctx context.Context, client *ent.Client, fileID uuid.UUID, r *http.Request) (*fileservice.FileContent, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fileID, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			http.Error(w, "invalid file id", http.StatusBadRequest)
+			return
+		}
+
+		db := middleware.GetDBFromContext(r.Context())
+		if db == nil {
+			utils.Logger.Error("Database client not found in context")
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+		ctx := localizationservice.NewLocalizationService().ContextWithTenantLocale(r.Context(), db.Query())
+
+		content, err := open(ctx, db.Mutation(), fileID, r)
+		if err != nil {
+			writeFileContentError(w, fileID, err)
+			return
+		}
+		defer content.Body.Close()
+
+		header := w.Header()
+		header.Set("Content-Type", content.ContentType)
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("Cache-Control", imageCacheControl)
+		if content.ETag != "" {
+			header.Set("ETag", content.ETag)
+			if r.Header.Get("If-None-Match") == content.ETag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+		header.Set("Content-Length", strconv.FormatInt(content.Size, 10))
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.Copy(w, content.Body); err != nil {
+			utils.Logger.Debug("Derived content stream interrupted", zap.Error(err), zap.String("file_id", fileID.String()))
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package server
+
+import (
+	"bytes"
+	"html/template"
+	"main/graph/generated"
+	"main/security"
+	"main/utils"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"go.uber.org/zap"
+)
+
+// Режимы доступа к документации API (DOCS_MODE)
+const (
+	// DocsModePublic документация доступна всем (по умолчанию вне production)
+	DocsModePublic = "public"
+	// DocsModeAdmin только администраторам тенанта или по INTERNAL_API_TOKEN
+	DocsModeAdmin = "admin"
+	// DocsModeDisabled endpoint отключен (по умолчанию в production)
+	DocsModeDisabled = "disabled"
+)
+
+// docsAccessMode возвращает режим доступа к /docs
+func docsAccessMode() string {
+	switch mode := strings.ToLower(strings.TrimSpace(os.Getenv("DOCS_MODE"))); mode {
+	case DocsModePublic, DocsModeAdmin, DocsModeDisabled:
+		return mode
+	case "":
+		if utils.IsProduction() {
+			return DocsModeDisabled
+		}
+		return DocsModePublic
+	default:
+		utils.Logger.Warn("Unknown DOCS_MODE, docs endpoint is disabled", zap.String("mode", mode))
+		return DocsModeDisabled
+	}
+}
+
+// isDocsRequestAllowed проверяет доступ к документации в текущем режиме
+func isDocsRequestAllowed(r *http.Request) bool {
+	switch docsAccessMode() {
+	case DocsModePublic:
+		return true
+	case DocsModeAdmin:
+		return isInternalRequestAuthorized(r) || security.ValidateAdminAccess(r.Context()) == nil
+	default:
+		return false
+	}
+}
+
+// DocsHandler отдает HTML документацию схемы GraphQL, сгенерированную из SDL сервиса.
+// Без доступа endpoint отвечает 404, чтобы не раскрывать его наличие.
+func DocsHandler(w http.ResponseWriter, r *http.Request) {
+	if !isDocsRequestAllowed(r) {
+		http.NotFound(w, r)
+		return
+	}
+
+	page, err := getDocsPage()
+	if err != nil {
+		utils.Logger.Error("Failed to render API docs", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write(page)
+}
+
+// DocsSchemaHandler отдает SDL схемы (то, что описывает /docs) для импорта в клиентские инструменты
+func DocsSchemaHandler(w http.ResponseWriter, r *http.Request) {
+	if !isDocsRequestAllowed(r) {
+		http.NotFound(w, r)
+		return
+	}
+
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf, formatter.WithComments()).FormatSchema(publicDocsSchema())
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write(buf.Bytes())
+}
+
+var (
+	docsPageOnce sync.Once
+	docsPage     []byte
+	docsPageErr  error
+)
+
+// getDocsPage рендерит страницу один раз: схема неизменна в рамках процесса
+func getDocsPage() ([]byte, error) {
+	docsPageOnce.Do(func() {
+		var buf bytes.Buffer
+		docsPageErr = docsTemplate.Execute(&buf, buildDocsView(publicDocsSchema()))
+		docsPage = buf.Bytes()
+	})
+	return docsPage, docsPageErr
+}
+
+// publicDocsSchema возвращает схему сервиса (встроенную в сгенерированный код, файлы схемы не нужны)
+func publicDocsSchema() *ast.Schema {
+	return generated.NewExecutableSchema(generated.Config{}).Schema()
+}
+
+// docsField поле или операция в документации
+type docsField struct {
+	Name        string
+	Description string
+	Type        string
+	TypeName    string
+	Args        []docsField
+	Access      string
+	Deprecated  string
+}
+
+// docsType тип схемы в документации
+type docsType struct {
+	Name        string
+	Kind        string
+	Description string
+	Fields      []docsField
+	Values      []docsField
+}
+
+// docsView данные шаблона документации
+type docsView struct {
+	Queries       []docsField
+	Mutations     []docsField
+	Subscriptions []docsField
+	Types         []docsType
+}
+
+// isInternalDocsType скрывает служебные типы интроспекции и федерации
+func isInternalDocsType(name string) bool {
+	if strings.HasPrefix(name, "_") || strings.HasPrefix(name, "link__") || strings.HasPrefix(name, "federation__") {
+		return true
+	}
+	switch name {
+	case "Query", "Mutation", "Subscription", "FieldSet":
+		return true
+	}
+	return false
+}
+
+// buildDocsView собирает данные для шаблона из AST схемы
+func buildDocsView(schema *ast.Schema) docsView {
+	view := docsView{}
+	if schema.Query != nil {
+		view.Queries = buildDocsFields(schema.Query.Fields)
+	}
+	if schema.Mutation != nil {
+		view.Mutations = buildDocsFields(schema.Mutation.Fields)
+	}
+	if schema.Subscription != nil {
+		view.Subscriptions = buildDocsFields(schema.Subscription.Fields)
+	}
+
+	names := make([]string, 0, len(schema.Types))
+	for name, definition := range schema.Types {
+		if definition.BuiltIn || isInternalDocsType(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		definition := schema.Types[name]
+		item := docsType{
+			Name:        name,
+			Kind:        strings.ToLower(string(definition.Kind)),
+			Description: definition.Description,
+			Fields:      buildDocsFields(definition.Fields),
+		}
+		for _, value := range definition.EnumValues {
+			item.Values = append(item.Values, docsField{
+				Name:        value.Name,
+				Description: value.Description,
+				Deprecated:  deprecationReason(value.Directives),
+			})
+		}
+		view.Types = append(view.Types, item)
+	}
+	return view
+}
+
+// buildDocsFields конвертирует поля, пропуская служебные (__typename, _entities, _service)
+func buildDocsFields(fields ast.FieldList) []docsField {
+	result := make([]docsField, 0, len(fields))
+	for _, field := range fields {
+		if strings.HasPrefix(field.Name, "_") {
+			continue
+		}
+		item := docsField{
+			Name:        field.Name,
+			Description: field.Description,
+			Type:        field.Type.String(),
+			TypeName:    field.Type.Name(),
+			Access:      accessDirective(field.Directives),
+			Deprecated:  deprecationReason(field.Directives),
+		}
+		for _, arg := range field.Arguments {
+			item.Args = append(item.Args, docsField{
+				Name:        arg.Name,
+				Description: arg.Description,
+				Type:        arg.Type.String(),
+				TypeName:    arg.Type.Name(),
+			})
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// accessDirective возвращает требование доступа по директивам @auth/@member/@admin
+func accessDirective(directives ast.DirectiveList) string {
+	for _, name := range []string{"admin", "member", "auth"} {
+		if directives.ForName(name) != nil {
+			return name
+		}
+	}
+	return ""
+}
+
+// deprecationReason возвращает причину @deprecated или пустую строку
+func deprecationReason(directives ast.DirectiveList) string {
+	directive := directives.ForName("deprecated")
+	if directive == nil {
+		return ""
+	}
+	if reason := directive.Arguments.ForName("reason"); reason != nil && reason.Value != nil {
+		return reason.Value.Raw
+	}
+	return "deprecated"
+}
+
+var docsTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Files API</title>
+<style>
+body{font-family:-apple-system,BlinkMacSystemFont,"Segoe UI",sans-serif;margin:0;display:flex;color:#1f2328}
+nav{width:260px;height:100vh;overflow:auto;position:sticky;top:0;padding:16px;border-right:1px solid #d0d7de;box-sizing:border-box;font-size:14px}
+nav a{display:block;color:#0969da;text-decoration:none;padding:2px 0}
+main{flex:1;padding:24px 40px;max-width:960px}
+h2{border-bottom:1px solid #d0d7de;padding-bottom:4px;margin-top:40px}
+.item{margin:16px 0;padding:12px 16px;border:1px solid #d0d7de;border-radius:6px}
+code{font-family:SFMono-Regular,Consolas,monospace;font-size:13px}
+.desc{color:#57606a;white-space:pre-wrap;margin:6px 0}
+.badge{font-size:11px;border-radius:10px;padding:1px 8px;margin-left:6px;background:#ddf4ff;color:#0969da}
+.deprecated{background:#fff8c5;color:#9a6700}
+ul{margin:6px 0;padding-left:20px}
+</style>
+</head>
+<body>
+<nav>
+<strong>Files API</strong>
+<p><a href="/docs/schema.graphql">schema.graphql</a></p>
+<a href="#queries">Queries</a>
+<a href="#mutations">Mutations</a>
+{{if .Subscriptions}}<a href="#subscriptions">Subscriptions</a>{{end}}
+<p><strong>Types</strong></p>
+{{range .Types}}<a href="#type-{{.Name}}">{{.Name}}</a>{{end}}
+</nav>
+<main>
+<h1>Files API</h1>
+{{define "field"}}<div class="item" id="op-{{.Name}}">
+<code><strong>{{.Name}}</strong>{{if .Args}}({{range $i, $a := .Args}}{{if $i}}, {{end}}{{$a.Name}}: <a href="#type-{{$a.TypeName}}">{{$a.Type}}</a>{{end}}){{end}}: <a href="#type-{{.TypeName}}">{{.Type}}</a></code>
+{{if .Access}}<span class="badge">@{{.Access}}</span>{{end}}{{if .Deprecated}}<span class="badge deprecated">deprecated: {{.Deprecated}}</span>{{end}}
+{{if .Description}}<div class="desc">{{.Description}}</div>{{end}}
+{{if .Args}}<ul>{{range .Args}}{{if .Description}}<li><code>{{.Name}}</code> — {{.Description}}</li>{{end}}{{end}}</ul>{{end}}
+</div>{{end}}
+<h2 id="queries">Queries</h2>
+{{range .Queries}}{{template "field" .}}{{end}}
+<h2 id="mutations">Mutations</h2>
+{{range .Mutations}}{{template "field" .}}{{end}}
+{{if .Subscriptions}}<h2 id="subscriptions">Subscriptions</h2>
+{{range .Subscriptions}}{{template "field" .}}{{end}}{{end}}
+<h2>Types</h2>
+{{range .Types}}<div class="item" id="type-{{.Name}}">
+<code>{{.Kind}} <strong>{{.Name}}</strong></code>
+{{if .Description}}<div class="desc">{{.Description}}</div>{{end}}
+{{if .Fields}}<ul>{{range .Fields}}<li><code>{{.Name}}: <a href="#type-{{.TypeName}}">{{.Type}}</a></code>{{if .Access}}<span class="badge">@{{.Access}}</span>{{end}}{{if .Deprecated}}<span class="badge deprecated">deprecated: {{.Deprecated}}</span>{{end}}{{if .Description}}<div class="desc">{{.Description}}</div>{{end}}</li>{{end}}</ul>{{end}}
+{{if .Values}}<ul>{{range .Values}}<li><code>{{.Name}}</code>{{if .Deprecated}}<span class="badge deprecated">deprecated: {{.Deprecated}}</span>{{end}}{{if .Description}}<div class="desc">{{.Description}}</div>{{end}}</li>{{end}}</ul>{{end}}
+</div>{{end}}
+</main>
+</body>
+</html>
+`))
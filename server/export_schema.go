@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -23,8 +24,7 @@ func ExportSchema() error {
 		return err
 	}
 
-	// Make common Relay primitives shareable in this subgraph as well
-	sdl = addShareableToCommonTypes(sdl)
+	sdl = transformFederatedSDL(sdl)
 
 	file, err := os.Create(schemaPath)
 	if err != nil {
@@ -109,6 +109,22 @@ func buildFederatedSDL() (string, error) {
 	return b.String(), nil
 }
 
+// transformFederatedSDL applies the export transformations to the concatenated source SDL
+func transformFederatedSDL(sdl string) string {
+	// Make common Relay primitives shareable in this subgraph as well
+	sdl = addShareableToCommonTypes(sdl)
+
+	// gqlgen-only directives are not part of the published subgraph schema
+	return removeEntityResolverDirective(sdl)
+}
+
+// isTypeDefinition reports whether the trimmed line starts the definition of exactly typeName
+// ("type PageInfo {" matches PageInfo, "type PageInfoExtra {" does not)
+func isTypeDefinition(trimmed, typeName string) bool {
+	rest, ok := strings.CutPrefix(trimmed, "type "+typeName)
+	return ok && (rest == "" || rest[0] == ' ' || rest[0] == '{' || rest[0] == '@')
+}
+
 // addShareableToCommonTypes injects @shareable on the Query and PageInfo type definitions
 // inside the SDL string to mark their fields as shareable across subgraphs.
 // For Query type, it also removes node/nodes fields as they should be defined by the gateway.
@@ -119,6 +135,18 @@ func addShareableToCommonTypes(input string) string {
 	return input
 }
 
+var (
+	entityResolverDefinition = regexp.MustCompile(`(?m)^directive @entityResolver\b.*\n?`)
+	entityResolverUsage      = regexp.MustCompile(`\s*@entityResolver(\([^)]*\))?`)
+)
+
+// removeEntityResolverDirective removes the gqlgen @entityResolver directive (batched entity
+// resolvers), which is a code generation hint and is unknown to Apollo composition.
+func removeEntityResolverDirective(input string) string {
+	input = entityResolverDefinition.ReplaceAllString(input, "")
+	return entityResolverUsage.ReplaceAllString(input, "")
+}
+
 func removeNodeFieldsFromQuery(input string) string {
 	lines := strings.Split(input, "\n")
 	result := []string{}
@@ -130,7 +158,7 @@ func removeNodeFieldsFromQuery(input string) string {
 		trimmed := strings.TrimSpace(line)
 
 		// Check if we're entering the main Query type (not extend)
-		if strings.HasPrefix(trimmed, "type Query") && !strings.Contains(line, "extend") {
+		if isTypeDefinition(trimmed, "Query") {
 			inQueryType = true
 			result = append(result, line)
 			continue
@@ -219,7 +247,7 @@ func addDirectiveToTypeLine(input, typeName, directive string) string {
 		trimmed := strings.TrimSpace(line)
 
 		// Check if this is the main type definition (not an extend)
-		if strings.HasPrefix(trimmed, "type "+typeName) && !strings.Contains(line, "extend") {
+		if isTypeDefinition(trimmed, typeName) {
 			// Check if it already has the directive
 			if strings.Contains(line, directive) {
 				continue
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// updateGolden перезаписывает эталонные файлы: go test ./server -run TestExportSchema -update
+var updateGolden = flag.Bool("update", false, "update golden files of schema export and GraphQL contract tests")
+
+const exportSchemaTestdata = "testdata/export_schema"
+
+// TestExportSchemaTransformations сравнивает результат преобразований SDL с эталонными файлами:
+// testdata/export_schema/<name>.graphql - входная схема, <name>.golden - ожидаемый результат
+func TestExportSchemaTransformations(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join(exportSchemaTestdata, "*.graphql"))
+	require.NoError(t, err)
+	require.NotEmpty(t, inputs)
+
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".graphql")
+		t.Run(name, func(t *testing.T) {
+			source, err := os.ReadFile(input)
+			require.NoError(t, err)
+
+			actual := transformFederatedSDL(string(source))
+
+			goldenPath := filepath.Join(exportSchemaTestdata, name+".golden")
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(goldenPath, []byte(actual), 0o644))
+			}
+
+			expected, err := os.ReadFile(goldenPath)
+			require.NoError(t, err, "golden file is missing, run with -update to create it")
+			assert.Equal(t, string(expected), actual)
+		})
+	}
+}
+
+// TestExportSchemaIdempotent проверяет, что повторное преобразование не меняет результат
+func TestExportSchemaIdempotent(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join(exportSchemaTestdata, "*.graphql"))
+	require.NoError(t, err)
+
+	for _, input := range inputs {
+		source, err := os.ReadFile(input)
+		require.NoError(t, err)
+
+		once := transformFederatedSDL(string(source))
+		assert.Equal(t, once, transformFederatedSDL(once), filepath.Base(input))
+	}
+}
+
+// TestExportSchemaSourceSDL проверяет преобразование реальной схемы сервиса
+func TestExportSchemaSourceSDL(t *testing.T) {
+	// Схема читается относительно корня репозитория
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(".."))
+	defer func() { _ = os.Chdir(wd) }()
+
+	sdl, err := buildFederatedSDL()
+	require.NoError(t, err)
+	require.NotEmpty(t, sdl)
+
+	exported := transformFederatedSDL(sdl)
+
+	assert.Contains(t, exported, "type Query @shareable")
+	assert.Contains(t, exported, "type PageInfo @shareable")
+	assert.NotContains(t, exported, "@entityResolver")
+	for _, line := range strings.Split(exported, "\n") {
+		trimmed := strings.TrimSpace(line)
+		assert.False(t, strings.HasPrefix(trimmed, "node(") || strings.HasPrefix(trimmed, "nodes("),
+			"node/nodes fields must be removed from the exported Query: %q", line)
+	}
+}
+
+func TestIsTypeDefinition(t *testing.T) {
+	tests := []struct {
+		line     string
+		typeName string
+		expected bool
+	}{
+		{line: "type PageInfo {", typeName: "PageInfo", expected: true},
+		{line: "type PageInfo", typeName: "PageInfo", expected: true},
+		{line: "type PageInfo{", typeName: "PageInfo", expected: true},
+		{line: "type PageInfo @shareable {", typeName: "PageInfo", expected: true},
+		{line: "type PageInfoExtra {", typeName: "PageInfo", expected: false},
+		{line: "extend type PageInfo {", typeName: "PageInfo", expected: false},
+		{line: "type QueryResult {", typeName: "Query", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isTypeDefinition(tt.line, tt.typeName))
+		})
+	}
+}
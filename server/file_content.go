@@ -0,0 +1,120 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"main/errcatalog"
+	"main/middleware"
+	fileservice "main/services/file"
+	localizationservice "main/services/localization"
+	"main/utils"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// fileContentErrorStatus HTTP статусы ошибок каталога при отдаче содержимого файла
+var fileContentErrorStatus = map[string]int{
+	errcatalog.KeyUserNotAuthenticated:          http.StatusUnauthorized,
+	errcatalog.KeyFileNotFound:                  http.StatusNotFound,
+	errcatalog.KeyFileViewPermissionDenied:      http.StatusForbidden,
+	errcatalog.KeyFileMalwareBlocked:            http.StatusForbidden,
+	errcatalog.KeyFileScanPending:               http.StatusConflict,
+	errcatalog.KeyFileS3NotConfigured:           http.StatusServiceUnavailable,
+	errcatalog.KeyFileImageTransformInvalid:     http.StatusBadRequest,
+	errcatalog.KeyFileImageTransformUnsupported: http.StatusUnsupportedMediaType,
+}
+
+// FileContentHandler отдает содержимое файла через сервис (GET /files/{id}/content) с поддержкой Range,
+// чтобы видео и аудио воспроизводились с перемоткой без pre-signed ссылок. Права проверяются как для
+// getFileDownloadURL по контексту федерации; типы, небезопасные для открытия в браузере, отдаются как вложение.
+// Параметры disposition=attachment и watermark=true соответствуют аргументам getFileDownloadURL.
+func FileContentHandler(limits HTTPLimits) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fileID, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			http.Error(w, "invalid file id", http.StatusBadRequest)
+			return
+		}
+
+		db := middleware.GetDBFromContext(r.Context())
+		if db == nil {
+			utils.Logger.Error("Database client not found in context")
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+		client := db.Mutation()
+		ctx := localizationservice.NewLocalizationService().ContextWithTenantLocale(r.Context(), db.Query())
+
+		options := fileservice.FileContentOptions{
+			Attachment: r.URL.Query().Get("disposition") == "attachment",
+			Watermark:  r.URL.Query().Get("watermark") == "true",
+		}
+		content, err := fileservice.NewFileService().OpenFileContent(ctx, client, fileID, r.Header.Get("Range"), r.Header.Get("If-Range"), options)
+		if err != nil {
+			writeFileContentError(w, fileID, err)
+			return
+		}
+		defer content.Body.Close()
+
+		size := content.Size
+		header := w.Header()
+		header.Set("Accept-Ranges", "bytes")
+		header.Set("Content-Type", content.ContentType)
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("Cache-Control", "private, no-cache")
+		if content.ETag != "" {
+			header.Set("ETag", content.ETag)
+		}
+		disposition := "attachment"
+		if content.Inline {
+			disposition = "inline"
+		}
+		if value := mime.FormatMediaType(disposition, map[string]string{"filename": content.File.OriginalName}); value != "" {
+			disposition = value
+		}
+		header.Set("Content-Disposition", disposition)
+
+		status, length := http.StatusOK, size
+		if content.Range != nil {
+			status, length = http.StatusPartialContent, content.Range.Length()
+			header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", content.Range.Start, content.Range.End, size))
+		}
+		header.Set("Content-Length", strconv.FormatInt(length, 10))
+
+		// Общий WriteTimeout оборвал бы отдачу больших файлов
+		setRequestDeadlines(w, r, 0, limits.downloadTimeout(length))
+
+		w.WriteHeader(status)
+		if _, err := io.Copy(w, content.Body); err != nil {
+			utils.Logger.Debug("File content stream interrupted", zap.Error(err), zap.String("file_id", fileID.String()))
+		}
+	}
+}
+
+// writeFileContentError отвечает статусом по ошибке каталога с локализованным текстом
+func writeFileContentError(w http.ResponseWriter, fileID uuid.UUID, err error) {
+	var rangeErr *fileservice.RangeNotSatisfiableError
+	if errors.As(err, &rangeErr) {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", rangeErr.Size))
+		http.Error(w, http.StatusText(http.StatusRequestedRangeNotSatisfiable), http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+
+	status := http.StatusInternalServerError
+	var catalogErr *errcatalog.Error
+	if errors.As(err, &catalogErr) {
+		if mapped, ok := fileContentErrorStatus[catalogErr.Key]; ok {
+			status = mapped
+		}
+	}
+	if status == http.StatusInternalServerError {
+		utils.Logger.Error("Failed to serve file content", zap.Error(err), zap.String("file_id", fileID.String()))
+	}
+	http.Error(w, err.Error(), status)
+}
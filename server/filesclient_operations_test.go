@@ -0,0 +1,88 @@
+package server
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+
+	"main/graph/generated"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+)
+
+// filesClientOperations путь к операциям клиента filesclient (отдельный модуль, поэтому читается исходник)
+const filesClientOperations = "../filesclient/operations.go"
+
+// TestFilesClientOperations проверяет операции клиента filesclient по схеме сервиса:
+// изменение схемы, ломающее клиента, должно обнаруживаться здесь, а не у потребителей
+func TestFilesClientOperations(t *testing.T) {
+	operations := filesClientOperationDocuments(t)
+	require.NotEmpty(t, operations)
+
+	schema := generated.NewExecutableSchema(generated.Config{}).Schema()
+	for name, document := range operations {
+		t.Run(name, func(t *testing.T) {
+			query, errs := gqlparser.LoadQuery(schema, document)
+			require.Empty(t, errs, "operation %s does not match the schema", name)
+			assert.Len(t, query.Operations, 1)
+		})
+	}
+}
+
+// filesClientOperationDocuments возвращает документы операций (константы *Query и *Mutation),
+// вычисляя конкатенацию строковых констант, например операция + фрагмент
+func filesClientOperationDocuments(t *testing.T) map[string]string {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), filesClientOperations, nil, 0)
+	require.NoError(t, err)
+
+	values := make(map[string]ast.Expr)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for i, name := range valueSpec.Names {
+				if i < len(valueSpec.Values) {
+					values[name.Name] = valueSpec.Values[i]
+				}
+			}
+		}
+	}
+
+	var evaluate func(expr ast.Expr) string
+	evaluate = func(expr ast.Expr) string {
+		switch expr := expr.(type) {
+		case *ast.BasicLit:
+			value, err := strconv.Unquote(expr.Value)
+			require.NoError(t, err)
+			return value
+		case *ast.Ident:
+			value, ok := values[expr.Name]
+			require.True(t, ok, "unknown constant %s", expr.Name)
+			return evaluate(value)
+		case *ast.BinaryExpr:
+			require.Equal(t, token.ADD, expr.Op)
+			return evaluate(expr.X) + evaluate(expr.Y)
+		case *ast.ParenExpr:
+			return evaluate(expr.X)
+		}
+		t.Fatalf("unsupported constant expression %T", expr)
+		return ""
+	}
+
+	documents := make(map[string]string)
+	for name, value := range values {
+		if strings.HasSuffix(name, "Query") || strings.HasSuffix(name, "Mutation") {
+			documents[name] = evaluate(value)
+		}
+	}
+	return documents
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"errors"
+	"main/config"
+	"main/middleware"
+	tenantservice "main/services/tenant"
+	"main/utils"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultMaxHeaderBytes максимальный размер заголовков запроса
+	defaultMaxHeaderBytes = 1 << 20
+	// defaultMaxJSONBodyBytes максимальный размер тела обычного (не multipart) запроса
+	defaultMaxJSONBodyBytes int64 = 1 << 20
+	// multipartOverheadBytes запас на operations/map и границы частей multipart запроса сверх лимита размера файла
+	multipartOverheadBytes int64 = 1 << 20
+	// defaultUploadMinRate минимальная скорость загрузки (байт/с), ниже которой клиент считается медленным
+	defaultUploadMinRate int64 = 64 << 10
+)
+
+// HTTPLimits таймауты и ограничения размера запросов HTTP сервера
+type HTTPLimits struct {
+	// ReadHeaderTimeout время на чтение заголовков (защита от slowloris)
+	ReadHeaderTimeout time.Duration
+	// IdleTimeout время жизни keep-alive соединения без запросов
+	IdleTimeout time.Duration
+	// MaxHeaderBytes максимальный размер заголовков
+	MaxHeaderBytes int
+	// ReadTimeout время на чтение тела обычного запроса
+	ReadTimeout time.Duration
+	// WriteTimeout время на обработку и запись ответа обычного запроса
+	WriteTimeout time.Duration
+	// MaxJSONBodyBytes максимальный размер тела обычного запроса (/query JSON, внутренние API)
+	MaxJSONBodyBytes int64
+	// UploadTimeout базовое время загрузки файла; увеличивается пропорционально размеру тела
+	UploadTimeout time.Duration
+	// UploadMaxTimeout верхняя граница времени загрузки
+	UploadMaxTimeout time.Duration
+	// UploadMinRate минимальная ожидаемая скорость загрузки (байт/с)
+	UploadMinRate int64
+}
+
+// LoadHTTPLimits читает настройки из окружения; некорректные значения заменяются значениями по умолчанию
+func LoadHTTPLimits() HTTPLimits {
+	return HTTPLimits{
+		ReadHeaderTimeout: envDuration("HTTP_READ_HEADER_TIMEOUT", 10*time.Second),
+		IdleTimeout:       envDuration("HTTP_IDLE_TIMEOUT", 120*time.Second),
+		MaxHeaderBytes:    int(envInt64("HTTP_MAX_HEADER_BYTES", defaultMaxHeaderBytes)),
+		ReadTimeout:       envDuration("HTTP_READ_TIMEOUT", 30*time.Second),
+		WriteTimeout:      envDuration("HTTP_WRITE_TIMEOUT", 2*time.Minute),
+		MaxJSONBodyBytes:  envInt64("HTTP_MAX_JSON_BODY_BYTES", defaultMaxJSONBodyBytes),
+		UploadTimeout:     envDuration("HTTP_UPLOAD_TIMEOUT", 2*time.Minute),
+		UploadMaxTimeout:  envDuration("HTTP_UPLOAD_MAX_TIMEOUT", 30*time.Minute),
+		UploadMinRate:     envInt64("HTTP_UPLOAD_MIN_RATE", defaultUploadMinRate),
+	}
+}
+
+// NewHTTPServer создает HTTP сервер с таймаутами на уровне соединения.
+// ReadTimeout/WriteTimeout сервера не задаются: они оборвали бы загрузки и WebSocket подписки,
+// поэтому сроки чтения и записи выставляются для каждого запроса в RequestLimitsMiddleware.
+func NewHTTPServer(addr string, handler http.Handler, limits HTTPLimits) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: limits.ReadHeaderTimeout,
+		IdleTimeout:       limits.IdleTimeout,
+		MaxHeaderBytes:    limits.MaxHeaderBytes,
+	}
+}
+
+// RequestLimitsMiddleware ограничивает размер тела и время обработки запроса по типу запроса:
+//   - WebSocket подписки: без ограничений (соединение долгоживущее);
+//   - multipart загрузки в /query: тело до MAX_TENANT_UPLOAD_SIZE (наибольший лимит, который может задать тенант;
+//     тенант здесь еще не известен, его лимит проверяет TenantUploadLimitMiddleware), время растет с размером тела
+//     (не ниже UploadMinRate);
+//   - остальные запросы: тело до MaxJSONBodyBytes, сроки ReadTimeout и WriteTimeout.
+func RequestLimitsMiddleware(limits HTTPLimits) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isWebSocketUpgrade(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			maxBody := limits.MaxJSONBodyBytes
+			readTimeout, writeTimeout := limits.ReadTimeout, limits.WriteTimeout
+			if r.URL.Path == "/query" && isMultipart(r) {
+				maxBody = config.Get().MaxTenantUploadSize + multipartOverheadBytes
+				readTimeout = limits.uploadTimeout(r.ContentLength, maxBody)
+				writeTimeout = readTimeout + limits.WriteTimeout
+			}
+
+			// Заведомо слишком большое тело отклоняем до чтения
+			if r.ContentLength > maxBody {
+				utils.Logger.Warn("Request body too large",
+					zap.String("path", r.URL.Path),
+					zap.Int64("content_length", r.ContentLength),
+					zap.Int64("limit", maxBody))
+				http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+				return
+			}
+			if r.Body != nil {
+				r.Body = http.MaxBytesReader(w, r.Body, maxBody)
+			}
+
+			setRequestDeadlines(w, r, readTimeout, writeTimeout)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// TenantUploadLimitMiddleware ограничивает тело multipart загрузки в /query действующим лимитом размера файла
+// тенанта (без лимита тенанта - MAX_UPLOAD_SIZE). Подключается после DatabaseMiddleware и FederationMiddleware.
+func TenantUploadLimitMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/query" || !isMultipart(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		maxBody := tenantMaxUploadSize(r) + multipartOverheadBytes
+		if r.ContentLength > maxBody {
+			utils.Logger.Warn("Upload body exceeds tenant limit",
+				zap.Int64("content_length", r.ContentLength),
+				zap.Int64("limit", maxBody))
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if r.Body != nil {
+			r.Body = http.MaxBytesReader(w, r.Body, maxBody)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// tenantMaxUploadSize возвращает действующий лимит размера файла тенанта запроса.
+// Без тенанта или при ошибке чтения настроек - MAX_UPLOAD_SIZE: UploadFile все равно проверит лимит тенанта.
+func tenantMaxUploadSize(r *http.Request) int64 {
+	db := middleware.GetDBFromContext(r.Context())
+	if db == nil || federation.GetTenantID(r.Context()) == nil {
+		return config.Get().MaxUploadSize
+	}
+
+	policy, err := tenantservice.NewTenantUploadPolicyService().GetPolicy(r.Context(), db.Query())
+	if err != nil {
+		utils.Logger.Warn("Failed to get tenant upload policy, using MAX_UPLOAD_SIZE", zap.Error(err))
+		return config.Get().MaxUploadSize
+	}
+	return policy.EffectiveMaxUploadSize()
+}
+
+// uploadTimeout время на чтение загрузки: базовое время плюс передача тела на минимальной скорости
+func (l HTTPLimits) uploadTimeout(contentLength, maxBody int64) time.Duration {
+	size := contentLength
+	if size <= 0 {
+		// Размер неизвестен (chunked) - рассчитываем на максимальный
+		size = maxBody
+	}
+
+	timeout := l.UploadTimeout
+	if l.UploadMinRate > 0 {
+		timeout += time.Duration(size/l.UploadMinRate) * time.Second
+	}
+	if l.UploadMaxTimeout > 0 && timeout > l.UploadMaxTimeout {
+		timeout = l.UploadMaxTimeout
+	}
+	return timeout
+}
+
+// downloadTimeout время на отдачу содержимого файла размера size: базовое время ответа плюс передача
+// на минимальной скорости загрузки (та же граница медленного клиента, что и для загрузки)
+func (l HTTPLimits) downloadTimeout(size int64) time.Duration {
+	timeout := l.WriteTimeout
+	if l.UploadMinRate > 0 {
+		timeout += time.Duration(size/l.UploadMinRate) * time.Second
+	}
+	if l.UploadMaxTimeout > 0 && timeout > l.UploadMaxTimeout {
+		timeout = l.UploadMaxTimeout
+	}
+	return timeout
+}
+
+// setRequestDeadlines выставляет сроки чтения тела и записи ответа для текущего запроса
+func setRequestDeadlines(w http.ResponseWriter, r *http.Request, readTimeout, writeTimeout time.Duration) {
+	controller := http.NewResponseController(w)
+	now := time.Now()
+	if readTimeout > 0 {
+		if err := controller.SetReadDeadline(now.Add(readTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			utils.Logger.Debug("Failed to set read deadline", zap.Error(err), zap.String("path", r.URL.Path))
+		}
+	}
+	if writeTimeout > 0 {
+		if err := controller.SetWriteDeadline(now.Add(writeTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			utils.Logger.Debug("Failed to set write deadline", zap.Error(err), zap.String("path", r.URL.Path))
+		}
+	}
+}
+
+func isWebSocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
+
+func isMultipart(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
+}
+
+// envDuration возвращает длительность из окружения (например 30s); 0 отключает ограничение
+func envDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed < 0 {
+		utils.Logger.Warn("Invalid duration in environment, using default",
+			zap.String("key", key),
+			zap.String("value", value),
+			zap.Duration("default", defaultValue))
+		return defaultValue
+	}
+	return parsed
+}
+
+// envInt64 возвращает положительное число из окружения или значение по умолчанию
+func envInt64(key string, defaultValue int64) int64 {
+	if parsed, err := strconv.ParseInt(os.Getenv(key), 10, 64); err == nil && parsed > 0 {
+		return parsed
+	}
+	return defaultValue
+}
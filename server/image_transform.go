@@ -0,0 +1,71 @@
+package server
+
+import (
+	"io"
+	"main/middleware"
+	fileservice "main/services/file"
+	localizationservice "main/services/localization"
+	"main/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// imageCacheControl производная копия неизменна для своего ETag (в нем контрольная сумма исходного файла),
+// поэтому браузер может хранить ее без повторной проверки; private - ответ зависит от прав пользователя
+const imageCacheControl = "private, max-age=86400"
+
+// ImageHandler отдает уменьшенную копию изображения (GET /files/{id}/image?w=..&h=..&fit=contain|cover),
+// чтобы frontend не скачивал оригиналы в полном разрешении для аватаров и превью. Копии кэшируются в S3.
+func ImageHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fileID, err := uuid.Parse(chi.URLParam(r, "id"))
+		if err != nil {
+			http.Error(w, "invalid file id", http.StatusBadRequest)
+			return
+		}
+
+		db := middleware.GetDBFromContext(r.Context())
+		if db == nil {
+			utils.Logger.Error("Database client not found in context")
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+		ctx := localizationservice.NewLocalizationService().ContextWithTenantLocale(r.Context(), db.Query())
+
+		query := r.URL.Query()
+		transform, err := fileservice.ParseImageTransform(ctx, query.Get("w"), query.Get("h"), query.Get("fit"))
+		if err != nil {
+			writeFileContentError(w, fileID, err)
+			return
+		}
+
+		content, err := fileservice.NewFileService().OpenImageDerivative(ctx, db.Mutation(), fileID, transform)
+		if err != nil {
+			writeFileContentError(w, fileID, err)
+			return
+		}
+		defer content.Body.Close()
+
+		header := w.Header()
+		header.Set("Content-Type", content.ContentType)
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("Cache-Control", imageCacheControl)
+		if content.ETag != "" {
+			header.Set("ETag", content.ETag)
+			if r.Header.Get("If-None-Match") == content.ETag {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+		header.Set("Content-Length", strconv.FormatInt(content.Size, 10))
+
+		w.WriteHeader(http.StatusOK)
+		if _, err := io.Copy(w, content.Body); err != nil {
+			utils.Logger.Debug("Image stream interrupted", zap.Error(err), zap.String("file_id", fileID.String()))
+		}
+	}
+}
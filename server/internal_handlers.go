@@ -0,0 +1,246 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"main/ent"
+	"main/ent/schema/mixin"
+	"main/ent/tenantsetting"
+	"main/middleware"
+	"main/redis"
+	fileservice "main/services/file"
+	tenantservice "main/services/tenant"
+	"main/utils"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// logLevelRequest тело запроса изменения уровня логирования
+type logLevelRequest struct {
+	Level           string `json:"level"`
+	DurationMinutes int    `json:"durationMinutes"`
+}
+
+// LogLevelHandler внутренний endpoint управления уровнем логирования:
+// GET - текущий уровень, POST {"level":"debug","durationMinutes":15} - временное изменение с автоматическим откатом,
+// DELETE - немедленный возврат базового уровня.
+// Доступ по заголовку Authorization: Bearer <INTERNAL_API_TOKEN>; без токена endpoint отключен.
+func LogLevelHandler(w http.ResponseWriter, r *http.Request) {
+	if !isInternalRequestAuthorized(r) {
+		http.NotFound(w, r)
+		return
+	}
+
+	var state utils.LogLevelState
+	switch r.Method {
+	case http.MethodGet:
+		state = utils.CurrentLogLevel()
+	case http.MethodPost, http.MethodPut:
+		var request logLevelRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+		var err error
+		state, err = utils.SetTemporaryLogLevel(request.Level, time.Duration(request.DurationMinutes)*time.Minute)
+		if err != nil {
+			http.Error(w, "invalid log level", http.StatusBadRequest)
+			return
+		}
+	case http.MethodDelete:
+		state = utils.ResetLogLevel()
+	default:
+		w.Header().Set("Allow", "GET, POST, PUT, DELETE")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(state)
+}
+
+// DeprecatedFieldsHandler отдает статистику обращений к устаревшим полям GraphQL по клиентам (INTERNAL_API_TOKEN)
+func DeprecatedFieldsHandler(w http.ResponseWriter, r *http.Request) {
+	if !isInternalRequestAuthorized(r) {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"usages": middleware.DeprecatedFieldUsages(),
+	})
+}
+
+// RedisHotPathHandler отдает состояние деградированного режима Redis и статистику таймаутов команд
+// горячего пути (INTERNAL_API_TOKEN)
+func RedisHotPathHandler(w http.ResponseWriter, r *http.Request) {
+	if !isInternalRequestAuthorized(r) {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(redis.GetHotPathStatus())
+}
+
+// InflightRequestsHandler отдает количество выполняющихся HTTP/GraphQL запросов и WebSocket соединений
+// по операциям и тенантам и самые долгие из них; ?tenantId= отбирает запросы тенанта (INTERNAL_API_TOKEN)
+func InflightRequestsHandler(w http.ResponseWriter, r *http.Request) {
+	if !isInternalRequestAuthorized(r) {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(middleware.GetInflightStats(r.URL.Query().Get("tenantId")))
+}
+
+// TenantFilterSkipsHandler отдает статистику отключений фильтра по тенанту по местам вызова (INTERNAL_API_TOKEN)
+func TenantFilterSkipsHandler(w http.ResponseWriter, r *http.Request) {
+	if !isInternalRequestAuthorized(r) {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"usages": mixin.TenantFilterSkipUsages(),
+	})
+}
+
+// RetenantFilesHandler внутренний endpoint переноса файлов между тенантами при слиянии организаций:
+// POST {"sourceTenantId":"...","targetTenantId":"...","fileIds":["..."],"userId":"...","dryRun":true}.
+// Переносит указанные файлы и/или все файлы пользователя и возвращает отчет по каждому файлу.
+// Рекомендуется сначала выполнить пробный запуск (dryRun). Доступ по INTERNAL_API_TOKEN.
+func RetenantFilesHandler(w http.ResponseWriter, r *http.Request) {
+	if !isInternalRequestAuthorized(r) {
+		http.NotFound(w, r)
+		return
+	}
+
+	var input fileservice.RetenantInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	db := middleware.GetDatabaseClient()
+	if db == nil {
+		http.Error(w, "database is unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	// 🔄 [TRANSACTION] Перенос выполняется в одной транзакции: при ошибке копии в S3 удаляются
+	var report *fileservice.RetenantReport
+	if err := withTx(r.Context(), db.Mutation(), func(txCtx context.Context, client *ent.Client) error {
+		var err error
+		report, err = fileservice.NewFileService().RetenantFiles(txCtx, client, input)
+		return err
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(report)
+}
+
+// tenantQuotaPolicyRequest тело запроса изменения квоты хранилища тенанта
+type tenantQuotaPolicyRequest struct {
+	TenantID          uuid.UUID               `json:"tenantId"`
+	Mode              tenantsetting.QuotaMode `json:"mode"`
+	GracePercent      int                     `json:"gracePercent"`
+	StorageLimitBytes *int64                  `json:"storageLimitBytes"`
+}
+
+// TenantQuotaPolicyHandler внутренний endpoint оператора платформы для изменения квоты хранилища тенанта:
+// POST {"tenantId":"...","mode":"GRACE","gracePercent":20,"storageLimitBytes":null}. Админам тенанта режим
+// доступен только для чтения (tenantQuotaPolicy), иначе SOFT и GRACE позволяли бы обойти лимит платформы.
+// storageLimitBytes null - действует S3_STORAGE_LIMIT_BYTES. Доступ по INTERNAL_API_TOKEN.
+func TenantQuotaPolicyHandler(w http.ResponseWriter, r *http.Request) {
+	if !isInternalRequestAuthorized(r) {
+		http.NotFound(w, r)
+		return
+	}
+
+	var input tenantQuotaPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil || input.TenantID == uuid.Nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	db := middleware.GetDatabaseClient()
+	if db == nil {
+		http.Error(w, "database is unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	// Настройки тенанта выбираются и создаются по тенанту из контекста федерации
+	ctx := federation.WithContext(r.Context(), &federation.Context{TenantID: &input.TenantID})
+	quotaService := tenantservice.NewTenantQuotaService()
+
+	// 🔄 [TRANSACTION]
+	if err := withTx(ctx, db.Mutation(), func(txCtx context.Context, client *ent.Client) error {
+		return quotaService.SetPolicy(txCtx, client, tenantservice.QuotaPolicy{
+			Mode:              input.Mode,
+			GracePercent:      input.GracePercent,
+			StorageLimitBytes: input.StorageLimitBytes,
+		})
+	}); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	utils.Logger.Info("Tenant quota policy changed by operator",
+		zap.String("tenant_id", input.TenantID.String()),
+		zap.String("mode", input.Mode.String()))
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(input)
+}
+
+// withTx выполняет fn в транзакции: коммит при успехе, откат при ошибке или панике.
+// Побочные эффекты сервисов (database.OnCommit/OnRollback) выполняются вместе с завершением транзакции.
+func withTx(ctx context.Context, client *ent.Client, fn func(txCtx context.Context, client *ent.Client) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(ent.NewTxContext(ctx, tx), tx.Client()); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			utils.Logger.Error("Failed to rollback transaction", zap.Error(rollbackErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// isInternalRequestAuthorized проверяет токен внутренних endpoint'ов
+func isInternalRequestAuthorized(r *http.Request) bool {
+	token := os.Getenv("INTERNAL_API_TOKEN")
+	if token == "" {
+		return false
+	}
+	provided, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return found && subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"main/ent"
+	"main/graph/resolvers"
+	"main/middleware"
+	"main/privacy"
+	"main/redis"
+	"main/s3"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SelfTestStatus результат отдельной проверки самотестирования
+type SelfTestStatus string
+
+const (
+	SelfTestStatusOK     SelfTestStatus = "ok"
+	SelfTestStatusFailed SelfTestStatus = "failed"
+)
+
+// SelfTestCheck описывает результат одной проверки
+type SelfTestCheck struct {
+	Name     string         `json:"name"`
+	Status   SelfTestStatus `json:"status"`
+	Error    string         `json:"error,omitempty"`
+	Duration string         `json:"duration"`
+}
+
+// SelfTestReport машиночитаемый отчет самотестирования (-selftest)
+type SelfTestReport struct {
+	Status    SelfTestStatus  `json:"status"`
+	StartedAt time.Time       `json:"startedAt"`
+	Duration  string          `json:"duration"`
+	Checks    []SelfTestCheck `json:"checks"`
+}
+
+// OK возвращает true, если все проверки прошли
+func (r *SelfTestReport) OK() bool {
+	return r.Status == SelfTestStatusOK
+}
+
+// selfTestStep описывает одну неразрушающую проверку
+type selfTestStep struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// selfTestSteps возвращает проверки в порядке выполнения: Redis раньше БД, т.к. БД использует его уровнем кэша
+func selfTestSteps() []selfTestStep {
+	return []selfTestStep{
+		{name: "i18n", run: selfTestI18n},
+		{name: "schema", run: selfTestSchema},
+		{name: "redis", run: selfTestRedis},
+		{name: "database", run: selfTestDatabase},
+		{name: "s3", run: selfTestS3},
+	}
+}
+
+// RunSelfTest последовательно выполняет неразрушающие проверки зависимостей сервиса.
+// Каждая проверка ограничена таймаутом STARTUP_COMPONENT_TIMEOUT; ошибка одной проверки не прерывает остальные.
+func RunSelfTest(ctx context.Context) *SelfTestReport {
+	timeout := getComponentTimeout()
+	report := &SelfTestReport{
+		Status:    SelfTestStatusOK,
+		StartedAt: time.Now().UTC(),
+	}
+
+	for _, step := range selfTestSteps() {
+		started := time.Now()
+		err := runWithTimeout(ctx, timeout, func(stepCtx context.Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("panic: %v", r)
+				}
+			}()
+			return step.run(stepCtx)
+		})
+
+		check := SelfTestCheck{
+			Name:     step.name,
+			Status:   SelfTestStatusOK,
+			Duration: time.Since(started).Round(time.Millisecond).String(),
+		}
+		if err != nil {
+			check.Status = SelfTestStatusFailed
+			check.Error = err.Error()
+			report.Status = SelfTestStatusFailed
+		}
+		report.Checks = append(report.Checks, check)
+	}
+
+	report.Duration = time.Since(report.StartedAt).Round(time.Millisecond).String()
+	return report
+}
+
+// WriteSelfTestReport выводит отчет в формате JSON
+func WriteSelfTestReport(w io.Writer, report *SelfTestReport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// selfTestI18n загружает bundle локализаций
+func selfTestI18n(ctx context.Context) error {
+	bundle, err := InitI18n()
+	if err != nil {
+		return err
+	}
+	if len(bundle.LanguageTags()) == 0 {
+		return fmt.Errorf("no translations loaded")
+	}
+	return nil
+}
+
+// selfTestSchema собирает исполняемую GraphQL схему
+func selfTestSchema(ctx context.Context) error {
+	schema := resolvers.NewSchema(nil).Schema()
+	if schema == nil || schema.Query == nil {
+		return fmt.Errorf("graphql schema has no query type")
+	}
+	return nil
+}
+
+// selfTestRedis выполняет запись, чтение и удаление временного ключа (с шифрованием, если оно включено)
+func selfTestRedis(ctx context.Context) error {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil {
+		return err
+	}
+
+	key := "selftest:" + uuid.NewString()
+	value := []byte(key)
+	if err := cacheService.SetTenantCache(ctx, "", key, value); err != nil {
+		return fmt.Errorf("failed to write test key: %w", err)
+	}
+	defer func() {
+		_ = cacheService.DeleteTenantCache(context.Background(), "", key)
+	}()
+
+	data, err := cacheService.GetTenantCache(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to read test key: %w", err)
+	}
+	if string(data) != string(value) {
+		return fmt.Errorf("redis round trip returned unexpected value")
+	}
+	return nil
+}
+
+// selfTestDatabase подключается к обоим endpoint'ам БД и выполняет читающий запрос
+func selfTestDatabase(ctx context.Context) error {
+	if err := middleware.InitDatabaseClient(ctx); err != nil {
+		return err
+	}
+	db := middleware.GetDatabaseClient()
+
+	systemCtx := privacy.WithSystemContext(ctx)
+	for name, client := range map[string]*ent.Client{"query": db.Query(), "mutation": db.Mutation()} {
+		if _, err := client.File.Query().Limit(1).Exist(ent.NewContext(systemCtx, client)); err != nil {
+			return fmt.Errorf("%s endpoint: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// selfTestS3 проверяет доступ к бакету
+func selfTestS3(ctx context.Context) error {
+	return s3.NewS3Service().HeadBucket(ctx)
+}
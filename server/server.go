@@ -2,14 +2,18 @@ package server
 
 import (
 	"context"
+	"main/config"
 	"main/database"
 	"main/ent"
 	"main/graph/dataloader"
 	"main/graph/resolvers"
 	"main/middleware"
+	fileservice "main/services/file"
+	"main/services/impersonation"
+	localizationservice "main/services/localization"
 	"main/utils"
+	subscriptions "main/websocket"
 	"net/http"
-	"os"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -41,7 +45,7 @@ func LoggingMiddleware() graphql.OperationMiddleware {
 func NewGraphQLServer(db *database.Client) *handler.Server {
 	// Базовый клиент для схемы — Query
 	srv := handler.New(resolvers.NewSchema(db.Query()))
-	if os.Getenv("ENV") != "production" {
+	if !utils.IsProduction() {
 		srv.Use(extension.Introspection{})
 	}
 
@@ -50,11 +54,12 @@ func NewGraphQLServer(db *database.Client) *handler.Server {
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
 	srv.AddTransport(transport.MultipartForm{
-		MaxMemory:     32 << 20,  // 32MB
-		MaxUploadSize: 100 << 20, // 100MB
+		MaxMemory:     32 << 20,                         // 32MB
+		MaxUploadSize: config.Get().MaxTenantUploadSize, // лимит тенанта проверяет TenantUploadLimitMiddleware
 	})
 
 	// Добавляем WebSocket транспорт для подписок
+	subscriptionLimits := subscriptions.LoadSubscriptionLimits()
 	srv.AddTransport(&transport.Websocket{
 		Upgrader: websocket.Upgrader{
 			CheckOrigin: func(r *http.Request) bool {
@@ -65,24 +70,42 @@ func NewGraphQLServer(db *database.Client) *handler.Server {
 			WriteBufferSize: 1024,
 		},
 		KeepAlivePingInterval: 10,
+		// Лимиты подписок соединения и пользователя, круговая доставка сообщений подписок соединения
+		InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			return subscriptions.WithConnection(ctx, subscriptionLimits), nil, nil
+		},
+		CloseFunc: func(ctx context.Context, closeCode int) {
+			subscriptions.CloseConnection(ctx)
+		},
 	})
 
 	// Выбор клиента по типу операции и инъекция в контекст
 	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
 		opCtx := graphql.GetOperationContext(ctx)
 		if opCtx != nil && opCtx.Operation != nil {
+			// Read-your-writes: токен последней мутации клиента из заголовка или extensions запроса
+			ctx = database.WithConsistencyToken(ctx, consistencyTokenFromRequest(opCtx))
+
+			readClient := db.Query()
+			if database.RequiresPrimary(ctx) {
+				readClient = db.Mutation()
+			}
+
 			var entClient *ent.Client
 			switch opCtx.Operation.Operation {
 			case ast.Query:
-				entClient = db.Query()
+				entClient = readClient
 			case ast.Mutation, ast.Subscription:
 				entClient = db.Mutation()
 			default:
-				entClient = db.Query()
+				entClient = readClient
 			}
 
 			ctx = ent.NewContext(ctx, entClient)
 
+			// Язык тенанта по умолчанию и переопределения терминологии (читаем через Query клиент с кэшем)
+			ctx = localizationservice.NewLocalizationService().ContextWithTenantLocale(ctx, readClient)
+
 			// Инициализируем DataLoader и PreloadCache для Query/Mutation (подписки без PreloadCache)
 			switch opCtx.Operation.Operation {
 			case ast.Query, ast.Mutation:
@@ -99,46 +122,129 @@ func NewGraphQLServer(db *database.Client) *handler.Server {
 		return next(ctx)
 	})
 
+	// Токен согласованности в ответе мутации: метка ставится после выполнения резолверов и коммита их транзакций
+	srv.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		resp := next(ctx)
+		opCtx := graphql.GetOperationContext(ctx)
+		if resp == nil || opCtx == nil || opCtx.Operation == nil || opCtx.Operation.Operation != ast.Mutation {
+			return resp
+		}
+		if resp.Extensions == nil {
+			resp.Extensions = make(map[string]interface{})
+		}
+		resp.Extensions[database.ConsistencyTokenExtension] = database.NewConsistencyToken()
+		return resp
+	})
+
+	// Подсказки кэширования полей с @cacheControl в extensions ответа
+	srv.AroundResponses(middleware.CacheControlMiddleware())
+
 	// Cache control per operation type (query vs mutation)
 	srv.AroundOperations(middleware.GraphQLCacheMiddleware())
 
 	// Logging
 	srv.AroundOperations(LoggingMiddleware())
 
+	// Тип и имя операции в учете выполняющихся запросов
+	srv.AroundOperations(middleware.InflightOperationMiddleware())
+
+	// Сессия поддержки от имени пользователя только на чтение
+	srv.AroundOperations(middleware.ImpersonationScopeMiddleware())
+
+	// Журнал мутаций администраторов и владельцев
+	srv.AroundFields(middleware.OperationAuditMiddleware())
+
+	// Учет потребителей устаревших полей
+	srv.AroundFields(middleware.DeprecatedFieldMiddleware())
+
 	return srv
 }
 
+// consistencyTokenFromRequest возвращает токен согласованности из заголовка или extensions запроса
+func consistencyTokenFromRequest(opCtx *graphql.OperationContext) string {
+	if token := opCtx.Headers.Get(database.ConsistencyTokenHeader); token != "" {
+		return token
+	}
+	if token, ok := opCtx.Extensions[database.ConsistencyTokenExtension].(string); ok {
+		return token
+	}
+	return ""
+}
+
 func SetupRouter() (*chi.Mux, error) {
 	r := chi.NewRouter()
 
-	// i18n initialization
-	bundle, err := InitI18n()
-	if err != nil {
-		return nil, err
+	// i18n initialization (пропускаем, если bundle уже загружен на фазе прогрева)
+	if utils.GetI18nBundle() == nil {
+		bundle, err := InitI18n()
+		if err != nil {
+			return nil, err
+		}
+		// Устанавливаем глобальный bundle для локализации
+		utils.SetI18nBundle(bundle)
 	}
-	// Устанавливаем глобальный bundle для локализации
-	utils.SetI18nBundle(bundle)
 
 	// Global CORS middleware
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   federation.CORSAllowedHeaders,
+		AllowedHeaders:   append([]string{database.ConsistencyTokenHeader, fileservice.UploadProgressHeader, impersonation.TokenHeader}, federation.CORSAllowedHeaders...),
 		ExposedHeaders:   []string{"Link", "X-Request-Id"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
 
+	// Ограничения размера тела и сроков чтения/записи по типу запроса (HTTP_* переменные окружения)
+	limits := LoadHTTPLimits()
+	r.Use(RequestLimitsMiddleware(limits))
+
+	// Состояние компонентов (не зависит от DatabaseMiddleware, чтобы отвечать и без БД)
+	r.Get("/readyz", ReadyzHandler)
+
+	// Внутреннее управление уровнем логирования (INTERNAL_API_TOKEN)
+	r.HandleFunc("/internal/log-level", LogLevelHandler)
+	r.Get("/internal/deprecated-fields", DeprecatedFieldsHandler)
+	r.Get("/internal/tenant-filter-skips", TenantFilterSkipsHandler)
+	r.Get("/internal/redis-hot-path", RedisHotPathHandler)
+	r.Get("/internal/inflight-requests", InflightRequestsHandler)
+
+	// Перенос файлов между тенантами при слиянии организаций (INTERNAL_API_TOKEN)
+	r.Post("/internal/retenant-files", RetenantFilesHandler)
+
+	// Режим квоты и лимит хранилища тенанта задает только оператор платформы (INTERNAL_API_TOKEN)
+	r.Post("/internal/tenant-quota-policy", TenantQuotaPolicyHandler)
+
 	r.Group(func(r chi.Router) {
 		r.Use(middleware.DatabaseMiddleware)
 		// r.Use(HTTPHeadersLoggingMiddleware)
 		r.Use(middleware.FederationMiddleware)
+		// Учет выполняющихся запросов и WebSocket соединений (/internal/inflight-requests, остановка сервиса)
+		r.Use(middleware.InflightRequestsMiddleware)
+		// Сессия поддержки от имени пользователя по токену, выданному владельцем
+		r.Use(middleware.ImpersonationMiddleware)
+		// Размер загрузки по лимиту тенанта (RequestLimitsMiddleware пропускает до MAX_TENANT_UPLOAD_SIZE)
+		r.Use(TenantUploadLimitMiddleware)
+		r.Use(UploadProgressMiddleware)
 
 		// Playground только для не-продакшн окружения
-		if os.Getenv("ENV") != "production" {
+		if !utils.IsProduction() {
 			r.Handle("/", playground.Handler("GraphQL playground", "/query"))
 		}
 
+		// Документация схемы: DOCS_MODE=public|admin|disabled (в production по умолчанию отключена)
+		r.Get("/docs", DocsHandler)
+		r.Get("/docs/schema.graphql", DocsSchemaHandler)
+
+		// Потоковая отдача содержимого файла с поддержкой Range (права по контексту федерации)
+		r.Get("/files/{id}/content", FileContentHandler(limits))
+		// Уменьшенные копии изображений с кэшем в S3 (w, h, fit=contain|cover)
+		r.Get("/files/{id}/image", ImageHandler())
+		// Готовые превью изображений и документов (ссылки thumbnailUrl/previewUrl при шифровании хранилища)
+		r.Get("/files/{id}/thumbnail/{size}", ThumbnailHandler())
+		r.Get("/files/{id}/preview", PreviewHandler())
+		// PDF-версии документов Office (ссылка pdfUrl при шифровании хранилища)
+		r.Get("/files/{id}/pdf", PDFRenditionHandler())
+
 		// Обработчик GraphQL запросов (динамически создаем сервер на каждый запрос)
 		r.HandleFunc("/query", func(w http.ResponseWriter, r *http.Request) {
 			// Получаем client БД из контекста запроса
@@ -0,0 +1,319 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"main/middleware"
+	"main/redis"
+	"main/utils"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ComponentStatus описывает состояние компонента после прогрева
+type ComponentStatus string
+
+const (
+	ComponentStatusPending  ComponentStatus = "pending"
+	ComponentStatusReady    ComponentStatus = "ready"
+	ComponentStatusDegraded ComponentStatus = "degraded"
+	ComponentStatusFailed   ComponentStatus = "failed"
+)
+
+const (
+	// defaultComponentTimeout время на инициализацию одного компонента по умолчанию
+	defaultComponentTimeout = 10 * time.Second
+)
+
+// ComponentState содержит состояние инициализации тяжеловесного синглтона
+type ComponentState struct {
+	Name     string          `json:"name"`
+	Status   ComponentStatus `json:"status"`
+	Optional bool            `json:"optional"`
+	Error    string          `json:"error,omitempty"`
+	Duration string          `json:"duration,omitempty"`
+}
+
+// startupComponent описывает компонент, участвующий в прогреве
+type startupComponent struct {
+	name     string
+	optional bool
+	init     func(ctx context.Context) error
+	// probe проверяет текущее состояние компонента для /readyz
+	probe func() error
+}
+
+var (
+	componentStates = make(map[string]*ComponentState)
+	componentOrder  []string
+	componentProbes = make(map[string]func() error)
+	componentsMutex sync.RWMutex
+)
+
+// IsEagerStartup возвращает true, если зависимости нужно инициализировать при старте (STARTUP_MODE=eager, по умолчанию)
+// В режиме lazy БД и Redis инициализируются при первом запросе, как и раньше
+func IsEagerStartup() bool {
+	return os.Getenv("STARTUP_MODE") != "lazy"
+}
+
+// getComponentTimeout возвращает таймаут инициализации компонента из STARTUP_COMPONENT_TIMEOUT (секунды)
+func getComponentTimeout() time.Duration {
+	if value := os.Getenv("STARTUP_COMPONENT_TIMEOUT"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return defaultComponentTimeout
+}
+
+// startupPhases возвращает компоненты, сгруппированные по фазам.
+// Компоненты одной фазы инициализируются параллельно; БД зависит от Redis (уровень entcache),
+// поэтому вынесена во вторую фазу.
+func startupPhases() [][]startupComponent {
+	return [][]startupComponent{
+		{
+			{
+				name:     "i18n",
+				optional: false,
+				init: func(ctx context.Context) error {
+					bundle, err := InitI18n()
+					if err != nil {
+						return err
+					}
+					utils.SetI18nBundle(bundle)
+					return nil
+				},
+				probe: func() error {
+					if utils.GetI18nBundle() == nil {
+						return fmt.Errorf("i18n bundle is not loaded")
+					}
+					return nil
+				},
+			},
+			{
+				name:     "redis",
+				optional: true,
+				init: func(ctx context.Context) error {
+					_, err := redis.GetTenantCacheService()
+					return err
+				},
+				probe: func() error {
+					svc, err := redis.GetTenantCacheService()
+					if err != nil {
+						return err
+					}
+					if svc.GetClient() == nil {
+						return fmt.Errorf("redis client is nil")
+					}
+					if redis.IsDegraded() {
+						return fmt.Errorf("redis exceeds latency budget, cache is bypassed")
+					}
+					return nil
+				},
+			},
+		},
+		{
+			{
+				name:     "database",
+				optional: false,
+				init: func(ctx context.Context) error {
+					return middleware.InitDatabaseClient(ctx)
+				},
+				probe: func() error {
+					if middleware.GetDatabaseClient() == nil {
+						return fmt.Errorf("database client is not initialized")
+					}
+					return nil
+				},
+			},
+		},
+	}
+}
+
+// Warmup выполняет явную фазу запуска: параллельно инициализирует компоненты с таймаутами.
+// Необязательные компоненты (Redis) при ошибке переводят сервис в degraded режим,
+// обязательные - возвращают ошибку.
+func Warmup(ctx context.Context) error {
+	timeout := getComponentTimeout()
+	var requiredErrors []error
+
+	for _, phase := range startupPhases() {
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+
+		for _, component := range phase {
+			registerComponent(component)
+
+			wg.Add(1)
+			go func(c startupComponent) {
+				defer wg.Done()
+
+				started := time.Now()
+				err := runWithTimeout(ctx, timeout, c.init)
+				state := setComponentResult(c, err, time.Since(started))
+
+				if err != nil && !c.optional {
+					mu.Lock()
+					requiredErrors = append(requiredErrors, fmt.Errorf("%s: %w", c.name, err))
+					mu.Unlock()
+				}
+
+				utils.Logger.Info("Startup component initialized",
+					zap.String("component", c.name),
+					zap.String("status", string(state.Status)),
+					zap.Bool("optional", c.optional),
+					zap.String("duration", state.Duration),
+					zap.String("error", state.Error))
+			}(component)
+		}
+
+		wg.Wait()
+	}
+
+	if IsDegraded() {
+		utils.Logger.Warn("Service started in degraded mode - optional dependencies are unavailable")
+	}
+
+	if len(requiredErrors) > 0 {
+		return fmt.Errorf("required components failed to initialize: %v", requiredErrors)
+	}
+
+	return nil
+}
+
+// runWithTimeout выполняет init с ограничением по времени
+func runWithTimeout(ctx context.Context, timeout time.Duration, init func(ctx context.Context) error) error {
+	initCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- init(initCtx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-initCtx.Done():
+		return fmt.Errorf("initialization timed out after %s", timeout)
+	}
+}
+
+// registerComponent регистрирует компонент в состоянии pending
+func registerComponent(c startupComponent) {
+	componentsMutex.Lock()
+	defer componentsMutex.Unlock()
+
+	if _, exists := componentStates[c.name]; !exists {
+		componentOrder = append(componentOrder, c.name)
+	}
+	componentStates[c.name] = &ComponentState{
+		Name:     c.name,
+		Status:   ComponentStatusPending,
+		Optional: c.optional,
+	}
+	componentProbes[c.name] = c.probe
+}
+
+// RegisterLazyComponents регистрирует компоненты без инициализации (STARTUP_MODE=lazy),
+// чтобы /readyz отражал их состояние после ленивой инициализации первым запросом
+func RegisterLazyComponents() {
+	for _, phase := range startupPhases() {
+		for _, component := range phase {
+			registerComponent(component)
+		}
+	}
+}
+
+// setComponentResult фиксирует результат инициализации компонента
+func setComponentResult(c startupComponent, err error, duration time.Duration) ComponentState {
+	componentsMutex.Lock()
+	defer componentsMutex.Unlock()
+
+	state := componentStates[c.name]
+	state.Status = statusFor(c.optional, err)
+	state.Duration = duration.Round(time.Millisecond).String()
+	state.Error = ""
+	if err != nil {
+		state.Error = err.Error()
+	}
+	return *state
+}
+
+// statusFor возвращает статус компонента по результату инициализации
+func statusFor(optional bool, err error) ComponentStatus {
+	if err == nil {
+		return ComponentStatusReady
+	}
+	if optional {
+		return ComponentStatusDegraded
+	}
+	return ComponentStatusFailed
+}
+
+// ComponentStates возвращает актуальные состояния компонентов.
+// Статус пересчитывается через probe, чтобы отражать переподключения (например, Redis) и ленивую инициализацию.
+func ComponentStates() []ComponentState {
+	// Probe может обращаться к сети, поэтому вызывается вне блокировки: копируем состояния и probe под RLock
+	componentsMutex.RLock()
+	states := make([]ComponentState, 0, len(componentOrder))
+	probes := make([]func() error, 0, len(componentOrder))
+	for _, name := range componentOrder {
+		states = append(states, *componentStates[name])
+		probes = append(probes, componentProbes[name])
+	}
+	componentsMutex.RUnlock()
+
+	for i := range states {
+		state := &states[i]
+		if probe := probes[i]; probe != nil {
+			err := probe()
+			state.Status = statusFor(state.Optional, err)
+			state.Error = ""
+			if err != nil {
+				state.Error = err.Error()
+			}
+		}
+	}
+	return states
+}
+
+// IsDegraded возвращает true, если хотя бы один необязательный компонент недоступен
+func IsDegraded() bool {
+	for _, state := range ComponentStates() {
+		if state.Status == ComponentStatusDegraded {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadyzHandler отдает состояния компонентов. 503 - если обязательный компонент не готов.
+func ReadyzHandler(w http.ResponseWriter, r *http.Request) {
+	states := ComponentStates()
+
+	status := "ready"
+	httpStatus := http.StatusOK
+	for _, state := range states {
+		if state.Status == ComponentStatusDegraded && status == "ready" {
+			status = "degraded"
+		}
+		if !state.Optional && state.Status != ComponentStatusReady {
+			status = "not_ready"
+			httpStatus = http.StatusServiceUnavailable
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"status":     status,
+		"components": states,
+	})
+}
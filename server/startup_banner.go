@@ -0,0 +1,240 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"io/fs"
+	"main/config"
+	"main/database"
+	"main/ent/migrate"
+	"main/graph/resolvers"
+	"main/redis"
+	"main/s3"
+	"main/utils"
+	"net/url"
+	"os"
+	"path"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/formatter"
+	"go.uber.org/zap"
+)
+
+// Версия сборки задается при сборке: go build -ldflags "-X main/server.BuildVersion=1.2.3 -X main/server.BuildCommit=abc123".
+// Без ldflags коммит берется из VCS-информации Go toolchain.
+var (
+	BuildVersion = "dev"
+	BuildCommit  = ""
+)
+
+// startupBannerDependencies модули, версии которых выводятся в сводке запуска
+var startupBannerDependencies = []string{
+	"entgo.io/ent",
+	"github.com/99designs/gqlgen",
+	"github.com/aws/aws-sdk-go",
+	"github.com/esemashko/v2-federation",
+	"github.com/go-redis/redis/v8",
+	"github.com/jackc/pgx/v5",
+}
+
+// migrationStatusTimeout время на чтение примененной ревизии миграций
+const migrationStatusTimeout = 3 * time.Second
+
+// LogStartupBanner один раз выводит структурированную сводку запуска: версию сборки, версии Go и зависимостей,
+// действующую конфигурацию БД, Redis и S3 без секретов, флаги функциональности, состояние миграций и хеш
+// GraphQL схемы. Первичный разбор инцидента начинается с этой записи, а не с поиска по логам инициализации.
+func LogStartupBanner(ctx context.Context) {
+	version, commit, dependencies := buildInfo()
+
+	utils.Logger.Info("Service startup summary",
+		zap.String("version", version),
+		zap.String("commit", commit),
+		zap.String("go_version", runtime.Version()),
+		zap.Any("dependencies", dependencies),
+		zap.String("startup_mode", startupModeName()),
+		zap.Any("database", databaseSummary()),
+		zap.Any("redis", redisSummary()),
+		zap.Any("s3", s3.NewS3Service().ConfigSummary()),
+		zap.Any("runtime_config", config.Get()),
+		zap.Any("migrations", migrationSummary(ctx)),
+		zap.String("schema_hash", schemaHash()))
+}
+
+// buildInfo возвращает версию, коммит сборки и версии зависимостей из информации о сборке
+func buildInfo() (string, string, map[string]string) {
+	version, commit := BuildVersion, BuildCommit
+	dependencies := make(map[string]string)
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return version, commit, dependencies
+	}
+
+	modified := false
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if commit == "" {
+				commit = setting.Value
+			}
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		}
+	}
+	if modified && BuildCommit == "" && commit != "" {
+		commit += "-dirty"
+	}
+
+	wanted := make(map[string]bool, len(startupBannerDependencies))
+	for _, name := range startupBannerDependencies {
+		wanted[name] = true
+	}
+	for _, dep := range info.Deps {
+		if !wanted[dep.Path] {
+			continue
+		}
+		if dep.Replace != nil {
+			dependencies[dep.Path] = dep.Replace.Version + " (replaced)"
+			continue
+		}
+		dependencies[dep.Path] = dep.Version
+	}
+
+	return version, commit, dependencies
+}
+
+// startupModeName возвращает режим инициализации зависимостей (STARTUP_MODE)
+func startupModeName() string {
+	if IsEagerStartup() {
+		return "eager"
+	}
+	return "lazy"
+}
+
+// databaseSummary возвращает endpoint'ы БД с замаскированным паролем
+func databaseSummary() map[string]interface{} {
+	cfg := database.GetConfigFromEnv()
+	return map[string]interface{}{
+		"query":         redactDSN(cfg.QueryDSN),
+		"mutation":      redactDSN(cfg.MutationDSN),
+		"cache_enabled": cfg.EnableCache,
+		"cache_ttl":     cfg.CacheTTL.String(),
+		"debug":         cfg.Debug,
+	}
+}
+
+// redactDSN маскирует пароль в строке подключения
+func redactDSN(dsn string) string {
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		return "<invalid dsn>"
+	}
+	return parsed.Redacted()
+}
+
+// redisSummary возвращает параметры подключения к Redis без пароля и ключа шифрования
+func redisSummary() map[string]interface{} {
+	cfg := redis.NewRedisConfigFromEnv()
+	return map[string]interface{}{
+		"address":            cfg.Host + ":" + cfg.Port,
+		"db":                 cfg.DB,
+		"pool_size":          cfg.PoolSize,
+		"password_set":       cfg.Password != "",
+		"encryption_enabled": os.Getenv("REDIS_CACHE_ENCRYPTION_KEY") != "",
+	}
+}
+
+// migrationSummary сравнивает последнюю ревизию Atlas в БД с последней миграцией сборки.
+// Ошибка чтения ревизии не мешает запуску: статус выводится как unknown.
+func migrationSummary(ctx context.Context) map[string]interface{} {
+	versions := embeddedMigrationVersions()
+	summary := map[string]interface{}{
+		"status": "unknown",
+		"build":  len(versions),
+	}
+	if len(versions) > 0 {
+		summary["latest"] = versions[len(versions)-1]
+	}
+
+	applied, err := appliedMigrationVersion(ctx)
+	if err != nil {
+		summary["error"] = err.Error()
+		return summary
+	}
+	summary["applied"] = applied
+
+	pending := 0
+	for _, version := range versions {
+		if version > applied {
+			pending++
+		}
+	}
+	summary["pending"] = pending
+	switch {
+	case pending > 0:
+		summary["status"] = "pending"
+	case len(versions) > 0 && applied > versions[len(versions)-1]:
+		// БД уже обновлена более новой сборкой
+		summary["status"] = "ahead"
+	default:
+		summary["status"] = "up_to_date"
+	}
+	return summary
+}
+
+// embeddedMigrationVersions возвращает отсортированные версии миграций сборки (префикс имени файла)
+func embeddedMigrationVersions() []string {
+	files, err := fs.Glob(migrate.MigrationFiles, "migrations/*.sql")
+	if err != nil {
+		return nil
+	}
+	versions := make([]string, 0, len(files))
+	for _, file := range files {
+		version, _, _ := strings.Cut(path.Base(file), "_")
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// appliedMigrationVersion читает последнюю примененную ревизию из таблицы Atlas: в схеме приложения
+// (подключение с search_path, как в tools/atlas/migrate.sh) или в отдельной схеме atlas_schema_revisions
+func appliedMigrationVersion(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, migrationStatusTimeout)
+	defer cancel()
+
+	db, err := sql.Open("pgx", database.GetConfigFromEnv().QueryDSN)
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	var version string
+	for _, table := range []string{"atlas_schema_revisions", "atlas_schema_revisions.atlas_schema_revisions"} {
+		err = db.QueryRowContext(ctx, `SELECT version FROM `+table+` ORDER BY version DESC LIMIT 1`).Scan(&version)
+		if err == nil {
+			return version, nil
+		}
+	}
+	return "", err
+}
+
+// schemaHash возвращает SHA-256 исполняемой GraphQL схемы: одинаковый хеш у реплик означает одинаковый контракт
+func schemaHash() string {
+	schema := resolvers.NewSchema(nil).Schema()
+	if schema == nil {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatSchema(schema)
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:])
+}
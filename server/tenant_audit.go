@@ -0,0 +1,283 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"main/database"
+	"main/ent/migrate"
+	"strings"
+	"time"
+
+	entschema "entgo.io/ent/dialect/sql/schema"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// tenantColumn колонка, которую добавляет TenantMixin
+const tenantColumn = "tenant_id"
+
+// tenantAuditSampleSize количество примеров нарушений в отчете для каждой проверки
+const tenantAuditSampleSize = 10
+
+// tenantGlobalTables таблицы, которые намеренно не принадлежат тенанту (сейчас таких нет)
+var tenantGlobalTables = map[string]bool{}
+
+// tenantReference логическая ссылка между таблицами без внешнего ключа (UUID поле вместо ent edge)
+type tenantReference struct {
+	Table    string
+	Column   string
+	RefTable string
+}
+
+// tenantReferences ссылки по UUID полям, которые ent не видит как связи.
+// Новые поля, ссылающиеся на записи этого сервиса, нужно добавлять сюда.
+var tenantReferences = []tenantReference{
+	{Table: "file_audit_events", Column: "file_id", RefTable: "files"},
+}
+
+// TenantAuditViolation нарушение изоляции тенантов
+type TenantAuditViolation struct {
+	Check   string   `json:"check"`
+	Table   string   `json:"table"`
+	Details string   `json:"details"`
+	Count   int64    `json:"count,omitempty"`
+	Samples []string `json:"samples,omitempty"`
+}
+
+// TenantAuditReport машиночитаемый отчет проверки изоляции тенантов (-tenant-audit)
+type TenantAuditReport struct {
+	Status     SelfTestStatus         `json:"status"`
+	StartedAt  time.Time              `json:"startedAt"`
+	Duration   string                 `json:"duration"`
+	Tables     int                    `json:"tables"`
+	Relations  int                    `json:"relations"`
+	Violations []TenantAuditViolation `json:"violations"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// OK возвращает true, если нарушений не найдено
+func (r *TenantAuditReport) OK() bool {
+	return r.Status == SelfTestStatusOK
+}
+
+// tenantRelation пара таблиц, записи которых должны принадлежать одному тенанту
+type tenantRelation struct {
+	// Check имя проверки в отчете
+	Check string
+	// Table таблица со ссылкой (для join таблиц - сама join таблица)
+	Table string
+	// Query возвращает id нарушающих записей
+	Query string
+}
+
+// RunTenantIsolationAudit проверяет схему и данные на нарушения изоляции тенантов:
+// таблицы без TenantMixin, связи (внешние ключи, join таблицы many-to-many и логические ссылки),
+// соединяющие записи разных тенантов. Выполняется только на чтение.
+func RunTenantIsolationAudit(ctx context.Context) *TenantAuditReport {
+	report := &TenantAuditReport{
+		Status:     SelfTestStatusOK,
+		StartedAt:  time.Now().UTC(),
+		Tables:     len(migrate.Tables),
+		Violations: []TenantAuditViolation{},
+	}
+	defer func() {
+		report.Duration = time.Since(report.StartedAt).Round(time.Millisecond).String()
+		if len(report.Violations) > 0 || report.Error != "" {
+			report.Status = SelfTestStatusFailed
+		}
+	}()
+
+	report.Violations = append(report.Violations, auditTenantSchema(migrate.Tables)...)
+
+	relations := tenantRelations(migrate.Tables)
+	report.Relations = len(relations)
+
+	db, err := sql.Open("pgx", database.GetConfigFromEnv().MutationDSN)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	defer db.Close()
+
+	for _, relation := range relations {
+		violation, err := runTenantRelationCheck(ctx, db, relation)
+		if err != nil {
+			report.Error = fmt.Sprintf("%s: %v", relation.Check, err)
+			return report
+		}
+		if violation != nil {
+			report.Violations = append(report.Violations, *violation)
+		}
+	}
+
+	return report
+}
+
+// WriteTenantAuditReport выводит отчет в формате JSON
+func WriteTenantAuditReport(w io.Writer, report *TenantAuditReport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// auditTenantSchema находит таблицы сущностей без колонки tenant_id (TenantMixin не подключен)
+func auditTenantSchema(tables []*entschema.Table) []TenantAuditViolation {
+	violations := make([]TenantAuditViolation, 0)
+	for _, table := range tables {
+		if tenantGlobalTables[table.Name] || isJoinTable(table) {
+			continue
+		}
+
+		column, ok := table.Column(tenantColumn)
+		switch {
+		case !ok:
+			violations = append(violations, TenantAuditViolation{
+				Check:   "missing_tenant_mixin",
+				Table:   table.Name,
+				Details: "table has no tenant_id column, TenantMixin is not applied",
+			})
+		case column.Nullable:
+			violations = append(violations, TenantAuditViolation{
+				Check:   "nullable_tenant_id",
+				Table:   table.Name,
+				Details: "tenant_id column is nullable",
+			})
+		}
+	}
+	return violations
+}
+
+// isJoinTable определяет join таблицу many-to-many: первичный ключ целиком состоит из внешних ключей
+func isJoinTable(table *entschema.Table) bool {
+	if len(table.PrimaryKey) < 2 || len(table.ForeignKeys) < 2 {
+		return false
+	}
+	fkColumns := make(map[string]bool)
+	for _, fk := range table.ForeignKeys {
+		for _, column := range fk.Columns {
+			fkColumns[column.Name] = true
+		}
+	}
+	for _, column := range table.PrimaryKey {
+		if !fkColumns[column.Name] {
+			return false
+		}
+	}
+	return true
+}
+
+// hasTenantColumn проверяет наличие tenant_id в таблице
+func hasTenantColumn(table *entschema.Table) bool {
+	_, ok := table.Column(tenantColumn)
+	return ok
+}
+
+// tenantRelations собирает проверяемые связи из схемы ent и списка логических ссылок
+func tenantRelations(tables []*entschema.Table) []tenantRelation {
+	byName := make(map[string]*entschema.Table, len(tables))
+	for _, table := range tables {
+		byName[table.Name] = table
+	}
+
+	relations := make([]tenantRelation, 0)
+	for _, table := range tables {
+		// Join таблица many-to-many: сравниваем тенантов обеих связанных записей
+		if isJoinTable(table) {
+			left, right := table.ForeignKeys[0], table.ForeignKeys[1]
+			if !hasTenantColumn(left.RefTable) || !hasTenantColumn(right.RefTable) {
+				continue
+			}
+			relations = append(relations, tenantRelation{
+				Check: "cross_tenant_join",
+				Table: table.Name,
+				Query: fmt.Sprintf(
+					`SELECT CONCAT(l.id, '<->', r.id) FROM %s j JOIN %s l ON j.%s = l.id JOIN %s r ON j.%s = r.id WHERE l.%s <> r.%s`,
+					quoteIdent(table.Name),
+					quoteIdent(left.RefTable.Name), quoteIdent(left.Columns[0].Name),
+					quoteIdent(right.RefTable.Name), quoteIdent(right.Columns[0].Name),
+					tenantColumn, tenantColumn),
+			})
+			continue
+		}
+
+		// Внешние ключи ent edges
+		if !hasTenantColumn(table) {
+			continue
+		}
+		for _, fk := range table.ForeignKeys {
+			if len(fk.Columns) != 1 || !hasTenantColumn(fk.RefTable) {
+				continue
+			}
+			relations = append(relations, crossTenantReference("cross_tenant_edge", table.Name, fk.Columns[0].Name, fk.RefTable.Name))
+		}
+	}
+
+	// Логические ссылки по UUID полям
+	for _, reference := range tenantReferences {
+		table, refTable := byName[reference.Table], byName[reference.RefTable]
+		if table == nil || refTable == nil || !hasTenantColumn(table) || !hasTenantColumn(refTable) {
+			continue
+		}
+		if _, ok := table.Column(reference.Column); !ok {
+			continue
+		}
+		relations = append(relations, crossTenantReference("cross_tenant_reference", reference.Table, reference.Column, reference.RefTable))
+	}
+
+	return relations
+}
+
+// crossTenantReference строит проверку ссылки table.column -> refTable.id
+func crossTenantReference(check, table, column, refTable string) tenantRelation {
+	return tenantRelation{
+		Check: check,
+		Table: table,
+		Query: fmt.Sprintf(
+			`SELECT CAST(c.id AS text) FROM %s c JOIN %s p ON c.%s = p.id WHERE c.%s <> p.%s`,
+			quoteIdent(table), quoteIdent(refTable), quoteIdent(column), tenantColumn, tenantColumn),
+	}
+}
+
+// runTenantRelationCheck считает нарушающие записи связи и собирает примеры
+func runTenantRelationCheck(ctx context.Context, db *sql.DB, relation tenantRelation) (*TenantAuditViolation, error) {
+	var count int64
+	if err := db.QueryRowContext(ctx, fmt.Sprintf(`SELECT COUNT(*) FROM (%s) v`, relation.Query)).Scan(&count); err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, nil
+	}
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(`%s LIMIT %d`, relation.Query, tenantAuditSampleSize))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	samples := make([]string, 0, tenantAuditSampleSize)
+	for rows.Next() {
+		var sample string
+		if err := rows.Scan(&sample); err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &TenantAuditViolation{
+		Check:   relation.Check,
+		Table:   relation.Table,
+		Details: "rows reference records of another tenant",
+		Count:   count,
+		Samples: samples,
+	}, nil
+}
+
+// quoteIdent экранирует идентификатор PostgreSQL
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
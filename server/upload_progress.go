@@ -0,0 +1,38 @@
+package server
+
+import (
+	"io"
+	fileservice "main/services/file"
+	"net/http"
+	"regexp"
+)
+
+// uploadProgressIDPattern допустимый идентификатор загрузки от клиента (UUID или короткий токен)
+var uploadProgressIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// UploadProgressMiddleware публикует прогресс приема multipart загрузок в /query для запросов
+// с заголовком X-Upload-Progress-Id. Идентификатор сохраняется в контексте, чтобы FileService
+// продолжил публикацию на этапе передачи файла в S3. Должен стоять после FederationMiddleware.
+func UploadProgressMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		progressID := r.Header.Get(fileservice.UploadProgressHeader)
+		if progressID == "" || r.URL.Path != "/query" || !isMultipart(r) || r.Body == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if !uploadProgressIDPattern.MatchString(progressID) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := fileservice.WithUploadProgressID(r.Context(), progressID)
+		body := fileservice.NewUploadProgressReader(ctx, r.Body, fileservice.UploadPhaseReceiving, "", r.ContentLength)
+		r = r.WithContext(ctx)
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{body, r.Body}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,259 @@
+package audit
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/fileauditevent"
+	"main/errcatalog"
+	"main/utils"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultAggregationPeriod период агрегации, если начало не задано фильтром
+	DefaultAggregationPeriod = 30 * 24 * time.Hour
+	// maxAggregationDays максимальная длина периода агрегации в днях
+	maxAggregationDays = 366
+	// defaultTopDownloadersLimit количество пользователей в рейтинге скачиваний по умолчанию
+	defaultTopDownloadersLimit = 10
+	// maxTopDownloadersLimit максимальное количество пользователей в рейтинге скачиваний
+	maxTopDownloadersLimit = 100
+	// aggregationDayLayout формат даты в корзинах по дням
+	aggregationDayLayout = "2006-01-02"
+)
+
+// downloadActions действия, которые считаются скачиванием файлов
+var downloadActions = []fileauditevent.Action{
+	fileauditevent.ActionURL_GENERATED,
+	fileauditevent.ActionBATCH_DOWNLOAD,
+	fileauditevent.ActionCONTENT_STREAMED,
+}
+
+// DayBucket количество событий за день в часовом поясе пользователя
+type DayBucket struct {
+	Date  string
+	Count int
+}
+
+// ActorBucket количество событий пользователя
+type ActorBucket struct {
+	ActorID uuid.UUID
+	Count   int
+}
+
+// ActionBucket количество событий одного типа
+type ActionBucket struct {
+	Action fileauditevent.Action
+	Count  int
+}
+
+// Aggregations агрегаты аудита для графиков админки
+type Aggregations struct {
+	From time.Time
+	To   time.Time
+	// DownloadsPerDay скачивания по дням периода, включая дни без скачиваний
+	DownloadsPerDay []DayBucket
+	// TopDownloaders пользователи с наибольшим количеством скачиваний
+	TopDownloaders []ActorBucket
+	// ActionsByType количество событий по типам действий
+	ActionsByType []ActionBucket
+}
+
+// Aggregate считает агрегаты аудита тенанта запросами GROUP BY, не выбирая сами события.
+// Фильтр по действиям применяется к ActionsByType; скачивания всегда считаются по URL_GENERATED, BATCH_DOWNLOAD и CONTENT_STREAMED.
+// Период по умолчанию - последние 30 дней, не длиннее 366 дней.
+func (s *AuditService) Aggregate(ctx context.Context, client *ent.Client, filter ListFilter, topLimit int) (*Aggregations, error) {
+	to := time.Now()
+	if filter.To != nil {
+		to = *filter.To
+	}
+	from := to.Add(-DefaultAggregationPeriod)
+	if filter.From != nil {
+		from = *filter.From
+	}
+	if !from.Before(to) || to.Sub(from) > maxAggregationDays*24*time.Hour {
+		return nil, errcatalog.AuditInvalidPeriod(ctx)
+	}
+	filter.From, filter.To = &from, &to
+
+	if topLimit <= 0 {
+		topLimit = defaultTopDownloadersLimit
+	}
+	if topLimit > maxTopDownloadersLimit {
+		topLimit = maxTopDownloadersLimit
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	loc := utils.UserLocation(ctx)
+
+	downloadsFilter := filter
+	downloadsFilter.Actions = downloadActions
+
+	result := &Aggregations{From: from, To: to}
+	var err error
+	if result.DownloadsPerDay, err = s.countPerDay(ctxWithClient, filteredQuery(client, downloadsFilter), loc, from, to); err != nil {
+		utils.Logger.Error("Failed to aggregate downloads per day", zap.Error(err))
+		return nil, errcatalog.AuditAggregateFailed(ctx)
+	}
+	if result.TopDownloaders, err = s.topActors(ctxWithClient, filteredQuery(client, downloadsFilter), topLimit); err != nil {
+		utils.Logger.Error("Failed to aggregate top downloaders", zap.Error(err))
+		return nil, errcatalog.AuditAggregateFailed(ctx)
+	}
+	if result.ActionsByType, err = s.countByAction(ctxWithClient, filteredQuery(client, filter)); err != nil {
+		utils.Logger.Error("Failed to aggregate audit actions", zap.Error(err))
+		return nil, errcatalog.AuditAggregateFailed(ctx)
+	}
+
+	return result, nil
+}
+
+// countPerDay группирует события по дню в часовом поясе пользователя и дополняет период днями без событий
+func (s *AuditService) countPerDay(ctx context.Context, query *ent.FileAuditEventQuery, loc *time.Location, from, to time.Time) ([]DayBucket, error) {
+	var rows []struct {
+		Day   string `json:"day"`
+		Count int    `json:"count"`
+	}
+	err := query.Modify(func(sel *sql.Selector) {
+		day := sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("to_char(").
+				WriteString(sel.C(fileauditevent.FieldCreateTime)).
+				WriteString(" AT TIME ZONE ").
+				Arg(loc.String()).
+				WriteString(", 'YYYY-MM-DD')")
+		})
+		sel.Select().
+			AppendSelectExprAs(day, "day").
+			AppendSelectExprAs(sql.Raw("COUNT(*)"), "count").
+			GroupBy("day")
+	}).Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Day] = row.Count
+	}
+
+	buckets := make([]DayBucket, 0, len(rows))
+	last := to.In(loc).Format(aggregationDayLayout)
+	for day := from.In(loc); ; day = day.AddDate(0, 0, 1) {
+		date := day.Format(aggregationDayLayout)
+		buckets = append(buckets, DayBucket{Date: date, Count: counts[date]})
+		if date >= last {
+			break
+		}
+	}
+	return buckets, nil
+}
+
+// topActors возвращает пользователей с наибольшим количеством событий; системные события не учитываются
+func (s *AuditService) topActors(ctx context.Context, query *ent.FileAuditEventQuery, limit int) ([]ActorBucket, error) {
+	var rows []struct {
+		ActorID uuid.UUID `json:"actor_id"`
+		Count   int       `json:"count"`
+	}
+	err := query.
+		Where(fileauditevent.ActorIDNotNil()).
+		Modify(func(sel *sql.Selector) {
+			sel.Select(sel.C(fileauditevent.FieldActorID)).
+				AppendSelectExprAs(sql.Raw("COUNT(*)"), "count").
+				GroupBy(sel.C(fileauditevent.FieldActorID)).
+				OrderExpr(sql.Raw("count DESC"), sql.Raw(sel.C(fileauditevent.FieldActorID))).
+				Limit(limit)
+		}).Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]ActorBucket, 0, len(rows))
+	for _, row := range rows {
+		buckets = append(buckets, ActorBucket{ActorID: row.ActorID, Count: row.Count})
+	}
+	return buckets, nil
+}
+
+// countByAction считает события по типам действий, начиная с самых частых
+func (s *AuditService) countByAction(ctx context.Context, query *ent.FileAuditEventQuery) ([]ActionBucket, error) {
+	var rows []struct {
+		Action fileauditevent.Action `json:"action"`
+		Count  int                   `json:"count"`
+	}
+	err := query.
+		GroupBy(fileauditevent.FieldAction).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]ActionBucket, 0, len(rows))
+	for _, row := range rows {
+		buckets = append(buckets, ActionBucket{Action: row.Action, Count: row.Count})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].Count != buckets[j].Count {
+			return buckets[i].Count > buckets[j].Count
+		}
+		return buckets[i].Action < buckets[j].Action
+	})
+	return buckets, nil
+}
+
+// DownloadedFileCounts считает, сколько раз каждый файл тенанта скачивался за период [from, to): ссылки и
+// потоковые скачивания по file_id события, архивы по списку file_ids в деталях события.
+// Используется для оценки исходящего трафика.
+func (s *AuditService) DownloadedFileCounts(ctx context.Context, client *ent.Client, from, to time.Time) (map[uuid.UUID]int, error) {
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	var rows []struct {
+		FileID uuid.UUID `json:"file_id"`
+		Count  int       `json:"count"`
+	}
+	err := client.FileAuditEvent.Query().
+		Where(
+			fileauditevent.ActionIn(fileauditevent.ActionURL_GENERATED, fileauditevent.ActionCONTENT_STREAMED),
+			fileauditevent.FileIDNotNil(),
+			fileauditevent.CreateTimeGTE(from),
+			fileauditevent.CreateTimeLT(to),
+		).
+		GroupBy(fileauditevent.FieldFileID).
+		Aggregate(ent.Count()).
+		Scan(ctxWithClient, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.FileID] += row.Count
+	}
+
+	archives, err := client.FileAuditEvent.Query().
+		Where(
+			fileauditevent.ActionEQ(fileauditevent.ActionBATCH_DOWNLOAD),
+			fileauditevent.CreateTimeGTE(from),
+			fileauditevent.CreateTimeLT(to),
+		).
+		Select(fileauditevent.FieldDetails).
+		All(ctxWithClient)
+	if err != nil {
+		return nil, err
+	}
+	for _, archive := range archives {
+		fileIDs, _ := archive.Details["file_ids"].([]interface{})
+		for _, value := range fileIDs {
+			raw, _ := value.(string)
+			if fileID, err := uuid.Parse(raw); err == nil {
+				counts[fileID]++
+			}
+		}
+	}
+
+	return counts, nil
+}
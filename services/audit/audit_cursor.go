@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"encoding/base64"
+	"main/ent"
+	"main/ent/fileauditevent"
+	"main/ent/predicate"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EncodeCursor возвращает непрозрачный курсор события для продолжения выдачи после него
+func EncodeCursor(event *ent.FileAuditEvent) string {
+	value := event.CreateTime.UTC().Format(time.RFC3339Nano) + "|" + event.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// decodeCursor разбирает курсор EncodeCursor в время создания и идентификатор события
+func decodeCursor(cursor string) (time.Time, uuid.UUID, bool) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, uuid.Nil, false
+	}
+	createdValue, idValue, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return time.Time{}, uuid.Nil, false
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, createdValue)
+	if err != nil {
+		return time.Time{}, uuid.Nil, false
+	}
+	id, err := uuid.Parse(idValue)
+	if err != nil {
+		return time.Time{}, uuid.Nil, false
+	}
+	return createdAt, id, true
+}
+
+// olderThanCursor выбирает события после курсора в порядке выдачи (create_time DESC, id DESC)
+func olderThanCursor(createdAt time.Time, id uuid.UUID) predicate.FileAuditEvent {
+	return fileauditevent.Or(
+		fileauditevent.CreateTimeLT(createdAt),
+		fileauditevent.And(
+			fileauditevent.CreateTime(createdAt),
+			fileauditevent.IDLT(id),
+		),
+	)
+}
@@ -0,0 +1,199 @@
+package audit
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/fileauditevent"
+	"main/ent/predicate"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/security"
+	"main/utils"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultListLimit количество событий в выдаче по умолчанию
+	DefaultListLimit = 50
+	// MaxListLimit максимальное количество событий в выдаче
+	MaxListLimit = 500
+	// recordTimeout максимальное время записи события аудита с явным тенантом
+	recordTimeout = 10 * time.Second
+)
+
+// AuditService записывает и читает события аудита файлов
+type AuditService struct{}
+
+// NewAuditService creates a new audit service
+func NewAuditService() *AuditService {
+	return &AuditService{}
+}
+
+// Event описывает событие аудита для записи
+type Event struct {
+	Action fileauditevent.Action
+	// TenantID задается явно для системных операций без тенанта в контексте
+	TenantID *uuid.UUID
+	FileID   *uuid.UUID
+	Details  map[string]interface{}
+}
+
+// ListFilter содержит параметры фильтрации событий аудита
+type ListFilter struct {
+	Actions []fileauditevent.Action
+	FileID  *uuid.UUID
+	ActorID *uuid.UUID
+	From    *time.Time
+	To      *time.Time
+	// Search подстрока имени файла из деталей события (без учета регистра)
+	Search *string
+	Limit  int
+	Offset int
+	// After курсор (endCursor предыдущей страницы): выдача продолжается после него, Offset не применяется.
+	// В отличие от смещения, новые события не сдвигают страницы при просмотре длинного журнала.
+	After *string
+}
+
+// EventPage страница событий аудита
+type EventPage struct {
+	Events []*ent.FileAuditEvent
+	// TotalCount количество событий по фильтру без учета курсора и пагинации
+	TotalCount  int
+	HasNextPage bool
+}
+
+// Record сохраняет событие аудита. Ошибки записи не прерывают основную операцию -
+// они логируются, а событие дублируется в лог.
+func (s *AuditService) Record(ctx context.Context, client *ent.Client, event Event) {
+	fields := []zap.Field{
+		zap.String("action", event.Action.String()),
+		zap.Any("details", event.Details),
+	}
+	if event.FileID != nil {
+		fields = append(fields, zap.String("file_id", event.FileID.String()))
+	}
+	if impersonatorID := security.GetImpersonatorID(ctx); impersonatorID != nil {
+		fields = append(fields, zap.String("impersonator_id", impersonatorID.String()))
+	}
+	utils.Logger.Info("Audit event", fields...)
+
+	if client == nil {
+		return
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	create := client.FileAuditEvent.Create()
+	if event.TenantID != nil {
+		var cancel context.CancelFunc
+		ctxWithClient, cancel = mixin.SkipTenantFilterFor(ctxWithClient, "record audit event for explicit tenant", recordTimeout)
+		defer cancel()
+		create = create.SetTenantID(*event.TenantID)
+	}
+
+	err := create.
+		SetAction(event.Action).
+		SetNillableFileID(event.FileID).
+		SetNillableActorID(federation.GetUserID(ctx)).
+		SetNillableImpersonatorID(security.GetImpersonatorID(ctx)).
+		SetDetails(event.Details).
+		Exec(ctxWithClient)
+	if err != nil {
+		utils.Logger.Error("Failed to save audit event",
+			append(fields, zap.Error(err))...)
+	}
+}
+
+// List возвращает страницу событий аудита тенанта по фильтру, начиная с новых
+func (s *AuditService) List(ctx context.Context, client *ent.Client, filter ListFilter) (*EventPage, error) {
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	query := filteredQuery(client, filter)
+
+	totalCount, err := query.Clone().Count(ctxWithClient)
+	if err != nil {
+		return nil, errcatalog.AuditGetFailed(ctx)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+	offset := filter.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	if filter.After != nil && *filter.After != "" {
+		createdAt, id, ok := decodeCursor(*filter.After)
+		if !ok {
+			return nil, errcatalog.AuditInvalidCursor(ctx)
+		}
+		query = query.Where(olderThanCursor(createdAt, id))
+		offset = 0
+	}
+
+	// Лишнее событие показывает, есть ли следующая страница
+	events, err := query.
+		Order(ent.Desc(fileauditevent.FieldCreateTime), ent.Desc(fileauditevent.FieldID)).
+		Limit(limit + 1).
+		Offset(offset).
+		All(ctxWithClient)
+	if err != nil {
+		return nil, errcatalog.AuditGetFailed(ctx)
+	}
+
+	page := &EventPage{Events: events, TotalCount: totalCount}
+	if len(events) > limit {
+		page.Events = events[:limit]
+		page.HasNextPage = true
+	}
+	return page, nil
+}
+
+// filteredQuery строит запрос событий аудита тенанта по условиям фильтра (без сортировки и пагинации)
+func filteredQuery(client *ent.Client, filter ListFilter) *ent.FileAuditEventQuery {
+	query := client.FileAuditEvent.Query()
+	if len(filter.Actions) > 0 {
+		query = query.Where(fileauditevent.ActionIn(filter.Actions...))
+	}
+	if filter.FileID != nil {
+		query = query.Where(fileauditevent.FileID(*filter.FileID))
+	}
+	if filter.ActorID != nil {
+		query = query.Where(fileauditevent.ActorID(*filter.ActorID))
+	}
+	if filter.From != nil {
+		query = query.Where(fileauditevent.CreateTimeGTE(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where(fileauditevent.CreateTimeLT(*filter.To))
+	}
+	if filter.Search != nil && strings.TrimSpace(*filter.Search) != "" {
+		query = query.Where(detailsFilenameContains(strings.TrimSpace(*filter.Search)))
+	}
+	return query
+}
+
+// likeEscaper экранирует спецсимволы шаблона LIKE в пользовательской подстроке
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// detailsFilenameContains ищет подстроку в имени файла из деталей события (details->>'filename')
+func detailsFilenameContains(search string) predicate.FileAuditEvent {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(").
+				WriteString(s.C(fileauditevent.FieldDetails)).
+				WriteString(" ->> 'filename') ILIKE ").
+				Arg("%" + likeEscaper.Replace(search) + "%")
+		}))
+	}
+}
@@ -0,0 +1,196 @@
+package audit
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/operationauditlog"
+	"main/errcatalog"
+	"main/security"
+	"main/utils"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// redactedValue подставляется вместо значений секретных аргументов
+	redactedValue = "[REDACTED]"
+	// maxOperationErrorLength максимальная длина сохраняемого сообщения об ошибке
+	maxOperationErrorLength = 1000
+)
+
+// sensitiveArgumentMarkers подстроки имен аргументов, значения которых не сохраняются в аудит
+var sensitiveArgumentMarkers = []string{"password", "secret", "token", "credential", "authorization", "apikey", "api_key", "privatekey", "private_key"}
+
+// Operation описывает выполненную мутацию для журнала операций
+type Operation struct {
+	Name      string
+	Variables map[string]interface{}
+	Success   bool
+	Error     string
+	Duration  time.Duration
+}
+
+// OperationListFilter содержит параметры фильтрации журнала операций
+type OperationListFilter struct {
+	OperationName *string
+	ActorID       *uuid.UUID
+	Success       *bool
+	From          *time.Time
+	To            *time.Time
+	Limit         int
+	Offset        int
+}
+
+// RecordOperation сохраняет запись журнала операций администраторов.
+// Как и Record, не прерывает основную операцию при ошибке записи.
+func (s *AuditService) RecordOperation(ctx context.Context, client *ent.Client, operation Operation) {
+	errorMessage := operation.Error
+	if len(errorMessage) > maxOperationErrorLength {
+		errorMessage = errorMessage[:maxOperationErrorLength]
+	}
+
+	fields := []zap.Field{
+		zap.String("operation", operation.Name),
+		zap.Bool("success", operation.Success),
+		zap.Duration("duration", operation.Duration),
+	}
+	if impersonatorID := security.GetImpersonatorID(ctx); impersonatorID != nil {
+		fields = append(fields, zap.String("impersonator_id", impersonatorID.String()))
+	}
+	utils.Logger.Info("Admin operation", fields...)
+
+	if client == nil || federation.GetTenantID(ctx) == nil {
+		return
+	}
+
+	err := client.OperationAuditLog.Create().
+		SetOperationName(operation.Name).
+		SetVariables(RedactVariables(operation.Variables)).
+		SetNillableActorID(federation.GetUserID(ctx)).
+		SetActorRole(federation.GetUserRole(ctx)).
+		SetNillableImpersonatorID(security.GetImpersonatorID(ctx)).
+		SetSuccess(operation.Success).
+		SetErrorMessage(errorMessage).
+		SetDurationMs(operation.Duration.Milliseconds()).
+		Exec(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Error("Failed to save operation audit log",
+			append(fields, zap.Error(err))...)
+	}
+}
+
+// ListOperations возвращает журнал операций тенанта по фильтру, начиная с новых
+func (s *AuditService) ListOperations(ctx context.Context, client *ent.Client, filter OperationListFilter) ([]*ent.OperationAuditLog, int, error) {
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	query := client.OperationAuditLog.Query()
+	if filter.OperationName != nil && *filter.OperationName != "" {
+		query = query.Where(operationauditlog.OperationName(*filter.OperationName))
+	}
+	if filter.ActorID != nil {
+		query = query.Where(operationauditlog.ActorID(*filter.ActorID))
+	}
+	if filter.Success != nil {
+		query = query.Where(operationauditlog.Success(*filter.Success))
+	}
+	if filter.From != nil {
+		query = query.Where(operationauditlog.CreateTimeGTE(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where(operationauditlog.CreateTimeLT(*filter.To))
+	}
+
+	totalCount, err := query.Clone().Count(ctxWithClient)
+	if err != nil {
+		return nil, 0, errcatalog.AuditGetFailed(ctx)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+	offset := filter.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	logs, err := query.
+		Order(ent.Desc(operationauditlog.FieldCreateTime), ent.Desc(operationauditlog.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctxWithClient)
+	if err != nil {
+		return nil, 0, errcatalog.AuditGetFailed(ctx)
+	}
+
+	return logs, totalCount, nil
+}
+
+// RedactVariables возвращает копию аргументов, в которой значения секретных полей скрыты,
+// а загружаемые файлы заменены их метаданными
+func RedactVariables(variables map[string]interface{}) map[string]interface{} {
+	if variables == nil {
+		return nil
+	}
+	redacted, _ := redactValue("", variables).(map[string]interface{})
+	return redacted
+}
+
+// redactValue рекурсивно обрабатывает значение аргумента
+func redactValue(key string, value interface{}) interface{} {
+	if key != "" && isSensitiveArgument(key) {
+		return redactedValue
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			result[k] = redactValue(k, item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = redactValue("", item)
+		}
+		return result
+	case graphql.Upload:
+		return uploadSummary(v)
+	case *graphql.Upload:
+		if v == nil {
+			return nil
+		}
+		return uploadSummary(*v)
+	default:
+		return v
+	}
+}
+
+// uploadSummary заменяет содержимое загружаемого файла его метаданными
+func uploadSummary(upload graphql.Upload) map[string]interface{} {
+	return map[string]interface{}{
+		"filename":    upload.Filename,
+		"size":        upload.Size,
+		"contentType": upload.ContentType,
+	}
+}
+
+// isSensitiveArgument проверяет, содержит ли аргумент секретные данные
+func isSensitiveArgument(key string) bool {
+	lowerKey := strings.ToLower(key)
+	for _, marker := range sensitiveArgumentMarkers {
+		if strings.Contains(lowerKey, marker) {
+			return true
+		}
+	}
+	return false
+}
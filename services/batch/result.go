@@ -0,0 +1,66 @@
+// Package batch содержит общий итог пакетных операций над файлами: каждый уникальный ID запроса попадает
+// либо в обработанные, либо в отказы с ключом ошибки и локализованной причиной.
+package batch
+
+import (
+	"context"
+	"main/errcatalog"
+
+	"github.com/google/uuid"
+)
+
+// Failure элемент пакетной операции, который не удалось обработать
+type Failure struct {
+	ID uuid.UUID `json:"id"`
+	// Code ключ ошибки каталога (например, error.file.not_found), по которому клиент выбирает обработку
+	Code string `json:"code"`
+	// Message причина на языке запроса
+	Message string `json:"message"`
+}
+
+// Result итог пакетной операции. Элементы перечисляются в порядке обработки.
+// Методы nil-результата ничего не делают, поэтому сбор итога можно сделать необязательным.
+type Result struct {
+	Succeeded []uuid.UUID `json:"succeeded"`
+	Failed    []*Failure  `json:"failed"`
+}
+
+// NewResult создает пустой итог
+func NewResult() *Result {
+	return &Result{Succeeded: []uuid.UUID{}, Failed: []*Failure{}}
+}
+
+// Succeed отмечает элемент обработанным
+func (r *Result) Succeed(id uuid.UUID) {
+	if r == nil {
+		return
+	}
+	r.Succeeded = append(r.Succeeded, id)
+}
+
+// Fail отмечает отказ по элементу. Ошибка вне каталога заменяется общей ошибкой error.batch.item_failed,
+// чтобы внутренние подробности не попадали клиенту.
+func (r *Result) Fail(ctx context.Context, id uuid.UUID, err error) {
+	if r == nil {
+		return
+	}
+	code := errcatalog.KeyOf(err)
+	if code == "" {
+		err = errcatalog.BatchItemFailed(ctx)
+		code = errcatalog.KeyOf(err)
+	}
+	r.Failed = append(r.Failed, &Failure{ID: id, Code: code, Message: err.Error()})
+}
+
+// UniqueIDs возвращает ID без повторов в исходном порядке: повторный ID запроса обрабатывается один раз
+func UniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,130 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"main/redis"
+	"main/utils"
+	"os"
+	"strconv"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+const (
+	// archiveCleanupKey общий (для всех тенантов) ZSET временных архивов: ключ объекта S3 -> время удаления
+	archiveCleanupKey = "archive:cleanup"
+	// archiveCleanupBatch количество архивов, удаляемых за один проход очистки
+	archiveCleanupBatch = 100
+	// archiveCleanupRetryDelay задержка повторной попытки удаления после ошибки S3
+	archiveCleanupRetryDelay = 5 * time.Minute
+)
+
+// scheduleArchiveDeletion планирует удаление временного архива через указанное время.
+// Расписание хранится в Redis, поэтому архив удаляется и после перезапуска сервиса.
+func (s *FileService) scheduleArchiveDeletion(ctx context.Context, storageKey string, delay time.Duration) {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil || cacheService.GetClient() == nil {
+		// Остается правило жизненного цикла бакета на префиксе temp/
+		utils.Logger.Warn("Redis is unavailable, temporary archive deletion is not scheduled",
+			zap.String("storage_key", storageKey))
+		return
+	}
+
+	err = cacheService.GetClient().ZAdd(ctx, archiveCleanupKey, &goredis.Z{
+		Score:  float64(time.Now().Add(delay).Unix()),
+		Member: storageKey,
+	}).Err()
+	if err != nil {
+		utils.Logger.Warn("Failed to schedule temporary archive deletion",
+			zap.Error(err),
+			zap.String("storage_key", storageKey))
+	}
+}
+
+// CleanupExpiredArchives удаляет из S3 временные архивы, срок действия ссылок на которые истек.
+// Архив снимается с расписания до удаления, поэтому параллельные экземпляры сервиса не удаляют его дважды;
+// при ошибке S3 удаление откладывается на archiveCleanupRetryDelay.
+func (s *FileService) CleanupExpiredArchives(ctx context.Context) (int, error) {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil || cacheService.GetClient() == nil {
+		return 0, fmt.Errorf("redis is unavailable")
+	}
+	client := cacheService.GetClient()
+
+	storageKeys, err := client.ZRangeByScore(ctx, archiveCleanupKey, &goredis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(time.Now().Unix(), 10),
+		Count: archiveCleanupBatch,
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, storageKey := range storageKeys {
+		claimed, err := client.ZRem(ctx, archiveCleanupKey, storageKey).Result()
+		if err != nil {
+			return deleted, err
+		}
+		if claimed == 0 {
+			// Архив уже удаляет другой экземпляр сервиса
+			continue
+		}
+
+		if err := s.s3Service.DeleteFile(ctx, storageKey); err != nil {
+			utils.Logger.Warn("Failed to delete temporary archive, retrying later",
+				zap.Error(err),
+				zap.String("storage_key", storageKey))
+			client.ZAdd(ctx, archiveCleanupKey, &goredis.Z{
+				Score:  float64(time.Now().Add(archiveCleanupRetryDelay).Unix()),
+				Member: storageKey,
+			})
+			continue
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// StartArchiveCleanup запускает периодическое удаление временных архивов пакетного скачивания.
+// Интервал задается ARCHIVE_CLEANUP_INTERVAL (по умолчанию 5m, "0" отключает очистку).
+func StartArchiveCleanup(ctx context.Context) {
+	interval := 5 * time.Minute
+	if value := os.Getenv("ARCHIVE_CLEANUP_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			utils.Logger.Info("Temporary archive cleanup is disabled")
+			return
+		}
+		interval = parsed
+	}
+
+	service := NewFileService()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				deleted, err := service.CleanupExpiredArchives(ctx)
+				if err != nil {
+					utils.Logger.Warn("Temporary archive cleanup failed", zap.Error(err))
+					continue
+				}
+				if deleted > 0 {
+					utils.Logger.Info("Temporary archives deleted", zap.Int("deleted", deleted))
+				}
+			}
+		}
+	}()
+
+	utils.Logger.Info("Temporary archive cleanup started", zap.Duration("interval", interval))
+}
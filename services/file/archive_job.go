@@ -0,0 +1,284 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"main/config"
+	"main/ent"
+	"main/errcatalog"
+	"main/redis"
+	"main/services/batch"
+	"main/utils"
+	"main/websocket"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// ArchiveJobTTL время хранения состояния задания: совпадает со сроком действия ссылки на готовый архив
+	ArchiveJobTTL = DefaultPresignedURLExpiration
+	// archiveJobPrefix префикс ключей состояния заданий сборки архивов
+	archiveJobPrefix = "archive:job:"
+	// archiveJobProgressEntityType тип события прогресса; канал {tenantID}:archive_job_progress_user_{userID}
+	archiveJobProgressEntityType = "archive_job_progress_user"
+	// archiveJobProgressInterval минимальный интервал между сохранениями прогресса одного задания
+	archiveJobProgressInterval = 500 * time.Millisecond
+	// archiveJobWorkers количество архивов, собираемых экземпляром сервиса одновременно
+	archiveJobWorkers = 4
+)
+
+// ArchiveJobStatus статус задания сборки архива
+type ArchiveJobStatus string
+
+const (
+	// ArchiveJobStatusPending задание ожидает свободного обработчика
+	ArchiveJobStatusPending ArchiveJobStatus = "PENDING"
+	// ArchiveJobStatusRunning архив собирается
+	ArchiveJobStatusRunning ArchiveJobStatus = "RUNNING"
+	// ArchiveJobStatusCompleted архив загружен, ссылка для скачивания готова
+	ArchiveJobStatusCompleted ArchiveJobStatus = "COMPLETED"
+	// ArchiveJobStatusFailed архив собрать не удалось
+	ArchiveJobStatusFailed ArchiveJobStatus = "FAILED"
+)
+
+// archiveJobSlots ограничивает количество одновременно собираемых архивов
+var archiveJobSlots = make(chan struct{}, archiveJobWorkers)
+
+// ArchiveJob состояние фоновой сборки ZIP архива. Хранится в Redis, поэтому опрашивать задание
+// можно через любой экземпляр сервиса; собирает архив экземпляр, принявший запрос.
+type ArchiveJob struct {
+	ID             uuid.UUID        `json:"id"`
+	TenantID       uuid.UUID        `json:"tenantId"`
+	UserID         uuid.UUID        `json:"userId"`
+	Status         ArchiveJobStatus `json:"status"`
+	ArchiveName    string           `json:"archiveName"`
+	Layout         ArchiveLayout    `json:"layout"`
+	TotalFiles     int              `json:"totalFiles"`
+	ProcessedFiles int              `json:"processedFiles"`
+	URL            *string          `json:"url,omitempty"`
+	URLExpiresAt   *time.Time       `json:"urlExpiresAt,omitempty"`
+	Error          *string          `json:"error,omitempty"`
+	// Result файлы, вошедшие в архив (после завершения), и причины пропуска остальных
+	Result    *batch.Result `json:"result"`
+	CreatedAt time.Time     `json:"createdAt"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// Percent процент выполнения задания
+func (j *ArchiveJob) Percent() int {
+	if j.Status == ArchiveJobStatusCompleted {
+		return 100
+	}
+	if j.TotalFiles <= 0 {
+		return 0
+	}
+	// 100% только после загрузки архива в хранилище
+	return min(99, j.ProcessedFiles*100/j.TotalFiles)
+}
+
+// archiveJobKey ключ состояния задания
+func archiveJobKey(tenantID, jobID uuid.UUID) string {
+	return fmt.Sprintf("%s%s:%s", archiveJobPrefix, tenantID, jobID)
+}
+
+// archiveJobStore хранилище состояния заданий сборки архивов в Redis
+type archiveJobStore struct {
+	cache *redis.TenantCacheService
+}
+
+// getArchiveJobStore возвращает хранилище или ошибку, если Redis недоступен
+func getArchiveJobStore(ctx context.Context) (*archiveJobStore, error) {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil || cacheService.GetClient() == nil {
+		return nil, errcatalog.FileArchiveJobUnavailable(ctx)
+	}
+	return &archiveJobStore{cache: cacheService}, nil
+}
+
+// save сохраняет состояние задания; срок хранения отсчитывается от последнего изменения
+func (st *archiveJobStore) save(ctx context.Context, job *ArchiveJob) error {
+	job.UpdatedAt = time.Now()
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return st.cache.SetTenantCacheWithTTL(ctx, job.TenantID.String(), archiveJobKey(job.TenantID, job.ID), data, ArchiveJobTTL)
+}
+
+// load читает состояние задания. Возвращает (nil, nil), если задание не найдено или истекло.
+func (st *archiveJobStore) load(ctx context.Context, tenantID, jobID uuid.UUID) (*ArchiveJob, error) {
+	data, err := st.cache.GetTenantCache(ctx, archiveJobKey(tenantID, jobID))
+	if err != nil {
+		if _, unavailable := err.(*redis.RedisUnavailableError); unavailable {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	var job ArchiveJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, nil
+	}
+	return &job, nil
+}
+
+// CreateArchiveJob проверяет запрос и права на файлы, сохраняет задание и запускает сборку архива в фоне.
+// Прогресс публикуется в канал archive_job_progress_user пользователя, состояние доступно через GetArchiveJob.
+func (s *FileService) CreateArchiveJob(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID, archiveName string, layout ArchiveLayout) (*ArchiveJob, error) {
+	tenantID, userID := federation.GetTenantID(ctx), federation.GetUserID(ctx)
+	if tenantID == nil || userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	if len(fileIDs) == 0 {
+		return nil, errcatalog.FileNoFilesSelected(ctx)
+	}
+	if len(fileIDs) > config.Get().MaxBatchArchiveFiles {
+		return nil, errcatalog.FileTooManyFilesSelected(ctx)
+	}
+
+	store, err := getArchiveJobStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Права проверяются до постановки задания, чтобы ошибки доступа возвращались сразу
+	items := batch.NewResult()
+	files, err := s.validateAndGetFilesForBatch(ctx, client, fileIDs, items)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errcatalog.FileNoAccessibleFiles(ctx)
+	}
+
+	now := time.Now()
+	job := &ArchiveJob{
+		ID:          uuid.New(),
+		TenantID:    *tenantID,
+		UserID:      *userID,
+		Status:      ArchiveJobStatusPending,
+		ArchiveName: archiveName,
+		Layout:      layout,
+		TotalFiles:  len(files),
+		Result:      items,
+		CreatedAt:   now,
+	}
+	if err := store.save(ctx, job); err != nil {
+		utils.Logger.Error("Failed to save archive job", zap.Error(err))
+		return nil, errcatalog.FileArchiveJobUnavailable(ctx)
+	}
+
+	// Контекст запроса отменяется после ответа клиенту, но тенант, пользователь и язык нужны сборке архива
+	go s.runArchiveJob(context.WithoutCancel(ctx), client, store, job, files)
+
+	utils.Logger.Info("Archive job created",
+		zap.String("job_id", job.ID.String()),
+		zap.Int("total_files", job.TotalFiles))
+
+	return job, nil
+}
+
+// GetArchiveJob возвращает состояние задания; задание видит только создавший его пользователь
+func (s *FileService) GetArchiveJob(ctx context.Context, jobID uuid.UUID) (*ArchiveJob, error) {
+	tenantID, userID := federation.GetTenantID(ctx), federation.GetUserID(ctx)
+	if tenantID == nil || userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	store, err := getArchiveJobStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := store.load(ctx, *tenantID, jobID)
+	if err != nil {
+		utils.Logger.Error("Failed to load archive job", zap.Error(err), zap.String("job_id", jobID.String()))
+		return nil, errcatalog.FileArchiveJobUnavailable(ctx)
+	}
+	if job == nil || job.UserID != *userID {
+		return nil, errcatalog.FileArchiveJobNotFound(ctx)
+	}
+	return job, nil
+}
+
+// runArchiveJob собирает архив задания, сохраняя и публикуя прогресс по мере добавления файлов
+func (s *FileService) runArchiveJob(ctx context.Context, client *ent.Client, store *archiveJobStore, job *ArchiveJob, files []*ent.File) {
+	archiveJobSlots <- struct{}{}
+	defer func() { <-archiveJobSlots }()
+
+	job.Status = ArchiveJobStatusRunning
+	s.saveArchiveJob(ctx, store, job)
+
+	var lastSaved time.Time
+	progress := func(processed, total int) {
+		job.ProcessedFiles, job.TotalFiles = processed, total
+		if processed < total && time.Since(lastSaved) < archiveJobProgressInterval {
+			return
+		}
+		lastSaved = time.Now()
+		s.saveArchiveJob(ctx, store, job)
+	}
+
+	result, err := s.createBatchArchive(ctx, client, files, job.ArchiveName, job.Layout, job.Result, map[string]interface{}{
+		"archive_job_id": job.ID.String(),
+	}, progress)
+	if err == nil && result.URL == "" {
+		// Ни один файл не вошел в архив; причины перечислены в job.Result
+		err = errcatalog.FileNoAccessibleFiles(ctx)
+	}
+	if err != nil {
+		utils.Logger.Error("Archive job failed",
+			zap.Error(err),
+			zap.String("job_id", job.ID.String()))
+		message := err.Error()
+		job.Status = ArchiveJobStatusFailed
+		job.Error = &message
+		s.saveArchiveJob(ctx, store, job)
+		return
+	}
+
+	job.Status = ArchiveJobStatusCompleted
+	job.ArchiveName = result.ArchiveName
+	job.TotalFiles = result.TotalFiles
+	job.ProcessedFiles = result.TotalFiles
+	job.URL = &result.URL
+	job.URLExpiresAt = &result.ExpiresAt
+	s.saveArchiveJob(ctx, store, job)
+}
+
+// saveArchiveJob сохраняет состояние задания и публикует событие прогресса; ошибки не прерывают сборку
+func (s *FileService) saveArchiveJob(ctx context.Context, store *archiveJobStore, job *ArchiveJob) {
+	if err := store.save(ctx, job); err != nil {
+		utils.Logger.Warn("Failed to save archive job state",
+			zap.Error(err),
+			zap.String("job_id", job.ID.String()))
+	}
+
+	metadata := map[string]any{
+		"job_id":          job.ID.String(),
+		"status":          string(job.Status),
+		"percent":         job.Percent(),
+		"processed_files": job.ProcessedFiles,
+		"total_files":     job.TotalFiles,
+	}
+	if job.URL != nil {
+		metadata["url"] = *job.URL
+		metadata["expires_at"] = job.URLExpiresAt
+		metadata["archive_name"] = job.ArchiveName
+	}
+	if job.Error != nil {
+		metadata["error"] = *job.Error
+	}
+
+	err := websocket.NewPublisher().PublishEntityEvent(ctx, archiveJobProgressEntityType, job.UserID, websocket.EntityActionProgress, metadata)
+	if err != nil {
+		utils.Logger.Debug("Failed to publish archive job progress",
+			zap.Error(err),
+			zap.String("job_id", job.ID.String()))
+	}
+}
@@ -0,0 +1,92 @@
+package file
+
+import (
+	"main/ent"
+	"main/ent/file"
+	"main/ent/schema/filemeta"
+	"path"
+	"strings"
+)
+
+// ArchiveLayout определяет структуру каталогов внутри ZIP-архива пакетного скачивания
+type ArchiveLayout string
+
+const (
+	// ArchiveLayoutFlat все файлы в корне архива (поведение по умолчанию)
+	ArchiveLayoutFlat ArchiveLayout = "FLAT"
+	// ArchiveLayoutByFolder файлы раскладываются по папке из metadata.folder (например, TICKET-123/)
+	ArchiveLayoutByFolder ArchiveLayout = "BY_FOLDER"
+	// ArchiveLayoutByDate файлы раскладываются по месяцу загрузки (YYYY-MM/)
+	ArchiveLayoutByDate ArchiveLayout = "BY_DATE"
+	// ArchiveLayoutByEntity файлы раскладываются по сущности, к которой прикреплены (ticket-<id>/, comment-<id>/, chat-<id>/)
+	ArchiveLayoutByEntity ArchiveLayout = "BY_ENTITY"
+)
+
+const (
+	// ArchiveFolderMetadataKey ключ metadata, в котором сервис-владелец сохраняет папку файла (тикет, проект и т.п.)
+	ArchiveFolderMetadataKey = filemeta.KeyFolder
+	// maxArchiveFolderDepth максимальная вложенность папок внутри архива
+	maxArchiveFolderDepth = 5
+)
+
+// archiveEntryDir возвращает каталог файла внутри архива для выбранной раскладки.
+// Пустая строка означает корень архива.
+func archiveEntryDir(fileRecord *ent.File, layout ArchiveLayout) string {
+	switch layout {
+	case ArchiveLayoutByFolder:
+		folder, _ := fileRecord.Metadata[ArchiveFolderMetadataKey].(string)
+		return sanitizeArchiveDir(folder)
+	case ArchiveLayoutByDate:
+		return fileRecord.CreateTime.Format("2006-01")
+	case ArchiveLayoutByEntity:
+		return archiveEntityDir(fileRecord)
+	default:
+		return ""
+	}
+}
+
+// archiveEntityDirPrefixes префиксы каталогов архива для типов сущностей; сообщения чата
+// складываются в каталог чата
+var archiveEntityDirPrefixes = map[file.EntityType]string{
+	file.EntityTypeTICKET:  "ticket",
+	file.EntityTypeCOMMENT: "comment",
+	file.EntityTypeMESSAGE: "chat",
+}
+
+// archiveEntityDir возвращает каталог сущности файла; файлы без сущности остаются в корне архива
+func archiveEntityDir(fileRecord *ent.File) string {
+	if fileRecord.EntityType == nil || fileRecord.EntityID == nil {
+		return ""
+	}
+	prefix, ok := archiveEntityDirPrefixes[*fileRecord.EntityType]
+	if !ok {
+		return ""
+	}
+	return prefix + "-" + fileRecord.EntityID.String()
+}
+
+// sanitizeArchiveDir нормализует путь папки: убирает "..", пустые и служебные сегменты,
+// чтобы запись архива не могла выйти за его пределы (zip slip)
+func sanitizeArchiveDir(dir string) string {
+	segments := make([]string, 0, maxArchiveFolderDepth)
+	for _, segment := range strings.Split(strings.ReplaceAll(dir, "\\", "/"), "/") {
+		segment = strings.TrimSpace(segment)
+		if segment == "" || segment == "." || segment == ".." {
+			continue
+		}
+		segments = append(segments, segment)
+		if len(segments) == maxArchiveFolderDepth {
+			break
+		}
+	}
+	return path.Join(segments...)
+}
+
+// archiveEntryName возвращает путь записи в архиве; уникальность имен обеспечивается в пределах каталога
+func (s *FileService) archiveEntryName(fileRecord *ent.File, layout ArchiveLayout, usedFilenames map[string]bool) string {
+	dir := archiveEntryDir(fileRecord, layout)
+	if dir == "" {
+		return s.generateUniqueFilename(fileRecord.OriginalName, usedFilenames)
+	}
+	return s.generateUniqueFilename(path.Join(dir, path.Base(fileRecord.OriginalName)), usedFilenames)
+}
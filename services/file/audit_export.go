@@ -0,0 +1,98 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"main/ent"
+	"main/errcatalog"
+	"main/s3"
+	"main/services/audit"
+	"main/utils"
+	"mime"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// AuditExportResult ссылка на выгрузку аудита во временном хранилище
+type AuditExportResult struct {
+	URL       string
+	ExpiresAt time.Time
+	FileName  string
+	RowCount  int
+}
+
+// ExportAuditLog выгружает события аудита тенанта за период в CSV и возвращает pre-signed URL.
+// Выгрузка доставляется так же, как архивы: CSV пишется в pipe и сразу загружается в temp/,
+// а объект удаляется после истечения ссылки. Период по умолчанию - последние 30 дней, не длиннее 366 дней.
+func (s *FileService) ExportAuditLog(ctx context.Context, client *ent.Client, filter audit.ListFilter) (*AuditExportResult, error) {
+	to := time.Now()
+	if filter.To != nil {
+		to = *filter.To
+	}
+	from := to.Add(-audit.DefaultAggregationPeriod)
+	if filter.From != nil {
+		from = *filter.From
+	}
+	if err := audit.ValidateExportPeriod(ctx, from, to); err != nil {
+		return nil, err
+	}
+	filter.From, filter.To = &from, &to
+
+	fileName := fmt.Sprintf("audit_%s_%s.csv", from.UTC().Format("20060102"), to.UTC().Format("20060102"))
+	storageKey := s.generateTemporaryKey(fmt.Sprintf("audit_%s", from.UTC().Format("20060102")), ".csv")
+
+	pipeReader, pipeWriter := io.Pipe()
+	written := make(chan auditExportWriteResult, 1)
+	go func() {
+		rows, err := s.auditService.WriteCSV(ctx, client, filter, pipeWriter)
+		// Ошибка записи прерывает загрузку в S3, nil завершает поток выгрузки
+		_ = pipeWriter.CloseWithError(err)
+		written <- auditExportWriteResult{rows: rows, err: err}
+	}()
+
+	uploadErr := s.s3Service.UploadTemporaryFile(ctx, pipeReader, storageKey, "text/csv; charset=utf-8")
+	_ = pipeReader.Close()
+	result := <-written
+
+	if result.err != nil && !errors.Is(result.err, io.ErrClosedPipe) {
+		utils.Logger.Error("Failed to write audit export", zap.Error(result.err))
+		return nil, errcatalog.AuditExportFailed(ctx)
+	}
+	if uploadErr != nil {
+		utils.Logger.Error("Failed to upload audit export", zap.Error(uploadErr))
+		return nil, errcatalog.AuditExportFailed(ctx)
+	}
+
+	url, err := s.s3Service.GetPresignedURLWithOptions(ctx, storageKey, DefaultPresignedURLExpiration, s3.PresignOptions{
+		ResponseContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": fileName}),
+	})
+	if err != nil {
+		_ = s.s3Service.DeleteFile(ctx, storageKey)
+		return nil, errcatalog.FileURLGenerationFailed(ctx)
+	}
+
+	// Планируем удаление выгрузки после истечения ссылки (очистка ARCHIVE_CLEANUP_INTERVAL)
+	s.scheduleArchiveDeletion(ctx, storageKey, DefaultPresignedURLExpiration)
+
+	utils.Logger.Info("Audit log exported",
+		zap.Int("rows", result.rows),
+		zap.Time("from", from),
+		zap.Time("to", to),
+		zap.String("storage_key", storageKey))
+
+	return &AuditExportResult{
+		URL:       url,
+		ExpiresAt: time.Now().Add(DefaultPresignedURLExpiration),
+		FileName:  fileName,
+		RowCount:  result.rows,
+	}, nil
+}
+
+// auditExportWriteResult итог записи CSV выгрузки в pipe
+type auditExportWriteResult struct {
+	rows int
+	err  error
+}
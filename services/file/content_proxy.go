@@ -0,0 +1,132 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"main/ent"
+	"main/ent/file"
+	"main/errcatalog"
+	"main/s3"
+	"main/utils"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Ссылки на содержимое через сервис. При шифровании хранилища (s3.S3Service.EncryptionEnabled) pre-signed
+// ссылки S3 отдавали бы шифротекст, поэтому файл, превью изображений и документов выдаются через маршруты
+// /files/{id}/... сервиса. FILE_PROXY_BASE_URL - внешний адрес сервиса (например, адрес gateway);
+// без него возвращается путь относительно адреса, по которому клиент обращается к API.
+
+// fileProxyURL возвращает ссылку на маршрут содержимого файла
+func fileProxyURL(fileID uuid.UUID, path string, query url.Values) string {
+	link := strings.TrimRight(os.Getenv("FILE_PROXY_BASE_URL"), "/") + "/files/" + fileID.String() + path
+	if len(query) > 0 {
+		link += "?" + query.Encode()
+	}
+	return link
+}
+
+// fileContentProxyURL возвращает ссылку на GET /files/{id}/content с параметрами скачивания.
+// Переопределение Content-Type через сервис не поддерживается: отдается тип, определенный по содержимому.
+func fileContentProxyURL(fileID uuid.UUID, disposition DownloadDisposition, watermark bool) string {
+	query := url.Values{}
+	if disposition != DownloadDispositionInline {
+		query.Set("disposition", "attachment")
+	}
+	if watermark {
+		query.Set("watermark", "true")
+	}
+	return fileProxyURL(fileID, "/content", query)
+}
+
+// OpenThumbnailContent проверяет права на скачивание и открывает готовое превью изображения
+// (GET /files/{id}/thumbnail/{size}). Превью, которое еще не построено, не найдено.
+func (s *FileService) OpenThumbnailContent(ctx context.Context, client *ent.Client, fileID uuid.UUID, size ThumbnailSize) (*FileContent, error) {
+	if _, ok := thumbnailMaxSide[size]; !ok {
+		return nil, errcatalog.FileNotFound(ctx)
+	}
+	return s.openDerivedContent(ctx, client, fileID, "thumb-"+strings.ToLower(string(size)), "image/jpeg", func(fileRecord *ent.File) (string, bool) {
+		return ThumbnailStorageKey(fileRecord.StorageKey, size), fileRecord.ThumbnailStatus == file.ThumbnailStatusREADY
+	})
+}
+
+// OpenPreviewContent проверяет права на скачивание и открывает готовое превью документа (GET /files/{id}/preview)
+func (s *FileService) OpenPreviewContent(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*FileContent, error) {
+	return s.openDerivedContent(ctx, client, fileID, "preview", "image/jpeg", func(fileRecord *ent.File) (string, bool) {
+		return PreviewStorageKey(fileRecord.StorageKey), fileRecord.PreviewStatus == file.PreviewStatusREADY
+	})
+}
+
+// OpenPDFRenditionContent проверяет права на скачивание и открывает готовую PDF-версию документа (GET /files/{id}/pdf)
+func (s *FileService) OpenPDFRenditionContent(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*FileContent, error) {
+	return s.openDerivedContent(ctx, client, fileID, "pdf", "application/pdf", func(fileRecord *ent.File) (string, bool) {
+		return PDFRenditionStorageKey(fileRecord.StorageKey), fileRecord.PdfStatus == file.PdfStatusREADY
+	})
+}
+
+// openDerivedContent открывает сохраненную производную копию файла (превью или PDF-версию) с типом contentType;
+// derived возвращает ее ключ и готовность
+func (s *FileService) openDerivedContent(ctx context.Context, client *ent.Client, fileID uuid.UUID, variant, contentType string, derived func(*ent.File) (string, bool)) (*FileContent, error) {
+	// 🔒 [POLICY CHECK] Права как при получении ссылки на скачивание
+	if err := s.canDownloadFile(ctx, client, fileID); err != nil {
+		return nil, err
+	}
+
+	fileRecord, err := client.File.Query().
+		Where(file.ID(fileID)).
+		Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, errcatalog.FileGetFailed(ctx)
+	}
+
+	// 🛡️ [ANTIVIRUS] Зараженные и еще не проверенные файлы не выдаются
+	if err := s.ensureScanAllowsDownload(ctx, client, fileRecord); err != nil {
+		return nil, err
+	}
+
+	storageKey, ready := derived(fileRecord)
+	if !ready {
+		return nil, errcatalog.FileNotFound(ctx)
+	}
+
+	info, err := s.s3Service.GetFileInfo(ctx, storageKey)
+	var body io.ReadCloser
+	if err == nil {
+		body, err = s.s3Service.GetFileObject(ctx, storageKey)
+	}
+	if err != nil {
+		if s3.IsNotFoundError(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		utils.Logger.Error("Failed to open derived file content",
+			zap.Error(err),
+			zap.String("file_id", fileID.String()),
+			zap.String("storage_key", storageKey))
+		if strings.Contains(err.Error(), "S3 credentials are not configured") {
+			return nil, errcatalog.FileS3NotConfigured(ctx)
+		}
+		return nil, errcatalog.FileDownloadFailed(ctx)
+	}
+
+	content := &FileContent{
+		File:        fileRecord,
+		Body:        body,
+		ContentType: contentType,
+		Inline:      true,
+	}
+	if info.ContentLength != nil {
+		content.Size = *info.ContentLength
+	}
+	if fileRecord.ChecksumSha256 != "" {
+		content.ETag = fmt.Sprintf(`"%s-%s"`, fileRecord.ChecksumSha256, variant)
+	}
+	return content, nil
+}
@@ -0,0 +1,217 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/departmentquota"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/services/audit"
+	"main/utils"
+	"sort"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// DepartmentStorageUsage использование хранилища отделом
+type DepartmentStorageUsage struct {
+	DepartmentID uuid.UUID
+	// UsedBytes учитывает и файлы в корзине: они занимают место до окончательного удаления
+	UsedBytes int64
+	FileCount int
+	// QuotaBytes лимит хранилища отдела в байтах (nil - без ограничений)
+	QuotaBytes *int64
+}
+
+// uploadDepartmentID возвращает отдел, за которым учитывается загрузка: первый отдел пользователя из федерации
+func uploadDepartmentID(ctx context.Context) *uuid.UUID {
+	fedCtx := federation.GetContext(ctx)
+	if fedCtx == nil || len(fedCtx.DepartmentIDs) == 0 {
+		return nil
+	}
+	departmentID := fedCtx.DepartmentIDs[0]
+	return &departmentID
+}
+
+// queryDepartmentUsage считает суммарный размер и количество файлов отделов (включая корзину)
+func queryDepartmentUsage(ctx context.Context, client *ent.Client, departmentIDs ...uuid.UUID) (map[uuid.UUID]*DepartmentStorageUsage, error) {
+	query := client.File.Query().Where(file.DepartmentIDNotNil())
+	if len(departmentIDs) > 0 {
+		query = query.Where(file.DepartmentIDIn(departmentIDs...))
+	}
+
+	var rows []struct {
+		DepartmentID uuid.UUID `json:"department_id"`
+		Count        int       `json:"count"`
+		Sum          int64     `json:"sum"`
+	}
+	err := query.
+		GroupBy(file.FieldDepartmentID).
+		Aggregate(ent.Count(), ent.Sum(file.FieldSize)).
+		Scan(mixin.SkipSoftDelete(ent.NewContext(ctx, client)), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make(map[uuid.UUID]*DepartmentStorageUsage, len(rows))
+	for _, row := range rows {
+		usage[row.DepartmentID] = &DepartmentStorageUsage{
+			DepartmentID: row.DepartmentID,
+			UsedBytes:    row.Sum,
+			FileCount:    row.Count,
+		}
+	}
+	return usage, nil
+}
+
+// checkDepartmentQuota проверяет квоту отдела загружающего пользователя. Квота отдела действует
+// жестко независимо от режима квоты тенанта; без заданной квоты загрузка не ограничивается.
+func (s *FileService) checkDepartmentQuota(ctx context.Context, client *ent.Client, filename string, size int64) error {
+	departmentID := uploadDepartmentID(ctx)
+	if departmentID == nil {
+		return nil
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	quota, err := client.DepartmentQuota.Query().
+		Where(departmentquota.DepartmentID(*departmentID)).
+		Only(ctxWithClient)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		utils.Logger.Warn("Failed to get department quota, proceeding without department limit check",
+			zap.Error(err),
+			zap.String("department_id", departmentID.String()))
+		return nil
+	}
+
+	usage, err := queryDepartmentUsage(ctx, client, *departmentID)
+	if err != nil {
+		utils.Logger.Warn("Failed to get department storage usage, proceeding without department limit check",
+			zap.Error(err),
+			zap.String("department_id", departmentID.String()))
+		return nil
+	}
+	var currentUsage int64
+	if departmentUsage, ok := usage[*departmentID]; ok {
+		currentUsage = departmentUsage.UsedBytes
+	}
+
+	if currentUsage+size <= quota.LimitBytes {
+		return nil
+	}
+
+	utils.Logger.Info("Department storage quota exceeded",
+		zap.String("department_id", departmentID.String()),
+		zap.String("filename", filename),
+		zap.Int64("file_size", size),
+		zap.Int64("current_usage", currentUsage),
+		zap.Int64("quota", quota.LimitBytes))
+
+	// 📊 [AUDIT] Фиксируем попытку превышения квоты отдела
+	s.auditService.Record(ctx, client, audit.Event{
+		Action: fileauditevent.ActionLIMIT_VIOLATION,
+		Details: map[string]interface{}{
+			"reason":        "department_quota_exceeded",
+			"department_id": departmentID.String(),
+			"filename":      filename,
+			"file_size":     size,
+			"current_usage": currentUsage,
+			"storage_limit": quota.LimitBytes,
+		},
+	})
+
+	return errcatalog.FileDepartmentQuotaExceeded(ctx)
+}
+
+// GetDepartmentStorageUsage возвращает использование хранилища по отделам тенанта вместе с квотами.
+// Отделы с квотой, но без файлов, возвращаются с нулевым использованием.
+func (s *FileService) GetDepartmentStorageUsage(ctx context.Context, client *ent.Client) ([]*DepartmentStorageUsage, error) {
+	usage, err := queryDepartmentUsage(ctx, client)
+	if err != nil {
+		utils.Logger.Error("Failed to aggregate department storage usage", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+
+	quotas, err := client.DepartmentQuota.Query().All(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Error("Failed to get department quotas", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+	for _, quota := range quotas {
+		departmentUsage, ok := usage[quota.DepartmentID]
+		if !ok {
+			departmentUsage = &DepartmentStorageUsage{DepartmentID: quota.DepartmentID}
+			usage[quota.DepartmentID] = departmentUsage
+		}
+		limit := quota.LimitBytes
+		departmentUsage.QuotaBytes = &limit
+	}
+
+	result := make([]*DepartmentStorageUsage, 0, len(usage))
+	for _, departmentUsage := range usage {
+		result = append(result, departmentUsage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].UsedBytes != result[j].UsedBytes {
+			return result[i].UsedBytes > result[j].UsedBytes
+		}
+		return result[i].DepartmentID.String() < result[j].DepartmentID.String()
+	})
+	return result, nil
+}
+
+// SetDepartmentQuota задает квоту хранилища отдела; nil снимает ограничение
+func (s *FileService) SetDepartmentQuota(ctx context.Context, client *ent.Client, departmentID uuid.UUID, limitBytes *int64) (*DepartmentStorageUsage, error) {
+	if limitBytes != nil && *limitBytes <= 0 {
+		return nil, errcatalog.FileInvalidDepartmentQuota(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	quota, err := client.DepartmentQuota.Query().
+		Where(departmentquota.DepartmentID(departmentID)).
+		Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errcatalog.FileDepartmentQuotaUpdateFailed(ctx)
+	}
+
+	switch {
+	case limitBytes == nil && quota != nil:
+		err = client.DepartmentQuota.DeleteOne(quota).Exec(ctxWithClient)
+	case limitBytes != nil && quota == nil:
+		err = client.DepartmentQuota.Create().
+			SetDepartmentID(departmentID).
+			SetLimitBytes(*limitBytes).
+			Exec(ctxWithClient)
+	case limitBytes != nil:
+		err = client.DepartmentQuota.UpdateOne(quota).
+			SetLimitBytes(*limitBytes).
+			Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save department quota", zap.Error(err), zap.String("department_id", departmentID.String()))
+		return nil, errcatalog.FileDepartmentQuotaUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Department storage quota changed",
+		zap.String("department_id", departmentID.String()),
+		zap.Int64p("limit_bytes", limitBytes))
+
+	usage, err := queryDepartmentUsage(ctx, client, departmentID)
+	if err != nil {
+		utils.Logger.Error("Failed to aggregate department storage usage", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+	result, ok := usage[departmentID]
+	if !ok {
+		result = &DepartmentStorageUsage{DepartmentID: departmentID}
+	}
+	result.QuotaBytes = limitBytes
+	return result, nil
+}
@@ -0,0 +1,90 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/errcatalog"
+	"main/s3"
+	"mime"
+	"strings"
+)
+
+// DownloadDisposition определяет, как браузер открывает файл по ссылке скачивания
+type DownloadDisposition string
+
+const (
+	// DownloadDispositionAttachment файл сохраняется на диск (поведение по умолчанию)
+	DownloadDispositionAttachment DownloadDisposition = "ATTACHMENT"
+	// DownloadDispositionInline файл открывается в браузере (встраивание изображений и PDF в интерфейс)
+	DownloadDispositionInline DownloadDisposition = "INLINE"
+)
+
+// DownloadURLOptions параметры ссылки на скачивание одиночного файла
+type DownloadURLOptions struct {
+	// Disposition способ открытия файла (пустое значение - ATTACHMENT)
+	Disposition DownloadDisposition
+	// ContentType переопределяет Content-Type ответа S3 (по умолчанию - тип, определенный по содержимому)
+	ContentType *string
+	// Watermark выдает PDF копией с email пользователя и временем на каждой странице
+	Watermark bool
+	// ExpiresIn время жизни ссылки в секундах (nil - DefaultPresignedURLExpiration, не больше MaxPresignedURLExpiration)
+	ExpiresIn *int
+}
+
+// inlineSafeMimeTypes типы, которые браузер отображает без выполнения скриптов.
+// HTML, SVG и XML открываются только как вложение: при inline они исполняются в контексте хранилища.
+var inlineSafeMimeTypes = map[string]bool{
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"image/bmp":       true,
+	"image/avif":      true,
+	"application/pdf": true,
+	"text/plain":      true,
+}
+
+// isInlineSafeMimeType проверяет, можно ли открыть файл с таким типом в браузере
+func isInlineSafeMimeType(mimeType string) bool {
+	return inlineSafeMimeTypes[mimeType] ||
+		strings.HasPrefix(mimeType, "video/") ||
+		strings.HasPrefix(mimeType, "audio/")
+}
+
+// downloadPresignOptions возвращает заголовки ответа для ссылки на файл и фактический способ открытия.
+// Файлы небезопасных для inline типов отдаются как вложение, даже если запрошен INLINE.
+func downloadPresignOptions(ctx context.Context, fileRecord *ent.File, options DownloadURLOptions) (s3.PresignOptions, DownloadDisposition, error) {
+	contentType := fileRecord.DetectedMimeType
+	if contentType == "" {
+		contentType = fileRecord.MimeType
+	}
+	if options.ContentType != nil {
+		contentType = strings.TrimSpace(*options.ContentType)
+		if _, _, err := mime.ParseMediaType(contentType); err != nil || !strings.Contains(contentType, "/") {
+			return s3.PresignOptions{}, "", errcatalog.FileInvalidResponseContentType(ctx)
+		}
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = ""
+	}
+
+	disposition := DownloadDispositionAttachment
+	if options.Disposition == DownloadDispositionInline && isInlineSafeMimeType(strings.ToLower(mediaType)) {
+		disposition = DownloadDispositionInline
+	}
+
+	dispositionType := strings.ToLower(string(disposition))
+	// FormatMediaType кодирует имена не в ASCII по RFC 2231 (filename*=utf-8''...)
+	header := mime.FormatMediaType(dispositionType, map[string]string{"filename": fileRecord.OriginalName})
+	if header == "" {
+		header = dispositionType
+	}
+
+	presign := s3.PresignOptions{ResponseContentDisposition: header}
+	if options.ContentType != nil || disposition == DownloadDispositionInline {
+		presign.ResponseContentType = contentType
+	}
+	return presign, disposition, nil
+}
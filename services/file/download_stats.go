@@ -0,0 +1,63 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/errcatalog"
+	"main/utils"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultTopDownloadedLimit количество файлов в рейтинге скачиваний по умолчанию
+	defaultTopDownloadedLimit = 20
+	// maxTopDownloadedLimit максимальное количество файлов в рейтинге скачиваний
+	maxTopDownloadedLimit = 100
+)
+
+// recordDownloads увеличивает счетчики скачиваний файлов и фиксирует время последнего скачивания.
+// Ошибка не прерывает скачивание.
+func (s *FileService) recordDownloads(ctx context.Context, client *ent.Client, fileIDs ...uuid.UUID) {
+	if len(fileIDs) == 0 {
+		return
+	}
+	err := client.File.Update().
+		Where(file.IDIn(fileIDs...)).
+		AddDownloadCount(1).
+		SetLastDownloadedAt(time.Now()).
+		Exec(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Warn("Failed to update file download statistics", zap.Error(err))
+	}
+}
+
+// TopDownloadedFiles возвращает самые скачиваемые файлы тенанта и общее количество скачанных файлов
+func (s *FileService) TopDownloadedFiles(ctx context.Context, client *ent.Client, limit int) ([]*ent.File, int, error) {
+	if limit <= 0 {
+		limit = defaultTopDownloadedLimit
+	}
+	if limit > maxTopDownloadedLimit {
+		limit = maxTopDownloadedLimit
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	query := client.File.Query().Where(file.DownloadCountGT(0))
+
+	totalCount, err := query.Clone().Count(ctxWithClient)
+	if err != nil {
+		return nil, 0, errcatalog.FileGetFilesFailed(ctx)
+	}
+
+	files, err := query.
+		Order(ent.Desc(file.FieldDownloadCount), ent.Desc(file.FieldLastDownloadedAt), ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(ctxWithClient)
+	if err != nil {
+		return nil, 0, errcatalog.FileGetFilesFailed(ctx)
+	}
+	return files, totalCount, nil
+}
@@ -0,0 +1,169 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/errcatalog"
+	"main/utils"
+	"sort"
+
+	"entgo.io/ent/dialect/sql"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultDuplicateGroupsLimit количество групп дублей в отчете по умолчанию
+	defaultDuplicateGroupsLimit = 20
+	// maxDuplicateGroupsLimit максимальное количество групп дублей на странице отчета
+	maxDuplicateGroupsLimit = 100
+	// maxDuplicateGroupFiles количество файлов, возвращаемых для каждой группы
+	maxDuplicateGroupFiles = 20
+)
+
+// DuplicateMatch признак, по которому файлы считаются одинаковыми
+type DuplicateMatch string
+
+const (
+	// DuplicateMatchChecksum одинаковый SHA-256 содержимого
+	DuplicateMatchChecksum DuplicateMatch = "CHECKSUM"
+	// DuplicateMatchNameSize одинаковые имя и размер (файлы, загруженные до вычисления контрольной суммы)
+	DuplicateMatchNameSize DuplicateMatch = "NAME_SIZE"
+)
+
+// DuplicateFileGroup группа одинаковых файлов тенанта
+type DuplicateFileGroup struct {
+	MatchedBy DuplicateMatch
+	// ChecksumSha256 задан для DuplicateMatchChecksum, Name - для DuplicateMatchNameSize
+	ChecksumSha256 string
+	Name           string
+	Size           int64
+	FileCount      int
+	// WastedBytes место, которое освободится, если оставить один файл группы
+	WastedBytes int64
+	// Files файлы группы (не более maxDuplicateGroupFiles), начиная с самого раннего
+	Files []*ent.File
+}
+
+// DuplicateFilesReport отчет о дублях файлов тенанта
+type DuplicateFilesReport struct {
+	Groups           []*DuplicateFileGroup
+	TotalGroups      int
+	TotalWastedBytes int64
+}
+
+// duplicateGroupRow строка группировки файлов
+type duplicateGroupRow struct {
+	ChecksumSha256 string `json:"checksum_sha256"`
+	OriginalName   string `json:"original_name"`
+	Size           int64  `json:"size"`
+	Count          int    `json:"count"`
+}
+
+// FindDuplicateFiles группирует файлы тенанта вне корзины по SHA-256 содержимого, а файлы без контрольной
+// суммы - по имени и размеру. Группы упорядочены по лишнему объему; файлы загружаются только для страницы.
+func (s *FileService) FindDuplicateFiles(ctx context.Context, client *ent.Client, limit, offset int) (*DuplicateFilesReport, error) {
+	if limit <= 0 {
+		limit = defaultDuplicateGroupsLimit
+	}
+	if limit > maxDuplicateGroupsLimit {
+		limit = maxDuplicateGroupsLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	byChecksum, err := duplicateGroupRows(ctxWithClient, client.File.Query().
+		Where(file.ChecksumSha256NotNil(), file.ChecksumSha256NEQ("")),
+		file.FieldChecksumSha256, file.FieldSize)
+	if err != nil {
+		utils.Logger.Error("Failed to group files by checksum", zap.Error(err))
+		return nil, errcatalog.FileDuplicateReportFailed(ctx)
+	}
+	byNameSize, err := duplicateGroupRows(ctxWithClient, client.File.Query().
+		Where(file.Or(file.ChecksumSha256IsNil(), file.ChecksumSha256EQ(""))),
+		file.FieldOriginalName, file.FieldSize)
+	if err != nil {
+		utils.Logger.Error("Failed to group files by name and size", zap.Error(err))
+		return nil, errcatalog.FileDuplicateReportFailed(ctx)
+	}
+
+	report := &DuplicateFilesReport{Groups: []*DuplicateFileGroup{}}
+	groups := make([]*DuplicateFileGroup, 0, len(byChecksum)+len(byNameSize))
+	for _, row := range byChecksum {
+		groups = append(groups, newDuplicateFileGroup(DuplicateMatchChecksum, row))
+	}
+	for _, row := range byNameSize {
+		groups = append(groups, newDuplicateFileGroup(DuplicateMatchNameSize, row))
+	}
+	for _, group := range groups {
+		report.TotalWastedBytes += group.WastedBytes
+	}
+	report.TotalGroups = len(groups)
+
+	// Порядок стабилен между страницами: при равном объеме - по ключу группы
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].WastedBytes != groups[j].WastedBytes {
+			return groups[i].WastedBytes > groups[j].WastedBytes
+		}
+		if groups[i].MatchedBy != groups[j].MatchedBy {
+			return groups[i].MatchedBy < groups[j].MatchedBy
+		}
+		return groups[i].ChecksumSha256+groups[i].Name < groups[j].ChecksumSha256+groups[j].Name
+	})
+	if offset >= len(groups) {
+		return report, nil
+	}
+	groups = groups[offset:min(offset+limit, len(groups))]
+
+	for _, group := range groups {
+		query := client.File.Query().Where(file.Size(group.Size))
+		if group.MatchedBy == DuplicateMatchChecksum {
+			query = query.Where(file.ChecksumSha256EQ(group.ChecksumSha256))
+		} else {
+			query = query.Where(file.OriginalName(group.Name), file.Or(file.ChecksumSha256IsNil(), file.ChecksumSha256EQ("")))
+		}
+		group.Files, err = query.
+			Order(ent.Asc(file.FieldCreateTime), ent.Asc(file.FieldID)).
+			Limit(maxDuplicateGroupFiles).
+			All(ctxWithClient)
+		if err != nil {
+			utils.Logger.Error("Failed to load duplicate files", zap.Error(err))
+			return nil, errcatalog.FileDuplicateReportFailed(ctx)
+		}
+	}
+	report.Groups = groups
+
+	return report, nil
+}
+
+// duplicateGroupRows возвращает группы выборки по полям с двумя и более файлами
+func duplicateGroupRows(ctx context.Context, query *ent.FileQuery, fields ...string) ([]duplicateGroupRow, error) {
+	var rows []duplicateGroupRow
+	err := query.Modify(func(sel *sql.Selector) {
+		columns := make([]string, 0, len(fields))
+		for _, field := range fields {
+			columns = append(columns, sel.C(field))
+		}
+		sel.Select(columns...).
+			AppendSelectExprAs(sql.Raw("COUNT(*)"), "count").
+			GroupBy(columns...).
+			Having(sql.GT("COUNT(*)", 1))
+	}).Scan(ctx, &rows)
+	return rows, err
+}
+
+// newDuplicateFileGroup создает группу дублей по строке группировки
+func newDuplicateFileGroup(matchedBy DuplicateMatch, row duplicateGroupRow) *DuplicateFileGroup {
+	return &DuplicateFileGroup{
+		MatchedBy:      matchedBy,
+		ChecksumSha256: row.ChecksumSha256,
+		Name:           row.OriginalName,
+		Size:           row.Size,
+		FileCount:      row.Count,
+		WastedBytes:    row.Size * int64(row.Count-1),
+		Files:          []*ent.File{},
+	}
+}
@@ -0,0 +1,197 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/errcatalog"
+	"main/services/audit"
+	"main/utils"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RangeNotSatisfiableError запрошенный диапазон не пересекается с содержимым файла (HTTP 416)
+type RangeNotSatisfiableError struct {
+	// Size размер файла для заголовка Content-Range: bytes */size
+	Size int64
+}
+
+func (e *RangeNotSatisfiableError) Error() string {
+	return fmt.Sprintf("requested range not satisfiable for %d bytes", e.Size)
+}
+
+// ByteRange диапазон байт содержимого, границы включительно (как в заголовке Range)
+type ByteRange struct {
+	Start int64
+	End   int64
+}
+
+// Length возвращает количество байт диапазона
+func (r ByteRange) Length() int64 {
+	return r.End - r.Start + 1
+}
+
+// FileContent поток содержимого файла для HTTP ответа
+type FileContent struct {
+	File *ent.File
+	Body io.ReadCloser
+	// Range отдаваемый диапазон; nil - файл целиком
+	Range *ByteRange
+	// Size полный размер содержимого (для Content-Range и Content-Length без диапазона)
+	Size int64
+	// ContentType тип содержимого, определенный при загрузке
+	ContentType string
+	// ETag строгий валидатор по SHA-256 содержимого (пустой для файлов без контрольной суммы)
+	ETag string
+	// Inline файл можно открыть в браузере (см. isInlineSafeMimeType), иначе он отдается как вложение
+	Inline bool
+}
+
+// ParseByteRange разбирает заголовок Range для файла размера size. Поддерживается один диапазон
+// (bytes=a-b, bytes=a-, bytes=-n); пустой, некорректный или составной заголовок означает файл целиком,
+// как разрешает RFC 9110. Диапазон за пределами файла возвращает RangeNotSatisfiableError.
+func ParseByteRange(header string, size int64) (*ByteRange, error) {
+	spec, ok := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !ok || spec == "" || strings.Contains(spec, ",") {
+		return nil, nil
+	}
+	startValue, endValue, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return nil, nil
+	}
+
+	if startValue == "" {
+		// Суффикс: последние n байт
+		suffix, err := strconv.ParseInt(endValue, 10, 64)
+		if err != nil || suffix < 0 {
+			return nil, nil
+		}
+		if suffix == 0 || size == 0 {
+			return nil, &RangeNotSatisfiableError{Size: size}
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return &ByteRange{Start: size - suffix, End: size - 1}, nil
+	}
+
+	start, err := strconv.ParseInt(startValue, 10, 64)
+	if err != nil || start < 0 {
+		return nil, nil
+	}
+	end := size - 1
+	if endValue != "" {
+		if end, err = strconv.ParseInt(endValue, 10, 64); err != nil || end < start {
+			return nil, nil
+		}
+		if end > size-1 {
+			end = size - 1
+		}
+	}
+	if start >= size {
+		return nil, &RangeNotSatisfiableError{Size: size}
+	}
+	return &ByteRange{Start: start, End: end}, nil
+}
+
+// FileContentOptions параметры отдачи содержимого файла (ссылка getFileDownloadURL при шифровании хранилища)
+type FileContentOptions struct {
+	// Attachment отдает файл вложением даже для типов, безопасных для открытия в браузере
+	Attachment bool
+	// Watermark отдает PDF копией с водяным знаком, как DownloadURLOptions.Watermark
+	Watermark bool
+}
+
+// OpenFileContent проверяет права на скачивание и открывает поток содержимого файла из S3 для отдачи
+// через сервис без pre-signed ссылок. ifRange - заголовок If-Range: при несовпадении с ETag диапазон
+// игнорируется. Скачивание фиксируется в аудите только для запроса с начала файла, чтобы перемотка
+// видео не создавала событие на каждый диапазон. Если тенант требует водяной знак, PDF отдается его копией.
+func (s *FileService) OpenFileContent(ctx context.Context, client *ent.Client, fileID uuid.UUID, rangeHeader, ifRange string, options FileContentOptions) (*FileContent, error) {
+	// 🔒 [POLICY CHECK] Права как при получении ссылки на скачивание
+	if err := s.canDownloadFile(ctx, client, fileID); err != nil {
+		return nil, err
+	}
+
+	fileRecord, err := client.File.Query().
+		Where(file.ID(fileID)).
+		Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, errcatalog.FileGetFailed(ctx)
+	}
+
+	// 🛡️ [ANTIVIRUS] Зараженные и еще не проверенные файлы не выдаются
+	if err := s.ensureScanAllowsDownload(ctx, client, fileRecord); err != nil {
+		return nil, err
+	}
+
+	// 🔏 [WATERMARK] Тенант с обязательным водяным знаком получает копию PDF и при потоковой отдаче
+	watermark, err := s.pdfWatermarkFor(ctx, client, fileRecord, options.Watermark)
+	if err != nil {
+		return nil, err
+	}
+	storageKey := fileRecord.StorageKey
+	content := &FileContent{
+		File:        fileRecord,
+		Size:        fileRecord.Size,
+		ContentType: fileRecord.DetectedMimeType,
+	}
+	if watermark != nil {
+		storageKey, content.Size = watermark.StorageKey, watermark.Size
+	}
+	if content.ContentType == "" {
+		content.ContentType = fileRecord.MimeType
+	}
+	content.Inline = !options.Attachment && isInlineSafeMimeType(strings.ToLower(content.ContentType))
+	// Копия с водяным знаком строится заново каждый день, поэтому не получает строгого валидатора
+	if fileRecord.ChecksumSha256 != "" && watermark == nil {
+		content.ETag = `"` + fileRecord.ChecksumSha256 + `"`
+	}
+
+	if ifRange == "" || (content.ETag != "" && ifRange == content.ETag) {
+		if content.Range, err = ParseByteRange(rangeHeader, content.Size); err != nil {
+			return nil, err
+		}
+	}
+
+	byteRange := ByteRange{Start: 0, End: content.Size - 1}
+	if content.Range != nil {
+		byteRange = *content.Range
+	}
+	if content.Size == 0 {
+		content.Body = io.NopCloser(strings.NewReader(""))
+	} else if content.Body, err = s.s3Service.GetFileObjectRange(ctx, storageKey, byteRange.Start, byteRange.End); err != nil {
+		utils.Logger.Error("Failed to open file content",
+			zap.Error(err),
+			zap.String("file_id", fileID.String()),
+			zap.String("storage_key", storageKey))
+		if strings.Contains(err.Error(), "S3 credentials are not configured") {
+			return nil, errcatalog.FileS3NotConfigured(ctx)
+		}
+		return nil, errcatalog.FileDownloadFailed(ctx)
+	}
+
+	if byteRange.Start == 0 {
+		// 📊 [AUDIT] Фиксируем потоковое скачивание
+		auditEvent := audit.Event{
+			Action: fileauditevent.ActionCONTENT_STREAMED,
+			FileID: &fileID,
+		}
+		if watermark != nil {
+			auditEvent.Details = map[string]interface{}{"watermarked": true}
+		}
+		s.auditService.Record(ctx, client, auditEvent)
+		s.recordDownloads(ctx, client, fileID)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,206 @@
+package file
+
+import (
+	"context"
+	"main/database"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/errcatalog"
+	"main/services/audit"
+	"main/utils"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// FileEntityTarget сущность другого сервиса (тикет, комментарий, сообщение чата), к которой копируется или переносится файл
+type FileEntityTarget struct {
+	EntityType file.EntityType
+	EntityID   uuid.UUID
+}
+
+// CopyFile создает копию файла, прикрепленную к другой сущности. Объект копируется на стороне S3,
+// копия принадлежит текущему пользователю и наследует описание, метаданные, теги и результат антивирусной проверки.
+// Одинаковые имена в целевой сущности обрабатываются по режиму тенанта, как при загрузке.
+func (s *FileService) CopyFile(ctx context.Context, client *ent.Client, fileID uuid.UUID, target FileEntityTarget) (*ent.File, *FilenameConflict, error) {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, nil, err
+	}
+
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	// Копировать можно файлы, которые пользователь может скачать
+	if err := s.canDownloadFile(ctx, client, fileID); err != nil {
+		return nil, nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	source, err := client.File.Query().
+		Where(file.ID(fileID)).
+		WithTags().
+		Only(ctxWithClient)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, nil, errcatalog.FileGetFailed(ctx)
+	}
+	// Зараженные и непроверенные файлы не копируются
+	if err := s.ensureScanAllowsDownload(ctx, client, source); err != nil {
+		return nil, nil, err
+	}
+
+	// 📊 [STORAGE LIMIT CHECK] Копия занимает место в хранилище так же, как новая загрузка
+	if err := s.checkUploadStorageLimit(ctx, client, source.OriginalName, source.Size); err != nil {
+		return nil, nil, err
+	}
+
+	filename, conflict := s.resolveFilenameConflict(ctx, client, source.OriginalName,
+		filenameConflictScope(&target.EntityType, &target.EntityID, nil))
+
+	storageKey, err := s.s3Service.NewStorageKey(ctx, filename)
+	if err != nil {
+		utils.Logger.Error("Failed to generate storage key for file copy", zap.Error(err))
+		return nil, nil, errcatalog.FileCopyFailed(ctx)
+	}
+	if err := s.s3Service.CopyFile(ctx, source.StorageKey, storageKey, source.Size); err != nil {
+		utils.Logger.Error("Failed to copy file object",
+			zap.Error(err),
+			zap.String("file_id", fileID.String()),
+			zap.String("storage_key", source.StorageKey))
+		return nil, nil, errcatalog.FileCopyFailed(ctx)
+	}
+	cleanup := func(ctx context.Context) {
+		if err := s.s3Service.DeleteFile(ctx, storageKey); err != nil {
+			utils.Logger.Error("Failed to cleanup copied S3 object",
+				zap.Error(err),
+				zap.String("storage_key", storageKey))
+		}
+	}
+
+	// Превью привязаны к ключу объекта, поэтому для копии они создаются заново
+	copied, err := client.File.Create().
+		SetOriginalName(filename).
+		SetStorageKey(storageKey).
+		SetMimeType(source.MimeType).
+		SetDetectedMimeType(source.DetectedMimeType).
+		SetSize(source.Size).
+		SetChecksumSha256(source.ChecksumSha256).
+		SetThumbnailStatus(initialThumbnailStatus(source.MimeType)).
+		SetPreviewStatus(initialPreviewStatus(source.MimeType)).
+		SetPdfStatus(s.initialPDFStatus(source.MimeType)).
+		SetScanStatus(source.ScanStatus).
+		SetCreatedBy(*userID).
+		SetDescription(source.Description).
+		SetMetadata(source.Metadata).
+		SetNillableExpiresAt(source.ExpiresAt).
+		SetEntityType(target.EntityType).
+		SetEntityID(target.EntityID).
+		SetNillableDepartmentID(uploadDepartmentID(ctx)).
+		AddTags(source.Edges.Tags...).
+		Save(ctxWithClient)
+	if err != nil {
+		cleanup(ctx)
+		if isMetadataSchemaError(err) {
+			return nil, nil, err
+		}
+		utils.Logger.Error("Failed to create file copy", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, nil, errcatalog.FileCopyFailed(ctx)
+	}
+
+	// При откате транзакции резолвера скопированный объект не нужен
+	database.OnRollback(ctx, cleanup)
+
+	if copied.ThumbnailStatus == file.ThumbnailStatusPENDING {
+		database.OnCommit(ctx, func(context.Context) { requestThumbnails() })
+	}
+	if copied.PreviewStatus == file.PreviewStatusPENDING {
+		database.OnCommit(ctx, func(context.Context) { requestPreviews() })
+	}
+	if copied.PdfStatus == file.PdfStatusPENDING {
+		database.OnCommit(ctx, func(context.Context) { requestPDFRenditions() })
+	}
+
+	// 📊 [AUDIT] Фиксируем копирование: событие относится к копии и ссылается на исходный файл
+	s.auditService.Record(ctx, client, audit.Event{
+		Action: fileauditevent.ActionCOPY,
+		FileID: &copied.ID,
+		Details: map[string]interface{}{
+			"filename":       copied.OriginalName,
+			"size":           copied.Size,
+			"source_file_id": source.ID.String(),
+			"entity_type":    target.EntityType.String(),
+			"entity_id":      target.EntityID.String(),
+		},
+	})
+
+	return copied, conflict, nil
+}
+
+// MoveFile прикрепляет файл к другой сущности. Объект в S3 не меняется, меняется только привязка записи.
+// Одинаковые имена в целевой сущности обрабатываются по режиму тенанта, как при загрузке.
+func (s *FileService) MoveFile(ctx context.Context, client *ent.Client, fileID uuid.UUID, target FileEntityTarget) (*ent.File, *FilenameConflict, error) {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, nil, err
+	}
+
+	// Переносить можно файлы, которые пользователь может редактировать
+	if err := s.CanUpdateFile(ctx, client, fileID); err != nil {
+		return nil, nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	source, err := client.File.Get(ctxWithClient, fileID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, nil, errcatalog.FileGetFailed(ctx)
+	}
+	if source.EntityType != nil && *source.EntityType == target.EntityType &&
+		source.EntityID != nil && *source.EntityID == target.EntityID {
+		return nil, nil, errcatalog.FileAlreadyAttached(ctx)
+	}
+
+	filename, conflict := s.resolveFilenameConflict(ctx, client, source.OriginalName,
+		filenameConflictScope(&target.EntityType, &target.EntityID, nil))
+
+	moved, err := client.File.UpdateOneID(fileID).
+		SetOriginalName(filename).
+		SetEntityType(target.EntityType).
+		SetEntityID(target.EntityID).
+		Save(ctxWithClient)
+	if err != nil {
+		if isMetadataSchemaError(err) {
+			return nil, nil, err
+		}
+		utils.Logger.Error("Failed to move file", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, nil, errcatalog.FileMoveFailed(ctx)
+	}
+
+	// 📊 [AUDIT] Фиксируем перенос с прежней и новой сущностью
+	details := map[string]interface{}{
+		"filename":    moved.OriginalName,
+		"entity_type": target.EntityType.String(),
+		"entity_id":   target.EntityID.String(),
+	}
+	if source.EntityType != nil && source.EntityID != nil {
+		details["previous_entity_type"] = source.EntityType.String()
+		details["previous_entity_id"] = source.EntityID.String()
+	}
+	if moved.OriginalName != source.OriginalName {
+		details["previous_filename"] = source.OriginalName
+	}
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:  fileauditevent.ActionMOVE,
+		FileID:  &fileID,
+		Details: details,
+	})
+
+	return moved, conflict, nil
+}
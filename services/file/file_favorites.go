@@ -0,0 +1,91 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/filefavorite"
+	"main/ent/predicate"
+	"main/errcatalog"
+	"main/utils"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// FavoriteOf возвращает условие выборки файлов, добавленных пользователем в избранное
+func FavoriteOf(userID uuid.UUID) predicate.File {
+	return file.HasFavoritesWith(filefavorite.UserID(userID))
+}
+
+// StarFile добавляет файл в избранное текущего пользователя. Добавить можно файл, который пользователь может скачать;
+// повторное добавление не считается ошибкой.
+func (s *FileService) StarFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*ent.File, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	if err := s.canDownloadFile(ctx, client, fileID); err != nil {
+		return nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	exists, err := client.FileFavorite.Query().
+		Where(
+			filefavorite.FileID(fileID),
+			filefavorite.UserID(*userID),
+		).
+		Exist(ctxWithClient)
+	if err != nil {
+		utils.Logger.Error("Failed to check favorite file", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, errcatalog.FileFavoriteFailed(ctx)
+	}
+	if !exists {
+		err := client.FileFavorite.Create().
+			SetFileID(fileID).
+			SetUserID(*userID).
+			Exec(ctxWithClient)
+		if err != nil {
+			utils.Logger.Error("Failed to star file", zap.Error(err), zap.String("file_id", fileID.String()))
+			return nil, errcatalog.FileFavoriteFailed(ctx)
+		}
+	}
+
+	return s.getFavoriteFile(ctx, client, fileID)
+}
+
+// UnstarFile убирает файл из избранного текущего пользователя; отсутствие файла в избранном не считается ошибкой
+func (s *FileService) UnstarFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*ent.File, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	_, err := client.FileFavorite.Delete().
+		Where(
+			filefavorite.FileID(fileID),
+			filefavorite.UserID(*userID),
+		).
+		Exec(ctxWithClient)
+	if err != nil {
+		utils.Logger.Error("Failed to unstar file", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, errcatalog.FileFavoriteFailed(ctx)
+	}
+
+	return s.getFavoriteFile(ctx, client, fileID)
+}
+
+// getFavoriteFile возвращает файл после изменения избранного
+func (s *FileService) getFavoriteFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*ent.File, error) {
+	fileRecord, err := client.File.Get(ent.NewContext(ctx, client), fileID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, errcatalog.FileGetFailed(ctx)
+	}
+	return fileRecord, nil
+}
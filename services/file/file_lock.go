@@ -0,0 +1,134 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/errcatalog"
+	"main/services/audit"
+	"main/utils"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// LockFile берет файл на редактирование текущим пользователем (check-out). Пока блокировка не снята,
+// остальные пользователи, включая администраторов, не могут изменять, переносить и удалять файл.
+// Повторная блокировка своим же пользователем не считается ошибкой.
+func (s *FileService) LockFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*ent.File, error) {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	// Блокировать можно файлы, которые пользователь может редактировать; чужая блокировка проверяется там же
+	if err := s.CanUpdateFile(ctx, client, fileID); err != nil {
+		return nil, err
+	}
+	userID := federation.GetUserID(ctx)
+
+	// Условие на locked_by защищает от одновременной блокировки двумя пользователями
+	ctxWithClient := ent.NewContext(ctx, client)
+	affected, err := client.File.Update().
+		Where(
+			file.ID(fileID),
+			file.Or(file.LockedByIsNil(), file.LockedBy(*userID)),
+		).
+		SetLockedBy(*userID).
+		SetLockedAt(time.Now()).
+		Save(ctxWithClient)
+	if err != nil {
+		utils.Logger.Error("Failed to lock file", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, errcatalog.FileLockFailed(ctx)
+	}
+	if affected == 0 {
+		return nil, errcatalog.FileLockedByOther(ctx)
+	}
+
+	// 📊 [AUDIT] Фиксируем блокировку
+	s.auditService.Record(ctx, client, audit.Event{
+		Action: fileauditevent.ActionFILE_LOCKED,
+		FileID: &fileID,
+	})
+
+	return s.getLockedFile(ctx, client, fileID)
+}
+
+// UnlockFile снимает блокировку файла (check-in). Снять блокировку может ее владелец, а администратор -
+// принудительно снять чужую. Снятие блокировки с незаблокированного файла не считается ошибкой.
+func (s *FileService) UnlockFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*ent.File, error) {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	fileRecord, err := s.getLockedFile(ctx, client, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if fileRecord.LockedBy == nil {
+		if err := s.CanUpdateFile(ctx, client, fileID); err != nil {
+			return nil, err
+		}
+		return fileRecord, nil
+	}
+
+	lockedBy := *fileRecord.LockedBy
+	forced := lockedBy != *userID
+	if forced && !s.hasAdminRole(ctx) {
+		return nil, errcatalog.FileLockedByOther(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	err = client.File.UpdateOneID(fileID).
+		Where(file.LockedBy(lockedBy)).
+		ClearLockedBy().
+		ClearLockedAt().
+		Exec(ctxWithClient)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Блокировка изменилась между чтением и обновлением
+			return nil, errcatalog.FileLockedByOther(ctx)
+		}
+		utils.Logger.Error("Failed to unlock file", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, errcatalog.FileUnlockFailed(ctx)
+	}
+
+	// 📊 [AUDIT] Принудительное снятие отмечается вместе с владельцем блокировки
+	auditEvent := audit.Event{
+		Action: fileauditevent.ActionFILE_UNLOCKED,
+		FileID: &fileID,
+	}
+	if forced {
+		auditEvent.Details = map[string]interface{}{"forced": true, "locked_by": lockedBy.String()}
+	}
+	s.auditService.Record(ctx, client, auditEvent)
+
+	return s.getLockedFile(ctx, client, fileID)
+}
+
+// ensureNotLockedByOther возвращает ошибку, если файл заблокирован другим пользователем
+func ensureNotLockedByOther(ctx context.Context, fileRecord *ent.File, userID uuid.UUID) error {
+	if fileRecord.LockedBy != nil && *fileRecord.LockedBy != userID {
+		return errcatalog.FileLockedByOther(ctx)
+	}
+	return nil
+}
+
+// getLockedFile возвращает файл после изменения блокировки
+func (s *FileService) getLockedFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*ent.File, error) {
+	fileRecord, err := client.File.Get(ent.NewContext(ctx, client), fileID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, errcatalog.FileGetFailed(ctx)
+	}
+	return fileRecord, nil
+}
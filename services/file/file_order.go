@@ -0,0 +1,70 @@
+package file
+
+import (
+	"fmt"
+	"main/config"
+	"main/ent"
+	"main/utils"
+	"strings"
+
+	"entgo.io/contrib/entgql"
+	"go.uber.org/zap"
+)
+
+// FileConnectionOrder возвращает сортировку для connection файлов: orderBy клиента или сортировку
+// по умолчанию из FILES_DEFAULT_ORDER. Последним ключом пагинация всегда добавляет ID,
+// поэтому файлы с одинаковыми значениями полей сортировки не пропускаются и не дублируются между страницами.
+func FileConnectionOrder(orderBy []*ent.FileOrder) []*ent.FileOrder {
+	if len(orderBy) > 0 {
+		return orderBy
+	}
+
+	value := config.Get().FilesDefaultOrder
+	order, err := ParseFileOrder(value)
+	if err != nil {
+		utils.Logger.Warn("Invalid FILES_DEFAULT_ORDER, using default",
+			zap.String("value", value),
+			zap.Error(err))
+		order, _ = ParseFileOrder(config.DefaultFilesOrder)
+	}
+	return order
+}
+
+// ParseFileOrder разбирает сортировку вида "CREATE_TIME:DESC,NAME:ASC" (направление по умолчанию ASC)
+func ParseFileOrder(value string) ([]*ent.FileOrder, error) {
+	order := make([]*ent.FileOrder, 0)
+	seen := make(map[string]bool)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		name, direction, _ := strings.Cut(item, ":")
+		name = strings.ToUpper(strings.TrimSpace(name))
+
+		field := &ent.FileOrderField{}
+		if err := field.UnmarshalGQL(name); err != nil {
+			return nil, err
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate order field %q", name)
+		}
+		seen[name] = true
+
+		orderDirection := entgql.OrderDirectionAsc
+		if direction = strings.ToUpper(strings.TrimSpace(direction)); direction != "" {
+			orderDirection = entgql.OrderDirection(direction)
+			if err := orderDirection.Validate(); err != nil {
+				return nil, err
+			}
+		}
+
+		order = append(order, &ent.FileOrder{Field: field, Direction: orderDirection})
+	}
+
+	if len(order) == 0 {
+		return nil, fmt.Errorf("order is empty")
+	}
+	return order, nil
+}
@@ -0,0 +1,196 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"main/ent"
+
+	"entgo.io/contrib/entgql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newOrderTestClient создает клиент на SQLite в памяти с файлами, у которых совпадают create_time,
+// размер и имя у групп записей - именно на таких данных страницы раньше теряли и дублировали строки
+func newOrderTestClient(t *testing.T, count int) (*ent.Client, context.Context) {
+	t.Helper()
+
+	client, ctx := newTestClient(t, "order test fixtures")
+	tenantID := uuid.New()
+	createdAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	builders := make([]*ent.FileCreate, 0, count)
+	for i := 0; i < count; i++ {
+		builders = append(builders, client.File.Create().
+			SetTenantID(tenantID).
+			SetCreatedBy(uuid.New()).
+			SetOriginalName(fmt.Sprintf("report-%d.pdf", i%3)).
+			SetStorageKey(fmt.Sprintf("tenants/%s/report-%d.pdf", tenantID, i)).
+			SetMimeType("application/pdf").
+			SetSize(int64(100*(i%4)+1)).
+			SetCreateTime(createdAt).
+			SetUpdateTime(createdAt.Add(time.Duration(i%2)*time.Minute)))
+	}
+	require.NoError(t, client.File.CreateBulk(builders...).Exec(ctx))
+
+	return client, ctx
+}
+
+// collectForward проходит все страницы вперед и возвращает ID в порядке выдачи
+func collectForward(t *testing.T, ctx context.Context, client *ent.Client, order []*ent.FileOrder, pageSize int) []uuid.UUID {
+	t.Helper()
+
+	ids := make([]uuid.UUID, 0)
+	var after *ent.Cursor
+	for {
+		conn, err := client.File.Query().Paginate(ctx, after, &pageSize, nil, nil, ent.WithFileOrder(order))
+		require.NoError(t, err)
+		for _, edge := range conn.Edges {
+			ids = append(ids, edge.Node.ID)
+		}
+		if !conn.PageInfo.HasNextPage {
+			return ids
+		}
+		after = conn.PageInfo.EndCursor
+	}
+}
+
+// collectBackward проходит все страницы назад и возвращает ID в прямом порядке
+func collectBackward(t *testing.T, ctx context.Context, client *ent.Client, order []*ent.FileOrder, pageSize int) []uuid.UUID {
+	t.Helper()
+
+	pages := make([][]uuid.UUID, 0)
+	var before *ent.Cursor
+	for {
+		conn, err := client.File.Query().Paginate(ctx, nil, nil, before, &pageSize, ent.WithFileOrder(order))
+		require.NoError(t, err)
+		page := make([]uuid.UUID, 0, len(conn.Edges))
+		for _, edge := range conn.Edges {
+			page = append(page, edge.Node.ID)
+		}
+		pages = append(pages, page)
+		if !conn.PageInfo.HasPreviousPage {
+			break
+		}
+		before = conn.PageInfo.StartCursor
+	}
+
+	ids := make([]uuid.UUID, 0)
+	for i := len(pages) - 1; i >= 0; i-- {
+		ids = append(ids, pages[i]...)
+	}
+	return ids
+}
+
+// TestFileConnectionCursorStability проверяет, что при одинаковых значениях полей сортировки
+// постраничный обход возвращает каждый файл ровно один раз и в одном порядке в обе стороны
+func TestFileConnectionCursorStability(t *testing.T) {
+	const total, pageSize = 23, 5
+	client, ctx := newOrderTestClient(t, total)
+
+	orders := map[string]string{
+		"default":          "",
+		"create_time_desc": "CREATE_TIME:DESC",
+		"update_time_asc":  "UPDATE_TIME:ASC",
+		"size_desc":        "SIZE:DESC",
+		"name_asc":         "NAME:ASC",
+		"name_size":        "NAME:ASC,SIZE:DESC",
+	}
+
+	for name, value := range orders {
+		t.Run(name, func(t *testing.T) {
+			var orderBy []*ent.FileOrder
+			if value != "" {
+				parsed, err := ParseFileOrder(value)
+				require.NoError(t, err)
+				orderBy = parsed
+			}
+			order := FileConnectionOrder(orderBy)
+
+			forward := collectForward(t, ctx, client, order, pageSize)
+			require.Len(t, forward, total)
+
+			seen := make(map[uuid.UUID]bool, total)
+			for _, id := range forward {
+				assert.False(t, seen[id], "file %s returned twice", id)
+				seen[id] = true
+			}
+
+			// Повторный обход дает тот же порядок
+			assert.Equal(t, forward, collectForward(t, ctx, client, order, pageSize))
+			// Обход назад от конца дает тот же порядок
+			assert.Equal(t, forward, collectBackward(t, ctx, client, order, pageSize))
+		})
+	}
+}
+
+func TestParseFileOrder(t *testing.T) {
+	order, err := ParseFileOrder("create_time:desc, SIZE")
+	require.NoError(t, err)
+	require.Len(t, order, 2)
+	assert.Equal(t, "CREATE_TIME", order[0].Field.String())
+	assert.Equal(t, entgql.OrderDirectionDesc, order[0].Direction)
+	assert.Equal(t, "SIZE", order[1].Field.String())
+	assert.Equal(t, entgql.OrderDirectionAsc, order[1].Direction)
+
+	for _, invalid := range []string{"", "ID:ASC", "NAME:UP", "NAME,NAME:DESC"} {
+		_, err := ParseFileOrder(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestFileConnectionOrderKeepsClientOrder(t *testing.T) {
+	orderBy := []*ent.FileOrder{{Field: ent.FileOrderFieldSize, Direction: entgql.OrderDirectionDesc}}
+	assert.Equal(t, orderBy, FileConnectionOrder(orderBy))
+
+	defaultOrder := FileConnectionOrder(nil)
+	require.Len(t, defaultOrder, 1)
+	assert.Equal(t, "CREATE_TIME", defaultOrder[0].Field.String())
+	assert.Equal(t, entgql.OrderDirectionDesc, defaultOrder[0].Direction)
+}
+
+// TestFileCreateTimeStoredInUTC проверяет, что время в часовом поясе клиента или сервера сохраняется в UTC,
+// а сортировка по CREATE_TIME и курсоры идут по моменту времени, а не по записи со смещением
+func TestFileCreateTimeStoredInUTC(t *testing.T) {
+	client, ctx := newTestClient(t, "utc test fixtures")
+	tenantID := uuid.New()
+
+	// Без приведения к UTC строки времени в SQLite сортировались бы как 07:00, 10:00, 14:00, 22:00
+	base := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	newYork, tokyo := time.FixedZone("UTC-5", -5*60*60), time.FixedZone("UTC+9", 9*60*60)
+	createTimes := []time.Time{
+		base.In(newYork),
+		base.Add(time.Hour).In(tokyo),
+		base.Add(2 * time.Hour),
+		base.Add(3 * time.Hour).In(newYork),
+	}
+
+	expected := make([]uuid.UUID, 0, len(createTimes))
+	for i, createTime := range createTimes {
+		created, err := client.File.Create().
+			SetTenantID(tenantID).
+			SetCreatedBy(uuid.New()).
+			SetOriginalName(fmt.Sprintf("utc-%d.pdf", i)).
+			SetStorageKey(fmt.Sprintf("tenants/%s/utc-%d.pdf", tenantID, i)).
+			SetMimeType("application/pdf").
+			SetSize(1).
+			SetCreateTime(createTime).
+			Save(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, time.UTC, created.CreateTime.Location())
+		assert.Equal(t, time.UTC, created.UpdateTime.Location())
+		assert.True(t, created.CreateTime.Equal(createTime))
+		expected = append(expected, created.ID)
+	}
+
+	order, err := ParseFileOrder("CREATE_TIME:ASC")
+	require.NoError(t, err)
+	order = FileConnectionOrder(order)
+
+	assert.Equal(t, expected, collectForward(t, ctx, client, order, 3))
+	assert.Equal(t, expected, collectBackward(t, ctx, client, order, 3))
+}
@@ -0,0 +1,355 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"main/database"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/fileset"
+	"main/ent/predicate"
+	"main/ent/schema/mixin"
+	"main/privacy"
+	"main/redis"
+	"main/s3"
+	"main/services/audit"
+	"main/utils"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// RetenantStatus результат переноса отдельного файла
+type RetenantStatus string
+
+const (
+	// RetenantStatusPlanned файл будет перенесен (пробный запуск)
+	RetenantStatusPlanned RetenantStatus = "planned"
+	// RetenantStatusMoved файл перенесен
+	RetenantStatusMoved RetenantStatus = "moved"
+	// RetenantStatusFailed файл не перенесен
+	RetenantStatusFailed RetenantStatus = "failed"
+)
+
+// retenantTimeout максимальное время переноса файлов (включая копирование объектов в S3)
+const retenantTimeout = time.Hour
+
+// RetenantInput параметры переноса файлов между тенантами: конкретные файлы и/или все файлы пользователя
+type RetenantInput struct {
+	SourceTenantID uuid.UUID   `json:"sourceTenantId"`
+	TargetTenantID uuid.UUID   `json:"targetTenantId"`
+	FileIDs        []uuid.UUID `json:"fileIds,omitempty"`
+	UserID         *uuid.UUID  `json:"userId,omitempty"`
+	DryRun         bool        `json:"dryRun"`
+}
+
+// RetenantFileResult результат переноса файла
+type RetenantFileResult struct {
+	FileID       uuid.UUID      `json:"fileId"`
+	OriginalName string         `json:"originalName,omitempty"`
+	Size         int64          `json:"size"`
+	SourceKey    string         `json:"sourceKey,omitempty"`
+	TargetKey    string         `json:"targetKey,omitempty"`
+	Status       RetenantStatus `json:"status"`
+	Error        string         `json:"error,omitempty"`
+}
+
+// RetenantReport отчет переноса файлов между тенантами
+type RetenantReport struct {
+	DryRun         bool                 `json:"dryRun"`
+	SourceTenantID uuid.UUID            `json:"sourceTenantId"`
+	TargetTenantID uuid.UUID            `json:"targetTenantId"`
+	Files          int                  `json:"files"`
+	TotalBytes     int64                `json:"totalBytes"`
+	Moved          int                  `json:"moved"`
+	Failed         int                  `json:"failed"`
+	Items          []RetenantFileResult `json:"items"`
+}
+
+// RetenantFiles переносит файлы в другой тенант (слияние организаций). Для каждого файла:
+// копирование объекта в S3 под префикс целевого тенанта, затем смена tenant_id и ключа хранилища
+// у файла и его событий аудита. Транзакцией управляет вызывающий код (client - клиент транзакции):
+// исходные объекты удаляются после коммита, копии - при откате. Файл, который не удалось скопировать
+// в S3, отмечается в отчете, ошибка БД прерывает перенос целиком.
+// Перенесенные файлы убираются из наборов исходного тенанта, счетчики использования хранилища
+// обоих тенантов сбрасываются. Файлы в корзине переносятся вместе с остальными.
+// В режиме DryRun только формирует отчет. Системная операция: тенант в контексте не используется.
+func (s *FileService) RetenantFiles(ctx context.Context, client *ent.Client, input RetenantInput) (*RetenantReport, error) {
+	if input.SourceTenantID == uuid.Nil || input.TargetTenantID == uuid.Nil {
+		return nil, fmt.Errorf("source and target tenant IDs are required")
+	}
+	if input.SourceTenantID == input.TargetTenantID {
+		return nil, fmt.Errorf("source and target tenants must differ")
+	}
+	if len(input.FileIDs) == 0 && input.UserID == nil {
+		return nil, fmt.Errorf("file IDs or user ID are required")
+	}
+
+	skipCtx, cancel := mixin.SkipTenantFilterFor(ent.NewContext(privacy.WithSystemContext(ctx), client),
+		"move files between tenants", retenantTimeout)
+	defer cancel()
+	systemCtx := mixin.SkipSoftDelete(skipCtx)
+
+	files, err := s.selectRetenantFiles(systemCtx, client, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select files: %w", err)
+	}
+
+	report := &RetenantReport{
+		DryRun:         input.DryRun,
+		SourceTenantID: input.SourceTenantID,
+		TargetTenantID: input.TargetTenantID,
+		Items:          make([]RetenantFileResult, 0, len(files)),
+	}
+
+	// Запрошенные файлы, которых нет в исходном тенанте
+	found := make(map[uuid.UUID]bool, len(files))
+	for _, fileRecord := range files {
+		found[fileRecord.ID] = true
+	}
+	for _, fileID := range input.FileIDs {
+		if !found[fileID] {
+			found[fileID] = true
+			report.Items = append(report.Items, RetenantFileResult{
+				FileID: fileID,
+				Status: RetenantStatusFailed,
+				Error:  "file not found in source tenant",
+			})
+			report.Failed++
+		}
+	}
+
+	moved := make([]uuid.UUID, 0, len(files))
+	for _, fileRecord := range files {
+		result := RetenantFileResult{
+			FileID:       fileRecord.ID,
+			OriginalName: fileRecord.OriginalName,
+			Size:         fileRecord.Size,
+			SourceKey:    fileRecord.StorageKey,
+			TargetKey:    s3.TenantStorageKey(fileRecord.StorageKey, input.SourceTenantID, input.TargetTenantID),
+			Status:       RetenantStatusPlanned,
+		}
+		report.Files++
+		report.TotalBytes += fileRecord.Size
+
+		if !input.DryRun {
+			if err := s.copyRetenantObject(systemCtx, fileRecord, result.TargetKey); err != nil {
+				utils.Logger.Error("Failed to move file to another tenant",
+					zap.Error(err),
+					zap.String("file_id", fileRecord.ID.String()),
+					zap.String("target_tenant_id", input.TargetTenantID.String()))
+				result.Status = RetenantStatusFailed
+				result.Error = err.Error()
+				report.Failed++
+			} else {
+				if err := s.moveFileRecord(systemCtx, client, fileRecord, input, result.TargetKey); err != nil {
+					return nil, err
+				}
+				result.Status = RetenantStatusMoved
+				report.Moved++
+				moved = append(moved, fileRecord.ID)
+			}
+		}
+		report.Items = append(report.Items, result)
+	}
+
+	if len(moved) == 0 {
+		return report, nil
+	}
+
+	s.removeFilesFromTenantSets(systemCtx, client, input.SourceTenantID, moved)
+	database.OnCommit(ctx, func(ctx context.Context) {
+		invalidateStorageUsage(ctx, input.SourceTenantID, input.TargetTenantID)
+	})
+
+	// 📊 [AUDIT] Итог в исходном тенанте: ссылки на файлы хранятся в деталях, файлы уже в другом тенанте
+	sourceTenantID := input.SourceTenantID
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:   fileauditevent.ActionRETENANT,
+		TenantID: &sourceTenantID,
+		Details: map[string]interface{}{
+			"target_tenant_id": input.TargetTenantID.String(),
+			"file_ids":         moved,
+			"moved":            report.Moved,
+			"failed":           report.Failed,
+		},
+	})
+
+	utils.Logger.Info("Files moved to another tenant",
+		zap.String("source_tenant_id", input.SourceTenantID.String()),
+		zap.String("target_tenant_id", input.TargetTenantID.String()),
+		zap.Int("moved", report.Moved),
+		zap.Int("failed", report.Failed))
+
+	return report, nil
+}
+
+// selectRetenantFiles выбирает файлы исходного тенанта: по списку ID и/или все файлы пользователя
+func (s *FileService) selectRetenantFiles(ctx context.Context, client *ent.Client, input RetenantInput) ([]*ent.File, error) {
+	selection := make([]predicate.File, 0, 2)
+	if len(input.FileIDs) > 0 {
+		selection = append(selection, file.IDIn(input.FileIDs...))
+	}
+	if input.UserID != nil {
+		selection = append(selection, file.CreatedBy(*input.UserID))
+	}
+
+	return client.File.Query().
+		Where(file.TenantID(input.SourceTenantID), file.Or(selection...)).
+		Order(ent.Asc(file.FieldCreateTime), ent.Asc(file.FieldID)).
+		All(ctx)
+}
+
+// copyRetenantObject копирует объект файла под ключ целевого тенанта. Копия удаляется при откате транзакции,
+// исходный объект и производные (превью, PDF-версии) - после коммита; ошибка их удаления не отменяет перенос.
+func (s *FileService) copyRetenantObject(ctx context.Context, fileRecord *ent.File, targetKey string) error {
+	// Исходный объект под удержанием удалить нельзя, а копия удержание не наследует
+	if objectLockActive(fileRecord, time.Now()) {
+		return fmt.Errorf("file is under S3 object lock")
+	}
+	if err := s.s3Service.CopyFile(ctx, fileRecord.StorageKey, targetKey, fileRecord.Size); err != nil {
+		return err
+	}
+
+	database.OnRollback(ctx, func(ctx context.Context) {
+		s.deleteRetenantCopy(ctx, targetKey)
+	})
+	database.OnCommit(ctx, func(ctx context.Context) {
+		// Контекст переноса к моменту коммита уже может быть отменен
+		ctx = context.WithoutCancel(ctx)
+		if err := s.s3Service.DeleteFile(ctx, fileRecord.StorageKey); err != nil {
+			utils.Logger.Warn("Failed to delete source object after moving file to another tenant",
+				zap.Error(err),
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("storage_key", fileRecord.StorageKey))
+		}
+		if fileRecord.ThumbnailStatus == file.ThumbnailStatusREADY {
+			s.deleteThumbnails(ctx, fileRecord)
+			requestThumbnails()
+		}
+		if fileRecord.PreviewStatus == file.PreviewStatusREADY {
+			s.deletePreview(ctx, fileRecord)
+			requestPreviews()
+		}
+		if fileRecord.PdfStatus == file.PdfStatusREADY {
+			s.deletePDFRendition(ctx, fileRecord)
+			requestPDFRenditions()
+		}
+		s.deleteImageDerivatives(ctx, fileRecord)
+		s.deletePDFWatermarks(ctx, fileRecord)
+	})
+	return nil
+}
+
+// moveFileRecord меняет тенанта файла и его событий аудита (tenant_id неизменяем через API ent,
+// поэтому обновляется модификатором запроса) и записывает событие в целевом тенанте
+func (s *FileService) moveFileRecord(ctx context.Context, client *ent.Client, fileRecord *ent.File, input RetenantInput, targetKey string) error {
+	setTenant := func(u *sql.UpdateBuilder) {
+		u.Set(file.FieldTenantID, input.TargetTenantID)
+	}
+
+	update := client.File.UpdateOneID(fileRecord.ID).
+		Where(file.TenantID(input.SourceTenantID)).
+		SetStorageKey(targetKey).
+		// Отделы и теги принадлежат исходному тенанту и в целевом не учитываются
+		ClearDepartmentID().
+		ClearTags().
+		Modify(setTenant)
+	// Превью привязаны к ключу исходного объекта: создаем их заново под новым ключом
+	if fileRecord.ThumbnailStatus == file.ThumbnailStatusREADY {
+		update.SetThumbnailStatus(file.ThumbnailStatusPENDING)
+	}
+	if fileRecord.PreviewStatus == file.PreviewStatusREADY {
+		update.SetPreviewStatus(file.PreviewStatusPENDING)
+	}
+	if fileRecord.PdfStatus == file.PdfStatusREADY {
+		update.SetPdfStatus(file.PdfStatusPENDING)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update file: %w", err)
+	}
+
+	if _, err := client.FileAuditEvent.Update().
+		Where(
+			fileauditevent.FileID(fileRecord.ID),
+			fileauditevent.TenantID(input.SourceTenantID),
+		).
+		Modify(setTenant).
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to update file audit events: %w", err)
+	}
+
+	// 📊 [AUDIT] Системная операция: тенант задается явно
+	fileID, targetTenantID := fileRecord.ID, input.TargetTenantID
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:   fileauditevent.ActionRETENANT,
+		TenantID: &targetTenantID,
+		FileID:   &fileID,
+		Details: map[string]interface{}{
+			"filename":         fileRecord.OriginalName,
+			"size":             fileRecord.Size,
+			"source_tenant_id": input.SourceTenantID.String(),
+			"source_key":       fileRecord.StorageKey,
+			"target_key":       targetKey,
+		},
+	})
+	return nil
+}
+
+// deleteRetenantCopy удаляет копию объекта после неудачного переноса
+func (s *FileService) deleteRetenantCopy(ctx context.Context, targetKey string) {
+	if err := s.s3Service.DeleteFile(context.WithoutCancel(ctx), targetKey); err != nil {
+		utils.Logger.Error("Failed to delete copied object after failed tenant move",
+			zap.Error(err),
+			zap.String("storage_key", targetKey))
+	}
+}
+
+// removeFilesFromTenantSets убирает перенесенные файлы из наборов тенанта; ошибки только логируются
+func (s *FileService) removeFilesFromTenantSets(ctx context.Context, client *ent.Client, tenantID uuid.UUID, fileIDs []uuid.UUID) {
+	removed := make(map[uuid.UUID]bool, len(fileIDs))
+	for _, fileID := range fileIDs {
+		removed[fileID] = true
+	}
+
+	sets, err := client.FileSet.Query().Where(fileset.TenantID(tenantID)).All(ctx)
+	if err != nil {
+		utils.Logger.Warn("Failed to load file sets after tenant move", zap.Error(err))
+		return
+	}
+
+	for _, set := range sets {
+		kept := make([]uuid.UUID, 0, len(set.FileIds))
+		for _, fileID := range set.FileIds {
+			if !removed[fileID] {
+				kept = append(kept, fileID)
+			}
+		}
+		if len(kept) == len(set.FileIds) {
+			continue
+		}
+		if err := client.FileSet.UpdateOne(set).SetFileIds(kept).Exec(ctx); err != nil {
+			utils.Logger.Warn("Failed to update file set after tenant move",
+				zap.Error(err),
+				zap.String("file_set_id", set.ID.String()))
+		}
+	}
+}
+
+// invalidateStorageUsage сбрасывает счетчики использования хранилища: они будут пересчитаны по БД
+func invalidateStorageUsage(ctx context.Context, tenantIDs ...uuid.UUID) {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil || cacheService.GetClient() == nil {
+		return
+	}
+	for _, tenantID := range tenantIDs {
+		if err := cacheService.DeleteTenantStorageUsage(ctx, tenantID.String()); err != nil {
+			utils.Logger.Warn("Failed to reset tenant storage usage counter",
+				zap.Error(err),
+				zap.String("tenant_id", tenantID.String()))
+		}
+	}
+}
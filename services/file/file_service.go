@@ -2,13 +2,28 @@ package file
 
 import (
 	"archive/zip"
-	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
+	"main/antivirus"
+	"main/config"
+	"main/converter"
+	"main/database"
 	"main/ent"
 	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/tenantsetting"
+	"main/errcatalog"
+	"main/redis"
 	"main/s3"
+	"main/services/audit"
+	"main/services/batch"
+	"main/services/tenant"
 	"main/types"
 	"main/utils"
 	"mime"
@@ -27,13 +42,26 @@ const (
 	DefaultPresignedURLExpiration = time.Hour
 	// MaxPresignedURLExpiration максимальное время жизни pre-signed URL (24 часа)
 	MaxPresignedURLExpiration = 24 * time.Hour
-	// MaxBatchArchiveFiles максимальное количество файлов в архиве
-	MaxBatchArchiveFiles = 50
+	// MaxDownloadURLsBatch максимальное количество файлов в одном запросе ссылок на скачивание
+	MaxDownloadURLsBatch = 100
 )
 
 // FileService provides file management operations
 type FileService struct {
-	s3Service *s3.S3Service
+	s3Service               *s3.S3Service
+	auditService            *audit.AuditService
+	tenantStateService      *tenant.TenantStateService
+	quotaService            *tenant.TenantQuotaService
+	retentionService        *tenant.TenantRetentionService
+	filenameConflictService *tenant.TenantFilenameConflictService
+	uploadPolicyService     *tenant.TenantUploadPolicyService
+	imageMetadataService    *tenant.TenantImageMetadataService
+	watermarkService        *tenant.TenantWatermarkService
+	fileTypeService         *tenant.TenantFileTypeService
+	// scanner антивирус; nil, если проверка отключена
+	scanner antivirus.Scanner
+	// pdfConverter конвертер документов Office в PDF; nil, если конвертация отключена
+	pdfConverter converter.Converter
 }
 
 // hasAdminRole проверяет, имеет ли пользователь админскую роль
@@ -61,17 +89,16 @@ func (s *FileService) canDownloadFile(ctx context.Context, client *ent.Client, f
 		Where(file.ID(fileID)).
 		Only(ctx); err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("%s", utils.T(ctx, "error.file.not_found"))
+			return errcatalog.FileNotFound(ctx)
 		}
-		return fmt.Errorf("%s", utils.T(ctx, "error.file.get_failed"))
+		return errcatalog.FileGetFailed(ctx)
 	}
 
 	// Аутентификация пользователя и роль
 	userID := federation.GetUserID(ctx)
 	if userID == nil {
-		return fmt.Errorf("%s", utils.T(ctx, "error.user.not_authenticated"))
+		return errcatalog.UserNotAuthenticated(ctx)
 	}
-	userRoleCode := federation.GetUserRole(ctx)
 
 	// Проверяем доступ - для простоты проверяем только что файл принадлежит пользователю или пользователь админ
 	fileRecord, err := client.File.Query().
@@ -79,28 +106,33 @@ func (s *FileService) canDownloadFile(ctx context.Context, client *ent.Client, f
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("%s", utils.T(ctx, "error.file.not_found"))
+			return errcatalog.FileNotFound(ctx)
 		}
-		return fmt.Errorf("%s", utils.T(ctx, "error.file.get_failed"))
+		return errcatalog.FileGetFailed(ctx)
 	}
 
-	// Админы могут видеть все файлы
-	if types.IsRoleHigherOrEqual(userRoleCode, types.RoleAdmin) {
-		return nil
+	if !s.canDownloadFileRecord(ctx, fileRecord) {
+		return errcatalog.FileViewPermissionDenied(ctx)
 	}
+	return nil
+}
 
-	// Пользователи могут видеть только свои файлы
-	if fileRecord.CreatedBy != *userID {
-		return fmt.Errorf("%s", utils.T(ctx, "error.file.view_permission_denied"))
+// canDownloadFileRecord проверяет права canDownloadFile на уже загруженный файл (для проверки списков
+// файлов без запроса на каждый файл)
+func (s *FileService) canDownloadFileRecord(ctx context.Context, fileRecord *ent.File) bool {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return false
 	}
-	return nil
+	// Админы могут видеть все файлы, пользователи - только свои
+	return types.IsRoleHigherOrEqual(federation.GetUserRole(ctx), types.RoleAdmin) || fileRecord.CreatedBy == *userID
 }
 
 // CanUpdateFile проверяет, может ли пользователь редактировать файл
 func (s *FileService) CanUpdateFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) error {
 	userID := federation.GetUserID(ctx)
 	if userID == nil {
-		return fmt.Errorf("%s", utils.T(ctx, "error.user.not_authenticated"))
+		return errcatalog.UserNotAuthenticated(ctx)
 	}
 
 	// Получаем файл
@@ -109,9 +141,14 @@ func (s *FileService) CanUpdateFile(ctx context.Context, client *ent.Client, fil
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("%s", utils.T(ctx, "error.file.not_found"))
+			return errcatalog.FileNotFound(ctx)
 		}
-		return fmt.Errorf("%s", utils.T(ctx, "error.file.get_failed"))
+		return errcatalog.FileGetFailed(ctx)
+	}
+
+	// Заблокированный файл изменяет только владелец блокировки
+	if err := ensureNotLockedByOther(ctx, fileRecord, *userID); err != nil {
+		return err
 	}
 
 	// Владельцы и администраторы могут редактировать любые файлы
@@ -124,7 +161,7 @@ func (s *FileService) CanUpdateFile(ctx context.Context, client *ent.Client, fil
 		return nil
 	}
 
-	return fmt.Errorf("%s", utils.T(ctx, "error.file.update_permission_denied"))
+	return errcatalog.FileUpdatePermissionDenied(ctx)
 }
 
 // CanUploadFile проверяет, может ли пользователь загружать файлы
@@ -133,14 +170,14 @@ func (s *FileService) CanUploadFile(ctx context.Context) error {
 	if userID != nil {
 		return nil
 	}
-	return fmt.Errorf("%s", utils.T(ctx, "error.file.upload_permission_denied"))
+	return errcatalog.FileUploadPermissionDenied(ctx)
 }
 
 // CanDeleteFile проверяет, может ли пользователь удалять файл
 func (s *FileService) CanDeleteFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) error {
 	userID := federation.GetUserID(ctx)
 	if userID == nil {
-		return fmt.Errorf("%s", utils.T(ctx, "error.user.not_authenticated"))
+		return errcatalog.UserNotAuthenticated(ctx)
 	}
 
 	// Получаем файл
@@ -149,9 +186,18 @@ func (s *FileService) CanDeleteFile(ctx context.Context, client *ent.Client, fil
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("%s", utils.T(ctx, "error.file.not_found"))
+			return errcatalog.FileNotFound(ctx)
 		}
-		return fmt.Errorf("%s", utils.T(ctx, "error.file.get_failed"))
+		return errcatalog.FileGetFailed(ctx)
+	}
+
+	// Заблокированный файл изменяет только владелец блокировки
+	if err := ensureNotLockedByOther(ctx, fileRecord, *userID); err != nil {
+		return err
+	}
+	// Файл под удержанием S3 Object Lock не удаляется до окончания удержания
+	if err := ensureObjectLockAllowsDelete(ctx, fileRecord); err != nil {
+		return err
 	}
 
 	// Владельцы и администраторы могут удалять любые файлы
@@ -164,7 +210,7 @@ func (s *FileService) CanDeleteFile(ctx context.Context, client *ent.Client, fil
 		return nil
 	}
 
-	return fmt.Errorf("%s", utils.T(ctx, "error.file.delete_permission_denied"))
+	return errcatalog.FileDeletePermissionDenied(ctx)
 }
 
 // CanViewFile проверяет, может ли пользователь просматривать файл
@@ -178,27 +224,51 @@ func (s *FileService) CanViewFile(ctx context.Context, client *ent.Client, fileI
 // NewFileService creates a new file service
 func NewFileService() *FileService {
 	return &FileService{
-		s3Service: s3.NewS3Service(),
+		s3Service:               s3.NewS3Service(),
+		auditService:            audit.NewAuditService(),
+		tenantStateService:      tenant.NewTenantStateService(),
+		quotaService:            tenant.NewTenantQuotaService(),
+		retentionService:        tenant.NewTenantRetentionService(),
+		filenameConflictService: tenant.NewTenantFilenameConflictService(),
+		uploadPolicyService:     tenant.NewTenantUploadPolicyService(),
+		imageMetadataService:    tenant.NewTenantImageMetadataService(),
+		watermarkService:        tenant.NewTenantWatermarkService(),
+		fileTypeService:         tenant.NewTenantFileTypeService(),
+		scanner:                 antivirus.DefaultScanner(),
+		pdfConverter:            converter.DefaultConverter(),
 	}
 }
 
-// getCurrentStorageUsage возвращает текущее использование хранилища для тенанта
+// getCurrentStorageUsage возвращает текущее использование хранилища для тенанта из счетчика в Redis.
+// Если счетчик не инициализирован или Redis недоступен, использование считается по БД.
 func (s *FileService) getCurrentStorageUsage(ctx context.Context, client *ent.Client) (int64, error) {
 	tenantID := federation.GetTenantID(ctx)
 	if tenantID == nil {
 		return 0, fmt.Errorf("tenant ID not found in context")
 	}
 
-	// Получаем суммарный размер всех файлов тенанта
-	var totalSize int64
-	err := client.File.Query().
-		Where(file.TenantID(*tenantID)).
-		Aggregate(ent.Sum(file.FieldSize)).
-		Scan(ctx, &totalSize)
+	cacheService, cacheErr := redis.GetTenantCacheService()
+	if cacheErr == nil && cacheService.GetClient() != nil {
+		usage, found, err := cacheService.GetTenantStorageUsage(ctx, tenantID.String())
+		if err == nil && found {
+			return usage, nil
+		}
+		if err != nil {
+			utils.Logger.Warn("Failed to read tenant storage usage counter, using database", zap.Error(err))
+		}
+	}
+
+	totalSize, err := queryStorageUsage(ctx, client, *tenantID)
 	if err != nil {
 		return 0, err
 	}
 
+	if cacheErr == nil && cacheService.GetClient() != nil {
+		if err := cacheService.SetTenantStorageUsage(ctx, tenantID.String(), totalSize); err != nil {
+			utils.Logger.Warn("Failed to initialize tenant storage usage counter", zap.Error(err))
+		}
+	}
+
 	return totalSize, nil
 }
 
@@ -206,12 +276,25 @@ func (s *FileService) getCurrentStorageUsage(ctx context.Context, client *ent.Cl
 type UploadFileInput struct {
 	Upload      *graphql.Upload
 	Description *string
+	// Metadata теги, папка и срок хранения, сохраняемые вместе с записью файла
+	Metadata *UploadMetadataInput
+	// ChecksumSha256 SHA-256 содержимого, посчитанный клиентом (hex); при несовпадении загрузка отклоняется
+	ChecksumSha256 *string
+	// ObjectLock удержание объекта в S3 Object Lock, задаваемое администратором при загрузке
+	ObjectLock *ObjectLockInput
+	// SourceURL ссылка, по которой файл скачан сервисом (uploadFileFromURL), сохраняется в аудите
+	SourceURL string
 }
 
 // FileDownloadUrlResult содержит данные о pre-signed URL для скачивания файла
 type FileDownloadUrlResult struct {
+	FileID    uuid.UUID
 	URL       string
 	ExpiresAt time.Time
+	// Disposition фактический способ открытия файла по ссылке
+	Disposition DownloadDisposition
+	// Watermarked ссылка ведет на копию PDF с водяным знаком пользователя
+	Watermarked bool
 }
 
 // BatchDownloadUrlResult содержит данные о pre-signed URL для скачивания архива
@@ -220,10 +303,14 @@ type BatchDownloadUrlResult struct {
 	ExpiresAt   time.Time
 	ArchiveName string
 	TotalFiles  int
+	// Result файлы, вошедшие в архив, и причины пропуска остальных. Если в архив не вошел ни один файл,
+	// архив не создается и URL пуст.
+	Result *batch.Result
 }
 
-// GetFileDownloadURL генерирует pre-signed URL для скачивания одиночного файла
-func (s *FileService) GetFileDownloadURL(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*FileDownloadUrlResult, error) {
+// GetFileDownloadURL генерирует pre-signed URL для скачивания одиночного файла.
+// options задают Content-Disposition и Content-Type ответа, чтобы изображения и PDF можно было встроить в интерфейс.
+func (s *FileService) GetFileDownloadURL(ctx context.Context, client *ent.Client, fileID uuid.UUID, options DownloadURLOptions) (*FileDownloadUrlResult, error) {
 	// 🔒 [POLICY CHECK] Проверяем права на скачивание файла
 	if err := s.canDownloadFile(ctx, client, fileID); err != nil {
 		return nil, err
@@ -235,48 +322,149 @@ func (s *FileService) GetFileDownloadURL(ctx context.Context, client *ent.Client
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.not_found"))
+			return nil, errcatalog.FileNotFound(ctx)
 		}
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.get_failed"))
+		return nil, errcatalog.FileGetFailed(ctx)
 	}
 
-	// Генерируем pre-signed URL с временем жизни 1 час
-	url, err := s.s3Service.GetPresignedURL(ctx, fileRecord.StorageKey, DefaultPresignedURLExpiration)
+	// 🛡️ [ANTIVIRUS] Зараженные и еще не проверенные файлы не выдаются
+	if err := s.ensureScanAllowsDownload(ctx, client, fileRecord); err != nil {
+		return nil, err
+	}
+
+	presign, disposition, err := downloadPresignOptions(ctx, fileRecord, options)
+	if err != nil {
+		return nil, err
+	}
+	expiration, err := presignedURLExpiration(ctx, options.ExpiresIn)
+	if err != nil {
+		return nil, err
+	}
+
+	// 🔏 [WATERMARK] PDF выдается копией с водяным знаком по запросу клиента или требованию тенанта
+	storageKey := fileRecord.StorageKey
+	watermark, err := s.pdfWatermarkFor(ctx, client, fileRecord, options.Watermark)
 	if err != nil {
+		return nil, err
+	}
+	if watermark != nil {
+		storageKey = watermark.StorageKey
+	}
+
+	// Генерируем pre-signed URL с временем жизни 1 час.
+	// 🔐 [ENCRYPTION] Зашифрованный файл расшифровывается сервисом при отдаче через /files/{id}/content
+	var url string
+	if s.s3Service.EncryptionEnabled() {
+		url = fileContentProxyURL(fileID, disposition, options.Watermark)
+	} else if url, err = s.s3Service.GetPresignedURLWithOptions(ctx, storageKey, expiration, presign); err != nil {
 		if strings.Contains(err.Error(), "S3 credentials are not configured") {
-			return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.s3_not_configured"))
+			return nil, errcatalog.FileS3NotConfigured(ctx)
 		}
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.url_generation_failed"))
+		return nil, errcatalog.FileURLGenerationFailed(ctx)
 	}
 
-	// 📊 [AUDIT] Логируем генерацию URL для скачивания
-	utils.Logger.Info("File download URL generated",
-		zap.String("file_id", fileID.String()))
+	// 📊 [AUDIT] Фиксируем генерацию URL для скачивания
+	s.auditService.Record(ctx, client, audit.Event{
+		Action: fileauditevent.ActionURL_GENERATED,
+		FileID: &fileID,
+		Details: map[string]interface{}{
+			"disposition":          string(disposition),
+			"watermarked":          watermark != nil,
+			"expires_in":           int(expiration.Seconds()),
+			"requested_expires_in": options.ExpiresIn,
+		},
+	})
+	s.recordDownloads(ctx, client, fileID)
 
 	return &FileDownloadUrlResult{
-		URL:       url,
-		ExpiresAt: time.Now().Add(DefaultPresignedURLExpiration),
+		FileID:      fileID,
+		URL:         url,
+		ExpiresAt:   time.Now().Add(expiration),
+		Disposition: disposition,
+		Watermarked: watermark != nil,
 	}, nil
 }
 
-// GetBatchDownloadURL создает ZIP архив из указанных файлов и возвращает pre-signed URL для его скачивания
-func (s *FileService) GetBatchDownloadURL(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID, archiveName string) (*BatchDownloadUrlResult, error) {
+// GetFileDownloadURLs выдает ссылки на скачивание нескольких файлов по отдельности (без архива) с проверкой
+// прав и антивирусной проверки каждого файла, как GetFileDownloadURL. Недоступные файлы попадают в отказы итога;
+// повторные ID обрабатываются один раз.
+func (s *FileService) GetFileDownloadURLs(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID, options DownloadURLOptions) ([]*FileDownloadUrlResult, *batch.Result, error) {
+	fileIDs = batch.UniqueIDs(fileIDs)
+	if len(fileIDs) == 0 {
+		return nil, nil, errcatalog.FileNoFilesSelected(ctx)
+	}
+	if len(fileIDs) > MaxDownloadURLsBatch {
+		return nil, nil, errcatalog.FileTooManyFilesForDownloadUrls(ctx, MaxDownloadURLsBatch)
+	}
+	// Срок жизни проверяется до обработки файлов: некорректное значение - ошибка запроса, а не каждого файла
+	if _, err := presignedURLExpiration(ctx, options.ExpiresIn); err != nil {
+		return nil, nil, err
+	}
+
+	items := batch.NewResult()
+	results := make([]*FileDownloadUrlResult, 0, len(fileIDs))
+	for _, fileID := range fileIDs {
+		result, err := s.GetFileDownloadURL(ctx, client, fileID, options)
+		if err != nil {
+			items.Fail(ctx, fileID, err)
+			continue
+		}
+		results = append(results, result)
+		items.Succeed(fileID)
+	}
+	return results, items, nil
+}
+
+// presignedURLExpiration возвращает время жизни ссылки для запрошенного количества секунд:
+// без значения - DefaultPresignedURLExpiration, больше MaxPresignedURLExpiration - MaxPresignedURLExpiration
+func presignedURLExpiration(ctx context.Context, expiresIn *int) (time.Duration, error) {
+	if expiresIn == nil {
+		return DefaultPresignedURLExpiration, nil
+	}
+	if *expiresIn <= 0 {
+		return 0, errcatalog.FileInvalidURLExpiration(ctx)
+	}
+	if *expiresIn >= int(MaxPresignedURLExpiration/time.Second) {
+		return MaxPresignedURLExpiration, nil
+	}
+	return time.Duration(*expiresIn) * time.Second, nil
+}
+
+// GetBatchDownloadURL создает ZIP архив из указанных файлов и возвращает pre-signed URL для его скачивания.
+// layout задает раскладку файлов по каталогам внутри архива (пустое значение - ArchiveLayoutFlat).
+func (s *FileService) GetBatchDownloadURL(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID, archiveName string, layout ArchiveLayout) (*BatchDownloadUrlResult, error) {
 	// Валидация входных данных
 	if len(fileIDs) == 0 {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.no_files_selected"))
+		return nil, errcatalog.FileNoFilesSelected(ctx)
 	}
-	if len(fileIDs) > MaxBatchArchiveFiles {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.too_many_files_selected"))
+	if len(fileIDs) > config.Get().MaxBatchArchiveFiles {
+		return nil, errcatalog.FileTooManyFilesSelected(ctx)
 	}
 
 	// Получаем и проверяем права на все файлы
-	files, err := s.validateAndGetFilesForBatch(ctx, client, fileIDs)
+	items := batch.NewResult()
+	files, err := s.validateAndGetFilesForBatch(ctx, client, fileIDs, items)
 	if err != nil {
 		return nil, err
 	}
 
+	return s.createBatchArchive(ctx, client, files, archiveName, layout, items, nil, nil)
+}
+
+// createBatchArchive собирает ZIP архив из уже проверенных файлов, загружает его во временное хранилище
+// и возвращает pre-signed URL. В items добавляются вошедшие в архив файлы и причины пропуска остальных.
+// auditDetails дополняют событие аудита скачивания архива, onProgress (может быть nil) вызывается
+// после обработки каждого файла.
+func (s *FileService) createBatchArchive(ctx context.Context, client *ent.Client, files []*ent.File, archiveName string, layout ArchiveLayout, items *batch.Result, auditDetails map[string]interface{}, onProgress func(processed, total int)) (*BatchDownloadUrlResult, error) {
+	// 🛡️ [ANTIVIRUS] Зараженные и еще не проверенные файлы в архив не попадают
+	files = s.filterScannedFiles(ctx, client, files, items)
+	// 🔏 [WATERMARK] При обязательном водяном знаке PDF документы скачиваются только по одному
+	files, err := s.filterWatermarkRequiredFiles(ctx, client, files, items)
+	if err != nil {
+		return nil, err
+	}
 	if len(files) == 0 {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.no_accessible_files"))
+		return &BatchDownloadUrlResult{Result: items}, nil
 	}
 
 	// Генерируем имя архива, если не задано
@@ -287,38 +475,38 @@ func (s *FileService) GetBatchDownloadURL(ctx context.Context, client *ent.Clien
 		archiveName += ".zip"
 	}
 
-	// Создаем ZIP архив в памяти
-	var buffer bytes.Buffer
-	zipWriter := zip.NewWriter(&buffer)
-
-	usedFilenames := make(map[string]bool)
-
-	for _, fileRecord := range files {
-		if err := s.addFileToZipFromS3(ctx, zipWriter, fileRecord, usedFilenames); err != nil {
-			utils.Logger.Error("Failed to add file to ZIP archive",
-				zap.Error(err),
-				zap.String("file_id", fileRecord.ID.String()),
-				zap.String("filename", fileRecord.OriginalName))
-			// Продолжаем обработку других файлов
-			continue
-		}
-
-		// 📊 [AUDIT] Логируем каждый файл отдельно как скачанный в составе архива
-		utils.Logger.Info("File included in batch download",
-			zap.String("file_id", fileRecord.ID.String()),
-			zap.String("archive_name", archiveName),
-			zap.Int("total_files", len(files)))
+	// ZIP архив пишется в pipe и сразу уходит в S3 multipart загрузкой: в памяти держатся только
+	// текущие части загрузки, а не весь архив
+	archiveStorageKey := s.generateTemporaryArchiveKey(archiveName)
+	pipeReader, pipeWriter := io.Pipe()
+	archived := make(chan archiveWriteResult, 1)
+	go s.writeBatchArchive(ctx, pipeWriter, files, layout, onProgress, archived)
+
+	uploadErr := s.s3Service.UploadTemporaryFile(ctx, pipeReader, archiveStorageKey, "application/zip")
+	// Если загрузка прервалась раньше, запись архива получит io.ErrClosedPipe и остановится
+	_ = pipeReader.Close()
+	result := <-archived
+
+	// Ошибка записи архива передается загрузке через pipe, поэтому незавершенный объект в S3 не остается
+	if result.err != nil && !errors.Is(result.err, io.ErrClosedPipe) {
+		utils.Logger.Error("Failed to write ZIP archive", zap.Error(result.err))
+		return nil, errcatalog.FileArchiveCreationFailed(ctx)
 	}
-
-	if err := zipWriter.Close(); err != nil {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.archive_creation_failed"))
+	if uploadErr != nil {
+		utils.Logger.Error("Failed to upload ZIP archive", zap.Error(uploadErr))
+		return nil, errcatalog.FileArchiveUploadFailed(ctx)
 	}
-
-	// Загружаем архив в S3 с временным ключом
-	archiveStorageKey := s.generateTemporaryArchiveKey(archiveName)
-	err = s.s3Service.UploadTemporaryFile(ctx, &buffer, archiveStorageKey, "application/zip")
-	if err != nil {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.archive_upload_failed"))
+	archivedFileIDs, downloadedFileIDs := result.archivedFileIDs, result.downloadedFileIDs
+	for _, fileID := range downloadedFileIDs {
+		items.Succeed(fileID)
+	}
+	for _, fileID := range result.failedFileIDs {
+		items.Fail(ctx, fileID, errcatalog.FileDownloadFailed(ctx))
+	}
+	if len(downloadedFileIDs) == 0 {
+		// Ни один файл не прочитан из S3: пустой архив не выдается
+		_ = s.s3Service.DeleteFile(ctx, archiveStorageKey)
+		return &BatchDownloadUrlResult{Result: items}, nil
 	}
 
 	// Генерируем pre-signed URL для архива
@@ -326,15 +514,30 @@ func (s *FileService) GetBatchDownloadURL(ctx context.Context, client *ent.Clien
 	if err != nil {
 		// Удаляем архив при ошибке генерации URL
 		_ = s.s3Service.DeleteFile(ctx, archiveStorageKey)
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.url_generation_failed"))
+		return nil, errcatalog.FileURLGenerationFailed(ctx)
 	}
 
-	// Планируем удаление архива через 1 час
-	go s.scheduleArchiveDeletion(ctx, archiveStorageKey, DefaultPresignedURLExpiration)
+	// Планируем удаление архива после истечения ссылки (очистка ARCHIVE_CLEANUP_INTERVAL)
+	s.scheduleArchiveDeletion(ctx, archiveStorageKey, DefaultPresignedURLExpiration)
+
+	// 📊 [AUDIT] Фиксируем скачивание архива со списком вошедших в него файлов
+	details := map[string]interface{}{
+		"archive_name": archiveName,
+		"layout":       string(layout),
+		"file_ids":     archivedFileIDs,
+	}
+	for key, value := range auditDetails {
+		details[key] = value
+	}
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:  fileauditevent.ActionBATCH_DOWNLOAD,
+		Details: details,
+	})
+	s.recordDownloads(ctx, client, downloadedFileIDs...)
 
 	utils.Logger.Info("Batch download archive created",
-		zap.Int("total_files", len(files)),
-		zap.Int("requested_files", len(fileIDs)),
+		zap.Int("total_files", len(downloadedFileIDs)),
+		zap.Int("failed_files", len(result.failedFileIDs)),
 		zap.String("archive_name", archiveName),
 		zap.String("storage_key", archiveStorageKey))
 
@@ -342,28 +545,92 @@ func (s *FileService) GetBatchDownloadURL(ctx context.Context, client *ent.Clien
 		URL:         url,
 		ExpiresAt:   time.Now().Add(DefaultPresignedURLExpiration),
 		ArchiveName: archiveName,
-		TotalFiles:  len(files),
+		TotalFiles:  len(downloadedFileIDs),
+		Result:      items,
 	}, nil
 }
 
-// validateAndGetFilesForBatch проверяет права доступа и получает файлы для группового скачивания
-func (s *FileService) validateAndGetFilesForBatch(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID) ([]*ent.File, error) {
+// archiveWriteResult итог записи ZIP архива в pipe
+type archiveWriteResult struct {
+	archivedFileIDs   []string
+	downloadedFileIDs []uuid.UUID
+	// failedFileIDs файлы, которые не удалось прочитать из S3
+	failedFileIDs []uuid.UUID
+	err           error
+}
+
+// writeBatchArchive пишет файлы из S3 в ZIP архив поверх pipe и закрывает pipe по окончании.
+// Файл, который не удалось прочитать, пропускается; закрытый читателем pipe останавливает запись.
+func (s *FileService) writeBatchArchive(ctx context.Context, pipeWriter *io.PipeWriter, files []*ent.File, layout ArchiveLayout, onProgress func(processed, total int), archived chan<- archiveWriteResult) {
+	zipWriter := zip.NewWriter(pipeWriter)
+
+	usedFilenames := make(map[string]bool)
+	result := archiveWriteResult{
+		archivedFileIDs:   make([]string, 0, len(files)),
+		downloadedFileIDs: make([]uuid.UUID, 0, len(files)),
+	}
+
+	for i, fileRecord := range files {
+		if err := s.addFileToZipFromS3(ctx, zipWriter, fileRecord, layout, usedFilenames); err != nil {
+			if errors.Is(err, io.ErrClosedPipe) {
+				// Загрузка архива в S3 прервана: дописывать архив некуда
+				result.err = err
+				break
+			}
+			utils.Logger.Error("Failed to add file to ZIP archive",
+				zap.Error(err),
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("filename", fileRecord.OriginalName))
+			result.failedFileIDs = append(result.failedFileIDs, fileRecord.ID)
+		} else {
+			result.archivedFileIDs = append(result.archivedFileIDs, fileRecord.ID.String())
+			result.downloadedFileIDs = append(result.downloadedFileIDs, fileRecord.ID)
+		}
+
+		// Прогресс учитывает и пропущенные из-за ошибки файлы
+		if onProgress != nil {
+			onProgress(i+1, len(files))
+		}
+	}
+
+	if result.err == nil {
+		result.err = zipWriter.Close()
+	}
+	// Ошибка записи прерывает загрузку в S3, nil завершает поток архива
+	_ = pipeWriter.CloseWithError(result.err)
+	archived <- result
+}
+
+// validateAndGetFilesForBatch проверяет права доступа и получает файлы для группового скачивания в порядке запроса.
+// Ненайденные и недоступные файлы не прерывают запрос, а попадают в отказы items.
+func (s *FileService) validateAndGetFilesForBatch(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID, items *batch.Result) ([]*ent.File, error) {
+	fileIDs = batch.UniqueIDs(fileIDs)
+
 	// Получаем все файлы из базы данных
 	files, err := client.File.Query().
 		Where(file.IDIn(fileIDs...)).
 		All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.get_files_failed"))
+		return nil, errcatalog.FileGetFilesFailed(ctx)
+	}
+	byID := make(map[uuid.UUID]*ent.File, len(files))
+	for _, fileRecord := range files {
+		byID[fileRecord.ID] = fileRecord
 	}
 
 	// Проверяем права на каждый файл
-	var accessibleFiles []*ent.File
-	for _, fileRecord := range files {
-		if err := s.canDownloadFile(ctx, client, fileRecord.ID); err != nil {
+	accessibleFiles := make([]*ent.File, 0, len(files))
+	for _, fileID := range fileIDs {
+		fileRecord, ok := byID[fileID]
+		if !ok {
+			items.Fail(ctx, fileID, errcatalog.FileNotFound(ctx))
+			continue
+		}
+		if err := s.canDownloadFile(ctx, client, fileID); err != nil {
 			utils.Logger.Warn("File access denied in batch download",
-				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("file_id", fileID.String()),
 				zap.Error(err))
-			// Пропускаем файлы без доступа, но не фейлим весь запрос
+			items.Fail(ctx, fileID, err)
 			continue
 		}
 		accessibleFiles = append(accessibleFiles, fileRecord)
@@ -373,7 +640,7 @@ func (s *FileService) validateAndGetFilesForBatch(ctx context.Context, client *e
 }
 
 // addFileToZipFromS3 добавляет файл из S3 в ZIP-архив
-func (s *FileService) addFileToZipFromS3(ctx context.Context, zipWriter *zip.Writer, fileRecord *ent.File, usedFilenames map[string]bool) error {
+func (s *FileService) addFileToZipFromS3(ctx context.Context, zipWriter *zip.Writer, fileRecord *ent.File, layout ArchiveLayout, usedFilenames map[string]bool) error {
 	// Получаем файл из S3
 	s3Object, err := s.s3Service.GetFileObject(ctx, fileRecord.StorageKey)
 	if err != nil {
@@ -381,8 +648,8 @@ func (s *FileService) addFileToZipFromS3(ctx context.Context, zipWriter *zip.Wri
 	}
 	defer s3Object.Close()
 
-	// Создаем уникальное имя файла в архиве
-	filename := s.generateUniqueFilename(fileRecord.OriginalName, usedFilenames)
+	// Создаем уникальное имя файла в архиве с учетом раскладки по каталогам
+	filename := s.archiveEntryName(fileRecord, layout, usedFilenames)
 
 	// Создаем заголовок файла в ZIP
 	header := &zip.FileHeader{
@@ -434,51 +701,90 @@ func (s *FileService) generateUniqueFilename(originalName string, usedFilenames
 
 // generateTemporaryArchiveKey генерирует ключ для временного архива в корневой временной папке S3
 func (s *FileService) generateTemporaryArchiveKey(archiveName string) string {
+	return s.generateTemporaryKey(strings.TrimSuffix(archiveName, ".zip"), ".zip")
+}
+
+// generateTemporaryKey генерирует ключ временного файла выдачи (архив, выгрузка) в корневой временной папке S3
+func (s *FileService) generateTemporaryKey(name, extension string) string {
 	timestamp := time.Now().Format("2006/01/02/15")
 	id := uuid.New().String()[:8]
 
 	// Сохраняем во временную папку в корне бакета
-	return fmt.Sprintf("temp/%s/%s-%s", timestamp, strings.TrimSuffix(archiveName, ".zip"), id) + ".zip"
-}
-
-// scheduleArchiveDeletion планирует удаление временного архива через указанное время
-func (s *FileService) scheduleArchiveDeletion(ctx context.Context, storageKey string, delay time.Duration) {
-	// Ждем указанное время
-	time.Sleep(delay)
-
-	// Удаляем архив из S3
-	if err := s.s3Service.DeleteFile(ctx, storageKey); err != nil {
-		utils.Logger.Error("Failed to delete temporary archive",
-			zap.Error(err),
-			zap.String("storage_key", storageKey))
-	} else {
-		utils.Logger.Info("Temporary archive deleted successfully",
-			zap.String("storage_key", storageKey))
-	}
+	return fmt.Sprintf("temp/%s/%s-%s", timestamp, name, id) + extension
 }
 
-// UploadFile uploads a file to S3 and creates a file record in database
-func (s *FileService) UploadFile(ctx context.Context, client *ent.Client, input UploadFileInput) (*ent.File, error) {
+// UploadFile uploads a file to S3 and creates a file record in database.
+// Для файлов сущности или папки возвращает конфликт имен по режиму тенанта (nil - конфликта нет).
+func (s *FileService) UploadFile(ctx context.Context, client *ent.Client, input UploadFileInput) (*ent.File, *FilenameConflict, error) {
 	utils.Logger.Info("UploadFile method called",
 		zap.String("filename", input.Upload.Filename),
 		zap.Int64("file_size", input.Upload.Size),
 		zap.Bool("client_not_nil", client != nil))
 
 	if input.Upload == nil {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.no_file"))
+		return nil, nil, errcatalog.FileNoFile(ctx)
+	}
+
+	// Приостановленный тенант работает в режиме только для чтения
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, nil, err
+	}
+
+	// Во время остановки сервиса новые загрузки не принимаются
+	if err := uploadsInFlight.ensureAccepting(ctx); err != nil {
+		return nil, nil, err
 	}
 
 	upload := input.Upload
 
 	// Validate filename length (prevent S3 key length issues)
 	if len(upload.Filename) > 200 {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.filename_too_long"))
+		return nil, nil, errcatalog.FileFilenameTooLong(ctx)
 	}
 
-	// Validate file size (limit to 100MB)
-	const maxFileSize = 100 * 1024 * 1024 // 100MB
-	if upload.Size > maxFileSize {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.size_too_large"))
+	// Validate file size: действующий лимит тенанта (без него MAX_UPLOAD_SIZE, по умолчанию 100MB)
+	maxUploadSize, err := s.effectiveMaxUploadSize(ctx, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	if upload.Size > maxUploadSize {
+		return nil, nil, errcatalog.FileSizeTooLarge(ctx)
+	}
+
+	// Проверяем метаданные до сохранения в S3
+	metadata, err := buildUploadMetadata(ctx, input.Metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	expectedChecksum, err := normalizeUploadChecksum(ctx, input.ChecksumSha256)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.validateObjectLockInput(ctx, input.ObjectLock); err != nil {
+		return nil, nil, err
+	}
+	objectLock := input.ObjectLock
+	if objectLock == nil {
+		objectLock = &ObjectLockInput{}
+	}
+	var expiresAt *time.Time
+	var entityType *file.EntityType
+	var entityID *uuid.UUID
+	var uploadTags []string
+	if input.Metadata != nil {
+		expiresAt = input.Metadata.ExpiresAt
+		entityType, entityID = input.Metadata.EntityType, input.Metadata.EntityID
+		if uploadTags, err = normalizeTagNames(ctx, input.Metadata.Tags); err != nil {
+			return nil, nil, err
+		}
+	}
+	// 📋 [UPLOAD POLICY] Лимит размера, обязательные описание и тег классификации тенанта
+	if err := s.enforceUploadPolicy(ctx, client, upload.Size, input.Description, uploadTags); err != nil {
+		return nil, nil, err
+	}
+	if expiresAt == nil {
+		// Срок хранения не задан явно: применяется срок тенанта по умолчанию
+		expiresAt = s.retentionService.DefaultExpiresAt(ctx, client)
 	}
 
 	// Detect content type if not provided or empty
@@ -490,91 +796,110 @@ func (s *FileService) UploadFile(ctx context.Context, client *ent.Client, input
 		}
 	}
 
-	// 📊 [STORAGE LIMIT CHECK] Проверяем лимит хранилища перед загрузкой
-	// Получаем текущее использование из базы данных
-	currentUsage, err := s.getCurrentStorageUsage(ctx, client)
+	// Сверяем заявленный тип с сигнатурой содержимого (например, exe, переименованный в jpg)
+	head, err := sniffUploadHead(upload.File)
 	if err != nil {
-		utils.Logger.Warn("Failed to get current storage usage, proceeding without limit check",
-			zap.Error(err))
-		currentUsage = 0
+		utils.Logger.Error("Failed to read uploaded file", zap.Error(err), zap.String("filename", upload.Filename))
+		return nil, nil, errcatalog.FileUploadFailed(ctx)
+	}
+	detectedType, err := validateContentType(ctx, contentType, upload.Filename, head)
+	if err != nil {
+		return nil, nil, err
+	}
+	// 🚫 [FILE TYPES] Разрешенные и запрещенные расширения и типы содержимого тенанта
+	if err := s.enforceFileTypePolicy(ctx, client, upload.Filename, upload.Size, contentType, detectedType); err != nil {
+		return nil, nil, err
 	}
 
-	if err := s.s3Service.CheckStorageLimitWithFilename(ctx, upload.Filename, upload.Size, currentUsage); err != nil {
-		utils.Logger.Info("Storage limit check failed",
-			zap.String("filename", upload.Filename),
-			zap.Int64("file_size", upload.Size),
-			zap.Error(err))
-
-		// Проверяем, является ли это ошибкой незастроенного хранилища
-		if storageNotConfiguredErr, ok := err.(*s3.StorageNotConfiguredError); ok {
-			utils.Logger.Info("Logging storage not configured violation",
-				zap.String("filename", storageNotConfiguredErr.FileName),
-				zap.Int64("file_size", storageNotConfiguredErr.FileSize))
-
-			// Логируем попытку загрузки в незастроенное хранилище
-			utils.Logger.Info("About to call LogStorageNotConfiguredViolation",
-				zap.String("filename", storageNotConfiguredErr.FileName),
-				zap.Int64("file_size", storageNotConfiguredErr.FileSize))
-
-			utils.Logger.Warn("Storage not configured violation",
-				zap.String("filename", storageNotConfiguredErr.FileName),
-				zap.Int64("file_size", storageNotConfiguredErr.FileSize))
-
-			utils.Logger.Info("LogStorageNotConfiguredViolation call completed")
-
-			// Возвращаем локализованную ошибку пользователю
-			return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.storage_not_configured"))
+	// 🔐 [CHECKSUM] Сумма клиента относится к переданному содержимому: для изображений она сверяется
+	// до удаления метаданных, остальные файлы сверяются по сумме, посчитанной ниже
+	clientChecksumVerified := false
+	if expectedChecksum != "" && isStrippableImage(detectedType) {
+		received, err := computeUploadChecksum(upload)
+		if err != nil {
+			utils.Logger.Error("Failed to read uploaded file", zap.Error(err), zap.String("filename", upload.Filename))
+			return nil, nil, errcatalog.FileUploadFailed(ctx)
 		}
+		if err := verifyUploadChecksum(ctx, expectedChecksum, received, upload.Filename); err != nil {
+			return nil, nil, err
+		}
+		clientChecksumVerified = true
+	}
 
-		// Проверяем, является ли это ошибкой превышения лимита с данными для аудита
-		if storageLimitErr, ok := err.(*s3.StorageLimitError); ok {
-			utils.Logger.Info("Logging storage limit violation",
-				zap.String("filename", storageLimitErr.FileName),
-				zap.Int64("file_size", storageLimitErr.FileSize),
-				zap.Int64("current_usage", storageLimitErr.CurrentUsage),
-				zap.Int64("storage_limit", storageLimitErr.StorageLimit))
-
-			// Логируем попытку превышения лимита
-			utils.Logger.Info("About to call LogStorageLimitViolation",
-				zap.String("filename", storageLimitErr.FileName),
-				zap.Int64("file_size", storageLimitErr.FileSize))
-
-			utils.Logger.Warn("Storage limit violation",
-				zap.String("filename", storageLimitErr.FileName),
-				zap.Int64("file_size", storageLimitErr.FileSize),
-				zap.Int64("current_usage", storageLimitErr.CurrentUsage),
-				zap.Int64("storage_limit", storageLimitErr.StorageLimit))
+	// 🔏 [PRIVACY] Удаляем EXIF и XMP изображений по режиму тенанта до расчета контрольной суммы
+	if metadata, err = s.applyImageMetadataPolicy(ctx, client, upload, detectedType, metadata); err != nil {
+		return nil, nil, err
+	}
 
-			utils.Logger.Info("LogStorageLimitViolation call completed")
+	// Вычисляем SHA-256 содержимого до загрузки: он используется для защиты от дублей и проверки целостности
+	checksum, err := computeUploadChecksum(upload)
+	if err != nil {
+		utils.Logger.Error("Failed to read uploaded file", zap.Error(err), zap.String("filename", upload.Filename))
+		return nil, nil, errcatalog.FileUploadFailed(ctx)
+	}
+	if !clientChecksumVerified {
+		if err := verifyUploadChecksum(ctx, expectedChecksum, checksum, upload.Filename); err != nil {
+			return nil, nil, err
+		}
+	}
 
-			// Возвращаем локализованную ошибку пользователю
-			return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.storage_limit_exceeded", map[string]interface{}{
-				"current_usage": storageLimitErr.CurrentUsage64,
-				"current_unit":  storageLimitErr.CurrentUnit,
-				"limit":         storageLimitErr.Limit64,
-				"limit_unit":    storageLimitErr.LimitUnit,
-			}))
+	// 🔁 [SINGLE-FLIGHT] Одновременная загрузка того же файла тем же пользователем возвращает уже созданную запись
+	var guard *uploadGuard
+	tenantID, uploaderID := federation.GetTenantID(ctx), federation.GetUserID(ctx)
+	if tenantID != nil && uploaderID != nil && config.FeatureEnabled(config.FeatureUploadSingleFlight) {
+		var existingID *uuid.UUID
+		guard, existingID = acquireUploadGuard(ctx, uploadSingleFlightKey(*tenantID, *uploaderID, upload.Filename, upload.Size, checksum))
+		if existingID != nil {
+			existingFile, getErr := client.File.Get(ent.NewContext(ctx, client), *existingID)
+			if getErr == nil {
+				utils.Logger.Info("Duplicate concurrent upload collapsed into existing file",
+					zap.String("file_id", existingFile.ID.String()),
+					zap.String("filename", upload.Filename))
+				return existingFile, nil, nil
+			}
 		}
+	}
+	completed := false
+	if guard != nil {
+		defer func() {
+			if !completed {
+				guard.release(ctx)
+			}
+		}()
+	}
 
-		// Проверяем, является ли это ошибкой файла, который сам по себе больше лимита
-		if fileTooLargeErr, ok := err.(*s3.FileTooLargeError); ok {
-			utils.Logger.Info("File too large for storage limit",
-				zap.String("filename", fileTooLargeErr.FileName),
-				zap.Int64("file_size", fileTooLargeErr.FileSize))
+	// 📊 [STORAGE LIMIT CHECK] Проверяем лимит хранилища перед загрузкой
+	if err := s.checkUploadStorageLimit(ctx, client, upload.Filename, upload.Size); err != nil {
+		return nil, nil, err
+	}
 
-			// Возвращаем локализованную ошибку пользователю
-			return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.file_too_large_for_storage", map[string]interface{}{
-				"file_size":  fileTooLargeErr.FileSize64,
-				"file_unit":  fileTooLargeErr.FileUnit,
-				"limit":      fileTooLargeErr.Limit64,
-				"limit_unit": fileTooLargeErr.LimitUnit,
-			}))
-		}
-		return nil, err
+	// 🛡️ [ANTIVIRUS] Проверяем файл до сохранения в S3
+	scanStatus, err := s.scanUpload(ctx, client, upload)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Upload to S3
-	storageKey, err := s.s3Service.UploadFile(ctx, upload.File, upload.Filename, contentType)
+	// Upload to S3 (передача отменяется, если не успевает завершиться при остановке сервиса)
+	uploadCtx, finishUpload, err := uploadsInFlight.beginUpload(ctx, upload.Filename)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Прогресс передачи в S3 публикуется пользователю, если клиент запросил его заголовком X-Upload-Progress-Id
+	fileContent := NewUploadProgressReader(ctx, upload.File, UploadPhaseStoring, upload.Filename, upload.Size)
+	var contentMD5 hash.Hash
+	if expectedChecksum != "" {
+		// MD5 переданного потока сверяется с ETag объекта, чтобы обнаружить повреждение при передаче в S3
+		contentMD5 = md5.New()
+		fileContent = io.TeeReader(fileContent, contentMD5)
+	}
+	storageKey, err := s.s3Service.UploadFile(uploadCtx, fileContent, upload.Filename, contentType)
+	if aborted := finishUpload(); aborted {
+		if err == nil {
+			// Загрузка завершилась одновременно с отменой: объект не нужен, клиент повторит загрузку
+			_ = s.s3Service.DeleteFile(context.WithoutCancel(ctx), storageKey)
+		}
+		return nil, nil, errcatalog.FileServiceShuttingDown(ctx)
+	}
 	if err != nil {
 		// 🔍 [DEBUG] Логируем детальную ошибку S3 для диагностики
 		utils.Logger.Error("S3 upload failed - detailed error",
@@ -585,7 +910,7 @@ func (s *FileService) UploadFile(ctx context.Context, client *ent.Client, input
 
 		// Check if it's S3 configuration error
 		if strings.Contains(err.Error(), "S3 credentials are not configured") {
-			return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.s3_not_configured"))
+			return nil, nil, errcatalog.FileS3NotConfigured(ctx)
 		}
 
 		// Check for timeout errors
@@ -593,7 +918,7 @@ func (s *FileService) UploadFile(ctx context.Context, client *ent.Client, input
 			utils.Logger.Error("S3 upload timeout detected",
 				zap.Error(err),
 				zap.String("filename", upload.Filename))
-			return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.upload_timeout"))
+			return nil, nil, errcatalog.FileUploadTimeout(ctx)
 		}
 
 		// Check for connection errors
@@ -601,10 +926,33 @@ func (s *FileService) UploadFile(ctx context.Context, client *ent.Client, input
 			utils.Logger.Error("S3 connection error detected",
 				zap.Error(err),
 				zap.String("filename", upload.Filename))
-			return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.s3_connection_failed"))
+			return nil, nil, errcatalog.FileS3ConnectionFailed(ctx)
 		}
 
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.upload_failed"))
+		return nil, nil, errcatalog.FileUploadFailed(ctx)
+	}
+	if contentMD5 != nil {
+		if err := s.verifyStoredETag(ctx, storageKey, hex.EncodeToString(contentMD5.Sum(nil))); err != nil {
+			if deleteErr := s.s3Service.DeleteFile(ctx, storageKey); deleteErr != nil {
+				utils.Logger.Error("Failed to cleanup S3 file after checksum mismatch",
+					zap.Error(deleteErr),
+					zap.String("storage_key", storageKey),
+				)
+			}
+			return nil, nil, err
+		}
+	}
+	// 🔒 [OBJECT LOCK] Удержание задается до создания записи: запись не должна обещать защиту, которой нет у объекта
+	if input.ObjectLock != nil {
+		if err := s.applyObjectLock(ctx, storageKey, input.ObjectLock, false); err != nil {
+			if deleteErr := s.s3Service.DeleteFile(ctx, storageKey); deleteErr != nil {
+				utils.Logger.Error("Failed to cleanup S3 file after object lock error",
+					zap.Error(deleteErr),
+					zap.String("storage_key", storageKey),
+				)
+			}
+			return nil, nil, err
+		}
 	}
 
 	// Get user from context for database record
@@ -617,18 +965,48 @@ func (s *FileService) UploadFile(ctx context.Context, client *ent.Client, input
 				zap.String("storage_key", storageKey),
 			)
 		}
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.user.not_authenticated"))
+		return nil, nil, errcatalog.UserNotAuthenticated(ctx)
 	}
 
-	// Create file record in database
+	// Create file record in database (теги создаются в той же транзакции)
 	ctxWithClient := ent.NewContext(ctx, client)
+	tags, err := s.ensureTags(ctx, client, uploadTags)
+	if err != nil {
+		if deleteErr := s.s3Service.DeleteFile(ctx, storageKey); deleteErr != nil {
+			utils.Logger.Error("Failed to cleanup S3 file after tag error",
+				zap.Error(deleteErr),
+				zap.String("storage_key", storageKey),
+			)
+		}
+		return nil, nil, err
+	}
+	// Одинаковые имена файлов одной сущности или папки переименовываются или сопровождаются предупреждением
+	filename, conflict := s.resolveFilenameConflict(ctx, client, upload.Filename, filenameConflictScope(entityType, entityID, metadata))
+	source, clientVersion := uploadSourceFromContext(ctx)
 	fileRecord, err := client.File.Create().
-		SetOriginalName(upload.Filename).
+		SetOriginalName(filename).
 		SetStorageKey(storageKey).
 		SetMimeType(contentType).
+		SetDetectedMimeType(detectedType).
 		SetSize(upload.Size).
+		SetChecksumSha256(checksum).
+		SetThumbnailStatus(initialThumbnailStatus(contentType)).
+		SetPreviewStatus(initialPreviewStatus(contentType)).
+		SetPdfStatus(s.initialPDFStatus(contentType)).
+		SetScanStatus(scanStatus).
 		SetCreatedBy(*userID).
 		SetNillableDescription(input.Description).
+		SetMetadata(metadata).
+		SetNillableExpiresAt(expiresAt).
+		SetNillableEntityType(entityType).
+		SetNillableEntityID(entityID).
+		SetNillableDepartmentID(uploadDepartmentID(ctx)).
+		SetUploadSource(source).
+		SetNillableClientVersion(clientVersion).
+		SetNillableObjectLockMode(objectLock.Mode).
+		SetNillableObjectLockRetainUntil(objectLock.RetainUntil).
+		SetNillableLegalHold(objectLock.LegalHold).
+		AddTags(tags...).
 		Save(ctxWithClient)
 	if err != nil {
 		// If database save fails, try to cleanup S3 file
@@ -638,39 +1016,309 @@ func (s *FileService) UploadFile(ctx context.Context, client *ent.Client, input
 				zap.String("storage_key", storageKey),
 			)
 		}
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.create_failed"))
+		if isMetadataSchemaError(err) {
+			return nil, nil, err
+		}
+		return nil, nil, errcatalog.FileCreateFailed(ctx)
 	}
 
-	return fileRecord, nil
+	// При откате транзакции резолвера (например, если следующий шаг составной операции упал)
+	// удаляем уже загруженный объект, чтобы не оставлять сирот в S3
+	database.OnRollback(ctx, func(ctx context.Context) {
+		if err := s.s3Service.DeleteFile(ctx, storageKey); err != nil {
+			utils.Logger.Error("Failed to cleanup S3 file after transaction rollback",
+				zap.Error(err),
+				zap.String("storage_key", storageKey))
+		}
+	})
+
+	// Публикуем созданную запись для параллельных загрузок того же файла после коммита
+	if guard != nil {
+		completed = true
+		database.OnCommit(ctx, func(ctx context.Context) {
+			guard.complete(ctx, fileRecord.ID)
+		})
+		database.OnRollback(ctx, func(ctx context.Context) {
+			guard.release(ctx)
+		})
+	}
+
+	// Превью изображения и документа создаются фоновыми обработчиками после коммита
+	if fileRecord.ThumbnailStatus == file.ThumbnailStatusPENDING {
+		database.OnCommit(ctx, func(context.Context) { requestThumbnails() })
+	}
+	if fileRecord.PreviewStatus == file.PreviewStatusPENDING {
+		database.OnCommit(ctx, func(context.Context) { requestPreviews() })
+	}
+	if fileRecord.PdfStatus == file.PdfStatusPENDING {
+		database.OnCommit(ctx, func(context.Context) { requestPDFRenditions() })
+	}
+	if fileRecord.ScanStatus == file.ScanStatusPENDING {
+		database.OnCommit(ctx, func(context.Context) { requestMalwareScan() })
+	}
+
+	// 📊 [AUDIT] Фиксируем загрузку файла
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:  fileauditevent.ActionUPLOAD,
+		FileID:  &fileRecord.ID,
+		Details: uploadSourceAuditDetails(uploadAuditDetails(fileRecord, input.SourceURL), source, clientVersion),
+	})
+
+	return fileRecord, conflict, nil
+}
+
+// checkUploadStorageLimit проверяет лимит хранилища тенанта, квоту отдела и лимиты пользователя перед
+// загрузкой файла и возвращает локализованную ошибку; нарушения лимита фиксируются в аудите
+func (s *FileService) checkUploadStorageLimit(ctx context.Context, client *ent.Client, filename string, size int64) error {
+	// Получаем текущее использование из базы данных
+	currentUsage, err := s.getCurrentStorageUsage(ctx, client)
+	if err != nil {
+		utils.Logger.Warn("Failed to get current storage usage, proceeding without limit check",
+			zap.Error(err))
+		currentUsage = 0
+	}
+
+	quotaPolicy := s.getQuotaPolicy(ctx, client)
+	storageLimit := s.tenantStorageLimit(quotaPolicy)
+
+	if quotaPolicy.Mode == tenantsetting.QuotaModeGRACE {
+		err = s.s3Service.CheckStorageLimitWithGrace(ctx, filename, size, currentUsage, storageLimit, quotaPolicy.GracePercent)
+	} else {
+		err = s.s3Service.CheckStorageLimitWithFilename(ctx, filename, size, currentUsage, storageLimit)
+	}
+	// В мягком режиме превышение квоты не блокирует загрузку
+	if err != nil && quotaPolicy.Mode == tenantsetting.QuotaModeSOFT && s3.IsQuotaExceeded(err) {
+		err = nil
+	}
+
+	if err != nil {
+		utils.Logger.Info("Storage limit check failed",
+			zap.String("filename", filename),
+			zap.Int64("file_size", size),
+			zap.Error(err))
+
+		// Проверяем, является ли это ошибкой незастроенного хранилища
+		if storageNotConfiguredErr, ok := err.(*s3.StorageNotConfiguredError); ok {
+			// 📊 [AUDIT] Фиксируем попытку загрузки в незастроенное хранилище
+			s.auditService.Record(ctx, client, audit.Event{
+				Action: fileauditevent.ActionLIMIT_VIOLATION,
+				Details: map[string]interface{}{
+					"reason":    "storage_not_configured",
+					"filename":  storageNotConfiguredErr.FileName,
+					"file_size": storageNotConfiguredErr.FileSize,
+				},
+			})
+
+			// Возвращаем локализованную ошибку пользователю
+			return errcatalog.FileStorageNotConfigured(ctx)
+		}
+
+		// Проверяем, является ли это ошибкой превышения лимита с данными для аудита
+		if storageLimitErr, ok := err.(*s3.StorageLimitError); ok {
+			// 📊 [AUDIT] Фиксируем попытку превышения лимита
+			s.auditService.Record(ctx, client, audit.Event{
+				Action: fileauditevent.ActionLIMIT_VIOLATION,
+				Details: map[string]interface{}{
+					"reason":        "storage_limit_exceeded",
+					"filename":      storageLimitErr.FileName,
+					"file_size":     storageLimitErr.FileSize,
+					"current_usage": storageLimitErr.CurrentUsage,
+					"storage_limit": storageLimitErr.StorageLimit,
+				},
+			})
+
+			// Возвращаем локализованную ошибку пользователю
+			return errcatalog.FileStorageLimitExceeded(ctx,
+				storageLimitErr.CurrentUnit, storageLimitErr.CurrentUsage64,
+				storageLimitErr.Limit64, storageLimitErr.LimitUnit)
+		}
+
+		// Проверяем, является ли это ошибкой файла, который сам по себе больше лимита
+		if fileTooLargeErr, ok := err.(*s3.FileTooLargeError); ok {
+			utils.Logger.Info("File too large for storage limit",
+				zap.String("filename", fileTooLargeErr.FileName),
+				zap.Int64("file_size", fileTooLargeErr.FileSize))
+
+			// Возвращаем локализованную ошибку пользователю
+			return errcatalog.FileFileTooLargeForStorage(ctx,
+				fileTooLargeErr.FileSize64, fileTooLargeErr.FileUnit,
+				fileTooLargeErr.Limit64, fileTooLargeErr.LimitUnit)
+		}
+		return err
+	}
+
+	// В режимах SOFT и GRACE загрузка сверх лимита разрешена, но фиксируется и сообщается админам
+	if quotaPolicy.Mode != tenantsetting.QuotaModeHARD {
+		s.reportOverQuota(ctx, client, quotaPolicy, filename, size, currentUsage)
+	}
+
+	if err := s.checkDepartmentQuota(ctx, client, filename, size); err != nil {
+		return err
+	}
+	return s.checkUserStorageLimit(ctx, client, filename, size)
+}
+
+// computeUploadChecksum вычисляет SHA-256 загружаемого файла и возвращает поток в начало
+func computeUploadChecksum(upload *graphql.Upload) (string, error) {
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, upload.File); err != nil {
+		return "", err
+	}
+	if _, err := upload.File.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// UpdateFileInfoInput содержит изменяемые пользователем поля файла
+type UpdateFileInfoInput struct {
+	OriginalName *string
+	Description  *string
+	// Attributes заменяют атрибуты metadata клиента (служебные ключи сохраняются), nil - без изменений
+	Attributes map[string]interface{}
 }
 
-// DeleteFile deletes a file from both database and S3
+// UpdateFileInfo обновляет имя, описание и атрибуты metadata файла и фиксирует изменение в аудите
+func (s *FileService) UpdateFileInfo(ctx context.Context, client *ent.Client, fileID uuid.UUID, input UpdateFileInfoInput) (*ent.File, error) {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	updater := client.File.UpdateOneID(fileID)
+
+	// Обновляем только переданные поля
+	if input.Description != nil {
+		updater = updater.SetDescription(*input.Description)
+	}
+	if input.OriginalName != nil {
+		updater = updater.SetOriginalName(*input.OriginalName)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	if input.Attributes != nil {
+		current, err := client.File.Query().
+			Where(file.ID(fileID)).
+			Select(file.FieldMetadata).
+			Only(ctxWithClient)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, errcatalog.FileNotFound(ctx)
+			}
+			return nil, errcatalog.FileGetFailed(ctx)
+		}
+		metadata, err := replaceMetadataAttributes(ctx, current.Metadata, input.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		updater = updater.SetMetadata(metadata)
+	}
+
+	updatedFile, err := updater.Save(ctxWithClient)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		if isMetadataSchemaError(err) {
+			return nil, err
+		}
+		utils.Logger.Error("Failed to update file", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, errcatalog.FileUpdateFailed(ctx)
+	}
+
+	// 📊 [AUDIT] Переименование фиксируется отдельным типом действия
+	auditEvent := audit.Event{
+		Action: fileauditevent.ActionUPDATE,
+		FileID: &fileID,
+	}
+	if input.OriginalName != nil {
+		auditEvent.Action = fileauditevent.ActionRENAME
+		auditEvent.Details = map[string]interface{}{"original_name": *input.OriginalName}
+	}
+	s.auditService.Record(ctx, client, auditEvent)
+
+	return updatedFile, nil
+}
+
+// DeleteFile перемещает файл в корзину: запись скрывается из запросов и может быть восстановлена
+// через RestoreFile, а объект в S3 удаляется фоновой очисткой после срока хранения (FILE_TRASH_RETENTION)
 func (s *FileService) DeleteFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) error {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return err
+	}
+
 	ctxWithClient := ent.NewContext(ctx, client)
 
 	// Проверяем существование файла перед удалением
-	_, err := client.File.Query().
+	fileRecord, err := client.File.Query().
 		Where(file.ID(fileID)).
 		Only(ctxWithClient)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return fmt.Errorf("%s", utils.T(ctx, "error.file.not_found"))
+			return errcatalog.FileNotFound(ctx)
 		}
-		return fmt.Errorf("%s", utils.T(ctx, "error.file.get_failed"))
+		return errcatalog.FileGetFailed(ctx)
 	}
 
-	// Жестко удаляем файл из базы данных
-	err = client.File.DeleteOneID(fileID).
+	// Мягко удаляем файл: до очистки корзины он продолжает занимать место в хранилище
+	err = client.File.UpdateOneID(fileID).
+		SetDeletedAt(time.Now()).
 		Exec(ctxWithClient)
 	if err != nil {
-		return fmt.Errorf("%s", utils.T(ctx, "error.file.delete_failed"))
+		return errcatalog.FileDeleteFailed(ctx)
 	}
 
-	// Delete from S3 происходит автоматически через хук WithFileS3Deletion()
+	// 📊 [AUDIT] Фиксируем удаление (в той же транзакции, что и удаление записи)
+	s.auditService.Record(ctx, client, audit.Event{
+		Action: fileauditevent.ActionDELETE,
+		FileID: &fileID,
+		Details: map[string]interface{}{
+			"filename": fileRecord.OriginalName,
+			"size":     fileRecord.Size,
+			"trashed":  true,
+		},
+	})
 
 	return nil
 }
 
+// DeleteFiles удаляет несколько файлов в транзакции резолвера. Права проверяются для каждого файла:
+// недоступные и ненайденные файлы попадают в отказы итога, остальные удаляются.
+// Ошибка удаления доступного файла откатывает всю операцию. Повторы ID учитываются один раз.
+func (s *FileService) DeleteFiles(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID) (*batch.Result, error) {
+	if !database.InTx(ctx) {
+		return nil, fmt.Errorf("DeleteFiles must be called within a transaction")
+	}
+	if len(fileIDs) == 0 {
+		return nil, errcatalog.FileNoFilesSelected(ctx)
+	}
+	if len(fileIDs) > config.Get().MaxBatchDeleteFiles {
+		return nil, errcatalog.FileTooManyFilesForBatchDelete(ctx)
+	}
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	// Проверяем права на все файлы до начала удаления
+	result := batch.NewResult()
+	deletable := make([]uuid.UUID, 0, len(fileIDs))
+	for _, fileID := range batch.UniqueIDs(fileIDs) {
+		if err := s.CanDeleteFile(ctx, client, fileID); err != nil {
+			result.Fail(ctx, fileID, err)
+			continue
+		}
+		deletable = append(deletable, fileID)
+	}
+
+	for _, fileID := range deletable {
+		if err := s.DeleteFile(ctx, client, fileID); err != nil {
+			return nil, err
+		}
+		result.Succeed(fileID)
+	}
+
+	return result, nil
+}
+
 // GetFilesByUser returns files uploaded by a specific user
 func (s *FileService) GetFilesByUser(ctx context.Context, client *ent.Client, userID uuid.UUID, limit, offset int) ([]*ent.File, error) {
 	ctxWithClient := ent.NewContext(ctx, client)
@@ -679,10 +1327,10 @@ func (s *FileService) GetFilesByUser(ctx context.Context, client *ent.Client, us
 		Where(file.CreatedBy(userID)).
 		Limit(limit).
 		Offset(offset).
-		Order(ent.Desc(file.FieldCreateTime)).
+		Order(ent.Desc(file.FieldCreateTime), ent.Desc(file.FieldID)).
 		All(ctxWithClient)
 	if err != nil {
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.get_files_failed"))
+		return nil, errcatalog.FileGetFilesFailed(ctx)
 	}
 
 	return files, nil
@@ -697,9 +1345,9 @@ func (s *FileService) GetFileInfo(ctx context.Context, client *ent.Client, fileI
 		Only(ctxWithClient)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.not_found"))
+			return nil, errcatalog.FileNotFound(ctx)
 		}
-		return nil, fmt.Errorf("%s", utils.T(ctx, "error.file.get_failed"))
+		return nil, errcatalog.FileGetFailed(ctx)
 	}
 
 	return fileRecord, nil
@@ -709,19 +1357,19 @@ func (s *FileService) GetFileInfo(ctx context.Context, client *ent.Client, fileI
 func (s *FileService) UpdateFilesBatch(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID) ([]*ent.File, int, error) {
 	// Валидация входных данных
 	if len(fileIDs) == 0 {
-		return nil, 0, fmt.Errorf("%s", utils.T(ctx, "error.file.no_files_selected"))
+		return nil, 0, errcatalog.FileNoFilesSelected(ctx)
 	}
 
 	// Ограничиваем количество файлов для обновления за раз
 	const maxBatchUpdateFiles = 100
 	if len(fileIDs) > maxBatchUpdateFiles {
-		return nil, 0, fmt.Errorf("%s", utils.T(ctx, "error.file.too_many_files_for_batch_update"))
+		return nil, 0, errcatalog.FileTooManyFilesForBatchUpdate(ctx)
 	}
 
 	// Проверяем права на все файлы перед началом обновления
 	for _, fileID := range fileIDs {
 		if err := s.CanUpdateFile(ctx, client, fileID); err != nil {
-			return nil, 0, fmt.Errorf("%s", utils.T(ctx, "error.file.access_denied_for_batch_update"))
+			return nil, 0, errcatalog.FileAccessDeniedForBatchUpdate(ctx)
 		}
 	}
 
@@ -732,12 +1380,12 @@ func (s *FileService) UpdateFilesBatch(ctx context.Context, client *ent.Client,
 		Limit(maxBatchUpdateFiles).
 		All(ctxWithClient)
 	if err != nil {
-		return nil, 0, fmt.Errorf("%s", utils.T(ctx, "error.file.get_files_failed"))
+		return nil, 0, errcatalog.FileGetFilesFailed(ctx)
 	}
 
 	// Проверяем, что все файлы найдены
 	if len(files) != len(fileIDs) {
-		return nil, 0, fmt.Errorf("%s", utils.T(ctx, "error.file.some_files_not_found"))
+		return nil, 0, errcatalog.FileSomeFilesNotFound(ctx)
 	}
 
 	// Возвращаем найденные файлы без изменения полей
@@ -747,7 +1395,7 @@ func (s *FileService) UpdateFilesBatch(ctx context.Context, client *ent.Client,
 		Limit(maxBatchUpdateFiles).
 		All(ctxWithClient)
 	if err != nil {
-		return nil, 0, fmt.Errorf("%s", utils.T(ctx, "error.file.get_updated_files_failed"))
+		return nil, 0, errcatalog.FileGetUpdatedFilesFailed(ctx)
 	}
 
 	return updatedFilesWithDetails, updatedCount, nil
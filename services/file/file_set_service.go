@@ -0,0 +1,475 @@
+package file
+
+import (
+	"context"
+	"main/config"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileset"
+	"main/ent/predicate"
+	"main/errcatalog"
+	"main/services/batch"
+	"main/utils"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxFileSetNameLength максимальная длина названия набора
+	MaxFileSetNameLength = 255
+	// MaxFileSetDescriptionLength максимальная длина описания набора
+	MaxFileSetDescriptionLength = 1000
+	// MaxFileSetSharedUsers максимальное количество пользователей, которым открыт набор
+	MaxFileSetSharedUsers = 100
+)
+
+// CreateFileSetInput параметры создания набора файлов
+type CreateFileSetInput struct {
+	Name        string
+	Description *string
+	FileIDs     []uuid.UUID
+}
+
+// UpdateFileSetInput параметры изменения набора (nil - значение не меняется)
+type UpdateFileSetInput struct {
+	Name        *string
+	Description *string
+	FileIDs     []uuid.UUID
+}
+
+// FileSetService управляет сохраненными наборами файлов
+type FileSetService struct {
+	fileService *FileService
+}
+
+// NewFileSetService creates a new file set service
+func NewFileSetService() *FileSetService {
+	return &FileSetService{
+		fileService: NewFileService(),
+	}
+}
+
+// CanEditFileSet проверяет, может ли пользователь изменять, удалять и открывать доступ к набору
+func (s *FileSetService) CanEditFileSet(ctx context.Context, set *ent.FileSet) bool {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return false
+	}
+	return set.CreatedBy == *userID || s.fileService.hasAdminRole(ctx)
+}
+
+// canViewFileSet проверяет доступ к набору: владелец, админы, пользователи из списка доступа
+// и все пользователи тенанта для наборов, открытых тенанту
+func (s *FileSetService) canViewFileSet(ctx context.Context, set *ent.FileSet) bool {
+	if s.CanEditFileSet(ctx, set) {
+		return true
+	}
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return false
+	}
+	if set.SharedWithTenant && s.fileService.isMember(ctx) {
+		return true
+	}
+	for _, sharedUserID := range set.SharedUserIds {
+		if sharedUserID == *userID {
+			return true
+		}
+	}
+	return false
+}
+
+// getFileSet загружает набор и проверяет доступ на просмотр
+func (s *FileSetService) getFileSet(ctx context.Context, client *ent.Client, id uuid.UUID) (*ent.FileSet, error) {
+	set, err := client.FileSet.Get(ent.NewContext(ctx, client), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FilesetNotFound(ctx)
+		}
+		return nil, errcatalog.FilesetGetFailed(ctx)
+	}
+	if !s.canViewFileSet(ctx, set) {
+		// Не раскрываем существование чужих наборов
+		return nil, errcatalog.FilesetNotFound(ctx)
+	}
+	return set, nil
+}
+
+// getEditableFileSet загружает набор и проверяет права на изменение
+func (s *FileSetService) getEditableFileSet(ctx context.Context, client *ent.Client, id uuid.UUID) (*ent.FileSet, error) {
+	set, err := s.getFileSet(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	if !s.CanEditFileSet(ctx, set) {
+		return nil, errcatalog.FilesetEditPermissionDenied(ctx)
+	}
+	return set, nil
+}
+
+// validateFileSetName проверяет и нормализует название набора
+func validateFileSetName(ctx context.Context, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errcatalog.FilesetNameRequired(ctx)
+	}
+	if len(name) > MaxFileSetNameLength {
+		return "", errcatalog.FilesetNameTooLong(ctx)
+	}
+	return name, nil
+}
+
+// validateFileSetFiles проверяет список файлов набора: без дублей, не больше лимита архива
+// и только файлы, доступные текущему пользователю
+func (s *FileSetService) validateFileSetFiles(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID) ([]uuid.UUID, error) {
+	unique := make([]uuid.UUID, 0, len(fileIDs))
+	seen := make(map[uuid.UUID]bool, len(fileIDs))
+	for _, fileID := range fileIDs {
+		if !seen[fileID] {
+			seen[fileID] = true
+			unique = append(unique, fileID)
+		}
+	}
+
+	if len(unique) == 0 {
+		return nil, errcatalog.FileNoFilesSelected(ctx)
+	}
+	if len(unique) > config.Get().MaxBatchArchiveFiles {
+		return nil, errcatalog.FileTooManyFilesSelected(ctx)
+	}
+
+	byID, err := loadFiles(ctx, client, unique)
+	if err != nil {
+		return nil, err
+	}
+	for _, fileID := range unique {
+		fileRecord, ok := byID[fileID]
+		if !ok {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		if !s.fileService.canDownloadFileRecord(ctx, fileRecord) {
+			return nil, errcatalog.FileViewPermissionDenied(ctx)
+		}
+	}
+	return unique, nil
+}
+
+// loadFiles загружает файлы одним запросом; удаленные файлы в результат не попадают
+func loadFiles(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID) (map[uuid.UUID]*ent.File, error) {
+	byID := make(map[uuid.UUID]*ent.File, len(fileIDs))
+	if len(fileIDs) == 0 {
+		return byID, nil
+	}
+	files, err := client.File.Query().
+		Where(file.IDIn(fileIDs...)).
+		All(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Error("Failed to load file set files", zap.Error(err))
+		return nil, errcatalog.FileGetFilesFailed(ctx)
+	}
+	for _, fileRecord := range files {
+		byID[fileRecord.ID] = fileRecord
+	}
+	return byID, nil
+}
+
+// fileSetShared сообщает, открыт ли набор кому-либо, кроме владельца
+func fileSetShared(sharedWithTenant bool, sharedUserIDs []uuid.UUID) bool {
+	return sharedWithTenant || len(sharedUserIDs) > 0
+}
+
+// downloadableFileIDs возвращает файлы набора, которые текущий пользователь может скачивать, в порядке набора
+func (s *FileSetService) downloadableFileIDs(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID) ([]uuid.UUID, error) {
+	byID, err := loadFiles(ctx, client, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+	downloadable := make([]uuid.UUID, 0, len(fileIDs))
+	for _, fileID := range fileIDs {
+		if fileRecord, ok := byID[fileID]; ok && s.fileService.canDownloadFileRecord(ctx, fileRecord) {
+			downloadable = append(downloadable, fileID)
+		}
+	}
+	return downloadable, nil
+}
+
+// fileSetSaveError конвертирует ошибку сохранения в локализованную
+func fileSetSaveError(ctx context.Context, err error) error {
+	if ent.IsConstraintError(err) {
+		return errcatalog.FilesetNameTaken(ctx)
+	}
+	utils.Logger.Error("Failed to save file set", zap.Error(err))
+	return errcatalog.FilesetSaveFailed(ctx)
+}
+
+// CreateFileSet создает набор файлов текущего пользователя
+func (s *FileSetService) CreateFileSet(ctx context.Context, client *ent.Client, input CreateFileSetInput) (*ent.FileSet, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+	if err := s.fileService.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	name, err := validateFileSetName(ctx, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	if input.Description != nil && len(*input.Description) > MaxFileSetDescriptionLength {
+		return nil, errcatalog.FilesetDescriptionTooLong(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	fileIDs, err := s.validateFileSetFiles(ctxWithClient, client, input.FileIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	set, err := client.FileSet.Create().
+		SetCreatedBy(*userID).
+		SetName(name).
+		SetNillableDescription(input.Description).
+		SetFileIds(fileIDs).
+		Save(ctxWithClient)
+	if err != nil {
+		return nil, fileSetSaveError(ctx, err)
+	}
+
+	return set, nil
+}
+
+// UpdateFileSet изменяет название, описание или состав набора
+func (s *FileSetService) UpdateFileSet(ctx context.Context, client *ent.Client, id uuid.UUID, input UpdateFileSetInput) (*ent.FileSet, error) {
+	if err := s.fileService.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	set, err := s.getEditableFileSet(ctxWithClient, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	update := client.FileSet.UpdateOne(set)
+	if input.Name != nil {
+		name, err := validateFileSetName(ctx, *input.Name)
+		if err != nil {
+			return nil, err
+		}
+		update.SetName(name)
+	}
+	if input.Description != nil {
+		if len(*input.Description) > MaxFileSetDescriptionLength {
+			return nil, errcatalog.FilesetDescriptionTooLong(ctx)
+		}
+		update.SetDescription(*input.Description)
+	}
+	if input.FileIDs != nil {
+		fileIDs, err := s.validateFileSetFiles(ctxWithClient, client, input.FileIDs)
+		if err != nil {
+			return nil, err
+		}
+		update.SetFileIds(fileIDs)
+		// Все файлы нового состава доступны изменяющему, поэтому для открытого набора они открываются вместе с ним
+		if fileSetShared(set.SharedWithTenant, set.SharedUserIds) {
+			update.SetGrantedFileIds(fileIDs)
+		}
+	}
+
+	updated, err := update.Save(ctxWithClient)
+	if err != nil {
+		return nil, fileSetSaveError(ctx, err)
+	}
+	return updated, nil
+}
+
+// ShareFileSet задает доступ к набору: всему тенанту и/или отдельным пользователям (заменяет текущий список).
+// Вместе с набором открывается доступ к его файлам, которые может скачивать открывающий доступ;
+// при закрытии доступа открытые файлы сбрасываются.
+func (s *FileSetService) ShareFileSet(ctx context.Context, client *ent.Client, id uuid.UUID, sharedWithTenant bool, userIDs []uuid.UUID) (*ent.FileSet, error) {
+	if err := s.fileService.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	set, err := s.getEditableFileSet(ctxWithClient, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedUserIDs := make([]uuid.UUID, 0, len(userIDs))
+	seen := map[uuid.UUID]bool{set.CreatedBy: true}
+	for _, userID := range userIDs {
+		if !seen[userID] {
+			seen[userID] = true
+			sharedUserIDs = append(sharedUserIDs, userID)
+		}
+	}
+	if len(sharedUserIDs) > MaxFileSetSharedUsers {
+		return nil, errcatalog.FilesetTooManySharedUsers(ctx)
+	}
+
+	grantedFileIDs := []uuid.UUID{}
+	if fileSetShared(sharedWithTenant, sharedUserIDs) {
+		grantedFileIDs, err = s.downloadableFileIDs(ctxWithClient, client, set.FileIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := client.FileSet.UpdateOne(set).
+		SetSharedWithTenant(sharedWithTenant).
+		SetSharedUserIds(sharedUserIDs).
+		SetGrantedFileIds(grantedFileIDs).
+		Save(ctxWithClient)
+	if err != nil {
+		return nil, fileSetSaveError(ctx, err)
+	}
+	return updated, nil
+}
+
+// DeleteFileSet удаляет набор (сами файлы не затрагиваются)
+func (s *FileSetService) DeleteFileSet(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	if err := s.fileService.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	set, err := s.getEditableFileSet(ctxWithClient, client, id)
+	if err != nil {
+		return err
+	}
+
+	if err := client.FileSet.DeleteOne(set).Exec(ctxWithClient); err != nil {
+		utils.Logger.Error("Failed to delete file set", zap.Error(err), zap.String("file_set_id", id.String()))
+		return errcatalog.FilesetDeleteFailed(ctx)
+	}
+	return nil
+}
+
+// GetFileSet возвращает набор, доступный текущему пользователю
+func (s *FileSetService) GetFileSet(ctx context.Context, client *ent.Client, id uuid.UUID) (*ent.FileSet, error) {
+	return s.getFileSet(ent.NewContext(ctx, client), client, id)
+}
+
+// ListFileSets возвращает свои наборы и наборы, открытые пользователю; админы видят все наборы тенанта
+func (s *FileSetService) ListFileSets(ctx context.Context, client *ent.Client) ([]*ent.FileSet, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	query := client.FileSet.Query()
+	if !s.fileService.hasAdminRole(ctx) {
+		visible := []predicate.FileSet{
+			fileset.CreatedBy(*userID),
+			func(sel *sql.Selector) {
+				sel.Where(sqljson.ValueContains(fileset.FieldSharedUserIds, *userID))
+			},
+		}
+		if s.fileService.isMember(ctx) {
+			visible = append(visible, fileset.SharedWithTenant(true))
+		}
+		query = query.Where(fileset.Or(visible...))
+	}
+
+	sets, err := query.
+		Order(ent.Desc(fileset.FieldUpdateTime), ent.Desc(fileset.FieldID)).
+		All(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Error("Failed to list file sets", zap.Error(err))
+		return nil, errcatalog.FilesetGetFailed(ctx)
+	}
+	return sets, nil
+}
+
+// GetFileSetFiles возвращает существующие файлы набора в порядке набора, доступные через набор.
+// Удаленные файлы пропускаются.
+func (s *FileSetService) GetFileSetFiles(ctx context.Context, client *ent.Client, set *ent.FileSet) ([]*ent.File, error) {
+	return s.fileSetFiles(ctx, client, set, nil)
+}
+
+// GetFileSetsFiles возвращает доступные файлы нескольких наборов по ID набора, загружая файлы одним запросом
+func (s *FileSetService) GetFileSetsFiles(ctx context.Context, client *ent.Client, sets []*ent.FileSet) (map[uuid.UUID][]*ent.File, error) {
+	fileIDs := make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]bool)
+	for _, set := range sets {
+		for _, fileID := range set.FileIds {
+			if !seen[fileID] {
+				seen[fileID] = true
+				fileIDs = append(fileIDs, fileID)
+			}
+		}
+	}
+
+	byID, err := loadFiles(ctx, client, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uuid.UUID][]*ent.File, len(sets))
+	for _, set := range sets {
+		result[set.ID] = s.accessibleFileSetFiles(ctx, set, byID, nil)
+	}
+	return result, nil
+}
+
+// fileSetFiles возвращает доступные файлы набора; удаленные и недоступные файлы попадают в отказы items
+func (s *FileSetService) fileSetFiles(ctx context.Context, client *ent.Client, set *ent.FileSet, items *batch.Result) ([]*ent.File, error) {
+	byID, err := loadFiles(ctx, client, set.FileIds)
+	if err != nil {
+		return nil, err
+	}
+	return s.accessibleFileSetFiles(ctx, set, byID, items), nil
+}
+
+// accessibleFileSetFiles выбирает из загруженных файлов файлы набора, доступные пользователю: по собственным
+// правам (canDownloadFile) или открытые вместе с набором. Остальные попадают в отказы items.
+func (s *FileSetService) accessibleFileSetFiles(ctx context.Context, set *ent.FileSet, byID map[uuid.UUID]*ent.File, items *batch.Result) []*ent.File {
+	granted := make(map[uuid.UUID]bool, len(set.GrantedFileIds))
+	for _, fileID := range set.GrantedFileIds {
+		granted[fileID] = true
+	}
+
+	ordered := make([]*ent.File, 0, len(set.FileIds))
+	for _, fileID := range set.FileIds {
+		fileRecord, ok := byID[fileID]
+		switch {
+		case !ok:
+			items.Fail(ctx, fileID, errcatalog.FileNotFound(ctx))
+		case !granted[fileID] && !s.fileService.canDownloadFileRecord(ctx, fileRecord):
+			items.Fail(ctx, fileID, errcatalog.FileViewPermissionDenied(ctx))
+		default:
+			ordered = append(ordered, fileRecord)
+		}
+	}
+	return ordered
+}
+
+// GetFileSetDownloadURL собирает ZIP архив из файлов набора; без archiveName архив называется по набору
+func (s *FileSetService) GetFileSetDownloadURL(ctx context.Context, client *ent.Client, id uuid.UUID, archiveName string, layout ArchiveLayout) (*BatchDownloadUrlResult, error) {
+	set, err := s.GetFileSet(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	items := batch.NewResult()
+	files, err := s.fileSetFiles(ctx, client, set, items)
+	if err != nil {
+		return nil, err
+	}
+
+	if archiveName == "" {
+		archiveName = set.Name
+	}
+
+	return s.fileService.createBatchArchive(ctx, client, files, archiveName, layout, items, map[string]interface{}{
+		"file_set_id":     set.ID.String(),
+		"requested_files": len(set.FileIds),
+	}, nil)
+}
@@ -0,0 +1,205 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"main/ent"
+	"main/services/audit"
+	"main/services/tenant"
+	"main/types"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fileSetFixture тенант с владельцем набора, файлами владельца и файлом другого пользователя
+type fileSetFixture struct {
+	client    *ent.Client
+	service   *FileSetService
+	tenantID  uuid.UUID
+	owner     uuid.UUID
+	ownerFile *ent.File
+	// otherFile файл другого пользователя, который владелец набора скачивать не может
+	otherFile *ent.File
+}
+
+func newFileSetFixture(t *testing.T) *fileSetFixture {
+	t.Helper()
+
+	client, setupCtx := newTestClient(t, "file set test fixtures")
+	f := &fileSetFixture{
+		client: client,
+		service: &FileSetService{fileService: &FileService{
+			auditService:       audit.NewAuditService(),
+			tenantStateService: tenant.NewTenantStateService(),
+		}},
+		tenantID: uuid.New(),
+		owner:    uuid.New(),
+	}
+
+	create := func(createdBy uuid.UUID, name string) *ent.File {
+		fileRecord, err := client.File.Create().
+			SetTenantID(f.tenantID).
+			SetCreatedBy(createdBy).
+			SetOriginalName(name).
+			SetStorageKey(fmt.Sprintf("tenants/%s/%s", f.tenantID, uuid.NewString())).
+			SetMimeType("application/pdf").
+			SetSize(100).
+			Save(setupCtx)
+		require.NoError(t, err)
+		return fileRecord
+	}
+	f.ownerFile = create(f.owner, "owner.pdf")
+	f.otherFile = create(uuid.New(), "other.pdf")
+	return f
+}
+
+// userContext контекст федерации пользователя тенанта
+func (f *fileSetFixture) userContext(userID uuid.UUID, role string) context.Context {
+	tenantID := f.tenantID
+	return federation.WithContext(context.Background(), &federation.Context{
+		TenantID: &tenantID,
+		UserID:   &userID,
+		UserRole: role,
+	})
+}
+
+// visibleFiles возвращает ID файлов набора, доступных пользователю, через GetFileSet и через список наборов
+func (f *fileSetFixture) visibleFiles(t *testing.T, ctx context.Context, setID uuid.UUID) ([]uuid.UUID, error) {
+	t.Helper()
+
+	set, err := f.service.GetFileSet(ctx, f.client, setID)
+	if err != nil {
+		return nil, err
+	}
+	files, err := f.service.GetFileSetFiles(ctx, f.client, set)
+	require.NoError(t, err)
+
+	sets, err := f.service.ListFileSets(ctx, f.client)
+	require.NoError(t, err)
+	require.Len(t, sets, 1)
+	listed, err := f.service.GetFileSetsFiles(ctx, f.client, sets)
+	require.NoError(t, err)
+
+	ids := make([]uuid.UUID, 0, len(files))
+	for _, fileRecord := range files {
+		ids = append(ids, fileRecord.ID)
+	}
+	listedIDs := make([]uuid.UUID, 0, len(listed[setID]))
+	for _, fileRecord := range listed[setID] {
+		listedIDs = append(listedIDs, fileRecord.ID)
+	}
+	assert.Equal(t, ids, listedIDs, "fileSet and fileSets return the same files")
+	return ids, nil
+}
+
+func TestFileSetAccess(t *testing.T) {
+	f := newFileSetFixture(t)
+	ownerCtx := f.userContext(f.owner, types.RoleMember)
+	sharedUser, tenantUser, unrelatedUser := uuid.New(), uuid.New(), uuid.New()
+
+	set, err := f.service.CreateFileSet(ownerCtx, f.client, CreateFileSetInput{
+		Name:    "reports",
+		FileIDs: []uuid.UUID{f.ownerFile.ID},
+	})
+	require.NoError(t, err)
+
+	t.Run("owner cannot add files they cannot download", func(t *testing.T) {
+		_, err := f.service.UpdateFileSet(ownerCtx, f.client, set.ID, UpdateFileSetInput{
+			FileIDs: []uuid.UUID{f.ownerFile.ID, f.otherFile.ID},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		ids, err := f.visibleFiles(t, ownerCtx, set.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{f.ownerFile.ID}, ids)
+	})
+
+	t.Run("users do not see an unshared set", func(t *testing.T) {
+		for _, userID := range []uuid.UUID{sharedUser, tenantUser, unrelatedUser} {
+			_, err := f.service.GetFileSet(f.userContext(userID, types.RoleMember), f.client, set.ID)
+			assert.Error(t, err)
+		}
+	})
+
+	_, err = f.service.ShareFileSet(ownerCtx, f.client, set.ID, false, []uuid.UUID{sharedUser})
+	require.NoError(t, err)
+
+	t.Run("shared user sees the files granted with the set", func(t *testing.T) {
+		ids, err := f.visibleFiles(t, f.userContext(sharedUser, types.RoleMember), set.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{f.ownerFile.ID}, ids)
+	})
+
+	t.Run("unrelated user does not see a set shared with other users", func(t *testing.T) {
+		_, err := f.service.GetFileSet(f.userContext(unrelatedUser, types.RoleMember), f.client, set.ID)
+		assert.Error(t, err)
+		sets, err := f.service.ListFileSets(f.userContext(unrelatedUser, types.RoleMember), f.client)
+		require.NoError(t, err)
+		assert.Empty(t, sets)
+	})
+
+	_, err = f.service.ShareFileSet(ownerCtx, f.client, set.ID, true, nil)
+	require.NoError(t, err)
+
+	t.Run("tenant member sees a set shared with the tenant", func(t *testing.T) {
+		ids, err := f.visibleFiles(t, f.userContext(tenantUser, types.RoleMember), set.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{f.ownerFile.ID}, ids)
+	})
+
+	t.Run("unsharing revokes file grants", func(t *testing.T) {
+		updated, err := f.service.ShareFileSet(ownerCtx, f.client, set.ID, false, nil)
+		require.NoError(t, err)
+		assert.Empty(t, updated.GrantedFileIds)
+		_, err = f.service.GetFileSet(f.userContext(tenantUser, types.RoleMember), f.client, set.ID)
+		assert.Error(t, err)
+	})
+}
+
+// TestFileSetAccessRequiresGrant файлы набора, не открытые при открытии доступа, остаются доступны
+// только по собственным правам пользователя, даже если их создал владелец набора
+func TestFileSetAccessRequiresGrant(t *testing.T) {
+	f := newFileSetFixture(t)
+	sharedUser := uuid.New()
+	sharedCtx := f.userContext(sharedUser, types.RoleMember)
+
+	// Набор открыт без записи открытых файлов (например, создан до появления granted_file_ids)
+	set, err := f.client.FileSet.Create().
+		SetTenantID(f.tenantID).
+		SetCreatedBy(f.owner).
+		SetName("legacy").
+		SetFileIds([]uuid.UUID{f.ownerFile.ID, f.otherFile.ID}).
+		SetSharedUserIds([]uuid.UUID{sharedUser}).
+		Save(f.userContext(f.owner, types.RoleMember))
+	require.NoError(t, err)
+
+	ids, err := f.visibleFiles(t, sharedCtx, set.ID)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	// Администратор открывает набор заново: открываются файлы, которые он может скачивать
+	adminCtx := f.userContext(uuid.New(), types.RoleAdmin)
+	_, err = f.service.ShareFileSet(adminCtx, f.client, set.ID, false, []uuid.UUID{sharedUser})
+	require.NoError(t, err)
+
+	ids, err = f.visibleFiles(t, sharedCtx, set.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{f.ownerFile.ID, f.otherFile.ID}, ids)
+
+	// Владелец меняет состав открытого набора: открываются только файлы нового состава
+	_, err = f.service.UpdateFileSet(f.userContext(f.owner, types.RoleMember), f.client, set.ID, UpdateFileSetInput{
+		FileIDs: []uuid.UUID{f.ownerFile.ID},
+	})
+	require.NoError(t, err)
+
+	ids, err = f.visibleFiles(t, sharedCtx, set.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{f.ownerFile.ID}, ids)
+}
@@ -0,0 +1,302 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/tag"
+	"main/errcatalog"
+	"main/services/audit"
+	"main/services/batch"
+	"main/utils"
+	"strings"
+	"unicode/utf8"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxFileTags максимальное количество тегов одного файла
+	maxFileTags = 20
+	// maxTagLength максимальная длина тега в символах
+	maxTagLength = 50
+	// maxTagFiles максимальное количество файлов в одном запросе изменения тегов
+	maxTagFiles = 100
+	// defaultTagListLimit количество тегов в выдаче по умолчанию
+	defaultTagListLimit = 50
+	// maxTagListLimit максимальное количество тегов в выдаче
+	maxTagListLimit = 200
+)
+
+// normalizeTagNames обрезает пробелы, удаляет пустые и повторяющиеся теги и проверяет их длину и количество
+func normalizeTagNames(ctx context.Context, names []string) ([]string, error) {
+	tags := make([]string, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if utf8.RuneCountInString(name) > maxTagLength {
+			return nil, errcatalog.FileTagTooLong(ctx)
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		tags = append(tags, name)
+	}
+	if len(tags) > maxFileTags {
+		return nil, errcatalog.FileTagTooMany(ctx)
+	}
+	return tags, nil
+}
+
+// ensureTags возвращает теги тенанта с указанными названиями, создавая недостающие
+func (s *FileService) ensureTags(ctx context.Context, client *ent.Client, names []string) ([]*ent.Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	tags, err := client.Tag.Query().
+		Where(tag.NameIn(names...)).
+		All(ctxWithClient)
+	if err != nil {
+		utils.Logger.Error("Failed to query tags", zap.Error(err))
+		return nil, errcatalog.FileTagSaveFailed(ctx)
+	}
+
+	existing := make(map[string]struct{}, len(tags))
+	for _, tagRecord := range tags {
+		existing[tagRecord.Name] = struct{}{}
+	}
+	for _, name := range names {
+		if _, ok := existing[name]; ok {
+			continue
+		}
+		created, err := client.Tag.Create().
+			SetName(name).
+			SetCreatedBy(*userID).
+			Save(ctxWithClient)
+		if err != nil {
+			utils.Logger.Error("Failed to create tag", zap.Error(err), zap.String("tag", name))
+			return nil, errcatalog.FileTagSaveFailed(ctx)
+		}
+		tags = append(tags, created)
+	}
+	return tags, nil
+}
+
+// tagNames возвращает названия тегов
+func tagNames(tags []*ent.Tag) []string {
+	names := make([]string, 0, len(tags))
+	for _, tagRecord := range tags {
+		names = append(names, tagRecord.Name)
+	}
+	return names
+}
+
+// loadTaggableFiles проверяет права на изменение файлов и загружает доступные вместе с тегами.
+// Ненайденные и недоступные файлы попадают в отказы items.
+func (s *FileService) loadTaggableFiles(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID, items *batch.Result) ([]*ent.File, error) {
+	if len(fileIDs) == 0 {
+		return nil, errcatalog.FileNoFilesSelected(ctx)
+	}
+	if len(fileIDs) > maxTagFiles {
+		return nil, errcatalog.FileTooManyFilesForBatchUpdate(ctx)
+	}
+
+	updatable := make([]uuid.UUID, 0, len(fileIDs))
+	for _, fileID := range batch.UniqueIDs(fileIDs) {
+		if err := s.CanUpdateFile(ctx, client, fileID); err != nil {
+			items.Fail(ctx, fileID, err)
+			continue
+		}
+		updatable = append(updatable, fileID)
+	}
+	if len(updatable) == 0 {
+		return []*ent.File{}, nil
+	}
+
+	files, err := client.File.Query().
+		Where(file.IDIn(updatable...)).
+		WithTags().
+		All(ent.NewContext(ctx, client))
+	if err != nil {
+		return nil, errcatalog.FileGetFilesFailed(ctx)
+	}
+	return files, nil
+}
+
+// TagFiles добавляет теги к файлам; отсутствующие теги создаются. Права проверяются как для изменения файла:
+// недоступные файлы и файлы, у которых превысилось бы количество тегов, попадают в отказы итога.
+// Возвращает обновленные файлы с тегами.
+func (s *FileService) TagFiles(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID, names []string) ([]*ent.File, *batch.Result, error) {
+	names, err := normalizeTagNames(ctx, names)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(names) == 0 {
+		return nil, nil, errcatalog.FileTagRequired(ctx)
+	}
+
+	items := batch.NewResult()
+	files, err := s.loadTaggableFiles(ctx, client, fileIDs, items)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(files) == 0 {
+		return []*ent.File{}, items, nil
+	}
+
+	tags, err := s.ensureTags(ctx, client, names)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	for _, fileRecord := range files {
+		current := make(map[uuid.UUID]struct{}, len(fileRecord.Edges.Tags))
+		for _, tagRecord := range fileRecord.Edges.Tags {
+			current[tagRecord.ID] = struct{}{}
+		}
+
+		added := make([]*ent.Tag, 0, len(tags))
+		for _, tagRecord := range tags {
+			if _, ok := current[tagRecord.ID]; !ok {
+				added = append(added, tagRecord)
+			}
+		}
+		if len(added) == 0 {
+			items.Succeed(fileRecord.ID)
+			continue
+		}
+		if len(current)+len(added) > maxFileTags {
+			items.Fail(ctx, fileRecord.ID, errcatalog.FileTagTooMany(ctx))
+			continue
+		}
+
+		if err := client.File.UpdateOne(fileRecord).AddTags(added...).Exec(ctxWithClient); err != nil {
+			utils.Logger.Error("Failed to tag file", zap.Error(err), zap.String("file_id", fileRecord.ID.String()))
+			return nil, nil, errcatalog.FileTagSaveFailed(ctx)
+		}
+
+		// 📊 [AUDIT] Фиксируем изменение тегов
+		fileID := fileRecord.ID
+		s.auditService.Record(ctx, client, audit.Event{
+			Action:  fileauditevent.ActionUPDATE,
+			FileID:  &fileID,
+			Details: map[string]interface{}{"tags_added": tagNames(added)},
+		})
+		items.Succeed(fileRecord.ID)
+	}
+
+	updated, err := s.reloadTaggedFiles(ctx, client, items.Succeeded)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, items, nil
+}
+
+// UntagFiles удаляет теги у файлов; недоступные файлы попадают в отказы итога.
+// Сами теги остаются доступными для повторного использования.
+func (s *FileService) UntagFiles(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID, names []string) ([]*ent.File, *batch.Result, error) {
+	names, err := normalizeTagNames(ctx, names)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(names) == 0 {
+		return nil, nil, errcatalog.FileTagRequired(ctx)
+	}
+
+	items := batch.NewResult()
+	files, err := s.loadTaggableFiles(ctx, client, fileIDs, items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	removeNames := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		removeNames[name] = struct{}{}
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	for _, fileRecord := range files {
+		removed := make([]*ent.Tag, 0, len(names))
+		for _, tagRecord := range fileRecord.Edges.Tags {
+			if _, ok := removeNames[tagRecord.Name]; ok {
+				removed = append(removed, tagRecord)
+			}
+		}
+		if len(removed) == 0 {
+			items.Succeed(fileRecord.ID)
+			continue
+		}
+
+		if err := client.File.UpdateOne(fileRecord).RemoveTags(removed...).Exec(ctxWithClient); err != nil {
+			utils.Logger.Error("Failed to untag file", zap.Error(err), zap.String("file_id", fileRecord.ID.String()))
+			return nil, nil, errcatalog.FileTagSaveFailed(ctx)
+		}
+
+		// 📊 [AUDIT] Фиксируем изменение тегов
+		fileID := fileRecord.ID
+		s.auditService.Record(ctx, client, audit.Event{
+			Action:  fileauditevent.ActionUPDATE,
+			FileID:  &fileID,
+			Details: map[string]interface{}{"tags_removed": tagNames(removed)},
+		})
+		items.Succeed(fileRecord.ID)
+	}
+
+	updated, err := s.reloadTaggedFiles(ctx, client, items.Succeeded)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, items, nil
+}
+
+// reloadTaggedFiles возвращает файлы с актуальными тегами
+func (s *FileService) reloadTaggedFiles(ctx context.Context, client *ent.Client, fileIDs []uuid.UUID) ([]*ent.File, error) {
+	files, err := client.File.Query().
+		Where(file.IDIn(fileIDs...)).
+		WithTags().
+		All(ent.NewContext(ctx, client))
+	if err != nil {
+		return nil, errcatalog.FileGetUpdatedFilesFailed(ctx)
+	}
+	return files, nil
+}
+
+// ListTags возвращает теги тенанта по алфавиту; search отбирает теги, содержащие строку (без учета регистра)
+func (s *FileService) ListTags(ctx context.Context, client *ent.Client, search string, limit int) ([]*ent.Tag, error) {
+	if limit <= 0 {
+		limit = defaultTagListLimit
+	}
+	if limit > maxTagListLimit {
+		limit = maxTagListLimit
+	}
+
+	query := client.Tag.Query()
+	if search = strings.TrimSpace(search); search != "" {
+		query = query.Where(tag.NameContainsFold(search))
+	}
+
+	tags, err := query.
+		Order(ent.Asc(tag.FieldName)).
+		Limit(limit).
+		All(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Error("Failed to list tags", zap.Error(err))
+		return nil, errcatalog.FileTagListFailed(ctx)
+	}
+	return tags, nil
+}
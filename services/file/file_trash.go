@@ -0,0 +1,276 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/privacy"
+	"main/s3"
+	"main/services/audit"
+	"main/utils"
+	"os"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultFileTrashRetention срок хранения файлов в корзине до окончательного удаления
+	defaultFileTrashRetention = 30 * 24 * time.Hour
+	// defaultFileTrashPurgeInterval интервал очистки корзины
+	defaultFileTrashPurgeInterval = time.Hour
+	// fileTrashPurgeBatch количество файлов, удаляемых за один запрос очистки
+	fileTrashPurgeBatch = 100
+	// defaultTrashListLimit количество файлов корзины в выдаче по умолчанию
+	defaultTrashListLimit = 50
+	// maxTrashListLimit максимальное количество файлов корзины в выдаче
+	maxTrashListLimit = 500
+	// fileTrashPurgeTimeout максимальное время одной очистки корзины
+	fileTrashPurgeTimeout = 30 * time.Minute
+)
+
+// FileTrashRetention возвращает срок хранения файлов в корзине (FILE_TRASH_RETENTION, например 720h)
+func FileTrashRetention() time.Duration {
+	if value := os.Getenv("FILE_TRASH_RETENTION"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed >= 0 {
+			return parsed
+		}
+		utils.Logger.Warn("Invalid FILE_TRASH_RETENTION, using default",
+			zap.String("value", value),
+			zap.Duration("default", defaultFileTrashRetention))
+	}
+	return defaultFileTrashRetention
+}
+
+// ListTrashedFiles возвращает файлы в корзине: свои для пользователя, все файлы тенанта для админов
+func (s *FileService) ListTrashedFiles(ctx context.Context, client *ent.Client, limit, offset int) ([]*ent.File, int, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, 0, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	trashCtx := mixin.SkipSoftDelete(ent.NewContext(ctx, client))
+
+	query := client.File.Query().Where(file.DeletedAtNotNil())
+	if !s.hasAdminRole(ctx) {
+		query = query.Where(file.CreatedBy(*userID))
+	}
+
+	totalCount, err := query.Clone().Count(trashCtx)
+	if err != nil {
+		return nil, 0, errcatalog.FileGetFilesFailed(ctx)
+	}
+
+	if limit <= 0 {
+		limit = defaultTrashListLimit
+	}
+	if limit > maxTrashListLimit {
+		limit = maxTrashListLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	files, err := query.
+		Order(ent.Desc(file.FieldDeletedAt), ent.Desc(file.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(trashCtx)
+	if err != nil {
+		return nil, 0, errcatalog.FileGetFilesFailed(ctx)
+	}
+
+	return files, totalCount, nil
+}
+
+// RestoreFile возвращает файл из корзины. Восстановить файл может загрузивший его пользователь или админ.
+func (s *FileService) RestoreFile(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*ent.File, error) {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	trashCtx := mixin.SkipSoftDelete(ent.NewContext(ctx, client))
+
+	fileRecord, err := client.File.Query().
+		Where(file.ID(fileID), file.DeletedAtNotNil()).
+		Only(trashCtx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotInTrash(ctx)
+		}
+		return nil, errcatalog.FileGetFailed(ctx)
+	}
+
+	if fileRecord.CreatedBy != *userID && !s.hasAdminRole(ctx) {
+		return nil, errcatalog.FileRestoreAccessDenied(ctx)
+	}
+
+	restored, err := client.File.UpdateOneID(fileID).
+		ClearDeletedAt().
+		Save(trashCtx)
+	if err != nil {
+		utils.Logger.Error("Failed to restore file", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, errcatalog.FileRestoreFailed(ctx)
+	}
+
+	// 📊 [AUDIT] Фиксируем восстановление
+	s.auditService.Record(ctx, client, audit.Event{
+		Action: fileauditevent.ActionRESTORE,
+		FileID: &fileID,
+		Details: map[string]interface{}{
+			"filename":   restored.OriginalName,
+			"deleted_at": fileRecord.DeletedAt,
+		},
+	})
+
+	return restored, nil
+}
+
+// PurgeTrashedFiles окончательно удаляет файлы всех тенантов, находящиеся в корзине дольше retention:
+// сначала объект в S3, затем запись. Если объект удалить не удалось, запись остается до следующей очистки.
+// Файлы под удержанием S3 Object Lock пропускаются до окончания удержания.
+func (s *FileService) PurgeTrashedFiles(ctx context.Context, client *ent.Client, retention time.Duration) (int, error) {
+	skipCtx, cancel := mixin.SkipTenantFilterFor(ent.NewContext(privacy.WithSystemContext(ctx), client),
+		"purge trashed files of all tenants", fileTrashPurgeTimeout)
+	defer cancel()
+	systemCtx := mixin.SkipSoftDelete(skipCtx)
+	cutoff := time.Now().Add(-retention)
+
+	purged := 0
+	for ctx.Err() == nil {
+		files, err := client.File.Query().
+			Where(file.DeletedAtNotNil(), file.DeletedAtLT(cutoff), ObjectLockReleased(time.Now())).
+			Order(ent.Asc(file.FieldDeletedAt), ent.Asc(file.FieldID)).
+			Limit(fileTrashPurgeBatch).
+			All(systemCtx)
+		if err != nil {
+			return purged, fmt.Errorf("failed to select trashed files: %w", err)
+		}
+
+		batchPurged := 0
+		for _, fileRecord := range files {
+			if err := s.s3Service.DeleteFile(ctx, fileRecord.StorageKey); err != nil {
+				if s3.IsObjectLockError(err) {
+					// Удержание задано в S3 в обход сервиса: запись остается до его окончания
+					utils.Logger.Warn("Trashed file object is locked in S3, keeping it until the lock ends",
+						zap.String("file_id", fileRecord.ID.String()),
+						zap.String("storage_key", fileRecord.StorageKey))
+					continue
+				}
+				utils.Logger.Error("Failed to delete trashed file object from S3",
+					zap.Error(err),
+					zap.String("file_id", fileRecord.ID.String()),
+					zap.String("storage_key", fileRecord.StorageKey))
+				continue
+			}
+			if err := client.File.DeleteOneID(fileRecord.ID).Exec(systemCtx); err != nil && !ent.IsNotFound(err) {
+				utils.Logger.Error("Failed to delete trashed file record",
+					zap.Error(err),
+					zap.String("file_id", fileRecord.ID.String()))
+				continue
+			}
+			s.deleteThumbnails(ctx, fileRecord)
+			s.deletePreview(ctx, fileRecord)
+			s.deletePDFRendition(ctx, fileRecord)
+			s.deleteImageDerivatives(ctx, fileRecord)
+			s.deletePDFWatermarks(ctx, fileRecord)
+
+			// 📊 [AUDIT] Системная операция: тенант задается явно
+			fileID, tenantID := fileRecord.ID, fileRecord.TenantID
+			s.auditService.Record(ctx, client, audit.Event{
+				Action:   fileauditevent.ActionPURGE,
+				TenantID: &tenantID,
+				FileID:   &fileID,
+				Details: map[string]interface{}{
+					"filename":   fileRecord.OriginalName,
+					"size":       fileRecord.Size,
+					"deleted_at": fileRecord.DeletedAt,
+				},
+			})
+			batchPurged++
+		}
+		purged += batchPurged
+
+		// Последняя страница или в пачке остались только файлы с ошибками S3
+		if len(files) < fileTrashPurgeBatch || batchPurged == 0 {
+			break
+		}
+	}
+
+	return purged, nil
+}
+
+// StartTrashPurger запускает периодическое применение правил жизненного цикла тенантов, очистку корзины
+// и перемещение в корзину файлов с истекшим сроком хранения или наступившим запланированным удалением.
+// Интервал задается FILE_TRASH_PURGE_INTERVAL (по умолчанию 1h, "0" отключает очистку),
+// срок хранения - FILE_TRASH_RETENTION.
+func StartTrashPurger(ctx context.Context, getClient func() *ent.Client) {
+	interval := defaultFileTrashPurgeInterval
+	if value := os.Getenv("FILE_TRASH_PURGE_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			utils.Logger.Info("File trash purger is disabled")
+			return
+		}
+		interval = parsed
+	}
+
+	retention := FileTrashRetention()
+	service := NewFileService()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				client := getClient()
+				if client == nil {
+					continue
+				}
+
+				// Правила жизненного цикла тенантов: архив, перемещение в корзину, уведомления
+				if _, err := service.ApplyLifecycleRules(ctx, client); err != nil {
+					utils.Logger.Error("Lifecycle rules failed", zap.Error(err))
+				}
+
+				// Файлы с истекшим сроком хранения сначала попадают в корзину и удаляются вместе с остальными
+				if _, err := service.ExpireFiles(ctx, client); err != nil {
+					utils.Logger.Error("File expiration failed", zap.Error(err))
+				}
+
+				// Запланированное пользователями удаление (scheduleFileDeletion) тоже переводит файлы в корзину
+				if _, err := service.DeleteScheduledFiles(ctx, client); err != nil {
+					utils.Logger.Error("Scheduled file deletion failed", zap.Error(err))
+				}
+
+				purged, err := service.PurgeTrashedFiles(ctx, client, retention)
+				if err != nil {
+					utils.Logger.Error("File trash purge failed", zap.Error(err))
+					continue
+				}
+				if purged > 0 {
+					utils.Logger.Info("Trashed files purged", zap.Int("purged", purged))
+				}
+			}
+		}
+	}()
+
+	utils.Logger.Info("File trash purger started",
+		zap.Duration("interval", interval),
+		zap.Duration("retention", retention))
+}
@@ -0,0 +1,46 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/fileauditevent"
+	"main/errcatalog"
+	"main/services/audit"
+	"main/utils"
+
+	"go.uber.org/zap"
+)
+
+// enforceFileTypePolicy отклоняет файл, расширение или тип содержимого которого не допускает политика тенанта.
+// Нарушение фиксируется в аудите через клиент запроса: транзакция загрузки откатывается вместе с файлом.
+func (s *FileService) enforceFileTypePolicy(ctx context.Context, client *ent.Client, filename string, size int64, mimeTypes ...string) error {
+	policy, err := s.fileTypeService.GetPolicy(ctx, client)
+	if err != nil {
+		return err
+	}
+	reason := policy.Violation(filename, mimeTypes...)
+	if reason == "" {
+		return nil
+	}
+
+	utils.Logger.Warn("Upload rejected by tenant file type policy",
+		zap.String("reason", reason),
+		zap.String("filename", filename),
+		zap.Strings("mime_types", mimeTypes))
+
+	auditClient := client
+	if requestClient := ent.FromContext(ctx); requestClient != nil {
+		auditClient = requestClient
+	}
+	// 📊 [AUDIT] Фиксируем попытку загрузки запрещенного типа файла
+	s.auditService.Record(ctx, auditClient, audit.Event{
+		Action: fileauditevent.ActionFILE_TYPE_BLOCKED,
+		Details: map[string]interface{}{
+			"reason":     reason,
+			"filename":   filename,
+			"size":       size,
+			"mime_types": mimeTypes,
+		},
+	})
+	return errcatalog.FileTypeNotAllowed(ctx)
+}
@@ -0,0 +1,318 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"main/ent"
+	"main/ent/schema/filemeta"
+	"main/ent/tenantsetting"
+	"main/errcatalog"
+	"main/utils"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.uber.org/zap"
+)
+
+const (
+	// ImageMetadataStrippedKey ключ metadata: из сохраненного изображения удалены EXIF и XMP
+	ImageMetadataStrippedKey = filemeta.KeyImageMetadataStripped
+	// ImageMetadataOriginalExifKey ключ metadata: исходный блок EXIF (TIFF, base64) в режиме STRIP_PRESERVE_ORIGINAL
+	ImageMetadataOriginalExifKey = filemeta.KeyOriginalExif
+)
+
+// imageMetadataStripMaxSize максимальный размер изображения, из которого удаляются метаданные:
+// изображение разбирается в памяти целиком
+const imageMetadataStripMaxSize = 50 << 20
+
+// errMalformedImage структура изображения не разобрана, метаданные удалить нельзя
+var errMalformedImage = errors.New("malformed image structure")
+
+var (
+	jpegExifHeader = []byte("Exif\x00\x00")
+	jpegXMPHeader  = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	pngSignature   = []byte("\x89PNG\r\n\x1a\n")
+)
+
+// exifOrientationTag тег ориентации EXIF: сохраняется в JPEG, чтобы снимок не поворачивался после удаления EXIF
+const exifOrientationTag = 0x0112
+
+// stripImageMetadata удаляет из изображения EXIF (GPS, модель камеры, время съемки) и XMP без перекодирования
+// пикселей. Возвращает очищенное содержимое и исходный блок EXIF (nil, если его не было). Цветовые профили
+// сохраняются. Неподдерживаемые типы возвращаются без изменений.
+func stripImageMetadata(mimeType string, data []byte) ([]byte, []byte, error) {
+	switch mimeType {
+	case "image/jpeg":
+		return stripJPEGMetadata(data)
+	case "image/png":
+		return stripPNGMetadata(data)
+	case "image/webp":
+		return stripWebPMetadata(data)
+	default:
+		return data, nil, nil
+	}
+}
+
+// isStrippableImage проверяет, поддерживается ли удаление метаданных для типа содержимого
+func isStrippableImage(mimeType string) bool {
+	return mimeType == "image/jpeg" || mimeType == "image/png" || mimeType == "image/webp"
+}
+
+// stripJPEGMetadata удаляет сегменты APP1 (EXIF, XMP) и APP13 (IPTC) до начала данных изображения.
+// Ориентация снимка переносится в минимальный EXIF, содержащий только ее.
+func stripJPEGMetadata(data []byte) ([]byte, []byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, nil, errMalformedImage
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(data[:2])
+
+	var exif []byte
+	exifAt := -1
+	pos := 2
+	for {
+		if pos+2 > len(data) || data[pos] != 0xFF {
+			return nil, nil, errMalformedImage
+		}
+		marker := data[pos+1]
+		if marker == 0xFF {
+			// Заполняющий байт перед маркером
+			pos++
+			continue
+		}
+		if marker == 0xD9 || marker == 0xDA {
+			// Конец изображения или начало сжатых данных: остаток копируется без изменений
+			out.Write(data[pos:])
+			break
+		}
+		if marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7) {
+			out.Write(data[pos : pos+2])
+			pos += 2
+			continue
+		}
+
+		if pos+4 > len(data) {
+			return nil, nil, errMalformedImage
+		}
+		end := pos + 2 + int(binary.BigEndian.Uint16(data[pos+2:pos+4]))
+		if end > len(data) || end < pos+4 {
+			return nil, nil, errMalformedImage
+		}
+		payload := data[pos+4 : end]
+
+		switch {
+		case marker == 0xE1 && bytes.HasPrefix(payload, jpegExifHeader):
+			if exif == nil {
+				exif = append([]byte(nil), payload[len(jpegExifHeader):]...)
+				exifAt = out.Len()
+			}
+		case marker == 0xE1 && bytes.HasPrefix(payload, jpegXMPHeader), marker == 0xED:
+		default:
+			out.Write(data[pos:end])
+		}
+		pos = end
+	}
+
+	result := out.Bytes()
+	if orientation := exifOrientation(exif); orientation > 1 {
+		result = append(result[:exifAt:exifAt], append(orientationOnlyExif(orientation), result[exifAt:]...)...)
+	}
+	return result, exif, nil
+}
+
+// exifOrientation читает ориентацию из IFD0 блока EXIF (0 - не задана или блок не разобран)
+func exifOrientation(tiff []byte) uint16 {
+	if len(tiff) < 8 {
+		return 0
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[ifd : ifd+2]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:entry+2]) == exifOrientationTag {
+			return order.Uint16(tiff[entry+8 : entry+10])
+		}
+	}
+	return 0
+}
+
+// orientationOnlyExif собирает сегмент APP1 с EXIF из одного тега ориентации
+func orientationOnlyExif(orientation uint16) []byte {
+	tiff := []byte{'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08, 0x00, 0x01}
+	entry := make([]byte, 12)
+	binary.BigEndian.PutUint16(entry[0:2], exifOrientationTag)
+	binary.BigEndian.PutUint16(entry[2:4], 3) // SHORT
+	binary.BigEndian.PutUint32(entry[4:8], 1)
+	binary.BigEndian.PutUint16(entry[8:10], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00) // следующего IFD нет
+
+	payload := append(append([]byte(nil), jpegExifHeader...), tiff...)
+	segment := []byte{0xFF, 0xE1, 0x00, 0x00}
+	binary.BigEndian.PutUint16(segment[2:4], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// stripPNGMetadata удаляет чанки eXIf и текстовые чанки (tEXt, zTXt, iTXt, в том числе XMP)
+func stripPNGMetadata(data []byte) ([]byte, []byte, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, nil, errMalformedImage
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(pngSignature)
+
+	var exif []byte
+	pos := len(pngSignature)
+	for pos < len(data) {
+		if pos+12 > len(data) {
+			return nil, nil, errMalformedImage
+		}
+		length := int(binary.BigEndian.Uint32(data[pos : pos+4]))
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return nil, nil, errMalformedImage
+		}
+		chunkType := string(data[pos+4 : pos+8])
+
+		switch chunkType {
+		case "eXIf":
+			exif = append([]byte(nil), data[pos+8:pos+8+length]...)
+		case "tEXt", "zTXt", "iTXt":
+		default:
+			out.Write(data[pos:end])
+		}
+		pos = end
+		if chunkType == "IEND" {
+			break
+		}
+	}
+	return out.Bytes(), exif, nil
+}
+
+// stripWebPMetadata удаляет чанки EXIF и XMP контейнера RIFF и сбрасывает их флаги в VP8X
+func stripWebPMetadata(data []byte) ([]byte, []byte, error) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, nil, errMalformedImage
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	out.Write(data[:12])
+
+	var exif []byte
+	pos := 12
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return nil, nil, errMalformedImage
+		}
+		size := int(binary.LittleEndian.Uint32(data[pos+4 : pos+8]))
+		end := pos + 8 + size + size%2
+		if end == len(data)+1 && size%2 == 1 {
+			// Некоторые кодировщики не дописывают байт выравнивания последнего чанка
+			end = len(data)
+		}
+		if size < 0 || end > len(data) {
+			return nil, nil, errMalformedImage
+		}
+
+		switch string(data[pos : pos+4]) {
+		case "EXIF":
+			exif = append([]byte(nil), data[pos+8:pos+8+size]...)
+			exif = bytes.TrimPrefix(exif, jpegExifHeader)
+		case "XMP ":
+		case "VP8X":
+			chunk := append([]byte(nil), data[pos:end]...)
+			if size > 0 {
+				// Флаги: 0x08 - есть EXIF, 0x04 - есть XMP
+				chunk[8] &^= 0x08 | 0x04
+			}
+			out.Write(chunk)
+		default:
+			out.Write(data[pos:end])
+		}
+		pos = end
+	}
+
+	result := out.Bytes()
+	binary.LittleEndian.PutUint32(result[4:8], uint32(len(result)-8))
+	return result, exif, nil
+}
+
+// applyImageMetadataPolicy удаляет метаданные загружаемого изображения по режиму тенанта: содержимое загрузки
+// заменяется очищенным до расчета контрольной суммы, антивирусной проверки и сохранения в S3. В режиме
+// STRIP_PRESERVE_ORIGINAL исходный EXIF сохраняется в metadata файла. Без настроек режим KEEP.
+func (s *FileService) applyImageMetadataPolicy(ctx context.Context, client *ent.Client, upload *graphql.Upload, detectedType string, metadata map[string]interface{}) (map[string]interface{}, error) {
+	if !isStrippableImage(detectedType) {
+		return metadata, nil
+	}
+
+	mode, err := s.imageMetadataService.GetMode(ctx, client)
+	if err != nil {
+		// Режим конфиденциальности не должен молча пропускать изображения с координатами
+		return nil, err
+	}
+	if mode == tenantsetting.ImageMetadataModeKEEP {
+		return metadata, nil
+	}
+
+	// Режим конфиденциальности не пропускает изображения без очистки, поэтому слишком большие отклоняются
+	if upload.Size > imageMetadataStripMaxSize {
+		return nil, errcatalog.FileImageMetadataStripTooLarge(ctx, imageMetadataStripMaxSize>>20)
+	}
+	original, err := io.ReadAll(io.LimitReader(upload.File, imageMetadataStripMaxSize+1))
+	if err != nil {
+		utils.Logger.Error("Failed to read uploaded image", zap.Error(err), zap.String("filename", upload.Filename))
+		return nil, errcatalog.FileUploadFailed(ctx)
+	}
+	if len(original) > imageMetadataStripMaxSize {
+		return nil, errcatalog.FileImageMetadataStripTooLarge(ctx, imageMetadataStripMaxSize>>20)
+	}
+
+	stripped, exif, err := stripImageMetadata(detectedType, original)
+	if err != nil {
+		utils.Logger.Warn("Failed to strip image metadata",
+			zap.Error(err),
+			zap.String("filename", upload.Filename),
+			zap.String("mime_type", detectedType))
+		return nil, errcatalog.FileImageMetadataStripFailed(ctx)
+	}
+
+	upload.File = bytes.NewReader(stripped)
+	upload.Size = int64(len(stripped))
+
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata[ImageMetadataStrippedKey] = true
+	if mode == tenantsetting.ImageMetadataModeSTRIP_PRESERVE_ORIGINAL && exif != nil {
+		metadata[ImageMetadataOriginalExifKey] = base64.StdEncoding.EncodeToString(exif)
+	}
+
+	utils.Logger.Debug("Image metadata stripped",
+		zap.String("filename", upload.Filename),
+		zap.Int("original_size", len(original)),
+		zap.Int("stripped_size", len(stripped)),
+		zap.Bool("had_exif", exif != nil))
+
+	return metadata, nil
+}
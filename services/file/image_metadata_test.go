@@ -0,0 +1,231 @@
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jpegSegment собирает сегмент JPEG с маркером 0xFF<marker> и длиной
+func jpegSegment(marker byte, payload []byte) []byte {
+	segment := []byte{0xFF, marker, 0x00, 0x00}
+	binary.BigEndian.PutUint16(segment[2:4], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// testExif собирает блок EXIF (TIFF, little endian) с ориентацией и моделью камеры
+func testExif(orientation uint16) []byte {
+	tiff := []byte{'I', 'I', 0x2A, 0x00, 0x08, 0x00, 0x00, 0x00, 0x02, 0x00}
+	entry := make([]byte, 12)
+	binary.LittleEndian.PutUint16(entry[0:2], 0x0110) // Model
+	binary.LittleEndian.PutUint16(entry[2:4], 2)
+	binary.LittleEndian.PutUint32(entry[4:8], 4)
+	copy(entry[8:12], "Cam\x00")
+	tiff = append(tiff, entry...)
+	entry = make([]byte, 12)
+	binary.LittleEndian.PutUint16(entry[0:2], exifOrientationTag)
+	binary.LittleEndian.PutUint16(entry[2:4], 3)
+	binary.LittleEndian.PutUint32(entry[4:8], 1)
+	binary.LittleEndian.PutUint16(entry[8:10], orientation)
+	tiff = append(tiff, entry...)
+	return append(tiff, 0x00, 0x00, 0x00, 0x00)
+}
+
+// testJPEG собирает JPEG с JFIF, EXIF, XMP, IPTC и сжатыми данными
+func testJPEG(orientation uint16) []byte {
+	data := []byte{0xFF, 0xD8}
+	data = append(data, jpegSegment(0xE0, []byte("JFIF\x00\x01\x01"))...)
+	data = append(data, jpegSegment(0xE1, append(append([]byte(nil), jpegExifHeader...), testExif(orientation)...))...)
+	data = append(data, jpegSegment(0xE1, append(append([]byte(nil), jpegXMPHeader...), "<x:xmpmeta/>"...))...)
+	data = append(data, jpegSegment(0xED, []byte("Photoshop 3.0\x00"))...)
+	data = append(data, jpegSegment(0xDB, bytes.Repeat([]byte{0x01}, 65))...)
+	data = append(data, jpegSegment(0xDA, []byte{0x01, 0x01, 0x00, 0x00, 0x3F, 0x00})...)
+	return append(data, 0x12, 0x34, 0x56, 0xFF, 0xD9)
+}
+
+// pngChunk собирает чанк PNG с контрольной суммой
+func pngChunk(chunkType string, payload []byte) []byte {
+	chunk := make([]byte, 4, 12+len(payload))
+	binary.BigEndian.PutUint32(chunk, uint32(len(payload)))
+	chunk = append(chunk, chunkType...)
+	chunk = append(chunk, payload...)
+	crc := make([]byte, 4)
+	binary.BigEndian.PutUint32(crc, crc32.ChecksumIEEE(chunk[4:]))
+	return append(chunk, crc...)
+}
+
+// webpChunk собирает чанк RIFF с байтом выравнивания
+func webpChunk(fourCC string, payload []byte) []byte {
+	chunk := make([]byte, 8, 9+len(payload))
+	copy(chunk, fourCC)
+	binary.LittleEndian.PutUint32(chunk[4:8], uint32(len(payload)))
+	chunk = append(chunk, payload...)
+	if len(payload)%2 == 1 {
+		chunk = append(chunk, 0x00)
+	}
+	return chunk
+}
+
+// testWebP собирает расширенный WebP (VP8X) с флагами EXIF, XMP и альфа-канала
+func testWebP(chunks ...[]byte) []byte {
+	body := []byte("WEBP")
+	for _, chunk := range chunks {
+		body = append(body, chunk...)
+	}
+	header := make([]byte, 8)
+	copy(header, "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(len(body)))
+	return append(header, body...)
+}
+
+// jpegMarkers возвращает маркеры сегментов до начала сжатых данных
+func jpegMarkers(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var markers []byte
+	pos := 2
+	for pos+4 <= len(data) && data[pos+1] != 0xDA {
+		markers = append(markers, data[pos+1])
+		pos += 2 + int(binary.BigEndian.Uint16(data[pos+2:pos+4]))
+	}
+	return markers
+}
+
+// jpegSegmentPayload возвращает содержимое первого сегмента с маркером или nil
+func jpegSegmentPayload(data []byte, marker byte) []byte {
+	pos := 2
+	for pos+4 <= len(data) && data[pos+1] != 0xDA {
+		end := pos + 2 + int(binary.BigEndian.Uint16(data[pos+2:pos+4]))
+		if data[pos+1] == marker {
+			return data[pos+4 : end]
+		}
+		pos = end
+	}
+	return nil
+}
+
+func TestStripImageMetadata(t *testing.T) {
+	vp8x := make([]byte, 10)
+	vp8x[0] = 0x08 | 0x04 | 0x10
+	webp := testWebP(
+		webpChunk("VP8X", vp8x),
+		webpChunk("VP8 ", []byte{0x01, 0x02, 0x03}),
+		webpChunk("EXIF", append(append([]byte(nil), jpegExifHeader...), testExif(6)...)),
+		webpChunk("XMP ", []byte("<x:xmpmeta/>")),
+	)
+
+	png := append([]byte(nil), pngSignature...)
+	png = append(png, pngChunk("IHDR", make([]byte, 13))...)
+	png = append(png, pngChunk("tEXt", []byte("Author\x00someone"))...)
+	png = append(png, pngChunk("eXIf", testExif(1))...)
+	png = append(png, pngChunk("iTXt", []byte("XML:com.adobe.xmp\x00\x00\x00\x00\x00<x:xmpmeta/>"))...)
+	png = append(png, pngChunk("zTXt", []byte("Comment\x00\x00x"))...)
+	png = append(png, pngChunk("IDAT", []byte{0x78, 0x9C})...)
+	png = append(png, pngChunk("IEND", nil)...)
+
+	tests := []struct {
+		name     string
+		mimeType string
+		data     []byte
+		check    func(t *testing.T, stripped, exif []byte)
+	}{
+		{
+			name:     "jpeg keeps orientation only",
+			mimeType: "image/jpeg",
+			data:     testJPEG(6),
+			check: func(t *testing.T, stripped, exif []byte) {
+				assert.Equal(t, testExif(6), exif)
+				assert.Equal(t, []byte{0xE0, 0xE1, 0xDB}, jpegMarkers(t, stripped))
+				preserved := jpegSegmentPayload(stripped, 0xE1)
+				require.True(t, bytes.HasPrefix(preserved, jpegExifHeader))
+				assert.Equal(t, uint16(6), exifOrientation(preserved[len(jpegExifHeader):]))
+				assert.NotContains(t, string(stripped), "Cam")
+				assert.NotContains(t, string(stripped), "xmpmeta")
+				assert.True(t, bytes.HasSuffix(stripped, []byte{0x12, 0x34, 0x56, 0xFF, 0xD9}))
+			},
+		},
+		{
+			name:     "jpeg without rotation drops exif entirely",
+			mimeType: "image/jpeg",
+			data:     testJPEG(1),
+			check: func(t *testing.T, stripped, exif []byte) {
+				assert.Equal(t, testExif(1), exif)
+				assert.Equal(t, []byte{0xE0, 0xDB}, jpegMarkers(t, stripped))
+			},
+		},
+		{
+			name:     "png drops exif and text chunks",
+			mimeType: "image/png",
+			data:     png,
+			check: func(t *testing.T, stripped, exif []byte) {
+				assert.Equal(t, testExif(1), exif)
+				expected := append([]byte(nil), pngSignature...)
+				expected = append(expected, pngChunk("IHDR", make([]byte, 13))...)
+				expected = append(expected, pngChunk("IDAT", []byte{0x78, 0x9C})...)
+				expected = append(expected, pngChunk("IEND", nil)...)
+				assert.Equal(t, expected, stripped)
+			},
+		},
+		{
+			name:     "webp drops exif and xmp and resets vp8x flags",
+			mimeType: "image/webp",
+			data:     webp,
+			check: func(t *testing.T, stripped, exif []byte) {
+				assert.Equal(t, testExif(6), exif)
+				expectedVP8X := make([]byte, 10)
+				expectedVP8X[0] = 0x10
+				assert.Equal(t, testWebP(
+					webpChunk("VP8X", expectedVP8X),
+					webpChunk("VP8 ", []byte{0x01, 0x02, 0x03}),
+				), stripped)
+			},
+		},
+		{
+			name:     "unsupported type is returned unchanged",
+			mimeType: "image/gif",
+			data:     []byte("GIF89a"),
+			check: func(t *testing.T, stripped, exif []byte) {
+				assert.Equal(t, []byte("GIF89a"), stripped)
+				assert.Nil(t, exif)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stripped, exif, err := stripImageMetadata(tt.mimeType, tt.data)
+			require.NoError(t, err)
+			tt.check(t, stripped, exif)
+		})
+	}
+}
+
+func TestStripImageMetadataMalformed(t *testing.T) {
+	jpeg := testJPEG(6)
+	png := append(append([]byte(nil), pngSignature...), pngChunk("IHDR", make([]byte, 13))...)
+	webp := testWebP(webpChunk("VP8 ", []byte{0x01, 0x02, 0x03, 0x04}))
+
+	tests := []struct {
+		name     string
+		mimeType string
+		data     []byte
+	}{
+		{name: "jpeg without SOI", mimeType: "image/jpeg", data: jpeg[2:]},
+		{name: "jpeg truncated segment", mimeType: "image/jpeg", data: jpeg[:10]},
+		{name: "jpeg garbage between segments", mimeType: "image/jpeg", data: append([]byte{0xFF, 0xD8, 0x00}, jpeg[2:]...)},
+		{name: "png without signature", mimeType: "image/png", data: png[1:]},
+		{name: "png truncated chunk", mimeType: "image/png", data: png[:len(png)-3]},
+		{name: "webp without riff header", mimeType: "image/webp", data: webp[4:]},
+		{name: "webp chunk larger than file", mimeType: "image/webp", data: webp[:len(webp)-2]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := stripImageMetadata(tt.mimeType, tt.data)
+			assert.ErrorIs(t, err, errMalformedImage)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"main/ent"
+	"main/ent/file"
+	"main/errcatalog"
+	"main/s3"
+	"main/utils"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ImageFit способ вписывания изображения в заданные размеры
+type ImageFit string
+
+const (
+	// ImageFitContain изображение целиком помещается в рамку, пропорции сохраняются (по умолчанию)
+	ImageFitContain ImageFit = "contain"
+	// ImageFitCover изображение заполняет рамку, выступающие края обрезаются по центру (аватары)
+	ImageFitCover ImageFit = "cover"
+)
+
+// imageTransformMaxSide максимальная сторона производного изображения в пикселях
+const imageTransformMaxSide = 2048
+
+// ImageTransform размеры производного изображения. Нулевая сторона вычисляется по пропорциям исходного;
+// изображения не увеличиваются.
+type ImageTransform struct {
+	Width  int
+	Height int
+	Fit    ImageFit
+}
+
+// ParseImageTransform разбирает параметры w, h и fit запроса изображения
+func ParseImageTransform(ctx context.Context, width, height, fit string) (ImageTransform, error) {
+	transform := ImageTransform{Fit: ImageFit(strings.ToLower(strings.TrimSpace(fit)))}
+	if transform.Fit == "" {
+		transform.Fit = ImageFitContain
+	}
+	if transform.Fit != ImageFitContain && transform.Fit != ImageFitCover {
+		return ImageTransform{}, errcatalog.FileImageTransformInvalid(ctx, imageTransformMaxSide)
+	}
+
+	for _, side := range []struct {
+		value  string
+		target *int
+	}{{width, &transform.Width}, {height, &transform.Height}} {
+		if side.value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(side.value)
+		if err != nil || parsed < 1 || parsed > imageTransformMaxSide {
+			return ImageTransform{}, errcatalog.FileImageTransformInvalid(ctx, imageTransformMaxSide)
+		}
+		*side.target = parsed
+	}
+	if transform.Width == 0 && transform.Height == 0 {
+		return ImageTransform{}, errcatalog.FileImageTransformInvalid(ctx, imageTransformMaxSide)
+	}
+	if transform.Width == 0 || transform.Height == 0 {
+		// С одной стороной рамки способы вписывания совпадают: один ключ производного на размер
+		transform.Fit = ImageFitContain
+	}
+	return transform, nil
+}
+
+// ImageDerivativeStorageKey возвращает ключ производного изображения, производный от ключа исходного файла
+func ImageDerivativeStorageKey(storageKey string, transform ImageTransform) string {
+	return fmt.Sprintf("%s%s%dx%d-%s.jpg", storageKey, imageDerivativeKeyInfix, transform.Width, transform.Height, transform.Fit)
+}
+
+// imageDerivativeKeyInfix часть ключа, по которой производные удаляются вместе с исходным файлом
+const imageDerivativeKeyInfix = ".img-"
+
+// OpenImageDerivative возвращает уменьшенную копию изображения для frontend (аватары, превью в списках).
+// Копия строится при первом запросе и сохраняется в S3 под производным ключом; следующие запросы
+// отдают ее без декодирования исходного файла. Права проверяются как при скачивании файла.
+func (s *FileService) OpenImageDerivative(ctx context.Context, client *ent.Client, fileID uuid.UUID, transform ImageTransform) (*FileContent, error) {
+	// 🔒 [POLICY CHECK] Права как при получении ссылки на скачивание
+	if err := s.canDownloadFile(ctx, client, fileID); err != nil {
+		return nil, err
+	}
+
+	fileRecord, err := client.File.Query().
+		Where(file.ID(fileID)).
+		Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, errcatalog.FileGetFailed(ctx)
+	}
+
+	// 🛡️ [ANTIVIRUS] Зараженные и еще не проверенные файлы не выдаются
+	if err := s.ensureScanAllowsDownload(ctx, client, fileRecord); err != nil {
+		return nil, err
+	}
+
+	if !SupportsThumbnail(fileRecord.DetectedMimeType) && !SupportsThumbnail(fileRecord.MimeType) {
+		return nil, errcatalog.FileImageTransformUnsupported(ctx)
+	}
+
+	content := &FileContent{
+		File:        fileRecord,
+		ContentType: "image/jpeg",
+		Inline:      true,
+	}
+	if fileRecord.ChecksumSha256 != "" {
+		content.ETag = fmt.Sprintf(`"%s-%dx%d-%s"`, fileRecord.ChecksumSha256, transform.Width, transform.Height, transform.Fit)
+	}
+
+	key := ImageDerivativeStorageKey(fileRecord.StorageKey, transform)
+	if info, err := s.s3Service.GetFileInfo(ctx, key); err == nil && info.ContentLength != nil {
+		body, err := s.s3Service.GetFileObject(ctx, key)
+		if err == nil {
+			content.Size = *info.ContentLength
+			content.Body = body
+			return content, nil
+		}
+		utils.Logger.Warn("Failed to read cached image derivative", zap.Error(err), zap.String("storage_key", key))
+	} else if err != nil && !s3.IsNotFoundError(err) {
+		utils.Logger.Warn("Failed to check cached image derivative", zap.Error(err), zap.String("storage_key", key))
+	}
+
+	source, err := s.loadSourceImage(ctx, fileRecord)
+	if err != nil {
+		utils.Logger.Warn("Failed to load image for transformation",
+			zap.Error(err),
+			zap.String("file_id", fileID.String()))
+		if strings.Contains(err.Error(), "S3 credentials are not configured") {
+			return nil, errcatalog.FileS3NotConfigured(ctx)
+		}
+		return nil, errcatalog.FileImageTransformFailed(ctx)
+	}
+
+	var buffer bytes.Buffer
+	if err := jpeg.Encode(&buffer, transformImage(source, transform), &jpeg.Options{Quality: thumbnailJPEGQuality}); err != nil {
+		utils.Logger.Error("Failed to encode image derivative", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, errcatalog.FileImageTransformFailed(ctx)
+	}
+
+	// Ошибка сохранения в кэш не мешает ответу: копия будет построена при следующем запросе
+	if err := s.s3Service.PutObject(ctx, key, bytes.NewReader(buffer.Bytes()), "image/jpeg"); err != nil {
+		utils.Logger.Warn("Failed to cache image derivative", zap.Error(err), zap.String("storage_key", key))
+	}
+
+	content.Size = int64(buffer.Len())
+	content.Body = io.NopCloser(bytes.NewReader(buffer.Bytes()))
+	return content, nil
+}
+
+// transformImage уменьшает изображение по рамке transform без увеличения
+func transformImage(source image.Image, transform ImageTransform) *image.RGBA {
+	bounds := source.Bounds()
+	sourceWidth, sourceHeight := bounds.Dx(), bounds.Dy()
+
+	if transform.Fit == ImageFitCover && transform.Width > 0 && transform.Height > 0 {
+		// Обрезаем по центру до пропорций рамки, затем уменьшаем до нее
+		cropWidth, cropHeight := sourceWidth, sourceHeight
+		if sourceWidth*transform.Height > sourceHeight*transform.Width {
+			cropWidth = max(1, sourceHeight*transform.Width/transform.Height)
+		} else {
+			cropHeight = max(1, sourceWidth*transform.Height/transform.Width)
+		}
+		offsetX, offsetY := (sourceWidth-cropWidth)/2, (sourceHeight-cropHeight)/2
+		area := image.Rect(bounds.Min.X+offsetX, bounds.Min.Y+offsetY, bounds.Min.X+offsetX+cropWidth, bounds.Min.Y+offsetY+cropHeight)
+
+		width, height := transform.Width, transform.Height
+		if cropWidth < width {
+			width, height = cropWidth, cropHeight
+		}
+		return scaleImage(source, area, width, height)
+	}
+
+	// Вписываем в рамку; отсутствующая сторона рамки не ограничивает размер
+	boxWidth, boxHeight := transform.Width, transform.Height
+	if boxWidth == 0 {
+		boxWidth = sourceWidth
+	}
+	if boxHeight == 0 {
+		boxHeight = sourceHeight
+	}
+	width, height := sourceWidth, sourceHeight
+	if width > boxWidth {
+		width, height = boxWidth, max(1, sourceHeight*boxWidth/sourceWidth)
+	}
+	if height > boxHeight {
+		width, height = max(1, sourceWidth*boxHeight/sourceHeight), boxHeight
+	}
+	return scaleImage(source, bounds, width, height)
+}
+
+// deleteImageDerivatives удаляет производные изображения файла из S3; ошибки только логируются
+func (s *FileService) deleteImageDerivatives(ctx context.Context, fileRecord *ent.File) {
+	if !SupportsThumbnail(fileRecord.DetectedMimeType) && !SupportsThumbnail(fileRecord.MimeType) {
+		return
+	}
+	prefix := fileRecord.StorageKey + imageDerivativeKeyInfix
+	if _, err := s.s3Service.DeleteObjectsWithPrefix(ctx, prefix); err != nil {
+		utils.Logger.Warn("Failed to delete image derivatives",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()),
+			zap.String("storage_key", prefix))
+	}
+}
@@ -0,0 +1,238 @@
+package file
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/errcatalog"
+	"main/privacy"
+	"main/s3"
+	"main/services/audit"
+	"main/utils"
+	"os"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultIntegritySampleSize количество файлов, проверяемых за один проход выборочной проверки
+	DefaultIntegritySampleSize = 20
+	// DefaultIntegrityRecheckAge минимальный возраст предыдущей проверки для повторной проверки файла
+	DefaultIntegrityRecheckAge = 7 * 24 * time.Hour
+)
+
+// FileIntegrityResult содержит результат проверки целостности файла
+type FileIntegrityResult struct {
+	File             *ent.File
+	Status           file.IntegrityStatus
+	ExpectedChecksum string
+	ActualChecksum   string
+	CheckedAt        time.Time
+}
+
+// VerifyFileIntegrity сравнивает сохраненный SHA-256 файла с текущим объектом в S3
+// и записывает результат проверки в запись файла.
+// Если контрольная сумма ранее не вычислялась (файлы, загруженные до ее появления), она сохраняется как эталон.
+func (s *FileService) VerifyFileIntegrity(ctx context.Context, client *ent.Client, fileID uuid.UUID) (*FileIntegrityResult, error) {
+	if err := s.canDownloadFile(ctx, client, fileID); err != nil {
+		return nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	fileRecord, err := client.File.Get(ctxWithClient, fileID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, errcatalog.FileGetFailed(ctx)
+	}
+
+	result, err := s.verifyFileRecord(ctx, client, fileRecord)
+	if err != nil {
+		return nil, errcatalog.FileIntegrityCheckFailed(ctx)
+	}
+
+	return result, nil
+}
+
+// verifyFileRecord выполняет проверку без проверки прав (используется также фоновой выборочной проверкой)
+func (s *FileService) verifyFileRecord(ctx context.Context, client *ent.Client, fileRecord *ent.File) (*FileIntegrityResult, error) {
+	result := &FileIntegrityResult{
+		ExpectedChecksum: fileRecord.ChecksumSha256,
+		CheckedAt:        time.Now(),
+	}
+
+	actualChecksum, actualSize, err := s.computeObjectChecksum(ctx, fileRecord.StorageKey)
+	switch {
+	case err != nil && s3.IsNotFoundError(err):
+		result.Status = file.IntegrityStatusMISSING
+	case err != nil:
+		return nil, err
+	case fileRecord.ChecksumSha256 == "":
+		// Эталонной суммы нет - фиксируем текущую, сверяя только размер
+		result.ActualChecksum = actualChecksum
+		result.Status = file.IntegrityStatusOK
+		if actualSize != fileRecord.Size {
+			result.Status = file.IntegrityStatusCORRUPTED
+		}
+	default:
+		result.ActualChecksum = actualChecksum
+		result.Status = file.IntegrityStatusOK
+		if actualChecksum != fileRecord.ChecksumSha256 || actualSize != fileRecord.Size {
+			result.Status = file.IntegrityStatusCORRUPTED
+		}
+	}
+
+	updater := client.File.UpdateOne(fileRecord).
+		SetIntegrityStatus(result.Status).
+		SetIntegrityCheckedAt(result.CheckedAt)
+	if fileRecord.ChecksumSha256 == "" && result.Status == file.IntegrityStatusOK {
+		updater = updater.SetChecksumSha256(result.ActualChecksum)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	updatedFile, err := updater.Save(ctxWithClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save integrity result: %w", err)
+	}
+	result.File = updatedFile
+
+	if result.Status != file.IntegrityStatusOK {
+		utils.Logger.Warn("File integrity check failed",
+			zap.String("file_id", fileRecord.ID.String()),
+			zap.String("storage_key", fileRecord.StorageKey),
+			zap.String("status", result.Status.String()),
+			zap.String("expected_checksum", result.ExpectedChecksum),
+			zap.String("actual_checksum", result.ActualChecksum))
+
+		// 📊 [AUDIT] Фиксируем нарушение целостности в тенанте файла (проверка может идти без тенанта в контексте)
+		s.auditService.Record(ctx, client, audit.Event{
+			Action:   fileauditevent.ActionINTEGRITY_FAILURE,
+			TenantID: &fileRecord.TenantID,
+			FileID:   &fileRecord.ID,
+			Details: map[string]interface{}{
+				"status":            result.Status.String(),
+				"expected_checksum": result.ExpectedChecksum,
+				"actual_checksum":   result.ActualChecksum,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// computeObjectChecksum скачивает объект из S3 потоково и вычисляет его SHA-256 и размер
+func (s *FileService) computeObjectChecksum(ctx context.Context, storageKey string) (string, int64, error) {
+	object, err := s.s3Service.GetFileObject(ctx, storageKey)
+	if err != nil {
+		return "", 0, err
+	}
+	defer object.Close()
+
+	hasher := sha256.New()
+	size, err := io.Copy(hasher, object)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read file object: %w", err)
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
+
+// RunIntegritySampling проверяет случайную выборку файлов, которые давно не проверялись.
+// Выполняется в системном контексте без тенанта, поэтому охватывает файлы всех тенантов.
+func (s *FileService) RunIntegritySampling(ctx context.Context, client *ent.Client, sampleSize int, recheckAge time.Duration) (checked int, failed int, err error) {
+	systemCtx := ent.NewContext(privacy.WithSystemContext(ctx), client)
+
+	files, err := client.File.Query().
+		Where(file.Or(
+			file.IntegrityCheckedAtIsNil(),
+			file.IntegrityCheckedAtLT(time.Now().Add(-recheckAge)),
+		)).
+		Order(func(sel *sql.Selector) {
+			sel.OrderExpr(sql.Expr("RANDOM()"))
+		}).
+		Limit(sampleSize).
+		All(systemCtx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to select files for integrity sampling: %w", err)
+	}
+
+	for _, fileRecord := range files {
+		if ctx.Err() != nil {
+			break
+		}
+
+		result, verifyErr := s.verifyFileRecord(systemCtx, client, fileRecord)
+		if verifyErr != nil {
+			utils.Logger.Error("Integrity sampling failed for file",
+				zap.Error(verifyErr),
+				zap.String("file_id", fileRecord.ID.String()))
+			continue
+		}
+
+		checked++
+		if result.Status != file.IntegrityStatusOK {
+			failed++
+		}
+	}
+
+	return checked, failed, nil
+}
+
+// StartIntegritySampler запускает периодическую выборочную проверку целостности файлов.
+// Интервал задается FILE_INTEGRITY_SAMPLE_INTERVAL (например, "1h"); пустое значение отключает проверку.
+// Размер выборки - FILE_INTEGRITY_SAMPLE_SIZE. Останавливается при отмене ctx.
+func StartIntegritySampler(ctx context.Context, getClient func() *ent.Client) {
+	interval, err := time.ParseDuration(os.Getenv("FILE_INTEGRITY_SAMPLE_INTERVAL"))
+	if err != nil || interval <= 0 {
+		utils.Logger.Info("File integrity sampler is disabled")
+		return
+	}
+
+	sampleSize := DefaultIntegritySampleSize
+	if value, err := strconv.Atoi(os.Getenv("FILE_INTEGRITY_SAMPLE_SIZE")); err == nil && value > 0 {
+		sampleSize = value
+	}
+
+	service := NewFileService()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				client := getClient()
+				if client == nil {
+					continue
+				}
+
+				checked, failed, err := service.RunIntegritySampling(ctx, client, sampleSize, DefaultIntegrityRecheckAge)
+				if err != nil {
+					utils.Logger.Error("File integrity sampling failed", zap.Error(err))
+					continue
+				}
+
+				utils.Logger.Info("File integrity sampling completed",
+					zap.Int("checked", checked),
+					zap.Int("failed", failed))
+			}
+		}
+	}()
+
+	utils.Logger.Info("File integrity sampler started",
+		zap.Duration("interval", interval),
+		zap.Int("sample_size", sampleSize))
+}
@@ -0,0 +1,538 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/lifecyclerule"
+	"main/ent/predicate"
+	"main/ent/schema/mixin"
+	"main/ent/tag"
+	"main/errcatalog"
+	"main/privacy"
+	"main/services/audit"
+	"main/utils"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxLifecycleRules максимальное количество правил жизненного цикла тенанта
+	maxLifecycleRules = 50
+	// maxLifecycleRuleNameLength максимальная длина названия правила в символах
+	maxLifecycleRuleNameLength = 100
+	// maxLifecycleRuleDays максимальное значение условий возраста и простоя в днях
+	maxLifecycleRuleDays = 36500
+	// lifecycleRuleBatch количество файлов, обрабатываемых правилом за один запрос
+	lifecycleRuleBatch = 100
+	// lifecycleNotifyInterval минимальный интервал между уведомлениями одного правила NOTIFY
+	lifecycleNotifyInterval = 24 * time.Hour
+	// lifecycleNoticeFiles количество идентификаторов файлов в событии уведомления
+	lifecycleNoticeFiles = 20
+	// defaultLifecycleSampleLimit количество примеров файлов пробного запуска по умолчанию
+	defaultLifecycleSampleLimit = 20
+	// maxLifecycleSampleLimit максимальное количество примеров файлов пробного запуска
+	maxLifecycleSampleLimit = 100
+)
+
+// LifecycleRuleInput параметры правила жизненного цикла. Условия объединяются через И,
+// правило без условий не допускается, чтобы не затронуть все файлы тенанта.
+type LifecycleRuleInput struct {
+	Name    string
+	Enabled bool
+	Action  lifecyclerule.Action
+	// MinAgeDays файл загружен не менее указанного количества дней назад
+	MinAgeDays *int
+	// MinIdleDays файл не скачивали указанное количество дней
+	MinIdleDays *int
+	// Tag файл отмечен тегом
+	Tag *string
+	// MimeCategory категория MIME типа файла (кроме OTHER)
+	MimeCategory *FileCategory
+}
+
+// LifecycleRuleDryRun файлы, которые правило затронуло бы при применении сейчас
+type LifecycleRuleDryRun struct {
+	// RuleID пусто для несохраненного правила
+	RuleID       *uuid.UUID
+	Name         string
+	Action       lifecyclerule.Action
+	MatchedCount int
+	MatchedBytes int64
+	// SampleFiles первые подходящие файлы, начиная с самых старых
+	SampleFiles []*ent.File
+}
+
+// validateLifecycleRule нормализует и проверяет параметры правила
+func validateLifecycleRule(ctx context.Context, input *LifecycleRuleInput) error {
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" || utf8.RuneCountInString(input.Name) > maxLifecycleRuleNameLength {
+		return errcatalog.FileLifecycleInvalidName(ctx)
+	}
+	if lifecyclerule.ActionValidator(input.Action) != nil {
+		return errcatalog.FileLifecycleInvalidAction(ctx)
+	}
+
+	for _, days := range []*int{input.MinAgeDays, input.MinIdleDays} {
+		if days != nil && (*days <= 0 || *days > maxLifecycleRuleDays) {
+			return errcatalog.FileLifecycleInvalidDays(ctx)
+		}
+	}
+
+	if input.Tag != nil {
+		name := strings.TrimSpace(*input.Tag)
+		if utf8.RuneCountInString(name) > maxTagLength {
+			return errcatalog.FileTagTooLong(ctx)
+		}
+		input.Tag = &name
+		if name == "" {
+			input.Tag = nil
+		}
+	}
+
+	if input.MimeCategory != nil {
+		valid := false
+		for _, category := range FileCategories {
+			if category == *input.MimeCategory && category != FileCategoryOther {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return errcatalog.FileLifecycleInvalidMIMECategory(ctx)
+		}
+	}
+
+	if input.MinAgeDays == nil && input.MinIdleDays == nil && input.Tag == nil && input.MimeCategory == nil {
+		return errcatalog.FileLifecycleConditionRequired(ctx)
+	}
+	return nil
+}
+
+// lifecycleRuleFromInput создает несохраненное правило для пробного запуска
+func lifecycleRuleFromInput(input *LifecycleRuleInput) *ent.LifecycleRule {
+	rule := &ent.LifecycleRule{
+		Name:        input.Name,
+		Enabled:     input.Enabled,
+		Action:      input.Action,
+		MinAgeDays:  input.MinAgeDays,
+		MinIdleDays: input.MinIdleDays,
+		Tag:         input.Tag,
+	}
+	if input.MimeCategory != nil {
+		category := string(*input.MimeCategory)
+		rule.MimeCategory = &category
+	}
+	return rule
+}
+
+// lifecycleRulePredicates возвращает условия отбора файлов правилом на момент now
+func lifecycleRulePredicates(rule *ent.LifecycleRule, now time.Time) []predicate.File {
+	predicates := make([]predicate.File, 0, 5)
+	if rule.MinAgeDays != nil {
+		predicates = append(predicates, file.CreateTimeLT(now.AddDate(0, 0, -*rule.MinAgeDays)))
+	}
+	if rule.MinIdleDays != nil {
+		// Файлы без скачиваний простаивают с момента загрузки
+		cutoff := now.AddDate(0, 0, -*rule.MinIdleDays)
+		predicates = append(predicates, file.Or(
+			file.LastDownloadedAtLT(cutoff),
+			file.And(file.LastDownloadedAtIsNil(), file.CreateTimeLT(cutoff)),
+		))
+	}
+	if rule.Tag != nil {
+		predicates = append(predicates, file.HasTagsWith(tag.Name(*rule.Tag)))
+	}
+	if rule.MimeCategory != nil {
+		predicates = append(predicates, mimeCategoryPredicate(FileCategory(*rule.MimeCategory)))
+	}
+	// Уже архивные файлы повторно не архивируются
+	if rule.Action == lifecyclerule.ActionARCHIVE {
+		predicates = append(predicates, file.ArchivedAtIsNil())
+	}
+	// Файлы под удержанием S3 Object Lock не перемещаются в корзину до окончания удержания
+	if rule.Action == lifecyclerule.ActionEXPIRE {
+		predicates = append(predicates, ObjectLockReleased(now))
+	}
+	return predicates
+}
+
+// aggregateRuleFiles считает количество и суммарный размер файлов, подходящих под правило
+func aggregateRuleFiles(ctx context.Context, query *ent.FileQuery) (int, int64, error) {
+	var rows []struct {
+		Count int   `json:"count"`
+		Sum   int64 `json:"sum"`
+	}
+	err := query.
+		GroupBy(file.FieldTenantID).
+		Aggregate(ent.Count(), ent.Sum(file.FieldSize)).
+		Scan(ctx, &rows)
+	if err != nil || len(rows) == 0 {
+		return 0, 0, err
+	}
+	return rows[0].Count, rows[0].Sum, nil
+}
+
+// ListLifecycleRules возвращает правила жизненного цикла тенанта по названию
+func (s *FileService) ListLifecycleRules(ctx context.Context, client *ent.Client) ([]*ent.LifecycleRule, error) {
+	rules, err := client.LifecycleRule.Query().
+		Order(ent.Asc(lifecyclerule.FieldName)).
+		All(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Error("Failed to list lifecycle rules", zap.Error(err))
+		return nil, errcatalog.FileLifecycleListFailed(ctx)
+	}
+	return rules, nil
+}
+
+// ensureLifecycleRuleNameFree проверяет, что название не занято другим правилом тенанта
+func ensureLifecycleRuleNameFree(ctx context.Context, client *ent.Client, name string, exceptID *uuid.UUID) error {
+	query := client.LifecycleRule.Query().Where(lifecyclerule.Name(name))
+	if exceptID != nil {
+		query = query.Where(lifecyclerule.IDNEQ(*exceptID))
+	}
+	exists, err := query.Exist(ent.NewContext(ctx, client))
+	if err != nil {
+		return errcatalog.FileLifecycleSaveFailed(ctx)
+	}
+	if exists {
+		return errcatalog.FileLifecycleNameTaken(ctx)
+	}
+	return nil
+}
+
+// CreateLifecycleRule создает правило жизненного цикла тенанта
+func (s *FileService) CreateLifecycleRule(ctx context.Context, client *ent.Client, input LifecycleRuleInput) (*ent.LifecycleRule, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+	if err := validateLifecycleRule(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	count, err := client.LifecycleRule.Query().Count(ctxWithClient)
+	if err != nil {
+		return nil, errcatalog.FileLifecycleSaveFailed(ctx)
+	}
+	if count >= maxLifecycleRules {
+		return nil, errcatalog.FileLifecycleTooMany(ctx)
+	}
+	if err := ensureLifecycleRuleNameFree(ctx, client, input.Name, nil); err != nil {
+		return nil, err
+	}
+
+	rule := lifecycleRuleFromInput(&input)
+	created, err := client.LifecycleRule.Create().
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetAction(rule.Action).
+		SetNillableMinAgeDays(rule.MinAgeDays).
+		SetNillableMinIdleDays(rule.MinIdleDays).
+		SetNillableTag(rule.Tag).
+		SetNillableMimeCategory(rule.MimeCategory).
+		SetCreatedBy(*userID).
+		Save(ctxWithClient)
+	if err != nil {
+		utils.Logger.Error("Failed to create lifecycle rule", zap.Error(err))
+		return nil, errcatalog.FileLifecycleSaveFailed(ctx)
+	}
+
+	utils.Logger.Info("Lifecycle rule created",
+		zap.String("rule_id", created.ID.String()),
+		zap.String("action", created.Action.String()))
+	return created, nil
+}
+
+// UpdateLifecycleRule заменяет условия и действие правила
+func (s *FileService) UpdateLifecycleRule(ctx context.Context, client *ent.Client, id uuid.UUID, input LifecycleRuleInput) (*ent.LifecycleRule, error) {
+	if err := validateLifecycleRule(ctx, &input); err != nil {
+		return nil, err
+	}
+	if err := ensureLifecycleRuleNameFree(ctx, client, input.Name, &id); err != nil {
+		return nil, err
+	}
+
+	rule := lifecycleRuleFromInput(&input)
+	update := client.LifecycleRule.UpdateOneID(id).
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetAction(rule.Action)
+	if rule.MinAgeDays != nil {
+		update = update.SetMinAgeDays(*rule.MinAgeDays)
+	} else {
+		update = update.ClearMinAgeDays()
+	}
+	if rule.MinIdleDays != nil {
+		update = update.SetMinIdleDays(*rule.MinIdleDays)
+	} else {
+		update = update.ClearMinIdleDays()
+	}
+	if rule.Tag != nil {
+		update = update.SetTag(*rule.Tag)
+	} else {
+		update = update.ClearTag()
+	}
+	if rule.MimeCategory != nil {
+		update = update.SetMimeCategory(*rule.MimeCategory)
+	} else {
+		update = update.ClearMimeCategory()
+	}
+
+	updated, err := update.Save(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileLifecycleNotFound(ctx)
+		}
+		utils.Logger.Error("Failed to update lifecycle rule", zap.Error(err), zap.String("rule_id", id.String()))
+		return nil, errcatalog.FileLifecycleSaveFailed(ctx)
+	}
+	return updated, nil
+}
+
+// DeleteLifecycleRule удаляет правило; уже примененные действия не отменяются
+func (s *FileService) DeleteLifecycleRule(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	if err := client.LifecycleRule.DeleteOneID(id).Exec(ent.NewContext(ctx, client)); err != nil {
+		if ent.IsNotFound(err) {
+			return errcatalog.FileLifecycleNotFound(ctx)
+		}
+		utils.Logger.Error("Failed to delete lifecycle rule", zap.Error(err), zap.String("rule_id", id.String()))
+		return errcatalog.FileLifecycleSaveFailed(ctx)
+	}
+	return nil
+}
+
+// DryRunLifecycleRules проверяет правила и показывает, какие файлы они затронули бы сейчас, ничего не изменяя.
+// Без inputs проверяются сохраненные включенные правила тенанта.
+func (s *FileService) DryRunLifecycleRules(ctx context.Context, client *ent.Client, inputs []*LifecycleRuleInput, sampleLimit int) ([]*LifecycleRuleDryRun, error) {
+	if sampleLimit <= 0 {
+		sampleLimit = defaultLifecycleSampleLimit
+	}
+	if sampleLimit > maxLifecycleSampleLimit {
+		sampleLimit = maxLifecycleSampleLimit
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	var rules []*ent.LifecycleRule
+	if inputs == nil {
+		saved, err := client.LifecycleRule.Query().
+			Where(lifecyclerule.Enabled(true)).
+			Order(ent.Asc(lifecyclerule.FieldName)).
+			All(ctxWithClient)
+		if err != nil {
+			utils.Logger.Error("Failed to list lifecycle rules", zap.Error(err))
+			return nil, errcatalog.FileLifecycleListFailed(ctx)
+		}
+		rules = saved
+	} else {
+		if len(inputs) > maxLifecycleRules {
+			return nil, errcatalog.FileLifecycleTooMany(ctx)
+		}
+		for _, input := range inputs {
+			if err := validateLifecycleRule(ctx, input); err != nil {
+				return nil, err
+			}
+			rules = append(rules, lifecycleRuleFromInput(input))
+		}
+	}
+
+	now := time.Now()
+	results := make([]*LifecycleRuleDryRun, 0, len(rules))
+	for _, rule := range rules {
+		query := client.File.Query().Where(lifecycleRulePredicates(rule, now)...)
+
+		count, size, err := aggregateRuleFiles(ctxWithClient, query.Clone())
+		if err != nil {
+			utils.Logger.Error("Failed to evaluate lifecycle rule", zap.Error(err), zap.String("rule", rule.Name))
+			return nil, errcatalog.FileLifecycleDryRunFailed(ctx)
+		}
+		samples, err := query.
+			Order(ent.Asc(file.FieldCreateTime), ent.Asc(file.FieldID)).
+			Limit(sampleLimit).
+			All(ctxWithClient)
+		if err != nil {
+			utils.Logger.Error("Failed to evaluate lifecycle rule", zap.Error(err), zap.String("rule", rule.Name))
+			return nil, errcatalog.FileLifecycleDryRunFailed(ctx)
+		}
+
+		result := &LifecycleRuleDryRun{
+			Name:         rule.Name,
+			Action:       rule.Action,
+			MatchedCount: count,
+			MatchedBytes: size,
+			SampleFiles:  samples,
+		}
+		if rule.ID != uuid.Nil {
+			ruleID := rule.ID
+			result.RuleID = &ruleID
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// ApplyLifecycleRules применяет включенные правила жизненного цикла всех тенантов и возвращает
+// количество архивированных и перемещенных в корзину файлов. Ошибка правила не останавливает остальные.
+func (s *FileService) ApplyLifecycleRules(ctx context.Context, client *ent.Client) (int, error) {
+	systemCtx, cancel := mixin.SkipTenantFilterFor(ent.NewContext(privacy.WithSystemContext(ctx), client),
+		"apply lifecycle rules of all tenants", fileTrashPurgeTimeout)
+	defer cancel()
+
+	rules, err := client.LifecycleRule.Query().
+		Where(lifecyclerule.Enabled(true)).
+		Order(ent.Asc(lifecyclerule.FieldTenantID), ent.Asc(lifecyclerule.FieldName)).
+		All(systemCtx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select lifecycle rules: %w", err)
+	}
+
+	applied := 0
+	for _, rule := range rules {
+		if ctx.Err() != nil {
+			break
+		}
+		affected, err := s.applyLifecycleRule(ctx, systemCtx, client, rule)
+		applied += affected
+		if err != nil {
+			utils.Logger.Error("Failed to apply lifecycle rule",
+				zap.Error(err),
+				zap.String("rule_id", rule.ID.String()),
+				zap.String("tenant_id", rule.TenantID.String()))
+		}
+	}
+
+	if applied > 0 {
+		utils.Logger.Info("Lifecycle rules applied", zap.Int("rules", len(rules)), zap.Int("files", applied))
+	}
+	return applied, nil
+}
+
+// applyLifecycleRule применяет одно правило к файлам его тенанта
+func (s *FileService) applyLifecycleRule(ctx, systemCtx context.Context, client *ent.Client, rule *ent.LifecycleRule) (int, error) {
+	now := time.Now()
+	if rule.Action == lifecyclerule.ActionNOTIFY && rule.LastRunAt != nil && now.Sub(*rule.LastRunAt) < lifecycleNotifyInterval {
+		return 0, nil
+	}
+
+	query := client.File.Query().
+		Where(file.TenantID(rule.TenantID)).
+		Where(lifecycleRulePredicates(rule, now)...)
+
+	var (
+		affected int
+		err      error
+	)
+	if rule.Action == lifecyclerule.ActionNOTIFY {
+		err = s.notifyLifecycleRule(ctx, systemCtx, client, rule, query)
+	} else {
+		affected, err = s.updateLifecycleRuleFiles(ctx, systemCtx, client, rule, query, now)
+	}
+	if err != nil {
+		return affected, err
+	}
+
+	if err := client.LifecycleRule.UpdateOneID(rule.ID).SetLastRunAt(now).Exec(systemCtx); err != nil {
+		utils.Logger.Warn("Failed to save lifecycle rule run time", zap.Error(err), zap.String("rule_id", rule.ID.String()))
+	}
+	return affected, nil
+}
+
+// updateLifecycleRuleFiles архивирует файлы (ARCHIVE) или перемещает их в корзину (EXPIRE)
+func (s *FileService) updateLifecycleRuleFiles(ctx, systemCtx context.Context, client *ent.Client, rule *ent.LifecycleRule, query *ent.FileQuery, now time.Time) (int, error) {
+	affected := 0
+	for ctx.Err() == nil {
+		files, err := query.Clone().
+			Order(ent.Asc(file.FieldCreateTime), ent.Asc(file.FieldID)).
+			Limit(lifecycleRuleBatch).
+			All(systemCtx)
+		if err != nil {
+			return affected, fmt.Errorf("failed to select lifecycle rule files: %w", err)
+		}
+
+		for _, fileRecord := range files {
+			details := map[string]interface{}{
+				"filename":          fileRecord.OriginalName,
+				"size":              fileRecord.Size,
+				"lifecycle_rule":    rule.Name,
+				"lifecycle_rule_id": rule.ID.String(),
+			}
+
+			action := fileauditevent.ActionUPDATE
+			update := client.File.Update().Where(file.ID(fileRecord.ID))
+			if rule.Action == lifecyclerule.ActionEXPIRE {
+				action = fileauditevent.ActionDELETE
+				update = update.Where(file.DeletedAtIsNil()).SetDeletedAt(now)
+				details["trashed"] = true
+			} else {
+				update = update.Where(file.ArchivedAtIsNil()).SetArchivedAt(now)
+				details["archived"] = true
+			}
+
+			updated, err := update.Save(systemCtx)
+			if err != nil {
+				return affected, fmt.Errorf("failed to apply lifecycle rule to file: %w", err)
+			}
+			if updated == 0 {
+				continue
+			}
+			affected++
+
+			// 📊 [AUDIT] Системная операция: тенант задается явно
+			fileID, tenantID := fileRecord.ID, fileRecord.TenantID
+			s.auditService.Record(ctx, client, audit.Event{
+				Action:   action,
+				TenantID: &tenantID,
+				FileID:   &fileID,
+				Details:  details,
+			})
+		}
+
+		if len(files) < lifecycleRuleBatch {
+			break
+		}
+	}
+	return affected, nil
+}
+
+// notifyLifecycleRule фиксирует в аудите файлы, подходящие под правило NOTIFY (не чаще lifecycleNotifyInterval)
+func (s *FileService) notifyLifecycleRule(ctx, systemCtx context.Context, client *ent.Client, rule *ent.LifecycleRule, query *ent.FileQuery) error {
+	count, size, err := aggregateRuleFiles(systemCtx, query.Clone())
+	if err != nil {
+		return fmt.Errorf("failed to count lifecycle rule files: %w", err)
+	}
+	if count == 0 {
+		return nil
+	}
+
+	fileIDs, err := query.
+		Order(ent.Asc(file.FieldCreateTime), ent.Asc(file.FieldID)).
+		Limit(lifecycleNoticeFiles).
+		IDs(systemCtx)
+	if err != nil {
+		return fmt.Errorf("failed to select lifecycle rule files: %w", err)
+	}
+
+	// 📊 [AUDIT] Системная операция: тенант задается явно
+	tenantID := rule.TenantID
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:   fileauditevent.ActionLIFECYCLE_NOTICE,
+		TenantID: &tenantID,
+		Details: map[string]interface{}{
+			"lifecycle_rule":    rule.Name,
+			"lifecycle_rule_id": rule.ID.String(),
+			"matched_count":     count,
+			"matched_bytes":     size,
+			"file_ids":          fileIDs,
+		},
+	})
+	return nil
+}
@@ -0,0 +1,281 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"main/antivirus"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/privacy"
+	"main/services/audit"
+	"main/services/batch"
+	"main/utils"
+	"os"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultMalwareScanInterval интервал проверки файлов, ожидающих антивирусной проверки
+	defaultMalwareScanInterval = time.Minute
+	// malwareScanBatchSize количество файлов, проверяемых за один проход
+	malwareScanBatchSize = 10
+	// malwareScanBatchTimeout максимальное время проверки одной пачки файлов
+	malwareScanBatchTimeout = 30 * time.Minute
+)
+
+// malwareScanWakeup будит фоновую проверку сразу после загрузки файла, ожидающего проверки
+var malwareScanWakeup = make(chan struct{}, 1)
+
+// initialScanStatus возвращает состояние антивирусной проверки для нового файла
+func (s *FileService) initialScanStatus() file.ScanStatus {
+	if s.scanner == nil {
+		return file.ScanStatusNONE
+	}
+	return file.ScanStatusPENDING
+}
+
+// requestMalwareScan сообщает фоновой проверке о новых файлах (без блокировки)
+func requestMalwareScan() {
+	select {
+	case malwareScanWakeup <- struct{}{}:
+	default:
+	}
+}
+
+// scanUpload проверяет загружаемый файл до сохранения в S3 и возвращает поток в начало.
+// Зараженный файл отклоняется с записью в аудит. Если сканер недоступен, файл сохраняется
+// в состоянии PENDING и проверяется фоновой проверкой; до этого скачивание файла запрещено.
+func (s *FileService) scanUpload(ctx context.Context, client *ent.Client, upload *graphql.Upload) (file.ScanStatus, error) {
+	if s.scanner == nil {
+		return file.ScanStatusNONE, nil
+	}
+
+	result, err := s.scanner.Scan(ctx, upload.File)
+	if _, seekErr := upload.File.Seek(0, io.SeekStart); seekErr != nil {
+		utils.Logger.Error("Failed to rewind uploaded file after scanning", zap.Error(seekErr), zap.String("filename", upload.Filename))
+		return "", errcatalog.FileUploadFailed(ctx)
+	}
+	if err != nil {
+		utils.Logger.Warn("Antivirus scan failed, file will be scanned in background",
+			zap.Error(err),
+			zap.String("scanner", s.scanner.Name()),
+			zap.String("filename", upload.Filename))
+		return file.ScanStatusPENDING, nil
+	}
+
+	if result.Infected {
+		// 📊 [AUDIT] Фиксируем попытку загрузки зараженного файла
+		s.auditService.Record(ctx, client, audit.Event{
+			Action: fileauditevent.ActionMALWARE_DETECTED,
+			Details: map[string]interface{}{
+				"stage":     "upload",
+				"filename":  upload.Filename,
+				"size":      upload.Size,
+				"scanner":   s.scanner.Name(),
+				"signature": result.Signature,
+			},
+		})
+		return "", errcatalog.FileMalwareDetected(ctx)
+	}
+
+	return file.ScanStatusCLEAN, nil
+}
+
+// ensureScanAllowsDownload запрещает скачивание зараженных и еще не проверенных файлов.
+// Попытки скачать зараженный файл фиксируются в аудите.
+func (s *FileService) ensureScanAllowsDownload(ctx context.Context, client *ent.Client, fileRecord *ent.File) error {
+	switch fileRecord.ScanStatus {
+	case file.ScanStatusINFECTED:
+		// 📊 [AUDIT] Фиксируем попытку скачать зараженный файл
+		s.auditService.Record(ctx, client, audit.Event{
+			Action: fileauditevent.ActionMALWARE_DOWNLOAD_BLOCKED,
+			FileID: &fileRecord.ID,
+			Details: map[string]interface{}{
+				"filename": fileRecord.OriginalName,
+			},
+		})
+		return errcatalog.FileMalwareBlocked(ctx)
+	case file.ScanStatusPENDING:
+		return errcatalog.FileScanPending(ctx)
+	default:
+		return nil
+	}
+}
+
+// filterScannedFiles оставляет файлы, которые разрешено скачивать по результату антивирусной проверки;
+// остальные попадают в отказы items
+func (s *FileService) filterScannedFiles(ctx context.Context, client *ent.Client, files []*ent.File, items *batch.Result) []*ent.File {
+	allowed := make([]*ent.File, 0, len(files))
+	for _, fileRecord := range files {
+		if err := s.ensureScanAllowsDownload(ctx, client, fileRecord); err != nil {
+			utils.Logger.Warn("File skipped in batch download by antivirus status",
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("scan_status", fileRecord.ScanStatus.String()))
+			items.Fail(ctx, fileRecord.ID, err)
+			continue
+		}
+		allowed = append(allowed, fileRecord)
+	}
+	return allowed
+}
+
+// ScanPendingFiles проверяет антивирусом файлы всех тенантов, ожидающие проверки.
+// Файл, который не удалось проверить, остается в PENDING до следующего прохода.
+func (s *FileService) ScanPendingFiles(ctx context.Context, client *ent.Client, limit int) (int, int, error) {
+	if s.scanner == nil {
+		return 0, 0, nil
+	}
+
+	systemCtx, cancel := mixin.SkipTenantFilterFor(ent.NewContext(privacy.WithSystemContext(ctx), client),
+		"scan pending files of all tenants", malwareScanBatchTimeout)
+	defer cancel()
+
+	files, err := client.File.Query().
+		Where(file.ScanStatusEQ(file.ScanStatusPENDING)).
+		Order(ent.Asc(file.FieldCreateTime), ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(systemCtx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to select files for scanning: %w", err)
+	}
+
+	scanned, failed := 0, 0
+	for _, fileRecord := range files {
+		if ctx.Err() != nil {
+			break
+		}
+
+		result, err := s.scanStoredFile(ctx, fileRecord)
+		if err != nil {
+			utils.Logger.Warn("Failed to scan file",
+				zap.Error(err),
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("scanner", s.scanner.Name()))
+			failed++
+			continue
+		}
+
+		status := file.ScanStatusCLEAN
+		if result.Infected {
+			status = file.ScanStatusINFECTED
+		}
+
+		// Файл мог быть удален или перенесен во время проверки - обновляем только ожидающие
+		updated, err := client.File.Update().
+			Where(file.ID(fileRecord.ID), file.ScanStatusEQ(file.ScanStatusPENDING)).
+			SetScanStatus(status).
+			Save(systemCtx)
+		if err != nil {
+			return scanned, failed, fmt.Errorf("failed to update scan status: %w", err)
+		}
+		scanned++
+
+		if updated > 0 && result.Infected {
+			utils.Logger.Warn("Malware detected in stored file",
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("tenant_id", fileRecord.TenantID.String()),
+				zap.String("signature", result.Signature))
+
+			// 📊 [AUDIT] Фиксируем обнаружение зараженного файла в тенанте файла
+			s.auditService.Record(systemCtx, client, audit.Event{
+				Action:   fileauditevent.ActionMALWARE_DETECTED,
+				TenantID: &fileRecord.TenantID,
+				FileID:   &fileRecord.ID,
+				Details: map[string]interface{}{
+					"stage":     "background",
+					"filename":  fileRecord.OriginalName,
+					"size":      fileRecord.Size,
+					"scanner":   s.scanner.Name(),
+					"signature": result.Signature,
+				},
+			})
+		}
+		if updated > 0 && !result.Infected && fileRecord.PreviewStatus == file.PreviewStatusPENDING {
+			requestPreviews()
+		}
+		if updated > 0 && !result.Infected && fileRecord.PdfStatus == file.PdfStatusPENDING {
+			requestPDFRenditions()
+		}
+	}
+
+	return scanned, failed, nil
+}
+
+// scanStoredFile передает сканеру содержимое файла из S3
+func (s *FileService) scanStoredFile(ctx context.Context, fileRecord *ent.File) (*antivirus.Result, error) {
+	object, err := s.s3Service.GetFileObject(ctx, fileRecord.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	return s.scanner.Scan(ctx, object)
+}
+
+// StartMalwareScanner запускает фоновую антивирусную проверку файлов, не проверенных при загрузке
+// (составные загрузки и файлы, загруженные во время недоступности сканера).
+// Проверка выполняется с интервалом ANTIVIRUS_SCAN_INTERVAL (по умолчанию 1m, "0" отключает проверку)
+// и сразу после загрузки таких файлов.
+func StartMalwareScanner(ctx context.Context, getClient func() *ent.Client) {
+	service := NewFileService()
+	if service.scanner == nil {
+		return
+	}
+
+	interval := defaultMalwareScanInterval
+	if value := os.Getenv("ANTIVIRUS_SCAN_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			utils.Logger.Info("Background malware scanner is disabled")
+			return
+		}
+		interval = parsed
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-malwareScanWakeup:
+			}
+
+			client := getClient()
+			if client == nil {
+				continue
+			}
+
+			// Обрабатываем пачками, пока есть ожидающие файлы; при ошибках сканера ждем следующего прохода
+			for ctx.Err() == nil {
+				scanned, failed, err := service.ScanPendingFiles(ctx, client, malwareScanBatchSize)
+				if err != nil {
+					utils.Logger.Error("Background malware scan failed", zap.Error(err))
+					break
+				}
+				if scanned > 0 || failed > 0 {
+					utils.Logger.Debug("Files scanned",
+						zap.Int("scanned", scanned),
+						zap.Int("failed", failed))
+				}
+				if failed > 0 || scanned < malwareScanBatchSize {
+					break
+				}
+			}
+		}
+	}()
+
+	utils.Logger.Info("Background malware scanner started",
+		zap.String("scanner", service.scanner.Name()),
+		zap.Duration("interval", interval))
+}
@@ -0,0 +1,193 @@
+package file
+
+import (
+	"context"
+	"main/ent/file"
+	"main/ent/predicate"
+	"main/utils"
+	"mime"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileCategory категория файла для отображения на клиентах
+type FileCategory string
+
+const (
+	FileCategoryImage        FileCategory = "IMAGE"
+	FileCategoryVideo        FileCategory = "VIDEO"
+	FileCategoryAudio        FileCategory = "AUDIO"
+	FileCategoryPDF          FileCategory = "PDF"
+	FileCategoryDocument     FileCategory = "DOCUMENT"
+	FileCategorySpreadsheet  FileCategory = "SPREADSHEET"
+	FileCategoryPresentation FileCategory = "PRESENTATION"
+	FileCategoryArchive      FileCategory = "ARCHIVE"
+	FileCategoryCode         FileCategory = "CODE"
+	FileCategoryText         FileCategory = "TEXT"
+	FileCategoryOther        FileCategory = "OTHER"
+)
+
+// FileCategories все категории файлов в порядке отображения
+var FileCategories = []FileCategory{
+	FileCategoryImage,
+	FileCategoryVideo,
+	FileCategoryAudio,
+	FileCategoryPDF,
+	FileCategoryDocument,
+	FileCategorySpreadsheet,
+	FileCategoryPresentation,
+	FileCategoryArchive,
+	FileCategoryCode,
+	FileCategoryText,
+	FileCategoryOther,
+}
+
+// FileTypeInfo описывает категорию файла и идентификатор иконки
+type FileTypeInfo struct {
+	Category FileCategory
+	IconKey  string
+}
+
+// mimeTypeRegistry точные соответствия MIME типов (проверяются первыми)
+var mimeTypeRegistry = map[string]FileTypeInfo{
+	"application/pdf": {FileCategoryPDF, "file-pdf"},
+
+	"application/msword": {FileCategoryDocument, "file-word"},
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": {FileCategoryDocument, "file-word"},
+	"application/vnd.oasis.opendocument.text":                                 {FileCategoryDocument, "file-document"},
+	"application/rtf": {FileCategoryDocument, "file-document"},
+
+	"application/vnd.ms-excel": {FileCategorySpreadsheet, "file-excel"},
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": {FileCategorySpreadsheet, "file-excel"},
+	"application/vnd.oasis.opendocument.spreadsheet":                    {FileCategorySpreadsheet, "file-spreadsheet"},
+	"text/csv": {FileCategorySpreadsheet, "file-csv"},
+
+	"application/vnd.ms-powerpoint":                                             {FileCategoryPresentation, "file-powerpoint"},
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": {FileCategoryPresentation, "file-powerpoint"},
+	"application/vnd.oasis.opendocument.presentation":                           {FileCategoryPresentation, "file-presentation"},
+
+	"application/zip":              {FileCategoryArchive, "file-archive"},
+	"application/x-zip-compressed": {FileCategoryArchive, "file-archive"},
+	"application/x-rar-compressed": {FileCategoryArchive, "file-archive"},
+	"application/vnd.rar":          {FileCategoryArchive, "file-archive"},
+	"application/x-7z-compressed":  {FileCategoryArchive, "file-archive"},
+	"application/x-tar":            {FileCategoryArchive, "file-archive"},
+	"application/gzip":             {FileCategoryArchive, "file-archive"},
+
+	"application/json":       {FileCategoryCode, "file-code"},
+	"application/xml":        {FileCategoryCode, "file-code"},
+	"application/javascript": {FileCategoryCode, "file-code"},
+	"text/html":              {FileCategoryCode, "file-code"},
+	"text/css":               {FileCategoryCode, "file-code"},
+	"text/javascript":        {FileCategoryCode, "file-code"},
+	"text/xml":               {FileCategoryCode, "file-code"},
+	"application/x-yaml":     {FileCategoryCode, "file-code"},
+
+	"image/svg+xml": {FileCategoryImage, "file-vector"},
+}
+
+// mimePrefixRegistry соответствия по семейству MIME типов
+var mimePrefixRegistry = []struct {
+	prefix string
+	info   FileTypeInfo
+}{
+	{"image/", FileTypeInfo{FileCategoryImage, "file-image"}},
+	{"video/", FileTypeInfo{FileCategoryVideo, "file-video"}},
+	{"audio/", FileTypeInfo{FileCategoryAudio, "file-audio"}},
+	{"text/", FileTypeInfo{FileCategoryText, "file-text"}},
+}
+
+// fileTypeOther используется, если тип файла не удалось определить
+var fileTypeOther = FileTypeInfo{FileCategoryOther, "file"}
+
+// ResolveFileType определяет категорию и иконку файла по MIME типу.
+// Для неопределенных типов (application/octet-stream) используется расширение имени файла.
+func ResolveFileType(mimeType, filename string) FileTypeInfo {
+	if info, ok := lookupMimeType(mimeType); ok {
+		return info
+	}
+	if extensionType := mime.TypeByExtension(strings.ToLower(filepath.Ext(filename))); extensionType != "" {
+		if info, ok := lookupMimeType(extensionType); ok {
+			return info
+		}
+	}
+	return fileTypeOther
+}
+
+// lookupMimeType ищет MIME тип в реестре (без параметров вроде charset)
+func lookupMimeType(mimeType string) (FileTypeInfo, bool) {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(mimeType))
+	}
+	if mediaType == "" || mediaType == "application/octet-stream" {
+		return FileTypeInfo{}, false
+	}
+
+	if info, ok := mimeTypeRegistry[mediaType]; ok {
+		return info, true
+	}
+	for _, entry := range mimePrefixRegistry {
+		if strings.HasPrefix(mediaType, entry.prefix) {
+			return entry.info, true
+		}
+	}
+	return FileTypeInfo{}, false
+}
+
+// mimeCategoryPredicate возвращает условие отбора файлов категории по заявленному MIME типу.
+// В отличие от ResolveFileType расширение имени не учитывается, поэтому OTHER не поддерживается.
+func mimeCategoryPredicate(category FileCategory) predicate.File {
+	registered := make([]string, 0, len(mimeTypeRegistry))
+	exact := make([]string, 0)
+	for mimeType, info := range mimeTypeRegistry {
+		registered = append(registered, mimeType)
+		if info.Category == category {
+			exact = append(exact, mimeType)
+		}
+	}
+	sort.Strings(registered)
+	sort.Strings(exact)
+
+	predicates := make([]predicate.File, 0, len(mimePrefixRegistry)+1)
+	if len(exact) > 0 {
+		predicates = append(predicates, file.MimeTypeIn(exact...))
+	}
+	for _, entry := range mimePrefixRegistry {
+		if entry.info.Category != category {
+			continue
+		}
+		// Точные соответствия реестра имеют приоритет над семейством (text/csv - таблица, а не текст)
+		predicates = append(predicates, file.And(file.MimeTypeHasPrefix(entry.prefix), file.MimeTypeNotIn(registered...)))
+	}
+	return file.Or(predicates...)
+}
+
+// FileCategoryLabel возвращает локализованное название категории
+func FileCategoryLabel(ctx context.Context, category FileCategory) string {
+	switch category {
+	case FileCategoryImage:
+		return utils.T(ctx, "file.category.image")
+	case FileCategoryVideo:
+		return utils.T(ctx, "file.category.video")
+	case FileCategoryAudio:
+		return utils.T(ctx, "file.category.audio")
+	case FileCategoryPDF:
+		return utils.T(ctx, "file.category.pdf")
+	case FileCategoryDocument:
+		return utils.T(ctx, "file.category.document")
+	case FileCategorySpreadsheet:
+		return utils.T(ctx, "file.category.spreadsheet")
+	case FileCategoryPresentation:
+		return utils.T(ctx, "file.category.presentation")
+	case FileCategoryArchive:
+		return utils.T(ctx, "file.category.archive")
+	case FileCategoryCode:
+		return utils.T(ctx, "file.category.code")
+	case FileCategoryText:
+		return utils.T(ctx, "file.category.text")
+	default:
+		return utils.T(ctx, "file.category.uncategorized")
+	}
+}
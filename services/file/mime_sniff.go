@@ -0,0 +1,158 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"main/errcatalog"
+	"main/utils"
+	"mime"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// mimeSniffSize количество первых байт файла, по которым определяется тип содержимого
+const mimeSniffSize = 512
+
+// executableSignatures сигнатуры исполняемых файлов; такие файлы не принимаются независимо от заявленного типа
+var executableSignatures = []struct {
+	magic    []byte
+	mimeType string
+}{
+	{[]byte("MZ"), "application/x-msdownload"},
+	{[]byte("\x7fELF"), "application/x-executable"},
+	{[]byte{0xfe, 0xed, 0xfa, 0xce}, "application/x-mach-binary"},
+	{[]byte{0xfe, 0xed, 0xfa, 0xcf}, "application/x-mach-binary"},
+	{[]byte{0xce, 0xfa, 0xed, 0xfe}, "application/x-mach-binary"},
+	{[]byte{0xcf, 0xfa, 0xed, 0xfe}, "application/x-mach-binary"},
+}
+
+// scriptSignature начало сценария с интерпретатором; сценарии принимаются только с текстовым заявленным типом
+var scriptSignature = []byte("#!")
+
+// oleSignature сигнатура составных документов OLE (doc, xls, ppt)
+var oleSignature = []byte{0xd0, 0xcf, 0x11, 0xe0, 0xa1, 0xb1, 0x1a, 0xe1}
+
+// declaredMimeRequirements заявленные типы, содержимое которых обязано соответствовать сигнатуре:
+// ключ - заявленный тип, значение - допустимые обнаруженные типы (или семейство с "/" на конце)
+var declaredMimeRequirements = map[string][]string{
+	"application/pdf": {"application/pdf"},
+
+	"image/jpeg": {"image/"},
+	"image/png":  {"image/"},
+	"image/gif":  {"image/"},
+	"image/webp": {"image/"},
+	"image/bmp":  {"image/"},
+
+	"application/zip": {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         {"application/zip"},
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": {"application/zip"},
+	"application/vnd.oasis.opendocument.text":                                   {"application/zip"},
+	"application/vnd.oasis.opendocument.spreadsheet":                            {"application/zip"},
+	"application/vnd.oasis.opendocument.presentation":                           {"application/zip"},
+
+	"application/msword":            {"application/x-ole-storage", "application/zip"},
+	"application/vnd.ms-excel":      {"application/x-ole-storage", "application/zip"},
+	"application/vnd.ms-powerpoint": {"application/x-ole-storage", "application/zip"},
+
+	"text/plain": {"text/"},
+	"text/csv":   {"text/"},
+}
+
+// DetectMimeType определяет тип содержимого по первым байтам файла (без параметров вроде charset)
+func DetectMimeType(head []byte) string {
+	detected := http.DetectContentType(head)
+
+	for _, signature := range executableSignatures {
+		// Короткая сигнатура "MZ" встречается и в начале обычного текста
+		if bytes.HasPrefix(head, signature.magic) && !strings.HasPrefix(detected, "text/") {
+			return signature.mimeType
+		}
+	}
+	if bytes.HasPrefix(head, scriptSignature) {
+		return "text/x-shellscript"
+	}
+	if bytes.HasPrefix(head, oleSignature) {
+		return "application/x-ole-storage"
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(detected); err == nil {
+		return mediaType
+	}
+	return detected
+}
+
+// isExecutableMimeType проверяет, что обнаруженный тип относится к исполняемым файлам
+func isExecutableMimeType(mimeType string) bool {
+	for _, signature := range executableSignatures {
+		if signature.mimeType == mimeType {
+			return true
+		}
+	}
+	return false
+}
+
+// validateContentType сверяет заявленный тип файла с типом, обнаруженным по содержимому, и возвращает
+// обнаруженный тип. Исполняемые файлы отклоняются всегда, остальные - если заявленный тип
+// требует определенной сигнатуры, а содержимое ей не соответствует (например, exe, переименованный в jpg).
+func validateContentType(ctx context.Context, declared, filename string, head []byte) (string, error) {
+	detected := DetectMimeType(head)
+
+	declaredType, _, err := mime.ParseMediaType(declared)
+	if err != nil {
+		declaredType = strings.ToLower(strings.TrimSpace(declared))
+	}
+
+	allowed, strict := declaredMimeRequirements[declaredType]
+	matches := !strict
+	for _, prefix := range allowed {
+		if detected == prefix || (strings.HasSuffix(prefix, "/") && strings.HasPrefix(detected, prefix)) {
+			matches = true
+			break
+		}
+	}
+
+	if isExecutableMimeType(detected) || !matches {
+		utils.Logger.Warn("Upload content does not match declared type",
+			zap.String("filename", filename),
+			zap.String("declared_mime_type", declaredType),
+			zap.String("detected_mime_type", detected))
+		return detected, errcatalog.FileContentTypeMismatch(ctx)
+	}
+	return detected, nil
+}
+
+// readSniffHead читает начало потока для определения типа содержимого
+func readSniffHead(r io.Reader) ([]byte, error) {
+	head := make([]byte, mimeSniffSize)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	return head[:n], nil
+}
+
+// sniffUploadHead читает начало загружаемого файла и возвращает поток в начало
+func sniffUploadHead(file io.ReadSeeker) ([]byte, error) {
+	head, err := readSniffHead(file)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return head, nil
+}
+
+// sniffObjectHead читает начало объекта в S3
+func (s *FileService) sniffObjectHead(ctx context.Context, storageKey string) ([]byte, error) {
+	object, err := s.s3Service.GetFileObject(ctx, storageKey)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	return readSniffHead(object)
+}
@@ -0,0 +1,160 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/predicate"
+	"main/errcatalog"
+	"main/services/audit"
+	"main/utils"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxObjectLockYears максимальный срок удержания объекта (ограничение S3 - 100 лет)
+const maxObjectLockYears = 100
+
+// ObjectLockInput удержание объекта файла в S3 Object Lock (WORM). Mode и RetainUntil задаются вместе;
+// незаданные поля не меняют текущее удержание.
+type ObjectLockInput struct {
+	Mode        *file.ObjectLockMode
+	RetainUntil *time.Time
+	// LegalHold включает или снимает legal hold независимо от срока удержания
+	LegalHold *bool
+}
+
+// ObjectLockReleased возвращает условие выборки файлов без действующего удержания: legal hold снят,
+// срок удержания не задан или истек. Только такие файлы перемещаются в корзину и удаляются окончательно.
+func ObjectLockReleased(now time.Time) predicate.File {
+	return file.And(
+		file.LegalHold(false),
+		file.Or(file.ObjectLockRetainUntilIsNil(), file.ObjectLockRetainUntilLTE(now)),
+	)
+}
+
+// objectLockActive сообщает, удерживается ли объект файла в S3 на момент now
+func objectLockActive(fileRecord *ent.File, now time.Time) bool {
+	return fileRecord.LegalHold || (fileRecord.ObjectLockRetainUntil != nil && fileRecord.ObjectLockRetainUntil.After(now))
+}
+
+// ensureObjectLockAllowsDelete запрещает удаление файла под удержанием, в том числе администраторам
+func ensureObjectLockAllowsDelete(ctx context.Context, fileRecord *ent.File) error {
+	if objectLockActive(fileRecord, time.Now()) {
+		return errcatalog.FileObjectLocked(ctx)
+	}
+	return nil
+}
+
+// validateObjectLockInput проверяет удержание, заданное при загрузке или мутацией setFileObjectLock.
+// Удержание задают администраторы и только для бакета с S3 Object Lock (S3_OBJECT_LOCK_ENABLED).
+func (s *FileService) validateObjectLockInput(ctx context.Context, input *ObjectLockInput) error {
+	if input == nil {
+		return nil
+	}
+	if !s.hasAdminRole(ctx) {
+		return errcatalog.FileObjectLockPermissionDenied(ctx)
+	}
+	if !s.s3Service.ObjectLockEnabled(ctx) {
+		return errcatalog.FileObjectLockNotSupported(ctx)
+	}
+	if (input.Mode == nil) != (input.RetainUntil == nil) || (input.Mode == nil && input.LegalHold == nil) {
+		return errcatalog.FileObjectLockInvalid(ctx, maxObjectLockYears)
+	}
+	if input.Mode != nil {
+		if err := file.ObjectLockModeValidator(*input.Mode); err != nil {
+			return errcatalog.FileObjectLockInvalid(ctx, maxObjectLockYears)
+		}
+		now := time.Now()
+		if !input.RetainUntil.After(now) || input.RetainUntil.After(now.AddDate(maxObjectLockYears, 0, 0)) {
+			return errcatalog.FileObjectLockInvalid(ctx, maxObjectLockYears)
+		}
+	}
+	return nil
+}
+
+// applyObjectLock задает удержание объекта в S3. bypassGovernance разрешает сократить удержание GOVERNANCE.
+func (s *FileService) applyObjectLock(ctx context.Context, storageKey string, input *ObjectLockInput, bypassGovernance bool) error {
+	if input.Mode != nil {
+		if err := s.s3Service.PutObjectRetention(ctx, storageKey, input.Mode.String(), *input.RetainUntil, bypassGovernance); err != nil {
+			utils.Logger.Error("Failed to set object retention", zap.Error(err), zap.String("storage_key", storageKey))
+			return errcatalog.FileObjectLockFailed(ctx)
+		}
+	}
+	if input.LegalHold != nil {
+		if err := s.s3Service.PutObjectLegalHold(ctx, storageKey, *input.LegalHold); err != nil {
+			utils.Logger.Error("Failed to set object legal hold", zap.Error(err), zap.String("storage_key", storageKey))
+			return errcatalog.FileObjectLockFailed(ctx)
+		}
+	}
+	return nil
+}
+
+// SetFileObjectLock задает удержание объекта файла в S3 и отражает его в записи файла.
+// Удержание COMPLIANCE нельзя сократить или перевести в GOVERNANCE; сокращение удержания GOVERNANCE
+// выполняется с обходом (x-amz-bypass-governance-retention) и требует соответствующего права в S3.
+func (s *FileService) SetFileObjectLock(ctx context.Context, client *ent.Client, fileID uuid.UUID, input ObjectLockInput) (*ent.File, error) {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+	if err := s.validateObjectLockInput(ctx, &input); err != nil {
+		return nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	fileRecord, err := client.File.Get(ctxWithClient, fileID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, errcatalog.FileGetFailed(ctx)
+	}
+
+	bypassGovernance := false
+	retained := fileRecord.ObjectLockMode != nil && fileRecord.ObjectLockRetainUntil != nil &&
+		fileRecord.ObjectLockRetainUntil.After(time.Now())
+	if input.Mode != nil && retained {
+		weakened := *input.Mode != *fileRecord.ObjectLockMode || input.RetainUntil.Before(*fileRecord.ObjectLockRetainUntil)
+		if weakened && *fileRecord.ObjectLockMode == file.ObjectLockModeCOMPLIANCE {
+			return nil, errcatalog.FileObjectLockComplianceActive(ctx)
+		}
+		bypassGovernance = weakened
+	}
+
+	if err := s.applyObjectLock(ctx, fileRecord.StorageKey, &input, bypassGovernance); err != nil {
+		return nil, err
+	}
+
+	update := client.File.UpdateOneID(fileID).
+		SetNillableLegalHold(input.LegalHold)
+	if input.Mode != nil {
+		update = update.
+			SetObjectLockMode(*input.Mode).
+			SetObjectLockRetainUntil(*input.RetainUntil)
+	}
+	updated, err := update.Save(ctxWithClient)
+	if err != nil {
+		utils.Logger.Error("Failed to save file object lock", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, errcatalog.FileObjectLockFailed(ctx)
+	}
+
+	// 📊 [AUDIT] Фиксируем итоговое удержание и сокращение удержания GOVERNANCE
+	details := map[string]interface{}{"legal_hold": updated.LegalHold}
+	if updated.ObjectLockMode != nil {
+		details["mode"] = updated.ObjectLockMode.String()
+		details["retain_until"] = updated.ObjectLockRetainUntil
+	}
+	if bypassGovernance {
+		details["bypass_governance"] = true
+	}
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:  fileauditevent.ActionOBJECT_LOCK_UPDATED,
+		FileID:  &fileID,
+		Details: details,
+	})
+
+	return updated, nil
+}
@@ -0,0 +1,326 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"main/converter"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/privacy"
+	"main/utils"
+	"mime"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultPDFRenditionInterval интервал проверки документов, ожидающих конвертации в PDF
+	defaultPDFRenditionInterval = time.Minute
+	// pdfRenditionBatchSize количество документов, конвертируемых за один проход
+	pdfRenditionBatchSize = 5
+	// pdfRenditionBatchTimeout максимальное время конвертации одной пачки документов
+	pdfRenditionBatchTimeout = 30 * time.Minute
+	// pdfRenditionMaxSourceSize максимальный размер исходного документа
+	pdfRenditionMaxSourceSize = 100 << 20
+	// pdfRenditionMaxAttempts количество попыток конвертации документа при временных ошибках
+	pdfRenditionMaxAttempts = 5
+	// pdfRenditionRetryDelay задержка после первой временной ошибки; удваивается с каждой попыткой
+	pdfRenditionRetryDelay = time.Minute
+	// pdfRenditionMaxRetryDelay максимальная задержка между попытками
+	pdfRenditionMaxRetryDelay = time.Hour
+)
+
+// errPDFSourceTooLarge исходный документ больше pdfRenditionMaxSourceSize
+var errPDFSourceTooLarge = errors.New("source document is too large")
+
+// pdfRenditionWakeup будит конвертер сразу после загрузки документа
+var pdfRenditionWakeup = make(chan struct{}, 1)
+
+// supportsPDFRendition проверяет, создается ли PDF-версия для MIME типа: конвертируются документы Office,
+// которые браузер не показывает сам, и только при включенном конвертере (PDF_CONVERTER)
+func (s *FileService) supportsPDFRendition(mimeType string) bool {
+	if s.pdfConverter == nil {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	return previewOfficeMimeTypes[strings.ToLower(mediaType)]
+}
+
+// initialPDFStatus возвращает состояние PDF-версии документа для нового файла
+func (s *FileService) initialPDFStatus(mimeType string) file.PdfStatus {
+	if s.supportsPDFRendition(mimeType) {
+		return file.PdfStatusPENDING
+	}
+	return file.PdfStatusNONE
+}
+
+// requestPDFRenditions сообщает конвертеру о новых документах (без блокировки)
+func requestPDFRenditions() {
+	select {
+	case pdfRenditionWakeup <- struct{}{}:
+	default:
+	}
+}
+
+// PDFRenditionStorageKey возвращает ключ PDF-версии документа, производный от ключа исходного файла
+func PDFRenditionStorageKey(storageKey string) string {
+	return storageKey + ".rendition.pdf"
+}
+
+// GetPDFURL возвращает временную ссылку на PDF-версию документа или nil, если она еще не готова
+// или не создается (в том числе для исходных PDF, которые открываются ссылкой на скачивание)
+func (s *FileService) GetPDFURL(ctx context.Context, fileRecord *ent.File) (*string, error) {
+	if fileRecord.PdfStatus != file.PdfStatusREADY {
+		return nil, nil
+	}
+
+	// 🔐 [ENCRYPTION] Зашифрованная PDF-версия отдается сервисом
+	if s.s3Service.EncryptionEnabled() {
+		url := fileProxyURL(fileRecord.ID, "/pdf", nil)
+		return &url, nil
+	}
+
+	url, err := s.s3Service.GetPresignedURL(ctx, PDFRenditionStorageKey(fileRecord.StorageKey), DefaultPresignedURLExpiration)
+	if err != nil {
+		utils.Logger.Error("Failed to generate PDF rendition URL",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()))
+		return nil, errcatalog.FilePDFURLFailed(ctx)
+	}
+	return &url, nil
+}
+
+// ConvertPDFRenditions создает PDF-версии документов всех тенантов, ожидающих конвертации.
+// Ошибка отдельного документа не прерывает обработку остальных: после временной ошибки (S3, сервер
+// конвертации) документ остается в PENDING и повторяется с нарастающей задержкой, после ошибки самого
+// документа или исчерпания pdfRenditionMaxAttempts попыток переводится в FAILED.
+func (s *FileService) ConvertPDFRenditions(ctx context.Context, client *ent.Client, limit int) (int, int, error) {
+	systemCtx, cancel := mixin.SkipTenantFilterFor(ent.NewContext(privacy.WithSystemContext(ctx), client),
+		"convert documents to PDF for all tenants", pdfRenditionBatchTimeout)
+	defer cancel()
+
+	now := time.Now()
+	files, err := client.File.Query().
+		// Документы, ожидающие антивирусной проверки или зараженные, не передаются конвертеру
+		Where(
+			file.PdfStatusEQ(file.PdfStatusPENDING),
+			file.ScanStatusIn(file.ScanStatusNONE, file.ScanStatusCLEAN),
+			file.Or(file.PdfRetryAtIsNil(), file.PdfRetryAtLTE(now)),
+		).
+		Order(ent.Asc(file.FieldCreateTime), ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(systemCtx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to select files for PDF conversion: %w", err)
+	}
+
+	processed, failed := 0, 0
+	for _, fileRecord := range files {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Документ мог быть удален или перенесен во время конвертации - обновляем только ожидающие
+		update := client.File.Update().
+			Where(
+				file.ID(fileRecord.ID),
+				file.StorageKey(fileRecord.StorageKey),
+				file.PdfStatusEQ(file.PdfStatusPENDING),
+			)
+		convertErr := s.convertFileToPDF(ctx, fileRecord)
+		switch attempts := fileRecord.PdfAttempts + 1; {
+		case convertErr == nil:
+			update.SetPdfStatus(file.PdfStatusREADY).SetPdfAttempts(0).ClearPdfRetryAt()
+		case pdfConversionRetryable(convertErr) && attempts < pdfRenditionMaxAttempts:
+			retryAt := time.Now().Add(pdfRenditionRetryBackoff(attempts))
+			utils.Logger.Warn("Failed to convert document to PDF, retrying later",
+				zap.Error(convertErr),
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.Int("attempt", attempts),
+				zap.Time("retry_at", retryAt),
+				zap.String("converter", s.pdfConverter.Name()))
+			update.SetPdfAttempts(attempts).SetPdfRetryAt(retryAt)
+			failed++
+		default:
+			utils.Logger.Warn("Failed to convert document to PDF",
+				zap.Error(convertErr),
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("mime_type", fileRecord.MimeType),
+				zap.Int("attempt", attempts),
+				zap.String("converter", s.pdfConverter.Name()))
+			update.SetPdfStatus(file.PdfStatusFAILED).SetPdfAttempts(attempts).ClearPdfRetryAt()
+			failed++
+		}
+
+		updated, err := update.Save(systemCtx)
+		if err != nil {
+			return processed, failed, fmt.Errorf("failed to update PDF status: %w", err)
+		}
+		if updated == 0 && convertErr == nil {
+			s.deleteOrphanedPDFRendition(systemCtx, client, fileRecord)
+		}
+		processed++
+	}
+
+	return processed, failed, nil
+}
+
+// pdfConversionRetryable сообщает, можно ли повторить конвертацию после ошибки: ошибки S3, сети и таймауты
+// временные, отказ конвертера обработать документ и слишком большой документ - нет
+func pdfConversionRetryable(err error) bool {
+	if errors.Is(err, errPDFSourceTooLarge) {
+		return false
+	}
+	var convertErr *converter.ConvertError
+	if errors.As(err, &convertErr) {
+		return convertErr.Temporary
+	}
+	return true
+}
+
+// pdfRenditionRetryBackoff возвращает задержку перед попыткой после attempts неудачных
+func pdfRenditionRetryBackoff(attempts int) time.Duration {
+	delay := pdfRenditionRetryDelay
+	for i := 1; i < attempts && delay < pdfRenditionMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, pdfRenditionMaxRetryDelay)
+}
+
+// deleteOrphanedPDFRendition удаляет PDF-версию, сохраненную для файла, который за время конвертации
+// был удален, перенесен под другой ключ или уже получил PDF-версию в другом экземпляре сервиса.
+// В последнем случае объект под тем же ключом используется и не удаляется.
+func (s *FileService) deleteOrphanedPDFRendition(ctx context.Context, client *ent.Client, fileRecord *ent.File) {
+	current, err := client.File.Query().Where(file.ID(fileRecord.ID)).Only(mixin.SkipSoftDelete(ctx))
+	if err != nil && !ent.IsNotFound(err) {
+		utils.Logger.Warn("Failed to check file of converted PDF rendition",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()))
+		return
+	}
+	if current != nil && current.StorageKey == fileRecord.StorageKey && current.PdfStatus == file.PdfStatusREADY {
+		return
+	}
+
+	key := PDFRenditionStorageKey(fileRecord.StorageKey)
+	if err := s.s3Service.DeleteFile(ctx, key); err != nil {
+		utils.Logger.Warn("Failed to delete orphaned PDF rendition",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()),
+			zap.String("storage_key", key))
+	}
+}
+
+// convertFileToPDF передает конвертеру содержимое документа из S3 и сохраняет PDF рядом с исходным файлом
+func (s *FileService) convertFileToPDF(ctx context.Context, fileRecord *ent.File) error {
+	if fileRecord.Size > pdfRenditionMaxSourceSize {
+		return fmt.Errorf("%w: %d bytes", errPDFSourceTooLarge, fileRecord.Size)
+	}
+
+	object, err := s.s3Service.GetFileObject(ctx, fileRecord.StorageKey)
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+
+	// PDF собирается во временном файле: S3 нужен поток с перемоткой
+	output, err := os.CreateTemp("", "file-rendition-*.pdf")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(output.Name())
+	defer output.Close()
+
+	if err := s.pdfConverter.ConvertToPDF(ctx, io.LimitReader(object, pdfRenditionMaxSourceSize), fileRecord.OriginalName, output); err != nil {
+		return err
+	}
+	if _, err := output.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind converted document: %w", err)
+	}
+	return s.s3Service.PutObject(ctx, PDFRenditionStorageKey(fileRecord.StorageKey), output, "application/pdf")
+}
+
+// deletePDFRendition удаляет PDF-версию документа из S3; ошибки только логируются
+func (s *FileService) deletePDFRendition(ctx context.Context, fileRecord *ent.File) {
+	if fileRecord.PdfStatus != file.PdfStatusREADY {
+		return
+	}
+	key := PDFRenditionStorageKey(fileRecord.StorageKey)
+	if err := s.s3Service.DeleteFile(ctx, key); err != nil {
+		utils.Logger.Warn("Failed to delete document PDF rendition",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()),
+			zap.String("storage_key", key))
+	}
+}
+
+// StartPDFRenditionConverter запускает фоновую конвертацию документов Office в PDF.
+// Конвертер задается PDF_CONVERTER (libreoffice или gotenberg, без него конвертация отключена),
+// проверка выполняется с интервалом PDF_CONVERSION_INTERVAL (по умолчанию 1m, "0" отключает конвертацию)
+// и сразу после загрузки документов.
+func StartPDFRenditionConverter(ctx context.Context, getClient func() *ent.Client) {
+	interval := defaultPDFRenditionInterval
+	if value := os.Getenv("PDF_CONVERSION_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			utils.Logger.Info("PDF rendition converter is disabled")
+			return
+		}
+		interval = parsed
+	}
+
+	service := NewFileService()
+	if service.pdfConverter == nil {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-pdfRenditionWakeup:
+			}
+
+			client := getClient()
+			if client == nil {
+				continue
+			}
+
+			// Обрабатываем пачками, пока есть ожидающие документы
+			for ctx.Err() == nil {
+				processed, failed, err := service.ConvertPDFRenditions(ctx, client, pdfRenditionBatchSize)
+				if err != nil {
+					utils.Logger.Error("PDF rendition conversion failed", zap.Error(err))
+					break
+				}
+				if processed > 0 {
+					utils.Logger.Debug("PDF renditions converted",
+						zap.Int("processed", processed),
+						zap.Int("failed", failed))
+				}
+				if processed < pdfRenditionBatchSize {
+					break
+				}
+			}
+		}
+	}()
+
+	utils.Logger.Info("PDF rendition converter started",
+		zap.Duration("interval", interval),
+		zap.String("converter", service.pdfConverter.Name()))
+}
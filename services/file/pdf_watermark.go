@@ -0,0 +1,285 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"main/ent"
+	"main/errcatalog"
+	"main/s3"
+	"main/services/batch"
+	"main/utils"
+	"mime"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"go.uber.org/zap"
+)
+
+const (
+	// UserEmailHeader заголовок, которым gateway передает email пользователя (текст водяного знака)
+	UserEmailHeader = "X-User-Email"
+	// pdfWatermarkKeyInfix часть ключа копий с водяным знаком, по которой они удаляются вместе с исходным файлом
+	pdfWatermarkKeyInfix = ".wm-"
+	// pdfWatermarkTimeout максимальное время наложения водяного знака на один документ
+	pdfWatermarkTimeout = time.Minute
+	// maxUserEmailLength максимальная длина email в тексте водяного знака
+	maxUserEmailLength = 254
+)
+
+// userEmailKey ключ контекста с email пользователя
+type userEmailKey struct{}
+
+// WithUserEmail сохраняет в контексте email пользователя, переданный gateway
+func WithUserEmail(ctx context.Context, email string) context.Context {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return ctx
+	}
+	if len(email) > maxUserEmailLength {
+		email = email[:maxUserEmailLength]
+	}
+	return context.WithValue(ctx, userEmailKey{}, email)
+}
+
+// userEmailFromContext возвращает email пользователя или пустую строку, если gateway его не передал
+func userEmailFromContext(ctx context.Context) string {
+	email, _ := ctx.Value(userEmailKey{}).(string)
+	return email
+}
+
+// watermarkTool программа наложения водяного знака, определяется один раз при первом обращении
+var watermarkTool struct {
+	once sync.Once
+	path string
+}
+
+// resolveWatermarkTool находит qpdf (WATERMARK_PDF_COMMAND); пустая строка - программа не установлена
+func resolveWatermarkTool() string {
+	watermarkTool.once.Do(func() {
+		command := os.Getenv("WATERMARK_PDF_COMMAND")
+		if command == "" {
+			command = "qpdf"
+		}
+		if path, err := exec.LookPath(command); err == nil {
+			watermarkTool.path = path
+		}
+	})
+	return watermarkTool.path
+}
+
+// pdfWatermarkCopy копия PDF документа с водяным знаком текущего пользователя
+type pdfWatermarkCopy struct {
+	StorageKey string
+	Size       int64
+}
+
+// isPDFFile проверяет, является ли файл PDF документом по типу содержимого или заявленному типу
+func isPDFFile(fileRecord *ent.File) bool {
+	for _, mimeType := range []string{fileRecord.DetectedMimeType, fileRecord.MimeType} {
+		if mediaType, _, err := mime.ParseMediaType(mimeType); err == nil && strings.EqualFold(mediaType, "application/pdf") {
+			return true
+		}
+	}
+	return false
+}
+
+// pdfWatermarkFor возвращает копию с водяным знаком, если ее запросил клиент (requested) или требует тенант,
+// и nil, если отдается исходный файл. Для тенанта с обязательным водяным знаком другие типы файлов
+// отдаются без изменений, явный запрос для них отклоняется.
+func (s *FileService) pdfWatermarkFor(ctx context.Context, client *ent.Client, fileRecord *ent.File, requested bool) (*pdfWatermarkCopy, error) {
+	required, err := s.watermarkService.IsPDFWatermarkRequired(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if !requested && !required {
+		return nil, nil
+	}
+	if !isPDFFile(fileRecord) {
+		if requested {
+			return nil, errcatalog.FileWatermarkUnsupported(ctx)
+		}
+		return nil, nil
+	}
+	return s.ensurePDFWatermark(ctx, fileRecord)
+}
+
+// filterWatermarkRequiredFiles исключает PDF документы из архива, если тенант требует водяной знак:
+// такие документы скачиваются по одному, остальные файлы архивируются без изменений
+func (s *FileService) filterWatermarkRequiredFiles(ctx context.Context, client *ent.Client, files []*ent.File, items *batch.Result) ([]*ent.File, error) {
+	required, err := s.watermarkService.IsPDFWatermarkRequired(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if !required {
+		return files, nil
+	}
+	allowed := make([]*ent.File, 0, len(files))
+	for _, fileRecord := range files {
+		if isPDFFile(fileRecord) {
+			items.Fail(ctx, fileRecord.ID, errcatalog.FileWatermarkArchiveExcluded(ctx))
+			continue
+		}
+		allowed = append(allowed, fileRecord)
+	}
+	return allowed, nil
+}
+
+// ensurePDFWatermark возвращает копию документа с email пользователя и временем на каждой странице.
+// Копия строится при первом запросе пользователя за день и сохраняется в S3 под производным ключом;
+// копии предыдущих дней удаляются при построении новой.
+func (s *FileService) ensurePDFWatermark(ctx context.Context, fileRecord *ent.File) (*pdfWatermarkCopy, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+	now := time.Now().UTC()
+	userPrefix := fmt.Sprintf("%s%s%s-", fileRecord.StorageKey, pdfWatermarkKeyInfix, userID.String())
+	key := userPrefix + now.Format("20060102") + ".pdf"
+
+	if info, err := s.s3Service.GetFileInfo(ctx, key); err == nil && info.ContentLength != nil {
+		return &pdfWatermarkCopy{StorageKey: key, Size: *info.ContentLength}, nil
+	} else if err != nil && !s3.IsNotFoundError(err) {
+		utils.Logger.Warn("Failed to check cached watermarked copy", zap.Error(err), zap.String("storage_key", key))
+	}
+
+	tool := resolveWatermarkTool()
+	if tool == "" {
+		return nil, errcatalog.FileWatermarkUnavailable(ctx)
+	}
+	if fileRecord.Size > previewMaxSourceSize {
+		utils.Logger.Warn("Document is too large for watermarking",
+			zap.String("file_id", fileRecord.ID.String()),
+			zap.Int64("size", fileRecord.Size))
+		return nil, errcatalog.FileWatermarkFailed(ctx)
+	}
+
+	identity := userEmailFromContext(ctx)
+	if identity == "" {
+		identity = userID.String()
+	}
+	text := fmt.Sprintf("%s  %s UTC", identity, now.Format("2006-01-02 15:04"))
+
+	watermarked, err := s.renderPDFWatermark(ctx, tool, fileRecord, text)
+	if err != nil {
+		utils.Logger.Warn("Failed to watermark document",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()))
+		if strings.Contains(err.Error(), "S3 credentials are not configured") {
+			return nil, errcatalog.FileS3NotConfigured(ctx)
+		}
+		return nil, errcatalog.FileWatermarkFailed(ctx)
+	}
+
+	// Копия пользователя за прошлые дни больше не выдается
+	if _, err := s.s3Service.DeleteObjectsWithPrefix(ctx, userPrefix); err != nil {
+		utils.Logger.Warn("Failed to delete outdated watermarked copies", zap.Error(err), zap.String("storage_key", userPrefix))
+	}
+	if err := s.s3Service.PutObject(ctx, key, bytes.NewReader(watermarked), "application/pdf"); err != nil {
+		utils.Logger.Error("Failed to store watermarked copy", zap.Error(err), zap.String("storage_key", key))
+		return nil, errcatalog.FileWatermarkFailed(ctx)
+	}
+
+	return &pdfWatermarkCopy{StorageKey: key, Size: int64(len(watermarked))}, nil
+}
+
+// renderPDFWatermark скачивает документ во временный каталог и накладывает на все страницы
+// страницу-штамп с текстом (qpdf --overlay)
+func (s *FileService) renderPDFWatermark(ctx context.Context, tool string, fileRecord *ent.File, text string) ([]byte, error) {
+	workDir, err := os.MkdirTemp("", "file-watermark-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	sourcePath := filepath.Join(workDir, "source.pdf")
+	if err := s.downloadToFile(ctx, fileRecord.StorageKey, sourcePath); err != nil {
+		return nil, err
+	}
+	stampPath := filepath.Join(workDir, "stamp.pdf")
+	if err := os.WriteFile(stampPath, buildWatermarkStamp(text), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write watermark stamp: %w", err)
+	}
+
+	renderCtx, cancel := context.WithTimeout(ctx, pdfWatermarkTimeout)
+	defer cancel()
+
+	// Первая страница штампа повторяется на всех страницах документа и масштабируется по их размеру
+	outputPath := filepath.Join(workDir, "watermarked.pdf")
+	if err := runPreviewCommand(renderCtx, tool,
+		"--warning-exit-0", sourcePath,
+		"--overlay", stampPath, "--from=", "--repeat=1", "--",
+		outputPath); err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(outputPath)
+}
+
+// buildWatermarkStamp строит одностраничный PDF (A4) с полупрозрачным текстом по диагонали и в нижнем колонтитуле
+func buildWatermarkStamp(text string) []byte {
+	label := pdfStringLiteral(text)
+	content := fmt.Sprintf("q /GS1 gs 0.4 g BT /F1 18 Tf 0.7071 0.7071 -0.7071 0.7071 90 200 Tm (%s) Tj ET "+
+		"BT /F1 8 Tf 36 20 Td (%s) Tj ET Q", label, label)
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] " +
+			"/Resources << /Font << /F1 4 0 R >> /ExtGState << /GS1 5 0 R >> >> /Contents 6 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /ExtGState /ca 0.3 /CA 0.3 >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buffer.Len()
+		fmt.Fprintf(&buffer, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buffer.Len()
+	fmt.Fprintf(&buffer, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buffer, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buffer, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buffer.Bytes()
+}
+
+// pdfStringLiteral экранирует текст для строки PDF; символы вне печатного ASCII (нет в шрифте Helvetica
+// без встраивания) заменяются на "?"
+func pdfStringLiteral(text string) string {
+	var builder strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			builder.WriteByte('\\')
+			builder.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			builder.WriteByte('?')
+		default:
+			builder.WriteRune(r)
+		}
+	}
+	return builder.String()
+}
+
+// deletePDFWatermarks удаляет копии документа с водяным знаком из S3; ошибки только логируются
+func (s *FileService) deletePDFWatermarks(ctx context.Context, fileRecord *ent.File) {
+	if !isPDFFile(fileRecord) {
+		return
+	}
+	prefix := fileRecord.StorageKey + pdfWatermarkKeyInfix
+	if _, err := s.s3Service.DeleteObjectsWithPrefix(ctx, prefix); err != nil {
+		utils.Logger.Warn("Failed to delete watermarked copies",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()),
+			zap.String("storage_key", prefix))
+	}
+}
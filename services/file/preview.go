@@ -0,0 +1,415 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/privacy"
+	"main/utils"
+	"mime"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultPreviewInterval интервал проверки документов, ожидающих превью
+	defaultPreviewInterval = time.Minute
+	// defaultPreviewRenderTimeout максимальное время рендеринга одного документа
+	defaultPreviewRenderTimeout = time.Minute
+	// previewBatchSize количество документов, обрабатываемых за один проход
+	previewBatchSize = 5
+	// previewMaxSourceSize максимальный размер исходного документа
+	previewMaxSourceSize = 100 << 20
+	// previewMaxSide максимальная сторона изображения превью в пикселях
+	previewMaxSide = 1024
+	// previewResolution разрешение рендеринга страницы (DPI)
+	previewResolution = 110
+)
+
+// previewPDFMimeTypes документы, которые рендерятся напрямую (pdftoppm)
+var previewPDFMimeTypes = map[string]bool{
+	"application/pdf": true,
+}
+
+// previewOfficeMimeTypes документы Office, которые предварительно конвертируются в PDF (LibreOffice)
+var previewOfficeMimeTypes = map[string]bool{
+	"application/msword": true,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": true,
+	"application/vnd.ms-excel": true,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         true,
+	"application/vnd.ms-powerpoint":                                             true,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": true,
+	"application/vnd.oasis.opendocument.text":                                   true,
+	"application/vnd.oasis.opendocument.spreadsheet":                            true,
+	"application/vnd.oasis.opendocument.presentation":                           true,
+	"application/rtf": true,
+}
+
+// previewWakeup будит рендерер превью сразу после загрузки документа
+var previewWakeup = make(chan struct{}, 1)
+
+// previewTools внешние программы рендеринга, определяются один раз при первом обращении
+var previewTools struct {
+	once   sync.Once
+	pdf    string
+	office string
+}
+
+// resolvePreviewTools находит программы рендеринга (PREVIEW_PDF_COMMAND, PREVIEW_OFFICE_COMMAND)
+func resolvePreviewTools() (string, string) {
+	previewTools.once.Do(func() {
+		lookup := func(env, fallback string) string {
+			command := os.Getenv(env)
+			if command == "" {
+				command = fallback
+			}
+			path, err := exec.LookPath(command)
+			if err != nil {
+				return ""
+			}
+			return path
+		}
+		previewTools.pdf = lookup("PREVIEW_PDF_COMMAND", "pdftoppm")
+		if previewTools.pdf != "" {
+			previewTools.office = lookup("PREVIEW_OFFICE_COMMAND", "soffice")
+		}
+	})
+	return previewTools.pdf, previewTools.office
+}
+
+// SupportsPreview проверяет, создается ли превью для MIME типа с учетом установленных программ рендеринга
+func SupportsPreview(mimeType string) bool {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	mediaType = strings.ToLower(mediaType)
+
+	pdfTool, officeTool := resolvePreviewTools()
+	switch {
+	case previewPDFMimeTypes[mediaType]:
+		return pdfTool != ""
+	case previewOfficeMimeTypes[mediaType]:
+		return officeTool != ""
+	default:
+		return false
+	}
+}
+
+// initialPreviewStatus возвращает состояние превью документа для нового файла
+func initialPreviewStatus(mimeType string) file.PreviewStatus {
+	if SupportsPreview(mimeType) {
+		return file.PreviewStatusPENDING
+	}
+	return file.PreviewStatusNONE
+}
+
+// requestPreviews сообщает рендереру о новых документах (без блокировки)
+func requestPreviews() {
+	select {
+	case previewWakeup <- struct{}{}:
+	default:
+	}
+}
+
+// PreviewStorageKey возвращает ключ превью документа, производный от ключа исходного файла
+func PreviewStorageKey(storageKey string) string {
+	return storageKey + ".preview.jpg"
+}
+
+// GetPreviewURL возвращает временную ссылку на превью документа или nil, если превью еще не готово
+func (s *FileService) GetPreviewURL(ctx context.Context, fileRecord *ent.File) (*string, error) {
+	if fileRecord.PreviewStatus != file.PreviewStatusREADY {
+		return nil, nil
+	}
+
+	// 🔐 [ENCRYPTION] Зашифрованное превью отдается сервисом
+	if s.s3Service.EncryptionEnabled() {
+		url := fileProxyURL(fileRecord.ID, "/preview", nil)
+		return &url, nil
+	}
+
+	url, err := s.s3Service.GetPresignedURL(ctx, PreviewStorageKey(fileRecord.StorageKey), DefaultPresignedURLExpiration)
+	if err != nil {
+		utils.Logger.Error("Failed to generate preview URL",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()))
+		return nil, errcatalog.FilePreviewURLFailed(ctx)
+	}
+	return &url, nil
+}
+
+// RenderPreviews создает превью первой страницы для документов всех тенантов, ожидающих рендеринга.
+// Ошибка отдельного документа переводит его в FAILED и не прерывает обработку остальных.
+func (s *FileService) RenderPreviews(ctx context.Context, client *ent.Client, limit int, timeout time.Duration) (int, int, error) {
+	// Рендеринг каждого документа ограничен timeout, плюс запас на обращения к БД
+	systemCtx, cancel := mixin.SkipTenantFilterFor(ent.NewContext(privacy.WithSystemContext(ctx), client),
+		"render document previews for all tenants", time.Duration(limit)*timeout+time.Minute)
+	defer cancel()
+
+	files, err := client.File.Query().
+		// Документы, ожидающие антивирусной проверки или зараженные, не передаются программам рендеринга
+		Where(
+			file.PreviewStatusEQ(file.PreviewStatusPENDING),
+			file.ScanStatusIn(file.ScanStatusNONE, file.ScanStatusCLEAN),
+		).
+		Order(ent.Asc(file.FieldCreateTime), ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(systemCtx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to select files for previews: %w", err)
+	}
+
+	processed, failed := 0, 0
+	for _, fileRecord := range files {
+		if ctx.Err() != nil {
+			break
+		}
+
+		status := file.PreviewStatusREADY
+		if err := s.renderFilePreview(ctx, fileRecord, timeout); err != nil {
+			utils.Logger.Warn("Failed to render document preview",
+				zap.Error(err),
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("mime_type", fileRecord.MimeType))
+			status = file.PreviewStatusFAILED
+			failed++
+		}
+
+		// Документ мог быть удален или перенесен во время рендеринга - обновляем только ожидающие
+		if _, err := client.File.Update().
+			Where(file.ID(fileRecord.ID), file.PreviewStatusEQ(file.PreviewStatusPENDING)).
+			SetPreviewStatus(status).
+			Save(systemCtx); err != nil {
+			return processed, failed, fmt.Errorf("failed to update preview status: %w", err)
+		}
+		processed++
+	}
+
+	return processed, failed, nil
+}
+
+// renderFilePreview скачивает документ во временный каталог, рендерит первую страницу
+// и сохраняет ее в S3 как JPEG
+func (s *FileService) renderFilePreview(ctx context.Context, fileRecord *ent.File, timeout time.Duration) error {
+	if fileRecord.Size > previewMaxSourceSize {
+		return fmt.Errorf("source document is too large: %d bytes", fileRecord.Size)
+	}
+
+	workDir, err := os.MkdirTemp("", "file-preview-")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	sourcePath := filepath.Join(workDir, "source"+previewExtension(fileRecord.OriginalName))
+	if err := s.downloadToFile(ctx, fileRecord.StorageKey, sourcePath); err != nil {
+		return err
+	}
+
+	renderCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	page, err := renderFirstPage(renderCtx, workDir, sourcePath, fileRecord.MimeType)
+	if err != nil {
+		return err
+	}
+
+	var buffer bytes.Buffer
+	if err := jpeg.Encode(&buffer, resizeToFit(page, previewMaxSide), &jpeg.Options{Quality: thumbnailJPEGQuality}); err != nil {
+		return fmt.Errorf("failed to encode preview: %w", err)
+	}
+	return s.s3Service.PutObject(ctx, PreviewStorageKey(fileRecord.StorageKey), bytes.NewReader(buffer.Bytes()), "image/jpeg")
+}
+
+// downloadToFile сохраняет объект S3 в локальный файл
+func (s *FileService) downloadToFile(ctx context.Context, storageKey, path string) error {
+	object, err := s.s3Service.GetFileObject(ctx, storageKey)
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+
+	target, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer target.Close()
+
+	if _, err := io.Copy(target, io.LimitReader(object, previewMaxSourceSize)); err != nil {
+		return fmt.Errorf("failed to download document: %w", err)
+	}
+	return nil
+}
+
+// renderFirstPage рендерит первую страницу документа: Office документы сначала конвертируются в PDF
+func renderFirstPage(ctx context.Context, workDir, sourcePath, mimeType string) (image.Image, error) {
+	pdfTool, officeTool := resolvePreviewTools()
+	if pdfTool == "" {
+		return nil, fmt.Errorf("pdf renderer is not installed")
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(mimeType)
+	pdfPath := sourcePath
+	if previewOfficeMimeTypes[strings.ToLower(mediaType)] {
+		if officeTool == "" {
+			return nil, fmt.Errorf("office converter is not installed")
+		}
+		// Отдельный профиль LibreOffice: параллельные экземпляры с общим профилем блокируют друг друга
+		profile := "-env:UserInstallation=file://" + filepath.Join(workDir, "profile")
+		if err := runPreviewCommand(ctx, officeTool, profile, "--headless", "--convert-to", "pdf", "--outdir", workDir, sourcePath); err != nil {
+			return nil, err
+		}
+		pdfPath = strings.TrimSuffix(sourcePath, filepath.Ext(sourcePath)) + ".pdf"
+	}
+
+	outputBase := filepath.Join(workDir, "page")
+	if err := runPreviewCommand(ctx, pdfTool,
+		"-f", "1", "-l", "1", "-singlefile", "-png",
+		"-r", fmt.Sprint(previewResolution),
+		pdfPath, outputBase); err != nil {
+		return nil, err
+	}
+
+	pageFile, err := os.Open(outputBase + ".png")
+	if err != nil {
+		return nil, fmt.Errorf("rendered page not found: %w", err)
+	}
+	defer pageFile.Close()
+
+	page, _, err := image.Decode(pageFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode rendered page: %w", err)
+	}
+	return page, nil
+}
+
+// runPreviewCommand выполняет программу рендеринга и возвращает ошибку с ее выводом
+func runPreviewCommand(ctx context.Context, name string, args ...string) error {
+	output, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("%s timed out: %w", filepath.Base(name), ctx.Err())
+		}
+		return fmt.Errorf("%s failed: %w: %s", filepath.Base(name), err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// previewExtension возвращает расширение имени файла для временной копии (по нему LibreOffice
+// определяет формат) или пустую строку, если расширение содержит недопустимые символы
+func previewExtension(name string) string {
+	ext := strings.ToLower(filepath.Ext(name))
+	if len(ext) > 10 {
+		return ""
+	}
+	for _, r := range strings.TrimPrefix(ext, ".") {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+			return ""
+		}
+	}
+	return ext
+}
+
+// deletePreview удаляет превью документа из S3; ошибки только логируются
+func (s *FileService) deletePreview(ctx context.Context, fileRecord *ent.File) {
+	if fileRecord.PreviewStatus != file.PreviewStatusREADY {
+		return
+	}
+	key := PreviewStorageKey(fileRecord.StorageKey)
+	if err := s.s3Service.DeleteFile(ctx, key); err != nil {
+		utils.Logger.Warn("Failed to delete document preview",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()),
+			zap.String("storage_key", key))
+	}
+}
+
+// StartPreviewRenderer запускает фоновый рендеринг превью документов.
+// Проверка выполняется с интервалом PREVIEW_INTERVAL (по умолчанию 1m, "0" отключает рендеринг)
+// и сразу после загрузки документов. Время рендеринга одного документа - PREVIEW_RENDER_TIMEOUT.
+// Для PDF нужен pdftoppm (poppler), для Office документов дополнительно LibreOffice (soffice).
+func StartPreviewRenderer(ctx context.Context, getClient func() *ent.Client) {
+	interval := defaultPreviewInterval
+	if value := os.Getenv("PREVIEW_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			utils.Logger.Info("Document preview renderer is disabled")
+			return
+		}
+		interval = parsed
+	}
+
+	timeout := defaultPreviewRenderTimeout
+	if value := os.Getenv("PREVIEW_RENDER_TIMEOUT"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+			timeout = parsed
+		} else {
+			utils.Logger.Warn("Invalid PREVIEW_RENDER_TIMEOUT, using default",
+				zap.String("value", value),
+				zap.Duration("default", defaultPreviewRenderTimeout))
+		}
+	}
+
+	pdfTool, officeTool := resolvePreviewTools()
+	if pdfTool == "" {
+		utils.Logger.Info("Document preview renderer is disabled: pdftoppm is not installed")
+		return
+	}
+
+	service := NewFileService()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-previewWakeup:
+			}
+
+			client := getClient()
+			if client == nil {
+				continue
+			}
+
+			// Обрабатываем пачками, пока есть ожидающие документы
+			for ctx.Err() == nil {
+				processed, failed, err := service.RenderPreviews(ctx, client, previewBatchSize, timeout)
+				if err != nil {
+					utils.Logger.Error("Document preview rendering failed", zap.Error(err))
+					break
+				}
+				if processed > 0 {
+					utils.Logger.Debug("Document previews rendered",
+						zap.Int("processed", processed),
+						zap.Int("failed", failed))
+				}
+				if processed < previewBatchSize {
+					break
+				}
+			}
+		}
+	}()
+
+	utils.Logger.Info("Document preview renderer started",
+		zap.Duration("interval", interval),
+		zap.Duration("render_timeout", timeout),
+		zap.Bool("office_supported", officeTool != ""))
+}
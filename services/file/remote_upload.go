@@ -0,0 +1,239 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"main/ent"
+	"main/errcatalog"
+	"main/utils"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.uber.org/zap"
+)
+
+const (
+	// remoteUploadTimeout максимальное время скачивания файла по ссылке
+	remoteUploadTimeout = 2 * time.Minute
+	// remoteUploadMaxRedirects максимальное количество перенаправлений при скачивании по ссылке
+	remoteUploadMaxRedirects = 5
+	// maxRemoteUploadURLLength максимальная длина ссылки на файл
+	maxRemoteUploadURLLength = 2048
+	// remoteUploadDefaultName имя файла, если его нельзя определить по ответу и ссылке
+	remoteUploadDefaultName = "download"
+)
+
+// errRemoteAddressForbidden ссылка ведет на локальный или внутренний адрес
+var errRemoteAddressForbidden = errors.New("remote address is not public")
+
+// remoteForbiddenPrefixes служебные диапазоны, не покрытые IsGlobalUnicast и IsPrivate:
+// 0.0.0.0/8, CGNAT, протокольные и тестовые сети, NAT64 и 6to4 (могут вести на внутренние IPv4)
+var remoteForbiddenPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// remoteUploadClient HTTP клиент для загрузки по ссылке. Адрес проверяется при каждом соединении
+// (после разрешения имени и при перенаправлениях), поэтому DNS не может подменить адрес на внутренний.
+var remoteUploadClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 10 * time.Second,
+			Control: remoteDialControl,
+		}).DialContext,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= remoteUploadMaxRedirects {
+			return fmt.Errorf("stopped after %d redirects", remoteUploadMaxRedirects)
+		}
+		if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+			return fmt.Errorf("redirect to unsupported scheme %q", req.URL.Scheme)
+		}
+		return nil
+	},
+}
+
+// remoteDialControl разрешает соединения только с публичными адресами
+func remoteDialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil || !isPublicAddr(ip) {
+		return errRemoteAddressForbidden
+	}
+	return nil
+}
+
+// isPublicAddr проверяет, что адрес доступен из интернета (не локальный, не частный и не служебный)
+func isPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range remoteForbiddenPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoteFile файл, скачанный по ссылке во временный файл
+type RemoteFile struct {
+	Upload *graphql.Upload
+	// SourceURL ссылка без параметров запроса и фрагмента (для аудита: параметры могут содержать токены)
+	SourceURL string
+	path      string
+}
+
+// Close закрывает и удаляет временный файл
+func (f *RemoteFile) Close() {
+	if file, ok := f.Upload.File.(*os.File); ok {
+		file.Close()
+	}
+	if err := os.Remove(f.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		utils.Logger.Warn("Failed to remove remote upload temp file", zap.Error(err), zap.String("path", f.path))
+	}
+}
+
+// FetchRemoteFile скачивает файл по http(s) ссылке во временный файл для загрузки через UploadFile.
+// Разрешены только публичные адреса; размер ограничен действующим лимитом тенанта, тип содержимого
+// берется из ответа и сверяется с сигнатурой при загрузке. Вызывается вне транзакции.
+func (s *FileService) FetchRemoteFile(ctx context.Context, client *ent.Client, rawURL string) (*RemoteFile, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	source, err := url.Parse(rawURL)
+	if err != nil || len(rawURL) > maxRemoteUploadURLLength || (source.Scheme != "http" && source.Scheme != "https") ||
+		source.Host == "" || source.User != nil {
+		return nil, errcatalog.FileRemoteURLInvalid(ctx)
+	}
+
+	maxSize, err := s.effectiveMaxUploadSize(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, remoteUploadTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(fetchCtx, http.MethodGet, source.String(), nil)
+	if err != nil {
+		return nil, errcatalog.FileRemoteURLInvalid(ctx)
+	}
+	request.Header.Set("User-Agent", "files-service/remote-upload")
+
+	response, err := remoteUploadClient.Do(request)
+	if err != nil {
+		utils.Logger.Warn("Failed to fetch remote file", zap.Error(err), zap.String("host", source.Host))
+		if errors.Is(err, errRemoteAddressForbidden) {
+			return nil, errcatalog.FileRemoteURLForbidden(ctx)
+		}
+		return nil, errcatalog.FileRemoteFetchFailed(ctx)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		utils.Logger.Warn("Remote file responded with unexpected status",
+			zap.Int("status", response.StatusCode),
+			zap.String("host", source.Host))
+		return nil, errcatalog.FileRemoteFetchFailed(ctx)
+	}
+
+	if response.ContentLength > maxSize {
+		return nil, errcatalog.FileSizeTooLarge(ctx)
+	}
+
+	contentType := ""
+	if mediaType, _, err := mime.ParseMediaType(response.Header.Get("Content-Type")); err == nil {
+		contentType = mediaType
+	}
+
+	temp, err := os.CreateTemp("", "remote-upload-*")
+	if err != nil {
+		utils.Logger.Error("Failed to create remote upload temp file", zap.Error(err))
+		return nil, errcatalog.FileUploadFailed(ctx)
+	}
+	remote := &RemoteFile{
+		Upload: &graphql.Upload{
+			File:        temp,
+			Filename:    remoteFilename(response, contentType),
+			ContentType: contentType,
+		},
+		SourceURL: (&url.URL{Scheme: source.Scheme, Host: source.Host, Path: source.Path}).String(),
+		path:      temp.Name(),
+	}
+
+	size, err := io.Copy(temp, io.LimitReader(response.Body, maxSize+1))
+	if err != nil {
+		remote.Close()
+		utils.Logger.Warn("Failed to download remote file", zap.Error(err), zap.String("host", source.Host))
+		return nil, errcatalog.FileRemoteFetchFailed(ctx)
+	}
+	if size > maxSize {
+		remote.Close()
+		return nil, errcatalog.FileSizeTooLarge(ctx)
+	}
+	if _, err := temp.Seek(0, io.SeekStart); err != nil {
+		remote.Close()
+		return nil, errcatalog.FileUploadFailed(ctx)
+	}
+	remote.Upload.Size = size
+
+	return remote, nil
+}
+
+// remoteFilename возвращает имя файла из Content-Disposition или последнего сегмента пути ссылки
+// (после перенаправлений); без расширения оно добавляется по типу содержимого
+func remoteFilename(response *http.Response, contentType string) string {
+	name := ""
+	if _, params, err := mime.ParseMediaType(response.Header.Get("Content-Disposition")); err == nil {
+		name = params["filename"]
+	}
+	if name == "" && response.Request != nil {
+		name = path.Base(response.Request.URL.Path)
+	}
+	// Имя из ответа не должно содержать каталогов
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		name = remoteUploadDefaultName
+	}
+	if filepath.Ext(name) == "" && contentType != "" {
+		if extensions, err := mime.ExtensionsByType(contentType); err == nil && len(extensions) > 0 {
+			name += extensions[0]
+		}
+	}
+	return name
+}
+
+// uploadAuditDetails возвращает детали события загрузки; для загрузки по ссылке добавляется ее адрес
+func uploadAuditDetails(fileRecord *ent.File, sourceURL string) map[string]interface{} {
+	details := map[string]interface{}{
+		"filename": fileRecord.OriginalName,
+		"size":     fileRecord.Size,
+	}
+	if sourceURL != "" {
+		details["source_url"] = sourceURL
+	}
+	return details
+}
@@ -0,0 +1,62 @@
+package file
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"main/errcatalog"
+)
+
+// PartChecksum контрольные суммы части возобновляемой загрузки, переданные клиентом.
+// Формат как у заголовков S3: Content-MD5 и x-amz-checksum-crc32 (base64 от дайджеста).
+type PartChecksum struct {
+	// ContentMD5 base64 от 16 байт MD5 содержимого части
+	ContentMD5 *string
+	// CRC32 base64 от 4 байт CRC32 (IEEE, big-endian) содержимого части
+	CRC32 *string
+}
+
+// verifyPartChecksum считает MD5 и CRC32 части и сверяет их с переданными клиентом, чтобы поврежденная часть
+// отклонялась сразу, а не после сборки всего файла. Возвращает base64 MD5 части для проверки передачи в S3.
+// После проверки позиция чтения части возвращается в начало.
+func verifyPartChecksum(ctx context.Context, body io.ReadSeeker, checksum PartChecksum) (string, error) {
+	var expectedMD5, expectedCRC32 []byte
+	if checksum.ContentMD5 != nil {
+		decoded, err := base64.StdEncoding.DecodeString(*checksum.ContentMD5)
+		if err != nil || len(decoded) != md5.Size {
+			return "", errcatalog.FileResumableInvalidChecksum(ctx)
+		}
+		expectedMD5 = decoded
+	}
+	if checksum.CRC32 != nil {
+		decoded, err := base64.StdEncoding.DecodeString(*checksum.CRC32)
+		if err != nil || len(decoded) != crc32.Size {
+			return "", errcatalog.FileResumableInvalidChecksum(ctx)
+		}
+		expectedCRC32 = decoded
+	}
+
+	md5Hash, crcHash := md5.New(), crc32.NewIEEE()
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(io.MultiWriter(md5Hash, crcHash), body); err != nil {
+		return "", err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	actualMD5 := md5Hash.Sum(nil)
+	if expectedMD5 != nil && string(expectedMD5) != string(actualMD5) {
+		return "", errcatalog.FileResumablePartChecksumMismatch(ctx, "MD5")
+	}
+	if expectedCRC32 != nil && binary.BigEndian.Uint32(expectedCRC32) != crcHash.Sum32() {
+		return "", errcatalog.FileResumablePartChecksumMismatch(ctx, "CRC32")
+	}
+
+	return base64.StdEncoding.EncodeToString(actualMD5), nil
+}
@@ -0,0 +1,748 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"main/database"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/errcatalog"
+	"main/redis"
+	"main/s3"
+	"main/services/audit"
+	"main/utils"
+	"mime"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	federation "github.com/esemashko/v2-federation"
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// ResumableUploadPartSize размер части возобновляемой загрузки (минимум S3 для всех частей, кроме последней, - 5MB)
+	ResumableUploadPartSize int64 = 8 << 20
+	// ResumableUploadTTL время, в течение которого возобновляемую загрузку можно продолжить
+	ResumableUploadTTL = 24 * time.Hour
+	// resumableUploadGrace дополнительное время хранения состояния после истечения, чтобы janitor успел отменить загрузку в S3
+	resumableUploadGrace = time.Hour
+	// resumableUploadPrefix префикс ключей состояния возобновляемых загрузок
+	resumableUploadPrefix = "upload:resumable:"
+	// resumableUploadExpiryKey общий (для всех тенантов) ZSET сроков истечения загрузок для janitor
+	resumableUploadExpiryKey = "upload:resumable:expiry"
+	// resumableUploadJanitorBatch количество истекших загрузок, обрабатываемых за один проход janitor
+	resumableUploadJanitorBatch = 100
+)
+
+// ResumableUpload состояние возобновляемой (по частям) загрузки.
+// Метаданные хранятся в Redis в зашифрованном виде, загруженные части - в отдельном hash,
+// поэтому клиент и сервис могут продолжить загрузку после падения любой из сторон.
+type ResumableUpload struct {
+	ID          uuid.UUID `json:"id"`
+	TenantID    uuid.UUID `json:"tenantId"`
+	UploadedBy  uuid.UUID `json:"uploadedBy"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"contentType"`
+	Description *string   `json:"description,omitempty"`
+	Size        int64     `json:"size"`
+	PartSize    int64     `json:"partSize"`
+	StorageKey  string    `json:"storageKey"`
+	S3UploadID  string    `json:"s3UploadId"`
+	// S3Encryption параметры шифрования частей (пусто, если шифрование хранилища выключено)
+	S3Encryption string         `json:"s3Encryption,omitempty"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	ExpiresAt    time.Time      `json:"expiresAt"`
+	Parts        []UploadedPart `json:"-"`
+	// DepartmentID отдел, за которым учитывается загрузка (квота отдела проверяется при начале загрузки)
+	DepartmentID *uuid.UUID `json:"departmentId,omitempty"`
+	// UploadSource и ClientVersion источник и версия клиента из заголовков gateway при начале загрузки
+	UploadSource  file.UploadSource `json:"uploadSource,omitempty"`
+	ClientVersion *string           `json:"clientVersion,omitempty"`
+}
+
+// UploadedPart загруженная часть возобновляемой загрузки
+type UploadedPart struct {
+	PartNumber int       `json:"partNumber"`
+	ETag       string    `json:"etag"`
+	Size       int64     `json:"size"`
+	UploadedAt time.Time `json:"uploadedAt"`
+	// MD5 base64 от MD5 содержимого части, проверенный при загрузке
+	MD5 string `json:"md5,omitempty"`
+}
+
+// StartResumableUploadInput параметры начала возобновляемой загрузки
+type StartResumableUploadInput struct {
+	Filename    string
+	Size        int64
+	ContentType string
+	Description *string
+}
+
+// TotalParts возвращает количество частей загрузки
+func (u *ResumableUpload) TotalParts() int {
+	return int((u.Size + u.PartSize - 1) / u.PartSize)
+}
+
+// expectedPartSize возвращает ожидаемый размер части (последняя часть может быть меньше)
+func (u *ResumableUpload) expectedPartSize(partNumber int) int64 {
+	if partNumber == u.TotalParts() {
+		return u.Size - int64(partNumber-1)*u.PartSize
+	}
+	return u.PartSize
+}
+
+// MissingParts возвращает номера еще не загруженных частей
+func (u *ResumableUpload) MissingParts() []int {
+	uploaded := make(map[int]bool, len(u.Parts))
+	for _, part := range u.Parts {
+		uploaded[part.PartNumber] = true
+	}
+
+	missing := make([]int, 0, u.TotalParts()-len(uploaded))
+	for partNumber := 1; partNumber <= u.TotalParts(); partNumber++ {
+		if !uploaded[partNumber] {
+			missing = append(missing, partNumber)
+		}
+	}
+	return missing
+}
+
+// resumableUploadKey ключ метаданных загрузки
+func resumableUploadKey(tenantID, uploadID uuid.UUID) string {
+	return fmt.Sprintf("%s%s:%s", resumableUploadPrefix, tenantID, uploadID)
+}
+
+// resumableUploadPartsKey ключ hash загруженных частей (номер части -> UploadedPart)
+func resumableUploadPartsKey(tenantID, uploadID uuid.UUID) string {
+	return resumableUploadKey(tenantID, uploadID) + ":parts"
+}
+
+// resumableUploadIndexKey ключ множества загрузок тенанта
+func resumableUploadIndexKey(tenantID uuid.UUID) string {
+	return fmt.Sprintf("%sindex:%s", resumableUploadPrefix, tenantID)
+}
+
+// resumableUploadExpiryMember элемент ZSET сроков истечения
+func resumableUploadExpiryMember(tenantID, uploadID uuid.UUID) string {
+	return tenantID.String() + ":" + uploadID.String()
+}
+
+// resumableUploadStore хранилище состояния возобновляемых загрузок в Redis
+type resumableUploadStore struct {
+	cache  *redis.TenantCacheService
+	client *goredis.Client
+}
+
+// getResumableUploadStore возвращает хранилище или ошибку, если Redis недоступен
+func getResumableUploadStore(ctx context.Context) (*resumableUploadStore, error) {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil || cacheService.GetClient() == nil {
+		return nil, errcatalog.FileResumableUnavailable(ctx)
+	}
+	return &resumableUploadStore{cache: cacheService, client: cacheService.GetClient()}, nil
+}
+
+// save сохраняет метаданные загрузки и регистрирует ее в индексе тенанта и ZSET сроков истечения
+func (st *resumableUploadStore) save(ctx context.Context, upload *ResumableUpload) error {
+	data, err := json.Marshal(upload)
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(upload.ExpiresAt) + resumableUploadGrace
+	if err := st.cache.SetTenantCacheWithTTL(ctx, upload.TenantID.String(), resumableUploadKey(upload.TenantID, upload.ID), data, ttl); err != nil {
+		return err
+	}
+
+	pipe := st.client.TxPipeline()
+	pipe.SAdd(ctx, resumableUploadIndexKey(upload.TenantID), upload.ID.String())
+	pipe.ZAdd(ctx, resumableUploadExpiryKey, &goredis.Z{
+		Score:  float64(upload.ExpiresAt.Unix()),
+		Member: resumableUploadExpiryMember(upload.TenantID, upload.ID),
+	})
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// load читает метаданные загрузки и список загруженных частей. Возвращает (nil, nil), если загрузка не найдена.
+func (st *resumableUploadStore) load(ctx context.Context, tenantID, uploadID uuid.UUID) (*ResumableUpload, error) {
+	data, err := st.cache.GetTenantCache(ctx, resumableUploadKey(tenantID, uploadID))
+	if err != nil {
+		if _, unavailable := err.(*redis.RedisUnavailableError); unavailable {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	var upload ResumableUpload
+	if err := json.Unmarshal(data, &upload); err != nil {
+		return nil, nil
+	}
+
+	rawParts, err := st.client.HGetAll(ctx, resumableUploadPartsKey(tenantID, uploadID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	for _, raw := range rawParts {
+		var part UploadedPart
+		if err := json.Unmarshal([]byte(raw), &part); err == nil {
+			upload.Parts = append(upload.Parts, part)
+		}
+	}
+	sort.Slice(upload.Parts, func(i, j int) bool { return upload.Parts[i].PartNumber < upload.Parts[j].PartNumber })
+
+	return &upload, nil
+}
+
+// savePart атомарно фиксирует загруженную часть (повторная загрузка части перезаписывает ETag)
+func (st *resumableUploadStore) savePart(ctx context.Context, upload *ResumableUpload, part UploadedPart) error {
+	data, err := json.Marshal(part)
+	if err != nil {
+		return err
+	}
+
+	partsKey := resumableUploadPartsKey(upload.TenantID, upload.ID)
+	pipe := st.client.TxPipeline()
+	pipe.HSet(ctx, partsKey, strconv.Itoa(part.PartNumber), data)
+	pipe.ExpireAt(ctx, partsKey, upload.ExpiresAt.Add(resumableUploadGrace))
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// delete удаляет все состояние загрузки
+func (st *resumableUploadStore) delete(ctx context.Context, tenantID, uploadID uuid.UUID) error {
+	pipe := st.client.TxPipeline()
+	pipe.Del(ctx, resumableUploadKey(tenantID, uploadID), resumableUploadPartsKey(tenantID, uploadID))
+	pipe.SRem(ctx, resumableUploadIndexKey(tenantID), uploadID.String())
+	pipe.ZRem(ctx, resumableUploadExpiryKey, resumableUploadExpiryMember(tenantID, uploadID))
+	_, err := pipe.Exec(context.WithoutCancel(ctx))
+	return err
+}
+
+// list возвращает все незавершенные загрузки тенанта; устаревшие записи индекса удаляются
+func (st *resumableUploadStore) list(ctx context.Context, tenantID uuid.UUID) ([]*ResumableUpload, error) {
+	ids, err := st.client.SMembers(ctx, resumableUploadIndexKey(tenantID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	uploads := make([]*ResumableUpload, 0, len(ids))
+	for _, rawID := range ids {
+		uploadID, parseErr := uuid.Parse(rawID)
+		if parseErr != nil {
+			continue
+		}
+		upload, loadErr := st.load(ctx, tenantID, uploadID)
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		if upload == nil {
+			st.client.SRem(ctx, resumableUploadIndexKey(tenantID), rawID)
+			continue
+		}
+		uploads = append(uploads, upload)
+	}
+
+	sort.Slice(uploads, func(i, j int) bool { return uploads[i].CreatedAt.After(uploads[j].CreatedAt) })
+	return uploads, nil
+}
+
+// getOwnResumableUpload загружает состояние и проверяет права: загрузку видит загрузивший ее пользователь и админы
+func (s *FileService) getOwnResumableUpload(ctx context.Context, store *resumableUploadStore, uploadID uuid.UUID) (*ResumableUpload, error) {
+	tenantID, userID := federation.GetTenantID(ctx), federation.GetUserID(ctx)
+	if tenantID == nil || userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	upload, err := store.load(ctx, *tenantID, uploadID)
+	if err != nil {
+		utils.Logger.Error("Failed to load resumable upload", zap.Error(err), zap.String("upload_id", uploadID.String()))
+		return nil, errcatalog.FileResumableUnavailable(ctx)
+	}
+	if upload == nil || (upload.UploadedBy != *userID && !s.hasAdminRole(ctx)) {
+		return nil, errcatalog.FileResumableNotFound(ctx)
+	}
+	if time.Now().After(upload.ExpiresAt) {
+		return nil, errcatalog.FileResumableExpired(ctx)
+	}
+
+	return upload, nil
+}
+
+// StartResumableUpload начинает загрузку по частям: проверяет лимиты, создает multipart загрузку в S3
+// и сохраняет ее состояние в Redis
+func (s *FileService) StartResumableUpload(ctx context.Context, client *ent.Client, input StartResumableUploadInput) (*ResumableUpload, error) {
+	if err := s.CanUploadFile(ctx); err != nil {
+		return nil, err
+	}
+
+	// Приостановленный тенант работает в режиме только для чтения
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	// Во время остановки сервиса новые загрузки не принимаются
+	if err := uploadsInFlight.ensureAccepting(ctx); err != nil {
+		return nil, err
+	}
+
+	tenantID, userID := federation.GetTenantID(ctx), federation.GetUserID(ctx)
+	if tenantID == nil || userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	filename := strings.TrimSpace(input.Filename)
+	if filename == "" {
+		return nil, errcatalog.FileNoFile(ctx)
+	}
+	if len(filename) > 200 {
+		return nil, errcatalog.FileFilenameTooLong(ctx)
+	}
+	if input.Size <= 0 {
+		return nil, errcatalog.FileResumableInvalidSize(ctx)
+	}
+	// 📋 [UPLOAD POLICY] Теги и атрибуты metadata при возобновляемой загрузке не передаются: при справочнике
+	// классификации или обязательных полях схемы metadata тенанта такие файлы загружаются через uploadFile
+	if err := s.enforceUploadPolicy(ctx, client, input.Size, input.Description, nil); err != nil {
+		return nil, err
+	}
+
+	contentType := input.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(filename))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+	}
+
+	// 🚫 [FILE TYPES] Заявленный тип проверяется до загрузки, обнаруженный - при завершении
+	if err := s.enforceFileTypePolicy(ctx, client, filename, input.Size, contentType); err != nil {
+		return nil, err
+	}
+
+	// 📊 [STORAGE LIMIT CHECK] Проверяем лимит хранилища до начала загрузки
+	if err := s.checkUploadStorageLimit(ctx, client, filename, input.Size); err != nil {
+		return nil, err
+	}
+
+	store, err := getResumableUploadStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	multipart, err := s.s3Service.CreateMultipartUpload(ctx, filename, contentType, ResumableUploadPartSize, input.Size)
+	if err != nil {
+		utils.Logger.Error("Failed to create multipart upload", zap.Error(err), zap.String("filename", filename))
+		if strings.Contains(err.Error(), "S3 credentials are not configured") {
+			return nil, errcatalog.FileS3NotConfigured(ctx)
+		}
+		return nil, errcatalog.FileUploadFailed(ctx)
+	}
+
+	now := time.Now()
+	upload := &ResumableUpload{
+		ID:           uuid.New(),
+		TenantID:     *tenantID,
+		UploadedBy:   *userID,
+		DepartmentID: uploadDepartmentID(ctx),
+		Filename:     filename,
+		ContentType:  contentType,
+		Description:  input.Description,
+		Size:         input.Size,
+		PartSize:     ResumableUploadPartSize,
+		StorageKey:   multipart.StorageKey,
+		S3UploadID:   multipart.UploadID,
+		S3Encryption: multipart.Encryption,
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(ResumableUploadTTL),
+	}
+	upload.UploadSource, upload.ClientVersion = uploadSourceFromContext(ctx)
+
+	if err := store.save(ctx, upload); err != nil {
+		utils.Logger.Error("Failed to save resumable upload state", zap.Error(err))
+		if abortErr := s.s3Service.AbortMultipartUpload(context.WithoutCancel(ctx), multipart.StorageKey, multipart.UploadID); abortErr != nil {
+			utils.Logger.Error("Failed to abort multipart upload after state error", zap.Error(abortErr))
+		}
+		return nil, errcatalog.FileResumableUnavailable(ctx)
+	}
+
+	utils.Logger.Info("Resumable upload started",
+		zap.String("upload_id", upload.ID.String()),
+		zap.String("filename", filename),
+		zap.Int64("size", input.Size),
+		zap.Int("total_parts", upload.TotalParts()))
+
+	return upload, nil
+}
+
+// UploadResumablePart загружает часть в S3 и фиксирует ее ETag в Redis.
+// Повторная загрузка той же части допускается (например, после обрыва соединения).
+// Часть с контрольной суммой, не совпадающей с переданной клиентом, отклоняется до загрузки в S3.
+func (s *FileService) UploadResumablePart(ctx context.Context, uploadID uuid.UUID, partNumber int, chunk *graphql.Upload, checksum PartChecksum) (*ResumableUpload, error) {
+	if chunk == nil {
+		return nil, errcatalog.FileNoFile(ctx)
+	}
+
+	store, err := getResumableUploadStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := s.getOwnResumableUpload(ctx, store, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	if partNumber < 1 || partNumber > upload.TotalParts() {
+		return nil, errcatalog.FileResumableInvalidPart(ctx)
+	}
+	if chunk.Size != upload.expectedPartSize(partNumber) {
+		return nil, errcatalog.FileResumableInvalidPartSize(ctx, upload.expectedPartSize(partNumber))
+	}
+
+	// Первая часть содержит сигнатуру файла: несоответствие заявленному типу выявляется до загрузки остальных частей
+	if partNumber == 1 {
+		head, err := sniffUploadHead(chunk.File)
+		if err != nil {
+			utils.Logger.Error("Failed to read resumable part", zap.Error(err), zap.String("upload_id", uploadID.String()))
+			return nil, errcatalog.FileUploadFailed(ctx)
+		}
+		if _, err := validateContentType(ctx, upload.ContentType, upload.Filename, head); err != nil {
+			return nil, err
+		}
+	}
+
+	partMD5, err := verifyPartChecksum(ctx, chunk.File, checksum)
+	if err != nil {
+		utils.Logger.Warn("Resumable part rejected by checksum",
+			zap.Error(err),
+			zap.String("upload_id", uploadID.String()),
+			zap.Int("part_number", partNumber))
+		return nil, err
+	}
+
+	// Отмененную при остановке часть клиент загружает повторно: состояние загрузки сохраняется
+	partCtx, finishPart, err := uploadsInFlight.beginUpload(ctx, fmt.Sprintf("%s (part %d)", upload.Filename, partNumber))
+	if err != nil {
+		return nil, err
+	}
+	etag, err := s.s3Service.UploadPart(partCtx, &s3.MultipartUpload{
+		StorageKey: upload.StorageKey,
+		UploadID:   upload.S3UploadID,
+		Encryption: upload.S3Encryption,
+	}, int64(partNumber), chunk.File, partMD5)
+	if aborted := finishPart(); aborted {
+		return nil, errcatalog.FileServiceShuttingDown(ctx)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to upload resumable part",
+			zap.Error(err),
+			zap.String("upload_id", uploadID.String()),
+			zap.Int("part_number", partNumber))
+		return nil, errcatalog.FileUploadFailed(ctx)
+	}
+
+	part := UploadedPart{PartNumber: partNumber, ETag: etag, Size: chunk.Size, UploadedAt: time.Now(), MD5: partMD5}
+	if err := store.savePart(ctx, upload, part); err != nil {
+		utils.Logger.Error("Failed to save resumable part state", zap.Error(err), zap.String("upload_id", uploadID.String()))
+		return nil, errcatalog.FileResumableUnavailable(ctx)
+	}
+
+	return store.load(ctx, upload.TenantID, upload.ID)
+}
+
+// CompleteResumableUpload собирает объект из частей, проверяет размер и создает запись файла
+func (s *FileService) CompleteResumableUpload(ctx context.Context, client *ent.Client, uploadID uuid.UUID) (*ent.File, error) {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	store, err := getResumableUploadStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := s.getOwnResumableUpload(ctx, store, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	if missing := upload.MissingParts(); len(missing) > 0 {
+		return nil, errcatalog.FileResumableIncomplete(ctx, len(missing))
+	}
+
+	parts := make([]s3.CompletedPart, 0, len(upload.Parts))
+	for _, part := range upload.Parts {
+		parts = append(parts, s3.CompletedPart{PartNumber: int64(part.PartNumber), ETag: part.ETag})
+	}
+	if err := s.s3Service.CompleteMultipartUpload(ctx, upload.StorageKey, upload.S3UploadID, parts); err != nil {
+		utils.Logger.Error("Failed to complete multipart upload", zap.Error(err), zap.String("upload_id", uploadID.String()))
+		return nil, errcatalog.FileUploadFailed(ctx)
+	}
+
+	// Собранный объект больше не является multipart загрузкой: при любой ошибке ниже удаляем его как обычный файл
+	cleanup := func(ctx context.Context) {
+		if err := s.s3Service.DeleteFile(ctx, upload.StorageKey); err != nil {
+			utils.Logger.Error("Failed to cleanup assembled resumable upload",
+				zap.Error(err),
+				zap.String("storage_key", upload.StorageKey))
+		}
+	}
+
+	checksum, actualSize, err := s.computeObjectChecksum(ctx, upload.StorageKey)
+	if err != nil || actualSize != upload.Size {
+		utils.Logger.Error("Assembled resumable upload failed verification",
+			zap.Error(err),
+			zap.String("upload_id", uploadID.String()),
+			zap.Int64("expected_size", upload.Size),
+			zap.Int64("actual_size", actualSize))
+		cleanup(ctx)
+		_ = store.delete(ctx, upload.TenantID, upload.ID)
+		return nil, errcatalog.FileUploadFailed(ctx)
+	}
+
+	// Тип содержимого собранного объекта (первая часть могла быть заменена повторной загрузкой)
+	head, err := s.sniffObjectHead(ctx, upload.StorageKey)
+	if err != nil {
+		utils.Logger.Error("Failed to read assembled resumable upload", zap.Error(err), zap.String("upload_id", uploadID.String()))
+		cleanup(ctx)
+		_ = store.delete(ctx, upload.TenantID, upload.ID)
+		return nil, errcatalog.FileUploadFailed(ctx)
+	}
+	detectedType, err := validateContentType(ctx, upload.ContentType, upload.Filename, head)
+	if err != nil {
+		cleanup(ctx)
+		_ = store.delete(ctx, upload.TenantID, upload.ID)
+		return nil, err
+	}
+	if err := s.enforceFileTypePolicy(ctx, client, upload.Filename, upload.Size, upload.ContentType, detectedType); err != nil {
+		cleanup(ctx)
+		_ = store.delete(ctx, upload.TenantID, upload.ID)
+		return nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	fileRecord, err := client.File.Create().
+		SetOriginalName(upload.Filename).
+		SetStorageKey(upload.StorageKey).
+		SetMimeType(upload.ContentType).
+		SetDetectedMimeType(detectedType).
+		SetSize(upload.Size).
+		SetChecksumSha256(checksum).
+		SetThumbnailStatus(initialThumbnailStatus(upload.ContentType)).
+		SetPreviewStatus(initialPreviewStatus(upload.ContentType)).
+		SetPdfStatus(s.initialPDFStatus(upload.ContentType)).
+		SetScanStatus(s.initialScanStatus()).
+		SetCreatedBy(upload.UploadedBy).
+		SetNillableDescription(upload.Description).
+		SetNillableDepartmentID(upload.DepartmentID).
+		SetUploadSource(uploadSourceOrUnknown(upload.UploadSource)).
+		SetNillableClientVersion(upload.ClientVersion).
+		SetNillableExpiresAt(s.retentionService.DefaultExpiresAt(ctx, client)).
+		Save(ctxWithClient)
+	if err != nil {
+		cleanup(ctx)
+		if isMetadataSchemaError(err) {
+			return nil, err
+		}
+		return nil, errcatalog.FileCreateFailed(ctx)
+	}
+
+	database.OnRollback(ctx, cleanup)
+	database.OnCommit(ctx, func(ctx context.Context) {
+		if err := store.delete(ctx, upload.TenantID, upload.ID); err != nil {
+			utils.Logger.Warn("Failed to delete completed resumable upload state", zap.Error(err))
+		}
+		if fileRecord.ThumbnailStatus == file.ThumbnailStatusPENDING {
+			requestThumbnails()
+		}
+		if fileRecord.PreviewStatus == file.PreviewStatusPENDING {
+			requestPreviews()
+		}
+		if fileRecord.PdfStatus == file.PdfStatusPENDING {
+			requestPDFRenditions()
+		}
+		if fileRecord.ScanStatus == file.ScanStatusPENDING {
+			requestMalwareScan()
+		}
+	})
+
+	// 📊 [AUDIT] Фиксируем загрузку файла
+	s.auditService.Record(ctx, client, audit.Event{
+		Action: fileauditevent.ActionUPLOAD,
+		FileID: &fileRecord.ID,
+		Details: uploadSourceAuditDetails(map[string]interface{}{
+			"filename":  fileRecord.OriginalName,
+			"size":      fileRecord.Size,
+			"resumable": true,
+			"parts":     len(parts),
+		}, fileRecord.UploadSource, upload.ClientVersion),
+	})
+
+	return fileRecord, nil
+}
+
+// GetResumableUpload возвращает состояние загрузки, чтобы клиент мог продолжить ее после обрыва соединения
+func (s *FileService) GetResumableUpload(ctx context.Context, uploadID uuid.UUID) (*ResumableUpload, error) {
+	store, err := getResumableUploadStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getOwnResumableUpload(ctx, store, uploadID)
+}
+
+// ListResumableUploads возвращает незавершенные загрузки: свои для пользователя, все загрузки тенанта для админов
+func (s *FileService) ListResumableUploads(ctx context.Context) ([]*ResumableUpload, error) {
+	tenantID, userID := federation.GetTenantID(ctx), federation.GetUserID(ctx)
+	if tenantID == nil || userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	store, err := getResumableUploadStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uploads, err := store.list(ctx, *tenantID)
+	if err != nil {
+		utils.Logger.Error("Failed to list resumable uploads", zap.Error(err))
+		return nil, errcatalog.FileResumableUnavailable(ctx)
+	}
+
+	isAdmin := s.hasAdminRole(ctx)
+	now := time.Now()
+	result := make([]*ResumableUpload, 0, len(uploads))
+	for _, upload := range uploads {
+		if now.After(upload.ExpiresAt) || (!isAdmin && upload.UploadedBy != *userID) {
+			continue
+		}
+		result = append(result, upload)
+	}
+
+	return result, nil
+}
+
+// AbortResumableUpload отменяет загрузку в S3 и удаляет ее состояние
+func (s *FileService) AbortResumableUpload(ctx context.Context, uploadID uuid.UUID) error {
+	store, err := getResumableUploadStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	upload, err := s.getOwnResumableUpload(ctx, store, uploadID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.s3Service.AbortMultipartUpload(ctx, upload.StorageKey, upload.S3UploadID); err != nil {
+		utils.Logger.Error("Failed to abort multipart upload", zap.Error(err), zap.String("upload_id", uploadID.String()))
+		return errcatalog.FileResumableAbortFailed(ctx)
+	}
+
+	if err := store.delete(ctx, upload.TenantID, upload.ID); err != nil {
+		utils.Logger.Warn("Failed to delete aborted resumable upload state", zap.Error(err))
+	}
+
+	utils.Logger.Info("Resumable upload aborted", zap.String("upload_id", uploadID.String()))
+	return nil
+}
+
+// CleanupExpiredResumableUploads отменяет в S3 истекшие загрузки всех тенантов и удаляет их состояние.
+// Состояние хранится дольше срока загрузки (resumableUploadGrace), поэтому после перезапуска сервиса
+// janitor находит загрузки, брошенные клиентом или прерванные падением сервиса.
+func (s *FileService) CleanupExpiredResumableUploads(ctx context.Context) (int, error) {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil || cacheService.GetClient() == nil {
+		return 0, fmt.Errorf("redis is unavailable")
+	}
+	store := &resumableUploadStore{cache: cacheService, client: cacheService.GetClient()}
+
+	members, err := store.client.ZRangeByScore(ctx, resumableUploadExpiryKey, &goredis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(time.Now().Unix(), 10),
+		Count: resumableUploadJanitorBatch,
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	cleaned := 0
+	for _, member := range members {
+		rawTenantID, rawUploadID, _ := strings.Cut(member, ":")
+		tenantID, tenantErr := uuid.Parse(rawTenantID)
+		uploadID, uploadErr := uuid.Parse(rawUploadID)
+		if tenantErr != nil || uploadErr != nil {
+			store.client.ZRem(ctx, resumableUploadExpiryKey, member)
+			continue
+		}
+
+		upload, err := store.load(ctx, tenantID, uploadID)
+		if err != nil {
+			return cleaned, err
+		}
+		if upload != nil {
+			if err := s.s3Service.AbortMultipartUpload(ctx, upload.StorageKey, upload.S3UploadID); err != nil {
+				// Загрузка могла быть уже отменена или завершена - состояние все равно удаляем
+				utils.Logger.Warn("Failed to abort expired multipart upload",
+					zap.Error(err),
+					zap.String("upload_id", uploadID.String()))
+			}
+		}
+
+		if err := store.delete(ctx, tenantID, uploadID); err != nil {
+			return cleaned, err
+		}
+		cleaned++
+	}
+
+	return cleaned, nil
+}
+
+// StartResumableUploadJanitor запускает периодическую очистку истекших возобновляемых загрузок.
+// Интервал задается RESUMABLE_UPLOAD_JANITOR_INTERVAL (по умолчанию 15m, "0" отключает очистку).
+func StartResumableUploadJanitor(ctx context.Context) {
+	interval := 15 * time.Minute
+	if value := os.Getenv("RESUMABLE_UPLOAD_JANITOR_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			utils.Logger.Info("Resumable upload janitor is disabled")
+			return
+		}
+		interval = parsed
+	}
+
+	service := NewFileService()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				cleaned, err := service.CleanupExpiredResumableUploads(ctx)
+				if err != nil {
+					utils.Logger.Warn("Resumable upload cleanup failed", zap.Error(err))
+					continue
+				}
+				if cleaned > 0 {
+					utils.Logger.Info("Expired resumable uploads cleaned up", zap.Int("cleaned", cleaned))
+				}
+			}
+		}
+	}()
+
+	utils.Logger.Info("Resumable upload janitor started", zap.Duration("interval", interval))
+}
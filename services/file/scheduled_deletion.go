@@ -0,0 +1,179 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/predicate"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/privacy"
+	"main/services/audit"
+	"main/utils"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// scheduledDeletionBatch количество файлов с наступившим сроком удаления, обрабатываемых за один запрос
+const scheduledDeletionBatch = 100
+
+// ScheduleFileDeletion планирует перемещение файла в корзину на время at; nil отменяет запланированное удаление.
+// Запланировать удаление может тот, кто может удалить файл сейчас, отменить - тот, кто может его изменять.
+// Повторный вызов переносит срок, отмена без запланированного удаления не считается ошибкой.
+func (s *FileService) ScheduleFileDeletion(ctx context.Context, client *ent.Client, fileID uuid.UUID, at *time.Time) (*ent.File, error) {
+	if err := s.tenantStateService.EnsureWritable(ctx, client); err != nil {
+		return nil, err
+	}
+
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	if at != nil {
+		if !at.After(time.Now()) {
+			return nil, errcatalog.FileDeletionTimeInvalid(ctx)
+		}
+		if err := s.CanDeleteFile(ctx, client, fileID); err != nil {
+			return nil, err
+		}
+	} else if err := s.CanUpdateFile(ctx, client, fileID); err != nil {
+		return nil, err
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	fileRecord, err := client.File.Get(ctxWithClient, fileID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.FileNotFound(ctx)
+		}
+		return nil, errcatalog.FileGetFailed(ctx)
+	}
+	if at == nil && fileRecord.ScheduledDeletionAt == nil {
+		return fileRecord, nil
+	}
+
+	update := client.File.UpdateOneID(fileID)
+	if at != nil {
+		update = update.SetScheduledDeletionAt(at.UTC()).SetScheduledDeletionBy(*userID)
+	} else {
+		update = update.ClearScheduledDeletionAt().ClearScheduledDeletionBy()
+	}
+	updated, err := update.Save(ctxWithClient)
+	if err != nil {
+		utils.Logger.Error("Failed to schedule file deletion", zap.Error(err), zap.String("file_id", fileID.String()))
+		return nil, errcatalog.FileDeletionScheduleFailed(ctx)
+	}
+
+	// 📊 [AUDIT] Фиксируем планирование или отмену вместе с прежним сроком
+	auditEvent := audit.Event{
+		Action: fileauditevent.ActionDELETION_SCHEDULED,
+		FileID: &fileID,
+		Details: map[string]interface{}{
+			"filename": fileRecord.OriginalName,
+		},
+	}
+	if at != nil {
+		auditEvent.Details["scheduled_at"] = updated.ScheduledDeletionAt
+	} else {
+		auditEvent.Action = fileauditevent.ActionDELETION_CANCELED
+	}
+	if fileRecord.ScheduledDeletionAt != nil {
+		auditEvent.Details["previous_scheduled_at"] = fileRecord.ScheduledDeletionAt
+	}
+	s.auditService.Record(ctx, client, auditEvent)
+
+	return updated, nil
+}
+
+// DeleteScheduledFiles перемещает в корзину файлы всех тенантов с наступившим сроком запланированного удаления.
+// Файлы под удержанием S3 Object Lock остаются запланированными до окончания удержания, а файлы, взятые на
+// редактирование не автором расписания, - до снятия блокировки.
+func (s *FileService) DeleteScheduledFiles(ctx context.Context, client *ent.Client) (int, error) {
+	systemCtx, cancel := mixin.SkipTenantFilterFor(ent.NewContext(privacy.WithSystemContext(ctx), client),
+		"move files scheduled for deletion of all tenants to trash", fileTrashPurgeTimeout)
+	defer cancel()
+
+	deleted := 0
+	for ctx.Err() == nil {
+		now := time.Now()
+		files, err := client.File.Query().
+			Where(
+				file.ScheduledDeletionAtNotNil(),
+				file.ScheduledDeletionAtLTE(now),
+				ObjectLockReleased(now),
+				scheduledDeletionUnlocked(),
+			).
+			Order(ent.Asc(file.FieldScheduledDeletionAt), ent.Asc(file.FieldID)).
+			Limit(scheduledDeletionBatch).
+			All(systemCtx)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to select files scheduled for deletion: %w", err)
+		}
+
+		for _, fileRecord := range files {
+			// Условия на срок и блокировку защищают от гонки с переносом или отменой удаления
+			// и с блокировкой файла пользователем
+			updated, err := client.File.Update().
+				Where(
+					file.ID(fileRecord.ID),
+					file.DeletedAtIsNil(),
+					file.ScheduledDeletionAt(*fileRecord.ScheduledDeletionAt),
+					scheduledDeletionUnlocked(),
+				).
+				SetDeletedAt(now).
+				ClearScheduledDeletionAt().
+				ClearScheduledDeletionBy().
+				Save(systemCtx)
+			if err != nil {
+				return deleted, fmt.Errorf("failed to move scheduled file to trash: %w", err)
+			}
+			if updated == 0 {
+				continue
+			}
+			deleted++
+
+			// 📊 [AUDIT] Системная операция: тенант задается явно, автор расписания - в деталях
+			fileID, tenantID := fileRecord.ID, fileRecord.TenantID
+			details := map[string]interface{}{
+				"filename":     fileRecord.OriginalName,
+				"size":         fileRecord.Size,
+				"trashed":      true,
+				"scheduled":    true,
+				"scheduled_at": fileRecord.ScheduledDeletionAt,
+			}
+			if fileRecord.ScheduledDeletionBy != nil {
+				details["scheduled_by"] = fileRecord.ScheduledDeletionBy.String()
+			}
+			s.auditService.Record(ctx, client, audit.Event{
+				Action:   fileauditevent.ActionDELETE,
+				TenantID: &tenantID,
+				FileID:   &fileID,
+				Details:  details,
+			})
+		}
+
+		if len(files) < scheduledDeletionBatch {
+			break
+		}
+	}
+
+	if deleted > 0 {
+		utils.Logger.Info("Scheduled files moved to trash", zap.Int("deleted", deleted))
+	}
+	return deleted, nil
+}
+
+// scheduledDeletionUnlocked файл не заблокирован или заблокирован автором расписания удаления
+// (сравнение двух столбцов, поэтому предикат собирается на уровне SQL)
+func scheduledDeletionUnlocked() predicate.File {
+	return file.Or(file.LockedByIsNil(), func(s *sql.Selector) {
+		s.Where(sql.ColumnsEQ(s.C(file.FieldLockedBy), s.C(file.FieldScheduledDeletionBy)))
+	})
+}
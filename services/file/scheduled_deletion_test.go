@@ -0,0 +1,260 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/schema/mixin"
+	"main/services/audit"
+	"main/services/tenant"
+	"main/types"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scheduledDeletionFixture клиент на SQLite в памяти, тенант и сервис с зависимостями, которые нужны
+// планированию удаления и воркеру (S3 и Redis не используются)
+type scheduledDeletionFixture struct {
+	client   *ent.Client
+	service  *FileService
+	tenantID uuid.UUID
+	// setupCtx контекст без фильтра тенанта для подготовки и проверки данных
+	setupCtx context.Context
+}
+
+func newScheduledDeletionFixture(t *testing.T) *scheduledDeletionFixture {
+	t.Helper()
+
+	client, setupCtx := newTestClient(t, "scheduled deletion test fixtures")
+
+	return &scheduledDeletionFixture{
+		client: client,
+		service: &FileService{
+			auditService:       audit.NewAuditService(),
+			tenantStateService: tenant.NewTenantStateService(),
+		},
+		tenantID: uuid.New(),
+		setupCtx: setupCtx,
+	}
+}
+
+// userContext контекст федерации пользователя тенанта
+func (f *scheduledDeletionFixture) userContext(userID uuid.UUID, role string) context.Context {
+	tenantID := f.tenantID
+	return federation.WithContext(context.Background(), &federation.Context{
+		TenantID: &tenantID,
+		UserID:   &userID,
+		UserRole: role,
+	})
+}
+
+// createFile создает файл пользователя; update дополнительно задает расписание, блокировку или удержание
+func (f *scheduledDeletionFixture) createFile(t *testing.T, createdBy uuid.UUID, update func(*ent.FileCreate)) *ent.File {
+	t.Helper()
+	create := f.client.File.Create().
+		SetTenantID(f.tenantID).
+		SetCreatedBy(createdBy).
+		SetOriginalName("report.pdf").
+		SetStorageKey(fmt.Sprintf("tenants/%s/%s.pdf", f.tenantID, uuid.NewString())).
+		SetMimeType("application/pdf").
+		SetSize(100)
+	if update != nil {
+		update(create)
+	}
+	fileRecord, err := create.Save(f.setupCtx)
+	require.NoError(t, err)
+	return fileRecord
+}
+
+func (f *scheduledDeletionFixture) reload(t *testing.T, fileID uuid.UUID) *ent.File {
+	t.Helper()
+	fileRecord, err := f.client.File.Query().Where(file.ID(fileID)).Only(mixin.SkipSoftDelete(f.setupCtx))
+	require.NoError(t, err)
+	return fileRecord
+}
+
+// auditActions возвращает действия событий аудита файла в порядке записи
+func (f *scheduledDeletionFixture) auditActions(t *testing.T, fileID uuid.UUID) []fileauditevent.Action {
+	t.Helper()
+	events, err := f.client.FileAuditEvent.Query().
+		Where(fileauditevent.FileID(fileID)).
+		Order(ent.Asc(fileauditevent.FieldCreateTime)).
+		All(f.setupCtx)
+	require.NoError(t, err)
+	actions := make([]fileauditevent.Action, 0, len(events))
+	for _, event := range events {
+		actions = append(actions, event.Action)
+	}
+	return actions
+}
+
+func TestScheduleFileDeletion(t *testing.T) {
+	f := newScheduledDeletionFixture(t)
+	owner := uuid.New()
+	ctx := f.userContext(owner, types.RoleMember)
+	fileRecord := f.createFile(t, owner, nil)
+
+	t.Run("schedule", func(t *testing.T) {
+		at := time.Now().Add(time.Hour)
+		updated, err := f.service.ScheduleFileDeletion(ctx, f.client, fileRecord.ID, &at)
+		require.NoError(t, err)
+		require.NotNil(t, updated.ScheduledDeletionAt)
+		assert.WithinDuration(t, at, *updated.ScheduledDeletionAt, time.Second)
+		assert.Equal(t, &owner, updated.ScheduledDeletionBy)
+	})
+
+	t.Run("reschedule moves the date", func(t *testing.T) {
+		at := time.Now().Add(48 * time.Hour)
+		updated, err := f.service.ScheduleFileDeletion(ctx, f.client, fileRecord.ID, &at)
+		require.NoError(t, err)
+		require.NotNil(t, updated.ScheduledDeletionAt)
+		assert.WithinDuration(t, at, *updated.ScheduledDeletionAt, time.Second)
+	})
+
+	t.Run("time in the past is rejected", func(t *testing.T) {
+		at := time.Now().Add(-time.Minute)
+		_, err := f.service.ScheduleFileDeletion(ctx, f.client, fileRecord.ID, &at)
+		assert.Error(t, err)
+	})
+
+	t.Run("other member cannot schedule", func(t *testing.T) {
+		at := time.Now().Add(time.Hour)
+		_, err := f.service.ScheduleFileDeletion(f.userContext(uuid.New(), types.RoleMember), f.client, fileRecord.ID, &at)
+		assert.Error(t, err)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		updated, err := f.service.ScheduleFileDeletion(ctx, f.client, fileRecord.ID, nil)
+		require.NoError(t, err)
+		assert.Nil(t, updated.ScheduledDeletionAt)
+		assert.Nil(t, updated.ScheduledDeletionBy)
+	})
+
+	t.Run("cancel without schedule is a no-op", func(t *testing.T) {
+		updated, err := f.service.ScheduleFileDeletion(ctx, f.client, fileRecord.ID, nil)
+		require.NoError(t, err)
+		assert.Nil(t, updated.ScheduledDeletionAt)
+	})
+
+	assert.Equal(t, []fileauditevent.Action{
+		fileauditevent.ActionDELETION_SCHEDULED,
+		fileauditevent.ActionDELETION_SCHEDULED,
+		fileauditevent.ActionDELETION_CANCELED,
+	}, f.auditActions(t, fileRecord.ID))
+}
+
+func TestDeleteScheduledFiles(t *testing.T) {
+	f := newScheduledDeletionFixture(t)
+	owner, other := uuid.New(), uuid.New()
+	past, future := time.Now().Add(-time.Minute), time.Now().Add(time.Hour)
+	schedule := func(at time.Time) func(*ent.FileCreate) {
+		return func(create *ent.FileCreate) {
+			create.SetScheduledDeletionAt(at).SetScheduledDeletionBy(owner)
+		}
+	}
+
+	due := f.createFile(t, owner, schedule(past))
+	notDue := f.createFile(t, owner, schedule(future))
+	unscheduled := f.createFile(t, owner, nil)
+	lockedByOther := f.createFile(t, owner, func(create *ent.FileCreate) {
+		schedule(past)(create)
+		create.SetLockedBy(other).SetLockedAt(time.Now())
+	})
+	lockedByScheduler := f.createFile(t, owner, func(create *ent.FileCreate) {
+		schedule(past)(create)
+		create.SetLockedBy(owner).SetLockedAt(time.Now())
+	})
+	legalHold := f.createFile(t, owner, func(create *ent.FileCreate) {
+		schedule(past)(create)
+		create.SetLegalHold(true)
+	})
+
+	deleted, err := f.service.DeleteScheduledFiles(context.Background(), f.client)
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+
+	for _, fileRecord := range []*ent.File{due, lockedByScheduler} {
+		reloaded := f.reload(t, fileRecord.ID)
+		assert.False(t, reloaded.DeletedAt.IsZero(), fileRecord.ID)
+		assert.Nil(t, reloaded.ScheduledDeletionAt, fileRecord.ID)
+		assert.Nil(t, reloaded.ScheduledDeletionBy, fileRecord.ID)
+		assert.Equal(t, []fileauditevent.Action{fileauditevent.ActionDELETE}, f.auditActions(t, fileRecord.ID))
+	}
+	for _, fileRecord := range []*ent.File{notDue, unscheduled, lockedByOther, legalHold} {
+		reloaded := f.reload(t, fileRecord.ID)
+		assert.True(t, reloaded.DeletedAt.IsZero(), fileRecord.ID)
+		assert.Equal(t, fileRecord.ScheduledDeletionAt != nil, reloaded.ScheduledDeletionAt != nil, fileRecord.ID)
+		assert.Empty(t, f.auditActions(t, fileRecord.ID))
+	}
+}
+
+// TestDeleteScheduledFilesConcurrentChange изменение файла между выборкой воркера и перемещением в корзину:
+// условное обновление не должно удалить перенесенный, отмененный или заблокированный файл
+func TestDeleteScheduledFilesConcurrentChange(t *testing.T) {
+	owner, other := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name   string
+		change func(update *ent.FileUpdateOne)
+	}{
+		{
+			name: "rescheduled",
+			change: func(update *ent.FileUpdateOne) {
+				update.SetScheduledDeletionAt(time.Now().Add(time.Hour))
+			},
+		},
+		{
+			name: "canceled",
+			change: func(update *ent.FileUpdateOne) {
+				update.ClearScheduledDeletionAt().ClearScheduledDeletionBy()
+			},
+		},
+		{
+			name: "locked by other user",
+			change: func(update *ent.FileUpdateOne) {
+				update.SetLockedBy(other).SetLockedAt(time.Now())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newScheduledDeletionFixture(t)
+			fileRecord := f.createFile(t, owner, func(create *ent.FileCreate) {
+				create.SetScheduledDeletionAt(time.Now().Add(-time.Minute)).SetScheduledDeletionBy(owner)
+			})
+
+			// Изменение выполняется сразу после выборки файлов воркером
+			changed := false
+			f.client.File.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+				return ent.QuerierFunc(func(ctx context.Context, query ent.Query) (ent.Value, error) {
+					value, err := next.Query(ctx, query)
+					if err == nil && !changed {
+						changed = true
+						update := f.client.File.UpdateOneID(fileRecord.ID)
+						tt.change(update)
+						require.NoError(t, update.Exec(f.setupCtx))
+					}
+					return value, err
+				})
+			}))
+
+			deleted, err := f.service.DeleteScheduledFiles(context.Background(), f.client)
+			require.NoError(t, err)
+			require.True(t, changed)
+			assert.Equal(t, 0, deleted)
+
+			reloaded := f.reload(t, fileRecord.ID)
+			assert.True(t, reloaded.DeletedAt.IsZero())
+			assert.Empty(t, f.auditActions(t, fileRecord.ID))
+		})
+	}
+}
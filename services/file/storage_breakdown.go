@@ -0,0 +1,208 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/redis"
+	"main/utils"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// StorageUsageBucket объем и количество файлов одной группы разбивки
+type StorageUsageBucket struct {
+	FileCount int   `json:"fileCount"`
+	Bytes     int64 `json:"bytes"`
+}
+
+// UploaderStorageUsage использование хранилища файлами одного пользователя
+type UploaderStorageUsage struct {
+	UserID uuid.UUID `json:"userId"`
+	StorageUsageBucket
+}
+
+// CategoryStorageUsage использование хранилища файлами одной категории
+type CategoryStorageUsage struct {
+	Category FileCategory `json:"category"`
+	StorageUsageBucket
+}
+
+// MonthStorageUsage использование хранилища файлами, загруженными в одном месяце
+type MonthStorageUsage struct {
+	// Month месяц загрузки в UTC (YYYY-MM)
+	Month string `json:"month"`
+	StorageUsageBucket
+}
+
+// StorageUsageBreakdown разбивка использования хранилища тенанта. Как и квота, учитывает файлы в корзине.
+type StorageUsageBreakdown struct {
+	TotalBytes   int64 `json:"totalBytes"`
+	FileCount    int   `json:"fileCount"`
+	TrashedBytes int64 `json:"trashedBytes"`
+	// ByUploader и ByCategory упорядочены по объему, ByMonth - по месяцу загрузки
+	ByUploader []*UploaderStorageUsage `json:"byUploader"`
+	ByCategory []*CategoryStorageUsage `json:"byCategory"`
+	ByMonth    []*MonthStorageUsage    `json:"byMonth"`
+	// ComputedAt время расчета (разбивка кэшируется на redis.StorageBreakdownTTL)
+	ComputedAt time.Time `json:"computedAt"`
+}
+
+// GetStorageUsageBreakdown возвращает разбивку использования хранилища тенанта по загрузившим, категориям
+// и месяцам загрузки. Разбивка считается агрегирующими запросами по File и кэшируется в Redis;
+// refresh пересчитывает ее без кэша.
+func (s *FileService) GetStorageUsageBreakdown(ctx context.Context, client *ent.Client, refresh bool) (*StorageUsageBreakdown, error) {
+	tenantID := federation.GetTenantID(ctx)
+	if tenantID == nil {
+		return nil, errcatalog.TenantNotFound(ctx)
+	}
+	cacheKey := redis.GetTenantStorageBreakdownKey(tenantID.String())
+
+	cacheService, cacheErr := redis.GetTenantCacheService()
+	if cacheErr == nil && !refresh {
+		if data, err := cacheService.GetTenantCache(ctx, cacheKey); err == nil {
+			var breakdown StorageUsageBreakdown
+			if err := json.Unmarshal(data, &breakdown); err == nil {
+				return &breakdown, nil
+			}
+		}
+	}
+
+	breakdown, err := queryStorageUsageBreakdown(mixin.SkipSoftDelete(ent.NewContext(ctx, client)), client)
+	if err != nil {
+		utils.Logger.Error("Failed to aggregate storage usage breakdown", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+
+	// Ошибки кэша не критичны
+	if cacheErr == nil {
+		if data, err := json.Marshal(breakdown); err == nil {
+			_ = cacheService.SetTenantCacheWithTTL(ctx, tenantID.String(), cacheKey, data, redis.StorageBreakdownTTL)
+		}
+	}
+
+	return breakdown, nil
+}
+
+// queryStorageUsageBreakdown считает разбивку по БД; ctx должен включать файлы в корзине
+func queryStorageUsageBreakdown(ctx context.Context, client *ent.Client) (*StorageUsageBreakdown, error) {
+	breakdown := &StorageUsageBreakdown{
+		ByUploader: []*UploaderStorageUsage{},
+		ByCategory: []*CategoryStorageUsage{},
+		ByMonth:    []*MonthStorageUsage{},
+		ComputedAt: time.Now().UTC(),
+	}
+
+	var uploaderRows []struct {
+		CreatedBy uuid.UUID `json:"created_by"`
+		Count     int       `json:"count"`
+		Sum       int64     `json:"sum"`
+	}
+	err := client.File.Query().
+		GroupBy(file.FieldCreatedBy).
+		Aggregate(ent.Count(), ent.Sum(file.FieldSize)).
+		Scan(ctx, &uploaderRows)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range uploaderRows {
+		breakdown.TotalBytes += row.Sum
+		breakdown.FileCount += row.Count
+		breakdown.ByUploader = append(breakdown.ByUploader, &UploaderStorageUsage{
+			UserID:             row.CreatedBy,
+			StorageUsageBucket: StorageUsageBucket{FileCount: row.Count, Bytes: row.Sum},
+		})
+	}
+	sort.Slice(breakdown.ByUploader, func(i, j int) bool {
+		if breakdown.ByUploader[i].Bytes != breakdown.ByUploader[j].Bytes {
+			return breakdown.ByUploader[i].Bytes > breakdown.ByUploader[j].Bytes
+		}
+		return breakdown.ByUploader[i].UserID.String() < breakdown.ByUploader[j].UserID.String()
+	})
+
+	// Категория определяется по заявленному MIME типу (без расширения имени, как в фильтре по категории)
+	var mimeRows []struct {
+		MimeType string `json:"mime_type"`
+		Count    int    `json:"count"`
+		Sum      int64  `json:"sum"`
+	}
+	err = client.File.Query().
+		GroupBy(file.FieldMimeType).
+		Aggregate(ent.Count(), ent.Sum(file.FieldSize)).
+		Scan(ctx, &mimeRows)
+	if err != nil {
+		return nil, err
+	}
+	categories := make(map[FileCategory]*CategoryStorageUsage)
+	for _, row := range mimeRows {
+		category := ResolveFileType(row.MimeType, "").Category
+		usage, ok := categories[category]
+		if !ok {
+			usage = &CategoryStorageUsage{Category: category}
+			categories[category] = usage
+			breakdown.ByCategory = append(breakdown.ByCategory, usage)
+		}
+		usage.FileCount += row.Count
+		usage.Bytes += row.Sum
+	}
+	sort.Slice(breakdown.ByCategory, func(i, j int) bool {
+		if breakdown.ByCategory[i].Bytes != breakdown.ByCategory[j].Bytes {
+			return breakdown.ByCategory[i].Bytes > breakdown.ByCategory[j].Bytes
+		}
+		return breakdown.ByCategory[i].Category < breakdown.ByCategory[j].Category
+	})
+
+	var monthRows []struct {
+		Month string `json:"month"`
+		Count int    `json:"count"`
+		Sum   int64  `json:"sum"`
+	}
+	err = client.File.Query().Modify(func(sel *sql.Selector) {
+		month := sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("to_char(").
+				WriteString(sel.C(file.FieldCreateTime)).
+				WriteString(" AT TIME ZONE 'UTC', 'YYYY-MM')")
+		})
+		sel.Select().
+			AppendSelectExprAs(month, "month").
+			AppendSelectExprAs(sql.Raw("COUNT(*)"), "count").
+			AppendSelectExprAs(sql.Raw("SUM("+sel.C(file.FieldSize)+")"), "sum").
+			GroupBy("month").
+			OrderBy("month")
+	}).Scan(ctx, &monthRows)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range monthRows {
+		breakdown.ByMonth = append(breakdown.ByMonth, &MonthStorageUsage{
+			Month:              row.Month,
+			StorageUsageBucket: StorageUsageBucket{FileCount: row.Count, Bytes: row.Sum},
+		})
+	}
+
+	// Группировка по тенанту не дает SUM вернуть NULL для пустой корзины
+	var trashedRows []struct {
+		Sum int64 `json:"sum"`
+	}
+	err = client.File.Query().
+		Where(file.DeletedAtNotNil()).
+		GroupBy(file.FieldTenantID).
+		Aggregate(ent.Sum(file.FieldSize)).
+		Scan(ctx, &trashedRows)
+	if err != nil {
+		return nil, err
+	}
+	if len(trashedRows) > 0 {
+		breakdown.TrashedBytes = trashedRows[0].Sum
+	}
+
+	return breakdown, nil
+}
@@ -0,0 +1,148 @@
+package file
+
+import (
+	"context"
+	"main/config"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/predicate"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/services/audit"
+	"main/utils"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// bytesPerGB объем ГБ в модели стоимости (2^30 байт, как в счетах S3)
+const bytesPerGB = float64(1 << 30)
+
+// egressSizeBatch количество файлов в одном запросе размеров скачанных файлов
+const egressSizeBatch = 1000
+
+// StorageClass класс хранения в оценке стоимости
+type StorageClass string
+
+const (
+	// StorageClassStandard обычные файлы
+	StorageClassStandard StorageClass = "STANDARD"
+	// StorageClassArchive файлы, переведенные в архив правилами жизненного цикла
+	StorageClassArchive StorageClass = "ARCHIVE"
+	// StorageClassTrash файлы в корзине: занимают место по цене STANDARD до окончательного удаления
+	StorageClassTrash StorageClass = "TRASH"
+)
+
+// StorageClassCost объем и месячная стоимость хранения одного класса
+type StorageClassCost struct {
+	StorageClass    StorageClass
+	FileCount       int
+	Bytes           int64
+	PricePerGBMonth float64
+	MonthlyCost     float64
+}
+
+// StorageCostEstimate оценка месячной стоимости хранения и исходящего трафика тенанта
+type StorageCostEstimate struct {
+	Currency string
+	// From и To период, по скачиваниям которого оценивается трафик (последние 30 дней)
+	From           time.Time
+	To             time.Time
+	StorageClasses []*StorageClassCost
+	StorageCost    float64
+	// EgressDownloads количество скачиваний файлов за период (файл в архиве считается отдельно)
+	EgressDownloads  int
+	EgressBytes      int64
+	EgressPricePerGB float64
+	EgressCost       float64
+	TotalMonthlyCost float64
+}
+
+// EstimateStorageCost оценивает месячную стоимость вложений тенанта по ценам STORAGE_COST_*: хранение по
+// текущему объему файлов каждого класса и трафик по скачиваниям из аудита за последние 30 дней.
+// Трафик скачанного архива считается по размерам вошедших в него файлов; окончательно удаленные файлы не учитываются.
+func (s *FileService) EstimateStorageCost(ctx context.Context, client *ent.Client) (*StorageCostEstimate, error) {
+	prices := config.Get().StorageCost
+	to := time.Now()
+	estimate := &StorageCostEstimate{
+		Currency:         prices.Currency,
+		From:             to.Add(-audit.DefaultAggregationPeriod),
+		To:               to,
+		EgressPricePerGB: prices.EgressGB,
+	}
+
+	// Корзина учитывается отдельно, поэтому выборки идут в обход мягкого удаления
+	ctxWithClient := mixin.SkipSoftDelete(ent.NewContext(ctx, client))
+	for _, class := range []struct {
+		storageClass StorageClass
+		price        float64
+		predicates   []predicate.File
+	}{
+		{StorageClassStandard, prices.StandardGBMonth, []predicate.File{file.DeletedAtIsNil(), file.ArchivedAtIsNil()}},
+		{StorageClassArchive, prices.ArchiveGBMonth, []predicate.File{file.DeletedAtIsNil(), file.ArchivedAtNotNil()}},
+		{StorageClassTrash, prices.StandardGBMonth, []predicate.File{file.DeletedAtNotNil()}},
+	} {
+		count, size, err := aggregateRuleFiles(ctxWithClient, client.File.Query().Where(class.predicates...))
+		if err != nil {
+			utils.Logger.Error("Failed to aggregate storage for cost estimate",
+				zap.Error(err),
+				zap.String("storage_class", string(class.storageClass)))
+			return nil, errcatalog.FileStorageStatsFailed(ctx)
+		}
+		cost := &StorageClassCost{
+			StorageClass:    class.storageClass,
+			FileCount:       count,
+			Bytes:           size,
+			PricePerGBMonth: class.price,
+			MonthlyCost:     float64(size) / bytesPerGB * class.price,
+		}
+		estimate.StorageClasses = append(estimate.StorageClasses, cost)
+		estimate.StorageCost += cost.MonthlyCost
+	}
+
+	downloads, err := s.auditService.DownloadedFileCounts(ctx, client, estimate.From, estimate.To)
+	if err != nil {
+		utils.Logger.Error("Failed to count downloads for cost estimate", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+	if estimate.EgressBytes, estimate.EgressDownloads, err = downloadedBytes(ctxWithClient, client, downloads); err != nil {
+		utils.Logger.Error("Failed to sum downloaded file sizes", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+	estimate.EgressCost = float64(estimate.EgressBytes) / bytesPerGB * prices.EgressGB
+	estimate.TotalMonthlyCost = estimate.StorageCost + estimate.EgressCost
+
+	return estimate, nil
+}
+
+// downloadedBytes суммирует размеры скачанных файлов с учетом количества скачиваний каждого.
+// Возвращает объем и количество скачиваний существующих файлов.
+func downloadedBytes(ctx context.Context, client *ent.Client, downloads map[uuid.UUID]int) (int64, int, error) {
+	fileIDs := make([]uuid.UUID, 0, len(downloads))
+	for fileID := range downloads {
+		fileIDs = append(fileIDs, fileID)
+	}
+
+	var total int64
+	var count int
+	for start := 0; start < len(fileIDs); start += egressSizeBatch {
+		end := min(start+egressSizeBatch, len(fileIDs))
+		var rows []struct {
+			ID   uuid.UUID `json:"id"`
+			Size int64     `json:"size"`
+		}
+		err := client.File.Query().
+			Where(file.IDIn(fileIDs[start:end]...)).
+			Select(file.FieldID, file.FieldSize).
+			Scan(ctx, &rows)
+		if err != nil {
+			return 0, 0, err
+		}
+		for _, row := range rows {
+			total += row.Size * int64(downloads[row.ID])
+			count += downloads[row.ID]
+		}
+	}
+	return total, count, nil
+}
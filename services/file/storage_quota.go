@@ -0,0 +1,83 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/fileauditevent"
+	"main/ent/tenantsetting"
+	"main/services/audit"
+	"main/services/tenant"
+	"main/utils"
+	"main/websocket"
+
+	federation "github.com/esemashko/v2-federation"
+	"go.uber.org/zap"
+)
+
+const (
+	// storageQuotaEntityType тип события превышения квоты; на канал подписываются уведомления админов
+	storageQuotaEntityType = "storage_quota"
+)
+
+// getQuotaPolicy возвращает режим квоты тенанта; при ошибке чтения настроек действует жесткая квота
+func (s *FileService) getQuotaPolicy(ctx context.Context, client *ent.Client) *tenant.QuotaPolicy {
+	policy, err := s.quotaService.GetPolicy(ctx, client)
+	if err != nil {
+		utils.Logger.Warn("Failed to get tenant quota mode, using hard quota", zap.Error(err))
+		return &tenant.QuotaPolicy{Mode: tenantsetting.QuotaModeHARD}
+	}
+	return policy
+}
+
+// tenantStorageLimit возвращает действующий лимит хранилища тенанта: лимит, заданный оператором, но не больше
+// S3_STORAGE_LIMIT_BYTES платформы (отрицательное значение - без ограничений)
+func (s *FileService) tenantStorageLimit(policy *tenant.QuotaPolicy) int64 {
+	platformLimit := s.s3Service.StorageLimitBytes()
+	if policy.StorageLimitBytes == nil {
+		return platformLimit
+	}
+	if platformLimit >= 0 && *policy.StorageLimitBytes > platformLimit {
+		return platformLimit
+	}
+	return *policy.StorageLimitBytes
+}
+
+// reportOverQuota фиксирует в аудите загрузку сверх лимита хранилища и уведомляет админов тенанта.
+// Вызывается только для разрешенных загрузок в режимах SOFT и GRACE; ошибки уведомления не блокируют загрузку.
+func (s *FileService) reportOverQuota(ctx context.Context, client *ent.Client, policy *tenant.QuotaPolicy, filename string, size, currentUsage int64) {
+	storageLimit := s.tenantStorageLimit(policy)
+	if storageLimit <= 0 || currentUsage+size <= storageLimit {
+		return
+	}
+
+	details := map[string]interface{}{
+		"reason":        "over_quota",
+		"quota_mode":    policy.Mode.String(),
+		"grace_percent": policy.GracePercent,
+		"filename":      filename,
+		"file_size":     size,
+		"current_usage": currentUsage,
+		"storage_limit": storageLimit,
+	}
+
+	utils.Logger.Warn("Upload exceeds tenant storage quota",
+		zap.String("quota_mode", policy.Mode.String()),
+		zap.String("filename", filename),
+		zap.Int64("file_size", size),
+		zap.Int64("current_usage", currentUsage),
+		zap.Int64("storage_limit", storageLimit))
+
+	// 📊 [AUDIT] Фиксируем превышение квоты
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:  fileauditevent.ActionQUOTA_EXCEEDED,
+		Details: details,
+	})
+
+	tenantID := federation.GetTenantID(ctx)
+	if tenantID == nil {
+		return
+	}
+	if err := websocket.NewPublisher().PublishEntityEvent(ctx, storageQuotaEntityType, *tenantID, websocket.EntityActionQuotaExceeded, details); err != nil {
+		utils.Logger.Warn("Failed to notify admins about exceeded storage quota", zap.Error(err))
+	}
+}
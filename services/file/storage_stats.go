@@ -0,0 +1,176 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/utils"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultLargestFilesLimit количество крупнейших файлов в отчете пользователя по умолчанию
+	defaultLargestFilesLimit = 10
+	// maxLargestFilesLimit максимальное количество крупнейших файлов в отчете пользователя
+	maxLargestFilesLimit = 50
+)
+
+// TenantStorageStats использование хранилища тенантом
+type TenantStorageStats struct {
+	TenantID    uuid.UUID
+	StorageUsed int64
+	// StorageLimit лимит хранилища в байтах (nil - без ограничений)
+	StorageLimit *int64
+	FileCount    int
+}
+
+// GetTenantStorageStats считает использование хранилища для нескольких тенантов одним агрегирующим запросом.
+// Данные доступны только по тенанту из контекста федерации: для остальных ID результат не возвращается.
+func (s *FileService) GetTenantStorageStats(ctx context.Context, client *ent.Client, tenantIDs []uuid.UUID) (map[uuid.UUID]*TenantStorageStats, error) {
+	currentTenantID := federation.GetTenantID(ctx)
+	if currentTenantID == nil {
+		return nil, errcatalog.TenantNotFound(ctx)
+	}
+
+	requested := make([]uuid.UUID, 0, 1)
+	for _, tenantID := range tenantIDs {
+		if tenantID == *currentTenantID {
+			requested = append(requested, tenantID)
+			break
+		}
+	}
+
+	stats := make(map[uuid.UUID]*TenantStorageStats, len(requested))
+	if len(requested) == 0 {
+		return stats, nil
+	}
+
+	var storageLimit *int64
+	if limit := s.tenantStorageLimit(s.getQuotaPolicy(ctx, client)); limit >= 0 {
+		storageLimit = &limit
+	}
+	for _, tenantID := range requested {
+		stats[tenantID] = &TenantStorageStats{TenantID: tenantID, StorageLimit: storageLimit}
+	}
+
+	var rows []struct {
+		TenantID uuid.UUID `json:"tenant_id"`
+		Count    int       `json:"count"`
+		Sum      int64     `json:"sum"`
+	}
+	err := client.File.Query().
+		Where(file.TenantIDIn(requested...)).
+		GroupBy(file.FieldTenantID).
+		Aggregate(ent.Count(), ent.Sum(file.FieldSize)).
+		Scan(ent.NewContext(ctx, client), &rows)
+	if err != nil {
+		utils.Logger.Error("Failed to aggregate tenant storage usage", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+
+	for _, row := range rows {
+		if stat, ok := stats[row.TenantID]; ok {
+			stat.StorageUsed = row.Sum
+			stat.FileCount = row.Count
+		}
+	}
+
+	return stats, nil
+}
+
+// UserStorageUsage использование хранилища собственными загрузками пользователя
+type UserStorageUsage struct {
+	UsedBytes int64
+	FileCount int
+	// TrashedBytes файлы в корзине занимают место до окончательного удаления
+	TrashedBytes int64
+	TrashedCount int
+	// StorageLimit лимит хранилища тенанта в байтах (nil - без ограничений)
+	StorageLimit *int64
+	// MaxFiles и MaxBytes лимиты пользователя, заданные администратором (nil - без ограничения).
+	// Для них учитываются и файлы в корзине.
+	MaxFiles *int
+	MaxBytes *int64
+	// LargestFiles крупнейшие файлы пользователя вне корзины
+	LargestFiles []*ent.File
+}
+
+// GetMyStorageUsage считает использование хранилища файлами, загруженными текущим пользователем.
+// Роль не расширяет выборку: администратор тоже видит только свои загрузки.
+func (s *FileService) GetMyStorageUsage(ctx context.Context, client *ent.Client, largestLimit int) (*UserStorageUsage, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	if largestLimit <= 0 {
+		largestLimit = defaultLargestFilesLimit
+	}
+	if largestLimit > maxLargestFilesLimit {
+		largestLimit = maxLargestFilesLimit
+	}
+
+	usage := &UserStorageUsage{LargestFiles: []*ent.File{}}
+	if limit := s.tenantStorageLimit(s.getQuotaPolicy(ctx, client)); limit >= 0 {
+		usage.StorageLimit = &limit
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	var err error
+	usage.UsedBytes, usage.FileCount, err = aggregateUserFiles(ctxWithClient, client.File.Query().
+		Where(file.CreatedBy(*userID)))
+	if err == nil {
+		// Файлы в корзине занимают место до очистки, поэтому учитываются отдельно
+		usage.TrashedBytes, usage.TrashedCount, err = aggregateUserFiles(mixin.SkipSoftDelete(ctxWithClient), client.File.Query().
+			Where(file.CreatedBy(*userID), file.DeletedAtNotNil()))
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to aggregate user storage usage", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+
+	userLimit, err := getUserStorageLimit(ctx, client, *userID)
+	if err != nil {
+		utils.Logger.Error("Failed to get user storage limit", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+	if userLimit != nil {
+		usage.MaxFiles, usage.MaxBytes = userLimit.MaxFiles, userLimit.MaxBytes
+	}
+
+	if usage.FileCount > 0 {
+		usage.LargestFiles, err = client.File.Query().
+			Where(file.CreatedBy(*userID)).
+			Order(ent.Desc(file.FieldSize), ent.Asc(file.FieldID)).
+			Limit(largestLimit).
+			All(ctxWithClient)
+		if err != nil {
+			utils.Logger.Error("Failed to query user's largest files", zap.Error(err))
+			return nil, errcatalog.FileStorageStatsFailed(ctx)
+		}
+	}
+
+	return usage, nil
+}
+
+// aggregateUserFiles возвращает суммарный размер и количество файлов выборки.
+// Группировка по автору не дает SUM вернуть NULL для пустой выборки.
+func aggregateUserFiles(ctx context.Context, query *ent.FileQuery) (int64, int, error) {
+	var rows []struct {
+		Count int   `json:"count"`
+		Sum   int64 `json:"sum"`
+	}
+	err := query.
+		GroupBy(file.FieldCreatedBy).
+		Aggregate(ent.Count(), ent.Sum(file.FieldSize)).
+		Scan(ctx, &rows)
+	if err != nil || len(rows) == 0 {
+		return 0, 0, err
+	}
+	return rows[0].Sum, rows[0].Count, nil
+}
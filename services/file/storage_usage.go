@@ -0,0 +1,110 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/schema/mixin"
+	"main/redis"
+	"main/utils"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// defaultStorageUsageReconcileInterval интервал сверки счетчиков использования хранилища с БД
+const defaultStorageUsageReconcileInterval = time.Hour
+
+// storageUsageReconcileTimeout максимальное время подсчета использования хранилища по БД
+const storageUsageReconcileTimeout = time.Minute
+
+// queryStorageUsage считает использование хранилища тенанта по БД (суммарный размер файлов).
+// Файлы в корзине учитываются: их объекты остаются в S3 до очистки корзины.
+func queryStorageUsage(ctx context.Context, client *ent.Client, tenantID uuid.UUID) (int64, error) {
+	var totalSize int64
+	err := client.File.Query().
+		Where(file.TenantID(tenantID)).
+		Aggregate(ent.Sum(file.FieldSize)).
+		Scan(mixin.SkipSoftDelete(ctx), &totalSize)
+	if err != nil {
+		return 0, err
+	}
+	return totalSize, nil
+}
+
+// ReconcileStorageUsage пересчитывает по БД счетчики использования хранилища всех тенантов с файлами.
+// Счетчики тенантов без файлов не обновляются и истекают по StorageUsageTTL.
+func (s *FileService) ReconcileStorageUsage(ctx context.Context, client *ent.Client) (int, error) {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil || cacheService.GetClient() == nil {
+		return 0, fmt.Errorf("redis is unavailable")
+	}
+
+	var rows []struct {
+		TenantID uuid.UUID `json:"tenant_id"`
+		Sum      int64     `json:"sum"`
+	}
+	skipCtx, cancel := mixin.SkipTenantFilterFor(ctx, "reconcile storage usage of all tenants", storageUsageReconcileTimeout)
+	defer cancel()
+	err = client.File.Query().
+		GroupBy(file.FieldTenantID).
+		Aggregate(ent.Sum(file.FieldSize)).
+		Scan(mixin.SkipSoftDelete(skipCtx), &rows)
+	if err != nil {
+		return 0, err
+	}
+
+	reconciled := 0
+	for _, row := range rows {
+		if err := cacheService.SetTenantStorageUsage(ctx, row.TenantID.String(), row.Sum); err != nil {
+			return reconciled, err
+		}
+		reconciled++
+	}
+	return reconciled, nil
+}
+
+// StartStorageUsageReconciler запускает периодическую сверку счетчиков использования хранилища с БД.
+// Интервал задается STORAGE_USAGE_RECONCILE_INTERVAL (по умолчанию 1h, "0" отключает сверку).
+func StartStorageUsageReconciler(ctx context.Context, getClient func() *ent.Client) {
+	interval := defaultStorageUsageReconcileInterval
+	if value := os.Getenv("STORAGE_USAGE_RECONCILE_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			utils.Logger.Info("Storage usage reconciler is disabled")
+			return
+		}
+		interval = parsed
+	}
+
+	service := NewFileService()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				client := getClient()
+				if client == nil {
+					continue
+				}
+
+				reconciled, err := service.ReconcileStorageUsage(ctx, client)
+				if err != nil {
+					utils.Logger.Warn("Storage usage reconciliation failed", zap.Error(err))
+					continue
+				}
+				utils.Logger.Debug("Storage usage reconciled", zap.Int("tenants", reconciled))
+			}
+		}
+	}()
+
+	utils.Logger.Info("Storage usage reconciler started", zap.Duration("interval", interval))
+}
@@ -0,0 +1,41 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"main/ent"
+	"main/ent/enttest"
+	_ "main/ent/runtime"
+	"main/ent/schema/mixin"
+	"main/utils"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"go.uber.org/zap"
+	"golang.org/x/text/language"
+)
+
+// newTestClient создает клиент на SQLite в памяти (отдельная БД на каждый тест) и контекст без фильтра
+// тенанта для подготовки и проверки данных. Хуки File пишут в лог, ошибки локализуются пустым bundle,
+// который возвращает идентификаторы сообщений; Redis в тесте недоступен, счетчик хранилища не ведется.
+func newTestClient(t *testing.T, reason string) (*ent.Client, context.Context) {
+	t.Helper()
+
+	if utils.Logger == nil {
+		utils.Logger = zap.NewNop()
+	}
+	if utils.GetI18nBundle() == nil {
+		utils.SetI18nBundle(i18n.NewBundle(language.English))
+	}
+
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx, cancel := mixin.SkipTenantFilterFor(context.Background(), reason, time.Minute)
+	t.Cleanup(cancel)
+
+	return client, ctx
+}
@@ -0,0 +1,347 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/privacy"
+	"main/utils"
+	"mime"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ThumbnailSize размер превью изображения
+type ThumbnailSize string
+
+const (
+	ThumbnailSizeSmall  ThumbnailSize = "SMALL"
+	ThumbnailSizeMedium ThumbnailSize = "MEDIUM"
+)
+
+// ThumbnailSizes все размеры превью; каждый следующий строится из предыдущего большего
+var ThumbnailSizes = []ThumbnailSize{ThumbnailSizeMedium, ThumbnailSizeSmall}
+
+// thumbnailMaxSide максимальная сторона превью в пикселях
+var thumbnailMaxSide = map[ThumbnailSize]int{
+	ThumbnailSizeSmall:  160,
+	ThumbnailSizeMedium: 640,
+}
+
+const (
+	// defaultThumbnailInterval интервал проверки файлов, ожидающих генерации превью
+	defaultThumbnailInterval = 30 * time.Second
+	// thumbnailBatchSize количество файлов, обрабатываемых за один проход
+	thumbnailBatchSize = 20
+	// thumbnailMaxSourceSize максимальный размер исходного изображения
+	thumbnailMaxSourceSize = 30 << 20
+	// thumbnailMaxPixels максимальное разрешение исходного изображения (защита от "бомб" декомпрессии)
+	thumbnailMaxPixels = 25_000_000
+	// thumbnailJPEGQuality качество JPEG превью
+	thumbnailJPEGQuality = 80
+	// thumbnailBatchTimeout максимальное время обработки одной пачки файлов
+	thumbnailBatchTimeout = 10 * time.Minute
+)
+
+// thumbnailMimeTypes типы изображений, для которых создаются превью (декодеры стандартной библиотеки)
+var thumbnailMimeTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// thumbnailWakeup будит генератор превью сразу после загрузки изображения
+var thumbnailWakeup = make(chan struct{}, 1)
+
+// SupportsThumbnail проверяет, создаются ли превью для MIME типа
+func SupportsThumbnail(mimeType string) bool {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	return thumbnailMimeTypes[strings.ToLower(mediaType)]
+}
+
+// initialThumbnailStatus возвращает состояние превью для нового файла
+func initialThumbnailStatus(mimeType string) file.ThumbnailStatus {
+	if SupportsThumbnail(mimeType) {
+		return file.ThumbnailStatusPENDING
+	}
+	return file.ThumbnailStatusNONE
+}
+
+// requestThumbnails сообщает генератору о новых изображениях (без блокировки)
+func requestThumbnails() {
+	select {
+	case thumbnailWakeup <- struct{}{}:
+	default:
+	}
+}
+
+// ThumbnailStorageKey возвращает ключ превью, производный от ключа исходного файла
+func ThumbnailStorageKey(storageKey string, size ThumbnailSize) string {
+	return storageKey + ".thumb-" + strings.ToLower(string(size)) + ".jpg"
+}
+
+// GetThumbnailURL возвращает временную ссылку на превью файла или nil, если превью еще не готово
+func (s *FileService) GetThumbnailURL(ctx context.Context, fileRecord *ent.File, size ThumbnailSize) (*string, error) {
+	if fileRecord.ThumbnailStatus != file.ThumbnailStatusREADY {
+		return nil, nil
+	}
+	if _, ok := thumbnailMaxSide[size]; !ok {
+		return nil, nil
+	}
+
+	// 🔐 [ENCRYPTION] Зашифрованное превью отдается сервисом
+	if s.s3Service.EncryptionEnabled() {
+		url := fileProxyURL(fileRecord.ID, "/thumbnail/"+strings.ToLower(string(size)), nil)
+		return &url, nil
+	}
+
+	url, err := s.s3Service.GetPresignedURL(ctx, ThumbnailStorageKey(fileRecord.StorageKey, size), DefaultPresignedURLExpiration)
+	if err != nil {
+		utils.Logger.Error("Failed to generate thumbnail URL",
+			zap.Error(err),
+			zap.String("file_id", fileRecord.ID.String()))
+		return nil, errcatalog.FileThumbnailURLFailed(ctx)
+	}
+	return &url, nil
+}
+
+// GenerateThumbnails создает превью для файлов всех тенантов, ожидающих генерации.
+// Ошибка отдельного файла переводит его в FAILED и не прерывает обработку остальных.
+func (s *FileService) GenerateThumbnails(ctx context.Context, client *ent.Client, limit int) (int, int, error) {
+	systemCtx, cancel := mixin.SkipTenantFilterFor(ent.NewContext(privacy.WithSystemContext(ctx), client),
+		"generate thumbnails for all tenants", thumbnailBatchTimeout)
+	defer cancel()
+
+	files, err := client.File.Query().
+		Where(file.ThumbnailStatusEQ(file.ThumbnailStatusPENDING)).
+		Order(ent.Asc(file.FieldCreateTime), ent.Asc(file.FieldID)).
+		Limit(limit).
+		All(systemCtx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to select files for thumbnails: %w", err)
+	}
+
+	processed, failed := 0, 0
+	for _, fileRecord := range files {
+		if ctx.Err() != nil {
+			break
+		}
+
+		status := file.ThumbnailStatusREADY
+		if err := s.generateFileThumbnails(ctx, fileRecord); err != nil {
+			utils.Logger.Warn("Failed to generate thumbnails",
+				zap.Error(err),
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("mime_type", fileRecord.MimeType))
+			status = file.ThumbnailStatusFAILED
+			failed++
+		}
+
+		// Файл мог быть удален или перенесен во время генерации - обновляем только ожидающие
+		if _, err := client.File.Update().
+			Where(file.ID(fileRecord.ID), file.ThumbnailStatusEQ(file.ThumbnailStatusPENDING)).
+			SetThumbnailStatus(status).
+			Save(systemCtx); err != nil {
+			return processed, failed, fmt.Errorf("failed to update thumbnail status: %w", err)
+		}
+		processed++
+	}
+
+	return processed, failed, nil
+}
+
+// generateFileThumbnails декодирует исходное изображение и сохраняет превью всех размеров в S3
+func (s *FileService) generateFileThumbnails(ctx context.Context, fileRecord *ent.File) error {
+	source, err := s.loadSourceImage(ctx, fileRecord)
+	if err != nil {
+		return err
+	}
+
+	for _, size := range ThumbnailSizes {
+		source = resizeToFit(source, thumbnailMaxSide[size])
+
+		var buffer bytes.Buffer
+		if err := jpeg.Encode(&buffer, source, &jpeg.Options{Quality: thumbnailJPEGQuality}); err != nil {
+			return fmt.Errorf("failed to encode thumbnail: %w", err)
+		}
+		if err := s.s3Service.PutObject(ctx, ThumbnailStorageKey(fileRecord.StorageKey, size), bytes.NewReader(buffer.Bytes()), "image/jpeg"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadSourceImage читает исходное изображение из S3 и декодирует его с ограничением размера и разрешения
+func (s *FileService) loadSourceImage(ctx context.Context, fileRecord *ent.File) (image.Image, error) {
+	if fileRecord.Size > thumbnailMaxSourceSize {
+		return nil, fmt.Errorf("source image is too large: %d bytes", fileRecord.Size)
+	}
+
+	object, err := s.s3Service.GetFileObject(ctx, fileRecord.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	data, err := io.ReadAll(io.LimitReader(object, thumbnailMaxSourceSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source image: %w", err)
+	}
+	if len(data) > thumbnailMaxSourceSize {
+		return nil, fmt.Errorf("source image is too large")
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image header: %w", err)
+	}
+	if config.Width*config.Height > thumbnailMaxPixels {
+		return nil, fmt.Errorf("source image resolution is too large: %dx%d", config.Width, config.Height)
+	}
+
+	source, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	return source, nil
+}
+
+// deleteThumbnails удаляет превью файла из S3; ошибки только логируются
+func (s *FileService) deleteThumbnails(ctx context.Context, fileRecord *ent.File) {
+	if fileRecord.ThumbnailStatus != file.ThumbnailStatusREADY {
+		return
+	}
+	for _, size := range ThumbnailSizes {
+		key := ThumbnailStorageKey(fileRecord.StorageKey, size)
+		if err := s.s3Service.DeleteFile(ctx, key); err != nil {
+			utils.Logger.Warn("Failed to delete thumbnail",
+				zap.Error(err),
+				zap.String("file_id", fileRecord.ID.String()),
+				zap.String("storage_key", key))
+		}
+	}
+}
+
+// resizeToFit уменьшает изображение так, чтобы большая сторона не превышала maxSide
+// (усреднение по области). Изображения меньше maxSide не увеличиваются.
+func resizeToFit(source image.Image, maxSide int) *image.RGBA {
+	bounds := source.Bounds()
+	sourceWidth, sourceHeight := bounds.Dx(), bounds.Dy()
+
+	width, height := sourceWidth, sourceHeight
+	if sourceWidth > maxSide || sourceHeight > maxSide {
+		if sourceWidth >= sourceHeight {
+			width, height = maxSide, max(1, sourceHeight*maxSide/sourceWidth)
+		} else {
+			width, height = max(1, sourceWidth*maxSide/sourceHeight), maxSide
+		}
+	}
+
+	return scaleImage(source, bounds, width, height)
+}
+
+// scaleImage масштабирует область bounds изображения до width x height (усреднение по области).
+// Прозрачные области заливаются белым, так как результат сохраняется в JPEG.
+func scaleImage(source image.Image, bounds image.Rectangle, width, height int) *image.RGBA {
+	sourceWidth, sourceHeight := bounds.Dx(), bounds.Dy()
+
+	result := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*sourceHeight/height
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*sourceHeight/height)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*sourceWidth/width
+			x1 := max(x0+1, bounds.Min.X+(x+1)*sourceWidth/width)
+
+			var r, g, b, a, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := source.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					count++
+				}
+			}
+
+			// Значения RGBA() умножены на альфу: наложение на белый фон - добавление (1 - альфа)
+			background := 0xffff - a/count
+			result.SetRGBA(x, y, color.RGBA{
+				R: uint8((r/count + background) >> 8),
+				G: uint8((g/count + background) >> 8),
+				B: uint8((b/count + background) >> 8),
+				A: 0xff,
+			})
+		}
+	}
+	return result
+}
+
+// StartThumbnailGenerator запускает фоновую генерацию превью изображений.
+// Проверка выполняется с интервалом THUMBNAIL_INTERVAL (по умолчанию 30s, "0" отключает генерацию)
+// и сразу после загрузки изображений.
+func StartThumbnailGenerator(ctx context.Context, getClient func() *ent.Client) {
+	interval := defaultThumbnailInterval
+	if value := os.Getenv("THUMBNAIL_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			utils.Logger.Info("Thumbnail generator is disabled")
+			return
+		}
+		interval = parsed
+	}
+
+	service := NewFileService()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-thumbnailWakeup:
+			}
+
+			client := getClient()
+			if client == nil {
+				continue
+			}
+
+			// Обрабатываем пачками, пока есть ожидающие файлы
+			for ctx.Err() == nil {
+				processed, failed, err := service.GenerateThumbnails(ctx, client, thumbnailBatchSize)
+				if err != nil {
+					utils.Logger.Error("Thumbnail generation failed", zap.Error(err))
+					break
+				}
+				if processed > 0 {
+					utils.Logger.Debug("Thumbnails generated",
+						zap.Int("processed", processed),
+						zap.Int("failed", failed))
+				}
+				if processed < thumbnailBatchSize {
+					break
+				}
+			}
+		}
+	}()
+
+	utils.Logger.Info("Thumbnail generator started", zap.Duration("interval", interval))
+}
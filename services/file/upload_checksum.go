@@ -0,0 +1,65 @@
+package file
+
+import (
+	"context"
+	"encoding/hex"
+	"main/errcatalog"
+	"main/utils"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// normalizeUploadChecksum проверяет SHA-256, переданный клиентом с загрузкой (64 шестнадцатеричных символа
+// в любом регистре), и приводит его к нижнему регистру, как в checksum_sha256. Пустое значение - без проверки.
+func normalizeUploadChecksum(ctx context.Context, checksum *string) (string, error) {
+	if checksum == nil || strings.TrimSpace(*checksum) == "" {
+		return "", nil
+	}
+	value := strings.ToLower(strings.TrimSpace(*checksum))
+	if decoded, err := hex.DecodeString(value); err != nil || len(decoded) != 32 {
+		return "", errcatalog.FileChecksumInvalid(ctx)
+	}
+	return value, nil
+}
+
+// verifyUploadChecksum сверяет SHA-256 полученного содержимого с переданным клиентом
+func verifyUploadChecksum(ctx context.Context, expected, actual, filename string) error {
+	if expected == "" || expected == actual {
+		return nil
+	}
+	utils.Logger.Warn("Upload checksum mismatch",
+		zap.String("filename", filename),
+		zap.String("expected_sha256", expected),
+		zap.String("actual_sha256", actual))
+	return errcatalog.FileChecksumMismatch(ctx)
+}
+
+// verifyStoredETag сверяет ETag сохраненного объекта с MD5 переданного в S3 потока. ETag равен MD5 содержимого
+// только для объектов, загруженных одним запросом без шифрования SSE-KMS/SSE-C; составной ETag (с суффиксом
+// -N) и недоступный HEAD пропускаются без ошибки. При шифровании на стороне сервиса ETag - MD5 шифротекста,
+// целостность в этом случае обеспечивают теги AES-GCM.
+func (s *FileService) verifyStoredETag(ctx context.Context, storageKey, md5Hex string) error {
+	if s.s3Service.EncryptionEnabled() {
+		return nil
+	}
+
+	info, err := s.s3Service.GetFileInfo(ctx, storageKey)
+	if err != nil || info.ETag == nil {
+		utils.Logger.Warn("Failed to read uploaded object ETag", zap.Error(err), zap.String("storage_key", storageKey))
+		return nil
+	}
+
+	etag := strings.ToLower(strings.Trim(*info.ETag, `"`))
+	if decoded, err := hex.DecodeString(etag); err != nil || len(decoded) != 16 {
+		return nil
+	}
+	if etag != md5Hex {
+		utils.Logger.Error("Stored object ETag does not match uploaded content",
+			zap.String("storage_key", storageKey),
+			zap.String("etag", etag),
+			zap.String("content_md5", md5Hex))
+		return errcatalog.FileChecksumMismatch(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package file
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"main/redis"
+	"main/utils"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// uploadSingleFlightPrefix префикс ключей защиты от повторной одновременной загрузки
+	uploadSingleFlightPrefix = "upload:singleflight:"
+	// uploadPendingMarker значение ключа, пока первая загрузка еще выполняется
+	uploadPendingMarker = "pending"
+	// uploadPendingTTL время жизни ключа выполняющейся загрузки (покрывает загрузку в S3 крупного файла)
+	uploadPendingTTL = 2 * time.Minute
+	// uploadResultTTL время, в течение которого повторная загрузка того же файла возвращает созданную запись
+	uploadResultTTL = 30 * time.Second
+	// uploadWaitTimeout максимальное ожидание результата первой загрузки
+	uploadWaitTimeout = time.Minute
+	// uploadPollInterval интервал опроса результата первой загрузки
+	uploadPollInterval = 200 * time.Millisecond
+)
+
+// uploadGuard защищает от дублей при одновременной загрузке одного и того же файла одним пользователем
+// (например, двойной клик). Первая загрузка захватывает ключ в Redis, остальные ждут ее результата.
+type uploadGuard struct {
+	client *goredis.Client
+	key    string
+}
+
+// uploadSingleFlightKey строит ключ по (тенант, загрузивший, имя, размер, контрольная сумма)
+func uploadSingleFlightKey(tenantID, userID uuid.UUID, filename string, size int64, checksum string) string {
+	fingerprint := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%s", filename, size, checksum)))
+	return fmt.Sprintf("%s%s:%s:%s", uploadSingleFlightPrefix, tenantID, userID, hex.EncodeToString(fingerprint[:]))
+}
+
+// acquireUploadGuard захватывает ключ загрузки. Возвращает guard для первой загрузки
+// или ID записи, созданной параллельной загрузкой того же файла.
+// Если Redis недоступен или ожидание истекло, возвращает (nil, nil) - загрузка выполняется без защиты.
+func acquireUploadGuard(ctx context.Context, key string) (*uploadGuard, *uuid.UUID) {
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil || cacheService.GetClient() == nil {
+		return nil, nil
+	}
+	client := cacheService.GetClient()
+
+	deadline := time.Now().Add(uploadWaitTimeout)
+	for {
+		acquired, err := client.SetNX(ctx, key, uploadPendingMarker, uploadPendingTTL).Result()
+		if err != nil {
+			utils.Logger.Warn("Upload single-flight guard is unavailable", zap.Error(err))
+			return nil, nil
+		}
+		if acquired {
+			return &uploadGuard{client: client, key: key}, nil
+		}
+
+		value, err := client.Get(ctx, key).Result()
+		switch {
+		case err == goredis.Nil:
+			// Первая загрузка завершилась ошибкой и освободила ключ - пробуем захватить его сами
+			continue
+		case err != nil:
+			utils.Logger.Warn("Upload single-flight guard is unavailable", zap.Error(err))
+			return nil, nil
+		case value != uploadPendingMarker:
+			if fileID, parseErr := uuid.Parse(value); parseErr == nil {
+				return nil, &fileID
+			}
+			return nil, nil
+		}
+
+		if time.Now().After(deadline) {
+			utils.Logger.Warn("Timed out waiting for concurrent identical upload", zap.String("key", key))
+			return nil, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case <-time.After(uploadPollInterval):
+		}
+	}
+}
+
+// complete публикует ID созданной записи для ожидающих загрузок того же файла
+func (g *uploadGuard) complete(ctx context.Context, fileID uuid.UUID) {
+	if err := g.client.Set(ctx, g.key, fileID.String(), uploadResultTTL).Err(); err != nil {
+		utils.Logger.Warn("Failed to publish upload single-flight result", zap.Error(err), zap.String("key", g.key))
+	}
+}
+
+// release освобождает ключ после неудачной загрузки, чтобы ожидающие запросы загрузили файл сами
+func (g *uploadGuard) release(ctx context.Context) {
+	if err := g.client.Del(context.WithoutCancel(ctx), g.key).Err(); err != nil {
+		utils.Logger.Warn("Failed to release upload single-flight guard", zap.Error(err), zap.String("key", g.key))
+	}
+}
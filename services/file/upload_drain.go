@@ -0,0 +1,171 @@
+package file
+
+import (
+	"context"
+	"main/errcatalog"
+	"main/utils"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultUploadDrainGracePeriod время, которое выполняющиеся загрузки получают на завершение при остановке
+	defaultUploadDrainGracePeriod = 20 * time.Second
+	// uploadDrainAbortWait сколько ждать завершения отмененных загрузок (очистка multipart в S3)
+	uploadDrainAbortWait = 5 * time.Second
+	// uploadDrainPollInterval интервал проверки выполняющихся загрузок
+	uploadDrainPollInterval = 100 * time.Millisecond
+)
+
+// inflightUpload выполняющаяся загрузка в S3
+type inflightUpload struct {
+	filename  string
+	startedAt time.Time
+	cancel    context.CancelFunc
+	aborted   bool
+}
+
+// uploadDrainer учитывает выполняющиеся загрузки, чтобы при остановке сервиса дождаться их
+// или отменить с очисткой, вместо обрыва multipart загрузок на середине
+type uploadDrainer struct {
+	mu       sync.Mutex
+	draining bool
+	nextID   uint64
+	uploads  map[uint64]*inflightUpload
+}
+
+var uploadsInFlight = &uploadDrainer{uploads: make(map[uint64]*inflightUpload)}
+
+// UploadDrainSummary итог остановки загрузок
+type UploadDrainSummary struct {
+	InFlight  int
+	Completed int
+	Aborted   int
+	Duration  time.Duration
+}
+
+// UploadDrainGracePeriod возвращает время ожидания загрузок при остановке (UPLOAD_DRAIN_GRACE_PERIOD, например 20s)
+func UploadDrainGracePeriod() time.Duration {
+	if value := os.Getenv("UPLOAD_DRAIN_GRACE_PERIOD"); value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed >= 0 {
+			return parsed
+		}
+		utils.Logger.Warn("Invalid UPLOAD_DRAIN_GRACE_PERIOD, using default",
+			zap.String("value", value),
+			zap.Duration("default", defaultUploadDrainGracePeriod))
+	}
+	return defaultUploadDrainGracePeriod
+}
+
+// beginUpload регистрирует загрузку и возвращает контекст передачи в S3, который отменяется при остановке.
+// Во время остановки новые загрузки отклоняются.
+func (d *uploadDrainer) beginUpload(ctx context.Context, filename string) (context.Context, func() (aborted bool), error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.draining {
+		return nil, nil, errcatalog.FileServiceShuttingDown(ctx)
+	}
+
+	uploadCtx, cancel := context.WithCancel(ctx)
+	d.nextID++
+	id := d.nextID
+	upload := &inflightUpload{filename: filename, startedAt: time.Now(), cancel: cancel}
+	d.uploads[id] = upload
+
+	finish := func() bool {
+		cancel()
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		delete(d.uploads, id)
+		return upload.aborted
+	}
+	return uploadCtx, finish, nil
+}
+
+// ensureAccepting возвращает ошибку, если сервис останавливается и новые загрузки не принимаются
+func (d *uploadDrainer) ensureAccepting(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.draining {
+		return errcatalog.FileServiceShuttingDown(ctx)
+	}
+	return nil
+}
+
+func (d *uploadDrainer) count() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.uploads)
+}
+
+// waitIdle ждет завершения всех загрузок не дольше timeout
+func (d *uploadDrainer) waitIdle(ctx context.Context, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for d.count() > 0 {
+		if !time.Now().Before(deadline) {
+			return false
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(uploadDrainPollInterval):
+		}
+	}
+	return true
+}
+
+// abortAll отменяет оставшиеся загрузки; S3 сервис прерывает их multipart загрузки
+func (d *uploadDrainer) abortAll() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, upload := range d.uploads {
+		utils.Logger.Warn("Aborting in-flight upload on shutdown",
+			zap.String("filename", upload.filename),
+			zap.Duration("elapsed", time.Since(upload.startedAt)))
+		upload.aborted = true
+		upload.cancel()
+	}
+	return len(d.uploads)
+}
+
+// DrainUploads останавливает прием новых загрузок, ждет выполняющиеся в течение UploadDrainGracePeriod,
+// отменяет оставшиеся (их multipart загрузки в S3 прерываются) и логирует итог.
+// Вызывается при получении сигнала завершения до остановки HTTP сервера.
+func DrainUploads(ctx context.Context) UploadDrainSummary {
+	start := time.Now()
+	grace := UploadDrainGracePeriod()
+
+	uploadsInFlight.mu.Lock()
+	uploadsInFlight.draining = true
+	inFlight := len(uploadsInFlight.uploads)
+	uploadsInFlight.mu.Unlock()
+
+	summary := UploadDrainSummary{InFlight: inFlight}
+	if inFlight > 0 {
+		utils.Logger.Info("Draining in-flight uploads",
+			zap.Int("in_flight", inFlight),
+			zap.Duration("grace_period", grace))
+
+		if !uploadsInFlight.waitIdle(ctx, grace) {
+			summary.Aborted = uploadsInFlight.abortAll()
+			if !uploadsInFlight.waitIdle(ctx, uploadDrainAbortWait) {
+				utils.Logger.Warn("Some aborted uploads did not finish cleanup before shutdown",
+					zap.Int("remaining", uploadsInFlight.count()))
+			}
+		}
+		summary.Completed = summary.InFlight - summary.Aborted
+	}
+	summary.Duration = time.Since(start)
+
+	utils.Logger.Info("Upload drain complete",
+		zap.Int("in_flight", summary.InFlight),
+		zap.Int("completed", summary.Completed),
+		zap.Int("aborted", summary.Aborted),
+		zap.Duration("duration", summary.Duration))
+
+	return summary
+}
@@ -0,0 +1,179 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/schema/filemeta"
+	"main/ent/schema/mixin"
+	"main/errcatalog"
+	"main/privacy"
+	"main/services/audit"
+	"main/utils"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxUploadFolderLength максимальная длина пути папки в символах
+	maxUploadFolderLength = 255
+	// fileExpireBatch количество файлов с истекшим сроком хранения, обрабатываемых за один запрос
+	fileExpireBatch = 100
+)
+
+// UploadMetadataInput структурированные метаданные, передаваемые вместе с файлом в той же мутации
+type UploadMetadataInput struct {
+	// Tags теги файла (пробелы по краям обрезаются, повторы удаляются), отсутствующие теги создаются
+	Tags []string
+	// Folder папка файла, используется раскладкой архива BY_FOLDER
+	Folder *string
+	// ExpiresAt срок хранения: после него файл перемещается в корзину
+	ExpiresAt *time.Time
+	// EntityType и EntityID сущность другого сервиса, к которой прикреплен файл (задаются вместе)
+	EntityType *file.EntityType
+	EntityID   *uuid.UUID
+	// Attributes произвольные ключи metadata интеграций; проверяются схемой metadata тенанта
+	Attributes map[string]interface{}
+}
+
+// buildUploadMetadata проверяет метаданные загрузки и возвращает значение поля metadata (теги хранятся
+// связью с Tag и проверяются normalizeTagNames). Вызывается до сохранения объекта в S3, чтобы некорректный запрос не оставлял загруженных данных.
+func buildUploadMetadata(ctx context.Context, input *UploadMetadataInput) (map[string]interface{}, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	metadata := make(map[string]interface{})
+
+	if input.Folder != nil {
+		folder := strings.Trim(strings.TrimSpace(*input.Folder), "/")
+		if utf8.RuneCountInString(folder) > maxUploadFolderLength || strings.Contains(folder, "..") {
+			return nil, errcatalog.FileMetadataInvalidFolder(ctx)
+		}
+		if folder != "" {
+			metadata[ArchiveFolderMetadataKey] = folder
+		}
+	}
+
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return nil, errcatalog.FileMetadataExpiresInPast(ctx)
+	}
+
+	if (input.EntityType == nil) != (input.EntityID == nil) {
+		return nil, errcatalog.FileMetadataInvalidEntity(ctx)
+	}
+
+	if err := mergeMetadataAttributes(ctx, metadata, input.Attributes); err != nil {
+		return nil, err
+	}
+
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+	return metadata, nil
+}
+
+// mergeMetadataAttributes добавляет в metadata атрибуты клиента; служебные ключи сервиса задать нельзя
+func mergeMetadataAttributes(ctx context.Context, metadata, attributes map[string]interface{}) error {
+	for key, value := range attributes {
+		if slices.Contains(filemeta.ServiceKeys, key) {
+			return errcatalog.FileMetadataReservedKey(ctx, key)
+		}
+		metadata[key] = value
+	}
+	return nil
+}
+
+// replaceMetadataAttributes возвращает metadata, в которых атрибуты клиента заменены новыми,
+// а служебные ключи сервиса сохранены
+func replaceMetadataAttributes(ctx context.Context, current, attributes map[string]interface{}) (map[string]interface{}, error) {
+	metadata := make(map[string]interface{}, len(attributes)+len(filemeta.ServiceKeys))
+	for _, key := range filemeta.ServiceKeys {
+		if value, ok := current[key]; ok {
+			metadata[key] = value
+		}
+	}
+	if err := mergeMetadataAttributes(ctx, metadata, attributes); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// isMetadataSchemaError проверяет, что сохранение файла отклонено проверкой metadata по схеме тенанта
+// (hooks.WithFileMetadataSchema): такая ошибка возвращается клиенту вместо общей ошибки сохранения
+func isMetadataSchemaError(err error) bool {
+	switch errcatalog.KeyOf(err) {
+	case errcatalog.KeyFileMetadataRequiredKey, errcatalog.KeyFileMetadataInvalidType,
+		errcatalog.KeyFileMetadataUnknownKey, errcatalog.KeyFileMetadataSchemaCheckFailed:
+		return true
+	}
+	return false
+}
+
+// ExpireFiles перемещает в корзину файлы всех тенантов, срок хранения которых истек.
+// Дальше файлы удаляются обычной очисткой корзины по FILE_TRASH_RETENTION.
+// Файлы под удержанием S3 Object Lock остаются на месте до окончания удержания.
+func (s *FileService) ExpireFiles(ctx context.Context, client *ent.Client) (int, error) {
+	systemCtx, cancel := mixin.SkipTenantFilterFor(ent.NewContext(privacy.WithSystemContext(ctx), client),
+		"move expired files of all tenants to trash", fileTrashPurgeTimeout)
+	defer cancel()
+
+	expired := 0
+	for ctx.Err() == nil {
+		now := time.Now()
+		files, err := client.File.Query().
+			Where(file.ExpiresAtNotNil(), file.ExpiresAtLTE(now), ObjectLockReleased(now)).
+			Order(ent.Asc(file.FieldExpiresAt), ent.Asc(file.FieldID)).
+			Limit(fileExpireBatch).
+			All(systemCtx)
+		if err != nil {
+			return expired, fmt.Errorf("failed to select expired files: %w", err)
+		}
+
+		for _, fileRecord := range files {
+			// Запрос не видит файлы в корзине, поэтому условие на deleted_at защищает только от гонки с удалением
+			updated, err := client.File.Update().
+				Where(file.ID(fileRecord.ID), file.DeletedAtIsNil()).
+				SetDeletedAt(now).
+				Save(systemCtx)
+			if err != nil {
+				return expired, fmt.Errorf("failed to move expired file to trash: %w", err)
+			}
+			if updated == 0 {
+				continue
+			}
+			expired++
+
+			// 📊 [AUDIT] Системная операция: тенант задается явно
+			fileID, tenantID := fileRecord.ID, fileRecord.TenantID
+			s.auditService.Record(ctx, client, audit.Event{
+				Action:   fileauditevent.ActionDELETE,
+				TenantID: &tenantID,
+				FileID:   &fileID,
+				Details: map[string]interface{}{
+					"filename":   fileRecord.OriginalName,
+					"size":       fileRecord.Size,
+					"trashed":    true,
+					"expired":    true,
+					"expires_at": fileRecord.ExpiresAt,
+				},
+			})
+		}
+
+		if len(files) < fileExpireBatch {
+			break
+		}
+	}
+
+	if expired > 0 {
+		utils.Logger.Info("Expired files moved to trash", zap.Int("expired", expired))
+	}
+	return expired, nil
+}
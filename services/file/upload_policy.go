@@ -0,0 +1,48 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/errcatalog"
+	"strings"
+)
+
+// effectiveMaxUploadSize возвращает действующий лимит размера файла тенанта из контекста
+func (s *FileService) effectiveMaxUploadSize(ctx context.Context, client *ent.Client) (int64, error) {
+	policy, err := s.uploadPolicyService.GetPolicy(ctx, client)
+	if err != nil {
+		return 0, err
+	}
+	return policy.EffectiveMaxUploadSize(), nil
+}
+
+// enforceUploadPolicy проверяет загрузку по политике тенанта: размер файла, описание и тег классификации
+// из справочника. В отличие от необязательных проверок загрузки, ошибка чтения политики отклоняет загрузку.
+func (s *FileService) enforceUploadPolicy(ctx context.Context, client *ent.Client, size int64, description *string, tags []string) error {
+	policy, err := s.uploadPolicyService.GetPolicy(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if size > policy.EffectiveMaxUploadSize() {
+		return errcatalog.FileSizeTooLarge(ctx)
+	}
+
+	if policy.RequireDescription && (description == nil || strings.TrimSpace(*description) == "") {
+		return errcatalog.FileDescriptionRequired(ctx)
+	}
+
+	if len(policy.ClassificationTags) == 0 {
+		return nil
+	}
+	allowed := make(map[string]struct{}, len(policy.ClassificationTags))
+	for _, tag := range policy.ClassificationTags {
+		allowed[tag] = struct{}{}
+	}
+	for _, tag := range tags {
+		if _, ok := allowed[tag]; ok {
+			return nil
+		}
+	}
+	return errcatalog.FileClassificationTagRequired(ctx, strings.Join(policy.ClassificationTags, ", "))
+}
@@ -0,0 +1,123 @@
+package file
+
+import (
+	"context"
+	"io"
+	"main/utils"
+	"main/websocket"
+	"sync"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"go.uber.org/zap"
+)
+
+const (
+	// UploadProgressHeader заголовок с идентификатором загрузки, выданным клиентом; без него прогресс не публикуется
+	UploadProgressHeader = "X-Upload-Progress-Id"
+	// uploadProgressEntityType тип события прогресса; канал {tenantID}:upload_progress_user_{userID}
+	uploadProgressEntityType = "upload_progress_user"
+	// uploadProgressInterval минимальный интервал между событиями прогресса одной загрузки
+	uploadProgressInterval = 500 * time.Millisecond
+)
+
+// Этапы загрузки на стороне сервера
+const (
+	// UploadPhaseReceiving прием тела запроса от клиента
+	UploadPhaseReceiving = "receiving"
+	// UploadPhaseStoring передача файла в S3
+	UploadPhaseStoring = "storing"
+)
+
+type uploadProgressKey struct{}
+
+// WithUploadProgressID сохраняет в контексте идентификатор загрузки для публикации прогресса
+func WithUploadProgressID(ctx context.Context, progressID string) context.Context {
+	return context.WithValue(ctx, uploadProgressKey{}, progressID)
+}
+
+func getUploadProgressID(ctx context.Context) string {
+	progressID, _ := ctx.Value(uploadProgressKey{}).(string)
+	return progressID
+}
+
+// progressReader считает прочитанные байты и публикует прогресс пользователю не чаще uploadProgressInterval
+type progressReader struct {
+	ctx        context.Context
+	reader     io.Reader
+	progressID string
+	phase      string
+	filename   string
+	total      int64
+
+	mu            sync.Mutex
+	read          int64
+	lastPublished time.Time
+	done          bool
+}
+
+// NewUploadProgressReader оборачивает поток загрузки публикацией прогресса этапа phase.
+// Если в контексте нет идентификатора загрузки или пользователя, поток возвращается без изменений.
+// total - ожидаемый размер в байтах (0 или меньше, если неизвестен).
+func NewUploadProgressReader(ctx context.Context, reader io.Reader, phase, filename string, total int64) io.Reader {
+	progressID := getUploadProgressID(ctx)
+	if progressID == "" || federation.GetUserID(ctx) == nil || federation.GetTenantID(ctx) == nil {
+		return reader
+	}
+	return &progressReader{
+		ctx:        ctx,
+		reader:     reader,
+		progressID: progressID,
+		phase:      phase,
+		filename:   filename,
+		total:      total,
+	}
+}
+
+func (p *progressReader) Read(buf []byte) (int, error) {
+	n, err := p.reader.Read(buf)
+
+	p.mu.Lock()
+	p.read += int64(n)
+	finished := err == io.EOF && !p.done
+	if finished {
+		p.done = true
+	}
+	publish := finished || (n > 0 && time.Since(p.lastPublished) >= uploadProgressInterval)
+	if publish {
+		p.lastPublished = time.Now()
+	}
+	read := p.read
+	p.mu.Unlock()
+
+	if publish {
+		p.publish(read, finished)
+	}
+	return n, err
+}
+
+// publish отправляет событие прогресса; ошибки публикации не прерывают загрузку
+func (p *progressReader) publish(read int64, finished bool) {
+	metadata := map[string]any{
+		"upload_id": p.progressID,
+		"phase":     p.phase,
+		"bytes":     read,
+		"done":      finished,
+	}
+	if p.filename != "" {
+		metadata["filename"] = p.filename
+	}
+	if p.total > 0 {
+		metadata["total"] = p.total
+		metadata["percent"] = min(100, read*100/p.total)
+	}
+
+	userID := federation.GetUserID(p.ctx)
+	err := websocket.NewPublisher().PublishEntityEvent(p.ctx, uploadProgressEntityType, *userID, websocket.EntityActionProgress, metadata)
+	if err != nil {
+		utils.Logger.Debug("Failed to publish upload progress",
+			zap.Error(err),
+			zap.String("upload_id", p.progressID),
+			zap.String("phase", p.phase))
+	}
+}
@@ -0,0 +1,77 @@
+package file
+
+import (
+	"context"
+	"main/ent/file"
+	"strings"
+)
+
+const (
+	// UploadSourceHeader заголовок, которым gateway передает источник запроса: web, mobile, api-token, email-in, import
+	UploadSourceHeader = "X-Upload-Source"
+	// ClientVersionHeader заголовок, которым gateway передает версию клиента
+	ClientVersionHeader = "X-Client-Version"
+	// maxClientVersionLength максимальная длина сохраняемой версии клиента
+	maxClientVersionLength = 64
+)
+
+// uploadSourceKey ключ контекста с источником загрузки
+type uploadSourceKey struct{}
+
+// uploadSource источник загрузки и версия клиента из заголовков gateway
+type uploadSource struct {
+	source        file.UploadSource
+	clientVersion string
+}
+
+// WithUploadSource сохраняет в контексте источник загрузки и версию клиента.
+// Неизвестный источник сохраняется как UNKNOWN, чтобы версия клиента не терялась.
+func WithUploadSource(ctx context.Context, source, clientVersion string) context.Context {
+	value := uploadSource{source: file.UploadSourceUNKNOWN}
+
+	normalized := file.UploadSource(strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(source), "-", "_")))
+	if normalized != "" && file.UploadSourceValidator(normalized) == nil {
+		value.source = normalized
+	}
+
+	clientVersion = strings.TrimSpace(clientVersion)
+	if len(clientVersion) > maxClientVersionLength {
+		clientVersion = clientVersion[:maxClientVersionLength]
+	}
+	value.clientVersion = clientVersion
+
+	if value.source == file.UploadSourceUNKNOWN && value.clientVersion == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, uploadSourceKey{}, value)
+}
+
+// uploadSourceFromContext возвращает источник загрузки и версию клиента (nil, если gateway ее не передал)
+func uploadSourceFromContext(ctx context.Context) (file.UploadSource, *string) {
+	value, ok := ctx.Value(uploadSourceKey{}).(uploadSource)
+	if !ok {
+		return file.UploadSourceUNKNOWN, nil
+	}
+	if value.clientVersion == "" {
+		return value.source, nil
+	}
+	clientVersion := value.clientVersion
+	return value.source, &clientVersion
+}
+
+// uploadSourceOrUnknown возвращает UNKNOWN для загрузок, начатых до появления учета источника
+func uploadSourceOrUnknown(source file.UploadSource) file.UploadSource {
+	if source == "" {
+		return file.UploadSourceUNKNOWN
+	}
+	return source
+}
+
+// uploadSourceAuditDetails дополняет детали события аудита загрузки источником и версией клиента
+func uploadSourceAuditDetails(details map[string]interface{}, source file.UploadSource, clientVersion *string) map[string]interface{} {
+	details["source"] = source.String()
+	if clientVersion != nil {
+		details["client_version"] = *clientVersion
+	}
+	return details
+}
@@ -0,0 +1,243 @@
+package file
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/file"
+	"main/ent/fileauditevent"
+	"main/ent/schema/mixin"
+	"main/ent/userstoragelimit"
+	"main/errcatalog"
+	"main/services/audit"
+	"main/utils"
+	"sort"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// UserStorageLimitInfo лимиты загрузок пользователя вместе с текущим использованием
+type UserStorageLimitInfo struct {
+	UserID uuid.UUID
+	// MaxFiles максимальное количество файлов (nil - без ограничения)
+	MaxFiles *int
+	// MaxBytes максимальный суммарный размер файлов в байтах (nil - без ограничения)
+	MaxBytes *int64
+	// UsedBytes и FileCount учитывают файлы в корзине: они занимают место до окончательного удаления
+	UsedBytes int64
+	FileCount int
+}
+
+// queryUserUsage считает суммарный размер и количество файлов, загруженных пользователями (включая корзину)
+func queryUserUsage(ctx context.Context, client *ent.Client, userIDs ...uuid.UUID) (map[uuid.UUID]*UserStorageLimitInfo, error) {
+	var rows []struct {
+		CreatedBy uuid.UUID `json:"created_by"`
+		Count     int       `json:"count"`
+		Sum       int64     `json:"sum"`
+	}
+	err := client.File.Query().
+		Where(file.CreatedByIn(userIDs...)).
+		GroupBy(file.FieldCreatedBy).
+		Aggregate(ent.Count(), ent.Sum(file.FieldSize)).
+		Scan(mixin.SkipSoftDelete(ent.NewContext(ctx, client)), &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make(map[uuid.UUID]*UserStorageLimitInfo, len(rows))
+	for _, row := range rows {
+		usage[row.CreatedBy] = &UserStorageLimitInfo{
+			UserID:    row.CreatedBy,
+			UsedBytes: row.Sum,
+			FileCount: row.Count,
+		}
+	}
+	return usage, nil
+}
+
+// getUserStorageLimit возвращает лимиты пользователя (nil, если они не заданы)
+func getUserStorageLimit(ctx context.Context, client *ent.Client, userID uuid.UUID) (*ent.UserStorageLimit, error) {
+	limit, err := client.UserStorageLimit.Query().
+		Where(userstoragelimit.UserID(userID)).
+		Only(ent.NewContext(ctx, client))
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return limit, err
+}
+
+// checkUserStorageLimit проверяет лимиты количества и объема файлов загружающего пользователя.
+// Лимиты действуют жестко независимо от режима квоты тенанта и не дают одной учетной записи
+// (например, интеграции) занять все хранилище тенанта; без заданных лимитов загрузка не ограничивается.
+func (s *FileService) checkUserStorageLimit(ctx context.Context, client *ent.Client, filename string, size int64) error {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil
+	}
+
+	limit, err := getUserStorageLimit(ctx, client, *userID)
+	if err != nil {
+		utils.Logger.Warn("Failed to get user storage limit, proceeding without user limit check",
+			zap.Error(err),
+			zap.String("user_id", userID.String()))
+		return nil
+	}
+	if limit == nil {
+		return nil
+	}
+
+	usage, err := queryUserUsage(ctx, client, *userID)
+	if err != nil {
+		utils.Logger.Warn("Failed to get user storage usage, proceeding without user limit check",
+			zap.Error(err),
+			zap.String("user_id", userID.String()))
+		return nil
+	}
+	current := &UserStorageLimitInfo{UserID: *userID}
+	if userUsage, ok := usage[*userID]; ok {
+		current = userUsage
+	}
+
+	var reason string
+	var limitErr error
+	switch {
+	case limit.MaxFiles != nil && current.FileCount+1 > *limit.MaxFiles:
+		reason = "user_file_limit_exceeded"
+		limitErr = errcatalog.FileUserFileLimitExceeded(ctx, *limit.MaxFiles)
+	case limit.MaxBytes != nil && current.UsedBytes+size > *limit.MaxBytes:
+		reason = "user_storage_limit_exceeded"
+		limitErr = errcatalog.FileUserStorageLimitExceeded(ctx)
+	default:
+		return nil
+	}
+
+	utils.Logger.Info("User storage limit exceeded",
+		zap.String("user_id", userID.String()),
+		zap.String("reason", reason),
+		zap.String("filename", filename),
+		zap.Int64("file_size", size),
+		zap.Int("file_count", current.FileCount),
+		zap.Int64("current_usage", current.UsedBytes))
+
+	// 📊 [AUDIT] Фиксируем попытку превышения лимита пользователя
+	details := map[string]interface{}{
+		"reason":        reason,
+		"filename":      filename,
+		"file_size":     size,
+		"file_count":    current.FileCount,
+		"current_usage": current.UsedBytes,
+	}
+	if limit.MaxFiles != nil {
+		details["max_files"] = *limit.MaxFiles
+	}
+	if limit.MaxBytes != nil {
+		details["max_bytes"] = *limit.MaxBytes
+	}
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:  fileauditevent.ActionLIMIT_VIOLATION,
+		Details: details,
+	})
+
+	return limitErr
+}
+
+// GetUserStorageLimits возвращает пользователей тенанта с заданными лимитами и их использование,
+// начиная с наибольшего объема
+func (s *FileService) GetUserStorageLimits(ctx context.Context, client *ent.Client) ([]*UserStorageLimitInfo, error) {
+	limits, err := client.UserStorageLimit.Query().All(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Error("Failed to get user storage limits", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+	if len(limits) == 0 {
+		return []*UserStorageLimitInfo{}, nil
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(limits))
+	for _, limit := range limits {
+		userIDs = append(userIDs, limit.UserID)
+	}
+	usage, err := queryUserUsage(ctx, client, userIDs...)
+	if err != nil {
+		utils.Logger.Error("Failed to aggregate user storage usage", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+
+	result := make([]*UserStorageLimitInfo, 0, len(limits))
+	for _, limit := range limits {
+		info, ok := usage[limit.UserID]
+		if !ok {
+			info = &UserStorageLimitInfo{UserID: limit.UserID}
+		}
+		info.MaxFiles, info.MaxBytes = limit.MaxFiles, limit.MaxBytes
+		result = append(result, info)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].UsedBytes != result[j].UsedBytes {
+			return result[i].UsedBytes > result[j].UsedBytes
+		}
+		return result[i].UserID.String() < result[j].UserID.String()
+	})
+	return result, nil
+}
+
+// SetUserStorageLimit задает лимиты загрузок пользователя; nil снимает соответствующее ограничение,
+// оба nil удаляют лимиты пользователя. Уже загруженные файлы не удаляются, ограничиваются только новые загрузки.
+func (s *FileService) SetUserStorageLimit(ctx context.Context, client *ent.Client, userID uuid.UUID, maxFiles *int, maxBytes *int64) (*UserStorageLimitInfo, error) {
+	if (maxFiles != nil && *maxFiles <= 0) || (maxBytes != nil && *maxBytes <= 0) {
+		return nil, errcatalog.FileInvalidUserStorageLimit(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	limit, err := getUserStorageLimit(ctx, client, userID)
+	if err != nil {
+		return nil, errcatalog.FileUserStorageLimitUpdateFailed(ctx)
+	}
+
+	switch {
+	case maxFiles == nil && maxBytes == nil:
+		if limit != nil {
+			err = client.UserStorageLimit.DeleteOne(limit).Exec(ctxWithClient)
+		}
+	case limit == nil:
+		err = client.UserStorageLimit.Create().
+			SetUserID(userID).
+			SetNillableMaxFiles(maxFiles).
+			SetNillableMaxBytes(maxBytes).
+			Exec(ctxWithClient)
+	default:
+		update := client.UserStorageLimit.UpdateOne(limit).
+			SetNillableMaxFiles(maxFiles).
+			SetNillableMaxBytes(maxBytes)
+		if maxFiles == nil {
+			update = update.ClearMaxFiles()
+		}
+		if maxBytes == nil {
+			update = update.ClearMaxBytes()
+		}
+		err = update.Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save user storage limit", zap.Error(err), zap.String("user_id", userID.String()))
+		return nil, errcatalog.FileUserStorageLimitUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("User storage limit changed",
+		zap.String("user_id", userID.String()),
+		zap.Intp("max_files", maxFiles),
+		zap.Int64p("max_bytes", maxBytes))
+
+	usage, err := queryUserUsage(ctx, client, userID)
+	if err != nil {
+		utils.Logger.Error("Failed to aggregate user storage usage", zap.Error(err))
+		return nil, errcatalog.FileStorageStatsFailed(ctx)
+	}
+	result, ok := usage[userID]
+	if !ok {
+		result = &UserStorageLimitInfo{UserID: userID}
+	}
+	result.MaxFiles, result.MaxBytes = maxFiles, maxBytes
+	return result, nil
+}
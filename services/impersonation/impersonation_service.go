@@ -0,0 +1,244 @@
+package impersonation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"main/database"
+	"main/ent"
+	"main/ent/fileauditevent"
+	"main/ent/impersonationgrant"
+	"main/errcatalog"
+	"main/security"
+	"main/services/audit"
+	"main/types"
+	"main/utils"
+	"strings"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// TokenHeader заголовок, которым администратор поддержки передает токен сессии от имени пользователя
+	TokenHeader = "X-Impersonation-Token"
+	// MaxDurationMinutes максимальная длительность доступа поддержки
+	MaxDurationMinutes = 120
+	// MaxReasonLength максимальная длина причины выдачи доступа
+	MaxReasonLength = 500
+	// tokenPrefix отличает токен сессии поддержки от других секретов в логах и менеджерах паролей
+	tokenPrefix = "imp_"
+	// tokenBytes длина случайной части токена
+	tokenBytes = 32
+)
+
+// GrantInput параметры выдачи доступа поддержки
+type GrantInput struct {
+	SupportUserID       uuid.UUID
+	TargetUserID        uuid.UUID
+	TargetRole          impersonationgrant.TargetRole
+	TargetDepartmentIDs []uuid.UUID
+	DurationMinutes     int
+	Reason              string
+}
+
+// ImpersonationService выдает, проверяет и отзывает доступ поддержки от имени пользователя тенанта.
+// Доступ выдает владелец на ограниченное время; сессия работает с ролью не выше сотрудника и только на чтение.
+type ImpersonationService struct {
+	auditService *audit.AuditService
+}
+
+// NewImpersonationService creates a new impersonation service
+func NewImpersonationService() *ImpersonationService {
+	return &ImpersonationService{
+		auditService: audit.NewAuditService(),
+	}
+}
+
+// hashToken возвращает SHA-256 токена: в БД хранится только хеш
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// newToken генерирует токен сессии поддержки
+func newToken() (string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return tokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Grant выдает администратору поддержки доступ от имени пользователя и возвращает токен сессии.
+// Токен возвращается только здесь; в БД сохраняется его хеш.
+func (s *ImpersonationService) Grant(ctx context.Context, client *ent.Client, input GrantInput) (*ent.ImpersonationGrant, string, error) {
+	ownerID := federation.GetUserID(ctx)
+	if ownerID == nil {
+		return nil, "", errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" || len(reason) > MaxReasonLength {
+		return nil, "", errcatalog.ImpersonationInvalidReason(ctx)
+	}
+	if input.DurationMinutes < 1 || input.DurationMinutes > MaxDurationMinutes {
+		return nil, "", errcatalog.ImpersonationInvalidDuration(ctx, MaxDurationMinutes)
+	}
+	if impersonationgrant.TargetRoleValidator(input.TargetRole) != nil {
+		return nil, "", errcatalog.ImpersonationInvalidRole(ctx)
+	}
+	if input.SupportUserID == input.TargetUserID || input.TargetUserID == *ownerID {
+		return nil, "", errcatalog.ImpersonationInvalidTarget(ctx)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		utils.Logger.Error("Failed to generate impersonation token", zap.Error(err))
+		return nil, "", errcatalog.ImpersonationGrantFailed(ctx)
+	}
+
+	grant, err := client.ImpersonationGrant.Create().
+		SetSupportUserID(input.SupportUserID).
+		SetTargetUserID(input.TargetUserID).
+		SetTargetRole(input.TargetRole).
+		SetTargetDepartmentIds(input.TargetDepartmentIDs).
+		SetGrantedBy(*ownerID).
+		SetReason(reason).
+		SetTokenHash(hashToken(token)).
+		SetExpiresAt(time.Now().Add(time.Duration(input.DurationMinutes) * time.Minute)).
+		Save(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Error("Failed to save impersonation grant", zap.Error(err))
+		return nil, "", errcatalog.ImpersonationGrantFailed(ctx)
+	}
+
+	// 📊 [AUDIT] Фиксируем выдачу доступа поддержки
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:  fileauditevent.ActionIMPERSONATION_GRANTED,
+		Details: grantAuditDetails(grant),
+	})
+
+	return grant, token, nil
+}
+
+// Revoke досрочно отзывает доступ. Отозвать может владелец или сам администратор поддержки.
+func (s *ImpersonationService) Revoke(ctx context.Context, client *ent.Client, grantID uuid.UUID) (*ent.ImpersonationGrant, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+	grant, err := client.ImpersonationGrant.Get(ctxWithClient, grantID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errcatalog.ImpersonationNotFound(ctx)
+		}
+		return nil, errcatalog.ImpersonationRevokeFailed(ctx)
+	}
+	if federation.GetUserRole(ctx) != types.RoleOwner && grant.SupportUserID != *userID {
+		return nil, errcatalog.ImpersonationNotFound(ctx)
+	}
+	if grant.RevokedAt != nil {
+		return grant, nil
+	}
+
+	grant, err = client.ImpersonationGrant.UpdateOne(grant).
+		SetRevokedAt(time.Now()).
+		Save(ctxWithClient)
+	if err != nil {
+		utils.Logger.Error("Failed to revoke impersonation grant", zap.Error(err), zap.String("grant_id", grantID.String()))
+		return nil, errcatalog.ImpersonationRevokeFailed(ctx)
+	}
+
+	// 📊 [AUDIT] Фиксируем отзыв доступа поддержки
+	s.auditService.Record(ctx, client, audit.Event{
+		Action:  fileauditevent.ActionIMPERSONATION_REVOKED,
+		Details: grantAuditDetails(grant),
+	})
+
+	return grant, nil
+}
+
+// List возвращает выданные доступы, начиная с новых: владельцу - все, администратору - выданные ему
+func (s *ImpersonationService) List(ctx context.Context, client *ent.Client, activeOnly bool) ([]*ent.ImpersonationGrant, error) {
+	userID := federation.GetUserID(ctx)
+	if userID == nil {
+		return nil, errcatalog.UserNotAuthenticated(ctx)
+	}
+
+	query := client.ImpersonationGrant.Query()
+	if federation.GetUserRole(ctx) != types.RoleOwner {
+		query = query.Where(impersonationgrant.SupportUserID(*userID))
+	}
+	if activeOnly {
+		query = query.Where(
+			impersonationgrant.RevokedAtIsNil(),
+			impersonationgrant.ExpiresAtGT(time.Now()),
+		)
+	}
+
+	grants, err := query.
+		Order(ent.Desc(impersonationgrant.FieldCreateTime)).
+		All(ent.NewContext(ctx, client))
+	if err != nil {
+		utils.Logger.Error("Failed to list impersonation grants", zap.Error(err))
+		return nil, errcatalog.ImpersonationGetFailed(ctx)
+	}
+	return grants, nil
+}
+
+// Authorize проверяет токен сессии поддержки для текущего пользователя и возвращает контекст,
+// в котором запросы выполняются от имени пользователя тенанта. Токен действует только для
+// администратора, которому выдан, в тенанте выдачи и до истечения или отзыва.
+func (s *ImpersonationService) Authorize(ctx context.Context, client *ent.Client, token string) (context.Context, error) {
+	fedCtx := federation.GetContext(ctx)
+	if fedCtx == nil || fedCtx.UserID == nil || fedCtx.TenantID == nil ||
+		!types.IsRoleHigherOrEqual(fedCtx.UserRole, types.RoleAdmin) {
+		return ctx, errcatalog.ImpersonationInvalidToken(ctx)
+	}
+
+	grant, err := client.ImpersonationGrant.Query().
+		Where(impersonationgrant.TokenHash(hashToken(token))).
+		Only(ent.NewContext(database.SkipCache(ctx), client))
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			utils.Logger.Error("Failed to check impersonation token", zap.Error(err))
+		}
+		return ctx, errcatalog.ImpersonationInvalidToken(ctx)
+	}
+	if grant.SupportUserID != *fedCtx.UserID || grant.RevokedAt != nil || !time.Now().Before(grant.ExpiresAt) {
+		return ctx, errcatalog.ImpersonationInvalidToken(ctx)
+	}
+
+	// Копия контекста федерации: администратор поддержки видит сервис так же, как пользователь
+	impersonated := *fedCtx
+	impersonated.UserID = &grant.TargetUserID
+	impersonated.UserRole = strings.ToLower(string(grant.TargetRole))
+	impersonated.DepartmentIDs = grant.TargetDepartmentIds
+	impersonated.ManagedDepartmentIDs = nil
+
+	ctx = federation.WithContext(ctx, &impersonated)
+	return security.WithImpersonation(ctx, &security.Impersonation{
+		GrantID:          grant.ID,
+		ImpersonatorID:   *fedCtx.UserID,
+		ImpersonatorRole: fedCtx.UserRole,
+	}), nil
+}
+
+// grantAuditDetails формирует детали события аудита для доступа поддержки
+func grantAuditDetails(grant *ent.ImpersonationGrant) map[string]interface{} {
+	return map[string]interface{}{
+		"grant_id":        grant.ID.String(),
+		"support_user_id": grant.SupportUserID.String(),
+		"target_user_id":  grant.TargetUserID.String(),
+		"target_role":     string(grant.TargetRole),
+		"reason":          grant.Reason,
+		"expires_at":      grant.ExpiresAt.UTC().Format(time.RFC3339),
+	}
+}
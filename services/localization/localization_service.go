@@ -0,0 +1,276 @@
+package localization
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"main/ent"
+	"main/ent/translationoverride"
+	"main/errcatalog"
+	"main/redis"
+	"main/utils"
+	"strings"
+
+	federation "github.com/esemashko/v2-federation"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxOverrideTextLength максимальная длина текста переопределения
+	MaxOverrideTextLength = 1000
+)
+
+// SupportedLanguages языки, для которых собран базовый bundle (locales/build)
+var SupportedLanguages = []string{"en", "ru"}
+
+// LocalizationService управляет языком тенанта по умолчанию и переопределениями терминологии
+type LocalizationService struct{}
+
+// NewLocalizationService creates a new localization service
+func NewLocalizationService() *LocalizationService {
+	return &LocalizationService{}
+}
+
+// OverrideInput содержит параметры переопределения сообщения
+type OverrideInput struct {
+	MessageID string
+	Language  string
+	Text      string
+}
+
+// isSupportedLanguage проверяет, что язык поддерживается базовым bundle
+func isSupportedLanguage(lang string) bool {
+	for _, supported := range SupportedLanguages {
+		if lang == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// ContextWithTenantLocale загружает настройки локализации тенанта и добавляет их в контекст.
+// Ошибки загрузки не прерывают запрос - используется базовый bundle.
+func (s *LocalizationService) ContextWithTenantLocale(ctx context.Context, client *ent.Client) context.Context {
+	if federation.GetTenantID(ctx) == nil {
+		return ctx
+	}
+
+	locale, err := s.GetTenantLocale(ctx, client)
+	if err != nil {
+		utils.Logger.Warn("Failed to load tenant locale, using base bundle",
+			zap.Error(err))
+		return ctx
+	}
+
+	return utils.WithTenantLocale(ctx, locale)
+}
+
+// GetTenantLocale возвращает настройки локализации тенанта (Redis cache-first, затем БД)
+func (s *LocalizationService) GetTenantLocale(ctx context.Context, client *ent.Client) (*utils.TenantLocale, error) {
+	tenantID := federation.GetTenantID(ctx)
+	if tenantID == nil {
+		return nil, fmt.Errorf("tenant ID not found in context")
+	}
+	cacheKey := redis.GetTenantLocaleKey(tenantID.String())
+
+	// Пробуем получить из Redis
+	cacheService, cacheErr := redis.GetTenantCacheService()
+	if cacheErr == nil {
+		if data, err := cacheService.GetTenantCache(ctx, cacheKey); err == nil {
+			var locale utils.TenantLocale
+			if err := json.Unmarshal(data, &locale); err == nil {
+				return &locale, nil
+			}
+		}
+	}
+
+	// Загружаем из БД
+	locale, err := s.loadTenantLocale(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	// Сохраняем в Redis (ошибки кэша не критичны)
+	if cacheErr == nil {
+		if data, err := json.Marshal(locale); err == nil {
+			_ = cacheService.SetTenantCache(ctx, tenantID.String(), cacheKey, data)
+		}
+	}
+
+	return locale, nil
+}
+
+// loadTenantLocale читает настройки и переопределения тенанта из БД
+func (s *LocalizationService) loadTenantLocale(ctx context.Context, client *ent.Client) (*utils.TenantLocale, error) {
+	ctxWithClient := ent.NewContext(ctx, client)
+	locale := &utils.TenantLocale{
+		Overrides: make(map[string]map[string]string),
+	}
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to load tenant setting: %w", err)
+	}
+	if setting != nil {
+		locale.DefaultLanguage = setting.DefaultLanguage
+	}
+
+	overrides, err := client.TranslationOverride.Query().
+		Order(ent.Asc(translationoverride.FieldMessageID)).
+		All(ctxWithClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load translation overrides: %w", err)
+	}
+	for _, override := range overrides {
+		if locale.Overrides[override.Language] == nil {
+			locale.Overrides[override.Language] = make(map[string]string)
+		}
+		locale.Overrides[override.Language][override.MessageID] = override.Text
+	}
+
+	return locale, nil
+}
+
+// ListOverrides возвращает переопределения тенанта
+func (s *LocalizationService) ListOverrides(ctx context.Context, client *ent.Client) ([]*ent.TranslationOverride, error) {
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	overrides, err := client.TranslationOverride.Query().
+		Order(ent.Asc(translationoverride.FieldLanguage), ent.Asc(translationoverride.FieldMessageID)).
+		All(ctxWithClient)
+	if err != nil {
+		return nil, errcatalog.LocalizationGetFailed(ctx)
+	}
+	return overrides, nil
+}
+
+// GetDefaultLanguage возвращает язык тенанта по умолчанию (пустая строка, если не задан)
+func (s *LocalizationService) GetDefaultLanguage(ctx context.Context, client *ent.Client) (string, error) {
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", nil
+		}
+		return "", errcatalog.LocalizationGetFailed(ctx)
+	}
+	return setting.DefaultLanguage, nil
+}
+
+// SetDefaultLanguage устанавливает язык тенанта по умолчанию (пустая строка сбрасывает значение)
+func (s *LocalizationService) SetDefaultLanguage(ctx context.Context, client *ent.Client, language string) error {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if language != "" && !isSupportedLanguage(language) {
+		return errcatalog.LocalizationUnsupportedLanguage(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return errcatalog.LocalizationUpdateFailed(ctx)
+	}
+
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetDefaultLanguage(language).
+			Exec(ctxWithClient)
+	} else {
+		err = client.TenantSetting.UpdateOne(setting).
+			SetDefaultLanguage(language).
+			Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant default language", zap.Error(err))
+		return errcatalog.LocalizationUpdateFailed(ctx)
+	}
+
+	return nil
+}
+
+// SetOverride создает или обновляет переопределение сообщения для тенанта
+func (s *LocalizationService) SetOverride(ctx context.Context, client *ent.Client, input OverrideInput) error {
+	messageID := strings.TrimSpace(input.MessageID)
+	language := strings.ToLower(strings.TrimSpace(input.Language))
+	text := strings.TrimSpace(input.Text)
+
+	if messageID == "" || text == "" {
+		return errcatalog.LocalizationInvalidOverride(ctx)
+	}
+	if !isSupportedLanguage(language) {
+		return errcatalog.LocalizationUnsupportedLanguage(ctx)
+	}
+	if len(text) > MaxOverrideTextLength {
+		return errcatalog.LocalizationOverrideTooLong(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	existing, err := client.TranslationOverride.Query().
+		Where(
+			translationoverride.MessageID(messageID),
+			translationoverride.Language(language),
+		).
+		Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return errcatalog.LocalizationUpdateFailed(ctx)
+	}
+
+	if existing == nil {
+		err = client.TranslationOverride.Create().
+			SetMessageID(messageID).
+			SetLanguage(language).
+			SetText(text).
+			Exec(ctxWithClient)
+	} else {
+		err = client.TranslationOverride.UpdateOne(existing).
+			SetText(text).
+			Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save translation override",
+			zap.Error(err),
+			zap.String("message_id", messageID),
+			zap.String("language", language))
+		return errcatalog.LocalizationUpdateFailed(ctx)
+	}
+
+	return nil
+}
+
+// DeleteOverride удаляет переопределение сообщения для тенанта
+func (s *LocalizationService) DeleteOverride(ctx context.Context, client *ent.Client, messageID, language string) error {
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	deleted, err := client.TranslationOverride.Delete().
+		Where(
+			translationoverride.MessageID(strings.TrimSpace(messageID)),
+			translationoverride.Language(strings.ToLower(strings.TrimSpace(language))),
+		).
+		Exec(ctxWithClient)
+	if err != nil {
+		return errcatalog.LocalizationUpdateFailed(ctx)
+	}
+	if deleted == 0 {
+		return errcatalog.LocalizationOverrideNotFound(ctx)
+	}
+
+	return nil
+}
+
+// InvalidateTenantLocaleCache удаляет кэш настроек локализации тенанта.
+// Вызывается после коммита транзакции.
+func (s *LocalizationService) InvalidateTenantLocaleCache(ctx context.Context) {
+	tenantID := federation.GetTenantID(ctx)
+	if tenantID == nil {
+		return
+	}
+
+	cacheService, err := redis.GetTenantCacheService()
+	if err != nil {
+		return
+	}
+
+	_ = cacheService.DeleteTenantCache(ctx, tenantID.String(), redis.GetTenantLocaleKey(tenantID.String()))
+}
@@ -0,0 +1,201 @@
+package tenant
+
+import (
+	"context"
+	"main/ent"
+	"main/errcatalog"
+	"main/utils"
+	"mime"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// maxFileTypePolicyEntries максимальное количество расширений или типов содержимого в одном списке
+const maxFileTypePolicyEntries = 200
+
+// Причины отклонения файла политикой типов (сохраняются в аудите)
+const (
+	FileTypeViolationBlockedExtension    = "blocked_extension"
+	FileTypeViolationExtensionNotAllowed = "extension_not_allowed"
+	FileTypeViolationBlockedMimeType     = "blocked_mime_type"
+	FileTypeViolationMimeTypeNotAllowed  = "mime_type_not_allowed"
+)
+
+var (
+	// fileExtensionPattern допустимое расширение в политике (без точки, в нижнем регистре)
+	fileExtensionPattern = regexp.MustCompile(`^[a-z0-9_+-]{1,20}$`)
+	// mimeTypePattern допустимый тип содержимого в политике: type/subtype или семейство type/*
+	mimeTypePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9!#$&^_.+-]{0,63}/([a-z0-9][a-z0-9!#$&^_.+-]{0,126}|\*)$`)
+)
+
+// FileTypePolicy разрешенные и запрещенные типы загружаемых файлов тенанта.
+// Запреты имеют приоритет; пустой список разрешенных не ограничивает загрузку.
+type FileTypePolicy struct {
+	AllowedExtensions []string
+	BlockedExtensions []string
+	AllowedMimeTypes  []string
+	BlockedMimeTypes  []string
+}
+
+// Violation возвращает причину отклонения файла или пустую строку, если файл разрешен.
+// Расширение берется из имени файла; тип разрешен, если разрешен хотя бы один из переданных
+// (заявленный клиентом или обнаруженный по содержимому), и запрещен, если запрещен любой из них.
+func (p *FileTypePolicy) Violation(filename string, mimeTypes ...string) string {
+	extension := strings.ToLower(strings.TrimPrefix(filepath.Ext(filename), "."))
+	if slices.Contains(p.BlockedExtensions, extension) {
+		return FileTypeViolationBlockedExtension
+	}
+	if len(p.AllowedExtensions) > 0 && !slices.Contains(p.AllowedExtensions, extension) {
+		return FileTypeViolationExtensionNotAllowed
+	}
+
+	allowed := len(p.AllowedMimeTypes) == 0
+	for _, mimeType := range mimeTypes {
+		mimeType = normalizeMimeType(mimeType)
+		if mimeType == "" {
+			continue
+		}
+		if matchesMimeType(p.BlockedMimeTypes, mimeType) {
+			return FileTypeViolationBlockedMimeType
+		}
+		if matchesMimeType(p.AllowedMimeTypes, mimeType) {
+			allowed = true
+		}
+	}
+	if !allowed {
+		return FileTypeViolationMimeTypeNotAllowed
+	}
+	return ""
+}
+
+// TenantFileTypeService управляет разрешенными и запрещенными типами загружаемых файлов тенанта
+type TenantFileTypeService struct{}
+
+// NewTenantFileTypeService creates a new tenant file type service
+func NewTenantFileTypeService() *TenantFileTypeService {
+	return &TenantFileTypeService{}
+}
+
+// GetPolicy возвращает политику типов файлов тенанта; без сохраненных настроек ограничений нет
+func (s *TenantFileTypeService) GetPolicy(ctx context.Context, client *ent.Client) (*FileTypePolicy, error) {
+	setting, err := client.TenantSetting.Query().Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &FileTypePolicy{}, nil
+		}
+		return nil, errcatalog.TenantFileTypePolicyGetFailed(ctx)
+	}
+	return &FileTypePolicy{
+		AllowedExtensions: setting.UploadAllowedExtensions,
+		BlockedExtensions: setting.UploadBlockedExtensions,
+		AllowedMimeTypes:  setting.UploadAllowedMimeTypes,
+		BlockedMimeTypes:  setting.UploadBlockedMimeTypes,
+	}, nil
+}
+
+// SetPolicy заменяет политику типов файлов тенанта. Расширения сохраняются без точки, расширения и типы -
+// в нижнем регистре без повторов. Уже загруженные файлы не проверяются.
+func (s *TenantFileTypeService) SetPolicy(ctx context.Context, client *ent.Client, policy FileTypePolicy) (*FileTypePolicy, error) {
+	normalized := FileTypePolicy{}
+	var ok bool
+	if normalized.AllowedExtensions, ok = normalizeFileTypeList(policy.AllowedExtensions, normalizeExtension, fileExtensionPattern); !ok {
+		return nil, errcatalog.TenantInvalidFileTypePolicy(ctx)
+	}
+	if normalized.BlockedExtensions, ok = normalizeFileTypeList(policy.BlockedExtensions, normalizeExtension, fileExtensionPattern); !ok {
+		return nil, errcatalog.TenantInvalidFileTypePolicy(ctx)
+	}
+	if normalized.AllowedMimeTypes, ok = normalizeFileTypeList(policy.AllowedMimeTypes, normalizeMimeType, mimeTypePattern); !ok {
+		return nil, errcatalog.TenantInvalidFileTypePolicy(ctx)
+	}
+	if normalized.BlockedMimeTypes, ok = normalizeFileTypeList(policy.BlockedMimeTypes, normalizeMimeType, mimeTypePattern); !ok {
+		return nil, errcatalog.TenantInvalidFileTypePolicy(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errcatalog.TenantFileTypePolicyUpdateFailed(ctx)
+	}
+
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetUploadAllowedExtensions(normalized.AllowedExtensions).
+			SetUploadBlockedExtensions(normalized.BlockedExtensions).
+			SetUploadAllowedMimeTypes(normalized.AllowedMimeTypes).
+			SetUploadBlockedMimeTypes(normalized.BlockedMimeTypes).
+			Exec(ctxWithClient)
+	} else {
+		err = client.TenantSetting.UpdateOne(setting).
+			SetUploadAllowedExtensions(normalized.AllowedExtensions).
+			SetUploadBlockedExtensions(normalized.BlockedExtensions).
+			SetUploadAllowedMimeTypes(normalized.AllowedMimeTypes).
+			SetUploadBlockedMimeTypes(normalized.BlockedMimeTypes).
+			Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant file type policy", zap.Error(err))
+		return nil, errcatalog.TenantFileTypePolicyUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Tenant file type policy changed",
+		zap.Strings("allowed_extensions", normalized.AllowedExtensions),
+		zap.Strings("blocked_extensions", normalized.BlockedExtensions),
+		zap.Strings("allowed_mime_types", normalized.AllowedMimeTypes),
+		zap.Strings("blocked_mime_types", normalized.BlockedMimeTypes))
+
+	return &normalized, nil
+}
+
+// normalizeFileTypeList нормализует список политики, пропуская пустые значения и повторы;
+// false - значение не соответствует формату или список слишком длинный
+func normalizeFileTypeList(values []string, normalize func(string) string, pattern *regexp.Regexp) ([]string, bool) {
+	result := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		value = normalize(value)
+		if value == "" {
+			continue
+		}
+		if !pattern.MatchString(value) {
+			return nil, false
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	if len(result) > maxFileTypePolicyEntries {
+		return nil, false
+	}
+	return result, true
+}
+
+// normalizeExtension приводит расширение к нижнему регистру без ведущей точки
+func normalizeExtension(extension string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(extension), "."))
+}
+
+// normalizeMimeType приводит тип содержимого к нижнему регистру без параметров (например, charset)
+func normalizeMimeType(mimeType string) string {
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	if mediaType, _, err := mime.ParseMediaType(mimeType); err == nil {
+		return mediaType
+	}
+	return mimeType
+}
+
+// matchesMimeType проверяет, входит ли тип в список с учетом семейств type/*
+func matchesMimeType(patterns []string, mimeType string) bool {
+	for _, pattern := range patterns {
+		if pattern == mimeType || (strings.HasSuffix(pattern, "/*") && strings.HasPrefix(mimeType, strings.TrimSuffix(pattern, "*"))) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package tenant
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/tenantsetting"
+	"main/errcatalog"
+	"main/utils"
+
+	"go.uber.org/zap"
+)
+
+// TenantFilenameConflictService управляет обработкой одинаковых имен файлов, прикрепляемых к одной сущности или папке
+type TenantFilenameConflictService struct{}
+
+// NewTenantFilenameConflictService creates a new tenant filename conflict service
+func NewTenantFilenameConflictService() *TenantFilenameConflictService {
+	return &TenantFilenameConflictService{}
+}
+
+// GetMode возвращает режим тенанта; без сохраненных настроек дубли имен разрешены
+func (s *TenantFilenameConflictService) GetMode(ctx context.Context, client *ent.Client) (tenantsetting.FilenameConflictMode, error) {
+	setting, err := client.TenantSetting.Query().Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return tenantsetting.DefaultFilenameConflictMode, nil
+		}
+		return "", errcatalog.TenantFilenameConflictGetFailed(ctx)
+	}
+	return setting.FilenameConflictMode, nil
+}
+
+// SetMode сохраняет режим тенанта. Уже загруженные файлы не переименовываются.
+func (s *TenantFilenameConflictService) SetMode(ctx context.Context, client *ent.Client, mode tenantsetting.FilenameConflictMode) error {
+	if err := tenantsetting.FilenameConflictModeValidator(mode); err != nil {
+		return errcatalog.TenantInvalidFilenameConflictMode(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return errcatalog.TenantFilenameConflictUpdateFailed(ctx)
+	}
+
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetFilenameConflictMode(mode).
+			Exec(ctxWithClient)
+	} else {
+		err = client.TenantSetting.UpdateOne(setting).
+			SetFilenameConflictMode(mode).
+			Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant filename conflict mode", zap.Error(err), zap.String("mode", mode.String()))
+		return errcatalog.TenantFilenameConflictUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Tenant filename conflict mode changed", zap.String("mode", mode.String()))
+
+	return nil
+}
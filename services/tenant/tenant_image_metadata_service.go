@@ -0,0 +1,63 @@
+package tenant
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/tenantsetting"
+	"main/errcatalog"
+	"main/utils"
+
+	"go.uber.org/zap"
+)
+
+// TenantImageMetadataService управляет удалением метаданных (EXIF, XMP) из загружаемых изображений
+type TenantImageMetadataService struct{}
+
+// NewTenantImageMetadataService creates a new tenant image metadata service
+func NewTenantImageMetadataService() *TenantImageMetadataService {
+	return &TenantImageMetadataService{}
+}
+
+// GetMode возвращает режим тенанта; без сохраненных настроек метаданные сохраняются
+func (s *TenantImageMetadataService) GetMode(ctx context.Context, client *ent.Client) (tenantsetting.ImageMetadataMode, error) {
+	setting, err := client.TenantSetting.Query().Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return tenantsetting.DefaultImageMetadataMode, nil
+		}
+		return "", errcatalog.TenantImageMetadataGetFailed(ctx)
+	}
+	return setting.ImageMetadataMode, nil
+}
+
+// SetMode сохраняет режим тенанта. Уже загруженные изображения не изменяются.
+func (s *TenantImageMetadataService) SetMode(ctx context.Context, client *ent.Client, mode tenantsetting.ImageMetadataMode) error {
+	if err := tenantsetting.ImageMetadataModeValidator(mode); err != nil {
+		return errcatalog.TenantInvalidImageMetadataMode(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return errcatalog.TenantImageMetadataUpdateFailed(ctx)
+	}
+
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetImageMetadataMode(mode).
+			Exec(ctxWithClient)
+	} else {
+		err = client.TenantSetting.UpdateOne(setting).
+			SetImageMetadataMode(mode).
+			Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant image metadata mode", zap.Error(err), zap.String("mode", mode.String()))
+		return errcatalog.TenantImageMetadataUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Tenant image metadata mode changed", zap.String("mode", mode.String()))
+
+	return nil
+}
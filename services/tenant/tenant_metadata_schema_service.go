@@ -0,0 +1,115 @@
+package tenant
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/schema/filemeta"
+	"main/errcatalog"
+	"main/utils"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// maxMetadataSchemaFields максимальное количество полей в одной схеме metadata
+const maxMetadataSchemaFields = 50
+
+// metadataKeyPattern допустимый ключ поля metadata
+var metadataKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]{0,63}$`)
+
+// TenantMetadataSchemaService управляет схемами metadata файлов тенанта.
+// Проверку metadata при создании и изменении файла выполняет хук hooks.WithFileMetadataSchema.
+type TenantMetadataSchemaService struct{}
+
+// NewTenantMetadataSchemaService creates a new tenant metadata schema service
+func NewTenantMetadataSchemaService() *TenantMetadataSchemaService {
+	return &TenantMetadataSchemaService{}
+}
+
+// GetSchemas возвращает схемы metadata тенанта; без сохраненных настроек metadata не проверяется
+func (s *TenantMetadataSchemaService) GetSchemas(ctx context.Context, client *ent.Client) ([]filemeta.Schema, error) {
+	setting, err := client.TenantSetting.Query().Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return []filemeta.Schema{}, nil
+		}
+		return nil, errcatalog.TenantFileMetadataSchemaGetFailed(ctx)
+	}
+	if setting.FileMetadataSchemas == nil {
+		return []filemeta.Schema{}, nil
+	}
+	return setting.FileMetadataSchemas, nil
+}
+
+// SetSchemas заменяет схемы metadata тенанта. Допускается одна схема на тип сущности и одна общая схема
+// (без типа сущности); ключи полей уникальны в схеме. Уже сохраненные файлы не проверяются.
+func (s *TenantMetadataSchemaService) SetSchemas(ctx context.Context, client *ent.Client, schemas []filemeta.Schema) ([]filemeta.Schema, error) {
+	normalized, ok := normalizeMetadataSchemas(schemas)
+	if !ok {
+		return nil, errcatalog.TenantInvalidFileMetadataSchema(ctx, maxMetadataSchemaFields)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errcatalog.TenantFileMetadataSchemaUpdateFailed(ctx)
+	}
+
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetFileMetadataSchemas(normalized).
+			Exec(ctxWithClient)
+	} else {
+		err = client.TenantSetting.UpdateOne(setting).
+			SetFileMetadataSchemas(normalized).
+			Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant file metadata schemas", zap.Error(err))
+		return nil, errcatalog.TenantFileMetadataSchemaUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Tenant file metadata schemas changed", zap.Int("schemas", len(normalized)))
+
+	return normalized, nil
+}
+
+// normalizeMetadataSchemas проверяет схемы и убирает пробелы вокруг ключей;
+// false - повтор типа сущности или ключа, неверный ключ или тип поля, слишком много полей
+func normalizeMetadataSchemas(schemas []filemeta.Schema) ([]filemeta.Schema, bool) {
+	result := make([]filemeta.Schema, 0, len(schemas))
+	entityTypes := make(map[string]struct{}, len(schemas))
+	for _, schema := range schemas {
+		if _, ok := entityTypes[schema.EntityType]; ok {
+			return nil, false
+		}
+		entityTypes[schema.EntityType] = struct{}{}
+
+		if len(schema.Fields) > maxMetadataSchemaFields {
+			return nil, false
+		}
+		fields := make([]filemeta.Field, 0, len(schema.Fields))
+		keys := make(map[string]struct{}, len(schema.Fields))
+		for _, field := range schema.Fields {
+			field.Key = strings.TrimSpace(field.Key)
+			if !metadataKeyPattern.MatchString(field.Key) || !slices.Contains(filemeta.FieldTypes, field.Type) {
+				return nil, false
+			}
+			if _, ok := keys[field.Key]; ok {
+				return nil, false
+			}
+			keys[field.Key] = struct{}{}
+			fields = append(fields, field)
+		}
+
+		result = append(result, filemeta.Schema{
+			EntityType:      schema.EntityType,
+			Fields:          fields,
+			AllowAdditional: schema.AllowAdditional,
+		})
+	}
+	return result, true
+}
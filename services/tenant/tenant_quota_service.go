@@ -0,0 +1,98 @@
+package tenant
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/tenantsetting"
+	"main/errcatalog"
+	"main/utils"
+
+	"go.uber.org/zap"
+)
+
+// QuotaPolicy режим применения квоты хранилища тенанта
+type QuotaPolicy struct {
+	Mode tenantsetting.QuotaMode
+	// GracePercent допустимое превышение лимита в процентах (только для режима GRACE)
+	GracePercent int
+	// StorageLimitBytes лимит хранилища тенанта, заданный оператором (nil - S3_STORAGE_LIMIT_BYTES)
+	StorageLimitBytes *int64
+}
+
+// TenantQuotaService управляет режимом квоты хранилища тенанта (hard, soft, grace).
+// Режим и лимит меняет только оператор платформы (/internal/tenant-quota-policy), админам тенанта они доступны для чтения.
+type TenantQuotaService struct{}
+
+// NewTenantQuotaService creates a new tenant quota service
+func NewTenantQuotaService() *TenantQuotaService {
+	return &TenantQuotaService{}
+}
+
+// GetPolicy возвращает режим квоты тенанта; без сохраненных настроек действует жесткая квота
+func (s *TenantQuotaService) GetPolicy(ctx context.Context, client *ent.Client) (*QuotaPolicy, error) {
+	setting, err := client.TenantSetting.Query().Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &QuotaPolicy{Mode: tenantsetting.DefaultQuotaMode}, nil
+		}
+		return nil, errcatalog.TenantQuotaGetFailed(ctx)
+	}
+
+	return &QuotaPolicy{
+		Mode:              setting.QuotaMode,
+		GracePercent:      setting.QuotaGracePercent,
+		StorageLimitBytes: setting.StorageLimitBytes,
+	}, nil
+}
+
+// SetPolicy сохраняет режим квоты и лимит хранилища тенанта. Процент превышения хранится только для режима GRACE.
+func (s *TenantQuotaService) SetPolicy(ctx context.Context, client *ent.Client, policy QuotaPolicy) error {
+	mode, gracePercent := policy.Mode, policy.GracePercent
+	if err := tenantsetting.QuotaModeValidator(mode); err != nil {
+		return errcatalog.TenantInvalidQuotaMode(ctx)
+	}
+	if mode != tenantsetting.QuotaModeGRACE {
+		gracePercent = 0
+	} else if gracePercent <= 0 || gracePercent > 100 {
+		return errcatalog.TenantInvalidQuotaGracePercent(ctx)
+	}
+	if policy.StorageLimitBytes != nil && *policy.StorageLimitBytes < 0 {
+		return errcatalog.TenantInvalidStorageLimit(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return errcatalog.TenantQuotaUpdateFailed(ctx)
+	}
+
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetQuotaMode(mode).
+			SetQuotaGracePercent(gracePercent).
+			SetNillableStorageLimitBytes(policy.StorageLimitBytes).
+			Exec(ctxWithClient)
+	} else {
+		update := client.TenantSetting.UpdateOne(setting).
+			SetQuotaMode(mode).
+			SetQuotaGracePercent(gracePercent)
+		if policy.StorageLimitBytes == nil {
+			update.ClearStorageLimitBytes()
+		} else {
+			update.SetStorageLimitBytes(*policy.StorageLimitBytes)
+		}
+		err = update.Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant quota mode", zap.Error(err), zap.String("mode", mode.String()))
+		return errcatalog.TenantQuotaUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Tenant quota mode changed",
+		zap.String("mode", mode.String()),
+		zap.Int("grace_percent", gracePercent),
+		zap.Any("storage_limit_bytes", policy.StorageLimitBytes))
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package tenant
+
+import (
+	"context"
+	"main/ent"
+	"main/errcatalog"
+	"main/utils"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxFileRetentionDays максимальный срок хранения файлов по умолчанию (10 лет)
+const maxFileRetentionDays = 3650
+
+// TenantRetentionService управляет сроком хранения файлов тенанта по умолчанию
+type TenantRetentionService struct{}
+
+// NewTenantRetentionService creates a new tenant retention service
+func NewTenantRetentionService() *TenantRetentionService {
+	return &TenantRetentionService{}
+}
+
+// GetDefaultRetentionDays возвращает срок хранения новых файлов в днях (nil - файлы хранятся бессрочно)
+func (s *TenantRetentionService) GetDefaultRetentionDays(ctx context.Context, client *ent.Client) (*int, error) {
+	setting, err := client.TenantSetting.Query().Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errcatalog.TenantRetentionGetFailed(ctx)
+	}
+	return setting.DefaultFileRetentionDays, nil
+}
+
+// DefaultExpiresAt возвращает срок хранения для файла, загружаемого сейчас без явного expires_at.
+// Ошибка чтения настроек не блокирует загрузку: файл сохраняется без срока.
+func (s *TenantRetentionService) DefaultExpiresAt(ctx context.Context, client *ent.Client) *time.Time {
+	days, err := s.GetDefaultRetentionDays(ctx, client)
+	if err != nil {
+		utils.Logger.Warn("Failed to get tenant default file retention", zap.Error(err))
+		return nil
+	}
+	if days == nil {
+		return nil
+	}
+	expiresAt := time.Now().AddDate(0, 0, *days)
+	return &expiresAt
+}
+
+// SetDefaultRetentionDays сохраняет срок хранения новых файлов; nil отключает срок хранения по умолчанию.
+// Уже загруженные файлы сохраняют свой срок.
+func (s *TenantRetentionService) SetDefaultRetentionDays(ctx context.Context, client *ent.Client, days *int) error {
+	if days != nil && (*days <= 0 || *days > maxFileRetentionDays) {
+		return errcatalog.TenantInvalidRetentionDays(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return errcatalog.TenantRetentionUpdateFailed(ctx)
+	}
+
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetNillableDefaultFileRetentionDays(days).
+			Exec(ctxWithClient)
+	} else {
+		update := client.TenantSetting.UpdateOne(setting)
+		if days == nil {
+			update.ClearDefaultFileRetentionDays()
+		} else {
+			update.SetDefaultFileRetentionDays(*days)
+		}
+		err = update.Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant default file retention", zap.Error(err))
+		return errcatalog.TenantRetentionUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Tenant default file retention changed", zap.Any("days", days))
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package tenant
+
+import (
+	"context"
+	"main/ent"
+	"main/ent/tenantsetting"
+	"main/errcatalog"
+	"main/utils"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// TenantStateHeader заголовок, которым gateway передает состояние тенанта из биллинга
+	TenantStateHeader = "X-Tenant-State"
+	// MaxStateReasonLength максимальная длина причины изменения состояния
+	MaxStateReasonLength = 500
+)
+
+// StateSource источник состояния тенанта
+type StateSource string
+
+const (
+	// StateSourceFederation состояние передано gateway (имеет приоритет)
+	StateSourceFederation StateSource = "FEDERATION"
+	// StateSourceSettings состояние сохранено в настройках тенанта
+	StateSourceSettings StateSource = "SETTINGS"
+)
+
+type federatedStateKey struct{}
+
+// TenantState описывает текущее состояние тенанта
+type TenantState struct {
+	State     tenantsetting.State
+	Reason    string
+	ChangedAt *time.Time
+	Source    StateSource
+}
+
+// TenantStateService управляет состоянием тенанта (active, suspended, offboarding)
+type TenantStateService struct{}
+
+// NewTenantStateService creates a new tenant state service
+func NewTenantStateService() *TenantStateService {
+	return &TenantStateService{}
+}
+
+// WithFederatedState сохраняет в контексте состояние тенанта из атрибута федерации.
+// Неизвестные значения игнорируются.
+func WithFederatedState(ctx context.Context, value string) context.Context {
+	state := tenantsetting.State(strings.ToUpper(strings.TrimSpace(value)))
+	if tenantsetting.StateValidator(state) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, federatedStateKey{}, state)
+}
+
+// GetState возвращает состояние тенанта: атрибут федерации имеет приоритет над настройками
+func (s *TenantStateService) GetState(ctx context.Context, client *ent.Client) (*TenantState, error) {
+	if state, ok := ctx.Value(federatedStateKey{}).(tenantsetting.State); ok {
+		return &TenantState{State: state, Source: StateSourceFederation}, nil
+	}
+
+	setting, err := client.TenantSetting.Query().Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &TenantState{State: tenantsetting.DefaultState, Source: StateSourceSettings}, nil
+		}
+		return nil, errcatalog.TenantStateGetFailed(ctx)
+	}
+
+	return &TenantState{
+		State:     setting.State,
+		Reason:    setting.StateReason,
+		ChangedAt: setting.StateChangedAt,
+		Source:    StateSourceSettings,
+	}, nil
+}
+
+// EnsureWritable возвращает локализованную ошибку, если тенант находится в режиме только для чтения.
+// Вызывается сервисами перед изменяющими операциями; чтение и скачивание не ограничиваются.
+func (s *TenantStateService) EnsureWritable(ctx context.Context, client *ent.Client) error {
+	state, err := s.GetState(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	switch state.State {
+	case tenantsetting.StateSUSPENDED:
+		return errcatalog.TenantSuspended(ctx)
+	case tenantsetting.StateOFFBOARDING:
+		return errcatalog.TenantOffboarding(ctx)
+	default:
+		return nil
+	}
+}
+
+// SetState сохраняет состояние тенанта в настройках
+func (s *TenantStateService) SetState(ctx context.Context, client *ent.Client, state tenantsetting.State, reason string) error {
+	if err := tenantsetting.StateValidator(state); err != nil {
+		return errcatalog.TenantInvalidState(ctx)
+	}
+	reason = strings.TrimSpace(reason)
+	if len(reason) > MaxStateReasonLength {
+		return errcatalog.TenantStateReasonTooLong(ctx)
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return errcatalog.TenantStateUpdateFailed(ctx)
+	}
+
+	now := time.Now()
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetState(state).
+			SetStateReason(reason).
+			SetStateChangedAt(now).
+			Exec(ctxWithClient)
+	} else {
+		err = client.TenantSetting.UpdateOne(setting).
+			SetState(state).
+			SetStateReason(reason).
+			SetStateChangedAt(now).
+			Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant state", zap.Error(err), zap.String("state", state.String()))
+		return errcatalog.TenantStateUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Tenant state changed",
+		zap.String("state", state.String()),
+		zap.String("reason", reason))
+
+	return nil
+}
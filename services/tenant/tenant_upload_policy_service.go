@@ -0,0 +1,153 @@
+package tenant
+
+import (
+	"context"
+	"main/config"
+	"main/ent"
+	"main/errcatalog"
+	"main/utils"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// maxUploadDescriptionTemplateLength максимальная длина шаблона описания в символах
+	maxUploadDescriptionTemplateLength = 1000
+	// maxClassificationTags максимальный размер справочника тегов классификации
+	maxClassificationTags = 100
+	// maxClassificationTagLength максимальная длина тега классификации (совпадает с длиной тега файла)
+	maxClassificationTagLength = 50
+)
+
+// UploadPolicy обязательные поля загрузки тенанта
+type UploadPolicy struct {
+	// RequireDescription загрузка без описания отклоняется
+	RequireDescription bool
+	// DescriptionTemplate шаблон описания для формы загрузки (nil - без шаблона)
+	DescriptionTemplate *string
+	// ClassificationTags справочник тегов классификации; пустой справочник не требует тегов
+	ClassificationTags []string
+	// MaxUploadSize максимальный размер файла в байтах (nil - MAX_UPLOAD_SIZE сервиса)
+	MaxUploadSize *int64
+}
+
+// EffectiveMaxUploadSize возвращает действующий лимит размера файла: лимит тенанта (меньше или больше
+// MAX_UPLOAD_SIZE, но не больше MAX_TENANT_UPLOAD_SIZE), без него - MAX_UPLOAD_SIZE сервиса
+func (p *UploadPolicy) EffectiveMaxUploadSize() int64 {
+	cfg := config.Get()
+	if p.MaxUploadSize == nil {
+		return cfg.MaxUploadSize
+	}
+	return min(*p.MaxUploadSize, cfg.MaxTenantUploadSize)
+}
+
+// TenantUploadPolicyService управляет обязательными полями загрузки файлов тенанта
+type TenantUploadPolicyService struct{}
+
+// NewTenantUploadPolicyService creates a new tenant upload policy service
+func NewTenantUploadPolicyService() *TenantUploadPolicyService {
+	return &TenantUploadPolicyService{}
+}
+
+// GetPolicy возвращает политику загрузки тенанта; без сохраненных настроек обязательных полей нет
+func (s *TenantUploadPolicyService) GetPolicy(ctx context.Context, client *ent.Client) (*UploadPolicy, error) {
+	setting, err := client.TenantSetting.Query().Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &UploadPolicy{}, nil
+		}
+		return nil, errcatalog.TenantUploadPolicyGetFailed(ctx)
+	}
+	return &UploadPolicy{
+		RequireDescription:  setting.RequireUploadDescription,
+		DescriptionTemplate: setting.UploadDescriptionTemplate,
+		ClassificationTags:  setting.UploadClassificationTags,
+		MaxUploadSize:       setting.MaxUploadSize,
+	}, nil
+}
+
+// SetPolicy сохраняет политику загрузки тенанта. Пустой шаблон удаляет шаблон, теги справочника
+// нормализуются как теги файлов, лимит размера не может превышать MAX_TENANT_UPLOAD_SIZE сервиса.
+// Уже загруженные файлы не проверяются.
+func (s *TenantUploadPolicyService) SetPolicy(ctx context.Context, client *ent.Client, policy UploadPolicy) (*UploadPolicy, error) {
+	if policy.DescriptionTemplate != nil {
+		template := strings.TrimSpace(*policy.DescriptionTemplate)
+		if utf8.RuneCountInString(template) > maxUploadDescriptionTemplateLength {
+			return nil, errcatalog.TenantInvalidUploadDescriptionTemplate(ctx)
+		}
+		policy.DescriptionTemplate = &template
+		if template == "" {
+			policy.DescriptionTemplate = nil
+		}
+	}
+
+	tags := make([]string, 0, len(policy.ClassificationTags))
+	seen := make(map[string]struct{}, len(policy.ClassificationTags))
+	for _, tag := range policy.ClassificationTags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxClassificationTagLength {
+			return nil, errcatalog.TenantInvalidClassificationTags(ctx)
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxClassificationTags {
+		return nil, errcatalog.TenantInvalidClassificationTags(ctx)
+	}
+	policy.ClassificationTags = tags
+
+	if maxUploadSize := config.Get().MaxTenantUploadSize; policy.MaxUploadSize != nil &&
+		(*policy.MaxUploadSize < 1 || *policy.MaxUploadSize > maxUploadSize) {
+		return nil, errcatalog.TenantInvalidMaxUploadSize(ctx, int(maxUploadSize))
+	}
+
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, errcatalog.TenantUploadPolicyUpdateFailed(ctx)
+	}
+
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetRequireUploadDescription(policy.RequireDescription).
+			SetNillableUploadDescriptionTemplate(policy.DescriptionTemplate).
+			SetUploadClassificationTags(policy.ClassificationTags).
+			SetNillableMaxUploadSize(policy.MaxUploadSize).
+			Exec(ctxWithClient)
+	} else {
+		update := client.TenantSetting.UpdateOne(setting).
+			SetRequireUploadDescription(policy.RequireDescription).
+			SetUploadClassificationTags(policy.ClassificationTags)
+		if policy.DescriptionTemplate == nil {
+			update.ClearUploadDescriptionTemplate()
+		} else {
+			update.SetUploadDescriptionTemplate(*policy.DescriptionTemplate)
+		}
+		if policy.MaxUploadSize == nil {
+			update.ClearMaxUploadSize()
+		} else {
+			update.SetMaxUploadSize(*policy.MaxUploadSize)
+		}
+		err = update.Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant upload policy", zap.Error(err))
+		return nil, errcatalog.TenantUploadPolicyUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Tenant upload policy changed",
+		zap.Bool("require_description", policy.RequireDescription),
+		zap.Int("classification_tags", len(policy.ClassificationTags)),
+		zap.Int64p("max_upload_size", policy.MaxUploadSize))
+
+	return &policy, nil
+}
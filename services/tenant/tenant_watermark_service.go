@@ -0,0 +1,59 @@
+package tenant
+
+import (
+	"context"
+	"main/ent"
+	"main/errcatalog"
+	"main/utils"
+
+	"go.uber.org/zap"
+)
+
+// TenantWatermarkService управляет обязательным водяным знаком на скачиваемых PDF документах
+type TenantWatermarkService struct{}
+
+// NewTenantWatermarkService creates a new tenant watermark service
+func NewTenantWatermarkService() *TenantWatermarkService {
+	return &TenantWatermarkService{}
+}
+
+// IsPDFWatermarkRequired сообщает, выдаются ли PDF документы тенанта только с водяным знаком.
+// Без сохраненных настроек водяной знак не обязателен.
+func (s *TenantWatermarkService) IsPDFWatermarkRequired(ctx context.Context, client *ent.Client) (bool, error) {
+	setting, err := client.TenantSetting.Query().Only(ent.NewContext(ctx, client))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errcatalog.TenantWatermarkGetFailed(ctx)
+	}
+	return setting.RequirePdfWatermark, nil
+}
+
+// SetPDFWatermarkRequired сохраняет требование водяного знака. Действует на ссылки, выданные после изменения.
+func (s *TenantWatermarkService) SetPDFWatermarkRequired(ctx context.Context, client *ent.Client, required bool) error {
+	ctxWithClient := ent.NewContext(ctx, client)
+
+	setting, err := client.TenantSetting.Query().Only(ctxWithClient)
+	if err != nil && !ent.IsNotFound(err) {
+		return errcatalog.TenantWatermarkUpdateFailed(ctx)
+	}
+
+	if setting == nil {
+		err = client.TenantSetting.Create().
+			SetRequirePdfWatermark(required).
+			Exec(ctxWithClient)
+	} else {
+		err = client.TenantSetting.UpdateOne(setting).
+			SetRequirePdfWatermark(required).
+			Exec(ctxWithClient)
+	}
+	if err != nil {
+		utils.Logger.Error("Failed to save tenant PDF watermark policy", zap.Error(err), zap.Bool("required", required))
+		return errcatalog.TenantWatermarkUpdateFailed(ctx)
+	}
+
+	utils.Logger.Info("Tenant PDF watermark policy changed", zap.Bool("required", required))
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Represents a nested map structure for JSON locale files
+type LocaleMap map[string]interface{}
+
+// Template parameters are typed by name; parameters not listed here are strings
+var paramTypes = map[string]string{
+	"missing":  "int",
+	"expected": "int64",
+	"max":      "int",
+}
+
+// Words rendered as Go initialisms in identifiers
+var initialisms = map[string]string{
+	"id":   "ID",
+	"url":  "URL",
+	"s3":   "S3",
+	"sha":  "SHA",
+	"json": "JSON",
+	"http": "HTTP",
+	"api":  "API",
+	"mime": "MIME",
+	"pdf":  "PDF",
+}
+
+var templateParamRegex = regexp.MustCompile(`\{\{\s*\.(\w+)`)
+
+// Error catalog entry generated for a single error.* key
+type catalogEntry struct {
+	Key    string
+	Name   string
+	Text   string
+	Params []string
+}
+
+func main() {
+	var (
+		rootPath   string
+		outputPath string
+	)
+
+	flag.StringVar(&rootPath, "path", ".", "Project root path")
+	flag.StringVar(&outputPath, "out", "errcatalog/errors_gen.go", "Output file relative to project root")
+	flag.Parse()
+
+	localesDir := filepath.Join(rootPath, "locales/build")
+
+	enMap, err := loadLocaleFile(filepath.Join(localesDir, "en.json"))
+	if err != nil {
+		fmt.Printf("Failed to load English locale file: %v\n", err)
+		os.Exit(1)
+	}
+	ruMap, err := loadLocaleFile(filepath.Join(localesDir, "ru.json"))
+	if err != nil {
+		fmt.Printf("Failed to load Russian locale file: %v\n", err)
+		os.Exit(1)
+	}
+
+	errorsMap, ok := enMap["error"].(map[string]interface{})
+	if !ok {
+		fmt.Println("No error.* keys found in English locale")
+		os.Exit(1)
+	}
+
+	enMessages := make(map[string]string)
+	collectMessages(errorsMap, "error", enMessages)
+	ruMessages := make(map[string]string)
+	if ruErrors, ok := ruMap["error"].(map[string]interface{}); ok {
+		collectMessages(ruErrors, "error", ruMessages)
+	}
+
+	entries, err := buildEntries(enMessages, ruMessages)
+	if err != nil {
+		fmt.Printf("Error building error catalog: %v\n", err)
+		os.Exit(1)
+	}
+
+	source, err := render(entries)
+	if err != nil {
+		fmt.Printf("Error rendering error catalog: %v\n", err)
+		os.Exit(1)
+	}
+
+	outputFile := filepath.Join(rootPath, outputPath)
+	if err := os.MkdirAll(filepath.Dir(outputFile), 0755); err != nil {
+		fmt.Printf("Error creating output directory: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(outputFile, source, 0644); err != nil {
+		fmt.Printf("Error writing error catalog: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Error catalog generated: %s (%d keys)\n", outputFile, len(entries))
+}
+
+// Load JSON file
+func loadLocaleFile(filePath string) (LocaleMap, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var localeMap LocaleMap
+	if err := json.Unmarshal(data, &localeMap); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON in %s: %v", filePath, err)
+	}
+	return localeMap, nil
+}
+
+// Recursively collect leaf messages with their full keys
+func collectMessages(data map[string]interface{}, prefix string, messages map[string]string) {
+	for key, value := range data {
+		fullKey := prefix + "." + key
+		switch typed := value.(type) {
+		case map[string]interface{}:
+			collectMessages(typed, fullKey, messages)
+		case string:
+			messages[fullKey] = typed
+		}
+	}
+}
+
+// Build sorted catalog entries; parameters are the union of template fields used in all languages
+func buildEntries(enMessages, ruMessages map[string]string) ([]catalogEntry, error) {
+	keys := make([]string, 0, len(enMessages))
+	for key := range enMessages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]catalogEntry, 0, len(keys))
+	names := make(map[string]string, len(keys))
+	for _, key := range keys {
+		name := identifier(strings.TrimPrefix(key, "error."))
+		if existing, ok := names[name]; ok {
+			return nil, fmt.Errorf("keys %q and %q produce the same identifier %s", existing, key, name)
+		}
+		names[name] = key
+
+		params := make(map[string]bool)
+		for _, text := range []string{enMessages[key], ruMessages[key]} {
+			for _, match := range templateParamRegex.FindAllStringSubmatch(text, -1) {
+				params[match[1]] = true
+			}
+		}
+		sortedParams := make([]string, 0, len(params))
+		for param := range params {
+			sortedParams = append(sortedParams, param)
+		}
+		sort.Strings(sortedParams)
+
+		entries = append(entries, catalogEntry{
+			Key:    key,
+			Name:   name,
+			Text:   enMessages[key],
+			Params: sortedParams,
+		})
+	}
+	return entries, nil
+}
+
+// Convert a dotted snake_case key to an exported Go identifier
+func identifier(key string) string {
+	var builder strings.Builder
+	for _, part := range strings.FieldsFunc(key, func(r rune) bool { return r == '.' || r == '_' || r == '-' }) {
+		if initialism, ok := initialisms[part]; ok {
+			builder.WriteString(initialism)
+			continue
+		}
+		builder.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return builder.String()
+}
+
+// Convert a template field name to a Go parameter name
+func paramName(param string) string {
+	name := identifier(param)
+	name = strings.ToLower(name[:1]) + name[1:]
+	if token.IsKeyword(name) || name == "ctx" {
+		name += "Value"
+	}
+	return name
+}
+
+func paramType(param string) string {
+	if typ, ok := paramTypes[param]; ok {
+		return typ
+	}
+	return "string"
+}
+
+func render(entries []catalogEntry) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString("// Code generated by tools/build_errors from locales/build. DO NOT EDIT.\n\n")
+	buf.WriteString("package errcatalog\n\n")
+	buf.WriteString("import (\n\t\"context\"\n\t\"main/utils\"\n)\n\n")
+
+	buf.WriteString("// Message keys of localized errors\nconst (\n")
+	for _, entry := range entries {
+		fmt.Fprintf(&buf, "\t// Key%s %q\n\tKey%s = %q\n", entry.Name, entry.Text, entry.Name, entry.Key)
+	}
+	buf.WriteString(")\n")
+
+	for _, entry := range entries {
+		fmt.Fprintf(&buf, "\n// %s %q\n", entry.Name, entry.Text)
+
+		args := []string{"ctx context.Context"}
+		data := "nil"
+		if len(entry.Params) > 0 {
+			fields := make([]string, 0, len(entry.Params))
+			for _, param := range entry.Params {
+				args = append(args, fmt.Sprintf("%s %s", paramName(param), paramType(param)))
+				fields = append(fields, fmt.Sprintf("%q: %s", param, paramName(param)))
+			}
+			data = "utils.TemplateData{" + strings.Join(fields, ", ") + "}"
+		}
+
+		fmt.Fprintf(&buf, "func %s(%s) error {\n\treturn newError(ctx, Key%s, %s)\n}\n",
+			entry.Name, strings.Join(args, ", "), entry.Name, data)
+	}
+
+	return format.Source(buf.Bytes())
+}
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 )
 
 // Represents a nested map structure for JSON locale files
@@ -16,14 +17,20 @@ type LocaleMap map[string]interface{}
 
 func main() {
 	var (
-		rootPath     string
-		fixOption    bool
-		removeUnused bool
+		rootPath       string
+		fixOption      bool
+		removeUnused   bool
+		pruneStateFile string
+		pruneAfterDays int
+		pruneMinRuns   int
 	)
 
 	flag.StringVar(&rootPath, "path", ".", "Project root path")
 	flag.BoolVar(&fixOption, "fix", false, "Generate translation template for missing keys")
-	flag.BoolVar(&removeUnused, "remove-unused", false, "Remove unused keys from locale files")
+	flag.BoolVar(&removeUnused, "remove-unused", false, "Remove unused keys from locale files once they pass the safety window")
+	flag.StringVar(&pruneStateFile, "prune-state", defaultPruneStateFile, "State file tracking unused key candidates (relative to -path)")
+	flag.IntVar(&pruneAfterDays, "prune-after-days", 14, "Days a key must stay unused before -remove-unused deletes it")
+	flag.IntVar(&pruneMinRuns, "prune-min-runs", 2, "Days with a -remove-unused run in which a key must stay unused before it is deleted")
 	flag.Parse()
 
 	localesDir := filepath.Join(rootPath, "locales/build")
@@ -151,6 +158,39 @@ func main() {
 		}
 	}
 
+	// Keys are only removed after staying unused across the safety window: a key that looks unused
+	// here may be referenced by a feature branch that has not been merged yet
+	if removeUnused {
+		statePath := pruneStateFile
+		if !filepath.IsAbs(statePath) {
+			statePath = filepath.Join(rootPath, statePath)
+		}
+
+		state, err := loadPruneState(statePath)
+		if err != nil {
+			fmt.Printf("Error loading prune state %s: %v\n", statePath, err)
+			os.Exit(1)
+		}
+
+		minAge := time.Duration(pruneAfterDays) * 24 * time.Hour
+		ready, pending := state.update(append(unusedInEn, unusedInRu...), time.Now().UTC(), minAge, pruneMinRuns)
+		if err := savePruneState(statePath, state); err != nil {
+			fmt.Printf("Error saving prune state %s: %v\n", statePath, err)
+			os.Exit(1)
+		}
+
+		if len(pending) > 0 {
+			fmt.Printf("\n=== PRUNE CANDIDATES (%d, kept until unused for %d days and %d runs) ===\n", len(pending), pruneAfterDays, pruneMinRuns)
+			for _, key := range pending {
+				candidate := state.Candidates[key]
+				fmt.Printf("  - %s (unused since %s, %d runs)\n", key, candidate.FirstSeen.Format("2006-01-02"), candidate.Runs)
+			}
+		}
+
+		unusedInEn = filterKeys(unusedInEn, ready)
+		unusedInRu = filterKeys(unusedInRu, ready)
+	}
+
 	// Remove unused keys if requested
 	if removeUnused && (len(unusedInEn) > 0 || len(unusedInRu) > 0) {
 		fmt.Println("\n=== REMOVING UNUSED KEYS ===")
@@ -396,7 +436,14 @@ func findTranslationKeys(rootPath string) ([]string, error) {
 	// Also matches: utils.T(ctx, "key", data) where data is ...TemplateData
 	templateKeyRegex := regexp.MustCompile(`utils\.T\s*\(\s*[^,]+\s*,\s*["']([^"']+)["']\s*,\s*(?:map\[|[^)]+)`)
 
-	err := filepath.WalkDir(rootPath, func(path string, d os.DirEntry, err error) error {
+	// 3. Generated error catalog: errcatalog.FileNotFound(ctx) or errcatalog.KeyFileNotFound
+	catalogKeys, err := loadErrorCatalog(rootPath)
+	if err != nil {
+		return nil, err
+	}
+	catalogRefRegex := regexp.MustCompile(`errcatalog\.(?:Key)?(\w+)`)
+
+	err = filepath.WalkDir(rootPath, func(path string, d os.DirEntry, err error) error {
 		if err != nil {
 			return err
 		}
@@ -418,7 +465,8 @@ func findTranslationKeys(rootPath string) ([]string, error) {
 		}
 
 		// Skip tools directory to avoid matching our own check script
-		if strings.Contains(path, "/tools/check_translations") {
+		// and the generated catalog, which lists every error key
+		if strings.Contains(path, "/tools/check_translations") || strings.HasSuffix(path, errorCatalogFile) {
 			if d.IsDir() {
 				return filepath.SkipDir
 			}
@@ -458,6 +506,13 @@ func findTranslationKeys(rootPath string) ([]string, error) {
 						keys[key] = true
 					}
 				}
+
+				// Find error catalog references
+				for _, match := range catalogRefRegex.FindAllStringSubmatch(line, -1) {
+					if key, ok := catalogKeys[match[1]]; ok {
+						keys[key] = true
+					}
+				}
 			}
 		}
 
@@ -477,6 +532,27 @@ func findTranslationKeys(rootPath string) ([]string, error) {
 	return result, nil
 }
 
+// Path of the error catalog generated by tools/build_errors
+const errorCatalogFile = "errcatalog/errors_gen.go"
+
+// Load catalog identifiers mapped to their keys (KeyFileNotFound = "error.file.not_found" -> FileNotFound)
+func loadErrorCatalog(rootPath string) (map[string]string, error) {
+	content, err := os.ReadFile(filepath.Join(rootPath, errorCatalogFile))
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	catalogKeys := make(map[string]string)
+	constRegex := regexp.MustCompile(`(?m)^\s*Key(\w+)\s*=\s*"([^"]+)"`)
+	for _, match := range constRegex.FindAllStringSubmatch(string(content), -1) {
+		catalogKeys[match[1]] = match[2]
+	}
+	return catalogKeys, nil
+}
+
 // Check if a key exists in the locale map
 func hasKey(localeMap LocaleMap, key string) bool {
 	parts := strings.Split(key, ".")
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"time"
+)
+
+// Default location of the pruning state, relative to the project root
+const defaultPruneStateFile = "locales/.unused_keys_state.json"
+
+// pruneCandidate tracks how long a key has stayed unused
+type pruneCandidate struct {
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	// Runs counts days with a run that found the key unused: go generate runs the tool often,
+	// so repeated runs on the same day count once
+	Runs int `json:"runs"`
+}
+
+// pruneState is persisted between -remove-unused runs so that keys are only deleted
+// after they have been unused for the whole safety window
+type pruneState struct {
+	Candidates map[string]*pruneCandidate `json:"candidates"`
+}
+
+// Load pruning state; a missing file means no candidates yet
+func loadPruneState(filePath string) (*pruneState, error) {
+	state := &pruneState{Candidates: map[string]*pruneCandidate{}}
+
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, err
+	}
+	if state.Candidates == nil {
+		state.Candidates = map[string]*pruneCandidate{}
+	}
+	return state, nil
+}
+
+// Save pruning state
+func savePruneState(filePath string, state *pruneState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, append(data, '\n'), 0644)
+}
+
+// Record this run's unused keys and return the ones that may be deleted.
+// A key that is used again (e.g. by a merged feature branch) is dropped from the candidates,
+// so its window restarts if it becomes unused later. A key is ready once it has been unused
+// for at least minAge and in at least minRuns consecutive runs.
+func (s *pruneState) update(unusedKeys []string, now time.Time, minAge time.Duration, minRuns int) (ready, pending []string) {
+	unused := make(map[string]bool, len(unusedKeys))
+	for _, key := range unusedKeys {
+		unused[key] = true
+	}
+
+	for key := range s.Candidates {
+		if !unused[key] {
+			delete(s.Candidates, key)
+		}
+	}
+
+	for key := range unused {
+		candidate, exists := s.Candidates[key]
+		if !exists {
+			candidate = &pruneCandidate{FirstSeen: now}
+			s.Candidates[key] = candidate
+		}
+		if !exists || !sameDay(candidate.LastSeen, now) {
+			candidate.LastSeen = now
+			candidate.Runs++
+		}
+
+		if candidate.Runs >= minRuns && now.Sub(candidate.FirstSeen) >= minAge {
+			ready = append(ready, key)
+		} else {
+			pending = append(pending, key)
+		}
+	}
+
+	sort.Strings(ready)
+	sort.Strings(pending)
+	return ready, pending
+}
+
+// Check whether two times fall on the same UTC day
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.UTC().Date()
+	by, bm, bd := b.UTC().Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// Keep only keys present in the allowed list
+func filterKeys(keys, allowed []string) []string {
+	allowedMap := make(map[string]bool, len(allowed))
+	for _, key := range allowed {
+		allowedMap[key] = true
+	}
+
+	var result []string
+	for _, key := range keys {
+		if allowedMap[key] {
+			result = append(result, key)
+		}
+	}
+	return result
+}
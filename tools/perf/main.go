@@ -0,0 +1,113 @@
+package main
+
+// Нагрузочный драйвер для основных файловых сценариев сервиса.
+// Запускается против поднятого стека (docker compose), сравнивает результаты с baseline.json
+// и завершается с кодом 1 при регрессии.
+//
+//	go run ./tools/perf -url http://localhost:9010/query -header "X-Federation-Context: ..." -scenario all
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// headerFlags повторяемый флаг -header "Name: value"
+type headerFlags []string
+
+func (h *headerFlags) String() string { return strings.Join(*h, ", ") }
+
+func (h *headerFlags) Set(value string) error {
+	if !strings.Contains(value, ":") {
+		return fmt.Errorf("header must be in \"Name: value\" format")
+	}
+	*h = append(*h, value)
+	return nil
+}
+
+func main() {
+	var (
+		options        Options
+		headers        headerFlags
+		scenarioNames  string
+		baselinePath   string
+		updateBaseline bool
+		maxRegression  float64
+	)
+
+	flag.StringVar(&options.URL, "url", "http://localhost:9010/query", "GraphQL endpoint")
+	flag.Var(&headers, "header", "Request header \"Name: value\" (repeatable), e.g. federation context of a test user")
+	flag.StringVar(&scenarioNames, "scenario", "all", "Comma separated scenarios: "+strings.Join(scenarioNamesList(), ", ")+" or all")
+	flag.IntVar(&options.Concurrency, "concurrency", 8, "Number of concurrent workers")
+	flag.DurationVar(&options.Duration, "duration", 30*time.Second, "Duration of each scenario")
+	flag.Int64Var(&options.FileSize, "file-size", 1<<20, "Size of uploaded files in bytes")
+	flag.IntVar(&options.SeedFiles, "seed-files", 40, "Number of files uploaded before presign/archive/files scenarios")
+	flag.StringVar(&options.Stand, "stand", "", "Stand description stored in the baseline (PostgreSQL, Redis, storage, machine)")
+	flag.StringVar(&baselinePath, "baseline", "tools/perf/baseline.json", "Baseline file")
+	flag.BoolVar(&updateBaseline, "update-baseline", false, "Write results to the baseline file instead of comparing")
+	flag.Float64Var(&maxRegression, "max-regression", 20, "Allowed p95 latency / throughput regression in percent")
+	flag.Parse()
+
+	options.Headers = http.Header{}
+	for _, header := range headers {
+		name, value, _ := strings.Cut(header, ":")
+		options.Headers.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	selected, err := selectScenarios(scenarioNames)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	runner := NewRunner(options)
+
+	results := make([]*Result, 0, len(selected))
+	for _, scenario := range selected {
+		fmt.Printf("Running %s (%d workers, %s)...\n", scenario.Name, options.Concurrency, options.Duration)
+		result, err := runner.Run(ctx, scenario)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Scenario %s failed: %v\n", scenario.Name, err)
+			os.Exit(1)
+		}
+		results = append(results, result)
+	}
+
+	printResults(os.Stdout, results)
+
+	if updateBaseline {
+		if err := writeBaseline(baselinePath, options, results); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write baseline: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Baseline written to %s\n", baselinePath)
+		return
+	}
+
+	baseline, err := loadBaseline(baselinePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load baseline: %v\n", err)
+		os.Exit(1)
+	}
+
+	if mismatches := parameterMismatches(baseline, options); len(mismatches) > 0 {
+		fmt.Printf("⚠ Run parameters differ from baseline (%s): %s\n", baseline.Stand, strings.Join(mismatches, ", "))
+	}
+
+	regressions := compareWithBaseline(baseline, results, maxRegression)
+	if len(regressions) == 0 {
+		fmt.Println("✅ No regressions against baseline")
+		return
+	}
+
+	fmt.Printf("❌ Regressions against baseline (> %.0f%%):\n", maxRegression)
+	for _, regression := range regressions {
+		fmt.Printf("  - %s\n", regression)
+	}
+	os.Exit(1)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// Result итог прогона сценария
+type Result struct {
+	Scenario   string  `json:"scenario"`
+	Requests   int     `json:"requests"`
+	Errors     int     `json:"errors"`
+	Throughput float64 `json:"throughputRps"`
+	P50Ms      float64 `json:"p50Ms"`
+	P95Ms      float64 `json:"p95Ms"`
+	P99Ms      float64 `json:"p99Ms"`
+	LastError  string  `json:"lastError,omitempty"`
+}
+
+// Baseline эталонные результаты, сохраненные в репозитории, и параметры прогона, на котором они получены
+type Baseline struct {
+	RecordedAt  time.Time `json:"recordedAt"`
+	Concurrency int       `json:"concurrency"`
+	Duration    string    `json:"duration"`
+	FileSize    int64     `json:"fileSize"`
+	SeedFiles   int       `json:"seedFiles"`
+	// Stand описание стенда (-stand): версии PostgreSQL, Redis, хранилища и ресурсы машины
+	Stand     string             `json:"stand"`
+	Scenarios map[string]*Result `json:"scenarios"`
+}
+
+func newResult(scenario string, latencies []time.Duration, errors int, elapsed time.Duration) *Result {
+	sortDurations(latencies)
+	result := &Result{
+		Scenario: scenario,
+		Requests: len(latencies),
+		Errors:   errors,
+		P50Ms:    toMs(percentile(latencies, 0.50)),
+		P95Ms:    toMs(percentile(latencies, 0.95)),
+		P99Ms:    toMs(percentile(latencies, 0.99)),
+	}
+	if elapsed > 0 {
+		result.Throughput = float64(len(latencies)) / elapsed.Seconds()
+	}
+	return result
+}
+
+// percentile возвращает перцентиль отсортированных задержек
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	index := int(float64(len(sorted)-1) * p)
+	return sorted[index]
+}
+
+// sortDurations сортирует задержки по возрастанию
+func sortDurations(values []time.Duration) {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+}
+
+func toMs(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// printResults выводит результаты таблицей
+func printResults(w io.Writer, results []*Result) {
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "SCENARIO\tREQUESTS\tERRORS\tRPS\tP50 ms\tP95 ms\tP99 ms")
+	for _, result := range results {
+		fmt.Fprintf(table, "%s\t%d\t%d\t%.1f\t%.1f\t%.1f\t%.1f\n",
+			result.Scenario, result.Requests, result.Errors, result.Throughput, result.P50Ms, result.P95Ms, result.P99Ms)
+	}
+	_ = table.Flush()
+
+	for _, result := range results {
+		if result.LastError != "" {
+			fmt.Fprintf(w, "%s last error: %s\n", result.Scenario, result.LastError)
+		}
+	}
+}
+
+// loadBaseline читает эталонные результаты; отсутствующий файл означает пустой baseline
+func loadBaseline(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &Baseline{Scenarios: map[string]*Result{}}, nil
+		}
+		return nil, err
+	}
+
+	var baseline Baseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, err
+	}
+	if baseline.Scenarios == nil {
+		baseline.Scenarios = map[string]*Result{}
+	}
+	return &baseline, nil
+}
+
+// writeBaseline сохраняет результаты как новый baseline, сохраняя сценарии, которые не запускались
+func writeBaseline(path string, options Options, results []*Result) error {
+	// Прогон с ошибками или без завершенных запросов не может быть эталоном
+	for _, result := range results {
+		if result.Requests == 0 || result.Errors > 0 {
+			return fmt.Errorf("%s: %d requests, %d errors, baseline is not updated", result.Scenario, result.Requests, result.Errors)
+		}
+	}
+
+	baseline, err := loadBaseline(path)
+	if err != nil {
+		return err
+	}
+
+	baseline.RecordedAt = time.Now().UTC().Truncate(time.Second)
+	baseline.Concurrency = options.Concurrency
+	baseline.Duration = options.Duration.String()
+	baseline.FileSize = options.FileSize
+	baseline.SeedFiles = options.SeedFiles
+	baseline.Stand = options.Stand
+	for _, result := range results {
+		stored := *result
+		stored.LastError = ""
+		baseline.Scenarios[result.Scenario] = &stored
+	}
+
+	data, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// parameterMismatches возвращает параметры прогона, которые отличаются от параметров baseline
+func parameterMismatches(baseline *Baseline, options Options) []string {
+	mismatches := make([]string, 0)
+	if baseline.Concurrency != options.Concurrency {
+		mismatches = append(mismatches, fmt.Sprintf("concurrency %d vs %d", options.Concurrency, baseline.Concurrency))
+	}
+	if baseline.Duration != options.Duration.String() {
+		mismatches = append(mismatches, fmt.Sprintf("duration %s vs %s", options.Duration, baseline.Duration))
+	}
+	if baseline.FileSize != options.FileSize {
+		mismatches = append(mismatches, fmt.Sprintf("file size %d vs %d", options.FileSize, baseline.FileSize))
+	}
+	if baseline.SeedFiles != options.SeedFiles {
+		mismatches = append(mismatches, fmt.Sprintf("seed files %d vs %d", options.SeedFiles, baseline.SeedFiles))
+	}
+	return mismatches
+}
+
+// compareWithBaseline возвращает описания регрессий p95 и пропускной способности сверх допустимого процента.
+// Сценарии без baseline и прогоны с ошибками сообщаются отдельно.
+func compareWithBaseline(baseline *Baseline, results []*Result, maxRegression float64) []string {
+	regressions := make([]string, 0)
+	for _, result := range results {
+		if result.Errors > 0 {
+			regressions = append(regressions, fmt.Sprintf("%s: %d failed requests", result.Scenario, result.Errors))
+		}
+
+		expected, ok := baseline.Scenarios[result.Scenario]
+		if !ok {
+			fmt.Printf("⚠ No baseline for %s, run with -update-baseline to record it\n", result.Scenario)
+			continue
+		}
+
+		if expected.P95Ms > 0 {
+			if change := (result.P95Ms - expected.P95Ms) / expected.P95Ms * 100; change > maxRegression {
+				regressions = append(regressions, fmt.Sprintf("%s: p95 %.1fms vs baseline %.1fms (+%.0f%%)",
+					result.Scenario, result.P95Ms, expected.P95Ms, change))
+			}
+		}
+		if expected.Throughput > 0 {
+			if change := (expected.Throughput - result.Throughput) / expected.Throughput * 100; change > maxRegression {
+				regressions = append(regressions, fmt.Sprintf("%s: %.1f rps vs baseline %.1f rps (-%.0f%%)",
+					result.Scenario, result.Throughput, expected.Throughput, change))
+			}
+		}
+	}
+	return regressions
+}
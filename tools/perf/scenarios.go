@@ -0,0 +1,342 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Options параметры нагрузочного прогона
+type Options struct {
+	URL         string
+	Headers     http.Header
+	Concurrency int
+	Duration    time.Duration
+	FileSize    int64
+	SeedFiles   int
+	// Stand описание стенда, сохраняемое в baseline
+	Stand string
+}
+
+// Scenario нагрузочный сценарий: Setup выполняется один раз, Step - в цикле каждым воркером
+type Scenario struct {
+	Name  string
+	Setup func(ctx context.Context, r *Runner) error
+	Step  func(ctx context.Context, r *Runner) error
+}
+
+// scenarios доступные сценарии в порядке запуска
+var scenarios = []Scenario{
+	{Name: "upload", Step: stepUpload},
+	{Name: "presign", Setup: setupSeedFiles, Step: stepPresign},
+	{Name: "archive", Setup: setupSeedFiles, Step: stepArchive},
+	{Name: "files", Setup: setupSeedFiles, Step: stepFilesConnection},
+}
+
+func scenarioNamesList() []string {
+	names := make([]string, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		names = append(names, scenario.Name)
+	}
+	return names
+}
+
+// selectScenarios возвращает сценарии по списку имен через запятую
+func selectScenarios(value string) ([]Scenario, error) {
+	if value == "" || value == "all" {
+		return scenarios, nil
+	}
+
+	selected := make([]Scenario, 0)
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, scenario := range scenarios {
+			if scenario.Name == name {
+				selected = append(selected, scenario)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown scenario %q (available: %s)", name, strings.Join(scenarioNamesList(), ", "))
+		}
+	}
+	return selected, nil
+}
+
+// Runner выполняет сценарии против GraphQL endpoint
+type Runner struct {
+	options Options
+	client  *http.Client
+	payload []byte
+
+	seedMu  sync.Mutex
+	fileIDs []string
+	counter atomic.Uint64
+}
+
+// NewRunner создает runner; содержимое загружаемых файлов генерируется один раз
+func NewRunner(options Options) *Runner {
+	payload := make([]byte, options.FileSize)
+	_, _ = rand.Read(payload)
+
+	return &Runner{
+		options: options,
+		client: &http.Client{
+			Timeout: 2 * time.Minute,
+			Transport: &http.Transport{
+				MaxIdleConns:        options.Concurrency * 2,
+				MaxIdleConnsPerHost: options.Concurrency * 2,
+			},
+		},
+		payload: payload,
+	}
+}
+
+// Run выполняет сценарий заданное время заданным числом воркеров
+func (r *Runner) Run(ctx context.Context, scenario Scenario) (*Result, error) {
+	if scenario.Setup != nil {
+		if err := scenario.Setup(ctx, r); err != nil {
+			return nil, fmt.Errorf("setup: %w", err)
+		}
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, r.options.Duration)
+	defer cancel()
+
+	var (
+		mu        sync.Mutex
+		latencies []time.Duration
+		errors    int
+		lastError error
+		wg        sync.WaitGroup
+	)
+
+	start := time.Now()
+	for i := 0; i < r.options.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for runCtx.Err() == nil {
+				stepStart := time.Now()
+				err := scenario.Step(runCtx, r)
+				elapsed := time.Since(stepStart)
+
+				// Запрос, прерванный окончанием прогона, не учитываем
+				if runCtx.Err() != nil {
+					return
+				}
+
+				mu.Lock()
+				if err != nil {
+					errors++
+					lastError = err
+				} else {
+					latencies = append(latencies, elapsed)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	result := newResult(scenario.Name, latencies, errors, time.Since(start))
+	if lastError != nil {
+		result.LastError = lastError.Error()
+	}
+	return result, nil
+}
+
+// graphQLResponse ответ GraphQL: data разбирается сценарием
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// do выполняет запрос и возвращает data; ошибки GraphQL и ответы с success=false считаются ошибкой
+func (r *Runner) do(ctx context.Context, req *http.Request, target interface{}) error {
+	for name, values := range r.options.Headers {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+
+	resp, err := r.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, truncate(body))
+	}
+
+	var payload graphQLResponse
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	if len(payload.Errors) > 0 {
+		return fmt.Errorf("graphql error: %s", payload.Errors[0].Message)
+	}
+	if target == nil {
+		return nil
+	}
+	return json.Unmarshal(payload.Data, target)
+}
+
+// query выполняет JSON GraphQL запрос
+func (r *Runner) query(ctx context.Context, query string, variables map[string]interface{}, target interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.options.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return r.do(ctx, req, target)
+}
+
+// mutationResult общая часть ответов мутаций {success, message}
+type mutationResult struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+func (m mutationResult) err() error {
+	if !m.Success {
+		return fmt.Errorf("mutation failed: %s", m.Message)
+	}
+	return nil
+}
+
+const uploadMutation = `mutation($file: Upload!) { uploadFile(input: {file: $file}) { success message file { id } } }`
+
+// upload загружает файл по GraphQL multipart request spec и возвращает ID созданной записи
+func (r *Runner) upload(ctx context.Context) (string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	operations, _ := json.Marshal(map[string]interface{}{
+		"query":     uploadMutation,
+		"variables": map[string]interface{}{"file": nil},
+	})
+	_ = writer.WriteField("operations", string(operations))
+	_ = writer.WriteField("map", `{"0": ["variables.file"]}`)
+
+	part, err := writer.CreateFormFile("0", fmt.Sprintf("perf-%d.bin", time.Now().UnixNano()))
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(r.payload); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.options.URL, &body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var data struct {
+		UploadFile struct {
+			mutationResult
+			File *struct {
+				ID string `json:"id"`
+			} `json:"file"`
+		} `json:"uploadFile"`
+	}
+	if err := r.do(ctx, req, &data); err != nil {
+		return "", err
+	}
+	if err := data.UploadFile.err(); err != nil {
+		return "", err
+	}
+	if data.UploadFile.File == nil {
+		return "", fmt.Errorf("upload returned no file")
+	}
+	return data.UploadFile.File.ID, nil
+}
+
+// setupSeedFiles загружает файлы, на которых выполняются сценарии чтения (один раз за прогон)
+func setupSeedFiles(ctx context.Context, r *Runner) error {
+	r.seedMu.Lock()
+	defer r.seedMu.Unlock()
+
+	for len(r.fileIDs) < r.options.SeedFiles {
+		fileID, err := r.upload(ctx)
+		if err != nil {
+			return err
+		}
+		r.fileIDs = append(r.fileIDs, fileID)
+	}
+	return nil
+}
+
+// seedFileID возвращает один из загруженных файлов (по кругу)
+func (r *Runner) seedFileID(i int) string {
+	return r.fileIDs[i%len(r.fileIDs)]
+}
+
+func stepUpload(ctx context.Context, r *Runner) error {
+	_, err := r.upload(ctx)
+	return err
+}
+
+func stepPresign(ctx context.Context, r *Runner) error {
+	index := int(r.counter.Add(1))
+
+	var data struct {
+		GetFileDownloadURL mutationResult `json:"getFileDownloadURL"`
+	}
+	if err := r.query(ctx, `mutation($id: ID!) { getFileDownloadURL(id: $id) { success message url } }`,
+		map[string]interface{}{"id": r.seedFileID(index)}, &data); err != nil {
+		return err
+	}
+	return data.GetFileDownloadURL.err()
+}
+
+func stepArchive(ctx context.Context, r *Runner) error {
+	var data struct {
+		GetBatchDownloadURL mutationResult `json:"getBatchDownloadURL"`
+	}
+	if err := r.query(ctx, `mutation($ids: [ID!]!) { getBatchDownloadURL(input: {fileIds: $ids}) { success message url totalFiles } }`,
+		map[string]interface{}{"ids": r.fileIDs}, &data); err != nil {
+		return err
+	}
+	return data.GetBatchDownloadURL.err()
+}
+
+func stepFilesConnection(ctx context.Context, r *Runner) error {
+	return r.query(ctx, `query { files(first: 50) { totalCount edges { node { id originalName size mimeType createTime } } } }`, nil, nil)
+}
+
+// truncate сокращает тело ответа для сообщения об ошибке
+func truncate(body []byte) string {
+	const limit = 200
+	if len(body) > limit {
+		return string(body[:limit]) + "..."
+	}
+	return string(body)
+}
@@ -0,0 +1,9 @@
+package utils
+
+import "os"
+
+// IsProduction сообщает, запущен ли сервис в production окружении.
+// Окружение задается переменной ENV или GO_ENV; production считается любая из них.
+func IsProduction() bool {
+	return os.Getenv("ENV") == "production" || os.Getenv("GO_ENV") == "production"
+}
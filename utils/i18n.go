@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"bytes"
 	"context"
 	"sync"
+	"text/template"
 
 	federation "github.com/esemashko/v2-federation"
 	"github.com/nicksnyder/go-i18n/v2/i18n"
@@ -67,12 +69,83 @@ func getLocalizer(lang string) *i18n.Localizer {
 // TemplateData представляет данные для подстановки в шаблон локализации
 type TemplateData map[string]interface{}
 
+// TenantLocale содержит настройки локализации тенанта: язык по умолчанию и переопределения сообщений
+type TenantLocale struct {
+	DefaultLanguage string `json:"default_language,omitempty"`
+	// Overrides: язык -> ключ сообщения -> текст
+	Overrides map[string]map[string]string `json:"overrides,omitempty"`
+}
+
+type tenantLocaleKey struct{}
+
+// WithTenantLocale сохраняет настройки локализации тенанта в контексте запроса
+func WithTenantLocale(ctx context.Context, locale *TenantLocale) context.Context {
+	return context.WithValue(ctx, tenantLocaleKey{}, locale)
+}
+
+// GetTenantLocale возвращает настройки локализации тенанта из контекста
+func GetTenantLocale(ctx context.Context) *TenantLocale {
+	locale, _ := ctx.Value(tenantLocaleKey{}).(*TenantLocale)
+	return locale
+}
+
+// resolveLanguage определяет язык: язык пользователя, затем язык тенанта по умолчанию, затем en
+func resolveLanguage(ctx context.Context) string {
+	if lang := federation.GetLanguage(ctx); lang != "" {
+		return lang
+	}
+	if locale := GetTenantLocale(ctx); locale != nil && locale.DefaultLanguage != "" {
+		return locale.DefaultLanguage
+	}
+	return "en"
+}
+
+// localizeOverride применяет переопределение тенанта поверх базового bundle
+func localizeOverride(ctx context.Context, lang, messageID string, data []TemplateData) (string, bool) {
+	locale := GetTenantLocale(ctx)
+	if locale == nil {
+		return "", false
+	}
+	text, ok := locale.Overrides[lang][messageID]
+	if !ok || text == "" {
+		return "", false
+	}
+
+	tmpl, err := template.New(messageID).Parse(text)
+	if err != nil {
+		Logger.Warn("Invalid tenant translation override",
+			zap.String("messageID", messageID),
+			zap.String("language", lang),
+			zap.Error(err),
+		)
+		return "", false
+	}
+
+	var templateData interface{}
+	if len(data) > 0 {
+		templateData = data[0]
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, templateData); err != nil {
+		Logger.Warn("Failed to render tenant translation override",
+			zap.String("messageID", messageID),
+			zap.String("language", lang),
+			zap.Error(err),
+		)
+		return "", false
+	}
+	return buf.String(), true
+}
+
 // T возвращает локализованную строку по ключу с подстановкой переменных
 func T(ctx context.Context, messageID string, data ...TemplateData) string {
-	// Получаем язык из federation контекста
-	lang := federation.GetLanguage(ctx)
-	if lang == "" {
-		lang = "en"
+	// Получаем язык из federation контекста (или язык тенанта по умолчанию)
+	lang := resolveLanguage(ctx)
+
+	// Переопределения тенанта имеют приоритет над базовым bundle
+	if msg, ok := localizeOverride(ctx, lang, messageID, data); ok {
+		return msg
 	}
 
 	// Получаем закешированный локализатор
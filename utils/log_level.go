@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// DefaultLogLevelOverrideDuration длительность временного уровня логирования по умолчанию
+	DefaultLogLevelOverrideDuration = 15 * time.Minute
+	// MaxLogLevelOverrideDuration максимальная длительность временного уровня логирования
+	MaxLogLevelOverrideDuration = 2 * time.Hour
+)
+
+// LogLevel текущий уровень логирования; может меняться во время работы сервиса
+var LogLevel = zap.NewAtomicLevel()
+
+var (
+	logLevelMu sync.Mutex
+	// baseLogLevel уровень из конфигурации, к которому возвращается временное переопределение
+	baseLogLevel = zapcore.InfoLevel
+	// logLevelOverride активное временное переопределение уровня
+	logLevelOverride *logLevelOverrideState
+)
+
+type logLevelOverrideState struct {
+	timer     *time.Timer
+	expiresAt time.Time
+}
+
+// LogLevelState описывает текущий уровень логирования
+type LogLevelState struct {
+	Level     string     `json:"level"`
+	BaseLevel string     `json:"baseLevel"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// SetLogLevel меняет базовый уровень логирования без пересоздания логгера (debug, info, warn, error).
+// Если активно временное переопределение, новый уровень применится после его окончания.
+func SetLogLevel(level string) error {
+	parsed, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	setBaseLogLevel(parsed)
+	return nil
+}
+
+// SetTemporaryLogLevel устанавливает уровень логирования на время duration,
+// после чего автоматически возвращает базовый уровень. Повторный вызов заменяет предыдущее переопределение.
+func SetTemporaryLogLevel(level string, duration time.Duration) (LogLevelState, error) {
+	parsed, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return LogLevelState{}, err
+	}
+	if duration <= 0 {
+		duration = DefaultLogLevelOverrideDuration
+	}
+	if duration > MaxLogLevelOverrideDuration {
+		duration = MaxLogLevelOverrideDuration
+	}
+
+	logLevelMu.Lock()
+	defer logLevelMu.Unlock()
+
+	if logLevelOverride != nil {
+		logLevelOverride.timer.Stop()
+	}
+
+	override := &logLevelOverrideState{expiresAt: time.Now().Add(duration)}
+	override.timer = time.AfterFunc(duration, func() {
+		revertLogLevel(override)
+	})
+	logLevelOverride = override
+	LogLevel.SetLevel(parsed)
+
+	if Logger != nil {
+		Logger.Info("Log level temporarily changed",
+			zap.String("level", parsed.String()),
+			zap.String("base_level", baseLogLevel.String()),
+			zap.Time("expires_at", override.expiresAt))
+	}
+
+	return currentLogLevelStateLocked(), nil
+}
+
+// ResetLogLevel отменяет временное переопределение и возвращает базовый уровень
+func ResetLogLevel() LogLevelState {
+	logLevelMu.Lock()
+	defer logLevelMu.Unlock()
+
+	if logLevelOverride != nil {
+		logLevelOverride.timer.Stop()
+		logLevelOverride = nil
+	}
+	LogLevel.SetLevel(baseLogLevel)
+	return currentLogLevelStateLocked()
+}
+
+// CurrentLogLevel возвращает текущее состояние уровня логирования
+func CurrentLogLevel() LogLevelState {
+	logLevelMu.Lock()
+	defer logLevelMu.Unlock()
+	return currentLogLevelStateLocked()
+}
+
+// setBaseLogLevel задает базовый уровень и применяет его, если нет временного переопределения
+func setBaseLogLevel(level zapcore.Level) {
+	logLevelMu.Lock()
+	defer logLevelMu.Unlock()
+
+	baseLogLevel = level
+	if logLevelOverride == nil {
+		LogLevel.SetLevel(level)
+	}
+}
+
+// revertLogLevel возвращает базовый уровень по истечении переопределения (если оно не было заменено)
+func revertLogLevel(override *logLevelOverrideState) {
+	logLevelMu.Lock()
+	defer logLevelMu.Unlock()
+
+	if logLevelOverride != override {
+		return
+	}
+	logLevelOverride = nil
+	LogLevel.SetLevel(baseLogLevel)
+
+	if Logger != nil {
+		Logger.Info("Temporary log level expired, reverted to base level",
+			zap.String("level", baseLogLevel.String()))
+	}
+}
+
+func currentLogLevelStateLocked() LogLevelState {
+	state := LogLevelState{
+		Level:     LogLevel.Level().String(),
+		BaseLevel: baseLogLevel.String(),
+	}
+	if logLevelOverride != nil {
+		expiresAt := logLevelOverride.expiresAt
+		state.ExpiresAt = &expiresAt
+	}
+	return state
+}
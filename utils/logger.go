@@ -14,16 +14,20 @@ var Logger *zap.Logger
 func InitLogger() {
 	config := zap.NewProductionConfig()
 
-	// Set output path
+	// Set output path (LOG_OUTPUT=stderr оставляет stdout для машиночитаемого вывода, например -selftest)
 	config.OutputPaths = []string{"stdout"}
+	if output := os.Getenv("LOG_OUTPUT"); output != "" {
+		config.OutputPaths = []string{output}
+	}
 
 	// Set time format
 	config.EncoderConfig.TimeKey = "timestamp"
 	config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 
 	// Set log level depending on environment
-	if os.Getenv("GO_ENV") == "production" {
-		config.Level = zap.NewAtomicLevelAt(zap.InfoLevel)
+	if IsProduction() {
+		setBaseLogLevel(zap.InfoLevel)
+		config.Level = LogLevel
 		// Additional settings for production
 		config.Sampling = &zap.SamplingConfig{
 			Initial:    100,
@@ -31,7 +35,8 @@ func InitLogger() {
 		}
 	} else {
 		// For local development
-		config.Level = zap.NewAtomicLevelAt(zap.DebugLevel)
+		setBaseLogLevel(zap.DebugLevel)
+		config.Level = LogLevel
 		config.Development = true
 		config.Encoding = "console" // More readable format for development
 
@@ -46,7 +51,7 @@ func InitLogger() {
 	}
 
 	// Если включено логирование запросов, добавляем обертку
-	if os.Getenv("ENABLE_QUERY_LOG") == "true" && os.Getenv("ENV") != "production" {
+	if os.Getenv("ENABLE_QUERY_LOG") == "true" && !IsProduction() {
 		options = append(options, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
 			// TODO: uncomment after querylog creation
 			// return querylog.NewQueryLogCore(core, querylog.GetCollector())
@@ -59,6 +64,13 @@ func InitLogger() {
 	if err != nil {
 		panic(err)
 	}
+
+	// LOG_LEVEL переопределяет уровень по умолчанию для окружения
+	if level := os.Getenv("LOG_LEVEL"); level != "" {
+		if err := SetLogLevel(level); err != nil {
+			Logger.Warn("Invalid LOG_LEVEL, using default", zap.String("level", level))
+		}
+	}
 }
 
 // DebugLog логирует debug-сообщения с поддержкой форматирования
@@ -1,5 +1,44 @@
 package utils
 
+import (
+	"context"
+	"time"
+	// Встроенная база часовых поясов: образ сервиса может не содержать tzdata
+	_ "time/tzdata"
+)
+
+// UserTimezoneHeader заголовок, которым gateway передает часовой пояс пользователя (IANA ID, например Europe/Moscow)
+const UserTimezoneHeader = "X-User-Timezone"
+
+// userTimezoneKey ключ часового пояса пользователя в контексте
+type userTimezoneKey struct{}
+
+// WithUserTimezone сохраняет в контексте часовой пояс пользователя; неизвестные часовые пояса игнорируются
+func WithUserTimezone(ctx context.Context, timezoneID string) context.Context {
+	if !IsValidTimezone(timezoneID) {
+		return ctx
+	}
+	location, err := time.LoadLocation(timezoneID)
+	if err != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, userTimezoneKey{}, location)
+}
+
+// UserLocation возвращает часовой пояс пользователя из контекста (UTC, если gateway его не передал)
+func UserLocation(ctx context.Context) *time.Location {
+	if location, ok := ctx.Value(userTimezoneKey{}).(*time.Location); ok {
+		return location
+	}
+	return time.UTC
+}
+
+// FormatInUserTimezone форматирует время в RFC3339 в часовом поясе пользователя.
+// Время хранится и сравнивается в UTC, часовой пояс применяется только при выдаче клиенту.
+func FormatInUserTimezone(ctx context.Context, t time.Time) string {
+	return t.In(UserLocation(ctx)).Format(time.RFC3339)
+}
+
 // TimezoneInfo содержит информацию о часовом поясе
 type TimezoneInfo struct {
 	ID          string
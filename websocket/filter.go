@@ -0,0 +1,102 @@
+package websocket
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Ключи metadata событий, по которым фильтрует EventFilter
+const (
+	// MetadataMimeGroup группа типа содержимого файла (image, video, document, ...)
+	MetadataMimeGroup = "mime_group"
+	// MetadataEntityType тип сущности, к которой прикреплен файл (например, ticket)
+	MetadataEntityType = "entity_type"
+	// MetadataEntityID идентификатор сущности, к которой прикреплен файл
+	MetadataEntityID = "entity_id"
+)
+
+// EventFilter условия доставки событий подписчику. Проверяются на сервере до постановки события
+// в очередь соединения, поэтому отфильтрованные события не расходуют очередь и трафик клиента.
+// Пустые условия не ограничивают доставку; событие без нужного ключа metadata под условие не подходит.
+type EventFilter struct {
+	// Actions доставлять только события с этими действиями
+	Actions []EntityAction
+	// MimeGroups доставлять только события файлов этих групп (без учета регистра)
+	MimeGroups []string
+	// EntityType и EntityID доставлять только события файлов, прикрепленных к сущности
+	EntityType string
+	EntityID   *uuid.UUID
+	// ExcludeActorID не доставлять события, инициированные этим пользователем (собственные действия
+	// клиент уже отобразил); системные события без автора доставляются
+	ExcludeActorID *uuid.UUID
+}
+
+// IsEmpty проверяет, что фильтр не ограничивает доставку
+func (f EventFilter) IsEmpty() bool {
+	return len(f.Actions) == 0 && len(f.MimeGroups) == 0 && f.EntityType == "" && f.EntityID == nil && f.ExcludeActorID == nil
+}
+
+// Matches проверяет, подходит ли событие под фильтр
+func (f EventFilter) Matches(event EntityEvent) bool {
+	if len(f.Actions) > 0 && !containsAction(f.Actions, event.Action) {
+		return false
+	}
+	if f.ExcludeActorID != nil && event.ActorID != nil && *event.ActorID == *f.ExcludeActorID {
+		return false
+	}
+	if len(f.MimeGroups) > 0 {
+		group, _ := event.Metadata[MetadataMimeGroup].(string)
+		if !containsFold(f.MimeGroups, group) {
+			return false
+		}
+	}
+	if f.EntityType != "" {
+		entityType, _ := event.Metadata[MetadataEntityType].(string)
+		if !strings.EqualFold(entityType, f.EntityType) {
+			return false
+		}
+	}
+	if f.EntityID != nil {
+		entityID, _ := event.Metadata[MetadataEntityID].(string)
+		if parsed, err := uuid.Parse(entityID); err != nil || parsed != *f.EntityID {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesPayload проверяет сырое сообщение канала; сообщения не в формате EntityEvent
+// доставляются только подписчикам без фильтра
+func (f EventFilter) matchesPayload(payload []byte) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	var event EntityEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return false
+	}
+	return f.Matches(event)
+}
+
+func containsAction(actions []EntityAction, action EntityAction) bool {
+	for _, candidate := range actions {
+		if candidate == action {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package websocket
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEventFilterMatches проверяет условия фильтрации событий подписки
+func TestEventFilterMatches(t *testing.T) {
+	actorID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	otherID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+	ticketID := uuid.MustParse("33333333-3333-3333-3333-333333333333")
+
+	imageEvent := EntityEvent{
+		Action:   EntityActionCreated,
+		EntityID: uuid.New(),
+		Type:     "file",
+		ActorID:  &actorID,
+		Metadata: map[string]any{
+			MetadataMimeGroup:  "image",
+			MetadataEntityType: "ticket",
+			MetadataEntityID:   ticketID.String(),
+		},
+	}
+	systemEvent := EntityEvent{Action: EntityActionDeleted, EntityID: uuid.New(), Type: "file"}
+
+	tests := []struct {
+		name     string
+		filter   EventFilter
+		event    EntityEvent
+		expected bool
+	}{
+		{"empty filter", EventFilter{}, systemEvent, true},
+		{"action matches", EventFilter{Actions: []EntityAction{EntityActionCreated}}, imageEvent, true},
+		{"action differs", EventFilter{Actions: []EntityAction{EntityActionUpdated}}, imageEvent, false},
+		{"mime group case-insensitive", EventFilter{MimeGroups: []string{"IMAGE"}}, imageEvent, true},
+		{"mime group differs", EventFilter{MimeGroups: []string{"video"}}, imageEvent, false},
+		{"mime group missing in metadata", EventFilter{MimeGroups: []string{"image"}}, systemEvent, false},
+		{"attached to ticket", EventFilter{EntityType: "ticket", EntityID: &ticketID}, imageEvent, true},
+		{"attached to other ticket", EventFilter{EntityType: "ticket", EntityID: &otherID}, imageEvent, false},
+		{"own event excluded", EventFilter{ExcludeActorID: &actorID}, imageEvent, false},
+		{"other user's event delivered", EventFilter{ExcludeActorID: &otherID}, imageEvent, true},
+		{"system event delivered", EventFilter{ExcludeActorID: &actorID}, systemEvent, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.Matches(tt.event))
+
+			// Фильтр сырого сообщения канала дает тот же результат
+			payload, err := json.Marshal(tt.event)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, tt.filter.matchesPayload(payload))
+		})
+	}
+}
+
+// TestEventFilterPayloadNotEntityEvent проверяет доставку сообщений не в формате EntityEvent
+func TestEventFilterPayloadNotEntityEvent(t *testing.T) {
+	assert.True(t, EventFilter{}.matchesPayload([]byte("not json")))
+	assert.False(t, EventFilter{MimeGroups: []string{"image"}}.matchesPayload([]byte("not json")))
+}
@@ -0,0 +1,289 @@
+package websocket
+
+import (
+	"context"
+	"main/errcatalog"
+	"main/utils"
+	"os"
+	"strconv"
+	"sync"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultMaxSubscriptionsPerConnection максимальное количество одновременных подписок одного WebSocket соединения
+	defaultMaxSubscriptionsPerConnection = 50
+	// defaultMaxSubscriptionsPerUser максимальное количество одновременных подписок пользователя на всех соединениях экземпляра
+	defaultMaxSubscriptionsPerUser = 100
+	// defaultSubscriptionQueueSize количество недоставленных сообщений подписки, после которого клиент считается медленным
+	defaultSubscriptionQueueSize = 64
+)
+
+// SubscriptionLimits ограничения подписок WebSocket соединений
+type SubscriptionLimits struct {
+	// PerConnection максимальное количество подписок одного соединения (0 - без ограничения)
+	PerConnection int
+	// PerUser максимальное количество подписок пользователя на экземпляре сервиса (0 - без ограничения)
+	PerUser int
+	// QueueSize размер очереди сообщений каждой подписки
+	QueueSize int
+}
+
+// LoadSubscriptionLimits читает ограничения из окружения; некорректные значения заменяются значениями по умолчанию
+func LoadSubscriptionLimits() SubscriptionLimits {
+	limits := SubscriptionLimits{
+		PerConnection: envInt("WS_MAX_SUBSCRIPTIONS_PER_CONNECTION", defaultMaxSubscriptionsPerConnection),
+		PerUser:       envInt("WS_MAX_SUBSCRIPTIONS_PER_USER", defaultMaxSubscriptionsPerUser),
+		QueueSize:     envInt("WS_SUBSCRIPTION_QUEUE_SIZE", defaultSubscriptionQueueSize),
+	}
+	if limits.QueueSize <= 0 {
+		limits.QueueSize = defaultSubscriptionQueueSize
+	}
+	return limits
+}
+
+// envInt возвращает неотрицательное целое значение переменной окружения или значение по умолчанию
+func envInt(name string, defaultValue int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed < 0 {
+		utils.Logger.Warn("Invalid subscription limit, using default",
+			zap.String("name", name),
+			zap.String("value", value),
+			zap.Int("default", defaultValue))
+		return defaultValue
+	}
+	return parsed
+}
+
+// userSubscriptions считает активные подписки пользователей на экземпляре сервиса
+var userSubscriptions = struct {
+	sync.Mutex
+	counts map[uuid.UUID]int
+}{counts: make(map[uuid.UUID]int)}
+
+// acquireUserSubscription резервирует подписку пользователя; false - лимит исчерпан
+func acquireUserSubscription(userID uuid.UUID, limit int) bool {
+	userSubscriptions.Lock()
+	defer userSubscriptions.Unlock()
+	if limit > 0 && userSubscriptions.counts[userID] >= limit {
+		return false
+	}
+	userSubscriptions.counts[userID]++
+	return true
+}
+
+// releaseUserSubscription освобождает подписку пользователя
+func releaseUserSubscription(userID uuid.UUID) {
+	userSubscriptions.Lock()
+	defer userSubscriptions.Unlock()
+	if userSubscriptions.counts[userID] <= 1 {
+		delete(userSubscriptions.counts, userID)
+		return
+	}
+	userSubscriptions.counts[userID]--
+}
+
+// subscriptionQueue очередь сообщений одной подписки соединения
+type subscriptionQueue struct {
+	conn     *Connection
+	ctx      context.Context
+	channel  string
+	handler  EventHandler
+	messages chan []byte
+}
+
+// Connection мультиплексирует подписки одного WebSocket соединения: сообщения каждой подписки
+// попадают в отдельную очередь, а одна горутина доставляет их по кругу, по одному сообщению
+// из каждой очереди, поэтому подписка с потоком событий не задерживает остальные.
+// Переполнение очереди означает, что клиент не успевает читать: соединение закрывается.
+type Connection struct {
+	limits SubscriptionLimits
+	cancel context.CancelFunc
+	wake   chan struct{}
+
+	mu     sync.Mutex
+	queues []*subscriptionQueue
+	next   int
+}
+
+// connectionKey ключ контекста с соединением
+type connectionKey struct{}
+
+// WithConnection создает мультиплексор подписок WebSocket соединения и запускает доставку сообщений.
+// Вызывается при инициализации соединения; отмена контекста закрывает соединение.
+func WithConnection(ctx context.Context, limits SubscriptionLimits) context.Context {
+	ctx, cancel := context.WithCancel(ctx)
+	conn := &Connection{
+		limits: limits,
+		cancel: cancel,
+		wake:   make(chan struct{}, 1),
+	}
+	go conn.dispatch(ctx)
+	return context.WithValue(ctx, connectionKey{}, conn)
+}
+
+// connectionFromContext возвращает мультиплексор соединения (nil вне WebSocket соединения)
+func connectionFromContext(ctx context.Context) *Connection {
+	conn, _ := ctx.Value(connectionKey{}).(*Connection)
+	return conn
+}
+
+// CloseConnection останавливает доставку сообщений соединения
+func CloseConnection(ctx context.Context) {
+	if conn := connectionFromContext(ctx); conn != nil {
+		conn.cancel()
+	}
+}
+
+// register добавляет подписку в круговую доставку; nil - лимит подписок соединения исчерпан
+func (c *Connection) register(ctx context.Context, channel string, handler EventHandler) *subscriptionQueue {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.limits.PerConnection > 0 && len(c.queues) >= c.limits.PerConnection {
+		return nil
+	}
+	queue := &subscriptionQueue{
+		conn:     c,
+		ctx:      ctx,
+		channel:  channel,
+		handler:  handler,
+		messages: make(chan []byte, c.limits.QueueSize),
+	}
+	c.queues = append(c.queues, queue)
+	return queue
+}
+
+// unregister удаляет подписку из круговой доставки
+func (c *Connection) unregister(queue *subscriptionQueue) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, item := range c.queues {
+		if item != queue {
+			continue
+		}
+		c.queues = append(c.queues[:i], c.queues[i+1:]...)
+		if c.next > i {
+			c.next--
+		}
+		if c.next >= len(c.queues) {
+			c.next = 0
+		}
+		return
+	}
+}
+
+// push ставит сообщение в очередь подписки; false - очередь переполнена (медленный клиент)
+func (q *subscriptionQueue) push(payload []byte) bool {
+	select {
+	case q.messages <- payload:
+	default:
+		return false
+	}
+	select {
+	case q.conn.wake <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// closeSlowConsumer закрывает соединение клиента, который не успевает читать сообщения
+func (q *subscriptionQueue) closeSlowConsumer(tenantID string) {
+	utils.Logger.Warn("Closing websocket connection of slow subscription consumer",
+		zap.String("tenantID", tenantID),
+		zap.String("channel", q.channel),
+		zap.Int("queue_size", q.conn.limits.QueueSize))
+	q.conn.cancel()
+}
+
+// nextMessage выбирает следующее сообщение по кругу: очереди обходятся начиная с подписки,
+// следующей за обслуженной последней
+func (c *Connection) nextMessage() (*subscriptionQueue, []byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := 0; i < len(c.queues); i++ {
+		index := (c.next + i) % len(c.queues)
+		queue := c.queues[index]
+		select {
+		case payload := <-queue.messages:
+			c.next = (index + 1) % len(c.queues)
+			return queue, payload, true
+		default:
+		}
+	}
+	return nil, nil, false
+}
+
+// dispatch доставляет сообщения подписок до закрытия соединения
+func (c *Connection) dispatch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.wake:
+		}
+
+		for ctx.Err() == nil {
+			queue, payload, ok := c.nextMessage()
+			if !ok {
+				break
+			}
+			if queue.ctx.Err() != nil {
+				continue
+			}
+			if err := queue.handler(queue.ctx, payload); err != nil {
+				utils.Logger.Error("Error handling websocket event",
+					zap.String("channel", queue.channel),
+					zap.Error(err))
+			}
+		}
+	}
+}
+
+// acquireSubscription проверяет лимиты подписок пользователя и соединения и регистрирует подписку.
+// Возвращает очередь соединения (nil вне WebSocket соединения) и функцию освобождения лимитов.
+func acquireSubscription(ctx context.Context, channel string, handler EventHandler) (*subscriptionQueue, func(), error) {
+	conn := connectionFromContext(ctx)
+	var limits SubscriptionLimits
+	if conn != nil {
+		limits = conn.limits
+	} else {
+		limits = LoadSubscriptionLimits()
+	}
+
+	userID := federation.GetUserID(ctx)
+	if userID != nil && !acquireUserSubscription(*userID, limits.PerUser) {
+		utils.Logger.Warn("User subscription limit reached",
+			zap.String("userID", userID.String()),
+			zap.Int("limit", limits.PerUser))
+		return nil, nil, errcatalog.SubscriptionUserLimitExceeded(ctx)
+	}
+	releaseUser := func() {
+		if userID != nil {
+			releaseUserSubscription(*userID)
+		}
+	}
+
+	if conn == nil {
+		return nil, releaseUser, nil
+	}
+
+	queue := conn.register(ctx, channel, handler)
+	if queue == nil {
+		releaseUser()
+		utils.Logger.Warn("Connection subscription limit reached",
+			zap.String("channel", channel),
+			zap.Int("limit", limits.PerConnection))
+		return nil, nil, errcatalog.SubscriptionConnectionLimitExceeded(ctx)
+	}
+	return queue, func() {
+		conn.unregister(queue)
+		releaseUser()
+	}, nil
+}
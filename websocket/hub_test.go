@@ -0,0 +1,238 @@
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	federation "github.com/esemashko/v2-federation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// userContext возвращает контекст пользователя тенанта
+func userContext(ctx context.Context, tenantID, userID uuid.UUID) context.Context {
+	return federation.WithContext(ctx, &federation.Context{TenantID: &tenantID, UserID: &userID})
+}
+
+// userSubscriptionCount возвращает число активных подписок пользователя
+func userSubscriptionCount(userID uuid.UUID) int {
+	userSubscriptions.Lock()
+	defer userSubscriptions.Unlock()
+	return userSubscriptions.counts[userID]
+}
+
+// queueCount возвращает число подписок в круговой доставке соединения
+func (c *Connection) queueCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.queues)
+}
+
+// newTestConnection соединение с отменяемым контекстом; доставка сообщений запущена
+func newTestConnection(t *testing.T, limits SubscriptionLimits) (context.Context, *Connection) {
+	t.Helper()
+	ctx := WithConnection(context.Background(), limits)
+	t.Cleanup(func() { CloseConnection(ctx) })
+	return ctx, connectionFromContext(ctx)
+}
+
+// newRotationConnection соединение без горутины доставки для пошаговой проверки nextMessage
+func newRotationConnection(names ...string) (*Connection, map[string]*subscriptionQueue) {
+	conn := &Connection{
+		limits: SubscriptionLimits{QueueSize: 8},
+		cancel: func() {},
+		wake:   make(chan struct{}, 1),
+	}
+	queues := make(map[string]*subscriptionQueue, len(names))
+	for _, name := range names {
+		queue := conn.register(context.Background(), name, nil)
+		queue.push([]byte(name))
+		queues[name] = queue
+	}
+	return conn, queues
+}
+
+// nextChannel возвращает канал подписки, сообщение которой доставляется следующим
+func nextChannel(t *testing.T, conn *Connection) string {
+	t.Helper()
+	queue, payload, ok := conn.nextMessage()
+	require.True(t, ok)
+	assert.Equal(t, queue.channel, string(payload))
+	return queue.channel
+}
+
+func noopHandler(context.Context, []byte) error { return nil }
+
+func TestSubscriptionLimitsReleasedOnFailure(t *testing.T) {
+	tenantID := uuid.New()
+
+	t.Run("connection limit releases the user subscription", func(t *testing.T) {
+		userID := uuid.New()
+		connCtx, conn := newTestConnection(t, SubscriptionLimits{PerConnection: 1, PerUser: 5, QueueSize: 4})
+		ctx := userContext(connCtx, tenantID, userID)
+
+		_, release, err := acquireSubscription(ctx, "first", noopHandler)
+		require.NoError(t, err)
+
+		_, _, err = acquireSubscription(ctx, "second", noopHandler)
+		require.Error(t, err)
+		assert.Equal(t, 1, userSubscriptionCount(userID), "rejected subscription does not hold a user slot")
+		assert.Equal(t, 1, conn.queueCount())
+
+		release()
+		assert.Equal(t, 0, userSubscriptionCount(userID))
+		assert.Equal(t, 0, conn.queueCount())
+	})
+
+	t.Run("user limit does not register the subscription", func(t *testing.T) {
+		userID := uuid.New()
+		limits := SubscriptionLimits{PerConnection: 5, PerUser: 1, QueueSize: 4}
+		firstCtx, _ := newTestConnection(t, limits)
+		secondCtx, second := newTestConnection(t, limits)
+
+		_, release, err := acquireSubscription(userContext(firstCtx, tenantID, userID), "first", noopHandler)
+		require.NoError(t, err)
+		defer release()
+
+		_, _, err = acquireSubscription(userContext(secondCtx, tenantID, userID), "second", noopHandler)
+		require.Error(t, err)
+		assert.Equal(t, 0, second.queueCount())
+		assert.Equal(t, 1, userSubscriptionCount(userID))
+	})
+
+	t.Run("subscription end releases limits", func(t *testing.T) {
+		userID := uuid.New()
+		connCtx, conn := newTestConnection(t, SubscriptionLimits{PerConnection: 1, PerUser: 1, QueueSize: 4})
+		ctx, cancel := context.WithCancel(userContext(connCtx, tenantID, userID))
+
+		require.NoError(t, New().Subscribe(ctx, "subscription-end", noopHandler))
+		assert.Equal(t, 1, userSubscriptionCount(userID))
+
+		cancel()
+		assert.Eventually(t, func() bool {
+			return userSubscriptionCount(userID) == 0 && conn.queueCount() == 0
+		}, 2*time.Second, 10*time.Millisecond)
+
+		// Освобожденный лимит позволяет подписаться снова
+		require.NoError(t, New().Subscribe(userContext(connCtx, tenantID, userID), "subscription-end", noopHandler))
+	})
+}
+
+func TestConnectionRotationAfterUnregister(t *testing.T) {
+	t.Run("unregister before the cursor keeps the next subscription", func(t *testing.T) {
+		conn, queues := newRotationConnection("a", "b", "c")
+		assert.Equal(t, "a", nextChannel(t, conn))
+
+		conn.unregister(queues["a"])
+		assert.Equal(t, "b", nextChannel(t, conn))
+		assert.Equal(t, "c", nextChannel(t, conn))
+	})
+
+	t.Run("unregister at the cursor moves to the following subscription", func(t *testing.T) {
+		conn, queues := newRotationConnection("a", "b", "c")
+		assert.Equal(t, "a", nextChannel(t, conn))
+
+		conn.unregister(queues["b"])
+		assert.Equal(t, "c", nextChannel(t, conn))
+	})
+
+	t.Run("unregister of the last subscription wraps the cursor", func(t *testing.T) {
+		conn, queues := newRotationConnection("a", "b", "c")
+		assert.Equal(t, "a", nextChannel(t, conn))
+		assert.Equal(t, "b", nextChannel(t, conn))
+
+		conn.unregister(queues["c"])
+		assert.Equal(t, 0, conn.next)
+		queues["a"].push([]byte("a"))
+		assert.Equal(t, "a", nextChannel(t, conn))
+	})
+
+	t.Run("unregister of all subscriptions", func(t *testing.T) {
+		conn, queues := newRotationConnection("a", "b")
+		assert.Equal(t, "a", nextChannel(t, conn))
+		conn.unregister(queues["b"])
+		conn.unregister(queues["a"])
+
+		_, _, ok := conn.nextMessage()
+		assert.False(t, ok)
+		assert.Equal(t, 0, conn.next)
+	})
+
+	t.Run("concurrent unregister during rotation", func(t *testing.T) {
+		conn, _ := newRotationConnection()
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 200; j++ {
+					queue := conn.register(context.Background(), fmt.Sprintf("%d-%d", i, j), nil)
+					queue.push([]byte(queue.channel))
+					conn.unregister(queue)
+				}
+			}(i)
+		}
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+		for {
+			select {
+			case <-done:
+				assert.Equal(t, 0, conn.queueCount())
+				return
+			default:
+				conn.nextMessage()
+			}
+		}
+	})
+}
+
+func TestSlowConsumerClosesConnection(t *testing.T) {
+	tenantID, userID := uuid.New(), uuid.New()
+	connCtx, conn := newTestConnection(t, SubscriptionLimits{PerConnection: 5, PerUser: 5, QueueSize: 2})
+	ctx := userContext(connCtx, tenantID, userID)
+
+	// Обработчик не успевает доставлять сообщения клиенту
+	blocked := make(chan struct{})
+	defer close(blocked)
+	handler := func(ctx context.Context, payload []byte) error {
+		<-blocked
+		return nil
+	}
+
+	channel := tenantID.String() + ":file:updates"
+	require.NoError(t, New().Subscribe(ctx, channel, handler))
+	require.Eventually(t, func() bool {
+		return testRedis.PubSubNumSub(channel)[channel] == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	for i := 0; i < 10; i++ {
+		testRedis.Publish(channel, fmt.Sprintf(`{"n":%d}`, i))
+	}
+
+	select {
+	case <-connCtx.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("connection of a slow consumer is not closed")
+	}
+	assert.Eventually(t, func() bool {
+		return userSubscriptionCount(userID) == 0 && conn.queueCount() == 0
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestSubscriptionQueueOverflow(t *testing.T) {
+	conn, queues := newRotationConnection("a")
+	queue := queues["a"]
+
+	// Одно сообщение уже в очереди размером 8
+	for i := 1; i < conn.limits.QueueSize; i++ {
+		require.True(t, queue.push([]byte("a")))
+	}
+	assert.False(t, queue.push([]byte("a")), "full queue rejects the message")
+}
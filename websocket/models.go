@@ -12,6 +12,10 @@ const (
 	EntityActionCreated EntityAction = "created"
 	EntityActionUpdated EntityAction = "updated"
 	EntityActionDeleted EntityAction = "deleted"
+	// EntityActionQuotaExceeded загрузка превысила квоту хранилища тенанта (режимы SOFT и GRACE)
+	EntityActionQuotaExceeded EntityAction = "quota_exceeded"
+	// EntityActionProgress прогресс длительной операции (например, загрузки файла)
+	EntityActionProgress EntityAction = "progress"
 )
 
 // EntityEvent представляет универсальное событие для любой сущности в системе
@@ -25,6 +29,9 @@ type EntityEvent struct {
 	// Type определяет тип сущности: ticket, user, etc.
 	Type string `json:"type"`
 
+	// ActorID пользователь, инициировавший событие (пусто для системных событий)
+	ActorID *uuid.UUID `json:"actor_id,omitempty"`
+
 	// Metadata содержит дополнительные данные о событии
 	Metadata map[string]any `json:"metadata,omitempty"`
 }
@@ -327,6 +327,12 @@ func (p *Publisher) publishEvent(ctx context.Context, channel string, event inte
 	}
 	redisClient := redisService.GetClient()
 
+	// Автор события нужен подписчикам с фильтром ExcludeActorID
+	if entityEvent, ok := event.(EntityEvent); ok && entityEvent.ActorID == nil {
+		entityEvent.ActorID = federation.GetUserID(ctx)
+		event = entityEvent
+	}
+
 	// Сериализуем событие
 	eventJSON, err := json.Marshal(event)
 	if err != nil {
@@ -334,8 +340,10 @@ func (p *Publisher) publishEvent(ctx context.Context, channel string, event inte
 		return err
 	}
 
-	// Публикуем событие
-	if err := redisClient.Publish(ctx, channel, eventJSON).Err(); err != nil {
+	// Публикуем событие (с бюджетом задержки: при замедлении Redis событие теряется, а не задерживает запрос)
+	if err := redis.WithLatencyBudget(ctx, redis.HotPathPublish, func(ctx context.Context) error {
+		return redisClient.Publish(ctx, channel, eventJSON).Err()
+	}); err != nil {
 		utils.Logger.Error("Failed to publish event",
 			zap.Error(err),
 			zap.String("channel", channel),
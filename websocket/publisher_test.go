@@ -3,17 +3,47 @@ package websocket
 import (
 	"context"
 	"encoding/json"
+	"os"
 	"testing"
 	"time"
 
-	"main/ctxkeys"
 	"main/utils"
 
+	"github.com/alicebob/miniredis/v2"
+	federation "github.com/esemashko/v2-federation"
 	"github.com/google/uuid"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
 )
 
+// testRedis Redis пакета: подписки и публикация работают через miniredis
+var testRedis *miniredis.Miniredis
+
+// TestMain подключает пустой bundle локализации (ошибки возвращают ключ сообщения) и miniredis
+func TestMain(m *testing.M) {
+	utils.InitLogger()
+	utils.SetI18nBundle(i18n.NewBundle(language.English))
+
+	server, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	testRedis = server
+	os.Setenv("REDIS_HOST", server.Host())
+	os.Setenv("REDIS_PORT", server.Port())
+
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+// tenantContext возвращает контекст запроса тенанта
+func tenantContext(tenantID uuid.UUID) context.Context {
+	return federation.WithContext(context.Background(), &federation.Context{TenantID: &tenantID})
+}
+
 // TestPublisherEventSerialization проверяет корректность сериализации событий
 func TestPublisherEventSerialization(t *testing.T) {
 	tests := []struct {
@@ -120,7 +150,7 @@ func TestPublisherErrorHandling(t *testing.T) {
 	})
 
 	t.Run("Nil tenant in context", func(t *testing.T) {
-		ctx := ctxkeys.SetTenant(context.Background(), nil)
+		ctx := federation.WithContext(context.Background(), &federation.Context{})
 		entityID := uuid.New()
 
 		err := publisher.PublishEntityCreated(ctx, "test", entityID)
@@ -134,10 +164,7 @@ func TestSubscriptionServiceChannelNames(t *testing.T) {
 	service := New()
 
 	// Создаем тестовый tenant
-	tenant := &ctxkeys.TenantInfo{
-		ID: uuid.MustParse("12345678-1234-1234-1234-123456789012"),
-	}
-	ctx := ctxkeys.SetTenant(context.Background(), tenant)
+	ctx := tenantContext(uuid.MustParse("12345678-1234-1234-1234-123456789012"))
 
 	tests := []struct {
 		name       string
@@ -257,10 +284,7 @@ func TestPublisherValidation(t *testing.T) {
 	// В интеграционных тестах проверяется полная функциональность
 
 	publisher := NewPublisher()
-	tenant := &ctxkeys.TenantInfo{
-		ID: uuid.New(),
-	}
-	ctx := ctxkeys.SetTenant(context.Background(), tenant)
+	ctx := tenantContext(uuid.New())
 
 	t.Run("Valid entity types", func(t *testing.T) {
 		validTypes := []string{
@@ -304,8 +328,7 @@ func TestTicketGlobalPublish(t *testing.T) {
 	utils.InitLogger()
 
 	publisher := NewPublisher()
-	tenant := &ctxkeys.TenantInfo{ID: uuid.New()}
-	ctx := ctxkeys.SetTenant(context.Background(), tenant)
+	ctx := tenantContext(uuid.New())
 
 	// We can't assert Redis behavior in unit test environment, but
 	// we ensure no validation error occurs when publishing to both channels.
@@ -344,10 +367,7 @@ func BenchmarkEventSerialization(b *testing.B) {
 // BenchmarkChannelNameGeneration тестирует производительность генерации имен каналов
 func BenchmarkChannelNameGeneration(b *testing.B) {
 	service := New()
-	tenant := &ctxkeys.TenantInfo{
-		ID: uuid.New(),
-	}
-	ctx := ctxkeys.SetTenant(context.Background(), tenant)
+	ctx := tenantContext(uuid.New())
 	entityID := uuid.New().String()
 
 	b.ResetTimer()
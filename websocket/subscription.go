@@ -25,6 +25,12 @@ func New() *SubscriptionService {
 // Subscribe выполняет подписку на указанный channel и вызывает переданный обработчик для каждого сообщения.
 // Возвращает канал для отмены подписки (закрытие канала отменяет подписку).
 func (s *SubscriptionService) Subscribe(ctx context.Context, channel string, handler EventHandler) error {
+	return s.SubscribeFiltered(ctx, channel, EventFilter{}, handler)
+}
+
+// SubscribeFiltered выполняет подписку как Subscribe, но доставляет обработчику только события,
+// подходящие под filter. Фильтр проверяется до очереди соединения.
+func (s *SubscriptionService) SubscribeFiltered(ctx context.Context, channel string, filter EventFilter, handler EventHandler) error {
 	// Проверяем наличие tenant в контексте
 	tenantIDPtr := federation.GetTenantID(ctx)
 	if tenantIDPtr == nil {
@@ -34,9 +40,16 @@ func (s *SubscriptionService) Subscribe(ctx context.Context, channel string, han
 
 	tenantID := tenantIDPtr.String()
 
+	// Лимиты подписок пользователя и соединения; queue - очередь круговой доставки соединения
+	queue, release, err := acquireSubscription(ctx, channel, handler)
+	if err != nil {
+		return err
+	}
+
 	// Получаем Redis клиент
 	redisService, err := redis.GetTenantCacheService()
 	if err != nil || redisService == nil || redisService.GetClient() == nil {
+		release()
 		utils.Logger.Error("Redis unavailable for websocket", zap.Error(err))
 		return errors.New(utils.T(ctx, "error.internal.redis_unavailable"))
 	}
@@ -48,6 +61,7 @@ func (s *SubscriptionService) Subscribe(ctx context.Context, channel string, han
 
 	// Проверяем, что подписка успешно создана
 	if chEvents == nil {
+		release()
 		utils.Logger.Error("Failed to create Redis websocket channel",
 			zap.String("tenantID", tenantID),
 			zap.String("channel", channel))
@@ -57,6 +71,7 @@ func (s *SubscriptionService) Subscribe(ctx context.Context, channel string, han
 	// Запускаем горутину для обработки сообщений
 	go func() {
 		var nilMessageCount int // Счетчик последовательных nil сообщений
+		defer release()
 		defer func() {
 			if r := recover(); r != nil {
 				utils.Logger.Error("Panic in websocket handler",
@@ -106,6 +121,20 @@ func (s *SubscriptionService) Subscribe(ctx context.Context, channel string, han
 				// Сбрасываем счетчик nil сообщений при получении валидного сообщения
 				nilMessageCount = 0
 
+				// Отфильтрованное событие не доставляется и не занимает очередь соединения
+				if !filter.matchesPayload([]byte(msg.Payload)) {
+					continue
+				}
+
+				// В WebSocket соединении событие доставляется по кругу вместе с остальными подписками
+				if queue != nil {
+					if !queue.push([]byte(msg.Payload)) {
+						queue.closeSlowConsumer(tenantID)
+						return
+					}
+					continue
+				}
+
 				// Вызываем обработчик для обработки события
 				if err := handler(ctx, []byte(msg.Payload)); err != nil {
 					utils.Logger.Error("Error handling websocket event",